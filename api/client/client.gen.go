@@ -90,6 +90,107 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetAdminFeeds request
+	GetAdminFeeds(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAdminFeedsRefresh request
+	PostAdminFeedsRefresh(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminMaintenance request
+	GetAdminMaintenance(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAdminMaintenance request with any body
+	PutAdminMaintenanceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutAdminMaintenance(ctx context.Context, body PutAdminMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminTasks request
+	GetAdminTasks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAdminTasksTaskNameRun request
+	PostAdminTasksTaskNameRun(ctx context.Context, taskName TaskName, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminUsage request
+	GetAdminUsage(ctx context.Context, params *GetAdminUsageParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAPIKeys request
+	GetAPIKeys(ctx context.Context, params *GetAPIKeysParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAPIKeys request with any body
+	PostAPIKeysWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAPIKeys(ctx context.Context, body PostAPIKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAPIKeysAPIKeyID request
+	DeleteAPIKeysAPIKeyID(ctx context.Context, apiKeyID ApiKeyID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAPIKeysAPIKeyID request
+	GetAPIKeysAPIKeyID(ctx context.Context, apiKeyID ApiKeyID, params *GetAPIKeysAPIKeyIDParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAssetGroups request
+	GetAssetGroups(ctx context.Context, params *GetAssetGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAssetGroups request with any body
+	PostAssetGroupsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAssetGroups(ctx context.Context, body PostAssetGroupsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAssetGroupsAssetGroupID request
+	DeleteAssetGroupsAssetGroupID(ctx context.Context, assetGroupID AssetGroupID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAssetGroupsAssetGroupID request
+	GetAssetGroupsAssetGroupID(ctx context.Context, assetGroupID AssetGroupID, params *GetAssetGroupsAssetGroupIDParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchAssetGroupsAssetGroupID request with any body
+	PatchAssetGroupsAssetGroupIDWithBody(ctx context.Context, assetGroupID AssetGroupID, params *PatchAssetGroupsAssetGroupIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchAssetGroupsAssetGroupID(ctx context.Context, assetGroupID AssetGroupID, params *PatchAssetGroupsAssetGroupIDParams, body PatchAssetGroupsAssetGroupIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAssetGroupsAssetGroupIDAssets request
+	GetAssetGroupsAssetGroupIDAssets(ctx context.Context, assetGroupID AssetGroupID, params *GetAssetGroupsAssetGroupIDAssetsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAssets request
+	GetAssets(ctx context.Context, params *GetAssetsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAssets request with any body
+	PostAssetsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAssets(ctx context.Context, body PostAssetsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAssetsAssetID request
+	DeleteAssetsAssetID(ctx context.Context, assetID AssetID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAssetsAssetID request
+	GetAssetsAssetID(ctx context.Context, assetID AssetID, params *GetAssetsAssetIDParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchAssetsAssetID request with any body
+	PatchAssetsAssetIDWithBody(ctx context.Context, assetID AssetID, params *PatchAssetsAssetIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchAssetsAssetID(ctx context.Context, assetID AssetID, params *PatchAssetsAssetIDParams, body PatchAssetsAssetIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAssetsAssetID request with any body
+	PutAssetsAssetIDWithBody(ctx context.Context, assetID AssetID, params *PutAssetsAssetIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutAssetsAssetID(ctx context.Context, assetID AssetID, params *PutAssetsAssetIDParams, body PutAssetsAssetIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAssetsAssetIDPackages request
+	GetAssetsAssetIDPackages(ctx context.Context, assetID AssetID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAssetsAssetIDSbomDiff request
+	GetAssetsAssetIDSbomDiff(ctx context.Context, assetID AssetID, params *GetAssetsAssetIDSbomDiffParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAssetsAssetIDScanResultDiff request
+	GetAssetsAssetIDScanResultDiff(ctx context.Context, assetID AssetID, params *GetAssetsAssetIDScanResultDiffParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAssetsAssetIDUpgradePlan request
+	GetAssetsAssetIDUpgradePlan(ctx context.Context, assetID AssetID, params *GetAssetsAssetIDUpgradePlanParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCorrelatedFindings request
+	GetCorrelatedFindings(ctx context.Context, params *GetCorrelatedFindingsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCorrelatedFindingsCorrelatedFindingID request
+	GetCorrelatedFindingsCorrelatedFindingID(ctx context.Context, correlatedFindingID CorrelatedFindingID, params *GetCorrelatedFindingsCorrelatedFindingIDParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetDiscoveryScopes request
 	GetDiscoveryScopes(ctx context.Context, params *GetDiscoveryScopesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -98,6 +199,47 @@ type ClientInterface interface {
 
 	PutDiscoveryScopes(ctx context.Context, body PutDiscoveryScopesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetFeedsFeedName request
+	GetFeedsFeedName(ctx context.Context, feedName FeedName, params *GetFeedsFeedNameParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetFindingDigests request
+	GetFindingDigests(ctx context.Context, params *GetFindingDigestsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostFindingDigests request with any body
+	PostFindingDigestsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostFindingDigests(ctx context.Context, body PostFindingDigestsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteFindingDigestsFindingDigestID request
+	DeleteFindingDigestsFindingDigestID(ctx context.Context, findingDigestID FindingDigestID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetFindingDigestsFindingDigestID request
+	GetFindingDigestsFindingDigestID(ctx context.Context, findingDigestID FindingDigestID, params *GetFindingDigestsFindingDigestIDParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchFindingDigestsFindingDigestID request with any body
+	PatchFindingDigestsFindingDigestIDWithBody(ctx context.Context, findingDigestID FindingDigestID, params *PatchFindingDigestsFindingDigestIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchFindingDigestsFindingDigestID(ctx context.Context, findingDigestID FindingDigestID, params *PatchFindingDigestsFindingDigestIDParams, body PatchFindingDigestsFindingDigestIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetFindingExceptions request
+	GetFindingExceptions(ctx context.Context, params *GetFindingExceptionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostFindingExceptions request with any body
+	PostFindingExceptionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostFindingExceptions(ctx context.Context, body PostFindingExceptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteFindingExceptionsFindingExceptionID request
+	DeleteFindingExceptionsFindingExceptionID(ctx context.Context, findingExceptionID FindingExceptionID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetFindingExceptionsFindingExceptionID request
+	GetFindingExceptionsFindingExceptionID(ctx context.Context, findingExceptionID FindingExceptionID, params *GetFindingExceptionsFindingExceptionIDParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchFindingExceptionsFindingExceptionID request with any body
+	PatchFindingExceptionsFindingExceptionIDWithBody(ctx context.Context, findingExceptionID FindingExceptionID, params *PatchFindingExceptionsFindingExceptionIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchFindingExceptionsFindingExceptionID(ctx context.Context, findingExceptionID FindingExceptionID, params *PatchFindingExceptionsFindingExceptionIDParams, body PatchFindingExceptionsFindingExceptionIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetFindings request
 	GetFindings(ctx context.Context, params *GetFindingsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -106,6 +248,16 @@ type ClientInterface interface {
 
 	PostFindings(ctx context.Context, body PostFindingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PatchFindingsBulk request with any body
+	PatchFindingsBulkWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchFindingsBulk(ctx context.Context, body PatchFindingsBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostFindingsBulk request with any body
+	PostFindingsBulkWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostFindingsBulk(ctx context.Context, body PostFindingsBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteFindingsFindingID request
 	DeleteFindingsFindingID(ctx context.Context, findingID FindingID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -122,6 +274,99 @@ type ClientInterface interface {
 
 	PutFindingsFindingID(ctx context.Context, findingID FindingID, body PutFindingsFindingIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostFindingsFindingIDScannerSuppression request with any body
+	PostFindingsFindingIDScannerSuppressionWithBody(ctx context.Context, findingID FindingID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostFindingsFindingIDScannerSuppression(ctx context.Context, findingID FindingID, body PostFindingsFindingIDScannerSuppressionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetNotificationConfigs request
+	GetNotificationConfigs(ctx context.Context, params *GetNotificationConfigsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostNotificationConfigs request with any body
+	PostNotificationConfigsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostNotificationConfigs(ctx context.Context, body PostNotificationConfigsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteNotificationConfigsNotificationConfigID request
+	DeleteNotificationConfigsNotificationConfigID(ctx context.Context, notificationConfigID NotificationConfigID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetNotificationConfigsNotificationConfigID request
+	GetNotificationConfigsNotificationConfigID(ctx context.Context, notificationConfigID NotificationConfigID, params *GetNotificationConfigsNotificationConfigIDParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchNotificationConfigsNotificationConfigID request with any body
+	PatchNotificationConfigsNotificationConfigIDWithBody(ctx context.Context, notificationConfigID NotificationConfigID, params *PatchNotificationConfigsNotificationConfigIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchNotificationConfigsNotificationConfigID(ctx context.Context, notificationConfigID NotificationConfigID, params *PatchNotificationConfigsNotificationConfigIDParams, body PatchNotificationConfigsNotificationConfigIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOperationsOperationID request
+	GetOperationsOperationID(ctx context.Context, operationID OperationID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOperationsOperationIDResult request
+	GetOperationsOperationIDResult(ctx context.Context, operationID OperationID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPostureScores request
+	GetPostureScores(ctx context.Context, params *GetPostureScoresParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProviderOperations request
+	GetProviderOperations(ctx context.Context, params *GetProviderOperationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostProviderOperations request with any body
+	PostProviderOperationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostProviderOperations(ctx context.Context, body PostProviderOperationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProviderOperationsProviderOperationID request
+	GetProviderOperationsProviderOperationID(ctx context.Context, providerOperationID ProviderOperationID, params *GetProviderOperationsProviderOperationIDParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProviders request
+	GetProviders(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetReportSchedules request
+	GetReportSchedules(ctx context.Context, params *GetReportSchedulesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostReportSchedules request with any body
+	PostReportSchedulesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostReportSchedules(ctx context.Context, body PostReportSchedulesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteReportSchedulesReportScheduleID request
+	DeleteReportSchedulesReportScheduleID(ctx context.Context, reportScheduleID ReportScheduleID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetReportSchedulesReportScheduleID request
+	GetReportSchedulesReportScheduleID(ctx context.Context, reportScheduleID ReportScheduleID, params *GetReportSchedulesReportScheduleIDParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchReportSchedulesReportScheduleID request with any body
+	PatchReportSchedulesReportScheduleIDWithBody(ctx context.Context, reportScheduleID ReportScheduleID, params *PatchReportSchedulesReportScheduleIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchReportSchedulesReportScheduleID(ctx context.Context, reportScheduleID ReportScheduleID, params *PatchReportSchedulesReportScheduleIDParams, body PatchReportSchedulesReportScheduleIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetReportSchedulesReportScheduleIDReport request
+	GetReportSchedulesReportScheduleIDReport(ctx context.Context, reportScheduleID ReportScheduleID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSboms request with any body
+	PostSbomsWithBody(ctx context.Context, params *PostSbomsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostSboms(ctx context.Context, params *PostSbomsParams, body PostSbomsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetScanConfigTemplates request
+	GetScanConfigTemplates(ctx context.Context, params *GetScanConfigTemplatesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostScanConfigTemplates request with any body
+	PostScanConfigTemplatesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostScanConfigTemplates(ctx context.Context, body PostScanConfigTemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteScanConfigTemplatesScanConfigTemplateID request
+	DeleteScanConfigTemplatesScanConfigTemplateID(ctx context.Context, scanConfigTemplateID ScanConfigTemplateID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetScanConfigTemplatesScanConfigTemplateID request
+	GetScanConfigTemplatesScanConfigTemplateID(ctx context.Context, scanConfigTemplateID ScanConfigTemplateID, params *GetScanConfigTemplatesScanConfigTemplateIDParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchScanConfigTemplatesScanConfigTemplateID request with any body
+	PatchScanConfigTemplatesScanConfigTemplateIDWithBody(ctx context.Context, scanConfigTemplateID ScanConfigTemplateID, params *PatchScanConfigTemplatesScanConfigTemplateIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchScanConfigTemplatesScanConfigTemplateID(ctx context.Context, scanConfigTemplateID ScanConfigTemplateID, params *PatchScanConfigTemplatesScanConfigTemplateIDParams, body PatchScanConfigTemplatesScanConfigTemplateIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetScanConfigs request
 	GetScanConfigs(ctx context.Context, params *GetScanConfigsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -146,6 +391,9 @@ type ClientInterface interface {
 
 	PutScanConfigsScanConfigID(ctx context.Context, scanConfigID ScanConfigID, params *PutScanConfigsScanConfigIDParams, body PutScanConfigsScanConfigIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetScanConfigsScanConfigIDNextRuns request
+	GetScanConfigsScanConfigIDNextRuns(ctx context.Context, scanConfigID ScanConfigID, params *GetScanConfigsScanConfigIDNextRunsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetScanResults request
 	GetScanResults(ctx context.Context, params *GetScanResultsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -167,6 +415,34 @@ type ClientInterface interface {
 
 	PutScanResultsScanResultID(ctx context.Context, scanResultID ScanResultID, params *PutScanResultsScanResultIDParams, body PutScanResultsScanResultIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetScanResultsScanResultIDFamiliesFamilyArtifact request
+	GetScanResultsScanResultIDFamiliesFamilyArtifact(ctx context.Context, scanResultID ScanResultID, family ScanResultFamily, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutScanResultsScanResultIDFamiliesFamilyArtifact request with any body
+	PutScanResultsScanResultIDFamiliesFamilyArtifactWithBody(ctx context.Context, scanResultID ScanResultID, family ScanResultFamily, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetScanResultsScanResultIDFamiliesFamilyItems request
+	GetScanResultsScanResultIDFamiliesFamilyItems(ctx context.Context, scanResultID ScanResultID, family ScanResultFamily, params *GetScanResultsScanResultIDFamiliesFamilyItemsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostScanResultsScanResultIDFamiliesFamilyItems request with any body
+	PostScanResultsScanResultIDFamiliesFamilyItemsWithBody(ctx context.Context, scanResultID ScanResultID, family ScanResultFamily, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostScanResultsScanResultIDFamiliesFamilyItems(ctx context.Context, scanResultID ScanResultID, family ScanResultFamily, body PostScanResultsScanResultIDFamiliesFamilyItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostScanResultsScanResultIDRerun request
+	PostScanResultsScanResultIDRerun(ctx context.Context, scanResultID ScanResultID, params *PostScanResultsScanResultIDRerunParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetScanResultsScanResultIDSbom request
+	GetScanResultsScanResultIDSbom(ctx context.Context, scanResultID ScanResultID, params *GetScanResultsScanResultIDSbomParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetScanResultsScanResultIDScannerConfig request
+	GetScanResultsScanResultIDScannerConfig(ctx context.Context, scanResultID ScanResultID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutScanResultsScanResultIDScannerConfig request with any body
+	PutScanResultsScanResultIDScannerConfigWithBody(ctx context.Context, scanResultID ScanResultID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutScanResultsScanResultIDScannerConfig(ctx context.Context, scanResultID ScanResultID, body PutScanResultsScanResultIDScannerConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetScans request
 	GetScans(ctx context.Context, params *GetScansParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -191,33 +467,41 @@ type ClientInterface interface {
 
 	PutScansScanID(ctx context.Context, scanID ScanID, params *PutScansScanIDParams, body PutScansScanIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// GetTargets request
-	GetTargets(ctx context.Context, params *GetTargetsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// GetScansScanIDProgress request
+	GetScansScanIDProgress(ctx context.Context, scanID ScanID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// PostTargets request with any body
-	PostTargetsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// GetSettingsFindingTemplates request
+	GetSettingsFindingTemplates(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PostTargets(ctx context.Context, body PostTargetsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// PutSettingsFindingTemplates request with any body
+	PutSettingsFindingTemplatesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// DeleteTargetsTargetID request
-	DeleteTargetsTargetID(ctx context.Context, targetID TargetID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PutSettingsFindingTemplates(ctx context.Context, body PutSettingsFindingTemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// GetTargetsTargetID request
-	GetTargetsTargetID(ctx context.Context, targetID TargetID, params *GetTargetsTargetIDParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// PostSettingsFindingTemplatesPreview request with any body
+	PostSettingsFindingTemplatesPreviewWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// PatchTargetsTargetID request with any body
-	PatchTargetsTargetIDWithBody(ctx context.Context, targetID TargetID, params *PatchTargetsTargetIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostSettingsFindingTemplatesPreview(ctx context.Context, body PostSettingsFindingTemplatesPreviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PatchTargetsTargetID(ctx context.Context, targetID TargetID, params *PatchTargetsTargetIDParams, body PatchTargetsTargetIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// GetSettingsRetention request
+	GetSettingsRetention(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// PutTargetsTargetID request with any body
-	PutTargetsTargetIDWithBody(ctx context.Context, targetID TargetID, params *PutTargetsTargetIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// PutSettingsRetention request with any body
+	PutSettingsRetentionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PutTargetsTargetID(ctx context.Context, targetID TargetID, params *PutTargetsTargetIDParams, body PutTargetsTargetIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PutSettingsRetention(ctx context.Context, body PutSettingsRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserPreferences request
+	GetUserPreferences(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutUserPreferences request with any body
+	PutUserPreferencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutUserPreferences(ctx context.Context, body PutUserPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) GetDiscoveryScopes(ctx context.Context, params *GetDiscoveryScopesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDiscoveryScopesRequest(c.Server, params)
+func (c *Client) GetAdminFeeds(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminFeedsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -228,8 +512,8 @@ func (c *Client) GetDiscoveryScopes(ctx context.Context, params *GetDiscoverySco
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutDiscoveryScopesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutDiscoveryScopesRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostAdminFeedsRefresh(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminFeedsRefreshRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -240,8 +524,8 @@ func (c *Client) PutDiscoveryScopesWithBody(ctx context.Context, contentType str
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutDiscoveryScopes(ctx context.Context, body PutDiscoveryScopesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutDiscoveryScopesRequest(c.Server, body)
+func (c *Client) GetAdminMaintenance(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminMaintenanceRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -252,8 +536,8 @@ func (c *Client) PutDiscoveryScopes(ctx context.Context, body PutDiscoveryScopes
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetFindings(ctx context.Context, params *GetFindingsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetFindingsRequest(c.Server, params)
+func (c *Client) PutAdminMaintenanceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminMaintenanceRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -264,8 +548,8 @@ func (c *Client) GetFindings(ctx context.Context, params *GetFindingsParams, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostFindingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostFindingsRequestWithBody(c.Server, contentType, body)
+func (c *Client) PutAdminMaintenance(ctx context.Context, body PutAdminMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminMaintenanceRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -276,8 +560,8 @@ func (c *Client) PostFindingsWithBody(ctx context.Context, contentType string, b
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostFindings(ctx context.Context, body PostFindingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostFindingsRequest(c.Server, body)
+func (c *Client) GetAdminTasks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminTasksRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -288,8 +572,8 @@ func (c *Client) PostFindings(ctx context.Context, body PostFindingsJSONRequestB
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteFindingsFindingID(ctx context.Context, findingID FindingID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteFindingsFindingIDRequest(c.Server, findingID)
+func (c *Client) PostAdminTasksTaskNameRun(ctx context.Context, taskName TaskName, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminTasksTaskNameRunRequest(c.Server, taskName)
 	if err != nil {
 		return nil, err
 	}
@@ -300,8 +584,8 @@ func (c *Client) DeleteFindingsFindingID(ctx context.Context, findingID FindingI
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetFindingsFindingID(ctx context.Context, findingID FindingID, params *GetFindingsFindingIDParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetFindingsFindingIDRequest(c.Server, findingID, params)
+func (c *Client) GetAdminUsage(ctx context.Context, params *GetAdminUsageParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminUsageRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -312,8 +596,8 @@ func (c *Client) GetFindingsFindingID(ctx context.Context, findingID FindingID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchFindingsFindingIDWithBody(ctx context.Context, findingID FindingID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchFindingsFindingIDRequestWithBody(c.Server, findingID, contentType, body)
+func (c *Client) GetAPIKeys(ctx context.Context, params *GetAPIKeysParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIKeysRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -324,8 +608,8 @@ func (c *Client) PatchFindingsFindingIDWithBody(ctx context.Context, findingID F
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchFindingsFindingID(ctx context.Context, findingID FindingID, body PatchFindingsFindingIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchFindingsFindingIDRequest(c.Server, findingID, body)
+func (c *Client) PostAPIKeysWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIKeysRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -336,8 +620,8 @@ func (c *Client) PatchFindingsFindingID(ctx context.Context, findingID FindingID
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutFindingsFindingIDWithBody(ctx context.Context, findingID FindingID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutFindingsFindingIDRequestWithBody(c.Server, findingID, contentType, body)
+func (c *Client) PostAPIKeys(ctx context.Context, body PostAPIKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAPIKeysRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -348,8 +632,8 @@ func (c *Client) PutFindingsFindingIDWithBody(ctx context.Context, findingID Fin
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutFindingsFindingID(ctx context.Context, findingID FindingID, body PutFindingsFindingIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutFindingsFindingIDRequest(c.Server, findingID, body)
+func (c *Client) DeleteAPIKeysAPIKeyID(ctx context.Context, apiKeyID ApiKeyID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAPIKeysAPIKeyIDRequest(c.Server, apiKeyID)
 	if err != nil {
 		return nil, err
 	}
@@ -360,8 +644,8 @@ func (c *Client) PutFindingsFindingID(ctx context.Context, findingID FindingID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetScanConfigs(ctx context.Context, params *GetScanConfigsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetScanConfigsRequest(c.Server, params)
+func (c *Client) GetAPIKeysAPIKeyID(ctx context.Context, apiKeyID ApiKeyID, params *GetAPIKeysAPIKeyIDParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAPIKeysAPIKeyIDRequest(c.Server, apiKeyID, params)
 	if err != nil {
 		return nil, err
 	}
@@ -372,8 +656,8 @@ func (c *Client) GetScanConfigs(ctx context.Context, params *GetScanConfigsParam
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostScanConfigsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostScanConfigsRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetAssetGroups(ctx context.Context, params *GetAssetGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAssetGroupsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -384,8 +668,8 @@ func (c *Client) PostScanConfigsWithBody(ctx context.Context, contentType string
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostScanConfigs(ctx context.Context, body PostScanConfigsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostScanConfigsRequest(c.Server, body)
+func (c *Client) PostAssetGroupsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAssetGroupsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -396,8 +680,8 @@ func (c *Client) PostScanConfigs(ctx context.Context, body PostScanConfigsJSONRe
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteScanConfigsScanConfigID(ctx context.Context, scanConfigID ScanConfigID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteScanConfigsScanConfigIDRequest(c.Server, scanConfigID)
+func (c *Client) PostAssetGroups(ctx context.Context, body PostAssetGroupsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAssetGroupsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -408,8 +692,8 @@ func (c *Client) DeleteScanConfigsScanConfigID(ctx context.Context, scanConfigID
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetScanConfigsScanConfigID(ctx context.Context, scanConfigID ScanConfigID, params *GetScanConfigsScanConfigIDParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetScanConfigsScanConfigIDRequest(c.Server, scanConfigID, params)
+func (c *Client) DeleteAssetGroupsAssetGroupID(ctx context.Context, assetGroupID AssetGroupID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAssetGroupsAssetGroupIDRequest(c.Server, assetGroupID)
 	if err != nil {
 		return nil, err
 	}
@@ -420,8 +704,8 @@ func (c *Client) GetScanConfigsScanConfigID(ctx context.Context, scanConfigID Sc
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchScanConfigsScanConfigIDWithBody(ctx context.Context, scanConfigID ScanConfigID, params *PatchScanConfigsScanConfigIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchScanConfigsScanConfigIDRequestWithBody(c.Server, scanConfigID, params, contentType, body)
+func (c *Client) GetAssetGroupsAssetGroupID(ctx context.Context, assetGroupID AssetGroupID, params *GetAssetGroupsAssetGroupIDParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAssetGroupsAssetGroupIDRequest(c.Server, assetGroupID, params)
 	if err != nil {
 		return nil, err
 	}
@@ -432,8 +716,8 @@ func (c *Client) PatchScanConfigsScanConfigIDWithBody(ctx context.Context, scanC
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchScanConfigsScanConfigID(ctx context.Context, scanConfigID ScanConfigID, params *PatchScanConfigsScanConfigIDParams, body PatchScanConfigsScanConfigIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchScanConfigsScanConfigIDRequest(c.Server, scanConfigID, params, body)
+func (c *Client) PatchAssetGroupsAssetGroupIDWithBody(ctx context.Context, assetGroupID AssetGroupID, params *PatchAssetGroupsAssetGroupIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchAssetGroupsAssetGroupIDRequestWithBody(c.Server, assetGroupID, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -444,8 +728,8 @@ func (c *Client) PatchScanConfigsScanConfigID(ctx context.Context, scanConfigID
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutScanConfigsScanConfigIDWithBody(ctx context.Context, scanConfigID ScanConfigID, params *PutScanConfigsScanConfigIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutScanConfigsScanConfigIDRequestWithBody(c.Server, scanConfigID, params, contentType, body)
+func (c *Client) PatchAssetGroupsAssetGroupID(ctx context.Context, assetGroupID AssetGroupID, params *PatchAssetGroupsAssetGroupIDParams, body PatchAssetGroupsAssetGroupIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchAssetGroupsAssetGroupIDRequest(c.Server, assetGroupID, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -456,8 +740,8 @@ func (c *Client) PutScanConfigsScanConfigIDWithBody(ctx context.Context, scanCon
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutScanConfigsScanConfigID(ctx context.Context, scanConfigID ScanConfigID, params *PutScanConfigsScanConfigIDParams, body PutScanConfigsScanConfigIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutScanConfigsScanConfigIDRequest(c.Server, scanConfigID, params, body)
+func (c *Client) GetAssetGroupsAssetGroupIDAssets(ctx context.Context, assetGroupID AssetGroupID, params *GetAssetGroupsAssetGroupIDAssetsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAssetGroupsAssetGroupIDAssetsRequest(c.Server, assetGroupID, params)
 	if err != nil {
 		return nil, err
 	}
@@ -468,8 +752,8 @@ func (c *Client) PutScanConfigsScanConfigID(ctx context.Context, scanConfigID Sc
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetScanResults(ctx context.Context, params *GetScanResultsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetScanResultsRequest(c.Server, params)
+func (c *Client) GetAssets(ctx context.Context, params *GetAssetsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAssetsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -480,8 +764,8 @@ func (c *Client) GetScanResults(ctx context.Context, params *GetScanResultsParam
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostScanResultsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostScanResultsRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostAssetsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAssetsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -492,8 +776,8 @@ func (c *Client) PostScanResultsWithBody(ctx context.Context, contentType string
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostScanResults(ctx context.Context, body PostScanResultsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostScanResultsRequest(c.Server, body)
+func (c *Client) PostAssets(ctx context.Context, body PostAssetsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAssetsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -504,8 +788,8 @@ func (c *Client) PostScanResults(ctx context.Context, body PostScanResultsJSONRe
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetScanResultsScanResultID(ctx context.Context, scanResultID ScanResultID, params *GetScanResultsScanResultIDParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetScanResultsScanResultIDRequest(c.Server, scanResultID, params)
+func (c *Client) DeleteAssetsAssetID(ctx context.Context, assetID AssetID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAssetsAssetIDRequest(c.Server, assetID)
 	if err != nil {
 		return nil, err
 	}
@@ -516,8 +800,8 @@ func (c *Client) GetScanResultsScanResultID(ctx context.Context, scanResultID Sc
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchScanResultsScanResultIDWithBody(ctx context.Context, scanResultID ScanResultID, params *PatchScanResultsScanResultIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchScanResultsScanResultIDRequestWithBody(c.Server, scanResultID, params, contentType, body)
+func (c *Client) GetAssetsAssetID(ctx context.Context, assetID AssetID, params *GetAssetsAssetIDParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAssetsAssetIDRequest(c.Server, assetID, params)
 	if err != nil {
 		return nil, err
 	}
@@ -528,8 +812,8 @@ func (c *Client) PatchScanResultsScanResultIDWithBody(ctx context.Context, scanR
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchScanResultsScanResultID(ctx context.Context, scanResultID ScanResultID, params *PatchScanResultsScanResultIDParams, body PatchScanResultsScanResultIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchScanResultsScanResultIDRequest(c.Server, scanResultID, params, body)
+func (c *Client) PatchAssetsAssetIDWithBody(ctx context.Context, assetID AssetID, params *PatchAssetsAssetIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchAssetsAssetIDRequestWithBody(c.Server, assetID, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -540,8 +824,8 @@ func (c *Client) PatchScanResultsScanResultID(ctx context.Context, scanResultID
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutScanResultsScanResultIDWithBody(ctx context.Context, scanResultID ScanResultID, params *PutScanResultsScanResultIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutScanResultsScanResultIDRequestWithBody(c.Server, scanResultID, params, contentType, body)
+func (c *Client) PatchAssetsAssetID(ctx context.Context, assetID AssetID, params *PatchAssetsAssetIDParams, body PatchAssetsAssetIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchAssetsAssetIDRequest(c.Server, assetID, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -552,8 +836,8 @@ func (c *Client) PutScanResultsScanResultIDWithBody(ctx context.Context, scanRes
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutScanResultsScanResultID(ctx context.Context, scanResultID ScanResultID, params *PutScanResultsScanResultIDParams, body PutScanResultsScanResultIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutScanResultsScanResultIDRequest(c.Server, scanResultID, params, body)
+func (c *Client) PutAssetsAssetIDWithBody(ctx context.Context, assetID AssetID, params *PutAssetsAssetIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAssetsAssetIDRequestWithBody(c.Server, assetID, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -564,8 +848,8 @@ func (c *Client) PutScanResultsScanResultID(ctx context.Context, scanResultID Sc
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetScans(ctx context.Context, params *GetScansParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetScansRequest(c.Server, params)
+func (c *Client) PutAssetsAssetID(ctx context.Context, assetID AssetID, params *PutAssetsAssetIDParams, body PutAssetsAssetIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAssetsAssetIDRequest(c.Server, assetID, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -576,8 +860,8 @@ func (c *Client) GetScans(ctx context.Context, params *GetScansParams, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostScansWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostScansRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetAssetsAssetIDPackages(ctx context.Context, assetID AssetID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAssetsAssetIDPackagesRequest(c.Server, assetID)
 	if err != nil {
 		return nil, err
 	}
@@ -588,8 +872,8 @@ func (c *Client) PostScansWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostScans(ctx context.Context, body PostScansJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostScansRequest(c.Server, body)
+func (c *Client) GetAssetsAssetIDSbomDiff(ctx context.Context, assetID AssetID, params *GetAssetsAssetIDSbomDiffParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAssetsAssetIDSbomDiffRequest(c.Server, assetID, params)
 	if err != nil {
 		return nil, err
 	}
@@ -600,8 +884,8 @@ func (c *Client) PostScans(ctx context.Context, body PostScansJSONRequestBody, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteScansScanID(ctx context.Context, scanID ScanID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteScansScanIDRequest(c.Server, scanID)
+func (c *Client) GetAssetsAssetIDScanResultDiff(ctx context.Context, assetID AssetID, params *GetAssetsAssetIDScanResultDiffParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAssetsAssetIDScanResultDiffRequest(c.Server, assetID, params)
 	if err != nil {
 		return nil, err
 	}
@@ -612,8 +896,8 @@ func (c *Client) DeleteScansScanID(ctx context.Context, scanID ScanID, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetScansScanID(ctx context.Context, scanID ScanID, params *GetScansScanIDParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetScansScanIDRequest(c.Server, scanID, params)
+func (c *Client) GetAssetsAssetIDUpgradePlan(ctx context.Context, assetID AssetID, params *GetAssetsAssetIDUpgradePlanParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAssetsAssetIDUpgradePlanRequest(c.Server, assetID, params)
 	if err != nil {
 		return nil, err
 	}
@@ -624,8 +908,8 @@ func (c *Client) GetScansScanID(ctx context.Context, scanID ScanID, params *GetS
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchScansScanIDWithBody(ctx context.Context, scanID ScanID, params *PatchScansScanIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchScansScanIDRequestWithBody(c.Server, scanID, params, contentType, body)
+func (c *Client) GetCorrelatedFindings(ctx context.Context, params *GetCorrelatedFindingsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCorrelatedFindingsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -636,8 +920,8 @@ func (c *Client) PatchScansScanIDWithBody(ctx context.Context, scanID ScanID, pa
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchScansScanID(ctx context.Context, scanID ScanID, params *PatchScansScanIDParams, body PatchScansScanIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchScansScanIDRequest(c.Server, scanID, params, body)
+func (c *Client) GetCorrelatedFindingsCorrelatedFindingID(ctx context.Context, correlatedFindingID CorrelatedFindingID, params *GetCorrelatedFindingsCorrelatedFindingIDParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCorrelatedFindingsCorrelatedFindingIDRequest(c.Server, correlatedFindingID, params)
 	if err != nil {
 		return nil, err
 	}
@@ -648,8 +932,8 @@ func (c *Client) PatchScansScanID(ctx context.Context, scanID ScanID, params *Pa
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutScansScanIDWithBody(ctx context.Context, scanID ScanID, params *PutScansScanIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutScansScanIDRequestWithBody(c.Server, scanID, params, contentType, body)
+func (c *Client) GetDiscoveryScopes(ctx context.Context, params *GetDiscoveryScopesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDiscoveryScopesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -660,8 +944,8 @@ func (c *Client) PutScansScanIDWithBody(ctx context.Context, scanID ScanID, para
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutScansScanID(ctx context.Context, scanID ScanID, params *PutScansScanIDParams, body PutScansScanIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutScansScanIDRequest(c.Server, scanID, params, body)
+func (c *Client) PutDiscoveryScopesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutDiscoveryScopesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -672,8 +956,8 @@ func (c *Client) PutScansScanID(ctx context.Context, scanID ScanID, params *PutS
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetTargets(ctx context.Context, params *GetTargetsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTargetsRequest(c.Server, params)
+func (c *Client) PutDiscoveryScopes(ctx context.Context, body PutDiscoveryScopesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutDiscoveryScopesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -684,8 +968,8 @@ func (c *Client) GetTargets(ctx context.Context, params *GetTargetsParams, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostTargetsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostTargetsRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetFeedsFeedName(ctx context.Context, feedName FeedName, params *GetFeedsFeedNameParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFeedsFeedNameRequest(c.Server, feedName, params)
 	if err != nil {
 		return nil, err
 	}
@@ -696,8 +980,8 @@ func (c *Client) PostTargetsWithBody(ctx context.Context, contentType string, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostTargets(ctx context.Context, body PostTargetsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostTargetsRequest(c.Server, body)
+func (c *Client) GetFindingDigests(ctx context.Context, params *GetFindingDigestsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFindingDigestsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -708,8 +992,8 @@ func (c *Client) PostTargets(ctx context.Context, body PostTargetsJSONRequestBod
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteTargetsTargetID(ctx context.Context, targetID TargetID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteTargetsTargetIDRequest(c.Server, targetID)
+func (c *Client) PostFindingDigestsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostFindingDigestsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -720,8 +1004,8 @@ func (c *Client) DeleteTargetsTargetID(ctx context.Context, targetID TargetID, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetTargetsTargetID(ctx context.Context, targetID TargetID, params *GetTargetsTargetIDParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTargetsTargetIDRequest(c.Server, targetID, params)
+func (c *Client) PostFindingDigests(ctx context.Context, body PostFindingDigestsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostFindingDigestsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -732,8 +1016,8 @@ func (c *Client) GetTargetsTargetID(ctx context.Context, targetID TargetID, para
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchTargetsTargetIDWithBody(ctx context.Context, targetID TargetID, params *PatchTargetsTargetIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchTargetsTargetIDRequestWithBody(c.Server, targetID, params, contentType, body)
+func (c *Client) DeleteFindingDigestsFindingDigestID(ctx context.Context, findingDigestID FindingDigestID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteFindingDigestsFindingDigestIDRequest(c.Server, findingDigestID)
 	if err != nil {
 		return nil, err
 	}
@@ -744,8 +1028,8 @@ func (c *Client) PatchTargetsTargetIDWithBody(ctx context.Context, targetID Targ
 	return c.Client.Do(req)
 }
 
-func (c *Client) PatchTargetsTargetID(ctx context.Context, targetID TargetID, params *PatchTargetsTargetIDParams, body PatchTargetsTargetIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPatchTargetsTargetIDRequest(c.Server, targetID, params, body)
+func (c *Client) GetFindingDigestsFindingDigestID(ctx context.Context, findingDigestID FindingDigestID, params *GetFindingDigestsFindingDigestIDParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFindingDigestsFindingDigestIDRequest(c.Server, findingDigestID, params)
 	if err != nil {
 		return nil, err
 	}
@@ -756,8 +1040,8 @@ func (c *Client) PatchTargetsTargetID(ctx context.Context, targetID TargetID, pa
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutTargetsTargetIDWithBody(ctx context.Context, targetID TargetID, params *PutTargetsTargetIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutTargetsTargetIDRequestWithBody(c.Server, targetID, params, contentType, body)
+func (c *Client) PatchFindingDigestsFindingDigestIDWithBody(ctx context.Context, findingDigestID FindingDigestID, params *PatchFindingDigestsFindingDigestIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchFindingDigestsFindingDigestIDRequestWithBody(c.Server, findingDigestID, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -768,8 +1052,8 @@ func (c *Client) PutTargetsTargetIDWithBody(ctx context.Context, targetID Target
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutTargetsTargetID(ctx context.Context, targetID TargetID, params *PutTargetsTargetIDParams, body PutTargetsTargetIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutTargetsTargetIDRequest(c.Server, targetID, params, body)
+func (c *Client) PatchFindingDigestsFindingDigestID(ctx context.Context, findingDigestID FindingDigestID, params *PatchFindingDigestsFindingDigestIDParams, body PatchFindingDigestsFindingDigestIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchFindingDigestsFindingDigestIDRequest(c.Server, findingDigestID, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -780,1280 +1064,1244 @@ func (c *Client) PutTargetsTargetID(ctx context.Context, targetID TargetID, para
 	return c.Client.Do(req)
 }
 
-// NewGetDiscoveryScopesRequest generates requests for GetDiscoveryScopes
-func NewGetDiscoveryScopesRequest(server string, params *GetDiscoveryScopesParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetFindingExceptions(ctx context.Context, params *GetFindingExceptionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFindingExceptionsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/discovery/scopes")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostFindingExceptionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostFindingExceptionsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	queryValues := queryURL.Query()
-
-	if params.Filter != nil {
+func (c *Client) PostFindingExceptions(ctx context.Context, body PostFindingExceptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostFindingExceptionsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+func (c *Client) DeleteFindingExceptionsFindingExceptionID(ctx context.Context, findingExceptionID FindingExceptionID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteFindingExceptionsFindingExceptionIDRequest(c.Server, findingExceptionID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
+func (c *Client) GetFindingExceptionsFindingExceptionID(ctx context.Context, findingExceptionID FindingExceptionID, params *GetFindingExceptionsFindingExceptionIDParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFindingExceptionsFindingExceptionIDRequest(c.Server, findingExceptionID, params)
+	if err != nil {
+		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params.Select != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+func (c *Client) PatchFindingExceptionsFindingExceptionIDWithBody(ctx context.Context, findingExceptionID FindingExceptionID, params *PatchFindingExceptionsFindingExceptionIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchFindingExceptionsFindingExceptionIDRequestWithBody(c.Server, findingExceptionID, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
+func (c *Client) PatchFindingExceptionsFindingExceptionID(ctx context.Context, findingExceptionID FindingExceptionID, params *PatchFindingExceptionsFindingExceptionIDParams, body PatchFindingExceptionsFindingExceptionIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchFindingExceptionsFindingExceptionIDRequest(c.Server, findingExceptionID, params, body)
+	if err != nil {
+		return nil, err
 	}
-
-	if params.OrderBy != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$orderby", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL.RawQuery = queryValues.Encode()
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetFindings(ctx context.Context, params *GetFindingsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFindingsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutDiscoveryScopesRequest calls the generic PutDiscoveryScopes builder with application/json body
-func NewPutDiscoveryScopesRequest(server string, body PutDiscoveryScopesJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostFindingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostFindingsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutDiscoveryScopesRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutDiscoveryScopesRequestWithBody generates requests for PutDiscoveryScopes with any type of body
-func NewPutDiscoveryScopesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostFindings(ctx context.Context, body PostFindingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostFindingsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/discovery/scopes")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PatchFindingsBulkWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchFindingsBulkRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetFindingsRequest generates requests for GetFindings
-func NewGetFindingsRequest(server string, params *GetFindingsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PatchFindingsBulk(ctx context.Context, body PatchFindingsBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchFindingsBulkRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/findings")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostFindingsBulkWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostFindingsBulkRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	queryValues := queryURL.Query()
-
-	if params.Filter != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	if params.Select != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+func (c *Client) PostFindingsBulk(ctx context.Context, body PostFindingsBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostFindingsBulkRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-
-	if params.Count != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$count", runtime.ParamLocationQuery, *params.Count); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	if params.Top != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$top", runtime.ParamLocationQuery, *params.Top); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+func (c *Client) DeleteFindingsFindingID(ctx context.Context, findingID FindingID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteFindingsFindingIDRequest(c.Server, findingID)
+	if err != nil {
+		return nil, err
 	}
-
-	if params.Skip != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	if params.Expand != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+func (c *Client) GetFindingsFindingID(ctx context.Context, findingID FindingID, params *GetFindingsFindingIDParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFindingsFindingIDRequest(c.Server, findingID, params)
+	if err != nil {
+		return nil, err
 	}
-
-	if params.OrderBy != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$orderby", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL.RawQuery = queryValues.Encode()
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) PatchFindingsFindingIDWithBody(ctx context.Context, findingID FindingID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchFindingsFindingIDRequestWithBody(c.Server, findingID, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostFindingsRequest calls the generic PostFindings builder with application/json body
-func NewPostFindingsRequest(server string, body PostFindingsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PatchFindingsFindingID(ctx context.Context, findingID FindingID, body PatchFindingsFindingIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchFindingsFindingIDRequest(c.Server, findingID, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostFindingsRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewPostFindingsRequestWithBody generates requests for PostFindings with any type of body
-func NewPostFindingsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PutFindingsFindingIDWithBody(ctx context.Context, findingID FindingID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutFindingsFindingIDRequestWithBody(c.Server, findingID, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/findings")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PutFindingsFindingID(ctx context.Context, findingID FindingID, body PutFindingsFindingIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutFindingsFindingIDRequest(c.Server, findingID, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteFindingsFindingIDRequest generates requests for DeleteFindingsFindingID
-func NewDeleteFindingsFindingIDRequest(server string, findingID FindingID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "findingID", runtime.ParamLocationPath, findingID)
+func (c *Client) PostFindingsFindingIDScannerSuppressionWithBody(ctx context.Context, findingID FindingID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostFindingsFindingIDScannerSuppressionRequestWithBody(c.Server, findingID, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostFindingsFindingIDScannerSuppression(ctx context.Context, findingID FindingID, body PostFindingsFindingIDScannerSuppressionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostFindingsFindingIDScannerSuppressionRequest(c.Server, findingID, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/findings/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetNotificationConfigs(ctx context.Context, params *GetNotificationConfigsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNotificationConfigsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetFindingsFindingIDRequest generates requests for GetFindingsFindingID
-func NewGetFindingsFindingIDRequest(server string, findingID FindingID, params *GetFindingsFindingIDParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "findingID", runtime.ParamLocationPath, findingID)
+func (c *Client) PostNotificationConfigsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostNotificationConfigsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostNotificationConfigs(ctx context.Context, body PostNotificationConfigsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostNotificationConfigsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/findings/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteNotificationConfigsNotificationConfigID(ctx context.Context, notificationConfigID NotificationConfigID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteNotificationConfigsNotificationConfigIDRequest(c.Server, notificationConfigID)
 	if err != nil {
 		return nil, err
 	}
-
-	queryValues := queryURL.Query()
-
-	if params.Select != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	if params.Expand != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL.RawQuery = queryValues.Encode()
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetNotificationConfigsNotificationConfigID(ctx context.Context, notificationConfigID NotificationConfigID, params *GetNotificationConfigsNotificationConfigIDParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNotificationConfigsNotificationConfigIDRequest(c.Server, notificationConfigID, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPatchFindingsFindingIDRequest calls the generic PatchFindingsFindingID builder with application/json body
-func NewPatchFindingsFindingIDRequest(server string, findingID FindingID, body PatchFindingsFindingIDJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PatchNotificationConfigsNotificationConfigIDWithBody(ctx context.Context, notificationConfigID NotificationConfigID, params *PatchNotificationConfigsNotificationConfigIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchNotificationConfigsNotificationConfigIDRequestWithBody(c.Server, notificationConfigID, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPatchFindingsFindingIDRequestWithBody(server, findingID, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPatchFindingsFindingIDRequestWithBody generates requests for PatchFindingsFindingID with any type of body
-func NewPatchFindingsFindingIDRequestWithBody(server string, findingID FindingID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "findingID", runtime.ParamLocationPath, findingID)
+func (c *Client) PatchNotificationConfigsNotificationConfigID(ctx context.Context, notificationConfigID NotificationConfigID, params *PatchNotificationConfigsNotificationConfigIDParams, body PatchNotificationConfigsNotificationConfigIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchNotificationConfigsNotificationConfigIDRequest(c.Server, notificationConfigID, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetOperationsOperationID(ctx context.Context, operationID OperationID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOperationsOperationIDRequest(c.Server, operationID)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/findings/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetOperationsOperationIDResult(ctx context.Context, operationID OperationID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOperationsOperationIDResultRequest(c.Server, operationID)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+func (c *Client) GetPostureScores(ctx context.Context, params *GetPostureScoresParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPostureScoresRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutFindingsFindingIDRequest calls the generic PutFindingsFindingID builder with application/json body
-func NewPutFindingsFindingIDRequest(server string, findingID FindingID, body PutFindingsFindingIDJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetProviderOperations(ctx context.Context, params *GetProviderOperationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProviderOperationsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutFindingsFindingIDRequestWithBody(server, findingID, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutFindingsFindingIDRequestWithBody generates requests for PutFindingsFindingID with any type of body
-func NewPutFindingsFindingIDRequestWithBody(server string, findingID FindingID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "findingID", runtime.ParamLocationPath, findingID)
+func (c *Client) PostProviderOperationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostProviderOperationsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostProviderOperations(ctx context.Context, body PostProviderOperationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostProviderOperationsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/findings/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetProviderOperationsProviderOperationID(ctx context.Context, providerOperationID ProviderOperationID, params *GetProviderOperationsProviderOperationIDParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProviderOperationsProviderOperationIDRequest(c.Server, providerOperationID, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) GetProviders(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProvidersRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetScanConfigsRequest generates requests for GetScanConfigs
-func NewGetScanConfigsRequest(server string, params *GetScanConfigsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetReportSchedules(ctx context.Context, params *GetReportSchedulesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReportSchedulesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/scanConfigs")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostReportSchedulesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostReportSchedulesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	queryValues := queryURL.Query()
-
-	if params.Filter != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	if params.Select != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+func (c *Client) PostReportSchedules(ctx context.Context, body PostReportSchedulesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostReportSchedulesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-
-	if params.Count != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$count", runtime.ParamLocationQuery, *params.Count); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	if params.Top != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$top", runtime.ParamLocationQuery, *params.Top); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+func (c *Client) DeleteReportSchedulesReportScheduleID(ctx context.Context, reportScheduleID ReportScheduleID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteReportSchedulesReportScheduleIDRequest(c.Server, reportScheduleID)
+	if err != nil {
+		return nil, err
 	}
-
-	if params.Skip != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	if params.Expand != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+func (c *Client) GetReportSchedulesReportScheduleID(ctx context.Context, reportScheduleID ReportScheduleID, params *GetReportSchedulesReportScheduleIDParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReportSchedulesReportScheduleIDRequest(c.Server, reportScheduleID, params)
+	if err != nil {
+		return nil, err
 	}
-
-	if params.OrderBy != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$orderby", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL.RawQuery = queryValues.Encode()
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) PatchReportSchedulesReportScheduleIDWithBody(ctx context.Context, reportScheduleID ReportScheduleID, params *PatchReportSchedulesReportScheduleIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchReportSchedulesReportScheduleIDRequestWithBody(c.Server, reportScheduleID, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostScanConfigsRequest calls the generic PostScanConfigs builder with application/json body
-func NewPostScanConfigsRequest(server string, body PostScanConfigsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PatchReportSchedulesReportScheduleID(ctx context.Context, reportScheduleID ReportScheduleID, params *PatchReportSchedulesReportScheduleIDParams, body PatchReportSchedulesReportScheduleIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchReportSchedulesReportScheduleIDRequest(c.Server, reportScheduleID, params, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostScanConfigsRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostScanConfigsRequestWithBody generates requests for PostScanConfigs with any type of body
-func NewPostScanConfigsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetReportSchedulesReportScheduleIDReport(ctx context.Context, reportScheduleID ReportScheduleID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReportSchedulesReportScheduleIDReportRequest(c.Server, reportScheduleID)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/scanConfigs")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostSbomsWithBody(ctx context.Context, params *PostSbomsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSbomsRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) PostSboms(ctx context.Context, params *PostSbomsParams, body PostSbomsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSbomsRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeleteScanConfigsScanConfigIDRequest generates requests for DeleteScanConfigsScanConfigID
-func NewDeleteScanConfigsScanConfigIDRequest(server string, scanConfigID ScanConfigID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanConfigID", runtime.ParamLocationPath, scanConfigID)
+func (c *Client) GetScanConfigTemplates(ctx context.Context, params *GetScanConfigTemplatesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScanConfigTemplatesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostScanConfigTemplatesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostScanConfigTemplatesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/scanConfigs/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostScanConfigTemplates(ctx context.Context, body PostScanConfigTemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostScanConfigTemplatesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) DeleteScanConfigTemplatesScanConfigTemplateID(ctx context.Context, scanConfigTemplateID ScanConfigTemplateID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteScanConfigTemplatesScanConfigTemplateIDRequest(c.Server, scanConfigTemplateID)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetScanConfigsScanConfigIDRequest generates requests for GetScanConfigsScanConfigID
-func NewGetScanConfigsScanConfigIDRequest(server string, scanConfigID ScanConfigID, params *GetScanConfigsScanConfigIDParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanConfigID", runtime.ParamLocationPath, scanConfigID)
+func (c *Client) GetScanConfigTemplatesScanConfigTemplateID(ctx context.Context, scanConfigTemplateID ScanConfigTemplateID, params *GetScanConfigTemplatesScanConfigTemplateIDParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScanConfigTemplatesScanConfigTemplateIDRequest(c.Server, scanConfigTemplateID, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PatchScanConfigTemplatesScanConfigTemplateIDWithBody(ctx context.Context, scanConfigTemplateID ScanConfigTemplateID, params *PatchScanConfigTemplatesScanConfigTemplateIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchScanConfigTemplatesScanConfigTemplateIDRequestWithBody(c.Server, scanConfigTemplateID, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/scanConfigs/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PatchScanConfigTemplatesScanConfigTemplateID(ctx context.Context, scanConfigTemplateID ScanConfigTemplateID, params *PatchScanConfigTemplatesScanConfigTemplateIDParams, body PatchScanConfigTemplatesScanConfigTemplateIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchScanConfigTemplatesScanConfigTemplateIDRequest(c.Server, scanConfigTemplateID, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	queryValues := queryURL.Query()
-
-	if params.Select != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+func (c *Client) GetScanConfigs(ctx context.Context, params *GetScanConfigsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScanConfigsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
 	}
-
-	if params.Expand != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL.RawQuery = queryValues.Encode()
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) PostScanConfigsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostScanConfigsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPatchScanConfigsScanConfigIDRequest calls the generic PatchScanConfigsScanConfigID builder with application/json body
-func NewPatchScanConfigsScanConfigIDRequest(server string, scanConfigID ScanConfigID, params *PatchScanConfigsScanConfigIDParams, body PatchScanConfigsScanConfigIDJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostScanConfigs(ctx context.Context, body PostScanConfigsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostScanConfigsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPatchScanConfigsScanConfigIDRequestWithBody(server, scanConfigID, params, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPatchScanConfigsScanConfigIDRequestWithBody generates requests for PatchScanConfigsScanConfigID with any type of body
-func NewPatchScanConfigsScanConfigIDRequestWithBody(server string, scanConfigID ScanConfigID, params *PatchScanConfigsScanConfigIDParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanConfigID", runtime.ParamLocationPath, scanConfigID)
+func (c *Client) DeleteScanConfigsScanConfigID(ctx context.Context, scanConfigID ScanConfigID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteScanConfigsScanConfigIDRequest(c.Server, scanConfigID)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetScanConfigsScanConfigID(ctx context.Context, scanConfigID ScanConfigID, params *GetScanConfigsScanConfigIDParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScanConfigsScanConfigIDRequest(c.Server, scanConfigID, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/scanConfigs/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PatchScanConfigsScanConfigIDWithBody(ctx context.Context, scanConfigID ScanConfigID, params *PatchScanConfigsScanConfigIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchScanConfigsScanConfigIDRequestWithBody(c.Server, scanConfigID, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+func (c *Client) PatchScanConfigsScanConfigID(ctx context.Context, scanConfigID ScanConfigID, params *PatchScanConfigsScanConfigIDParams, body PatchScanConfigsScanConfigIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchScanConfigsScanConfigIDRequest(c.Server, scanConfigID, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	if params.IfMatch != nil {
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("If-Match", headerParam0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPutScanConfigsScanConfigIDRequest calls the generic PutScanConfigsScanConfigID builder with application/json body
-func NewPutScanConfigsScanConfigIDRequest(server string, scanConfigID ScanConfigID, params *PutScanConfigsScanConfigIDParams, body PutScanConfigsScanConfigIDJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PutScanConfigsScanConfigIDWithBody(ctx context.Context, scanConfigID ScanConfigID, params *PutScanConfigsScanConfigIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutScanConfigsScanConfigIDRequestWithBody(c.Server, scanConfigID, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutScanConfigsScanConfigIDRequestWithBody(server, scanConfigID, params, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutScanConfigsScanConfigIDRequestWithBody generates requests for PutScanConfigsScanConfigID with any type of body
-func NewPutScanConfigsScanConfigIDRequestWithBody(server string, scanConfigID ScanConfigID, params *PutScanConfigsScanConfigIDParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanConfigID", runtime.ParamLocationPath, scanConfigID)
+func (c *Client) PutScanConfigsScanConfigID(ctx context.Context, scanConfigID ScanConfigID, params *PutScanConfigsScanConfigIDParams, body PutScanConfigsScanConfigIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutScanConfigsScanConfigIDRequest(c.Server, scanConfigID, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetScanConfigsScanConfigIDNextRuns(ctx context.Context, scanConfigID ScanConfigID, params *GetScanConfigsScanConfigIDNextRunsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScanConfigsScanConfigIDNextRunsRequest(c.Server, scanConfigID, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/scanConfigs/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetScanResults(ctx context.Context, params *GetScanResultsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScanResultsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) PostScanResultsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostScanResultsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	if params.IfMatch != nil {
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("If-Match", headerParam0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetScanResultsRequest generates requests for GetScanResults
-func NewGetScanResultsRequest(server string, params *GetScanResultsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostScanResults(ctx context.Context, body PostScanResultsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostScanResultsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/scanResults")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetScanResultsScanResultID(ctx context.Context, scanResultID ScanResultID, params *GetScanResultsScanResultIDParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScanResultsScanResultIDRequest(c.Server, scanResultID, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	queryValues := queryURL.Query()
-
-	if params.Filter != nil {
+func (c *Client) PatchScanResultsScanResultIDWithBody(ctx context.Context, scanResultID ScanResultID, params *PatchScanResultsScanResultIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchScanResultsScanResultIDRequestWithBody(c.Server, scanResultID, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+func (c *Client) PatchScanResultsScanResultID(ctx context.Context, scanResultID ScanResultID, params *PatchScanResultsScanResultIDParams, body PatchScanResultsScanResultIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchScanResultsScanResultIDRequest(c.Server, scanResultID, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
+func (c *Client) PutScanResultsScanResultIDWithBody(ctx context.Context, scanResultID ScanResultID, params *PutScanResultsScanResultIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutScanResultsScanResultIDRequestWithBody(c.Server, scanResultID, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	if params.Select != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+func (c *Client) PutScanResultsScanResultID(ctx context.Context, scanResultID ScanResultID, params *PutScanResultsScanResultIDParams, body PutScanResultsScanResultIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutScanResultsScanResultIDRequest(c.Server, scanResultID, params, body)
+	if err != nil {
+		return nil, err
 	}
-
-	if params.Count != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$count", runtime.ParamLocationQuery, *params.Count); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	if params.Top != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$top", runtime.ParamLocationQuery, *params.Top); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+func (c *Client) GetScanResultsScanResultIDFamiliesFamilyArtifact(ctx context.Context, scanResultID ScanResultID, family ScanResultFamily, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScanResultsScanResultIDFamiliesFamilyArtifactRequest(c.Server, scanResultID, family)
+	if err != nil {
+		return nil, err
 	}
-
-	if params.Skip != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	if params.Expand != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+func (c *Client) PutScanResultsScanResultIDFamiliesFamilyArtifactWithBody(ctx context.Context, scanResultID ScanResultID, family ScanResultFamily, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutScanResultsScanResultIDFamiliesFamilyArtifactRequestWithBody(c.Server, scanResultID, family, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-
-	if params.OrderBy != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$orderby", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL.RawQuery = queryValues.Encode()
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetScanResultsScanResultIDFamiliesFamilyItems(ctx context.Context, scanResultID ScanResultID, family ScanResultFamily, params *GetScanResultsScanResultIDFamiliesFamilyItemsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScanResultsScanResultIDFamiliesFamilyItemsRequest(c.Server, scanResultID, family, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostScanResultsRequest calls the generic PostScanResults builder with application/json body
-func NewPostScanResultsRequest(server string, body PostScanResultsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostScanResultsScanResultIDFamiliesFamilyItemsWithBody(ctx context.Context, scanResultID ScanResultID, family ScanResultFamily, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostScanResultsScanResultIDFamiliesFamilyItemsRequestWithBody(c.Server, scanResultID, family, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostScanResultsRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostScanResultsRequestWithBody generates requests for PostScanResults with any type of body
-func NewPostScanResultsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostScanResultsScanResultIDFamiliesFamilyItems(ctx context.Context, scanResultID ScanResultID, family ScanResultFamily, body PostScanResultsScanResultIDFamiliesFamilyItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostScanResultsScanResultIDFamiliesFamilyItemsRequest(c.Server, scanResultID, family, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/scanResults")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostScanResultsScanResultIDRerun(ctx context.Context, scanResultID ScanResultID, params *PostScanResultsScanResultIDRerunParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostScanResultsScanResultIDRerunRequest(c.Server, scanResultID, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetScanResultsScanResultIDRequest generates requests for GetScanResultsScanResultID
-func NewGetScanResultsScanResultIDRequest(server string, scanResultID ScanResultID, params *GetScanResultsScanResultIDParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, scanResultID)
+func (c *Client) GetScanResultsScanResultIDSbom(ctx context.Context, scanResultID ScanResultID, params *GetScanResultsScanResultIDSbomParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScanResultsScanResultIDSbomRequest(c.Server, scanResultID, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetScanResultsScanResultIDScannerConfig(ctx context.Context, scanResultID ScanResultID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScanResultsScanResultIDScannerConfigRequest(c.Server, scanResultID)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/scanResults/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PutScanResultsScanResultIDScannerConfigWithBody(ctx context.Context, scanResultID ScanResultID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutScanResultsScanResultIDScannerConfigRequestWithBody(c.Server, scanResultID, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	queryValues := queryURL.Query()
-
-	if params.Select != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	if params.Expand != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL.RawQuery = queryValues.Encode()
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) PutScanResultsScanResultIDScannerConfig(ctx context.Context, scanResultID ScanResultID, body PutScanResultsScanResultIDScannerConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutScanResultsScanResultIDScannerConfigRequest(c.Server, scanResultID, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPatchScanResultsScanResultIDRequest calls the generic PatchScanResultsScanResultID builder with application/json body
-func NewPatchScanResultsScanResultIDRequest(server string, scanResultID ScanResultID, params *PatchScanResultsScanResultIDParams, body PatchScanResultsScanResultIDJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetScans(ctx context.Context, params *GetScansParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScansRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPatchScanResultsScanResultIDRequestWithBody(server, scanResultID, params, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPatchScanResultsScanResultIDRequestWithBody generates requests for PatchScanResultsScanResultID with any type of body
-func NewPatchScanResultsScanResultIDRequestWithBody(server string, scanResultID ScanResultID, params *PatchScanResultsScanResultIDParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, scanResultID)
+func (c *Client) PostScansWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostScansRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/scanResults/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) PostScans(ctx context.Context, body PostScansJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostScansRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteScansScanID(ctx context.Context, scanID ScanID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteScansScanIDRequest(c.Server, scanID)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+func (c *Client) GetScansScanID(ctx context.Context, scanID ScanID, params *GetScansScanIDParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScansScanIDRequest(c.Server, scanID, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
-
-	if params.IfMatch != nil {
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
-		if err != nil {
-			return nil, err
-		}
+func (c *Client) PatchScansScanIDWithBody(ctx context.Context, scanID ScanID, params *PatchScansScanIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchScansScanIDRequestWithBody(c.Server, scanID, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		req.Header.Set("If-Match", headerParam0)
+func (c *Client) PatchScansScanID(ctx context.Context, scanID ScanID, params *PatchScansScanIDParams, body PatchScansScanIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchScansScanIDRequest(c.Server, scanID, params, body)
+	if err != nil {
+		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) PutScansScanIDWithBody(ctx context.Context, scanID ScanID, params *PutScansScanIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutScansScanIDRequestWithBody(c.Server, scanID, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutScanResultsScanResultIDRequest calls the generic PutScanResultsScanResultID builder with application/json body
-func NewPutScanResultsScanResultIDRequest(server string, scanResultID ScanResultID, params *PutScanResultsScanResultIDParams, body PutScanResultsScanResultIDJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PutScansScanID(ctx context.Context, scanID ScanID, params *PutScansScanIDParams, body PutScansScanIDJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutScansScanIDRequest(c.Server, scanID, params, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutScanResultsScanResultIDRequestWithBody(server, scanResultID, params, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutScanResultsScanResultIDRequestWithBody generates requests for PutScanResultsScanResultID with any type of body
-func NewPutScanResultsScanResultIDRequestWithBody(server string, scanResultID ScanResultID, params *PutScanResultsScanResultIDParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+func (c *Client) GetScansScanIDProgress(ctx context.Context, scanID ScanID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScansScanIDProgressRequest(c.Server, scanID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) GetSettingsFindingTemplates(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSettingsFindingTemplatesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, scanResultID)
+func (c *Client) PutSettingsFindingTemplatesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutSettingsFindingTemplatesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PutSettingsFindingTemplates(ctx context.Context, body PutSettingsFindingTemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutSettingsFindingTemplatesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/scanResults/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) PostSettingsFindingTemplatesPreviewWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSettingsFindingTemplatesPreviewRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostSettingsFindingTemplatesPreview(ctx context.Context, body PostSettingsFindingTemplatesPreviewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSettingsFindingTemplatesPreviewRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) GetSettingsRetention(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSettingsRetentionRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
+func (c *Client) PutSettingsRetentionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutSettingsRetentionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params.IfMatch != nil {
-		var headerParam0 string
+func (c *Client) PutSettingsRetention(ctx context.Context, body PutSettingsRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutSettingsRetentionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
-		if err != nil {
-			return nil, err
-		}
+func (c *Client) GetUserPreferences(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserPreferencesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		req.Header.Set("If-Match", headerParam0)
+func (c *Client) PutUserPreferencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutUserPreferencesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) PutUserPreferences(ctx context.Context, body PutUserPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutUserPreferencesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetScansRequest generates requests for GetScans
-func NewGetScansRequest(server string, params *GetScansParams) (*http.Request, error) {
+// NewGetAdminFeedsRequest generates requests for GetAdminFeeds
+func NewGetAdminFeedsRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2061,7 +2309,7 @@ func NewGetScansRequest(server string, params *GetScansParams) (*http.Request, e
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/scans")
+	operationPath := fmt.Sprintf("/admin/feeds")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2071,122 +2319,60 @@ func NewGetScansRequest(server string, params *GetScansParams) (*http.Request, e
 		return nil, err
 	}
 
-	queryValues := queryURL.Query()
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	if params.Filter != nil {
+	return req, nil
+}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+// NewPostAdminFeedsRefreshRequest generates requests for PostAdminFeedsRefresh
+func NewPostAdminFeedsRefreshRequest(server string) (*http.Request, error) {
+	var err error
 
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	if params.Select != nil {
+	operationPath := fmt.Sprintf("/admin/feeds/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	if params.Count != nil {
+	return req, nil
+}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$count", runtime.ParamLocationQuery, *params.Count); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	if params.Top != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$top", runtime.ParamLocationQuery, *params.Top); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	if params.Skip != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+// NewGetAdminMaintenanceRequest generates requests for GetAdminMaintenance
+func NewGetAdminMaintenanceRequest(server string) (*http.Request, error) {
+	var err error
 
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	if params.Expand != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	operationPath := fmt.Sprintf("/admin/maintenance")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	if params.OrderBy != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$orderby", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
-	queryURL.RawQuery = queryValues.Encode()
-
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -2195,19 +2381,19 @@ func NewGetScansRequest(server string, params *GetScansParams) (*http.Request, e
 	return req, nil
 }
 
-// NewPostScansRequest calls the generic PostScans builder with application/json body
-func NewPostScansRequest(server string, body PostScansJSONRequestBody) (*http.Request, error) {
+// NewPutAdminMaintenanceRequest calls the generic PutAdminMaintenance builder with application/json body
+func NewPutAdminMaintenanceRequest(server string, body PutAdminMaintenanceJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostScansRequestWithBody(server, "application/json", bodyReader)
+	return NewPutAdminMaintenanceRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewPostScansRequestWithBody generates requests for PostScans with any type of body
-func NewPostScansRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPutAdminMaintenanceRequestWithBody generates requests for PutAdminMaintenance with any type of body
+func NewPutAdminMaintenanceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2215,7 +2401,7 @@ func NewPostScansRequestWithBody(server string, contentType string, body io.Read
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/scans")
+	operationPath := fmt.Sprintf("/admin/maintenance")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2225,7 +2411,7 @@ func NewPostScansRequestWithBody(server string, contentType string, body io.Read
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2235,23 +2421,16 @@ func NewPostScansRequestWithBody(server string, contentType string, body io.Read
 	return req, nil
 }
 
-// NewDeleteScansScanIDRequest generates requests for DeleteScansScanID
-func NewDeleteScansScanIDRequest(server string, scanID ScanID) (*http.Request, error) {
+// NewGetAdminTasksRequest generates requests for GetAdminTasks
+func NewGetAdminTasksRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanID", runtime.ParamLocationPath, scanID)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/scans/%s", pathParam0)
+	operationPath := fmt.Sprintf("/admin/tasks")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2261,7 +2440,7 @@ func NewDeleteScansScanIDRequest(server string, scanID ScanID) (*http.Request, e
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2269,13 +2448,13 @@ func NewDeleteScansScanIDRequest(server string, scanID ScanID) (*http.Request, e
 	return req, nil
 }
 
-// NewGetScansScanIDRequest generates requests for GetScansScanID
-func NewGetScansScanIDRequest(server string, scanID ScanID, params *GetScansScanIDParams) (*http.Request, error) {
+// NewPostAdminTasksTaskNameRunRequest generates requests for PostAdminTasksTaskNameRun
+func NewPostAdminTasksTaskNameRunRequest(server string, taskName TaskName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanID", runtime.ParamLocationPath, scanID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "taskName", runtime.ParamLocationPath, taskName)
 	if err != nil {
 		return nil, err
 	}
@@ -2285,7 +2464,7 @@ func NewGetScansScanIDRequest(server string, scanID ScanID, params *GetScansScan
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/scans/%s", pathParam0)
+	operationPath := fmt.Sprintf("/admin/tasks/%s/run", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2295,43 +2474,7 @@ func NewGetScansScanIDRequest(server string, scanID ScanID, params *GetScansScan
 		return nil, err
 	}
 
-	queryValues := queryURL.Query()
-
-	if params.Select != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	if params.Expand != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	queryURL.RawQuery = queryValues.Encode()
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2339,34 +2482,16 @@ func NewGetScansScanIDRequest(server string, scanID ScanID, params *GetScansScan
 	return req, nil
 }
 
-// NewPatchScansScanIDRequest calls the generic PatchScansScanID builder with application/json body
-func NewPatchScansScanIDRequest(server string, scanID ScanID, params *PatchScansScanIDParams, body PatchScansScanIDJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPatchScansScanIDRequestWithBody(server, scanID, params, "application/json", bodyReader)
-}
-
-// NewPatchScansScanIDRequestWithBody generates requests for PatchScansScanID with any type of body
-func NewPatchScansScanIDRequestWithBody(server string, scanID ScanID, params *PatchScansScanIDParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetAdminUsageRequest generates requests for GetAdminUsage
+func NewGetAdminUsageRequest(server string, params *GetAdminUsageParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanID", runtime.ParamLocationPath, scanID)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/scans/%s", pathParam0)
+	operationPath := fmt.Sprintf("/admin/usage")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2376,87 +2501,38 @@ func NewPatchScansScanIDRequestWithBody(server string, scanID ScanID, params *Pa
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	req.Header.Add("Content-Type", contentType)
+		if params.Async != nil {
 
-	if params.IfMatch != nil {
-		var headerParam0 string
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "async", runtime.ParamLocationQuery, *params.Async); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
-		if err != nil {
-			return nil, err
 		}
 
-		req.Header.Set("If-Match", headerParam0)
-	}
-
-	return req, nil
-}
-
-// NewPutScansScanIDRequest calls the generic PutScansScanID builder with application/json body
-func NewPutScansScanIDRequest(server string, scanID ScanID, params *PutScansScanIDParams, body PutScansScanIDJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutScansScanIDRequestWithBody(server, scanID, params, "application/json", bodyReader)
-}
-
-// NewPutScansScanIDRequestWithBody generates requests for PutScansScanID with any type of body
-func NewPutScansScanIDRequestWithBody(server string, scanID ScanID, params *PutScansScanIDParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanID", runtime.ParamLocationPath, scanID)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/scans/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
-	if params.IfMatch != nil {
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("If-Match", headerParam0)
-	}
-
 	return req, nil
 }
 
-// NewGetTargetsRequest generates requests for GetTargets
-func NewGetTargetsRequest(server string, params *GetTargetsParams) (*http.Request, error) {
+// NewGetAPIKeysRequest generates requests for GetAPIKeys
+func NewGetAPIKeysRequest(server string, params *GetAPIKeysParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2464,7 +2540,7 @@ func NewGetTargetsRequest(server string, params *GetTargetsParams) (*http.Reques
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/targets")
+	operationPath := fmt.Sprintf("/apiKeys")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2556,22 +2632,6 @@ func NewGetTargetsRequest(server string, params *GetTargetsParams) (*http.Reques
 
 	}
 
-	if params.Expand != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
 	if params.OrderBy != nil {
 
 		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$orderby", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
@@ -2598,19 +2658,19 @@ func NewGetTargetsRequest(server string, params *GetTargetsParams) (*http.Reques
 	return req, nil
 }
 
-// NewPostTargetsRequest calls the generic PostTargets builder with application/json body
-func NewPostTargetsRequest(server string, body PostTargetsJSONRequestBody) (*http.Request, error) {
+// NewPostAPIKeysRequest calls the generic PostAPIKeys builder with application/json body
+func NewPostAPIKeysRequest(server string, body PostAPIKeysJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostTargetsRequestWithBody(server, "application/json", bodyReader)
+	return NewPostAPIKeysRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewPostTargetsRequestWithBody generates requests for PostTargets with any type of body
-func NewPostTargetsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostAPIKeysRequestWithBody generates requests for PostAPIKeys with any type of body
+func NewPostAPIKeysRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2618,7 +2678,7 @@ func NewPostTargetsRequestWithBody(server string, contentType string, body io.Re
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/targets")
+	operationPath := fmt.Sprintf("/apiKeys")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2638,13 +2698,13 @@ func NewPostTargetsRequestWithBody(server string, contentType string, body io.Re
 	return req, nil
 }
 
-// NewDeleteTargetsTargetIDRequest generates requests for DeleteTargetsTargetID
-func NewDeleteTargetsTargetIDRequest(server string, targetID TargetID) (*http.Request, error) {
+// NewDeleteAPIKeysAPIKeyIDRequest generates requests for DeleteAPIKeysAPIKeyID
+func NewDeleteAPIKeysAPIKeyIDRequest(server string, apiKeyID ApiKeyID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "targetID", runtime.ParamLocationPath, targetID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "apiKeyID", runtime.ParamLocationPath, apiKeyID)
 	if err != nil {
 		return nil, err
 	}
@@ -2654,7 +2714,7 @@ func NewDeleteTargetsTargetIDRequest(server string, targetID TargetID) (*http.Re
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/targets/%s", pathParam0)
+	operationPath := fmt.Sprintf("/apiKeys/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2672,13 +2732,13 @@ func NewDeleteTargetsTargetIDRequest(server string, targetID TargetID) (*http.Re
 	return req, nil
 }
 
-// NewGetTargetsTargetIDRequest generates requests for GetTargetsTargetID
-func NewGetTargetsTargetIDRequest(server string, targetID TargetID, params *GetTargetsTargetIDParams) (*http.Request, error) {
+// NewGetAPIKeysAPIKeyIDRequest generates requests for GetAPIKeysAPIKeyID
+func NewGetAPIKeysAPIKeyIDRequest(server string, apiKeyID ApiKeyID, params *GetAPIKeysAPIKeyIDParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "targetID", runtime.ParamLocationPath, targetID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "apiKeyID", runtime.ParamLocationPath, apiKeyID)
 	if err != nil {
 		return nil, err
 	}
@@ -2688,7 +2748,7 @@ func NewGetTargetsTargetIDRequest(server string, targetID TargetID, params *GetT
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/targets/%s", pathParam0)
+	operationPath := fmt.Sprintf("/apiKeys/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2716,21 +2776,132 @@ func NewGetTargetsTargetIDRequest(server string, targetID TargetID, params *GetT
 
 	}
 
-	if params.Expand != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	queryURL.RawQuery = queryValues.Encode()
 
-	}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAssetGroupsRequest generates requests for GetAssetGroups
+func NewGetAssetGroupsRequest(server string, params *GetAssetGroupsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/assetGroups")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Filter != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Select != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Count != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$count", runtime.ParamLocationQuery, *params.Count); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Top != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$top", runtime.ParamLocationQuery, *params.Top); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Skip != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.OrderBy != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$orderby", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
 
 	queryURL.RawQuery = queryValues.Encode()
 
@@ -2742,24 +2913,53 @@ func NewGetTargetsTargetIDRequest(server string, targetID TargetID, params *GetT
 	return req, nil
 }
 
-// NewPatchTargetsTargetIDRequest calls the generic PatchTargetsTargetID builder with application/json body
-func NewPatchTargetsTargetIDRequest(server string, targetID TargetID, params *PatchTargetsTargetIDParams, body PatchTargetsTargetIDJSONRequestBody) (*http.Request, error) {
+// NewPostAssetGroupsRequest calls the generic PostAssetGroups builder with application/json body
+func NewPostAssetGroupsRequest(server string, body PostAssetGroupsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPatchTargetsTargetIDRequestWithBody(server, targetID, params, "application/json", bodyReader)
+	return NewPostAssetGroupsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAssetGroupsRequestWithBody generates requests for PostAssetGroups with any type of body
+func NewPostAssetGroupsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/assetGroups")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// NewPatchTargetsTargetIDRequestWithBody generates requests for PatchTargetsTargetID with any type of body
-func NewPatchTargetsTargetIDRequestWithBody(server string, targetID TargetID, params *PatchTargetsTargetIDParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeleteAssetGroupsAssetGroupIDRequest generates requests for DeleteAssetGroupsAssetGroupID
+func NewDeleteAssetGroupsAssetGroupIDRequest(server string, assetGroupID AssetGroupID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "targetID", runtime.ParamLocationPath, targetID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "assetGroupID", runtime.ParamLocationPath, assetGroupID)
 	if err != nil {
 		return nil, err
 	}
@@ -2769,7 +2969,7 @@ func NewPatchTargetsTargetIDRequestWithBody(server string, targetID TargetID, pa
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/targets/%s", pathParam0)
+	operationPath := fmt.Sprintf("/assetGroups/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2779,45 +2979,86 @@ func NewPatchTargetsTargetIDRequestWithBody(server string, targetID TargetID, pa
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	return req, nil
+}
 
-	if params.IfMatch != nil {
-		var headerParam0 string
+// NewGetAssetGroupsAssetGroupIDRequest generates requests for GetAssetGroupsAssetGroupID
+func NewGetAssetGroupsAssetGroupIDRequest(server string, assetGroupID AssetGroupID, params *GetAssetGroupsAssetGroupIDParams) (*http.Request, error) {
+	var err error
 
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
-		if err != nil {
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "assetGroupID", runtime.ParamLocationPath, assetGroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/assetGroups/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Select != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
 
-		req.Header.Set("If-Match", headerParam0)
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
 	return req, nil
 }
 
-// NewPutTargetsTargetIDRequest calls the generic PutTargetsTargetID builder with application/json body
-func NewPutTargetsTargetIDRequest(server string, targetID TargetID, params *PutTargetsTargetIDParams, body PutTargetsTargetIDJSONRequestBody) (*http.Request, error) {
+// NewPatchAssetGroupsAssetGroupIDRequest calls the generic PatchAssetGroupsAssetGroupID builder with application/json body
+func NewPatchAssetGroupsAssetGroupIDRequest(server string, assetGroupID AssetGroupID, params *PatchAssetGroupsAssetGroupIDParams, body PatchAssetGroupsAssetGroupIDJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPutTargetsTargetIDRequestWithBody(server, targetID, params, "application/json", bodyReader)
+	return NewPatchAssetGroupsAssetGroupIDRequestWithBody(server, assetGroupID, params, "application/json", bodyReader)
 }
 
-// NewPutTargetsTargetIDRequestWithBody generates requests for PutTargetsTargetID with any type of body
-func NewPutTargetsTargetIDRequestWithBody(server string, targetID TargetID, params *PutTargetsTargetIDParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewPatchAssetGroupsAssetGroupIDRequestWithBody generates requests for PatchAssetGroupsAssetGroupID with any type of body
+func NewPatchAssetGroupsAssetGroupIDRequestWithBody(server string, assetGroupID AssetGroupID, params *PatchAssetGroupsAssetGroupIDParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "targetID", runtime.ParamLocationPath, targetID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "assetGroupID", runtime.ParamLocationPath, assetGroupID)
 	if err != nil {
 		return nil, err
 	}
@@ -2827,7 +3068,7 @@ func NewPutTargetsTargetIDRequestWithBody(server string, targetID TargetID, para
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/targets/%s", pathParam0)
+	operationPath := fmt.Sprintf("/assetGroups/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2837,7 +3078,7 @@ func NewPutTargetsTargetIDRequestWithBody(server string, targetID TargetID, para
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2858,1371 +3099,13633 @@ func NewPutTargetsTargetIDRequestWithBody(server string, targetID TargetID, para
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
-}
+// NewGetAssetGroupsAssetGroupIDAssetsRequest generates requests for GetAssetGroupsAssetGroupIDAssets
+func NewGetAssetGroupsAssetGroupIDAssetsRequest(server string, assetGroupID AssetGroupID, params *GetAssetGroupsAssetGroupIDAssetsParams) (*http.Request, error) {
+	var err error
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	var pathParam0 string
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "assetGroupID", runtime.ParamLocationPath, assetGroupID)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetDiscoveryScopes request
-	GetDiscoveryScopesWithResponse(ctx context.Context, params *GetDiscoveryScopesParams, reqEditors ...RequestEditorFn) (*GetDiscoveryScopesResponse, error)
+	operationPath := fmt.Sprintf("/assetGroups/%s/assets", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// PutDiscoveryScopes request with any body
-	PutDiscoveryScopesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutDiscoveryScopesResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	PutDiscoveryScopesWithResponse(ctx context.Context, body PutDiscoveryScopesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutDiscoveryScopesResponse, error)
+	queryValues := queryURL.Query()
 
-	// GetFindings request
-	GetFindingsWithResponse(ctx context.Context, params *GetFindingsParams, reqEditors ...RequestEditorFn) (*GetFindingsResponse, error)
+	if params.Filter != nil {
 
-	// PostFindings request with any body
-	PostFindingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFindingsResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	PostFindingsWithResponse(ctx context.Context, body PostFindingsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFindingsResponse, error)
+	}
 
-	// DeleteFindingsFindingID request
-	DeleteFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, reqEditors ...RequestEditorFn) (*DeleteFindingsFindingIDResponse, error)
+	if params.Select != nil {
 
-	// GetFindingsFindingID request
-	GetFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, params *GetFindingsFindingIDParams, reqEditors ...RequestEditorFn) (*GetFindingsFindingIDResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// PatchFindingsFindingID request with any body
-	PatchFindingsFindingIDWithBodyWithResponse(ctx context.Context, findingID FindingID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchFindingsFindingIDResponse, error)
+	}
 
-	PatchFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, body PatchFindingsFindingIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchFindingsFindingIDResponse, error)
+	if params.Count != nil {
 
-	// PutFindingsFindingID request with any body
-	PutFindingsFindingIDWithBodyWithResponse(ctx context.Context, findingID FindingID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutFindingsFindingIDResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$count", runtime.ParamLocationQuery, *params.Count); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	PutFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, body PutFindingsFindingIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutFindingsFindingIDResponse, error)
+	}
 
-	// GetScanConfigs request
-	GetScanConfigsWithResponse(ctx context.Context, params *GetScanConfigsParams, reqEditors ...RequestEditorFn) (*GetScanConfigsResponse, error)
+	if params.Top != nil {
 
-	// PostScanConfigs request with any body
-	PostScanConfigsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScanConfigsResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$top", runtime.ParamLocationQuery, *params.Top); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	PostScanConfigsWithResponse(ctx context.Context, body PostScanConfigsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScanConfigsResponse, error)
+	}
 
-	// DeleteScanConfigsScanConfigID request
-	DeleteScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, reqEditors ...RequestEditorFn) (*DeleteScanConfigsScanConfigIDResponse, error)
+	if params.Skip != nil {
 
-	// GetScanConfigsScanConfigID request
-	GetScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *GetScanConfigsScanConfigIDParams, reqEditors ...RequestEditorFn) (*GetScanConfigsScanConfigIDResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// PatchScanConfigsScanConfigID request with any body
-	PatchScanConfigsScanConfigIDWithBodyWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *PatchScanConfigsScanConfigIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScanConfigsScanConfigIDResponse, error)
+	}
 
-	PatchScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *PatchScanConfigsScanConfigIDParams, body PatchScanConfigsScanConfigIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScanConfigsScanConfigIDResponse, error)
+	if params.OrderBy != nil {
 
-	// PutScanConfigsScanConfigID request with any body
-	PutScanConfigsScanConfigIDWithBodyWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *PutScanConfigsScanConfigIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScanConfigsScanConfigIDResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$orderby", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	PutScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *PutScanConfigsScanConfigIDParams, body PutScanConfigsScanConfigIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScanConfigsScanConfigIDResponse, error)
+	}
 
-	// GetScanResults request
-	GetScanResultsWithResponse(ctx context.Context, params *GetScanResultsParams, reqEditors ...RequestEditorFn) (*GetScanResultsResponse, error)
+	queryURL.RawQuery = queryValues.Encode()
 
-	// PostScanResults request with any body
-	PostScanResultsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScanResultsResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	PostScanResultsWithResponse(ctx context.Context, body PostScanResultsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScanResultsResponse, error)
+	return req, nil
+}
 
-	// GetScanResultsScanResultID request
-	GetScanResultsScanResultIDWithResponse(ctx context.Context, scanResultID ScanResultID, params *GetScanResultsScanResultIDParams, reqEditors ...RequestEditorFn) (*GetScanResultsScanResultIDResponse, error)
+// NewGetAssetsRequest generates requests for GetAssets
+func NewGetAssetsRequest(server string, params *GetAssetsParams) (*http.Request, error) {
+	var err error
 
-	// PatchScanResultsScanResultID request with any body
-	PatchScanResultsScanResultIDWithBodyWithResponse(ctx context.Context, scanResultID ScanResultID, params *PatchScanResultsScanResultIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScanResultsScanResultIDResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	PatchScanResultsScanResultIDWithResponse(ctx context.Context, scanResultID ScanResultID, params *PatchScanResultsScanResultIDParams, body PatchScanResultsScanResultIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScanResultsScanResultIDResponse, error)
+	operationPath := fmt.Sprintf("/assets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// PutScanResultsScanResultID request with any body
-	PutScanResultsScanResultIDWithBodyWithResponse(ctx context.Context, scanResultID ScanResultID, params *PutScanResultsScanResultIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScanResultsScanResultIDResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	PutScanResultsScanResultIDWithResponse(ctx context.Context, scanResultID ScanResultID, params *PutScanResultsScanResultIDParams, body PutScanResultsScanResultIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScanResultsScanResultIDResponse, error)
+	queryValues := queryURL.Query()
 
-	// GetScans request
-	GetScansWithResponse(ctx context.Context, params *GetScansParams, reqEditors ...RequestEditorFn) (*GetScansResponse, error)
+	if params.Filter != nil {
 
-	// PostScans request with any body
-	PostScansWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScansResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	PostScansWithResponse(ctx context.Context, body PostScansJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScansResponse, error)
+	}
 
-	// DeleteScansScanID request
-	DeleteScansScanIDWithResponse(ctx context.Context, scanID ScanID, reqEditors ...RequestEditorFn) (*DeleteScansScanIDResponse, error)
+	if params.Search != nil {
 
-	// GetScansScanID request
-	GetScansScanIDWithResponse(ctx context.Context, scanID ScanID, params *GetScansScanIDParams, reqEditors ...RequestEditorFn) (*GetScansScanIDResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$search", runtime.ParamLocationQuery, *params.Search); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// PatchScansScanID request with any body
-	PatchScansScanIDWithBodyWithResponse(ctx context.Context, scanID ScanID, params *PatchScansScanIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScansScanIDResponse, error)
+	}
 
-	PatchScansScanIDWithResponse(ctx context.Context, scanID ScanID, params *PatchScansScanIDParams, body PatchScansScanIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScansScanIDResponse, error)
+	if params.Select != nil {
 
-	// PutScansScanID request with any body
-	PutScansScanIDWithBodyWithResponse(ctx context.Context, scanID ScanID, params *PutScansScanIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScansScanIDResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	PutScansScanIDWithResponse(ctx context.Context, scanID ScanID, params *PutScansScanIDParams, body PutScansScanIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScansScanIDResponse, error)
+	}
+
+	if params.Count != nil {
 
-	// GetTargets request
-	GetTargetsWithResponse(ctx context.Context, params *GetTargetsParams, reqEditors ...RequestEditorFn) (*GetTargetsResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$count", runtime.ParamLocationQuery, *params.Count); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// PostTargets request with any body
-	PostTargetsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTargetsResponse, error)
+	}
 
-	PostTargetsWithResponse(ctx context.Context, body PostTargetsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTargetsResponse, error)
+	if params.Top != nil {
 
-	// DeleteTargetsTargetID request
-	DeleteTargetsTargetIDWithResponse(ctx context.Context, targetID TargetID, reqEditors ...RequestEditorFn) (*DeleteTargetsTargetIDResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$top", runtime.ParamLocationQuery, *params.Top); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// GetTargetsTargetID request
-	GetTargetsTargetIDWithResponse(ctx context.Context, targetID TargetID, params *GetTargetsTargetIDParams, reqEditors ...RequestEditorFn) (*GetTargetsTargetIDResponse, error)
+	}
 
-	// PatchTargetsTargetID request with any body
-	PatchTargetsTargetIDWithBodyWithResponse(ctx context.Context, targetID TargetID, params *PatchTargetsTargetIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchTargetsTargetIDResponse, error)
+	if params.Skip != nil {
 
-	PatchTargetsTargetIDWithResponse(ctx context.Context, targetID TargetID, params *PatchTargetsTargetIDParams, body PatchTargetsTargetIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchTargetsTargetIDResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// PutTargetsTargetID request with any body
-	PutTargetsTargetIDWithBodyWithResponse(ctx context.Context, targetID TargetID, params *PutTargetsTargetIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutTargetsTargetIDResponse, error)
+	}
 
-	PutTargetsTargetIDWithResponse(ctx context.Context, targetID TargetID, params *PutTargetsTargetIDParams, body PutTargetsTargetIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutTargetsTargetIDResponse, error)
-}
+	if params.Expand != nil {
 
-type GetDiscoveryScopesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Scopes
-	JSONDefault  *ApiResponse
-}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-// Status returns HTTPResponse.Status
-func (r GetDiscoveryScopesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDiscoveryScopesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
-
-type PutDiscoveryScopesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Scopes
-	JSONDefault  *ApiResponse
-}
+	if params.OrderBy != nil {
 
-// Status returns HTTPResponse.Status
-func (r PutDiscoveryScopesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$orderby", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutDiscoveryScopesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetFindingsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Findings
-	JSONDefault  *ApiResponse
-}
+	queryURL.RawQuery = queryValues.Encode()
 
-// Status returns HTTPResponse.Status
-func (r GetFindingsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetFindingsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostAssetsRequest calls the generic PostAssets builder with application/json body
+func NewPostAssetsRequest(server string, body PostAssetsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAssetsRequestWithBody(server, "application/json", bodyReader)
 }
 
-type PostFindingsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Finding
-	JSON400      *ApiResponse
-	JSON409      *FindingExists
-	JSONDefault  *ApiResponse
-}
+// NewPostAssetsRequestWithBody generates requests for PostAssets with any type of body
+func NewPostAssetsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r PostFindingsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostFindingsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/assets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
-
-type DeleteFindingsFindingIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessResponse
-	JSON404      *ApiResponse
-	JSONDefault  *ApiResponse
-}
 
-// Status returns HTTPResponse.Status
-func (r DeleteFindingsFindingIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteFindingsFindingIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetFindingsFindingIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Finding
-	JSON404      *ApiResponse
-	JSONDefault  *ApiResponse
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r GetFindingsFindingIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetFindingsFindingIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewDeleteAssetsAssetIDRequest generates requests for DeleteAssetsAssetID
+func NewDeleteAssetsAssetIDRequest(server string, assetID AssetID) (*http.Request, error) {
+	var err error
 
-type PatchFindingsFindingIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Finding
-	JSON400      *ApiResponse
-	JSON404      *ApiResponse
-	JSONDefault  *ApiResponse
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r PatchFindingsFindingIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "assetID", runtime.ParamLocationPath, assetID)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchFindingsFindingIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PutFindingsFindingIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Finding
-	JSON400      *ApiResponse
-	JSON404      *ApiResponse
-	JSONDefault  *ApiResponse
-}
+	operationPath := fmt.Sprintf("/assets/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r PutFindingsFindingIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutFindingsFindingIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetScanConfigsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ScanConfigs
-	JSONDefault  *ApiResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetScanConfigsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewGetAssetsAssetIDRequest generates requests for GetAssetsAssetID
+func NewGetAssetsAssetIDRequest(server string, assetID AssetID, params *GetAssetsAssetIDParams) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetScanConfigsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "assetID", runtime.ParamLocationPath, assetID)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostScanConfigsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *ScanConfig
-	JSON400      *ApiResponse
-	JSON409      *ScanConfigExists
-	JSONDefault  *ApiResponse
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r PostScanConfigsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/assets/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostScanConfigsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteScanConfigsScanConfigIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessResponse
-	JSON404      *ApiResponse
-	JSONDefault  *ApiResponse
-}
+	queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r DeleteScanConfigsScanConfigIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	if params.Select != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteScanConfigsScanConfigIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetScanConfigsScanConfigIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ScanConfig
-	JSON404      *ApiResponse
-	JSONDefault  *ApiResponse
-}
+	if params.Expand != nil {
 
-// Status returns HTTPResponse.Status
-func (r GetScanConfigsScanConfigIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetScanConfigsScanConfigIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type PatchScanConfigsScanConfigIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ScanConfig
-	JSON400      *ApiResponse
-	JSON404      *ApiResponse
-	JSON409      *ScanConfigExists
-	JSON412      *ApiResponse
-	JSONDefault  *ApiResponse
-}
+	queryURL.RawQuery = queryValues.Encode()
 
-// Status returns HTTPResponse.Status
-func (r PatchScanConfigsScanConfigIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchScanConfigsScanConfigIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	if params.IfNoneMatch != nil {
+		var headerParam0 string
 
-type PutScanConfigsScanConfigIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ScanConfig
-	JSON400      *ApiResponse
-	JSON404      *ApiResponse
-	JSON409      *ScanConfigExists
-	JSON412      *ApiResponse
-	JSONDefault  *ApiResponse
-}
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+		if err != nil {
+			return nil, err
+		}
 
-// Status returns HTTPResponse.Status
-func (r PutScanConfigsScanConfigIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		req.Header.Set("If-None-Match", headerParam0)
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutScanConfigsScanConfigIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPatchAssetsAssetIDRequest calls the generic PatchAssetsAssetID builder with application/json body
+func NewPatchAssetsAssetIDRequest(server string, assetID AssetID, params *PatchAssetsAssetIDParams, body PatchAssetsAssetIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchAssetsAssetIDRequestWithBody(server, assetID, params, "application/json", bodyReader)
 }
 
-type GetScanResultsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TargetScanResults
-	JSONDefault  *ApiResponse
-}
+// NewPatchAssetsAssetIDRequestWithBody generates requests for PatchAssetsAssetID with any type of body
+func NewPatchAssetsAssetIDRequestWithBody(server string, assetID AssetID, params *PatchAssetsAssetIDParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r GetScanResultsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "assetID", runtime.ParamLocationPath, assetID)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetScanResultsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostScanResultsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *TargetScanResult
-	JSON400      *ApiResponse
-	JSON409      *TargetScanResultExists
-	JSONDefault  *ApiResponse
-}
+	operationPath := fmt.Sprintf("/assets/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r PostScanResultsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostScanResultsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetScanResultsScanResultIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TargetScanResult
-	JSON404      *ApiResponse
-	JSONDefault  *ApiResponse
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r GetScanResultsScanResultIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	if params.IfMatch != nil {
+		var headerParam0 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetScanResultsScanResultIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-Match", headerParam0)
 	}
-	return 0
-}
 
-type PatchScanResultsScanResultIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TargetScanResult
-	JSON400      *ApiResponse
-	JSON404      *ApiResponse
-	JSON409      *TargetScanResultExists
-	JSON412      *ApiResponse
-	JSONDefault  *ApiResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PatchScanResultsScanResultIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPutAssetsAssetIDRequest calls the generic PutAssetsAssetID builder with application/json body
+func NewPutAssetsAssetIDRequest(server string, assetID AssetID, params *PutAssetsAssetIDParams, body PutAssetsAssetIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAssetsAssetIDRequestWithBody(server, assetID, params, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchScanResultsScanResultIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPutAssetsAssetIDRequestWithBody generates requests for PutAssetsAssetID with any type of body
+func NewPutAssetsAssetIDRequestWithBody(server string, assetID AssetID, params *PutAssetsAssetIDParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "assetID", runtime.ParamLocationPath, assetID)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PutScanResultsScanResultIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TargetScanResult
-	JSON400      *ApiResponse
-	JSON404      *ApiResponse
-	JSON409      *TargetScanResultExists
-	JSON412      *ApiResponse
-	JSONDefault  *ApiResponse
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r PutScanResultsScanResultIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/assets/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutScanResultsScanResultIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetScansResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Scans
-	JSONDefault  *ApiResponse
-}
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetScansResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req.Header.Add("Content-Type", contentType)
+
+	if params.IfMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-Match", headerParam0)
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetScansResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewGetAssetsAssetIDPackagesRequest generates requests for GetAssetsAssetIDPackages
+func NewGetAssetsAssetIDPackagesRequest(server string, assetID AssetID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "assetID", runtime.ParamLocationPath, assetID)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostScansResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Scan
-	JSON400      *ApiResponse
-	JSON409      *ScanExists
-	JSONDefault  *ApiResponse
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r PostScansResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/assets/%s/packages", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostScansResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteScansScanIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessResponse
-	JSON404      *ApiResponse
-	JSONDefault  *ApiResponse
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteScansScanIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetAssetsAssetIDSbomDiffRequest generates requests for GetAssetsAssetIDSbomDiff
+func NewGetAssetsAssetIDSbomDiffRequest(server string, assetID AssetID, params *GetAssetsAssetIDSbomDiffParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "assetID", runtime.ParamLocationPath, assetID)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteScansScanIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetScansScanIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Scan
-	JSON404      *ApiResponse
-	JSONDefault  *ApiResponse
-}
+	operationPath := fmt.Sprintf("/assets/%s/sbomDiff", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetScansScanIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetScansScanIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "base", runtime.ParamLocationQuery, params.Base); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
 	}
-	return 0
-}
 
-type PatchScansScanIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Scan
-	JSON400      *ApiResponse
-	JSON404      *ApiResponse
-	JSON409      *ScanExists
-	JSON412      *ApiResponse
-	JSONDefault  *ApiResponse
-}
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "head", runtime.ParamLocationQuery, params.Head); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
 
-// Status returns HTTPResponse.Status
-func (r PatchScansScanIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchScansScanIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewGetAssetsAssetIDScanResultDiffRequest generates requests for GetAssetsAssetIDScanResultDiff
+func NewGetAssetsAssetIDScanResultDiffRequest(server string, assetID AssetID, params *GetAssetsAssetIDScanResultDiffParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "assetID", runtime.ParamLocationPath, assetID)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PutScansScanIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Scan
-	JSON400      *ApiResponse
-	JSON404      *ApiResponse
-	JSON409      *ScanExists
-	JSON412      *ApiResponse
-	JSONDefault  *ApiResponse
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r PutScansScanIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/assets/%s/scanResultDiff", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutScansScanIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetTargetsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Targets
-	JSONDefault  *ApiResponse
-}
+	queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r GetTargetsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "baseScanId", runtime.ParamLocationQuery, params.BaseScanId); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTargetsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "compareScanId", runtime.ParamLocationQuery, params.CompareScanId); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
 	}
-	return 0
-}
 
-type PostTargetsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Target
-	JSON400      *ApiResponse
-	JSON409      *TargetExists
-	JSONDefault  *ApiResponse
-}
+	queryURL.RawQuery = queryValues.Encode()
 
-// Status returns HTTPResponse.Status
-func (r PostTargetsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostTargetsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewGetAssetsAssetIDUpgradePlanRequest generates requests for GetAssetsAssetIDUpgradePlan
+func NewGetAssetsAssetIDUpgradePlanRequest(server string, assetID AssetID, params *GetAssetsAssetIDUpgradePlanParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "assetID", runtime.ParamLocationPath, assetID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/assets/%s/upgradePlan", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Async != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "async", runtime.ParamLocationQuery, *params.Async); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCorrelatedFindingsRequest generates requests for GetCorrelatedFindings
+func NewGetCorrelatedFindingsRequest(server string, params *GetCorrelatedFindingsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/correlatedFindings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Select != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Count != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$count", runtime.ParamLocationQuery, *params.Count); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Top != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$top", runtime.ParamLocationQuery, *params.Top); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Skip != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Expand != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$orderby", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCorrelatedFindingsCorrelatedFindingIDRequest generates requests for GetCorrelatedFindingsCorrelatedFindingID
+func NewGetCorrelatedFindingsCorrelatedFindingIDRequest(server string, correlatedFindingID CorrelatedFindingID, params *GetCorrelatedFindingsCorrelatedFindingIDParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "correlatedFindingID", runtime.ParamLocationPath, correlatedFindingID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/correlatedFindings/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Select != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Expand != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDiscoveryScopesRequest generates requests for GetDiscoveryScopes
+func NewGetDiscoveryScopesRequest(server string, params *GetDiscoveryScopesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/discovery/scopes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Filter != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Select != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.OrderBy != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$orderby", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutDiscoveryScopesRequest calls the generic PutDiscoveryScopes builder with application/json body
+func NewPutDiscoveryScopesRequest(server string, body PutDiscoveryScopesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutDiscoveryScopesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPutDiscoveryScopesRequestWithBody generates requests for PutDiscoveryScopes with any type of body
+func NewPutDiscoveryScopesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/discovery/scopes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetFeedsFeedNameRequest generates requests for GetFeedsFeedName
+func NewGetFeedsFeedNameRequest(server string, feedName FeedName, params *GetFeedsFeedNameParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "feedName", runtime.ParamLocationPath, feedName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/feeds/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Version != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "version", runtime.ParamLocationQuery, *params.Version); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetFindingDigestsRequest generates requests for GetFindingDigests
+func NewGetFindingDigestsRequest(server string, params *GetFindingDigestsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/findingDigests")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Select != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Count != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$count", runtime.ParamLocationQuery, *params.Count); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Top != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$top", runtime.ParamLocationQuery, *params.Top); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Skip != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$orderby", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostFindingDigestsRequest calls the generic PostFindingDigests builder with application/json body
+func NewPostFindingDigestsRequest(server string, body PostFindingDigestsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostFindingDigestsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostFindingDigestsRequestWithBody generates requests for PostFindingDigests with any type of body
+func NewPostFindingDigestsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/findingDigests")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteFindingDigestsFindingDigestIDRequest generates requests for DeleteFindingDigestsFindingDigestID
+func NewDeleteFindingDigestsFindingDigestIDRequest(server string, findingDigestID FindingDigestID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "findingDigestID", runtime.ParamLocationPath, findingDigestID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/findingDigests/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetFindingDigestsFindingDigestIDRequest generates requests for GetFindingDigestsFindingDigestID
+func NewGetFindingDigestsFindingDigestIDRequest(server string, findingDigestID FindingDigestID, params *GetFindingDigestsFindingDigestIDParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "findingDigestID", runtime.ParamLocationPath, findingDigestID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/findingDigests/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Select != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchFindingDigestsFindingDigestIDRequest calls the generic PatchFindingDigestsFindingDigestID builder with application/json body
+func NewPatchFindingDigestsFindingDigestIDRequest(server string, findingDigestID FindingDigestID, params *PatchFindingDigestsFindingDigestIDParams, body PatchFindingDigestsFindingDigestIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchFindingDigestsFindingDigestIDRequestWithBody(server, findingDigestID, params, "application/json", bodyReader)
+}
+
+// NewPatchFindingDigestsFindingDigestIDRequestWithBody generates requests for PatchFindingDigestsFindingDigestID with any type of body
+func NewPatchFindingDigestsFindingDigestIDRequestWithBody(server string, findingDigestID FindingDigestID, params *PatchFindingDigestsFindingDigestIDParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "findingDigestID", runtime.ParamLocationPath, findingDigestID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/findingDigests/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params.IfMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-Match", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewGetFindingExceptionsRequest generates requests for GetFindingExceptions
+func NewGetFindingExceptionsRequest(server string, params *GetFindingExceptionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/findingExceptions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Select != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Count != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$count", runtime.ParamLocationQuery, *params.Count); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Top != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$top", runtime.ParamLocationQuery, *params.Top); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Skip != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$orderby", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostFindingExceptionsRequest calls the generic PostFindingExceptions builder with application/json body
+func NewPostFindingExceptionsRequest(server string, body PostFindingExceptionsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostFindingExceptionsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostFindingExceptionsRequestWithBody generates requests for PostFindingExceptions with any type of body
+func NewPostFindingExceptionsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/findingExceptions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteFindingExceptionsFindingExceptionIDRequest generates requests for DeleteFindingExceptionsFindingExceptionID
+func NewDeleteFindingExceptionsFindingExceptionIDRequest(server string, findingExceptionID FindingExceptionID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "findingExceptionID", runtime.ParamLocationPath, findingExceptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/findingExceptions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetFindingExceptionsFindingExceptionIDRequest generates requests for GetFindingExceptionsFindingExceptionID
+func NewGetFindingExceptionsFindingExceptionIDRequest(server string, findingExceptionID FindingExceptionID, params *GetFindingExceptionsFindingExceptionIDParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "findingExceptionID", runtime.ParamLocationPath, findingExceptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/findingExceptions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Select != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchFindingExceptionsFindingExceptionIDRequest calls the generic PatchFindingExceptionsFindingExceptionID builder with application/json body
+func NewPatchFindingExceptionsFindingExceptionIDRequest(server string, findingExceptionID FindingExceptionID, params *PatchFindingExceptionsFindingExceptionIDParams, body PatchFindingExceptionsFindingExceptionIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchFindingExceptionsFindingExceptionIDRequestWithBody(server, findingExceptionID, params, "application/json", bodyReader)
+}
+
+// NewPatchFindingExceptionsFindingExceptionIDRequestWithBody generates requests for PatchFindingExceptionsFindingExceptionID with any type of body
+func NewPatchFindingExceptionsFindingExceptionIDRequestWithBody(server string, findingExceptionID FindingExceptionID, params *PatchFindingExceptionsFindingExceptionIDParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "findingExceptionID", runtime.ParamLocationPath, findingExceptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/findingExceptions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params.IfMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-Match", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewGetFindingsRequest generates requests for GetFindings
+func NewGetFindingsRequest(server string, params *GetFindingsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/findings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Filter != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Search != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$search", runtime.ParamLocationQuery, *params.Search); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Select != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Count != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$count", runtime.ParamLocationQuery, *params.Count); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Top != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$top", runtime.ParamLocationQuery, *params.Top); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Skip != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Expand != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.OrderBy != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$orderby", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostFindingsRequest calls the generic PostFindings builder with application/json body
+func NewPostFindingsRequest(server string, body PostFindingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostFindingsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostFindingsRequestWithBody generates requests for PostFindings with any type of body
+func NewPostFindingsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/findings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPatchFindingsBulkRequest calls the generic PatchFindingsBulk builder with application/json body
+func NewPatchFindingsBulkRequest(server string, body PatchFindingsBulkJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchFindingsBulkRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPatchFindingsBulkRequestWithBody generates requests for PatchFindingsBulk with any type of body
+func NewPatchFindingsBulkRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/findings/bulk")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostFindingsBulkRequest calls the generic PostFindingsBulk builder with application/json body
+func NewPostFindingsBulkRequest(server string, body PostFindingsBulkJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostFindingsBulkRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostFindingsBulkRequestWithBody generates requests for PostFindingsBulk with any type of body
+func NewPostFindingsBulkRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/findings/bulk")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteFindingsFindingIDRequest generates requests for DeleteFindingsFindingID
+func NewDeleteFindingsFindingIDRequest(server string, findingID FindingID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "findingID", runtime.ParamLocationPath, findingID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/findings/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetFindingsFindingIDRequest generates requests for GetFindingsFindingID
+func NewGetFindingsFindingIDRequest(server string, findingID FindingID, params *GetFindingsFindingIDParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "findingID", runtime.ParamLocationPath, findingID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/findings/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Select != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Expand != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchFindingsFindingIDRequest calls the generic PatchFindingsFindingID builder with application/json body
+func NewPatchFindingsFindingIDRequest(server string, findingID FindingID, body PatchFindingsFindingIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchFindingsFindingIDRequestWithBody(server, findingID, "application/json", bodyReader)
+}
+
+// NewPatchFindingsFindingIDRequestWithBody generates requests for PatchFindingsFindingID with any type of body
+func NewPatchFindingsFindingIDRequestWithBody(server string, findingID FindingID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "findingID", runtime.ParamLocationPath, findingID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/findings/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPutFindingsFindingIDRequest calls the generic PutFindingsFindingID builder with application/json body
+func NewPutFindingsFindingIDRequest(server string, findingID FindingID, body PutFindingsFindingIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutFindingsFindingIDRequestWithBody(server, findingID, "application/json", bodyReader)
+}
+
+// NewPutFindingsFindingIDRequestWithBody generates requests for PutFindingsFindingID with any type of body
+func NewPutFindingsFindingIDRequestWithBody(server string, findingID FindingID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "findingID", runtime.ParamLocationPath, findingID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/findings/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostFindingsFindingIDScannerSuppressionRequest calls the generic PostFindingsFindingIDScannerSuppression builder with application/json body
+func NewPostFindingsFindingIDScannerSuppressionRequest(server string, findingID FindingID, body PostFindingsFindingIDScannerSuppressionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostFindingsFindingIDScannerSuppressionRequestWithBody(server, findingID, "application/json", bodyReader)
+}
+
+// NewPostFindingsFindingIDScannerSuppressionRequestWithBody generates requests for PostFindingsFindingIDScannerSuppression with any type of body
+func NewPostFindingsFindingIDScannerSuppressionRequestWithBody(server string, findingID FindingID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "findingID", runtime.ParamLocationPath, findingID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/findings/%s/scannerSuppression", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetNotificationConfigsRequest generates requests for GetNotificationConfigs
+func NewGetNotificationConfigsRequest(server string, params *GetNotificationConfigsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/notificationConfigs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Select != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Count != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$count", runtime.ParamLocationQuery, *params.Count); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Top != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$top", runtime.ParamLocationQuery, *params.Top); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Skip != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$orderby", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostNotificationConfigsRequest calls the generic PostNotificationConfigs builder with application/json body
+func NewPostNotificationConfigsRequest(server string, body PostNotificationConfigsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostNotificationConfigsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostNotificationConfigsRequestWithBody generates requests for PostNotificationConfigs with any type of body
+func NewPostNotificationConfigsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/notificationConfigs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteNotificationConfigsNotificationConfigIDRequest generates requests for DeleteNotificationConfigsNotificationConfigID
+func NewDeleteNotificationConfigsNotificationConfigIDRequest(server string, notificationConfigID NotificationConfigID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "notificationConfigID", runtime.ParamLocationPath, notificationConfigID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/notificationConfigs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetNotificationConfigsNotificationConfigIDRequest generates requests for GetNotificationConfigsNotificationConfigID
+func NewGetNotificationConfigsNotificationConfigIDRequest(server string, notificationConfigID NotificationConfigID, params *GetNotificationConfigsNotificationConfigIDParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "notificationConfigID", runtime.ParamLocationPath, notificationConfigID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/notificationConfigs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Select != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchNotificationConfigsNotificationConfigIDRequest calls the generic PatchNotificationConfigsNotificationConfigID builder with application/json body
+func NewPatchNotificationConfigsNotificationConfigIDRequest(server string, notificationConfigID NotificationConfigID, params *PatchNotificationConfigsNotificationConfigIDParams, body PatchNotificationConfigsNotificationConfigIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchNotificationConfigsNotificationConfigIDRequestWithBody(server, notificationConfigID, params, "application/json", bodyReader)
+}
+
+// NewPatchNotificationConfigsNotificationConfigIDRequestWithBody generates requests for PatchNotificationConfigsNotificationConfigID with any type of body
+func NewPatchNotificationConfigsNotificationConfigIDRequestWithBody(server string, notificationConfigID NotificationConfigID, params *PatchNotificationConfigsNotificationConfigIDParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "notificationConfigID", runtime.ParamLocationPath, notificationConfigID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/notificationConfigs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params.IfMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-Match", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewGetOperationsOperationIDRequest generates requests for GetOperationsOperationID
+func NewGetOperationsOperationIDRequest(server string, operationID OperationID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "operationID", runtime.ParamLocationPath, operationID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/operations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOperationsOperationIDResultRequest generates requests for GetOperationsOperationIDResult
+func NewGetOperationsOperationIDResultRequest(server string, operationID OperationID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "operationID", runtime.ParamLocationPath, operationID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/operations/%s/result", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPostureScoresRequest generates requests for GetPostureScores
+func NewGetPostureScoresRequest(server string, params *GetPostureScoresParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/postureScores")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Filter != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Select != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Count != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$count", runtime.ParamLocationQuery, *params.Count); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Top != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$top", runtime.ParamLocationQuery, *params.Top); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Skip != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.OrderBy != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$orderby", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProviderOperationsRequest generates requests for GetProviderOperations
+func NewGetProviderOperationsRequest(server string, params *GetProviderOperationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/providerOperations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Select != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Count != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$count", runtime.ParamLocationQuery, *params.Count); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Top != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$top", runtime.ParamLocationQuery, *params.Top); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Skip != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Expand != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$orderby", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostProviderOperationsRequest calls the generic PostProviderOperations builder with application/json body
+func NewPostProviderOperationsRequest(server string, body PostProviderOperationsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostProviderOperationsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostProviderOperationsRequestWithBody generates requests for PostProviderOperations with any type of body
+func NewPostProviderOperationsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/providerOperations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetProviderOperationsProviderOperationIDRequest generates requests for GetProviderOperationsProviderOperationID
+func NewGetProviderOperationsProviderOperationIDRequest(server string, providerOperationID ProviderOperationID, params *GetProviderOperationsProviderOperationIDParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "providerOperationID", runtime.ParamLocationPath, providerOperationID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/providerOperations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Select != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Expand != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProvidersRequest generates requests for GetProviders
+func NewGetProvidersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/providers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetReportSchedulesRequest generates requests for GetReportSchedules
+func NewGetReportSchedulesRequest(server string, params *GetReportSchedulesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/reportSchedules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Select != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Count != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$count", runtime.ParamLocationQuery, *params.Count); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Top != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$top", runtime.ParamLocationQuery, *params.Top); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Skip != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$orderby", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostReportSchedulesRequest calls the generic PostReportSchedules builder with application/json body
+func NewPostReportSchedulesRequest(server string, body PostReportSchedulesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostReportSchedulesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostReportSchedulesRequestWithBody generates requests for PostReportSchedules with any type of body
+func NewPostReportSchedulesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/reportSchedules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteReportSchedulesReportScheduleIDRequest generates requests for DeleteReportSchedulesReportScheduleID
+func NewDeleteReportSchedulesReportScheduleIDRequest(server string, reportScheduleID ReportScheduleID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "reportScheduleID", runtime.ParamLocationPath, reportScheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/reportSchedules/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetReportSchedulesReportScheduleIDRequest generates requests for GetReportSchedulesReportScheduleID
+func NewGetReportSchedulesReportScheduleIDRequest(server string, reportScheduleID ReportScheduleID, params *GetReportSchedulesReportScheduleIDParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "reportScheduleID", runtime.ParamLocationPath, reportScheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/reportSchedules/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Select != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchReportSchedulesReportScheduleIDRequest calls the generic PatchReportSchedulesReportScheduleID builder with application/json body
+func NewPatchReportSchedulesReportScheduleIDRequest(server string, reportScheduleID ReportScheduleID, params *PatchReportSchedulesReportScheduleIDParams, body PatchReportSchedulesReportScheduleIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchReportSchedulesReportScheduleIDRequestWithBody(server, reportScheduleID, params, "application/json", bodyReader)
+}
+
+// NewPatchReportSchedulesReportScheduleIDRequestWithBody generates requests for PatchReportSchedulesReportScheduleID with any type of body
+func NewPatchReportSchedulesReportScheduleIDRequestWithBody(server string, reportScheduleID ReportScheduleID, params *PatchReportSchedulesReportScheduleIDParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "reportScheduleID", runtime.ParamLocationPath, reportScheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/reportSchedules/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params.IfMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-Match", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewGetReportSchedulesReportScheduleIDReportRequest generates requests for GetReportSchedulesReportScheduleIDReport
+func NewGetReportSchedulesReportScheduleIDReportRequest(server string, reportScheduleID ReportScheduleID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "reportScheduleID", runtime.ParamLocationPath, reportScheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/reportSchedules/%s/report", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSbomsRequest calls the generic PostSboms builder with application/json body
+func NewPostSbomsRequest(server string, params *PostSbomsParams, body PostSbomsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSbomsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostSbomsRequestWithBody generates requests for PostSboms with any type of body
+func NewPostSbomsRequestWithBody(server string, params *PostSbomsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sboms")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, params.Name); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Version != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "version", runtime.ParamLocationQuery, *params.Version); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Location != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "location", runtime.ParamLocationQuery, *params.Location); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetScanConfigTemplatesRequest generates requests for GetScanConfigTemplates
+func NewGetScanConfigTemplatesRequest(server string, params *GetScanConfigTemplatesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanConfigTemplates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Filter != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Select != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Count != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$count", runtime.ParamLocationQuery, *params.Count); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Top != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$top", runtime.ParamLocationQuery, *params.Top); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Skip != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.OrderBy != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$orderby", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostScanConfigTemplatesRequest calls the generic PostScanConfigTemplates builder with application/json body
+func NewPostScanConfigTemplatesRequest(server string, body PostScanConfigTemplatesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostScanConfigTemplatesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostScanConfigTemplatesRequestWithBody generates requests for PostScanConfigTemplates with any type of body
+func NewPostScanConfigTemplatesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanConfigTemplates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteScanConfigTemplatesScanConfigTemplateIDRequest generates requests for DeleteScanConfigTemplatesScanConfigTemplateID
+func NewDeleteScanConfigTemplatesScanConfigTemplateIDRequest(server string, scanConfigTemplateID ScanConfigTemplateID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanConfigTemplateID", runtime.ParamLocationPath, scanConfigTemplateID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanConfigTemplates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetScanConfigTemplatesScanConfigTemplateIDRequest generates requests for GetScanConfigTemplatesScanConfigTemplateID
+func NewGetScanConfigTemplatesScanConfigTemplateIDRequest(server string, scanConfigTemplateID ScanConfigTemplateID, params *GetScanConfigTemplatesScanConfigTemplateIDParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanConfigTemplateID", runtime.ParamLocationPath, scanConfigTemplateID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanConfigTemplates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Select != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchScanConfigTemplatesScanConfigTemplateIDRequest calls the generic PatchScanConfigTemplatesScanConfigTemplateID builder with application/json body
+func NewPatchScanConfigTemplatesScanConfigTemplateIDRequest(server string, scanConfigTemplateID ScanConfigTemplateID, params *PatchScanConfigTemplatesScanConfigTemplateIDParams, body PatchScanConfigTemplatesScanConfigTemplateIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchScanConfigTemplatesScanConfigTemplateIDRequestWithBody(server, scanConfigTemplateID, params, "application/json", bodyReader)
+}
+
+// NewPatchScanConfigTemplatesScanConfigTemplateIDRequestWithBody generates requests for PatchScanConfigTemplatesScanConfigTemplateID with any type of body
+func NewPatchScanConfigTemplatesScanConfigTemplateIDRequestWithBody(server string, scanConfigTemplateID ScanConfigTemplateID, params *PatchScanConfigTemplatesScanConfigTemplateIDParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanConfigTemplateID", runtime.ParamLocationPath, scanConfigTemplateID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanConfigTemplates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params.IfMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-Match", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewGetScanConfigsRequest generates requests for GetScanConfigs
+func NewGetScanConfigsRequest(server string, params *GetScanConfigsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanConfigs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Filter != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Search != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$search", runtime.ParamLocationQuery, *params.Search); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Select != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Count != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$count", runtime.ParamLocationQuery, *params.Count); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Top != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$top", runtime.ParamLocationQuery, *params.Top); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Skip != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Expand != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.OrderBy != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$orderby", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostScanConfigsRequest calls the generic PostScanConfigs builder with application/json body
+func NewPostScanConfigsRequest(server string, body PostScanConfigsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostScanConfigsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostScanConfigsRequestWithBody generates requests for PostScanConfigs with any type of body
+func NewPostScanConfigsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanConfigs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteScanConfigsScanConfigIDRequest generates requests for DeleteScanConfigsScanConfigID
+func NewDeleteScanConfigsScanConfigIDRequest(server string, scanConfigID ScanConfigID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanConfigID", runtime.ParamLocationPath, scanConfigID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanConfigs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetScanConfigsScanConfigIDRequest generates requests for GetScanConfigsScanConfigID
+func NewGetScanConfigsScanConfigIDRequest(server string, scanConfigID ScanConfigID, params *GetScanConfigsScanConfigIDParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanConfigID", runtime.ParamLocationPath, scanConfigID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanConfigs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Select != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Expand != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.IfNoneMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-None-Match", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewPatchScanConfigsScanConfigIDRequest calls the generic PatchScanConfigsScanConfigID builder with application/json body
+func NewPatchScanConfigsScanConfigIDRequest(server string, scanConfigID ScanConfigID, params *PatchScanConfigsScanConfigIDParams, body PatchScanConfigsScanConfigIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchScanConfigsScanConfigIDRequestWithBody(server, scanConfigID, params, "application/json", bodyReader)
+}
+
+// NewPatchScanConfigsScanConfigIDRequestWithBody generates requests for PatchScanConfigsScanConfigID with any type of body
+func NewPatchScanConfigsScanConfigIDRequestWithBody(server string, scanConfigID ScanConfigID, params *PatchScanConfigsScanConfigIDParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanConfigID", runtime.ParamLocationPath, scanConfigID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanConfigs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params.IfMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-Match", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewPutScanConfigsScanConfigIDRequest calls the generic PutScanConfigsScanConfigID builder with application/json body
+func NewPutScanConfigsScanConfigIDRequest(server string, scanConfigID ScanConfigID, params *PutScanConfigsScanConfigIDParams, body PutScanConfigsScanConfigIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutScanConfigsScanConfigIDRequestWithBody(server, scanConfigID, params, "application/json", bodyReader)
+}
+
+// NewPutScanConfigsScanConfigIDRequestWithBody generates requests for PutScanConfigsScanConfigID with any type of body
+func NewPutScanConfigsScanConfigIDRequestWithBody(server string, scanConfigID ScanConfigID, params *PutScanConfigsScanConfigIDParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanConfigID", runtime.ParamLocationPath, scanConfigID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanConfigs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params.IfMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-Match", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewGetScanConfigsScanConfigIDNextRunsRequest generates requests for GetScanConfigsScanConfigIDNextRuns
+func NewGetScanConfigsScanConfigIDNextRunsRequest(server string, scanConfigID ScanConfigID, params *GetScanConfigsScanConfigIDNextRunsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanConfigID", runtime.ParamLocationPath, scanConfigID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanConfigs/%s/nextRuns", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Count != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "count", runtime.ParamLocationQuery, *params.Count); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetScanResultsRequest generates requests for GetScanResults
+func NewGetScanResultsRequest(server string, params *GetScanResultsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanResults")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Filter != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Select != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Count != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$count", runtime.ParamLocationQuery, *params.Count); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Top != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$top", runtime.ParamLocationQuery, *params.Top); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Skip != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Expand != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.OrderBy != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$orderby", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostScanResultsRequest calls the generic PostScanResults builder with application/json body
+func NewPostScanResultsRequest(server string, body PostScanResultsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostScanResultsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostScanResultsRequestWithBody generates requests for PostScanResults with any type of body
+func NewPostScanResultsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanResults")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetScanResultsScanResultIDRequest generates requests for GetScanResultsScanResultID
+func NewGetScanResultsScanResultIDRequest(server string, scanResultID ScanResultID, params *GetScanResultsScanResultIDParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, scanResultID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanResults/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Select != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Expand != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.IfNoneMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-None-Match", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewPatchScanResultsScanResultIDRequest calls the generic PatchScanResultsScanResultID builder with application/json body
+func NewPatchScanResultsScanResultIDRequest(server string, scanResultID ScanResultID, params *PatchScanResultsScanResultIDParams, body PatchScanResultsScanResultIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchScanResultsScanResultIDRequestWithBody(server, scanResultID, params, "application/json", bodyReader)
+}
+
+// NewPatchScanResultsScanResultIDRequestWithBody generates requests for PatchScanResultsScanResultID with any type of body
+func NewPatchScanResultsScanResultIDRequestWithBody(server string, scanResultID ScanResultID, params *PatchScanResultsScanResultIDParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, scanResultID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanResults/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params.IfMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-Match", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewPutScanResultsScanResultIDRequest calls the generic PutScanResultsScanResultID builder with application/json body
+func NewPutScanResultsScanResultIDRequest(server string, scanResultID ScanResultID, params *PutScanResultsScanResultIDParams, body PutScanResultsScanResultIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutScanResultsScanResultIDRequestWithBody(server, scanResultID, params, "application/json", bodyReader)
+}
+
+// NewPutScanResultsScanResultIDRequestWithBody generates requests for PutScanResultsScanResultID with any type of body
+func NewPutScanResultsScanResultIDRequestWithBody(server string, scanResultID ScanResultID, params *PutScanResultsScanResultIDParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, scanResultID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanResults/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params.IfMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-Match", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewGetScanResultsScanResultIDFamiliesFamilyArtifactRequest generates requests for GetScanResultsScanResultIDFamiliesFamilyArtifact
+func NewGetScanResultsScanResultIDFamiliesFamilyArtifactRequest(server string, scanResultID ScanResultID, family ScanResultFamily) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, scanResultID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "family", runtime.ParamLocationPath, family)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanResults/%s/families/%s/artifact", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutScanResultsScanResultIDFamiliesFamilyArtifactRequestWithBody generates requests for PutScanResultsScanResultIDFamiliesFamilyArtifact with any type of body
+func NewPutScanResultsScanResultIDFamiliesFamilyArtifactRequestWithBody(server string, scanResultID ScanResultID, family ScanResultFamily, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, scanResultID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "family", runtime.ParamLocationPath, family)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanResults/%s/families/%s/artifact", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetScanResultsScanResultIDFamiliesFamilyItemsRequest generates requests for GetScanResultsScanResultIDFamiliesFamilyItems
+func NewGetScanResultsScanResultIDFamiliesFamilyItemsRequest(server string, scanResultID ScanResultID, family ScanResultFamily, params *GetScanResultsScanResultIDFamiliesFamilyItemsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, scanResultID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "family", runtime.ParamLocationPath, family)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanResults/%s/families/%s/items", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Top != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$top", runtime.ParamLocationQuery, *params.Top); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Skip != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostScanResultsScanResultIDFamiliesFamilyItemsRequest calls the generic PostScanResultsScanResultIDFamiliesFamilyItems builder with application/json body
+func NewPostScanResultsScanResultIDFamiliesFamilyItemsRequest(server string, scanResultID ScanResultID, family ScanResultFamily, body PostScanResultsScanResultIDFamiliesFamilyItemsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostScanResultsScanResultIDFamiliesFamilyItemsRequestWithBody(server, scanResultID, family, "application/json", bodyReader)
+}
+
+// NewPostScanResultsScanResultIDFamiliesFamilyItemsRequestWithBody generates requests for PostScanResultsScanResultIDFamiliesFamilyItems with any type of body
+func NewPostScanResultsScanResultIDFamiliesFamilyItemsRequestWithBody(server string, scanResultID ScanResultID, family ScanResultFamily, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, scanResultID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "family", runtime.ParamLocationPath, family)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanResults/%s/families/%s/items", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostScanResultsScanResultIDRerunRequest generates requests for PostScanResultsScanResultIDRerun
+func NewPostScanResultsScanResultIDRerunRequest(server string, scanResultID ScanResultID, params *PostScanResultsScanResultIDRerunParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, scanResultID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanResults/%s/rerun", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", false, "families", runtime.ParamLocationQuery, params.Families); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetScanResultsScanResultIDSbomRequest generates requests for GetScanResultsScanResultIDSbom
+func NewGetScanResultsScanResultIDSbomRequest(server string, scanResultID ScanResultID, params *GetScanResultsScanResultIDSbomParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, scanResultID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanResults/%s/sbom", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, params.Format); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetScanResultsScanResultIDScannerConfigRequest generates requests for GetScanResultsScanResultIDScannerConfig
+func NewGetScanResultsScanResultIDScannerConfigRequest(server string, scanResultID ScanResultID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, scanResultID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanResults/%s/scannerConfig", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutScanResultsScanResultIDScannerConfigRequest calls the generic PutScanResultsScanResultIDScannerConfig builder with application/json body
+func NewPutScanResultsScanResultIDScannerConfigRequest(server string, scanResultID ScanResultID, body PutScanResultsScanResultIDScannerConfigJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutScanResultsScanResultIDScannerConfigRequestWithBody(server, scanResultID, "application/json", bodyReader)
+}
+
+// NewPutScanResultsScanResultIDScannerConfigRequestWithBody generates requests for PutScanResultsScanResultIDScannerConfig with any type of body
+func NewPutScanResultsScanResultIDScannerConfigRequestWithBody(server string, scanResultID ScanResultID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, scanResultID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scanResults/%s/scannerConfig", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetScansRequest generates requests for GetScans
+func NewGetScansRequest(server string, params *GetScansParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scans")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Filter != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Search != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$search", runtime.ParamLocationQuery, *params.Search); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Select != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Count != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$count", runtime.ParamLocationQuery, *params.Count); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Top != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$top", runtime.ParamLocationQuery, *params.Top); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Skip != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Expand != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.OrderBy != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$orderby", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostScansRequest calls the generic PostScans builder with application/json body
+func NewPostScansRequest(server string, body PostScansJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostScansRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostScansRequestWithBody generates requests for PostScans with any type of body
+func NewPostScansRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scans")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteScansScanIDRequest generates requests for DeleteScansScanID
+func NewDeleteScansScanIDRequest(server string, scanID ScanID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanID", runtime.ParamLocationPath, scanID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scans/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetScansScanIDRequest generates requests for GetScansScanID
+func NewGetScansScanIDRequest(server string, scanID ScanID, params *GetScansScanIDParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanID", runtime.ParamLocationPath, scanID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scans/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Select != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Expand != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$expand", runtime.ParamLocationQuery, *params.Expand); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.IfNoneMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-None-Match", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewPatchScansScanIDRequest calls the generic PatchScansScanID builder with application/json body
+func NewPatchScansScanIDRequest(server string, scanID ScanID, params *PatchScansScanIDParams, body PatchScansScanIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchScansScanIDRequestWithBody(server, scanID, params, "application/json", bodyReader)
+}
+
+// NewPatchScansScanIDRequestWithBody generates requests for PatchScansScanID with any type of body
+func NewPatchScansScanIDRequestWithBody(server string, scanID ScanID, params *PatchScansScanIDParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanID", runtime.ParamLocationPath, scanID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scans/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params.IfMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-Match", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewPutScansScanIDRequest calls the generic PutScansScanID builder with application/json body
+func NewPutScansScanIDRequest(server string, scanID ScanID, params *PutScansScanIDParams, body PutScansScanIDJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutScansScanIDRequestWithBody(server, scanID, params, "application/json", bodyReader)
+}
+
+// NewPutScansScanIDRequestWithBody generates requests for PutScansScanID with any type of body
+func NewPutScansScanIDRequestWithBody(server string, scanID ScanID, params *PutScansScanIDParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanID", runtime.ParamLocationPath, scanID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scans/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params.IfMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-Match", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewGetScansScanIDProgressRequest generates requests for GetScansScanIDProgress
+func NewGetScansScanIDProgressRequest(server string, scanID ScanID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "scanID", runtime.ParamLocationPath, scanID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scans/%s/progress", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSettingsFindingTemplatesRequest generates requests for GetSettingsFindingTemplates
+func NewGetSettingsFindingTemplatesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/settings/findingTemplates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutSettingsFindingTemplatesRequest calls the generic PutSettingsFindingTemplates builder with application/json body
+func NewPutSettingsFindingTemplatesRequest(server string, body PutSettingsFindingTemplatesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutSettingsFindingTemplatesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPutSettingsFindingTemplatesRequestWithBody generates requests for PutSettingsFindingTemplates with any type of body
+func NewPutSettingsFindingTemplatesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/settings/findingTemplates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostSettingsFindingTemplatesPreviewRequest calls the generic PostSettingsFindingTemplatesPreview builder with application/json body
+func NewPostSettingsFindingTemplatesPreviewRequest(server string, body PostSettingsFindingTemplatesPreviewJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSettingsFindingTemplatesPreviewRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostSettingsFindingTemplatesPreviewRequestWithBody generates requests for PostSettingsFindingTemplatesPreview with any type of body
+func NewPostSettingsFindingTemplatesPreviewRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/settings/findingTemplates/preview")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSettingsRetentionRequest generates requests for GetSettingsRetention
+func NewGetSettingsRetentionRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/settings/retention")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutSettingsRetentionRequest calls the generic PutSettingsRetention builder with application/json body
+func NewPutSettingsRetentionRequest(server string, body PutSettingsRetentionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutSettingsRetentionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPutSettingsRetentionRequestWithBody generates requests for PutSettingsRetention with any type of body
+func NewPutSettingsRetentionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/settings/retention")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUserPreferencesRequest generates requests for GetUserPreferences
+func NewGetUserPreferencesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/userPreferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutUserPreferencesRequest calls the generic PutUserPreferences builder with application/json body
+func NewPutUserPreferencesRequest(server string, body PutUserPreferencesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutUserPreferencesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPutUserPreferencesRequestWithBody generates requests for PutUserPreferences with any type of body
+func NewPutUserPreferencesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/userPreferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAdminFeeds request
+	GetAdminFeedsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAdminFeedsResponse, error)
+
+	// PostAdminFeedsRefresh request
+	PostAdminFeedsRefreshWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostAdminFeedsRefreshResponse, error)
+
+	// GetAdminMaintenance request
+	GetAdminMaintenanceWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAdminMaintenanceResponse, error)
+
+	// PutAdminMaintenance request with any body
+	PutAdminMaintenanceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminMaintenanceResponse, error)
+
+	PutAdminMaintenanceWithResponse(ctx context.Context, body PutAdminMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminMaintenanceResponse, error)
+
+	// GetAdminTasks request
+	GetAdminTasksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAdminTasksResponse, error)
+
+	// PostAdminTasksTaskNameRun request
+	PostAdminTasksTaskNameRunWithResponse(ctx context.Context, taskName TaskName, reqEditors ...RequestEditorFn) (*PostAdminTasksTaskNameRunResponse, error)
+
+	// GetAdminUsage request
+	GetAdminUsageWithResponse(ctx context.Context, params *GetAdminUsageParams, reqEditors ...RequestEditorFn) (*GetAdminUsageResponse, error)
+
+	// GetAPIKeys request
+	GetAPIKeysWithResponse(ctx context.Context, params *GetAPIKeysParams, reqEditors ...RequestEditorFn) (*GetAPIKeysResponse, error)
+
+	// PostAPIKeys request with any body
+	PostAPIKeysWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIKeysResponse, error)
+
+	PostAPIKeysWithResponse(ctx context.Context, body PostAPIKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIKeysResponse, error)
+
+	// DeleteAPIKeysAPIKeyID request
+	DeleteAPIKeysAPIKeyIDWithResponse(ctx context.Context, apiKeyID ApiKeyID, reqEditors ...RequestEditorFn) (*DeleteAPIKeysAPIKeyIDResponse, error)
+
+	// GetAPIKeysAPIKeyID request
+	GetAPIKeysAPIKeyIDWithResponse(ctx context.Context, apiKeyID ApiKeyID, params *GetAPIKeysAPIKeyIDParams, reqEditors ...RequestEditorFn) (*GetAPIKeysAPIKeyIDResponse, error)
+
+	// GetAssetGroups request
+	GetAssetGroupsWithResponse(ctx context.Context, params *GetAssetGroupsParams, reqEditors ...RequestEditorFn) (*GetAssetGroupsResponse, error)
+
+	// PostAssetGroups request with any body
+	PostAssetGroupsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAssetGroupsResponse, error)
+
+	PostAssetGroupsWithResponse(ctx context.Context, body PostAssetGroupsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAssetGroupsResponse, error)
+
+	// DeleteAssetGroupsAssetGroupID request
+	DeleteAssetGroupsAssetGroupIDWithResponse(ctx context.Context, assetGroupID AssetGroupID, reqEditors ...RequestEditorFn) (*DeleteAssetGroupsAssetGroupIDResponse, error)
+
+	// GetAssetGroupsAssetGroupID request
+	GetAssetGroupsAssetGroupIDWithResponse(ctx context.Context, assetGroupID AssetGroupID, params *GetAssetGroupsAssetGroupIDParams, reqEditors ...RequestEditorFn) (*GetAssetGroupsAssetGroupIDResponse, error)
+
+	// PatchAssetGroupsAssetGroupID request with any body
+	PatchAssetGroupsAssetGroupIDWithBodyWithResponse(ctx context.Context, assetGroupID AssetGroupID, params *PatchAssetGroupsAssetGroupIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchAssetGroupsAssetGroupIDResponse, error)
+
+	PatchAssetGroupsAssetGroupIDWithResponse(ctx context.Context, assetGroupID AssetGroupID, params *PatchAssetGroupsAssetGroupIDParams, body PatchAssetGroupsAssetGroupIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchAssetGroupsAssetGroupIDResponse, error)
+
+	// GetAssetGroupsAssetGroupIDAssets request
+	GetAssetGroupsAssetGroupIDAssetsWithResponse(ctx context.Context, assetGroupID AssetGroupID, params *GetAssetGroupsAssetGroupIDAssetsParams, reqEditors ...RequestEditorFn) (*GetAssetGroupsAssetGroupIDAssetsResponse, error)
+
+	// GetAssets request
+	GetAssetsWithResponse(ctx context.Context, params *GetAssetsParams, reqEditors ...RequestEditorFn) (*GetAssetsResponse, error)
+
+	// PostAssets request with any body
+	PostAssetsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAssetsResponse, error)
+
+	PostAssetsWithResponse(ctx context.Context, body PostAssetsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAssetsResponse, error)
+
+	// DeleteAssetsAssetID request
+	DeleteAssetsAssetIDWithResponse(ctx context.Context, assetID AssetID, reqEditors ...RequestEditorFn) (*DeleteAssetsAssetIDResponse, error)
+
+	// GetAssetsAssetID request
+	GetAssetsAssetIDWithResponse(ctx context.Context, assetID AssetID, params *GetAssetsAssetIDParams, reqEditors ...RequestEditorFn) (*GetAssetsAssetIDResponse, error)
+
+	// PatchAssetsAssetID request with any body
+	PatchAssetsAssetIDWithBodyWithResponse(ctx context.Context, assetID AssetID, params *PatchAssetsAssetIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchAssetsAssetIDResponse, error)
+
+	PatchAssetsAssetIDWithResponse(ctx context.Context, assetID AssetID, params *PatchAssetsAssetIDParams, body PatchAssetsAssetIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchAssetsAssetIDResponse, error)
+
+	// PutAssetsAssetID request with any body
+	PutAssetsAssetIDWithBodyWithResponse(ctx context.Context, assetID AssetID, params *PutAssetsAssetIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAssetsAssetIDResponse, error)
+
+	PutAssetsAssetIDWithResponse(ctx context.Context, assetID AssetID, params *PutAssetsAssetIDParams, body PutAssetsAssetIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAssetsAssetIDResponse, error)
+
+	// GetAssetsAssetIDPackages request
+	GetAssetsAssetIDPackagesWithResponse(ctx context.Context, assetID AssetID, reqEditors ...RequestEditorFn) (*GetAssetsAssetIDPackagesResponse, error)
+
+	// GetAssetsAssetIDSbomDiff request
+	GetAssetsAssetIDSbomDiffWithResponse(ctx context.Context, assetID AssetID, params *GetAssetsAssetIDSbomDiffParams, reqEditors ...RequestEditorFn) (*GetAssetsAssetIDSbomDiffResponse, error)
+
+	// GetAssetsAssetIDScanResultDiff request
+	GetAssetsAssetIDScanResultDiffWithResponse(ctx context.Context, assetID AssetID, params *GetAssetsAssetIDScanResultDiffParams, reqEditors ...RequestEditorFn) (*GetAssetsAssetIDScanResultDiffResponse, error)
+
+	// GetAssetsAssetIDUpgradePlan request
+	GetAssetsAssetIDUpgradePlanWithResponse(ctx context.Context, assetID AssetID, params *GetAssetsAssetIDUpgradePlanParams, reqEditors ...RequestEditorFn) (*GetAssetsAssetIDUpgradePlanResponse, error)
+
+	// GetCorrelatedFindings request
+	GetCorrelatedFindingsWithResponse(ctx context.Context, params *GetCorrelatedFindingsParams, reqEditors ...RequestEditorFn) (*GetCorrelatedFindingsResponse, error)
+
+	// GetCorrelatedFindingsCorrelatedFindingID request
+	GetCorrelatedFindingsCorrelatedFindingIDWithResponse(ctx context.Context, correlatedFindingID CorrelatedFindingID, params *GetCorrelatedFindingsCorrelatedFindingIDParams, reqEditors ...RequestEditorFn) (*GetCorrelatedFindingsCorrelatedFindingIDResponse, error)
+
+	// GetDiscoveryScopes request
+	GetDiscoveryScopesWithResponse(ctx context.Context, params *GetDiscoveryScopesParams, reqEditors ...RequestEditorFn) (*GetDiscoveryScopesResponse, error)
+
+	// PutDiscoveryScopes request with any body
+	PutDiscoveryScopesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutDiscoveryScopesResponse, error)
+
+	PutDiscoveryScopesWithResponse(ctx context.Context, body PutDiscoveryScopesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutDiscoveryScopesResponse, error)
+
+	// GetFeedsFeedName request
+	GetFeedsFeedNameWithResponse(ctx context.Context, feedName FeedName, params *GetFeedsFeedNameParams, reqEditors ...RequestEditorFn) (*GetFeedsFeedNameResponse, error)
+
+	// GetFindingDigests request
+	GetFindingDigestsWithResponse(ctx context.Context, params *GetFindingDigestsParams, reqEditors ...RequestEditorFn) (*GetFindingDigestsResponse, error)
+
+	// PostFindingDigests request with any body
+	PostFindingDigestsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFindingDigestsResponse, error)
+
+	PostFindingDigestsWithResponse(ctx context.Context, body PostFindingDigestsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFindingDigestsResponse, error)
+
+	// DeleteFindingDigestsFindingDigestID request
+	DeleteFindingDigestsFindingDigestIDWithResponse(ctx context.Context, findingDigestID FindingDigestID, reqEditors ...RequestEditorFn) (*DeleteFindingDigestsFindingDigestIDResponse, error)
+
+	// GetFindingDigestsFindingDigestID request
+	GetFindingDigestsFindingDigestIDWithResponse(ctx context.Context, findingDigestID FindingDigestID, params *GetFindingDigestsFindingDigestIDParams, reqEditors ...RequestEditorFn) (*GetFindingDigestsFindingDigestIDResponse, error)
+
+	// PatchFindingDigestsFindingDigestID request with any body
+	PatchFindingDigestsFindingDigestIDWithBodyWithResponse(ctx context.Context, findingDigestID FindingDigestID, params *PatchFindingDigestsFindingDigestIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchFindingDigestsFindingDigestIDResponse, error)
+
+	PatchFindingDigestsFindingDigestIDWithResponse(ctx context.Context, findingDigestID FindingDigestID, params *PatchFindingDigestsFindingDigestIDParams, body PatchFindingDigestsFindingDigestIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchFindingDigestsFindingDigestIDResponse, error)
+
+	// GetFindingExceptions request
+	GetFindingExceptionsWithResponse(ctx context.Context, params *GetFindingExceptionsParams, reqEditors ...RequestEditorFn) (*GetFindingExceptionsResponse, error)
+
+	// PostFindingExceptions request with any body
+	PostFindingExceptionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFindingExceptionsResponse, error)
+
+	PostFindingExceptionsWithResponse(ctx context.Context, body PostFindingExceptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFindingExceptionsResponse, error)
+
+	// DeleteFindingExceptionsFindingExceptionID request
+	DeleteFindingExceptionsFindingExceptionIDWithResponse(ctx context.Context, findingExceptionID FindingExceptionID, reqEditors ...RequestEditorFn) (*DeleteFindingExceptionsFindingExceptionIDResponse, error)
+
+	// GetFindingExceptionsFindingExceptionID request
+	GetFindingExceptionsFindingExceptionIDWithResponse(ctx context.Context, findingExceptionID FindingExceptionID, params *GetFindingExceptionsFindingExceptionIDParams, reqEditors ...RequestEditorFn) (*GetFindingExceptionsFindingExceptionIDResponse, error)
+
+	// PatchFindingExceptionsFindingExceptionID request with any body
+	PatchFindingExceptionsFindingExceptionIDWithBodyWithResponse(ctx context.Context, findingExceptionID FindingExceptionID, params *PatchFindingExceptionsFindingExceptionIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchFindingExceptionsFindingExceptionIDResponse, error)
+
+	PatchFindingExceptionsFindingExceptionIDWithResponse(ctx context.Context, findingExceptionID FindingExceptionID, params *PatchFindingExceptionsFindingExceptionIDParams, body PatchFindingExceptionsFindingExceptionIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchFindingExceptionsFindingExceptionIDResponse, error)
+
+	// GetFindings request
+	GetFindingsWithResponse(ctx context.Context, params *GetFindingsParams, reqEditors ...RequestEditorFn) (*GetFindingsResponse, error)
+
+	// PostFindings request with any body
+	PostFindingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFindingsResponse, error)
+
+	PostFindingsWithResponse(ctx context.Context, body PostFindingsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFindingsResponse, error)
+
+	// PatchFindingsBulk request with any body
+	PatchFindingsBulkWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchFindingsBulkResponse, error)
+
+	PatchFindingsBulkWithResponse(ctx context.Context, body PatchFindingsBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchFindingsBulkResponse, error)
+
+	// PostFindingsBulk request with any body
+	PostFindingsBulkWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFindingsBulkResponse, error)
+
+	PostFindingsBulkWithResponse(ctx context.Context, body PostFindingsBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFindingsBulkResponse, error)
+
+	// DeleteFindingsFindingID request
+	DeleteFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, reqEditors ...RequestEditorFn) (*DeleteFindingsFindingIDResponse, error)
+
+	// GetFindingsFindingID request
+	GetFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, params *GetFindingsFindingIDParams, reqEditors ...RequestEditorFn) (*GetFindingsFindingIDResponse, error)
+
+	// PatchFindingsFindingID request with any body
+	PatchFindingsFindingIDWithBodyWithResponse(ctx context.Context, findingID FindingID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchFindingsFindingIDResponse, error)
+
+	PatchFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, body PatchFindingsFindingIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchFindingsFindingIDResponse, error)
+
+	// PutFindingsFindingID request with any body
+	PutFindingsFindingIDWithBodyWithResponse(ctx context.Context, findingID FindingID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutFindingsFindingIDResponse, error)
+
+	PutFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, body PutFindingsFindingIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutFindingsFindingIDResponse, error)
+
+	// PostFindingsFindingIDScannerSuppression request with any body
+	PostFindingsFindingIDScannerSuppressionWithBodyWithResponse(ctx context.Context, findingID FindingID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFindingsFindingIDScannerSuppressionResponse, error)
+
+	PostFindingsFindingIDScannerSuppressionWithResponse(ctx context.Context, findingID FindingID, body PostFindingsFindingIDScannerSuppressionJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFindingsFindingIDScannerSuppressionResponse, error)
+
+	// GetNotificationConfigs request
+	GetNotificationConfigsWithResponse(ctx context.Context, params *GetNotificationConfigsParams, reqEditors ...RequestEditorFn) (*GetNotificationConfigsResponse, error)
+
+	// PostNotificationConfigs request with any body
+	PostNotificationConfigsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostNotificationConfigsResponse, error)
+
+	PostNotificationConfigsWithResponse(ctx context.Context, body PostNotificationConfigsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostNotificationConfigsResponse, error)
+
+	// DeleteNotificationConfigsNotificationConfigID request
+	DeleteNotificationConfigsNotificationConfigIDWithResponse(ctx context.Context, notificationConfigID NotificationConfigID, reqEditors ...RequestEditorFn) (*DeleteNotificationConfigsNotificationConfigIDResponse, error)
+
+	// GetNotificationConfigsNotificationConfigID request
+	GetNotificationConfigsNotificationConfigIDWithResponse(ctx context.Context, notificationConfigID NotificationConfigID, params *GetNotificationConfigsNotificationConfigIDParams, reqEditors ...RequestEditorFn) (*GetNotificationConfigsNotificationConfigIDResponse, error)
+
+	// PatchNotificationConfigsNotificationConfigID request with any body
+	PatchNotificationConfigsNotificationConfigIDWithBodyWithResponse(ctx context.Context, notificationConfigID NotificationConfigID, params *PatchNotificationConfigsNotificationConfigIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchNotificationConfigsNotificationConfigIDResponse, error)
+
+	PatchNotificationConfigsNotificationConfigIDWithResponse(ctx context.Context, notificationConfigID NotificationConfigID, params *PatchNotificationConfigsNotificationConfigIDParams, body PatchNotificationConfigsNotificationConfigIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchNotificationConfigsNotificationConfigIDResponse, error)
+
+	// GetOperationsOperationID request
+	GetOperationsOperationIDWithResponse(ctx context.Context, operationID OperationID, reqEditors ...RequestEditorFn) (*GetOperationsOperationIDResponse, error)
+
+	// GetOperationsOperationIDResult request
+	GetOperationsOperationIDResultWithResponse(ctx context.Context, operationID OperationID, reqEditors ...RequestEditorFn) (*GetOperationsOperationIDResultResponse, error)
+
+	// GetPostureScores request
+	GetPostureScoresWithResponse(ctx context.Context, params *GetPostureScoresParams, reqEditors ...RequestEditorFn) (*GetPostureScoresResponse, error)
+
+	// GetProviderOperations request
+	GetProviderOperationsWithResponse(ctx context.Context, params *GetProviderOperationsParams, reqEditors ...RequestEditorFn) (*GetProviderOperationsResponse, error)
+
+	// PostProviderOperations request with any body
+	PostProviderOperationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostProviderOperationsResponse, error)
+
+	PostProviderOperationsWithResponse(ctx context.Context, body PostProviderOperationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostProviderOperationsResponse, error)
+
+	// GetProviderOperationsProviderOperationID request
+	GetProviderOperationsProviderOperationIDWithResponse(ctx context.Context, providerOperationID ProviderOperationID, params *GetProviderOperationsProviderOperationIDParams, reqEditors ...RequestEditorFn) (*GetProviderOperationsProviderOperationIDResponse, error)
+
+	// GetProviders request
+	GetProvidersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetProvidersResponse, error)
+
+	// GetReportSchedules request
+	GetReportSchedulesWithResponse(ctx context.Context, params *GetReportSchedulesParams, reqEditors ...RequestEditorFn) (*GetReportSchedulesResponse, error)
+
+	// PostReportSchedules request with any body
+	PostReportSchedulesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostReportSchedulesResponse, error)
+
+	PostReportSchedulesWithResponse(ctx context.Context, body PostReportSchedulesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostReportSchedulesResponse, error)
+
+	// DeleteReportSchedulesReportScheduleID request
+	DeleteReportSchedulesReportScheduleIDWithResponse(ctx context.Context, reportScheduleID ReportScheduleID, reqEditors ...RequestEditorFn) (*DeleteReportSchedulesReportScheduleIDResponse, error)
+
+	// GetReportSchedulesReportScheduleID request
+	GetReportSchedulesReportScheduleIDWithResponse(ctx context.Context, reportScheduleID ReportScheduleID, params *GetReportSchedulesReportScheduleIDParams, reqEditors ...RequestEditorFn) (*GetReportSchedulesReportScheduleIDResponse, error)
+
+	// PatchReportSchedulesReportScheduleID request with any body
+	PatchReportSchedulesReportScheduleIDWithBodyWithResponse(ctx context.Context, reportScheduleID ReportScheduleID, params *PatchReportSchedulesReportScheduleIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchReportSchedulesReportScheduleIDResponse, error)
+
+	PatchReportSchedulesReportScheduleIDWithResponse(ctx context.Context, reportScheduleID ReportScheduleID, params *PatchReportSchedulesReportScheduleIDParams, body PatchReportSchedulesReportScheduleIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchReportSchedulesReportScheduleIDResponse, error)
+
+	// GetReportSchedulesReportScheduleIDReport request
+	GetReportSchedulesReportScheduleIDReportWithResponse(ctx context.Context, reportScheduleID ReportScheduleID, reqEditors ...RequestEditorFn) (*GetReportSchedulesReportScheduleIDReportResponse, error)
+
+	// PostSboms request with any body
+	PostSbomsWithBodyWithResponse(ctx context.Context, params *PostSbomsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSbomsResponse, error)
+
+	PostSbomsWithResponse(ctx context.Context, params *PostSbomsParams, body PostSbomsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSbomsResponse, error)
+
+	// GetScanConfigTemplates request
+	GetScanConfigTemplatesWithResponse(ctx context.Context, params *GetScanConfigTemplatesParams, reqEditors ...RequestEditorFn) (*GetScanConfigTemplatesResponse, error)
+
+	// PostScanConfigTemplates request with any body
+	PostScanConfigTemplatesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScanConfigTemplatesResponse, error)
+
+	PostScanConfigTemplatesWithResponse(ctx context.Context, body PostScanConfigTemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScanConfigTemplatesResponse, error)
+
+	// DeleteScanConfigTemplatesScanConfigTemplateID request
+	DeleteScanConfigTemplatesScanConfigTemplateIDWithResponse(ctx context.Context, scanConfigTemplateID ScanConfigTemplateID, reqEditors ...RequestEditorFn) (*DeleteScanConfigTemplatesScanConfigTemplateIDResponse, error)
+
+	// GetScanConfigTemplatesScanConfigTemplateID request
+	GetScanConfigTemplatesScanConfigTemplateIDWithResponse(ctx context.Context, scanConfigTemplateID ScanConfigTemplateID, params *GetScanConfigTemplatesScanConfigTemplateIDParams, reqEditors ...RequestEditorFn) (*GetScanConfigTemplatesScanConfigTemplateIDResponse, error)
+
+	// PatchScanConfigTemplatesScanConfigTemplateID request with any body
+	PatchScanConfigTemplatesScanConfigTemplateIDWithBodyWithResponse(ctx context.Context, scanConfigTemplateID ScanConfigTemplateID, params *PatchScanConfigTemplatesScanConfigTemplateIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScanConfigTemplatesScanConfigTemplateIDResponse, error)
+
+	PatchScanConfigTemplatesScanConfigTemplateIDWithResponse(ctx context.Context, scanConfigTemplateID ScanConfigTemplateID, params *PatchScanConfigTemplatesScanConfigTemplateIDParams, body PatchScanConfigTemplatesScanConfigTemplateIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScanConfigTemplatesScanConfigTemplateIDResponse, error)
+
+	// GetScanConfigs request
+	GetScanConfigsWithResponse(ctx context.Context, params *GetScanConfigsParams, reqEditors ...RequestEditorFn) (*GetScanConfigsResponse, error)
+
+	// PostScanConfigs request with any body
+	PostScanConfigsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScanConfigsResponse, error)
+
+	PostScanConfigsWithResponse(ctx context.Context, body PostScanConfigsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScanConfigsResponse, error)
+
+	// DeleteScanConfigsScanConfigID request
+	DeleteScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, reqEditors ...RequestEditorFn) (*DeleteScanConfigsScanConfigIDResponse, error)
+
+	// GetScanConfigsScanConfigID request
+	GetScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *GetScanConfigsScanConfigIDParams, reqEditors ...RequestEditorFn) (*GetScanConfigsScanConfigIDResponse, error)
+
+	// PatchScanConfigsScanConfigID request with any body
+	PatchScanConfigsScanConfigIDWithBodyWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *PatchScanConfigsScanConfigIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScanConfigsScanConfigIDResponse, error)
+
+	PatchScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *PatchScanConfigsScanConfigIDParams, body PatchScanConfigsScanConfigIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScanConfigsScanConfigIDResponse, error)
+
+	// PutScanConfigsScanConfigID request with any body
+	PutScanConfigsScanConfigIDWithBodyWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *PutScanConfigsScanConfigIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScanConfigsScanConfigIDResponse, error)
+
+	PutScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *PutScanConfigsScanConfigIDParams, body PutScanConfigsScanConfigIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScanConfigsScanConfigIDResponse, error)
+
+	// GetScanConfigsScanConfigIDNextRuns request
+	GetScanConfigsScanConfigIDNextRunsWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *GetScanConfigsScanConfigIDNextRunsParams, reqEditors ...RequestEditorFn) (*GetScanConfigsScanConfigIDNextRunsResponse, error)
+
+	// GetScanResults request
+	GetScanResultsWithResponse(ctx context.Context, params *GetScanResultsParams, reqEditors ...RequestEditorFn) (*GetScanResultsResponse, error)
+
+	// PostScanResults request with any body
+	PostScanResultsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScanResultsResponse, error)
+
+	PostScanResultsWithResponse(ctx context.Context, body PostScanResultsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScanResultsResponse, error)
+
+	// GetScanResultsScanResultID request
+	GetScanResultsScanResultIDWithResponse(ctx context.Context, scanResultID ScanResultID, params *GetScanResultsScanResultIDParams, reqEditors ...RequestEditorFn) (*GetScanResultsScanResultIDResponse, error)
+
+	// PatchScanResultsScanResultID request with any body
+	PatchScanResultsScanResultIDWithBodyWithResponse(ctx context.Context, scanResultID ScanResultID, params *PatchScanResultsScanResultIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScanResultsScanResultIDResponse, error)
+
+	PatchScanResultsScanResultIDWithResponse(ctx context.Context, scanResultID ScanResultID, params *PatchScanResultsScanResultIDParams, body PatchScanResultsScanResultIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScanResultsScanResultIDResponse, error)
+
+	// PutScanResultsScanResultID request with any body
+	PutScanResultsScanResultIDWithBodyWithResponse(ctx context.Context, scanResultID ScanResultID, params *PutScanResultsScanResultIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScanResultsScanResultIDResponse, error)
+
+	PutScanResultsScanResultIDWithResponse(ctx context.Context, scanResultID ScanResultID, params *PutScanResultsScanResultIDParams, body PutScanResultsScanResultIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScanResultsScanResultIDResponse, error)
+
+	// GetScanResultsScanResultIDFamiliesFamilyArtifact request
+	GetScanResultsScanResultIDFamiliesFamilyArtifactWithResponse(ctx context.Context, scanResultID ScanResultID, family ScanResultFamily, reqEditors ...RequestEditorFn) (*GetScanResultsScanResultIDFamiliesFamilyArtifactResponse, error)
+
+	// PutScanResultsScanResultIDFamiliesFamilyArtifact request with any body
+	PutScanResultsScanResultIDFamiliesFamilyArtifactWithBodyWithResponse(ctx context.Context, scanResultID ScanResultID, family ScanResultFamily, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScanResultsScanResultIDFamiliesFamilyArtifactResponse, error)
+
+	// GetScanResultsScanResultIDFamiliesFamilyItems request
+	GetScanResultsScanResultIDFamiliesFamilyItemsWithResponse(ctx context.Context, scanResultID ScanResultID, family ScanResultFamily, params *GetScanResultsScanResultIDFamiliesFamilyItemsParams, reqEditors ...RequestEditorFn) (*GetScanResultsScanResultIDFamiliesFamilyItemsResponse, error)
+
+	// PostScanResultsScanResultIDFamiliesFamilyItems request with any body
+	PostScanResultsScanResultIDFamiliesFamilyItemsWithBodyWithResponse(ctx context.Context, scanResultID ScanResultID, family ScanResultFamily, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScanResultsScanResultIDFamiliesFamilyItemsResponse, error)
+
+	PostScanResultsScanResultIDFamiliesFamilyItemsWithResponse(ctx context.Context, scanResultID ScanResultID, family ScanResultFamily, body PostScanResultsScanResultIDFamiliesFamilyItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScanResultsScanResultIDFamiliesFamilyItemsResponse, error)
+
+	// PostScanResultsScanResultIDRerun request
+	PostScanResultsScanResultIDRerunWithResponse(ctx context.Context, scanResultID ScanResultID, params *PostScanResultsScanResultIDRerunParams, reqEditors ...RequestEditorFn) (*PostScanResultsScanResultIDRerunResponse, error)
+
+	// GetScanResultsScanResultIDSbom request
+	GetScanResultsScanResultIDSbomWithResponse(ctx context.Context, scanResultID ScanResultID, params *GetScanResultsScanResultIDSbomParams, reqEditors ...RequestEditorFn) (*GetScanResultsScanResultIDSbomResponse, error)
+
+	// GetScanResultsScanResultIDScannerConfig request
+	GetScanResultsScanResultIDScannerConfigWithResponse(ctx context.Context, scanResultID ScanResultID, reqEditors ...RequestEditorFn) (*GetScanResultsScanResultIDScannerConfigResponse, error)
+
+	// PutScanResultsScanResultIDScannerConfig request with any body
+	PutScanResultsScanResultIDScannerConfigWithBodyWithResponse(ctx context.Context, scanResultID ScanResultID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScanResultsScanResultIDScannerConfigResponse, error)
+
+	PutScanResultsScanResultIDScannerConfigWithResponse(ctx context.Context, scanResultID ScanResultID, body PutScanResultsScanResultIDScannerConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScanResultsScanResultIDScannerConfigResponse, error)
+
+	// GetScans request
+	GetScansWithResponse(ctx context.Context, params *GetScansParams, reqEditors ...RequestEditorFn) (*GetScansResponse, error)
+
+	// PostScans request with any body
+	PostScansWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScansResponse, error)
+
+	PostScansWithResponse(ctx context.Context, body PostScansJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScansResponse, error)
+
+	// DeleteScansScanID request
+	DeleteScansScanIDWithResponse(ctx context.Context, scanID ScanID, reqEditors ...RequestEditorFn) (*DeleteScansScanIDResponse, error)
+
+	// GetScansScanID request
+	GetScansScanIDWithResponse(ctx context.Context, scanID ScanID, params *GetScansScanIDParams, reqEditors ...RequestEditorFn) (*GetScansScanIDResponse, error)
+
+	// PatchScansScanID request with any body
+	PatchScansScanIDWithBodyWithResponse(ctx context.Context, scanID ScanID, params *PatchScansScanIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScansScanIDResponse, error)
+
+	PatchScansScanIDWithResponse(ctx context.Context, scanID ScanID, params *PatchScansScanIDParams, body PatchScansScanIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScansScanIDResponse, error)
+
+	// PutScansScanID request with any body
+	PutScansScanIDWithBodyWithResponse(ctx context.Context, scanID ScanID, params *PutScansScanIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScansScanIDResponse, error)
+
+	PutScansScanIDWithResponse(ctx context.Context, scanID ScanID, params *PutScansScanIDParams, body PutScansScanIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScansScanIDResponse, error)
+
+	// GetScansScanIDProgress request
+	GetScansScanIDProgressWithResponse(ctx context.Context, scanID ScanID, reqEditors ...RequestEditorFn) (*GetScansScanIDProgressResponse, error)
+
+	// GetSettingsFindingTemplates request
+	GetSettingsFindingTemplatesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSettingsFindingTemplatesResponse, error)
+
+	// PutSettingsFindingTemplates request with any body
+	PutSettingsFindingTemplatesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutSettingsFindingTemplatesResponse, error)
+
+	PutSettingsFindingTemplatesWithResponse(ctx context.Context, body PutSettingsFindingTemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutSettingsFindingTemplatesResponse, error)
+
+	// PostSettingsFindingTemplatesPreview request with any body
+	PostSettingsFindingTemplatesPreviewWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSettingsFindingTemplatesPreviewResponse, error)
+
+	PostSettingsFindingTemplatesPreviewWithResponse(ctx context.Context, body PostSettingsFindingTemplatesPreviewJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSettingsFindingTemplatesPreviewResponse, error)
+
+	// GetSettingsRetention request
+	GetSettingsRetentionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSettingsRetentionResponse, error)
+
+	// PutSettingsRetention request with any body
+	PutSettingsRetentionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutSettingsRetentionResponse, error)
+
+	PutSettingsRetentionWithResponse(ctx context.Context, body PutSettingsRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*PutSettingsRetentionResponse, error)
+
+	// GetUserPreferences request
+	GetUserPreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserPreferencesResponse, error)
+
+	// PutUserPreferences request with any body
+	PutUserPreferencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUserPreferencesResponse, error)
+
+	PutUserPreferencesWithResponse(ctx context.Context, body PutUserPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUserPreferencesResponse, error)
+}
+
+type GetAdminFeedsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Feeds
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminFeedsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminFeedsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminFeedsRefreshResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *BackgroundTask
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminFeedsRefreshResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminFeedsRefreshResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminMaintenanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MaintenanceMode
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminMaintenanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminMaintenanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminMaintenanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MaintenanceMode
+	JSON400      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminMaintenanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminMaintenanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminTasksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BackgroundTasks
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminTasksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminTasksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminTasksTaskNameRunResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *BackgroundTask
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminTasksTaskNameRunResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminTasksTaskNameRunResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminUsageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Usage
+	JSON202      *Operation
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminUsageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminUsageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *APIKeys
+	JSON400      *QueryError
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAPIKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *APIKey
+	JSON400      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAPIKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAPIKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAPIKeysAPIKeyIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAPIKeysAPIKeyIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAPIKeysAPIKeyIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAPIKeysAPIKeyIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *APIKey
+	JSON400      *QueryError
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAPIKeysAPIKeyIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAPIKeysAPIKeyIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAssetGroupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AssetGroups
+	JSON400      *QueryError
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAssetGroupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAssetGroupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAssetGroupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AssetGroup
+	JSON400      *ApiResponse
+	JSON409      *AssetGroupExists
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAssetGroupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAssetGroupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAssetGroupsAssetGroupIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON404      *ApiResponse
+	JSON409      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAssetGroupsAssetGroupIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAssetGroupsAssetGroupIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAssetGroupsAssetGroupIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AssetGroup
+	JSON400      *QueryError
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAssetGroupsAssetGroupIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAssetGroupsAssetGroupIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchAssetGroupsAssetGroupIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AssetGroup
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSON409      *AssetGroupExists
+	JSON412      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchAssetGroupsAssetGroupIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchAssetGroupsAssetGroupIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAssetGroupsAssetGroupIDAssetsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Assets
+	JSON400      *QueryError
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAssetGroupsAssetGroupIDAssetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAssetGroupsAssetGroupIDAssetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAssetsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Assets
+	JSON400      *QueryError
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAssetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAssetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAssetsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Asset
+	JSON400      *ApiResponse
+	JSON409      *AssetExists
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAssetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAssetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAssetsAssetIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAssetsAssetIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAssetsAssetIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAssetsAssetIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Asset
+	JSON400      *QueryError
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAssetsAssetIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAssetsAssetIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchAssetsAssetIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Asset
+	JSON404      *ApiResponse
+	JSON409      *AssetExists
+	JSON412      *ApiResponse
+	JSON422      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchAssetsAssetIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchAssetsAssetIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAssetsAssetIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Asset
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSON409      *AssetExists
+	JSON412      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAssetsAssetIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAssetsAssetIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAssetsAssetIDPackagesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InstalledPackages
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAssetsAssetIDPackagesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAssetsAssetIDPackagesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAssetsAssetIDSbomDiffResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SbomDiff
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAssetsAssetIDSbomDiffResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAssetsAssetIDSbomDiffResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAssetsAssetIDScanResultDiffResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScanResultDiff
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAssetsAssetIDScanResultDiffResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAssetsAssetIDScanResultDiffResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAssetsAssetIDUpgradePlanResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UpgradePlan
+	JSON202      *Operation
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAssetsAssetIDUpgradePlanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAssetsAssetIDUpgradePlanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCorrelatedFindingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CorrelatedFindings
+	JSON400      *QueryError
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCorrelatedFindingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCorrelatedFindingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCorrelatedFindingsCorrelatedFindingIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CorrelatedFinding
+	JSON400      *QueryError
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCorrelatedFindingsCorrelatedFindingIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCorrelatedFindingsCorrelatedFindingIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDiscoveryScopesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Scopes
+	JSON400      *QueryError
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDiscoveryScopesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDiscoveryScopesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutDiscoveryScopesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Scopes
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutDiscoveryScopesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutDiscoveryScopesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFeedsFeedNameResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFeedsFeedNameResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFeedsFeedNameResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFindingDigestsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FindingDigests
+	JSON400      *QueryError
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFindingDigestsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFindingDigestsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostFindingDigestsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *FindingDigest
+	JSON400      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostFindingDigestsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostFindingDigestsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteFindingDigestsFindingDigestIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteFindingDigestsFindingDigestIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteFindingDigestsFindingDigestIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFindingDigestsFindingDigestIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FindingDigest
+	JSON400      *QueryError
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFindingDigestsFindingDigestIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFindingDigestsFindingDigestIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchFindingDigestsFindingDigestIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FindingDigest
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSON412      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchFindingDigestsFindingDigestIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchFindingDigestsFindingDigestIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFindingExceptionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FindingExceptions
+	JSON400      *QueryError
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFindingExceptionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFindingExceptionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostFindingExceptionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *FindingException
+	JSON400      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostFindingExceptionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostFindingExceptionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteFindingExceptionsFindingExceptionIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteFindingExceptionsFindingExceptionIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteFindingExceptionsFindingExceptionIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFindingExceptionsFindingExceptionIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FindingException
+	JSON400      *QueryError
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFindingExceptionsFindingExceptionIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFindingExceptionsFindingExceptionIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchFindingExceptionsFindingExceptionIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FindingException
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSON412      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchFindingExceptionsFindingExceptionIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchFindingExceptionsFindingExceptionIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFindingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Findings
+	JSON400      *QueryError
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFindingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFindingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostFindingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Finding
+	JSON400      *ApiResponse
+	JSON409      *FindingExists
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostFindingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostFindingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchFindingsBulkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FindingsBulkResult
+	JSON400      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchFindingsBulkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchFindingsBulkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostFindingsBulkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FindingsBulkResult
+	JSON400      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostFindingsBulkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostFindingsBulkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteFindingsFindingIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteFindingsFindingIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteFindingsFindingIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetFindingsFindingIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Finding
+	JSON400      *QueryError
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFindingsFindingIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFindingsFindingIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchFindingsFindingIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Finding
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSON422      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchFindingsFindingIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchFindingsFindingIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutFindingsFindingIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Finding
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutFindingsFindingIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutFindingsFindingIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostFindingsFindingIDScannerSuppressionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScannerSuppression
+	JSON201      *ScannerSuppression
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSON409      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostFindingsFindingIDScannerSuppressionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostFindingsFindingIDScannerSuppressionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNotificationConfigsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NotificationConfigs
+	JSON400      *QueryError
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNotificationConfigsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNotificationConfigsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostNotificationConfigsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *NotificationConfig
+	JSON400      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostNotificationConfigsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostNotificationConfigsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteNotificationConfigsNotificationConfigIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteNotificationConfigsNotificationConfigIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteNotificationConfigsNotificationConfigIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNotificationConfigsNotificationConfigIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NotificationConfig
+	JSON400      *QueryError
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNotificationConfigsNotificationConfigIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNotificationConfigsNotificationConfigIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchNotificationConfigsNotificationConfigIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NotificationConfig
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSON412      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchNotificationConfigsNotificationConfigIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchNotificationConfigsNotificationConfigIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOperationsOperationIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Operation
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOperationsOperationIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOperationsOperationIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOperationsOperationIDResultResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSON404      *ApiResponse
+	JSON409      *Operation
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOperationsOperationIDResultResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOperationsOperationIDResultResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPostureScoresResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PostureScores
+	JSON400      *QueryError
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPostureScoresResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPostureScoresResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProviderOperationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProviderOperations
+	JSON400      *QueryError
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProviderOperationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProviderOperationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostProviderOperationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ProviderOperation
+	JSON400      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostProviderOperationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostProviderOperationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProviderOperationsProviderOperationIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProviderOperation
+	JSON400      *QueryError
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProviderOperationsProviderOperationIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProviderOperationsProviderOperationIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProvidersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Providers
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProvidersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProvidersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetReportSchedulesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReportSchedules
+	JSON400      *QueryError
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetReportSchedulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetReportSchedulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostReportSchedulesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ReportSchedule
+	JSON400      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostReportSchedulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostReportSchedulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteReportSchedulesReportScheduleIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteReportSchedulesReportScheduleIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteReportSchedulesReportScheduleIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetReportSchedulesReportScheduleIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReportSchedule
+	JSON400      *QueryError
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetReportSchedulesReportScheduleIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetReportSchedulesReportScheduleIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchReportSchedulesReportScheduleIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReportSchedule
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSON412      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchReportSchedulesReportScheduleIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchReportSchedulesReportScheduleIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetReportSchedulesReportScheduleIDReportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetReportSchedulesReportScheduleIDReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetReportSchedulesReportScheduleIDReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSbomsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *Operation
+	JSON400      *ApiResponse
+	JSON503      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSbomsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSbomsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScanConfigTemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScanConfigTemplates
+	JSON400      *QueryError
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScanConfigTemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScanConfigTemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostScanConfigTemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ScanConfigTemplate
+	JSON400      *ApiResponse
+	JSON409      *ScanConfigTemplateExists
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostScanConfigTemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostScanConfigTemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteScanConfigTemplatesScanConfigTemplateIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON404      *ApiResponse
+	JSON409      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteScanConfigTemplatesScanConfigTemplateIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteScanConfigTemplatesScanConfigTemplateIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScanConfigTemplatesScanConfigTemplateIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScanConfigTemplate
+	JSON400      *QueryError
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScanConfigTemplatesScanConfigTemplateIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScanConfigTemplatesScanConfigTemplateIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchScanConfigTemplatesScanConfigTemplateIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScanConfigTemplate
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSON409      *ScanConfigTemplateExists
+	JSON412      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchScanConfigTemplatesScanConfigTemplateIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchScanConfigTemplatesScanConfigTemplateIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScanConfigsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScanConfigs
+	JSON400      *QueryError
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScanConfigsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScanConfigsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostScanConfigsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ScanConfig
+	JSON400      *ApiResponse
+	JSON409      *ScanConfigExists
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostScanConfigsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostScanConfigsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteScanConfigsScanConfigIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteScanConfigsScanConfigIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteScanConfigsScanConfigIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScanConfigsScanConfigIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScanConfig
+	JSON400      *QueryError
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScanConfigsScanConfigIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScanConfigsScanConfigIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchScanConfigsScanConfigIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScanConfig
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSON409      *ScanConfigExists
+	JSON412      *ApiResponse
+	JSON422      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchScanConfigsScanConfigIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchScanConfigsScanConfigIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutScanConfigsScanConfigIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScanConfig
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSON409      *ScanConfigExists
+	JSON412      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutScanConfigsScanConfigIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutScanConfigsScanConfigIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScanConfigsScanConfigIDNextRunsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScanConfigNextRuns
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScanConfigsScanConfigIDNextRunsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScanConfigsScanConfigIDNextRunsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScanResultsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AssetScanResults
+	JSON400      *QueryError
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScanResultsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScanResultsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostScanResultsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AssetScanResult
+	JSON400      *ApiResponse
+	JSON409      *AssetScanResultExists
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostScanResultsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostScanResultsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScanResultsScanResultIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AssetScanResult
+	JSON400      *QueryError
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScanResultsScanResultIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScanResultsScanResultIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchScanResultsScanResultIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AssetScanResult
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSON409      *AssetScanResultExists
+	JSON412      *ApiResponse
+	JSON422      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchScanResultsScanResultIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchScanResultsScanResultIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutScanResultsScanResultIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AssetScanResult
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSON409      *AssetScanResultExists
+	JSON412      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutScanResultsScanResultIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutScanResultsScanResultIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScanResultsScanResultIDFamiliesFamilyArtifactResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScanResultsScanResultIDFamiliesFamilyArtifactResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScanResultsScanResultIDFamiliesFamilyArtifactResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutScanResultsScanResultIDFamiliesFamilyArtifactResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScanResultArtifact
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSON413      *ApiResponse
+	JSON503      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutScanResultsScanResultIDFamiliesFamilyArtifactResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutScanResultsScanResultIDFamiliesFamilyArtifactResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScanResultsScanResultIDFamiliesFamilyItemsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScanResultItems
+	JSON400      *QueryError
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScanResultsScanResultIDFamiliesFamilyItemsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScanResultsScanResultIDFamiliesFamilyItemsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostScanResultsScanResultIDFamiliesFamilyItemsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScanResultItems
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSON409      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostScanResultsScanResultIDFamiliesFamilyItemsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostScanResultsScanResultIDFamiliesFamilyItemsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostScanResultsScanResultIDRerunResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *AssetScanResult
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSON409      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostScanResultsScanResultIDRerunResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostScanResultsScanResultIDRerunResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScanResultsScanResultIDSbomResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScanResultsScanResultIDSbomResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScanResultsScanResultIDSbomResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScanResultsScanResultIDScannerConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScannerConfig
+	JSON401      *ApiResponse
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScanResultsScanResultIDScannerConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScanResultsScanResultIDScannerConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutScanResultsScanResultIDScannerConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScannerConfig
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutScanResultsScanResultIDScannerConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutScanResultsScanResultIDScannerConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScansResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Scans
+	JSON400      *QueryError
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScansResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScansResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostScansResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Scan
+	JSON400      *ApiResponse
+	JSON409      *ScanExists
+	JSON429      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostScansResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostScansResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteScansScanIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessResponse
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteScansScanIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteScansScanIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScansScanIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Scan
+	JSON400      *QueryError
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScansScanIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScansScanIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchScansScanIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Scan
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSON409      *ScanExists
+	JSON412      *ApiResponse
+	JSON422      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchScansScanIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchScansScanIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutScansScanIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Scan
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSON409      *ScanExists
+	JSON412      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutScansScanIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutScansScanIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScansScanIDProgressResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScanProgress
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScansScanIDProgressResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScansScanIDProgressResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSettingsFindingTemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FindingTemplateSettings
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSettingsFindingTemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSettingsFindingTemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutSettingsFindingTemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FindingTemplateSettings
+	JSON400      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutSettingsFindingTemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutSettingsFindingTemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSettingsFindingTemplatesPreviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RenderedFinding
+	JSON400      *ApiResponse
+	JSON404      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSettingsFindingTemplatesPreviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSettingsFindingTemplatesPreviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSettingsRetentionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RetentionSettings
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSettingsRetentionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSettingsRetentionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutSettingsRetentionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RetentionSettings
+	JSON400      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutSettingsRetentionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutSettingsRetentionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserPreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserPreferences
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserPreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserPreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutUserPreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserPreferences
+	JSON400      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutUserPreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutUserPreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetAdminFeedsWithResponse request returning *GetAdminFeedsResponse
+func (c *ClientWithResponses) GetAdminFeedsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAdminFeedsResponse, error) {
+	rsp, err := c.GetAdminFeeds(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminFeedsResponse(rsp)
+}
+
+// PostAdminFeedsRefreshWithResponse request returning *PostAdminFeedsRefreshResponse
+func (c *ClientWithResponses) PostAdminFeedsRefreshWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostAdminFeedsRefreshResponse, error) {
+	rsp, err := c.PostAdminFeedsRefresh(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminFeedsRefreshResponse(rsp)
+}
+
+// GetAdminMaintenanceWithResponse request returning *GetAdminMaintenanceResponse
+func (c *ClientWithResponses) GetAdminMaintenanceWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAdminMaintenanceResponse, error) {
+	rsp, err := c.GetAdminMaintenance(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminMaintenanceResponse(rsp)
+}
+
+// PutAdminMaintenanceWithBodyWithResponse request with arbitrary body returning *PutAdminMaintenanceResponse
+func (c *ClientWithResponses) PutAdminMaintenanceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminMaintenanceResponse, error) {
+	rsp, err := c.PutAdminMaintenanceWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminMaintenanceResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAdminMaintenanceWithResponse(ctx context.Context, body PutAdminMaintenanceJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminMaintenanceResponse, error) {
+	rsp, err := c.PutAdminMaintenance(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminMaintenanceResponse(rsp)
+}
+
+// GetAdminTasksWithResponse request returning *GetAdminTasksResponse
+func (c *ClientWithResponses) GetAdminTasksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAdminTasksResponse, error) {
+	rsp, err := c.GetAdminTasks(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminTasksResponse(rsp)
+}
+
+// PostAdminTasksTaskNameRunWithResponse request returning *PostAdminTasksTaskNameRunResponse
+func (c *ClientWithResponses) PostAdminTasksTaskNameRunWithResponse(ctx context.Context, taskName TaskName, reqEditors ...RequestEditorFn) (*PostAdminTasksTaskNameRunResponse, error) {
+	rsp, err := c.PostAdminTasksTaskNameRun(ctx, taskName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminTasksTaskNameRunResponse(rsp)
+}
+
+// GetAdminUsageWithResponse request returning *GetAdminUsageResponse
+func (c *ClientWithResponses) GetAdminUsageWithResponse(ctx context.Context, params *GetAdminUsageParams, reqEditors ...RequestEditorFn) (*GetAdminUsageResponse, error) {
+	rsp, err := c.GetAdminUsage(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminUsageResponse(rsp)
+}
+
+// GetAPIKeysWithResponse request returning *GetAPIKeysResponse
+func (c *ClientWithResponses) GetAPIKeysWithResponse(ctx context.Context, params *GetAPIKeysParams, reqEditors ...RequestEditorFn) (*GetAPIKeysResponse, error) {
+	rsp, err := c.GetAPIKeys(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIKeysResponse(rsp)
+}
+
+// PostAPIKeysWithBodyWithResponse request with arbitrary body returning *PostAPIKeysResponse
+func (c *ClientWithResponses) PostAPIKeysWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAPIKeysResponse, error) {
+	rsp, err := c.PostAPIKeysWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIKeysResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAPIKeysWithResponse(ctx context.Context, body PostAPIKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAPIKeysResponse, error) {
+	rsp, err := c.PostAPIKeys(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAPIKeysResponse(rsp)
+}
+
+// DeleteAPIKeysAPIKeyIDWithResponse request returning *DeleteAPIKeysAPIKeyIDResponse
+func (c *ClientWithResponses) DeleteAPIKeysAPIKeyIDWithResponse(ctx context.Context, apiKeyID ApiKeyID, reqEditors ...RequestEditorFn) (*DeleteAPIKeysAPIKeyIDResponse, error) {
+	rsp, err := c.DeleteAPIKeysAPIKeyID(ctx, apiKeyID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAPIKeysAPIKeyIDResponse(rsp)
+}
+
+// GetAPIKeysAPIKeyIDWithResponse request returning *GetAPIKeysAPIKeyIDResponse
+func (c *ClientWithResponses) GetAPIKeysAPIKeyIDWithResponse(ctx context.Context, apiKeyID ApiKeyID, params *GetAPIKeysAPIKeyIDParams, reqEditors ...RequestEditorFn) (*GetAPIKeysAPIKeyIDResponse, error) {
+	rsp, err := c.GetAPIKeysAPIKeyID(ctx, apiKeyID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAPIKeysAPIKeyIDResponse(rsp)
+}
+
+// GetAssetGroupsWithResponse request returning *GetAssetGroupsResponse
+func (c *ClientWithResponses) GetAssetGroupsWithResponse(ctx context.Context, params *GetAssetGroupsParams, reqEditors ...RequestEditorFn) (*GetAssetGroupsResponse, error) {
+	rsp, err := c.GetAssetGroups(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAssetGroupsResponse(rsp)
+}
+
+// PostAssetGroupsWithBodyWithResponse request with arbitrary body returning *PostAssetGroupsResponse
+func (c *ClientWithResponses) PostAssetGroupsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAssetGroupsResponse, error) {
+	rsp, err := c.PostAssetGroupsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAssetGroupsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAssetGroupsWithResponse(ctx context.Context, body PostAssetGroupsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAssetGroupsResponse, error) {
+	rsp, err := c.PostAssetGroups(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAssetGroupsResponse(rsp)
+}
+
+// DeleteAssetGroupsAssetGroupIDWithResponse request returning *DeleteAssetGroupsAssetGroupIDResponse
+func (c *ClientWithResponses) DeleteAssetGroupsAssetGroupIDWithResponse(ctx context.Context, assetGroupID AssetGroupID, reqEditors ...RequestEditorFn) (*DeleteAssetGroupsAssetGroupIDResponse, error) {
+	rsp, err := c.DeleteAssetGroupsAssetGroupID(ctx, assetGroupID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAssetGroupsAssetGroupIDResponse(rsp)
+}
+
+// GetAssetGroupsAssetGroupIDWithResponse request returning *GetAssetGroupsAssetGroupIDResponse
+func (c *ClientWithResponses) GetAssetGroupsAssetGroupIDWithResponse(ctx context.Context, assetGroupID AssetGroupID, params *GetAssetGroupsAssetGroupIDParams, reqEditors ...RequestEditorFn) (*GetAssetGroupsAssetGroupIDResponse, error) {
+	rsp, err := c.GetAssetGroupsAssetGroupID(ctx, assetGroupID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAssetGroupsAssetGroupIDResponse(rsp)
+}
+
+// PatchAssetGroupsAssetGroupIDWithBodyWithResponse request with arbitrary body returning *PatchAssetGroupsAssetGroupIDResponse
+func (c *ClientWithResponses) PatchAssetGroupsAssetGroupIDWithBodyWithResponse(ctx context.Context, assetGroupID AssetGroupID, params *PatchAssetGroupsAssetGroupIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchAssetGroupsAssetGroupIDResponse, error) {
+	rsp, err := c.PatchAssetGroupsAssetGroupIDWithBody(ctx, assetGroupID, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchAssetGroupsAssetGroupIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchAssetGroupsAssetGroupIDWithResponse(ctx context.Context, assetGroupID AssetGroupID, params *PatchAssetGroupsAssetGroupIDParams, body PatchAssetGroupsAssetGroupIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchAssetGroupsAssetGroupIDResponse, error) {
+	rsp, err := c.PatchAssetGroupsAssetGroupID(ctx, assetGroupID, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchAssetGroupsAssetGroupIDResponse(rsp)
+}
+
+// GetAssetGroupsAssetGroupIDAssetsWithResponse request returning *GetAssetGroupsAssetGroupIDAssetsResponse
+func (c *ClientWithResponses) GetAssetGroupsAssetGroupIDAssetsWithResponse(ctx context.Context, assetGroupID AssetGroupID, params *GetAssetGroupsAssetGroupIDAssetsParams, reqEditors ...RequestEditorFn) (*GetAssetGroupsAssetGroupIDAssetsResponse, error) {
+	rsp, err := c.GetAssetGroupsAssetGroupIDAssets(ctx, assetGroupID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAssetGroupsAssetGroupIDAssetsResponse(rsp)
+}
+
+// GetAssetsWithResponse request returning *GetAssetsResponse
+func (c *ClientWithResponses) GetAssetsWithResponse(ctx context.Context, params *GetAssetsParams, reqEditors ...RequestEditorFn) (*GetAssetsResponse, error) {
+	rsp, err := c.GetAssets(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAssetsResponse(rsp)
+}
+
+// PostAssetsWithBodyWithResponse request with arbitrary body returning *PostAssetsResponse
+func (c *ClientWithResponses) PostAssetsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAssetsResponse, error) {
+	rsp, err := c.PostAssetsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAssetsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAssetsWithResponse(ctx context.Context, body PostAssetsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAssetsResponse, error) {
+	rsp, err := c.PostAssets(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAssetsResponse(rsp)
+}
+
+// DeleteAssetsAssetIDWithResponse request returning *DeleteAssetsAssetIDResponse
+func (c *ClientWithResponses) DeleteAssetsAssetIDWithResponse(ctx context.Context, assetID AssetID, reqEditors ...RequestEditorFn) (*DeleteAssetsAssetIDResponse, error) {
+	rsp, err := c.DeleteAssetsAssetID(ctx, assetID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAssetsAssetIDResponse(rsp)
+}
+
+// GetAssetsAssetIDWithResponse request returning *GetAssetsAssetIDResponse
+func (c *ClientWithResponses) GetAssetsAssetIDWithResponse(ctx context.Context, assetID AssetID, params *GetAssetsAssetIDParams, reqEditors ...RequestEditorFn) (*GetAssetsAssetIDResponse, error) {
+	rsp, err := c.GetAssetsAssetID(ctx, assetID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAssetsAssetIDResponse(rsp)
+}
+
+// PatchAssetsAssetIDWithBodyWithResponse request with arbitrary body returning *PatchAssetsAssetIDResponse
+func (c *ClientWithResponses) PatchAssetsAssetIDWithBodyWithResponse(ctx context.Context, assetID AssetID, params *PatchAssetsAssetIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchAssetsAssetIDResponse, error) {
+	rsp, err := c.PatchAssetsAssetIDWithBody(ctx, assetID, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchAssetsAssetIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchAssetsAssetIDWithResponse(ctx context.Context, assetID AssetID, params *PatchAssetsAssetIDParams, body PatchAssetsAssetIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchAssetsAssetIDResponse, error) {
+	rsp, err := c.PatchAssetsAssetID(ctx, assetID, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchAssetsAssetIDResponse(rsp)
+}
+
+// PutAssetsAssetIDWithBodyWithResponse request with arbitrary body returning *PutAssetsAssetIDResponse
+func (c *ClientWithResponses) PutAssetsAssetIDWithBodyWithResponse(ctx context.Context, assetID AssetID, params *PutAssetsAssetIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAssetsAssetIDResponse, error) {
+	rsp, err := c.PutAssetsAssetIDWithBody(ctx, assetID, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAssetsAssetIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAssetsAssetIDWithResponse(ctx context.Context, assetID AssetID, params *PutAssetsAssetIDParams, body PutAssetsAssetIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAssetsAssetIDResponse, error) {
+	rsp, err := c.PutAssetsAssetID(ctx, assetID, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAssetsAssetIDResponse(rsp)
+}
+
+// GetAssetsAssetIDPackagesWithResponse request returning *GetAssetsAssetIDPackagesResponse
+func (c *ClientWithResponses) GetAssetsAssetIDPackagesWithResponse(ctx context.Context, assetID AssetID, reqEditors ...RequestEditorFn) (*GetAssetsAssetIDPackagesResponse, error) {
+	rsp, err := c.GetAssetsAssetIDPackages(ctx, assetID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAssetsAssetIDPackagesResponse(rsp)
+}
+
+// GetAssetsAssetIDSbomDiffWithResponse request returning *GetAssetsAssetIDSbomDiffResponse
+func (c *ClientWithResponses) GetAssetsAssetIDSbomDiffWithResponse(ctx context.Context, assetID AssetID, params *GetAssetsAssetIDSbomDiffParams, reqEditors ...RequestEditorFn) (*GetAssetsAssetIDSbomDiffResponse, error) {
+	rsp, err := c.GetAssetsAssetIDSbomDiff(ctx, assetID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAssetsAssetIDSbomDiffResponse(rsp)
+}
+
+// GetAssetsAssetIDScanResultDiffWithResponse request returning *GetAssetsAssetIDScanResultDiffResponse
+func (c *ClientWithResponses) GetAssetsAssetIDScanResultDiffWithResponse(ctx context.Context, assetID AssetID, params *GetAssetsAssetIDScanResultDiffParams, reqEditors ...RequestEditorFn) (*GetAssetsAssetIDScanResultDiffResponse, error) {
+	rsp, err := c.GetAssetsAssetIDScanResultDiff(ctx, assetID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAssetsAssetIDScanResultDiffResponse(rsp)
+}
+
+// GetAssetsAssetIDUpgradePlanWithResponse request returning *GetAssetsAssetIDUpgradePlanResponse
+func (c *ClientWithResponses) GetAssetsAssetIDUpgradePlanWithResponse(ctx context.Context, assetID AssetID, params *GetAssetsAssetIDUpgradePlanParams, reqEditors ...RequestEditorFn) (*GetAssetsAssetIDUpgradePlanResponse, error) {
+	rsp, err := c.GetAssetsAssetIDUpgradePlan(ctx, assetID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAssetsAssetIDUpgradePlanResponse(rsp)
+}
+
+// GetCorrelatedFindingsWithResponse request returning *GetCorrelatedFindingsResponse
+func (c *ClientWithResponses) GetCorrelatedFindingsWithResponse(ctx context.Context, params *GetCorrelatedFindingsParams, reqEditors ...RequestEditorFn) (*GetCorrelatedFindingsResponse, error) {
+	rsp, err := c.GetCorrelatedFindings(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCorrelatedFindingsResponse(rsp)
+}
+
+// GetCorrelatedFindingsCorrelatedFindingIDWithResponse request returning *GetCorrelatedFindingsCorrelatedFindingIDResponse
+func (c *ClientWithResponses) GetCorrelatedFindingsCorrelatedFindingIDWithResponse(ctx context.Context, correlatedFindingID CorrelatedFindingID, params *GetCorrelatedFindingsCorrelatedFindingIDParams, reqEditors ...RequestEditorFn) (*GetCorrelatedFindingsCorrelatedFindingIDResponse, error) {
+	rsp, err := c.GetCorrelatedFindingsCorrelatedFindingID(ctx, correlatedFindingID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCorrelatedFindingsCorrelatedFindingIDResponse(rsp)
+}
+
+// GetDiscoveryScopesWithResponse request returning *GetDiscoveryScopesResponse
+func (c *ClientWithResponses) GetDiscoveryScopesWithResponse(ctx context.Context, params *GetDiscoveryScopesParams, reqEditors ...RequestEditorFn) (*GetDiscoveryScopesResponse, error) {
+	rsp, err := c.GetDiscoveryScopes(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDiscoveryScopesResponse(rsp)
+}
+
+// PutDiscoveryScopesWithBodyWithResponse request with arbitrary body returning *PutDiscoveryScopesResponse
+func (c *ClientWithResponses) PutDiscoveryScopesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutDiscoveryScopesResponse, error) {
+	rsp, err := c.PutDiscoveryScopesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutDiscoveryScopesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutDiscoveryScopesWithResponse(ctx context.Context, body PutDiscoveryScopesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutDiscoveryScopesResponse, error) {
+	rsp, err := c.PutDiscoveryScopes(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutDiscoveryScopesResponse(rsp)
+}
+
+// GetFeedsFeedNameWithResponse request returning *GetFeedsFeedNameResponse
+func (c *ClientWithResponses) GetFeedsFeedNameWithResponse(ctx context.Context, feedName FeedName, params *GetFeedsFeedNameParams, reqEditors ...RequestEditorFn) (*GetFeedsFeedNameResponse, error) {
+	rsp, err := c.GetFeedsFeedName(ctx, feedName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFeedsFeedNameResponse(rsp)
+}
+
+// GetFindingDigestsWithResponse request returning *GetFindingDigestsResponse
+func (c *ClientWithResponses) GetFindingDigestsWithResponse(ctx context.Context, params *GetFindingDigestsParams, reqEditors ...RequestEditorFn) (*GetFindingDigestsResponse, error) {
+	rsp, err := c.GetFindingDigests(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFindingDigestsResponse(rsp)
+}
+
+// PostFindingDigestsWithBodyWithResponse request with arbitrary body returning *PostFindingDigestsResponse
+func (c *ClientWithResponses) PostFindingDigestsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFindingDigestsResponse, error) {
+	rsp, err := c.PostFindingDigestsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostFindingDigestsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostFindingDigestsWithResponse(ctx context.Context, body PostFindingDigestsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFindingDigestsResponse, error) {
+	rsp, err := c.PostFindingDigests(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostFindingDigestsResponse(rsp)
+}
+
+// DeleteFindingDigestsFindingDigestIDWithResponse request returning *DeleteFindingDigestsFindingDigestIDResponse
+func (c *ClientWithResponses) DeleteFindingDigestsFindingDigestIDWithResponse(ctx context.Context, findingDigestID FindingDigestID, reqEditors ...RequestEditorFn) (*DeleteFindingDigestsFindingDigestIDResponse, error) {
+	rsp, err := c.DeleteFindingDigestsFindingDigestID(ctx, findingDigestID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteFindingDigestsFindingDigestIDResponse(rsp)
+}
+
+// GetFindingDigestsFindingDigestIDWithResponse request returning *GetFindingDigestsFindingDigestIDResponse
+func (c *ClientWithResponses) GetFindingDigestsFindingDigestIDWithResponse(ctx context.Context, findingDigestID FindingDigestID, params *GetFindingDigestsFindingDigestIDParams, reqEditors ...RequestEditorFn) (*GetFindingDigestsFindingDigestIDResponse, error) {
+	rsp, err := c.GetFindingDigestsFindingDigestID(ctx, findingDigestID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFindingDigestsFindingDigestIDResponse(rsp)
+}
+
+// PatchFindingDigestsFindingDigestIDWithBodyWithResponse request with arbitrary body returning *PatchFindingDigestsFindingDigestIDResponse
+func (c *ClientWithResponses) PatchFindingDigestsFindingDigestIDWithBodyWithResponse(ctx context.Context, findingDigestID FindingDigestID, params *PatchFindingDigestsFindingDigestIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchFindingDigestsFindingDigestIDResponse, error) {
+	rsp, err := c.PatchFindingDigestsFindingDigestIDWithBody(ctx, findingDigestID, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchFindingDigestsFindingDigestIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchFindingDigestsFindingDigestIDWithResponse(ctx context.Context, findingDigestID FindingDigestID, params *PatchFindingDigestsFindingDigestIDParams, body PatchFindingDigestsFindingDigestIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchFindingDigestsFindingDigestIDResponse, error) {
+	rsp, err := c.PatchFindingDigestsFindingDigestID(ctx, findingDigestID, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchFindingDigestsFindingDigestIDResponse(rsp)
+}
+
+// GetFindingExceptionsWithResponse request returning *GetFindingExceptionsResponse
+func (c *ClientWithResponses) GetFindingExceptionsWithResponse(ctx context.Context, params *GetFindingExceptionsParams, reqEditors ...RequestEditorFn) (*GetFindingExceptionsResponse, error) {
+	rsp, err := c.GetFindingExceptions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFindingExceptionsResponse(rsp)
+}
+
+// PostFindingExceptionsWithBodyWithResponse request with arbitrary body returning *PostFindingExceptionsResponse
+func (c *ClientWithResponses) PostFindingExceptionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFindingExceptionsResponse, error) {
+	rsp, err := c.PostFindingExceptionsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostFindingExceptionsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostFindingExceptionsWithResponse(ctx context.Context, body PostFindingExceptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFindingExceptionsResponse, error) {
+	rsp, err := c.PostFindingExceptions(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostFindingExceptionsResponse(rsp)
+}
+
+// DeleteFindingExceptionsFindingExceptionIDWithResponse request returning *DeleteFindingExceptionsFindingExceptionIDResponse
+func (c *ClientWithResponses) DeleteFindingExceptionsFindingExceptionIDWithResponse(ctx context.Context, findingExceptionID FindingExceptionID, reqEditors ...RequestEditorFn) (*DeleteFindingExceptionsFindingExceptionIDResponse, error) {
+	rsp, err := c.DeleteFindingExceptionsFindingExceptionID(ctx, findingExceptionID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteFindingExceptionsFindingExceptionIDResponse(rsp)
+}
+
+// GetFindingExceptionsFindingExceptionIDWithResponse request returning *GetFindingExceptionsFindingExceptionIDResponse
+func (c *ClientWithResponses) GetFindingExceptionsFindingExceptionIDWithResponse(ctx context.Context, findingExceptionID FindingExceptionID, params *GetFindingExceptionsFindingExceptionIDParams, reqEditors ...RequestEditorFn) (*GetFindingExceptionsFindingExceptionIDResponse, error) {
+	rsp, err := c.GetFindingExceptionsFindingExceptionID(ctx, findingExceptionID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFindingExceptionsFindingExceptionIDResponse(rsp)
+}
+
+// PatchFindingExceptionsFindingExceptionIDWithBodyWithResponse request with arbitrary body returning *PatchFindingExceptionsFindingExceptionIDResponse
+func (c *ClientWithResponses) PatchFindingExceptionsFindingExceptionIDWithBodyWithResponse(ctx context.Context, findingExceptionID FindingExceptionID, params *PatchFindingExceptionsFindingExceptionIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchFindingExceptionsFindingExceptionIDResponse, error) {
+	rsp, err := c.PatchFindingExceptionsFindingExceptionIDWithBody(ctx, findingExceptionID, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchFindingExceptionsFindingExceptionIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchFindingExceptionsFindingExceptionIDWithResponse(ctx context.Context, findingExceptionID FindingExceptionID, params *PatchFindingExceptionsFindingExceptionIDParams, body PatchFindingExceptionsFindingExceptionIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchFindingExceptionsFindingExceptionIDResponse, error) {
+	rsp, err := c.PatchFindingExceptionsFindingExceptionID(ctx, findingExceptionID, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchFindingExceptionsFindingExceptionIDResponse(rsp)
+}
+
+// GetFindingsWithResponse request returning *GetFindingsResponse
+func (c *ClientWithResponses) GetFindingsWithResponse(ctx context.Context, params *GetFindingsParams, reqEditors ...RequestEditorFn) (*GetFindingsResponse, error) {
+	rsp, err := c.GetFindings(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFindingsResponse(rsp)
+}
+
+// PostFindingsWithBodyWithResponse request with arbitrary body returning *PostFindingsResponse
+func (c *ClientWithResponses) PostFindingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFindingsResponse, error) {
+	rsp, err := c.PostFindingsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostFindingsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostFindingsWithResponse(ctx context.Context, body PostFindingsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFindingsResponse, error) {
+	rsp, err := c.PostFindings(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostFindingsResponse(rsp)
+}
+
+// PatchFindingsBulkWithBodyWithResponse request with arbitrary body returning *PatchFindingsBulkResponse
+func (c *ClientWithResponses) PatchFindingsBulkWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchFindingsBulkResponse, error) {
+	rsp, err := c.PatchFindingsBulkWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchFindingsBulkResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchFindingsBulkWithResponse(ctx context.Context, body PatchFindingsBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchFindingsBulkResponse, error) {
+	rsp, err := c.PatchFindingsBulk(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchFindingsBulkResponse(rsp)
+}
+
+// PostFindingsBulkWithBodyWithResponse request with arbitrary body returning *PostFindingsBulkResponse
+func (c *ClientWithResponses) PostFindingsBulkWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFindingsBulkResponse, error) {
+	rsp, err := c.PostFindingsBulkWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostFindingsBulkResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostFindingsBulkWithResponse(ctx context.Context, body PostFindingsBulkJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFindingsBulkResponse, error) {
+	rsp, err := c.PostFindingsBulk(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostFindingsBulkResponse(rsp)
+}
+
+// DeleteFindingsFindingIDWithResponse request returning *DeleteFindingsFindingIDResponse
+func (c *ClientWithResponses) DeleteFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, reqEditors ...RequestEditorFn) (*DeleteFindingsFindingIDResponse, error) {
+	rsp, err := c.DeleteFindingsFindingID(ctx, findingID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteFindingsFindingIDResponse(rsp)
+}
+
+// GetFindingsFindingIDWithResponse request returning *GetFindingsFindingIDResponse
+func (c *ClientWithResponses) GetFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, params *GetFindingsFindingIDParams, reqEditors ...RequestEditorFn) (*GetFindingsFindingIDResponse, error) {
+	rsp, err := c.GetFindingsFindingID(ctx, findingID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFindingsFindingIDResponse(rsp)
+}
+
+// PatchFindingsFindingIDWithBodyWithResponse request with arbitrary body returning *PatchFindingsFindingIDResponse
+func (c *ClientWithResponses) PatchFindingsFindingIDWithBodyWithResponse(ctx context.Context, findingID FindingID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchFindingsFindingIDResponse, error) {
+	rsp, err := c.PatchFindingsFindingIDWithBody(ctx, findingID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchFindingsFindingIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, body PatchFindingsFindingIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchFindingsFindingIDResponse, error) {
+	rsp, err := c.PatchFindingsFindingID(ctx, findingID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchFindingsFindingIDResponse(rsp)
+}
+
+// PutFindingsFindingIDWithBodyWithResponse request with arbitrary body returning *PutFindingsFindingIDResponse
+func (c *ClientWithResponses) PutFindingsFindingIDWithBodyWithResponse(ctx context.Context, findingID FindingID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutFindingsFindingIDResponse, error) {
+	rsp, err := c.PutFindingsFindingIDWithBody(ctx, findingID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutFindingsFindingIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, body PutFindingsFindingIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutFindingsFindingIDResponse, error) {
+	rsp, err := c.PutFindingsFindingID(ctx, findingID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutFindingsFindingIDResponse(rsp)
+}
+
+// PostFindingsFindingIDScannerSuppressionWithBodyWithResponse request with arbitrary body returning *PostFindingsFindingIDScannerSuppressionResponse
+func (c *ClientWithResponses) PostFindingsFindingIDScannerSuppressionWithBodyWithResponse(ctx context.Context, findingID FindingID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFindingsFindingIDScannerSuppressionResponse, error) {
+	rsp, err := c.PostFindingsFindingIDScannerSuppressionWithBody(ctx, findingID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostFindingsFindingIDScannerSuppressionResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostFindingsFindingIDScannerSuppressionWithResponse(ctx context.Context, findingID FindingID, body PostFindingsFindingIDScannerSuppressionJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFindingsFindingIDScannerSuppressionResponse, error) {
+	rsp, err := c.PostFindingsFindingIDScannerSuppression(ctx, findingID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostFindingsFindingIDScannerSuppressionResponse(rsp)
+}
+
+// GetNotificationConfigsWithResponse request returning *GetNotificationConfigsResponse
+func (c *ClientWithResponses) GetNotificationConfigsWithResponse(ctx context.Context, params *GetNotificationConfigsParams, reqEditors ...RequestEditorFn) (*GetNotificationConfigsResponse, error) {
+	rsp, err := c.GetNotificationConfigs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNotificationConfigsResponse(rsp)
+}
+
+// PostNotificationConfigsWithBodyWithResponse request with arbitrary body returning *PostNotificationConfigsResponse
+func (c *ClientWithResponses) PostNotificationConfigsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostNotificationConfigsResponse, error) {
+	rsp, err := c.PostNotificationConfigsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostNotificationConfigsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostNotificationConfigsWithResponse(ctx context.Context, body PostNotificationConfigsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostNotificationConfigsResponse, error) {
+	rsp, err := c.PostNotificationConfigs(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostNotificationConfigsResponse(rsp)
+}
+
+// DeleteNotificationConfigsNotificationConfigIDWithResponse request returning *DeleteNotificationConfigsNotificationConfigIDResponse
+func (c *ClientWithResponses) DeleteNotificationConfigsNotificationConfigIDWithResponse(ctx context.Context, notificationConfigID NotificationConfigID, reqEditors ...RequestEditorFn) (*DeleteNotificationConfigsNotificationConfigIDResponse, error) {
+	rsp, err := c.DeleteNotificationConfigsNotificationConfigID(ctx, notificationConfigID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteNotificationConfigsNotificationConfigIDResponse(rsp)
+}
+
+// GetNotificationConfigsNotificationConfigIDWithResponse request returning *GetNotificationConfigsNotificationConfigIDResponse
+func (c *ClientWithResponses) GetNotificationConfigsNotificationConfigIDWithResponse(ctx context.Context, notificationConfigID NotificationConfigID, params *GetNotificationConfigsNotificationConfigIDParams, reqEditors ...RequestEditorFn) (*GetNotificationConfigsNotificationConfigIDResponse, error) {
+	rsp, err := c.GetNotificationConfigsNotificationConfigID(ctx, notificationConfigID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNotificationConfigsNotificationConfigIDResponse(rsp)
+}
+
+// PatchNotificationConfigsNotificationConfigIDWithBodyWithResponse request with arbitrary body returning *PatchNotificationConfigsNotificationConfigIDResponse
+func (c *ClientWithResponses) PatchNotificationConfigsNotificationConfigIDWithBodyWithResponse(ctx context.Context, notificationConfigID NotificationConfigID, params *PatchNotificationConfigsNotificationConfigIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchNotificationConfigsNotificationConfigIDResponse, error) {
+	rsp, err := c.PatchNotificationConfigsNotificationConfigIDWithBody(ctx, notificationConfigID, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchNotificationConfigsNotificationConfigIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchNotificationConfigsNotificationConfigIDWithResponse(ctx context.Context, notificationConfigID NotificationConfigID, params *PatchNotificationConfigsNotificationConfigIDParams, body PatchNotificationConfigsNotificationConfigIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchNotificationConfigsNotificationConfigIDResponse, error) {
+	rsp, err := c.PatchNotificationConfigsNotificationConfigID(ctx, notificationConfigID, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchNotificationConfigsNotificationConfigIDResponse(rsp)
+}
+
+// GetOperationsOperationIDWithResponse request returning *GetOperationsOperationIDResponse
+func (c *ClientWithResponses) GetOperationsOperationIDWithResponse(ctx context.Context, operationID OperationID, reqEditors ...RequestEditorFn) (*GetOperationsOperationIDResponse, error) {
+	rsp, err := c.GetOperationsOperationID(ctx, operationID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOperationsOperationIDResponse(rsp)
+}
+
+// GetOperationsOperationIDResultWithResponse request returning *GetOperationsOperationIDResultResponse
+func (c *ClientWithResponses) GetOperationsOperationIDResultWithResponse(ctx context.Context, operationID OperationID, reqEditors ...RequestEditorFn) (*GetOperationsOperationIDResultResponse, error) {
+	rsp, err := c.GetOperationsOperationIDResult(ctx, operationID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOperationsOperationIDResultResponse(rsp)
+}
+
+// GetPostureScoresWithResponse request returning *GetPostureScoresResponse
+func (c *ClientWithResponses) GetPostureScoresWithResponse(ctx context.Context, params *GetPostureScoresParams, reqEditors ...RequestEditorFn) (*GetPostureScoresResponse, error) {
+	rsp, err := c.GetPostureScores(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPostureScoresResponse(rsp)
+}
+
+// GetProviderOperationsWithResponse request returning *GetProviderOperationsResponse
+func (c *ClientWithResponses) GetProviderOperationsWithResponse(ctx context.Context, params *GetProviderOperationsParams, reqEditors ...RequestEditorFn) (*GetProviderOperationsResponse, error) {
+	rsp, err := c.GetProviderOperations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProviderOperationsResponse(rsp)
+}
+
+// PostProviderOperationsWithBodyWithResponse request with arbitrary body returning *PostProviderOperationsResponse
+func (c *ClientWithResponses) PostProviderOperationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostProviderOperationsResponse, error) {
+	rsp, err := c.PostProviderOperationsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostProviderOperationsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostProviderOperationsWithResponse(ctx context.Context, body PostProviderOperationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostProviderOperationsResponse, error) {
+	rsp, err := c.PostProviderOperations(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostProviderOperationsResponse(rsp)
+}
+
+// GetProviderOperationsProviderOperationIDWithResponse request returning *GetProviderOperationsProviderOperationIDResponse
+func (c *ClientWithResponses) GetProviderOperationsProviderOperationIDWithResponse(ctx context.Context, providerOperationID ProviderOperationID, params *GetProviderOperationsProviderOperationIDParams, reqEditors ...RequestEditorFn) (*GetProviderOperationsProviderOperationIDResponse, error) {
+	rsp, err := c.GetProviderOperationsProviderOperationID(ctx, providerOperationID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProviderOperationsProviderOperationIDResponse(rsp)
+}
+
+// GetProvidersWithResponse request returning *GetProvidersResponse
+func (c *ClientWithResponses) GetProvidersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetProvidersResponse, error) {
+	rsp, err := c.GetProviders(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProvidersResponse(rsp)
+}
+
+// GetReportSchedulesWithResponse request returning *GetReportSchedulesResponse
+func (c *ClientWithResponses) GetReportSchedulesWithResponse(ctx context.Context, params *GetReportSchedulesParams, reqEditors ...RequestEditorFn) (*GetReportSchedulesResponse, error) {
+	rsp, err := c.GetReportSchedules(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetReportSchedulesResponse(rsp)
+}
+
+// PostReportSchedulesWithBodyWithResponse request with arbitrary body returning *PostReportSchedulesResponse
+func (c *ClientWithResponses) PostReportSchedulesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostReportSchedulesResponse, error) {
+	rsp, err := c.PostReportSchedulesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostReportSchedulesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostReportSchedulesWithResponse(ctx context.Context, body PostReportSchedulesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostReportSchedulesResponse, error) {
+	rsp, err := c.PostReportSchedules(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostReportSchedulesResponse(rsp)
+}
+
+// DeleteReportSchedulesReportScheduleIDWithResponse request returning *DeleteReportSchedulesReportScheduleIDResponse
+func (c *ClientWithResponses) DeleteReportSchedulesReportScheduleIDWithResponse(ctx context.Context, reportScheduleID ReportScheduleID, reqEditors ...RequestEditorFn) (*DeleteReportSchedulesReportScheduleIDResponse, error) {
+	rsp, err := c.DeleteReportSchedulesReportScheduleID(ctx, reportScheduleID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteReportSchedulesReportScheduleIDResponse(rsp)
+}
+
+// GetReportSchedulesReportScheduleIDWithResponse request returning *GetReportSchedulesReportScheduleIDResponse
+func (c *ClientWithResponses) GetReportSchedulesReportScheduleIDWithResponse(ctx context.Context, reportScheduleID ReportScheduleID, params *GetReportSchedulesReportScheduleIDParams, reqEditors ...RequestEditorFn) (*GetReportSchedulesReportScheduleIDResponse, error) {
+	rsp, err := c.GetReportSchedulesReportScheduleID(ctx, reportScheduleID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetReportSchedulesReportScheduleIDResponse(rsp)
+}
+
+// PatchReportSchedulesReportScheduleIDWithBodyWithResponse request with arbitrary body returning *PatchReportSchedulesReportScheduleIDResponse
+func (c *ClientWithResponses) PatchReportSchedulesReportScheduleIDWithBodyWithResponse(ctx context.Context, reportScheduleID ReportScheduleID, params *PatchReportSchedulesReportScheduleIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchReportSchedulesReportScheduleIDResponse, error) {
+	rsp, err := c.PatchReportSchedulesReportScheduleIDWithBody(ctx, reportScheduleID, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchReportSchedulesReportScheduleIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchReportSchedulesReportScheduleIDWithResponse(ctx context.Context, reportScheduleID ReportScheduleID, params *PatchReportSchedulesReportScheduleIDParams, body PatchReportSchedulesReportScheduleIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchReportSchedulesReportScheduleIDResponse, error) {
+	rsp, err := c.PatchReportSchedulesReportScheduleID(ctx, reportScheduleID, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchReportSchedulesReportScheduleIDResponse(rsp)
+}
+
+// GetReportSchedulesReportScheduleIDReportWithResponse request returning *GetReportSchedulesReportScheduleIDReportResponse
+func (c *ClientWithResponses) GetReportSchedulesReportScheduleIDReportWithResponse(ctx context.Context, reportScheduleID ReportScheduleID, reqEditors ...RequestEditorFn) (*GetReportSchedulesReportScheduleIDReportResponse, error) {
+	rsp, err := c.GetReportSchedulesReportScheduleIDReport(ctx, reportScheduleID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetReportSchedulesReportScheduleIDReportResponse(rsp)
+}
+
+// PostSbomsWithBodyWithResponse request with arbitrary body returning *PostSbomsResponse
+func (c *ClientWithResponses) PostSbomsWithBodyWithResponse(ctx context.Context, params *PostSbomsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSbomsResponse, error) {
+	rsp, err := c.PostSbomsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSbomsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSbomsWithResponse(ctx context.Context, params *PostSbomsParams, body PostSbomsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSbomsResponse, error) {
+	rsp, err := c.PostSboms(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSbomsResponse(rsp)
+}
+
+// GetScanConfigTemplatesWithResponse request returning *GetScanConfigTemplatesResponse
+func (c *ClientWithResponses) GetScanConfigTemplatesWithResponse(ctx context.Context, params *GetScanConfigTemplatesParams, reqEditors ...RequestEditorFn) (*GetScanConfigTemplatesResponse, error) {
+	rsp, err := c.GetScanConfigTemplates(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScanConfigTemplatesResponse(rsp)
+}
+
+// PostScanConfigTemplatesWithBodyWithResponse request with arbitrary body returning *PostScanConfigTemplatesResponse
+func (c *ClientWithResponses) PostScanConfigTemplatesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScanConfigTemplatesResponse, error) {
+	rsp, err := c.PostScanConfigTemplatesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostScanConfigTemplatesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostScanConfigTemplatesWithResponse(ctx context.Context, body PostScanConfigTemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScanConfigTemplatesResponse, error) {
+	rsp, err := c.PostScanConfigTemplates(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostScanConfigTemplatesResponse(rsp)
+}
+
+// DeleteScanConfigTemplatesScanConfigTemplateIDWithResponse request returning *DeleteScanConfigTemplatesScanConfigTemplateIDResponse
+func (c *ClientWithResponses) DeleteScanConfigTemplatesScanConfigTemplateIDWithResponse(ctx context.Context, scanConfigTemplateID ScanConfigTemplateID, reqEditors ...RequestEditorFn) (*DeleteScanConfigTemplatesScanConfigTemplateIDResponse, error) {
+	rsp, err := c.DeleteScanConfigTemplatesScanConfigTemplateID(ctx, scanConfigTemplateID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteScanConfigTemplatesScanConfigTemplateIDResponse(rsp)
+}
+
+// GetScanConfigTemplatesScanConfigTemplateIDWithResponse request returning *GetScanConfigTemplatesScanConfigTemplateIDResponse
+func (c *ClientWithResponses) GetScanConfigTemplatesScanConfigTemplateIDWithResponse(ctx context.Context, scanConfigTemplateID ScanConfigTemplateID, params *GetScanConfigTemplatesScanConfigTemplateIDParams, reqEditors ...RequestEditorFn) (*GetScanConfigTemplatesScanConfigTemplateIDResponse, error) {
+	rsp, err := c.GetScanConfigTemplatesScanConfigTemplateID(ctx, scanConfigTemplateID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScanConfigTemplatesScanConfigTemplateIDResponse(rsp)
+}
+
+// PatchScanConfigTemplatesScanConfigTemplateIDWithBodyWithResponse request with arbitrary body returning *PatchScanConfigTemplatesScanConfigTemplateIDResponse
+func (c *ClientWithResponses) PatchScanConfigTemplatesScanConfigTemplateIDWithBodyWithResponse(ctx context.Context, scanConfigTemplateID ScanConfigTemplateID, params *PatchScanConfigTemplatesScanConfigTemplateIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScanConfigTemplatesScanConfigTemplateIDResponse, error) {
+	rsp, err := c.PatchScanConfigTemplatesScanConfigTemplateIDWithBody(ctx, scanConfigTemplateID, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchScanConfigTemplatesScanConfigTemplateIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchScanConfigTemplatesScanConfigTemplateIDWithResponse(ctx context.Context, scanConfigTemplateID ScanConfigTemplateID, params *PatchScanConfigTemplatesScanConfigTemplateIDParams, body PatchScanConfigTemplatesScanConfigTemplateIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScanConfigTemplatesScanConfigTemplateIDResponse, error) {
+	rsp, err := c.PatchScanConfigTemplatesScanConfigTemplateID(ctx, scanConfigTemplateID, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchScanConfigTemplatesScanConfigTemplateIDResponse(rsp)
+}
+
+// GetScanConfigsWithResponse request returning *GetScanConfigsResponse
+func (c *ClientWithResponses) GetScanConfigsWithResponse(ctx context.Context, params *GetScanConfigsParams, reqEditors ...RequestEditorFn) (*GetScanConfigsResponse, error) {
+	rsp, err := c.GetScanConfigs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScanConfigsResponse(rsp)
+}
+
+// PostScanConfigsWithBodyWithResponse request with arbitrary body returning *PostScanConfigsResponse
+func (c *ClientWithResponses) PostScanConfigsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScanConfigsResponse, error) {
+	rsp, err := c.PostScanConfigsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostScanConfigsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostScanConfigsWithResponse(ctx context.Context, body PostScanConfigsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScanConfigsResponse, error) {
+	rsp, err := c.PostScanConfigs(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostScanConfigsResponse(rsp)
+}
+
+// DeleteScanConfigsScanConfigIDWithResponse request returning *DeleteScanConfigsScanConfigIDResponse
+func (c *ClientWithResponses) DeleteScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, reqEditors ...RequestEditorFn) (*DeleteScanConfigsScanConfigIDResponse, error) {
+	rsp, err := c.DeleteScanConfigsScanConfigID(ctx, scanConfigID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteScanConfigsScanConfigIDResponse(rsp)
+}
+
+// GetScanConfigsScanConfigIDWithResponse request returning *GetScanConfigsScanConfigIDResponse
+func (c *ClientWithResponses) GetScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *GetScanConfigsScanConfigIDParams, reqEditors ...RequestEditorFn) (*GetScanConfigsScanConfigIDResponse, error) {
+	rsp, err := c.GetScanConfigsScanConfigID(ctx, scanConfigID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScanConfigsScanConfigIDResponse(rsp)
+}
+
+// PatchScanConfigsScanConfigIDWithBodyWithResponse request with arbitrary body returning *PatchScanConfigsScanConfigIDResponse
+func (c *ClientWithResponses) PatchScanConfigsScanConfigIDWithBodyWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *PatchScanConfigsScanConfigIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScanConfigsScanConfigIDResponse, error) {
+	rsp, err := c.PatchScanConfigsScanConfigIDWithBody(ctx, scanConfigID, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchScanConfigsScanConfigIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *PatchScanConfigsScanConfigIDParams, body PatchScanConfigsScanConfigIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScanConfigsScanConfigIDResponse, error) {
+	rsp, err := c.PatchScanConfigsScanConfigID(ctx, scanConfigID, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchScanConfigsScanConfigIDResponse(rsp)
+}
+
+// PutScanConfigsScanConfigIDWithBodyWithResponse request with arbitrary body returning *PutScanConfigsScanConfigIDResponse
+func (c *ClientWithResponses) PutScanConfigsScanConfigIDWithBodyWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *PutScanConfigsScanConfigIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScanConfigsScanConfigIDResponse, error) {
+	rsp, err := c.PutScanConfigsScanConfigIDWithBody(ctx, scanConfigID, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutScanConfigsScanConfigIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *PutScanConfigsScanConfigIDParams, body PutScanConfigsScanConfigIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScanConfigsScanConfigIDResponse, error) {
+	rsp, err := c.PutScanConfigsScanConfigID(ctx, scanConfigID, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutScanConfigsScanConfigIDResponse(rsp)
+}
+
+// GetScanConfigsScanConfigIDNextRunsWithResponse request returning *GetScanConfigsScanConfigIDNextRunsResponse
+func (c *ClientWithResponses) GetScanConfigsScanConfigIDNextRunsWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *GetScanConfigsScanConfigIDNextRunsParams, reqEditors ...RequestEditorFn) (*GetScanConfigsScanConfigIDNextRunsResponse, error) {
+	rsp, err := c.GetScanConfigsScanConfigIDNextRuns(ctx, scanConfigID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScanConfigsScanConfigIDNextRunsResponse(rsp)
+}
+
+// GetScanResultsWithResponse request returning *GetScanResultsResponse
+func (c *ClientWithResponses) GetScanResultsWithResponse(ctx context.Context, params *GetScanResultsParams, reqEditors ...RequestEditorFn) (*GetScanResultsResponse, error) {
+	rsp, err := c.GetScanResults(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScanResultsResponse(rsp)
+}
+
+// PostScanResultsWithBodyWithResponse request with arbitrary body returning *PostScanResultsResponse
+func (c *ClientWithResponses) PostScanResultsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScanResultsResponse, error) {
+	rsp, err := c.PostScanResultsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostScanResultsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostScanResultsWithResponse(ctx context.Context, body PostScanResultsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScanResultsResponse, error) {
+	rsp, err := c.PostScanResults(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostScanResultsResponse(rsp)
+}
+
+// GetScanResultsScanResultIDWithResponse request returning *GetScanResultsScanResultIDResponse
+func (c *ClientWithResponses) GetScanResultsScanResultIDWithResponse(ctx context.Context, scanResultID ScanResultID, params *GetScanResultsScanResultIDParams, reqEditors ...RequestEditorFn) (*GetScanResultsScanResultIDResponse, error) {
+	rsp, err := c.GetScanResultsScanResultID(ctx, scanResultID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScanResultsScanResultIDResponse(rsp)
+}
+
+// PatchScanResultsScanResultIDWithBodyWithResponse request with arbitrary body returning *PatchScanResultsScanResultIDResponse
+func (c *ClientWithResponses) PatchScanResultsScanResultIDWithBodyWithResponse(ctx context.Context, scanResultID ScanResultID, params *PatchScanResultsScanResultIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScanResultsScanResultIDResponse, error) {
+	rsp, err := c.PatchScanResultsScanResultIDWithBody(ctx, scanResultID, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchScanResultsScanResultIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchScanResultsScanResultIDWithResponse(ctx context.Context, scanResultID ScanResultID, params *PatchScanResultsScanResultIDParams, body PatchScanResultsScanResultIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScanResultsScanResultIDResponse, error) {
+	rsp, err := c.PatchScanResultsScanResultID(ctx, scanResultID, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchScanResultsScanResultIDResponse(rsp)
+}
+
+// PutScanResultsScanResultIDWithBodyWithResponse request with arbitrary body returning *PutScanResultsScanResultIDResponse
+func (c *ClientWithResponses) PutScanResultsScanResultIDWithBodyWithResponse(ctx context.Context, scanResultID ScanResultID, params *PutScanResultsScanResultIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScanResultsScanResultIDResponse, error) {
+	rsp, err := c.PutScanResultsScanResultIDWithBody(ctx, scanResultID, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutScanResultsScanResultIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutScanResultsScanResultIDWithResponse(ctx context.Context, scanResultID ScanResultID, params *PutScanResultsScanResultIDParams, body PutScanResultsScanResultIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScanResultsScanResultIDResponse, error) {
+	rsp, err := c.PutScanResultsScanResultID(ctx, scanResultID, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutScanResultsScanResultIDResponse(rsp)
+}
+
+// GetScanResultsScanResultIDFamiliesFamilyArtifactWithResponse request returning *GetScanResultsScanResultIDFamiliesFamilyArtifactResponse
+func (c *ClientWithResponses) GetScanResultsScanResultIDFamiliesFamilyArtifactWithResponse(ctx context.Context, scanResultID ScanResultID, family ScanResultFamily, reqEditors ...RequestEditorFn) (*GetScanResultsScanResultIDFamiliesFamilyArtifactResponse, error) {
+	rsp, err := c.GetScanResultsScanResultIDFamiliesFamilyArtifact(ctx, scanResultID, family, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScanResultsScanResultIDFamiliesFamilyArtifactResponse(rsp)
+}
+
+// PutScanResultsScanResultIDFamiliesFamilyArtifactWithBodyWithResponse request with arbitrary body returning *PutScanResultsScanResultIDFamiliesFamilyArtifactResponse
+func (c *ClientWithResponses) PutScanResultsScanResultIDFamiliesFamilyArtifactWithBodyWithResponse(ctx context.Context, scanResultID ScanResultID, family ScanResultFamily, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScanResultsScanResultIDFamiliesFamilyArtifactResponse, error) {
+	rsp, err := c.PutScanResultsScanResultIDFamiliesFamilyArtifactWithBody(ctx, scanResultID, family, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutScanResultsScanResultIDFamiliesFamilyArtifactResponse(rsp)
+}
+
+// GetScanResultsScanResultIDFamiliesFamilyItemsWithResponse request returning *GetScanResultsScanResultIDFamiliesFamilyItemsResponse
+func (c *ClientWithResponses) GetScanResultsScanResultIDFamiliesFamilyItemsWithResponse(ctx context.Context, scanResultID ScanResultID, family ScanResultFamily, params *GetScanResultsScanResultIDFamiliesFamilyItemsParams, reqEditors ...RequestEditorFn) (*GetScanResultsScanResultIDFamiliesFamilyItemsResponse, error) {
+	rsp, err := c.GetScanResultsScanResultIDFamiliesFamilyItems(ctx, scanResultID, family, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScanResultsScanResultIDFamiliesFamilyItemsResponse(rsp)
+}
+
+// PostScanResultsScanResultIDFamiliesFamilyItemsWithBodyWithResponse request with arbitrary body returning *PostScanResultsScanResultIDFamiliesFamilyItemsResponse
+func (c *ClientWithResponses) PostScanResultsScanResultIDFamiliesFamilyItemsWithBodyWithResponse(ctx context.Context, scanResultID ScanResultID, family ScanResultFamily, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScanResultsScanResultIDFamiliesFamilyItemsResponse, error) {
+	rsp, err := c.PostScanResultsScanResultIDFamiliesFamilyItemsWithBody(ctx, scanResultID, family, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostScanResultsScanResultIDFamiliesFamilyItemsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostScanResultsScanResultIDFamiliesFamilyItemsWithResponse(ctx context.Context, scanResultID ScanResultID, family ScanResultFamily, body PostScanResultsScanResultIDFamiliesFamilyItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScanResultsScanResultIDFamiliesFamilyItemsResponse, error) {
+	rsp, err := c.PostScanResultsScanResultIDFamiliesFamilyItems(ctx, scanResultID, family, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostScanResultsScanResultIDFamiliesFamilyItemsResponse(rsp)
+}
+
+// PostScanResultsScanResultIDRerunWithResponse request returning *PostScanResultsScanResultIDRerunResponse
+func (c *ClientWithResponses) PostScanResultsScanResultIDRerunWithResponse(ctx context.Context, scanResultID ScanResultID, params *PostScanResultsScanResultIDRerunParams, reqEditors ...RequestEditorFn) (*PostScanResultsScanResultIDRerunResponse, error) {
+	rsp, err := c.PostScanResultsScanResultIDRerun(ctx, scanResultID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostScanResultsScanResultIDRerunResponse(rsp)
+}
+
+// GetScanResultsScanResultIDSbomWithResponse request returning *GetScanResultsScanResultIDSbomResponse
+func (c *ClientWithResponses) GetScanResultsScanResultIDSbomWithResponse(ctx context.Context, scanResultID ScanResultID, params *GetScanResultsScanResultIDSbomParams, reqEditors ...RequestEditorFn) (*GetScanResultsScanResultIDSbomResponse, error) {
+	rsp, err := c.GetScanResultsScanResultIDSbom(ctx, scanResultID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScanResultsScanResultIDSbomResponse(rsp)
+}
+
+// GetScanResultsScanResultIDScannerConfigWithResponse request returning *GetScanResultsScanResultIDScannerConfigResponse
+func (c *ClientWithResponses) GetScanResultsScanResultIDScannerConfigWithResponse(ctx context.Context, scanResultID ScanResultID, reqEditors ...RequestEditorFn) (*GetScanResultsScanResultIDScannerConfigResponse, error) {
+	rsp, err := c.GetScanResultsScanResultIDScannerConfig(ctx, scanResultID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScanResultsScanResultIDScannerConfigResponse(rsp)
+}
+
+// PutScanResultsScanResultIDScannerConfigWithBodyWithResponse request with arbitrary body returning *PutScanResultsScanResultIDScannerConfigResponse
+func (c *ClientWithResponses) PutScanResultsScanResultIDScannerConfigWithBodyWithResponse(ctx context.Context, scanResultID ScanResultID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScanResultsScanResultIDScannerConfigResponse, error) {
+	rsp, err := c.PutScanResultsScanResultIDScannerConfigWithBody(ctx, scanResultID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutScanResultsScanResultIDScannerConfigResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutScanResultsScanResultIDScannerConfigWithResponse(ctx context.Context, scanResultID ScanResultID, body PutScanResultsScanResultIDScannerConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScanResultsScanResultIDScannerConfigResponse, error) {
+	rsp, err := c.PutScanResultsScanResultIDScannerConfig(ctx, scanResultID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutScanResultsScanResultIDScannerConfigResponse(rsp)
+}
+
+// GetScansWithResponse request returning *GetScansResponse
+func (c *ClientWithResponses) GetScansWithResponse(ctx context.Context, params *GetScansParams, reqEditors ...RequestEditorFn) (*GetScansResponse, error) {
+	rsp, err := c.GetScans(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScansResponse(rsp)
+}
+
+// PostScansWithBodyWithResponse request with arbitrary body returning *PostScansResponse
+func (c *ClientWithResponses) PostScansWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScansResponse, error) {
+	rsp, err := c.PostScansWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostScansResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostScansWithResponse(ctx context.Context, body PostScansJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScansResponse, error) {
+	rsp, err := c.PostScans(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostScansResponse(rsp)
+}
+
+// DeleteScansScanIDWithResponse request returning *DeleteScansScanIDResponse
+func (c *ClientWithResponses) DeleteScansScanIDWithResponse(ctx context.Context, scanID ScanID, reqEditors ...RequestEditorFn) (*DeleteScansScanIDResponse, error) {
+	rsp, err := c.DeleteScansScanID(ctx, scanID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteScansScanIDResponse(rsp)
+}
+
+// GetScansScanIDWithResponse request returning *GetScansScanIDResponse
+func (c *ClientWithResponses) GetScansScanIDWithResponse(ctx context.Context, scanID ScanID, params *GetScansScanIDParams, reqEditors ...RequestEditorFn) (*GetScansScanIDResponse, error) {
+	rsp, err := c.GetScansScanID(ctx, scanID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScansScanIDResponse(rsp)
+}
+
+// PatchScansScanIDWithBodyWithResponse request with arbitrary body returning *PatchScansScanIDResponse
+func (c *ClientWithResponses) PatchScansScanIDWithBodyWithResponse(ctx context.Context, scanID ScanID, params *PatchScansScanIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScansScanIDResponse, error) {
+	rsp, err := c.PatchScansScanIDWithBody(ctx, scanID, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchScansScanIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchScansScanIDWithResponse(ctx context.Context, scanID ScanID, params *PatchScansScanIDParams, body PatchScansScanIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScansScanIDResponse, error) {
+	rsp, err := c.PatchScansScanID(ctx, scanID, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchScansScanIDResponse(rsp)
+}
+
+// PutScansScanIDWithBodyWithResponse request with arbitrary body returning *PutScansScanIDResponse
+func (c *ClientWithResponses) PutScansScanIDWithBodyWithResponse(ctx context.Context, scanID ScanID, params *PutScansScanIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScansScanIDResponse, error) {
+	rsp, err := c.PutScansScanIDWithBody(ctx, scanID, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutScansScanIDResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutScansScanIDWithResponse(ctx context.Context, scanID ScanID, params *PutScansScanIDParams, body PutScansScanIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScansScanIDResponse, error) {
+	rsp, err := c.PutScansScanID(ctx, scanID, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutScansScanIDResponse(rsp)
+}
+
+// GetScansScanIDProgressWithResponse request returning *GetScansScanIDProgressResponse
+func (c *ClientWithResponses) GetScansScanIDProgressWithResponse(ctx context.Context, scanID ScanID, reqEditors ...RequestEditorFn) (*GetScansScanIDProgressResponse, error) {
+	rsp, err := c.GetScansScanIDProgress(ctx, scanID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScansScanIDProgressResponse(rsp)
+}
+
+// GetSettingsFindingTemplatesWithResponse request returning *GetSettingsFindingTemplatesResponse
+func (c *ClientWithResponses) GetSettingsFindingTemplatesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSettingsFindingTemplatesResponse, error) {
+	rsp, err := c.GetSettingsFindingTemplates(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSettingsFindingTemplatesResponse(rsp)
+}
+
+// PutSettingsFindingTemplatesWithBodyWithResponse request with arbitrary body returning *PutSettingsFindingTemplatesResponse
+func (c *ClientWithResponses) PutSettingsFindingTemplatesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutSettingsFindingTemplatesResponse, error) {
+	rsp, err := c.PutSettingsFindingTemplatesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutSettingsFindingTemplatesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutSettingsFindingTemplatesWithResponse(ctx context.Context, body PutSettingsFindingTemplatesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutSettingsFindingTemplatesResponse, error) {
+	rsp, err := c.PutSettingsFindingTemplates(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutSettingsFindingTemplatesResponse(rsp)
+}
+
+// PostSettingsFindingTemplatesPreviewWithBodyWithResponse request with arbitrary body returning *PostSettingsFindingTemplatesPreviewResponse
+func (c *ClientWithResponses) PostSettingsFindingTemplatesPreviewWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSettingsFindingTemplatesPreviewResponse, error) {
+	rsp, err := c.PostSettingsFindingTemplatesPreviewWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSettingsFindingTemplatesPreviewResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSettingsFindingTemplatesPreviewWithResponse(ctx context.Context, body PostSettingsFindingTemplatesPreviewJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSettingsFindingTemplatesPreviewResponse, error) {
+	rsp, err := c.PostSettingsFindingTemplatesPreview(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSettingsFindingTemplatesPreviewResponse(rsp)
+}
+
+// GetSettingsRetentionWithResponse request returning *GetSettingsRetentionResponse
+func (c *ClientWithResponses) GetSettingsRetentionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSettingsRetentionResponse, error) {
+	rsp, err := c.GetSettingsRetention(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSettingsRetentionResponse(rsp)
+}
+
+// PutSettingsRetentionWithBodyWithResponse request with arbitrary body returning *PutSettingsRetentionResponse
+func (c *ClientWithResponses) PutSettingsRetentionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutSettingsRetentionResponse, error) {
+	rsp, err := c.PutSettingsRetentionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutSettingsRetentionResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutSettingsRetentionWithResponse(ctx context.Context, body PutSettingsRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*PutSettingsRetentionResponse, error) {
+	rsp, err := c.PutSettingsRetention(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutSettingsRetentionResponse(rsp)
+}
+
+// GetUserPreferencesWithResponse request returning *GetUserPreferencesResponse
+func (c *ClientWithResponses) GetUserPreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserPreferencesResponse, error) {
+	rsp, err := c.GetUserPreferences(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserPreferencesResponse(rsp)
+}
+
+// PutUserPreferencesWithBodyWithResponse request with arbitrary body returning *PutUserPreferencesResponse
+func (c *ClientWithResponses) PutUserPreferencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutUserPreferencesResponse, error) {
+	rsp, err := c.PutUserPreferencesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutUserPreferencesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutUserPreferencesWithResponse(ctx context.Context, body PutUserPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUserPreferencesResponse, error) {
+	rsp, err := c.PutUserPreferences(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutUserPreferencesResponse(rsp)
+}
+
+// ParseGetAdminFeedsResponse parses an HTTP response from a GetAdminFeedsWithResponse call
+func ParseGetAdminFeedsResponse(rsp *http.Response) (*GetAdminFeedsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminFeedsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Feeds
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminFeedsRefreshResponse parses an HTTP response from a PostAdminFeedsRefreshWithResponse call
+func ParsePostAdminFeedsRefreshResponse(rsp *http.Response) (*PostAdminFeedsRefreshResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminFeedsRefreshResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest BackgroundTask
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminMaintenanceResponse parses an HTTP response from a GetAdminMaintenanceWithResponse call
+func ParseGetAdminMaintenanceResponse(rsp *http.Response) (*GetAdminMaintenanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminMaintenanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MaintenanceMode
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAdminMaintenanceResponse parses an HTTP response from a PutAdminMaintenanceWithResponse call
+func ParsePutAdminMaintenanceResponse(rsp *http.Response) (*PutAdminMaintenanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAdminMaintenanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MaintenanceMode
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminTasksResponse parses an HTTP response from a GetAdminTasksWithResponse call
+func ParseGetAdminTasksResponse(rsp *http.Response) (*GetAdminTasksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminTasksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BackgroundTasks
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminTasksTaskNameRunResponse parses an HTTP response from a PostAdminTasksTaskNameRunWithResponse call
+func ParsePostAdminTasksTaskNameRunResponse(rsp *http.Response) (*PostAdminTasksTaskNameRunResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminTasksTaskNameRunResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest BackgroundTask
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminUsageResponse parses an HTTP response from a GetAdminUsageWithResponse call
+func ParseGetAdminUsageResponse(rsp *http.Response) (*GetAdminUsageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminUsageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Usage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Operation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIKeysResponse parses an HTTP response from a GetAPIKeysWithResponse call
+func ParseGetAPIKeysResponse(rsp *http.Response) (*GetAPIKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest APIKeys
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAPIKeysResponse parses an HTTP response from a PostAPIKeysWithResponse call
+func ParsePostAPIKeysResponse(rsp *http.Response) (*PostAPIKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAPIKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest APIKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAPIKeysAPIKeyIDResponse parses an HTTP response from a DeleteAPIKeysAPIKeyIDWithResponse call
+func ParseDeleteAPIKeysAPIKeyIDResponse(rsp *http.Response) (*DeleteAPIKeysAPIKeyIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAPIKeysAPIKeyIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAPIKeysAPIKeyIDResponse parses an HTTP response from a GetAPIKeysAPIKeyIDWithResponse call
+func ParseGetAPIKeysAPIKeyIDResponse(rsp *http.Response) (*GetAPIKeysAPIKeyIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAPIKeysAPIKeyIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest APIKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAssetGroupsResponse parses an HTTP response from a GetAssetGroupsWithResponse call
+func ParseGetAssetGroupsResponse(rsp *http.Response) (*GetAssetGroupsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAssetGroupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AssetGroups
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAssetGroupsResponse parses an HTTP response from a PostAssetGroupsWithResponse call
+func ParsePostAssetGroupsResponse(rsp *http.Response) (*PostAssetGroupsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAssetGroupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AssetGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest AssetGroupExists
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAssetGroupsAssetGroupIDResponse parses an HTTP response from a DeleteAssetGroupsAssetGroupIDWithResponse call
+func ParseDeleteAssetGroupsAssetGroupIDResponse(rsp *http.Response) (*DeleteAssetGroupsAssetGroupIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAssetGroupsAssetGroupIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAssetGroupsAssetGroupIDResponse parses an HTTP response from a GetAssetGroupsAssetGroupIDWithResponse call
+func ParseGetAssetGroupsAssetGroupIDResponse(rsp *http.Response) (*GetAssetGroupsAssetGroupIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAssetGroupsAssetGroupIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AssetGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchAssetGroupsAssetGroupIDResponse parses an HTTP response from a PatchAssetGroupsAssetGroupIDWithResponse call
+func ParsePatchAssetGroupsAssetGroupIDResponse(rsp *http.Response) (*PatchAssetGroupsAssetGroupIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchAssetGroupsAssetGroupIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AssetGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest AssetGroupExists
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAssetGroupsAssetGroupIDAssetsResponse parses an HTTP response from a GetAssetGroupsAssetGroupIDAssetsWithResponse call
+func ParseGetAssetGroupsAssetGroupIDAssetsResponse(rsp *http.Response) (*GetAssetGroupsAssetGroupIDAssetsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAssetGroupsAssetGroupIDAssetsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Assets
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAssetsResponse parses an HTTP response from a GetAssetsWithResponse call
+func ParseGetAssetsResponse(rsp *http.Response) (*GetAssetsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAssetsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Assets
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (application/x-ndjson) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAssetsResponse parses an HTTP response from a PostAssetsWithResponse call
+func ParsePostAssetsResponse(rsp *http.Response) (*PostAssetsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAssetsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Asset
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest AssetExists
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAssetsAssetIDResponse parses an HTTP response from a DeleteAssetsAssetIDWithResponse call
+func ParseDeleteAssetsAssetIDResponse(rsp *http.Response) (*DeleteAssetsAssetIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAssetsAssetIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAssetsAssetIDResponse parses an HTTP response from a GetAssetsAssetIDWithResponse call
+func ParseGetAssetsAssetIDResponse(rsp *http.Response) (*GetAssetsAssetIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAssetsAssetIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Asset
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchAssetsAssetIDResponse parses an HTTP response from a PatchAssetsAssetIDWithResponse call
+func ParsePatchAssetsAssetIDResponse(rsp *http.Response) (*PatchAssetsAssetIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchAssetsAssetIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Asset
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest AssetExists
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAssetsAssetIDResponse parses an HTTP response from a PutAssetsAssetIDWithResponse call
+func ParsePutAssetsAssetIDResponse(rsp *http.Response) (*PutAssetsAssetIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAssetsAssetIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Asset
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest AssetExists
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAssetsAssetIDPackagesResponse parses an HTTP response from a GetAssetsAssetIDPackagesWithResponse call
+func ParseGetAssetsAssetIDPackagesResponse(rsp *http.Response) (*GetAssetsAssetIDPackagesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAssetsAssetIDPackagesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InstalledPackages
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAssetsAssetIDSbomDiffResponse parses an HTTP response from a GetAssetsAssetIDSbomDiffWithResponse call
+func ParseGetAssetsAssetIDSbomDiffResponse(rsp *http.Response) (*GetAssetsAssetIDSbomDiffResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAssetsAssetIDSbomDiffResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SbomDiff
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAssetsAssetIDScanResultDiffResponse parses an HTTP response from a GetAssetsAssetIDScanResultDiffWithResponse call
+func ParseGetAssetsAssetIDScanResultDiffResponse(rsp *http.Response) (*GetAssetsAssetIDScanResultDiffResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAssetsAssetIDScanResultDiffResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScanResultDiff
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAssetsAssetIDUpgradePlanResponse parses an HTTP response from a GetAssetsAssetIDUpgradePlanWithResponse call
+func ParseGetAssetsAssetIDUpgradePlanResponse(rsp *http.Response) (*GetAssetsAssetIDUpgradePlanResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAssetsAssetIDUpgradePlanResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UpgradePlan
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Operation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCorrelatedFindingsResponse parses an HTTP response from a GetCorrelatedFindingsWithResponse call
+func ParseGetCorrelatedFindingsResponse(rsp *http.Response) (*GetCorrelatedFindingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCorrelatedFindingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CorrelatedFindings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCorrelatedFindingsCorrelatedFindingIDResponse parses an HTTP response from a GetCorrelatedFindingsCorrelatedFindingIDWithResponse call
+func ParseGetCorrelatedFindingsCorrelatedFindingIDResponse(rsp *http.Response) (*GetCorrelatedFindingsCorrelatedFindingIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCorrelatedFindingsCorrelatedFindingIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CorrelatedFinding
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDiscoveryScopesResponse parses an HTTP response from a GetDiscoveryScopesWithResponse call
+func ParseGetDiscoveryScopesResponse(rsp *http.Response) (*GetDiscoveryScopesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDiscoveryScopesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Scopes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutDiscoveryScopesResponse parses an HTTP response from a PutDiscoveryScopesWithResponse call
+func ParsePutDiscoveryScopesResponse(rsp *http.Response) (*PutDiscoveryScopesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutDiscoveryScopesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Scopes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetFeedsFeedNameResponse parses an HTTP response from a GetFeedsFeedNameWithResponse call
+func ParseGetFeedsFeedNameResponse(rsp *http.Response) (*GetFeedsFeedNameResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFeedsFeedNameResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetFindingDigestsResponse parses an HTTP response from a GetFindingDigestsWithResponse call
+func ParseGetFindingDigestsResponse(rsp *http.Response) (*GetFindingDigestsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFindingDigestsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FindingDigests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostFindingDigestsResponse parses an HTTP response from a PostFindingDigestsWithResponse call
+func ParsePostFindingDigestsResponse(rsp *http.Response) (*PostFindingDigestsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostFindingDigestsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest FindingDigest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteFindingDigestsFindingDigestIDResponse parses an HTTP response from a DeleteFindingDigestsFindingDigestIDWithResponse call
+func ParseDeleteFindingDigestsFindingDigestIDResponse(rsp *http.Response) (*DeleteFindingDigestsFindingDigestIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteFindingDigestsFindingDigestIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetFindingDigestsFindingDigestIDResponse parses an HTTP response from a GetFindingDigestsFindingDigestIDWithResponse call
+func ParseGetFindingDigestsFindingDigestIDResponse(rsp *http.Response) (*GetFindingDigestsFindingDigestIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFindingDigestsFindingDigestIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FindingDigest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchFindingDigestsFindingDigestIDResponse parses an HTTP response from a PatchFindingDigestsFindingDigestIDWithResponse call
+func ParsePatchFindingDigestsFindingDigestIDResponse(rsp *http.Response) (*PatchFindingDigestsFindingDigestIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchFindingDigestsFindingDigestIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FindingDigest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetFindingExceptionsResponse parses an HTTP response from a GetFindingExceptionsWithResponse call
+func ParseGetFindingExceptionsResponse(rsp *http.Response) (*GetFindingExceptionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFindingExceptionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FindingExceptions
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostFindingExceptionsResponse parses an HTTP response from a PostFindingExceptionsWithResponse call
+func ParsePostFindingExceptionsResponse(rsp *http.Response) (*PostFindingExceptionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostFindingExceptionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest FindingException
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteFindingExceptionsFindingExceptionIDResponse parses an HTTP response from a DeleteFindingExceptionsFindingExceptionIDWithResponse call
+func ParseDeleteFindingExceptionsFindingExceptionIDResponse(rsp *http.Response) (*DeleteFindingExceptionsFindingExceptionIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteFindingExceptionsFindingExceptionIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetFindingExceptionsFindingExceptionIDResponse parses an HTTP response from a GetFindingExceptionsFindingExceptionIDWithResponse call
+func ParseGetFindingExceptionsFindingExceptionIDResponse(rsp *http.Response) (*GetFindingExceptionsFindingExceptionIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFindingExceptionsFindingExceptionIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FindingException
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchFindingExceptionsFindingExceptionIDResponse parses an HTTP response from a PatchFindingExceptionsFindingExceptionIDWithResponse call
+func ParsePatchFindingExceptionsFindingExceptionIDResponse(rsp *http.Response) (*PatchFindingExceptionsFindingExceptionIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchFindingExceptionsFindingExceptionIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FindingException
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetFindingsResponse parses an HTTP response from a GetFindingsWithResponse call
+func ParseGetFindingsResponse(rsp *http.Response) (*GetFindingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFindingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Findings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (application/x-ndjson) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParsePostFindingsResponse parses an HTTP response from a PostFindingsWithResponse call
+func ParsePostFindingsResponse(rsp *http.Response) (*PostFindingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostFindingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Finding
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest FindingExists
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchFindingsBulkResponse parses an HTTP response from a PatchFindingsBulkWithResponse call
+func ParsePatchFindingsBulkResponse(rsp *http.Response) (*PatchFindingsBulkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchFindingsBulkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FindingsBulkResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostFindingsBulkResponse parses an HTTP response from a PostFindingsBulkWithResponse call
+func ParsePostFindingsBulkResponse(rsp *http.Response) (*PostFindingsBulkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostFindingsBulkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FindingsBulkResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteFindingsFindingIDResponse parses an HTTP response from a DeleteFindingsFindingIDWithResponse call
+func ParseDeleteFindingsFindingIDResponse(rsp *http.Response) (*DeleteFindingsFindingIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteFindingsFindingIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetFindingsFindingIDResponse parses an HTTP response from a GetFindingsFindingIDWithResponse call
+func ParseGetFindingsFindingIDResponse(rsp *http.Response) (*GetFindingsFindingIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFindingsFindingIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Finding
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchFindingsFindingIDResponse parses an HTTP response from a PatchFindingsFindingIDWithResponse call
+func ParsePatchFindingsFindingIDResponse(rsp *http.Response) (*PatchFindingsFindingIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchFindingsFindingIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Finding
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutFindingsFindingIDResponse parses an HTTP response from a PutFindingsFindingIDWithResponse call
+func ParsePutFindingsFindingIDResponse(rsp *http.Response) (*PutFindingsFindingIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutFindingsFindingIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Finding
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostFindingsFindingIDScannerSuppressionResponse parses an HTTP response from a PostFindingsFindingIDScannerSuppressionWithResponse call
+func ParsePostFindingsFindingIDScannerSuppressionResponse(rsp *http.Response) (*PostFindingsFindingIDScannerSuppressionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostFindingsFindingIDScannerSuppressionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScannerSuppression
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ScannerSuppression
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetNotificationConfigsResponse parses an HTTP response from a GetNotificationConfigsWithResponse call
+func ParseGetNotificationConfigsResponse(rsp *http.Response) (*GetNotificationConfigsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetNotificationConfigsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NotificationConfigs
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostNotificationConfigsResponse parses an HTTP response from a PostNotificationConfigsWithResponse call
+func ParsePostNotificationConfigsResponse(rsp *http.Response) (*PostNotificationConfigsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostNotificationConfigsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest NotificationConfig
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteNotificationConfigsNotificationConfigIDResponse parses an HTTP response from a DeleteNotificationConfigsNotificationConfigIDWithResponse call
+func ParseDeleteNotificationConfigsNotificationConfigIDResponse(rsp *http.Response) (*DeleteNotificationConfigsNotificationConfigIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteNotificationConfigsNotificationConfigIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetNotificationConfigsNotificationConfigIDResponse parses an HTTP response from a GetNotificationConfigsNotificationConfigIDWithResponse call
+func ParseGetNotificationConfigsNotificationConfigIDResponse(rsp *http.Response) (*GetNotificationConfigsNotificationConfigIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetNotificationConfigsNotificationConfigIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NotificationConfig
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchNotificationConfigsNotificationConfigIDResponse parses an HTTP response from a PatchNotificationConfigsNotificationConfigIDWithResponse call
+func ParsePatchNotificationConfigsNotificationConfigIDResponse(rsp *http.Response) (*PatchNotificationConfigsNotificationConfigIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchNotificationConfigsNotificationConfigIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NotificationConfig
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOperationsOperationIDResponse parses an HTTP response from a GetOperationsOperationIDWithResponse call
+func ParseGetOperationsOperationIDResponse(rsp *http.Response) (*GetOperationsOperationIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOperationsOperationIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Operation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOperationsOperationIDResultResponse parses an HTTP response from a GetOperationsOperationIDResultWithResponse call
+func ParseGetOperationsOperationIDResultResponse(rsp *http.Response) (*GetOperationsOperationIDResultResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOperationsOperationIDResultResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Operation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPostureScoresResponse parses an HTTP response from a GetPostureScoresWithResponse call
+func ParseGetPostureScoresResponse(rsp *http.Response) (*GetPostureScoresResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPostureScoresResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PostureScores
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProviderOperationsResponse parses an HTTP response from a GetProviderOperationsWithResponse call
+func ParseGetProviderOperationsResponse(rsp *http.Response) (*GetProviderOperationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProviderOperationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProviderOperations
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return 0
-}
 
-type DeleteTargetsTargetIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessResponse
-	JSON400      *ApiResponse
-	JSON404      *ApiResponse
-	JSONDefault  *ApiResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteTargetsTargetIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParsePostProviderOperationsResponse parses an HTTP response from a PostProviderOperationsWithResponse call
+func ParsePostProviderOperationsResponse(rsp *http.Response) (*PostProviderOperationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteTargetsTargetIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &PostProviderOperationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetTargetsTargetIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Target
-	JSON404      *ApiResponse
-	JSONDefault  *ApiResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ProviderOperation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetTargetsTargetIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTargetsTargetIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type PatchTargetsTargetIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Target
-	JSON404      *ApiResponse
-	JSON409      *TargetExists
-	JSON412      *ApiResponse
-	JSONDefault  *ApiResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PatchTargetsTargetIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetProviderOperationsProviderOperationIDResponse parses an HTTP response from a GetProviderOperationsProviderOperationIDWithResponse call
+func ParseGetProviderOperationsProviderOperationIDResponse(rsp *http.Response) (*GetProviderOperationsProviderOperationIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PatchTargetsTargetIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetProviderOperationsProviderOperationIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type PutTargetsTargetIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Target
-	JSON400      *ApiResponse
-	JSON404      *ApiResponse
-	JSON409      *TargetExists
-	JSON412      *ApiResponse
-	JSONDefault  *ApiResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProviderOperation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r PutTargetsTargetIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutTargetsTargetIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-// GetDiscoveryScopesWithResponse request returning *GetDiscoveryScopesResponse
-func (c *ClientWithResponses) GetDiscoveryScopesWithResponse(ctx context.Context, params *GetDiscoveryScopesParams, reqEditors ...RequestEditorFn) (*GetDiscoveryScopesResponse, error) {
-	rsp, err := c.GetDiscoveryScopes(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseGetDiscoveryScopesResponse(rsp)
+
+	return response, nil
 }
 
-// PutDiscoveryScopesWithBodyWithResponse request with arbitrary body returning *PutDiscoveryScopesResponse
-func (c *ClientWithResponses) PutDiscoveryScopesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutDiscoveryScopesResponse, error) {
-	rsp, err := c.PutDiscoveryScopesWithBody(ctx, contentType, body, reqEditors...)
+// ParseGetProvidersResponse parses an HTTP response from a GetProvidersWithResponse call
+func ParseGetProvidersResponse(rsp *http.Response) (*GetProvidersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutDiscoveryScopesResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutDiscoveryScopesWithResponse(ctx context.Context, body PutDiscoveryScopesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutDiscoveryScopesResponse, error) {
-	rsp, err := c.PutDiscoveryScopes(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetProvidersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePutDiscoveryScopesResponse(rsp)
-}
 
-// GetFindingsWithResponse request returning *GetFindingsResponse
-func (c *ClientWithResponses) GetFindingsWithResponse(ctx context.Context, params *GetFindingsParams, reqEditors ...RequestEditorFn) (*GetFindingsResponse, error) {
-	rsp, err := c.GetFindings(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Providers
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseGetFindingsResponse(rsp)
+
+	return response, nil
 }
 
-// PostFindingsWithBodyWithResponse request with arbitrary body returning *PostFindingsResponse
-func (c *ClientWithResponses) PostFindingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostFindingsResponse, error) {
-	rsp, err := c.PostFindingsWithBody(ctx, contentType, body, reqEditors...)
+// ParseGetReportSchedulesResponse parses an HTTP response from a GetReportSchedulesWithResponse call
+func ParseGetReportSchedulesResponse(rsp *http.Response) (*GetReportSchedulesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostFindingsResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostFindingsWithResponse(ctx context.Context, body PostFindingsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostFindingsResponse, error) {
-	rsp, err := c.PostFindings(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetReportSchedulesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostFindingsResponse(rsp)
-}
 
-// DeleteFindingsFindingIDWithResponse request returning *DeleteFindingsFindingIDResponse
-func (c *ClientWithResponses) DeleteFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, reqEditors ...RequestEditorFn) (*DeleteFindingsFindingIDResponse, error) {
-	rsp, err := c.DeleteFindingsFindingID(ctx, findingID, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReportSchedules
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseDeleteFindingsFindingIDResponse(rsp)
+
+	return response, nil
 }
 
-// GetFindingsFindingIDWithResponse request returning *GetFindingsFindingIDResponse
-func (c *ClientWithResponses) GetFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, params *GetFindingsFindingIDParams, reqEditors ...RequestEditorFn) (*GetFindingsFindingIDResponse, error) {
-	rsp, err := c.GetFindingsFindingID(ctx, findingID, params, reqEditors...)
+// ParsePostReportSchedulesResponse parses an HTTP response from a PostReportSchedulesWithResponse call
+func ParsePostReportSchedulesResponse(rsp *http.Response) (*PostReportSchedulesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetFindingsFindingIDResponse(rsp)
-}
 
-// PatchFindingsFindingIDWithBodyWithResponse request with arbitrary body returning *PatchFindingsFindingIDResponse
-func (c *ClientWithResponses) PatchFindingsFindingIDWithBodyWithResponse(ctx context.Context, findingID FindingID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchFindingsFindingIDResponse, error) {
-	rsp, err := c.PatchFindingsFindingIDWithBody(ctx, findingID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostReportSchedulesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePatchFindingsFindingIDResponse(rsp)
-}
 
-func (c *ClientWithResponses) PatchFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, body PatchFindingsFindingIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchFindingsFindingIDResponse, error) {
-	rsp, err := c.PatchFindingsFindingID(ctx, findingID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ReportSchedule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParsePatchFindingsFindingIDResponse(rsp)
-}
 
-// PutFindingsFindingIDWithBodyWithResponse request with arbitrary body returning *PutFindingsFindingIDResponse
-func (c *ClientWithResponses) PutFindingsFindingIDWithBodyWithResponse(ctx context.Context, findingID FindingID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutFindingsFindingIDResponse, error) {
-	rsp, err := c.PutFindingsFindingIDWithBody(ctx, findingID, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePutFindingsFindingIDResponse(rsp)
+	return response, nil
 }
 
-func (c *ClientWithResponses) PutFindingsFindingIDWithResponse(ctx context.Context, findingID FindingID, body PutFindingsFindingIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutFindingsFindingIDResponse, error) {
-	rsp, err := c.PutFindingsFindingID(ctx, findingID, body, reqEditors...)
+// ParseDeleteReportSchedulesReportScheduleIDResponse parses an HTTP response from a DeleteReportSchedulesReportScheduleIDWithResponse call
+func ParseDeleteReportSchedulesReportScheduleIDResponse(rsp *http.Response) (*DeleteReportSchedulesReportScheduleIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutFindingsFindingIDResponse(rsp)
-}
 
-// GetScanConfigsWithResponse request returning *GetScanConfigsResponse
-func (c *ClientWithResponses) GetScanConfigsWithResponse(ctx context.Context, params *GetScanConfigsParams, reqEditors ...RequestEditorFn) (*GetScanConfigsResponse, error) {
-	rsp, err := c.GetScanConfigs(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteReportSchedulesReportScheduleIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetScanConfigsResponse(rsp)
-}
 
-// PostScanConfigsWithBodyWithResponse request with arbitrary body returning *PostScanConfigsResponse
-func (c *ClientWithResponses) PostScanConfigsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScanConfigsResponse, error) {
-	rsp, err := c.PostScanConfigsWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostScanConfigsResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-func (c *ClientWithResponses) PostScanConfigsWithResponse(ctx context.Context, body PostScanConfigsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScanConfigsResponse, error) {
-	rsp, err := c.PostScanConfigs(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostScanConfigsResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-// DeleteScanConfigsScanConfigIDWithResponse request returning *DeleteScanConfigsScanConfigIDResponse
-func (c *ClientWithResponses) DeleteScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, reqEditors ...RequestEditorFn) (*DeleteScanConfigsScanConfigIDResponse, error) {
-	rsp, err := c.DeleteScanConfigsScanConfigID(ctx, scanConfigID, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseDeleteScanConfigsScanConfigIDResponse(rsp)
+
+	return response, nil
 }
 
-// GetScanConfigsScanConfigIDWithResponse request returning *GetScanConfigsScanConfigIDResponse
-func (c *ClientWithResponses) GetScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *GetScanConfigsScanConfigIDParams, reqEditors ...RequestEditorFn) (*GetScanConfigsScanConfigIDResponse, error) {
-	rsp, err := c.GetScanConfigsScanConfigID(ctx, scanConfigID, params, reqEditors...)
+// ParseGetReportSchedulesReportScheduleIDResponse parses an HTTP response from a GetReportSchedulesReportScheduleIDWithResponse call
+func ParseGetReportSchedulesReportScheduleIDResponse(rsp *http.Response) (*GetReportSchedulesReportScheduleIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetScanConfigsScanConfigIDResponse(rsp)
-}
 
-// PatchScanConfigsScanConfigIDWithBodyWithResponse request with arbitrary body returning *PatchScanConfigsScanConfigIDResponse
-func (c *ClientWithResponses) PatchScanConfigsScanConfigIDWithBodyWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *PatchScanConfigsScanConfigIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScanConfigsScanConfigIDResponse, error) {
-	rsp, err := c.PatchScanConfigsScanConfigIDWithBody(ctx, scanConfigID, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetReportSchedulesReportScheduleIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePatchScanConfigsScanConfigIDResponse(rsp)
-}
 
-func (c *ClientWithResponses) PatchScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *PatchScanConfigsScanConfigIDParams, body PatchScanConfigsScanConfigIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScanConfigsScanConfigIDResponse, error) {
-	rsp, err := c.PatchScanConfigsScanConfigID(ctx, scanConfigID, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReportSchedule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParsePatchScanConfigsScanConfigIDResponse(rsp)
+
+	return response, nil
 }
 
-// PutScanConfigsScanConfigIDWithBodyWithResponse request with arbitrary body returning *PutScanConfigsScanConfigIDResponse
-func (c *ClientWithResponses) PutScanConfigsScanConfigIDWithBodyWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *PutScanConfigsScanConfigIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScanConfigsScanConfigIDResponse, error) {
-	rsp, err := c.PutScanConfigsScanConfigIDWithBody(ctx, scanConfigID, params, contentType, body, reqEditors...)
+// ParsePatchReportSchedulesReportScheduleIDResponse parses an HTTP response from a PatchReportSchedulesReportScheduleIDWithResponse call
+func ParsePatchReportSchedulesReportScheduleIDResponse(rsp *http.Response) (*PatchReportSchedulesReportScheduleIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutScanConfigsScanConfigIDResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutScanConfigsScanConfigIDWithResponse(ctx context.Context, scanConfigID ScanConfigID, params *PutScanConfigsScanConfigIDParams, body PutScanConfigsScanConfigIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScanConfigsScanConfigIDResponse, error) {
-	rsp, err := c.PutScanConfigsScanConfigID(ctx, scanConfigID, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PatchReportSchedulesReportScheduleIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePutScanConfigsScanConfigIDResponse(rsp)
-}
 
-// GetScanResultsWithResponse request returning *GetScanResultsResponse
-func (c *ClientWithResponses) GetScanResultsWithResponse(ctx context.Context, params *GetScanResultsParams, reqEditors ...RequestEditorFn) (*GetScanResultsResponse, error) {
-	rsp, err := c.GetScanResults(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReportSchedule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseGetScanResultsResponse(rsp)
+
+	return response, nil
 }
 
-// PostScanResultsWithBodyWithResponse request with arbitrary body returning *PostScanResultsResponse
-func (c *ClientWithResponses) PostScanResultsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScanResultsResponse, error) {
-	rsp, err := c.PostScanResultsWithBody(ctx, contentType, body, reqEditors...)
+// ParseGetReportSchedulesReportScheduleIDReportResponse parses an HTTP response from a GetReportSchedulesReportScheduleIDReportWithResponse call
+func ParseGetReportSchedulesReportScheduleIDReportResponse(rsp *http.Response) (*GetReportSchedulesReportScheduleIDReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostScanResultsResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostScanResultsWithResponse(ctx context.Context, body PostScanResultsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScanResultsResponse, error) {
-	rsp, err := c.PostScanResults(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetReportSchedulesReportScheduleIDReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostScanResultsResponse(rsp)
-}
 
-// GetScanResultsScanResultIDWithResponse request returning *GetScanResultsScanResultIDResponse
-func (c *ClientWithResponses) GetScanResultsScanResultIDWithResponse(ctx context.Context, scanResultID ScanResultID, params *GetScanResultsScanResultIDParams, reqEditors ...RequestEditorFn) (*GetScanResultsScanResultIDResponse, error) {
-	rsp, err := c.GetScanResultsScanResultID(ctx, scanResultID, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParseGetScanResultsScanResultIDResponse(rsp)
+
+	return response, nil
 }
 
-// PatchScanResultsScanResultIDWithBodyWithResponse request with arbitrary body returning *PatchScanResultsScanResultIDResponse
-func (c *ClientWithResponses) PatchScanResultsScanResultIDWithBodyWithResponse(ctx context.Context, scanResultID ScanResultID, params *PatchScanResultsScanResultIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScanResultsScanResultIDResponse, error) {
-	rsp, err := c.PatchScanResultsScanResultIDWithBody(ctx, scanResultID, params, contentType, body, reqEditors...)
+// ParsePostSbomsResponse parses an HTTP response from a PostSbomsWithResponse call
+func ParsePostSbomsResponse(rsp *http.Response) (*PostSbomsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchScanResultsScanResultIDResponse(rsp)
-}
 
-func (c *ClientWithResponses) PatchScanResultsScanResultIDWithResponse(ctx context.Context, scanResultID ScanResultID, params *PatchScanResultsScanResultIDParams, body PatchScanResultsScanResultIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScanResultsScanResultIDResponse, error) {
-	rsp, err := c.PatchScanResultsScanResultID(ctx, scanResultID, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostSbomsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePatchScanResultsScanResultIDResponse(rsp)
-}
 
-// PutScanResultsScanResultIDWithBodyWithResponse request with arbitrary body returning *PutScanResultsScanResultIDResponse
-func (c *ClientWithResponses) PutScanResultsScanResultIDWithBodyWithResponse(ctx context.Context, scanResultID ScanResultID, params *PutScanResultsScanResultIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScanResultsScanResultIDResponse, error) {
-	rsp, err := c.PutScanResultsScanResultIDWithBody(ctx, scanResultID, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Operation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParsePutScanResultsScanResultIDResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutScanResultsScanResultIDWithResponse(ctx context.Context, scanResultID ScanResultID, params *PutScanResultsScanResultIDParams, body PutScanResultsScanResultIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScanResultsScanResultIDResponse, error) {
-	rsp, err := c.PutScanResultsScanResultID(ctx, scanResultID, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePutScanResultsScanResultIDResponse(rsp)
+	return response, nil
 }
 
-// GetScansWithResponse request returning *GetScansResponse
-func (c *ClientWithResponses) GetScansWithResponse(ctx context.Context, params *GetScansParams, reqEditors ...RequestEditorFn) (*GetScansResponse, error) {
-	rsp, err := c.GetScans(ctx, params, reqEditors...)
+// ParseGetScanConfigTemplatesResponse parses an HTTP response from a GetScanConfigTemplatesWithResponse call
+func ParseGetScanConfigTemplatesResponse(rsp *http.Response) (*GetScanConfigTemplatesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetScansResponse(rsp)
-}
 
-// PostScansWithBodyWithResponse request with arbitrary body returning *PostScansResponse
-func (c *ClientWithResponses) PostScansWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScansResponse, error) {
-	rsp, err := c.PostScansWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetScanConfigTemplatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostScansResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostScansWithResponse(ctx context.Context, body PostScansJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScansResponse, error) {
-	rsp, err := c.PostScans(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostScansResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScanConfigTemplates
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// DeleteScansScanIDWithResponse request returning *DeleteScansScanIDResponse
-func (c *ClientWithResponses) DeleteScansScanIDWithResponse(ctx context.Context, scanID ScanID, reqEditors ...RequestEditorFn) (*DeleteScansScanIDResponse, error) {
-	rsp, err := c.DeleteScansScanID(ctx, scanID, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteScansScanIDResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// GetScansScanIDWithResponse request returning *GetScansScanIDResponse
-func (c *ClientWithResponses) GetScansScanIDWithResponse(ctx context.Context, scanID ScanID, params *GetScansScanIDParams, reqEditors ...RequestEditorFn) (*GetScansScanIDResponse, error) {
-	rsp, err := c.GetScansScanID(ctx, scanID, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetScansScanIDResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-// PatchScansScanIDWithBodyWithResponse request with arbitrary body returning *PatchScansScanIDResponse
-func (c *ClientWithResponses) PatchScansScanIDWithBodyWithResponse(ctx context.Context, scanID ScanID, params *PatchScansScanIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchScansScanIDResponse, error) {
-	rsp, err := c.PatchScansScanIDWithBody(ctx, scanID, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParsePatchScansScanIDResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PatchScansScanIDWithResponse(ctx context.Context, scanID ScanID, params *PatchScansScanIDParams, body PatchScansScanIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchScansScanIDResponse, error) {
-	rsp, err := c.PatchScansScanID(ctx, scanID, params, body, reqEditors...)
+// ParsePostScanConfigTemplatesResponse parses an HTTP response from a PostScanConfigTemplatesWithResponse call
+func ParsePostScanConfigTemplatesResponse(rsp *http.Response) (*PostScanConfigTemplatesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchScansScanIDResponse(rsp)
-}
 
-// PutScansScanIDWithBodyWithResponse request with arbitrary body returning *PutScansScanIDResponse
-func (c *ClientWithResponses) PutScansScanIDWithBodyWithResponse(ctx context.Context, scanID ScanID, params *PutScansScanIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutScansScanIDResponse, error) {
-	rsp, err := c.PutScansScanIDWithBody(ctx, scanID, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostScanConfigTemplatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePutScansScanIDResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutScansScanIDWithResponse(ctx context.Context, scanID ScanID, params *PutScansScanIDParams, body PutScansScanIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutScansScanIDResponse, error) {
-	rsp, err := c.PutScansScanID(ctx, scanID, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ScanConfigTemplate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ScanConfigTemplateExists
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParsePutScansScanIDResponse(rsp)
+
+	return response, nil
 }
 
-// GetTargetsWithResponse request returning *GetTargetsResponse
-func (c *ClientWithResponses) GetTargetsWithResponse(ctx context.Context, params *GetTargetsParams, reqEditors ...RequestEditorFn) (*GetTargetsResponse, error) {
-	rsp, err := c.GetTargets(ctx, params, reqEditors...)
+// ParseDeleteScanConfigTemplatesScanConfigTemplateIDResponse parses an HTTP response from a DeleteScanConfigTemplatesScanConfigTemplateIDWithResponse call
+func ParseDeleteScanConfigTemplatesScanConfigTemplateIDResponse(rsp *http.Response) (*DeleteScanConfigTemplatesScanConfigTemplateIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetTargetsResponse(rsp)
-}
 
-// PostTargetsWithBodyWithResponse request with arbitrary body returning *PostTargetsResponse
-func (c *ClientWithResponses) PostTargetsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTargetsResponse, error) {
-	rsp, err := c.PostTargetsWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteScanConfigTemplatesScanConfigTemplateIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostTargetsResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostTargetsWithResponse(ctx context.Context, body PostTargetsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTargetsResponse, error) {
-	rsp, err := c.PostTargets(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParsePostTargetsResponse(rsp)
+
+	return response, nil
 }
 
-// DeleteTargetsTargetIDWithResponse request returning *DeleteTargetsTargetIDResponse
-func (c *ClientWithResponses) DeleteTargetsTargetIDWithResponse(ctx context.Context, targetID TargetID, reqEditors ...RequestEditorFn) (*DeleteTargetsTargetIDResponse, error) {
-	rsp, err := c.DeleteTargetsTargetID(ctx, targetID, reqEditors...)
+// ParseGetScanConfigTemplatesScanConfigTemplateIDResponse parses an HTTP response from a GetScanConfigTemplatesScanConfigTemplateIDWithResponse call
+func ParseGetScanConfigTemplatesScanConfigTemplateIDResponse(rsp *http.Response) (*GetScanConfigTemplatesScanConfigTemplateIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteTargetsTargetIDResponse(rsp)
-}
 
-// GetTargetsTargetIDWithResponse request returning *GetTargetsTargetIDResponse
-func (c *ClientWithResponses) GetTargetsTargetIDWithResponse(ctx context.Context, targetID TargetID, params *GetTargetsTargetIDParams, reqEditors ...RequestEditorFn) (*GetTargetsTargetIDResponse, error) {
-	rsp, err := c.GetTargetsTargetID(ctx, targetID, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetScanConfigTemplatesScanConfigTemplateIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetTargetsTargetIDResponse(rsp)
-}
 
-// PatchTargetsTargetIDWithBodyWithResponse request with arbitrary body returning *PatchTargetsTargetIDResponse
-func (c *ClientWithResponses) PatchTargetsTargetIDWithBodyWithResponse(ctx context.Context, targetID TargetID, params *PatchTargetsTargetIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchTargetsTargetIDResponse, error) {
-	rsp, err := c.PatchTargetsTargetIDWithBody(ctx, targetID, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScanConfigTemplate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParsePatchTargetsTargetIDResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PatchTargetsTargetIDWithResponse(ctx context.Context, targetID TargetID, params *PatchTargetsTargetIDParams, body PatchTargetsTargetIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchTargetsTargetIDResponse, error) {
-	rsp, err := c.PatchTargetsTargetID(ctx, targetID, params, body, reqEditors...)
+// ParsePatchScanConfigTemplatesScanConfigTemplateIDResponse parses an HTTP response from a PatchScanConfigTemplatesScanConfigTemplateIDWithResponse call
+func ParsePatchScanConfigTemplatesScanConfigTemplateIDResponse(rsp *http.Response) (*PatchScanConfigTemplatesScanConfigTemplateIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePatchTargetsTargetIDResponse(rsp)
-}
 
-// PutTargetsTargetIDWithBodyWithResponse request with arbitrary body returning *PutTargetsTargetIDResponse
-func (c *ClientWithResponses) PutTargetsTargetIDWithBodyWithResponse(ctx context.Context, targetID TargetID, params *PutTargetsTargetIDParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutTargetsTargetIDResponse, error) {
-	rsp, err := c.PutTargetsTargetIDWithBody(ctx, targetID, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PatchScanConfigTemplatesScanConfigTemplateIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePutTargetsTargetIDResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutTargetsTargetIDWithResponse(ctx context.Context, targetID TargetID, params *PutTargetsTargetIDParams, body PutTargetsTargetIDJSONRequestBody, reqEditors ...RequestEditorFn) (*PutTargetsTargetIDResponse, error) {
-	rsp, err := c.PutTargetsTargetID(ctx, targetID, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScanConfigTemplate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ScanConfigTemplateExists
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return ParsePutTargetsTargetIDResponse(rsp)
+
+	return response, nil
 }
 
-// ParseGetDiscoveryScopesResponse parses an HTTP response from a GetDiscoveryScopesWithResponse call
-func ParseGetDiscoveryScopesResponse(rsp *http.Response) (*GetDiscoveryScopesResponse, error) {
+// ParseGetScanConfigsResponse parses an HTTP response from a GetScanConfigsWithResponse call
+func ParseGetScanConfigsResponse(rsp *http.Response) (*GetScanConfigsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDiscoveryScopesResponse{
+	response := &GetScanConfigsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Scopes
+		var dest ScanConfigs
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4230,31 +16733,48 @@ func ParseGetDiscoveryScopesResponse(rsp *http.Response) (*GetDiscoveryScopesRes
 		}
 		response.JSONDefault = &dest
 
+	case rsp.StatusCode == 200:
+		// Content-type (application/x-ndjson) unsupported
+
 	}
 
 	return response, nil
 }
 
-// ParsePutDiscoveryScopesResponse parses an HTTP response from a PutDiscoveryScopesWithResponse call
-func ParsePutDiscoveryScopesResponse(rsp *http.Response) (*PutDiscoveryScopesResponse, error) {
+// ParsePostScanConfigsResponse parses an HTTP response from a PostScanConfigsWithResponse call
+func ParsePostScanConfigsResponse(rsp *http.Response) (*PostScanConfigsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutDiscoveryScopesResponse{
+	response := &PostScanConfigsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Scopes
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ScanConfig
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ScanConfigExists
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
@@ -4268,27 +16788,34 @@ func ParsePutDiscoveryScopesResponse(rsp *http.Response) (*PutDiscoveryScopesRes
 	return response, nil
 }
 
-// ParseGetFindingsResponse parses an HTTP response from a GetFindingsWithResponse call
-func ParseGetFindingsResponse(rsp *http.Response) (*GetFindingsResponse, error) {
+// ParseDeleteScanConfigsScanConfigIDResponse parses an HTTP response from a DeleteScanConfigsScanConfigIDWithResponse call
+func ParseDeleteScanConfigsScanConfigIDResponse(rsp *http.Response) (*DeleteScanConfigsScanConfigIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetFindingsResponse{
+	response := &DeleteScanConfigsScanConfigIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Findings
+		var dest SuccessResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4301,40 +16828,40 @@ func ParseGetFindingsResponse(rsp *http.Response) (*GetFindingsResponse, error)
 	return response, nil
 }
 
-// ParsePostFindingsResponse parses an HTTP response from a PostFindingsWithResponse call
-func ParsePostFindingsResponse(rsp *http.Response) (*PostFindingsResponse, error) {
+// ParseGetScanConfigsScanConfigIDResponse parses an HTTP response from a GetScanConfigsScanConfigIDWithResponse call
+func ParseGetScanConfigsScanConfigIDResponse(rsp *http.Response) (*GetScanConfigsScanConfigIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostFindingsResponse{
+	response := &GetScanConfigsScanConfigIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Finding
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScanConfig
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ApiResponse
+		var dest QueryError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest FindingExists
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
@@ -4348,27 +16875,34 @@ func ParsePostFindingsResponse(rsp *http.Response) (*PostFindingsResponse, error
 	return response, nil
 }
 
-// ParseDeleteFindingsFindingIDResponse parses an HTTP response from a DeleteFindingsFindingIDWithResponse call
-func ParseDeleteFindingsFindingIDResponse(rsp *http.Response) (*DeleteFindingsFindingIDResponse, error) {
+// ParsePatchScanConfigsScanConfigIDResponse parses an HTTP response from a PatchScanConfigsScanConfigIDWithResponse call
+func ParsePatchScanConfigsScanConfigIDResponse(rsp *http.Response) (*PatchScanConfigsScanConfigIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteFindingsFindingIDResponse{
+	response := &PatchScanConfigsScanConfigIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessResponse
+		var dest ScanConfig
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4376,6 +16910,27 @@ func ParseDeleteFindingsFindingIDResponse(rsp *http.Response) (*DeleteFindingsFi
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ScanConfigExists
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4388,27 +16943,34 @@ func ParseDeleteFindingsFindingIDResponse(rsp *http.Response) (*DeleteFindingsFi
 	return response, nil
 }
 
-// ParseGetFindingsFindingIDResponse parses an HTTP response from a GetFindingsFindingIDWithResponse call
-func ParseGetFindingsFindingIDResponse(rsp *http.Response) (*GetFindingsFindingIDResponse, error) {
+// ParsePutScanConfigsScanConfigIDResponse parses an HTTP response from a PutScanConfigsScanConfigIDWithResponse call
+func ParsePutScanConfigsScanConfigIDResponse(rsp *http.Response) (*PutScanConfigsScanConfigIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetFindingsFindingIDResponse{
+	response := &PutScanConfigsScanConfigIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Finding
+		var dest ScanConfig
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4416,6 +16978,20 @@ func ParseGetFindingsFindingIDResponse(rsp *http.Response) (*GetFindingsFindingI
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ScanConfigExists
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4428,22 +17004,22 @@ func ParseGetFindingsFindingIDResponse(rsp *http.Response) (*GetFindingsFindingI
 	return response, nil
 }
 
-// ParsePatchFindingsFindingIDResponse parses an HTTP response from a PatchFindingsFindingIDWithResponse call
-func ParsePatchFindingsFindingIDResponse(rsp *http.Response) (*PatchFindingsFindingIDResponse, error) {
+// ParseGetScanConfigsScanConfigIDNextRunsResponse parses an HTTP response from a GetScanConfigsScanConfigIDNextRunsWithResponse call
+func ParseGetScanConfigsScanConfigIDNextRunsResponse(rsp *http.Response) (*GetScanConfigsScanConfigIDNextRunsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchFindingsFindingIDResponse{
+	response := &GetScanConfigsScanConfigIDNextRunsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Finding
+		var dest ScanConfigNextRuns
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4475,40 +17051,83 @@ func ParsePatchFindingsFindingIDResponse(rsp *http.Response) (*PatchFindingsFind
 	return response, nil
 }
 
-// ParsePutFindingsFindingIDResponse parses an HTTP response from a PutFindingsFindingIDWithResponse call
-func ParsePutFindingsFindingIDResponse(rsp *http.Response) (*PutFindingsFindingIDResponse, error) {
+// ParseGetScanResultsResponse parses an HTTP response from a GetScanResultsWithResponse call
+func ParseGetScanResultsResponse(rsp *http.Response) (*GetScanResultsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutFindingsFindingIDResponse{
+	response := &GetScanResultsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Finding
+		var dest AssetScanResults
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ApiResponse
+		var dest QueryError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (application/x-ndjson) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParsePostScanResultsResponse parses an HTTP response from a PostScanResultsWithResponse call
+func ParsePostScanResultsResponse(rsp *http.Response) (*PostScanResultsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostScanResultsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AssetScanResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest AssetScanResultExists
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
@@ -4522,27 +17141,41 @@ func ParsePutFindingsFindingIDResponse(rsp *http.Response) (*PutFindingsFindingI
 	return response, nil
 }
 
-// ParseGetScanConfigsResponse parses an HTTP response from a GetScanConfigsWithResponse call
-func ParseGetScanConfigsResponse(rsp *http.Response) (*GetScanConfigsResponse, error) {
+// ParseGetScanResultsScanResultIDResponse parses an HTTP response from a GetScanResultsScanResultIDWithResponse call
+func ParseGetScanResultsScanResultIDResponse(rsp *http.Response) (*GetScanResultsScanResultIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetScanConfigsResponse{
+	response := &GetScanResultsScanResultIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ScanConfigs
+		var dest AssetScanResult
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4555,26 +17188,26 @@ func ParseGetScanConfigsResponse(rsp *http.Response) (*GetScanConfigsResponse, e
 	return response, nil
 }
 
-// ParsePostScanConfigsResponse parses an HTTP response from a PostScanConfigsWithResponse call
-func ParsePostScanConfigsResponse(rsp *http.Response) (*PostScanConfigsResponse, error) {
+// ParsePatchScanResultsScanResultIDResponse parses an HTTP response from a PatchScanResultsScanResultIDWithResponse call
+func ParsePatchScanResultsScanResultIDResponse(rsp *http.Response) (*PatchScanResultsScanResultIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostScanConfigsResponse{
+	response := &PatchScanResultsScanResultIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ScanConfig
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AssetScanResult
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ApiResponse
@@ -4583,13 +17216,34 @@ func ParsePostScanConfigsResponse(rsp *http.Response) (*PostScanConfigsResponse,
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ScanConfigExists
+		var dest AssetScanResultExists
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON409 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4602,27 +17256,34 @@ func ParsePostScanConfigsResponse(rsp *http.Response) (*PostScanConfigsResponse,
 	return response, nil
 }
 
-// ParseDeleteScanConfigsScanConfigIDResponse parses an HTTP response from a DeleteScanConfigsScanConfigIDWithResponse call
-func ParseDeleteScanConfigsScanConfigIDResponse(rsp *http.Response) (*DeleteScanConfigsScanConfigIDResponse, error) {
+// ParsePutScanResultsScanResultIDResponse parses an HTTP response from a PutScanResultsScanResultIDWithResponse call
+func ParsePutScanResultsScanResultIDResponse(rsp *http.Response) (*PutScanResultsScanResultIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteScanConfigsScanConfigIDResponse{
+	response := &PutScanResultsScanResultIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessResponse
+		var dest AssetScanResult
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4630,6 +17291,20 @@ func ParseDeleteScanConfigsScanConfigIDResponse(rsp *http.Response) (*DeleteScan
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest AssetScanResultExists
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4642,27 +17317,20 @@ func ParseDeleteScanConfigsScanConfigIDResponse(rsp *http.Response) (*DeleteScan
 	return response, nil
 }
 
-// ParseGetScanConfigsScanConfigIDResponse parses an HTTP response from a GetScanConfigsScanConfigIDWithResponse call
-func ParseGetScanConfigsScanConfigIDResponse(rsp *http.Response) (*GetScanConfigsScanConfigIDResponse, error) {
+// ParseGetScanResultsScanResultIDFamiliesFamilyArtifactResponse parses an HTTP response from a GetScanResultsScanResultIDFamiliesFamilyArtifactWithResponse call
+func ParseGetScanResultsScanResultIDFamiliesFamilyArtifactResponse(rsp *http.Response) (*GetScanResultsScanResultIDFamiliesFamilyArtifactResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetScanConfigsScanConfigIDResponse{
+	response := &GetScanResultsScanResultIDFamiliesFamilyArtifactResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ScanConfig
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4682,22 +17350,22 @@ func ParseGetScanConfigsScanConfigIDResponse(rsp *http.Response) (*GetScanConfig
 	return response, nil
 }
 
-// ParsePatchScanConfigsScanConfigIDResponse parses an HTTP response from a PatchScanConfigsScanConfigIDWithResponse call
-func ParsePatchScanConfigsScanConfigIDResponse(rsp *http.Response) (*PatchScanConfigsScanConfigIDResponse, error) {
+// ParsePutScanResultsScanResultIDFamiliesFamilyArtifactResponse parses an HTTP response from a PutScanResultsScanResultIDFamiliesFamilyArtifactWithResponse call
+func ParsePutScanResultsScanResultIDFamiliesFamilyArtifactResponse(rsp *http.Response) (*PutScanResultsScanResultIDFamiliesFamilyArtifactResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchScanConfigsScanConfigIDResponse{
+	response := &PutScanResultsScanResultIDFamiliesFamilyArtifactResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ScanConfig
+		var dest ScanResultArtifact
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4717,19 +17385,19 @@ func ParsePatchScanConfigsScanConfigIDResponse(rsp *http.Response) (*PatchScanCo
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ScanConfigExists
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON413 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON412 = &dest
+		response.JSON503 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
@@ -4743,29 +17411,29 @@ func ParsePatchScanConfigsScanConfigIDResponse(rsp *http.Response) (*PatchScanCo
 	return response, nil
 }
 
-// ParsePutScanConfigsScanConfigIDResponse parses an HTTP response from a PutScanConfigsScanConfigIDWithResponse call
-func ParsePutScanConfigsScanConfigIDResponse(rsp *http.Response) (*PutScanConfigsScanConfigIDResponse, error) {
+// ParseGetScanResultsScanResultIDFamiliesFamilyItemsResponse parses an HTTP response from a GetScanResultsScanResultIDFamiliesFamilyItemsWithResponse call
+func ParseGetScanResultsScanResultIDFamiliesFamilyItemsResponse(rsp *http.Response) (*GetScanResultsScanResultIDFamiliesFamilyItemsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutScanConfigsScanConfigIDResponse{
+	response := &GetScanResultsScanResultIDFamiliesFamilyItemsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ScanConfig
+		var dest ScanResultItems
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ApiResponse
+		var dest QueryError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4778,20 +17446,6 @@ func ParsePutScanConfigsScanConfigIDResponse(rsp *http.Response) (*PutScanConfig
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ScanConfigExists
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest ApiResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON412 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4804,27 +17458,48 @@ func ParsePutScanConfigsScanConfigIDResponse(rsp *http.Response) (*PutScanConfig
 	return response, nil
 }
 
-// ParseGetScanResultsResponse parses an HTTP response from a GetScanResultsWithResponse call
-func ParseGetScanResultsResponse(rsp *http.Response) (*GetScanResultsResponse, error) {
+// ParsePostScanResultsScanResultIDFamiliesFamilyItemsResponse parses an HTTP response from a PostScanResultsScanResultIDFamiliesFamilyItemsWithResponse call
+func ParsePostScanResultsScanResultIDFamiliesFamilyItemsResponse(rsp *http.Response) (*PostScanResultsScanResultIDFamiliesFamilyItemsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetScanResultsResponse{
+	response := &PostScanResultsScanResultIDFamiliesFamilyItemsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TargetScanResults
+		var dest ScanResultItems
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4837,26 +17512,26 @@ func ParseGetScanResultsResponse(rsp *http.Response) (*GetScanResultsResponse, e
 	return response, nil
 }
 
-// ParsePostScanResultsResponse parses an HTTP response from a PostScanResultsWithResponse call
-func ParsePostScanResultsResponse(rsp *http.Response) (*PostScanResultsResponse, error) {
+// ParsePostScanResultsScanResultIDRerunResponse parses an HTTP response from a PostScanResultsScanResultIDRerunWithResponse call
+func ParsePostScanResultsScanResultIDRerunResponse(rsp *http.Response) (*PostScanResultsScanResultIDRerunResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostScanResultsResponse{
+	response := &PostScanResultsScanResultIDRerunResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest TargetScanResult
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest AssetScanResult
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON202 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ApiResponse
@@ -4865,8 +17540,15 @@ func ParsePostScanResultsResponse(rsp *http.Response) (*PostScanResultsResponse,
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest TargetScanResultExists
+		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4884,22 +17566,22 @@ func ParsePostScanResultsResponse(rsp *http.Response) (*PostScanResultsResponse,
 	return response, nil
 }
 
-// ParseGetScanResultsScanResultIDResponse parses an HTTP response from a GetScanResultsScanResultIDWithResponse call
-func ParseGetScanResultsScanResultIDResponse(rsp *http.Response) (*GetScanResultsScanResultIDResponse, error) {
+// ParseGetScanResultsScanResultIDSbomResponse parses an HTTP response from a GetScanResultsScanResultIDSbomWithResponse call
+func ParseGetScanResultsScanResultIDSbomResponse(rsp *http.Response) (*GetScanResultsScanResultIDSbomResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetScanResultsScanResultIDResponse{
+	response := &GetScanResultsScanResultIDSbomResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TargetScanResult
+		var dest map[string]interface{}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -4924,33 +17606,33 @@ func ParseGetScanResultsScanResultIDResponse(rsp *http.Response) (*GetScanResult
 	return response, nil
 }
 
-// ParsePatchScanResultsScanResultIDResponse parses an HTTP response from a PatchScanResultsScanResultIDWithResponse call
-func ParsePatchScanResultsScanResultIDResponse(rsp *http.Response) (*PatchScanResultsScanResultIDResponse, error) {
+// ParseGetScanResultsScanResultIDScannerConfigResponse parses an HTTP response from a GetScanResultsScanResultIDScannerConfigWithResponse call
+func ParseGetScanResultsScanResultIDScannerConfigResponse(rsp *http.Response) (*GetScanResultsScanResultIDScannerConfigResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchScanResultsScanResultIDResponse{
+	response := &GetScanResultsScanResultIDScannerConfigResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TargetScanResult
+		var dest ScannerConfig
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ApiResponse
@@ -4959,20 +17641,6 @@ func ParsePatchScanResultsScanResultIDResponse(rsp *http.Response) (*PatchScanRe
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest TargetScanResultExists
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest ApiResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON412 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -4985,22 +17653,22 @@ func ParsePatchScanResultsScanResultIDResponse(rsp *http.Response) (*PatchScanRe
 	return response, nil
 }
 
-// ParsePutScanResultsScanResultIDResponse parses an HTTP response from a PutScanResultsScanResultIDWithResponse call
-func ParsePutScanResultsScanResultIDResponse(rsp *http.Response) (*PutScanResultsScanResultIDResponse, error) {
+// ParsePutScanResultsScanResultIDScannerConfigResponse parses an HTTP response from a PutScanResultsScanResultIDScannerConfigWithResponse call
+func ParsePutScanResultsScanResultIDScannerConfigResponse(rsp *http.Response) (*PutScanResultsScanResultIDScannerConfigResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutScanResultsScanResultIDResponse{
+	response := &PutScanResultsScanResultIDScannerConfigResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TargetScanResult
+		var dest ScannerConfig
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5020,20 +17688,6 @@ func ParsePutScanResultsScanResultIDResponse(rsp *http.Response) (*PutScanResult
 		}
 		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest TargetScanResultExists
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON409 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
-		var dest ApiResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON412 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5067,6 +17721,13 @@ func ParseGetScansResponse(rsp *http.Response) (*GetScansResponse, error) {
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5074,6 +17735,9 @@ func ParseGetScansResponse(rsp *http.Response) (*GetScansResponse, error) {
 		}
 		response.JSONDefault = &dest
 
+	case rsp.StatusCode == 200:
+		// Content-type (application/x-ndjson) unsupported
+
 	}
 
 	return response, nil
@@ -5114,6 +17778,13 @@ func ParsePostScansResponse(rsp *http.Response) (*PostScansResponse, error) {
 		}
 		response.JSON409 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5187,6 +17858,13 @@ func ParseGetScansScanIDResponse(rsp *http.Response) (*GetScansScanIDResponse, e
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest QueryError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5255,6 +17933,13 @@ func ParsePatchScansScanIDResponse(rsp *http.Response) (*PatchScansScanIDRespons
 		}
 		response.JSON412 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5328,27 +18013,34 @@ func ParsePutScansScanIDResponse(rsp *http.Response) (*PutScansScanIDResponse, e
 	return response, nil
 }
 
-// ParseGetTargetsResponse parses an HTTP response from a GetTargetsWithResponse call
-func ParseGetTargetsResponse(rsp *http.Response) (*GetTargetsResponse, error) {
+// ParseGetScansScanIDProgressResponse parses an HTTP response from a GetScansScanIDProgressWithResponse call
+func ParseGetScansScanIDProgressResponse(rsp *http.Response) (*GetScansScanIDProgressResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetTargetsResponse{
+	response := &GetScansScanIDProgressResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Targets
+		var dest ScanProgress
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5361,40 +18053,26 @@ func ParseGetTargetsResponse(rsp *http.Response) (*GetTargetsResponse, error) {
 	return response, nil
 }
 
-// ParsePostTargetsResponse parses an HTTP response from a PostTargetsWithResponse call
-func ParsePostTargetsResponse(rsp *http.Response) (*PostTargetsResponse, error) {
+// ParseGetSettingsFindingTemplatesResponse parses an HTTP response from a GetSettingsFindingTemplatesWithResponse call
+func ParseGetSettingsFindingTemplatesResponse(rsp *http.Response) (*GetSettingsFindingTemplatesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostTargetsResponse{
+	response := &GetSettingsFindingTemplatesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Target
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ApiResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest TargetExists
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FindingTemplateSettings
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
@@ -5408,22 +18086,22 @@ func ParsePostTargetsResponse(rsp *http.Response) (*PostTargetsResponse, error)
 	return response, nil
 }
 
-// ParseDeleteTargetsTargetIDResponse parses an HTTP response from a DeleteTargetsTargetIDWithResponse call
-func ParseDeleteTargetsTargetIDResponse(rsp *http.Response) (*DeleteTargetsTargetIDResponse, error) {
+// ParsePutSettingsFindingTemplatesResponse parses an HTTP response from a PutSettingsFindingTemplatesWithResponse call
+func ParsePutSettingsFindingTemplatesResponse(rsp *http.Response) (*PutSettingsFindingTemplatesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteTargetsTargetIDResponse{
+	response := &PutSettingsFindingTemplatesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessResponse
+		var dest FindingTemplateSettings
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -5436,13 +18114,6 @@ func ParseDeleteTargetsTargetIDResponse(rsp *http.Response) (*DeleteTargetsTarge
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ApiResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5455,27 +18126,34 @@ func ParseDeleteTargetsTargetIDResponse(rsp *http.Response) (*DeleteTargetsTarge
 	return response, nil
 }
 
-// ParseGetTargetsTargetIDResponse parses an HTTP response from a GetTargetsTargetIDWithResponse call
-func ParseGetTargetsTargetIDResponse(rsp *http.Response) (*GetTargetsTargetIDResponse, error) {
+// ParsePostSettingsFindingTemplatesPreviewResponse parses an HTTP response from a PostSettingsFindingTemplatesPreviewWithResponse call
+func ParsePostSettingsFindingTemplatesPreviewResponse(rsp *http.Response) (*PostSettingsFindingTemplatesPreviewResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetTargetsTargetIDResponse{
+	response := &PostSettingsFindingTemplatesPreviewResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Target
+		var dest RenderedFinding
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -5495,47 +18173,66 @@ func ParseGetTargetsTargetIDResponse(rsp *http.Response) (*GetTargetsTargetIDRes
 	return response, nil
 }
 
-// ParsePatchTargetsTargetIDResponse parses an HTTP response from a PatchTargetsTargetIDWithResponse call
-func ParsePatchTargetsTargetIDResponse(rsp *http.Response) (*PatchTargetsTargetIDResponse, error) {
+// ParseGetSettingsRetentionResponse parses an HTTP response from a GetSettingsRetentionWithResponse call
+func ParseGetSettingsRetentionResponse(rsp *http.Response) (*GetSettingsRetentionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PatchTargetsTargetIDResponse{
+	response := &GetSettingsRetentionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Target
+		var dest RetentionSettings
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSONDefault = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest TargetExists
+	}
+
+	return response, nil
+}
+
+// ParsePutSettingsRetentionResponse parses an HTTP response from a PutSettingsRetentionWithResponse call
+func ParsePutSettingsRetentionResponse(rsp *http.Response) (*PutSettingsRetentionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutSettingsRetentionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RetentionSettings
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON412 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
@@ -5549,54 +18246,66 @@ func ParsePatchTargetsTargetIDResponse(rsp *http.Response) (*PatchTargetsTargetI
 	return response, nil
 }
 
-// ParsePutTargetsTargetIDResponse parses an HTTP response from a PutTargetsTargetIDWithResponse call
-func ParsePutTargetsTargetIDResponse(rsp *http.Response) (*PutTargetsTargetIDResponse, error) {
+// ParseGetUserPreferencesResponse parses an HTTP response from a GetUserPreferencesWithResponse call
+func ParseGetUserPreferencesResponse(rsp *http.Response) (*GetUserPreferencesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutTargetsTargetIDResponse{
+	response := &GetUserPreferencesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Target
+		var dest UserPreferences
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSONDefault = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ApiResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest TargetExists
+	return response, nil
+}
+
+// ParsePutUserPreferencesResponse parses an HTTP response from a PutUserPreferencesWithResponse call
+func ParsePutUserPreferencesResponse(rsp *http.Response) (*PutUserPreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutUserPreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserPreferences
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON412 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest ApiResponse
@@ -0,0 +1,71 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MembersFilter returns the OData filter matching the assets which are
+// members of the group. The properties of the assets are prefixed with
+// assetPath, e.g. "asset/" to match the findings of the members.
+func (g *AssetGroup) MembersFilter(assetPath string) string {
+	var conditions []string
+
+	if g.AssetIDs != nil && len(*g.AssetIDs) > 0 {
+		ids := make([]string, 0, len(*g.AssetIDs))
+		for _, id := range *g.AssetIDs {
+			ids = append(ids, fmt.Sprintf("%sid eq %s", assetPath, ODataString(id)))
+		}
+		conditions = append(conditions, strings.Join(ids, " or "))
+	}
+
+	if g.TagSelector != nil && len(*g.TagSelector) > 0 {
+		tags := make([]string, 0, len(*g.TagSelector))
+		for _, tag := range *g.TagSelector {
+			match := fmt.Sprintf("t/key eq %s and t/value eq %s", ODataString(tag.Key), ODataString(tag.Value))
+			tags = append(tags, fmt.Sprintf("(%sassetInfo/tags/any(t: %s) or %slabels/any(t: %s))", assetPath, match, assetPath, match))
+		}
+		conditions = append(conditions, strings.Join(tags, " and "))
+	}
+
+	if len(conditions) == 0 {
+		// A group without members must not match every asset.
+		return fmt.Sprintf("%sid eq null", assetPath)
+	}
+
+	return "(" + strings.Join(conditions, ") or (") + ")"
+}
+
+// AssetGroupsMembersFilter returns the OData filter matching the assets which
+// are members of any of the groups.
+func AssetGroupsMembersFilter(groups []AssetGroup, assetPath string) string {
+	filters := make([]string, 0, len(groups))
+	for i := range groups {
+		filters = append(filters, "("+groups[i].MembersFilter(assetPath)+")")
+	}
+	if len(filters) == 0 {
+		return fmt.Sprintf("%sid eq null", assetPath)
+	}
+	return strings.Join(filters, " or ")
+}
+
+// ODataString returns the OData string literal of s, the quotes in it are
+// escaped so that it can not end the literal early.
+func ODataString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
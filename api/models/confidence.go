@@ -0,0 +1,36 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+// Rank orders the confidences from the lowest to the highest, an unknown
+// confidence is ranked the lowest.
+func (c FindingConfidence) Rank() int {
+	switch c {
+	case High:
+		return 3 // nolint:gomnd
+	case Medium:
+		return 2 // nolint:gomnd
+	case Low:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// IsAtLeast returns whether the confidence is the same as or higher than min.
+func (c FindingConfidence) IsAtLeast(min FindingConfidence) bool {
+	return c.Rank() >= min.Rank()
+}
@@ -46,3 +46,67 @@ func (c *MalwareConfig) IsEnabled() bool {
 func (c *ExploitsConfig) IsEnabled() bool {
 	return c != nil && c.Enabled != nil && *c.Enabled
 }
+
+func (c *CertificatesConfig) IsEnabled() bool {
+	return c != nil && c.Enabled != nil && *c.Enabled
+}
+
+func (c *ComplianceConfig) IsEnabled() bool {
+	return c != nil && c.Enabled != nil && *c.Enabled
+}
+
+func (c *PluginsConfig) IsEnabled() bool {
+	return c != nil && c.Enabled != nil && *c.Enabled
+}
+
+// WithOnlyFamilies returns a copy of the ScanFamiliesConfig which has only the
+// given scan families enabled. The given families keep their configuration,
+// e.g. their scanners and ignore rules, the others are disabled.
+func (c *ScanFamiliesConfig) WithOnlyFamilies(families []ScanFamily) *ScanFamiliesConfig {
+	config := &ScanFamiliesConfig{}
+	if c != nil {
+		*config = *c
+	}
+
+	only := make(map[ScanFamily]bool, len(families))
+	for _, family := range families {
+		only[family] = true
+	}
+	enabled := func(family ScanFamily) *bool {
+		isEnabled := only[family]
+		return &isEnabled
+	}
+
+	config.Certificates = copyFamilyConfig(config.Certificates)
+	config.Certificates.Enabled = enabled(ScanFamilyCertificates)
+	config.Compliance = copyFamilyConfig(config.Compliance)
+	config.Compliance.Enabled = enabled(ScanFamilyCompliance)
+	config.Exploits = copyFamilyConfig(config.Exploits)
+	config.Exploits.Enabled = enabled(ScanFamilyExploits)
+	config.Malware = copyFamilyConfig(config.Malware)
+	config.Malware.Enabled = enabled(ScanFamilyMalware)
+	config.Misconfigurations = copyFamilyConfig(config.Misconfigurations)
+	config.Misconfigurations.Enabled = enabled(ScanFamilyMisconfigurations)
+	config.Plugins = copyFamilyConfig(config.Plugins)
+	config.Plugins.Enabled = enabled(ScanFamilyPlugins)
+	config.Rootkits = copyFamilyConfig(config.Rootkits)
+	config.Rootkits.Enabled = enabled(ScanFamilyRootkits)
+	config.Sbom = copyFamilyConfig(config.Sbom)
+	config.Sbom.Enabled = enabled(ScanFamilySbom)
+	config.Secrets = copyFamilyConfig(config.Secrets)
+	config.Secrets.Enabled = enabled(ScanFamilySecrets)
+	config.Vulnerabilities = copyFamilyConfig(config.Vulnerabilities)
+	config.Vulnerabilities.Enabled = enabled(ScanFamilyVulnerabilities)
+
+	return config
+}
+
+// copyFamilyConfig returns a shallow copy of the config of a scan family, or
+// an empty config if it is nil.
+func copyFamilyConfig[T any](c *T) *T {
+	copied := new(T)
+	if c != nil {
+		*copied = *c
+	}
+	return copied
+}
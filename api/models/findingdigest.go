@@ -0,0 +1,40 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import "time"
+
+// CronLine returns the cron expression the digests of the cadence are
+// delivered by, at 08:00 every day or every Monday.
+func (c FindingDigestCadence) CronLine() (string, bool) {
+	switch c {
+	case Daily:
+		return "0 8 * * *", true
+	case Weekly:
+		return "0 8 * * 1", true
+	default:
+		return "", false
+	}
+}
+
+// Period returns the period reported on by the first digest of the cadence,
+// later digests report on the period since the previous one.
+func (c FindingDigestCadence) Period() time.Duration {
+	if c == Weekly {
+		return 7 * 24 * time.Hour // nolint:gomnd
+	}
+	return 24 * time.Hour // nolint:gomnd
+}
@@ -11,10 +11,83 @@ import (
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
 )
 
+// Defines values for APIKeyRole.
+const (
+	Admin    APIKeyRole = "Admin"
+	Operator APIKeyRole = "Operator"
+	ReadOnly APIKeyRole = "ReadOnly"
+)
+
+// Defines values for AssetScanStateState.
+const (
+	AssetScanStateStateAborted     AssetScanStateState = "Aborted"
+	AssetScanStateStateDone        AssetScanStateState = "Done"
+	AssetScanStateStateInProgress  AssetScanStateState = "InProgress"
+	AssetScanStateStateNotScanned  AssetScanStateState = "NotScanned"
+	AssetScanStateStatePending     AssetScanStateState = "Pending"
+	AssetScanStateStateReadyToScan AssetScanStateState = "ReadyToScan"
+	AssetScanStateStateScheduled   AssetScanStateState = "Scheduled"
+)
+
+// Defines values for CertificateFindingType.
+const (
+	EXPIREDCERTIFICATE     CertificateFindingType = "EXPIRED_CERTIFICATE"
+	EXPIRINGCERTIFICATE    CertificateFindingType = "EXPIRING_CERTIFICATE"
+	PRIVATEKEY             CertificateFindingType = "PRIVATE_KEY"
+	WEAKKEY                CertificateFindingType = "WEAK_KEY"
+	WEAKSIGNATUREALGORITHM CertificateFindingType = "WEAK_SIGNATURE_ALGORITHM"
+)
+
 // Defines values for CloudProvider.
 const (
-	AWS   CloudProvider = "AWS"
-	Azure CloudProvider = "Azure"
+	AWS        CloudProvider = "AWS"
+	Azure      CloudProvider = "Azure"
+	Kubernetes CloudProvider = "Kubernetes"
+	SSH        CloudProvider = "SSH"
+)
+
+// Defines values for ComplianceBenchmark.
+const (
+	CISDOCKER ComplianceBenchmark = "CIS_DOCKER"
+	CISLINUX  ComplianceBenchmark = "CIS_LINUX"
+)
+
+// Defines values for CorrelationRule.
+const (
+	ExposedSecretAndExploitedVulnerability CorrelationRule = "ExposedSecretAndExploitedVulnerability"
+	MalwareAndRootkit                      CorrelationRule = "MalwareAndRootkit"
+	SamePath                               CorrelationRule = "SamePath"
+	WritableSUIDAndRootkit                 CorrelationRule = "WritableSUIDAndRootkit"
+)
+
+// Defines values for FeedKind.
+const (
+	FeedKindExploit       FeedKind = "Exploit"
+	FeedKindMalware       FeedKind = "Malware"
+	FeedKindVulnerability FeedKind = "Vulnerability"
+)
+
+// Defines values for FindingConfidence.
+const (
+	High   FindingConfidence = "high"
+	Low    FindingConfidence = "low"
+	Medium FindingConfidence = "medium"
+)
+
+// Defines values for FindingDigestCadence.
+const (
+	Daily  FindingDigestCadence = "Daily"
+	Weekly FindingDigestCadence = "Weekly"
+)
+
+// Defines values for JSONPatchOperationOp.
+const (
+	Add     JSONPatchOperationOp = "add"
+	Copy    JSONPatchOperationOp = "copy"
+	Move    JSONPatchOperationOp = "move"
+	Remove  JSONPatchOperationOp = "remove"
+	Replace JSONPatchOperationOp = "replace"
+	Test    JSONPatchOperationOp = "test"
 )
 
 // Defines values for MisconfigurationSeverity.
@@ -24,6 +97,71 @@ const (
 	MisconfigurationMediumSeverity MisconfigurationSeverity = "MisconfigurationMediumSeverity"
 )
 
+// Defines values for NotificationDeliveryState.
+const (
+	NotificationDeliveryStateDelivered   NotificationDeliveryState = "Delivered"
+	NotificationDeliveryStateUndelivered NotificationDeliveryState = "Undelivered"
+)
+
+// Defines values for NotificationEventType.
+const (
+	CriticalFindingFound NotificationEventType = "CriticalFindingFound"
+	FindingFixed         NotificationEventType = "FindingFixed"
+	FindingInvalidated   NotificationEventType = "FindingInvalidated"
+	FindingsDigest       NotificationEventType = "FindingsDigest"
+	ScanCompleted        NotificationEventType = "ScanCompleted"
+	ScanFailed           NotificationEventType = "ScanFailed"
+	ScanStarted          NotificationEventType = "ScanStarted"
+)
+
+// Defines values for OperationKind.
+const (
+	AdminUsage       OperationKind = "AdminUsage"
+	AssetUpgradePlan OperationKind = "AssetUpgradePlan"
+	SbomUpload       OperationKind = "SbomUpload"
+)
+
+// Defines values for OperationState.
+const (
+	Errored   OperationState = "Errored"
+	Queued    OperationState = "Queued"
+	Running   OperationState = "Running"
+	Succeeded OperationState = "Succeeded"
+)
+
+// Defines values for ProviderOperationStatus.
+const (
+	Successful   ProviderOperationStatus = "Successful"
+	Unsuccessful ProviderOperationStatus = "Unsuccessful"
+)
+
+// Defines values for ProviderOperationType.
+const (
+	InstanceCreate         ProviderOperationType = "InstanceCreate"
+	InstanceDelete         ProviderOperationType = "InstanceDelete"
+	JobCreate              ProviderOperationType = "JobCreate"
+	JobDelete              ProviderOperationType = "JobDelete"
+	NetworkInterfaceCreate ProviderOperationType = "NetworkInterfaceCreate"
+	NetworkInterfaceDelete ProviderOperationType = "NetworkInterfaceDelete"
+	SnapshotCopy           ProviderOperationType = "SnapshotCopy"
+	SnapshotCreate         ProviderOperationType = "SnapshotCreate"
+	SnapshotDelete         ProviderOperationType = "SnapshotDelete"
+	VolumeAttach           ProviderOperationType = "VolumeAttach"
+	VolumeCreate           ProviderOperationType = "VolumeCreate"
+	VolumeDelete           ProviderOperationType = "VolumeDelete"
+)
+
+// Defines values for ReportDeliveryState.
+const (
+	ReportDeliveryStateDelivered   ReportDeliveryState = "Delivered"
+	ReportDeliveryStateUndelivered ReportDeliveryState = "Undelivered"
+)
+
+// Defines values for ReportType.
+const (
+	ExecutiveSummary ReportType = "ExecutiveSummary"
+)
+
 // Defines values for ResourceCleanupState.
 const (
 	ResourceCleanupStateDone    ResourceCleanupState = "Done"
@@ -35,12 +173,19 @@ const (
 // Defines values for RootkitType.
 const (
 	APPLICATION RootkitType = "APPLICATION"
+	BOOT        RootkitType = "BOOT"
 	FIRMWARE    RootkitType = "FIRMWARE"
 	KERNEL      RootkitType = "KERNEL"
 	MEMORY      RootkitType = "MEMORY"
 	UNKNOWN     RootkitType = "UNKNOWN"
 )
 
+// Defines values for SbomFormat.
+const (
+	CyclonedxJson SbomFormat = "cyclonedx-json"
+	SpdxJson      SbomFormat = "spdx-json"
+)
+
 // Defines values for ScanState.
 const (
 	ScanStateAborted    ScanState = "Aborted"
@@ -62,6 +207,27 @@ const (
 	ScanStateReasonUnexpected                  ScanStateReason = "Unexpected"
 )
 
+// Defines values for ScanFamily.
+const (
+	ScanFamilyCertificates      ScanFamily = "certificates"
+	ScanFamilyCompliance        ScanFamily = "compliance"
+	ScanFamilyExploits          ScanFamily = "exploits"
+	ScanFamilyMalware           ScanFamily = "malware"
+	ScanFamilyMisconfigurations ScanFamily = "misconfigurations"
+	ScanFamilyPlugins           ScanFamily = "plugins"
+	ScanFamilyRootkits          ScanFamily = "rootkits"
+	ScanFamilySbom              ScanFamily = "sbom"
+	ScanFamilySecrets           ScanFamily = "secrets"
+	ScanFamilyVulnerabilities   ScanFamily = "vulnerabilities"
+)
+
+// Defines values for ScanOverlapPolicy.
+const (
+	CancelPrevious ScanOverlapPolicy = "CancelPrevious"
+	Queue          ScanOverlapPolicy = "Queue"
+	Skip           ScanOverlapPolicy = "Skip"
+)
+
 // Defines values for ScanRelationshipState.
 const (
 	ScanRelationshipStateAborted    ScanRelationshipState = "Aborted"
@@ -83,26 +249,43 @@ const (
 	ScanRelationshipStateReasonUnexpected                  ScanRelationshipStateReason = "Unexpected"
 )
 
+// Defines values for ScanResultRetentionTier.
+const (
+	Archived    ScanResultRetentionTier = "Archived"
+	Full        ScanResultRetentionTier = "Full"
+	SummaryOnly ScanResultRetentionTier = "SummaryOnly"
+)
+
 // Defines values for ScanType.
 const (
+	CERTIFICATE      ScanType = "CERTIFICATE"
+	COMPLIANCE       ScanType = "COMPLIANCE"
 	EXPLOIT          ScanType = "EXPLOIT"
 	MALWARE          ScanType = "MALWARE"
 	MISCONFIGURATION ScanType = "MISCONFIGURATION"
+	PLUGIN           ScanType = "PLUGIN"
 	ROOTKIT          ScanType = "ROOTKIT"
 	SBOM             ScanType = "SBOM"
 	SECRET           ScanType = "SECRET"
 	VULNERABILITY    ScanType = "VULNERABILITY"
 )
 
-// Defines values for TargetScanStateState.
+// Defines values for ScannerImageChannel.
+const (
+	Candidate ScannerImageChannel = "candidate"
+	Stable    ScannerImageChannel = "stable"
+)
+
+// Defines values for SecurityGroupRuleDirection.
+const (
+	Inbound  SecurityGroupRuleDirection = "Inbound"
+	Outbound SecurityGroupRuleDirection = "Outbound"
+)
+
+// Defines values for VulnerabilityScanner.
 const (
-	TargetScanStateStateAborted     TargetScanStateState = "Aborted"
-	TargetScanStateStateDone        TargetScanStateState = "Done"
-	TargetScanStateStateInProgress  TargetScanStateState = "InProgress"
-	TargetScanStateStateNotScanned  TargetScanStateState = "NotScanned"
-	TargetScanStateStatePending     TargetScanStateState = "Pending"
-	TargetScanStateStateReadyToScan TargetScanStateState = "ReadyToScan"
-	TargetScanStateStateScheduled   TargetScanStateState = "Scheduled"
+	Grype VulnerabilityScanner = "grype"
+	Trivy VulnerabilityScanner = "trivy"
 )
 
 // Defines values for VulnerabilitySeverity.
@@ -114,17 +297,287 @@ const (
 	NEGLIGIBLE VulnerabilitySeverity = "NEGLIGIBLE"
 )
 
+// APIKey A key authenticating the API requests which have it as their bearer
+// token, with the permissions of its role. Only a hash of the key is
+// stored, the key itself is returned once when the API key is created.
+type APIKey struct {
+	// AssetGroupIDs If set, the key can only access the assets which are members of
+	// any of these asset groups, and their scan results and findings.
+	AssetGroupIDs *[]string  `json:"assetGroupIDs,omitempty"`
+	CreatedAt     *time.Time `json:"createdAt,omitempty"`
+
+	// ExpiresAt The time the key expires at, it never expires if unset.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	Id        *string    `json:"id,omitempty"`
+
+	// Key The secret key, only set in the response to the creation of the API key.
+	Key  *string `json:"key,omitempty"`
+	Name string  `json:"name"`
+
+	// Prefix The first characters of the key, to tell the keys apart.
+	Prefix *string `json:"prefix,omitempty"`
+
+	// Role ReadOnly keys can only read the objects, Operator keys can also
+	// create and update them but not delete them, and Admin keys can do
+	// anything including managing the API keys and the settings.
+	Role APIKeyRole `json:"role"`
+}
+
+// APIKeyRole ReadOnly keys can only read the objects, Operator keys can also
+// create and update them but not delete them, and Admin keys can do
+// anything including managing the API keys and the settings.
+type APIKeyRole string
+
+// APIKeys defines model for APIKeys.
+type APIKeys struct {
+	// Count Total API key count according to the given filters
+	Count *int `json:"count,omitempty"`
+
+	// Items List of API keys according to the given filters and page.
+	Items *[]APIKey `json:"items,omitempty"`
+}
+
+// Annotations Free-form key/value pairs set by external automation, such as
+// correlation IDs, ticket numbers or pipeline run IDs. Keys must be
+// valid identifiers so that they can be filtered on, e.g.
+// annotations/ticketID eq 'SEC-123'. Patching merges the keys into the
+// existing annotations.
+type Annotations map[string]string
+
 // ApiResponse An object that is returned in all cases of failures.
 type ApiResponse struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// Asset Describes an asset object.
+type Asset struct {
+	AssetInfo *AssetType `json:"assetInfo,omitempty"`
+	Id        *string    `json:"id,omitempty"`
+
+	// Labels Tags set by the users on the asset in addition to the ones
+	// reported by its provider, e.g. to add it to asset groups.
+	Labels   *[]Tag `json:"labels,omitempty"`
+	Revision *int   `json:"revision,omitempty"`
+
+	// ScansCount Total number of scans that have ever run for this asset
+	ScansCount *int `json:"scansCount,omitempty"`
+
+	// Summary A summary of the scan findings.
+	Summary *ScanFindingsSummary `json:"summary,omitempty"`
+
+	// TerminatedOn The time the asset was no longer found by the periodic target
+	// discovery, e.g. because the instance was terminated. Not set for
+	// the assets which are still discovered.
+	TerminatedOn *time.Time `json:"terminatedOn,omitempty"`
+}
+
+// AssetCommon defines model for AssetCommon.
+type AssetCommon = map[string]interface{}
+
+// AssetExists defines model for AssetExists.
+type AssetExists struct {
+	// Asset Describes an asset object.
+	Asset *Asset `json:"asset,omitempty"`
+
+	// Message Describes which unique constraint combination causes the conflict.
+	Message *string `json:"message,omitempty"`
+}
+
+// AssetGroup A named group of assets, which can scope the scans of a scan config,
+// filter the dashboards and restrict the API keys. Its members are the
+// assets listed in assetIDs and the ones matching the tag selector.
+type AssetGroup struct {
+	// AssetIDs IDs of the assets which are members of the group regardless of their tags.
+	AssetIDs    *[]string `json:"assetIDs,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	Id          *string   `json:"id,omitempty"`
+	Name        *string   `json:"name,omitempty"`
+	Revision    *int      `json:"revision,omitempty"`
+
+	// TagSelector The assets with all of these tags or labels are members of the
+	// group. The tags of an asset are the ones reported by its provider.
+	TagSelector *[]Tag `json:"tagSelector,omitempty"`
+}
+
+// AssetGroupExists defines model for AssetGroupExists.
+type AssetGroupExists struct {
+	// AssetGroup A named group of assets, which can scope the scans of a scan config,
+	// filter the dashboards and restrict the API keys. Its members are the
+	// assets listed in assetIDs and the ones matching the tag selector.
+	AssetGroup *AssetGroup `json:"assetGroup,omitempty"`
+
+	// Message Describes which unique constraint combination causes the conflict.
+	Message *string `json:"message,omitempty"`
+}
+
+// AssetGroups defines model for AssetGroups.
+type AssetGroups struct {
+	// Count Total asset group count according to the given filters
+	Count *int `json:"count,omitempty"`
+
+	// Items List of asset groups according to the given filters and page.
+	Items *[]AssetGroup `json:"items,omitempty"`
+}
+
+// AssetRelationship Describes a relationship to an asset which can be expanded.
+type AssetRelationship struct {
+	AssetInfo *AssetType `json:"assetInfo,omitempty"`
+	Id        string     `json:"id"`
+	Labels    *[]Tag     `json:"labels,omitempty"`
+	Revision  *int       `json:"revision,omitempty"`
+
+	// ScansCount Total number of scans that have ever run for this asset
+	ScansCount *int `json:"scansCount,omitempty"`
+
+	// Summary A summary of the scan findings.
+	Summary      *ScanFindingsSummary `json:"summary,omitempty"`
+	TerminatedOn *time.Time           `json:"terminatedOn,omitempty"`
+}
+
+// AssetScanResult defines model for AssetScanResult.
+type AssetScanResult struct {
+	// Annotations Free-form key/value pairs set by external automation, such as
+	// correlation IDs, ticket numbers or pipeline run IDs. Keys must be
+	// valid identifiers so that they can be filtered on, e.g.
+	// annotations/ticketID eq 'SEC-123'. Patching merges the keys into the
+	// existing annotations.
+	Annotations *Annotations `json:"annotations,omitempty"`
+
+	// Artifacts The raw outputs of the scan families uploaded by the scanner to
+	// the artifact store of the backend, at most one per family. Set
+	// by the backend when an artifact is uploaded.
+	Artifacts *[]ScanResultArtifact `json:"artifacts"`
+
+	// Asset Describes a relationship to an asset which can be expanded.
+	Asset        *AssetRelationship `json:"asset,omitempty"`
+	Certificates *CertificateScan   `json:"certificates,omitempty"`
+	Compliance   *ComplianceScan    `json:"compliance,omitempty"`
+
+	// DeltaScan Describes the changes of the scanned volume since the previous
+	// successful scan of the same target.
+	DeltaScan         *DeltaScanInfo        `json:"deltaScan,omitempty"`
+	Exploits          *ExploitScan          `json:"exploits,omitempty"`
+	FindingsProcessed *bool                 `json:"findingsProcessed,omitempty"`
+	Id                *string               `json:"id,omitempty"`
+	Malware           *MalwareScan          `json:"malware,omitempty"`
+	Misconfigurations *MisconfigurationScan `json:"misconfigurations,omitempty"`
+	Plugins           *PluginScan           `json:"plugins,omitempty"`
+
+	// RerunFamilies The scan families to run when the scan result is re-run. If
+	// unset, all the families enabled in the scan config are run.
+	RerunFamilies   *[]ScanFamily         `json:"rerunFamilies"`
+	ResourceCleanup *ResourceCleanupState `json:"resourceCleanup,omitempty"`
+
+	// Retention The retention tier of a scan result. Managed by the orchestrator.
+	Retention *ScanResultRetention `json:"retention,omitempty"`
+	Revision  *int                 `json:"revision,omitempty"`
+	Rootkits  *RootkitScan         `json:"rootkits,omitempty"`
+	Sboms     *SbomScan            `json:"sboms,omitempty"`
+
+	// Scan Describes an expandable relationship to Scan object
+	Scan *ScanRelationship `json:"scan,omitempty"`
+
+	// ScannerEndTime The time when the scanner instance for the scan result was removed.
+	ScannerEndTime *time.Time `json:"scannerEndTime,omitempty"`
+
+	// ScannerInstanceImage The image the scanner instance was created from, as resolved by the
+	// provider when the scan result was scheduled.
+	ScannerInstanceImage *ScannerInstanceImage `json:"scannerInstanceImage,omitempty"`
+
+	// ScannerStartTime The time when the scanner instance for the scan result was created.
+	ScannerStartTime *time.Time       `json:"scannerStartTime,omitempty"`
+	Secrets          *SecretScan      `json:"secrets,omitempty"`
+	Status           *AssetScanStatus `json:"status,omitempty"`
+
+	// Summary A summary of the scan findings.
+	Summary *ScanFindingsSummary `json:"summary,omitempty"`
+
+	// Truncations The scan families whose result lists were truncated to the
+	// configured maximum number of findings per family. Set by the
+	// backend when the scan result is stored.
+	Truncations     *[]ScanResultTruncation `json:"truncations"`
+	Vulnerabilities *VulnerabilityScan      `json:"vulnerabilities,omitempty"`
+}
+
+// AssetScanResultExists defines model for AssetScanResultExists.
+type AssetScanResultExists struct {
+	AssetScanResult *AssetScanResult `json:"assetScanResult,omitempty"`
+
+	// Message Describes which unique constraint combination causes the conflict.
+	Message *string `json:"message,omitempty"`
+}
+
+// AssetScanResults defines model for AssetScanResults.
+type AssetScanResults struct {
+	// Count Total scan results count according to the given filters
+	Count *int `json:"count,omitempty"`
+
+	// Items List of scan results according to the given filters and page. List length must be lower or equal to pageSize.
+	Items *[]AssetScanResult `json:"items,omitempty"`
+}
+
+// AssetScanState defines model for AssetScanState.
+type AssetScanState struct {
+	Errors             *[]string  `json:"errors"`
+	LastTransitionTime *time.Time `json:"lastTransitionTime,omitempty"`
+
+	// Progress The percentage of the scan of the family which is done, reported by
+	// the scanner while the family is in progress.
+	Progress *int                 `json:"progress,omitempty"`
+	State    *AssetScanStateState `json:"state,omitempty"`
+}
+
+// AssetScanStateState defines model for AssetScanState.State.
+type AssetScanStateState string
+
+// AssetScanStatus defines model for AssetScanStatus.
+type AssetScanStatus struct {
+	Certificates      *AssetScanState `json:"certificates,omitempty"`
+	Compliance        *AssetScanState `json:"compliance,omitempty"`
+	Exploits          *AssetScanState `json:"exploits,omitempty"`
+	General           *AssetScanState `json:"general,omitempty"`
+	Malware           *AssetScanState `json:"malware,omitempty"`
+	Misconfigurations *AssetScanState `json:"misconfigurations,omitempty"`
+	Plugins           *AssetScanState `json:"plugins,omitempty"`
+	Rootkits          *AssetScanState `json:"rootkits,omitempty"`
+	Sbom              *AssetScanState `json:"sbom,omitempty"`
+	Secrets           *AssetScanState `json:"secrets,omitempty"`
+	Vulnerabilities   *AssetScanState `json:"vulnerabilities,omitempty"`
+}
+
+// AssetType defines model for AssetType.
+type AssetType struct {
+	union json.RawMessage
+}
+
+// Assets defines model for Assets.
+type Assets struct {
+	// Count Total assets count according the given filters
+	Count *int `json:"count,omitempty"`
+
+	// Items List of assets in the given filters and page. List length must be lower or equal to pageSize.
+	Items *[]Asset `json:"items,omitempty"`
+}
+
+// AwsAccount AWS member account of an organization
+type AwsAccount struct {
+	AccountID string       `json:"accountID"`
+	Name      *string      `json:"name,omitempty"`
+	Regions   *[]AwsRegion `json:"regions"`
+}
+
 // AwsAccountScope AWS cloud account scope
 type AwsAccountScope struct {
 	ObjectType string       `json:"objectType"`
 	Regions    *[]AwsRegion `json:"regions"`
 }
 
+// AwsOrganizationScope AWS organization scope
+type AwsOrganizationScope struct {
+	Accounts   *[]AwsAccount `json:"accounts"`
+	ObjectType string        `json:"objectType"`
+}
+
 // AwsRegion AWS region
 type AwsRegion struct {
 	Name string    `json:"name"`
@@ -133,6 +586,9 @@ type AwsRegion struct {
 
 // AwsScanScope The scope of a configured scan.
 type AwsScanScope struct {
+	// AccountIDs The member accounts of the AWS organization to scan. If empty, all the active member accounts are scanned. Only used if the provider is configured with an organization role.
+	AccountIDs *[]string `json:"accountIDs"`
+
 	// AllRegions Scan all regions, if set will override anything set in regions.
 	AllRegions *bool `json:"allRegions,omitempty"`
 
@@ -146,6 +602,19 @@ type AwsScanScope struct {
 	ShouldScanStoppedInstances *bool        `json:"shouldScanStoppedInstances,omitempty"`
 }
 
+// AwsScannerPlacement The dedicated network of the scanners on AWS, overriding the subnet
+// and security group of the provider configuration.
+type AwsScannerPlacement struct {
+	// SecurityGroupID The security group of the scanners, required if the subnets are
+	// not in the VPC of the configured scanner subnet.
+	SecurityGroupID *string `json:"securityGroupID,omitempty"`
+
+	// SubnetIDs The subnets in the scanner region the scanners are created in. The
+	// first subnet which is not excluded by avoidTargetSubnet is used,
+	// unless the scanners are spread across the zones of the subnets.
+	SubnetIDs *[]string `json:"subnetIDs,omitempty"`
+}
+
 // AwsVPC AWS VPC
 type AwsVPC struct {
 	Id             string           `json:"id"`
@@ -169,6 +638,28 @@ type AzureScanScope struct {
 	InstanceTagSelector *[]Tag                `json:"instanceTagSelector"`
 	ObjectType          string                `json:"objectType"`
 	ResourceGroups      *[]AzureResourceGroup `json:"resourceGroups"`
+
+	// ShouldScanStoppedInstances Scan the stopped and deallocated VM instances too, only the running ones are scanned if not set.
+	ShouldScanStoppedInstances *bool `json:"shouldScanStoppedInstances,omitempty"`
+}
+
+// AzureScannerPlacement The dedicated resource group and network of the scanners on Azure,
+// overriding the ones of the provider configuration.
+type AzureScannerPlacement struct {
+	// ResourceGroup The resource group the scanner virtual machines and their network
+	// interfaces are created in. The snapshots and disks of the targets
+	// are kept in the configured scanner resource group.
+	ResourceGroup *string `json:"resourceGroup,omitempty"`
+
+	// SecurityGroupID The network security group of the network interfaces of the scanners.
+	SecurityGroupID *string `json:"securityGroupID,omitempty"`
+
+	// SubnetID The subnet the network interfaces of the scanners are created in.
+	SubnetID *string `json:"subnetID,omitempty"`
+
+	// Zones The availability zones the scanners are spread across, zones 1, 2
+	// and 3 are used if not set.
+	Zones *[]string `json:"zones,omitempty"`
 }
 
 // AzureSubscriptionScope Azure subscription scope
@@ -178,9 +669,253 @@ type AzureSubscriptionScope struct {
 	SubscriptionID *string               `json:"subscriptionID,omitempty"`
 }
 
+// BackgroundTask A task which the backend runs periodically in the background.
+type BackgroundTask struct {
+	// Failures The number of failed runs since the backend started.
+	Failures *int `json:"failures,omitempty"`
+
+	// IntervalSeconds The time between the end of a run and the start of the next one.
+	IntervalSeconds *int `json:"intervalSeconds,omitempty"`
+
+	// JitterSeconds The maximum random delay added to the interval, so that the replicas of the backend don't run the task at the same time.
+	JitterSeconds *int               `json:"jitterSeconds,omitempty"`
+	LastRun       *BackgroundTaskRun `json:"lastRun,omitempty"`
+	Name          *string            `json:"name,omitempty"`
+	NextRunTime   *time.Time         `json:"nextRunTime,omitempty"`
+	Running       *bool              `json:"running,omitempty"`
+
+	// Runs The number of runs since the backend started.
+	Runs *int `json:"runs,omitempty"`
+}
+
+// BackgroundTaskRun defines model for BackgroundTaskRun.
+type BackgroundTaskRun struct {
+	EndTime *time.Time `json:"endTime,omitempty"`
+
+	// Error The error the run failed with.
+	Error     *string    `json:"error,omitempty"`
+	StartTime *time.Time `json:"startTime,omitempty"`
+
+	// Triggered Whether the run was requested with the API instead of being scheduled.
+	Triggered *bool `json:"triggered,omitempty"`
+}
+
+// BackgroundTasks defines model for BackgroundTasks.
+type BackgroundTasks struct {
+	Items *[]BackgroundTask `json:"items,omitempty"`
+}
+
+// Certificate defines model for Certificate.
+type Certificate struct {
+	// FilePath Path of the file that contains the certificate or key
+	FilePath    *string                 `json:"filePath,omitempty"`
+	FindingType *CertificateFindingType `json:"findingType,omitempty"`
+
+	// Fingerprint SHA-256 fingerprint of the certificate or public key
+	Fingerprint  *string `json:"fingerprint,omitempty"`
+	Issuer       *string `json:"issuer,omitempty"`
+	KeyAlgorithm *string `json:"keyAlgorithm,omitempty"`
+
+	// KeySize Size of the key in bits
+	KeySize            *int       `json:"keySize,omitempty"`
+	Message            *string    `json:"message,omitempty"`
+	NotAfter           *time.Time `json:"notAfter,omitempty"`
+	NotBefore          *time.Time `json:"notBefore,omitempty"`
+	SerialNumber       *string    `json:"serialNumber,omitempty"`
+	SignatureAlgorithm *string    `json:"signatureAlgorithm,omitempty"`
+	Subject            *string    `json:"subject,omitempty"`
+}
+
+// CertificateFindingInfo defines model for CertificateFindingInfo.
+type CertificateFindingInfo struct {
+	// FilePath Path of the file that contains the certificate or key
+	FilePath    *string                 `json:"filePath,omitempty"`
+	FindingType *CertificateFindingType `json:"findingType,omitempty"`
+
+	// Fingerprint SHA-256 fingerprint of the certificate or public key
+	Fingerprint  *string `json:"fingerprint,omitempty"`
+	Issuer       *string `json:"issuer,omitempty"`
+	KeyAlgorithm *string `json:"keyAlgorithm,omitempty"`
+
+	// KeySize Size of the key in bits
+	KeySize            *int       `json:"keySize,omitempty"`
+	Message            *string    `json:"message,omitempty"`
+	NotAfter           *time.Time `json:"notAfter,omitempty"`
+	NotBefore          *time.Time `json:"notBefore,omitempty"`
+	ObjectType         string     `json:"objectType"`
+	SerialNumber       *string    `json:"serialNumber,omitempty"`
+	SignatureAlgorithm *string    `json:"signatureAlgorithm,omitempty"`
+	Subject            *string    `json:"subject,omitempty"`
+}
+
+// CertificateFindingType defines model for CertificateFindingType.
+type CertificateFindingType string
+
+// CertificateScan defines model for CertificateScan.
+type CertificateScan struct {
+	Certificates *[]Certificate `json:"certificates"`
+}
+
+// CertificatesConfig defines model for CertificatesConfig.
+type CertificatesConfig struct {
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// ExpiryWarningDays Certificates which expire within this number of days are
+	// reported as expiring. Defaults to 30 days if unset.
+	ExpiryWarningDays *int `json:"expiryWarningDays,omitempty"`
+}
+
+// ChangedBlockRange A range of changed bytes of the scanned volume.
+type ChangedBlockRange struct {
+	Length int64 `json:"length"`
+	Offset int64 `json:"offset"`
+}
+
 // CloudProvider defines model for CloudProvider.
 type CloudProvider string
 
+// ComplianceBenchmark defines model for ComplianceBenchmark.
+type ComplianceBenchmark string
+
+// ComplianceCheck A failed check of a compliance benchmark.
+type ComplianceCheck struct {
+	Benchmark *ComplianceBenchmark `json:"benchmark,omitempty"`
+
+	// CheckID The ID of the check in the benchmark, e.g. 5.2.8
+	CheckID *string `json:"checkID,omitempty"`
+
+	// FilePath Path of the file the check was performed on
+	FilePath *string `json:"filePath,omitempty"`
+
+	// Message Describes why the check failed
+	Message     *string `json:"message,omitempty"`
+	Remediation *string `json:"remediation,omitempty"`
+	Title       *string `json:"title,omitempty"`
+}
+
+// ComplianceCheckFindingInfo defines model for ComplianceCheckFindingInfo.
+type ComplianceCheckFindingInfo struct {
+	Benchmark *ComplianceBenchmark `json:"benchmark,omitempty"`
+
+	// CheckID The ID of the check in the benchmark, e.g. 5.2.8
+	CheckID *string `json:"checkID,omitempty"`
+
+	// FilePath Path of the file the check was performed on
+	FilePath *string `json:"filePath,omitempty"`
+
+	// Message Describes why the check failed
+	Message     *string `json:"message,omitempty"`
+	ObjectType  string  `json:"objectType"`
+	Remediation *string `json:"remediation,omitempty"`
+	Title       *string `json:"title,omitempty"`
+}
+
+// ComplianceConfig defines model for ComplianceConfig.
+type ComplianceConfig struct {
+	// Benchmarks The benchmarks to check the target against. All the benchmarks
+	// are checked if unset.
+	Benchmarks *[]ComplianceBenchmark `json:"benchmarks,omitempty"`
+	Enabled    *bool                  `json:"enabled,omitempty"`
+}
+
+// ComplianceControl A reference to a control of a compliance framework.
+type ComplianceControl struct {
+	ControlID string `json:"controlID"`
+
+	// Framework The name of the compliance framework, e.g. CIS, PCI-DSS or NIST-800-53.
+	Framework string  `json:"framework"`
+	Title     *string `json:"title,omitempty"`
+}
+
+// ComplianceScan defines model for ComplianceScan.
+type ComplianceScan struct {
+	ComplianceChecks *[]ComplianceCheck `json:"complianceChecks"`
+}
+
+// ContainerImageInfo defines model for ContainerImageInfo.
+type ContainerImageInfo struct {
+	// ImageID Digest of the image manifest.
+	ImageID string `json:"imageID"`
+
+	// Location Fully qualified reference to the image by digest.
+	Location   string `json:"location"`
+	ObjectType string `json:"objectType"`
+
+	// Registry Host of the registry the image was discovered in.
+	Registry   *string   `json:"registry,omitempty"`
+	Repository string    `json:"repository"`
+	Tags       *[]string `json:"tags"`
+}
+
+// CorrelatedFinding An incident candidate linking the active findings of different scan
+// families on the same asset which together indicate a compromise or an
+// exploitable exposure, e.g. an exposed secret and an exploited
+// vulnerability. Correlated findings are computed by the backend, they
+// can't be modified.
+type CorrelatedFinding struct {
+	// Asset Describes a relationship to an asset which can be expanded.
+	Asset AssetRelationship `json:"asset"`
+
+	// FindingIDs The IDs of the correlated findings.
+	FindingIDs []string `json:"findingIDs"`
+
+	// FirstSeen When the findings were first correlated.
+	FirstSeen time.Time `json:"firstSeen"`
+	Id        *string   `json:"id,omitempty"`
+
+	// LastSeen When the findings were last correlated.
+	LastSeen time.Time `json:"lastSeen"`
+
+	// Path The path the findings were reported on, only set for the SamePath rule.
+	Path *string `json:"path,omitempty"`
+
+	// ResolvedOn When the findings stopped matching the rule, e.g. when one of them was fixed.
+	ResolvedOn *time.Time `json:"resolvedOn,omitempty"`
+
+	// Rule The rule which correlated the findings. SamePath correlates the
+	// findings of at least two of the secret, malware and vulnerability
+	// families reported on the same path, MalwareAndRootkit malware and a
+	// rootkit, ExposedSecretAndExploitedVulnerability a secret and a known
+	// exploited vulnerability or an exploit, WritableSUIDAndRootkit a
+	// writable or SUID binary misconfiguration and a rootkit. The SamePath
+	// correlations are CRITICAL when malware is involved, HIGH otherwise,
+	// the correlations of the other rules are CRITICAL.
+	Rule     CorrelationRule       `json:"rule"`
+	Severity VulnerabilitySeverity `json:"severity"`
+}
+
+// CorrelatedFindings defines model for CorrelatedFindings.
+type CorrelatedFindings struct {
+	// Count Total correlated finding count according to the given filters
+	Count *int `json:"count,omitempty"`
+
+	// Items List of correlated findings according to the given filters and page.
+	Items *[]CorrelatedFinding `json:"items,omitempty"`
+}
+
+// CorrelationRule The rule which correlated the findings. SamePath correlates the
+// findings of at least two of the secret, malware and vulnerability
+// families reported on the same path, MalwareAndRootkit malware and a
+// rootkit, ExposedSecretAndExploitedVulnerability a secret and a known
+// exploited vulnerability or an exploit, WritableSUIDAndRootkit a
+// writable or SUID binary misconfiguration and a rootkit. The SamePath
+// correlations are CRITICAL when malware is involved, HIGH otherwise,
+// the correlations of the other rules are CRITICAL.
+type CorrelationRule string
+
+// DeltaScanInfo Describes the changes of the scanned volume since the previous
+// successful scan of the same target.
+type DeltaScanInfo struct {
+	// BaseScanResultID The scan result which scanned the volume the changes are compared to.
+	BaseScanResultID *string              `json:"baseScanResultID,omitempty"`
+	ChangedBlocks    *[]ChangedBlockRange `json:"changedBlocks,omitempty"`
+
+	// ChangedPaths The files and directories which contain changed blocks, reported
+	// by the scanner. If unset, the changes could not be mapped to files
+	// and the volume was scanned in full.
+	ChangedPaths *[]string `json:"changedPaths"`
+}
+
 // DirInfo defines model for DirInfo.
 type DirInfo struct {
 	DirName    *string `json:"dirName,omitempty"`
@@ -219,21 +954,94 @@ type ExploitsConfig struct {
 	Enabled *bool `json:"enabled,omitempty"`
 }
 
-// Finding defines model for Finding.
-type Finding struct {
-	// Asset Describes a relationship to a target which can be expanded.
-	Asset       *TargetRelationship  `json:"asset,omitempty"`
-	FindingInfo *Finding_FindingInfo `json:"findingInfo,omitempty"`
+// Feed A vulnerability, exploit or malware feed which the backend downloads
+// periodically and serves to the scanners.
+type Feed struct {
+	Kind     *FeedKind `json:"kind,omitempty"`
+	LastSync *FeedSync `json:"lastSync,omitempty"`
+	Name     *string   `json:"name,omitempty"`
 
-	// FoundOn When this finding was discovered by a scan
-	FoundOn *time.Time `json:"foundOn,omitempty"`
-	Id      *string    `json:"id,omitempty"`
+	// Size The size in bytes of the current version.
+	Size *int64 `json:"size,omitempty"`
 
-	// InvalidatedOn When this finding was invalidated by a newer scan
-	InvalidatedOn *time.Time `json:"invalidatedOn,omitempty"`
+	// UpdatedAt The time the current version was downloaded.
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
 
-	// Scan Describes an expandable relationship to Scan object
-	Scan *ScanRelationship `json:"scan,omitempty"`
+	// Url The upstream URL the feed is downloaded from.
+	Url *string `json:"url,omitempty"`
+
+	// Version The current version of the feed, the SHA-256 digest of its content.
+	Version *string `json:"version,omitempty"`
+
+	// Versions The versions of the feed kept by the backend, the most recent first.
+	Versions *[]FeedVersion `json:"versions,omitempty"`
+}
+
+// FeedKind defines model for FeedKind.
+type FeedKind string
+
+// FeedSync The outcome of the last sync of a feed.
+type FeedSync struct {
+	EndTime *time.Time `json:"endTime,omitempty"`
+
+	// Error The error the sync failed with, the current version is kept.
+	Error     *string    `json:"error,omitempty"`
+	StartTime *time.Time `json:"startTime,omitempty"`
+
+	// Updated Whether the sync downloaded a new version of the feed.
+	Updated *bool `json:"updated,omitempty"`
+}
+
+// FeedVersion defines model for FeedVersion.
+type FeedVersion struct {
+	DownloadedAt *time.Time `json:"downloadedAt,omitempty"`
+	Size         *int64     `json:"size,omitempty"`
+	Version      *string    `json:"version,omitempty"`
+}
+
+// Feeds defines model for Feeds.
+type Feeds struct {
+	Items *[]Feed `json:"items,omitempty"`
+}
+
+// Finding defines model for Finding.
+type Finding struct {
+	// Annotations Free-form key/value pairs set by external automation, such as
+	// correlation IDs, ticket numbers or pipeline run IDs. Keys must be
+	// valid identifiers so that they can be filtered on, e.g.
+	// annotations/ticketID eq 'SEC-123'. Patching merges the keys into the
+	// existing annotations.
+	Annotations *Annotations `json:"annotations,omitempty"`
+
+	// Asset Describes a relationship to an asset which can be expanded.
+	Asset *AssetRelationship `json:"asset,omitempty"`
+
+	// Confidence How likely the finding is a true positive, derived from the confidence
+	// reported by the scanner, e.g. the entropy of a secret or whether
+	// malware was detected by a signature or a heuristic. Not set if the
+	// scanner doesn't report one.
+	Confidence  *FindingConfidence   `json:"confidence,omitempty"`
+	FindingInfo *Finding_FindingInfo `json:"findingInfo,omitempty"`
+
+	// FoundOn When this finding was discovered by a scan
+	FoundOn *time.Time `json:"foundOn,omitempty"`
+	Id      *string    `json:"id,omitempty"`
+
+	// InvalidatedOn When this finding was invalidated by a newer scan
+	InvalidatedOn *time.Time `json:"invalidatedOn,omitempty"`
+
+	// Scan Describes an expandable relationship to Scan object
+	Scan *ScanRelationship `json:"scan,omitempty"`
+
+	// ScannersCount The number of distinct scanners which reported this finding.
+	// Filtering on this field (e.g. scannersCount ge 2) can be used
+	// to reduce noise from findings reported by a single scanner.
+	ScannersCount *int `json:"scannersCount,omitempty"`
+
+	// Suppression Set on the findings suppressed by a finding exception when they are
+	// processed from the scan results. Suppressed findings are excluded from
+	// the summaries.
+	Suppression *FindingSuppression `json:"suppression,omitempty"`
 }
 
 // Finding_FindingInfo defines model for Finding.FindingInfo.
@@ -241,6 +1049,144 @@ type Finding_FindingInfo struct {
 	union json.RawMessage
 }
 
+// FindingBulkItemResult defines model for FindingBulkItemResult.
+type FindingBulkItemResult struct {
+	Finding *Finding `json:"finding,omitempty"`
+
+	// Message Describes why the operation on the finding failed.
+	Message *string `json:"message,omitempty"`
+
+	// Status The HTTP status code of the operation on the finding.
+	Status int `json:"status"`
+}
+
+// FindingConfidence How likely the finding is a true positive, derived from the confidence
+// reported by the scanner, e.g. the entropy of a secret or whether
+// malware was detected by a signature or a heuristic. Not set if the
+// scanner doesn't report one.
+type FindingConfidence string
+
+// FindingDigest A subscription to a consolidated summary of the new findings matching
+// a filter, delivered by email to the recipients and to the webhook of
+// the notification config once per cadence instead of one notification
+// per finding. Digests without new findings are not sent.
+type FindingDigest struct {
+	// Cadence Daily digests are delivered every day, weekly digests every Monday.
+	Cadence *FindingDigestCadence `json:"cadence,omitempty"`
+
+	// Disabled If true, the digest is not delivered.
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// Filter The OData filter of the findings listed in the digest, e.g.
+	// "findingInfo/objectType eq 'Vulnerability'".
+	Filter *string `json:"filter,omitempty"`
+	Id     *string `json:"id,omitempty"`
+
+	// LastDelivery The status of a delivery of a finding digest.
+	LastDelivery *FindingDigestDelivery `json:"lastDelivery,omitempty"`
+	Name         *string                `json:"name,omitempty"`
+
+	// NextDeliveryTime The time of the next delivery. Managed by the backend.
+	NextDeliveryTime *time.Time `json:"nextDeliveryTime,omitempty"`
+
+	// NotificationConfigID The ID of the notification config whose webhook the digest is
+	// posted to as a FindingsDigest event, regardless of the events the
+	// webhook is subscribed to.
+	NotificationConfigID *string `json:"notificationConfigID,omitempty"`
+
+	// Recipients The email addresses the digest is delivered to.
+	Recipients *[]string `json:"recipients,omitempty"`
+	Revision   *int      `json:"revision,omitempty"`
+
+	// Timezone The IANA time zone the digest is delivered at 08:00 in, e.g.
+	// "Europe/Budapest". Defaults to UTC.
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// FindingDigestCadence Daily digests are delivered every day, weekly digests every Monday.
+type FindingDigestCadence string
+
+// FindingDigestContent The new findings matching the filter of a finding digest found in
+// the period of the digest. At most 100 of them are listed.
+type FindingDigestContent struct {
+	// Count The number of new findings in the period.
+	Count           int       `json:"count"`
+	FindingDigestID string    `json:"findingDigestID"`
+	Findings        []Finding `json:"findings"`
+	Name            *string   `json:"name,omitempty"`
+	PeriodEnd       time.Time `json:"periodEnd"`
+	PeriodStart     time.Time `json:"periodStart"`
+
+	// RenderedFindings The findings rendered with the finding templates, in the same order.
+	RenderedFindings *[]RenderedFinding `json:"renderedFindings,omitempty"`
+}
+
+// FindingDigestDelivery The status of a delivery of a finding digest.
+type FindingDigestDelivery struct {
+	// Findings The number of new findings of the digest.
+	Findings int `json:"findings"`
+
+	// Message The reason the delivery failed.
+	Message *string             `json:"message,omitempty"`
+	State   ReportDeliveryState `json:"state"`
+	Time    time.Time           `json:"time"`
+}
+
+// FindingDigests defines model for FindingDigests.
+type FindingDigests struct {
+	// Count Total finding digest count according to the given filters
+	Count *int `json:"count,omitempty"`
+
+	// Items List of finding digests according to the given filters and page.
+	Items *[]FindingDigest `json:"items,omitempty"`
+}
+
+// FindingException Suppresses the findings matching all of its match rules, for example a
+// false positive vulnerability or an accepted risk. The findings of the
+// scan results processed while it is in effect are flagged with the
+// suppression and excluded from the summaries.
+type FindingException struct {
+	// ExpiresAt When the exception stops suppressing findings, it never expires if not set.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	Id        *string    `json:"id,omitempty"`
+
+	// MatchRules The rules a finding must match to be suppressed, the unset rules match
+	// any finding. At least one rule must be set.
+	MatchRules *FindingExceptionMatchRules `json:"matchRules,omitempty"`
+	Name       *string                     `json:"name,omitempty"`
+
+	// Reason Why the findings are suppressed.
+	Reason   *string `json:"reason,omitempty"`
+	Revision *int    `json:"revision,omitempty"`
+}
+
+// FindingExceptionMatchRules The rules a finding must match to be suppressed, the unset rules match
+// any finding. At least one rule must be set.
+type FindingExceptionMatchRules struct {
+	// AssetFilter OData filter the assets are selected by, e.g.
+	// "assetInfo/instanceID eq 'i-0123'" or
+	// "assetInfo/tags/any(t: t/key eq 'env' and t/value eq 'dev')".
+	AssetFilter *string `json:"assetFilter,omitempty"`
+
+	// FindingType The objectType of the finding info, e.g. Vulnerability or Secret.
+	FindingType *string `json:"findingType,omitempty"`
+
+	// PackagePurl The package URL of the package or of the vulnerable package.
+	PackagePurl *string `json:"packagePurl,omitempty"`
+
+	// VulnerabilityName The CVE or other identifier of the vulnerability.
+	VulnerabilityName *string `json:"vulnerabilityName,omitempty"`
+}
+
+// FindingExceptions defines model for FindingExceptions.
+type FindingExceptions struct {
+	// Count Total finding exception count according to the given filters
+	Count *int `json:"count,omitempty"`
+
+	// Items List of finding exceptions according to the given filters and page.
+	Items *[]FindingException `json:"items,omitempty"`
+}
+
 // FindingExists defines model for FindingExists.
 type FindingExists struct {
 	Finding *Finding `json:"finding,omitempty"`
@@ -249,6 +1195,58 @@ type FindingExists struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// FindingSuppression Set on the findings suppressed by a finding exception when they are
+// processed from the scan results. Suppressed findings are excluded from
+// the summaries.
+type FindingSuppression struct {
+	// ExpiresAt When the finding exception expires, after which the findings of newer scans are not suppressed.
+	ExpiresAt          *time.Time `json:"expiresAt,omitempty"`
+	FindingExceptionID string     `json:"findingExceptionID"`
+	Reason             *string    `json:"reason,omitempty"`
+}
+
+// FindingTemplate defines model for FindingTemplate.
+type FindingTemplate struct {
+	Description *string `json:"description,omitempty"`
+	Title       *string `json:"title,omitempty"`
+}
+
+// FindingTemplatePreview Either the finding, or the findingID of an existing finding, must
+// be set.
+type FindingTemplatePreview struct {
+	Finding   *Finding `json:"finding,omitempty"`
+	FindingID *string  `json:"findingID,omitempty"`
+
+	// Templates Go text/template templates of the title and the description findings
+	// are rendered with in the webhook events and the finding digests.
+	// The templates of the family of a finding override the default ones,
+	// and the built-in templates are used for the unset ones. The
+	// templates are executed on an object with the fields Type (the
+	// family of the finding, e.g. Vulnerability), Key (the unique key of
+	// the finding in its family), Info (the findingInfo of the finding),
+	// AssetID and Finding, and can use the default, upper and lower
+	// functions, e.g. "{{ upper (default \"unknown\" .Info.Severity) }}".
+	Templates *FindingTemplateSettings `json:"templates,omitempty"`
+}
+
+// FindingTemplateSettings Go text/template templates of the title and the description findings
+// are rendered with in the webhook events and the finding digests.
+// The templates of the family of a finding override the default ones,
+// and the built-in templates are used for the unset ones. The
+// templates are executed on an object with the fields Type (the
+// family of the finding, e.g. Vulnerability), Key (the unique key of
+// the finding in its family), Info (the findingInfo of the finding),
+// AssetID and Finding, and can use the default, upper and lower
+// functions, e.g. "{{ upper (default \"unknown\" .Info.Severity) }}".
+type FindingTemplateSettings struct {
+	Description *string `json:"description,omitempty"`
+
+	// Families The templates of the finding families, keyed by the objectType of their findingInfo.
+	Families  *map[string]FindingTemplate `json:"families,omitempty"`
+	Title     *string                     `json:"title,omitempty"`
+	UpdatedAt *time.Time                  `json:"updatedAt,omitempty"`
+}
+
 // Findings defines model for Findings.
 type Findings struct {
 	// Count Total findings count according to the given filters
@@ -258,13 +1256,135 @@ type Findings struct {
 	Items *[]Finding `json:"items,omitempty"`
 }
 
+// FindingsBulkRequest defines model for FindingsBulkRequest.
+type FindingsBulkRequest struct {
+	Items []Finding `json:"items"`
+}
+
+// FindingsBulkResult defines model for FindingsBulkResult.
+type FindingsBulkResult struct {
+	// Items The result of each finding of the request, in the same order.
+	Items []FindingBulkItemResult `json:"items"`
+}
+
+// FindingsRetentionPolicy Retention of the findings which are no longer found. Active findings
+// are always retained.
+type FindingsRetentionPolicy struct {
+	// MaxAgeDays Days the findings are retained after they were invalidated, zero or unset retains them regardless of their age.
+	MaxAgeDays *int `json:"maxAgeDays,omitempty"`
+}
+
+// InstalledPackage defines model for InstalledPackage.
+type InstalledPackage struct {
+	// InstalledVersions The versions of the package currently installed on the target.
+	InstalledVersions *[]string `json:"installedVersions,omitempty"`
+	Language          *string   `json:"language,omitempty"`
+	Licenses          *[]string `json:"licenses,omitempty"`
+	Name              *string   `json:"name,omitempty"`
+	Type              *string   `json:"type,omitempty"`
+
+	// VersionHistory The versions of the package found on the target, ordered by when they were first found.
+	VersionHistory *[]PackageVersionHistory `json:"versionHistory,omitempty"`
+}
+
+// InstalledPackages defines model for InstalledPackages.
+type InstalledPackages struct {
+	Items *[]InstalledPackage `json:"items,omitempty"`
+}
+
+// JSONPatch A JSON Patch document (RFC 6902).
+type JSONPatch = []JSONPatchOperation
+
+// JSONPatchOperation defines model for JSONPatchOperation.
+type JSONPatchOperation struct {
+	// From The JSON Pointer of the source location of move and copy.
+	From *string              `json:"from,omitempty"`
+	Op   JSONPatchOperationOp `json:"op"`
+
+	// Path The JSON Pointer (RFC 6901) of the target location.
+	Path string `json:"path"`
+
+	// Value The value to add, replace or test.
+	Value *interface{} `json:"value,omitempty"`
+}
+
+// JSONPatchOperationOp defines model for JSONPatchOperation.Op.
+type JSONPatchOperationOp string
+
+// KubernetesClusterScope Nodes and namespaces of a Kubernetes cluster
+type KubernetesClusterScope struct {
+	ClusterName *string   `json:"clusterName,omitempty"`
+	Namespaces  *[]string `json:"namespaces"`
+	Nodes       *[]string `json:"nodes"`
+	ObjectType  string    `json:"objectType"`
+}
+
+// KubernetesScanScope The scope of a configured scan of a Kubernetes cluster.
+type KubernetesScanScope struct {
+	// Namespaces The namespaces the images of the running workloads are scanned in. If empty, all the namespaces are scanned.
+	Namespaces *[]string `json:"namespaces"`
+
+	// NodeSelector Nodes will be scanned if they contain all of these labels. If empty, not taken into account.
+	NodeSelector *[]Tag `json:"nodeSelector"`
+	ObjectType   string `json:"objectType"`
+
+	// SkipNodes Do not scan the root filesystems of the nodes.
+	SkipNodes *bool `json:"skipNodes,omitempty"`
+
+	// SkipWorkloadImages Do not scan the images of the running workloads.
+	SkipWorkloadImages *bool `json:"skipWorkloadImages,omitempty"`
+}
+
+// LocationMetadata The location of an asset normalized across the clouds, set at
+// discovery by the providers which know it.
+type LocationMetadata struct {
+	Cloud *CloudProvider `json:"cloud,omitempty"`
+
+	// Country The ISO 3166-1 alpha-2 code of the country the region is in, empty if unknown.
+	Country *string `json:"country,omitempty"`
+
+	// Region The region of the cloud in its own naming, e.g. us-east-1 or westeurope.
+	Region *string `json:"region,omitempty"`
+
+	// Zone The availability zone within the region, unique across the regions of the cloud, e.g. us-east-1a or westeurope-1.
+	Zone *string `json:"zone,omitempty"`
+}
+
+// MaintenanceMode The maintenance mode of the backend, e.g. during database migrations
+// and backups. The API stays available for reading while the backend is
+// in maintenance mode.
+type MaintenanceMode struct {
+	Enabled bool `json:"enabled"`
+
+	// Reason Why the backend is in maintenance mode, it is reported to the clients of the rejected requests.
+	Reason *string `json:"reason,omitempty"`
+
+	// RetryAfterSeconds The delay the clients of the rejected requests are asked to retry after, 300 if unset.
+	RetryAfterSeconds *int       `json:"retryAfterSeconds,omitempty"`
+	UpdatedAt         *time.Time `json:"updatedAt,omitempty"`
+}
+
 // Malware defines model for Malware.
 type Malware struct {
-	MalwareName *string      `json:"malwareName,omitempty"`
-	MalwareType *MalwareType `json:"malwareType,omitempty"`
+	// Confidence How likely the finding is a true positive, derived from the confidence
+	// reported by the scanner, e.g. the entropy of a secret or whether
+	// malware was detected by a signature or a heuristic. Not set if the
+	// scanner doesn't report one.
+	Confidence  *FindingConfidence `json:"confidence,omitempty"`
+	MalwareName *string            `json:"malwareName,omitempty"`
+	MalwareType *MalwareType       `json:"malwareType,omitempty"`
+
+	// MatchedStrings Strings of the YARA rule found in the file, if detected by a YARA scanner.
+	MatchedStrings *[]MalwareMatchedString `json:"matchedStrings,omitempty"`
 
 	// Path Path of the file that contains malware
 	Path *string `json:"path,omitempty"`
+
+	// RuleName Name of the YARA rule which matched the file, if detected by a YARA scanner.
+	RuleName *string `json:"ruleName,omitempty"`
+
+	// Scanners The scanners which reported this malware.
+	Scanners *[]ScannerAttribution `json:"scanners"`
 }
 
 // MalwareConfig defines model for MalwareConfig.
@@ -274,12 +1394,35 @@ type MalwareConfig struct {
 
 // MalwareFindingInfo defines model for MalwareFindingInfo.
 type MalwareFindingInfo struct {
-	MalwareName *string      `json:"malwareName,omitempty"`
-	MalwareType *MalwareType `json:"malwareType,omitempty"`
-	ObjectType  string       `json:"objectType"`
+	// Confidence How likely the finding is a true positive, derived from the confidence
+	// reported by the scanner, e.g. the entropy of a secret or whether
+	// malware was detected by a signature or a heuristic. Not set if the
+	// scanner doesn't report one.
+	Confidence  *FindingConfidence `json:"confidence,omitempty"`
+	MalwareName *string            `json:"malwareName,omitempty"`
+	MalwareType *MalwareType       `json:"malwareType,omitempty"`
+
+	// MatchedStrings Strings of the YARA rule found in the file, if detected by a YARA scanner.
+	MatchedStrings *[]MalwareMatchedString `json:"matchedStrings,omitempty"`
+	ObjectType     string                  `json:"objectType"`
 
 	// Path Path of the file that contains malware
 	Path *string `json:"path,omitempty"`
+
+	// RuleName Name of the YARA rule which matched the file, if detected by a YARA scanner.
+	RuleName *string `json:"ruleName,omitempty"`
+
+	// Scanners The scanners which reported this malware.
+	Scanners *[]ScannerAttribution `json:"scanners"`
+}
+
+// MalwareMatchedString defines model for MalwareMatchedString.
+type MalwareMatchedString struct {
+	Data       *string `json:"data,omitempty"`
+	Identifier *string `json:"identifier,omitempty"`
+
+	// Offset Offset of the matched string in the file.
+	Offset *int64 `json:"offset,omitempty"`
 }
 
 // MalwareScan defines model for MalwareScan.
@@ -305,15 +1448,17 @@ type Misconfiguration struct {
 
 // MisconfigurationFindingInfo defines model for MisconfigurationFindingInfo.
 type MisconfigurationFindingInfo struct {
-	Message         *string                   `json:"message,omitempty"`
-	ObjectType      string                    `json:"objectType"`
-	Remediation     *string                   `json:"remediation,omitempty"`
-	ScannedPath     *string                   `json:"scannedPath,omitempty"`
-	ScannerName     *string                   `json:"scannerName,omitempty"`
-	Severity        *MisconfigurationSeverity `json:"severity,omitempty"`
-	TestCategory    *string                   `json:"testCategory,omitempty"`
-	TestDescription *string                   `json:"testDescription,omitempty"`
-	TestID          *string                   `json:"testID,omitempty"`
+	// ComplianceControls The compliance framework controls the misconfiguration maps to.
+	ComplianceControls *[]ComplianceControl      `json:"complianceControls,omitempty"`
+	Message            *string                   `json:"message,omitempty"`
+	ObjectType         string                    `json:"objectType"`
+	Remediation        *string                   `json:"remediation,omitempty"`
+	ScannedPath        *string                   `json:"scannedPath,omitempty"`
+	ScannerName        *string                   `json:"scannerName,omitempty"`
+	Severity           *MisconfigurationSeverity `json:"severity,omitempty"`
+	TestCategory       *string                   `json:"testCategory,omitempty"`
+	TestDescription    *string                   `json:"testDescription,omitempty"`
+	TestID             *string                   `json:"testID,omitempty"`
 }
 
 // MisconfigurationScan defines model for MisconfigurationScan.
@@ -325,11 +1470,160 @@ type MisconfigurationScan struct {
 // MisconfigurationSeverity defines model for MisconfigurationSeverity.
 type MisconfigurationSeverity string
 
+// MisconfigurationSkipTest defines model for MisconfigurationSkipTest.
+type MisconfigurationSkipTest struct {
+	// Audit Records the false positive finding a scanner suppression was generated from.
+	Audit *SuppressionAudit `json:"audit,omitempty"`
+
+	// TestID The ID of the Lynis test, e.g. SSH-7408.
+	TestID string `json:"testID"`
+}
+
 // MisconfigurationsConfig defines model for MisconfigurationsConfig.
 type MisconfigurationsConfig struct {
 	Enabled *bool `json:"enabled,omitempty"`
+
+	// SkipTests Lynis tests which are not run.
+	SkipTests *[]MisconfigurationSkipTest `json:"skipTests,omitempty"`
+}
+
+// NotificationConfig Describes a webhook which is called with a NotificationEvent when one
+// of the subscribed events happens. If minConfidence is set, the finding
+// events are only sent for findings with at least that confidence,
+// findings without a confidence are always sent.
+type NotificationConfig struct {
+	// Disabled If true, the webhook is not called.
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// Events The events the webhook is called for.
+	Events *[]NotificationEventType `json:"events,omitempty"`
+
+	// Filter A CEL expression over the event which must evaluate to true for the
+	// event to be posted, e.g. `event.scan.scanConfig.id == "nightly"`.
+	// The fields of the event are referred to by their JSON names.
+	Filter *string `json:"filter,omitempty"`
+	Id     *string `json:"id,omitempty"`
+
+	// LastDelivery The status of the last delivery of an event to a webhook.
+	LastDelivery *NotificationDelivery `json:"lastDelivery,omitempty"`
+
+	// MinConfidence How likely the finding is a true positive, derived from the confidence
+	// reported by the scanner, e.g. the entropy of a secret or whether
+	// malware was detected by a signature or a heuristic. Not set if the
+	// scanner doesn't report one.
+	MinConfidence *FindingConfidence `json:"minConfidence,omitempty"`
+	Name          *string            `json:"name,omitempty"`
+
+	// PayloadContentType The Content-Type header of the body rendered with
+	// payloadTemplate, application/json if not set.
+	PayloadContentType *string `json:"payloadContentType,omitempty"`
+
+	// PayloadTemplate A Go template, with the sprig functions but env, expandenv and
+	// getHostByName, rendering the body posted for the event instead of
+	// the NotificationEvent, e.g. `{"text": {{ .scan.id | toJson }}}`.
+	// The fields of the event are referred to by their JSON names.
+	PayloadTemplate *string `json:"payloadTemplate,omitempty"`
+	Revision        *int    `json:"revision,omitempty"`
+
+	// Secret The key the payload is signed with, the hex encoded HMAC-SHA256 of
+	// the body is sent in the X-VMClarity-Signature header prefixed with
+	// "sha256=". It is never returned by the API.
+	Secret *string `json:"secret,omitempty"`
+
+	// Url The http or https URL the events are posted to.
+	Url *string `json:"url,omitempty"`
+}
+
+// NotificationConfigs defines model for NotificationConfigs.
+type NotificationConfigs struct {
+	// Count Total notification config count according to the given filters
+	Count *int `json:"count,omitempty"`
+
+	// Items List of notification configs according to the given filters and page.
+	Items *[]NotificationConfig `json:"items,omitempty"`
+}
+
+// NotificationDelivery The status of the last delivery of an event to a webhook.
+type NotificationDelivery struct {
+	// Attempts The number of times the delivery was attempted.
+	Attempts int                   `json:"attempts"`
+	Event    NotificationEventType `json:"event"`
+
+	// Message The reason the delivery failed.
+	Message *string                   `json:"message,omitempty"`
+	State   NotificationDeliveryState `json:"state"`
+	Time    time.Time                 `json:"time"`
+}
+
+// NotificationDeliveryState defines model for NotificationDeliveryState.
+type NotificationDeliveryState string
+
+// NotificationEvent The payload posted to the webhooks. Scan is set for the scan events,
+// Finding for the finding events, Digest for the FindingsDigest event.
+// RenderedFinding is the Finding rendered with the finding templates.
+// FindingFixed is sent when a finding stops appearing in a later scan
+// of its asset, FindingInvalidated when a finding is invalidated
+// without a later scan of its asset, e.g. a network misconfiguration
+// which no longer applies.
+type NotificationEvent struct {
+	// Digest The new findings matching the filter of a finding digest found in
+	// the period of the digest. At most 100 of them are listed.
+	Digest  *FindingDigestContent `json:"digest,omitempty"`
+	Finding *Finding              `json:"finding,omitempty"`
+
+	// RenderedFinding A finding rendered with the finding templates.
+	RenderedFinding *RenderedFinding `json:"renderedFinding,omitempty"`
+
+	// Scan Describes a multi-target scheduled scan.
+	Scan *Scan     `json:"scan,omitempty"`
+	Time time.Time `json:"time"`
+
+	// TimeToFixSeconds Set for the FindingFixed and FindingInvalidated events, the number
+	// of seconds between the finding was first found on the asset and
+	// it stopped appearing.
+	TimeToFixSeconds *int64                `json:"timeToFixSeconds,omitempty"`
+	Type             NotificationEventType `json:"type"`
+}
+
+// NotificationEventType defines model for NotificationEventType.
+type NotificationEventType string
+
+// ObjectCounts The number of stored objects per type.
+type ObjectCounts struct {
+	Assets      *int `json:"assets,omitempty"`
+	Findings    *int `json:"findings,omitempty"`
+	ScanConfigs *int `json:"scanConfigs,omitempty"`
+	ScanResults *int `json:"scanResults,omitempty"`
+	Scans       *int `json:"scans,omitempty"`
+}
+
+// Operation An asynchronous operation started by a long-running endpoint.
+// Operations are kept in memory by the backend for a limited time
+// after they complete.
+type Operation struct {
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	CreatedAt   *time.Time `json:"createdAt,omitempty"`
+
+	// Error The reason the operation failed.
+	Error *string `json:"error,omitempty"`
+
+	// ExpiresAt When the completed operation and its result are removed.
+	ExpiresAt *time.Time     `json:"expiresAt,omitempty"`
+	Id        *string        `json:"id,omitempty"`
+	Kind      *OperationKind `json:"kind,omitempty"`
+
+	// ResultLink The link to the result of the operation once it succeeded.
+	ResultLink *string         `json:"resultLink,omitempty"`
+	StartedAt  *time.Time      `json:"startedAt,omitempty"`
+	State      *OperationState `json:"state,omitempty"`
 }
 
+// OperationKind defines model for OperationKind.
+type OperationKind string
+
+// OperationState defines model for OperationState.
+type OperationState string
+
 // Package defines model for Package.
 type Package struct {
 	Cpes     *[]string `json:"cpes"`
@@ -341,6 +1635,12 @@ type Package struct {
 	Version  *string   `json:"version,omitempty"`
 }
 
+// PackageChange defines model for PackageChange.
+type PackageChange struct {
+	Base    *Package `json:"base,omitempty"`
+	Compare *Package `json:"compare,omitempty"`
+}
+
 // PackageFindingInfo defines model for PackageFindingInfo.
 type PackageFindingInfo struct {
 	Cpes       *[]string `json:"cpes"`
@@ -353,110 +1653,516 @@ type PackageFindingInfo struct {
 	Version    *string   `json:"version,omitempty"`
 }
 
-// PodInfo defines model for PodInfo.
-type PodInfo struct {
-	Location   *string `json:"location,omitempty"`
-	ObjectType string  `json:"objectType"`
-	PodName    *string `json:"podName,omitempty"`
-}
+// PackageUpgrade defines model for PackageUpgrade.
+type PackageUpgrade struct {
+	CurrentVersion *string `json:"currentVersion,omitempty"`
 
-// ResourceCleanupState defines model for ResourceCleanupState.
-type ResourceCleanupState string
+	// FixVersion The version which fixes all the fixable vulnerabilities of the package.
+	FixVersion *string `json:"fixVersion,omitempty"`
+	Name       *string `json:"name,omitempty"`
 
-// Rootkit defines model for Rootkit.
-type Rootkit struct {
-	Message     *string      `json:"message,omitempty"`
-	RootkitName *string      `json:"rootkitName,omitempty"`
-	RootkitType *RootkitType `json:"rootkitType,omitempty"`
+	// ResolvedFindings The number of findings resolved by this upgrade.
+	ResolvedFindings *int      `json:"resolvedFindings,omitempty"`
+	Type             *string   `json:"type,omitempty"`
+	Vulnerabilities  *[]string `json:"vulnerabilities,omitempty"`
 }
 
-// RootkitFindingInfo defines model for RootkitFindingInfo.
-type RootkitFindingInfo struct {
-	Message     *string      `json:"message,omitempty"`
-	ObjectType  string       `json:"objectType"`
-	RootkitName *string      `json:"rootkitName,omitempty"`
-	RootkitType *RootkitType `json:"rootkitType,omitempty"`
+// PackageVersionHistory defines model for PackageVersionHistory.
+type PackageVersionHistory struct {
+	// FirstFoundOn When the version was first found on the target.
+	FirstFoundOn *time.Time `json:"firstFoundOn,omitempty"`
+
+	// RemovedOn When the version was no longer found on the target, not set while it is installed.
+	RemovedOn *time.Time `json:"removedOn,omitempty"`
+	Version   *string    `json:"version,omitempty"`
 }
 
-// RootkitScan defines model for RootkitScan.
-type RootkitScan struct {
-	Rootkits *[]Rootkit `json:"rootkits"`
+// PackagesDiff defines model for PackagesDiff.
+type PackagesDiff struct {
+	Added   *[]Package       `json:"added,omitempty"`
+	Changed *[]PackageChange `json:"changed,omitempty"`
+	Removed *[]Package       `json:"removed,omitempty"`
 }
 
-// RootkitType defines model for RootkitType.
-type RootkitType string
+// PluginFinding A finding reported by a scanner plugin.
+type PluginFinding struct {
+	Description *string `json:"description,omitempty"`
 
-// RootkitsConfig defines model for RootkitsConfig.
-type RootkitsConfig struct {
-	Enabled *bool `json:"enabled,omitempty"`
+	// FindingID The ID of the rule or check of the plugin which reported the finding
+	FindingID *string `json:"findingID,omitempty"`
+
+	// Path Path of the file the finding was found in
+	Path       *string `json:"path,omitempty"`
+	PluginName *string `json:"pluginName,omitempty"`
+
+	// Properties Plugin specific details of the finding
+	Properties  *map[string]string `json:"properties,omitempty"`
+	Remediation *string            `json:"remediation,omitempty"`
+
+	// Severity The severity as reported by the plugin, e.g. HIGH
+	Severity *string `json:"severity,omitempty"`
+	Title    *string `json:"title,omitempty"`
 }
 
-// RuntimeScheduleScanConfig Runtime schedule scan configuration. If only operationTime is set, it will be a single scan scheduled for the operationTime. If only cronLine is set, the current time will be the "from time" to start the scheduling according to the cronLine. If both operationTime and cronLine are set, the first scan will run at operationTime and the operationTime will be the first time that the cronLine will be effective from.
-type RuntimeScheduleScanConfig struct {
-	// CronLine Cron schedule expressions.
-	CronLine *string `json:"cronLine,omitempty"`
+// PluginFindingInfo defines model for PluginFindingInfo.
+type PluginFindingInfo struct {
+	Description *string `json:"description,omitempty"`
 
-	// OperationTime The next time this ScanConfig should trigger a scan.
-	OperationTime *time.Time `json:"operationTime,omitempty"`
+	// FindingID The ID of the rule or check of the plugin which reported the finding
+	FindingID  *string `json:"findingID,omitempty"`
+	ObjectType string  `json:"objectType"`
+
+	// Path Path of the file the finding was found in
+	Path       *string `json:"path,omitempty"`
+	PluginName *string `json:"pluginName,omitempty"`
+
+	// Properties Plugin specific details of the finding
+	Properties  *map[string]string `json:"properties,omitempty"`
+	Remediation *string            `json:"remediation,omitempty"`
+
+	// Severity The severity as reported by the plugin, e.g. HIGH
+	Severity *string `json:"severity,omitempty"`
+	Title    *string `json:"title,omitempty"`
 }
 
-// SBOMConfig defines model for SBOMConfig.
-type SBOMConfig struct {
-	Enabled *bool `json:"enabled,omitempty"`
+// PluginScan defines model for PluginScan.
+type PluginScan struct {
+	PluginFindings *[]PluginFinding `json:"pluginFindings"`
 }
 
-// SbomScan defines model for SbomScan.
-type SbomScan struct {
-	Packages *[]Package `json:"packages"`
+// PluginsConfig defines model for PluginsConfig.
+type PluginsConfig struct {
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Plugins The external scanners run against the target.
+	Plugins *[]ScannerPlugin `json:"plugins,omitempty"`
 }
 
-// Scan Describes a multi-target scheduled scan.
-type Scan struct {
-	EndTime  *time.Time `json:"endTime,omitempty"`
-	Id       *string    `json:"id,omitempty"`
-	Revision *int       `json:"revision,omitempty"`
+// PodInfo defines model for PodInfo.
+type PodInfo struct {
+	Location   *string `json:"location,omitempty"`
+	ObjectType string  `json:"objectType"`
+	PodName    *string `json:"podName,omitempty"`
+}
 
-	// ScanConfig Describes a relationship to a scan config which can be expanded.
-	ScanConfig *ScanConfigRelationship `json:"scanConfig,omitempty"`
+// PostureScore The security posture score of the VM assets of a team at a point in
+// time, from 0, the worst, to 100. It is lowered by the severity of the
+// active findings per asset, the share of the assets exposed by an
+// active critical or high finding and the share of the assets whose
+// last scan breaches the scan SLA.
+type PostureScore struct {
+	// Assets The number of assets of the team.
+	Assets           *int `json:"assets,omitempty"`
+	CriticalFindings *int `json:"criticalFindings,omitempty"`
 
-	// ScanConfigSnapshot Snapshot of the configuration from the ScanConfig which created the
-	// scan, so that changes in the ScanConfig do not affect the existing
-	// Scan.
-	ScanConfigSnapshot *ScanConfigSnapshot `json:"scanConfigSnapshot,omitempty"`
-	StartTime          *time.Time          `json:"startTime,omitempty"`
+	// ExposedAssets The number of assets with an active critical or high finding.
+	ExposedAssets  *int    `json:"exposedAssets,omitempty"`
+	HighFindings   *int    `json:"highFindings,omitempty"`
+	Id             *string `json:"id,omitempty"`
+	LowFindings    *int    `json:"lowFindings,omitempty"`
+	MediumFindings *int    `json:"mediumFindings,omitempty"`
+	Score          *int    `json:"score,omitempty"`
 
-	// State The lifecycle state of this scan.
-	State *ScanState `json:"state,omitempty"`
+	// SlaBreaches The number of assets which were never scanned or whose last scan is older than the SLA.
+	SlaBreaches *int `json:"slaBreaches,omitempty"`
 
-	// StateMessage Human-readable message indicating details about the last state transition.
-	StateMessage *string `json:"stateMessage,omitempty"`
+	// Team The value of the team tag of the assets, empty for the assets without it.
+	Team *string    `json:"team,omitempty"`
+	Time *time.Time `json:"time,omitempty"`
+}
 
-	// StateReason Machine-readable, UpperCamelCase text indicating the reason for the condition's last transition.
-	StateReason *ScanStateReason `json:"stateReason,omitempty"`
+// PostureScores defines model for PostureScores.
+type PostureScores struct {
+	// Count Total posture score count according to the given filters
+	Count *int `json:"count,omitempty"`
 
-	// Summary A summary of the progress of a scan for informational purposes.
-	Summary *ScanSummary `json:"summary,omitempty"`
+	// Items List of posture scores according to the given filters and page.
+	Items *[]PostureScore `json:"items,omitempty"`
+}
 
-	// TargetIDs List of target IDs that are targeted for scanning as part of this scan
-	TargetIDs *[]string `json:"targetIDs"`
+// Provider defines model for Provider.
+type Provider struct {
+	Capabilities ProviderCapabilities `json:"capabilities"`
+	Kind         CloudProvider        `json:"kind"`
 }
 
-// ScanState The lifecycle state of this scan.
-type ScanState string
+// ProviderCapabilities defines model for ProviderCapabilities.
+type ProviderCapabilities struct {
+	// CrossRegion The provider can scan instances in a different region than the scanner.
+	CrossRegion bool `json:"crossRegion"`
 
-// ScanStateReason Machine-readable, UpperCamelCase text indicating the reason for the condition's last transition.
-type ScanStateReason string
+	// EncryptedVolumes The provider can scan instances with encrypted volumes.
+	EncryptedVolumes bool `json:"encryptedVolumes"`
 
-// ScanConfig Describes a multi-target scheduled scan config.
-type ScanConfig struct {
-	// Disabled if true, the scan config is disabled and no scan should run from it
-	Disabled *bool   `json:"disabled,omitempty"`
-	Id       *string `json:"id,omitempty"`
+	// ScanStoppedInstances The provider can scan instances which are not running.
+	ScanStoppedInstances bool `json:"scanStoppedInstances"`
 
-	// MaxParallelScanners The maximum number of scanners that can run in parallel for each scan
+	// ScannerPlacement The provider honors the scanner placement of the scan configs.
+	ScannerPlacement bool `json:"scannerPlacement"`
+
+	// SpotInstances The provider can run the scanners on spot instances.
+	SpotInstances bool `json:"spotInstances"`
+}
+
+// ProviderOperation A mutation of the cloud resources performed by the provider while
+// scanning a target. Provider operations are only recorded, they can't
+// be modified.
+type ProviderOperation struct {
+	// Asset Describes a relationship to an asset which can be expanded.
+	Asset        *AssetRelationship `json:"asset,omitempty"`
+	EndTime      *time.Time         `json:"endTime,omitempty"`
+	ErrorMessage *string            `json:"errorMessage,omitempty"`
+	Id           *string            `json:"id,omitempty"`
+
+	// Location The region or location of the cloud resource.
+	Location  *string               `json:"location,omitempty"`
+	Operation ProviderOperationType `json:"operation"`
+	Provider  CloudProvider         `json:"provider"`
+
+	// RequestID The ID the cloud API assigned to the request, which identifies the
+	// operation in the audit logs of the cloud provider.
+	RequestID *string `json:"requestID,omitempty"`
+
+	// ResourceID The ID of the cloud resource the operation was performed on, if known.
+	ResourceID *string `json:"resourceID,omitempty"`
+
+	// Scan Describes an expandable relationship to Scan object
+	Scan         *ScanRelationship       `json:"scan,omitempty"`
+	ScanResultID *string                 `json:"scanResultID,omitempty"`
+	StartTime    time.Time               `json:"startTime"`
+	Status       ProviderOperationStatus `json:"status"`
+}
+
+// ProviderOperationStatus defines model for ProviderOperationStatus.
+type ProviderOperationStatus string
+
+// ProviderOperationType defines model for ProviderOperationType.
+type ProviderOperationType string
+
+// ProviderOperations defines model for ProviderOperations.
+type ProviderOperations struct {
+	// Count Total provider operation count according to the given filters
+	Count *int `json:"count,omitempty"`
+
+	// Items List of provider operations according to the given filters and page.
+	Items *[]ProviderOperation `json:"items,omitempty"`
+}
+
+// Providers defines model for Providers.
+type Providers struct {
+	Items *[]Provider `json:"items,omitempty"`
+}
+
+// QueryError An object that is returned when the OData query options are invalid.
+type QueryError struct {
+	Message *string `json:"message,omitempty"`
+
+	// Option The query option that failed, for example $filter.
+	Option *string `json:"option,omitempty"`
+
+	// Position The zero based offset of the offending segment in the option
+	// value, -1 if unknown.
+	Position *int `json:"position,omitempty"`
+
+	// Segment The part of the option value that failed to parse.
+	Segment *string `json:"segment,omitempty"`
+
+	// Suggestions Property paths that are likely to have been meant.
+	Suggestions *[]string `json:"suggestions,omitempty"`
+}
+
+// RenderedFinding A finding rendered with the finding templates.
+type RenderedFinding struct {
+	Description string `json:"description"`
+	Title       string `json:"title"`
+}
+
+// ReportDelivery The status of a delivery of a scheduled report.
+type ReportDelivery struct {
+	// Message The reason the delivery failed.
+	Message *string `json:"message,omitempty"`
+
+	// ReportKey The key of the delivered report in the object store, it is not
+	// set if no object store is configured.
+	ReportKey *string             `json:"reportKey,omitempty"`
+	State     ReportDeliveryState `json:"state"`
+	Time      time.Time           `json:"time"`
+}
+
+// ReportDeliveryState defines model for ReportDeliveryState.
+type ReportDeliveryState string
+
+// ReportSchedule Describes a report which is generated and delivered by email to the
+// recipients on a recurring schedule.
+type ReportSchedule struct {
+	// CronLine The cron expression of the delivery schedule, e.g. "0 8 * * 1" for weekly on Monday 08:00.
+	CronLine *string `json:"cronLine,omitempty"`
+
+	// Disabled If true, the report is not delivered.
+	Disabled *bool   `json:"disabled,omitempty"`
+	Id       *string `json:"id,omitempty"`
+
+	// LastDelivery The status of a delivery of a scheduled report.
+	LastDelivery *ReportDelivery `json:"lastDelivery,omitempty"`
+	Name         *string         `json:"name,omitempty"`
+
+	// NextDeliveryTime The time of the next delivery. Managed by the backend and the orchestrator.
+	NextDeliveryTime *time.Time `json:"nextDeliveryTime,omitempty"`
+
+	// Recipients The email addresses the report is delivered to.
+	Recipients *[]string   `json:"recipients,omitempty"`
+	ReportType *ReportType `json:"reportType,omitempty"`
+	Revision   *int        `json:"revision,omitempty"`
+
+	// Timezone The IANA time zone the cron expression is evaluated in, e.g.
+	// "Europe/Budapest". Defaults to UTC.
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// ReportSchedules defines model for ReportSchedules.
+type ReportSchedules struct {
+	// Count Total report schedule count according to the given filters
+	Count *int `json:"count,omitempty"`
+
+	// Items List of report schedules according to the given filters and page.
+	Items *[]ReportSchedule `json:"items,omitempty"`
+}
+
+// ReportType defines model for ReportType.
+type ReportType string
+
+// ResourceCleanupState defines model for ResourceCleanupState.
+type ResourceCleanupState string
+
+// RetentionRun The outcome of applying the retention settings.
+type RetentionRun struct {
+	DeletedFindings    *int       `json:"deletedFindings,omitempty"`
+	DeletedScanResults *int       `json:"deletedScanResults,omitempty"`
+	DeletedScans       *int       `json:"deletedScans,omitempty"`
+	EndTime            *time.Time `json:"endTime,omitempty"`
+	Error              *string    `json:"error,omitempty"`
+	StartTime          *time.Time `json:"startTime,omitempty"`
+}
+
+// RetentionSettings Retention of the scan history. The backend periodically deletes the
+// scans, scan results and findings which are not retained by the
+// policies. Nothing is deleted while the retention is disabled.
+type RetentionSettings struct {
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Findings Retention of the findings which are no longer found. Active findings
+	// are always retained.
+	Findings *FindingsRetentionPolicy `json:"findings,omitempty"`
+
+	// LastRun The outcome of applying the retention settings.
+	LastRun *RetentionRun `json:"lastRun,omitempty"`
+
+	// Scans Retention of the finished scans, which are deleted together with
+	// their scan results. Scans which are not finished yet are always
+	// retained.
+	Scans     *ScansRetentionPolicy `json:"scans,omitempty"`
+	UpdatedAt *time.Time            `json:"updatedAt,omitempty"`
+}
+
+// RootVolume defines model for RootVolume.
+type RootVolume struct {
+	Encrypted *bool `json:"encrypted,omitempty"`
+	SizeGB    *int  `json:"sizeGB,omitempty"`
+}
+
+// Rootkit defines model for Rootkit.
+type Rootkit struct {
+	Message     *string      `json:"message,omitempty"`
+	RootkitName *string      `json:"rootkitName,omitempty"`
+	RootkitType *RootkitType `json:"rootkitType,omitempty"`
+}
+
+// RootkitFindingInfo defines model for RootkitFindingInfo.
+type RootkitFindingInfo struct {
+	Message     *string      `json:"message,omitempty"`
+	ObjectType  string       `json:"objectType"`
+	RootkitName *string      `json:"rootkitName,omitempty"`
+	RootkitType *RootkitType `json:"rootkitType,omitempty"`
+}
+
+// RootkitScan defines model for RootkitScan.
+type RootkitScan struct {
+	Rootkits *[]Rootkit `json:"rootkits"`
+}
+
+// RootkitType defines model for RootkitType.
+type RootkitType string
+
+// RootkitsConfig defines model for RootkitsConfig.
+type RootkitsConfig struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// RuntimeScheduleScanConfig Runtime schedule scan configuration. If only operationTime is set, it will be a single scan scheduled for the operationTime. If only cronLine is set, the current time will be the "from time" to start the scheduling according to the cronLine. If both operationTime and cronLine are set, the first scan will run at operationTime and the operationTime will be the first time that the cronLine will be effective from.
+type RuntimeScheduleScanConfig struct {
+	// CronLine Cron schedule expressions.
+	CronLine *string `json:"cronLine,omitempty"`
+
+	// OperationTime The next time this ScanConfig should trigger a scan.
+	OperationTime *time.Time `json:"operationTime,omitempty"`
+
+	// Timezone The IANA time zone the cron expression is evaluated in, e.g.
+	// "Europe/Budapest". Defaults to UTC.
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// SBOMConfig defines model for SBOMConfig.
+type SBOMConfig struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// SBOMInfo An artifact known only through an uploaded SBOM.
+type SBOMInfo struct {
+	Location   *string `json:"location,omitempty"`
+	Name       string  `json:"name"`
+	ObjectType string  `json:"objectType"`
+	Version    *string `json:"version,omitempty"`
+}
+
+// SSHHostsScope Hosts which are reachable over SSH
+type SSHHostsScope struct {
+	Hosts      *[]string `json:"hosts"`
+	ObjectType string    `json:"objectType"`
+}
+
+// SSHScanScope The scope of a configured scan of hosts which are reachable over SSH.
+type SSHScanScope struct {
+	// Hosts The hosts to scan. If empty, all the configured hosts are scanned.
+	Hosts      *[]string `json:"hosts"`
+	ObjectType string    `json:"objectType"`
+}
+
+// SbomDiff The differences between the packages found on a asset by two scans.
+type SbomDiff struct {
+	// Added The packages found only by the head scan.
+	Added            *[]Package `json:"added,omitempty"`
+	BaseScanResultID *string    `json:"baseScanResultID,omitempty"`
+
+	// Downgraded The packages whose version found by the head scan is lower than the one found by the base scan.
+	Downgraded       *[]PackageChange `json:"downgraded,omitempty"`
+	HeadScanResultID *string          `json:"headScanResultID,omitempty"`
+
+	// Removed The packages found only by the base scan.
+	Removed *[]Package `json:"removed,omitempty"`
+
+	// Upgraded The packages whose version changed to a higher one in the head scan.
+	Upgraded *[]PackageChange `json:"upgraded,omitempty"`
+}
+
+// SbomFormat defines model for SbomFormat.
+type SbomFormat string
+
+// SbomScan defines model for SbomScan.
+type SbomScan struct {
+	Packages *[]Package `json:"packages"`
+}
+
+// Scan Describes a multi-target scheduled scan.
+type Scan struct {
+	// AssetIDs List of asset IDs that are targeted for scanning as part of this scan
+	AssetIDs *[]string `json:"assetIDs"`
+
+	// DryRun If true, the targets of the scan are discovered and the scan is
+	// planned, but no cloud resources are provisioned and nothing is
+	// scanned. The plan is recorded in the plan field. Dry run scans are
+	// not counted against the scan quota, but the quota is checked.
+	DryRun  *bool      `json:"dryRun,omitempty"`
+	EndTime *time.Time `json:"endTime,omitempty"`
+	Id      *string    `json:"id,omitempty"`
+
+	// Plan What a dry run scan would have done.
+	Plan     *ScanPlan `json:"plan,omitempty"`
+	Revision *int      `json:"revision,omitempty"`
+
+	// SamplingCoverage The coverage of the targets of a sampled scan by the rotation it is
+	// part of. Managed by the orchestrator.
+	SamplingCoverage *ScanSamplingCoverage `json:"samplingCoverage,omitempty"`
+
+	// ScanConfig Describes a relationship to a scan config which can be expanded.
+	ScanConfig *ScanConfigRelationship `json:"scanConfig,omitempty"`
+
+	// ScanConfigSnapshot Snapshot of the configuration from the ScanConfig which created the
+	// scan, so that changes in the ScanConfig do not affect the existing
+	// Scan.
+	ScanConfigSnapshot *ScanConfigSnapshot `json:"scanConfigSnapshot,omitempty"`
+	StartTime          *time.Time          `json:"startTime,omitempty"`
+
+	// State The lifecycle state of this scan.
+	State *ScanState `json:"state,omitempty"`
+
+	// StateMessage Human-readable message indicating details about the last state transition.
+	StateMessage *string `json:"stateMessage,omitempty"`
+
+	// StateReason Machine-readable, UpperCamelCase text indicating the reason for the condition's last transition.
+	StateReason *ScanStateReason `json:"stateReason,omitempty"`
+
+	// Summary A summary of the progress of a scan for informational purposes.
+	Summary *ScanSummary `json:"summary,omitempty"`
+}
+
+// ScanState The lifecycle state of this scan.
+type ScanState string
+
+// ScanStateReason Machine-readable, UpperCamelCase text indicating the reason for the condition's last transition.
+type ScanStateReason string
+
+// ScanConfig Describes a multi-target scheduled scan config.
+type ScanConfig struct {
+	// AssetGroupIDs If set, only the discovered assets which are members of any of
+	// these asset groups when the scan starts are scanned.
+	AssetGroupIDs *[]string `json:"assetGroupIDs,omitempty"`
+
+	// DeltaScanEnabled If true, repeat scans of the same target only scan the files
+	// changed since the previous successful scan for secrets and
+	// malware, where the provider can report the changed blocks of the
+	// scanned volume.
+	DeltaScanEnabled *bool `json:"deltaScanEnabled,omitempty"`
+
+	// Disabled if true, the scan config is disabled and no scan should run from it
+	Disabled *bool   `json:"disabled,omitempty"`
+	Id       *string `json:"id,omitempty"`
+
+	// MaxParallelScanners The maximum number of scanners that can run in parallel for each scan
 	MaxParallelScanners *int    `json:"maxParallelScanners,omitempty"`
 	Name                *string `json:"name,omitempty"`
-	Revision            *int    `json:"revision,omitempty"`
+
+	// OverlapPolicy Defines what happens when a scheduled run of a ScanConfig is due while
+	// a scan from the same config is still in progress.
+	// Skip: the run is skipped and recorded in the skippedRuns of the config.
+	// Queue: the run is started as soon as the in-progress scan is finished.
+	// CancelPrevious: the in-progress scan is aborted and the run is started
+	// once the abort is completed.
+	OverlapPolicy *ScanOverlapPolicy `json:"overlapPolicy,omitempty"`
+
+	// Priority The priority of the scans started from this config, the pending
+	// scans with a higher priority get scanners first. 0 if unset.
+	Priority *int `json:"priority,omitempty"`
+
+	// QueuedRun If true, a scheduled run was deferred by the overlap policy and a
+	// scan will be started as soon as the in-progress scans of this
+	// config are finished. Managed by the orchestrator.
+	QueuedRun *bool `json:"queuedRun,omitempty"`
+
+	// RetentionPolicy Defines how long the scan results of the scans started from a
+	// ScanConfig are kept in full, counted from the time they were done.
+	// The values not set are taken from the orchestrator configuration.
+	RetentionPolicy *ScanResultRetentionPolicy `json:"retentionPolicy,omitempty"`
+	Revision        *int                       `json:"revision,omitempty"`
+
+	// Sampling Scans a sample of the targets matching the scope on each run instead
+	// of all of them. The targets not scanned yet in the current rotation
+	// are sampled first, the ones not scanned for the most runs being the
+	// most likely to be sampled, so that all the targets are scanned in a
+	// rotation of 100 / percentage runs, rounded up.
+	Sampling *ScanSampling `json:"sampling,omitempty"`
+
+	// ScanConfigTemplate Describes a relationship to a scan config template which can be expanded.
+	ScanConfigTemplate *ScanConfigTemplateRelationship `json:"scanConfigTemplate,omitempty"`
 
 	// ScanFamiliesConfig The configuration of the scanner families within a scan config
 	ScanFamiliesConfig *ScanFamiliesConfig `json:"scanFamiliesConfig,omitempty"`
@@ -464,13 +2170,27 @@ type ScanConfig struct {
 	// ScannerInstanceCreationConfig Configuration of scanner instance
 	ScannerInstanceCreationConfig *ScannerInstanceCreationConfig `json:"scannerInstanceCreationConfig,omitempty"`
 
+	// ScannerPlacement Constrains where the scanner instances of a scan are created, e.g. to
+	// keep the scanners away from production networks. Only honored by the
+	// providers with the scannerPlacement capability.
+	ScannerPlacement *ScannerPlacement `json:"scannerPlacement,omitempty"`
+
 	// Scheduled Runtime schedule scan configuration. If only operationTime is set, it will be a single scan scheduled for the operationTime. If only cronLine is set, the current time will be the "from time" to start the scheduling according to the cronLine. If both operationTime and cronLine are set, the first scan will run at operationTime and the operationTime will be the first time that the cronLine will be effective from.
 	Scheduled *RuntimeScheduleScanConfig `json:"scheduled,omitempty"`
 	Scope     *ScanScopeType             `json:"scope,omitempty"`
 
+	// SkippedRuns The most recent scheduled runs which were skipped because a scan
+	// from this config was still in progress. Managed by the orchestrator.
+	SkippedRuns *[]ScanConfigSkippedRun `json:"skippedRuns,omitempty"`
+
 	// TimeoutSeconds The maximum time in seconds that a scan started from this config
 	// should run for before being automatically aborted.
 	TimeoutSeconds *int `json:"timeoutSeconds,omitempty"`
+
+	// VolumeSizeGuardrail Prevents snapshotting targets with unexpectedly large volumes. Targets
+	// whose volumes exceed the maximum size are not scanned unless they
+	// have the opt-in tag.
+	VolumeSizeGuardrail *VolumeSizeGuardrail `json:"volumeSizeGuardrail,omitempty"`
 }
 
 // ScanConfigExists defines model for ScanConfigExists.
@@ -482,8 +2202,27 @@ type ScanConfigExists struct {
 	ScanConfig *ScanConfig `json:"scanConfig,omitempty"`
 }
 
+// ScanConfigNextRuns defines model for ScanConfigNextRuns.
+type ScanConfigNextRuns struct {
+	// NextRuns The next run times of the scan config in ascending order. It has
+	// fewer items than requested if the scan config is not scheduled to
+	// run that many times, and is empty if it is disabled.
+	NextRuns []time.Time `json:"nextRuns"`
+
+	// Timezone The time zone the run times are calculated in.
+	Timezone string `json:"timezone"`
+}
+
 // ScanConfigRelationship Describes a relationship to a scan config which can be expanded.
 type ScanConfigRelationship struct {
+	AssetGroupIDs *[]string `json:"assetGroupIDs,omitempty"`
+
+	// DeltaScanEnabled If true, repeat scans of the same target only scan the files
+	// changed since the previous successful scan for secrets and
+	// malware, where the provider can report the changed blocks of the
+	// scanned volume.
+	DeltaScanEnabled *bool `json:"deltaScanEnabled,omitempty"`
+
 	// Disabled if true, the scan config is disabled and no scan should run from it
 	Disabled *bool  `json:"disabled,omitempty"`
 	Id       string `json:"id"`
@@ -491,7 +2230,34 @@ type ScanConfigRelationship struct {
 	// MaxParallelScanners The maximum number of scanners that can run in parallel for each scan
 	MaxParallelScanners *int    `json:"maxParallelScanners,omitempty"`
 	Name                *string `json:"name,omitempty"`
-	Revision            *int    `json:"revision,omitempty"`
+
+	// OverlapPolicy Defines what happens when a scheduled run of a ScanConfig is due while
+	// a scan from the same config is still in progress.
+	// Skip: the run is skipped and recorded in the skippedRuns of the config.
+	// Queue: the run is started as soon as the in-progress scan is finished.
+	// CancelPrevious: the in-progress scan is aborted and the run is started
+	// once the abort is completed.
+	OverlapPolicy *ScanOverlapPolicy `json:"overlapPolicy,omitempty"`
+
+	// Priority The priority of the scans started from this config, the pending
+	// scans with a higher priority get scanners first. 0 if unset.
+	Priority *int `json:"priority,omitempty"`
+
+	// QueuedRun If true, a scheduled run was deferred by the overlap policy and a
+	// scan will be started as soon as the in-progress scans of this
+	// config are finished. Managed by the orchestrator.
+	QueuedRun *bool `json:"queuedRun,omitempty"`
+	Revision  *int  `json:"revision,omitempty"`
+
+	// Sampling Scans a sample of the targets matching the scope on each run instead
+	// of all of them. The targets not scanned yet in the current rotation
+	// are sampled first, the ones not scanned for the most runs being the
+	// most likely to be sampled, so that all the targets are scanned in a
+	// rotation of 100 / percentage runs, rounded up.
+	Sampling *ScanSampling `json:"sampling,omitempty"`
+
+	// ScanConfigTemplate Describes a relationship to a scan config template which can be expanded.
+	ScanConfigTemplate *ScanConfigTemplateRelationship `json:"scanConfigTemplate,omitempty"`
 
 	// ScanFamiliesConfig The configuration of the scanner families within a scan config
 	ScanFamiliesConfig *ScanFamiliesConfig `json:"scanFamiliesConfig,omitempty"`
@@ -499,19 +2265,53 @@ type ScanConfigRelationship struct {
 	// ScannerInstanceCreationConfig Configuration of scanner instance
 	ScannerInstanceCreationConfig *ScannerInstanceCreationConfig `json:"scannerInstanceCreationConfig,omitempty"`
 
+	// ScannerPlacement Constrains where the scanner instances of a scan are created, e.g. to
+	// keep the scanners away from production networks. Only honored by the
+	// providers with the scannerPlacement capability.
+	ScannerPlacement *ScannerPlacement `json:"scannerPlacement,omitempty"`
+
 	// Scheduled Runtime schedule scan configuration. If only operationTime is set, it will be a single scan scheduled for the operationTime. If only cronLine is set, the current time will be the "from time" to start the scheduling according to the cronLine. If both operationTime and cronLine are set, the first scan will run at operationTime and the operationTime will be the first time that the cronLine will be effective from.
 	Scheduled *RuntimeScheduleScanConfig `json:"scheduled,omitempty"`
 	Scope     *ScanScopeType             `json:"scope,omitempty"`
 
+	// SkippedRuns The most recent scheduled runs which were skipped because a scan
+	// from this config was still in progress. Managed by the orchestrator.
+	SkippedRuns *[]ScanConfigSkippedRun `json:"skippedRuns,omitempty"`
+
 	// TimeoutSeconds The maximum time in seconds that a scan started from this config
 	// should run for before being automatically aborted.
 	TimeoutSeconds *int `json:"timeoutSeconds,omitempty"`
+
+	// VolumeSizeGuardrail Prevents snapshotting targets with unexpectedly large volumes. Targets
+	// whose volumes exceed the maximum size are not scanned unless they
+	// have the opt-in tag.
+	VolumeSizeGuardrail *VolumeSizeGuardrail `json:"volumeSizeGuardrail,omitempty"`
+}
+
+// ScanConfigSkippedRun A scheduled run of a ScanConfig which was skipped.
+type ScanConfigSkippedRun struct {
+	// InProgressScanIDs The scans from the ScanConfig which were in progress.
+	InProgressScanIDs *[]string `json:"inProgressScanIDs,omitempty"`
+
+	// OperationTime The time the skipped run was scheduled for.
+	OperationTime time.Time `json:"operationTime"`
+
+	// Reason Human-readable reason why the run was skipped.
+	Reason *string `json:"reason,omitempty"`
 }
 
 // ScanConfigSnapshot Snapshot of the configuration from the ScanConfig which created the
 // scan, so that changes in the ScanConfig do not affect the existing
 // Scan.
 type ScanConfigSnapshot struct {
+	AssetGroupIDs *[]string `json:"assetGroupIDs,omitempty"`
+
+	// DeltaScanEnabled If true, repeat scans of the same target only scan the files
+	// changed since the previous successful scan for secrets and
+	// malware, where the provider can report the changed blocks of the
+	// scanned volume.
+	DeltaScanEnabled *bool `json:"deltaScanEnabled,omitempty"`
+
 	// Disabled if true, the scan config is disabled and no scan should run from it
 	Disabled *bool `json:"disabled,omitempty"`
 
@@ -519,12 +2319,39 @@ type ScanConfigSnapshot struct {
 	MaxParallelScanners *int    `json:"maxParallelScanners,omitempty"`
 	Name                *string `json:"name,omitempty"`
 
+	// OverlapPolicy Defines what happens when a scheduled run of a ScanConfig is due while
+	// a scan from the same config is still in progress.
+	// Skip: the run is skipped and recorded in the skippedRuns of the config.
+	// Queue: the run is started as soon as the in-progress scan is finished.
+	// CancelPrevious: the in-progress scan is aborted and the run is started
+	// once the abort is completed.
+	OverlapPolicy *ScanOverlapPolicy `json:"overlapPolicy,omitempty"`
+
+	// Priority The priority of the scans started from this config, the pending
+	// scans with a higher priority get scanners first. 0 if unset.
+	Priority *int `json:"priority,omitempty"`
+
+	// Sampling Scans a sample of the targets matching the scope on each run instead
+	// of all of them. The targets not scanned yet in the current rotation
+	// are sampled first, the ones not scanned for the most runs being the
+	// most likely to be sampled, so that all the targets are scanned in a
+	// rotation of 100 / percentage runs, rounded up.
+	Sampling *ScanSampling `json:"sampling,omitempty"`
+
+	// ScanConfigTemplate Describes a relationship to a scan config template which can be expanded.
+	ScanConfigTemplate *ScanConfigTemplateRelationship `json:"scanConfigTemplate,omitempty"`
+
 	// ScanFamiliesConfig The configuration of the scanner families within a scan config
 	ScanFamiliesConfig *ScanFamiliesConfig `json:"scanFamiliesConfig,omitempty"`
 
 	// ScannerInstanceCreationConfig Configuration of scanner instance
 	ScannerInstanceCreationConfig *ScannerInstanceCreationConfig `json:"scannerInstanceCreationConfig,omitempty"`
 
+	// ScannerPlacement Constrains where the scanner instances of a scan are created, e.g. to
+	// keep the scanners away from production networks. Only honored by the
+	// providers with the scannerPlacement capability.
+	ScannerPlacement *ScannerPlacement `json:"scannerPlacement,omitempty"`
+
 	// Scheduled Runtime schedule scan configuration. If only operationTime is set, it will be a single scan scheduled for the operationTime. If only cronLine is set, the current time will be the "from time" to start the scheduling according to the cronLine. If both operationTime and cronLine are set, the first scan will run at operationTime and the operationTime will be the first time that the cronLine will be effective from.
 	Scheduled *RuntimeScheduleScanConfig `json:"scheduled,omitempty"`
 	Scope     *ScanScopeType             `json:"scope,omitempty"`
@@ -532,6 +2359,98 @@ type ScanConfigSnapshot struct {
 	// TimeoutSeconds The maximum time in seconds that a scan started from this config
 	// should run for before being automatically aborted.
 	TimeoutSeconds *int `json:"timeoutSeconds,omitempty"`
+
+	// VolumeSizeGuardrail Prevents snapshotting targets with unexpectedly large volumes. Targets
+	// whose volumes exceed the maximum size are not scanned unless they
+	// have the opt-in tag.
+	VolumeSizeGuardrail *VolumeSizeGuardrail `json:"volumeSizeGuardrail,omitempty"`
+}
+
+// ScanConfigTemplate A base scan configuration, e.g. a compliance baseline, which scan
+// configs reference. The settings a scan config sets itself override the
+// ones of its template, a family configured by the scan config overrides
+// the same family of the template.
+type ScanConfigTemplate struct {
+	// DeltaScanEnabled If true, repeat scans of the same target only scan the files
+	// changed since the previous successful scan for secrets and
+	// malware, where the provider can report the changed blocks of the
+	// scanned volume.
+	DeltaScanEnabled *bool   `json:"deltaScanEnabled,omitempty"`
+	Description      *string `json:"description,omitempty"`
+	Id               *string `json:"id,omitempty"`
+
+	// MaxParallelScanners The maximum number of scanners that can run in parallel for each scan
+	MaxParallelScanners *int    `json:"maxParallelScanners,omitempty"`
+	Name                *string `json:"name,omitempty"`
+	Revision            *int    `json:"revision,omitempty"`
+
+	// ScanFamiliesConfig The configuration of the scanner families within a scan config
+	ScanFamiliesConfig *ScanFamiliesConfig `json:"scanFamiliesConfig,omitempty"`
+
+	// ScannerInstanceCreationConfig Configuration of scanner instance
+	ScannerInstanceCreationConfig *ScannerInstanceCreationConfig `json:"scannerInstanceCreationConfig,omitempty"`
+
+	// ScannerPlacement Constrains where the scanner instances of a scan are created, e.g. to
+	// keep the scanners away from production networks. Only honored by the
+	// providers with the scannerPlacement capability.
+	ScannerPlacement *ScannerPlacement `json:"scannerPlacement,omitempty"`
+
+	// TimeoutSeconds The maximum time in seconds that a scan started from this template
+	// should run for before being automatically aborted.
+	TimeoutSeconds *int `json:"timeoutSeconds,omitempty"`
+
+	// VolumeSizeGuardrail Prevents snapshotting targets with unexpectedly large volumes. Targets
+	// whose volumes exceed the maximum size are not scanned unless they
+	// have the opt-in tag.
+	VolumeSizeGuardrail *VolumeSizeGuardrail `json:"volumeSizeGuardrail,omitempty"`
+}
+
+// ScanConfigTemplateExists defines model for ScanConfigTemplateExists.
+type ScanConfigTemplateExists struct {
+	// Message Describes which unique constraint combination causes the conflict.
+	Message *string `json:"message,omitempty"`
+
+	// ScanConfigTemplate A base scan configuration, e.g. a compliance baseline, which scan
+	// configs reference. The settings a scan config sets itself override the
+	// ones of its template, a family configured by the scan config overrides
+	// the same family of the template.
+	ScanConfigTemplate *ScanConfigTemplate `json:"scanConfigTemplate,omitempty"`
+}
+
+// ScanConfigTemplateRelationship Describes a relationship to a scan config template which can be expanded.
+type ScanConfigTemplateRelationship struct {
+	DeltaScanEnabled    *bool   `json:"deltaScanEnabled,omitempty"`
+	Description         *string `json:"description,omitempty"`
+	Id                  string  `json:"id"`
+	MaxParallelScanners *int    `json:"maxParallelScanners,omitempty"`
+	Name                *string `json:"name,omitempty"`
+	Revision            *int    `json:"revision,omitempty"`
+
+	// ScanFamiliesConfig The configuration of the scanner families within a scan config
+	ScanFamiliesConfig *ScanFamiliesConfig `json:"scanFamiliesConfig,omitempty"`
+
+	// ScannerInstanceCreationConfig Configuration of scanner instance
+	ScannerInstanceCreationConfig *ScannerInstanceCreationConfig `json:"scannerInstanceCreationConfig,omitempty"`
+
+	// ScannerPlacement Constrains where the scanner instances of a scan are created, e.g. to
+	// keep the scanners away from production networks. Only honored by the
+	// providers with the scannerPlacement capability.
+	ScannerPlacement *ScannerPlacement `json:"scannerPlacement,omitempty"`
+	TimeoutSeconds   *int              `json:"timeoutSeconds,omitempty"`
+
+	// VolumeSizeGuardrail Prevents snapshotting targets with unexpectedly large volumes. Targets
+	// whose volumes exceed the maximum size are not scanned unless they
+	// have the opt-in tag.
+	VolumeSizeGuardrail *VolumeSizeGuardrail `json:"volumeSizeGuardrail,omitempty"`
+}
+
+// ScanConfigTemplates defines model for ScanConfigTemplates.
+type ScanConfigTemplates struct {
+	// Count Total scan config template count according to the given filters
+	Count *int `json:"count,omitempty"`
+
+	// Items List of scan config templates according to the given filters and page.
+	Items *[]ScanConfigTemplate `json:"items,omitempty"`
 }
 
 // ScanConfigs defines model for ScanConfigs.
@@ -554,30 +2473,113 @@ type ScanExists struct {
 
 // ScanFamiliesConfig The configuration of the scanner families within a scan config
 type ScanFamiliesConfig struct {
+	Certificates *CertificatesConfig `json:"certificates,omitempty"`
+	Compliance   *ComplianceConfig   `json:"compliance,omitempty"`
+
+	// ExcludedPaths Paths, relative to the root of the scanned filesystem, which are excluded by the families walking the filesystem in addition to the built-in exclusions of scanner scratch directories and cloud agent caches.
+	ExcludedPaths     *[]string                `json:"excludedPaths,omitempty"`
 	Exploits          *ExploitsConfig          `json:"exploits,omitempty"`
 	Malware           *MalwareConfig           `json:"malware,omitempty"`
 	Misconfigurations *MisconfigurationsConfig `json:"misconfigurations,omitempty"`
+	Plugins           *PluginsConfig           `json:"plugins,omitempty"`
 	Rootkits          *RootkitsConfig          `json:"rootkits,omitempty"`
 	Sbom              *SBOMConfig              `json:"sbom,omitempty"`
 	Secrets           *SecretsConfig           `json:"secrets,omitempty"`
 	Vulnerabilities   *VulnerabilitiesConfig   `json:"vulnerabilities,omitempty"`
 }
 
+// ScanFamily defines model for ScanFamily.
+type ScanFamily string
+
+// ScanFamilyProgress The progress of a family of a scan across its assets.
+type ScanFamilyProgress struct {
+	// AssetsDone The number of assets whose scan of the family is done.
+	AssetsDone int `json:"assetsDone"`
+
+	// AssetsStarted The number of assets whose scan of the family started.
+	AssetsStarted int `json:"assetsStarted"`
+
+	// AssetsTotal The number of assets the family is scanned on.
+	AssetsTotal int `json:"assetsTotal"`
+
+	// Errors The number of errors reported by the scans of the family.
+	Errors int        `json:"errors"`
+	Family ScanFamily `json:"family"`
+
+	// Percent The percentage of the scans of the family which is done.
+	Percent int `json:"percent"`
+}
+
 // ScanFindingsSummary A summary of the scan findings.
 type ScanFindingsSummary struct {
+	TotalCertificates *int `json:"totalCertificates,omitempty"`
+
+	// TotalComplianceChecks The number of failed compliance benchmark checks.
+	TotalComplianceChecks  *int `json:"totalComplianceChecks,omitempty"`
 	TotalExploits          *int `json:"totalExploits,omitempty"`
 	TotalMalware           *int `json:"totalMalware,omitempty"`
 	TotalMisconfigurations *int `json:"totalMisconfigurations,omitempty"`
 	TotalPackages          *int `json:"totalPackages,omitempty"`
-	TotalRootkits          *int `json:"totalRootkits,omitempty"`
-	TotalSecrets           *int `json:"totalSecrets,omitempty"`
+
+	// TotalPluginFindings The number of findings reported by the scanner plugins.
+	TotalPluginFindings *int `json:"totalPluginFindings,omitempty"`
+	TotalRootkits       *int `json:"totalRootkits,omitempty"`
+	TotalSecrets        *int `json:"totalSecrets,omitempty"`
 
 	// TotalVulnerabilities A summary of number of vulnerabilities found per severity.
 	TotalVulnerabilities *VulnerabilityScanSummary `json:"totalVulnerabilities,omitempty"`
 }
 
+// ScanOverlapPolicy Defines what happens when a scheduled run of a ScanConfig is due while
+// a scan from the same config is still in progress.
+// Skip: the run is skipped and recorded in the skippedRuns of the config.
+// Queue: the run is started as soon as the in-progress scan is finished.
+// CancelPrevious: the in-progress scan is aborted and the run is started
+// once the abort is completed.
+type ScanOverlapPolicy string
+
+// ScanPlan What a dry run scan would have done.
+type ScanPlan struct {
+	Assets *[]ScanPlanAsset `json:"assets,omitempty"`
+
+	// EstimatedSnapshots The number of volume snapshots the scan would have created.
+	EstimatedSnapshots *int `json:"estimatedSnapshots,omitempty"`
+
+	// QuotaExceededReason Why the scan would have been rejected by the quota limits, not set if it is within them.
+	QuotaExceededReason *string `json:"quotaExceededReason,omitempty"`
+
+	// Regions The distinct regions of the VM targets.
+	Regions *[]string `json:"regions,omitempty"`
+}
+
+// ScanPlanAsset An asset a dry run scan would have scanned.
+type ScanPlanAsset struct {
+	AssetID string `json:"assetID"`
+
+	// AssetType The objectType of the asset info, e.g. VMInfo.
+	AssetType          string  `json:"assetType"`
+	EstimatedSnapshots *int    `json:"estimatedSnapshots,omitempty"`
+	Location           *string `json:"location,omitempty"`
+}
+
+// ScanProgress The progress of a scan, aggregated from the states of the families of
+// its asset scan results.
+type ScanProgress struct {
+	// AssetsDone The number of assets whose scan is done.
+	AssetsDone int `json:"assetsDone"`
+
+	// AssetsTotal The number of assets of the scan.
+	AssetsTotal int                  `json:"assetsTotal"`
+	Families    []ScanFamilyProgress `json:"families"`
+
+	// Percent The percentage of the scan which is done.
+	Percent int `json:"percent"`
+}
+
 // ScanRelationship Describes an expandable relationship to Scan object
 type ScanRelationship struct {
+	// AssetIDs List of asset IDs that are targeted for scanning as part of this scan
+	AssetIDs *[]string  `json:"assetIDs"`
 	EndTime  *time.Time `json:"endTime,omitempty"`
 	Id       string     `json:"id"`
 	Revision *int       `json:"revision,omitempty"`
@@ -591,7 +2593,7 @@ type ScanRelationship struct {
 	ScanConfigSnapshot *ScanConfigSnapshot `json:"scanConfigSnapshot,omitempty"`
 	StartTime          *time.Time          `json:"startTime,omitempty"`
 
-	// State The lifecycle state of this scan.
+	// State The lifecycle state of this scan. A scan which is not finished yet is cancelled by updating its state to Aborted, which stops launching scanners, removes the running ones and aborts the incomplete scan results.
 	State *ScanRelationshipState `json:"state,omitempty"`
 
 	// StateMessage Human-readable message indicating details about the last state transition.
@@ -602,17 +2604,118 @@ type ScanRelationship struct {
 
 	// Summary A summary of the progress of a scan for informational purposes.
 	Summary *ScanSummary `json:"summary,omitempty"`
-
-	// TargetIDs List of target IDs that are targeted for scanning as part of this scan
-	TargetIDs *[]string `json:"targetIDs"`
 }
 
-// ScanRelationshipState The lifecycle state of this scan.
+// ScanRelationshipState The lifecycle state of this scan. A scan which is not finished yet is cancelled by updating its state to Aborted, which stops launching scanners, removes the running ones and aborts the incomplete scan results.
 type ScanRelationshipState string
 
 // ScanRelationshipStateReason Machine-readable, UpperCamelCase text indicating the reason for the condition's last transition.
 type ScanRelationshipStateReason string
 
+// ScanResultArtifact Refers to the raw output of a scan family stored in the artifact
+// store of the backend, it is downloaded from the artifact endpoint of
+// the family of the scan result.
+type ScanResultArtifact struct {
+	// ContentType The media type of the artifact.
+	ContentType string     `json:"contentType"`
+	Family      ScanFamily `json:"family"`
+
+	// Key The key of the artifact in the artifact store.
+	Key string `json:"key"`
+
+	// Size The size of the artifact in bytes.
+	Size       int64     `json:"size"`
+	UploadTime time.Time `json:"uploadTime"`
+}
+
+// ScanResultDiff The differences between the scan results of two scans of a asset.
+type ScanResultDiff struct {
+	BaseScanResultID    *string              `json:"baseScanResultID,omitempty"`
+	CompareScanResultID *string              `json:"compareScanResultID,omitempty"`
+	Packages            *PackagesDiff        `json:"packages,omitempty"`
+	Secrets             *SecretsDiff         `json:"secrets,omitempty"`
+	Vulnerabilities     *VulnerabilitiesDiff `json:"vulnerabilities,omitempty"`
+}
+
+// ScanResultItems A page of the items of the result list of a scan family.
+type ScanResultItems struct {
+	// Count Number of items of the list.
+	Count *int `json:"count,omitempty"`
+
+	// Items The items, e.g. Package for the sbom scan family.
+	Items *[]map[string]interface{} `json:"items,omitempty"`
+
+	// Offset Index of the first item of the page in the list.
+	Offset int `json:"offset"`
+}
+
+// ScanResultRetention The retention tier of a scan result. Managed by the orchestrator.
+type ScanResultRetention struct {
+	// ArchiveKey Key of the archived summary in the archive storage.
+	ArchiveKey *string `json:"archiveKey,omitempty"`
+
+	// Tier Full: the scan result is kept as it was reported by the scanner.
+	// SummaryOnly: the raw results of the scan families were dropped.
+	// Archived: the summary was moved to the archive storage.
+	Tier           ScanResultRetentionTier `json:"tier"`
+	TransitionTime *time.Time              `json:"transitionTime,omitempty"`
+}
+
+// ScanResultRetentionPolicy Defines how long the scan results of the scans started from a
+// ScanConfig are kept in full, counted from the time they were done.
+// The values not set are taken from the orchestrator configuration.
+type ScanResultRetentionPolicy struct {
+	// ArchiveAfterDays Days after which the summary is moved to the archive storage,
+	// keeping only the status of the scan result. Zero disables it.
+	ArchiveAfterDays *int `json:"archiveAfterDays,omitempty"`
+
+	// SummaryOnlyAfterDays Days after which the raw results of the scan families are dropped,
+	// keeping only the summary and the findings. Zero disables it.
+	SummaryOnlyAfterDays *int `json:"summaryOnlyAfterDays,omitempty"`
+}
+
+// ScanResultRetentionTier Full: the scan result is kept as it was reported by the scanner.
+// SummaryOnly: the raw results of the scan families were dropped.
+// Archived: the summary was moved to the archive storage.
+type ScanResultRetentionTier string
+
+// ScanResultTruncation Records that the result list of a scan family was truncated.
+type ScanResultTruncation struct {
+	// Count The number of findings of the family reported by the scanners.
+	Count  int        `json:"count"`
+	Family ScanFamily `json:"family"`
+
+	// Limit The maximum number of findings of the family which are kept.
+	Limit int `json:"limit"`
+}
+
+// ScanSampling Scans a sample of the targets matching the scope on each run instead
+// of all of them. The targets not scanned yet in the current rotation
+// are sampled first, the ones not scanned for the most runs being the
+// most likely to be sampled, so that all the targets are scanned in a
+// rotation of 100 / percentage runs, rounded up.
+type ScanSampling struct {
+	// Percentage The percentage of the targets matching the scope which are scanned on each run.
+	Percentage int `json:"percentage"`
+}
+
+// ScanSamplingCoverage The coverage of the targets of a sampled scan by the rotation it is
+// part of. Managed by the orchestrator.
+type ScanSamplingCoverage struct {
+	// AssetsCovered The number of targets matching the scope which are scanned by
+	// the scan or by the previous scans of the rotation.
+	AssetsCovered int `json:"assetsCovered"`
+
+	// AssetsMatched The number of targets matching the scope of the scan.
+	AssetsMatched int `json:"assetsMatched"`
+
+	// AssetsSampled The number of targets sampled to be scanned by the scan.
+	AssetsSampled int `json:"assetsSampled"`
+
+	// RotationRun The number of the scan in its rotation, starting from 1.
+	RotationRun int `json:"rotationRun"`
+}
+
 // ScanScopeType defines model for ScanScopeType.
 type ScanScopeType struct {
 	union json.RawMessage
@@ -620,14 +2723,21 @@ type ScanScopeType struct {
 
 // ScanSummary defines model for ScanSummary.
 type ScanSummary struct {
-	JobsCompleted          *int `json:"jobsCompleted,omitempty"`
-	JobsLeftToRun          *int `json:"jobsLeftToRun,omitempty"`
+	JobsCompleted     *int `json:"jobsCompleted,omitempty"`
+	JobsLeftToRun     *int `json:"jobsLeftToRun,omitempty"`
+	TotalCertificates *int `json:"totalCertificates,omitempty"`
+
+	// TotalComplianceChecks The number of failed compliance benchmark checks.
+	TotalComplianceChecks  *int `json:"totalComplianceChecks,omitempty"`
 	TotalExploits          *int `json:"totalExploits,omitempty"`
 	TotalMalware           *int `json:"totalMalware,omitempty"`
 	TotalMisconfigurations *int `json:"totalMisconfigurations,omitempty"`
 	TotalPackages          *int `json:"totalPackages,omitempty"`
-	TotalRootkits          *int `json:"totalRootkits,omitempty"`
-	TotalSecrets           *int `json:"totalSecrets,omitempty"`
+
+	// TotalPluginFindings The number of findings reported by the scanner plugins.
+	TotalPluginFindings *int `json:"totalPluginFindings,omitempty"`
+	TotalRootkits       *int `json:"totalRootkits,omitempty"`
+	TotalSecrets        *int `json:"totalSecrets,omitempty"`
 
 	// TotalVulnerabilities A summary of number of vulnerabilities found per severity.
 	TotalVulnerabilities *VulnerabilityScanSummary `json:"totalVulnerabilities,omitempty"`
@@ -636,6 +2746,37 @@ type ScanSummary struct {
 // ScanType defines model for ScanType.
 type ScanType string
 
+// ScannerAttribution Describes a scanner which reported a finding.
+type ScannerAttribution struct {
+	// Confidence How likely the finding is a true positive, derived from the confidence
+	// reported by the scanner, e.g. the entropy of a secret or whether
+	// malware was detected by a signature or a heuristic. Not set if the
+	// scanner doesn't report one.
+	Confidence  *FindingConfidence `json:"confidence,omitempty"`
+	ScannerName *string            `json:"scannerName,omitempty"`
+
+	// Severity The severity reported by this scanner, if the scanner reports one.
+	Severity *string `json:"severity,omitempty"`
+}
+
+// ScannerConfig The configuration the scanner of a scan result runs with.
+type ScannerConfig struct {
+	// Config The families configuration of the scanner in YAML.
+	Config string `json:"config"`
+
+	// Token The token the scanner of the scan result fetches the configuration
+	// with, only returned when the configuration is set. A new token is
+	// returned every time, the previous ones stay valid until they
+	// expire.
+	Token          *string    `json:"token,omitempty"`
+	TokenExpiresAt *time.Time `json:"tokenExpiresAt,omitempty"`
+	UpdatedAt      *time.Time `json:"updatedAt,omitempty"`
+}
+
+// ScannerImageChannel The release channel the scanner instance image is resolved from.
+// The candidate channel includes pre-release images.
+type ScannerImageChannel string
+
 // ScannerInstanceCreationConfig Configuration of scanner instance
 type ScannerInstanceCreationConfig struct {
 	MaxPrice         *string `json:"maxPrice,omitempty"`
@@ -643,12 +2784,68 @@ type ScannerInstanceCreationConfig struct {
 	UseSpotInstances bool    `json:"useSpotInstances"`
 }
 
+// ScannerInstanceImage The image the scanner instance was created from, as resolved by the
+// provider when the scan result was scheduled.
+type ScannerInstanceImage struct {
+	// Channel The release channel the scanner instance image is resolved from.
+	// The candidate channel includes pre-release images.
+	Channel *ScannerImageChannel `json:"channel,omitempty"`
+
+	// Reference The provider specific reference of the image, e.g. the AMI ID.
+	Reference string `json:"reference"`
+
+	// Version The scanner version of the image if it was resolved automatically.
+	Version *string `json:"version,omitempty"`
+}
+
 // ScannerMetadata defines model for ScannerMetadata.
 type ScannerMetadata struct {
 	ScannerName    *string         `json:"scannerName,omitempty"`
 	ScannerSummary *ScannerSummary `json:"scannerSummary,omitempty"`
 }
 
+// ScannerPlacement Constrains where the scanner instances of a scan are created, e.g. to
+// keep the scanners away from production networks. Only honored by the
+// providers with the scannerPlacement capability.
+type ScannerPlacement struct {
+	// AvoidTargetSubnet If true, a scanner is never created in the subnet of the target it
+	// scans. The scan of a target fails if every scanner subnet is the
+	// subnet of the target.
+	AvoidTargetSubnet *bool `json:"avoidTargetSubnet,omitempty"`
+
+	// Aws The dedicated network of the scanners on AWS, overriding the subnet
+	// and security group of the provider configuration.
+	Aws *AwsScannerPlacement `json:"aws,omitempty"`
+
+	// Azure The dedicated resource group and network of the scanners on Azure,
+	// overriding the ones of the provider configuration.
+	Azure *AzureScannerPlacement `json:"azure,omitempty"`
+
+	// SpreadAcrossZones If true, the scanners are spread across the availability zones of
+	// the scanner location instead of being created in the same zone.
+	SpreadAcrossZones *bool `json:"spreadAcrossZones,omitempty"`
+}
+
+// ScannerPlugin An external scanner binary available on the scanner instance. It is
+// executed for each scanned input with a PluginRequest as JSON on its
+// standard input, and must write a PluginResponse as JSON to its
+// standard output.
+type ScannerPlugin struct {
+	Args *[]string `json:"args,omitempty"`
+
+	// Command Path of the executable of the plugin on the scanner instance.
+	Command string `json:"command"`
+
+	// Config Plugin specific configuration passed in the PluginRequest.
+	Config *map[string]interface{} `json:"config,omitempty"`
+
+	// Name The unique name of the plugin, reported with its findings.
+	Name string `json:"name"`
+
+	// TimeoutSeconds The time the plugin can run for each input, 10 minutes if unset.
+	TimeoutSeconds *int `json:"timeoutSeconds,omitempty"`
+}
+
 // ScannerSummary defines model for ScannerSummary.
 type ScannerSummary struct {
 	DataRead           *string `json:"DataRead,omitempty"`
@@ -662,6 +2859,33 @@ type ScannerSummary struct {
 	TimeTaken          *string `json:"TimeTaken,omitempty"`
 }
 
+// ScannerSuppression The scanner level suppression of a finding, only the field of the
+// finding's type is set.
+type ScannerSuppression struct {
+	Misconfiguration *MisconfigurationSkipTest `json:"misconfiguration,omitempty"`
+	ScanConfigID     *string                   `json:"scanConfigID,omitempty"`
+
+	// Secret Allows the secret with the credential fingerprint, in the file if it
+	// is set or in any file otherwise.
+	Secret *SecretAllowlistEntry `json:"secret,omitempty"`
+
+	// Vulnerability Ignores the vulnerability like a grype ignore rule, in the package with
+	// the name and version if they are set or in any package otherwise.
+	Vulnerability *VulnerabilityIgnoreRule `json:"vulnerability,omitempty"`
+}
+
+// ScannerSuppressionRequest defines model for ScannerSuppressionRequest.
+type ScannerSuppressionRequest struct {
+	// DryRun If true, the suppression is returned without adding it to the scan config.
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// Reason Why the finding is a false positive.
+	Reason *string `json:"reason,omitempty"`
+
+	// ScanConfigID The scan config the suppression is added to.
+	ScanConfigID string `json:"scanConfigID"`
+}
+
 // Scans defines model for Scans.
 type Scans struct {
 	// Count Total scans count according to the given filters
@@ -671,6 +2895,17 @@ type Scans struct {
 	Items *[]Scan `json:"items,omitempty"`
 }
 
+// ScansRetentionPolicy Retention of the finished scans, which are deleted together with
+// their scan results. Scans which are not finished yet are always
+// retained.
+type ScansRetentionPolicy struct {
+	// MaxAgeDays Days the scans are retained after they ended, zero or unset retains them regardless of their age.
+	MaxAgeDays *int `json:"maxAgeDays,omitempty"`
+
+	// MaxScansPerScanConfig Number of the most recent scans of each scan config which are retained, zero or unset retains all of them.
+	MaxScansPerScanConfig *int `json:"maxScansPerScanConfig,omitempty"`
+}
+
 // ScopeType defines model for ScopeType.
 type ScopeType struct {
 	union json.RawMessage
@@ -683,9 +2918,19 @@ type Scopes struct {
 
 // Secret defines model for Secret.
 type Secret struct {
-	Description *string `json:"description,omitempty"`
-	EndColumn   *int    `json:"endColumn,omitempty"`
-	EndLine     *int    `json:"endLine,omitempty"`
+	// Confidence How likely the finding is a true positive, derived from the confidence
+	// reported by the scanner, e.g. the entropy of a secret or whether
+	// malware was detected by a signature or a heuristic. Not set if the
+	// scanner doesn't report one.
+	Confidence *FindingConfidence `json:"confidence,omitempty"`
+
+	// CredentialFingerprint Hash of the matched secret value and the rule which detected it.
+	// It is the same for every occurrence of the same credential and
+	// does not expose the secret itself.
+	CredentialFingerprint *string `json:"credentialFingerprint,omitempty"`
+	Description           *string `json:"description,omitempty"`
+	EndColumn             *int    `json:"endColumn,omitempty"`
+	EndLine               *int    `json:"endLine,omitempty"`
 
 	// FilePath Name of the file containing the secret
 	FilePath *string `json:"filePath,omitempty"`
@@ -696,11 +2941,40 @@ type Secret struct {
 	StartLine   *int    `json:"startLine,omitempty"`
 }
 
+// SecretAllowlistEntry Allows the secret with the credential fingerprint, in the file if it
+// is set or in any file otherwise.
+type SecretAllowlistEntry struct {
+	// Audit Records the false positive finding a scanner suppression was generated from.
+	Audit *SuppressionAudit `json:"audit,omitempty"`
+
+	// CredentialFingerprint The credentialFingerprint of the secret finding.
+	CredentialFingerprint string `json:"credentialFingerprint"`
+
+	// FilePath Path of the file, relative to the root of the scanned filesystem.
+	FilePath *string `json:"filePath,omitempty"`
+}
+
+// SecretChange defines model for SecretChange.
+type SecretChange struct {
+	Base    *Secret `json:"base,omitempty"`
+	Compare *Secret `json:"compare,omitempty"`
+}
+
 // SecretFindingInfo defines model for SecretFindingInfo.
 type SecretFindingInfo struct {
-	Description *string `json:"description,omitempty"`
-	EndColumn   *int    `json:"endColumn,omitempty"`
-	EndLine     *int    `json:"endLine,omitempty"`
+	// Confidence How likely the finding is a true positive, derived from the confidence
+	// reported by the scanner, e.g. the entropy of a secret or whether
+	// malware was detected by a signature or a heuristic. Not set if the
+	// scanner doesn't report one.
+	Confidence *FindingConfidence `json:"confidence,omitempty"`
+
+	// CredentialFingerprint Hash of the matched secret value and the rule which detected it.
+	// It is the same for every occurrence of the same credential and
+	// does not expose the secret itself.
+	CredentialFingerprint *string `json:"credentialFingerprint,omitempty"`
+	Description           *string `json:"description,omitempty"`
+	EndColumn             *int    `json:"endColumn,omitempty"`
+	EndLine               *int    `json:"endLine,omitempty"`
 
 	// FilePath Name of the file containing the secret
 	FilePath *string `json:"filePath,omitempty"`
@@ -708,6 +2982,22 @@ type SecretFindingInfo struct {
 	// Fingerprint Note: this is not unique
 	Fingerprint *string `json:"fingerprint,omitempty"`
 	ObjectType  string  `json:"objectType"`
+
+	// Occurrences The locations in which the same credential was found, capped
+	// to the configured maximum number of stored occurrences.
+	Occurrences *[]SecretOccurrence `json:"occurrences,omitempty"`
+
+	// OccurrencesCount The number of locations in which the same credential was found.
+	OccurrencesCount *int `json:"occurrencesCount,omitempty"`
+	StartColumn      *int `json:"startColumn,omitempty"`
+	StartLine        *int `json:"startLine,omitempty"`
+}
+
+// SecretOccurrence defines model for SecretOccurrence.
+type SecretOccurrence struct {
+	EndColumn   *int    `json:"endColumn,omitempty"`
+	EndLine     *int    `json:"endLine,omitempty"`
+	FilePath    *string `json:"filePath,omitempty"`
 	StartColumn *int    `json:"startColumn,omitempty"`
 	StartLine   *int    `json:"startLine,omitempty"`
 }
@@ -719,157 +3009,208 @@ type SecretScan struct {
 
 // SecretsConfig defines model for SecretsConfig.
 type SecretsConfig struct {
-	Enabled *bool `json:"enabled,omitempty"`
+	// Allowlist Secrets which are not reported.
+	Allowlist *[]SecretAllowlistEntry `json:"allowlist,omitempty"`
+	Enabled   *bool                   `json:"enabled,omitempty"`
+
+	// Redact Only report the credential fingerprints and locations of the
+	// secrets. The matched secrets and the content of the lines they
+	// were found in are removed by the scanner before the results
+	// leave the scanned host.
+	Redact *bool `json:"redact,omitempty"`
+}
+
+// SecretsDiff defines model for SecretsDiff.
+type SecretsDiff struct {
+	Added   *[]Secret       `json:"added,omitempty"`
+	Changed *[]SecretChange `json:"changed,omitempty"`
+	Removed *[]Secret       `json:"removed,omitempty"`
 }
 
 // SecurityGroup general cloud security group
 type SecurityGroup struct {
-	Id string `json:"id"`
+	Id   string  `json:"id"`
+	Name *string `json:"name,omitempty"`
+
+	// Rules The rules allowing traffic, reported by the providers which
+	// discover them.
+	Rules *[]SecurityGroupRule `json:"rules"`
 }
 
+// SecurityGroupRule A rule of a security group allowing traffic.
+type SecurityGroupRule struct {
+	Direction SecurityGroupRuleDirection `json:"direction"`
+
+	// FromPort The first port of the allowed range. If unset, all ports are allowed.
+	FromPort *int `json:"fromPort,omitempty"`
+
+	// Protocol The IP protocol (tcp, udp, icmp) or all for any protocol.
+	Protocol string `json:"protocol"`
+
+	// Sources The CIDR blocks traffic is allowed from (inbound) or to (outbound).
+	Sources *[]string `json:"sources,omitempty"`
+
+	// ToPort The last port of the allowed range. If unset, all ports are allowed.
+	ToPort *int `json:"toPort,omitempty"`
+}
+
+// SecurityGroupRuleDirection defines model for SecurityGroupRuleDirection.
+type SecurityGroupRuleDirection string
+
 // SuccessResponse An object that is returned in cases of success that returns nothing.
 type SuccessResponse struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// SuppressionAudit Records the false positive finding a scanner suppression was generated from.
+type SuppressionAudit struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// CreatedBy The user who generated the suppression.
+	CreatedBy *string `json:"createdBy,omitempty"`
+	FindingID *string `json:"findingID,omitempty"`
+	Reason    *string `json:"reason,omitempty"`
+}
+
 // Tag AWS tag
 type Tag struct {
 	Key   string `json:"key"`
 	Value string `json:"value"`
 }
 
-// Target Describes a target object.
-type Target struct {
-	Id       *string `json:"id,omitempty"`
-	Revision *int    `json:"revision,omitempty"`
-
-	// ScansCount Total number of scans that have ever run for this target
-	ScansCount *int `json:"scansCount,omitempty"`
-
-	// Summary A summary of the scan findings.
-	Summary    *ScanFindingsSummary `json:"summary,omitempty"`
-	TargetInfo *TargetType          `json:"targetInfo,omitempty"`
-}
+// TenantUsage The usage of the assets of a tenant.
+type TenantUsage struct {
+	// AssetScansThisMonth The number of scans of the assets of the tenant whose scanner instance was created since the beginning of the current month.
+	AssetScansThisMonth *int `json:"assetScansThisMonth,omitempty"`
 
-// TargetCommon defines model for TargetCommon.
-type TargetCommon = map[string]interface{}
+	// Assets The number of assets of the tenant which are not terminated.
+	Assets *int `json:"assets,omitempty"`
 
-// TargetExists defines model for TargetExists.
-type TargetExists struct {
-	// Message Describes which unique constraint combination causes the conflict.
-	Message *string `json:"message,omitempty"`
+	// ScannerInstanceHoursThisMonth The number of hours consumed by the scanner instances of the assets of the tenant created since the beginning of the current month.
+	ScannerInstanceHoursThisMonth *float32 `json:"scannerInstanceHoursThisMonth,omitempty"`
 
-	// Target Describes a target object.
-	Target *Target `json:"target,omitempty"`
+	// Tenant The value of the tenant tag of the assets, empty for the assets without it.
+	Tenant *string `json:"tenant,omitempty"`
 }
 
-// TargetRelationship Describes a relationship to a target which can be expanded.
-type TargetRelationship struct {
-	Id       string `json:"id"`
-	Revision *int   `json:"revision,omitempty"`
-
-	// ScansCount Total number of scans that have ever run for this target
-	ScansCount *int `json:"scansCount,omitempty"`
-
-	// Summary A summary of the scan findings.
-	Summary    *ScanFindingsSummary `json:"summary,omitempty"`
-	TargetInfo *TargetType          `json:"targetInfo,omitempty"`
+// UpgradePlan defines model for UpgradePlan.
+type UpgradePlan struct {
+	Upgrades *[]PackageUpgrade `json:"upgrades,omitempty"`
 }
 
-// TargetScanResult defines model for TargetScanResult.
-type TargetScanResult struct {
-	Exploits          *ExploitScan          `json:"exploits,omitempty"`
-	FindingsProcessed *bool                 `json:"findingsProcessed,omitempty"`
-	Id                *string               `json:"id,omitempty"`
-	Malware           *MalwareScan          `json:"malware,omitempty"`
-	Misconfigurations *MisconfigurationScan `json:"misconfigurations,omitempty"`
-	ResourceCleanup   *ResourceCleanupState `json:"resourceCleanup,omitempty"`
-	Revision          *int                  `json:"revision,omitempty"`
-	Rootkits          *RootkitScan          `json:"rootkits,omitempty"`
-	Sboms             *SbomScan             `json:"sboms,omitempty"`
+// Usage Usage of the deployment and the configured limits.
+type Usage struct {
+	DatabaseSizeBytes *int64 `json:"databaseSizeBytes,omitempty"`
 
-	// Scan Describes an expandable relationship to Scan object
-	Scan    *ScanRelationship `json:"scan,omitempty"`
-	Secrets *SecretScan       `json:"secrets,omitempty"`
-	Status  *TargetScanStatus `json:"status,omitempty"`
+	// Limits The configured limits. Limits which are not set are not enforced.
+	Limits *UsageLimits `json:"limits,omitempty"`
 
-	// Summary A summary of the scan findings.
-	Summary *ScanFindingsSummary `json:"summary,omitempty"`
+	// ObjectCounts The number of stored objects per type.
+	ObjectCounts *ObjectCounts `json:"objectCounts,omitempty"`
 
-	// Target Describes a relationship to a target which can be expanded.
-	Target          *TargetRelationship `json:"target,omitempty"`
-	Vulnerabilities *VulnerabilityScan  `json:"vulnerabilities,omitempty"`
-}
+	// ScannerInstanceHoursThisMonth The number of hours consumed by the scanner instances created since the beginning of the current month.
+	ScannerInstanceHoursThisMonth *float32 `json:"scannerInstanceHoursThisMonth,omitempty"`
 
-// TargetScanResultExists defines model for TargetScanResultExists.
-type TargetScanResultExists struct {
-	// Message Describes which unique constraint combination causes the conflict.
-	Message          *string           `json:"message,omitempty"`
-	TargetScanResult *TargetScanResult `json:"targetScanResult,omitempty"`
+	// ScansThisMonth The number of scans started since the beginning of the current month.
+	ScansThisMonth *int `json:"scansThisMonth,omitempty"`
+
+	// Tenants The usage of each tenant, the value of the tenant tag of the VM
+	// assets. The usage of the other assets, and of the VM assets
+	// without the tag, is reported for the empty tenant.
+	Tenants *[]TenantUsage `json:"tenants,omitempty"`
 }
 
-// TargetScanResults defines model for TargetScanResults.
-type TargetScanResults struct {
-	// Count Total scan results count according to the given filters
-	Count *int `json:"count,omitempty"`
+// UsageLimits The configured limits. Limits which are not set are not enforced.
+type UsageLimits struct {
+	// MaxScannerInstanceHoursPerMonth The maximum number of scanner instance hours which can be consumed per month.
+	MaxScannerInstanceHoursPerMonth *int `json:"maxScannerInstanceHoursPerMonth,omitempty"`
 
-	// Items List of scan results according to the given filters and page. List length must be lower or equal to pageSize.
-	Items *[]TargetScanResult `json:"items,omitempty"`
+	// MaxScansPerMonth The maximum number of scans which can be started per month.
+	MaxScansPerMonth *int `json:"maxScansPerMonth,omitempty"`
 }
 
-// TargetScanState defines model for TargetScanState.
-type TargetScanState struct {
-	Errors             *[]string             `json:"errors"`
-	LastTransitionTime *time.Time            `json:"lastTransitionTime,omitempty"`
-	State              *TargetScanStateState `json:"state,omitempty"`
-}
+// UserPreferences Preferences of a user which are kept across browsers.
+type UserPreferences struct {
+	// DashboardLayout Saved layout of the dashboard widgets, owned by the UI.
+	DashboardLayout *map[string]interface{} `json:"dashboardLayout,omitempty"`
 
-// TargetScanStateState defines model for TargetScanState.State.
-type TargetScanStateState string
+	// DefaultSeverityFilter Severities the findings views are filtered by default.
+	DefaultSeverityFilter *[]VulnerabilitySeverity `json:"defaultSeverityFilter,omitempty"`
 
-// TargetScanStatus defines model for TargetScanStatus.
-type TargetScanStatus struct {
-	Exploits          *TargetScanState `json:"exploits,omitempty"`
-	General           *TargetScanState `json:"general,omitempty"`
-	Malware           *TargetScanState `json:"malware,omitempty"`
-	Misconfigurations *TargetScanState `json:"misconfigurations,omitempty"`
-	Rootkits          *TargetScanState `json:"rootkits,omitempty"`
-	Sbom              *TargetScanState `json:"sbom,omitempty"`
-	Secrets           *TargetScanState `json:"secrets,omitempty"`
-	Vulnerabilities   *TargetScanState `json:"vulnerabilities,omitempty"`
+	// DefaultTimeWindow Default time window of the dashboard, for example 7d or 24h.
+	DefaultTimeWindow *string    `json:"defaultTimeWindow,omitempty"`
+	UpdatedAt         *time.Time `json:"updatedAt,omitempty"`
 }
 
-// TargetType defines model for TargetType.
-type TargetType struct {
-	union json.RawMessage
-}
+// VMImageInfo The source image shared by several VM targets. Findings common to all
+// the scanned instances of the image are attributed to it, so that they
+// can be fixed once in the base image instead of on every instance.
+type VMImageInfo struct {
+	// CommonFindingsCount Number of active findings common to all the scanned instances.
+	CommonFindingsCount *int `json:"commonFindingsCount,omitempty"`
 
-// Targets defines model for Targets.
-type Targets struct {
-	// Count Total targets count according the given filters
-	Count *int `json:"count,omitempty"`
+	// Guidance Remediation guidance for the findings common to the instances.
+	Guidance *string `json:"guidance,omitempty"`
+	Image    string  `json:"image"`
 
-	// Items List of targets in the given filters and page. List length must be lower or equal to pageSize.
-	Items *[]Target `json:"items,omitempty"`
+	// InstanceCount Number of active VM targets launched from the image.
+	InstanceCount    *int           `json:"instanceCount,omitempty"`
+	InstanceProvider *CloudProvider `json:"instanceProvider,omitempty"`
+	ObjectType       string         `json:"objectType"`
+
+	// ScannedInstanceCount Number of those VM targets that have been scanned.
+	ScannedInstanceCount *int `json:"scannedInstanceCount,omitempty"`
 }
 
 // VMInfo defines model for VMInfo.
 type VMInfo struct {
-	Image            string           `json:"image"`
-	InstanceID       string           `json:"instanceID"`
-	InstanceProvider *CloudProvider   `json:"instanceProvider,omitempty"`
-	InstanceType     string           `json:"instanceType"`
-	LaunchTime       time.Time        `json:"launchTime"`
-	Location         string           `json:"location"`
-	ObjectType       string           `json:"objectType"`
-	Platform         string           `json:"platform"`
-	SecurityGroups   *[]SecurityGroup `json:"securityGroups"`
-	Tags             *[]Tag           `json:"tags"`
+	// AccountID The AWS account the instance is in, if it is not in the account of the scanner.
+	AccountID        *string        `json:"accountID,omitempty"`
+	Image            string         `json:"image"`
+	InstanceID       string         `json:"instanceID"`
+	InstanceProvider *CloudProvider `json:"instanceProvider,omitempty"`
+	InstanceType     string         `json:"instanceType"`
+	LaunchTime       time.Time      `json:"launchTime"`
+	Location         string         `json:"location"`
+
+	// LocationMetadata The location of an asset normalized across the clouds, set at
+	// discovery by the providers which know it.
+	LocationMetadata *LocationMetadata `json:"locationMetadata,omitempty"`
+	ObjectType       string            `json:"objectType"`
+	Platform         string            `json:"platform"`
+	RootVolume       *RootVolume       `json:"rootVolume,omitempty"`
+	SecurityGroups   *[]SecurityGroup  `json:"securityGroups"`
+	Tags             *[]Tag            `json:"tags"`
+}
+
+// VolumeSizeGuardrail Prevents snapshotting targets with unexpectedly large volumes. Targets
+// whose volumes exceed the maximum size are not scanned unless they
+// have the opt-in tag.
+type VolumeSizeGuardrail struct {
+	MaxVolumeSizeGB int `json:"maxVolumeSizeGB"`
+
+	// OptInTag AWS tag
+	OptInTag *Tag `json:"optInTag,omitempty"`
 }
 
 // VulnerabilitiesConfig defines model for VulnerabilitiesConfig.
 type VulnerabilitiesConfig struct {
 	Enabled *bool `json:"enabled,omitempty"`
+
+	// IgnoreRules Vulnerabilities which are not reported.
+	IgnoreRules *[]VulnerabilityIgnoreRule `json:"ignoreRules,omitempty"`
+
+	// Scanners The scanners to run, all of them if not set. The vulnerabilities
+	// reported by more than one of them for the same package are
+	// reported once.
+	Scanners *[]VulnerabilityScanner `json:"scanners,omitempty"`
+}
+
+// VulnerabilitiesDiff defines model for VulnerabilitiesDiff.
+type VulnerabilitiesDiff struct {
+	Added   *[]Vulnerability       `json:"added,omitempty"`
+	Changed *[]VulnerabilityChange `json:"changed,omitempty"`
+	Removed *[]Vulnerability       `json:"removed,omitempty"`
 }
 
 // Vulnerability defines model for Vulnerability.
@@ -878,16 +3219,25 @@ type Vulnerability struct {
 	Description *string              `json:"description,omitempty"`
 
 	// Distro Distro provides information about a detected Linux distribution.
-	Distro            *VulnerabilityDistro   `json:"distro,omitempty"`
-	Fix               *VulnerabilityFix      `json:"fix,omitempty"`
-	LayerId           *string                `json:"layerId,omitempty"`
-	Links             *[]string              `json:"links"`
-	Package           *Package               `json:"package,omitempty"`
-	Path              *string                `json:"path,omitempty"`
+	Distro  *VulnerabilityDistro `json:"distro,omitempty"`
+	Fix     *VulnerabilityFix    `json:"fix,omitempty"`
+	LayerId *string              `json:"layerId,omitempty"`
+	Links   *[]string            `json:"links"`
+	Package *Package             `json:"package,omitempty"`
+	Path    *string              `json:"path,omitempty"`
+
+	// Scanners The scanners which reported this vulnerability.
+	Scanners          *[]ScannerAttribution  `json:"scanners"`
 	Severity          *VulnerabilitySeverity `json:"severity,omitempty"`
 	VulnerabilityName *string                `json:"vulnerabilityName,omitempty"`
 }
 
+// VulnerabilityChange defines model for VulnerabilityChange.
+type VulnerabilityChange struct {
+	Base    *Vulnerability `json:"base,omitempty"`
+	Compare *Vulnerability `json:"compare,omitempty"`
+}
+
 // VulnerabilityCvss defines model for VulnerabilityCvss.
 type VulnerabilityCvss struct {
 	Metrics *VulnerabilityCvssMetrics `json:"metrics,omitempty"`
@@ -920,13 +3270,36 @@ type VulnerabilityFindingInfo struct {
 	Description *string              `json:"description,omitempty"`
 
 	// Distro Distro provides information about a detected Linux distribution.
-	Distro            *VulnerabilityDistro   `json:"distro,omitempty"`
-	Fix               *VulnerabilityFix      `json:"fix,omitempty"`
-	LayerId           *string                `json:"layerId,omitempty"`
-	Links             *[]string              `json:"links"`
-	ObjectType        string                 `json:"objectType"`
-	Package           *Package               `json:"package,omitempty"`
-	Path              *string                `json:"path,omitempty"`
+	Distro *VulnerabilityDistro `json:"distro,omitempty"`
+
+	// EpssPercentile The share, between 0 and 1, of the vulnerabilities with an
+	// EPSS score lower than or equal to this vulnerability.
+	EpssPercentile *float32 `json:"epssPercentile,omitempty"`
+
+	// EpssScore The EPSS probability, between 0 and 1, of the vulnerability
+	// being exploited in the next 30 days.
+	EpssScore *float32          `json:"epssScore,omitempty"`
+	Fix       *VulnerabilityFix `json:"fix,omitempty"`
+
+	// FixVersion The lowest version of the package which fixes the vulnerability.
+	FixVersion *string `json:"fixVersion,omitempty"`
+
+	// HasFix Whether a fixed version of the vulnerable package is available.
+	HasFix *bool `json:"hasFix,omitempty"`
+
+	// KnownExploited Whether the vulnerability is in the CISA Known Exploited Vulnerabilities catalog.
+	KnownExploited *bool `json:"knownExploited,omitempty"`
+
+	// KnownExploitedDateAdded The date (YYYY-MM-DD) the vulnerability was added to the CISA Known Exploited Vulnerabilities catalog.
+	KnownExploitedDateAdded *string   `json:"knownExploitedDateAdded,omitempty"`
+	LayerId                 *string   `json:"layerId,omitempty"`
+	Links                   *[]string `json:"links"`
+	ObjectType              string    `json:"objectType"`
+	Package                 *Package  `json:"package,omitempty"`
+	Path                    *string   `json:"path,omitempty"`
+
+	// Scanners The scanners which reported this vulnerability.
+	Scanners          *[]ScannerAttribution  `json:"scanners"`
 	Severity          *VulnerabilitySeverity `json:"severity,omitempty"`
 	VulnerabilityName *string                `json:"vulnerabilityName,omitempty"`
 }
@@ -937,6 +3310,18 @@ type VulnerabilityFix struct {
 	Versions *[]string `json:"versions"`
 }
 
+// VulnerabilityIgnoreRule Ignores the vulnerability like a grype ignore rule, in the package with
+// the name and version if they are set or in any package otherwise.
+type VulnerabilityIgnoreRule struct {
+	// Audit Records the false positive finding a scanner suppression was generated from.
+	Audit          *SuppressionAudit `json:"audit,omitempty"`
+	PackageName    *string           `json:"packageName,omitempty"`
+	PackageVersion *string           `json:"packageVersion,omitempty"`
+
+	// Vulnerability The CVE or other identifier of the vulnerability.
+	Vulnerability string `json:"vulnerability"`
+}
+
 // VulnerabilityScan defines model for VulnerabilityScan.
 type VulnerabilityScan struct {
 	Vulnerabilities *[]Vulnerability `json:"vulnerabilities"`
@@ -951,15 +3336,48 @@ type VulnerabilityScanSummary struct {
 	TotalNegligibleVulnerabilities *int `json:"totalNegligibleVulnerabilities,omitempty"`
 }
 
+// VulnerabilityScanner defines model for VulnerabilityScanner.
+type VulnerabilityScanner string
+
 // VulnerabilitySeverity defines model for VulnerabilitySeverity.
 type VulnerabilitySeverity string
 
+// ApiKeyID defines model for apiKeyID.
+type ApiKeyID = string
+
+// AssetGroupID defines model for assetGroupID.
+type AssetGroupID = string
+
+// AssetID defines model for assetID.
+type AssetID = string
+
+// Async defines model for async.
+type Async = bool
+
+// CorrelatedFindingID defines model for correlatedFindingID.
+type CorrelatedFindingID = string
+
+// FeedName defines model for feedName.
+type FeedName = string
+
+// FindingDigestID defines model for findingDigestID.
+type FindingDigestID = string
+
+// FindingExceptionID defines model for findingExceptionID.
+type FindingExceptionID = string
+
 // FindingID defines model for findingID.
 type FindingID = string
 
+// IfNoneMatch defines model for ifNoneMatch.
+type IfNoneMatch = string
+
 // Ifmatch defines model for ifmatch.
 type Ifmatch = int
 
+// NotificationConfigID defines model for notificationConfigID.
+type NotificationConfigID = string
+
 // OdataCount defines model for odataCount.
 type OdataCount = bool
 
@@ -972,6 +3390,9 @@ type OdataFilter = string
 // OrderBy defines model for odataOrderBy.
 type OrderBy = string
 
+// OdataSearch defines model for odataSearch.
+type OdataSearch = string
+
 // OdataSelect defines model for odataSelect.
 type OdataSelect = string
 
@@ -981,17 +3402,43 @@ type OdataSkip = int
 // OdataTop defines model for odataTop.
 type OdataTop = int
 
+// OperationID defines model for operationID.
+type OperationID = string
+
+// ProviderOperationID defines model for providerOperationID.
+type ProviderOperationID = string
+
+// ReportScheduleID defines model for reportScheduleID.
+type ReportScheduleID = string
+
 // ScanConfigID defines model for scanConfigID.
 type ScanConfigID = string
 
+// ScanConfigTemplateID defines model for scanConfigTemplateID.
+type ScanConfigTemplateID = string
+
 // ScanID defines model for scanID.
 type ScanID = string
 
+// ScanResultFamily defines model for scanResultFamily.
+type ScanResultFamily = ScanFamily
+
 // ScanResultID defines model for scanResultID.
 type ScanResultID = string
 
-// TargetID defines model for targetID.
-type TargetID = string
+// TaskName defines model for taskName.
+type TaskName = string
+
+// InvalidQuery An object that is returned when the OData query options are invalid.
+type InvalidQuery = QueryError
+
+// JSONPatchFailed An object that is returned in all cases of failures.
+type JSONPatchFailed = ApiResponse
+
+// OperationAccepted An asynchronous operation started by a long-running endpoint.
+// Operations are kept in memory by the backend for a limited time
+// after they complete.
+type OperationAccepted = Operation
 
 // Success An object that is returned in cases of success that returns nothing.
 type Success = SuccessResponse
@@ -999,6 +3446,131 @@ type Success = SuccessResponse
 // UnknownError An object that is returned in all cases of failures.
 type UnknownError = ApiResponse
 
+// GetAdminUsageParams defines parameters for GetAdminUsage.
+type GetAdminUsageParams struct {
+	// Async Run the request as an asynchronous operation. The response is 202
+	// with the operation which can be polled until its result is ready.
+	Async *Async `form:"async,omitempty" json:"async,omitempty"`
+}
+
+// GetAPIKeysParams defines parameters for GetAPIKeys.
+type GetAPIKeysParams struct {
+	Filter  *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
+	Select  *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
+	Count   *OdataCount  `form:"$count,omitempty" json:"$count,omitempty"`
+	Top     *OdataTop    `form:"$top,omitempty" json:"$top,omitempty"`
+	Skip    *OdataSkip   `form:"$skip,omitempty" json:"$skip,omitempty"`
+	OrderBy *OrderBy     `form:"$orderby,omitempty" json:"$orderby,omitempty"`
+}
+
+// GetAPIKeysAPIKeyIDParams defines parameters for GetAPIKeysAPIKeyID.
+type GetAPIKeysAPIKeyIDParams struct {
+	Select *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
+}
+
+// GetAssetGroupsParams defines parameters for GetAssetGroups.
+type GetAssetGroupsParams struct {
+	Filter  *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
+	Select  *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
+	Count   *OdataCount  `form:"$count,omitempty" json:"$count,omitempty"`
+	Top     *OdataTop    `form:"$top,omitempty" json:"$top,omitempty"`
+	Skip    *OdataSkip   `form:"$skip,omitempty" json:"$skip,omitempty"`
+	OrderBy *OrderBy     `form:"$orderby,omitempty" json:"$orderby,omitempty"`
+}
+
+// GetAssetGroupsAssetGroupIDParams defines parameters for GetAssetGroupsAssetGroupID.
+type GetAssetGroupsAssetGroupIDParams struct {
+	Select *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
+}
+
+// PatchAssetGroupsAssetGroupIDParams defines parameters for PatchAssetGroupsAssetGroupID.
+type PatchAssetGroupsAssetGroupIDParams struct {
+	IfMatch *Ifmatch `json:"If-Match,omitempty"`
+}
+
+// GetAssetGroupsAssetGroupIDAssetsParams defines parameters for GetAssetGroupsAssetGroupIDAssets.
+type GetAssetGroupsAssetGroupIDAssetsParams struct {
+	Filter  *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
+	Select  *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
+	Count   *OdataCount  `form:"$count,omitempty" json:"$count,omitempty"`
+	Top     *OdataTop    `form:"$top,omitempty" json:"$top,omitempty"`
+	Skip    *OdataSkip   `form:"$skip,omitempty" json:"$skip,omitempty"`
+	OrderBy *OrderBy     `form:"$orderby,omitempty" json:"$orderby,omitempty"`
+}
+
+// GetAssetsParams defines parameters for GetAssets.
+type GetAssetsParams struct {
+	Filter *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
+
+	// Search Free-text search of the objects with a text field, e.g. a name,
+	// containing the search terms regardless of case. Terms are words or
+	// double quoted phrases, which must all match unless combined with OR,
+	// and can be negated with NOT and grouped with parentheses.
+	Search  *OdataSearch `form:"$search,omitempty" json:"$search,omitempty"`
+	Select  *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
+	Count   *OdataCount  `form:"$count,omitempty" json:"$count,omitempty"`
+	Top     *OdataTop    `form:"$top,omitempty" json:"$top,omitempty"`
+	Skip    *OdataSkip   `form:"$skip,omitempty" json:"$skip,omitempty"`
+	Expand  *OdataExpand `form:"$expand,omitempty" json:"$expand,omitempty"`
+	OrderBy *OrderBy     `form:"$orderby,omitempty" json:"$orderby,omitempty"`
+}
+
+// GetAssetsAssetIDParams defines parameters for GetAssetsAssetID.
+type GetAssetsAssetIDParams struct {
+	Select *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
+	Expand *OdataExpand `form:"$expand,omitempty" json:"$expand,omitempty"`
+
+	// IfNoneMatch The ETag of a previously received response. If the object didn't
+	// change since, the response is 304 without a body.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// PatchAssetsAssetIDParams defines parameters for PatchAssetsAssetID.
+type PatchAssetsAssetIDParams struct {
+	IfMatch *Ifmatch `json:"If-Match,omitempty"`
+}
+
+// PutAssetsAssetIDParams defines parameters for PutAssetsAssetID.
+type PutAssetsAssetIDParams struct {
+	IfMatch *Ifmatch `json:"If-Match,omitempty"`
+}
+
+// GetAssetsAssetIDSbomDiffParams defines parameters for GetAssetsAssetIDSbomDiff.
+type GetAssetsAssetIDSbomDiffParams struct {
+	Base string `form:"base" json:"base"`
+	Head string `form:"head" json:"head"`
+}
+
+// GetAssetsAssetIDScanResultDiffParams defines parameters for GetAssetsAssetIDScanResultDiff.
+type GetAssetsAssetIDScanResultDiffParams struct {
+	BaseScanId    string `form:"baseScanId" json:"baseScanId"`
+	CompareScanId string `form:"compareScanId" json:"compareScanId"`
+}
+
+// GetAssetsAssetIDUpgradePlanParams defines parameters for GetAssetsAssetIDUpgradePlan.
+type GetAssetsAssetIDUpgradePlanParams struct {
+	// Async Run the request as an asynchronous operation. The response is 202
+	// with the operation which can be polled until its result is ready.
+	Async *Async `form:"async,omitempty" json:"async,omitempty"`
+}
+
+// GetCorrelatedFindingsParams defines parameters for GetCorrelatedFindings.
+type GetCorrelatedFindingsParams struct {
+	Filter  *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
+	Select  *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
+	Count   *OdataCount  `form:"$count,omitempty" json:"$count,omitempty"`
+	Top     *OdataTop    `form:"$top,omitempty" json:"$top,omitempty"`
+	Skip    *OdataSkip   `form:"$skip,omitempty" json:"$skip,omitempty"`
+	Expand  *OdataExpand `form:"$expand,omitempty" json:"$expand,omitempty"`
+	OrderBy *OrderBy     `form:"$orderby,omitempty" json:"$orderby,omitempty"`
+}
+
+// GetCorrelatedFindingsCorrelatedFindingIDParams defines parameters for GetCorrelatedFindingsCorrelatedFindingID.
+type GetCorrelatedFindingsCorrelatedFindingIDParams struct {
+	Select *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
+	Expand *OdataExpand `form:"$expand,omitempty" json:"$expand,omitempty"`
+}
+
 // GetDiscoveryScopesParams defines parameters for GetDiscoveryScopes.
 type GetDiscoveryScopesParams struct {
 	Filter  *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
@@ -1006,9 +3578,61 @@ type GetDiscoveryScopesParams struct {
 	OrderBy *OrderBy     `form:"$orderby,omitempty" json:"$orderby,omitempty"`
 }
 
+// GetFeedsFeedNameParams defines parameters for GetFeedsFeedName.
+type GetFeedsFeedNameParams struct {
+	// Version The version of the feed, the current version by default.
+	Version *string `form:"version,omitempty" json:"version,omitempty"`
+}
+
+// GetFindingDigestsParams defines parameters for GetFindingDigests.
+type GetFindingDigestsParams struct {
+	Filter  *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
+	Select  *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
+	Count   *OdataCount  `form:"$count,omitempty" json:"$count,omitempty"`
+	Top     *OdataTop    `form:"$top,omitempty" json:"$top,omitempty"`
+	Skip    *OdataSkip   `form:"$skip,omitempty" json:"$skip,omitempty"`
+	OrderBy *OrderBy     `form:"$orderby,omitempty" json:"$orderby,omitempty"`
+}
+
+// GetFindingDigestsFindingDigestIDParams defines parameters for GetFindingDigestsFindingDigestID.
+type GetFindingDigestsFindingDigestIDParams struct {
+	Select *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
+}
+
+// PatchFindingDigestsFindingDigestIDParams defines parameters for PatchFindingDigestsFindingDigestID.
+type PatchFindingDigestsFindingDigestIDParams struct {
+	IfMatch *Ifmatch `json:"If-Match,omitempty"`
+}
+
+// GetFindingExceptionsParams defines parameters for GetFindingExceptions.
+type GetFindingExceptionsParams struct {
+	Filter  *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
+	Select  *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
+	Count   *OdataCount  `form:"$count,omitempty" json:"$count,omitempty"`
+	Top     *OdataTop    `form:"$top,omitempty" json:"$top,omitempty"`
+	Skip    *OdataSkip   `form:"$skip,omitempty" json:"$skip,omitempty"`
+	OrderBy *OrderBy     `form:"$orderby,omitempty" json:"$orderby,omitempty"`
+}
+
+// GetFindingExceptionsFindingExceptionIDParams defines parameters for GetFindingExceptionsFindingExceptionID.
+type GetFindingExceptionsFindingExceptionIDParams struct {
+	Select *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
+}
+
+// PatchFindingExceptionsFindingExceptionIDParams defines parameters for PatchFindingExceptionsFindingExceptionID.
+type PatchFindingExceptionsFindingExceptionIDParams struct {
+	IfMatch *Ifmatch `json:"If-Match,omitempty"`
+}
+
 // GetFindingsParams defines parameters for GetFindings.
 type GetFindingsParams struct {
-	Filter  *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
+	Filter *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
+
+	// Search Free-text search of the objects with a text field, e.g. a name,
+	// containing the search terms regardless of case. Terms are words or
+	// double quoted phrases, which must all match unless combined with OR,
+	// and can be negated with NOT and grouped with parentheses.
+	Search  *OdataSearch `form:"$search,omitempty" json:"$search,omitempty"`
 	Select  *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
 	Count   *OdataCount  `form:"$count,omitempty" json:"$count,omitempty"`
 	Top     *OdataTop    `form:"$top,omitempty" json:"$top,omitempty"`
@@ -1023,9 +3647,117 @@ type GetFindingsFindingIDParams struct {
 	Expand *OdataExpand `form:"$expand,omitempty" json:"$expand,omitempty"`
 }
 
+// GetNotificationConfigsParams defines parameters for GetNotificationConfigs.
+type GetNotificationConfigsParams struct {
+	Filter  *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
+	Select  *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
+	Count   *OdataCount  `form:"$count,omitempty" json:"$count,omitempty"`
+	Top     *OdataTop    `form:"$top,omitempty" json:"$top,omitempty"`
+	Skip    *OdataSkip   `form:"$skip,omitempty" json:"$skip,omitempty"`
+	OrderBy *OrderBy     `form:"$orderby,omitempty" json:"$orderby,omitempty"`
+}
+
+// GetNotificationConfigsNotificationConfigIDParams defines parameters for GetNotificationConfigsNotificationConfigID.
+type GetNotificationConfigsNotificationConfigIDParams struct {
+	Select *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
+}
+
+// PatchNotificationConfigsNotificationConfigIDParams defines parameters for PatchNotificationConfigsNotificationConfigID.
+type PatchNotificationConfigsNotificationConfigIDParams struct {
+	IfMatch *Ifmatch `json:"If-Match,omitempty"`
+}
+
+// GetPostureScoresParams defines parameters for GetPostureScores.
+type GetPostureScoresParams struct {
+	Filter  *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
+	Select  *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
+	Count   *OdataCount  `form:"$count,omitempty" json:"$count,omitempty"`
+	Top     *OdataTop    `form:"$top,omitempty" json:"$top,omitempty"`
+	Skip    *OdataSkip   `form:"$skip,omitempty" json:"$skip,omitempty"`
+	OrderBy *OrderBy     `form:"$orderby,omitempty" json:"$orderby,omitempty"`
+}
+
+// GetProviderOperationsParams defines parameters for GetProviderOperations.
+type GetProviderOperationsParams struct {
+	Filter  *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
+	Select  *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
+	Count   *OdataCount  `form:"$count,omitempty" json:"$count,omitempty"`
+	Top     *OdataTop    `form:"$top,omitempty" json:"$top,omitempty"`
+	Skip    *OdataSkip   `form:"$skip,omitempty" json:"$skip,omitempty"`
+	Expand  *OdataExpand `form:"$expand,omitempty" json:"$expand,omitempty"`
+	OrderBy *OrderBy     `form:"$orderby,omitempty" json:"$orderby,omitempty"`
+}
+
+// GetProviderOperationsProviderOperationIDParams defines parameters for GetProviderOperationsProviderOperationID.
+type GetProviderOperationsProviderOperationIDParams struct {
+	Select *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
+	Expand *OdataExpand `form:"$expand,omitempty" json:"$expand,omitempty"`
+}
+
+// GetReportSchedulesParams defines parameters for GetReportSchedules.
+type GetReportSchedulesParams struct {
+	Filter  *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
+	Select  *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
+	Count   *OdataCount  `form:"$count,omitempty" json:"$count,omitempty"`
+	Top     *OdataTop    `form:"$top,omitempty" json:"$top,omitempty"`
+	Skip    *OdataSkip   `form:"$skip,omitempty" json:"$skip,omitempty"`
+	OrderBy *OrderBy     `form:"$orderby,omitempty" json:"$orderby,omitempty"`
+}
+
+// GetReportSchedulesReportScheduleIDParams defines parameters for GetReportSchedulesReportScheduleID.
+type GetReportSchedulesReportScheduleIDParams struct {
+	Select *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
+}
+
+// PatchReportSchedulesReportScheduleIDParams defines parameters for PatchReportSchedulesReportScheduleID.
+type PatchReportSchedulesReportScheduleIDParams struct {
+	IfMatch *Ifmatch `json:"If-Match,omitempty"`
+}
+
+// PostSbomsJSONBody defines parameters for PostSboms.
+type PostSbomsJSONBody = map[string]interface{}
+
+// PostSbomsParams defines parameters for PostSboms.
+type PostSbomsParams struct {
+	// Name Name of the artifact the SBOM describes, e.g. an image or application name.
+	Name string `form:"name" json:"name"`
+
+	// Version Version of the artifact the SBOM describes.
+	Version *string `form:"version,omitempty" json:"version,omitempty"`
+
+	// Location Where the artifact is stored, e.g. an image reference or a repository URL.
+	Location *string `form:"location,omitempty" json:"location,omitempty"`
+}
+
+// GetScanConfigTemplatesParams defines parameters for GetScanConfigTemplates.
+type GetScanConfigTemplatesParams struct {
+	Filter  *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
+	Select  *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
+	Count   *OdataCount  `form:"$count,omitempty" json:"$count,omitempty"`
+	Top     *OdataTop    `form:"$top,omitempty" json:"$top,omitempty"`
+	Skip    *OdataSkip   `form:"$skip,omitempty" json:"$skip,omitempty"`
+	OrderBy *OrderBy     `form:"$orderby,omitempty" json:"$orderby,omitempty"`
+}
+
+// GetScanConfigTemplatesScanConfigTemplateIDParams defines parameters for GetScanConfigTemplatesScanConfigTemplateID.
+type GetScanConfigTemplatesScanConfigTemplateIDParams struct {
+	Select *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
+}
+
+// PatchScanConfigTemplatesScanConfigTemplateIDParams defines parameters for PatchScanConfigTemplatesScanConfigTemplateID.
+type PatchScanConfigTemplatesScanConfigTemplateIDParams struct {
+	IfMatch *Ifmatch `json:"If-Match,omitempty"`
+}
+
 // GetScanConfigsParams defines parameters for GetScanConfigs.
 type GetScanConfigsParams struct {
-	Filter  *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
+	Filter *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
+
+	// Search Free-text search of the objects with a text field, e.g. a name,
+	// containing the search terms regardless of case. Terms are words or
+	// double quoted phrases, which must all match unless combined with OR,
+	// and can be negated with NOT and grouped with parentheses.
+	Search  *OdataSearch `form:"$search,omitempty" json:"$search,omitempty"`
 	Select  *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
 	Count   *OdataCount  `form:"$count,omitempty" json:"$count,omitempty"`
 	Top     *OdataTop    `form:"$top,omitempty" json:"$top,omitempty"`
@@ -1038,6 +3770,10 @@ type GetScanConfigsParams struct {
 type GetScanConfigsScanConfigIDParams struct {
 	Select *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
 	Expand *OdataExpand `form:"$expand,omitempty" json:"$expand,omitempty"`
+
+	// IfNoneMatch The ETag of a previously received response. If the object didn't
+	// change since, the response is 304 without a body.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
 }
 
 // PatchScanConfigsScanConfigIDParams defines parameters for PatchScanConfigsScanConfigID.
@@ -1050,6 +3786,12 @@ type PutScanConfigsScanConfigIDParams struct {
 	IfMatch *Ifmatch `json:"If-Match,omitempty"`
 }
 
+// GetScanConfigsScanConfigIDNextRunsParams defines parameters for GetScanConfigsScanConfigIDNextRuns.
+type GetScanConfigsScanConfigIDNextRunsParams struct {
+	// Count The number of run times to return, 5 by default.
+	Count *int `form:"count,omitempty" json:"count,omitempty"`
+}
+
 // GetScanResultsParams defines parameters for GetScanResults.
 type GetScanResultsParams struct {
 	Filter  *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
@@ -1065,6 +3807,10 @@ type GetScanResultsParams struct {
 type GetScanResultsScanResultIDParams struct {
 	Select *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
 	Expand *OdataExpand `form:"$expand,omitempty" json:"$expand,omitempty"`
+
+	// IfNoneMatch The ETag of a previously received response. If the object didn't
+	// change since, the response is 304 without a body.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
 }
 
 // PatchScanResultsScanResultIDParams defines parameters for PatchScanResultsScanResultID.
@@ -1077,9 +3823,33 @@ type PutScanResultsScanResultIDParams struct {
 	IfMatch *Ifmatch `json:"If-Match,omitempty"`
 }
 
+// GetScanResultsScanResultIDFamiliesFamilyItemsParams defines parameters for GetScanResultsScanResultIDFamiliesFamilyItems.
+type GetScanResultsScanResultIDFamiliesFamilyItemsParams struct {
+	Top  *OdataTop  `form:"$top,omitempty" json:"$top,omitempty"`
+	Skip *OdataSkip `form:"$skip,omitempty" json:"$skip,omitempty"`
+}
+
+// PostScanResultsScanResultIDRerunParams defines parameters for PostScanResultsScanResultIDRerun.
+type PostScanResultsScanResultIDRerunParams struct {
+	// Families Comma separated list of scan families to re-run.
+	Families []ScanFamily `form:"families" json:"families"`
+}
+
+// GetScanResultsScanResultIDSbomParams defines parameters for GetScanResultsScanResultIDSbom.
+type GetScanResultsScanResultIDSbomParams struct {
+	// Format The format of the SBOM document.
+	Format SbomFormat `form:"format" json:"format"`
+}
+
 // GetScansParams defines parameters for GetScans.
 type GetScansParams struct {
-	Filter  *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
+	Filter *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
+
+	// Search Free-text search of the objects with a text field, e.g. a name,
+	// containing the search terms regardless of case. Terms are words or
+	// double quoted phrases, which must all match unless combined with OR,
+	// and can be negated with NOT and grouped with parentheses.
+	Search  *OdataSearch `form:"$search,omitempty" json:"$search,omitempty"`
 	Select  *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
 	Count   *OdataCount  `form:"$count,omitempty" json:"$count,omitempty"`
 	Top     *OdataTop    `form:"$top,omitempty" json:"$top,omitempty"`
@@ -1092,6 +3862,10 @@ type GetScansParams struct {
 type GetScansScanIDParams struct {
 	Select *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
 	Expand *OdataExpand `form:"$expand,omitempty" json:"$expand,omitempty"`
+
+	// IfNoneMatch The ETag of a previously received response. If the object didn't
+	// change since, the response is 304 without a body.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
 }
 
 // PatchScansScanIDParams defines parameters for PatchScansScanID.
@@ -1104,80 +3878,337 @@ type PutScansScanIDParams struct {
 	IfMatch *Ifmatch `json:"If-Match,omitempty"`
 }
 
-// GetTargetsParams defines parameters for GetTargets.
-type GetTargetsParams struct {
-	Filter  *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
-	Select  *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
-	Count   *OdataCount  `form:"$count,omitempty" json:"$count,omitempty"`
-	Top     *OdataTop    `form:"$top,omitempty" json:"$top,omitempty"`
-	Skip    *OdataSkip   `form:"$skip,omitempty" json:"$skip,omitempty"`
-	Expand  *OdataExpand `form:"$expand,omitempty" json:"$expand,omitempty"`
-	OrderBy *OrderBy     `form:"$orderby,omitempty" json:"$orderby,omitempty"`
+// PutAdminMaintenanceJSONRequestBody defines body for PutAdminMaintenance for application/json ContentType.
+type PutAdminMaintenanceJSONRequestBody = MaintenanceMode
+
+// PostAPIKeysJSONRequestBody defines body for PostAPIKeys for application/json ContentType.
+type PostAPIKeysJSONRequestBody = APIKey
+
+// PostAssetGroupsJSONRequestBody defines body for PostAssetGroups for application/json ContentType.
+type PostAssetGroupsJSONRequestBody = AssetGroup
+
+// PatchAssetGroupsAssetGroupIDJSONRequestBody defines body for PatchAssetGroupsAssetGroupID for application/json ContentType.
+type PatchAssetGroupsAssetGroupIDJSONRequestBody = AssetGroup
+
+// PostAssetsJSONRequestBody defines body for PostAssets for application/json ContentType.
+type PostAssetsJSONRequestBody = Asset
+
+// PatchAssetsAssetIDJSONRequestBody defines body for PatchAssetsAssetID for application/json ContentType.
+type PatchAssetsAssetIDJSONRequestBody = Asset
+
+// PutAssetsAssetIDJSONRequestBody defines body for PutAssetsAssetID for application/json ContentType.
+type PutAssetsAssetIDJSONRequestBody = Asset
+
+// PutDiscoveryScopesJSONRequestBody defines body for PutDiscoveryScopes for application/json ContentType.
+type PutDiscoveryScopesJSONRequestBody = Scopes
+
+// PostFindingDigestsJSONRequestBody defines body for PostFindingDigests for application/json ContentType.
+type PostFindingDigestsJSONRequestBody = FindingDigest
+
+// PatchFindingDigestsFindingDigestIDJSONRequestBody defines body for PatchFindingDigestsFindingDigestID for application/json ContentType.
+type PatchFindingDigestsFindingDigestIDJSONRequestBody = FindingDigest
+
+// PostFindingExceptionsJSONRequestBody defines body for PostFindingExceptions for application/json ContentType.
+type PostFindingExceptionsJSONRequestBody = FindingException
+
+// PatchFindingExceptionsFindingExceptionIDJSONRequestBody defines body for PatchFindingExceptionsFindingExceptionID for application/json ContentType.
+type PatchFindingExceptionsFindingExceptionIDJSONRequestBody = FindingException
+
+// PostFindingsJSONRequestBody defines body for PostFindings for application/json ContentType.
+type PostFindingsJSONRequestBody = Finding
+
+// PatchFindingsBulkJSONRequestBody defines body for PatchFindingsBulk for application/json ContentType.
+type PatchFindingsBulkJSONRequestBody = FindingsBulkRequest
+
+// PostFindingsBulkJSONRequestBody defines body for PostFindingsBulk for application/json ContentType.
+type PostFindingsBulkJSONRequestBody = FindingsBulkRequest
+
+// PatchFindingsFindingIDJSONRequestBody defines body for PatchFindingsFindingID for application/json ContentType.
+type PatchFindingsFindingIDJSONRequestBody = Finding
+
+// PutFindingsFindingIDJSONRequestBody defines body for PutFindingsFindingID for application/json ContentType.
+type PutFindingsFindingIDJSONRequestBody = Finding
+
+// PostFindingsFindingIDScannerSuppressionJSONRequestBody defines body for PostFindingsFindingIDScannerSuppression for application/json ContentType.
+type PostFindingsFindingIDScannerSuppressionJSONRequestBody = ScannerSuppressionRequest
+
+// PostNotificationConfigsJSONRequestBody defines body for PostNotificationConfigs for application/json ContentType.
+type PostNotificationConfigsJSONRequestBody = NotificationConfig
+
+// PatchNotificationConfigsNotificationConfigIDJSONRequestBody defines body for PatchNotificationConfigsNotificationConfigID for application/json ContentType.
+type PatchNotificationConfigsNotificationConfigIDJSONRequestBody = NotificationConfig
+
+// PostProviderOperationsJSONRequestBody defines body for PostProviderOperations for application/json ContentType.
+type PostProviderOperationsJSONRequestBody = ProviderOperation
+
+// PostReportSchedulesJSONRequestBody defines body for PostReportSchedules for application/json ContentType.
+type PostReportSchedulesJSONRequestBody = ReportSchedule
+
+// PatchReportSchedulesReportScheduleIDJSONRequestBody defines body for PatchReportSchedulesReportScheduleID for application/json ContentType.
+type PatchReportSchedulesReportScheduleIDJSONRequestBody = ReportSchedule
+
+// PostSbomsJSONRequestBody defines body for PostSboms for application/json ContentType.
+type PostSbomsJSONRequestBody = PostSbomsJSONBody
+
+// PostScanConfigTemplatesJSONRequestBody defines body for PostScanConfigTemplates for application/json ContentType.
+type PostScanConfigTemplatesJSONRequestBody = ScanConfigTemplate
+
+// PatchScanConfigTemplatesScanConfigTemplateIDJSONRequestBody defines body for PatchScanConfigTemplatesScanConfigTemplateID for application/json ContentType.
+type PatchScanConfigTemplatesScanConfigTemplateIDJSONRequestBody = ScanConfigTemplate
+
+// PostScanConfigsJSONRequestBody defines body for PostScanConfigs for application/json ContentType.
+type PostScanConfigsJSONRequestBody = ScanConfig
+
+// PatchScanConfigsScanConfigIDJSONRequestBody defines body for PatchScanConfigsScanConfigID for application/json ContentType.
+type PatchScanConfigsScanConfigIDJSONRequestBody = ScanConfig
+
+// PutScanConfigsScanConfigIDJSONRequestBody defines body for PutScanConfigsScanConfigID for application/json ContentType.
+type PutScanConfigsScanConfigIDJSONRequestBody = ScanConfig
+
+// PostScanResultsJSONRequestBody defines body for PostScanResults for application/json ContentType.
+type PostScanResultsJSONRequestBody = AssetScanResult
+
+// PatchScanResultsScanResultIDJSONRequestBody defines body for PatchScanResultsScanResultID for application/json ContentType.
+type PatchScanResultsScanResultIDJSONRequestBody = AssetScanResult
+
+// PutScanResultsScanResultIDJSONRequestBody defines body for PutScanResultsScanResultID for application/json ContentType.
+type PutScanResultsScanResultIDJSONRequestBody = AssetScanResult
+
+// PostScanResultsScanResultIDFamiliesFamilyItemsJSONRequestBody defines body for PostScanResultsScanResultIDFamiliesFamilyItems for application/json ContentType.
+type PostScanResultsScanResultIDFamiliesFamilyItemsJSONRequestBody = ScanResultItems
+
+// PutScanResultsScanResultIDScannerConfigJSONRequestBody defines body for PutScanResultsScanResultIDScannerConfig for application/json ContentType.
+type PutScanResultsScanResultIDScannerConfigJSONRequestBody = ScannerConfig
+
+// PostScansJSONRequestBody defines body for PostScans for application/json ContentType.
+type PostScansJSONRequestBody = Scan
+
+// PatchScansScanIDJSONRequestBody defines body for PatchScansScanID for application/json ContentType.
+type PatchScansScanIDJSONRequestBody = Scan
+
+// PutScansScanIDJSONRequestBody defines body for PutScansScanID for application/json ContentType.
+type PutScansScanIDJSONRequestBody = Scan
+
+// PutSettingsFindingTemplatesJSONRequestBody defines body for PutSettingsFindingTemplates for application/json ContentType.
+type PutSettingsFindingTemplatesJSONRequestBody = FindingTemplateSettings
+
+// PostSettingsFindingTemplatesPreviewJSONRequestBody defines body for PostSettingsFindingTemplatesPreview for application/json ContentType.
+type PostSettingsFindingTemplatesPreviewJSONRequestBody = FindingTemplatePreview
+
+// PutSettingsRetentionJSONRequestBody defines body for PutSettingsRetention for application/json ContentType.
+type PutSettingsRetentionJSONRequestBody = RetentionSettings
+
+// PutUserPreferencesJSONRequestBody defines body for PutUserPreferences for application/json ContentType.
+type PutUserPreferencesJSONRequestBody = UserPreferences
+
+// AsVMInfo returns the union data inside the AssetType as a VMInfo
+func (t AssetType) AsVMInfo() (VMInfo, error) {
+	var body VMInfo
+	err := json.Unmarshal(t.union, &body)
+	return body, err
 }
 
-// GetTargetsTargetIDParams defines parameters for GetTargetsTargetID.
-type GetTargetsTargetIDParams struct {
-	Select *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
-	Expand *OdataExpand `form:"$expand,omitempty" json:"$expand,omitempty"`
+// FromVMInfo overwrites any union data inside the AssetType as the provided VMInfo
+func (t *AssetType) FromVMInfo(v VMInfo) error {
+	v.ObjectType = "VMInfo"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
 }
 
-// PatchTargetsTargetIDParams defines parameters for PatchTargetsTargetID.
-type PatchTargetsTargetIDParams struct {
-	IfMatch *Ifmatch `json:"If-Match,omitempty"`
+// MergeVMInfo performs a merge with any union data inside the AssetType, using the provided VMInfo
+func (t *AssetType) MergeVMInfo(v VMInfo) error {
+	v.ObjectType = "VMInfo"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
-// PutTargetsTargetIDParams defines parameters for PutTargetsTargetID.
-type PutTargetsTargetIDParams struct {
-	IfMatch *Ifmatch `json:"If-Match,omitempty"`
+// AsPodInfo returns the union data inside the AssetType as a PodInfo
+func (t AssetType) AsPodInfo() (PodInfo, error) {
+	var body PodInfo
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromPodInfo overwrites any union data inside the AssetType as the provided PodInfo
+func (t *AssetType) FromPodInfo(v PodInfo) error {
+	v.ObjectType = "PodInfo"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergePodInfo performs a merge with any union data inside the AssetType, using the provided PodInfo
+func (t *AssetType) MergePodInfo(v PodInfo) error {
+	v.ObjectType = "PodInfo"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsDirInfo returns the union data inside the AssetType as a DirInfo
+func (t AssetType) AsDirInfo() (DirInfo, error) {
+	var body DirInfo
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDirInfo overwrites any union data inside the AssetType as the provided DirInfo
+func (t *AssetType) FromDirInfo(v DirInfo) error {
+	v.ObjectType = "DirInfo"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDirInfo performs a merge with any union data inside the AssetType, using the provided DirInfo
+func (t *AssetType) MergeDirInfo(v DirInfo) error {
+	v.ObjectType = "DirInfo"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
 }
 
-// PutDiscoveryScopesJSONRequestBody defines body for PutDiscoveryScopes for application/json ContentType.
-type PutDiscoveryScopesJSONRequestBody = Scopes
+// AsContainerImageInfo returns the union data inside the AssetType as a ContainerImageInfo
+func (t AssetType) AsContainerImageInfo() (ContainerImageInfo, error) {
+	var body ContainerImageInfo
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
 
-// PostFindingsJSONRequestBody defines body for PostFindings for application/json ContentType.
-type PostFindingsJSONRequestBody = Finding
+// FromContainerImageInfo overwrites any union data inside the AssetType as the provided ContainerImageInfo
+func (t *AssetType) FromContainerImageInfo(v ContainerImageInfo) error {
+	v.ObjectType = "ContainerImageInfo"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
 
-// PatchFindingsFindingIDJSONRequestBody defines body for PatchFindingsFindingID for application/json ContentType.
-type PatchFindingsFindingIDJSONRequestBody = Finding
+// MergeContainerImageInfo performs a merge with any union data inside the AssetType, using the provided ContainerImageInfo
+func (t *AssetType) MergeContainerImageInfo(v ContainerImageInfo) error {
+	v.ObjectType = "ContainerImageInfo"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
 
-// PutFindingsFindingIDJSONRequestBody defines body for PutFindingsFindingID for application/json ContentType.
-type PutFindingsFindingIDJSONRequestBody = Finding
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
 
-// PostScanConfigsJSONRequestBody defines body for PostScanConfigs for application/json ContentType.
-type PostScanConfigsJSONRequestBody = ScanConfig
+// AsSBOMInfo returns the union data inside the AssetType as a SBOMInfo
+func (t AssetType) AsSBOMInfo() (SBOMInfo, error) {
+	var body SBOMInfo
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
 
-// PatchScanConfigsScanConfigIDJSONRequestBody defines body for PatchScanConfigsScanConfigID for application/json ContentType.
-type PatchScanConfigsScanConfigIDJSONRequestBody = ScanConfig
+// FromSBOMInfo overwrites any union data inside the AssetType as the provided SBOMInfo
+func (t *AssetType) FromSBOMInfo(v SBOMInfo) error {
+	v.ObjectType = "SBOMInfo"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
 
-// PutScanConfigsScanConfigIDJSONRequestBody defines body for PutScanConfigsScanConfigID for application/json ContentType.
-type PutScanConfigsScanConfigIDJSONRequestBody = ScanConfig
+// MergeSBOMInfo performs a merge with any union data inside the AssetType, using the provided SBOMInfo
+func (t *AssetType) MergeSBOMInfo(v SBOMInfo) error {
+	v.ObjectType = "SBOMInfo"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
 
-// PostScanResultsJSONRequestBody defines body for PostScanResults for application/json ContentType.
-type PostScanResultsJSONRequestBody = TargetScanResult
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
 
-// PatchScanResultsScanResultIDJSONRequestBody defines body for PatchScanResultsScanResultID for application/json ContentType.
-type PatchScanResultsScanResultIDJSONRequestBody = TargetScanResult
+// AsVMImageInfo returns the union data inside the AssetType as a VMImageInfo
+func (t AssetType) AsVMImageInfo() (VMImageInfo, error) {
+	var body VMImageInfo
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
 
-// PutScanResultsScanResultIDJSONRequestBody defines body for PutScanResultsScanResultID for application/json ContentType.
-type PutScanResultsScanResultIDJSONRequestBody = TargetScanResult
+// FromVMImageInfo overwrites any union data inside the AssetType as the provided VMImageInfo
+func (t *AssetType) FromVMImageInfo(v VMImageInfo) error {
+	v.ObjectType = "VMImageInfo"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
 
-// PostScansJSONRequestBody defines body for PostScans for application/json ContentType.
-type PostScansJSONRequestBody = Scan
+// MergeVMImageInfo performs a merge with any union data inside the AssetType, using the provided VMImageInfo
+func (t *AssetType) MergeVMImageInfo(v VMImageInfo) error {
+	v.ObjectType = "VMImageInfo"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
 
-// PatchScansScanIDJSONRequestBody defines body for PatchScansScanID for application/json ContentType.
-type PatchScansScanIDJSONRequestBody = Scan
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
 
-// PutScansScanIDJSONRequestBody defines body for PutScansScanID for application/json ContentType.
-type PutScansScanIDJSONRequestBody = Scan
+func (t AssetType) Discriminator() (string, error) {
+	var discriminator struct {
+		Discriminator string `json:"objectType"`
+	}
+	err := json.Unmarshal(t.union, &discriminator)
+	return discriminator.Discriminator, err
+}
 
-// PostTargetsJSONRequestBody defines body for PostTargets for application/json ContentType.
-type PostTargetsJSONRequestBody = Target
+func (t AssetType) ValueByDiscriminator() (interface{}, error) {
+	discriminator, err := t.Discriminator()
+	if err != nil {
+		return nil, err
+	}
+	switch discriminator {
+	case "ContainerImageInfo":
+		return t.AsContainerImageInfo()
+	case "DirInfo":
+		return t.AsDirInfo()
+	case "PodInfo":
+		return t.AsPodInfo()
+	case "SBOMInfo":
+		return t.AsSBOMInfo()
+	case "VMImageInfo":
+		return t.AsVMImageInfo()
+	case "VMInfo":
+		return t.AsVMInfo()
+	default:
+		return nil, errors.New("unknown discriminator value: " + discriminator)
+	}
+}
 
-// PatchTargetsTargetIDJSONRequestBody defines body for PatchTargetsTargetID for application/json ContentType.
-type PatchTargetsTargetIDJSONRequestBody = Target
+func (t AssetType) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
 
-// PutTargetsTargetIDJSONRequestBody defines body for PutTargetsTargetID for application/json ContentType.
-type PutTargetsTargetIDJSONRequestBody = Target
+func (t *AssetType) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
 
 // AsPackageFindingInfo returns the union data inside the Finding_FindingInfo as a PackageFindingInfo
 func (t Finding_FindingInfo) AsPackageFindingInfo() (PackageFindingInfo, error) {
@@ -1202,7 +4233,7 @@ func (t *Finding_FindingInfo) MergePackageFindingInfo(v PackageFindingInfo) erro
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(b, t.union)
+	merged, err := runtime.JsonMerge(t.union, b)
 	t.union = merged
 	return err
 }
@@ -1230,7 +4261,7 @@ func (t *Finding_FindingInfo) MergeVulnerabilityFindingInfo(v VulnerabilityFindi
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(b, t.union)
+	merged, err := runtime.JsonMerge(t.union, b)
 	t.union = merged
 	return err
 }
@@ -1258,7 +4289,7 @@ func (t *Finding_FindingInfo) MergeMalwareFindingInfo(v MalwareFindingInfo) erro
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(b, t.union)
+	merged, err := runtime.JsonMerge(t.union, b)
 	t.union = merged
 	return err
 }
@@ -1286,7 +4317,7 @@ func (t *Finding_FindingInfo) MergeSecretFindingInfo(v SecretFindingInfo) error
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(b, t.union)
+	merged, err := runtime.JsonMerge(t.union, b)
 	t.union = merged
 	return err
 }
@@ -1314,7 +4345,7 @@ func (t *Finding_FindingInfo) MergeMisconfigurationFindingInfo(v Misconfiguratio
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(b, t.union)
+	merged, err := runtime.JsonMerge(t.union, b)
 	t.union = merged
 	return err
 }
@@ -1342,7 +4373,7 @@ func (t *Finding_FindingInfo) MergeRootkitFindingInfo(v RootkitFindingInfo) erro
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(b, t.union)
+	merged, err := runtime.JsonMerge(t.union, b)
 	t.union = merged
 	return err
 }
@@ -1370,7 +4401,91 @@ func (t *Finding_FindingInfo) MergeExploitFindingInfo(v ExploitFindingInfo) erro
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(b, t.union)
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsCertificateFindingInfo returns the union data inside the Finding_FindingInfo as a CertificateFindingInfo
+func (t Finding_FindingInfo) AsCertificateFindingInfo() (CertificateFindingInfo, error) {
+	var body CertificateFindingInfo
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromCertificateFindingInfo overwrites any union data inside the Finding_FindingInfo as the provided CertificateFindingInfo
+func (t *Finding_FindingInfo) FromCertificateFindingInfo(v CertificateFindingInfo) error {
+	v.ObjectType = "Certificate"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeCertificateFindingInfo performs a merge with any union data inside the Finding_FindingInfo, using the provided CertificateFindingInfo
+func (t *Finding_FindingInfo) MergeCertificateFindingInfo(v CertificateFindingInfo) error {
+	v.ObjectType = "Certificate"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsComplianceCheckFindingInfo returns the union data inside the Finding_FindingInfo as a ComplianceCheckFindingInfo
+func (t Finding_FindingInfo) AsComplianceCheckFindingInfo() (ComplianceCheckFindingInfo, error) {
+	var body ComplianceCheckFindingInfo
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromComplianceCheckFindingInfo overwrites any union data inside the Finding_FindingInfo as the provided ComplianceCheckFindingInfo
+func (t *Finding_FindingInfo) FromComplianceCheckFindingInfo(v ComplianceCheckFindingInfo) error {
+	v.ObjectType = "ComplianceCheck"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeComplianceCheckFindingInfo performs a merge with any union data inside the Finding_FindingInfo, using the provided ComplianceCheckFindingInfo
+func (t *Finding_FindingInfo) MergeComplianceCheckFindingInfo(v ComplianceCheckFindingInfo) error {
+	v.ObjectType = "ComplianceCheck"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsPluginFindingInfo returns the union data inside the Finding_FindingInfo as a PluginFindingInfo
+func (t Finding_FindingInfo) AsPluginFindingInfo() (PluginFindingInfo, error) {
+	var body PluginFindingInfo
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromPluginFindingInfo overwrites any union data inside the Finding_FindingInfo as the provided PluginFindingInfo
+func (t *Finding_FindingInfo) FromPluginFindingInfo(v PluginFindingInfo) error {
+	v.ObjectType = "PluginFinding"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergePluginFindingInfo performs a merge with any union data inside the Finding_FindingInfo, using the provided PluginFindingInfo
+func (t *Finding_FindingInfo) MergePluginFindingInfo(v PluginFindingInfo) error {
+	v.ObjectType = "PluginFinding"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
 	t.union = merged
 	return err
 }
@@ -1389,6 +4504,10 @@ func (t Finding_FindingInfo) ValueByDiscriminator() (interface{}, error) {
 		return nil, err
 	}
 	switch discriminator {
+	case "Certificate":
+		return t.AsCertificateFindingInfo()
+	case "ComplianceCheck":
+		return t.AsComplianceCheckFindingInfo()
 	case "Exploit":
 		return t.AsExploitFindingInfo()
 	case "Malware":
@@ -1397,6 +4516,8 @@ func (t Finding_FindingInfo) ValueByDiscriminator() (interface{}, error) {
 		return t.AsMisconfigurationFindingInfo()
 	case "Package":
 		return t.AsPackageFindingInfo()
+	case "PluginFinding":
+		return t.AsPluginFindingInfo()
 	case "Rootkit":
 		return t.AsRootkitFindingInfo()
 	case "Secret":
@@ -1441,7 +4562,7 @@ func (t *ScanScopeType) MergeAwsScanScope(v AwsScanScope) error {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(b, t.union)
+	merged, err := runtime.JsonMerge(t.union, b)
 	t.union = merged
 	return err
 }
@@ -1469,7 +4590,63 @@ func (t *ScanScopeType) MergeAzureScanScope(v AzureScanScope) error {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(b, t.union)
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsSSHScanScope returns the union data inside the ScanScopeType as a SSHScanScope
+func (t ScanScopeType) AsSSHScanScope() (SSHScanScope, error) {
+	var body SSHScanScope
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromSSHScanScope overwrites any union data inside the ScanScopeType as the provided SSHScanScope
+func (t *ScanScopeType) FromSSHScanScope(v SSHScanScope) error {
+	v.ObjectType = "SSHScanScope"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeSSHScanScope performs a merge with any union data inside the ScanScopeType, using the provided SSHScanScope
+func (t *ScanScopeType) MergeSSHScanScope(v SSHScanScope) error {
+	v.ObjectType = "SSHScanScope"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsKubernetesScanScope returns the union data inside the ScanScopeType as a KubernetesScanScope
+func (t ScanScopeType) AsKubernetesScanScope() (KubernetesScanScope, error) {
+	var body KubernetesScanScope
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromKubernetesScanScope overwrites any union data inside the ScanScopeType as the provided KubernetesScanScope
+func (t *ScanScopeType) FromKubernetesScanScope(v KubernetesScanScope) error {
+	v.ObjectType = "KubernetesScanScope"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeKubernetesScanScope performs a merge with any union data inside the ScanScopeType, using the provided KubernetesScanScope
+func (t *ScanScopeType) MergeKubernetesScanScope(v KubernetesScanScope) error {
+	v.ObjectType = "KubernetesScanScope"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
 	t.union = merged
 	return err
 }
@@ -1492,6 +4669,10 @@ func (t ScanScopeType) ValueByDiscriminator() (interface{}, error) {
 		return t.AsAwsScanScope()
 	case "AzureScanScope":
 		return t.AsAzureScanScope()
+	case "KubernetesScanScope":
+		return t.AsKubernetesScanScope()
+	case "SSHScanScope":
+		return t.AsSSHScanScope()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
@@ -1530,157 +4711,124 @@ func (t *ScopeType) MergeAwsAccountScope(v AwsAccountScope) error {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(b, t.union)
+	merged, err := runtime.JsonMerge(t.union, b)
 	t.union = merged
 	return err
 }
 
-// AsAzureSubscriptionScope returns the union data inside the ScopeType as a AzureSubscriptionScope
-func (t ScopeType) AsAzureSubscriptionScope() (AzureSubscriptionScope, error) {
-	var body AzureSubscriptionScope
+// AsAwsOrganizationScope returns the union data inside the ScopeType as a AwsOrganizationScope
+func (t ScopeType) AsAwsOrganizationScope() (AwsOrganizationScope, error) {
+	var body AwsOrganizationScope
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromAzureSubscriptionScope overwrites any union data inside the ScopeType as the provided AzureSubscriptionScope
-func (t *ScopeType) FromAzureSubscriptionScope(v AzureSubscriptionScope) error {
-	v.ObjectType = "AzureSubscriptionScope"
+// FromAwsOrganizationScope overwrites any union data inside the ScopeType as the provided AwsOrganizationScope
+func (t *ScopeType) FromAwsOrganizationScope(v AwsOrganizationScope) error {
+	v.ObjectType = "AwsOrganizationScope"
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeAzureSubscriptionScope performs a merge with any union data inside the ScopeType, using the provided AzureSubscriptionScope
-func (t *ScopeType) MergeAzureSubscriptionScope(v AzureSubscriptionScope) error {
-	v.ObjectType = "AzureSubscriptionScope"
+// MergeAwsOrganizationScope performs a merge with any union data inside the ScopeType, using the provided AwsOrganizationScope
+func (t *ScopeType) MergeAwsOrganizationScope(v AwsOrganizationScope) error {
+	v.ObjectType = "AwsOrganizationScope"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(b, t.union)
+	merged, err := runtime.JsonMerge(t.union, b)
 	t.union = merged
 	return err
 }
 
-func (t ScopeType) Discriminator() (string, error) {
-	var discriminator struct {
-		Discriminator string `json:"objectType"`
-	}
-	err := json.Unmarshal(t.union, &discriminator)
-	return discriminator.Discriminator, err
-}
-
-func (t ScopeType) ValueByDiscriminator() (interface{}, error) {
-	discriminator, err := t.Discriminator()
-	if err != nil {
-		return nil, err
-	}
-	switch discriminator {
-	case "AwsAccountScope":
-		return t.AsAwsAccountScope()
-	case "AzureSubscriptionScope":
-		return t.AsAzureSubscriptionScope()
-	default:
-		return nil, errors.New("unknown discriminator value: " + discriminator)
-	}
-}
-
-func (t ScopeType) MarshalJSON() ([]byte, error) {
-	b, err := t.union.MarshalJSON()
-	return b, err
-}
-
-func (t *ScopeType) UnmarshalJSON(b []byte) error {
-	err := t.union.UnmarshalJSON(b)
-	return err
-}
-
-// AsVMInfo returns the union data inside the TargetType as a VMInfo
-func (t TargetType) AsVMInfo() (VMInfo, error) {
-	var body VMInfo
+// AsAzureSubscriptionScope returns the union data inside the ScopeType as a AzureSubscriptionScope
+func (t ScopeType) AsAzureSubscriptionScope() (AzureSubscriptionScope, error) {
+	var body AzureSubscriptionScope
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromVMInfo overwrites any union data inside the TargetType as the provided VMInfo
-func (t *TargetType) FromVMInfo(v VMInfo) error {
-	v.ObjectType = "VMInfo"
+// FromAzureSubscriptionScope overwrites any union data inside the ScopeType as the provided AzureSubscriptionScope
+func (t *ScopeType) FromAzureSubscriptionScope(v AzureSubscriptionScope) error {
+	v.ObjectType = "AzureSubscriptionScope"
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeVMInfo performs a merge with any union data inside the TargetType, using the provided VMInfo
-func (t *TargetType) MergeVMInfo(v VMInfo) error {
-	v.ObjectType = "VMInfo"
+// MergeAzureSubscriptionScope performs a merge with any union data inside the ScopeType, using the provided AzureSubscriptionScope
+func (t *ScopeType) MergeAzureSubscriptionScope(v AzureSubscriptionScope) error {
+	v.ObjectType = "AzureSubscriptionScope"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(b, t.union)
+	merged, err := runtime.JsonMerge(t.union, b)
 	t.union = merged
 	return err
 }
 
-// AsPodInfo returns the union data inside the TargetType as a PodInfo
-func (t TargetType) AsPodInfo() (PodInfo, error) {
-	var body PodInfo
+// AsSSHHostsScope returns the union data inside the ScopeType as a SSHHostsScope
+func (t ScopeType) AsSSHHostsScope() (SSHHostsScope, error) {
+	var body SSHHostsScope
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromPodInfo overwrites any union data inside the TargetType as the provided PodInfo
-func (t *TargetType) FromPodInfo(v PodInfo) error {
-	v.ObjectType = "PodInfo"
+// FromSSHHostsScope overwrites any union data inside the ScopeType as the provided SSHHostsScope
+func (t *ScopeType) FromSSHHostsScope(v SSHHostsScope) error {
+	v.ObjectType = "SSHHostsScope"
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergePodInfo performs a merge with any union data inside the TargetType, using the provided PodInfo
-func (t *TargetType) MergePodInfo(v PodInfo) error {
-	v.ObjectType = "PodInfo"
+// MergeSSHHostsScope performs a merge with any union data inside the ScopeType, using the provided SSHHostsScope
+func (t *ScopeType) MergeSSHHostsScope(v SSHHostsScope) error {
+	v.ObjectType = "SSHHostsScope"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(b, t.union)
+	merged, err := runtime.JsonMerge(t.union, b)
 	t.union = merged
 	return err
 }
 
-// AsDirInfo returns the union data inside the TargetType as a DirInfo
-func (t TargetType) AsDirInfo() (DirInfo, error) {
-	var body DirInfo
+// AsKubernetesClusterScope returns the union data inside the ScopeType as a KubernetesClusterScope
+func (t ScopeType) AsKubernetesClusterScope() (KubernetesClusterScope, error) {
+	var body KubernetesClusterScope
 	err := json.Unmarshal(t.union, &body)
 	return body, err
 }
 
-// FromDirInfo overwrites any union data inside the TargetType as the provided DirInfo
-func (t *TargetType) FromDirInfo(v DirInfo) error {
-	v.ObjectType = "DirInfo"
+// FromKubernetesClusterScope overwrites any union data inside the ScopeType as the provided KubernetesClusterScope
+func (t *ScopeType) FromKubernetesClusterScope(v KubernetesClusterScope) error {
+	v.ObjectType = "KubernetesClusterScope"
 	b, err := json.Marshal(v)
 	t.union = b
 	return err
 }
 
-// MergeDirInfo performs a merge with any union data inside the TargetType, using the provided DirInfo
-func (t *TargetType) MergeDirInfo(v DirInfo) error {
-	v.ObjectType = "DirInfo"
+// MergeKubernetesClusterScope performs a merge with any union data inside the ScopeType, using the provided KubernetesClusterScope
+func (t *ScopeType) MergeKubernetesClusterScope(v KubernetesClusterScope) error {
+	v.ObjectType = "KubernetesClusterScope"
 	b, err := json.Marshal(v)
 	if err != nil {
 		return err
 	}
 
-	merged, err := runtime.JsonMerge(b, t.union)
+	merged, err := runtime.JsonMerge(t.union, b)
 	t.union = merged
 	return err
 }
 
-func (t TargetType) Discriminator() (string, error) {
+func (t ScopeType) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"objectType"`
 	}
@@ -1688,29 +4836,33 @@ func (t TargetType) Discriminator() (string, error) {
 	return discriminator.Discriminator, err
 }
 
-func (t TargetType) ValueByDiscriminator() (interface{}, error) {
+func (t ScopeType) ValueByDiscriminator() (interface{}, error) {
 	discriminator, err := t.Discriminator()
 	if err != nil {
 		return nil, err
 	}
 	switch discriminator {
-	case "DirInfo":
-		return t.AsDirInfo()
-	case "PodInfo":
-		return t.AsPodInfo()
-	case "VMInfo":
-		return t.AsVMInfo()
+	case "AwsAccountScope":
+		return t.AsAwsAccountScope()
+	case "AwsOrganizationScope":
+		return t.AsAwsOrganizationScope()
+	case "AzureSubscriptionScope":
+		return t.AsAzureSubscriptionScope()
+	case "KubernetesClusterScope":
+		return t.AsKubernetesClusterScope()
+	case "SSHHostsScope":
+		return t.AsSSHHostsScope()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
 }
 
-func (t TargetType) MarshalJSON() ([]byte, error) {
+func (t ScopeType) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
 }
 
-func (t *TargetType) UnmarshalJSON(b []byte) error {
+func (t *ScopeType) UnmarshalJSON(b []byte) error {
 	err := t.union.UnmarshalJSON(b)
 	return err
 }
@@ -61,6 +61,11 @@ func (s *Scan) GetTimeoutSeconds() int {
 }
 
 func (s *Scan) IsTimedOut(defaultTimeout time.Duration) bool {
+	return s.IsTimedOutAt(defaultTimeout, time.Now())
+}
+
+// IsTimedOutAt returns whether the Scan is timed out at the given time.
+func (s *Scan) IsTimedOutAt(defaultTimeout time.Duration, now time.Time) bool {
 	if s == nil || s.StartTime == nil {
 		return false
 	}
@@ -72,5 +77,5 @@ func (s *Scan) IsTimedOut(defaultTimeout time.Duration) bool {
 		timeoutTime = s.StartTime.Add(time.Duration(timeoutSeconds) * time.Second)
 	}
 
-	return time.Now().After(timeoutTime)
+	return now.After(timeoutTime)
 }
@@ -42,3 +42,13 @@ func (s *ScanConfigSnapshot) GetTimeoutSeconds() int {
 
 	return timeoutSec
 }
+
+func (s *ScanConfigSnapshot) GetPriority() int {
+	var priority int
+
+	if s.Priority != nil {
+		priority = *s.Priority
+	}
+
+	return priority
+}
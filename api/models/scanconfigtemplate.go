@@ -0,0 +1,91 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+// ApplyTo fills in the settings the snapshot of a scan config doesn't set with
+// the ones of the template. A family configured in the snapshot overrides the
+// same family of the template as a whole.
+func (t *ScanConfigTemplate) ApplyTo(s *ScanConfigSnapshot) {
+	s.ScanConfigTemplate = &ScanConfigTemplateRelationship{
+		Id: *t.Id,
+	}
+
+	s.ScanFamiliesConfig = mergeScanFamiliesConfig(t.ScanFamiliesConfig, s.ScanFamiliesConfig)
+	if s.TimeoutSeconds == nil {
+		s.TimeoutSeconds = t.TimeoutSeconds
+	}
+	if s.MaxParallelScanners == nil {
+		s.MaxParallelScanners = t.MaxParallelScanners
+	}
+	if s.ScannerInstanceCreationConfig == nil {
+		s.ScannerInstanceCreationConfig = t.ScannerInstanceCreationConfig
+	}
+	if s.ScannerPlacement == nil {
+		s.ScannerPlacement = t.ScannerPlacement
+	}
+	if s.VolumeSizeGuardrail == nil {
+		s.VolumeSizeGuardrail = t.VolumeSizeGuardrail
+	}
+	if s.DeltaScanEnabled == nil {
+		s.DeltaScanEnabled = t.DeltaScanEnabled
+	}
+}
+
+// nolint:cyclop
+func mergeScanFamiliesConfig(base, override *ScanFamiliesConfig) *ScanFamiliesConfig {
+	if base == nil {
+		return override
+	}
+	if override == nil {
+		return base
+	}
+
+	merged := *base
+	if override.Certificates != nil {
+		merged.Certificates = override.Certificates
+	}
+	if override.Compliance != nil {
+		merged.Compliance = override.Compliance
+	}
+	if override.ExcludedPaths != nil {
+		merged.ExcludedPaths = override.ExcludedPaths
+	}
+	if override.Exploits != nil {
+		merged.Exploits = override.Exploits
+	}
+	if override.Malware != nil {
+		merged.Malware = override.Malware
+	}
+	if override.Misconfigurations != nil {
+		merged.Misconfigurations = override.Misconfigurations
+	}
+	if override.Plugins != nil {
+		merged.Plugins = override.Plugins
+	}
+	if override.Rootkits != nil {
+		merged.Rootkits = override.Rootkits
+	}
+	if override.Sbom != nil {
+		merged.Sbom = override.Sbom
+	}
+	if override.Secrets != nil {
+		merged.Secrets = override.Secrets
+	}
+	if override.Vulnerabilities != nil {
+		merged.Vulnerabilities = override.Vulnerabilities
+	}
+	return &merged
+}
@@ -15,8 +15,8 @@
 
 package models
 
-func (r *TargetScanResult) GetGeneralState() (TargetScanStateState, bool) {
-	var state TargetScanStateState
+func (r *AssetScanResult) GetGeneralState() (AssetScanStateState, bool) {
+	var state AssetScanStateState
 	var ok bool
 
 	if r.Status != nil {
@@ -26,7 +26,7 @@ func (r *TargetScanResult) GetGeneralState() (TargetScanStateState, bool) {
 	return state, ok
 }
 
-func (r *TargetScanResult) GetGeneralErrors() []string {
+func (r *AssetScanResult) GetGeneralErrors() []string {
 	var errs []string
 
 	if r.Status != nil {
@@ -36,7 +36,7 @@ func (r *TargetScanResult) GetGeneralErrors() []string {
 	return errs
 }
 
-func (r *TargetScanResult) GetID() (string, bool) {
+func (r *AssetScanResult) GetID() (string, bool) {
 	var id string
 	var ok bool
 
@@ -47,7 +47,7 @@ func (r *TargetScanResult) GetID() (string, bool) {
 	return id, ok
 }
 
-func (r *TargetScanResult) GetScanID() (string, bool) {
+func (r *AssetScanResult) GetScanID() (string, bool) {
 	var scanID string
 	var ok bool
 
@@ -58,30 +58,30 @@ func (r *TargetScanResult) GetScanID() (string, bool) {
 	return scanID, ok
 }
 
-func (r *TargetScanResult) GetTargetID() (string, bool) {
+func (r *AssetScanResult) GetAssetID() (string, bool) {
 	var targetID string
 	var ok bool
 
-	if r.Target != nil {
-		targetID, ok = r.Target.Id, true
+	if r.Asset != nil {
+		targetID, ok = r.Asset.Id, true
 	}
 
 	return targetID, ok
 }
 
-func (r *TargetScanResult) IsDone() (bool, bool) {
+func (r *AssetScanResult) IsDone() (bool, bool) {
 	var done bool
 	var ok bool
-	var state TargetScanStateState
+	var state AssetScanStateState
 
-	if state, ok = r.GetGeneralState(); ok && state == TargetScanStateStateDone {
+	if state, ok = r.GetGeneralState(); ok && state == AssetScanStateStateDone {
 		done = true
 	}
 
 	return done, ok
 }
 
-func (r *TargetScanResult) HasErrors() bool {
+func (r *AssetScanResult) HasErrors() bool {
 	var has bool
 
 	if errs := r.GetGeneralErrors(); len(errs) > 0 {
@@ -90,3 +90,47 @@ func (r *TargetScanResult) HasErrors() bool {
 
 	return has
 }
+
+// ScanFamilies are all the scan families.
+var ScanFamilies = []ScanFamily{
+	ScanFamilySbom,
+	ScanFamilyVulnerabilities,
+	ScanFamilyMalware,
+	ScanFamilyRootkits,
+	ScanFamilySecrets,
+	ScanFamilyMisconfigurations,
+	ScanFamilyExploits,
+	ScanFamilyCertificates,
+	ScanFamilyCompliance,
+	ScanFamilyPlugins,
+}
+
+// ScanResultItemsFields returns the JSON fields of AssetScanResult the result
+// of the scan family is stored in and of its list of items, e.g. sboms and
+// packages. It returns false if the scan family is unknown.
+func ScanResultItemsFields(family ScanFamily) (string, string, bool) {
+	switch family {
+	case ScanFamilyCertificates:
+		return "certificates", "certificates", true
+	case ScanFamilyCompliance:
+		return "compliance", "complianceChecks", true
+	case ScanFamilyExploits:
+		return "exploits", "exploits", true
+	case ScanFamilyMalware:
+		return "malware", "malware", true
+	case ScanFamilyMisconfigurations:
+		return "misconfigurations", "misconfigurations", true
+	case ScanFamilyPlugins:
+		return "plugins", "pluginFindings", true
+	case ScanFamilyRootkits:
+		return "rootkits", "rootkits", true
+	case ScanFamilySbom:
+		return "sboms", "packages", true
+	case ScanFamilySecrets:
+		return "secrets", "secrets", true
+	case ScanFamilyVulnerabilities:
+		return "vulnerabilities", "vulnerabilities", true
+	default:
+		return "", "", false
+	}
+}
@@ -15,8 +15,8 @@
 
 package models
 
-func (s *TargetScanStatus) GetGeneralState() (TargetScanStateState, bool) {
-	var state TargetScanStateState
+func (s *AssetScanStatus) GetGeneralState() (AssetScanStateState, bool) {
+	var state AssetScanStateState
 	var ok bool
 
 	if s.General != nil {
@@ -26,7 +26,7 @@ func (s *TargetScanStatus) GetGeneralState() (TargetScanStateState, bool) {
 	return state, ok
 }
 
-func (s *TargetScanStatus) GetGeneralErrors() []string {
+func (s *AssetScanStatus) GetGeneralErrors() []string {
 	var errs []string
 
 	if s.General != nil {
@@ -35,3 +35,63 @@ func (s *TargetScanStatus) GetGeneralErrors() []string {
 
 	return errs
 }
+
+// GetFamilyState returns the state of the given scan family, it is nil if the
+// family state is not set or the scan family is unknown.
+func (s *AssetScanStatus) GetFamilyState(family ScanFamily) *AssetScanState {
+	switch family {
+	case ScanFamilyCertificates:
+		return s.Certificates
+	case ScanFamilyCompliance:
+		return s.Compliance
+	case ScanFamilyExploits:
+		return s.Exploits
+	case ScanFamilyMalware:
+		return s.Malware
+	case ScanFamilyMisconfigurations:
+		return s.Misconfigurations
+	case ScanFamilyPlugins:
+		return s.Plugins
+	case ScanFamilyRootkits:
+		return s.Rootkits
+	case ScanFamilySbom:
+		return s.Sbom
+	case ScanFamilySecrets:
+		return s.Secrets
+	case ScanFamilyVulnerabilities:
+		return s.Vulnerabilities
+	default:
+		return nil
+	}
+}
+
+// SetFamilyState sets the state of the given scan family. It returns false if
+// the scan family is unknown.
+func (s *AssetScanStatus) SetFamilyState(family ScanFamily, state *AssetScanState) bool {
+	switch family {
+	case ScanFamilyCertificates:
+		s.Certificates = state
+	case ScanFamilyCompliance:
+		s.Compliance = state
+	case ScanFamilyExploits:
+		s.Exploits = state
+	case ScanFamilyMalware:
+		s.Malware = state
+	case ScanFamilyMisconfigurations:
+		s.Misconfigurations = state
+	case ScanFamilyPlugins:
+		s.Plugins = state
+	case ScanFamilyRootkits:
+		s.Rootkits = state
+	case ScanFamilySbom:
+		s.Sbom = state
+	case ScanFamilySecrets:
+		s.Secrets = state
+	case ScanFamilyVulnerabilities:
+		s.Vulnerabilities = state
+	default:
+		return false
+	}
+
+	return true
+}
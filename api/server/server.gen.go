@@ -21,18 +21,145 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Get the vulnerability, exploit and malware feeds mirrored by the backend.
+	// (GET /admin/feeds)
+	GetAdminFeeds(ctx echo.Context) error
+	// Sync the feeds now.
+	// (POST /admin/feeds/refresh)
+	PostAdminFeedsRefresh(ctx echo.Context) error
+	// Get the maintenance mode of the backend.
+	// (GET /admin/maintenance)
+	GetAdminMaintenance(ctx echo.Context) error
+	// Enter or leave the maintenance mode of the backend.
+	// (PUT /admin/maintenance)
+	PutAdminMaintenance(ctx echo.Context) error
+	// Get the background tasks of the backend and their last run.
+	// (GET /admin/tasks)
+	GetAdminTasks(ctx echo.Context) error
+	// Run a background task now.
+	// (POST /admin/tasks/{taskName}/run)
+	PostAdminTasksTaskNameRun(ctx echo.Context, taskName TaskName) error
+	// Get the usage of the deployment and the configured limits.
+	// (GET /admin/usage)
+	GetAdminUsage(ctx echo.Context, params GetAdminUsageParams) error
+	// Get all API keys.
+	// (GET /apiKeys)
+	GetAPIKeys(ctx echo.Context, params GetAPIKeysParams) error
+	// Create an API key.
+	// (POST /apiKeys)
+	PostAPIKeys(ctx echo.Context) error
+	// Delete an API key, which revokes it.
+	// (DELETE /apiKeys/{apiKeyID})
+	DeleteAPIKeysAPIKeyID(ctx echo.Context, apiKeyID ApiKeyID) error
+	// Get the details for an API key.
+	// (GET /apiKeys/{apiKeyID})
+	GetAPIKeysAPIKeyID(ctx echo.Context, apiKeyID ApiKeyID, params GetAPIKeysAPIKeyIDParams) error
+	// Get all asset groups.
+	// (GET /assetGroups)
+	GetAssetGroups(ctx echo.Context, params GetAssetGroupsParams) error
+	// Create an asset group.
+	// (POST /assetGroups)
+	PostAssetGroups(ctx echo.Context) error
+	// Delete an asset group. A group referenced by a scan config or an API
+	// key can not be deleted.
+	// (DELETE /assetGroups/{assetGroupID})
+	DeleteAssetGroupsAssetGroupID(ctx echo.Context, assetGroupID AssetGroupID) error
+	// Get the details for an asset group.
+	// (GET /assetGroups/{assetGroupID})
+	GetAssetGroupsAssetGroupID(ctx echo.Context, assetGroupID AssetGroupID, params GetAssetGroupsAssetGroupIDParams) error
+	// Patch an asset group.
+	// (PATCH /assetGroups/{assetGroupID})
+	PatchAssetGroupsAssetGroupID(ctx echo.Context, assetGroupID AssetGroupID, params PatchAssetGroupsAssetGroupIDParams) error
+	// Get the assets which are currently members of an asset group.
+	// (GET /assetGroups/{assetGroupID}/assets)
+	GetAssetGroupsAssetGroupIDAssets(ctx echo.Context, assetGroupID AssetGroupID, params GetAssetGroupsAssetGroupIDAssetsParams) error
+	// Get assets
+	// (GET /assets)
+	GetAssets(ctx echo.Context, params GetAssetsParams) error
+	// Create asset
+	// (POST /assets)
+	PostAssets(ctx echo.Context) error
+	// Delete asset.
+	// (DELETE /assets/{assetID})
+	DeleteAssetsAssetID(ctx echo.Context, assetID AssetID) error
+	// Get asset.
+	// (GET /assets/{assetID})
+	GetAssetsAssetID(ctx echo.Context, assetID AssetID, params GetAssetsAssetIDParams) error
+	// Update asset.
+	// (PATCH /assets/{assetID})
+	PatchAssetsAssetID(ctx echo.Context, assetID AssetID, params PatchAssetsAssetIDParams) error
+	// Update asset.
+	// (PUT /assets/{assetID})
+	PutAssetsAssetID(ctx echo.Context, assetID AssetID, params PutAssetsAssetIDParams) error
+	// Get the installed package inventory of a asset.
+	// (GET /assets/{assetID}/packages)
+	GetAssetsAssetIDPackages(ctx echo.Context, assetID AssetID) error
+	// Get the differences between the packages of two scans of a asset.
+	// (GET /assets/{assetID}/sbomDiff)
+	GetAssetsAssetIDSbomDiff(ctx echo.Context, assetID AssetID, params GetAssetsAssetIDSbomDiffParams) error
+	// Get the differences between the scan results of two scans of a asset.
+	// (GET /assets/{assetID}/scanResultDiff)
+	GetAssetsAssetIDScanResultDiff(ctx echo.Context, assetID AssetID, params GetAssetsAssetIDScanResultDiffParams) error
+	// Get the package upgrade plan for a asset.
+	// (GET /assets/{assetID}/upgradePlan)
+	GetAssetsAssetIDUpgradePlan(ctx echo.Context, assetID AssetID, params GetAssetsAssetIDUpgradePlanParams) error
+	// Get all correlated findings.
+	// (GET /correlatedFindings)
+	GetCorrelatedFindings(ctx echo.Context, params GetCorrelatedFindingsParams) error
+	// Get the details for a correlated finding.
+	// (GET /correlatedFindings/{correlatedFindingID})
+	GetCorrelatedFindingsCorrelatedFindingID(ctx echo.Context, correlatedFindingID CorrelatedFindingID, params GetCorrelatedFindingsCorrelatedFindingIDParams) error
 	// Get all available scopes
 	// (GET /discovery/scopes)
 	GetDiscoveryScopes(ctx echo.Context, params GetDiscoveryScopesParams) error
 	// Set all available scopes
 	// (PUT /discovery/scopes)
 	PutDiscoveryScopes(ctx echo.Context) error
+	// Download a feed mirrored by the backend.
+	// (GET /feeds/{feedName})
+	GetFeedsFeedName(ctx echo.Context, feedName FeedName, params GetFeedsFeedNameParams) error
+	// Get all finding digests.
+	// (GET /findingDigests)
+	GetFindingDigests(ctx echo.Context, params GetFindingDigestsParams) error
+	// Create a finding digest.
+	// (POST /findingDigests)
+	PostFindingDigests(ctx echo.Context) error
+	// Delete a finding digest.
+	// (DELETE /findingDigests/{findingDigestID})
+	DeleteFindingDigestsFindingDigestID(ctx echo.Context, findingDigestID FindingDigestID) error
+	// Get the details for a finding digest.
+	// (GET /findingDigests/{findingDigestID})
+	GetFindingDigestsFindingDigestID(ctx echo.Context, findingDigestID FindingDigestID, params GetFindingDigestsFindingDigestIDParams) error
+	// Patch a finding digest.
+	// (PATCH /findingDigests/{findingDigestID})
+	PatchFindingDigestsFindingDigestID(ctx echo.Context, findingDigestID FindingDigestID, params PatchFindingDigestsFindingDigestIDParams) error
+	// Get all finding exceptions.
+	// (GET /findingExceptions)
+	GetFindingExceptions(ctx echo.Context, params GetFindingExceptionsParams) error
+	// Create a finding exception.
+	// (POST /findingExceptions)
+	PostFindingExceptions(ctx echo.Context) error
+	// Delete a finding exception.
+	// (DELETE /findingExceptions/{findingExceptionID})
+	DeleteFindingExceptionsFindingExceptionID(ctx echo.Context, findingExceptionID FindingExceptionID) error
+	// Get the details for a finding exception.
+	// (GET /findingExceptions/{findingExceptionID})
+	GetFindingExceptionsFindingExceptionID(ctx echo.Context, findingExceptionID FindingExceptionID, params GetFindingExceptionsFindingExceptionIDParams) error
+	// Patch a finding exception.
+	// (PATCH /findingExceptions/{findingExceptionID})
+	PatchFindingExceptionsFindingExceptionID(ctx echo.Context, findingExceptionID FindingExceptionID, params PatchFindingExceptionsFindingExceptionIDParams) error
 	// Get all findings.
 	// (GET /findings)
 	GetFindings(ctx echo.Context, params GetFindingsParams) error
 	// Create a finding
 	// (POST /findings)
 	PostFindings(ctx echo.Context) error
+	// Patch findings in bulk
+	// (PATCH /findings/bulk)
+	PatchFindingsBulk(ctx echo.Context) error
+	// Create findings in bulk
+	// (POST /findings/bulk)
+	PostFindingsBulk(ctx echo.Context) error
 	// Delete a finding.
 	// (DELETE /findings/{findingID})
 	DeleteFindingsFindingID(ctx echo.Context, findingID FindingID) error
@@ -45,6 +172,83 @@ type ServerInterface interface {
 	// Update a finding.
 	// (PUT /findings/{findingID})
 	PutFindingsFindingID(ctx echo.Context, findingID FindingID) error
+	// Suppress a false positive finding in the scanner.
+	// (POST /findings/{findingID}/scannerSuppression)
+	PostFindingsFindingIDScannerSuppression(ctx echo.Context, findingID FindingID) error
+	// Get all notification configs.
+	// (GET /notificationConfigs)
+	GetNotificationConfigs(ctx echo.Context, params GetNotificationConfigsParams) error
+	// Create a notification config.
+	// (POST /notificationConfigs)
+	PostNotificationConfigs(ctx echo.Context) error
+	// Delete a notification config.
+	// (DELETE /notificationConfigs/{notificationConfigID})
+	DeleteNotificationConfigsNotificationConfigID(ctx echo.Context, notificationConfigID NotificationConfigID) error
+	// Get the details for a notification config.
+	// (GET /notificationConfigs/{notificationConfigID})
+	GetNotificationConfigsNotificationConfigID(ctx echo.Context, notificationConfigID NotificationConfigID, params GetNotificationConfigsNotificationConfigIDParams) error
+	// Patch a notification config.
+	// (PATCH /notificationConfigs/{notificationConfigID})
+	PatchNotificationConfigsNotificationConfigID(ctx echo.Context, notificationConfigID NotificationConfigID, params PatchNotificationConfigsNotificationConfigIDParams) error
+	// Get the status of an asynchronous operation.
+	// (GET /operations/{operationID})
+	GetOperationsOperationID(ctx echo.Context, operationID OperationID) error
+	// Get the result of a succeeded asynchronous operation.
+	// (GET /operations/{operationID}/result)
+	GetOperationsOperationIDResult(ctx echo.Context, operationID OperationID) error
+	// Get the security posture scores of the teams.
+	// (GET /postureScores)
+	GetPostureScores(ctx echo.Context, params GetPostureScoresParams) error
+	// Get all provider operations.
+	// (GET /providerOperations)
+	GetProviderOperations(ctx echo.Context, params GetProviderOperationsParams) error
+	// Record a provider operation.
+	// (POST /providerOperations)
+	PostProviderOperations(ctx echo.Context) error
+	// Get the details for a provider operation.
+	// (GET /providerOperations/{providerOperationID})
+	GetProviderOperationsProviderOperationID(ctx echo.Context, providerOperationID ProviderOperationID, params GetProviderOperationsProviderOperationIDParams) error
+	// Get the configured providers and their capabilities.
+	// (GET /providers)
+	GetProviders(ctx echo.Context) error
+	// Get all report schedules.
+	// (GET /reportSchedules)
+	GetReportSchedules(ctx echo.Context, params GetReportSchedulesParams) error
+	// Create a report schedule.
+	// (POST /reportSchedules)
+	PostReportSchedules(ctx echo.Context) error
+	// Delete a report schedule.
+	// (DELETE /reportSchedules/{reportScheduleID})
+	DeleteReportSchedulesReportScheduleID(ctx echo.Context, reportScheduleID ReportScheduleID) error
+	// Get the details for a report schedule.
+	// (GET /reportSchedules/{reportScheduleID})
+	GetReportSchedulesReportScheduleID(ctx echo.Context, reportScheduleID ReportScheduleID, params GetReportSchedulesReportScheduleIDParams) error
+	// Patch a report schedule.
+	// (PATCH /reportSchedules/{reportScheduleID})
+	PatchReportSchedulesReportScheduleID(ctx echo.Context, reportScheduleID ReportScheduleID, params PatchReportSchedulesReportScheduleIDParams) error
+	// Get the last delivered report of a report schedule.
+	// (GET /reportSchedules/{reportScheduleID}/report)
+	GetReportSchedulesReportScheduleIDReport(ctx echo.Context, reportScheduleID ReportScheduleID) error
+	// Upload an SBOM to be scanned for vulnerabilities.
+	// (POST /sboms)
+	PostSboms(ctx echo.Context, params PostSbomsParams) error
+	// Get all scan config templates.
+	// (GET /scanConfigTemplates)
+	GetScanConfigTemplates(ctx echo.Context, params GetScanConfigTemplatesParams) error
+	// Create a scan config template.
+	// (POST /scanConfigTemplates)
+	PostScanConfigTemplates(ctx echo.Context) error
+	// Delete a scan config template. A template referenced by a scan config
+	// can not be deleted.
+	// (DELETE /scanConfigTemplates/{scanConfigTemplateID})
+	DeleteScanConfigTemplatesScanConfigTemplateID(ctx echo.Context, scanConfigTemplateID ScanConfigTemplateID) error
+	// Get the details for a scan config template.
+	// (GET /scanConfigTemplates/{scanConfigTemplateID})
+	GetScanConfigTemplatesScanConfigTemplateID(ctx echo.Context, scanConfigTemplateID ScanConfigTemplateID, params GetScanConfigTemplatesScanConfigTemplateIDParams) error
+	// Patch a scan config template. The changes apply to the scans started
+	// afterwards from the scan configs referencing it.
+	// (PATCH /scanConfigTemplates/{scanConfigTemplateID})
+	PatchScanConfigTemplatesScanConfigTemplateID(ctx echo.Context, scanConfigTemplateID ScanConfigTemplateID, params PatchScanConfigTemplatesScanConfigTemplateIDParams) error
 	// Get all scan configs.
 	// (GET /scanConfigs)
 	GetScanConfigs(ctx echo.Context, params GetScanConfigsParams) error
@@ -63,6 +267,9 @@ type ServerInterface interface {
 	// Update a scan config.
 	// (PUT /scanConfigs/{scanConfigID})
 	PutScanConfigsScanConfigID(ctx echo.Context, scanConfigID ScanConfigID, params PutScanConfigsScanConfigIDParams) error
+	// Get the next scheduled run times of a scan config.
+	// (GET /scanConfigs/{scanConfigID}/nextRuns)
+	GetScanConfigsScanConfigIDNextRuns(ctx echo.Context, scanConfigID ScanConfigID, params GetScanConfigsScanConfigIDNextRunsParams) error
 	// Get scan results according to the given filters
 	// (GET /scanResults)
 	GetScanResults(ctx echo.Context, params GetScanResultsParams) error
@@ -78,6 +285,30 @@ type ServerInterface interface {
 	// Update a scan result.
 	// (PUT /scanResults/{scanResultID})
 	PutScanResultsScanResultID(ctx echo.Context, scanResultID ScanResultID, params PutScanResultsScanResultIDParams) error
+	// Download the raw output of a scan family.
+	// (GET /scanResults/{scanResultID}/families/{family}/artifact)
+	GetScanResultsScanResultIDFamiliesFamilyArtifact(ctx echo.Context, scanResultID ScanResultID, family ScanResultFamily) error
+	// Upload the raw output of a scan family.
+	// (PUT /scanResults/{scanResultID}/families/{family}/artifact)
+	PutScanResultsScanResultIDFamiliesFamilyArtifact(ctx echo.Context, scanResultID ScanResultID, family ScanResultFamily) error
+	// Get a page of the result items of a scan family.
+	// (GET /scanResults/{scanResultID}/families/{family}/items)
+	GetScanResultsScanResultIDFamiliesFamilyItems(ctx echo.Context, scanResultID ScanResultID, family ScanResultFamily, params GetScanResultsScanResultIDFamiliesFamilyItemsParams) error
+	// Upload a page of the result items of a scan family.
+	// (POST /scanResults/{scanResultID}/families/{family}/items)
+	PostScanResultsScanResultIDFamiliesFamilyItems(ctx echo.Context, scanResultID ScanResultID, family ScanResultFamily) error
+	// Re-run a subset of the scan families for a scan result.
+	// (POST /scanResults/{scanResultID}/rerun)
+	PostScanResultsScanResultIDRerun(ctx echo.Context, scanResultID ScanResultID, params PostScanResultsScanResultIDRerunParams) error
+	// Export the SBOM of a scan result.
+	// (GET /scanResults/{scanResultID}/sbom)
+	GetScanResultsScanResultIDSbom(ctx echo.Context, scanResultID ScanResultID, params GetScanResultsScanResultIDSbomParams) error
+	// Get the configuration of the scanner of a scan result.
+	// (GET /scanResults/{scanResultID}/scannerConfig)
+	GetScanResultsScanResultIDScannerConfig(ctx echo.Context, scanResultID ScanResultID) error
+	// Set the configuration of the scanner of a scan result.
+	// (PUT /scanResults/{scanResultID}/scannerConfig)
+	PutScanResultsScanResultIDScannerConfig(ctx echo.Context, scanResultID ScanResultID) error
 	// Get all scans. Each scan contains details about a multi-target scheduled scan.
 	// (GET /scans)
 	GetScans(ctx echo.Context, params GetScansParams) error
@@ -96,24 +327,30 @@ type ServerInterface interface {
 	// Update a scan.
 	// (PUT /scans/{scanID})
 	PutScansScanID(ctx echo.Context, scanID ScanID, params PutScansScanIDParams) error
-	// Get targets
-	// (GET /targets)
-	GetTargets(ctx echo.Context, params GetTargetsParams) error
-	// Create target
-	// (POST /targets)
-	PostTargets(ctx echo.Context) error
-	// Delete target.
-	// (DELETE /targets/{targetID})
-	DeleteTargetsTargetID(ctx echo.Context, targetID TargetID) error
-	// Get target.
-	// (GET /targets/{targetID})
-	GetTargetsTargetID(ctx echo.Context, targetID TargetID, params GetTargetsTargetIDParams) error
-	// Update target.
-	// (PATCH /targets/{targetID})
-	PatchTargetsTargetID(ctx echo.Context, targetID TargetID, params PatchTargetsTargetIDParams) error
-	// Update target.
-	// (PUT /targets/{targetID})
-	PutTargetsTargetID(ctx echo.Context, targetID TargetID, params PutTargetsTargetIDParams) error
+	// Get the progress of a scan.
+	// (GET /scans/{scanID}/progress)
+	GetScansScanIDProgress(ctx echo.Context, scanID ScanID) error
+	// Get the templates findings are rendered with in the notifications and the finding digests.
+	// (GET /settings/findingTemplates)
+	GetSettingsFindingTemplates(ctx echo.Context) error
+	// Set the templates findings are rendered with in the notifications and the finding digests.
+	// (PUT /settings/findingTemplates)
+	PutSettingsFindingTemplates(ctx echo.Context) error
+	// Render a finding with the finding templates.
+	// (POST /settings/findingTemplates/preview)
+	PostSettingsFindingTemplatesPreview(ctx echo.Context) error
+	// Get the retention settings of the scan history.
+	// (GET /settings/retention)
+	GetSettingsRetention(ctx echo.Context) error
+	// Set the retention settings of the scan history.
+	// (PUT /settings/retention)
+	PutSettingsRetention(ctx echo.Context) error
+	// Get the preferences of the authenticated user.
+	// (GET /userPreferences)
+	GetUserPreferences(ctx echo.Context) error
+	// Set the preferences of the authenticated user.
+	// (PUT /userPreferences)
+	PutUserPreferences(ctx echo.Context) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -121,12 +358,1671 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetAdminFeeds converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminFeeds(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAdminFeeds(ctx)
+	return err
+}
+
+// PostAdminFeedsRefresh converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminFeedsRefresh(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostAdminFeedsRefresh(ctx)
+	return err
+}
+
+// GetAdminMaintenance converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminMaintenance(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAdminMaintenance(ctx)
+	return err
+}
+
+// PutAdminMaintenance converts echo context to params.
+func (w *ServerInterfaceWrapper) PutAdminMaintenance(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PutAdminMaintenance(ctx)
+	return err
+}
+
+// GetAdminTasks converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminTasks(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAdminTasks(ctx)
+	return err
+}
+
+// PostAdminTasksTaskNameRun converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminTasksTaskNameRun(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "taskName" -------------
+	var taskName TaskName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "taskName", runtime.ParamLocationPath, ctx.Param("taskName"), &taskName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter taskName: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostAdminTasksTaskNameRun(ctx, taskName)
+	return err
+}
+
+// GetAdminUsage converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminUsage(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminUsageParams
+	// ------------- Optional query parameter "async" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "async", ctx.QueryParams(), &params.Async)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter async: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAdminUsage(ctx, params)
+	return err
+}
+
+// GetAPIKeys converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAPIKeys(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAPIKeysParams
+	// ------------- Optional query parameter "$filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "$select" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+	}
+
+	// ------------- Optional query parameter "$count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$count", ctx.QueryParams(), &params.Count)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $count: %s", err))
+	}
+
+	// ------------- Optional query parameter "$top" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$top", ctx.QueryParams(), &params.Top)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $top: %s", err))
+	}
+
+	// ------------- Optional query parameter "$skip" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$skip", ctx.QueryParams(), &params.Skip)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $skip: %s", err))
+	}
+
+	// ------------- Optional query parameter "$orderby" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$orderby", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $orderby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAPIKeys(ctx, params)
+	return err
+}
+
+// PostAPIKeys converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAPIKeys(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostAPIKeys(ctx)
+	return err
+}
+
+// DeleteAPIKeysAPIKeyID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAPIKeysAPIKeyID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "apiKeyID" -------------
+	var apiKeyID ApiKeyID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "apiKeyID", runtime.ParamLocationPath, ctx.Param("apiKeyID"), &apiKeyID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter apiKeyID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteAPIKeysAPIKeyID(ctx, apiKeyID)
+	return err
+}
+
+// GetAPIKeysAPIKeyID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAPIKeysAPIKeyID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "apiKeyID" -------------
+	var apiKeyID ApiKeyID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "apiKeyID", runtime.ParamLocationPath, ctx.Param("apiKeyID"), &apiKeyID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter apiKeyID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAPIKeysAPIKeyIDParams
+	// ------------- Optional query parameter "$select" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAPIKeysAPIKeyID(ctx, apiKeyID, params)
+	return err
+}
+
+// GetAssetGroups converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAssetGroups(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAssetGroupsParams
+	// ------------- Optional query parameter "$filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "$select" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+	}
+
+	// ------------- Optional query parameter "$count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$count", ctx.QueryParams(), &params.Count)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $count: %s", err))
+	}
+
+	// ------------- Optional query parameter "$top" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$top", ctx.QueryParams(), &params.Top)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $top: %s", err))
+	}
+
+	// ------------- Optional query parameter "$skip" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$skip", ctx.QueryParams(), &params.Skip)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $skip: %s", err))
+	}
+
+	// ------------- Optional query parameter "$orderby" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$orderby", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $orderby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAssetGroups(ctx, params)
+	return err
+}
+
+// PostAssetGroups converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAssetGroups(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostAssetGroups(ctx)
+	return err
+}
+
+// DeleteAssetGroupsAssetGroupID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAssetGroupsAssetGroupID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "assetGroupID" -------------
+	var assetGroupID AssetGroupID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "assetGroupID", runtime.ParamLocationPath, ctx.Param("assetGroupID"), &assetGroupID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assetGroupID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteAssetGroupsAssetGroupID(ctx, assetGroupID)
+	return err
+}
+
+// GetAssetGroupsAssetGroupID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAssetGroupsAssetGroupID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "assetGroupID" -------------
+	var assetGroupID AssetGroupID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "assetGroupID", runtime.ParamLocationPath, ctx.Param("assetGroupID"), &assetGroupID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assetGroupID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAssetGroupsAssetGroupIDParams
+	// ------------- Optional query parameter "$select" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAssetGroupsAssetGroupID(ctx, assetGroupID, params)
+	return err
+}
+
+// PatchAssetGroupsAssetGroupID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchAssetGroupsAssetGroupID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "assetGroupID" -------------
+	var assetGroupID AssetGroupID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "assetGroupID", runtime.ParamLocationPath, ctx.Param("assetGroupID"), &assetGroupID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assetGroupID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchAssetGroupsAssetGroupIDParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch Ifmatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PatchAssetGroupsAssetGroupID(ctx, assetGroupID, params)
+	return err
+}
+
+// GetAssetGroupsAssetGroupIDAssets converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAssetGroupsAssetGroupIDAssets(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "assetGroupID" -------------
+	var assetGroupID AssetGroupID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "assetGroupID", runtime.ParamLocationPath, ctx.Param("assetGroupID"), &assetGroupID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assetGroupID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAssetGroupsAssetGroupIDAssetsParams
+	// ------------- Optional query parameter "$filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "$select" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+	}
+
+	// ------------- Optional query parameter "$count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$count", ctx.QueryParams(), &params.Count)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $count: %s", err))
+	}
+
+	// ------------- Optional query parameter "$top" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$top", ctx.QueryParams(), &params.Top)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $top: %s", err))
+	}
+
+	// ------------- Optional query parameter "$skip" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$skip", ctx.QueryParams(), &params.Skip)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $skip: %s", err))
+	}
+
+	// ------------- Optional query parameter "$orderby" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$orderby", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $orderby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAssetGroupsAssetGroupIDAssets(ctx, assetGroupID, params)
+	return err
+}
+
+// GetAssets converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAssets(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAssetsParams
+	// ------------- Optional query parameter "$filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "$search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$search", ctx.QueryParams(), &params.Search)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $search: %s", err))
+	}
+
+	// ------------- Optional query parameter "$select" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+	}
+
+	// ------------- Optional query parameter "$count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$count", ctx.QueryParams(), &params.Count)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $count: %s", err))
+	}
+
+	// ------------- Optional query parameter "$top" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$top", ctx.QueryParams(), &params.Top)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $top: %s", err))
+	}
+
+	// ------------- Optional query parameter "$skip" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$skip", ctx.QueryParams(), &params.Skip)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $skip: %s", err))
+	}
+
+	// ------------- Optional query parameter "$expand" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$expand", ctx.QueryParams(), &params.Expand)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $expand: %s", err))
+	}
+
+	// ------------- Optional query parameter "$orderby" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$orderby", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $orderby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAssets(ctx, params)
+	return err
+}
+
+// PostAssets converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAssets(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostAssets(ctx)
+	return err
+}
+
+// DeleteAssetsAssetID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAssetsAssetID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "assetID" -------------
+	var assetID AssetID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "assetID", runtime.ParamLocationPath, ctx.Param("assetID"), &assetID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assetID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteAssetsAssetID(ctx, assetID)
+	return err
+}
+
+// GetAssetsAssetID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAssetsAssetID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "assetID" -------------
+	var assetID AssetID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "assetID", runtime.ParamLocationPath, ctx.Param("assetID"), &assetID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assetID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAssetsAssetIDParams
+	// ------------- Optional query parameter "$select" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+	}
+
+	// ------------- Optional query parameter "$expand" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$expand", ctx.QueryParams(), &params.Expand)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $expand: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-None-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-None-Match: %s", err))
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAssetsAssetID(ctx, assetID, params)
+	return err
+}
+
+// PatchAssetsAssetID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchAssetsAssetID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "assetID" -------------
+	var assetID AssetID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "assetID", runtime.ParamLocationPath, ctx.Param("assetID"), &assetID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assetID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchAssetsAssetIDParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch Ifmatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PatchAssetsAssetID(ctx, assetID, params)
+	return err
+}
+
+// PutAssetsAssetID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutAssetsAssetID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "assetID" -------------
+	var assetID AssetID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "assetID", runtime.ParamLocationPath, ctx.Param("assetID"), &assetID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assetID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutAssetsAssetIDParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch Ifmatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PutAssetsAssetID(ctx, assetID, params)
+	return err
+}
+
+// GetAssetsAssetIDPackages converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAssetsAssetIDPackages(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "assetID" -------------
+	var assetID AssetID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "assetID", runtime.ParamLocationPath, ctx.Param("assetID"), &assetID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assetID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAssetsAssetIDPackages(ctx, assetID)
+	return err
+}
+
+// GetAssetsAssetIDSbomDiff converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAssetsAssetIDSbomDiff(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "assetID" -------------
+	var assetID AssetID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "assetID", runtime.ParamLocationPath, ctx.Param("assetID"), &assetID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assetID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAssetsAssetIDSbomDiffParams
+	// ------------- Required query parameter "base" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "base", ctx.QueryParams(), &params.Base)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter base: %s", err))
+	}
+
+	// ------------- Required query parameter "head" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "head", ctx.QueryParams(), &params.Head)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter head: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAssetsAssetIDSbomDiff(ctx, assetID, params)
+	return err
+}
+
+// GetAssetsAssetIDScanResultDiff converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAssetsAssetIDScanResultDiff(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "assetID" -------------
+	var assetID AssetID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "assetID", runtime.ParamLocationPath, ctx.Param("assetID"), &assetID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assetID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAssetsAssetIDScanResultDiffParams
+	// ------------- Required query parameter "baseScanId" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "baseScanId", ctx.QueryParams(), &params.BaseScanId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter baseScanId: %s", err))
+	}
+
+	// ------------- Required query parameter "compareScanId" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "compareScanId", ctx.QueryParams(), &params.CompareScanId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter compareScanId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAssetsAssetIDScanResultDiff(ctx, assetID, params)
+	return err
+}
+
+// GetAssetsAssetIDUpgradePlan converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAssetsAssetIDUpgradePlan(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "assetID" -------------
+	var assetID AssetID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "assetID", runtime.ParamLocationPath, ctx.Param("assetID"), &assetID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assetID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAssetsAssetIDUpgradePlanParams
+	// ------------- Optional query parameter "async" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "async", ctx.QueryParams(), &params.Async)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter async: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAssetsAssetIDUpgradePlan(ctx, assetID, params)
+	return err
+}
+
+// GetCorrelatedFindings converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCorrelatedFindings(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCorrelatedFindingsParams
+	// ------------- Optional query parameter "$filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "$select" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+	}
+
+	// ------------- Optional query parameter "$count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$count", ctx.QueryParams(), &params.Count)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $count: %s", err))
+	}
+
+	// ------------- Optional query parameter "$top" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$top", ctx.QueryParams(), &params.Top)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $top: %s", err))
+	}
+
+	// ------------- Optional query parameter "$skip" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$skip", ctx.QueryParams(), &params.Skip)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $skip: %s", err))
+	}
+
+	// ------------- Optional query parameter "$expand" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$expand", ctx.QueryParams(), &params.Expand)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $expand: %s", err))
+	}
+
+	// ------------- Optional query parameter "$orderby" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$orderby", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $orderby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetCorrelatedFindings(ctx, params)
+	return err
+}
+
+// GetCorrelatedFindingsCorrelatedFindingID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCorrelatedFindingsCorrelatedFindingID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "correlatedFindingID" -------------
+	var correlatedFindingID CorrelatedFindingID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "correlatedFindingID", runtime.ParamLocationPath, ctx.Param("correlatedFindingID"), &correlatedFindingID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter correlatedFindingID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCorrelatedFindingsCorrelatedFindingIDParams
+	// ------------- Optional query parameter "$select" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+	}
+
+	// ------------- Optional query parameter "$expand" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$expand", ctx.QueryParams(), &params.Expand)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $expand: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetCorrelatedFindingsCorrelatedFindingID(ctx, correlatedFindingID, params)
+	return err
+}
+
 // GetDiscoveryScopes converts echo context to params.
 func (w *ServerInterfaceWrapper) GetDiscoveryScopes(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetDiscoveryScopesParams
+	var params GetDiscoveryScopesParams
+	// ------------- Optional query parameter "$filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "$select" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+	}
+
+	// ------------- Optional query parameter "$orderby" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$orderby", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $orderby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetDiscoveryScopes(ctx, params)
+	return err
+}
+
+// PutDiscoveryScopes converts echo context to params.
+func (w *ServerInterfaceWrapper) PutDiscoveryScopes(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PutDiscoveryScopes(ctx)
+	return err
+}
+
+// GetFeedsFeedName converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFeedsFeedName(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "feedName" -------------
+	var feedName FeedName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "feedName", runtime.ParamLocationPath, ctx.Param("feedName"), &feedName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter feedName: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFeedsFeedNameParams
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", ctx.QueryParams(), &params.Version)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetFeedsFeedName(ctx, feedName, params)
+	return err
+}
+
+// GetFindingDigests converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFindingDigests(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFindingDigestsParams
+	// ------------- Optional query parameter "$filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "$select" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+	}
+
+	// ------------- Optional query parameter "$count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$count", ctx.QueryParams(), &params.Count)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $count: %s", err))
+	}
+
+	// ------------- Optional query parameter "$top" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$top", ctx.QueryParams(), &params.Top)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $top: %s", err))
+	}
+
+	// ------------- Optional query parameter "$skip" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$skip", ctx.QueryParams(), &params.Skip)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $skip: %s", err))
+	}
+
+	// ------------- Optional query parameter "$orderby" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$orderby", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $orderby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetFindingDigests(ctx, params)
+	return err
+}
+
+// PostFindingDigests converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFindingDigests(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostFindingDigests(ctx)
+	return err
+}
+
+// DeleteFindingDigestsFindingDigestID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteFindingDigestsFindingDigestID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "findingDigestID" -------------
+	var findingDigestID FindingDigestID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "findingDigestID", runtime.ParamLocationPath, ctx.Param("findingDigestID"), &findingDigestID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter findingDigestID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteFindingDigestsFindingDigestID(ctx, findingDigestID)
+	return err
+}
+
+// GetFindingDigestsFindingDigestID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFindingDigestsFindingDigestID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "findingDigestID" -------------
+	var findingDigestID FindingDigestID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "findingDigestID", runtime.ParamLocationPath, ctx.Param("findingDigestID"), &findingDigestID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter findingDigestID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFindingDigestsFindingDigestIDParams
+	// ------------- Optional query parameter "$select" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetFindingDigestsFindingDigestID(ctx, findingDigestID, params)
+	return err
+}
+
+// PatchFindingDigestsFindingDigestID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchFindingDigestsFindingDigestID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "findingDigestID" -------------
+	var findingDigestID FindingDigestID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "findingDigestID", runtime.ParamLocationPath, ctx.Param("findingDigestID"), &findingDigestID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter findingDigestID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchFindingDigestsFindingDigestIDParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch Ifmatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PatchFindingDigestsFindingDigestID(ctx, findingDigestID, params)
+	return err
+}
+
+// GetFindingExceptions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFindingExceptions(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFindingExceptionsParams
+	// ------------- Optional query parameter "$filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "$select" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+	}
+
+	// ------------- Optional query parameter "$count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$count", ctx.QueryParams(), &params.Count)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $count: %s", err))
+	}
+
+	// ------------- Optional query parameter "$top" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$top", ctx.QueryParams(), &params.Top)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $top: %s", err))
+	}
+
+	// ------------- Optional query parameter "$skip" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$skip", ctx.QueryParams(), &params.Skip)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $skip: %s", err))
+	}
+
+	// ------------- Optional query parameter "$orderby" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$orderby", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $orderby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetFindingExceptions(ctx, params)
+	return err
+}
+
+// PostFindingExceptions converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFindingExceptions(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostFindingExceptions(ctx)
+	return err
+}
+
+// DeleteFindingExceptionsFindingExceptionID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteFindingExceptionsFindingExceptionID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "findingExceptionID" -------------
+	var findingExceptionID FindingExceptionID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "findingExceptionID", runtime.ParamLocationPath, ctx.Param("findingExceptionID"), &findingExceptionID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter findingExceptionID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteFindingExceptionsFindingExceptionID(ctx, findingExceptionID)
+	return err
+}
+
+// GetFindingExceptionsFindingExceptionID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFindingExceptionsFindingExceptionID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "findingExceptionID" -------------
+	var findingExceptionID FindingExceptionID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "findingExceptionID", runtime.ParamLocationPath, ctx.Param("findingExceptionID"), &findingExceptionID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter findingExceptionID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFindingExceptionsFindingExceptionIDParams
+	// ------------- Optional query parameter "$select" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetFindingExceptionsFindingExceptionID(ctx, findingExceptionID, params)
+	return err
+}
+
+// PatchFindingExceptionsFindingExceptionID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchFindingExceptionsFindingExceptionID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "findingExceptionID" -------------
+	var findingExceptionID FindingExceptionID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "findingExceptionID", runtime.ParamLocationPath, ctx.Param("findingExceptionID"), &findingExceptionID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter findingExceptionID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchFindingExceptionsFindingExceptionIDParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch Ifmatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PatchFindingExceptionsFindingExceptionID(ctx, findingExceptionID, params)
+	return err
+}
+
+// GetFindings converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFindings(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFindingsParams
+	// ------------- Optional query parameter "$filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "$search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$search", ctx.QueryParams(), &params.Search)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $search: %s", err))
+	}
+
+	// ------------- Optional query parameter "$select" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+	}
+
+	// ------------- Optional query parameter "$count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$count", ctx.QueryParams(), &params.Count)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $count: %s", err))
+	}
+
+	// ------------- Optional query parameter "$top" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$top", ctx.QueryParams(), &params.Top)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $top: %s", err))
+	}
+
+	// ------------- Optional query parameter "$skip" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$skip", ctx.QueryParams(), &params.Skip)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $skip: %s", err))
+	}
+
+	// ------------- Optional query parameter "$expand" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$expand", ctx.QueryParams(), &params.Expand)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $expand: %s", err))
+	}
+
+	// ------------- Optional query parameter "$orderby" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$orderby", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $orderby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetFindings(ctx, params)
+	return err
+}
+
+// PostFindings converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFindings(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostFindings(ctx)
+	return err
+}
+
+// PatchFindingsBulk converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchFindingsBulk(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PatchFindingsBulk(ctx)
+	return err
+}
+
+// PostFindingsBulk converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFindingsBulk(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostFindingsBulk(ctx)
+	return err
+}
+
+// DeleteFindingsFindingID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteFindingsFindingID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "findingID" -------------
+	var findingID FindingID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "findingID", runtime.ParamLocationPath, ctx.Param("findingID"), &findingID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter findingID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteFindingsFindingID(ctx, findingID)
+	return err
+}
+
+// GetFindingsFindingID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFindingsFindingID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "findingID" -------------
+	var findingID FindingID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "findingID", runtime.ParamLocationPath, ctx.Param("findingID"), &findingID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter findingID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFindingsFindingIDParams
+	// ------------- Optional query parameter "$select" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+	}
+
+	// ------------- Optional query parameter "$expand" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$expand", ctx.QueryParams(), &params.Expand)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $expand: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetFindingsFindingID(ctx, findingID, params)
+	return err
+}
+
+// PatchFindingsFindingID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchFindingsFindingID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "findingID" -------------
+	var findingID FindingID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "findingID", runtime.ParamLocationPath, ctx.Param("findingID"), &findingID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter findingID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PatchFindingsFindingID(ctx, findingID)
+	return err
+}
+
+// PutFindingsFindingID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutFindingsFindingID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "findingID" -------------
+	var findingID FindingID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "findingID", runtime.ParamLocationPath, ctx.Param("findingID"), &findingID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter findingID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PutFindingsFindingID(ctx, findingID)
+	return err
+}
+
+// PostFindingsFindingIDScannerSuppression converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFindingsFindingIDScannerSuppression(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "findingID" -------------
+	var findingID FindingID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "findingID", runtime.ParamLocationPath, ctx.Param("findingID"), &findingID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter findingID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostFindingsFindingIDScannerSuppression(ctx, findingID)
+	return err
+}
+
+// GetNotificationConfigs converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNotificationConfigs(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNotificationConfigsParams
+	// ------------- Optional query parameter "$filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "$select" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+	}
+
+	// ------------- Optional query parameter "$count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$count", ctx.QueryParams(), &params.Count)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $count: %s", err))
+	}
+
+	// ------------- Optional query parameter "$top" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$top", ctx.QueryParams(), &params.Top)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $top: %s", err))
+	}
+
+	// ------------- Optional query parameter "$skip" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$skip", ctx.QueryParams(), &params.Skip)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $skip: %s", err))
+	}
+
+	// ------------- Optional query parameter "$orderby" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$orderby", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $orderby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetNotificationConfigs(ctx, params)
+	return err
+}
+
+// PostNotificationConfigs converts echo context to params.
+func (w *ServerInterfaceWrapper) PostNotificationConfigs(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostNotificationConfigs(ctx)
+	return err
+}
+
+// DeleteNotificationConfigsNotificationConfigID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteNotificationConfigsNotificationConfigID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "notificationConfigID" -------------
+	var notificationConfigID NotificationConfigID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "notificationConfigID", runtime.ParamLocationPath, ctx.Param("notificationConfigID"), &notificationConfigID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter notificationConfigID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteNotificationConfigsNotificationConfigID(ctx, notificationConfigID)
+	return err
+}
+
+// GetNotificationConfigsNotificationConfigID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetNotificationConfigsNotificationConfigID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "notificationConfigID" -------------
+	var notificationConfigID NotificationConfigID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "notificationConfigID", runtime.ParamLocationPath, ctx.Param("notificationConfigID"), &notificationConfigID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter notificationConfigID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNotificationConfigsNotificationConfigIDParams
+	// ------------- Optional query parameter "$select" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetNotificationConfigsNotificationConfigID(ctx, notificationConfigID, params)
+	return err
+}
+
+// PatchNotificationConfigsNotificationConfigID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchNotificationConfigsNotificationConfigID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "notificationConfigID" -------------
+	var notificationConfigID NotificationConfigID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "notificationConfigID", runtime.ParamLocationPath, ctx.Param("notificationConfigID"), &notificationConfigID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter notificationConfigID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchNotificationConfigsNotificationConfigIDParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch Ifmatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PatchNotificationConfigsNotificationConfigID(ctx, notificationConfigID, params)
+	return err
+}
+
+// GetOperationsOperationID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOperationsOperationID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "operationID" -------------
+	var operationID OperationID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "operationID", runtime.ParamLocationPath, ctx.Param("operationID"), &operationID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter operationID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetOperationsOperationID(ctx, operationID)
+	return err
+}
+
+// GetOperationsOperationIDResult converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOperationsOperationIDResult(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "operationID" -------------
+	var operationID OperationID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "operationID", runtime.ParamLocationPath, ctx.Param("operationID"), &operationID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter operationID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetOperationsOperationIDResult(ctx, operationID)
+	return err
+}
+
+// GetPostureScores converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPostureScores(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPostureScoresParams
+	// ------------- Optional query parameter "$filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "$select" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+	}
+
+	// ------------- Optional query parameter "$count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$count", ctx.QueryParams(), &params.Count)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $count: %s", err))
+	}
+
+	// ------------- Optional query parameter "$top" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$top", ctx.QueryParams(), &params.Top)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $top: %s", err))
+	}
+
+	// ------------- Optional query parameter "$skip" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$skip", ctx.QueryParams(), &params.Skip)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $skip: %s", err))
+	}
+
+	// ------------- Optional query parameter "$orderby" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$orderby", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $orderby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetPostureScores(ctx, params)
+	return err
+}
+
+// GetProviderOperations converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProviderOperations(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProviderOperationsParams
+	// ------------- Optional query parameter "$filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "$select" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+	}
+
+	// ------------- Optional query parameter "$count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$count", ctx.QueryParams(), &params.Count)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $count: %s", err))
+	}
+
+	// ------------- Optional query parameter "$top" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$top", ctx.QueryParams(), &params.Top)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $top: %s", err))
+	}
+
+	// ------------- Optional query parameter "$skip" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$skip", ctx.QueryParams(), &params.Skip)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $skip: %s", err))
+	}
+
+	// ------------- Optional query parameter "$expand" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$expand", ctx.QueryParams(), &params.Expand)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $expand: %s", err))
+	}
+
+	// ------------- Optional query parameter "$orderby" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$orderby", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $orderby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetProviderOperations(ctx, params)
+	return err
+}
+
+// PostProviderOperations converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProviderOperations(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostProviderOperations(ctx)
+	return err
+}
+
+// GetProviderOperationsProviderOperationID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProviderOperationsProviderOperationID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "providerOperationID" -------------
+	var providerOperationID ProviderOperationID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "providerOperationID", runtime.ParamLocationPath, ctx.Param("providerOperationID"), &providerOperationID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter providerOperationID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProviderOperationsProviderOperationIDParams
+	// ------------- Optional query parameter "$select" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+	}
+
+	// ------------- Optional query parameter "$expand" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$expand", ctx.QueryParams(), &params.Expand)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $expand: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetProviderOperationsProviderOperationID(ctx, providerOperationID, params)
+	return err
+}
+
+// GetProviders converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProviders(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetProviders(ctx)
+	return err
+}
+
+// GetReportSchedules converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReportSchedules(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReportSchedulesParams
 	// ------------- Optional query parameter "$filter" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "$filter", ctx.QueryParams(), &params.Filter)
@@ -141,6 +2037,27 @@ func (w *ServerInterfaceWrapper) GetDiscoveryScopes(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
 	}
 
+	// ------------- Optional query parameter "$count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$count", ctx.QueryParams(), &params.Count)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $count: %s", err))
+	}
+
+	// ------------- Optional query parameter "$top" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$top", ctx.QueryParams(), &params.Top)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $top: %s", err))
+	}
+
+	// ------------- Optional query parameter "$skip" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$skip", ctx.QueryParams(), &params.Skip)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $skip: %s", err))
+	}
+
 	// ------------- Optional query parameter "$orderby" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "$orderby", ctx.QueryParams(), &params.OrderBy)
@@ -149,25 +2066,289 @@ func (w *ServerInterfaceWrapper) GetDiscoveryScopes(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetDiscoveryScopes(ctx, params)
+	err = w.Handler.GetReportSchedules(ctx, params)
+	return err
+}
+
+// PostReportSchedules converts echo context to params.
+func (w *ServerInterfaceWrapper) PostReportSchedules(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostReportSchedules(ctx)
+	return err
+}
+
+// DeleteReportSchedulesReportScheduleID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteReportSchedulesReportScheduleID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "reportScheduleID" -------------
+	var reportScheduleID ReportScheduleID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "reportScheduleID", runtime.ParamLocationPath, ctx.Param("reportScheduleID"), &reportScheduleID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reportScheduleID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteReportSchedulesReportScheduleID(ctx, reportScheduleID)
+	return err
+}
+
+// GetReportSchedulesReportScheduleID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReportSchedulesReportScheduleID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "reportScheduleID" -------------
+	var reportScheduleID ReportScheduleID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "reportScheduleID", runtime.ParamLocationPath, ctx.Param("reportScheduleID"), &reportScheduleID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reportScheduleID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReportSchedulesReportScheduleIDParams
+	// ------------- Optional query parameter "$select" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetReportSchedulesReportScheduleID(ctx, reportScheduleID, params)
+	return err
+}
+
+// PatchReportSchedulesReportScheduleID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchReportSchedulesReportScheduleID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "reportScheduleID" -------------
+	var reportScheduleID ReportScheduleID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "reportScheduleID", runtime.ParamLocationPath, ctx.Param("reportScheduleID"), &reportScheduleID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reportScheduleID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchReportSchedulesReportScheduleIDParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch Ifmatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PatchReportSchedulesReportScheduleID(ctx, reportScheduleID, params)
+	return err
+}
+
+// GetReportSchedulesReportScheduleIDReport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReportSchedulesReportScheduleIDReport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "reportScheduleID" -------------
+	var reportScheduleID ReportScheduleID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "reportScheduleID", runtime.ParamLocationPath, ctx.Param("reportScheduleID"), &reportScheduleID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reportScheduleID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetReportSchedulesReportScheduleIDReport(ctx, reportScheduleID)
+	return err
+}
+
+// PostSboms converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSboms(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSbomsParams
+	// ------------- Required query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", ctx.QueryParams(), &params.Version)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter version: %s", err))
+	}
+
+	// ------------- Optional query parameter "location" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "location", ctx.QueryParams(), &params.Location)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter location: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostSboms(ctx, params)
+	return err
+}
+
+// GetScanConfigTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) GetScanConfigTemplates(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetScanConfigTemplatesParams
+	// ------------- Optional query parameter "$filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "$select" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+	}
+
+	// ------------- Optional query parameter "$count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$count", ctx.QueryParams(), &params.Count)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $count: %s", err))
+	}
+
+	// ------------- Optional query parameter "$top" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$top", ctx.QueryParams(), &params.Top)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $top: %s", err))
+	}
+
+	// ------------- Optional query parameter "$skip" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$skip", ctx.QueryParams(), &params.Skip)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $skip: %s", err))
+	}
+
+	// ------------- Optional query parameter "$orderby" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$orderby", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $orderby: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetScanConfigTemplates(ctx, params)
+	return err
+}
+
+// PostScanConfigTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) PostScanConfigTemplates(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PostScanConfigTemplates(ctx)
+	return err
+}
+
+// DeleteScanConfigTemplatesScanConfigTemplateID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteScanConfigTemplatesScanConfigTemplateID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "scanConfigTemplateID" -------------
+	var scanConfigTemplateID ScanConfigTemplateID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scanConfigTemplateID", runtime.ParamLocationPath, ctx.Param("scanConfigTemplateID"), &scanConfigTemplateID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanConfigTemplateID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteScanConfigTemplatesScanConfigTemplateID(ctx, scanConfigTemplateID)
+	return err
+}
+
+// GetScanConfigTemplatesScanConfigTemplateID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetScanConfigTemplatesScanConfigTemplateID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "scanConfigTemplateID" -------------
+	var scanConfigTemplateID ScanConfigTemplateID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scanConfigTemplateID", runtime.ParamLocationPath, ctx.Param("scanConfigTemplateID"), &scanConfigTemplateID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanConfigTemplateID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetScanConfigTemplatesScanConfigTemplateIDParams
+	// ------------- Optional query parameter "$select" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetScanConfigTemplatesScanConfigTemplateID(ctx, scanConfigTemplateID, params)
 	return err
 }
 
-// PutDiscoveryScopes converts echo context to params.
-func (w *ServerInterfaceWrapper) PutDiscoveryScopes(ctx echo.Context) error {
-	var err error
+// PatchScanConfigTemplatesScanConfigTemplateID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchScanConfigTemplatesScanConfigTemplateID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "scanConfigTemplateID" -------------
+	var scanConfigTemplateID ScanConfigTemplateID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scanConfigTemplateID", runtime.ParamLocationPath, ctx.Param("scanConfigTemplateID"), &scanConfigTemplateID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanConfigTemplateID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchScanConfigTemplatesScanConfigTemplateIDParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch Ifmatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.PutDiscoveryScopes(ctx)
+	err = w.Handler.PatchScanConfigTemplatesScanConfigTemplateID(ctx, scanConfigTemplateID, params)
 	return err
 }
 
-// GetFindings converts echo context to params.
-func (w *ServerInterfaceWrapper) GetFindings(ctx echo.Context) error {
+// GetScanConfigs converts echo context to params.
+func (w *ServerInterfaceWrapper) GetScanConfigs(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetFindingsParams
+	var params GetScanConfigsParams
 	// ------------- Optional query parameter "$filter" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "$filter", ctx.QueryParams(), &params.Filter)
@@ -175,6 +2356,13 @@ func (w *ServerInterfaceWrapper) GetFindings(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $filter: %s", err))
 	}
 
+	// ------------- Optional query parameter "$search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$search", ctx.QueryParams(), &params.Search)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $search: %s", err))
+	}
+
 	// ------------- Optional query parameter "$select" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
@@ -218,48 +2406,48 @@ func (w *ServerInterfaceWrapper) GetFindings(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetFindings(ctx, params)
+	err = w.Handler.GetScanConfigs(ctx, params)
 	return err
 }
 
-// PostFindings converts echo context to params.
-func (w *ServerInterfaceWrapper) PostFindings(ctx echo.Context) error {
+// PostScanConfigs converts echo context to params.
+func (w *ServerInterfaceWrapper) PostScanConfigs(ctx echo.Context) error {
 	var err error
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.PostFindings(ctx)
+	err = w.Handler.PostScanConfigs(ctx)
 	return err
 }
 
-// DeleteFindingsFindingID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteFindingsFindingID(ctx echo.Context) error {
+// DeleteScanConfigsScanConfigID converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteScanConfigsScanConfigID(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "findingID" -------------
-	var findingID FindingID
+	// ------------- Path parameter "scanConfigID" -------------
+	var scanConfigID ScanConfigID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "findingID", runtime.ParamLocationPath, ctx.Param("findingID"), &findingID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scanConfigID", runtime.ParamLocationPath, ctx.Param("scanConfigID"), &scanConfigID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter findingID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanConfigID: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.DeleteFindingsFindingID(ctx, findingID)
+	err = w.Handler.DeleteScanConfigsScanConfigID(ctx, scanConfigID)
 	return err
 }
 
-// GetFindingsFindingID converts echo context to params.
-func (w *ServerInterfaceWrapper) GetFindingsFindingID(ctx echo.Context) error {
+// GetScanConfigsScanConfigID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetScanConfigsScanConfigID(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "findingID" -------------
-	var findingID FindingID
+	// ------------- Path parameter "scanConfigID" -------------
+	var scanConfigID ScanConfigID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "findingID", runtime.ParamLocationPath, ctx.Param("findingID"), &findingID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scanConfigID", runtime.ParamLocationPath, ctx.Param("scanConfigID"), &scanConfigID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter findingID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanConfigID: %s", err))
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetFindingsFindingIDParams
+	var params GetScanConfigsScanConfigIDParams
 	// ------------- Optional query parameter "$select" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
@@ -274,49 +2462,131 @@ func (w *ServerInterfaceWrapper) GetFindingsFindingID(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $expand: %s", err))
 	}
 
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-None-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-None-Match: %s", err))
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetFindingsFindingID(ctx, findingID, params)
+	err = w.Handler.GetScanConfigsScanConfigID(ctx, scanConfigID, params)
 	return err
 }
 
-// PatchFindingsFindingID converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchFindingsFindingID(ctx echo.Context) error {
+// PatchScanConfigsScanConfigID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchScanConfigsScanConfigID(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "findingID" -------------
-	var findingID FindingID
+	// ------------- Path parameter "scanConfigID" -------------
+	var scanConfigID ScanConfigID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "findingID", runtime.ParamLocationPath, ctx.Param("findingID"), &findingID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scanConfigID", runtime.ParamLocationPath, ctx.Param("scanConfigID"), &scanConfigID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter findingID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanConfigID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchScanConfigsScanConfigIDParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch Ifmatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.PatchFindingsFindingID(ctx, findingID)
+	err = w.Handler.PatchScanConfigsScanConfigID(ctx, scanConfigID, params)
 	return err
 }
 
-// PutFindingsFindingID converts echo context to params.
-func (w *ServerInterfaceWrapper) PutFindingsFindingID(ctx echo.Context) error {
+// PutScanConfigsScanConfigID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutScanConfigsScanConfigID(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "findingID" -------------
-	var findingID FindingID
+	// ------------- Path parameter "scanConfigID" -------------
+	var scanConfigID ScanConfigID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "findingID", runtime.ParamLocationPath, ctx.Param("findingID"), &findingID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scanConfigID", runtime.ParamLocationPath, ctx.Param("scanConfigID"), &scanConfigID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter findingID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanConfigID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutScanConfigsScanConfigIDParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch Ifmatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.PutFindingsFindingID(ctx, findingID)
+	err = w.Handler.PutScanConfigsScanConfigID(ctx, scanConfigID, params)
 	return err
 }
 
-// GetScanConfigs converts echo context to params.
-func (w *ServerInterfaceWrapper) GetScanConfigs(ctx echo.Context) error {
+// GetScanConfigsScanConfigIDNextRuns converts echo context to params.
+func (w *ServerInterfaceWrapper) GetScanConfigsScanConfigIDNextRuns(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "scanConfigID" -------------
+	var scanConfigID ScanConfigID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scanConfigID", runtime.ParamLocationPath, ctx.Param("scanConfigID"), &scanConfigID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanConfigID: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetScanConfigsScanConfigIDNextRunsParams
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", ctx.QueryParams(), &params.Count)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter count: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetScanConfigsScanConfigIDNextRuns(ctx, scanConfigID, params)
+	return err
+}
+
+// GetScanResults converts echo context to params.
+func (w *ServerInterfaceWrapper) GetScanResults(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetScanConfigsParams
+	var params GetScanResultsParams
 	// ------------- Optional query parameter "$filter" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "$filter", ctx.QueryParams(), &params.Filter)
@@ -367,48 +2637,32 @@ func (w *ServerInterfaceWrapper) GetScanConfigs(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetScanConfigs(ctx, params)
-	return err
-}
-
-// PostScanConfigs converts echo context to params.
-func (w *ServerInterfaceWrapper) PostScanConfigs(ctx echo.Context) error {
-	var err error
-
-	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.PostScanConfigs(ctx)
+	err = w.Handler.GetScanResults(ctx, params)
 	return err
 }
 
-// DeleteScanConfigsScanConfigID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteScanConfigsScanConfigID(ctx echo.Context) error {
+// PostScanResults converts echo context to params.
+func (w *ServerInterfaceWrapper) PostScanResults(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "scanConfigID" -------------
-	var scanConfigID ScanConfigID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "scanConfigID", runtime.ParamLocationPath, ctx.Param("scanConfigID"), &scanConfigID)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanConfigID: %s", err))
-	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.DeleteScanConfigsScanConfigID(ctx, scanConfigID)
+	err = w.Handler.PostScanResults(ctx)
 	return err
 }
 
-// GetScanConfigsScanConfigID converts echo context to params.
-func (w *ServerInterfaceWrapper) GetScanConfigsScanConfigID(ctx echo.Context) error {
+// GetScanResultsScanResultID converts echo context to params.
+func (w *ServerInterfaceWrapper) GetScanResultsScanResultID(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "scanConfigID" -------------
-	var scanConfigID ScanConfigID
+	// ------------- Path parameter "scanResultID" -------------
+	var scanResultID ScanResultID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "scanConfigID", runtime.ParamLocationPath, ctx.Param("scanConfigID"), &scanConfigID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, ctx.Param("scanResultID"), &scanResultID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanConfigID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanResultID: %s", err))
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetScanConfigsScanConfigIDParams
+	var params GetScanResultsScanResultIDParams
 	// ------------- Optional query parameter "$select" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
@@ -423,24 +2677,41 @@ func (w *ServerInterfaceWrapper) GetScanConfigsScanConfigID(ctx echo.Context) er
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $expand: %s", err))
 	}
 
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-None-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-None-Match: %s", err))
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetScanConfigsScanConfigID(ctx, scanConfigID, params)
+	err = w.Handler.GetScanResultsScanResultID(ctx, scanResultID, params)
 	return err
 }
 
-// PatchScanConfigsScanConfigID converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchScanConfigsScanConfigID(ctx echo.Context) error {
+// PatchScanResultsScanResultID converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchScanResultsScanResultID(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "scanConfigID" -------------
-	var scanConfigID ScanConfigID
+	// ------------- Path parameter "scanResultID" -------------
+	var scanResultID ScanResultID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "scanConfigID", runtime.ParamLocationPath, ctx.Param("scanConfigID"), &scanConfigID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, ctx.Param("scanResultID"), &scanResultID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanConfigID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanResultID: %s", err))
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PatchScanConfigsScanConfigIDParams
+	var params PatchScanResultsScanResultIDParams
 
 	headers := ctx.Request().Header
 	// ------------- Optional header parameter "If-Match" -------------
@@ -460,23 +2731,23 @@ func (w *ServerInterfaceWrapper) PatchScanConfigsScanConfigID(ctx echo.Context)
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.PatchScanConfigsScanConfigID(ctx, scanConfigID, params)
+	err = w.Handler.PatchScanResultsScanResultID(ctx, scanResultID, params)
 	return err
 }
 
-// PutScanConfigsScanConfigID converts echo context to params.
-func (w *ServerInterfaceWrapper) PutScanConfigsScanConfigID(ctx echo.Context) error {
+// PutScanResultsScanResultID converts echo context to params.
+func (w *ServerInterfaceWrapper) PutScanResultsScanResultID(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "scanConfigID" -------------
-	var scanConfigID ScanConfigID
+	// ------------- Path parameter "scanResultID" -------------
+	var scanResultID ScanResultID
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "scanConfigID", runtime.ParamLocationPath, ctx.Param("scanConfigID"), &scanConfigID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, ctx.Param("scanResultID"), &scanResultID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanConfigID: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanResultID: %s", err))
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PutScanConfigsScanConfigIDParams
+	var params PutScanResultsScanResultIDParams
 
 	headers := ctx.Request().Header
 	// ------------- Optional header parameter "If-Match" -------------
@@ -492,41 +2763,83 @@ func (w *ServerInterfaceWrapper) PutScanConfigsScanConfigID(ctx echo.Context) er
 			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
 		}
 
-		params.IfMatch = &IfMatch
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PutScanResultsScanResultID(ctx, scanResultID, params)
+	return err
+}
+
+// GetScanResultsScanResultIDFamiliesFamilyArtifact converts echo context to params.
+func (w *ServerInterfaceWrapper) GetScanResultsScanResultIDFamiliesFamilyArtifact(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "scanResultID" -------------
+	var scanResultID ScanResultID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, ctx.Param("scanResultID"), &scanResultID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanResultID: %s", err))
+	}
+
+	// ------------- Path parameter "family" -------------
+	var family ScanResultFamily
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "family", runtime.ParamLocationPath, ctx.Param("family"), &family)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter family: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.PutScanConfigsScanConfigID(ctx, scanConfigID, params)
+	err = w.Handler.GetScanResultsScanResultIDFamiliesFamilyArtifact(ctx, scanResultID, family)
 	return err
 }
 
-// GetScanResults converts echo context to params.
-func (w *ServerInterfaceWrapper) GetScanResults(ctx echo.Context) error {
+// PutScanResultsScanResultIDFamiliesFamilyArtifact converts echo context to params.
+func (w *ServerInterfaceWrapper) PutScanResultsScanResultIDFamiliesFamilyArtifact(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "scanResultID" -------------
+	var scanResultID ScanResultID
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetScanResultsParams
-	// ------------- Optional query parameter "$filter" -------------
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, ctx.Param("scanResultID"), &scanResultID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanResultID: %s", err))
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "$filter", ctx.QueryParams(), &params.Filter)
+	// ------------- Path parameter "family" -------------
+	var family ScanResultFamily
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "family", runtime.ParamLocationPath, ctx.Param("family"), &family)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $filter: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter family: %s", err))
 	}
 
-	// ------------- Optional query parameter "$select" -------------
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PutScanResultsScanResultIDFamiliesFamilyArtifact(ctx, scanResultID, family)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+// GetScanResultsScanResultIDFamiliesFamilyItems converts echo context to params.
+func (w *ServerInterfaceWrapper) GetScanResultsScanResultIDFamiliesFamilyItems(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "scanResultID" -------------
+	var scanResultID ScanResultID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, ctx.Param("scanResultID"), &scanResultID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanResultID: %s", err))
 	}
 
-	// ------------- Optional query parameter "$count" -------------
+	// ------------- Path parameter "family" -------------
+	var family ScanResultFamily
 
-	err = runtime.BindQueryParameter("form", true, false, "$count", ctx.QueryParams(), &params.Count)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "family", runtime.ParamLocationPath, ctx.Param("family"), &family)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $count: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter family: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetScanResultsScanResultIDFamiliesFamilyItemsParams
 	// ------------- Optional query parameter "$top" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "$top", ctx.QueryParams(), &params.Top)
@@ -541,36 +2854,37 @@ func (w *ServerInterfaceWrapper) GetScanResults(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $skip: %s", err))
 	}
 
-	// ------------- Optional query parameter "$expand" -------------
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetScanResultsScanResultIDFamiliesFamilyItems(ctx, scanResultID, family, params)
+	return err
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "$expand", ctx.QueryParams(), &params.Expand)
+// PostScanResultsScanResultIDFamiliesFamilyItems converts echo context to params.
+func (w *ServerInterfaceWrapper) PostScanResultsScanResultIDFamiliesFamilyItems(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "scanResultID" -------------
+	var scanResultID ScanResultID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, ctx.Param("scanResultID"), &scanResultID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $expand: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanResultID: %s", err))
 	}
 
-	// ------------- Optional query parameter "$orderby" -------------
+	// ------------- Path parameter "family" -------------
+	var family ScanResultFamily
 
-	err = runtime.BindQueryParameter("form", true, false, "$orderby", ctx.QueryParams(), &params.OrderBy)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "family", runtime.ParamLocationPath, ctx.Param("family"), &family)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $orderby: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter family: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetScanResults(ctx, params)
-	return err
-}
-
-// PostScanResults converts echo context to params.
-func (w *ServerInterfaceWrapper) PostScanResults(ctx echo.Context) error {
-	var err error
-
-	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.PostScanResults(ctx)
+	err = w.Handler.PostScanResultsScanResultIDFamiliesFamilyItems(ctx, scanResultID, family)
 	return err
 }
 
-// GetScanResultsScanResultID converts echo context to params.
-func (w *ServerInterfaceWrapper) GetScanResultsScanResultID(ctx echo.Context) error {
+// PostScanResultsScanResultIDRerun converts echo context to params.
+func (w *ServerInterfaceWrapper) PostScanResultsScanResultIDRerun(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "scanResultID" -------------
 	var scanResultID ScanResultID
@@ -581,28 +2895,21 @@ func (w *ServerInterfaceWrapper) GetScanResultsScanResultID(ctx echo.Context) er
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetScanResultsScanResultIDParams
-	// ------------- Optional query parameter "$select" -------------
+	var params PostScanResultsScanResultIDRerunParams
+	// ------------- Required query parameter "families" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
-	}
-
-	// ------------- Optional query parameter "$expand" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "$expand", ctx.QueryParams(), &params.Expand)
+	err = runtime.BindQueryParameter("form", false, true, "families", ctx.QueryParams(), &params.Families)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $expand: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter families: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetScanResultsScanResultID(ctx, scanResultID, params)
+	err = w.Handler.PostScanResultsScanResultIDRerun(ctx, scanResultID, params)
 	return err
 }
 
-// PatchScanResultsScanResultID converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchScanResultsScanResultID(ctx echo.Context) error {
+// GetScanResultsScanResultIDSbom converts echo context to params.
+func (w *ServerInterfaceWrapper) GetScanResultsScanResultIDSbom(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "scanResultID" -------------
 	var scanResultID ScanResultID
@@ -613,32 +2920,21 @@ func (w *ServerInterfaceWrapper) PatchScanResultsScanResultID(ctx echo.Context)
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PatchScanResultsScanResultIDParams
-
-	headers := ctx.Request().Header
-	// ------------- Optional header parameter "If-Match" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
-		var IfMatch Ifmatch
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
-		}
-
-		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
-		}
+	var params GetScanResultsScanResultIDSbomParams
+	// ------------- Required query parameter "format" -------------
 
-		params.IfMatch = &IfMatch
+	err = runtime.BindQueryParameter("form", true, true, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.PatchScanResultsScanResultID(ctx, scanResultID, params)
+	err = w.Handler.GetScanResultsScanResultIDSbom(ctx, scanResultID, params)
 	return err
 }
 
-// PutScanResultsScanResultID converts echo context to params.
-func (w *ServerInterfaceWrapper) PutScanResultsScanResultID(ctx echo.Context) error {
+// GetScanResultsScanResultIDScannerConfig converts echo context to params.
+func (w *ServerInterfaceWrapper) GetScanResultsScanResultIDScannerConfig(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "scanResultID" -------------
 	var scanResultID ScanResultID
@@ -648,28 +2944,24 @@ func (w *ServerInterfaceWrapper) PutScanResultsScanResultID(ctx echo.Context) er
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanResultID: %s", err))
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params PutScanResultsScanResultIDParams
-
-	headers := ctx.Request().Header
-	// ------------- Optional header parameter "If-Match" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
-		var IfMatch Ifmatch
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
-		}
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetScanResultsScanResultIDScannerConfig(ctx, scanResultID)
+	return err
+}
 
-		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
-		}
+// PutScanResultsScanResultIDScannerConfig converts echo context to params.
+func (w *ServerInterfaceWrapper) PutScanResultsScanResultIDScannerConfig(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "scanResultID" -------------
+	var scanResultID ScanResultID
 
-		params.IfMatch = &IfMatch
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scanResultID", runtime.ParamLocationPath, ctx.Param("scanResultID"), &scanResultID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanResultID: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.PutScanResultsScanResultID(ctx, scanResultID, params)
+	err = w.Handler.PutScanResultsScanResultIDScannerConfig(ctx, scanResultID)
 	return err
 }
 
@@ -686,6 +2978,13 @@ func (w *ServerInterfaceWrapper) GetScans(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $filter: %s", err))
 	}
 
+	// ------------- Optional query parameter "$search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$search", ctx.QueryParams(), &params.Search)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $search: %s", err))
+	}
+
 	// ------------- Optional query parameter "$select" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
@@ -785,6 +3084,23 @@ func (w *ServerInterfaceWrapper) GetScansScanID(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $expand: %s", err))
 	}
 
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-None-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-None-Match: %s", err))
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.GetScansScanID(ctx, scanID, params)
 	return err
@@ -862,192 +3178,82 @@ func (w *ServerInterfaceWrapper) PutScansScanID(ctx echo.Context) error {
 	return err
 }
 
-// GetTargets converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTargets(ctx echo.Context) error {
+// GetScansScanIDProgress converts echo context to params.
+func (w *ServerInterfaceWrapper) GetScansScanIDProgress(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "scanID" -------------
+	var scanID ScanID
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTargetsParams
-	// ------------- Optional query parameter "$filter" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "$filter", ctx.QueryParams(), &params.Filter)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $filter: %s", err))
-	}
-
-	// ------------- Optional query parameter "$select" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
-	}
-
-	// ------------- Optional query parameter "$count" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "$count", ctx.QueryParams(), &params.Count)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $count: %s", err))
-	}
-
-	// ------------- Optional query parameter "$top" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "$top", ctx.QueryParams(), &params.Top)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $top: %s", err))
-	}
-
-	// ------------- Optional query parameter "$skip" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "$skip", ctx.QueryParams(), &params.Skip)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $skip: %s", err))
-	}
-
-	// ------------- Optional query parameter "$expand" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "$expand", ctx.QueryParams(), &params.Expand)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $expand: %s", err))
-	}
-
-	// ------------- Optional query parameter "$orderby" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "$orderby", ctx.QueryParams(), &params.OrderBy)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scanID", runtime.ParamLocationPath, ctx.Param("scanID"), &scanID)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $orderby: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scanID: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetTargets(ctx, params)
+	err = w.Handler.GetScansScanIDProgress(ctx, scanID)
 	return err
 }
 
-// PostTargets converts echo context to params.
-func (w *ServerInterfaceWrapper) PostTargets(ctx echo.Context) error {
+// GetSettingsFindingTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSettingsFindingTemplates(ctx echo.Context) error {
 	var err error
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.PostTargets(ctx)
+	err = w.Handler.GetSettingsFindingTemplates(ctx)
 	return err
 }
 
-// DeleteTargetsTargetID converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteTargetsTargetID(ctx echo.Context) error {
+// PutSettingsFindingTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) PutSettingsFindingTemplates(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "targetID" -------------
-	var targetID TargetID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "targetID", runtime.ParamLocationPath, ctx.Param("targetID"), &targetID)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter targetID: %s", err))
-	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.DeleteTargetsTargetID(ctx, targetID)
+	err = w.Handler.PutSettingsFindingTemplates(ctx)
 	return err
 }
 
-// GetTargetsTargetID converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTargetsTargetID(ctx echo.Context) error {
+// PostSettingsFindingTemplatesPreview converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSettingsFindingTemplatesPreview(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "targetID" -------------
-	var targetID TargetID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "targetID", runtime.ParamLocationPath, ctx.Param("targetID"), &targetID)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter targetID: %s", err))
-	}
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTargetsTargetIDParams
-	// ------------- Optional query parameter "$select" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
-	}
-
-	// ------------- Optional query parameter "$expand" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "$expand", ctx.QueryParams(), &params.Expand)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $expand: %s", err))
-	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetTargetsTargetID(ctx, targetID, params)
+	err = w.Handler.PostSettingsFindingTemplatesPreview(ctx)
 	return err
 }
 
-// PatchTargetsTargetID converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchTargetsTargetID(ctx echo.Context) error {
+// GetSettingsRetention converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSettingsRetention(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "targetID" -------------
-	var targetID TargetID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "targetID", runtime.ParamLocationPath, ctx.Param("targetID"), &targetID)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter targetID: %s", err))
-	}
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params PatchTargetsTargetIDParams
-
-	headers := ctx.Request().Header
-	// ------------- Optional header parameter "If-Match" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
-		var IfMatch Ifmatch
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
-		}
-
-		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
-		}
-
-		params.IfMatch = &IfMatch
-	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.PatchTargetsTargetID(ctx, targetID, params)
+	err = w.Handler.GetSettingsRetention(ctx)
 	return err
 }
 
-// PutTargetsTargetID converts echo context to params.
-func (w *ServerInterfaceWrapper) PutTargetsTargetID(ctx echo.Context) error {
+// PutSettingsRetention converts echo context to params.
+func (w *ServerInterfaceWrapper) PutSettingsRetention(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "targetID" -------------
-	var targetID TargetID
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "targetID", runtime.ParamLocationPath, ctx.Param("targetID"), &targetID)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter targetID: %s", err))
-	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params PutTargetsTargetIDParams
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PutSettingsRetention(ctx)
+	return err
+}
 
-	headers := ctx.Request().Header
-	// ------------- Optional header parameter "If-Match" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
-		var IfMatch Ifmatch
-		n := len(valueList)
-		if n != 1 {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
-		}
+// GetUserPreferences converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUserPreferences(ctx echo.Context) error {
+	var err error
 
-		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
-		if err != nil {
-			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
-		}
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetUserPreferences(ctx)
+	return err
+}
 
-		params.IfMatch = &IfMatch
-	}
+// PutUserPreferences converts echo context to params.
+func (w *ServerInterfaceWrapper) PutUserPreferences(ctx echo.Context) error {
+	var err error
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.PutTargetsTargetID(ctx, targetID, params)
+	err = w.Handler.PutUserPreferences(ctx)
 	return err
 }
 
@@ -1079,141 +3285,610 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/admin/feeds", wrapper.GetAdminFeeds)
+	router.POST(baseURL+"/admin/feeds/refresh", wrapper.PostAdminFeedsRefresh)
+	router.GET(baseURL+"/admin/maintenance", wrapper.GetAdminMaintenance)
+	router.PUT(baseURL+"/admin/maintenance", wrapper.PutAdminMaintenance)
+	router.GET(baseURL+"/admin/tasks", wrapper.GetAdminTasks)
+	router.POST(baseURL+"/admin/tasks/:taskName/run", wrapper.PostAdminTasksTaskNameRun)
+	router.GET(baseURL+"/admin/usage", wrapper.GetAdminUsage)
+	router.GET(baseURL+"/apiKeys", wrapper.GetAPIKeys)
+	router.POST(baseURL+"/apiKeys", wrapper.PostAPIKeys)
+	router.DELETE(baseURL+"/apiKeys/:apiKeyID", wrapper.DeleteAPIKeysAPIKeyID)
+	router.GET(baseURL+"/apiKeys/:apiKeyID", wrapper.GetAPIKeysAPIKeyID)
+	router.GET(baseURL+"/assetGroups", wrapper.GetAssetGroups)
+	router.POST(baseURL+"/assetGroups", wrapper.PostAssetGroups)
+	router.DELETE(baseURL+"/assetGroups/:assetGroupID", wrapper.DeleteAssetGroupsAssetGroupID)
+	router.GET(baseURL+"/assetGroups/:assetGroupID", wrapper.GetAssetGroupsAssetGroupID)
+	router.PATCH(baseURL+"/assetGroups/:assetGroupID", wrapper.PatchAssetGroupsAssetGroupID)
+	router.GET(baseURL+"/assetGroups/:assetGroupID/assets", wrapper.GetAssetGroupsAssetGroupIDAssets)
+	router.GET(baseURL+"/assets", wrapper.GetAssets)
+	router.POST(baseURL+"/assets", wrapper.PostAssets)
+	router.DELETE(baseURL+"/assets/:assetID", wrapper.DeleteAssetsAssetID)
+	router.GET(baseURL+"/assets/:assetID", wrapper.GetAssetsAssetID)
+	router.PATCH(baseURL+"/assets/:assetID", wrapper.PatchAssetsAssetID)
+	router.PUT(baseURL+"/assets/:assetID", wrapper.PutAssetsAssetID)
+	router.GET(baseURL+"/assets/:assetID/packages", wrapper.GetAssetsAssetIDPackages)
+	router.GET(baseURL+"/assets/:assetID/sbomDiff", wrapper.GetAssetsAssetIDSbomDiff)
+	router.GET(baseURL+"/assets/:assetID/scanResultDiff", wrapper.GetAssetsAssetIDScanResultDiff)
+	router.GET(baseURL+"/assets/:assetID/upgradePlan", wrapper.GetAssetsAssetIDUpgradePlan)
+	router.GET(baseURL+"/correlatedFindings", wrapper.GetCorrelatedFindings)
+	router.GET(baseURL+"/correlatedFindings/:correlatedFindingID", wrapper.GetCorrelatedFindingsCorrelatedFindingID)
 	router.GET(baseURL+"/discovery/scopes", wrapper.GetDiscoveryScopes)
 	router.PUT(baseURL+"/discovery/scopes", wrapper.PutDiscoveryScopes)
+	router.GET(baseURL+"/feeds/:feedName", wrapper.GetFeedsFeedName)
+	router.GET(baseURL+"/findingDigests", wrapper.GetFindingDigests)
+	router.POST(baseURL+"/findingDigests", wrapper.PostFindingDigests)
+	router.DELETE(baseURL+"/findingDigests/:findingDigestID", wrapper.DeleteFindingDigestsFindingDigestID)
+	router.GET(baseURL+"/findingDigests/:findingDigestID", wrapper.GetFindingDigestsFindingDigestID)
+	router.PATCH(baseURL+"/findingDigests/:findingDigestID", wrapper.PatchFindingDigestsFindingDigestID)
+	router.GET(baseURL+"/findingExceptions", wrapper.GetFindingExceptions)
+	router.POST(baseURL+"/findingExceptions", wrapper.PostFindingExceptions)
+	router.DELETE(baseURL+"/findingExceptions/:findingExceptionID", wrapper.DeleteFindingExceptionsFindingExceptionID)
+	router.GET(baseURL+"/findingExceptions/:findingExceptionID", wrapper.GetFindingExceptionsFindingExceptionID)
+	router.PATCH(baseURL+"/findingExceptions/:findingExceptionID", wrapper.PatchFindingExceptionsFindingExceptionID)
 	router.GET(baseURL+"/findings", wrapper.GetFindings)
 	router.POST(baseURL+"/findings", wrapper.PostFindings)
+	router.PATCH(baseURL+"/findings/bulk", wrapper.PatchFindingsBulk)
+	router.POST(baseURL+"/findings/bulk", wrapper.PostFindingsBulk)
 	router.DELETE(baseURL+"/findings/:findingID", wrapper.DeleteFindingsFindingID)
 	router.GET(baseURL+"/findings/:findingID", wrapper.GetFindingsFindingID)
 	router.PATCH(baseURL+"/findings/:findingID", wrapper.PatchFindingsFindingID)
 	router.PUT(baseURL+"/findings/:findingID", wrapper.PutFindingsFindingID)
+	router.POST(baseURL+"/findings/:findingID/scannerSuppression", wrapper.PostFindingsFindingIDScannerSuppression)
+	router.GET(baseURL+"/notificationConfigs", wrapper.GetNotificationConfigs)
+	router.POST(baseURL+"/notificationConfigs", wrapper.PostNotificationConfigs)
+	router.DELETE(baseURL+"/notificationConfigs/:notificationConfigID", wrapper.DeleteNotificationConfigsNotificationConfigID)
+	router.GET(baseURL+"/notificationConfigs/:notificationConfigID", wrapper.GetNotificationConfigsNotificationConfigID)
+	router.PATCH(baseURL+"/notificationConfigs/:notificationConfigID", wrapper.PatchNotificationConfigsNotificationConfigID)
+	router.GET(baseURL+"/operations/:operationID", wrapper.GetOperationsOperationID)
+	router.GET(baseURL+"/operations/:operationID/result", wrapper.GetOperationsOperationIDResult)
+	router.GET(baseURL+"/postureScores", wrapper.GetPostureScores)
+	router.GET(baseURL+"/providerOperations", wrapper.GetProviderOperations)
+	router.POST(baseURL+"/providerOperations", wrapper.PostProviderOperations)
+	router.GET(baseURL+"/providerOperations/:providerOperationID", wrapper.GetProviderOperationsProviderOperationID)
+	router.GET(baseURL+"/providers", wrapper.GetProviders)
+	router.GET(baseURL+"/reportSchedules", wrapper.GetReportSchedules)
+	router.POST(baseURL+"/reportSchedules", wrapper.PostReportSchedules)
+	router.DELETE(baseURL+"/reportSchedules/:reportScheduleID", wrapper.DeleteReportSchedulesReportScheduleID)
+	router.GET(baseURL+"/reportSchedules/:reportScheduleID", wrapper.GetReportSchedulesReportScheduleID)
+	router.PATCH(baseURL+"/reportSchedules/:reportScheduleID", wrapper.PatchReportSchedulesReportScheduleID)
+	router.GET(baseURL+"/reportSchedules/:reportScheduleID/report", wrapper.GetReportSchedulesReportScheduleIDReport)
+	router.POST(baseURL+"/sboms", wrapper.PostSboms)
+	router.GET(baseURL+"/scanConfigTemplates", wrapper.GetScanConfigTemplates)
+	router.POST(baseURL+"/scanConfigTemplates", wrapper.PostScanConfigTemplates)
+	router.DELETE(baseURL+"/scanConfigTemplates/:scanConfigTemplateID", wrapper.DeleteScanConfigTemplatesScanConfigTemplateID)
+	router.GET(baseURL+"/scanConfigTemplates/:scanConfigTemplateID", wrapper.GetScanConfigTemplatesScanConfigTemplateID)
+	router.PATCH(baseURL+"/scanConfigTemplates/:scanConfigTemplateID", wrapper.PatchScanConfigTemplatesScanConfigTemplateID)
 	router.GET(baseURL+"/scanConfigs", wrapper.GetScanConfigs)
 	router.POST(baseURL+"/scanConfigs", wrapper.PostScanConfigs)
 	router.DELETE(baseURL+"/scanConfigs/:scanConfigID", wrapper.DeleteScanConfigsScanConfigID)
 	router.GET(baseURL+"/scanConfigs/:scanConfigID", wrapper.GetScanConfigsScanConfigID)
 	router.PATCH(baseURL+"/scanConfigs/:scanConfigID", wrapper.PatchScanConfigsScanConfigID)
 	router.PUT(baseURL+"/scanConfigs/:scanConfigID", wrapper.PutScanConfigsScanConfigID)
+	router.GET(baseURL+"/scanConfigs/:scanConfigID/nextRuns", wrapper.GetScanConfigsScanConfigIDNextRuns)
 	router.GET(baseURL+"/scanResults", wrapper.GetScanResults)
 	router.POST(baseURL+"/scanResults", wrapper.PostScanResults)
 	router.GET(baseURL+"/scanResults/:scanResultID", wrapper.GetScanResultsScanResultID)
 	router.PATCH(baseURL+"/scanResults/:scanResultID", wrapper.PatchScanResultsScanResultID)
 	router.PUT(baseURL+"/scanResults/:scanResultID", wrapper.PutScanResultsScanResultID)
+	router.GET(baseURL+"/scanResults/:scanResultID/families/:family/artifact", wrapper.GetScanResultsScanResultIDFamiliesFamilyArtifact)
+	router.PUT(baseURL+"/scanResults/:scanResultID/families/:family/artifact", wrapper.PutScanResultsScanResultIDFamiliesFamilyArtifact)
+	router.GET(baseURL+"/scanResults/:scanResultID/families/:family/items", wrapper.GetScanResultsScanResultIDFamiliesFamilyItems)
+	router.POST(baseURL+"/scanResults/:scanResultID/families/:family/items", wrapper.PostScanResultsScanResultIDFamiliesFamilyItems)
+	router.POST(baseURL+"/scanResults/:scanResultID/rerun", wrapper.PostScanResultsScanResultIDRerun)
+	router.GET(baseURL+"/scanResults/:scanResultID/sbom", wrapper.GetScanResultsScanResultIDSbom)
+	router.GET(baseURL+"/scanResults/:scanResultID/scannerConfig", wrapper.GetScanResultsScanResultIDScannerConfig)
+	router.PUT(baseURL+"/scanResults/:scanResultID/scannerConfig", wrapper.PutScanResultsScanResultIDScannerConfig)
 	router.GET(baseURL+"/scans", wrapper.GetScans)
 	router.POST(baseURL+"/scans", wrapper.PostScans)
 	router.DELETE(baseURL+"/scans/:scanID", wrapper.DeleteScansScanID)
 	router.GET(baseURL+"/scans/:scanID", wrapper.GetScansScanID)
 	router.PATCH(baseURL+"/scans/:scanID", wrapper.PatchScansScanID)
 	router.PUT(baseURL+"/scans/:scanID", wrapper.PutScansScanID)
-	router.GET(baseURL+"/targets", wrapper.GetTargets)
-	router.POST(baseURL+"/targets", wrapper.PostTargets)
-	router.DELETE(baseURL+"/targets/:targetID", wrapper.DeleteTargetsTargetID)
-	router.GET(baseURL+"/targets/:targetID", wrapper.GetTargetsTargetID)
-	router.PATCH(baseURL+"/targets/:targetID", wrapper.PatchTargetsTargetID)
-	router.PUT(baseURL+"/targets/:targetID", wrapper.PutTargetsTargetID)
+	router.GET(baseURL+"/scans/:scanID/progress", wrapper.GetScansScanIDProgress)
+	router.GET(baseURL+"/settings/findingTemplates", wrapper.GetSettingsFindingTemplates)
+	router.PUT(baseURL+"/settings/findingTemplates", wrapper.PutSettingsFindingTemplates)
+	router.POST(baseURL+"/settings/findingTemplates/preview", wrapper.PostSettingsFindingTemplatesPreview)
+	router.GET(baseURL+"/settings/retention", wrapper.GetSettingsRetention)
+	router.PUT(baseURL+"/settings/retention", wrapper.PutSettingsRetention)
+	router.GET(baseURL+"/userPreferences", wrapper.GetUserPreferences)
+	router.PUT(baseURL+"/userPreferences", wrapper.PutUserPreferences)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9WXPbOLbwX0Hxm6pZirGT/vo+XL+5bSetam8lOcmdmnTdgkhIQocC2ABoW5PKf7+F",
-	"jeICkKCsxc74zRaAA+Dg7Dg4/BYldJlTgojg0cm3KIcMLpFATP03wyTFZD46l/9gEp1EORSLKI4IXKLo",
-	"pNIeRwz9WWCG0uhEsALFEU8WaAnlQLHKZWcuGCbz6Pv3OMKzJRTJooS6QDBFbA13NHtzpTo4wGAi0Bwx",
-	"BYemUMAzWhBRgvqzQGy1hvSXRLU64EwpzRAkazgXjzkkqRcQ0s3dG1OA3uNMIOYFNNPNAYBuWIrYLysv",
-	"JCrbp6suUHH0+GZO35gRFqCdYIIylPhxx3VzwEonX3HuByMbQ07yjvqBCNoLgyeQnFEyw36CrXUZRrNy",
-	"aCfcjSCOES8y0Qm37DIMuoBsjvyQy+YhUL/LzjynhCMlHyZFkiCu/kwoEUjzIczzDCdQYEqO/+CUyN/W",
-	"MP/C0Cw6if7f8VrwHOtWfmzgjc0cesYU8YThXIKLTuyUYIk4h3MkSfkj+UroA7lgjLKtLeU0x13LMHMC",
-	"pCbVp6kGSrjVsSffGiNPCaDTP1AigFhAATAHDImCEZQCTADMMpBAjjigMzCDOCsY4kdRHOWM5ogJrBFv",
-	"d3/yLWIIpjckW9nTc1CC/kXPKhF2+sBPEyUYJwnNXWv8PAFJRosUQN0PcNWxuQwN8m6lYbRED0NzTInq",
-	"iQVa8l6cP/CxGiIHkyLL4DRDjX1BxuAq0pRoyfZf1YX87t6wASxpIk2x3CfMbiubmcGMo9iBB72J1tY1",
-	"G32LlphcIjIXi+jkXdxGwX2eDNr/p9uzwZtXS/Fse5JAUh7ygJ3fLZA+c0mHECRKZhYMpUCKpDZBwiwb",
-	"r0+7wbIJ1IRt6CEGeAY4EuABZxmg94gxnCIAyUosMJmrJkxsbzlXU2XHESZcQJKgOzi/eEyygpvDrc/8",
-	"6QrYjlzPRqgAU6Q2oThuBsQCreT+BDTsR9VvHAEB5xz8Dd0jUvZTZguoTK41KGV/PwKjGUDLXKxiNYmA",
-	"X+U4IqjlIbmRIDK4g/N+GqihwK4iBANDdr//TR1OosQRX9AiSxXHCJrnKB1ZzHnMxmESSLL2cPEjRzWZ",
-	"DacBkoejpGBYrD4wWuThGJtUhw0WRTh17/7fBUNjxGnBEqQhD8SEBAAsBKBBbCSSg2WnnHE30hM8YCno",
-	"AAS8mJbDPDK1grNu0WpQM1c9pfwUchGVCYLFbnXKV+n7Kn0r0rdJjWFCuM3927bvFLNWaN1n1yoxUmWK",
-	"TQ3bvSEijqrL1e5ct0jrwdWZtOtvGb3HqQ5SIFIs5bjTz5PIoLIycL3pc8xGZEZVlKiGrBSzayN6W4My",
-	"qr0vZ2Mnloft6uIxzygW7cUl98iJtIbIdrQT3570+Z3/4mwUWGTuYQXL6qTSnrGPJXzbfm/icOZ4YJbd",
-	"zKKTf3VTo0XZ9/jbEOofci4dJyU1V/u0kG4MZ6n1JjbHHtcRIcdqiISXeqy/FjhzCm04kHMk+kU0myMx",
-	"RpniF77ASjjMGidLVgEnewuTr3COqlQhD7lryKciI4jBKc6wWA0ZeAWzB8gGzTVBCUNi0CSYWxtKYWfI",
-	"2DGl4iseNJ2DqyQpp1gKjCUm0Cj7Jcxzc+Cl/AmGGEcGdQMwG0dNTGyCsTgyBDKAfuLI4HEAmuNIn3Q4",
-	"HcRRjQ43IFbLeSutkariSfLsjBYkvXHYqp8XSFrMmAPDceABciBPXBrKKAXTlbTXpdCSUNgSym2lUKA3",
-	"Ai9R5NCXOHUKeUzuYYblyAELqQzSKyHoAbFh6+FG4naypoo3V0VQh6C7eMTc3BrVxN1sLQe75rLi8ntc",
-	"DWfWsXGu/ptK63mBkwUoCP6zQNJk5oJBTARI6HIqOVLacgksOOLK8ZHEn+FEWcgbREjN2hybS+xtU8Pj",
-	"owJm9sg40EFTaaUzdYaCqlXNsXRn9AUQX59ReYlRsebr4C8xFyoibCfoBR2kPStH0K8tS3HVRMlSN3ht",
-	"QNNu7YkAbaL5NdZXFi1k3EKxMJ6S3DDSgXTjRnFgpouCDtpMuCULwCGyg80xi949m2NmWrc5tlwfeRA9",
-	"rffQ69UskYApFDA8MKXcZnZlx21k8V3VSbFFqm31+s1/8+JwC5coxX5/x3j+t4aqPe1+Z4qje8SUXhxm",
-	"Lk3sOIkSxMUZFGhO2crtwCAuzntcI9nH54q2cd5hioRzR/Ng9s0mTZS6+aXRK9yPceyvPzCgyWXrTqWX",
-	"fCrBgmafX/F8UfZrg7hCKS6WHR0u6UPZ6go+NPtvy2crLeGWns/RkxAbRxkk88InKjKcIHuLvvkU3hBF",
-	"XrDMzbk+yXePGHezewfaNmJli/I9c/AtTd3hq81DVHGU09QjrYeFr2xQ8ExSapFPBBSoym63SBtqcTT5",
-	"ivMcpVEcvYc4U3+cU+KO2JUu2yAlpgd5lZBpD7HmxpWuTjJyOI3BZGQ3t2cyMtO65b/BTbjYX29iAzk9",
-	"rp9EKZovrm7G/4zi6LeL8fXFZRRHp7e3l6Oz07vRzbWkm9H46vPp+CKKo4/Xv13ffL7uIp5tCdpxQaRj",
-	"OkkWKC0yZW+uIQ+4WjNwADeA9I1aTTeoexNKshWQsNRPd3II5oAjEQMsyrsYCDgmcwvFwkzBjDLlXtQA",
-	"rOEmjJJLTNYglctZMIaIAGp5dgLZ8CWaMbpUv3+JpL/GBWRC38/pGaUf1/Lo7CRq2imVPk9tO5Ck64VA",
-	"htYrmWHGhb1qzDLACgKgcAxvbbG2bg1GbUd5WNVFlR3RbIYSge8RkJuU7vYSk+opvmverVgQbcfujNH1",
-	"IQD0mDPEuc0BQY9wmUv2iP4L/Az+Af4B3rmiHLXtOPz0BQIEPZbbwhysSRHoDAAgGJ7PETMBn6PACIuL",
-	"6ie/3FxtiYEmU7p0S51cK9RwqbPWwBtIHbsGX6AGgmWRCfxGJxhWeMqdOIRIak/qSWE1hu5xw4CphFV4",
-	"Tdz0uZm6ZzMUv4YxITDnCyrCYZUjJBzJ/cP2zK090CbmDM9QskqkCJOddFxEiiWD7bYFcV4GNaM4Gkle",
-	"nUs+k3piSpkItC3UbFe+uN2vxRKSNwzBVBKXTdYEUs9LY4vMQYoExBkHcEoLLVoyKIWW2oRgkHBssyLc",
-	"c48R5K6sgyuYLDBB5eQx+JjniJ3BJcrOIEdASPavrETOzRSwUuwnlGiF9Feul1VfUHljWuJLHmd6U4go",
-	"jm4IumFXlCF9oaMxeUcnOlRrkb8qMfyRoMccJRrONVW5GGV3m2DrPIFiuYRsFUKEE9O1khbcEWQ0nDs6",
-	"51ruS92ifzOaUTmeSmdxkEtVViW6auxxC85o3VDYSOgY66Ate1LMSxFch4xnQC3QKOkShlT4dpTSoIQa",
-	"00FrDqlplbLHwp04k3oipI+3kMEsQ9mk4tSnaAaLTEQnP7kSjJbwES+LJSDFcoqYPAMbEDABUUjUejCR",
-	"h6SAq9NDMFnYkzIwopOf3irFrf9554pMe33Nfsn7Hi5xhhEPl8CNEetgh03JO2NICedwkP7BJnlbEUyv",
-	"we41YxUU2u8UlTllNsgt4dFCTJCUOtwt5e1ZK6sFE8B1Z8OfhgSlXpEcqoxNyY6aZr+QKnFSBqZoRhkC",
-	"U6RYuBB0CQVOYJatpDSWMI6+kKhCD29j13OLDlb13Qwd9J5nMyugb6s1K6FTQrFKT2njw5pg0fuWP0yV",
-	"6QtJitL9iywPDp8gwvYqtjzLd4ixXmKpirV+sK9i7ocQc30HHZbuPHF6Co3MXdNiLzJrEQy7OVR1To2E",
-	"kIeKlOv+hUiExIBTwzkLSOaozP2tDE2pSiCFyldXjUhKaEzmX9RNhkbEoY2j5yFCBlg+r0z/H2jbDM0J",
-	"qTJIYFpIv7rpSROpzNmXKqIYNYdzdATU6Ey9nADLgqtU/Yw+SA5jAP1ZwExCkH0n+N8oOPe8TkeevfV4",
-	"gM/YoAzZvn9jbfHRZpC6ajD6wsgMMDMAKo9K1offjrZV8msDMiArMqqSChKQAVIZ57oSH3ITXllD9Xol",
-	"4FalKmGndNl7UOtYrX4+xZAIeS4lu63H3VdyEw3SQ/NuKzrBSy4m/2uyjv00XjgAExaq0kmZNtb2J4SU",
-	"URcVqmjLGtWlkvzl6+E6aE/f20rE2tNlXDlrT5fJ+og8PT5tfhirWtzMdx7B3h8xPp0KhzY9QfWKywAe",
-	"Gh/vlVEB8fIw9+blxM/75fbB4+lhS9xPfD1sLf9p8fZ+rPwA8fdeayzQ210b/cGPVGrFCfpeZDRe4/a9",
-	"xqgXPgiYv/3eN2wdne8NfLha001YpotL8bezXv6gU35Gl3kmycStE2WXSzQTd3RcEE8RnXYKTI+BkRt5",
-	"p586a3ODMoCJFs0qqwPkBcspR/zIIqGZtCKNryiOPn28vL4Yn/4yuhzd/TOKo6vTS5OqMrk4G1/cyZ9G",
-	"k7Ob6/ejDx/HNqNlfHNz99tINl78z+3lzejOeV826fOyG8kITavbWtz2RW+7KAt8vGU48d2SCLa6go+n",
-	"QqBl7rNaCo4mORVDCg+0hvh4tJo83XKkelOPdfskXOZVenvNpzrE+orOoYBjBN3Wi2zUANztF2SOCfrk",
-	"zWmUinqmlMB7nPns0N8IfSCfMCu4r4dZwjlm6gE47unXMdek4HnfeqTSu4NfUWiSppx1k2gF32uc4nkE",
-	"KDYNTWyi9mq1l8I0X+tpe4AGrJd4CluN/yn9sNUN14vU5Fs361vI38t3eKuWzFVhSZs42n3IZVjSuQDz",
-	"UrH1tr3nJQQi6RnNiqXn9huR1Ka6tRtnOEO3zhdOEmm1F07mcZM1n3VwwpUaM8NkjljOsIvFr6lAJ9pS",
-	"xFzdCOiwmCfHhomurakOvs35UbxRrq85nT2n+upZ3Tl3lfBQaFkdvYNNUmBqMaYn5xHWCvwMS76dI4IY",
-	"zExtOFthSNec2aRKUaCD0agKOLCkXllOj5vSgaqT7qG4QHqA2y6xdwfnGxR7ErAdsv2K3O+07mFWBFC9",
-	"HG47/+5cqHQsuzMXjEOqBx15DnqDvCB+1mWK1G/3zLEt4D0C6B6x8sZHiTO9QueL2gFuetvJsu56gILR",
-	"iPRrGN1+RpfLGkqaHZ7pTYcoyaQfB137f0q6jCHDwEyZrcU9d0ClARMfhmoDpLEeMSkL03YXcQm4ZLLm",
-	"t72kuGVUimm3RuvIPxpyP2XnfPLt1Np3qD3g6r2hcr336pWZA++/7Nr4lAYYKPZ5wcaVIgbemZWTCSgK",
-	"Hkanujaj6r8lHtmsKs/9E++VuiTkmrOetSqoC4CwozP9gza/UZoF00P3m2dhJz10GKON501CGnVGczyM",
-	"VhWvn/w0mou78opnw7cwNnR8TYUNBcbVh7Jl2lMcjRFMV+Ulj+eOzvPUpR9JBd9cBzbR/T22TtYGIwN1",
-	"oGvkUD3ogBGqnRxDQ9IzXMPC9I1j5EAB3oLgJ4ph0cBPV0EFyezb9b5+tkRjX3SwLOXYDabyaL57XXFk",
-	"NtK3zYFhQY3SoapAKyiHFtiFCrCTmaTbg8j8zSS9PbImbvHSVxrA3nx5Cmra5mpx0a7F1yuRVmvq+sot",
-	"ZLAgyWKYvnhSeYcMCjmLpxrPPgpsS3trHg49qNCvPzBaO+MK7hpnExsiqWCodjguv9Gd6fbUaGajXmC7",
-	"+isPx10N1pkcGXA6fRcEKeaC0UFTn+shyiV+HDTyPX7UbLJCbJR6Cs+Qr0+03vJ1zZzAV+a5t+JVYEWr",
-	"ugdVKWdVVeQrfymWbro5M1TSdLMEw8lwqrky41RRHVsX/In1dryTtFY9hRxNElpL1dQxKnUfpc3S0hX1",
-	"9cPLHCbC1967wvOS6BsOqvod5Frm82qSiElUgyBFQl2Eg0tMikeg+AdPC3eJ/NH5Jf7q8ISlKh6d/+/l",
-	"6LcLMMMoS4Gq/GmTpGXzMRLJMeVvGMoQ5Pqe7Qlvl9fPRPxXee0duRRWhTIa1eR1gx8a+NsS/kGVSaH+",
-	"OFpiQhkwAP8eVrLCW1w1+LauLpP3fGnXkoftqzvrQPowv/WCau34T2tRDodkuM7a0urCksvXge/G2g2r",
-	"5YgBK949eednDKvHOY40bU9C9694vgjvfUkfwjvrEnXh/a/RPMNzPM1QwJh+vDtq7J2NR3ejs9PLKI5+",
-	"HX34NYqjq4vz0cerKI4ubz5HcXR98eFy9GH0y+WFK26hDGrNt6YoffTp6iyD6sr29HYk3Z9S1kTvjt4e",
-	"vTUFdAjMcXQS/f+jt0fvIq291a6OyzyMY14mbJggall3R9od0Qckysxbk9sR174t6REh6y7H1U8p+vze",
-	"ZnfzPcPQ7uXnEH9vfNfup7dvt/dNO719/6fstBVpKj24YZWLO6596+57NQguca6+IQLvIVYiAJhDUvUA",
-	"HYd0WzgOSQpfxMUvNF3tBAX1jw1+PwjiT7PM4AY8IF04yyYHzIosW23rRCa+E4mjxzcJTdEckTcG4W+m",
-	"NF3Z73TKvxWs41mlELWP08pi1c+QxfTdaWjvO5qHL+QrDu9svu36rARDeWz7Ew3rd2CqdiV3CQXKqwS1",
-	"C3FQVh0PkQfvdjNt07Ah6KFWcN88s1eI+nmLh97zWdGRrvNfLoUXcqZyHf+9bWSYK0bHSkyHytXglmhR",
-	"5d0jAO0eNxCGx9/KD09/11ZqhrRJX6flc/W7peb3lY9VD5OT689c+wRCNzYq3Pzz25/3RUv2BEfnKtVT",
-	"WeXbOkSN2fUhHumLq279tJUD2I2asvphD/K+R9z/IAQiNY5YoPIZ4oyyBrXk9gvwDf0jf94+yx5Yi+2F",
-	"ihTqUFV5rE3aZ6bIfggaV/iuUnWYJvN7Y69kvwnZf8z1d5FeyX4/ZK/xPZzupQXH62V0fBZDtdrOq1P7",
-	"kpza6sntz6+t1jvq8W3rpLWbaFelduRePdzmzC4nt1Zz8vCObnU5O3N2W4VJXZRZWQjMGILpShfJ49v3",
-	"fOsVmjaQncff1v8E+cAVqp9URg4WrtVpX5QzXD3enTrEtYLTHU7xbk7k5XrH3bLrxyQat5PcpKAuR/lQ",
-	"VIRn6lv8O/MyhurQfdGhdbHrauvw/kaHGn0W3PLMtPnP737aF1YuBJyDFKfkrwIonjnadvih8YmDp4Ug",
-	"XgXKfgWKDV68CpRXgXJogVIGdjaQKNZBqTze67J8bbfX4M5LCu6032juJ8Qz4Jllf/BnTXq70DOOx657",
-	"DQG552+kJKOHEpsq/wmmKUotOk2VBeOL5CjBM5yY8qcH1EV6wbuLEXkeX/tUQUmNVV2gKz1o/EGSrpG2",
-	"/eiRQUfjlPxnt5kY13Em/Y+JMwVI9UllzEaGYzn4BcczQhjxgFENQz+7imrUqDQoinEI2tm107GZMtgv",
-	"Dd7ZL+ZVhJpSCrnNJjA1ml+UXngWzPRi1NOPFw7R+99KNORVMB1GMNnICGzw+TOJjbzKnVe544iaWItn",
-	"mLndGy95jZS8vDSYfSfA8CNwYT9wZwsG88Z3QHo+zt4bPNllzswhsmV68mSeS4LMTjNjekT6rpNhOghy",
-	"qBTV4YrghBhl021ozb3E9Jed5730Jrw8FeMvO73lmYWA9pfRoiP0vZqnJ0K0e+LZxyX0Ia6fezNZno1X",
-	"dVB3atd3zMMV7Q8XmNlOgsqrJNimJKiloLxKgldJsJ9QyZAYiVjXAfWZl7ZU6Guc5OVllOwrUmLJqDPM",
-	"sSak3UXeD5MV4g922C9rHD7cYd2D3aZ5+OWvuQ7dbdCj/A7IQPl3/M1+PTYgwmHo+M6MGCwY7VTbiHM8",
-	"EzLamxFxZ7/gu7OAi95gZ8BlewTw0rNwnk/gZYeEsVZwvdGUfVLGfq6yD3OB3eVNlRKo5U8dmtiehzr9",
-	"kRway3ZPjW288uUh+fLVSHkVD89APCgQiN1bni9YFp1ExzDH0fffv/9fAAAA//8AUfassMkAAA==",
+	"H4sIAAAAAAAC/+z9a3cbN5YogP4VLN5eq5NzypKddPeZ8VrngyzJiSaWrREVp/sMc2cgFkiiVQQqAEoS",
+	"4+v/fhc2HoWqQr0oUpLT/pJYLDw3Njb2e3+azPk654wwJSevP01WBKdEwD9Pr/BS/z8lci5orihnk9eT",
+	"s5QwRReUSKRWBAmiCsFIigTJBZGEKawbIr6Az/z6n2SuEkQVmq8wWxI5Y3crwoKPiAv460+SZPpPzFL0",
+	"J3Kf6/9zmFXavgczNkkmcr4ia6wXpjY5mbyeSCUoW04+f/6cTHIs8JoouwOc05/I5uxE/5vqxedYrSbJ",
+	"hOG17ug/JxNBfiuoIOnktRIF6ZokmWApifpB8CJvHzlsssXo3QNvMeaGzZtHeVkwe4a/FUQqhCXCDEHj",
+	"leCMFxLxnAg40AN0BS1lzpkkiEr03cvvZuyOqpU5S9cQ3a3ofIXmmKFrgnKeZSRFBVM0Q1RJPUKRKd1f",
+	"EJxuzJHCRn8riNiEO9VrjuzrmvOMYAYbm3MhSIYVSd9SllK2bAVcrOU4IC4ISd/DYNEJ/OeRo5rVnNAl",
+	"ke3HXm+11Ryn93MCR983Tdhwq5n6Jhg9Ll2854ycYzVfNdFYI6amVZrmYJQLckt5IbMNEmRO6C1JPdoe",
+	"oLOQLKGUpuzPasYMeUGSsjlJ7JUoEf37l39BGs95oRBG17yCtYZeljs8W7zQS31h1tq3q7XbUdtYrcNQ",
+	"psiSCBiHcU2Q53D9jjlb0PYDiDYddxY8xQof84IpP0ft6v5pDl977i6Mcwp0vnUg8wxMBizoLc0UEa0D",
+	"LcznAQN9ECkRbzatI3H9/XrTNVQyuX+x5C9sDzegm2BKsIih8VtByAtF7hWS0KL6hEpAQYQRtFhQkqUJ",
+	"IgfLA4SRniiZsTlnClNG2RL62VEUEWuJBFlikWZESj3sHOu7cAVfsCDojotUIi5mLOXFdUbQbwVXJEX5",
+	"SmBJZGJp+rrQj0SWIUBbVDAYb87X11RzALDAD5fJjOmn2z4AjCyxch/ff7iC532pX0b3Y44FYWpFJJHt",
+	"r8GfzG6GHOAU2IjW8zNcxqCBbmjePoz+2HMvYZQr3j6I4v1juHe19UqHLcbd5FzwW5oS8aF3jljLcXMJ",
+	"knOhpvMVSYuMtE7UaDZuFjnHfRSw0mTb0a/IOtfMxIBZgqbjZ+scf6sRL4H/eovXNNu0PdLmY9fYfxJk",
+	"MXk9+f8clsLDofkqD6dzzOz41Uk7N+ObjNuSwvKmgynzn8eMCthqnn+QIs7YLc5o+p9weV9rgYkpYl4/",
+	"nOeZfU0P/yk1Gf80EEow2qkQXJgZmyzNhxOsMAKS4eUgTaypWY5hyIkeAZnO10RaQm2az9gCU819K66J",
+	"rCRAe+9WRJAESY7UCisQzAylTqnMM7whKWL6iVG6AZkxWIAmzJ+TyX9MP7y/0LT/LQy8M2Ac5fTSQrwN",
+	"GnpqBHPr9f5Z6RXDhGZ/oax5Tea40LtFGhlQyokELo/cU6kSeEKlCgQWCyUrhnoBRU+CEcDaDm2h8J6r",
+	"c55qETiNM6MV7hKFzGWVt/TCE3CvkjCFKJuxCgtpnsSIVB6Dp212CG0AkJ5gH83nJFc7PDM/ctuJOany",
+	"DkskFRaaC+iSMKvbfMfNquIQzii78cceDNBxqT8nk2kxnxMpdwYCO14X6tomaE2kxEui0edndsP4HTN3",
+	"/5FukJ3TkAtLlqGjHvfo4uwnsmkC+gjdkA3ChVoRpmBZlrM8ujhzp+sozgrfEk1LMGiFqEDXBAsiZkzx",
+	"G8KSEtVzItZUSqBmfGFUAjwjB+gDyzYIoxWWnvPV01M5Y1JxQdKk/E1Jki2MDsFqn7i+XF6xpBdoOqO5",
+	"IJr/NNcoFxpZFDV0PVTSyIiia4EkUeWsmkxyWKQ5Uv07DOFgoInzmqyvidBb01zwxu5E2paG8ZUJEGID",
+	"J/32WZoj4WcrIjteWJG1jIoY9gcsBAbZwm70CBBpwcUaq8nrSYoVeaGofQFxqqHsXsDGkOQ+p4LII9UE",
+	"hr50ehgPDdsWYaPcY+SWCP8jXaCCSaIOJkl8KY2pKRCm3hXekE18bZLMBVF6ZYk5JA1tyqr01hIMgFSg",
+	"o7TYcjAERMzyGo0PuSALeh9f3IIKCe+AwHNlsMPBMYFFkSxzP0iEcyzUoMXoi9NLGOByX+qWnz+HXNB/",
+	"mb3YUX71w5vHSw8fdG2qDu3azJL91dBLDoXWBJlngouyIc4knzGDroDxRa5RQ3dbo+tCIcYVSklG7G/m",
+	"thyla8rKQVIO90utNE2ibJ4V+tKgNWZ4GZIpA1Fz2zROqPJqEVasNRhg5Il7KrmYJH53k18jUDdggUtZ",
+	"pSdzpxOpYQBXOPMkCRppEsIFrNji5JLeEoaMlkK2n72XDAPKUJ3tHdW8zSLYfOdUAJscL8lBSGz6EWry",
+	"uXWNliJ9jmEUY9zYBwwBTlOq/8DZRQWQDZBHFCWarOgNHt7irCAox1RIuPTXmjYpIhjO9NvF1zBfgmSh",
+	"SbScMacJ1hTg7EQmSNH5DVGIFZZ0C5TTnGSUESQKaHOA9Ikb/cc1mRkGHFFnCdEzW3ZarexLcU0siOFx",
+	"MqoajbIeAIdm2rMTRH5Df56eHr949d33fz4wPC7gMhFLa2SBg6TMseTAyOomwXAGp5sQD/iC5gPPHKtq",
+	"RIHgPaUMFD1zLAmQK80jF4LIg8Yr6jibfvIdxQgpSeTOnHiBBthFfa6WBY+/4mdswXsRVze80gvw700D",
+	"0TJ8TbLIrbrCS49d+kAKCZjCSiYAIGbx2XOmTJu7jEqDpLqvZnecLsWq7xTX/fQbqrgdynAJNQaga2vA",
+	"7jdZAq0Jl5aHbhIQzXnI4y6aZW6EPn5oa7AEOD147fXlWIDURKVZ+SRGqGSxXmOx6dsDqA0s7zO1XfSe",
+	"NL/INGfzgfWwJQZ4WtxgHGWcLYlAC16w1J1aTgTlKZ0jhcWSqBlLqZzzWyI29iyc5KgbUyYVBq5S87R+",
+	"FQfoPVeACgutL40ygVLRLENucMd8DmGEWq/IMV+vKwdZ+36qSULkTcLufvXeDD1UcJfb7qPZZcHobwVB",
+	"c86kEpgyZbXAhqoCEA3lmnO2yOh8CD/Tundg0WPiiWZgrDIZbD9wDklggJRznpvTNAisG8E/YV10mcyY",
+	"IdLQJsVydc2xSM2rKIhe2VxVeIkDdKak5/L1YQNFtiiQUaks8TR22pL34IxIozd37IkCmT8jc8VFq3gS",
+	"l0xOPAvZIYCYxx6AU9X+G8FD4aU8GCVhVBbxqY2HH84yd5MnhZdTC534vXdbB7NIlpWilt6ZfsUNMY9A",
+	"ZcYALEaDZlovyofGHqo5sTbi/VDi3I3snbfZX4feK21aPu97PZaPDl7IR+Olgzn3wE9XTmoLnlr3v7QM",
+	"rVzRvJObQiJoCRyHQ/uK24axu5L00ditMTepG0ZVutJ/9vthgwbMu1O2aBuFT00dQNO4FkDvaOotNBGi",
+	"VBXqOnEiaPo5mWCh6ALPlYwTeIHvEC9UXij/nMHbDfYpSiQq8ozjlHj+Tn9lRCDFLWNmx0egQHRjXOP5",
+	"DWFpgrBCa65vOAPW0Iy7OUBTzRpeb8LGRrWIWTkkLacf8RaUcDyyA8ELWWQZvs5IG0IPZ+IqdEArBYmw",
+	"/h6kd23HZVu9TOjO13lGMZv3qpmOfUvXNyWZwvBHT9cT1xCoilFDZpyq3gWfmnZuQqc4vRB8TqQkaczv",
+	"pJUUrXF2h0XvPs9NMzfnmkrDSRZi2A04r3VwA+VZsaT93S+gmeskiCiMqZWSljtUvTCKA7XyevJA+Wyk",
+	"/xeiYNpJasZAf5sAY6Vb+iEI05iaIhqMYHYEjJPuP+46OFNx/zUQRPJCzMmxPsp+Fuiy2nyqsCJmGEWY",
+	"Y2KHXdZL36WXbRWcq5sByHtp2rmjlNd8ALiu+dp3GHCzzAaqFMHSyFOWXtE16ZCoK0jCiCgF4gUX/oPD",
+	"Hi0kC7Lmt4ZnGKb0tyOf2YHP1pZH7dtTo0851lRhoXa+M2dEGr4zMEf0Hyg080eqsCqG8Yu6y9Q0fzgr",
+	"IQo2L6lXHw25W3HpreRa4JXojgiC7DDeHD9jjtCRFK3xPV0X64CVctS6/vLat3zGKi9vhFgZo+BWb++V",
+	"3/EQsnNbZIwIfE0z6liermk+Bs035mg/97NVnRJflfsahBy2+bOV/co1jhUAKwbTx5IAK5MOlQAR9M4I",
+	"W6qVsx2gjN/pCyAQ+a3AmXHNWZIp/X2kxFg95C3FRkdGSPMMwFOgKpo1NTs9NyfDUl0JzCRoxR1VHkZB",
+	"c8GXgsjImVwZTe6cMIWXpCIZ2H8bcmLRmkqUckaSUJUzY64LI0K3y0jYkUrN3rgVGBJjKdjk9auXLzXf",
+	"x8xfL6OinQOpMzG+58q8W+kkmVwQoHyTZOLcHFNrctxccd1skkzO2IXbfzI5uoZ1T5LJCWckYpPsPd8i",
+	"dslGiAY1ZBklGTT7DuXvmz2XhBGBs/EdB3L3kY5jGfzmEANZ+2bHoaxks6fmJrfoNYxpaXYc+ULWB2hF",
+	"X9AegYZh82Exef1fPQ/vuRUge0Qong5qd0LFoHbHxuueCGBFB3WZvvkwbK0fz4NBf00m2qQkKCh+jFZ8",
+	"jfNck4DXnyaRdQxfcTJx2+2BRjJx8OsBbzLxu+yDQjIJ9zkAFNChu62BriN5m/d4XeKXU0rGkW4rhXSE",
+	"E9mbIlo62fsp2I4tmY07eTRvgeLRL1NrngHoFczslCEulpjR351rZ40vNk2NS/masnew3cnrV8koA9TS",
+	"kfRhELiTl9Cln/mp6VbL5f7aCZ7pnOckDqN5xovUgwgsmw2oBPj9tPsNFtKy4Q/B6XbsOkSClk1bkIza",
+	"lsPGAUxsJ0xHb9vCs9X5aYEzSZIIIMzZNTbvcLvnCtzm81Hw+XhxPPrMYSkt24bX3p3yiJ0b9QPPiTHd",
+	"BxoFzbwftJOFFrmhSmi8WaGBaYqbCXS8KFnnalNqQvFc0dvmSOD3YXh868tcSK0mNRM4szF4JJebMLbr",
+	"Kqkz7tCdhvlee0GWXZZXveaQblwwM4tQMtFLBPuf9lnR/iqCpgR530rr2mRbH0ySmErd6s+usA4dzgoZ",
+	"9dv/eO4VbdLMxji8TRZsFlbaRRL4k4hR/xuiXzzXzkQhBpM7f4Fvw3PTkyh8Q5hxoLMHdjDSet8H8sgq",
+	"hkBgzO4ff1NP95wkE7niRZYaKYHnOUmdxle2hBOPo8NWjXyR4TlZE9bi8Z6SlBqdJiPqjoubUNvArAvg",
+	"0S/TxF0cH3tbXDNtRdRMmSTzQlC1KR2VKhShIl7G/IBc/yDjRNT/PTKJW2aCHHQcQTILBLI1YxqbLE/5",
+	"8eLYda4RW0aE7VVxNS3RwXxsJb1uRlrVvRskqgJV01KrckfUZJ6YMeNEb0YpVTt66UTTHGsGxrecplfg",
+	"3jc1TbWhVpI00SatzMVtVKaSObit47ng9vPv4PvDK6AaF4/RwgXrd3X826971bGCpgOe/QrqDL+o07Db",
+	"aD6gzY/g90IQZ5PzPkxjIKEHQG4EZIbYih8azLjoGffDusDTr6m8Ri/fLcLQZJnbcXmIrS+6BY31VHI3",
+	"LZhg8GsfTvn10f/66AePfh0bh739zdv/YCYgcg0A301TUIikBGcZN0945SAU5zZQTHcRBYO0GUD2saic",
+	"DzPu3rFLMJLrcNRkDN9RvdOwpy5WRE+RzFiNGwlfs+F8h6jT6uY6a6sL3/VbKpTWNK2x9ngmMgh5tBuY",
+	"McoUEQs8J9EnH0mGc7niNi4ypfLG78I48MsZ0/1uSO75lwjTUl1kG/MyhMlykI8zW+5rsKvaER10sU1d",
+	"XNPA8etQjE0HrE18LnyLaWYN15YF6uaVEtvqVYK+M7zu99CokNWr83DOCa5O8I61aYx0u8qDt62i7DGJ",
+	"XLBcgwYPUjK9wfMbjZcsvcLyJgIjpJNiWA46dHEUBZM+RAZn2cbdqWs/YpM/cXFhLRemdPcwORZgjjIT",
+	"gpvapgc4iMYP6X+KW5xNyZyzVHY49lwTdUest4geFhgvUbAyAFTPU97Xe/D9jM/6T6oUEZ1zOqcWgVnK",
+	"1zpkFW90LFeZkcItPQnjA5EgEOUvaw6p2kz9ZwXrNSRO3iDbQ+K12WJ8rdrOfln0uoNVMUN36FKOa/Bc",
+	"FiOt9vYhjXte6sPvw5PxCPK59w5Y0FQRl7B03NaIy9fQXD58coyEQ3XN4McJfuiiNmxuJehySUQs38gv",
+	"K6JWpJzdOOJBbga7CB+/RJlUmnjzBbomwO07/4MW5qYHrhFLmSeTg+hldbhhT0HgpdycfkEzcoFVJLma",
+	"/tW7hxh3D6wc223dmsqRkYlXjx2F9VtzT8dAh+q3QS8zyJKIXNAYAzj98ejFd3/9GwoauZXXlpgX1xmd",
+	"t62USlmYjHixNApH2ZILqlbrtgbaNhhZHP2dVNJzMHRNlYySpcDzrDEB4+poYRP2DbsDjKs3ZMHFiGsj",
+	"iaA4ew/EJboKSZcMq0KQbmjIwqBf9GnuwlCXatSGy+AsG+DHEPQHB4ERnMsYTuHXQUt3EzlPpovLs49H",
+	"V6f//dPpPybJ5PTvF2eXpyf/fXx6eXX29uz46OrU/Xr2/ofaz7+cHv1k+8E/p2c/vD+6+vny9L+P3v3w",
+	"4fLs6sfzaL6FelxCryfTINpThXK/hqsLVtJkeos9MnrMlkAESJay+QUL/WKe4E3kbQznsBwb9CJOfQQx",
+	"R+XrmeKNVel6ZzcsTRfKlgfohCwwuDAqjr5/aZr7XC0zFrnF0Z1DQqv0TcbnN5f6nzEmU+gPek0m/ZVW",
+	"zqq6xJKiW54VhqupAi6zurvgplOm/vaXKJ3hi4WNj+ltXL8gpmfi5oveCW13v7BSc3gVjn6ZTqxoMkkm",
+	"0+mPk2TyU3FNBCOKyDgqe6+5N4TNV2ssbsIRj8+m//3u7P3Pf58k8O+TD8c/nV72jHS8IvMom2/5kLn+",
+	"7nSQrhO6dvM3YX8dLm1YzE+5m8/JBCZsk2fPTvxbButyIoYbwAbi//Xgu4N/iz+/I154N4nmiXIiNHZA",
+	"No7YwIPcpDfBoAa8saEEWZOU4tY4aUVVRoY+JtVz3u5BqY7x6I9KOX0LmfSn3yIelN814TLgL7VACC81",
+	"D6cO0JE10ZftjYIIepC0RuqGPRNxHK/L8B2E/nMfSJTgWZSCkgURBCQhbgwIumXjJi8EXpM7HrvJtktU",
+	"qZBMfMcWmUzLnN4U2JzO3tTjs2mCLo7PXpxMtQ8Fen82vXrxby9fvvjr91HppwP5QywrF5cE2+hGrxbu",
+	"oIr9IziExrXZhkuIeGU2hCb4FCGYJt27OwRohtaY0QWRKgrcrDVp4ttCK3S07x/krawiVzn69QaldNk2",
+	"/AB/AKlEJEPbj7zchmsVzKrpc5ktpU1zKUjOJVXcTND4rK0lDwlXaCdziT+hyiICcMfxslZwIJp/ibI5",
+	"pJFCc8xSCnnQdG5Lp7m3zkY+WErzeHQBR6eAiZoxH5jlrOj63oYh/YovjYpADwKio6Efgq+pBDlSD2Od",
+	"8jV8NMPIZSGIveKYmV9I6tLsaX2a+VX3IemMhS7gmwNUbr5cO1Bivs4LVUZs+1hsbQWbMZ/ZdW3zq7bm",
+	"RdkqGtpXH5BtzIksKV5jA+OSpYC7wpQQFlXZMMumWNBAAJ3NEugn3nnyxAyPXFGGt1xQHuXQNIj1l8hM",
+	"XlzhzJrlbIYlaDvFa2D6kCgy0kIbJM9u4zmimptzFsJKOh49tkV4CDnkzD1/ayBQC3o/BgR6uP4Xxmei",
+	"u9TNQWVxS7RpaVyUoevUcDt22Sj06MHglasQ4mqAJINo2lhn+ea1erQQvnmMJO04l0uT5m/lLl/Hi7jt",
+	"tciIS9pSbi3E84Py4vgW0gTXhg8KVigj+qarO+7lc6DzCbJxUwCJCokP3p3g7pYPkL7nCbLZEo5YasPd",
+	"KwPiGbMhTgk6NQ+MCYg+Yumpe1oqiI5w5QVCkNTYv12ktkbztLlXKkG/CPO+TX8+OwmWhGfszn7RPfRX",
+	"pMNexQbVo7/stHbVxkrtYFxJLGleu+PLs6uz46N3hqC4rUN04S2QqwT9ePbDj4jr5/mOSm26Dx8flyRZ",
+	"/wZt4NCrY1dzmbrFTJJJA/ZaMzcIylpBFwVVVBNRTd/RIT4b0RlqgMXVQIHdxxXxmTFpEmgviqwS4mks",
+	"YiD/xXiEayzJtFZ4oCWw3YX5w01yC9JT2EWFy3YsDNZsquLRp2geaMdGCBsNnVosxbNppA9YtmUazohz",
+	"lxDgkES95tD5GHmFHKywjIv1OW8MEAS4Htk0ICEQ5tonx7lx6QA0Y++EqY0XQAA9/XI6oFKGFkWW9bkD",
+	"jBeyfOxa/S1KqXjfZt8MZaVxks44e7y9Y5GH8pa0SOZ9ifZabbbG8+DkzSi1UzIpRPZQ0alt21sprGzf",
+	"x1ZUhfmEGqcVBi0PutHlJraH3jaGhdhwb0nMdHxUfS4T91LqV9A9VgtCUks/qq4Kd0wnv5IzVvEYMf7u",
+	"4tbkGqr4PkWo9A1laR8Y9dJ/0u2cAGMrGfb1gXaddyVq34RnQds4KataLOaFAKH7lghpPXUHmCZMavO0",
+	"N6N+bXSjEbEwHiN1FCKLz1TkUgmC1+jny3eGSdQHS8NZ0ELwdfRJs6uKj1xfulPBE1exwdmzU6/NohAv",
+	"yxRhqmu+ljfOfQ1nMj6AEaWCyfAmyFwvEeScwcy8xqKPdueDXBM8qgYmnTpj5aiC58+iPJVH4CgAeKHm",
+	"vFTOgpQuN2xuVMMaHE1V8H7cXWDWwN8liSIzlXA8u3KGsXeq2xUGVhbgNkaM3MVwdKjrS4gNTT7DT9RR",
+	"fqOVAg2gI8ENHGA00mt9sHOOHmQg3pf6zZ1lhtw+46F+MVMyIDGJXfZx2SFQETp+ZVDmiQs8v8HLirdH",
+	"b2aHkC6M6WjJxpguRtwbNUlN7h3T18qKY7pEeMW+Li1ONp+TUTadMV1N6sVKj76MHKGr2qhtROz7o/cT",
+	"SB6DoV6+SsOxL5nUsWUbrEom9hKNuGPJpHImww8umVgkHYHDycRco+GXLKk9/uMpQVf6Ek2rtOdkh8ab",
+	"Sq9krVnYrjc2D/5IE0PzZ1MKpa08Q3whQSezEkbuiBi3ngemv2xN+FxxRk6hzMpclSEQRhLyGs9wawcz",
+	"ZmoQm0Ai941kKfoGrAqVqdGSoO++dem2C6nVL4ojQdJiThDjVBLgx0tddZiJHiNJ2TIrVTVR361kIos8",
+	"F0TKAclOLeZNgx5dj/2bIrs5U2TdlhR6UfIEA2Yd6YBTlm7kFcuOZUXbGE2bBq154j9eXV0g0wDNeeo5",
+	"67Z5Dvo9y+x0v7ZD8LjCqNTt5Xcoozck24TTakYaIyUKgsAITW9JglIioMQ5IIuPgIJxq6VnAmHcVZ6B",
+	"MAkleL6xlTGMbp3rlHjASs+Y0wMAASGKzAMMtL6zuj1GK1IIfV3mZYEUaost2FldIU6LyRB6UVFer+hy",
+	"NdGIkNJiDeb1u7h0FBbDj6k1KgFAznlGckdzbLrUMg7krrxlziQ4Y9iagTSIM+rpJlljmjnlhiBzmlPC",
+	"bIia/fWOXK84v4EagDBBUHPd5UqGeoU5EWiO4axC53zOqn1Ax+JxD5l9S1+SvrJ+fVYm7IpFFeN2uoH3",
+	"0kx1jD17nFLpFU+Naomg3AIIWFHfxud7+MUDiRe+cntbDVzTonTus3stq62Uc7pCW7OQkz8sH04otlV5",
+	"cv88m7TEBLYWLZDqxGxpMwqOvlNf2I1r2JPHOIxjsjDeHKBzzPCy4WExXIUUYl5YSrvLlzOG4SZPsLsL",
+	"FayYsZxLmykYa5pmwSQNnBC5JUwlzao15oM1YbqRqXT3/doYZeKHWV7V+GbMvcZpKoh0iW5LNC5JgLH6",
+	"DPcD6SlyQ9dEx1C2APjo/ZE5at2mdUlYoZf/9vrlS0RZif6nhb73h2+KFOdEqtmk6v3989VxFFAdT36V",
+	"GDSfaaxTp6aWOGk6VK6QaNTU7uYJuiPkJmhnvpxzluJN9TWA8bQpEjr0PwTHZRndWORuhMY7V2FLW7Br",
+	"4YBsaofpisxl8TCHiabJATqyNSRevXzpvUX03g1tilLgIZxnZb2WwJkFxCMAFyEk2vw9A5eNYRqgkjmr",
+	"Y3V7GVRY5KlRgg50FoIukLh9eCdBWKpRK/RDiZlDPfNsmpcBce6slS3PLxMHZzAtc6GLPA1VFV9WVxNV",
+	"m1W8W2vHVQVCCMXE4ktwfL/2XdHwcWrCxPK5gPDu1YihfyTWtxPWLchbvS99cWLNYQXB0nLffrU9bD7p",
+	"Py7NgDow+TS2aoQaunagtpGZfcxZjXWgqpGox3Keqk67e8epCky2c5qyQ5zez0ke94F2sq193Zvvgc2+",
+	"QpX9zbjbJOCHSO7xOs+I9haCdD9eBIv6HGFbbR8JKm+Mn1DtRsxY4H0CdeVM0RqbjZwqm4WcLBZkborS",
+	"LTK8XAY0TDvHeGkdYO4zYXlp0Mg6lETtrx31vr3TJHHwBK9JifyUbOm3FK8AHiROeZCOCY5C+8ENxSKP",
+	"Audlz+40sFjyqPZqU0UULIjffwv96WL3hmDteWWzca8/GdBqyO9rkFVxdB2uz4hi4MBju0E7U5feS5NH",
+	"zvuPMzO6Txks465VYJp52yKzVeS1oD4lQA5SJIFkUrKpvpzcocvNYwsi0xcvdT3k2QRxUW2o8FIeYrb5",
+	"Rr1G6hBq0f+G/kzY7Z+NEG4rQusfU3L7529b5btaKHcT2IHYWJU9EWULbjaBPtavv9EEH8T9okGJfdFq",
+	"prcNwEpvp3Q/cS8AO4KT+Y9xK3q4MOcG1Zzy+OMpjG1CBHxJ6/pkxrF/lMDgkXrbR66kPY/9zvmZ9/bU",
+	"le/Uw167eNmYPalfH7NMTEQh3XzRiaqpZmVAAI2esolMrqLQxoRKl09v+WoGT/MBmpYjVp6Cyms7Yzt5",
+	"bpurtb0ShBfKFCuZ1yIo+CKwoAQqwMpLNewJXtSQ8+yk58XsieJrDtfBEV9ZUSziX9HjEDkmorY22YV+",
+	"scld80hOqXcmsdtIEK/8bfRf4GFu6/H7hvoNnbH2R3T8/fRzxgFgdzOU+rjdT4lSIKgMAZVv3IDVDxwp",
+	"cq8O3TJKqdo9I3BCPg9T0NujsQnVrQrqViJ3qj6r/nOj1ISSgxm7WkWmtkV9KiKuz3xpVgN6MUiTl5Qe",
+	"zNcFzdQLyoIRfXIzF5lkuCvd0WaprbYl92Re2NAIzCw/EeogSJZKBAzGNyYuw6+1gndNRuPbBP1ENtDL",
+	"UWTNCTmTQ8mngDRjhv02QZqBQt8ELeCH6nTfJjN2ZGqPA6jfulXoPzRhLGQFbgkq8pwI+AxlJmZsUbC5",
+	"MtnFYemzyadPttU3Dtqz2WxSMBPBMZtN0IFeyoELZfoWff48m8SuTh8tWAT1MOPpWkfdkIY/9iSOZN4C",
+	"aWZP9HGUWvgGJ0lFeAIBX1Xevg5/7dCrdMvKv22XfUtebXAptm1ZMtk/9MOUml0w0dbuS5NU68FudQHL",
+	"he/PTJdXL1++7MuqDC1/7V1k3BzfAmObwxOI3wIRPF+VFNJFa8OuH6IcjXsMfH7wfn1R1gue0XlE2+kb",
+	"NCyHhokyjBLKOFsSYbT9B+ioGnFtXiVtBN9IJAjE8kfV+mt8f7Qk8TQ++temJsGNZhk7YEghGDbwj0nQ",
+	"70RwzXdYOZ74fGXrpn2MCmQlka76dDFEh7y6WaZji6wXVgODXIuPo5yznfRqfYIhqaQdyHHuNoprlFUt",
+	"w2xZtOUWy+icMEl6QloGmzRUW74Du9cfqXRJCYbDw9iWKhBIzL0yj0YpowSx4QuXg3PQvbNH+bG6ykF0",
+	"r44OD3Ymrg84bBn/Mf3w/kLrrGJOHvojgq8o5fNiTZhC31y+PUZ/+/eX3307GEp+jg/O2SeGHJFWTZlb",
+	"8HVzoRoJzFK5voFer2JzErsoNP2zrp5smCyeb+IJOPIwtgCnKTz1uh/8I8/wXP/L/qCH0aMQGY/cbI/S",
+	"ryzYAfXVt9Xsy37tce2TVsK13An9CWz/aZogu2yQrcBE1Ijjyid2rbHXoMy4dZwVUhHRkhr4PU9tZKS+",
+	"6DJ3+ZMxKkdAczNE02Zrfm+NJSyHfFjBVKYX+bAhdhi5WAJmTzUQWsB/EK3qUMI3nqvIHqnPKuNJrkvu",
+	"rrMJQcxaNcN7tMhTMGDQ+GFVmfThtpcJMPg5qj5Ahq9J9vwqBMgbmr93iFxjhDgsUbpU/YJzZQKHN1Iv",
+	"sPQnSklL2Qk9+i/2JCGn0oBpevDh4an931lKeE4UTrHCcSwNab22EUpJFGJafMvo76RSgAaK/ckE3Cix",
+	"mjHnu71xAqVL4+94WS1HIxp3+dNj9cafV7IcfrZuB21czdn0A/r+1d/+9uIVwlm+wi++q/jN2r4+3ZOJ",
+	"AQPvJI2nJg+bXnFboqdla7ShHc5NpFftlBz8jul7WypMCvmCYKlevAKPViIVAZ+o1rT4A7Pil7k33XIS",
+	"p4IJTtB8kZWV1teFqwt78WqgfeUcU6YIw2xOznlK2rKT+0ZoHRyOj46EtaSFABUaVvgaS4LWdGkLHRtF",
+	"mG5d5Ea7BUmkpYIcowYoGQFNmCDYOPf7Qtp2EvD1o6yxlhiaduZK7TPRlvOhyHSJNaeXfvvcHorx3fWy",
+	"7j+NgdIKvbIFO5XYHC36ksObbPBDZoEXBssbsy4Y3oiECfr+5UtzWawd3Yt1r3qDjbdUC4VEz53Ir1EU",
+	"9AW1GzkHHxJ/Z/3NWzkt+31I+u3zoKlzIyDpFIaK1Y8xH9wx/ePo8sjYw50boM8sCkWUqs7w0NoFYwx9",
+	"bu0Cz8OFxYSPfJuM5hZQbamp4vbg90HOxxIA5oWx8BsDhWjUDiNCtqdjaY2ysfsZDFxbaOdIKUGvCzW0",
+	"iG0bou8oEUMkfm5wVgzb97GzYkSxtGmhs1xPxKnHeRREP5e5m2uOJPC7w0WHe6ZfeBcH5WHoOIl4so91",
+	"Sd3G3OQhzPM6YBHHILJnLR+Cxa08ezOEswGSjmT+fQmPrTTj8ja3fG8Xr4cmxavvosyLZ7Qgx1iRZWv+",
+	"UPCc7bEzt3lXR2HeEe46/NLXD6Z5++f1ZMIt5DWWxNdlFTY8ayPd2Rrnsh7xMDC82owbe872S63qKBC/",
+	"37VWwxWZkfPovfLhq7fLFEut6B7oCOttfqTLlW/XHOIcYu86Grzjd/5rTKfYWNMNza+iZjNcpLQ3xUPg",
+	"/XME7SuXsCsk6d2GUQkaRSvpTKc/vvg/f3n5bwf9ztxmgiHotV3lB2mBErN6+mVXrVRQF2o4Z9l2CoPU",
+	"7u8bEWBdXmHYu2f4orxzY98x1c1RONyp9uDw+VVnrCyx6wK4rI/HCuc5YUa5taasFBL0+D4lnbWnzZjt",
+	"pWFls8cykz62NPnBYnyqS8sr20GTGas01GGVOPiOAiNgW2TlwNDIIGxNn6oBVVzVZTYVR/QyEi4c0QJ+",
+	"wYcLIY3TcfJSM6Fx3OX3CB2fvtNeas4RXeupymg9Jz0UENeHswLcgzhAxLnR2POzHswmNtDe2v+BLwdQ",
+	"jF//x+DjAU3R//2/aDZhdLlS2WY2+R/r/WNdasJ4QWtsXRBh4vesCo0KY+YAVe/+IkBD+IYBoBWk3kpU",
+	"bo/Bwhut17QBce3uzbbBC90CrQhOS+vUNU83NV8sO6rzi9GOUjnUsdMjHv5TctYo+di2stDRr45M4Epm",
+	"pyg9pWQu6BJ5tyJ0XShE2C2krMMsJexWm3ZmbEmUzjX/BvydE7sDF+oHm7KRp86By2BIGXxt3Kcal8Jh",
+	"46fZRDu6zSav0adPyKAlTdH/Dyn+HxoCnz9/3g8mdoeRSpuaJHbK2i3MWJ4B9kA/6ZJVMnatyD0iTKtw",
+	"U/Tj+dHxi+mPRzplm4MHQI4a2uekr7+/+Hh+nGHNCbyY+lwAFo1yQSBfNsyh3fflCn/317/9Xx2GemYC",
+	"wyFcRBBVCFb6SR1dnMUAkEx0hl5Sqq66Ut2tlMq1YlX/X/p8d8EL4YOPB6pbm+/hWCepWHz0Y7m0R+be",
+	"vVN7E0TbubVHqWVPGKPPgVeJZmTIvymeQ4mUV1ea2KjekEZF1y4m3E2iM2LY7m2VS2EFWz/Ajx4WGQP+",
+	"DoMjDTTKIEkP+18HIsLUbcKHiJsPJJ0kk5+ZDzePCiYNMLcF4BgqWSYoCFgsHQugbYqGBfVvCNgZDX1J",
+	"Zk7M91+9R79pYNN3+M+x1AcHM1aLKNZTBq2HxDMf+KW8BUrsyDcw36UntInq09w2dro1jPQAwtYXsQGR",
+	"YK1MkFdilCmcagPSSoKnGSv56XJUVB3UVBnxBa7r0vmMGR6y9NUDziMeZJH6rDDD85sYPijwsh/hxFkL",
+	"RN8iUnxoKquxt9C0vuJv6X2rpWpKGphosCVw/A7P2iGx8tQREESa8SsFmMOMX4H7mvN5M9ZvYNqoKmv2",
+	"Ozw0RzsgM6YaYAlqIbF1MqV/tCDuI0nlKAE5Ai8ZU6h4ksBfoA8j5d9vXc24Y0GVTpnsYK4hM0km4RGU",
+	"fwYHUP5oCUaU1n2ANUOesd6XTSqu6YjZJpTJQ3q8g3jcp4zzn2FmgObXUm7raGDcgjsayKEhtRUXvUbB",
+	"JSw3bL4SnHHNPbimrsC0sWNpKvPCuYkQluacAlH2Ixs+EnIOa3MzWfPSM8OZoheQFiujayhMobFqxgJP",
+	"37lFjXiKEIs2Y7LJ2rr/Y7p05PgN+IsSSB0MxpDYNr+tYEhNZ/RLYL3QjYCk/Rd3X/5oSLZxf8Iu5bhZ",
+	"1zvKWqrk6WJdZTYw50lfTx43h4B+KGVB0nYWTYw8v0Fcnd+SZeU6r0w9fTXEAf2cLwVOyUWG2SSZHKVr",
+	"yn4GzjSZTK/5+udcc0xxQlSdOxj4PwtSADm7NNdMj+XAo1OXasxs4eRaXdTn+UOdJ3fgVt43RbvWxgq0",
+	"o/3PB5qjLNhMoZEm8K6xJAM9yo17GFRBGdyjY0Vb2cXKpTyqMdxOa29EBAVNmMPH1pPRb+X9x658+r4E",
+	"APC8WosivVvqgt6D01UYlE9JPbggSl46sl+Y0mlvB2b3CXIqmY7m4aMSFQYqB518WmeaAjouaKMDqT42",
+	"YjPqAbhCqrc9CXRJpR5DhIktQ1eGZq2Cp23wlLXopHq8iFW21lLG2EiL4avaio7IE7pYNOGK05SklTMc",
+	"SFBaah2NHcqSt8iAFvYPX1sUKNVE1JFi3F5wr2TxtZlRc+h+MD7WNQwK77KGgh8XF2UxcP2jmbXpbeVl",
+	"t+GlHaPFtysSoHWiiw4J62j1/mggWDSkt7uIkj0gJHMy10IcSonCNKuH78bCcHudWwKre/MI3FeEqxmc",
+	"S/hb9YcuBDeyXnI3Fo58T8Ouj/6qwuRxV408XNhwP436frZwrzBDbGfhN6tus93eKyIYzkqHR1EwV8W8",
+	"LSRygIuYWfBAgsXTeMm07cuiJZOcpy23eFw4xQWXqtC+eVy0lWgi8wKuVW6aIqnbutv88dzlnYIII0Xw",
+	"Wpv+MQJB3mT0pNo+CAlfXlrDPBdSJVqWe/XypbNSQT6D8sb62+xyuNXrM0MeBOn9E+QKl6uyS3KllPUD",
+	"wPwIc6sQAsMVXZZx2C4bRWwoSLE7Y6YAEKSSFwTPV9ZSAb9M3x21JvHqZfVKIAJSEryO83bzqjarRYlj",
+	"N340ZmrjtcFQD5Tiy9ItupfUZt/nd939TIrytz1aL4u9a3xvnfZfvgxc+F/Gliwz/Mae4VAIwesNQcLG",
+	"pOpCx7iwKZhL9KAS8SwFDZSNjNLoEWfXCV53RW8GOIEUXlYR08X5OM1ycJjaEkDjydFGWpg6qcZYA22V",
+	"jDyWabYy6+6NshUyupU51geENaGJ84rI1rkOO8px2GegQq4Wl1Z7R2CEpLqYXzv2cVxbdW1Pgkt52RF8",
+	"5oLuIAuOuVE2aaE0drOyNr+NU/MXrRkVETp6sbnY5LoMLxRNleNnBzrph7HFV9siKMFMAfaWMzfAFjPW",
+	"nRNZlQ7XJgT2BM/Jut3y6iZbccaFDKGGctc1LBzsnBlaJs25GrM9zYKpMAiFa2mBq3LLA8JEo6BNKogV",
+	"Oe36YiMQ60LqLnsHWhcKq0bEpCAm/QAwLJreBkKJAwooFWzxDmBEHFeK3MSIV+0h4HkpiCZiNvvoRoP2",
+	"zyYT2pqndEHjyVO2L/e2XV3D845ghoHWhJBPbg9TFZWg3+YBtGR5CA50CGH1GOBcR/KAbo+K+rUxiR0K",
+	"hXIPOhoUS+tL5k0gNl2Q9QV2wT+2ZoPfmXMiAydwlPFlNVDWY2GrN5wBX7/iowrummHmDocXgDMIaWuP",
+	"S35IzamwEPkuimCW5YxGIcjUdKvTLY8vIfKF60q6Chq1zRKaxn0Vd3DVKYu6xy08UbwOh2M4lyuujsHm",
+	"OUnKH0zKE/fnCckIfDek1jc3fx4phecr/6dv7Cixb+5+8C3eG0+VM6aIWOCgZf2D7/Ef/No3+g9+bX8f",
+	"tPnRvGyDPD8eQxt7GXbN1TaevQextg9ObRSSz/5p/7MgYnMat7sf+QyREJ5AZeke69JB2YJIvxXg5piX",
+	"b691uWqqkAMvwt43jeftL1o4pVmf8QaoZsf/kznWASl/k4lJoN82H+Q900ZJ7ZUdxoLyxYJY1zWyXAcO",
+	"yWZtMwYCaoJevArzTMxY+5IqjtTLDg4Vi3IVBhA2lVEJDo3kORaSDAKBLJZLIlU8nZrVb2+QVrlLM4kp",
+	"LWMKw3G0wrcEXRPC0Jpg1pNCbfwVuWy6trWbNfr9EUdbNzpU31X3Ut2sqvD/NbqdsOrH2OIo+rqnRQZ5",
+	"G/Q4nTftwf66Zo6fyKbdp58vwtH8uvxtMJQE3Ltc/gvG1YzZynyMV5roz2V6pha26zkUV+k/2Qe5CZuh",
+	"pvawu+PeLMB92NuSMCLATVI/aq1VA2csKBvIGQyk6/8DSbMTR73CBGfvaFuaGv21EoW1qCKbG9ln5H2J",
+	"/g39L/S/0KvZBEi4LczFma3GZWqKRbFzYKibQ8hBVQB3EGpVu+BPUGXPa+u5mK+IVAIrE4o31D1gfI26",
+	"EsgPqVGnxxiSU+WybLn72nZ1FKbSRwym+6ptV73vY1ltC3x3tx6Nz67Nu3smu0YGt2QfQqxyxPgUUqLT",
+	"WzI1VVhbqLCR1481dSjyBkW/IM5cryObc+MX7XyrTzgjLaPafMCXRQvTyQs15+bO6xCDjYscFK4nkjYH",
+	"foybAd/WbqOQbTTtc3gO2rW02E7xtRv1Qzs6hKdvQdZeNaCRnxm0uivjsmUyjjm6agriadOfrh0JwJFl",
+	"FS2ZVCp2ALpHcz2rMumyIdy6EmlG5zqWRAd9rmwAiwV/kNCsxAAqkXv/RqcxC/3kB8SXNFJc2wfR4m/3",
+	"BQ5wPfSg71NexebcS755XWPfKF5ivhVWRR4Ho6S/kx/eDI0H8LX+R6W0MZ1anZLs90FvZtC0a4Fb+e24",
+	"zT2yx46dNu6yY2EzXIVSbmILN53L6kn4xCen5x8u/zFJJj+dXr4/fTdJJkcXF+/Ojo+uzj6818/F2eX5",
+	"L0eXp5Nk8ubDhystGrz/6f2HX97Hnw67pR2lAbsswAHFva9THyAzMr+uHadkQAK7mA2egwwaYJnxOjlN",
+	"6n0aDap80lmMJGVLN0op8DozfmWAclwnl1Qyc1hHaMPhuQn0h9nEVFnS8TATpLiJvLHkH2YEY1Odn3GT",
+	"wLTXXK1q24G02W4hptqczxEinP8DrAN8rVSke2OLlXWbYWA7oIkJF+UbmmKN4BEk+NpmbAxP8dVwoe5Y",
+	"8PIQArYYWA+rb5u8nvwV/cWIcZ2WpHYZB+Qauy0qUYmKSK54kaVICbqEmEuA4XBh5otg/6dvPpzv6E7r",
+	"oRztbkadCUUXeK6MdcncG7USvFiCe1MBITQkRXqQJmvZ6ZPXKuP2OOt1en23PA52ttiLMJ3+qDNvyJb0",
+	"6/At4MXAyUnD16SLmU5/bOx6xaV6PsnQp9Mf95cFfdULnYN28DQnM8Mpbm5sJL15sATTdmdJzncJ8Wu+",
+	"dkEGzT06V5s5qYYd2xgYWUZMYBtrrDn+OwOTiPjmAxdai3KWQ2Y+2HNFcOrp4kNDHrS1Y9pnK075HYMw",
+	"m77FGuc/e8vt0uur9i6upaOSpsaVxnpVW22xPRRDz9+70SBeY9SRbL3e2EptSNM4WNvQFZN6RDuhEgFg",
+	"tZr5rXGmDaCfWy7PW/tOl0zxfDPPOCPp/Qudrwkcj9y/Y1yvHqTFKz+oCzMWxuNZfLeGdlX8usgUfWHr",
+	"kpScq4NyxNvo7KRDvwYt0NlJYHUzY1tuuHSKkoFVkEr48CD6mYpNVDtVUaybtciK2kSv0VUJIKUi2t7y",
+	"GcszoO4JpM5ivOEIpvuD+V6jsB2AeY2I9QMjqVHL5JmhHc7Zy6E2/A6Jrw7QidgAr+2Lks4YJLzTKlKS",
+	"VsIdYJG/FVxhszwF9mausJ4DQpZqJqmK7+RIPViLoUEvfYh+BKKg+xNyafYcMrbdEmF1DH0jT+t9KqkT",
+	"hoxgWsbcj8wX5xYzfCzfY3svJdIWOL8gmhwZmyup3CCQcBqq3hOP3eCScyH4UhAptWR/zYUaqASG2c7b",
+	"TLU/FmvMXgiCU+C+rIYIUZZC4g+29IFj+JpbTDX+9bAJJTAzTg3tWZguW0oZnOP5ijLiJ0/Qz3muXZbX",
+	"JDvGkkCF1XAlqjQrOwl9zpnhSv8szbKqC/JR/R5e+jjTD4WaJJMPjHwQ51yQK6AuBpJXfGoomgP+xkP4",
+	"Z0buc0j+PoHcKJpS+ObW3yt+AlbxP+RK2Katr8KQ9KQdb4NlhFueiB8EL/LoO3G2MPoFK8dVKW8Yl4GF",
+	"RiIdsmGcCpgv0SpdCpylnkWWHj6wLrhrVbZ8xkbZ9FKSKaxBdMr6bLWC5AQrS6jdm4LX7p0xu/TFdKBc",
+	"z4w5JkdSZv0qc00SeSFR6d1nesGLCRkKpUn4Y9Oray9RIkjV7djo8cG8pX9301xnfH4jfdyVBYp1dG97",
+	"G9rt1DR8TgNMCLX79gk0n60yRD9ooL+iaowVe43vL7DQYdnZtFICAbQWk9ffJdECLhA3FObqsX1t+lrr",
+	"tU4Zyu3gAGoo42kZER979N3Lvuoh7XqEWyIynJdVNvtu7YdKB3BHprw9RNZ9DdkZ6RPy2Jrs3j3FHFlO",
+	"bOJf09hmGra8th/QXHgLM1DgHaCgoApgTXc81m+QpqSbI6v4BhXGqzh1ST5d/V8DEgTWpg1gFjaLL0t+",
+	"2Q1jiSTnTP9f96TsRW5fuvCGapbMoiyG+pCMyhVJG44JFUeElmsimoVU+12cteQWMRUNY4vGsENVFiZM",
+	"HTuMhXE9YmzRW1uteTiLVetRRrdUnIYribMHRO62dG6JnRkUCuzawxgWPXvtMK1mCRiF58P4WN3QuYhI",
+	"Y5+/LNqCoddcKiTInDBVvUeV0EY7DLomc1xIYpXRM1anDnD5pNJXSpNFe3EG3IrBcdaWL/bbir29Goi8",
+	"UJ31oRx1V2CMYT6FnhE4Ay4gQgNnLHyOuEDXZMEFQdcEZNJC8TVW1liODa83hNiZx3SqjasFFqnANOuD",
+	"yMdIlx5m7fSeWsXlQPfJkpczCGFrrc050ydImZYp19eUWVd3jR/SazkzOlfDXHG3kLT6tvqe3CuH+dXN",
+	"suBLi01Gn61JO9uMedMIg+Xc+kGbctw6Yn2F5YwtiFboAUIbtZ4NyvHlJOsMD+Ph1VN8xmBujYhrzbHC",
+	"Kkz5fyrLEn5UhdxS7RoNtBFFLk67zahqLirhgwVBc5zNi8yaig4GuZTCREl5FL92nmXl+ehxCy1bGh1g",
+	"CG+Dw/qHa+Kyl6cDBJBtHbv/FSWBFog8omTQv4KxksKjSgf9DopOWuiPKfmXkR76gfYHlCb6ET2UCPpB",
+	"9FVC+Coh/AElhL43+ouQGPpv7w4liJBbo2kPcxYAOxKkViWo4INRdnU4pLHCjNJkxqg3Nuh+ZyctB2QI",
+	"qgU9icxBBKkg3SiF7gBfLuvGVV4G94BUfPnGxKPETRU1K4lphu5sLWY/aQnOHj+Qys56TjowYVUX5b6U",
+	"RcjDSobtp2LzZpfcZIIktzwUsJzSWTeDrqmpMI/B3w8+Ei3WAlOhm7UmuRjG0n9l4Z+DMv958OJfNfWD",
+	"NPVfGcd/Ncbxq5a1453sKrHnPeSqb6SvwxMUCtZNM8qIS+hjeGrTTZpSdoTNifEKcrFxNY0XmMOpkiRb",
+	"gOQqaEpsOiBmtJtUyaDoH0YLfRM2oZeqy78ZDOuGkjPm3zjb0ScnNENGywR9fVF78y88axXViGexxyb5",
+	"L0Os90cw3U37Q5DMZ2+g2p5RGQqCXRk7HF4MtXrEqPIAZX6VjvVCchxd26EufKQq9F+YMD1fVZO7ImNT",
+	"RUSvxWPli4hNvvukETF6s03iiGm1XtyWQH4C2A4HKYLeGWFLZWujXxMbicMFIr8VODOZxJaAr1scwfag",
+	"f8bv37AymW0baxLTSBqlitaQLyppiBd2ANBiUFZ97Jr5moiwBSP7U2QfB23L8ysFsd7+vmXZm9zPsyIl",
+	"qa7NIuMlW2RiH/Bb4hBWcK6q+06NTLORiqyTwK/Zje+EsxI6OLtxDuplV/BZsUGTbrLrgmbqBWVmLIhu",
+	"DsQEJOcCq/kKpVSQueKCEnOFTOwKXhKNWpAsf6x3NLnPM25TFHTB9dS2K6FqhbO+juemWdCvVtS2d+rz",
+	"eodyrKC6SH8NlKBfmJhhQD6GoKe85uvey1fGUvuS9P0EyzQr+0XKgXW+6dXmfX5ZQAI2YUgc7Kw5bXnQ",
+	"AdjKXcXOM8CqpHr5Kze5PL5oyJ1fpI9vactdDl+NOavUe1iShCHleFljWbbVcz1p9baKVJrgTm/kqiaZ",
+	"aalEKWctdd9MZ1cW94EzWcmzayZgBAbOU92DL5zB4uNDAqXeshymVaPWU0V7ZOaMz7LwGDqI0zdaeyLm",
+	"7VlLzUe8JFW1fRWyPolh20nW+GS7zHLuKvzr556E6OZB2cZpu/RH0zJSqGHTNZ/CLfmUT01cV3pRx7X3",
+	"uAl706x8SnXkYe+B27yvodKUsPlqjcWNiV2U8YOGyU6Dd6ilyXn54LS1iD0tLW0vgsDdtiaNilsDy0E2",
+	"Mb6sbdcFhMvgWWppMi1fk5YWH7d/NzaDQs0+1I1rPnoHktBNkgZXvKAMeGKs0ArnOWHS1crv9kjQt7Ag",
+	"rgCDpejegA2q59LA2XRlmTG9ntfeFk+9KR6Yp3rMbuB/UzWdH8wYVOmtjjTMr0w39V5kM3as70V2YTXg",
+	"r1u7WJ2gj16uTqoNBlaZDg0RmGpsTWnDAfoE8eZEYP2TZFKdv/XhvchwtDInqD7TIJ4Z3YGWE/IvO4LZ",
+	"VmBrsNymZ4caF1GGVSq6xjoloHVz6L2YRkGCpGtf0slg8db5IX45IQT79N4UZW4LW/1ltYmODGmpBfkn",
+	"mQc0AUY0NdFlWb7U+5JbsUqtyLolK/IynirbJASRirK5K70jg2JwNl5+jL9NGxUoDyleXF6SLlwJUqxE",
+	"UxJEFwbfXEaV5r7LLCqVIlyIsgW3Jr2PkJgoCtI4XjVxoSMFUY0zcFsJF9720I/hcY1rDl4uBVniwPxg",
+	"A8irDI0pijxjngGuJIhsr4i3HTs8gAEew5YGPE0Hj0jJOPJSkyoiNGYLNnI039jNLlr20O+vDW8G2yeY",
+	"NTZYP7WqrWIKAoYZ+DnnCOlzHu1LgbGtQWS8ueLLSVXRC5NtU1ego9rF0K+cY4XQhhiuBfiRzDyMkF1V",
+	"IwpV0o6oOLI5GrzjheK5RBku2BxSsjhLeoJMniTp+CVAOXCsgLAAPYpj0RyvFKGFf4BsG8NO9F8t+0Y/",
+	"VLbLxjHQZmbixI9s9sNYCugFuINY3TO+Q7xQeaECVZZX/XBRCi0un+KMwe/uSbLpol29C50rzSZW9MyC",
+	"64kIS005YJuQo+Y8FNyRaDEGzhRhHWwZFA1HKmTL7MxRPmwbtc/NgPIgfrs1wBl4RpeisyvHx9VfYgNf",
+	"b2x9F09qKVN/+0uUdzGpLq+2rwbiNU96+0nlIOzaK5N0Y+b4/IIh7QRYuJSCBmWBK2jy94NS++kDx6K/",
+	"XZh8bUDONQmbHK2Sd70eppA3o3zuPISzuIH1CMyfDt9MPLP9w8AfZY4VCynFwSQZZjJ+7/nuyth60IOH",
+	"GIav3Gqt9GWPwT8T2uJQX3CdGSzB1AgDgWJYEXdFlpJ7LwNRIRUswv2Sm4e1ssMup6t6iIaZtfsy+XQg",
+	"bSWQ7GekqJV3KlS2MwSryaCL+YrekmiJpJ9C+gfNUq8wpiz8Hcig9XGIZDDur6AZ2f0VtWpJ//pfPaT2",
+	"ERFDwR6qJmOayBW/Qxm3vEuDjsX9+fGMlSw2CDk3JAeivyiyLPHp/Pz76mKQNibcCcTCGfOFw6XX+hiB",
+	"6YYEqs3wxGtZzGMSuznCo4Ui4gRvIjdR/4qw/m65aNikQwSJIMGoYz5qGJHM2A0hueGmbZavsjpYnUNA",
+	"/48I7oJNJKLDQhbMSjSRGbsJzSlFDq9UfmjopgIqEUd3YoHgFK3edLLFRj4Pw84re5uqu3tbZNnrOjj1",
+	"2QCaYQn56XGrdUEru0sovh4GmztSAudgxo4siXhdgcwd7saPqtyktwGMt1+LFpTswK36ZgOhK1GwtlrC",
+	"l6Csl2XK+a6XD9aszGgxJWOb61TcnlO1D7bAX+7OiAla4aGO5C2rLJ1TNPqMMWOa2RMLozaCOw2CjGrR",
+	"h0A6MYIwJM+0uESta6yM2K7KVODM+LkbB3ipCNb2jQWk6Da91yaqwo1hsrQYEzWoEVil0ILgptD3jOnt",
+	"m2WkhhFIXGLn6iCOHzHx1QWT1k0bIgjgx7LS5LUfsoyOdMnE3QKDZIV6cXjG3Jr0hl69fIkOQy2injFB",
+	"QudvJikq8hiJL5sPVUp2QLxEjtLS78/gIIw1ePWyJ9ggrtTUy+zDnDAhbMz7zHyt78bcdHumcOPtNfQQ",
+	"BoF3xqyicUA4e0z7fWwVPj0kYhSMrzc2Pkevmgu3oDIaJnRGcNupRKw0dOnnet4HLbNXw24mmhqID53I",
+	"HZC9Lx4APXO5TbdWJQtmcoCkDLSFrmtimDa9SWCmXg0gfVVY1rec1FCiusxWLPfxeRqn2GZAAaOjO+l7",
+	"QhWjzsa/F4IMb16p2dDX+KfimghGFAnX8yvEHc8FXVOm747e1xrnuX0DKosfssFkUtvCsI0mk9jqRmyk",
+	"Xr9iELwcjdiYKlhhvYY2ni/w2BlWvyrm7tOsZfVPfi2PnaU/bqDUTd6Rhbri9h71s6m/Jn1uRU0LJLya",
+	"2rSqJTko/4HyQuRcagWYBUKjWv2bD+e6yvzP796fXh69OXt3dqULU50fvbMFqKanx5enV/qns+nxh/dv",
+	"z374+dLVqbr88OHqpzP98fTvF+8+wL+OTy+vzt7qWla69/GH84t3Z0fvj/UfF+9+/uHsfSvHyYg4UkrQ",
+	"6yLOb4ahPc6Bx9B0zwBix3zFGEy2oCkZ4K9sj/y47FCGkrTWXJPklrQHp7uvNVbVO/aJJEx/pzdmGkpU",
+	"tVx2lgsyPYc7kIfT1RUeNoUNVasWSLbM4OWYTl91ytA/js7fxRUb/Ia0vDXwqb7suni2IPrRkM00GzOm",
+	"t2PzYjeL11cXbMqFaYMZI3d2Ys3D+H4EChcruiZJlWcAVlYqvEFQ9B4VTFHgRTczbRahojsPXA0Qp9BD",
+	"bl/dcDcFEsO32R7/r+0oeLa2BUEYydrUbRnB0oQIM5LVkMOEcyG6Br2g1CfLs1uryLEKmzlmKU2xKseg",
+	"DFz+pT6KF24CGKNmR5QKDNjJxI/RRZLaQ9NqJdHqCF/fT7MmO76/EHTeFhesxOYc3x8pRdZ5m+dLIck0",
+	"58qtUbbUAwuPr9Gl6yBtIzjQ+EmaQ4qen5b4XQIbfXIJwsFZ+hqnLnq8mmTe3uZKgqCoratEsyGhgiFm",
+	"AmCsHaXVycesTeZkThd0XmYV8Kp/PaJVpuu/j87P0NlJlLIFRc3i+Zk09GyjyvDWCe0uBF8lXrk/jVG5",
+	"0Y7jPicKp1jhZnhV7+tnvk+H22uD1l2vWSV0s3HhTAiXDHIR1JEw5I4gFavBR3dg3Kgfw64S4Tu8MaJK",
+	"LnhazOFCM6LuuLiRB0hTSrTijIsIFps3MxzP7wDNcW59eqNS7i2nqTGwT4trRlRH1oeS/6ESMf0U+Yvm",
+	"HGdhiKqojqiymW5sSgwbw4Dd9wW4M9CFfdzcHHYs6ooaR4Zuy+KA73ptclYEqYfdYi1k9PZ1kki9t8z1",
+	"m3YEgSb/T7/IPUWLyrMXBJnOLkxFf8a3mGb27CDJr/SGeQck54Po9GUaPkZlVT8ZbBMFx2HWeRe0s3rU",
+	"t5PcKyIYzvxyrikDFbpZeAYqvdj9gMzMmrEhUPrcat58kgujLssL5XIjmTVcmqTNCEv0H9MP7/XgVMkZ",
+	"jJliYfuYrMwQQHonqCJBd5lzJonvr3itv3G0iBtWliPzk835eo1ZGg8ydGhstm8gtbDlovRSW+EWI/Dz",
+	"njK5hruqLcJM4x+YKhuaYylL1KkA/2ASwRQX7d98X2zcq25Q3WFSyiRwxlTJSrBKtHZqX6YOn+zPQtFl",
+	"U/HIZRHk1Uu0pqxQRJb5tPq1Q7DL8mA7XrTgQaqi0QlW+JLguG+h/mgGiH8/ZUvKyMfWGqXaJ20B/k9v",
+	"adYWzvKTLrb6kYpCtrWwSzgpA0w723XMNS1k3rcebQe+wlb2Gi5uTovcFcXtZmwycksyJMvmNjrQoFpQ",
+	"rghKtPlsQfb7n6VxV7JyWYQw1GMex4aw6qiMKyJV1dWzxcvFeKsMc1Y5yjJ+l1GpTpkSm7rXymZUMNDZ",
+	"knFBLouMDD0USy2aN2BQMb/wuEAQc1IzVSteKIiZBudQZ4isFa6KZVzuDtWw561nwwgq1qKcS6qjwOMO",
+	"YbWjiiOgzy/R3BPUVkWK9/PQlanaiM42WSHko+aDeB6JILZPASF7fUp8g9LlyLo4w/7DPAEpAbUtUnxJ",
+	"1EpLoVStgLOjouqKDB75Yem0hu+0/lHHQ26MnghTFhdZ1/j+aEk6HCpKdxc9pBvK+lmA+wrRdskE/U4E",
+	"10cBD6dtCN3XSJAlFmlm9cNmP9aXqNvxY43vYacXRHQVsXtfsftU02Bbo5nnIqvlJcI9tW0hNDRv5eGx",
+	"jannaA7XcKC1505+EEvM6O/m9RhhIiquPSAHm4qC8uXDbUXHWSEVEbZbv7moAoCBcEomUUiMgVoyaYHL",
+	"OCgmk5adj4NTo1r8sDMZbY/ieUwqNb/7ko2bBvEAG7Er5d9NZH060ugCPAezUzPJXJCUMEVx9payJRG5",
+	"oLG370csvei1NlZem2bSeOIFQbaZM9ynRJl4TfD7OnMKCZtckwurteBz43VSquigQbkwk8cy5dbjhNzn",
+	"XFrlkVmBSQdaEc+DEuc9eSkJS491cGtLQkfC0neUtQTML2hGtFAaobaB2KZbaYqqKaX3HDCnGVnvousY",
+	"3nMFEdRUumAgIya21IcVqmtr0KBtc+0oWGOPm9oN/V2G5+M1bMGZBttMnLgMgALV6YwZuQGBfRQqncJH",
+	"rp/8OyqjWVhxkdJ+Fr9kJo+g/fA7cFXZQdDU463ZbmDRjJxuG8aEyg3damwypX52OL7NX1sP2laIf/0p",
+	"En4wTJIK4hCGdmjHO0u+HCkd6BHg1lHfREDuY1ShJEotChOnPoR8/oE/cI10aRPAQnulJVqbnOu0A/Y0",
+	"g3zEkdS2Jj4pWMWYsiGw5w++b9Txvxz5eIgj59jtDlAKBSfwa8ybogUNgn01MHNXtPzRaOnUpqGrbiMI",
+	"rRlx4LFg3wECWiVlVmMp2BH7COdjetYELKebPBiHrU2dSzMu2SdxjSkp0mhE4AfmvY07HiAjQZco7hNf",
+	"mx0a40uV65Ge4bEhY2XADzO+DJsZA9dwuA7wiIEYZbzAa1ltbGLj0iVbzlhG8K35ydH6FZdqhA0iCLxq",
+	"HqvWoWyBXw1VvckYPnIk+7BExrPwefDKWgBSaJceKJrSrvMHFVZd6b8kjAic2VyB0o5kqq03C/ykRr3J",
+	"3oEiJvT5LSlKe37xImt7c+ATgisJfKTAiwWdJw13+sC2qe/mjDnpxMjno16SEmRGiTmAxgyJ5m0MPO48",
+	"joycYUzFldNogCeSFxr08wM0zo1Vnvie+sEQfH3BRcvTaYLmgPC44DG9MJIioZH/AJ1Z80miPyDjO4aF",
+	"bxb37s0FV3zOW5x0zi6Qa4C+UfM8QUWaJ4jO1/m3mpPWE2m5S7PTrmFcR8sL0cr5HJ+dXLoSARbGoJa1",
+	"2wM7/DeUXWu6B9Mqjr7hhTI/jCtTpXg7hCEofrcAriFviSgB5Aeh80mIYs6N6czARMfnW2jE3ZhMvL2z",
+	"uUbNx2ZqEzkRqvnBcieNE4WtDGEamRYgMq6i3pZBPt5+77ImAOpSVUfsEalZCbwFAQcODH44YCkN+XVu",
+	"Sc3FW4t9h8dc0/Rrurxp8f8sJDg48WDqmimiRbwzMspJW21dyYea667wcixR/GWKFG6mDraR/U33Jq24",
+	"6U/BZCLjTeMY8l8Rhpn6WbZ6nBUyCEIpsxJhpKBnS/4q0Glfrag850yt4iNXa4HI5hxqRewsQYalVp+3",
+	"srzKNVlSm/xkUQmMWuvFdEV5jMvK5NcWstCKCND4tj0CtRT8P/JCDAfUSreGbNrFusmIVrywWkH5AHiZ",
+	"hQBph7HiyzUqxeqkCi+ri0ps8XAXeeYyaVlLJ1UD3bB/zpcCp8Sl66uiYmE+DpfFbHy+HXQYZ9pyd34O",
+	"701K8oxvwC0tkDycBsEkwYuwOlhhyBZBfydvNjZV6YC8Gma8vr3CAt+Zpp8TuyFQJ/R2/RC2fSyc3gne",
+	"yi3okguCfxiBMRdB9hBZMOKZpsYloOcyfTyfMZvVGTWoNehb/X3TiOd72V9nzF04GB4vE8OQWInEXU5z",
+	"VS3BHy6AhG/L8Kv0zmNve0RFeWmQaV4jwi6pgP43YQsu5rEgaGt7rWPuBREd2NFayap8lQxGV+rcePTO",
+	"iejCksAcPHoNtSkd2nbOGD8FIi68B3OsXED50bAClt8KA66dP+e14HfSBofXqZtcXXMs0nd4wws1zo9v",
+	"irUiJoOensi6AdEdTZeA8/wuCLv8+SzqxGdz905tzNBbcMuIKczgO7WxLj7q/JaSO2lLpeueZj476GA9",
+	"WjUHsV1KvPwrDKzdx36hLOV30RwfuonxCryDRg0QJXC3yb0JUf8/qZb0vvuLiT7CShGhB/r//tfLF//+",
+	"6//+r1V69+ufJnuKdWmcx8dzCBtwloIm9hv51vrqyxW2IIegL5yFSV6RCydE2nnR1JrAWRa6EqdNrskM",
+	"DMKmjY8zgbxUlQHvRkVob9qC3kME+dyn1bn2wTChgzJn1mjq3Vqj2cT0St3Cj/vyFeF5KIXVNoqi+4xT",
+	"nmVBUxwNzbgkkMBM/4VcK/80RCYGGEYmK/GGugCX5hcX/jNw3+Vh24SIYf4ZmCa+WzfPhVW29RZV0apD",
+	"39izS60WKAv0s6HbUSDfBLtRJlW4y54cZAsebphxgP41fsvsBatJb8bbpM27T0uptknlnDXboJ2bff5m",
+	"/fLay+DaV6MSt0SMs5POz1ufpxug9UQNeo1J39SZKbn8GIYBdS35Xb19PxbmGVZ6pdGPgnNlyqANqQFj",
+	"Wxpn3FJdNsrMVXbr10QnE4WXw0fX+pax2u3qTSnxKzi3Gl44BA0gW0GM6EWLF6drcFS3ej8+RbvJK2pp",
+	"AXhgFD7LZ7ZBmf5i07przt80nDGjJrG/60pKxKq+HMcIaRs9l2xfhoJlRtWo37SVM1zxHMoxKbxs8aoM",
+	"dvbG2kw6SrLyXJ0xqxbrPcnaSdUni8I5Wv0nYmTuMERS7+8d4XprEzzUctrqaN68CbKztK77qp9eUbAk",
+	"9ObU9NjKQ0Y+rGVwnDG3bs1BrY0ZEzPEGfFDuJcevAVsvkm97aAv97zMFsyuWf4wATGWUfKB5tHKYnZh",
+	"Ja0MuDtjac86+6AVCcqZ30q55a5upewnt/3eeymVSvBRU5+YLqCtvx/V8y29N+/4hoizeKRRRtlNT7hb",
+	"35btBRmoaDQ92txWhl37Wk4OcC+shLyMChSoZQUZsOMwFcdWIm5lsS0xz73o/RB3t8bVGuj1VuvXv0Z7",
+	"4eq2OyXofPwFPLf9NAQhYi1uJmoNmxu03PNycbEsxrxSjKpUs9rqd57Et7Wj6xzPVdv33hWeePpR04DA",
+	"786TQoZJemz6eFz6N7+jrLiHkjEO65u6qrOTd/QmIhrrd/Hs5L/fnf10agPpjMNQWb0GHRI1P+TSp8jQ",
+	"nmqjSj7U71s86DR0WW7uaFR+hI/VnAjN0dA3a/xPDlFJ8I+DNWXc51L4dpjhpkabt/AOrV/bBquXS61H",
+	"nROmaNaWxnyFBUl8Vu+XoCV/lbi939Z5PgjLZjN2ejGdIqnx1kZoGbYpCNOK0OHQ+yu4K7mU/gY0Vwgz",
+	"5YJf21GGLXYzYyYc3t7EMqqZ6eIF379EKd7IlhUt6P3HruQZesdS1XNnONbQvEdaJyaby4pK/Sss39L7",
+	"5ly/rEyQGLYattqEbuCsnJvKMgY/HhB5o+OATx1Q2udsrNxoOOD347PpEYKAYuRHQnXxYI4VzvhyyCpO",
+	"sCJHaRpbzhUobRVB3/zjH//4x4vz8xcnJ99GFqcN4S608iFrLA+lU7XwUGfgBmPW9KJ1NVfa6NaD+LRe",
+	"ghQIZM2QXfgWQW5IkoowWgqInYZm4OrmYyT8HXFhjyZDgL7HDrlNYrKNSY1RiaFwnfcTRmFHb805Y793",
+	"heE3Aq0j7mcfT/WOYAuIgjfvgpaBjX20ooZ31Ql7ES3urx0parCdQPZAlKvlTOxIRxgU9qtdaMN95ET4",
+	"FHhtBVAFhWRGkVKZLUU1f6TL1fDW7/jd8MbnJKXFenj792SZ0SW9zsiAPoPgzogIff3gAmvsE/R2E3Xz",
+	"iwszwRDHl2dXZ8dH7ybJ5MezH37UCR1PT85+1skf3334RRfqOf3h3dkPZ2/enUYm+AwaacMMKao0Tk0+",
+	"nh9nWE+Dji7O5CRg4CavDl4evDRKNsJwTievJ98fvDx4Zax5puL7IU7XlB0uCDFZRJYmHFFjBjDGWiSe",
+	"/EDUkW72Flrpy2bcGKHHdy9fBuVu9D9xnmfUqEoP/2md48z16I1mhAlgnzUzq61XVJo624byazv8mZn3",
+	"VAhuDtwXMtIbahKWxHFFJmOOKaqLADJoTfUopdHYlhE6gHFDGB4KshBEguie81iIBZRR1WPo9i/khs1h",
+	"tCXkuUYKyxvv7nSHqcnYq6m9koZJ06moZ+xs4cew+ZI2bA4pPMpnYsPmRBfBx5BBZ05Cn5QZg5kJSy27",
+	"Vz3xCy6DI7+0e2qc/Hc7O/k3HgRXWN7EUAD4cr1mHm7dpGOD7Bpay/o5mfzl5V92tqqjnHqX3ZYllScA",
+	"GlUD8waW7AZtp3r75d4Zv6sg4BpTBt44c9J7lc+Dtnu80ME05zwlj3i1A2CgNU/rBcDgTPJCxVh9mpGw",
+	"qWXxGwPqJkcXZwhco3D6QufOSUyQkcVICWkxl+AHzTbgpI1IJq02PbpKq0G3RXFBsPzry+9NpT50SZTY",
+	"vIBKIGhFcEpE4h0HK5VRTKL3QrrUF7XLXcRxANb8hqeb/R5/yaopUZDPT4t9PxtflfhhWG97XcdmY2nL",
+	"y8eiLWfMZK6NLEpPSXZHVU6ZMrlkyui03suTTO5fzHlKloS9sIjz4pqnmxdGDTXR/w5Jk37W+vmLK2i1",
+	"R4SovjKPyWnUnnhZg6i7xlSYEBj9yDfgd/hJ/0+LYp8PRcEGsBjDuAl0tihba9Jhi4RCkURIQR3nIfQ3",
+	"w0GAERHG82liYRRTf71W/4mUHqdK0OUSnOMMV9POh8B5Xdnt65RZmocVeE0UmEJadIJlk0MHOtA8PDEb",
+	"Y0v6lyf0pEyMXgGkUTIx3jtC/MuCIdzgbOssS+G85DvpgvEVHnviWDNjsePeHT0xC+umIha9ugH5we37",
+	"aD4nuSLprulPMT7uwBxTTn8im27SfXEGTcaeD9fuStav9nMyrPmUZEZYH9bcONgNbX3F8+ELuaHDG38Q",
+	"KRFvNvvFRXcM3dhoOZhufLK8x38WRGx2iYjaBUVzzDdkY2phxJ+vWoFaF+Fhe+o3pVo9wGpR3TISyDUz",
+	"x+zPkCpPECUoMX7hiojWV8Zj8T4YYTP6MP731V5mrSsOGbnzEA3i5Z6KzXVL2Tl3CxUDCMLMTTGKeTXU",
+	"7/CT+cfZyWeDrRkxVogqGp3A7xaRzP/Ab3Dks2WnaqUW3aCo3PVHYyLc8Z2dlKzErk7QgDU4wcQ7t9zy",
+	"G1OCUs/V8z7t4EBGPlL7p/b7IPZ/EKxxjE9KFCTUNzkSSiJg7reURJUey60YFDT7yuU8LZcTHMUz53QA",
+	"uUwCkyq3E2E+Kgi2FwbEz/DoTEht5hgjEoDqOTAj4XIqDMlfXv77HuByek+liqLzUbAQnAmC0w0i0HoP",
+	"/FGw61E8Uom7h5/KP4bxSmXfo6DnFqJ+0PmL4puCA66/gjtFtuHLAHOGDeQ1EZRl8miJuKhKcLtl8EIU",
+	"REd2QdXV4HA9yD/quoTQBmKcNQyvfU5xLe518YZ7QcDnxCd2Ut8viVfsuCl75BcrRNG45c9XkUdc//xU",
+	"yEQXkMfQItLT8w6Phb0XNntj9bl+epNdB/vwx3tYHs7F/OXVd48FlVOdIyWlqVYNwp3Z2RsGuLgXLuqw",
+	"zEG1JC3a0jXRrn9yReH5Jjo7DKhMTUoCk/LBFVA3y4CcBT7bS1mJwyaVJJD6QZAMX5PMqU7RPzllVUMx",
+	"7LNMF+PdHaiK6VnbH9wjs8dHeXa/CuM7vfzyK2MxiLFwqcz8XbNW9Gzjrq++og2WwxOHfg3VYymnsJiv",
+	"RjT/Eq/P6X2OWTq4+ePetqQyyP0Llm4xUMet9Q4hxhhtXc2Qd2qLzW783uBN1fTfOFVbz1QlCF5DPDgB",
+	"J/SMMpKgP5n0H1TakAhtx9PBD1SCy5EV3J5Wi2ch3qu626vW7kkUdn26umejpduvfq6Pqd2zUg5OYiQT",
+	"6fjH4Qo4w4ltK6juRuP2PLDnkZmOfVpLJVRx7VN9Pfzo98MGDH9/6eI9Z+Q8UIDs8/nt4nWTiXkoYebT",
+	"jpw2ttnhqUlq8zmZfP/yL22Ny0N/z9U5T+mCkvTL4av3xVGX+O01ck2xWFNGU8MUClyviVgSBO3RN5dv",
+	"j9H/+f7f/vZtAkpkaGGE+JTPizWEyECjv/37y+++Lctd1eH1Asb73/qfvmyI2uRE66/1mDNmRqWWbypj",
+	"cZ0XreGVnFODqafGkCB5huc2BsAEhZoyIlEHJq9+fIwL/Sj6xidRNXZFCLgHo6FffOIb9Rx4nidX4f3l",
+	"uwFOtv6Kv8U0252LrUEQR5GGcWs+9qgZmfP1Fj/JLf7KgH6lJbszB2xDE2IS3KHNr9Cu/j9aLgVZYmUz",
+	"T9j2ZQLesLwCoq5QoRs21P2X+kjIbpllJE1QStLCQJ+kLnk3ZBU/QKdYl6FyE1I2z4rUrmJFpeImKwFV",
+	"0iWxcIkIOCuX1GUmcFTwwsFg1/LpTnDszAHLL7NPH/4HYcF9ol+9eVTiwS1h7vCx59OjyC2v+dola4wi",
+	"97HJuFZB7RgWuYBrLVkZ/xDHr88YZ76LD8u2RWM0jx7kCPKJR6ClRlyXhmXGNEufIFzeLzfgNVemvjoE",
+	"Cad0Ac4qqsR5HVBnK46kIGuk/I6ZP21WF9e2uspyL3oESCc15LJMHUwfwjpQDfzfClP62hEpLMmk/pYn",
+	"AaI2krLEx9H7GjXOPi+vh9bzu7Muv6rGACP51Wl5kI/VYuD+vGIsXs+J9InGKndSL+OOlyWb+m7+HLNL",
+	"2NTw+1/LapOUk2NWlvBsEocZszfK5m+sEgjUTR9mrJnuq5VMuBWFhMI0mTHfRqM3/APbNbtRguKl8F2n",
+	"BDxARwxRBfl2C02HahTHZhgwpCR4asFKb9NVuyZAfBZczBiu5zAzfV1yIGhH712/QVSnep77oD16irN0",
+	"FxTI4sEWA+6VFFVB+JUgbUWQgtWNJkpFtWjYEHbb1puoXqc4723LtkIidUhdWOYkNHw5RhmVAFNHO+yC",
+	"LK/t/tT5QSTPbk3ZWFtu4x7GqSf+quZg9MlCPENiDLRcpCTIbVTmEfMbgZxCdtYh5CAsv7ZHFcZjRHgH",
+	"O9lXnPcfSiKo4S7KM8yMM23l8s25ECTTUqWradPBBLimJUKCvMrXeWFLWVGe6rx12aaWeslnnZixekmc",
+	"Wt1BjOaNeYwvPGC9yWY/Y/ahNkMwjjKuH22jPYBHVBRZ2xU5bu75azTZl+SBEznAZx6D1sRp2XoBDz81",
+	"frM+BG0m5CY8jpsjjMbxyCr2bWZ+VKT5wv0lm+T48eIxIvhs0dk5Lm8O5ZznpNNZ8sS1nZqmz5AIPwo5",
+	"s9t/7mG0nku2J9thPGue7D4sWyHcHs+01X5aR1lmYYPuiM0PHVq4dpZ+su1Ehts3TI7UT/p/kMeslemb",
+	"gtOorDhK1BK9L4jVaQRhDcbLNAG2zzhN2Kr6UnFRT2NXqdtYVk5JubYHaS1txnE6Y24uGBR4vCI3Pq1Q",
+	"ulFLZtect1kyIIHqW7vf0aTGAQoIR6SmdgwiMZDVao9GlCK25WR36g8+V0S9MJCqorkvk3dNGYZFRBId",
+	"R8v86nnC3T622VQfpEO5CPD3kEftxOIhwmaG7kzE9lE8oUsiuyMG3lZbfhVEnvQprp3GM3+SHd+XmuX2",
+	"JLdoYNo+3uTKJI/tMR+ZPOY5XwXbc3Chr61ob8m3ahON8YioUrTDT5W/B/m4V/HvbbX/+Be51v9Lyjbx",
+	"tnrc+3Q/b5x4hyf6ng/oGWVj6CUUX5Ae4BGQKa4DiGBWV1aGJ8eufTtabvH0PSJGuyQNjafm6T0wu16/",
+	"53OR/kjpER7OB5zezwksd4hwEzT+Kt88B/kmOJAvRMQhfsXDpJwKyu2R2vt5nkjWqc3fJe54ED4niadc",
+	"1P6FHj/Xg+jd4af6T2Okn3Kct41RtmWCwiG+RDGoxIFHkYQCNOgXhvZ+Xs9PKuokKV+gYLRf9OqWjaq4",
+	"NkA8egb49khy0siX83HRvC4thc/U8xGYWh7PZ3XH/pBi00M4iSEC09cMUn9o/7XAa+2hOaT6fZm+ZpFq",
+	"ipMDhcg9y45PJDL2S4rPSD7cW1opzwW0BUbbBpDhOqNztTe5dIsn5PC6yG70IlqSrhj2xZY3tn1q8VKm",
+	"yCFNoXw9kpQtM4KUwEziObxsM3blc51o3wpwsKk4ZOdcqLLsE0QvNLKb4hnzWOXyqnj+AAKcgGuGQ4dj",
+	"RCknUr/guSC3kMQFgkfVighpPH+uiR4tNxxaaxYWd4XfaEjt9RrDFJdm/CfiZe0S9FG11jqMHuRTXW97",
+	"HLtX+hhOrcR5hq4NAgzMBhIthGZubP06tdwb1IT2jI2/N6hybWZsyD1BzWviyHhLtbWvt+Rf8pbYJ2jL",
+	"a1J5iZw2dIwSVG4flOBn+yI1nY+h3xyi1dzNAXy5sSB/jAiQt48e9hGi2Nd8fzVOc3dE7Yklzke5Z3UN",
+	"63PSqz61NrWhQ33CrHo11efDE+t9vS7bXBeXN+/rdXmc988ljhuL92288aENa5oWeS6IhBCf159a5M0f",
+	"CCPCS5y2J8rILcmQLAcwqTQWOJME5VzSMK4+0dllllRlBN9Afgp+B1ktCFNiY19zk/ZGy5pLoZPhGM0t",
+	"RM6bFvWkO6bXDc1zkqJ3G6bfTCKVHW5NpateD4edmIxdOE0losq9vmHVuHrYl0RSYZCNbYgXYnzGbGi/",
+	"kZtDGdyI2iFABJlzkVYEdShCrhWaSwtUM3hixW0sOfPF9wtJxAH6RbMcqdhcFlYPHs5QL3reJ1h7Kjdt",
+	"nv/zo3vNRT6RxB6BVovEHh6O4eTueJGluvQgTlPLx9njBJbTNDIHG+Ciy4C5wtIaK3apet9yP1jaTTQv",
+	"z2MT/avgSgXlHd1yS32WJVdP9RhwUSExT1jM86pG7W5IrqxWbm3zx+tPPt3nzrQ7DstaH4fGUQ1/2xjX",
+	"JgQDrmPYV6c9+32k+VcX4Cc1PseO5Jk7AYdIZ29TnwU3jnj7eDObMz22XbdtBTETbwSUz8HcG1vW/hyC",
+	"I7M9kAYefmr+OEgjHsHT95GRRhPN2HK+KJX5+whG7FV9HkWKDlX6457cM3ITHkZuviA9+mOhWlyn3oZ3",
+	"Xc7Czw339u0yvO0b+9hI75Ta8efs6TV2vc/sM7t1fyjn4QdyHZ4MyMNPJUnoTgzo05zKD2WP8QJY0Hev",
+	"L4tf5PPJuuyXtL/nQCqsirLo8IbNV4Izrn9ykx90o8ChsVC2JvG6BGWl1SbjNUFufcZSq38mLM05ZTY9",
+	"stfDGuc7DwNhB7rTllIK7r5zU3YiWHa2aUnBFcVG64/zpDjZ5QekgeMnS0x+duiIUpITlkqX476E0g1l",
+	"6UGZTMvYmZ89Su9SM9Z5kcv5V9g4g8LTSFKy86tVHiMuJ+m+YzmXqtBJ6bkg3cX+bUskoengVMiJdQk0",
+	"bQ0gwEaCzUjAFhKdPBEpgtcIq/LeKrpuS2p8UVn3Vx3bk+rYqofxjLVrgFlkXkC5iRpCW+KnkdAlK84F",
+	"v6UpESUl7+I+Lpqtv+LllxSnFDnAZ64pdghakvU+RXEUSfchwzYmemw1ccsCIg9bA4igIjbG9afTEUeW",
+	"tXMV8SXsEeHIZGNktSadPPzU+K1Hdmsi5kVzhNEENbKKL9mRdxBOf0GqyIsmjj+eJjKG8xV0bueH31Gp",
+	"bHVA1xY5ZyDtcWNT9aYkz/hmDb66fEnUioigQiAYyzkMKU38hWFB1uBwsOKMizJV9Dyjer/mE02JE1VN",
+	"76AmkN9VyokTN/Kci7ZE0Rd+s4+At30P6i4POziP8pCs6xMVaI5zX7DInrtxuZpqlWaRdefyv6w1/cro",
+	"PSnnVj+OZ862Wd8+6dbbw7M1kW0fDFt1lsfm1mKzxwz6NdA9B2N+fUn7M+TXZhrDotVo2+Gn6g+DjPc1",
+	"PLysjTCaCNaX8EUZ7C9rp75XY33j4DsM9fs/pWdknO8nG18QN/wYKBVnhWP41WWQfw44tm8j/Dbv4WMi",
+	"tjO+N5+fpze8dz6Jz+hG/aEM7nvlDmyTETJRnSiYv/fHJLQcYp4uHlwF6NlwGFyYYp/my94ehwxLhVKS",
+	"0Vso0GunA7Ni86XQ+COv+Vq2B3iZVFza5He8mWeckZO/o28gVpoL9Pfzd9/q/08v3K/f+tjoBJGD5QHi",
+	"jMxYLnhazE02H4yOz1BOc5JRZoO30HVBsxRhoegCz5UJlpq++XBukpAYXe6MYYkwg9/P2IIjhcWSqFqu",
+	"IF8z3ZZaCgoY+9rvVEHZZJskDAILjN6nXgu5Vi8pTDNkOocJUnBYSNoqf8gGLfX/BS+WTnOE1z64QZZw",
+	"wDKwAnuDliiYomuzSGnnh1k0XAqGDETiRuKkZlau+UeYIHInf4Vrb4sTmwKiNGhAzVVKb8+u3p0n/AHH",
+	"adpeE2mRQ+9kjZdE41BwF+EU26qOwf+6KrCvKXtH2FKtJq9fJbEC79UVf6zW5OpY9EPqoDWm/WVFBKnO",
+	"SKWpOZfWoSOILVyOPMcnqeJig36+fNe2KlfLv78823b8V91lJNllJbc+Zu27x/H/8HQIrofWV1hvIw10",
+	"k50QFvTOwTqibWY3LiSuYp9pP5PPT8P3mX2GzN5fX37/aAFonKM1ZpsSRobAUqYVwEtBpDzYXby0KY5n",
+	"nhJ9ONedz4B7J+fYutNekXWeYdWtZZ5Gmn/VND9x8drmkTxzbXMYlKncontUznHM21cMdnWmx1Y9t60g",
+	"pn6OwfI56KCj69pbMtEmxNrzik5jK3PR5wS67V5RHgPHGHk4QqcPPzV/HKQ1j1ylaWSk0YQ9tpwvSoMe",
+	"xYwnDGCProfKknMG4TBArd0hrlf0RxEXHZXrqS6m0mHGgkQFBidTm9thBIOxR9x8RnaDYTT/C7IdDLpM",
+	"+zMgxAlujxXhuWHfvi0K27I6j432zrLQwlQ8vXlhCLfzx33G9sF9/aEMIfFHFEr5rzDT2lu9uU2YZcgr",
+	"ZWYMLxQRd1ikNrF0LRFRyQ+YbFtG0zmesRwo+H8tjfKHDjkID/rh1VHK0b4WSBmtGxmuEtm/KuTpVCDD",
+	"VB/PTOPxCIqOYU/sI+o1tnt0Qi3GSO1FwJs/iCf/grUUe/Xxqyc7HMAb7PBE9hsTM0T2es8ZOQ/kr72/",
+	"uF0Sf8Uyd3qFl23D2maH0AYG/P7lX9oalwjxnqtzmxTxS9MuPIlS4WsK/rji5HFJwOMpSJ5OMTJUIfLc",
+	"9CDPQf3xOFqPrVmxJ1dyPIfCBhWi+tDiBl8J0eMSIlcW4Ssh+kqInlrb6ktGbEFRuqXSQ0bu1WXB5KAM",
+	"X7oxZAqSjYILVHpHZeDFwN1VJdpQms2LDAelF8qWiDL4Ww+JfueMlPmI7vAGYefSO2Ouh2gJrW6hju/d",
+	"7h5MJZt8MCvW16a8ot6rhQq3icwS9Fe9dnv4bT6foLirOBeu8T1dF+vJ61cvXyaTNWX2L+91SZkiSyKc",
+	"L+jeSaOH4CCb7WMSQqP1fI4kcJdiGly58maVqGYyj1XENnfVTeK7XquHa/bVzfFLMmMcSVk9vofbMmpD",
+	"fjVo9F/NIP5CIjzXIS96c1YJsaS3hKEFXBHZb+ooL+I+GOzo6T6evWMAcr2HZAMGlndEkGplGhs3ZPVU",
+	"OZnrVLdwAE/KgpsF788eUoNbDwPsUTHkgAFmFnyYpSXMdm8osdCoHVL70Y1kXu0NOfxU/tGT4yq4V9Og",
+	"z1aMoO/8r6O6H/4kfNXft17HvTGGlUv3VV9f19c/xb3ft5psq1f8UenBlSH2Fc4IXvPc1eO1qdq+qAf9",
+	"WdCNL4Wv+Kr1r5LmnSj9v1Kzp6BmTv2Pa8ThmRgAvhKrL59Y7d4y4BjCXQhXhwu8phkl8vAT/Gvz+dBl",
+	"Pmi1FUxBnyOraRJsogZYoBmpdPb2jSCRAuKLGQsyeCTVMs7QJOVEajAyYnjIayi1PF/h64x4a4HL4Gqn",
+	"Pro46zAbROjrW7t1+P/myG1731S3bG8mHiviPTCJQ6RWCb5DvFB5ETvFJ6Y5XFQxyC7QYtjufQf5HYM8",
+	"BKoBF9yAin3O65cDah+oPri6ErrddwNomStKTlW9k6wMbKCWzJiRp0BTCQmVyS3lhWwBoonjWJOUYhDm",
+	"fFaaMAOKTf/rJT43hCU7UTmt+DLu3RC2Z895U3Zr0TN79GBtufT+dLXneQHJNx7f9dzexGdEb2rJ7L5/",
+	"zFrf4YXLNCujaR82N95ajMs2kv5OHj0fTJNeUZMRvUwNvuuEMP2EeDSDQxVZd1dmghZgu1IrLgNqB2iS",
+	"aX6zSdFtfqhqVjPbTOe0SRz15tqAaPKQgebE374HcC9nsKfHJ6F7s93++ihU0oDtDxCovndVdA4J4Sr3",
+	"wNySOGsUzVo4JbbGg+/Zf6tMGKnmZYyAMGN8sZDEN9XrSoJBIdjUf7H5ANf8lqRaS21+U36Q34ngZgaz",
+	"MLOIWyKA8bK71osBAUQJSkpxZcawTVAloIm5xggb2u22BSNcb+zMJuuhv/GaUCxt6bcbkitEy/3rYReY",
+	"ZtIwaBxqXSwoydIa5JIZC6pAuins+cHQeqeg1MfWItDkGlvTCz5n4rNHX9UGeXgKHq6VOl059A6ZN59a",
+	"EpYFtRFVxYHN3boZ86h+XZikp/7+VL0uHrU6k97PH17T1c9gNckboiFZ01Kh/aiPcOcJ+MZQ+p1ooAQR",
+	"BWtPdXtJXpB7Mi8UkYizbBPKnSQN1kM1IV1iyqR+rxaCyNWMSYZzueLlywJVMEFPaOiqDsS1L02YQxbs",
+	"p9ojSXFzXUDLqJ+hSj7ZjOBbJ2fXssRagm1XNmMFU7zQFrKRpPYSwPNg4loF6jFfrzGSRPfQUHSPbxWa",
+	"4Of6QhSQGpPc5xlPyeT1AmeSxD1dXc/OTLCe/e4jgp7HdDI1FgLD31JtMpiPi3WMVfzuMW0IlwCiJuui",
+	"IajpMwYnuieO6/Yr+hensOEyILMvzbK95DO1WIGRLK4Del49jCAq03t59FBLnRi8VXZ9ozN2QyFghVmK",
+	"hc0f67w8So28l0/hDFxKbT32jJVL3CSlL4hLxYr1hUBYGg//Avw5SqZTd8UsNSQT/P2p0TClnPk4gDVR",
+	"WAt64+Rdneh612RQPwBGgxcK6h5ebQ79pksnkeukbdd8/dYM8XD//t4a4VfNXT2Pm+90+6Gl0xT0hvXu",
+	"7Cae3kOCfX+6JRsz+M4ZccxGkbVdvrfEOMC4y2Q6+VL3VsoMbV1OawZAn7GI0Yusrwl4yVrVUSGJQPru",
+	"hPSEETFjlOk7PyeBb1VG19SmwdfaQrewecaLoIIfsEEzZjkqtC6kPoZbgjBD//P3Fx/PjzOsqzu/mJqp",
+	"XlzxG8L+xzmFW7lS6R9nzITFGCBIopTjjSo7tSt0xdvJfa7v0EhqUDmSh5GFfauaynX2KZpePdadPKdS",
+	"UrbUKknDJHDhzsEjLhzpE9AKPXcVX5rqiv1F4rTOzIiIkY7RXj07x9z9KEBqSPu46o/OG+N8dsKDqZ7a",
+	"c/Hfia3s+XHfu7g90+1uzzj9QW+o29fUfn/41H67Sur3NfpteDo/eYBO8Xzlw1AVpkz6zEL4mhcKYbQu",
+	"MkVfKOce7kJZvSNed3DcPjMAPkXuv56sf88l3d9e8/z1+HHG0jh897iKoN8KrjAi93NC0n2UF+64E2Pf",
+	"PiOTDs4wCCznli7kX2I+wb0nEuzNIPhQiP9L5Qv8Gmn49OgdTxFogtp7H/OvgYhlIOL+b/5jZOd6Cjm/",
+	"NzXgs4nEeVLBfd/Jt7Zg1L6GADq2YBfBf18pyC4pSCWn31cK8pWCPE5c3sHWIt2h8z7oVXAaOnHhmu9Y",
+	"utsZOvgFPpvS73vnot0RllpuZ1c2ZlB5aMuLD6tTazu9rffZ48HV5nJL6DnDXcLQ11ItS7Eb/22WEmG5",
+	"cmcJZ1zRhd1o6fVs+6GULomjNK0PbxeMd/9CdoL38R7NEaccGtocYMsjeg4Pa2xVwSu7S8vWHnBzxHPR",
+	"RkMOIbiT3HW5z+oFynAFifO8cSvycrb94eykdPyasXLn1aBPGMZ3dYJ12ZraOFLb3Md0W+eSzQF6zxWY",
+	"S6hEEt92eMa23NQLu/lHubBuske+r5cWwexqnl+C1gA9hMeop2J2LZTKZ36H/pz6HLRjeculCSuBb3Gz",
+	"BdHAoZwNYQsufeO9Yp6d5Ak4AQ8N50VWjXRfUam42Ax63quw2j2ZaAHTY1KIAecUvuUR4D6Hxzy6rD29",
+	"5kPxa/hFLiQRF76WdHd4sW4Llv9UL2JBjb+knt38ojbO6UAS5dOd4EKt9Fd9BmypBY57yCKxEJx5/2Wb",
+	"N+IAna5ztUF5uSKEBQkcNG2kISxlheFhhjdYv8xoQ1SLO+bPtW3uEa/rUz0e9QmhZuEaAJ+kALVO4hMD",
+	"0+5JTxRCj0d4BhxQSHYA1ULQPgeiE1nUnkjOQKQaSHH0FETcOr1PIbLJ68khzunk86+f//8DAGxLeFDp",
+	"CwMA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
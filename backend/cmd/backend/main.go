@@ -26,14 +26,22 @@ import (
 
 	"github.com/openclarity/vmclarity/backend/pkg/backend"
 	"github.com/openclarity/vmclarity/backend/pkg/config"
+	"github.com/openclarity/vmclarity/backend/pkg/database/gorm"
 	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/backend/pkg/notifications"
+	"github.com/openclarity/vmclarity/backend/pkg/rest"
+	"github.com/openclarity/vmclarity/backend/pkg/sbomscan"
 	"github.com/openclarity/vmclarity/backend/pkg/version"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator"
 	"github.com/openclarity/vmclarity/shared/pkg/log"
 )
 
 const (
 	LogLevelFlag         = "log-level"
 	LogLevelDefaultValue = "warning"
+
+	BatchSizeFlag  = "batch-size"
+	CheckpointFlag = "checkpoint"
 )
 
 func run(cliCtx *cli.Context) {
@@ -45,6 +53,18 @@ func run(cliCtx *cli.Context) {
 	backend.Run(ctx)
 }
 
+func rotateKeys(cliCtx *cli.Context) error {
+	log.InitLogger(cliCtx.String(LogLevelFlag), os.Stderr)
+
+	ctx := context.Background()
+	logger := logrus.WithContext(ctx)
+	ctx = log.SetLoggerForContext(ctx, logger)
+	return backend.RotateKeys(ctx, databaseTypes.RotateKeysParams{
+		BatchSize:  cliCtx.Int(BatchSizeFlag),
+		Checkpoint: cliCtx.String(CheckpointFlag),
+	})
+}
+
 func versionCommand(_ *cli.Context) {
 	fmt.Printf("Version: %s \nCommit: %s\nBuild Time: %s",
 		version.Version, version.CommitHash, version.BuildTimestamp)
@@ -56,6 +76,15 @@ func main() {
 	viper.SetDefault(config.DatabaseDriver, databaseTypes.DBDriverTypeLocal)
 	viper.SetDefault(config.DisableOrchestrator, "false")
 	viper.SetDefault(config.UISitePath, "/app/site")
+	viper.SetDefault(config.UserIdentityHeader, "X-Forwarded-User")
+	viper.SetDefault(config.AuthTrustedNetworks, "127.0.0.0/8,::1/128")
+	viper.SetDefault(config.NotificationTimeout, notifications.DefaultTimeout)
+	viper.SetDefault(config.NotificationMaxAttempts, notifications.DefaultMaxAttempts)
+	viper.SetDefault(config.NotificationDigestInterval, notifications.DefaultDigestInterval)
+	viper.SetDefault(config.ReportSMTPFrom, orchestrator.DefaultReportSMTPFrom)
+	viper.SetDefault(config.GrypeServerTimeout, sbomscan.DefaultGrypeServerTimeout)
+	viper.SetDefault(config.TrivyServerTimeout, sbomscan.DefaultTrivyServerTimeout)
+	viper.SetDefault(config.ObjectStoreMaxArtifactSize, rest.DefaultMaxArtifactSize)
 	viper.AutomaticEnv()
 	app := cli.NewApp()
 	app.Usage = ""
@@ -76,6 +105,29 @@ func main() {
 	}
 	runCommand.UsageText = runCommand.Name
 
+	rotateKeysCommand := cli.Command{
+		Name:   "rotate-keys",
+		Usage:  "Re-encrypts the sensitive fields stored in the database with the current field encryption key",
+		Action: rotateKeys,
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  LogLevelFlag,
+				Value: "info",
+				Usage: fmt.Sprintf("Set log level %s", logrus.AllLevels),
+			},
+			cli.IntFlag{
+				Name:  BatchSizeFlag,
+				Value: gorm.DefaultRotateKeysBatchSize,
+				Usage: "Number of objects re-encrypted in a transaction",
+			},
+			cli.StringFlag{
+				Name:  CheckpointFlag,
+				Usage: "Checkpoint reported by an interrupted rotation to resume from",
+			},
+		},
+	}
+	rotateKeysCommand.UsageText = rotateKeysCommand.Name
+
 	versionCommand := cli.Command{
 		Name:   "version",
 		Usage:  "VMClarity Version Details",
@@ -85,6 +137,7 @@ func main() {
 
 	app.Commands = []cli.Command{
 		runCommand,
+		rotateKeysCommand,
 		versionCommand,
 	}
 
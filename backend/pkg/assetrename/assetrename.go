@@ -0,0 +1,143 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package assetrename holds the fields of the API objects which were renamed
+// with the rename of the Target objects to Asset, shared by the migration of
+// the stored objects and the compatibility of the API with the old clients.
+package assetrename
+
+import (
+	"strings"
+)
+
+// Field is a field of the objects which was renamed. Parent is the dot
+// separated path of the object holding the field in the new names, the
+// object itself if empty, a path element ending with [] selects the items of
+// a list.
+type Field struct {
+	Parent string
+	From   string
+	To     string
+}
+
+// The renamed fields of each kind of object, the parents are renamed before
+// the fields they hold.
+var (
+	AssetFields = []Field{
+		{From: "targetInfo", To: "assetInfo"},
+	}
+	ScanFields = []Field{
+		{From: "targetIDs", To: "assetIDs"},
+		{Parent: "plan", From: "targets", To: "assets"},
+		{Parent: "plan.assets[]", From: "targetID", To: "assetID"},
+		{Parent: "plan.assets[]", From: "targetType", To: "assetType"},
+	}
+	ScanResultFields = []Field{
+		{From: "target", To: "asset"},
+	}
+	ProviderOperationFields = []Field{
+		{From: "target", To: "asset"},
+	}
+)
+
+// Nested returns the fields of the object held by the parent field, e.g. the
+// fields of the expanded objects an object relates to.
+func Nested(parent string, fields []Field) []Field {
+	ret := make([]Field, 0, len(fields))
+	for _, f := range fields {
+		nested := f
+		nested.Parent = JoinPath(parent, f.Parent)
+		ret = append(ret, nested)
+	}
+	return ret
+}
+
+// Invert returns the fields renaming the new names back to the old ones, the
+// parents of the fields are renamed last.
+func Invert(fields []Field) []Field {
+	ret := make([]Field, 0, len(fields))
+	for i := len(fields) - 1; i >= 0; i-- {
+		ret = append(ret, Field{
+			Parent: fields[i].Parent,
+			From:   fields[i].To,
+			To:     fields[i].From,
+		})
+	}
+	return ret
+}
+
+// Rename renames the fields of the objects at the dot separated path in the
+// JSON document doc, and returns whether any of them was found.
+func Rename(doc interface{}, path string, fields []Field) bool {
+	renamed := false
+	for _, f := range fields {
+		for _, parent := range ObjectsAt(doc, JoinPath(path, f.Parent)) {
+			value, ok := parent[f.From]
+			if !ok {
+				continue
+			}
+			delete(parent, f.From)
+			parent[f.To] = value
+			renamed = true
+		}
+	}
+	return renamed
+}
+
+// JoinPath joins two dot separated paths, either of them may be empty.
+func JoinPath(a, b string) string {
+	if a == "" || b == "" {
+		return a + b
+	}
+	return a + "." + b
+}
+
+// ObjectsAt returns the JSON objects at the dot separated path in doc, a
+// path element ending with [] selects all the items of the list.
+func ObjectsAt(doc interface{}, path string) []map[string]interface{} {
+	current := []interface{}{doc}
+	if path != "" {
+		for _, elem := range strings.Split(path, ".") {
+			name, isList := strings.CutSuffix(elem, "[]")
+			var next []interface{}
+			for _, c := range current {
+				m, ok := c.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				value, ok := m[name]
+				if !ok {
+					continue
+				}
+				if !isList {
+					next = append(next, value)
+					continue
+				}
+				if items, ok := value.([]interface{}); ok {
+					next = append(next, items...)
+				}
+			}
+			current = next
+		}
+	}
+
+	ret := make([]map[string]interface{}, 0, len(current))
+	for _, c := range current {
+		if m, ok := c.(map[string]interface{}); ok {
+			ret = append(ret, m)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,92 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+)
+
+const (
+	// APIKeyPrefix tells the API keys apart from the OIDC tokens.
+	APIKeyPrefix = "vmc_"
+
+	apiKeyBytes = 32
+	// apiKeyShownLength is the length of the beginning of the keys, along
+	// with APIKeyPrefix, which is stored to tell the keys apart.
+	apiKeyShownLength = 8
+)
+
+// APIKeyStore looks up the API keys by the hash of their secret key, see
+// HashAPIKey.
+type APIKeyStore interface {
+	GetAPIKeyByHash(keyHash string) (models.APIKey, error)
+}
+
+// GenerateAPIKey returns a new random secret key, its beginning which can be
+// shown to tell it apart from the other keys, and its hash to be stored.
+func GenerateAPIKey() (key, prefix, keyHash string, err error) {
+	b := make([]byte, apiKeyBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", "", fmt.Errorf("failed to generate API key: %w", err)
+	}
+
+	key = APIKeyPrefix + base64.RawURLEncoding.EncodeToString(b)
+	prefix = key[:len(APIKeyPrefix)+apiKeyShownLength]
+	return key, prefix, HashAPIKey(key), nil
+}
+
+// HashAPIKey returns the hex encoded SHA-256 of the key. The keys are random
+// so they don't need a salt or a slow hash.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func isAPIKey(token string) bool {
+	return strings.HasPrefix(token, APIKeyPrefix)
+}
+
+// verifyAPIKey returns the API key of the secret key, if it exists and has
+// not expired.
+func (a *Authenticator) verifyAPIKey(key string) (models.APIKey, error) {
+	if a.apiKeys == nil {
+		return models.APIKey{}, errors.New("API keys are disabled")
+	}
+
+	apiKey, err := a.apiKeys.GetAPIKeyByHash(HashAPIKey(key))
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return models.APIKey{}, errors.New("unknown API key")
+		}
+		return models.APIKey{}, fmt.Errorf("failed to get API key: %w", err)
+	}
+
+	if apiKey.ExpiresAt != nil && !time.Now().Before(*apiKey.ExpiresAt) {
+		return models.APIKey{}, errors.New("API key has expired")
+	}
+
+	return apiKey, nil
+}
@@ -0,0 +1,152 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"gotest.tools/v3/assert"
+
+	"github.com/openclarity/vmclarity/api/models"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+type fakeAPIKeyStore map[string]models.APIKey
+
+func (s fakeAPIKeyStore) GetAPIKeyByHash(keyHash string) (models.APIKey, error) {
+	apiKey, ok := s[keyHash]
+	if !ok {
+		return models.APIKey{}, databaseTypes.ErrNotFound
+	}
+	return apiKey, nil
+}
+
+func TestGenerateAPIKey(t *testing.T) {
+	key, prefix, keyHash, err := GenerateAPIKey()
+	assert.NilError(t, err)
+
+	assert.Assert(t, strings.HasPrefix(key, APIKeyPrefix))
+	assert.Assert(t, strings.HasPrefix(key, prefix))
+	assert.Assert(t, len(prefix) < len(key))
+	assert.Equal(t, keyHash, HashAPIKey(key))
+
+	other, _, _, err := GenerateAPIKey()
+	assert.NilError(t, err)
+	assert.Assert(t, key != other)
+}
+
+func TestAuthenticator_MiddlewareAPIKey(t *testing.T) {
+	operatorKey, _, operatorHash, err := GenerateAPIKey()
+	assert.NilError(t, err)
+	expiredKey, _, expiredHash, err := GenerateAPIKey()
+	assert.NilError(t, err)
+	unknownKey, _, _, err := GenerateAPIKey()
+	assert.NilError(t, err)
+
+	store := fakeAPIKeyStore{
+		operatorHash: {
+			Id:   utils.PointerTo("key-1"),
+			Role: models.Operator,
+		},
+		expiredHash: {
+			Id:        utils.PointerTo("key-2"),
+			Role:      models.Admin,
+			ExpiresAt: utils.PointerTo(time.Now().Add(-time.Hour)),
+		},
+	}
+
+	// Without an issuer only the API keys are accepted
+	authenticator, err := New(context.Background(), Config{APIKeys: store})
+	assert.NilError(t, err)
+
+	tests := []struct {
+		name        string
+		token       string
+		wantStatus  int
+		wantSubject string
+		wantRole    models.APIKeyRole
+	}{
+		{
+			name:        "valid key",
+			token:       operatorKey,
+			wantStatus:  http.StatusOK,
+			wantSubject: "apiKey:key-1",
+			wantRole:    models.Operator,
+		},
+		{
+			name:       "expired key",
+			token:      expiredKey,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "unknown key",
+			token:      unknownKey,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "bearer token without an issuer",
+			token:      "eyJhbGciOiJSUzI1NiJ9.e30.c2ln",
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Use(authenticator.Middleware())
+			var subject string
+			var role models.APIKeyRole
+			e.GET("/", func(ctx echo.Context) error {
+				subject = Subject(ctx)
+				role = Role(ctx)
+				return ctx.NoContent(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set(echo.HeaderAuthorization, "Bearer "+tt.token)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, rec.Code, tt.wantStatus)
+			assert.Equal(t, subject, tt.wantSubject)
+			assert.Equal(t, role, tt.wantRole)
+		})
+	}
+}
+
+func TestAllows(t *testing.T) {
+	tests := []struct {
+		role     models.APIKeyRole
+		required models.APIKeyRole
+		want     bool
+	}{
+		{role: models.Admin, required: models.Admin, want: true},
+		{role: models.Admin, required: models.ReadOnly, want: true},
+		{role: models.Operator, required: models.Operator, want: true},
+		{role: models.Operator, required: models.Admin, want: false},
+		{role: models.ReadOnly, required: models.Operator, want: false},
+		{role: "", required: models.ReadOnly, want: false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, Allows(tt.role, tt.required), tt.want, "Allows(%q, %q)", tt.role, tt.required)
+	}
+}
@@ -0,0 +1,333 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/labstack/echo/v4"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const (
+	// subjectContextKey is the echo context key the subject of the verified
+	// token is stored in.
+	subjectContextKey = "auth.subject"
+	// roleContextKey is the echo context key the role of the authenticated
+	// request is stored in.
+	roleContextKey = "auth.role"
+	// assetGroupsContextKey is the echo context key the asset groups the
+	// authenticated request is restricted to are stored in.
+	assetGroupsContextKey = "auth.assetGroups"
+)
+
+// signingMethods are the asymmetric signing methods accepted for tokens,
+// symmetric ones would require sharing a secret with the provider.
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+type Config struct {
+	// IssuerURL of the OpenID provider, the tokens are verified with its
+	// signing keys and must be issued by it. The OIDC tokens are not
+	// accepted if not set.
+	IssuerURL string
+	// Audience the tokens must be issued for if set.
+	Audience string
+	// RequiredClaims are claim=value pairs the tokens must contain, a claim
+	// holding a list must contain the value.
+	RequiredClaims []string
+	// TrustedNetworks are the CIDRs the requests from are not authenticated,
+	// for example of the orchestrator and the scanners. They have the
+	// Operator role.
+	TrustedNetworks []string
+	// APIKeys the API keys are verified with, they are not accepted if nil.
+	APIKeys APIKeyStore
+}
+
+// Authenticator authenticates the requests with the OIDC bearer tokens issued
+// by the configured OpenID provider, or with API keys. The requests with an
+// OIDC token have the Admin role, the ones with an API key the role of the
+// key, and the ones from the trusted networks without a token the Operator
+// role, which is all the orchestrator and the scanners need.
+type Authenticator struct {
+	issuer          string
+	audience        string
+	requiredClaims  map[string]string
+	trustedNetworks []*net.IPNet
+	keys            *keySet
+	apiKeys         APIKeyStore
+}
+
+func New(ctx context.Context, config Config) (*Authenticator, error) {
+	requiredClaims := make(map[string]string, len(config.RequiredClaims))
+	for _, claim := range config.RequiredClaims {
+		name, value, ok := strings.Cut(claim, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid required claim %q, expected claim=value", claim)
+		}
+		requiredClaims[name] = value
+	}
+
+	trustedNetworks := make([]*net.IPNet, 0, len(config.TrustedNetworks))
+	for _, cidr := range config.TrustedNetworks {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted network %q: %w", cidr, err)
+		}
+		trustedNetworks = append(trustedNetworks, network)
+	}
+
+	a := &Authenticator{
+		audience:        config.Audience,
+		requiredClaims:  requiredClaims,
+		trustedNetworks: trustedNetworks,
+		apiKeys:         config.APIKeys,
+	}
+
+	if config.IssuerURL != "" {
+		client := &http.Client{Timeout: defaultHTTPTimeout}
+		metadata, err := discoverProvider(ctx, client, config.IssuerURL)
+		if err != nil {
+			return nil, err
+		}
+
+		a.issuer = metadata.Issuer
+		a.keys = &keySet{
+			client:  client,
+			jwksURI: metadata.JWKSURI,
+		}
+	}
+
+	return a, nil
+}
+
+// Middleware rejects the requests without a valid bearer token or API key with
+// 401 and the ones whose token lacks the required claims with 403. The
+// requests from the trusted networks are authenticated by their token if
+// they have one.
+func (a *Authenticator) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			request := ctx.Request()
+			id, err := a.authenticate(request.Context(), request.RemoteAddr, request.Header.Get(echo.HeaderAuthorization))
+			if err != nil {
+				if err.forbidden {
+					return sendError(ctx, http.StatusForbidden, err.message)
+				}
+				return sendUnauthorized(ctx, err.message)
+			}
+
+			ctx.Set(subjectContextKey, id.subject)
+			ctx.Set(roleContextKey, id.role)
+			ctx.Set(assetGroupsContextKey, id.assetGroupIDs)
+
+			return next(ctx)
+		}
+	}
+}
+
+// identity is who an authenticated request is sent by.
+type identity struct {
+	subject       string
+	role          models.APIKeyRole
+	assetGroupIDs []string
+}
+
+// authError is the reason a request is rejected, it is unauthenticated unless
+// forbidden is set.
+type authError struct {
+	forbidden bool
+	message   string
+}
+
+// authenticate authenticates a request with the value of its Authorization
+// header, or with the address it is sent from if it has none.
+func (a *Authenticator) authenticate(ctx context.Context, remoteAddr, authorization string) (identity, *authError) {
+	raw, ok := bearerToken(authorization)
+	if !ok {
+		if a.isTrusted(remoteAddr) {
+			return identity{role: models.Operator}, nil
+		}
+		return identity{}, &authError{message: "missing bearer token"}
+	}
+
+	if isAPIKey(raw) {
+		apiKey, err := a.verifyAPIKey(raw)
+		if err != nil {
+			log.Debugf("Failed to verify API key: %v", err)
+			return identity{}, &authError{message: "invalid API key"}
+		}
+
+		return identity{
+			subject:       "apiKey:" + utils.ValueOrZero(apiKey.Id),
+			role:          apiKey.Role,
+			assetGroupIDs: utils.ValueOrZero(apiKey.AssetGroupIDs),
+		}, nil
+	}
+
+	if a.keys == nil {
+		return identity{}, &authError{message: "invalid bearer token"}
+	}
+
+	claims, err := a.verify(ctx, raw)
+	if err != nil {
+		log.Debugf("Failed to verify bearer token: %v", err)
+		return identity{}, &authError{message: "invalid bearer token"}
+	}
+
+	if err := a.checkRequiredClaims(claims); err != nil {
+		log.Debugf("Bearer token is not authorized: %v", err)
+		return identity{}, &authError{forbidden: true, message: err.Error()}
+	}
+
+	subject, _ := claims["sub"].(string)
+	return identity{subject: subject, role: models.Admin}, nil
+}
+
+// Subject returns the subject of the verified token of the request, or an
+// empty string if the request was not authenticated.
+func Subject(ctx echo.Context) string {
+	subject, _ := ctx.Get(subjectContextKey).(string)
+	return subject
+}
+
+// Role returns the role of the authenticated request, or an empty role if the
+// request was not authenticated.
+func Role(ctx echo.Context) models.APIKeyRole {
+	role, _ := ctx.Get(roleContextKey).(models.APIKeyRole)
+	return role
+}
+
+// AssetGroupIDs returns the IDs of the asset groups the authenticated request
+// is restricted to, or nil if it can access every asset.
+func AssetGroupIDs(ctx echo.Context) []string {
+	ids, _ := ctx.Get(assetGroupsContextKey).([]string)
+	return ids
+}
+
+// roleLevels orders the roles by their permissions, each role has the
+// permissions of the ones below it.
+var roleLevels = map[models.APIKeyRole]int{
+	models.ReadOnly: 1,
+	models.Operator: 2,
+	models.Admin:    3,
+}
+
+// Allows reports whether the role has the permissions of the required role.
+func Allows(role, required models.APIKeyRole) bool {
+	level, ok := roleLevels[role]
+	return ok && level >= roleLevels[required]
+}
+
+func (a *Authenticator) verify(ctx context.Context, raw string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(raw, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return a.keys.get(ctx, kid)
+	}, jwt.WithValidMethods(signingMethods))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse token: %w", err)
+	}
+
+	now := jwt.TimeFunc().Unix()
+	if !claims.VerifyExpiresAt(now, true) {
+		return nil, errors.New("token has no expiry or has expired")
+	}
+	if !claims.VerifyIssuer(a.issuer, true) {
+		return nil, fmt.Errorf("token is not issued by %s", a.issuer)
+	}
+	if a.audience != "" && !claims.VerifyAudience(a.audience, true) {
+		return nil, fmt.Errorf("token is not issued for %s", a.audience)
+	}
+
+	return claims, nil
+}
+
+func (a *Authenticator) checkRequiredClaims(claims jwt.MapClaims) error {
+	for name, value := range a.requiredClaims {
+		if !claimContains(claims[name], value) {
+			return fmt.Errorf("token does not have the required claim %s=%s", name, value)
+		}
+	}
+
+	return nil
+}
+
+func claimContains(claim interface{}, value string) bool {
+	switch c := claim.(type) {
+	case nil:
+		return false
+	case []interface{}:
+		for _, item := range c {
+			if fmt.Sprint(item) == value {
+				return true
+			}
+		}
+		return false
+	default:
+		return fmt.Sprint(c) == value
+	}
+}
+
+// isTrusted reports whether the request is sent from a trusted network. The
+// address of the connection is used as the forwarding headers can be set by
+// any client.
+func (a *Authenticator) isTrusted(remoteAddr string) bool {
+	if len(a.trustedNetworks) == 0 {
+		return false
+	}
+
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+
+	for _, network := range a.trustedNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func bearerToken(authorization string) (string, bool) {
+	scheme, token, ok := strings.Cut(authorization, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+	return token, true
+}
+
+func sendUnauthorized(ctx echo.Context, message string) error {
+	ctx.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer error="invalid_token"`)
+	return sendError(ctx, http.StatusUnauthorized, message)
+}
+
+func sendError(ctx echo.Context, code int, message string) error {
+	return ctx.JSON(code, &models.ApiResponse{Message: &message}) // nolint:wrapcheck
+}
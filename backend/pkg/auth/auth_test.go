@@ -0,0 +1,246 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/labstack/echo/v4"
+	"gotest.tools/v3/assert"
+
+	"github.com/openclarity/vmclarity/api/models"
+)
+
+const testKeyID = "test-key"
+
+func newTestProvider(t *testing.T, key *rsa.PrivateKey) *httptest.Server {
+	t.Helper()
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			_ = json.NewEncoder(w).Encode(providerMetadata{
+				Issuer:  server.URL,
+				JWKSURI: server.URL + "/keys",
+			})
+		case "/keys":
+			_ = json.NewEncoder(w).Encode(jsonWebKeySet{
+				Keys: []jsonWebKey{
+					{
+						Kty: "RSA",
+						Kid: testKeyID,
+						Use: "sig",
+						N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+						E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+					},
+				},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func signToken(t *testing.T, key *rsa.PrivateKey, claims jwt.MapClaims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = testKeyID
+	signed, err := token.SignedString(key)
+	assert.NilError(t, err)
+
+	return signed
+}
+
+func TestAuthenticator_Middleware(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NilError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NilError(t, err)
+
+	provider := newTestProvider(t, key)
+	authenticator, err := New(context.Background(), Config{
+		IssuerURL:       provider.URL,
+		Audience:        "vmclarity",
+		RequiredClaims:  []string{"groups=vmclarity-admins"},
+		TrustedNetworks: []string{"10.0.0.0/8"},
+	})
+	assert.NilError(t, err)
+
+	validClaims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":    provider.URL,
+			"aud":    "vmclarity",
+			"sub":    "alice",
+			"exp":    time.Now().Add(time.Hour).Unix(),
+			"groups": []string{"developers", "vmclarity-admins"},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		remoteAddr  string
+		token       func() string
+		wantStatus  int
+		wantSubject string
+		wantRole    models.APIKeyRole
+	}{
+		{
+			name:        "valid token",
+			token:       func() string { return signToken(t, key, validClaims()) },
+			wantStatus:  http.StatusOK,
+			wantSubject: "alice",
+			wantRole:    models.Admin,
+		},
+		{
+			name:       "missing token",
+			token:      func() string { return "" },
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "trusted network without token",
+			remoteAddr: "10.1.2.3:4567",
+			token:      func() string { return "" },
+			wantStatus: http.StatusOK,
+			wantRole:   models.Operator,
+		},
+		{
+			name:        "trusted network with token",
+			remoteAddr:  "10.1.2.3:4567",
+			token:       func() string { return signToken(t, key, validClaims()) },
+			wantStatus:  http.StatusOK,
+			wantSubject: "alice",
+			wantRole:    models.Admin,
+		},
+		{
+			name:       "trusted network with invalid token",
+			remoteAddr: "10.1.2.3:4567",
+			token:      func() string { return signToken(t, otherKey, validClaims()) },
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "token signed with unknown key",
+			token:      func() string { return signToken(t, otherKey, validClaims()) },
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "expired token",
+			token: func() string {
+				claims := validClaims()
+				claims["exp"] = time.Now().Add(-time.Minute).Unix()
+				return signToken(t, key, claims)
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "token without expiry",
+			token: func() string {
+				claims := validClaims()
+				delete(claims, "exp")
+				return signToken(t, key, claims)
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "token of other audience",
+			token: func() string {
+				claims := validClaims()
+				claims["aud"] = "other"
+				return signToken(t, key, claims)
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "token of other issuer",
+			token: func() string {
+				claims := validClaims()
+				claims["iss"] = "https://issuer.example.com"
+				return signToken(t, key, claims)
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "token without required claim",
+			token: func() string {
+				claims := validClaims()
+				claims["groups"] = []string{"developers"}
+				return signToken(t, key, claims)
+			},
+			wantStatus: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Use(authenticator.Middleware())
+			var subject string
+			var role models.APIKeyRole
+			e.GET("/", func(ctx echo.Context) error {
+				subject = Subject(ctx)
+				role = Role(ctx)
+				return ctx.NoContent(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.remoteAddr != "" {
+				req.RemoteAddr = tt.remoteAddr
+			}
+			if token := tt.token(); token != "" {
+				req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, rec.Code, tt.wantStatus)
+			assert.Equal(t, subject, tt.wantSubject)
+			assert.Equal(t, role, tt.wantRole)
+			if tt.wantStatus != http.StatusOK {
+				var body map[string]string
+				assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+				assert.Assert(t, body["message"] != "")
+			}
+		})
+	}
+}
+
+func TestNew_invalidConfig(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NilError(t, err)
+	provider := newTestProvider(t, key)
+
+	_, err = New(context.Background(), Config{IssuerURL: provider.URL, RequiredClaims: []string{"groups"}})
+	assert.ErrorContains(t, err, "invalid required claim")
+
+	_, err = New(context.Background(), Config{IssuerURL: provider.URL, TrustedNetworks: []string{"10.0.0.1"}})
+	assert.ErrorContains(t, err, "invalid trusted network")
+
+	_, err = New(context.Background(), Config{IssuerURL: provider.URL + "/other"})
+	assert.ErrorContains(t, err, "failed to get OpenID provider metadata")
+}
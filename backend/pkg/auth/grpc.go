@@ -0,0 +1,88 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/openclarity/vmclarity/api/models"
+)
+
+// authorizationMetadataKey is the gRPC metadata key of the bearer token, the
+// counterpart of the Authorization header.
+const authorizationMetadataKey = "authorization"
+
+// UnaryServerInterceptor authenticates the calls of a gRPC API like
+// Middleware the requests of the REST API, and rejects the ones whose role
+// doesn't have the permissions of the required role.
+func (a *Authenticator) UnaryServerInterceptor(required models.APIKeyRole) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := a.authorizeGRPC(ctx, required); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is the UnaryServerInterceptor of the streaming
+// calls.
+func (a *Authenticator) StreamServerInterceptor(required models.APIKeyRole) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := a.authorizeGRPC(stream.Context(), required); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
+
+func (a *Authenticator) authorizeGRPC(ctx context.Context, required models.APIKeyRole) error {
+	var remoteAddr string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		remoteAddr = p.Addr.String()
+	}
+	var authorization string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(authorizationMetadataKey); len(values) > 0 {
+			authorization = values[0]
+		}
+	}
+
+	id, authErr := a.authenticate(ctx, remoteAddr, authorization)
+	if authErr != nil {
+		if authErr.forbidden {
+			return status.Error(codes.PermissionDenied, authErr.message) // nolint:wrapcheck
+		}
+		return status.Error(codes.Unauthenticated, authErr.message) // nolint:wrapcheck
+	}
+
+	if !Allows(id.role, required) {
+		return status.Error(codes.PermissionDenied, fmt.Sprintf("%s role is required, got %q", required, id.role)) // nolint:wrapcheck
+	}
+	// The gRPC calls are not scoped to asset groups, so the API keys
+	// restricted to them can't be used.
+	if len(id.assetGroupIDs) > 0 {
+		return status.Error(codes.PermissionDenied, "API key is restricted to asset groups") // nolint:wrapcheck
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"gotest.tools/v3/assert"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context // nolint:containedctx
+}
+
+func (s fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestAuthenticator_ServerInterceptors(t *testing.T) {
+	operatorKey, _, operatorHash, err := GenerateAPIKey()
+	assert.NilError(t, err)
+	readOnlyKey, _, readOnlyHash, err := GenerateAPIKey()
+	assert.NilError(t, err)
+	scopedKey, _, scopedHash, err := GenerateAPIKey()
+	assert.NilError(t, err)
+	unknownKey, _, _, err := GenerateAPIKey()
+	assert.NilError(t, err)
+
+	store := fakeAPIKeyStore{
+		operatorHash: {
+			Id:   utils.PointerTo("key-1"),
+			Role: models.Operator,
+		},
+		readOnlyHash: {
+			Id:   utils.PointerTo("key-2"),
+			Role: models.ReadOnly,
+		},
+		scopedHash: {
+			Id:            utils.PointerTo("key-3"),
+			Role:          models.Operator,
+			AssetGroupIDs: &[]string{"group-1"},
+		},
+	}
+
+	authenticator, err := New(context.Background(), Config{
+		TrustedNetworks: []string{"10.0.0.0/8"},
+		APIKeys:         store,
+	})
+	assert.NilError(t, err)
+
+	tests := []struct {
+		name     string
+		addr     string
+		token    string
+		wantCode codes.Code
+	}{
+		{
+			name:     "trusted network without a token",
+			addr:     "10.1.2.3:4567",
+			wantCode: codes.OK,
+		},
+		{
+			name:     "untrusted network without a token",
+			addr:     "192.168.1.1:4567",
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "operator key",
+			addr:     "192.168.1.1:4567",
+			token:    operatorKey,
+			wantCode: codes.OK,
+		},
+		{
+			name:     "read-only key",
+			addr:     "192.168.1.1:4567",
+			token:    readOnlyKey,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "key restricted to asset groups",
+			addr:     "192.168.1.1:4567",
+			token:    scopedKey,
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "unknown key from a trusted network",
+			addr:     "10.1.2.3:4567",
+			token:    unknownKey,
+			wantCode: codes.Unauthenticated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr, err := net.ResolveTCPAddr("tcp", tt.addr)
+			assert.NilError(t, err)
+			ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+			if tt.token != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+tt.token))
+			}
+
+			var called bool
+			_, err = authenticator.UnaryServerInterceptor(models.Operator)(ctx, nil, &grpc.UnaryServerInfo{},
+				func(context.Context, interface{}) (interface{}, error) {
+					called = true
+					return nil, nil // nolint:nilnil
+				})
+			assert.Equal(t, status.Code(err), tt.wantCode)
+			assert.Equal(t, called, tt.wantCode == codes.OK)
+
+			called = false
+			err = authenticator.StreamServerInterceptor(models.Operator)(nil, fakeServerStream{ctx: ctx}, &grpc.StreamServerInfo{},
+				func(interface{}, grpc.ServerStream) error {
+					called = true
+					return nil
+				})
+			assert.Equal(t, status.Code(err), tt.wantCode)
+			assert.Equal(t, called, tt.wantCode == codes.OK)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"time"
+)
+
+// ScannerTokenTTL is how long the scanner of a ScanResult can fetch its
+// configuration with the token it was created with. The scanners fetch it
+// when they start, so the token is only needed for the first minutes.
+const ScannerTokenTTL = time.Hour
+
+// GenerateScannerToken returns a new random token the scanner of a ScanResult
+// fetches its configuration with, and its hash to be stored.
+func GenerateScannerToken() (token, tokenHash string, err error) {
+	b := make([]byte, apiKeyBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", fmt.Errorf("failed to generate scanner token: %w", err)
+	}
+
+	token = base64.RawURLEncoding.EncodeToString(b)
+	return token, HashScannerToken(token), nil
+}
+
+// HashScannerToken returns the hash of the scanner token, which is random
+// like the API keys.
+func HashScannerToken(token string) string {
+	return HashAPIKey(token)
+}
@@ -25,14 +25,31 @@ import (
 	"syscall"
 
 	"github.com/Portshift/go-utils/healthz"
+	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/auth"
 	_config "github.com/openclarity/vmclarity/backend/pkg/config"
+	"github.com/openclarity/vmclarity/backend/pkg/correlation"
 	"github.com/openclarity/vmclarity/backend/pkg/database"
 	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/backend/pkg/exploitability"
+	"github.com/openclarity/vmclarity/backend/pkg/feeds"
+	"github.com/openclarity/vmclarity/backend/pkg/maintenance"
+	"github.com/openclarity/vmclarity/backend/pkg/notifications"
+	"github.com/openclarity/vmclarity/backend/pkg/posture"
 	"github.com/openclarity/vmclarity/backend/pkg/rest"
+	"github.com/openclarity/vmclarity/backend/pkg/retention"
+	"github.com/openclarity/vmclarity/backend/pkg/sbomscan"
+	"github.com/openclarity/vmclarity/backend/pkg/scannerapi"
+	"github.com/openclarity/vmclarity/backend/pkg/tasks"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
 	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
+	"github.com/openclarity/vmclarity/shared/pkg/compliance"
 	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/objectstore"
+	"github.com/openclarity/vmclarity/shared/pkg/report"
 	uibackend "github.com/openclarity/vmclarity/ui_backend/pkg/rest"
 )
 
@@ -46,6 +63,17 @@ func createDatabaseConfig(config *_config.Config) databaseTypes.DBConfig {
 		DBPort:         config.DBPort,
 		DBName:         config.DBName,
 		LocalDBPath:    config.LocalDBPath,
+
+		ReadReplicaDSN:    config.DBReadReplicaDSN,
+		ReadReplicaMaxLag: config.DBReadReplicaMaxLag,
+
+		FieldEncryptionKey:          config.FieldEncryptionKey,
+		FieldEncryptionPreviousKeys: config.FieldEncryptionPreviousKeys,
+
+		ScanResultLimits: databaseTypes.ScanResultLimits{
+			MaxFindings: config.ScanResultMaxFindings,
+			Families:    config.ScanResultMaxFindingsPerFamily,
+		},
 	}
 }
 
@@ -81,32 +109,175 @@ func Run(ctx context.Context) {
 		go database.CreateDemoData(ctx, dbHandler)
 	}
 
+	var objectStore objectstore.Store
+	if config.ObjectStoreDriver != "" {
+		objectStore, err = objectstore.New(ctx, objectstore.Config{
+			Driver:              objectstore.DriverType(config.ObjectStoreDriver),
+			Path:                config.ObjectStorePath,
+			S3Bucket:            config.ObjectStoreS3Bucket,
+			S3Region:            config.ObjectStoreS3Region,
+			S3Endpoint:          config.ObjectStoreS3Endpoint,
+			AzureBlobServiceURL: config.ObjectStoreAzureBlobServiceURL,
+			AzureBlobContainer:  config.ObjectStoreAzureBlobContainer,
+		})
+		if err != nil {
+			logger.Fatalf("Failed to create object store: %v", err)
+		}
+	} else {
+		logger.Infof("Object store is not configured")
+	}
+
 	backendAddress := fmt.Sprintf("http://%s%s", net.JoinHostPort(config.BackendRestHost, strconv.Itoa(config.BackendRestPort)), rest.BaseURL)
 	backendClient, err := backendclient.Create(backendAddress)
 	if err != nil {
 		logger.Fatalf("Failed to create a backend client: %v", err)
 	}
 
-	uiBackendServer := uibackend.CreateUIBackedServer(backendClient)
+	complianceMapper, err := compliance.NewMapper(config.ComplianceMappingsDir)
+	if err != nil {
+		logger.Fatalf("Failed to load compliance mappings: %v", err)
+	}
+
+	uiBackendServer := uibackend.CreateUIBackedServer(backendClient, complianceMapper)
 
 	// nolint:contextcheck
-	restServer, err := rest.CreateRESTServer(config.BackendRestPort, dbHandler, config.UISitePath, uiBackendServer)
+	limits := rest.UsageLimits{
+		MaxScansPerMonth:                config.QuotaMaxScansPerMonth,
+		MaxScannerInstanceHoursPerMonth: config.QuotaMaxScannerInstanceHoursPerMonth,
+		TenantTag:                       config.UsageTenantTag,
+	}
+
+	// The orchestrator is created before the REST server which reports the
+	// capabilities of its provider, but it is started after it.
+	var o *orchestrator.Orchestrator
+	providers := []models.Provider{}
+	if config.DisableOrchestrator {
+		logger.Infof("Runtime orchestrator is disabled")
+	} else {
+		var p provider.Provider
+		o, p, err = createOrchestrator(ctx, config, backendClient, objectStore)
+		if err != nil {
+			logger.Fatalf("Failed to create orchestrator: %v", err)
+		}
+		prometheus.MustRegister(o.Metrics())
+		providers = append(providers, models.Provider{
+			Kind:         p.Kind(),
+			Capabilities: provider.CapabilitiesOf(p),
+		})
+	}
+
+	notifier := notifications.New(dbHandler, notifications.Config{
+		Timeout:        config.NotificationTimeout,
+		MaxAttempts:    config.NotificationMaxAttempts,
+		DigestInterval: config.NotificationDigestInterval,
+		SMTP: report.SMTPConfig{
+			Address:  config.ReportSMTPAddress,
+			Username: config.ReportSMTPUsername,
+			Password: config.ReportSMTPPassword,
+			From:     config.ReportSMTPFrom,
+		},
+	})
+	notifier.Start(ctx)
+
+	// The background tasks change the DB, so they are paused while the
+	// backend is in maintenance mode.
+	scheduler := tasks.NewScheduler(maintenance.NewState(dbHandler).Enabled)
+	backgroundTasks := []tasks.Task{
+		notifier.DigestTask(),
+		retention.New(dbHandler, retention.Config{
+			Interval: config.RetentionInterval,
+		}).Task(),
+		posture.New(dbHandler, posture.Config{
+			Interval: config.PostureScoreInterval,
+			TeamTag:  config.PostureScoreTeamTag,
+			SLADays:  config.PostureScoreSLADays,
+		}).Task(),
+		correlation.New(dbHandler, correlation.Config{
+			Interval: config.CorrelationInterval,
+		}).Task(),
+		{
+			Name:       "findings-impact",
+			Interval:   uibackend.BackgroundRecalculationInterval,
+			RunOnStart: true,
+			Run:        uiBackendServer.RunBackgroundRecalculation,
+		},
+	}
+	if !config.DisableExploitabilityEnrichment {
+		backgroundTasks = append(backgroundTasks, exploitability.New(dbHandler, exploitability.Config{
+			Interval:    config.ExploitabilityInterval,
+			EPSSFeedURL: config.ExploitabilityEPSSFeedURL,
+			KEVFeedURL:  config.ExploitabilityKEVFeedURL,
+		}).Task())
+	}
+	var feedSyncer *feeds.Syncer
+	if len(config.Feeds) > 0 {
+		if objectStore == nil {
+			logger.Fatalf("An object store is required to sync the feeds")
+		}
+		feedSyncer = feeds.New(dbHandler, objectStore, feeds.Config{
+			Interval:    config.FeedSyncInterval,
+			MaxVersions: config.FeedMaxVersions,
+			Sources:     config.Feeds,
+		})
+		backgroundTasks = append(backgroundTasks, feedSyncer.Task())
+	}
+	for _, task := range backgroundTasks {
+		if err := scheduler.Register(task); err != nil {
+			logger.Fatalf("Failed to register background task: %v", err)
+		}
+	}
+
+	sbomScanner := sbomscan.New(sbomscan.Config{
+		GrypeServerAddress: config.GrypeServerAddress,
+		GrypeServerTimeout: config.GrypeServerTimeout,
+		TrivyServerAddress: config.TrivyServerAddress,
+		TrivyServerTimeout: config.TrivyServerTimeout,
+	})
+
+	var authenticator *auth.Authenticator
+	if config.OIDCIssuerURL != "" || config.AuthAPIKeysEnabled {
+		authConfig := auth.Config{
+			IssuerURL:       config.OIDCIssuerURL,
+			Audience:        config.OIDCAudience,
+			RequiredClaims:  config.OIDCRequiredClaims,
+			TrustedNetworks: config.AuthTrustedNetworks,
+		}
+		if config.AuthAPIKeysEnabled {
+			authConfig.APIKeys = dbHandler.APIKeysTable()
+		}
+		authenticator, err = auth.New(ctx, authConfig)
+		if err != nil {
+			logger.Fatalf("Failed to create authenticator: %v", err)
+		}
+	} else {
+		logger.Infof("Authentication is disabled")
+	}
+
+	restServer, err := rest.CreateRESTServer(config.BackendRestPort, dbHandler, limits, providers, notifier, scheduler, sbomScanner, objectStore, config.ObjectStoreMaxArtifactSize, feedSyncer, authenticator, config.UserIdentityHeader, config.UISitePath, uiBackendServer)
 	if err != nil {
 		logger.Fatalf("Failed to create REST server: %v", err)
 	}
 	restServer.Start(ctx, errChan)
 	defer restServer.Stop(ctx)
 
-	if config.DisableOrchestrator {
-		logger.Infof("Runtime orchestrator is disabled")
-	} else {
-		if err = startOrchestrator(ctx, config, backendClient); err != nil {
-			logger.Fatalf("Failed to start orchestrator: %v", err)
+	if config.BackendGRPCPort != 0 {
+		grpcServer, err := scannerapi.CreateGRPCServer(config.BackendGRPCPort, dbHandler, authenticator, config.BackendGRPCTLSCertFile, config.BackendGRPCTLSKeyFile)
+		if err != nil {
+			logger.Fatalf("Failed to create scanner gRPC server: %v", err)
 		}
+		grpcServer.Start(ctx, errChan)
+		defer grpcServer.Stop(ctx)
+	} else {
+		logger.Infof("Scanner gRPC server is disabled")
+	}
+
+	if o != nil {
+		o.Start(ctx)
 	}
 
-	// Background processing must start after rest server was started.
-	uiBackendServer.StartBackgroundProcessing(ctx)
+	// Background tasks must start after rest server was started, as the UI
+	// backend recalculation uses the backend API.
+	scheduler.Start(ctx)
 
 	healthServer.SetIsReady(true)
 	logger.Info("VMClarity backend is ready")
@@ -125,18 +296,22 @@ func Run(ctx context.Context) {
 	}
 }
 
-func startOrchestrator(ctx context.Context, config *_config.Config, client *backendclient.BackendClient) error {
+func createOrchestrator(ctx context.Context, config *_config.Config, client *backendclient.BackendClient, objectStore objectstore.Store) (*orchestrator.Orchestrator, provider.Provider, error) {
 	orchestratorConfig, err := orchestrator.LoadConfig(config.BackendRestHost, config.BackendRestPort, rest.BaseURL)
 	if err != nil {
-		return fmt.Errorf("failed to load Orchestrator config: %w", err)
+		return nil, nil, fmt.Errorf("failed to load Orchestrator config: %w", err)
 	}
+	orchestratorConfig.ReportScheduleWatcherConfig = orchestratorConfig.ReportScheduleWatcherConfig.WithObjectStore(objectStore)
 
-	o, err := orchestrator.New(ctx, orchestratorConfig, client)
+	p, err := orchestrator.NewProvider(ctx, orchestratorConfig.ProviderKind)
 	if err != nil {
-		return fmt.Errorf("failed to initialize Orchestrator: %w", err)
+		return nil, nil, fmt.Errorf("failed to initialize provider. Provider=%s: %w", orchestratorConfig.ProviderKind, err)
 	}
 
-	o.Start(ctx)
+	o, err := orchestrator.NewWithProvider(orchestratorConfig, p, client)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to initialize Orchestrator: %w", err)
+	}
 
-	return nil
+	return o, p, nil
 }
@@ -0,0 +1,54 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"fmt"
+
+	_config "github.com/openclarity/vmclarity/backend/pkg/config"
+	"github.com/openclarity/vmclarity/backend/pkg/database"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+)
+
+// RotateKeys re-encrypts the sensitive fields stored in the database with
+// the current field encryption key, the fields encrypted with the previous
+// keys must be readable with them.
+func RotateKeys(ctx context.Context, params databaseTypes.RotateKeysParams) error {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	config, err := _config.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	dbHandler, err := database.InitializeDatabase(createDatabaseConfig(config))
+	if err != nil {
+		return fmt.Errorf("failed to initialise database: %w", err)
+	}
+
+	err = dbHandler.RotateFieldEncryptionKeys(params, func(p databaseTypes.RotateKeysProgress) {
+		logger.Infof("Processed %d/%d objects of %s, re-encrypted %d (checkpoint %s)",
+			p.Processed, p.Total, p.Table, p.Rotated, p.Checkpoint)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to rotate field encryption keys: %w", err)
+	}
+
+	logger.Info("Field encryption keys rotated")
+	return nil
+}
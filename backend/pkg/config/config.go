@@ -18,9 +18,18 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
+
+	"github.com/openclarity/vmclarity/api/models"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/backend/pkg/feeds"
+	"github.com/openclarity/vmclarity/shared/pkg/objectstore"
 )
 
 const (
@@ -29,6 +38,12 @@ const (
 	BackendRestPort       = "BACKEND_REST_PORT"
 	HealthCheckAddress    = "HEALTH_CHECK_ADDRESS"
 
+	// Scanner gRPC API served over TLS on a separate port, disabled if the
+	// port is not set.
+	BackendGRPCPort        = "BACKEND_GRPC_PORT"
+	BackendGRPCTLSCertFile = "BACKEND_GRPC_TLS_CERT_FILE"
+	BackendGRPCTLSKeyFile  = "BACKEND_GRPC_TLS_KEY_FILE"
+
 	DBNameEnvVar     = "DB_NAME"
 	DBUserEnvVar     = "DB_USER"
 	DBPasswordEnvVar = "DB_PASS"
@@ -39,11 +54,109 @@ const (
 
 	LocalDBPath = "LOCAL_DB_PATH"
 
+	// Object store of the generated reports and of the raw outputs of the
+	// scanners. With the local database the objects are stored in a
+	// directory next to the database by default.
+	ObjectStoreDriver              = "OBJECT_STORE_DRIVER"
+	ObjectStorePath                = "OBJECT_STORE_PATH"
+	ObjectStoreS3Bucket            = "OBJECT_STORE_S3_BUCKET"
+	ObjectStoreS3Region            = "OBJECT_STORE_S3_REGION"
+	ObjectStoreS3Endpoint          = "OBJECT_STORE_S3_ENDPOINT"
+	ObjectStoreAzureBlobServiceURL = "OBJECT_STORE_AZURE_BLOB_SERVICE_URL"
+	ObjectStoreAzureBlobContainer  = "OBJECT_STORE_AZURE_BLOB_CONTAINER"
+	ObjectStoreMaxArtifactSize     = "OBJECT_STORE_MAX_ARTIFACT_SIZE"
+
+	// Optional read replica of the Postgres database the read-only queries
+	// are routed to.
+	DBReadReplicaDSN    = "DB_READ_REPLICA_DSN"
+	DBReadReplicaMaxLag = "DB_READ_REPLICA_MAX_LAG"
+
+	// Base64 encoded 32 bytes keys the sensitive fields are encrypted with
+	// at rest, the previous keys are comma separated.
+	FieldEncryptionKey          = "FIELD_ENCRYPTION_KEY"
+	FieldEncryptionPreviousKeys = "FIELD_ENCRYPTION_PREVIOUS_KEYS"
+
 	FakeDataEnvVar      = "FAKE_DATA"
 	DisableOrchestrator = "DISABLE_ORCHESTRATOR"
 
 	UISitePath = "UI_SITE_PATH" // TODO: UI site should be moved out of the backend to nginx
 
+	// Quota limits, 0 means unlimited.
+	QuotaMaxScansPerMonth                = "QUOTA_MAX_SCANS_PER_MONTH"
+	QuotaMaxScannerInstanceHoursPerMonth = "QUOTA_MAX_SCANNER_INSTANCE_HOURS_PER_MONTH"
+
+	// Key of the tag holding the tenant of the VM assets the usage is
+	// reported for.
+	UsageTenantTag = "USAGE_TENANT_TAG"
+
+	// Caps on the number of findings stored per scan family of a scan
+	// result, 0 means unlimited. The limits of the families are comma
+	// separated family=max pairs, e.g. secrets=50000, which override the
+	// limit of all the families.
+	ScanResultMaxFindings          = "SCAN_RESULT_MAX_FINDINGS"
+	ScanResultMaxFindingsPerFamily = "SCAN_RESULT_MAX_FINDINGS_PER_FAMILY"
+
+	// Optional directory of mapping files which extend the bundled compliance mappings.
+	ComplianceMappingsDir = "COMPLIANCE_MAPPINGS_DIR"
+
+	// Webhook notification delivery.
+	NotificationTimeout     = "NOTIFICATION_TIMEOUT"
+	NotificationMaxAttempts = "NOTIFICATION_MAX_ATTEMPTS"
+
+	// Finding digest delivery, the SMTP variables also configure the report
+	// delivery of the orchestrator.
+	NotificationDigestInterval = "NOTIFICATION_DIGEST_INTERVAL"
+	ReportSMTPAddress          = "REPORT_SMTP_ADDRESS"
+	ReportSMTPUsername         = "REPORT_SMTP_USERNAME"
+	ReportSMTPPassword         = "REPORT_SMTP_PASSWORD"
+	ReportSMTPFrom             = "REPORT_SMTP_FROM"
+
+	// Interval the retention settings are applied at.
+	RetentionInterval = "RETENTION_INTERVAL"
+
+	// Interval the posture scores of the teams are computed at, the tag
+	// holding the team of the assets and the scan SLA of the assets.
+	PostureScoreInterval = "POSTURE_SCORE_INTERVAL"
+	PostureScoreTeamTag  = "POSTURE_SCORE_TEAM_TAG"
+	PostureScoreSLADays  = "POSTURE_SCORE_SLA_DAYS"
+
+	// Interval the active findings are correlated at.
+	CorrelationInterval = "CORRELATION_INTERVAL"
+
+	// Enrichment of the vulnerability findings with the EPSS scores and the
+	// CISA Known Exploited Vulnerabilities catalog, the feed URLs can point
+	// to mirrors in air-gapped environments.
+	DisableExploitabilityEnrichment = "DISABLE_EXPLOITABILITY_ENRICHMENT"
+	ExploitabilityInterval          = "EXPLOITABILITY_INTERVAL"
+	ExploitabilityEPSSFeedURL       = "EXPLOITABILITY_EPSS_FEED_URL"
+	ExploitabilityKEVFeedURL        = "EXPLOITABILITY_KEV_FEED_URL"
+
+	// Vulnerability, exploit and malware feeds mirrored for the scanners, as
+	// comma separated name=kind=url items, the interval they are synced at
+	// and the number of versions kept of each.
+	Feeds            = "FEEDS"
+	FeedSyncInterval = "FEED_SYNC_INTERVAL"
+	FeedMaxVersions  = "FEED_MAX_VERSIONS"
+
+	// Vulnerability scanner servers the uploaded SBOMs are scanned with, the
+	// same variables configure the scanners of the orchestrator.
+	GrypeServerAddress = "GRYPE_SERVER_ADDRESS"
+	GrypeServerTimeout = "GRYPE_SERVER_TIMEOUT"
+	TrivyServerAddress = "TRIVY_SERVER_ADDRESS"
+	TrivyServerTimeout = "TRIVY_SERVER_TIMEOUT"
+
+	// Request header the authenticating proxy reports the identity of the user in.
+	UserIdentityHeader = "USER_IDENTITY_HEADER"
+
+	// OIDC authentication of the API requests, disabled if the issuer is not set.
+	OIDCIssuerURL       = "OIDC_ISSUER_URL"
+	OIDCAudience        = "OIDC_AUDIENCE"
+	OIDCRequiredClaims  = "OIDC_REQUIRED_CLAIMS"
+	AuthTrustedNetworks = "AUTH_TRUSTED_NETWORKS"
+	// Authentication of the API requests with the API keys created with the
+	// /apiKeys API.
+	AuthAPIKeysEnabled = "AUTH_API_KEYS_ENABLED"
+
 	LogLevel = "LOG_LEVEL"
 )
 
@@ -52,10 +165,63 @@ type Config struct {
 	BackendRestPort    int    `json:"backend-rest-port,omitempty"`
 	HealthCheckAddress string `json:"health-check-address,omitempty"`
 
+	BackendGRPCPort        int    `json:"backend-grpc-port,omitempty"`
+	BackendGRPCTLSCertFile string `json:"backend-grpc-tls-cert-file,omitempty"`
+	BackendGRPCTLSKeyFile  string `json:"backend-grpc-tls-key-file,omitempty"`
+
 	DisableOrchestrator bool `json:"disable_orchestrator"`
 
 	UISitePath string `json:"ui_site_path"`
 
+	QuotaMaxScansPerMonth                int `json:"quota-max-scans-per-month,omitempty"`
+	QuotaMaxScannerInstanceHoursPerMonth int `json:"quota-max-scanner-instance-hours-per-month,omitempty"`
+
+	UsageTenantTag string `json:"usage-tenant-tag,omitempty"`
+
+	ComplianceMappingsDir string `json:"compliance-mappings-dir,omitempty"`
+
+	ScanResultMaxFindings          int                       `json:"scan-result-max-findings,omitempty"`
+	ScanResultMaxFindingsPerFamily map[models.ScanFamily]int `json:"scan-result-max-findings-per-family,omitempty"`
+
+	UserIdentityHeader string `json:"user-identity-header,omitempty"`
+
+	OIDCIssuerURL       string   `json:"oidc-issuer-url,omitempty"`
+	OIDCAudience        string   `json:"oidc-audience,omitempty"`
+	OIDCRequiredClaims  []string `json:"oidc-required-claims,omitempty"`
+	AuthTrustedNetworks []string `json:"auth-trusted-networks,omitempty"`
+	AuthAPIKeysEnabled  bool     `json:"auth-api-keys-enabled,omitempty"`
+
+	NotificationTimeout     time.Duration `json:"notification-timeout,omitempty"`
+	NotificationMaxAttempts int           `json:"notification-max-attempts,omitempty"`
+
+	NotificationDigestInterval time.Duration `json:"notification-digest-interval,omitempty"`
+	ReportSMTPAddress          string        `json:"report-smtp-address,omitempty"`
+	ReportSMTPUsername         string        `json:"report-smtp-username,omitempty"`
+	ReportSMTPPassword         string        `json:"-"`
+	ReportSMTPFrom             string        `json:"report-smtp-from,omitempty"`
+
+	RetentionInterval time.Duration `json:"retention-interval,omitempty"`
+
+	PostureScoreInterval time.Duration `json:"posture-score-interval,omitempty"`
+	PostureScoreTeamTag  string        `json:"posture-score-team-tag,omitempty"`
+	PostureScoreSLADays  int           `json:"posture-score-sla-days,omitempty"`
+
+	CorrelationInterval time.Duration `json:"correlation-interval,omitempty"`
+
+	DisableExploitabilityEnrichment bool          `json:"disable-exploitability-enrichment,omitempty"`
+	ExploitabilityInterval          time.Duration `json:"exploitability-interval,omitempty"`
+	ExploitabilityEPSSFeedURL       string        `json:"exploitability-epss-feed-url,omitempty"`
+	ExploitabilityKEVFeedURL        string        `json:"exploitability-kev-feed-url,omitempty"`
+
+	Feeds            []feeds.Source `json:"feeds,omitempty"`
+	FeedSyncInterval time.Duration  `json:"feed-sync-interval,omitempty"`
+	FeedMaxVersions  int            `json:"feed-max-versions,omitempty"`
+
+	GrypeServerAddress string        `json:"grype-server-address,omitempty"`
+	GrypeServerTimeout time.Duration `json:"grype-server-timeout,omitempty"`
+	TrivyServerAddress string        `json:"trivy-server-address,omitempty"`
+	TrivyServerTimeout time.Duration `json:"trivy-server-timeout,omitempty"`
+
 	// database config
 	DatabaseDriver   string `json:"database-driver,omitempty"`
 	DBName           string `json:"db-name,omitempty"`
@@ -66,8 +232,23 @@ type Config struct {
 	EnableDBInfoLogs bool   `json:"enable-db-info-logs"`
 	EnableFakeData   bool   `json:"enable-fake-data"`
 
+	DBReadReplicaDSN    string        `json:"-"`
+	DBReadReplicaMaxLag time.Duration `json:"db-read-replica-max-lag,omitempty"`
+
 	LocalDBPath string    `json:"local-db-path,omitempty"`
 	LogLevel    log.Level `json:"log-level,omitempty"`
+
+	ObjectStoreDriver              string `json:"object-store-driver,omitempty"`
+	ObjectStorePath                string `json:"object-store-path,omitempty"`
+	ObjectStoreS3Bucket            string `json:"object-store-s3-bucket,omitempty"`
+	ObjectStoreS3Region            string `json:"object-store-s3-region,omitempty"`
+	ObjectStoreS3Endpoint          string `json:"object-store-s3-endpoint,omitempty"`
+	ObjectStoreAzureBlobServiceURL string `json:"object-store-azure-blob-service-url,omitempty"`
+	ObjectStoreAzureBlobContainer  string `json:"object-store-azure-blob-container,omitempty"`
+	ObjectStoreMaxArtifactSize     int64  `json:"object-store-max-artifact-size,omitempty"`
+
+	FieldEncryptionKey          string   `json:"-"`
+	FieldEncryptionPreviousKeys []string `json:"-"`
 }
 
 func LoadConfig() (*Config, error) {
@@ -77,10 +258,69 @@ func LoadConfig() (*Config, error) {
 	config.BackendRestPort = viper.GetInt(BackendRestPort)
 	config.HealthCheckAddress = viper.GetString(HealthCheckAddress)
 
+	config.BackendGRPCPort = viper.GetInt(BackendGRPCPort)
+	config.BackendGRPCTLSCertFile = viper.GetString(BackendGRPCTLSCertFile)
+	config.BackendGRPCTLSKeyFile = viper.GetString(BackendGRPCTLSKeyFile)
+
 	config.DisableOrchestrator = viper.GetBool(DisableOrchestrator)
 
 	config.UISitePath = viper.GetString(UISitePath)
 
+	config.QuotaMaxScansPerMonth = viper.GetInt(QuotaMaxScansPerMonth)
+	config.QuotaMaxScannerInstanceHoursPerMonth = viper.GetInt(QuotaMaxScannerInstanceHoursPerMonth)
+	config.UsageTenantTag = viper.GetString(UsageTenantTag)
+
+	config.ComplianceMappingsDir = viper.GetString(ComplianceMappingsDir)
+
+	config.ScanResultMaxFindings = viper.GetInt(ScanResultMaxFindings)
+	perFamily, err := parseFamilyLimits(viper.GetString(ScanResultMaxFindingsPerFamily))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", ScanResultMaxFindingsPerFamily, err)
+	}
+	config.ScanResultMaxFindingsPerFamily = perFamily
+
+	config.UserIdentityHeader = viper.GetString(UserIdentityHeader)
+
+	config.OIDCIssuerURL = viper.GetString(OIDCIssuerURL)
+	config.OIDCAudience = viper.GetString(OIDCAudience)
+	config.OIDCRequiredClaims = splitList(viper.GetString(OIDCRequiredClaims))
+	config.AuthTrustedNetworks = splitList(viper.GetString(AuthTrustedNetworks))
+	config.AuthAPIKeysEnabled = viper.GetBool(AuthAPIKeysEnabled)
+
+	config.NotificationTimeout = viper.GetDuration(NotificationTimeout)
+	config.NotificationMaxAttempts = viper.GetInt(NotificationMaxAttempts)
+
+	config.NotificationDigestInterval = viper.GetDuration(NotificationDigestInterval)
+	config.ReportSMTPAddress = viper.GetString(ReportSMTPAddress)
+	config.ReportSMTPUsername = viper.GetString(ReportSMTPUsername)
+	config.ReportSMTPPassword = viper.GetString(ReportSMTPPassword)
+	config.ReportSMTPFrom = viper.GetString(ReportSMTPFrom)
+
+	config.RetentionInterval = viper.GetDuration(RetentionInterval)
+
+	config.PostureScoreInterval = viper.GetDuration(PostureScoreInterval)
+	config.PostureScoreTeamTag = viper.GetString(PostureScoreTeamTag)
+	config.PostureScoreSLADays = viper.GetInt(PostureScoreSLADays)
+
+	config.CorrelationInterval = viper.GetDuration(CorrelationInterval)
+
+	config.DisableExploitabilityEnrichment = viper.GetBool(DisableExploitabilityEnrichment)
+	config.ExploitabilityInterval = viper.GetDuration(ExploitabilityInterval)
+	config.ExploitabilityEPSSFeedURL = viper.GetString(ExploitabilityEPSSFeedURL)
+	config.ExploitabilityKEVFeedURL = viper.GetString(ExploitabilityKEVFeedURL)
+
+	config.Feeds, err = parseFeeds(viper.GetString(Feeds))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", Feeds, err)
+	}
+	config.FeedSyncInterval = viper.GetDuration(FeedSyncInterval)
+	config.FeedMaxVersions = viper.GetInt(FeedMaxVersions)
+
+	config.GrypeServerAddress = viper.GetString(GrypeServerAddress)
+	config.GrypeServerTimeout = viper.GetDuration(GrypeServerTimeout)
+	config.TrivyServerAddress = viper.GetString(TrivyServerAddress)
+	config.TrivyServerTimeout = viper.GetDuration(TrivyServerTimeout)
+
 	config.DatabaseDriver = viper.GetString(DatabaseDriver)
 	config.DBPassword = viper.GetString(DBPasswordEnvVar)
 	config.DBUser = viper.GetString(DBUserEnvVar)
@@ -90,8 +330,29 @@ func LoadConfig() (*Config, error) {
 	config.EnableDBInfoLogs = viper.GetBool(EnableDBInfoLogs)
 	config.EnableFakeData = viper.GetBool(FakeDataEnvVar)
 
+	config.DBReadReplicaDSN = viper.GetString(DBReadReplicaDSN)
+	config.DBReadReplicaMaxLag = viper.GetDuration(DBReadReplicaMaxLag)
+
 	config.LocalDBPath = viper.GetString(LocalDBPath)
 
+	config.ObjectStoreDriver = viper.GetString(ObjectStoreDriver)
+	config.ObjectStorePath = viper.GetString(ObjectStorePath)
+	config.ObjectStoreS3Bucket = viper.GetString(ObjectStoreS3Bucket)
+	config.ObjectStoreS3Region = viper.GetString(ObjectStoreS3Region)
+	config.ObjectStoreS3Endpoint = viper.GetString(ObjectStoreS3Endpoint)
+	config.ObjectStoreAzureBlobServiceURL = viper.GetString(ObjectStoreAzureBlobServiceURL)
+	config.ObjectStoreAzureBlobContainer = viper.GetString(ObjectStoreAzureBlobContainer)
+	config.ObjectStoreMaxArtifactSize = viper.GetInt64(ObjectStoreMaxArtifactSize)
+	if config.DatabaseDriver == databaseTypes.DBDriverTypeLocal && config.ObjectStoreDriver == "" {
+		config.ObjectStoreDriver = string(objectstore.DriverTypeFilesystem)
+		if config.ObjectStorePath == "" {
+			config.ObjectStorePath = filepath.Join(filepath.Dir(config.LocalDBPath), "objects")
+		}
+	}
+
+	config.FieldEncryptionKey = viper.GetString(FieldEncryptionKey)
+	config.FieldEncryptionPreviousKeys = splitList(viper.GetString(FieldEncryptionPreviousKeys))
+
 	logLevel, err := log.ParseLevel(viper.GetString(LogLevel))
 	if err != nil {
 		logLevel = log.WarnLevel
@@ -107,3 +368,80 @@ func LoadConfig() (*Config, error) {
 
 	return config, nil
 }
+
+// parseFamilyLimits parses the comma separated family=max pairs.
+func parseFamilyLimits(list string) (map[models.ScanFamily]int, error) {
+	items := splitList(list)
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	limits := make(map[models.ScanFamily]int, len(items))
+	for _, item := range items {
+		family, value, ok := strings.Cut(item, "=")
+		if !ok {
+			return nil, fmt.Errorf("%q is not a family=max pair", item)
+		}
+		family = strings.TrimSpace(family)
+		if _, _, ok := models.ScanResultItemsFields(models.ScanFamily(family)); !ok {
+			return nil, fmt.Errorf("unknown scan family %q", family)
+		}
+		limit, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || limit < 0 {
+			return nil, fmt.Errorf("invalid maximum number of findings %q of %s", value, family)
+		}
+		limits[models.ScanFamily(family)] = limit
+	}
+	return limits, nil
+}
+
+// parseFeeds parses the comma separated name=kind=url items.
+func parseFeeds(list string) ([]feeds.Source, error) {
+	items := splitList(list)
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	sources := make([]feeds.Source, 0, len(items))
+	names := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		name, rest, ok := strings.Cut(item, "=")
+		if !ok {
+			return nil, fmt.Errorf("%q is not a name=kind=url item", item)
+		}
+		kind, url, ok := strings.Cut(rest, "=")
+		if !ok {
+			return nil, fmt.Errorf("%q is not a name=kind=url item", item)
+		}
+		name, kind, url = strings.TrimSpace(name), strings.TrimSpace(kind), strings.TrimSpace(url)
+		if name == "" || strings.Contains(name, "/") || url == "" {
+			return nil, fmt.Errorf("%q is not a name=kind=url item", item)
+		}
+		if _, ok := names[name]; ok {
+			return nil, fmt.Errorf("duplicate feed %q", name)
+		}
+		names[name] = struct{}{}
+		switch models.FeedKind(kind) {
+		case models.FeedKindVulnerability, models.FeedKindExploit, models.FeedKindMalware:
+		default:
+			return nil, fmt.Errorf("unknown kind %q of feed %s", kind, name)
+		}
+		sources = append(sources, feeds.Source{
+			Name: name,
+			Kind: models.FeedKind(kind),
+			URL:  url,
+		})
+	}
+	return sources, nil
+}
+
+// splitList returns the non-empty items of the comma separated list.
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
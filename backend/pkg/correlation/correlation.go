@@ -0,0 +1,298 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package correlation
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/backend/pkg/tasks"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const (
+	TaskName        = "finding-correlation"
+	DefaultInterval = 15 * time.Minute
+)
+
+// writableSUIDKeywords identify the misconfigurations of writable or SUID
+// binaries in the ID, description or message of the misconfiguration.
+var writableSUIDKeywords = []string{
+	"suid",
+	"sgid",
+	"setuid",
+	"setgid",
+	"world writable",
+	"world-writable",
+}
+
+type Config struct {
+	// Interval between correlating the findings.
+	Interval time.Duration
+}
+
+// Correlator periodically links the active findings of different scan
+// families on the same asset which together indicate a compromise or an
+// exploitable exposure into correlated findings. A correlated finding is
+// kept for as long as its rule matches, and resolved once it no longer does.
+type Correlator struct {
+	db     databaseTypes.Database
+	config Config
+}
+
+func New(db databaseTypes.Database, config Config) *Correlator {
+	if config.Interval <= 0 {
+		config.Interval = DefaultInterval
+	}
+
+	return &Correlator{
+		db:     db,
+		config: config,
+	}
+}
+
+// Task returns the task correlating the findings every interval, and once
+// when the backend starts.
+func (c *Correlator) Task() tasks.Task {
+	return tasks.Task{
+		Name:       TaskName,
+		Interval:   c.config.Interval,
+		Jitter:     tasks.DefaultJitter(c.config.Interval),
+		RunOnStart: true,
+		Run:        c.Run,
+	}
+}
+
+// Run correlates the active findings at now, and reconciles the unresolved
+// correlated findings with the correlations found.
+func (c *Correlator) Run(ctx context.Context, now time.Time) error {
+	now = now.UTC().Truncate(time.Second)
+
+	correlations, err := c.correlate()
+	if err != nil {
+		return err
+	}
+
+	unresolved, err := c.db.CorrelatedFindingsTable().GetCorrelatedFindings(models.GetCorrelatedFindingsParams{
+		Filter: utils.PointerTo("resolvedOn eq null"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get correlated findings: %w", err)
+	}
+
+	var created, resolved int
+	for _, correlatedFinding := range *unresolved.Items {
+		key := keyOf(correlatedFinding.Asset.Id, correlatedFinding.Rule, utils.ValueOrZero(correlatedFinding.Path))
+		if correlation, ok := correlations[key]; ok {
+			correlatedFinding.Severity = correlation.Severity
+			correlatedFinding.FindingIDs = correlation.FindingIDs
+			correlatedFinding.LastSeen = now
+			delete(correlations, key)
+		} else {
+			correlatedFinding.ResolvedOn = utils.PointerTo(now)
+			resolved++
+		}
+		if _, err := c.db.CorrelatedFindingsTable().SaveCorrelatedFinding(correlatedFinding); err != nil {
+			return fmt.Errorf("failed to save correlated finding %s: %w", *correlatedFinding.Id, err)
+		}
+	}
+
+	keys := make([]string, 0, len(correlations))
+	for key := range correlations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		correlatedFinding := correlations[key]
+		correlatedFinding.FirstSeen = now
+		correlatedFinding.LastSeen = now
+		if _, err := c.db.CorrelatedFindingsTable().CreateCorrelatedFinding(correlatedFinding); err != nil {
+			return fmt.Errorf("failed to create correlated finding: %w", err)
+		}
+		created++
+	}
+
+	log.GetLoggerFromContextOrDiscard(ctx).Infof("Correlated the findings: %d new and %d resolved correlated findings", created, resolved)
+
+	return nil
+}
+
+// assetFindings are the IDs of the active findings of an asset the rules
+// correlate.
+type assetFindings struct {
+	// byPath are the findings of the secret, malware and vulnerability
+	// families by the path they were reported on, and by family.
+	byPath                   map[string]map[models.ScanFamily][]string
+	malware                  []string
+	rootkits                 []string
+	secrets                  []string
+	exploitedVulnerabilities []string
+	writableSUIDs            []string
+}
+
+// correlate returns the correlations of the active findings by their key.
+func (c *Correlator) correlate() (map[string]models.CorrelatedFinding, error) {
+	findingsPerAsset := map[string]*assetFindings{}
+	err := c.db.FindingsTable().StreamFindings(models.GetFindingsParams{
+		Filter: utils.PointerTo("invalidatedOn eq null and suppression eq null"),
+		Select: utils.PointerTo("id,asset/id,findingInfo"),
+	}, func(finding models.Finding) error {
+		if finding.Id == nil || finding.Asset == nil {
+			return nil
+		}
+		findings, ok := findingsPerAsset[finding.Asset.Id]
+		if !ok {
+			findings = &assetFindings{byPath: map[string]map[models.ScanFamily][]string{}}
+			findingsPerAsset[finding.Asset.Id] = findings
+		}
+		findings.add(finding)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get findings: %w", err)
+	}
+
+	correlations := map[string]models.CorrelatedFinding{}
+	for assetID, findings := range findingsPerAsset {
+		for _, correlation := range findings.correlate(assetID) {
+			correlations[keyOf(assetID, correlation.Rule, utils.ValueOrZero(correlation.Path))] = correlation
+		}
+	}
+
+	return correlations, nil
+}
+
+// nolint:cyclop
+func (a *assetFindings) add(finding models.Finding) {
+	if finding.FindingInfo == nil {
+		return
+	}
+	info, err := finding.FindingInfo.ValueByDiscriminator()
+	if err != nil {
+		return
+	}
+
+	id := *finding.Id
+	switch info := info.(type) {
+	case models.VulnerabilityFindingInfo:
+		a.addPath(info.Path, models.ScanFamilyVulnerabilities, id)
+		if utils.ValueOrZero(info.KnownExploited) {
+			a.exploitedVulnerabilities = append(a.exploitedVulnerabilities, id)
+		}
+	case models.ExploitFindingInfo:
+		a.exploitedVulnerabilities = append(a.exploitedVulnerabilities, id)
+	case models.MalwareFindingInfo:
+		a.addPath(info.Path, models.ScanFamilyMalware, id)
+		a.malware = append(a.malware, id)
+	case models.SecretFindingInfo:
+		a.addPath(info.FilePath, models.ScanFamilySecrets, id)
+		a.secrets = append(a.secrets, id)
+	case models.RootkitFindingInfo:
+		a.rootkits = append(a.rootkits, id)
+	case models.MisconfigurationFindingInfo:
+		if isWritableSUID(info) {
+			a.writableSUIDs = append(a.writableSUIDs, id)
+		}
+	}
+}
+
+func (a *assetFindings) addPath(filePath *string, family models.ScanFamily, id string) {
+	if filePath == nil || *filePath == "" {
+		return
+	}
+	p := path.Clean(*filePath)
+	families, ok := a.byPath[p]
+	if !ok {
+		families = map[models.ScanFamily][]string{}
+		a.byPath[p] = families
+	}
+	families[family] = append(families[family], id)
+}
+
+// correlate returns the correlations of the findings of the asset.
+func (a *assetFindings) correlate(assetID string) []models.CorrelatedFinding {
+	var correlations []models.CorrelatedFinding
+	newCorrelation := func(rule models.CorrelationRule, severity models.VulnerabilitySeverity, findingIDs ...[]string) models.CorrelatedFinding {
+		var ids []string
+		for _, i := range findingIDs {
+			ids = append(ids, i...)
+		}
+		sort.Strings(ids)
+		return models.CorrelatedFinding{
+			Asset:      models.AssetRelationship{Id: assetID},
+			Rule:       rule,
+			Severity:   severity,
+			FindingIDs: ids,
+		}
+	}
+
+	for p, families := range a.byPath {
+		if len(families) < 2 { // nolint:gomnd
+			continue
+		}
+		severity := models.HIGH
+		if _, ok := families[models.ScanFamilyMalware]; ok {
+			severity = models.CRITICAL
+		}
+		ids := make([][]string, 0, len(families))
+		for _, familyIDs := range families {
+			ids = append(ids, familyIDs)
+		}
+		correlation := newCorrelation(models.SamePath, severity, ids...)
+		correlation.Path = utils.PointerTo(p)
+		correlations = append(correlations, correlation)
+	}
+
+	if len(a.malware) > 0 && len(a.rootkits) > 0 {
+		correlations = append(correlations, newCorrelation(models.MalwareAndRootkit, models.CRITICAL, a.malware, a.rootkits))
+	}
+	if len(a.secrets) > 0 && len(a.exploitedVulnerabilities) > 0 {
+		correlations = append(correlations, newCorrelation(models.ExposedSecretAndExploitedVulnerability, models.CRITICAL, a.secrets, a.exploitedVulnerabilities))
+	}
+	if len(a.writableSUIDs) > 0 && len(a.rootkits) > 0 {
+		correlations = append(correlations, newCorrelation(models.WritableSUIDAndRootkit, models.CRITICAL, a.writableSUIDs, a.rootkits))
+	}
+
+	return correlations
+}
+
+// isWritableSUID returns whether the misconfiguration is about a writable or
+// SUID binary.
+func isWritableSUID(info models.MisconfigurationFindingInfo) bool {
+	text := strings.ToLower(strings.Join([]string{
+		utils.ValueOrZero(info.TestID),
+		utils.ValueOrZero(info.TestDescription),
+		utils.ValueOrZero(info.Message),
+	}, " "))
+	for _, keyword := range writableSUIDKeywords {
+		if strings.Contains(text, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// keyOf returns the key identifying a correlation across the runs.
+func keyOf(assetID string, rule models.CorrelationRule, path string) string {
+	return assetID + "/" + string(rule) + "/" + path
+}
@@ -0,0 +1,209 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package correlation
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/database/gorm"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// nolint:cyclop
+func TestCorrelator_Run(t *testing.T) {
+	db, err := gorm.NewDatabase(databaseTypes.DBConfig{
+		DriverType:  databaseTypes.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("NewDatabase() error = %v", err)
+	}
+
+	createFinding := func(assetID string, from func(info *models.Finding_FindingInfo) error) string {
+		info := &models.Finding_FindingInfo{}
+		if err := from(info); err != nil {
+			t.Fatalf("failed to set finding info: %v", err)
+		}
+		finding, err := db.FindingsTable().CreateFinding(models.Finding{
+			Asset:       &models.AssetRelationship{Id: assetID},
+			FindingInfo: info,
+		})
+		if err != nil {
+			t.Fatalf("CreateFinding() error = %v", err)
+		}
+		return *finding.Id
+	}
+
+	malware := createFinding("asset-1", func(info *models.Finding_FindingInfo) error {
+		return info.FromMalwareFindingInfo(models.MalwareFindingInfo{
+			MalwareName: utils.PointerTo("Unix.Trojan.Mirai"),
+			Path:        utils.PointerTo("/usr/bin/../bin/sshd"),
+		})
+	})
+	secret := createFinding("asset-1", func(info *models.Finding_FindingInfo) error {
+		return info.FromSecretFindingInfo(models.SecretFindingInfo{
+			Description: utils.PointerTo("Private Key"),
+			FilePath:    utils.PointerTo("/usr/bin/sshd"),
+		})
+	})
+	exploited := createFinding("asset-1", func(info *models.Finding_FindingInfo) error {
+		return info.FromVulnerabilityFindingInfo(models.VulnerabilityFindingInfo{
+			VulnerabilityName: utils.PointerTo("CVE-2023-0001"),
+			Path:              utils.PointerTo("/usr/lib/libssl.so"),
+			KnownExploited:    utils.PointerTo(true),
+		})
+	})
+	rootkit := createFinding("asset-1", func(info *models.Finding_FindingInfo) error {
+		return info.FromRootkitFindingInfo(models.RootkitFindingInfo{
+			RootkitName: utils.PointerTo("Diamorphine"),
+		})
+	})
+	suid := createFinding("asset-1", func(info *models.Finding_FindingInfo) error {
+		return info.FromMisconfigurationFindingInfo(models.MisconfigurationFindingInfo{
+			TestID:          utils.PointerTo("FILE-7524"),
+			TestDescription: utils.PointerTo("Find world writable SUID binaries"),
+		})
+	})
+
+	// Not correlated, a single signal on its own asset
+	createFinding("asset-2", func(info *models.Finding_FindingInfo) error {
+		return info.FromRootkitFindingInfo(models.RootkitFindingInfo{
+			RootkitName: utils.PointerTo("Diamorphine"),
+		})
+	})
+
+	getCorrelatedFindings := func() []models.CorrelatedFinding {
+		got, err := db.CorrelatedFindingsTable().GetCorrelatedFindings(models.GetCorrelatedFindingsParams{})
+		if err != nil {
+			t.Fatalf("GetCorrelatedFindings() error = %v", err)
+		}
+		sort.Slice(*got.Items, func(i, j int) bool {
+			return (*got.Items)[i].Rule < (*got.Items)[j].Rule
+		})
+		return *got.Items
+	}
+	sorted := func(ids ...string) []string {
+		sort.Strings(ids)
+		return ids
+	}
+	ignoreID := cmpopts.IgnoreFields(models.CorrelatedFinding{}, "Id")
+
+	firstRun := time.Date(2023, 6, 30, 12, 0, 0, 0, time.UTC)
+	if err := New(db, Config{}).Run(context.Background(), firstRun); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	asset := models.AssetRelationship{Id: "asset-1"}
+	want := []models.CorrelatedFinding{
+		{
+			Asset:      asset,
+			Rule:       models.ExposedSecretAndExploitedVulnerability,
+			Severity:   models.CRITICAL,
+			FindingIDs: sorted(secret, exploited),
+			FirstSeen:  firstRun,
+			LastSeen:   firstRun,
+		},
+		{
+			Asset:      asset,
+			Rule:       models.MalwareAndRootkit,
+			Severity:   models.CRITICAL,
+			FindingIDs: sorted(malware, rootkit),
+			FirstSeen:  firstRun,
+			LastSeen:   firstRun,
+		},
+		{
+			Asset:      asset,
+			Rule:       models.SamePath,
+			Severity:   models.CRITICAL,
+			Path:       utils.PointerTo("/usr/bin/sshd"),
+			FindingIDs: sorted(malware, secret),
+			FirstSeen:  firstRun,
+			LastSeen:   firstRun,
+		},
+		{
+			Asset:      asset,
+			Rule:       models.WritableSUIDAndRootkit,
+			Severity:   models.CRITICAL,
+			FindingIDs: sorted(suid, rootkit),
+			FirstSeen:  firstRun,
+			LastSeen:   firstRun,
+		},
+	}
+	if diff := cmp.Diff(want, getCorrelatedFindings(), ignoreID); diff != "" {
+		t.Fatalf("GetCorrelatedFindings() mismatch (-want +got):\n%s", diff)
+	}
+
+	// Fixing the rootkit resolves its correlations, the others are seen again
+	secondRun := firstRun.Add(time.Hour)
+	_, err = db.FindingsTable().UpdateFinding(models.Finding{
+		Id:            utils.PointerTo(rootkit),
+		InvalidatedOn: utils.PointerTo(firstRun.Add(time.Minute)),
+	})
+	if err != nil {
+		t.Fatalf("UpdateFinding() error = %v", err)
+	}
+	if err := New(db, Config{}).Run(context.Background(), secondRun); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	want[0].LastSeen = secondRun
+	want[1].ResolvedOn = utils.PointerTo(secondRun)
+	want[2].LastSeen = secondRun
+	want[3].ResolvedOn = utils.PointerTo(secondRun)
+	if diff := cmp.Diff(want, getCorrelatedFindings(), ignoreID); diff != "" {
+		t.Errorf("GetCorrelatedFindings() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestIsWritableSUID(t *testing.T) {
+	tests := []struct {
+		name string
+		info models.MisconfigurationFindingInfo
+		want bool
+	}{
+		{
+			name: "suid binary",
+			info: models.MisconfigurationFindingInfo{TestID: utils.PointerTo("FILE-7524"), Message: utils.PointerTo("SUID bit set on /usr/bin/find")},
+			want: true,
+		},
+		{
+			name: "world writable",
+			info: models.MisconfigurationFindingInfo{TestDescription: utils.PointerTo("World-writable files in /etc")},
+			want: true,
+		},
+		{
+			name: "unrelated",
+			info: models.MisconfigurationFindingInfo{TestID: utils.PointerTo("SSH-7408"), Message: utils.PointerTo("PermitRootLogin is enabled")},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isWritableSUID(tt.info); got != tt.want {
+				t.Errorf("isWritableSUID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -119,7 +119,7 @@ func CreateDemoData(ctx context.Context, db types.Database) {
 	// Create targets:
 	targets := createTargets()
 	for i, target := range targets {
-		retTarget, err := db.TargetsTable().CreateTarget(target)
+		retTarget, err := db.AssetsTable().CreateAsset(target)
 		if err != nil {
 			logger.Fatalf("failed to create target [%d]: %v", i, err)
 		}
@@ -158,7 +158,7 @@ func CreateDemoData(ctx context.Context, db types.Database) {
 }
 
 // nolint:gocognit,prealloc,cyclop
-func createFindings(ctx context.Context, scanResults []models.TargetScanResult) []models.Finding {
+func createFindings(ctx context.Context, scanResults []models.AssetScanResult) []models.Finding {
 	var ret []models.Finding
 	rand.Seed(uint64(time.Now().Unix()))
 
@@ -171,8 +171,8 @@ func createFindings(ctx context.Context, scanResults []models.TargetScanResult)
 			foundOn = utils.PointerTo(time.Now().Add(time.Duration(-randMin) * time.Minute))
 		}
 		findingBase := models.Finding{
-			Asset: &models.TargetRelationship{
-				Id: scanResult.Target.Id,
+			Asset: &models.AssetRelationship{
+				Id: scanResult.Asset.Id,
 			},
 			FindingInfo: nil,
 			FoundOn:     foundOn,
@@ -419,8 +419,8 @@ func createVulnerabilityFindings(ctx context.Context, base models.Finding, vulne
 
 func createVMInfo(instanceID, location, image, instanceType, platform string,
 	tags []models.Tag, launchTime time.Time, instanceProvider models.CloudProvider,
-) *models.TargetType {
-	info := models.TargetType{}
+) *models.AssetType {
+	info := models.AssetType{}
 	err := info.FromVMInfo(models.VMInfo{
 		Image:            image,
 		InstanceID:       instanceID,
@@ -448,11 +448,14 @@ func createScopes() (models.Scopes, error) {
 	}, err
 }
 
-func createTargets() []models.Target {
-	return []models.Target{
+func createTargets() []models.Asset {
+	return []models.Asset{
 		{
 			ScansCount: utils.PointerTo(1),
 			Summary: &models.ScanFindingsSummary{
+				TotalCertificates:      utils.PointerTo(0),
+				TotalComplianceChecks:  utils.PointerTo(0),
+				TotalPluginFindings:    utils.PointerTo(0),
 				TotalExploits:          utils.PointerTo(0),
 				TotalMalware:           utils.PointerTo(0),
 				TotalMisconfigurations: utils.PointerTo(0),
@@ -467,12 +470,15 @@ func createTargets() []models.Target {
 					TotalNegligibleVulnerabilities: utils.PointerTo(0),
 				},
 			},
-			TargetInfo: createVMInfo(awsInstanceEUCentral11, awsRegionEUCentral1+"/"+awsVPCEUCentral11+"/"+awsSGEUCentral111,
+			AssetInfo: createVMInfo(awsInstanceEUCentral11, awsRegionEUCentral1+"/"+awsVPCEUCentral11+"/"+awsSGEUCentral111,
 				"ami-111", "t2.large", "Linux", []models.Tag{{Key: "Name", Value: "target1"}}, time.Now(), models.AWS),
 		},
 		{
 			ScansCount: utils.PointerTo(1),
 			Summary: &models.ScanFindingsSummary{
+				TotalCertificates:      utils.PointerTo(0),
+				TotalComplianceChecks:  utils.PointerTo(0),
+				TotalPluginFindings:    utils.PointerTo(0),
 				TotalExploits:          utils.PointerTo(0),
 				TotalMalware:           utils.PointerTo(0),
 				TotalMisconfigurations: utils.PointerTo(0),
@@ -487,12 +493,15 @@ func createTargets() []models.Target {
 					TotalNegligibleVulnerabilities: utils.PointerTo(0),
 				},
 			},
-			TargetInfo: createVMInfo(awsInstanceEUCentral12, awsRegionEUCentral1+"/"+awsVPCEUCentral11+"/"+awsSGEUCentral111,
+			AssetInfo: createVMInfo(awsInstanceEUCentral12, awsRegionEUCentral1+"/"+awsVPCEUCentral11+"/"+awsSGEUCentral111,
 				"ami-111", "t2.large", "Linux", []models.Tag{{Key: "Name", Value: "target2"}}, time.Now(), models.AWS),
 		},
 		{
 			ScansCount: utils.PointerTo(1),
 			Summary: &models.ScanFindingsSummary{
+				TotalCertificates:      utils.PointerTo(0),
+				TotalComplianceChecks:  utils.PointerTo(0),
+				TotalPluginFindings:    utils.PointerTo(0),
 				TotalExploits:          utils.PointerTo(2),
 				TotalMalware:           utils.PointerTo(3),
 				TotalMisconfigurations: utils.PointerTo(3),
@@ -507,7 +516,7 @@ func createTargets() []models.Target {
 					TotalNegligibleVulnerabilities: utils.PointerTo(0),
 				},
 			},
-			TargetInfo: createVMInfo(awsInstanceUSEast11, awsRegionUSEast1+"/"+awsVPCUSEast11+"/"+awsSGUSEast111,
+			AssetInfo: createVMInfo(awsInstanceUSEast11, awsRegionUSEast1+"/"+awsVPCUSEast11+"/"+awsSGUSEast111,
 				"ami-112", "t2.micro", "Linux", []models.Tag{{Key: "Name", Value: "target3"}}, time.Now(), models.AWS),
 		},
 	}
@@ -678,7 +687,7 @@ func createScanConfigs(ctx context.Context) []models.ScanConfig {
 	}
 }
 
-func createScans(targets []models.Target, scanConfigs []models.ScanConfig) []models.Scan {
+func createScans(targets []models.Asset, scanConfigs []models.ScanConfig) []models.Scan {
 	// Create scan 1: already ended
 	scan1Start := time.Now().Add(-10 * time.Hour)
 	scan1End := scan1Start.Add(5*time.Hour + 27*time.Minute + 56*time.Second)
@@ -687,6 +696,9 @@ func createScans(targets []models.Target, scanConfigs []models.ScanConfig) []mod
 	scan1Summary := &models.ScanSummary{
 		JobsCompleted:          utils.PointerTo[int](2),
 		JobsLeftToRun:          utils.PointerTo[int](0),
+		TotalCertificates:      utils.PointerTo[int](0),
+		TotalComplianceChecks:  utils.PointerTo[int](0),
+		TotalPluginFindings:    utils.PointerTo[int](0),
 		TotalExploits:          utils.PointerTo[int](0),
 		TotalMalware:           utils.PointerTo[int](0),
 		TotalMisconfigurations: utils.PointerTo[int](0),
@@ -717,6 +729,9 @@ func createScans(targets []models.Target, scanConfigs []models.ScanConfig) []mod
 	scan2Summary := &models.ScanSummary{
 		JobsCompleted:          utils.PointerTo[int](1),
 		JobsLeftToRun:          utils.PointerTo[int](1),
+		TotalCertificates:      utils.PointerTo[int](0),
+		TotalComplianceChecks:  utils.PointerTo[int](0),
+		TotalPluginFindings:    utils.PointerTo[int](0),
 		TotalExploits:          utils.PointerTo[int](2),
 		TotalMalware:           utils.PointerTo[int](3),
 		TotalMisconfigurations: utils.PointerTo[int](3),
@@ -752,7 +767,7 @@ func createScans(targets []models.Target, scanConfigs []models.ScanConfig) []mod
 			StateMessage:       utils.PointerTo("Scan was completed successfully"),
 			StateReason:        utils.PointerTo(models.ScanStateReasonSuccess),
 			Summary:            scan1Summary,
-			TargetIDs:          &scan1Targets,
+			AssetIDs:           &scan1Targets,
 		},
 		{
 			ScanConfig: &models.ScanConfigRelationship{
@@ -764,17 +779,17 @@ func createScans(targets []models.Target, scanConfigs []models.ScanConfig) []mod
 			StateMessage:       utils.PointerTo("Scan is in progress"),
 			StateReason:        nil,
 			Summary:            scan2Summary,
-			TargetIDs:          &scan2Targets,
+			AssetIDs:           &scan2Targets,
 		},
 	}
 }
 
 // nolint:gocognit
-func createScanResults(scans []models.Scan) []models.TargetScanResult {
-	var scanResults []models.TargetScanResult
+func createScanResults(scans []models.Scan) []models.AssetScanResult {
+	var scanResults []models.AssetScanResult
 	for _, scan := range scans {
-		for _, targetID := range *scan.TargetIDs {
-			result := models.TargetScanResult{
+		for _, targetID := range *scan.AssetIDs {
+			result := models.AssetScanResult{
 				Id: nil,
 				Scan: &models.ScanRelationship{
 					Id: *scan.Id,
@@ -782,7 +797,7 @@ func createScanResults(scans []models.Scan) []models.TargetScanResult {
 				Secrets: nil,
 				Status:  nil,
 				Summary: &models.ScanFindingsSummary{},
-				Target: &models.TargetRelationship{
+				Asset: &models.AssetRelationship{
 					Id: targetID,
 				},
 			}
@@ -872,6 +887,7 @@ func createSecretsResult() *[]models.Secret {
 			Fingerprint: utils.PointerTo("credentials:aws-access-token:4"),
 			StartColumn: utils.PointerTo(7),
 			StartLine:   utils.PointerTo(43),
+			Confidence:  utils.PointerTo(models.High),
 		},
 		{
 			Description: utils.PointerTo("export BUNDLE_ENTERPRISE__CONTRIBSYS__COM=cafebabe:deadbeef"),
@@ -881,6 +897,7 @@ func createSecretsResult() *[]models.Secret {
 			Fingerprint: utils.PointerTo("cd5226711335c68be1e720b318b7bc3135a30eb2:cmd/generate/config/rules/sidekiq.go:sidekiq-secret:23"),
 			StartColumn: utils.PointerTo(7),
 			StartLine:   utils.PointerTo(23),
+			Confidence:  utils.PointerTo(models.Medium),
 		},
 		{
 			Description: utils.PointerTo("GitLab Personal Access Token"),
@@ -890,6 +907,7 @@ func createSecretsResult() *[]models.Secret {
 			Fingerprint: utils.PointerTo("Applications/Firefox.app/Contents/Resources/browser/omni.ja:generic-api-key:sfs2"),
 			StartColumn: utils.PointerTo(20),
 			StartLine:   utils.PointerTo(7),
+			Confidence:  utils.PointerTo(models.Low),
 		},
 	}
 }
@@ -964,16 +982,19 @@ func createMalwareResult() *[]models.Malware {
 			MalwareName: utils.PointerTo("Pdf.Exploit.CVE_2009_4324-1"),
 			MalwareType: utils.PointerTo("WORM"),
 			Path:        utils.PointerTo("/test/metasploit-framework/modules/exploits/windows/browser/asus_net4switch_ipswcom.rb"),
+			Confidence:  utils.PointerTo(models.High),
 		},
 		{
 			MalwareName: utils.PointerTo("Xml.Malware.Squiblydoo-6728833-0"),
 			MalwareType: utils.PointerTo("SPYWARE"),
 			Path:        utils.PointerTo("/test/metasploit-framework/modules/exploits/windows/fileformat/office_ms17_11882.rb"),
+			Confidence:  utils.PointerTo(models.High),
 		},
 		{
 			MalwareName: utils.PointerTo("Unix.Trojan.MSShellcode-27"),
 			MalwareType: utils.PointerTo("TROJAN"),
 			Path:        utils.PointerTo("/test/metasploit-framework/documentation/modules/exploit/multi/http/makoserver_cmd_exec.md"),
+			Confidence:  utils.PointerTo(models.High),
 		},
 	}
 }
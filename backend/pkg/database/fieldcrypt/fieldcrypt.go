@@ -0,0 +1,206 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fieldcrypt encrypts the sensitive fields of the objects stored in
+// the database, e.g. the secrets of the webhooks.
+//
+// An encrypted value is "enc:v1:<key id>:<base64 of nonce and ciphertext>",
+// it is sealed with AES-256-GCM using the current key of the keyring and can
+// be opened with any key of the keyring, so that the values sealed with the
+// previous keys stay readable until they are re-encrypted with the current
+// one. Values without the prefix are stored as plain text, they were written
+// before encryption was enabled.
+package fieldcrypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	prefix  = "enc:v1:"
+	keySize = 32
+)
+
+var ErrUnknownKey = errors.New("value is encrypted with an unknown key")
+
+type key struct {
+	id   string
+	aead cipher.AEAD
+}
+
+// Keyring holds the key the values are encrypted with and the previous keys
+// which are only used for decryption. A nil Keyring leaves the values in
+// plain text.
+type Keyring struct {
+	current *key
+	keys    map[string]*key
+}
+
+// NewKeyring returns the keyring of the base64 encoded 32 bytes keys, or nil
+// if no current key is set.
+func NewKeyring(current string, previous []string) (*Keyring, error) {
+	if current == "" {
+		if len(previous) > 0 {
+			return nil, errors.New("previous encryption keys are set without a current key")
+		}
+		return nil, nil // nolint:nilnil
+	}
+
+	k, err := parseKey(current)
+	if err != nil {
+		return nil, fmt.Errorf("invalid current encryption key: %w", err)
+	}
+	keyring := &Keyring{
+		current: k,
+		keys:    map[string]*key{k.id: k},
+	}
+
+	for i, p := range previous {
+		k, err := parseKey(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid previous encryption key %d: %w", i, err)
+		}
+		keyring.keys[k.id] = k
+	}
+
+	return keyring, nil
+}
+
+func parseKey(encoded string) (*key, error) {
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode key: %w", err)
+	}
+	if len(raw) != keySize {
+		return nil, fmt.Errorf("key must be %d bytes, got %d", keySize, len(raw))
+	}
+
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+
+	sum := sha256.Sum256(raw)
+	return &key{
+		id:   hex.EncodeToString(sum[:4]),
+		aead: aead,
+	}, nil
+}
+
+// CurrentKeyID returns the ID of the key the values are encrypted with.
+func (k *Keyring) CurrentKeyID() string {
+	if k == nil {
+		return ""
+	}
+	return k.current.id
+}
+
+// Encrypt returns the value encrypted with the current key. Empty values and
+// the values of a nil keyring are returned as is.
+func (k *Keyring) Encrypt(value string) (string, error) {
+	if k == nil || value == "" {
+		return value, nil
+	}
+
+	nonce := make([]byte, k.current.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed := k.current.aead.Seal(nonce, nonce, []byte(value), nil)
+
+	return prefix + k.current.id + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt returns the plain text of the value encrypted with any key of the
+// keyring. Values which are not encrypted are returned as is.
+func (k *Keyring) Decrypt(value string) (string, error) {
+	keyID, payload, ok := parse(value)
+	if !ok {
+		return value, nil
+	}
+
+	if k == nil {
+		return "", ErrUnknownKey
+	}
+	dk, ok := k.keys[keyID]
+	if !ok {
+		return "", fmt.Errorf("%w %s", ErrUnknownKey, keyID)
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode encrypted value: %w", err)
+	}
+	nonceSize := dk.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return "", errors.New("encrypted value is too short")
+	}
+	plain, err := dk.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt value: %w", err)
+	}
+
+	return string(plain), nil
+}
+
+// NeedsRotation returns whether the value is not encrypted with the current
+// key, either because it is in plain text or it is encrypted with a previous
+// key.
+func (k *Keyring) NeedsRotation(value string) bool {
+	if k == nil || value == "" {
+		return false
+	}
+	keyID, _, ok := parse(value)
+	return !ok || keyID != k.current.id
+}
+
+// Rotate returns the value re-encrypted with the current key.
+func (k *Keyring) Rotate(value string) (string, error) {
+	plain, err := k.Decrypt(value)
+	if err != nil {
+		return "", err
+	}
+	return k.Encrypt(plain)
+}
+
+// IsEncrypted returns whether the value is encrypted.
+func IsEncrypted(value string) bool {
+	_, _, ok := parse(value)
+	return ok
+}
+
+func parse(value string) (string, string, bool) {
+	rest, ok := strings.CutPrefix(value, prefix)
+	if !ok {
+		return "", "", false
+	}
+	keyID, payload, ok := strings.Cut(rest, ":")
+	if !ok {
+		return "", "", false
+	}
+	return keyID, payload, true
+}
@@ -0,0 +1,167 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fieldcrypt
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+)
+
+var (
+	oldKey = base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+	newKey = base64.StdEncoding.EncodeToString([]byte("fedcba9876543210fedcba9876543210"))
+)
+
+func mustKeyring(t *testing.T, current string, previous ...string) *Keyring {
+	t.Helper()
+	k, err := NewKeyring(current, previous)
+	if err != nil {
+		t.Fatalf("NewKeyring() error = %v", err)
+	}
+	return k
+}
+
+func TestNewKeyring(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  string
+		previous []string
+		wantNil  bool
+		wantErr  bool
+	}{
+		{
+			name:    "disabled",
+			wantNil: true,
+		},
+		{
+			name:    "current key",
+			current: newKey,
+		},
+		{
+			name:     "current and previous keys",
+			current:  newKey,
+			previous: []string{oldKey},
+		},
+		{
+			name:     "previous keys without current key",
+			previous: []string{oldKey},
+			wantErr:  true,
+		},
+		{
+			name:    "not base64",
+			current: "not a key!",
+			wantErr: true,
+		},
+		{
+			name:    "short key",
+			current: base64.StdEncoding.EncodeToString([]byte("short")),
+			wantErr: true,
+		},
+		{
+			name:     "invalid previous key",
+			current:  newKey,
+			previous: []string{"not a key!"},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewKeyring(tt.current, tt.previous)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewKeyring() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (got == nil) != tt.wantNil {
+				t.Errorf("NewKeyring() = %v, wantNil %v", got, tt.wantNil)
+			}
+		})
+	}
+}
+
+func TestKeyring_EncryptDecrypt(t *testing.T) {
+	keyring := mustKeyring(t, newKey)
+
+	encrypted, err := keyring.Encrypt("s3cr3t")
+	if err != nil {
+		t.Fatalf("Encrypt() error = %v", err)
+	}
+	if !IsEncrypted(encrypted) || strings.Contains(encrypted, "s3cr3t") {
+		t.Errorf("Encrypt() = %q, want an encrypted value", encrypted)
+	}
+
+	decrypted, err := keyring.Decrypt(encrypted)
+	if err != nil {
+		t.Fatalf("Decrypt() error = %v", err)
+	}
+	if decrypted != "s3cr3t" {
+		t.Errorf("Decrypt() = %q, want %q", decrypted, "s3cr3t")
+	}
+
+	// Plain text values written before encryption was enabled are read as is.
+	plain, err := keyring.Decrypt("plain")
+	if err != nil || plain != "plain" {
+		t.Errorf("Decrypt() = %q, %v, want %q", plain, err, "plain")
+	}
+
+	// A nil keyring stores the values in plain text.
+	var disabled *Keyring
+	if got, _ := disabled.Encrypt("s3cr3t"); got != "s3cr3t" {
+		t.Errorf("Encrypt() of nil keyring = %q, want %q", got, "s3cr3t")
+	}
+	if _, err := disabled.Decrypt(encrypted); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("Decrypt() of nil keyring error = %v, want %v", err, ErrUnknownKey)
+	}
+}
+
+func TestKeyring_Rotation(t *testing.T) {
+	encrypted, err := mustKeyring(t, oldKey).Encrypt("s3cr3t")
+	if err != nil {
+		t.Fatalf("Encrypt() error = %v", err)
+	}
+
+	// Without the old key the value can't be read.
+	if _, err := mustKeyring(t, newKey).Decrypt(encrypted); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("Decrypt() error = %v, want %v", err, ErrUnknownKey)
+	}
+
+	keyring := mustKeyring(t, newKey, oldKey)
+	if !keyring.NeedsRotation(encrypted) {
+		t.Errorf("NeedsRotation() of value encrypted with previous key = false, want true")
+	}
+	if !keyring.NeedsRotation("plain") {
+		t.Errorf("NeedsRotation() of plain text value = false, want true")
+	}
+	if keyring.NeedsRotation("") {
+		t.Errorf("NeedsRotation() of empty value = true, want false")
+	}
+
+	rotated, err := keyring.Rotate(encrypted)
+	if err != nil {
+		t.Fatalf("Rotate() error = %v", err)
+	}
+	if keyring.NeedsRotation(rotated) {
+		t.Errorf("NeedsRotation() of rotated value = true, want false")
+	}
+
+	decrypted, err := mustKeyring(t, newKey).Decrypt(rotated)
+	if err != nil {
+		t.Fatalf("Decrypt() error = %v", err)
+	}
+	if decrypted != "s3cr3t" {
+		t.Errorf("Decrypt() = %q, want %q", decrypted, "s3cr3t")
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// APIKey is stored with the hash of its secret key outside of its Data, so
+// that the hash is never returned by the queries of the API.
+type APIKey struct {
+	ODataObject
+	KeyHash string `gorm:"uniqueIndex"`
+}
+
+type APIKeysTableHandler struct {
+	DB *gorm.DB
+}
+
+func (db *Handler) APIKeysTable() types.APIKeysTable {
+	return &APIKeysTableHandler{
+		DB: db.DB,
+	}
+}
+
+func (a *APIKeysTableHandler) GetAPIKeys(params models.GetAPIKeysParams) (models.APIKeys, error) {
+	var dbAPIKeys []APIKey
+	err := ODataQuery(a.DB, "APIKey", params.Filter, nil, params.Select, nil, params.OrderBy, params.Top, params.Skip, true, &dbAPIKeys)
+	if err != nil {
+		return models.APIKeys{}, err
+	}
+
+	items := []models.APIKey{}
+	for _, dbAPIKey := range dbAPIKeys {
+		var apiKey models.APIKey
+		if err := json.Unmarshal(dbAPIKey.Data, &apiKey); err != nil {
+			return models.APIKeys{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+		}
+		items = append(items, apiKey)
+	}
+
+	output := models.APIKeys{Items: &items}
+
+	if params.Count != nil && *params.Count {
+		count, err := ODataCount(a.DB, "APIKey", params.Filter, nil)
+		if err != nil {
+			return models.APIKeys{}, fmt.Errorf("failed to count records: %w", err)
+		}
+		output.Count = &count
+	}
+
+	return output, nil
+}
+
+func (a *APIKeysTableHandler) GetAPIKey(apiKeyID models.ApiKeyID, params models.GetAPIKeysAPIKeyIDParams) (models.APIKey, error) {
+	var dbAPIKey APIKey
+	filter := fmt.Sprintf("id eq '%s'", apiKeyID)
+	err := ODataQuery(a.DB, "APIKey", &filter, nil, params.Select, nil, nil, nil, nil, false, &dbAPIKey)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.APIKey{}, types.ErrNotFound
+		}
+		return models.APIKey{}, err
+	}
+
+	var apiKey models.APIKey
+	if err := json.Unmarshal(dbAPIKey.Data, &apiKey); err != nil {
+		return models.APIKey{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	return apiKey, nil
+}
+
+func (a *APIKeysTableHandler) GetAPIKeyByHash(keyHash string) (models.APIKey, error) {
+	var dbAPIKey APIKey
+	if err := a.DB.Where("key_hash = ?", keyHash).First(&dbAPIKey).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.APIKey{}, types.ErrNotFound
+		}
+		return models.APIKey{}, fmt.Errorf("failed to get API key from db: %w", err)
+	}
+
+	var apiKey models.APIKey
+	if err := json.Unmarshal(dbAPIKey.Data, &apiKey); err != nil {
+		return models.APIKey{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	return apiKey, nil
+}
+
+func (a *APIKeysTableHandler) CreateAPIKey(apiKey models.APIKey, keyHash string) (models.APIKey, error) {
+	// Check the user didn't provide an ID
+	if apiKey.Id != nil {
+		return models.APIKey{}, &common.BadRequestError{
+			Reason: "can not specify id field when creating a new APIKey",
+		}
+	}
+
+	if err := validateAPIKey(apiKey); err != nil {
+		return models.APIKey{}, err
+	}
+	if keyHash == "" {
+		return models.APIKey{}, errors.New("hash of the key is empty")
+	}
+
+	if err := validateAssetGroupReferences(a.DB, apiKey.AssetGroupIDs); err != nil {
+		return models.APIKey{}, err
+	}
+
+	// Generate a new UUID
+	apiKey.Id = utils.PointerTo(uuid.New().String())
+	apiKey.CreatedAt = utils.PointerTo(time.Now())
+
+	// The secret key itself is never stored
+	apiKey.Key = nil
+
+	marshaled, err := json.Marshal(apiKey)
+	if err != nil {
+		return models.APIKey{}, fmt.Errorf("failed to convert API model to DB model: %w", err)
+	}
+
+	newAPIKey := APIKey{KeyHash: keyHash}
+	newAPIKey.Data = marshaled
+
+	if err := a.DB.Create(&newAPIKey).Error; err != nil {
+		return models.APIKey{}, fmt.Errorf("failed to create API key in db: %w", err)
+	}
+
+	return apiKey, nil
+}
+
+func (a *APIKeysTableHandler) DeleteAPIKey(apiKeyID models.ApiKeyID) error {
+	if err := deleteObjByID(a.DB, apiKeyID, &APIKey{}); err != nil {
+		return fmt.Errorf("failed to delete API key: %w", err)
+	}
+	return nil
+}
+
+func validateAPIKey(apiKey models.APIKey) error {
+	if apiKey.Name == "" {
+		return &common.BadRequestError{
+			Reason: "name must be provided and can not be empty",
+		}
+	}
+
+	switch apiKey.Role {
+	case models.Admin, models.Operator, models.ReadOnly:
+	default:
+		return &common.BadRequestError{
+			Reason: fmt.Sprintf("unsupported role %q", apiKey.Role),
+		}
+	}
+
+	if apiKey.ExpiresAt != nil && !apiKey.ExpiresAt.After(time.Now()) {
+		return &common.BadRequestError{
+			Reason: "expiresAt must be in the future",
+		}
+	}
+
+	return nil
+}
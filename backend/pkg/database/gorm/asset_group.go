@@ -0,0 +1,300 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+type AssetGroup struct {
+	ODataObject
+}
+
+type AssetGroupsTableHandler struct {
+	DB *gorm.DB
+}
+
+func (db *Handler) AssetGroupsTable() types.AssetGroupsTable {
+	return &AssetGroupsTableHandler{
+		DB: db.DB,
+	}
+}
+
+func (a *AssetGroupsTableHandler) GetAssetGroups(params models.GetAssetGroupsParams) (models.AssetGroups, error) {
+	var dbGroups []AssetGroup
+	err := ODataQuery(a.DB, "AssetGroup", params.Filter, nil, params.Select, nil, params.OrderBy, params.Top, params.Skip, true, &dbGroups)
+	if err != nil {
+		return models.AssetGroups{}, err
+	}
+
+	items := []models.AssetGroup{}
+	for _, dbGroup := range dbGroups {
+		var group models.AssetGroup
+		if err := json.Unmarshal(dbGroup.Data, &group); err != nil {
+			return models.AssetGroups{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+		}
+		items = append(items, group)
+	}
+
+	output := models.AssetGroups{Items: &items}
+
+	if params.Count != nil && *params.Count {
+		count, err := ODataCount(a.DB, "AssetGroup", params.Filter, nil)
+		if err != nil {
+			return models.AssetGroups{}, fmt.Errorf("failed to count records: %w", err)
+		}
+		output.Count = &count
+	}
+
+	return output, nil
+}
+
+func (a *AssetGroupsTableHandler) GetAssetGroup(assetGroupID models.AssetGroupID, params models.GetAssetGroupsAssetGroupIDParams) (models.AssetGroup, error) {
+	var dbGroup AssetGroup
+	filter := fmt.Sprintf("id eq '%s'", assetGroupID)
+	err := ODataQuery(a.DB, "AssetGroup", &filter, nil, params.Select, nil, nil, nil, nil, false, &dbGroup)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.AssetGroup{}, types.ErrNotFound
+		}
+		return models.AssetGroup{}, err
+	}
+
+	var group models.AssetGroup
+	if err := json.Unmarshal(dbGroup.Data, &group); err != nil {
+		return models.AssetGroup{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	return group, nil
+}
+
+func (a *AssetGroupsTableHandler) CreateAssetGroup(group models.AssetGroup) (models.AssetGroup, error) {
+	// Check the user didn't provide an ID
+	if group.Id != nil {
+		return models.AssetGroup{}, &common.BadRequestError{
+			Reason: "can not specify id field when creating a new AssetGroup",
+		}
+	}
+
+	if err := validateAssetGroup(group); err != nil {
+		return models.AssetGroup{}, err
+	}
+
+	// Generate a new UUID
+	group.Id = utils.PointerTo(uuid.New().String())
+
+	// Initialise revision
+	group.Revision = utils.PointerTo(1)
+
+	// Check the existing DB entries to ensure that the name field is unique
+	existingGroup, err := a.checkUniqueness(group)
+	if err != nil {
+		var conflictErr *common.ConflictError
+		if errors.As(err, &conflictErr) {
+			return existingGroup, err
+		}
+		return models.AssetGroup{}, fmt.Errorf("failed to check existing asset group: %w", err)
+	}
+
+	marshaled, err := json.Marshal(group)
+	if err != nil {
+		return models.AssetGroup{}, fmt.Errorf("failed to convert API model to DB model: %w", err)
+	}
+
+	newGroup := AssetGroup{}
+	newGroup.Data = marshaled
+
+	if err := a.DB.Create(&newGroup).Error; err != nil {
+		return models.AssetGroup{}, fmt.Errorf("failed to create asset group in db: %w", err)
+	}
+
+	return group, nil
+}
+
+func (a *AssetGroupsTableHandler) UpdateAssetGroup(group models.AssetGroup, params models.PatchAssetGroupsAssetGroupIDParams) (models.AssetGroup, error) {
+	if group.Id == nil || *group.Id == "" {
+		return models.AssetGroup{}, &common.BadRequestError{
+			Reason: "id is required to update asset group",
+		}
+	}
+
+	var dbObj AssetGroup
+	if err := getExistingObjByID(a.DB, "AssetGroup", *group.Id, &dbObj); err != nil {
+		return models.AssetGroup{}, fmt.Errorf("failed to get asset group from db: %w", err)
+	}
+
+	var dbGroup models.AssetGroup
+	err := json.Unmarshal(dbObj.Data, &dbGroup)
+	if err != nil {
+		return models.AssetGroup{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	if err := checkRevisionEtag(params.IfMatch, dbGroup.Revision); err != nil {
+		return models.AssetGroup{}, err
+	}
+
+	group.Revision = bumpRevision(dbGroup.Revision)
+
+	dbObj.Data, err = patchObject(dbObj.Data, group)
+	if err != nil {
+		return models.AssetGroup{}, fmt.Errorf("failed to apply patch: %w", err)
+	}
+
+	var ag models.AssetGroup
+	err = json.Unmarshal(dbObj.Data, &ag)
+	if err != nil {
+		return models.AssetGroup{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	if err := validateAssetGroup(ag); err != nil {
+		return models.AssetGroup{}, err
+	}
+
+	// Check the existing DB entries to ensure that the name field is unique
+	existingGroup, err := a.checkUniqueness(ag)
+	if err != nil {
+		var conflictErr *common.ConflictError
+		if errors.As(err, &conflictErr) {
+			return existingGroup, err
+		}
+		return models.AssetGroup{}, fmt.Errorf("failed to check existing asset group: %w", err)
+	}
+
+	if err := a.DB.Save(&dbObj).Error; err != nil {
+		return models.AssetGroup{}, fmt.Errorf("failed to save asset group in db: %w", err)
+	}
+
+	return ag, nil
+}
+
+// DeleteAssetGroup deletes the group unless a scan config or an API key still
+// references it.
+func (a *AssetGroupsTableHandler) DeleteAssetGroup(assetGroupID models.AssetGroupID) error {
+	filter := fmt.Sprintf("assetGroupIDs/any(g: g eq '%s')", assetGroupID)
+
+	var scanConfigs []ScanConfig
+	err := ODataQuery(a.DB, "ScanConfig", &filter, nil, nil, nil, nil, utils.PointerTo(1), nil, true, &scanConfigs)
+	if err != nil {
+		return fmt.Errorf("failed to get scan configs referencing the asset group: %w", err)
+	}
+	if len(scanConfigs) > 0 {
+		return &common.ConflictError{
+			Reason: fmt.Sprintf("asset group %s is referenced by scan configs", assetGroupID),
+		}
+	}
+
+	var apiKeys []APIKey
+	err = ODataQuery(a.DB, "APIKey", &filter, nil, nil, nil, nil, utils.PointerTo(1), nil, true, &apiKeys)
+	if err != nil {
+		return fmt.Errorf("failed to get API keys referencing the asset group: %w", err)
+	}
+	if len(apiKeys) > 0 {
+		return &common.ConflictError{
+			Reason: fmt.Sprintf("asset group %s is referenced by API keys", assetGroupID),
+		}
+	}
+
+	if err := deleteObjByID(a.DB, assetGroupID, &AssetGroup{}); err != nil {
+		return fmt.Errorf("failed to delete asset group: %w", err)
+	}
+	return nil
+}
+
+func (a *AssetGroupsTableHandler) checkUniqueness(group models.AssetGroup) (models.AssetGroup, error) {
+	var dbGroups []AssetGroup
+	filter := fmt.Sprintf("id ne '%s' and name eq '%s'", *group.Id, *group.Name)
+	err := ODataQuery(a.DB, "AssetGroup", &filter, nil, nil, nil, nil, nil, nil, true, &dbGroups)
+	if err != nil {
+		return models.AssetGroup{}, err
+	}
+	if len(dbGroups) > 0 {
+		var ag models.AssetGroup
+		if err := json.Unmarshal(dbGroups[0].Data, &ag); err != nil {
+			return models.AssetGroup{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+		}
+		return ag, &common.ConflictError{
+			Reason: fmt.Sprintf("Asset group exists with name=%s", *ag.Name),
+		}
+	}
+	return models.AssetGroup{}, nil
+}
+
+// validateAssetGroup checks the group has members to select, and that they
+// can be matched by the OData filter of its members.
+func validateAssetGroup(group models.AssetGroup) error {
+	if group.Name == nil || *group.Name == "" {
+		return &common.BadRequestError{
+			Reason: "name must be provided and can not be empty",
+		}
+	}
+
+	if len(utils.ValueOrZero(group.TagSelector)) == 0 && len(utils.ValueOrZero(group.AssetIDs)) == 0 {
+		return &common.BadRequestError{
+			Reason: "tagSelector or assetIDs must be provided",
+		}
+	}
+
+	for _, tag := range utils.ValueOrZero(group.TagSelector) {
+		if tag.Key == "" {
+			return &common.BadRequestError{
+				Reason: "tagSelector keys can not be empty",
+			}
+		}
+		if strings.Contains(tag.Key, "'") || strings.Contains(tag.Value, "'") {
+			return &common.BadRequestError{
+				Reason: fmt.Sprintf("tagSelector tag %s can not contain quotes", tag.Key),
+			}
+		}
+	}
+
+	for _, id := range utils.ValueOrZero(group.AssetIDs) {
+		if id == "" || strings.Contains(id, "'") {
+			return &common.BadRequestError{
+				Reason: fmt.Sprintf("invalid asset ID %q in assetIDs", id),
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateAssetGroupReferences checks that the asset groups exist.
+func validateAssetGroupReferences(db *gorm.DB, assetGroupIDs *[]string) error {
+	for _, id := range utils.ValueOrZero(assetGroupIDs) {
+		var dbGroup AssetGroup
+		if err := getExistingObjByID(db, "AssetGroup", id, &dbGroup); err != nil {
+			if errors.Is(err, types.ErrNotFound) {
+				return &common.BadRequestError{
+					Reason: fmt.Sprintf("asset group %s not found", id),
+				}
+			}
+			return fmt.Errorf("failed to get asset group from db: %w", err)
+		}
+	}
+
+	return nil
+}
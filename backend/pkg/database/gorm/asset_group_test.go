@@ -0,0 +1,165 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestAssetGroupsTableHandler(t *testing.T) {
+	db, err := NewDatabase(types.DBConfig{
+		DriverType:  types.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("NewDatabase() error = %v", err)
+	}
+
+	var validationErr *common.BadRequestError
+	if _, err := db.AssetGroupsTable().CreateAssetGroup(models.AssetGroup{
+		Name: utils.PointerTo("production"),
+	}); !errors.As(err, &validationErr) {
+		t.Errorf("CreateAssetGroup() without members error = %v, want a validation error", err)
+	}
+	if _, err := db.AssetGroupsTable().CreateAssetGroup(models.AssetGroup{
+		Name:        utils.PointerTo("production"),
+		TagSelector: &[]models.Tag{{Key: "env", Value: "it's prod"}},
+	}); !errors.As(err, &validationErr) {
+		t.Errorf("CreateAssetGroup() with a quoted tag error = %v, want a validation error", err)
+	}
+
+	group, err := db.AssetGroupsTable().CreateAssetGroup(models.AssetGroup{
+		Name:        utils.PointerTo("production"),
+		TagSelector: &[]models.Tag{{Key: "env", Value: "prod"}},
+	})
+	if err != nil {
+		t.Fatalf("CreateAssetGroup() error = %v", err)
+	}
+
+	var conflictErr *common.ConflictError
+	if _, err := db.AssetGroupsTable().CreateAssetGroup(models.AssetGroup{
+		Name:     utils.PointerTo("production"),
+		AssetIDs: &[]string{"1"},
+	}); !errors.As(err, &conflictErr) {
+		t.Errorf("CreateAssetGroup() with the same name error = %v, want a conflict", err)
+	}
+
+	var vmInfo, imageInfo models.AssetType
+	if err := vmInfo.FromVMInfo(models.VMInfo{
+		ObjectType: "VMInfo",
+		InstanceID: "i-1",
+		Location:   "eu-central-1",
+		Tags:       &[]models.Tag{{Key: "env", Value: "prod"}},
+	}); err != nil {
+		t.Fatalf("FromVMInfo() error = %v", err)
+	}
+	if err := imageInfo.FromContainerImageInfo(models.ContainerImageInfo{
+		ObjectType: "ContainerImageInfo",
+		ImageID:    "sha256:1",
+		Tags:       &[]string{"latest"},
+	}); err != nil {
+		t.Fatalf("FromContainerImageInfo() error = %v", err)
+	}
+	vm, err := db.AssetsTable().CreateAsset(models.Asset{AssetInfo: &vmInfo})
+	if err != nil {
+		t.Fatalf("CreateAsset() error = %v", err)
+	}
+	image, err := db.AssetsTable().CreateAsset(models.Asset{AssetInfo: &imageInfo})
+	if err != nil {
+		t.Fatalf("CreateAsset() error = %v", err)
+	}
+
+	members := func() []string {
+		assets, err := db.AssetsTable().GetAssets(models.GetAssetsParams{
+			Filter: utils.PointerTo(group.MembersFilter("")),
+			Select: utils.PointerTo("id"),
+		})
+		if err != nil {
+			t.Fatalf("GetAssets() error = %v", err)
+		}
+		var ids []string
+		for _, asset := range *assets.Items {
+			ids = append(ids, *asset.Id)
+		}
+		return ids
+	}
+
+	if got := members(); len(got) != 1 || got[0] != *vm.Id {
+		t.Errorf("members = %v, want the VM %s", got, *vm.Id)
+	}
+
+	// Labeling the image makes it a member without changing the group.
+	if _, err := db.AssetsTable().UpdateAsset(models.Asset{
+		Id:     image.Id,
+		Labels: &[]models.Tag{{Key: "env", Value: "prod"}},
+	}, models.PatchAssetsAssetIDParams{}); err != nil {
+		t.Fatalf("UpdateAsset() error = %v", err)
+	}
+	if got := members(); len(got) != 2 {
+		t.Errorf("members = %v, want the VM and the labeled image", got)
+	}
+
+	// A quote in an asset ID must not widen the filter to other assets.
+	crafted := models.AssetGroup{AssetIDs: &[]string{"x' or id ne '"}}
+	assets, err := db.AssetsTable().GetAssets(models.GetAssetsParams{
+		Filter: utils.PointerTo(crafted.MembersFilter("")),
+	})
+	if err != nil {
+		t.Fatalf("GetAssets() error = %v", err)
+	}
+	if len(*assets.Items) != 0 {
+		t.Errorf("members of a crafted asset ID = %d, want none", len(*assets.Items))
+	}
+
+	scanConfig, err := db.ScanConfigsTable().CreateScanConfig(models.ScanConfig{
+		Name: utils.PointerTo("nightly production"),
+		Scheduled: &models.RuntimeScheduleScanConfig{
+			OperationTime: utils.PointerTo(time.Now().Add(time.Hour)),
+		},
+		AssetGroupIDs: &[]string{*group.Id},
+	})
+	if err != nil {
+		t.Fatalf("CreateScanConfig() error = %v", err)
+	}
+	if _, err := db.ScanConfigsTable().CreateScanConfig(models.ScanConfig{
+		Name: utils.PointerTo("nightly unknown"),
+		Scheduled: &models.RuntimeScheduleScanConfig{
+			OperationTime: utils.PointerTo(time.Now().Add(time.Hour)),
+		},
+		AssetGroupIDs: &[]string{"unknown"},
+	}); !errors.As(err, &validationErr) {
+		t.Errorf("CreateScanConfig() with an unknown asset group error = %v, want a validation error", err)
+	}
+
+	if err := db.AssetGroupsTable().DeleteAssetGroup(*group.Id); !errors.As(err, &conflictErr) {
+		t.Errorf("DeleteAssetGroup() of a referenced group error = %v, want a conflict", err)
+	}
+
+	if err := db.ScanConfigsTable().DeleteScanConfig(*scanConfig.Id); err != nil {
+		t.Fatalf("DeleteScanConfig() error = %v", err)
+	}
+	if err := db.AssetGroupsTable().DeleteAssetGroup(*group.Id); err != nil {
+		t.Errorf("DeleteAssetGroup() error = %v", err)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	"github.com/openclarity/vmclarity/backend/pkg/assetrename"
+)
+
+const (
+	legacyTargetsTable = "targets"
+	assetsTable        = "assets"
+
+	migrateAssetsBatchSize = 500
+)
+
+// assetRenamedFields are the renamed fields of the objects of each table.
+var assetRenamedFields = map[string][]assetrename.Field{
+	assetsTable:           assetrename.AssetFields,
+	"scan_results":        assetrename.ScanResultFields,
+	"provider_operations": assetrename.ProviderOperationFields,
+	"scans":               assetrename.ScanFields,
+}
+
+// migrateTargetsToAssets migrates a database created before the Target API
+// objects were renamed to Asset: the targets table is renamed to assets and
+// the renamed fields of the stored objects are renamed. It does nothing if
+// there is no targets table, so it is safe to run on every start.
+func migrateTargetsToAssets(db *gorm.DB) error {
+	migrator := db.Migrator()
+	if !migrator.HasTable(legacyTargetsTable) {
+		return nil
+	}
+	if migrator.HasTable(assetsTable) {
+		return fmt.Errorf("both the %s and the %s tables exist", legacyTargetsTable, assetsTable)
+	}
+
+	// nolint:wrapcheck
+	return db.Transaction(func(tx *gorm.DB) error {
+		// The index is recreated for the assets table after the migration.
+		if err := tx.Exec("DROP INDEX IF EXISTS targets_id_idx").Error; err != nil {
+			return fmt.Errorf("failed to drop index targets_id_idx: %w", err)
+		}
+		if err := tx.Migrator().RenameTable(legacyTargetsTable, assetsTable); err != nil {
+			return fmt.Errorf("failed to rename table %s to %s: %w", legacyTargetsTable, assetsTable, err)
+		}
+
+		for table, fields := range assetRenamedFields {
+			if !tx.Migrator().HasTable(table) {
+				continue
+			}
+			if err := renameTableFields(tx, table, fields); err != nil {
+				return fmt.Errorf("failed to migrate %s: %w", table, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func renameTableFields(tx *gorm.DB, table string, fields []assetrename.Field) error {
+	var lastID uint
+	for {
+		var batch []ODataObject
+		if err := tx.Table(table).Where("id > ?", lastID).Order("id").Limit(migrateAssetsBatchSize).Find(&batch).Error; err != nil {
+			return fmt.Errorf("failed to get objects: %w", err)
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+
+		for _, obj := range batch {
+			data, renamed, err := renameFields(obj.Data, fields)
+			if err != nil {
+				return fmt.Errorf("failed to migrate object %d: %w", obj.ID, err)
+			}
+			if !renamed {
+				continue
+			}
+			if err := tx.Table(table).Where("id = ?", obj.ID).Update("data", data).Error; err != nil {
+				return fmt.Errorf("failed to save object %d: %w", obj.ID, err)
+			}
+		}
+
+		lastID = batch[len(batch)-1].ID
+	}
+}
+
+// renameFields returns the data with the fields renamed, and whether any of
+// them was found.
+func renameFields(data datatypes.JSON, fields []assetrename.Field) (datatypes.JSON, bool, error) {
+	var obj interface{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, false, fmt.Errorf("failed to unmarshal data: %w", err)
+	}
+
+	if !assetrename.Rename(obj, "", fields) {
+		return data, false, nil
+	}
+
+	updated, err := json.Marshal(obj)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to marshal data: %w", err)
+	}
+
+	return updated, true, nil
+}
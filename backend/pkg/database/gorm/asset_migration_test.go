@@ -0,0 +1,97 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"path/filepath"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+)
+
+func TestMigrateTargetsToAssets(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "db.sqlite")
+
+	// Create a database as it was stored before the rename.
+	legacy, err := gorm.Open(sqlite.Open(path), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	for _, table := range []string{"targets", "scans"} {
+		if err := legacy.Table(table).AutoMigrate(&ODataObject{}); err != nil {
+			t.Fatalf("failed to create table %s: %v", table, err)
+		}
+	}
+	for _, stmt := range []string{
+		"CREATE INDEX targets_id_idx ON targets((JSON_EXTRACT(data, '$.id')))",
+		`INSERT INTO targets (data) VALUES ('{"id":"t1","targetInfo":{"objectType":"VMInfo","instanceID":"i-1"}}')`,
+		`INSERT INTO scans (data) VALUES ('{"id":"s1","targetIDs":["t1"],"plan":{"targets":[{"targetID":"t1","targetType":"VMInfo"}]}}')`,
+	} {
+		if err := legacy.Exec(stmt).Error; err != nil {
+			t.Fatalf("failed to create legacy database: %v", err)
+		}
+	}
+	sqlDB, err := legacy.DB()
+	if err != nil {
+		t.Fatalf("failed to get legacy database: %v", err)
+	}
+	_ = sqlDB.Close()
+
+	// Open it twice to check that the migration can run again.
+	for i := 0; i < 2; i++ {
+		db, err := NewDatabase(types.DBConfig{DriverType: types.DBDriverTypeLocal, LocalDBPath: path})
+		if err != nil {
+			t.Fatalf("NewDatabase() error = %v", err)
+		}
+
+		asset, err := db.AssetsTable().GetAsset("t1", models.GetAssetsAssetIDParams{})
+		if err != nil {
+			t.Fatalf("GetAsset() error = %v", err)
+		}
+		if asset.AssetInfo == nil {
+			t.Fatalf("assetInfo was not migrated")
+		}
+		vm, err := asset.AssetInfo.AsVMInfo()
+		if err != nil || vm.InstanceID != "i-1" {
+			t.Fatalf("unexpected assetInfo %v, error = %v", vm, err)
+		}
+
+		scan, err := db.ScansTable().GetScan("s1", models.GetScansScanIDParams{})
+		if err != nil {
+			t.Fatalf("GetScan() error = %v", err)
+		}
+		if scan.AssetIDs == nil || len(*scan.AssetIDs) != 1 || (*scan.AssetIDs)[0] != "t1" {
+			t.Fatalf("assetIDs was not migrated: %v", scan.AssetIDs)
+		}
+		if scan.Plan == nil || scan.Plan.Assets == nil || len(*scan.Plan.Assets) != 1 {
+			t.Fatalf("plan assets was not migrated: %v", scan.Plan)
+		}
+		if a := (*scan.Plan.Assets)[0]; a.AssetID != "t1" || a.AssetType != "VMInfo" {
+			t.Fatalf("unexpected plan asset %v", a)
+		}
+
+		handler := db.(*Handler) // nolint:forcetypeassert
+		sqlDB, err := handler.DB.DB()
+		if err != nil {
+			t.Fatalf("failed to get database: %v", err)
+		}
+		_ = sqlDB.Close()
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestAssetsTableHandler_Search(t *testing.T) {
+	db, err := NewDatabase(types.DBConfig{
+		DriverType:  types.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("NewDatabase() error = %v", err)
+	}
+	table := db.AssetsTable()
+
+	vms := []models.VMInfo{
+		{InstanceID: "i-web-1", Image: "ubuntu-22.04", Location: "eu-west-1"},
+		{InstanceID: "i-db-1", Image: "Ubuntu-20.04", Location: "us-east-1"},
+		{InstanceID: "i-web_2", Image: "debian-12", Location: "us-east-1"},
+	}
+	for _, vm := range vms {
+		info := models.AssetType{}
+		if err := info.FromVMInfo(vm); err != nil {
+			t.Fatalf("FromVMInfo() error = %v", err)
+		}
+		if _, err := table.CreateAsset(models.Asset{AssetInfo: &info}); err != nil {
+			t.Fatalf("CreateAsset() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		search string
+		want   []string
+	}{
+		{
+			name:   "case insensitive word",
+			search: "UBUNTU",
+			want:   []string{"i-db-1", "i-web-1"},
+		},
+		{
+			name:   "implicit and",
+			search: "ubuntu us-east",
+			want:   []string{"i-db-1"},
+		},
+		{
+			name:   "or and not",
+			search: "(debian OR eu-west) NOT web_",
+			want:   []string{"i-web-1"},
+		},
+		{
+			name:   "wildcards are literal",
+			search: `"web_"`,
+			want:   []string{"i-web_2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assets, err := table.GetAssets(models.GetAssetsParams{
+				Search: utils.PointerTo(tt.search),
+				Count:  utils.PointerTo(true),
+			})
+			if err != nil {
+				t.Fatalf("GetAssets() error = %v", err)
+			}
+			got := []string{}
+			for _, asset := range *assets.Items {
+				vm, err := asset.AssetInfo.AsVMInfo()
+				if err != nil {
+					t.Fatalf("AsVMInfo() error = %v", err)
+				}
+				got = append(got, vm.InstanceID)
+			}
+			sort.Strings(got)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetAssets() mismatch (-want +got):\n%s", diff)
+			}
+			if *assets.Count != len(tt.want) {
+				t.Errorf("GetAssets() count = %d, want %d", *assets.Count, len(tt.want))
+			}
+		})
+	}
+}
+
+func TestAssetsTableHandler_StreamAssetsWhileWriting(t *testing.T) {
+	db, err := NewDatabase(types.DBConfig{
+		DriverType:  types.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("NewDatabase() error = %v", err)
+	}
+	table := db.AssetsTable()
+
+	newAsset := func(instanceID string) models.Asset {
+		info := models.AssetType{}
+		if err := info.FromVMInfo(models.VMInfo{InstanceID: instanceID, Location: "eu-west-1"}); err != nil {
+			t.Fatalf("FromVMInfo() error = %v", err)
+		}
+		return models.Asset{AssetInfo: &info}
+	}
+	for _, instanceID := range []string{"i-1", "i-2"} {
+		if _, err := table.CreateAsset(newAsset(instanceID)); err != nil {
+			t.Fatalf("CreateAsset() error = %v", err)
+		}
+	}
+
+	// The assets are written while the stream holds its cursor, as they
+	// are while a slow client reads the stream.
+	var streamed int
+	err = table.StreamAssets(models.GetAssetsParams{}, func(asset models.Asset) error {
+		streamed++
+		_, err := table.CreateAsset(newAsset(fmt.Sprintf("i-new-%d", streamed)))
+		return err
+	})
+	if err != nil {
+		t.Fatalf("StreamAssets() error = %v", err)
+	}
+	if streamed < 2 {
+		t.Errorf("StreamAssets() streamed %d assets, want at least 2", streamed)
+	}
+}
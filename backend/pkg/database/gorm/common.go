@@ -19,18 +19,21 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
 
 	"gorm.io/gorm"
 
 	jsonpatch "github.com/evanphx/json-patch"
 
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
 	"github.com/openclarity/vmclarity/backend/pkg/database/types"
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
 func getExistingObjByID(db *gorm.DB, schema, objID string, obj interface{}) error {
 	filter := fmt.Sprintf("id eq '%s'", objID)
-	err := ODataQuery(db, schema, &filter, nil, nil, nil, nil, nil, false, &obj)
+	err := ODataQuery(db, schema, &filter, nil, nil, nil, nil, nil, nil, false, &obj)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return types.ErrNotFound
@@ -85,3 +88,22 @@ func bumpRevision(oldrevision *int) *int {
 	}
 	return utils.PointerTo(1)
 }
+
+// annotationKeyRegex matches the annotation keys which can be used as a property name in OData filters.
+var annotationKeyRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func validateAnnotations(annotations *models.Annotations) error {
+	if annotations == nil {
+		return nil
+	}
+
+	for key := range *annotations {
+		if !annotationKeyRegex.MatchString(key) {
+			return &common.BadRequestError{
+				Reason: fmt.Sprintf("invalid annotation key %q: must start with a letter or underscore and contain only letters, digits and underscores", key),
+			}
+		}
+	}
+
+	return nil
+}
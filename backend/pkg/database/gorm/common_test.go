@@ -21,6 +21,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 
+	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
@@ -209,3 +210,55 @@ func Test_patchObject(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateAnnotations(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations *models.Annotations
+		wantErr     bool
+	}{
+		{
+			name:        "no annotations",
+			annotations: nil,
+			wantErr:     false,
+		},
+		{
+			name: "valid keys",
+			annotations: &models.Annotations{
+				"ticketID":       "SEC-123",
+				"_pipeline_run2": "https://ci.example.com/runs/42",
+			},
+			wantErr: false,
+		},
+		{
+			name: "key with a dash",
+			annotations: &models.Annotations{
+				"ticket-id": "SEC-123",
+			},
+			wantErr: true,
+		},
+		{
+			name: "key starting with a digit",
+			annotations: &models.Annotations{
+				"1ticket": "SEC-123",
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty key",
+			annotations: &models.Annotations{
+				"": "SEC-123",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateAnnotations(test.annotations)
+			if (err != nil) != test.wantErr {
+				t.Errorf("validateAnnotations() error = %v, wantErr %v", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+type CorrelatedFinding struct {
+	ODataObject
+}
+
+type CorrelatedFindingsTableHandler struct {
+	DB *gorm.DB
+}
+
+func (db *Handler) CorrelatedFindingsTable() types.CorrelatedFindingsTable {
+	return &CorrelatedFindingsTableHandler{
+		DB: db.DB,
+	}
+}
+
+func (c *CorrelatedFindingsTableHandler) GetCorrelatedFindings(params models.GetCorrelatedFindingsParams) (models.CorrelatedFindings, error) {
+	var correlatedFindings []CorrelatedFinding
+	err := ODataQuery(c.DB, "CorrelatedFinding", params.Filter, nil, params.Select, params.Expand, params.OrderBy, params.Top, params.Skip, true, &correlatedFindings)
+	if err != nil {
+		return models.CorrelatedFindings{}, err
+	}
+
+	items := []models.CorrelatedFinding{}
+	for _, correlatedFinding := range correlatedFindings {
+		var cf models.CorrelatedFinding
+		err := json.Unmarshal(correlatedFinding.Data, &cf)
+		if err != nil {
+			return models.CorrelatedFindings{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+		}
+		items = append(items, cf)
+	}
+
+	output := models.CorrelatedFindings{Items: &items}
+
+	if params.Count != nil && *params.Count {
+		count, err := ODataCount(c.DB, "CorrelatedFinding", params.Filter, nil)
+		if err != nil {
+			return models.CorrelatedFindings{}, fmt.Errorf("failed to count records: %w", err)
+		}
+		output.Count = &count
+	}
+
+	return output, nil
+}
+
+func (c *CorrelatedFindingsTableHandler) GetCorrelatedFinding(correlatedFindingID models.CorrelatedFindingID, params models.GetCorrelatedFindingsCorrelatedFindingIDParams) (models.CorrelatedFinding, error) {
+	var dbCorrelatedFinding CorrelatedFinding
+	filter := fmt.Sprintf("id eq '%s'", correlatedFindingID)
+	err := ODataQuery(c.DB, "CorrelatedFinding", &filter, nil, params.Select, params.Expand, nil, nil, nil, false, &dbCorrelatedFinding)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.CorrelatedFinding{}, types.ErrNotFound
+		}
+		return models.CorrelatedFinding{}, err
+	}
+
+	var cf models.CorrelatedFinding
+	err = json.Unmarshal(dbCorrelatedFinding.Data, &cf)
+	if err != nil {
+		return models.CorrelatedFinding{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	return cf, nil
+}
+
+func (c *CorrelatedFindingsTableHandler) CreateCorrelatedFinding(correlatedFinding models.CorrelatedFinding) (models.CorrelatedFinding, error) {
+	// Check the user didn't provide an ID
+	if correlatedFinding.Id != nil {
+		return models.CorrelatedFinding{}, &common.BadRequestError{
+			Reason: "can not specify id field when creating a new CorrelatedFinding",
+		}
+	}
+
+	if err := validateCorrelatedFinding(correlatedFinding); err != nil {
+		return models.CorrelatedFinding{}, err
+	}
+
+	// Generate a new UUID
+	correlatedFinding.Id = utils.PointerTo(uuid.New().String())
+
+	marshaled, err := json.Marshal(correlatedFinding)
+	if err != nil {
+		return models.CorrelatedFinding{}, fmt.Errorf("failed to convert API model to DB model: %w", err)
+	}
+
+	newCorrelatedFinding := CorrelatedFinding{}
+	newCorrelatedFinding.Data = marshaled
+
+	if err := c.DB.Create(&newCorrelatedFinding).Error; err != nil {
+		return models.CorrelatedFinding{}, fmt.Errorf("failed to create correlated finding in db: %w", err)
+	}
+
+	return correlatedFinding, nil
+}
+
+func (c *CorrelatedFindingsTableHandler) SaveCorrelatedFinding(correlatedFinding models.CorrelatedFinding) (models.CorrelatedFinding, error) {
+	if correlatedFinding.Id == nil || *correlatedFinding.Id == "" {
+		return models.CorrelatedFinding{}, &common.BadRequestError{
+			Reason: "id is required to save correlated finding",
+		}
+	}
+
+	if err := validateCorrelatedFinding(correlatedFinding); err != nil {
+		return models.CorrelatedFinding{}, err
+	}
+
+	var dbCorrelatedFinding CorrelatedFinding
+	err := getExistingObjByID(c.DB, "CorrelatedFinding", *correlatedFinding.Id, &dbCorrelatedFinding)
+	if err != nil {
+		return models.CorrelatedFinding{}, err
+	}
+
+	marshaled, err := json.Marshal(correlatedFinding)
+	if err != nil {
+		return models.CorrelatedFinding{}, fmt.Errorf("failed to convert API model to DB model: %w", err)
+	}
+
+	dbCorrelatedFinding.Data = marshaled
+
+	if err := c.DB.Save(&dbCorrelatedFinding).Error; err != nil {
+		return models.CorrelatedFinding{}, fmt.Errorf("failed to save correlated finding in db: %w", err)
+	}
+
+	return correlatedFinding, nil
+}
+
+func validateCorrelatedFinding(correlatedFinding models.CorrelatedFinding) error {
+	if correlatedFinding.Asset.Id == "" {
+		return &common.BadRequestError{
+			Reason: "asset must be provided",
+		}
+	}
+
+	switch correlatedFinding.Rule {
+	case models.SamePath, models.MalwareAndRootkit, models.ExposedSecretAndExploitedVulnerability, models.WritableSUIDAndRootkit:
+	default:
+		return &common.BadRequestError{
+			Reason: fmt.Sprintf("unsupported rule %q", correlatedFinding.Rule),
+		}
+	}
+
+	if correlatedFinding.Rule == models.SamePath && (correlatedFinding.Path == nil || *correlatedFinding.Path == "") {
+		return &common.BadRequestError{
+			Reason: "path must be provided for the SamePath rule",
+		}
+	}
+
+	if len(correlatedFinding.FindingIDs) < 2 { // nolint:gomnd
+		return &common.BadRequestError{
+			Reason: "at least two findings must be correlated",
+		}
+	}
+
+	if correlatedFinding.LastSeen.Before(correlatedFinding.FirstSeen) {
+		return &common.BadRequestError{
+			Reason: "lastSeen can not be before firstSeen",
+		}
+	}
+
+	return nil
+}
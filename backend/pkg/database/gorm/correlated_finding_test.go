@@ -0,0 +1,92 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func Test_validateCorrelatedFinding(t *testing.T) {
+	firstSeen := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	valid := func() models.CorrelatedFinding {
+		return models.CorrelatedFinding{
+			Asset:      models.AssetRelationship{Id: "asset-1"},
+			Rule:       models.SamePath,
+			Severity:   models.CRITICAL,
+			Path:       utils.PointerTo("/usr/bin/backdoor"),
+			FindingIDs: []string{"finding-1", "finding-2"},
+			FirstSeen:  firstSeen,
+			LastSeen:   firstSeen.Add(time.Hour),
+		}
+	}
+	tests := []struct {
+		name    string
+		mutate  func(cf *models.CorrelatedFinding)
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			mutate:  func(cf *models.CorrelatedFinding) {},
+			wantErr: false,
+		},
+		{
+			name: "no path for other rules",
+			mutate: func(cf *models.CorrelatedFinding) {
+				cf.Rule = models.MalwareAndRootkit
+				cf.Path = nil
+			},
+			wantErr: false,
+		},
+		{
+			name:    "missing asset",
+			mutate:  func(cf *models.CorrelatedFinding) { cf.Asset.Id = "" },
+			wantErr: true,
+		},
+		{
+			name:    "unknown rule",
+			mutate:  func(cf *models.CorrelatedFinding) { cf.Rule = "SameHost" },
+			wantErr: true,
+		},
+		{
+			name:    "missing path",
+			mutate:  func(cf *models.CorrelatedFinding) { cf.Path = nil },
+			wantErr: true,
+		},
+		{
+			name:    "single finding",
+			mutate:  func(cf *models.CorrelatedFinding) { cf.FindingIDs = []string{"finding-1"} },
+			wantErr: true,
+		},
+		{
+			name:    "last seen before first seen",
+			mutate:  func(cf *models.CorrelatedFinding) { cf.LastSeen = firstSeen.Add(-time.Second) },
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cf := valid()
+			tt.mutate(&cf)
+			if err := validateCorrelatedFinding(cf); (err != nil) != tt.wantErr {
+				t.Errorf("validateCorrelatedFinding() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ package gorm
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -25,20 +26,41 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
+	"github.com/openclarity/vmclarity/backend/pkg/database/fieldcrypt"
 	"github.com/openclarity/vmclarity/backend/pkg/database/odatasql/jsonsql"
 	"github.com/openclarity/vmclarity/backend/pkg/database/types"
 )
 
 func NewDatabase(config types.DBConfig) (types.Database, error) {
+	keyring, err := fieldcrypt.NewKeyring(config.FieldEncryptionKey, config.FieldEncryptionPreviousKeys)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load field encryption keys: %w", err)
+	}
 	db, err := initDataBase(config)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create new GORM database: %w", err)
 	}
-	return &Handler{DB: db}, nil
+	return &Handler{DB: db, Keyring: keyring, ScanResultLimits: config.ScanResultLimits}, nil
 }
 
 type Handler struct {
 	DB *gorm.DB
+	// Keyring the sensitive fields are encrypted with, nil if they are
+	// stored in plain text.
+	Keyring *fieldcrypt.Keyring
+	// ScanResultLimits caps the result lists of the scan results.
+	ScanResultLimits types.ScanResultLimits
+}
+
+// ReadOnly returns a Handler whose queries are routed to the read replica if
+// there is one. It must only be used for the queries which don't lead to a
+// write, e.g. to serve the GET requests of the API.
+func (db *Handler) ReadOnly() types.Database {
+	return &Handler{
+		DB:               readOnly(db.DB),
+		Keyring:          db.Keyring,
+		ScanResultLimits: db.ScanResultLimits,
+	}
 }
 
 // Base contains common columns for all tables.
@@ -68,14 +90,33 @@ func initDataBase(config types.DBConfig) (*gorm.DB, error) {
 		return nil, err
 	}
 
+	if err := migrateTargetsToAssets(db); err != nil {
+		return nil, fmt.Errorf("failed to migrate targets to assets: %w", err)
+	}
+
 	// this will ensure table is created
 	if err := db.AutoMigrate(
-		Target{},
+		Asset{},
 		ScanResult{},
+		ScanResultBlob{},
 		ScanConfig{},
 		Scan{},
 		Scopes{},
 		Finding{},
+		ReportSchedule{},
+		ScanConfigTemplate{},
+		AssetGroup{},
+		PostureScore{},
+		FindingDigest{},
+		NotificationConfig{},
+		FindingException{},
+		ScannerConfig{},
+		ScannerToken{},
+		UserPreferences{},
+		ProviderOperation{},
+		CorrelatedFinding{},
+		Setting{},
+		APIKey{},
 	); err != nil {
 		return nil, fmt.Errorf("failed to run auto migration: %w", err)
 	}
@@ -85,9 +126,9 @@ func initDataBase(config types.DBConfig) (*gorm.DB, error) {
 	// First for all objects index the ID field this speeds up anywhere
 	// we're getting a single object out of the DB, including in PATCH/PUT
 	// etc.
-	idb := db.Exec(fmt.Sprintf("CREATE INDEX IF NOT EXISTS targets_id_idx ON targets((%s))", SQLVariant.JSONExtract("Data", "$.id")))
+	idb := db.Exec(fmt.Sprintf("CREATE INDEX IF NOT EXISTS assets_id_idx ON assets((%s))", SQLVariant.JSONExtract("Data", "$.id")))
 	if idb.Error != nil {
-		return nil, fmt.Errorf("failed to create index targets_id_idx: %w", idb.Error)
+		return nil, fmt.Errorf("failed to create index assets_id_idx: %w", idb.Error)
 	}
 
 	idb = db.Exec(fmt.Sprintf("CREATE INDEX IF NOT EXISTS scan_results_id_idx ON scan_results((%s))", SQLVariant.JSONExtract("Data", "$.id")))
@@ -110,6 +151,51 @@ func initDataBase(config types.DBConfig) (*gorm.DB, error) {
 		return nil, fmt.Errorf("failed to create index findings_id_idx: %w", idb.Error)
 	}
 
+	idb = db.Exec(fmt.Sprintf("CREATE INDEX IF NOT EXISTS report_schedules_id_idx ON report_schedules((%s))", SQLVariant.JSONExtract("Data", "$.id")))
+	if idb.Error != nil {
+		return nil, fmt.Errorf("failed to create index report_schedules_id_idx: %w", idb.Error)
+	}
+
+	idb = db.Exec(fmt.Sprintf("CREATE INDEX IF NOT EXISTS finding_digests_id_idx ON finding_digests((%s))", SQLVariant.JSONExtract("Data", "$.id")))
+	if idb.Error != nil {
+		return nil, fmt.Errorf("failed to create index finding_digests_id_idx: %w", idb.Error)
+	}
+
+	idb = db.Exec(fmt.Sprintf("CREATE INDEX IF NOT EXISTS provider_operations_id_idx ON provider_operations((%s))", SQLVariant.JSONExtract("Data", "$.id")))
+	if idb.Error != nil {
+		return nil, fmt.Errorf("failed to create index provider_operations_id_idx: %w", idb.Error)
+	}
+
+	idb = db.Exec(fmt.Sprintf("CREATE INDEX IF NOT EXISTS notification_configs_id_idx ON notification_configs((%s))", SQLVariant.JSONExtract("Data", "$.id")))
+	if idb.Error != nil {
+		return nil, fmt.Errorf("failed to create index notification_configs_id_idx: %w", idb.Error)
+	}
+
+	idb = db.Exec(fmt.Sprintf("CREATE INDEX IF NOT EXISTS api_keys_id_idx ON api_keys((%s))", SQLVariant.JSONExtract("Data", "$.id")))
+	if idb.Error != nil {
+		return nil, fmt.Errorf("failed to create index api_keys_id_idx: %w", idb.Error)
+	}
+
+	idb = db.Exec(fmt.Sprintf("CREATE INDEX IF NOT EXISTS scan_config_templates_id_idx ON scan_config_templates((%s))", SQLVariant.JSONExtract("Data", "$.id")))
+	if idb.Error != nil {
+		return nil, fmt.Errorf("failed to create index scan_config_templates_id_idx: %w", idb.Error)
+	}
+
+	idb = db.Exec(fmt.Sprintf("CREATE INDEX IF NOT EXISTS posture_scores_id_idx ON posture_scores((%s))", SQLVariant.JSONExtract("Data", "$.id")))
+	if idb.Error != nil {
+		return nil, fmt.Errorf("failed to create index posture_scores_id_idx: %w", idb.Error)
+	}
+
+	idb = db.Exec(fmt.Sprintf("CREATE INDEX IF NOT EXISTS finding_exceptions_id_idx ON finding_exceptions((%s))", SQLVariant.JSONExtract("Data", "$.id")))
+	if idb.Error != nil {
+		return nil, fmt.Errorf("failed to create index finding_exceptions_id_idx: %w", idb.Error)
+	}
+
+	idb = db.Exec(fmt.Sprintf("CREATE INDEX IF NOT EXISTS correlated_findings_id_idx ON correlated_findings((%s))", SQLVariant.JSONExtract("Data", "$.id")))
+	if idb.Error != nil {
+		return nil, fmt.Errorf("failed to create index correlated_findings_id_idx: %w", idb.Error)
+	}
+
 	// For processing scan results to findings we need to find all the scan
 	// results by general status and findingsProcessed, so add an index for
 	// that.
@@ -142,6 +228,14 @@ func initDataBase(config types.DBConfig) (*gorm.DB, error) {
 	// TODO(sambetts) Add indexes for all the uniqueness checks we need to
 	// do for each object
 
+	// The read replica is only used once the migrations are done, so that
+	// they are not checked against a replica lagging behind.
+	if config.ReadReplicaDSN != "" {
+		if err := initReadReplica(db, config, dbLogger); err != nil {
+			return nil, err
+		}
+	}
+
 	return db, nil
 }
 
@@ -158,8 +252,24 @@ func initDB(config types.DBConfig, dbDriver string, dbLogger logger.Interface) (
 	}
 }
 
+// sqliteBusyTimeout is how long a connection to the SQLite database waits for
+// the lock held by another one, e.g. by a checkpoint of the WAL, before
+// failing.
+const sqliteBusyTimeout = 5 * time.Second
+
+// sqliteDSN returns the DSN of the SQLite database at path. The database is
+// in WAL mode so that the reads, e.g. a collection streamed to a slow
+// client, don't block the writes.
+func sqliteDSN(path string) string {
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+	return fmt.Sprintf("%s%s_journal_mode=WAL&_busy_timeout=%d", path, sep, sqliteBusyTimeout.Milliseconds())
+}
+
 func initSqlite(config types.DBConfig, dbLogger logger.Interface) (*gorm.DB, error) {
-	db, err := gorm.Open(sqlite.Open(config.LocalDBPath), &gorm.Config{
+	db, err := gorm.Open(sqlite.Open(sqliteDSN(config.LocalDBPath)), &gorm.Config{
 		Logger: dbLogger,
 	})
 	if err != nil {
@@ -181,3 +291,26 @@ func initPostgres(config types.DBConfig, dbLogger logger.Interface) (*gorm.DB, e
 
 	return db, nil
 }
+
+func initReadReplica(db *gorm.DB, config types.DBConfig, dbLogger logger.Interface) error {
+	if config.DriverType != types.DBDriverTypePostgres {
+		return fmt.Errorf("read replica is not supported by driver type %s", config.DriverType)
+	}
+
+	replica, err := gorm.Open(postgres.Open(config.ReadReplicaDSN), &gorm.Config{
+		Logger: dbLogger,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to open read replica: %w", err)
+	}
+
+	maxLag := config.ReadReplicaMaxLag
+	if maxLag == 0 {
+		maxLag = DefaultReplicaMaxLag
+	}
+	if err := useReadReplica(db, replica.ConnPool, maxLag, postgresReplicaLag(replica)); err != nil {
+		return fmt.Errorf("failed to route queries to read replica: %w", err)
+	}
+
+	return nil
+}
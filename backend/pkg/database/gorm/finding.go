@@ -32,6 +32,10 @@ type Finding struct {
 	ODataObject
 }
 
+// findingsCreateBatchSize is the number of findings inserted by a single
+// statement when creating findings in bulk.
+const findingsCreateBatchSize = 100
+
 type FindingsTableHandler struct {
 	DB *gorm.DB
 }
@@ -44,7 +48,7 @@ func (db *Handler) FindingsTable() types.FindingsTable {
 
 func (s *FindingsTableHandler) GetFindings(params models.GetFindingsParams) (models.Findings, error) {
 	var findings []Finding
-	err := ODataQuery(s.DB, "Finding", params.Filter, params.Select, params.Expand, params.OrderBy, params.Top, params.Skip, true, &findings)
+	err := ODataQuery(s.DB, "Finding", params.Filter, params.Search, params.Select, params.Expand, params.OrderBy, params.Top, params.Skip, true, &findings)
 	if err != nil {
 		return models.Findings{}, err
 	}
@@ -62,7 +66,7 @@ func (s *FindingsTableHandler) GetFindings(params models.GetFindingsParams) (mod
 	output := models.Findings{Items: &items}
 
 	if params.Count != nil && *params.Count {
-		count, err := ODataCount(s.DB, "Finding", params.Filter)
+		count, err := ODataCount(s.DB, "Finding", params.Filter, params.Search)
 		if err != nil {
 			return models.Findings{}, fmt.Errorf("failed to count records: %w", err)
 		}
@@ -72,10 +76,20 @@ func (s *FindingsTableHandler) GetFindings(params models.GetFindingsParams) (mod
 	return output, nil
 }
 
+func (s *FindingsTableHandler) StreamFindings(params models.GetFindingsParams, fn func(models.Finding) error) error {
+	return ODataStream(s.DB, "Finding", params.Filter, params.Search, params.Select, params.Expand, params.OrderBy, params.Top, params.Skip, func(data []byte) error {
+		var finding models.Finding
+		if err := json.Unmarshal(data, &finding); err != nil {
+			return fmt.Errorf("failed to convert DB model to API model: %w", err)
+		}
+		return fn(finding)
+	})
+}
+
 func (s *FindingsTableHandler) GetFinding(findingID models.FindingID, params models.GetFindingsFindingIDParams) (models.Finding, error) {
 	var dbFinding Finding
 	filter := fmt.Sprintf("id eq '%s'", findingID)
-	err := ODataQuery(s.DB, "Finding", &filter, params.Select, params.Expand, nil, nil, nil, false, &dbFinding)
+	err := ODataQuery(s.DB, "Finding", &filter, nil, params.Select, params.Expand, nil, nil, nil, false, &dbFinding)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return models.Finding{}, types.ErrNotFound
@@ -100,6 +114,10 @@ func (s *FindingsTableHandler) CreateFinding(finding models.Finding) (models.Fin
 		}
 	}
 
+	if err := validateAnnotations(finding.Annotations); err != nil {
+		return models.Finding{}, err
+	}
+
 	// Generate a new UUID
 	newID := uuid.New().String()
 	finding.Id = &newID
@@ -128,6 +146,57 @@ func (s *FindingsTableHandler) CreateFinding(finding models.Finding) (models.Fin
 	return sc, nil
 }
 
+// CreateFindings creates the findings in a single transaction. The result of
+// each finding is returned in the order of findings, the findings which fail
+// validation are not created and only their error is reported.
+func (s *FindingsTableHandler) CreateFindings(findings []models.Finding) ([]types.FindingResult, error) {
+	results := make([]types.FindingResult, len(findings))
+
+	newFindings := make([]Finding, 0, len(findings))
+	for i, finding := range findings {
+		// Check the user didn't provide an ID
+		if finding.Id != nil {
+			results[i].Err = &common.BadRequestError{
+				Reason: "can not specify id field when creating a new Finding",
+			}
+			continue
+		}
+
+		if err := validateAnnotations(finding.Annotations); err != nil {
+			results[i].Err = err
+			continue
+		}
+
+		// Generate a new UUID
+		newID := uuid.New().String()
+		finding.Id = &newID
+
+		marshaled, err := json.Marshal(finding)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert API model to DB model: %w", err)
+		}
+
+		newFinding := Finding{}
+		newFinding.Data = marshaled
+		newFindings = append(newFindings, newFinding)
+
+		results[i].Finding = finding
+	}
+
+	if len(newFindings) == 0 {
+		return results, nil
+	}
+
+	err := s.DB.Transaction(func(tx *gorm.DB) error {
+		return tx.CreateInBatches(&newFindings, findingsCreateBatchSize).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create findings in db: %w", err)
+	}
+
+	return results, nil
+}
+
 func (s *FindingsTableHandler) SaveFinding(finding models.Finding) (models.Finding, error) {
 	if finding.Id == nil || *finding.Id == "" {
 		return models.Finding{}, &common.BadRequestError{
@@ -135,6 +204,10 @@ func (s *FindingsTableHandler) SaveFinding(finding models.Finding) (models.Findi
 		}
 	}
 
+	if err := validateAnnotations(finding.Annotations); err != nil {
+		return models.Finding{}, err
+	}
+
 	var dbFinding Finding
 	err := getExistingObjByID(s.DB, "Finding", *finding.Id, &dbFinding)
 	if err != nil {
@@ -164,25 +237,71 @@ func (s *FindingsTableHandler) SaveFinding(finding models.Finding) (models.Findi
 }
 
 func (s *FindingsTableHandler) UpdateFinding(finding models.Finding) (models.Finding, error) {
+	sc, _, err := patchFinding(s.DB, finding)
+	return sc, err
+}
+
+// UpdateFindings patches the findings in a single transaction. The result of
+// each finding is returned in the order of findings, the findings which are not
+// found or fail validation are not patched and only their error is reported.
+func (s *FindingsTableHandler) UpdateFindings(findings []models.Finding) ([]types.FindingResult, error) {
+	results := make([]types.FindingResult, len(findings))
+
+	err := s.DB.Transaction(func(tx *gorm.DB) error {
+		for i, finding := range findings {
+			updated, wasActive, err := patchFinding(tx, finding)
+			var validationErr *common.BadRequestError
+			switch {
+			case errors.Is(err, types.ErrNotFound), errors.As(err, &validationErr):
+				results[i].Err = err
+			case err != nil:
+				return err
+			default:
+				results[i].Finding = updated
+				results[i].Invalidated = wasActive && finding.InvalidatedOn != nil
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update findings in db: %w", err)
+	}
+
+	return results, nil
+}
+
+// patchFinding applies the finding as a patch to the stored finding with the
+// same ID. It also returns whether the stored finding was active, that is not
+// invalidated, before the patch.
+func patchFinding(db *gorm.DB, finding models.Finding) (models.Finding, bool, error) {
 	if finding.Id == nil || *finding.Id == "" {
-		return models.Finding{}, &common.BadRequestError{
+		return models.Finding{}, false, &common.BadRequestError{
 			Reason: "id is required to update finding",
 		}
 	}
 
+	if err := validateAnnotations(finding.Annotations); err != nil {
+		return models.Finding{}, false, err
+	}
+
 	var dbFinding Finding
-	err := getExistingObjByID(s.DB, "Finding", *finding.Id, &dbFinding)
+	err := getExistingObjByID(db, "Finding", *finding.Id, &dbFinding)
 	if err != nil {
-		return models.Finding{}, err
+		return models.Finding{}, false, err
+	}
+
+	var existing models.Finding
+	if err = json.Unmarshal(dbFinding.Data, &existing); err != nil {
+		return models.Finding{}, false, fmt.Errorf("failed to convert DB model to API model: %w", err)
 	}
 
 	dbFinding.Data, err = patchObject(dbFinding.Data, finding)
 	if err != nil {
-		return models.Finding{}, fmt.Errorf("failed to apply patch: %w", err)
+		return models.Finding{}, false, fmt.Errorf("failed to apply patch: %w", err)
 	}
 
-	if err := s.DB.Save(&dbFinding).Error; err != nil {
-		return models.Finding{}, fmt.Errorf("failed to save finding in db: %w", err)
+	if err := db.Save(&dbFinding).Error; err != nil {
+		return models.Finding{}, false, fmt.Errorf("failed to save finding in db: %w", err)
 	}
 
 	// TODO(sambetts) Maybe this isn't required now because the DB isn't
@@ -191,9 +310,9 @@ func (s *FindingsTableHandler) UpdateFinding(finding models.Finding) (models.Fin
 	var sc models.Finding
 	err = json.Unmarshal(dbFinding.Data, &sc)
 	if err != nil {
-		return models.Finding{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+		return models.Finding{}, false, fmt.Errorf("failed to convert DB model to API model: %w", err)
 	}
-	return sc, nil
+	return sc, existing.InvalidatedOn == nil, nil
 }
 
 func (s *FindingsTableHandler) DeleteFinding(findingID models.FindingID) error {
@@ -0,0 +1,286 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/mail"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	"github.com/openclarity/vmclarity/backend/pkg/database/odatasql"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/report"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+type FindingDigest struct {
+	ODataObject
+}
+
+type FindingDigestsTableHandler struct {
+	DB *gorm.DB
+}
+
+func (db *Handler) FindingDigestsTable() types.FindingDigestsTable {
+	return &FindingDigestsTableHandler{
+		DB: db.DB,
+	}
+}
+
+func (f *FindingDigestsTableHandler) GetFindingDigests(params models.GetFindingDigestsParams) (models.FindingDigests, error) {
+	var findingDigests []FindingDigest
+	err := ODataQuery(f.DB, "FindingDigest", params.Filter, nil, params.Select, nil, params.OrderBy, params.Top, params.Skip, true, &findingDigests)
+	if err != nil {
+		return models.FindingDigests{}, err
+	}
+
+	items := []models.FindingDigest{}
+	for _, findingDigest := range findingDigests {
+		var fd models.FindingDigest
+		err := json.Unmarshal(findingDigest.Data, &fd)
+		if err != nil {
+			return models.FindingDigests{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+		}
+		items = append(items, fd)
+	}
+
+	output := models.FindingDigests{Items: &items}
+
+	if params.Count != nil && *params.Count {
+		count, err := ODataCount(f.DB, "FindingDigest", params.Filter, nil)
+		if err != nil {
+			return models.FindingDigests{}, fmt.Errorf("failed to count records: %w", err)
+		}
+		output.Count = &count
+	}
+
+	return output, nil
+}
+
+func (f *FindingDigestsTableHandler) GetFindingDigest(findingDigestID models.FindingDigestID, params models.GetFindingDigestsFindingDigestIDParams) (models.FindingDigest, error) {
+	var dbFindingDigest FindingDigest
+	filter := fmt.Sprintf("id eq '%s'", findingDigestID)
+	err := ODataQuery(f.DB, "FindingDigest", &filter, nil, params.Select, nil, nil, nil, nil, false, &dbFindingDigest)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.FindingDigest{}, types.ErrNotFound
+		}
+		return models.FindingDigest{}, err
+	}
+
+	var fd models.FindingDigest
+	err = json.Unmarshal(dbFindingDigest.Data, &fd)
+	if err != nil {
+		return models.FindingDigest{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	return fd, nil
+}
+
+func (f *FindingDigestsTableHandler) CreateFindingDigest(findingDigest models.FindingDigest) (models.FindingDigest, error) {
+	// Check the user didn't provide an ID
+	if findingDigest.Id != nil {
+		return models.FindingDigest{}, &common.BadRequestError{
+			Reason: "can not specify id field when creating a new FindingDigest",
+		}
+	}
+
+	if err := f.validateFindingDigest(findingDigest); err != nil {
+		return models.FindingDigest{}, err
+	}
+
+	if findingDigest.NextDeliveryTime == nil {
+		next, err := nextDigestDeliveryTime(findingDigest)
+		if err != nil {
+			return models.FindingDigest{}, err
+		}
+		findingDigest.NextDeliveryTime = &next
+	}
+
+	// Generate a new UUID
+	findingDigest.Id = utils.PointerTo(uuid.New().String())
+
+	// Initialise revision
+	findingDigest.Revision = utils.PointerTo(1)
+
+	marshaled, err := json.Marshal(findingDigest)
+	if err != nil {
+		return models.FindingDigest{}, fmt.Errorf("failed to convert API model to DB model: %w", err)
+	}
+
+	newFindingDigest := FindingDigest{}
+	newFindingDigest.Data = marshaled
+
+	if err := f.DB.Create(&newFindingDigest).Error; err != nil {
+		return models.FindingDigest{}, fmt.Errorf("failed to create finding digest in db: %w", err)
+	}
+
+	return findingDigest, nil
+}
+
+func (f *FindingDigestsTableHandler) UpdateFindingDigest(findingDigest models.FindingDigest, params models.PatchFindingDigestsFindingDigestIDParams) (models.FindingDigest, error) {
+	if findingDigest.Id == nil || *findingDigest.Id == "" {
+		return models.FindingDigest{}, &common.BadRequestError{
+			Reason: "id is required to update finding digest",
+		}
+	}
+
+	var dbObj FindingDigest
+	if err := getExistingObjByID(f.DB, "FindingDigest", *findingDigest.Id, &dbObj); err != nil {
+		return models.FindingDigest{}, fmt.Errorf("failed to get finding digest from db: %w", err)
+	}
+
+	var dbFindingDigest models.FindingDigest
+	err := json.Unmarshal(dbObj.Data, &dbFindingDigest)
+	if err != nil {
+		return models.FindingDigest{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	if err := checkRevisionEtag(params.IfMatch, dbFindingDigest.Revision); err != nil {
+		return models.FindingDigest{}, err
+	}
+
+	findingDigest.Revision = bumpRevision(dbFindingDigest.Revision)
+
+	dbObj.Data, err = patchObject(dbObj.Data, findingDigest)
+	if err != nil {
+		return models.FindingDigest{}, fmt.Errorf("failed to apply patch: %w", err)
+	}
+
+	var fd models.FindingDigest
+	err = json.Unmarshal(dbObj.Data, &fd)
+	if err != nil {
+		return models.FindingDigest{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	if err := f.validateFindingDigest(fd); err != nil {
+		return models.FindingDigest{}, err
+	}
+
+	// Changing the cadence, or enabling the digest again, starts it over
+	// from now unless the patch sets the next delivery itself.
+	if findingDigest.NextDeliveryTime == nil &&
+		(findingDigest.Cadence != nil || findingDigest.Timezone != nil || findingDigest.Disabled != nil) {
+		next, err := nextDigestDeliveryTime(fd)
+		if err != nil {
+			return models.FindingDigest{}, err
+		}
+		fd.NextDeliveryTime = &next
+
+		dbObj.Data, err = json.Marshal(fd)
+		if err != nil {
+			return models.FindingDigest{}, fmt.Errorf("failed to convert API model to DB model: %w", err)
+		}
+	}
+
+	if err := f.DB.Save(&dbObj).Error; err != nil {
+		return models.FindingDigest{}, fmt.Errorf("failed to save finding digest in db: %w", err)
+	}
+
+	return fd, nil
+}
+
+func (f *FindingDigestsTableHandler) DeleteFindingDigest(findingDigestID models.FindingDigestID) error {
+	if err := deleteObjByID(f.DB, findingDigestID, &FindingDigest{}); err != nil {
+		return fmt.Errorf("failed to delete finding digest: %w", err)
+	}
+	return nil
+}
+
+// nextDigestDeliveryTime returns the next time the findingDigest is delivered
+// at after now according to its cadence and time zone.
+func nextDigestDeliveryTime(findingDigest models.FindingDigest) (time.Time, error) {
+	cronLine, _ := utils.ValueOrZero(findingDigest.Cadence).CronLine()
+	next, err := report.NextDeliveryTime(cronLine, utils.ValueOrZero(findingDigest.Timezone), time.Now())
+	if err != nil {
+		return time.Time{}, &common.BadRequestError{
+			Reason: fmt.Sprintf("invalid schedule: %v", err),
+		}
+	}
+	return next, nil
+}
+
+// nolint:cyclop
+func (f *FindingDigestsTableHandler) validateFindingDigest(findingDigest models.FindingDigest) error {
+	if findingDigest.Name == nil || *findingDigest.Name == "" {
+		return &common.BadRequestError{
+			Reason: "name must be provided and can not be empty",
+		}
+	}
+
+	if findingDigest.Filter == nil || *findingDigest.Filter == "" {
+		return &common.BadRequestError{
+			Reason: "filter must be provided and can not be empty",
+		}
+	}
+	// Building the query parses and validates the filter against the
+	// findings without running it.
+	if _, err := odatasql.BuildCountQuery(SQLVariant, schemaMetas, "Finding", findingDigest.Filter, nil); err != nil {
+		return &common.BadRequestError{
+			Reason: fmt.Sprintf("invalid filter: %v", err),
+		}
+	}
+
+	if findingDigest.Cadence == nil {
+		return &common.BadRequestError{
+			Reason: "cadence must be provided",
+		}
+	}
+	if _, ok := findingDigest.Cadence.CronLine(); !ok {
+		return &common.BadRequestError{
+			Reason: fmt.Sprintf("unsupported cadence %v", *findingDigest.Cadence),
+		}
+	}
+	if _, err := report.LoadTimezone(utils.ValueOrZero(findingDigest.Timezone)); err != nil {
+		return &common.BadRequestError{
+			Reason: err.Error(),
+		}
+	}
+
+	recipients := utils.ValueOrZero(findingDigest.Recipients)
+	if len(recipients) == 0 && findingDigest.NotificationConfigID == nil {
+		return &common.BadRequestError{
+			Reason: "at least one recipient or a notificationConfigID must be provided",
+		}
+	}
+	for _, recipient := range recipients {
+		if _, err := mail.ParseAddress(recipient); err != nil {
+			return &common.BadRequestError{
+				Reason: fmt.Sprintf("invalid recipient %q: %v", recipient, err),
+			}
+		}
+	}
+
+	if findingDigest.NotificationConfigID != nil {
+		if err := getExistingObjByID(f.DB, "NotificationConfig", *findingDigest.NotificationConfigID, &NotificationConfig{}); err != nil {
+			if errors.Is(err, types.ErrNotFound) {
+				return &common.BadRequestError{
+					Reason: fmt.Sprintf("notification config %s not found", *findingDigest.NotificationConfigID),
+				}
+			}
+			return err
+		}
+	}
+
+	return nil
+}
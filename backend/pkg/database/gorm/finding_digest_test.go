@@ -0,0 +1,126 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestFindingDigestsTableHandler_CreateFindingDigest(t *testing.T) {
+	db, err := NewDatabase(types.DBConfig{
+		DriverType:  types.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("NewDatabase() error = %v", err)
+	}
+	webhook, err := db.NotificationConfigsTable().CreateNotificationConfig(models.NotificationConfig{
+		Name:   utils.PointerTo("webhook"),
+		Url:    utils.PointerTo("https://example.com/hook"),
+		Events: &[]models.NotificationEventType{models.ScanCompleted},
+	})
+	if err != nil {
+		t.Fatalf("CreateNotificationConfig() error = %v", err)
+	}
+
+	valid := func() models.FindingDigest {
+		return models.FindingDigest{
+			Name:       utils.PointerTo("critical vulnerabilities"),
+			Filter:     utils.PointerTo("findingInfo/objectType eq 'Vulnerability' and findingInfo/severity eq 'CRITICAL'"),
+			Cadence:    utils.PointerTo(models.Daily),
+			Timezone:   utils.PointerTo("Europe/Budapest"),
+			Recipients: &[]string{"Security Team <security@example.com>"},
+		}
+	}
+	tests := []struct {
+		name    string
+		mutate  func(fd *models.FindingDigest)
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			mutate:  func(fd *models.FindingDigest) {},
+			wantErr: false,
+		},
+		{
+			name: "webhook only",
+			mutate: func(fd *models.FindingDigest) {
+				fd.Recipients = nil
+				fd.NotificationConfigID = webhook.Id
+			},
+			wantErr: false,
+		},
+		{
+			name:    "missing name",
+			mutate:  func(fd *models.FindingDigest) { fd.Name = nil },
+			wantErr: true,
+		},
+		{
+			name:    "missing filter",
+			mutate:  func(fd *models.FindingDigest) { fd.Filter = nil },
+			wantErr: true,
+		},
+		{
+			name:    "invalid filter",
+			mutate:  func(fd *models.FindingDigest) { fd.Filter = utils.PointerTo("findingInfo/unknown eq 'x'") },
+			wantErr: true,
+		},
+		{
+			name:    "unsupported cadence",
+			mutate:  func(fd *models.FindingDigest) { fd.Cadence = utils.PointerTo(models.FindingDigestCadence("Hourly")) },
+			wantErr: true,
+		},
+		{
+			name:    "unknown timezone",
+			mutate:  func(fd *models.FindingDigest) { fd.Timezone = utils.PointerTo("Nowhere/Town") },
+			wantErr: true,
+		},
+		{
+			name:    "no recipients nor webhook",
+			mutate:  func(fd *models.FindingDigest) { fd.Recipients = &[]string{} },
+			wantErr: true,
+		},
+		{
+			name:    "invalid recipient",
+			mutate:  func(fd *models.FindingDigest) { fd.Recipients = &[]string{"not an address"} },
+			wantErr: true,
+		},
+		{
+			name:    "unknown webhook",
+			mutate:  func(fd *models.FindingDigest) { fd.NotificationConfigID = utils.PointerTo("missing") },
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fd := valid()
+			tt.mutate(&fd)
+			created, err := db.FindingDigestsTable().CreateFindingDigest(fd)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CreateFindingDigest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && (created.NextDeliveryTime == nil || !created.NextDeliveryTime.After(time.Now())) {
+				t.Errorf("CreateFindingDigest() nextDeliveryTime = %v, want a future time", created.NextDeliveryTime)
+			}
+		})
+	}
+}
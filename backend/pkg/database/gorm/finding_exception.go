@@ -0,0 +1,206 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	"github.com/openclarity/vmclarity/backend/pkg/database/odatasql"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+type FindingException struct {
+	ODataObject
+}
+
+type FindingExceptionsTableHandler struct {
+	DB *gorm.DB
+}
+
+func (db *Handler) FindingExceptionsTable() types.FindingExceptionsTable {
+	return &FindingExceptionsTableHandler{
+		DB: db.DB,
+	}
+}
+
+func (f *FindingExceptionsTableHandler) GetFindingExceptions(params models.GetFindingExceptionsParams) (models.FindingExceptions, error) {
+	var findingExceptions []FindingException
+	err := ODataQuery(f.DB, "FindingException", params.Filter, nil, params.Select, nil, params.OrderBy, params.Top, params.Skip, true, &findingExceptions)
+	if err != nil {
+		return models.FindingExceptions{}, err
+	}
+
+	items := []models.FindingException{}
+	for _, findingException := range findingExceptions {
+		var fe models.FindingException
+		err := json.Unmarshal(findingException.Data, &fe)
+		if err != nil {
+			return models.FindingExceptions{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+		}
+		items = append(items, fe)
+	}
+
+	output := models.FindingExceptions{Items: &items}
+
+	if params.Count != nil && *params.Count {
+		count, err := ODataCount(f.DB, "FindingException", params.Filter, nil)
+		if err != nil {
+			return models.FindingExceptions{}, fmt.Errorf("failed to count records: %w", err)
+		}
+		output.Count = &count
+	}
+
+	return output, nil
+}
+
+func (f *FindingExceptionsTableHandler) GetFindingException(findingExceptionID models.FindingExceptionID, params models.GetFindingExceptionsFindingExceptionIDParams) (models.FindingException, error) {
+	var dbFindingException FindingException
+	filter := fmt.Sprintf("id eq '%s'", findingExceptionID)
+	err := ODataQuery(f.DB, "FindingException", &filter, nil, params.Select, nil, nil, nil, nil, false, &dbFindingException)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.FindingException{}, types.ErrNotFound
+		}
+		return models.FindingException{}, err
+	}
+
+	var fe models.FindingException
+	err = json.Unmarshal(dbFindingException.Data, &fe)
+	if err != nil {
+		return models.FindingException{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	return fe, nil
+}
+
+func (f *FindingExceptionsTableHandler) CreateFindingException(findingException models.FindingException) (models.FindingException, error) {
+	// Check the user didn't provide an ID
+	if findingException.Id != nil {
+		return models.FindingException{}, &common.BadRequestError{
+			Reason: "can not specify id field when creating a new FindingException",
+		}
+	}
+
+	if err := validateFindingException(findingException); err != nil {
+		return models.FindingException{}, err
+	}
+
+	// Generate a new UUID
+	findingException.Id = utils.PointerTo(uuid.New().String())
+
+	// Initialise revision
+	findingException.Revision = utils.PointerTo(1)
+
+	marshaled, err := json.Marshal(findingException)
+	if err != nil {
+		return models.FindingException{}, fmt.Errorf("failed to convert API model to DB model: %w", err)
+	}
+
+	newFindingException := FindingException{}
+	newFindingException.Data = marshaled
+
+	if err := f.DB.Create(&newFindingException).Error; err != nil {
+		return models.FindingException{}, fmt.Errorf("failed to create finding exception in db: %w", err)
+	}
+
+	return findingException, nil
+}
+
+func (f *FindingExceptionsTableHandler) UpdateFindingException(findingException models.FindingException, params models.PatchFindingExceptionsFindingExceptionIDParams) (models.FindingException, error) {
+	if findingException.Id == nil || *findingException.Id == "" {
+		return models.FindingException{}, &common.BadRequestError{
+			Reason: "id is required to update finding exception",
+		}
+	}
+
+	var dbObj FindingException
+	if err := getExistingObjByID(f.DB, "FindingException", *findingException.Id, &dbObj); err != nil {
+		return models.FindingException{}, fmt.Errorf("failed to get finding exception from db: %w", err)
+	}
+
+	var dbFindingException models.FindingException
+	err := json.Unmarshal(dbObj.Data, &dbFindingException)
+	if err != nil {
+		return models.FindingException{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	if err := checkRevisionEtag(params.IfMatch, dbFindingException.Revision); err != nil {
+		return models.FindingException{}, err
+	}
+
+	findingException.Revision = bumpRevision(dbFindingException.Revision)
+
+	dbObj.Data, err = patchObject(dbObj.Data, findingException)
+	if err != nil {
+		return models.FindingException{}, fmt.Errorf("failed to apply patch: %w", err)
+	}
+
+	var fe models.FindingException
+	err = json.Unmarshal(dbObj.Data, &fe)
+	if err != nil {
+		return models.FindingException{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	if err := validateFindingException(fe); err != nil {
+		return models.FindingException{}, err
+	}
+
+	if err := f.DB.Save(&dbObj).Error; err != nil {
+		return models.FindingException{}, fmt.Errorf("failed to save finding exception in db: %w", err)
+	}
+
+	return fe, nil
+}
+
+func (f *FindingExceptionsTableHandler) DeleteFindingException(findingExceptionID models.FindingExceptionID) error {
+	if err := deleteObjByID(f.DB, findingExceptionID, &FindingException{}); err != nil {
+		return fmt.Errorf("failed to delete finding exception: %w", err)
+	}
+	return nil
+}
+
+func validateFindingException(findingException models.FindingException) error {
+	if findingException.Name == nil || *findingException.Name == "" {
+		return &common.BadRequestError{
+			Reason: "name must be provided and can not be empty",
+		}
+	}
+
+	rules := findingException.MatchRules
+	if rules == nil || (rules.FindingType == nil && rules.VulnerabilityName == nil && rules.PackagePurl == nil && rules.AssetFilter == nil) {
+		return &common.BadRequestError{
+			Reason: "at least one match rule must be provided",
+		}
+	}
+
+	if rules.AssetFilter != nil {
+		if _, err := odatasql.BuildCountQuery(SQLVariant, schemaMetas, assetSchemaName, rules.AssetFilter, nil); err != nil {
+			return &common.BadRequestError{
+				Reason: fmt.Sprintf("invalid assetFilter: %v", err),
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestFindingsTableHandler_BulkOperations(t *testing.T) {
+	db, err := NewDatabase(types.DBConfig{
+		DriverType:  types.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("NewDatabase() error = %v", err)
+	}
+	table := db.FindingsTable()
+
+	foundOn := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	created, err := table.CreateFindings([]models.Finding{
+		{FoundOn: &foundOn},
+		{Id: utils.PointerTo("preset"), FoundOn: &foundOn},
+		{FoundOn: &foundOn},
+	})
+	if err != nil {
+		t.Fatalf("CreateFindings() error = %v", err)
+	}
+	if len(created) != 3 {
+		t.Fatalf("CreateFindings() returned %d results, want 3", len(created))
+	}
+	var validationErr *common.BadRequestError
+	if !errors.As(created[1].Err, &validationErr) {
+		t.Errorf("CreateFindings() item with id error = %v, want BadRequestError", created[1].Err)
+	}
+	for _, i := range []int{0, 2} {
+		if created[i].Err != nil || created[i].Finding.Id == nil {
+			t.Fatalf("CreateFindings() item %d = %+v, want created finding", i, created[i])
+		}
+		if _, err := table.GetFinding(*created[i].Finding.Id, models.GetFindingsFindingIDParams{}); err != nil {
+			t.Errorf("GetFinding() item %d error = %v", i, err)
+		}
+	}
+
+	invalidatedOn := foundOn.Add(24 * time.Hour)
+	updated, err := table.UpdateFindings([]models.Finding{
+		{Id: created[0].Finding.Id, InvalidatedOn: &invalidatedOn},
+		{Id: utils.PointerTo("missing"), InvalidatedOn: &invalidatedOn},
+		{Id: created[2].Finding.Id, Annotations: &models.Annotations{"team": "platform"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateFindings() error = %v", err)
+	}
+	if len(updated) != 3 {
+		t.Fatalf("UpdateFindings() returned %d results, want 3", len(updated))
+	}
+	if updated[0].Err != nil || !updated[0].Invalidated || !updated[0].Finding.InvalidatedOn.Equal(invalidatedOn) {
+		t.Errorf("UpdateFindings() invalidated item = %+v, want invalidated finding", updated[0])
+	}
+	if !errors.Is(updated[1].Err, types.ErrNotFound) {
+		t.Errorf("UpdateFindings() missing item error = %v, want ErrNotFound", updated[1].Err)
+	}
+	if updated[2].Err != nil || updated[2].Invalidated || updated[2].Finding.FoundOn == nil {
+		t.Errorf("UpdateFindings() annotated item = %+v, want patched active finding", updated[2])
+	}
+
+	// Invalidating an already invalidated finding is not reported again.
+	updated, err = table.UpdateFindings([]models.Finding{
+		{Id: created[0].Finding.Id, InvalidatedOn: &invalidatedOn},
+	})
+	if err != nil {
+		t.Fatalf("UpdateFindings() error = %v", err)
+	}
+	if updated[0].Err != nil || updated[0].Invalidated {
+		t.Errorf("UpdateFindings() invalidated again item = %+v, want not invalidated", updated[0])
+	}
+}
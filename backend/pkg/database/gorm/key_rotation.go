@@ -0,0 +1,196 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+)
+
+const DefaultRotateKeysBatchSize = 100
+
+// encryptedField is a sensitive field of the objects of a table, stored
+// encrypted at the top level of their data.
+type encryptedField struct {
+	table string
+	field string
+}
+
+var encryptedFields = []encryptedField{
+	{table: "notification_configs", field: "secret"},
+}
+
+// RotateFieldEncryptionKeys re-encrypts the sensitive fields which are in
+// plain text or encrypted with a previous key with the current key. The
+// objects are processed in batches ordered by their primary key, each in a
+// transaction, so the rotation can be resumed from the checkpoint of the
+// last batch. Objects already encrypted with the current key are skipped,
+// so running it again is harmless.
+func (db *Handler) RotateFieldEncryptionKeys(params types.RotateKeysParams, progress func(types.RotateKeysProgress)) error {
+	if db.Keyring == nil {
+		return errors.New("field encryption key is not configured")
+	}
+
+	batchSize := params.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultRotateKeysBatchSize
+	}
+
+	resumeTable, resumeID, err := parseRotateKeysCheckpoint(params.Checkpoint)
+	if err != nil {
+		return err
+	}
+	skip := resumeTable != ""
+
+	for _, f := range encryptedFields {
+		var lastID uint
+		if skip {
+			if f.table != resumeTable {
+				continue
+			}
+			skip = false
+			lastID = resumeID
+		}
+
+		if err := db.rotateTable(f, lastID, batchSize, progress); err != nil {
+			return fmt.Errorf("failed to rotate keys of %s: %w", f.table, err)
+		}
+	}
+	if skip {
+		return fmt.Errorf("unknown table %s in checkpoint", resumeTable)
+	}
+
+	return nil
+}
+
+func (db *Handler) rotateTable(f encryptedField, lastID uint, batchSize int, progress func(types.RotateKeysProgress)) error {
+	var total, processed int64
+	if err := db.DB.Table(f.table).Count(&total).Error; err != nil {
+		return fmt.Errorf("failed to count objects: %w", err)
+	}
+	if err := db.DB.Table(f.table).Where("id <= ?", lastID).Count(&processed).Error; err != nil {
+		return fmt.Errorf("failed to count processed objects: %w", err)
+	}
+
+	p := types.RotateKeysProgress{
+		Table:      f.table,
+		Processed:  int(processed),
+		Total:      int(total),
+		Checkpoint: rotateKeysCheckpoint(f.table, lastID),
+	}
+
+	for {
+		var batch []ODataObject
+		err := db.DB.Transaction(func(tx *gorm.DB) error {
+			query := tx.Table(f.table).Where("id > ?", lastID).Order("id").Limit(batchSize)
+			// Prevent the backend from updating the objects between
+			// reading and re-encrypting them.
+			if tx.Dialector.Name() == "postgres" {
+				query = query.Clauses(clause.Locking{Strength: "UPDATE"})
+			}
+			if err := query.Find(&batch).Error; err != nil {
+				return fmt.Errorf("failed to get objects: %w", err)
+			}
+
+			for _, obj := range batch {
+				data, rotated, err := db.rotateField(obj.Data, f.field)
+				if err != nil {
+					return fmt.Errorf("failed to rotate object %d: %w", obj.ID, err)
+				}
+				if !rotated {
+					continue
+				}
+				if err := tx.Table(f.table).Where("id = ?", obj.ID).Update("data", data).Error; err != nil {
+					return fmt.Errorf("failed to save object %d: %w", obj.ID, err)
+				}
+				p.Rotated++
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+
+		lastID = batch[len(batch)-1].ID
+		p.Processed += len(batch)
+		p.Checkpoint = rotateKeysCheckpoint(f.table, lastID)
+		if progress != nil {
+			progress(p)
+		}
+	}
+}
+
+// rotateField returns the data with the field re-encrypted with the current
+// key, and whether it needed to be.
+func (db *Handler) rotateField(data datatypes.JSON, field string) (datatypes.JSON, bool, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, false, fmt.Errorf("failed to unmarshal data: %w", err)
+	}
+	raw, ok := fields[field]
+	if !ok {
+		return data, false, nil
+	}
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, false, fmt.Errorf("failed to unmarshal %s: %w", field, err)
+	}
+	if !db.Keyring.NeedsRotation(value) {
+		return data, false, nil
+	}
+
+	rotated, err := db.Keyring.Rotate(value)
+	if err != nil {
+		return nil, false, err
+	}
+	updated, err := patchObject(data, map[string]string{field: rotated})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return updated, true, nil
+}
+
+func rotateKeysCheckpoint(table string, id uint) string {
+	return fmt.Sprintf("%s:%d", table, id)
+}
+
+func parseRotateKeysCheckpoint(checkpoint string) (string, uint, error) {
+	if checkpoint == "" {
+		return "", 0, nil
+	}
+	table, id, ok := strings.Cut(checkpoint, ":")
+	if !ok {
+		return "", 0, fmt.Errorf("invalid checkpoint %q, <table>:<id> is expected", checkpoint)
+	}
+	parsed, err := strconv.ParseUint(id, 10, 0)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid id in checkpoint %q: %w", checkpoint, err)
+	}
+	return table, uint(parsed), nil
+}
@@ -0,0 +1,127 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"encoding/base64"
+	"path/filepath"
+	"testing"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/database/fieldcrypt"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestHandler_RotateFieldEncryptionKeys(t *testing.T) {
+	oldKey := base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+	newKey := base64.StdEncoding.EncodeToString([]byte("fedcba9876543210fedcba9876543210"))
+
+	config := types.DBConfig{
+		DriverType:         types.DBDriverTypeLocal,
+		LocalDBPath:        filepath.Join(t.TempDir(), "db.sqlite"),
+		FieldEncryptionKey: oldKey,
+	}
+	db, err := NewDatabase(config)
+	if err != nil {
+		t.Fatalf("NewDatabase() error = %v", err)
+	}
+	handler := db.(*Handler) // nolint:forcetypeassert
+
+	// Create webhooks with secrets encrypted with the old key, one without
+	// a secret and one stored before encryption was enabled.
+	var ids []string
+	for _, secret := range []*string{utils.PointerTo("a"), nil, utils.PointerTo("b"), utils.PointerTo("c")} {
+		nc, err := db.NotificationConfigsTable().CreateNotificationConfig(models.NotificationConfig{
+			Name:   utils.PointerTo("webhook"),
+			Url:    utils.PointerTo("https://example.com/hook"),
+			Events: &[]models.NotificationEventType{models.FindingFixed},
+			Secret: secret,
+		})
+		if err != nil {
+			t.Fatalf("CreateNotificationConfig() error = %v", err)
+		}
+		ids = append(ids, *nc.Id)
+	}
+	plainTable := &NotificationConfigsTableHandler{DB: handler.DB}
+	plain, err := plainTable.CreateNotificationConfig(models.NotificationConfig{
+		Name:   utils.PointerTo("webhook"),
+		Url:    utils.PointerTo("https://example.com/hook"),
+		Events: &[]models.NotificationEventType{models.FindingFixed},
+		Secret: utils.PointerTo("d"),
+	})
+	if err != nil {
+		t.Fatalf("CreateNotificationConfig() error = %v", err)
+	}
+	ids = append(ids, *plain.Id)
+
+	// Rotate to the new key, with the old key still readable.
+	handler.Keyring, err = fieldcrypt.NewKeyring(newKey, []string{oldKey})
+	if err != nil {
+		t.Fatalf("NewKeyring() error = %v", err)
+	}
+
+	// Interrupt the rotation after the first batch and resume it.
+	var checkpoint string
+	var reports int
+	err = handler.RotateFieldEncryptionKeys(types.RotateKeysParams{BatchSize: 2}, func(p types.RotateKeysProgress) {
+		reports++
+		if reports == 1 {
+			checkpoint = p.Checkpoint
+			if p.Processed != 2 || p.Total != 5 || p.Rotated != 1 {
+				t.Errorf("unexpected progress %+v", p)
+			}
+		}
+	})
+	if err != nil {
+		t.Fatalf("RotateFieldEncryptionKeys() error = %v", err)
+	}
+	if reports != 3 {
+		t.Errorf("RotateFieldEncryptionKeys() reported progress %d times, want 3", reports)
+	}
+
+	var last types.RotateKeysProgress
+	err = handler.RotateFieldEncryptionKeys(types.RotateKeysParams{BatchSize: 2, Checkpoint: checkpoint}, func(p types.RotateKeysProgress) {
+		last = p
+	})
+	if err != nil {
+		t.Fatalf("RotateFieldEncryptionKeys() error = %v", err)
+	}
+	// Everything was already rotated by the first run.
+	if last.Processed != 5 || last.Rotated != 0 {
+		t.Errorf("unexpected progress of resumed rotation %+v", last)
+	}
+
+	// The secrets are readable with the new key only.
+	handler.Keyring, err = fieldcrypt.NewKeyring(newKey, nil)
+	if err != nil {
+		t.Fatalf("NewKeyring() error = %v", err)
+	}
+	want := []string{"a", "", "b", "c", "d"}
+	for i, id := range ids {
+		nc, err := db.NotificationConfigsTable().GetNotificationConfig(id, models.GetNotificationConfigsNotificationConfigIDParams{})
+		if err != nil {
+			t.Fatalf("GetNotificationConfig() error = %v", err)
+		}
+		if got := utils.ValueOrZero(nc.Secret); got != want[i] {
+			t.Errorf("secret of %s = %q, want %q", id, got, want[i])
+		}
+	}
+
+	if err := handler.RotateFieldEncryptionKeys(types.RotateKeysParams{Checkpoint: "unknown:1"}, nil); err == nil {
+		t.Errorf("RotateFieldEncryptionKeys() with unknown checkpoint table succeeded")
+	}
+}
@@ -0,0 +1,272 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	"github.com/openclarity/vmclarity/backend/pkg/database/fieldcrypt"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/backend/pkg/notifications/payload"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+type NotificationConfig struct {
+	ODataObject
+}
+
+type NotificationConfigsTableHandler struct {
+	DB      *gorm.DB
+	Keyring *fieldcrypt.Keyring
+}
+
+func (db *Handler) NotificationConfigsTable() types.NotificationConfigsTable {
+	return &NotificationConfigsTableHandler{
+		DB:      db.DB,
+		Keyring: db.Keyring,
+	}
+}
+
+func (n *NotificationConfigsTableHandler) GetNotificationConfigs(params models.GetNotificationConfigsParams) (models.NotificationConfigs, error) {
+	var notificationConfigs []NotificationConfig
+	err := ODataQuery(n.DB, "NotificationConfig", params.Filter, nil, params.Select, nil, params.OrderBy, params.Top, params.Skip, true, &notificationConfigs)
+	if err != nil {
+		return models.NotificationConfigs{}, err
+	}
+
+	items := []models.NotificationConfig{}
+	for _, notificationConfig := range notificationConfigs {
+		nc, err := n.toAPIModel(notificationConfig.Data)
+		if err != nil {
+			return models.NotificationConfigs{}, err
+		}
+		items = append(items, nc)
+	}
+
+	output := models.NotificationConfigs{Items: &items}
+
+	if params.Count != nil && *params.Count {
+		count, err := ODataCount(n.DB, "NotificationConfig", params.Filter, nil)
+		if err != nil {
+			return models.NotificationConfigs{}, fmt.Errorf("failed to count records: %w", err)
+		}
+		output.Count = &count
+	}
+
+	return output, nil
+}
+
+func (n *NotificationConfigsTableHandler) GetNotificationConfig(notificationConfigID models.NotificationConfigID, params models.GetNotificationConfigsNotificationConfigIDParams) (models.NotificationConfig, error) {
+	var dbNotificationConfig NotificationConfig
+	filter := fmt.Sprintf("id eq '%s'", notificationConfigID)
+	err := ODataQuery(n.DB, "NotificationConfig", &filter, nil, params.Select, nil, nil, nil, nil, false, &dbNotificationConfig)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.NotificationConfig{}, types.ErrNotFound
+		}
+		return models.NotificationConfig{}, err
+	}
+
+	return n.toAPIModel(dbNotificationConfig.Data)
+}
+
+func (n *NotificationConfigsTableHandler) CreateNotificationConfig(notificationConfig models.NotificationConfig) (models.NotificationConfig, error) {
+	// Check the user didn't provide an ID
+	if notificationConfig.Id != nil {
+		return models.NotificationConfig{}, &common.BadRequestError{
+			Reason: "can not specify id field when creating a new NotificationConfig",
+		}
+	}
+
+	if err := validateNotificationConfig(notificationConfig); err != nil {
+		return models.NotificationConfig{}, err
+	}
+
+	// Generate a new UUID
+	notificationConfig.Id = utils.PointerTo(uuid.New().String())
+
+	// Initialise revision
+	notificationConfig.Revision = utils.PointerTo(1)
+
+	if err := n.encryptSecret(&notificationConfig); err != nil {
+		return models.NotificationConfig{}, err
+	}
+
+	marshaled, err := json.Marshal(notificationConfig)
+	if err != nil {
+		return models.NotificationConfig{}, fmt.Errorf("failed to convert API model to DB model: %w", err)
+	}
+
+	newNotificationConfig := NotificationConfig{}
+	newNotificationConfig.Data = marshaled
+
+	if err := n.DB.Create(&newNotificationConfig).Error; err != nil {
+		return models.NotificationConfig{}, fmt.Errorf("failed to create notification config in db: %w", err)
+	}
+
+	return notificationConfig, nil
+}
+
+func (n *NotificationConfigsTableHandler) UpdateNotificationConfig(notificationConfig models.NotificationConfig, params models.PatchNotificationConfigsNotificationConfigIDParams) (models.NotificationConfig, error) {
+	if notificationConfig.Id == nil || *notificationConfig.Id == "" {
+		return models.NotificationConfig{}, &common.BadRequestError{
+			Reason: "id is required to update notification config",
+		}
+	}
+
+	var dbObj NotificationConfig
+	if err := getExistingObjByID(n.DB, "NotificationConfig", *notificationConfig.Id, &dbObj); err != nil {
+		return models.NotificationConfig{}, fmt.Errorf("failed to get notification config from db: %w", err)
+	}
+
+	var dbNotificationConfig models.NotificationConfig
+	err := json.Unmarshal(dbObj.Data, &dbNotificationConfig)
+	if err != nil {
+		return models.NotificationConfig{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	if err := checkRevisionEtag(params.IfMatch, dbNotificationConfig.Revision); err != nil {
+		return models.NotificationConfig{}, err
+	}
+
+	notificationConfig.Revision = bumpRevision(dbNotificationConfig.Revision)
+
+	if err := n.encryptSecret(&notificationConfig); err != nil {
+		return models.NotificationConfig{}, err
+	}
+
+	dbObj.Data, err = patchObject(dbObj.Data, notificationConfig)
+	if err != nil {
+		return models.NotificationConfig{}, fmt.Errorf("failed to apply patch: %w", err)
+	}
+
+	nc, err := n.toAPIModel(dbObj.Data)
+	if err != nil {
+		return models.NotificationConfig{}, err
+	}
+
+	if err := validateNotificationConfig(nc); err != nil {
+		return models.NotificationConfig{}, err
+	}
+
+	if err := n.DB.Save(&dbObj).Error; err != nil {
+		return models.NotificationConfig{}, fmt.Errorf("failed to save notification config in db: %w", err)
+	}
+
+	return nc, nil
+}
+
+func (n *NotificationConfigsTableHandler) DeleteNotificationConfig(notificationConfigID models.NotificationConfigID) error {
+	if err := deleteObjByID(n.DB, notificationConfigID, &NotificationConfig{}); err != nil {
+		return fmt.Errorf("failed to delete notification config: %w", err)
+	}
+	return nil
+}
+
+// encryptSecret replaces the secret of the notification config to store with
+// its encrypted value.
+func (n *NotificationConfigsTableHandler) encryptSecret(notificationConfig *models.NotificationConfig) error {
+	if notificationConfig.Secret == nil {
+		return nil
+	}
+	secret, err := n.Keyring.Encrypt(*notificationConfig.Secret)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt secret: %w", err)
+	}
+	notificationConfig.Secret = &secret
+	return nil
+}
+
+// toAPIModel returns the stored notification config with its secret
+// decrypted.
+func (n *NotificationConfigsTableHandler) toAPIModel(data []byte) (models.NotificationConfig, error) {
+	var nc models.NotificationConfig
+	if err := json.Unmarshal(data, &nc); err != nil {
+		return models.NotificationConfig{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	if nc.Secret != nil {
+		secret, err := n.Keyring.Decrypt(*nc.Secret)
+		if err != nil {
+			return models.NotificationConfig{}, fmt.Errorf("failed to decrypt secret of notification config %s: %w", utils.ValueOrZero(nc.Id), err)
+		}
+		nc.Secret = &secret
+	}
+
+	return nc, nil
+}
+
+func validateNotificationConfig(notificationConfig models.NotificationConfig) error {
+	if notificationConfig.Name == nil || *notificationConfig.Name == "" {
+		return &common.BadRequestError{
+			Reason: "name must be provided and can not be empty",
+		}
+	}
+
+	if notificationConfig.Url == nil {
+		return &common.BadRequestError{
+			Reason: "url must be provided",
+		}
+	}
+	u, err := url.Parse(*notificationConfig.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return &common.BadRequestError{
+			Reason: fmt.Sprintf("invalid url %q, an absolute http or https URL is expected", *notificationConfig.Url),
+		}
+	}
+
+	if notificationConfig.Events == nil || len(*notificationConfig.Events) == 0 {
+		return &common.BadRequestError{
+			Reason: "at least one event must be provided",
+		}
+	}
+	for _, event := range *notificationConfig.Events {
+		switch event {
+		case models.ScanStarted, models.ScanCompleted, models.ScanFailed, models.CriticalFindingFound,
+			models.FindingFixed, models.FindingInvalidated:
+		default:
+			return &common.BadRequestError{
+				Reason: fmt.Sprintf("unsupported event %v", event),
+			}
+		}
+	}
+
+	if notificationConfig.MinConfidence != nil {
+		switch *notificationConfig.MinConfidence {
+		case models.High, models.Medium, models.Low:
+		default:
+			return &common.BadRequestError{
+				Reason: fmt.Sprintf("unsupported minConfidence %v", *notificationConfig.MinConfidence),
+			}
+		}
+	}
+
+	if _, err := payload.Parse(notificationConfig); err != nil {
+		return &common.BadRequestError{
+			Reason: err.Error(),
+		}
+	}
+
+	return nil
+}
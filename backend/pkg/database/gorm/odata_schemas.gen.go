@@ -0,0 +1,2996 @@
+// Code generated by odatagen. DO NOT EDIT.
+
+package gorm
+
+import "github.com/openclarity/vmclarity/backend/pkg/database/odatasql"
+
+// generatedSchemaMetas are the schema metas of the object schemas of the API.
+var generatedSchemaMetas = map[string]odatasql.SchemaMeta{
+	"APIKey": {
+		Fields: odatasql.Schema{
+			"assetGroupIDs": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"createdAt": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"expiresAt": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"id":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"key":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"name":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"prefix":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"role":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"APIKeys": {
+		Fields: odatasql.Schema{
+			"count": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"items": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"APIKey"},
+				},
+			},
+		},
+	},
+	"ApiResponse": {
+		Fields: odatasql.Schema{
+			"message": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"Asset": {
+		Fields: odatasql.Schema{
+			"assetInfo": odatasql.FieldMeta{
+				FieldType: odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{
+					"VMInfo",
+					"PodInfo",
+					"DirInfo",
+					"ContainerImageInfo",
+					"SBOMInfo",
+					"VMImageInfo",
+				},
+				DiscriminatorProperty: "objectType",
+			},
+			"id": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"labels": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Tag"},
+				},
+			},
+			"revision":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scansCount": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"summary": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScanFindingsSummary"},
+			},
+			"terminatedOn": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"AssetExists": {
+		Fields: odatasql.Schema{
+			"asset": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"Asset"},
+			},
+			"message": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"AssetGroup": {
+		Fields: odatasql.Schema{
+			"assetIDs": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"description": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"id":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"name":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"revision":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"tagSelector": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Tag"},
+				},
+			},
+		},
+	},
+	"AssetGroupExists": {
+		Fields: odatasql.Schema{
+			"assetGroup": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"AssetGroup"},
+			},
+			"message": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"AssetGroups": {
+		Fields: odatasql.Schema{
+			"count": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"items": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"AssetGroup"},
+				},
+			},
+		},
+	},
+	"AssetRelationship": {
+		Fields: odatasql.Schema{
+			"assetInfo": odatasql.FieldMeta{
+				FieldType: odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{
+					"VMInfo",
+					"PodInfo",
+					"DirInfo",
+					"ContainerImageInfo",
+					"SBOMInfo",
+					"VMImageInfo",
+				},
+				DiscriminatorProperty: "objectType",
+			},
+			"id": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"labels": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Tag"},
+				},
+			},
+			"revision":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scansCount": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"summary": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScanFindingsSummary"},
+			},
+			"terminatedOn": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"AssetScanResult": {
+		Fields: odatasql.Schema{
+			"annotations": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"artifacts": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"ScanResultArtifact"},
+				},
+			},
+			"asset": odatasql.FieldMeta{
+				FieldType:            odatasql.RelationshipFieldType,
+				RelationshipSchema:   "Asset",
+				RelationshipProperty: "id",
+			},
+			"certificates": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"CertificateScan"},
+			},
+			"compliance": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ComplianceScan"},
+			},
+			"deltaScan": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"DeltaScanInfo"},
+			},
+			"exploits": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ExploitScan"},
+			},
+			"findingsProcessed": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"id":                odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"malware": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"MalwareScan"},
+			},
+			"misconfigurations": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"MisconfigurationScan"},
+			},
+			"plugins": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"PluginScan"},
+			},
+			"rerunFamilies": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"resourceCleanup": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"retention": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScanResultRetention"},
+			},
+			"revision": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"rootkits": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"RootkitScan"},
+			},
+			"sboms": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"SbomScan"},
+			},
+			"scan": odatasql.FieldMeta{
+				FieldType:            odatasql.RelationshipFieldType,
+				RelationshipSchema:   "Scan",
+				RelationshipProperty: "id",
+			},
+			"scannerEndTime": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scannerInstanceImage": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScannerInstanceImage"},
+			},
+			"scannerStartTime": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"secrets": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"SecretScan"},
+			},
+			"status": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"AssetScanStatus"},
+			},
+			"summary": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScanFindingsSummary"},
+			},
+			"truncations": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"ScanResultTruncation"},
+				},
+			},
+			"vulnerabilities": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"VulnerabilityScan"},
+			},
+		},
+	},
+	"AssetScanResultExists": {
+		Fields: odatasql.Schema{
+			"assetScanResult": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"AssetScanResult"},
+			},
+			"message": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"AssetScanResults": {
+		Fields: odatasql.Schema{
+			"count": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"items": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"AssetScanResult"},
+				},
+			},
+		},
+	},
+	"AssetScanState": {
+		Fields: odatasql.Schema{
+			"errors": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"lastTransitionTime": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"progress":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"state":              odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"AssetScanStatus": {
+		Fields: odatasql.Schema{
+			"certificates": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"AssetScanState"},
+			},
+			"compliance": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"AssetScanState"},
+			},
+			"exploits": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"AssetScanState"},
+			},
+			"general": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"AssetScanState"},
+			},
+			"malware": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"AssetScanState"},
+			},
+			"misconfigurations": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"AssetScanState"},
+			},
+			"plugins": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"AssetScanState"},
+			},
+			"rootkits": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"AssetScanState"},
+			},
+			"sbom": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"AssetScanState"},
+			},
+			"secrets": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"AssetScanState"},
+			},
+			"vulnerabilities": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"AssetScanState"},
+			},
+		},
+	},
+	"Assets": {
+		Fields: odatasql.Schema{
+			"count": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"items": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Asset"},
+				},
+			},
+		},
+	},
+	"AwsAccount": {
+		Fields: odatasql.Schema{
+			"accountID": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"name":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"regions": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"AwsRegion"},
+				},
+			},
+		},
+	},
+	"AwsAccountScope": {
+		Fields: odatasql.Schema{
+			"objectType": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"regions": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"AwsRegion"},
+				},
+			},
+		},
+	},
+	"AwsOrganizationScope": {
+		Fields: odatasql.Schema{
+			"accounts": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"AwsAccount"},
+				},
+			},
+			"objectType": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"AwsRegion": {
+		Fields: odatasql.Schema{
+			"name": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"vpcs": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"AwsVPC"},
+				},
+			},
+		},
+	},
+	"AwsScanScope": {
+		Fields: odatasql.Schema{
+			"accountIDs": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"allRegions": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"instanceTagExclusion": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Tag"},
+				},
+			},
+			"instanceTagSelector": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Tag"},
+				},
+			},
+			"objectType": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"regions": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"AwsRegion"},
+				},
+			},
+			"shouldScanStoppedInstances": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"AwsScannerPlacement": {
+		Fields: odatasql.Schema{
+			"securityGroupID": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"subnetIDs": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+		},
+	},
+	"AwsVPC": {
+		Fields: odatasql.Schema{
+			"id": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"securityGroups": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"SecurityGroup"},
+				},
+			},
+		},
+	},
+	"AzureResourceGroup": {
+		Fields: odatasql.Schema{
+			"name": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"AzureScanScope": {
+		Fields: odatasql.Schema{
+			"allResourceGroups": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"instanceTagExclusion": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Tag"},
+				},
+			},
+			"instanceTagSelector": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Tag"},
+				},
+			},
+			"objectType": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"resourceGroups": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"AzureResourceGroup"},
+				},
+			},
+			"shouldScanStoppedInstances": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"AzureScannerPlacement": {
+		Fields: odatasql.Schema{
+			"resourceGroup":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"securityGroupID": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"subnetID":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"zones": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+		},
+	},
+	"AzureSubscriptionScope": {
+		Fields: odatasql.Schema{
+			"objectType": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"resourceGroups": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"AzureResourceGroup"},
+				},
+			},
+			"subscriptionID": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"BackgroundTask": {
+		Fields: odatasql.Schema{
+			"failures":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"intervalSeconds": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"jitterSeconds":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"lastRun": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"BackgroundTaskRun"},
+			},
+			"name":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"nextRunTime": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"running":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"runs":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"BackgroundTaskRun": {
+		Fields: odatasql.Schema{
+			"endTime":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"error":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"startTime": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"triggered": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"BackgroundTasks": {
+		Fields: odatasql.Schema{
+			"items": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"BackgroundTask"},
+				},
+			},
+		},
+	},
+	"Certificate": {
+		Fields: odatasql.Schema{
+			"filePath":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"findingType":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"fingerprint":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"issuer":             odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"keyAlgorithm":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"keySize":            odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"message":            odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"notAfter":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"notBefore":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"serialNumber":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"signatureAlgorithm": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"subject":            odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"CertificateFindingInfo": {
+		Fields: odatasql.Schema{
+			"filePath":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"findingType":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"fingerprint":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"issuer":             odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"keyAlgorithm":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"keySize":            odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"message":            odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"notAfter":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"notBefore":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"objectType":         odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"serialNumber":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"signatureAlgorithm": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"subject":            odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"CertificateScan": {
+		Fields: odatasql.Schema{
+			"certificates": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Certificate"},
+				},
+			},
+		},
+	},
+	"CertificatesConfig": {
+		Fields: odatasql.Schema{
+			"enabled":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"expiryWarningDays": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ChangedBlockRange": {
+		Fields: odatasql.Schema{
+			"length": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"offset": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ComplianceCheck": {
+		Fields: odatasql.Schema{
+			"benchmark":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"checkID":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"filePath":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"message":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"remediation": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"title":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ComplianceCheckFindingInfo": {
+		Fields: odatasql.Schema{
+			"benchmark":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"checkID":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"filePath":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"message":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"objectType":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"remediation": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"title":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ComplianceConfig": {
+		Fields: odatasql.Schema{
+			"benchmarks": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"enabled": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ComplianceControl": {
+		Fields: odatasql.Schema{
+			"controlID": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"framework": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"title":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ComplianceScan": {
+		Fields: odatasql.Schema{
+			"complianceChecks": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"ComplianceCheck"},
+				},
+			},
+		},
+	},
+	"ContainerImageInfo": {
+		Fields: odatasql.Schema{
+			"imageID":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"location":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"objectType": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"registry":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"repository": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"tags": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+		},
+	},
+	"CorrelatedFinding": {
+		Fields: odatasql.Schema{
+			"asset": odatasql.FieldMeta{
+				FieldType:            odatasql.RelationshipFieldType,
+				RelationshipSchema:   "Asset",
+				RelationshipProperty: "id",
+			},
+			"findingIDs": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"firstSeen":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"id":         odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"lastSeen":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"path":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"resolvedOn": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"rule":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"severity":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"CorrelatedFindings": {
+		Fields: odatasql.Schema{
+			"count": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"items": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"CorrelatedFinding"},
+				},
+			},
+		},
+	},
+	"DeltaScanInfo": {
+		Fields: odatasql.Schema{
+			"baseScanResultID": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"changedBlocks": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"ChangedBlockRange"},
+				},
+			},
+			"changedPaths": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+		},
+	},
+	"DirInfo": {
+		Fields: odatasql.Schema{
+			"dirName":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"location":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"objectType": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"Exploit": {
+		Fields: odatasql.Schema{
+			"cveID":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"description": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"name":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"sourceDB":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"title":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"urls": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+		},
+	},
+	"ExploitFindingInfo": {
+		Fields: odatasql.Schema{
+			"cveID":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"description": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"name":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"objectType":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"sourceDB":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"title":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"urls": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+		},
+	},
+	"ExploitScan": {
+		Fields: odatasql.Schema{
+			"exploits": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Exploit"},
+				},
+			},
+		},
+	},
+	"ExploitsConfig": {
+		Fields: odatasql.Schema{
+			"enabled": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"Feed": {
+		Fields: odatasql.Schema{
+			"kind": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"lastSync": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"FeedSync"},
+			},
+			"name":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"size":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"updatedAt": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"url":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"version":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"versions": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"FeedVersion"},
+				},
+			},
+		},
+	},
+	"FeedSync": {
+		Fields: odatasql.Schema{
+			"endTime":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"error":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"startTime": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"updated":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"FeedVersion": {
+		Fields: odatasql.Schema{
+			"downloadedAt": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"size":         odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"version":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"Feeds": {
+		Fields: odatasql.Schema{
+			"items": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Feed"},
+				},
+			},
+		},
+	},
+	"Finding": {
+		Fields: odatasql.Schema{
+			"annotations": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"asset": odatasql.FieldMeta{
+				FieldType:            odatasql.RelationshipFieldType,
+				RelationshipSchema:   "Asset",
+				RelationshipProperty: "id",
+			},
+			"confidence": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"findingInfo": odatasql.FieldMeta{
+				FieldType: odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{
+					"PackageFindingInfo",
+					"VulnerabilityFindingInfo",
+					"MalwareFindingInfo",
+					"SecretFindingInfo",
+					"MisconfigurationFindingInfo",
+					"RootkitFindingInfo",
+					"ExploitFindingInfo",
+					"CertificateFindingInfo",
+					"ComplianceCheckFindingInfo",
+					"PluginFindingInfo",
+				},
+				DiscriminatorProperty: "objectType",
+				DiscriminatorSchemaMapping: map[string]string{
+					"CertificateFindingInfo":      "Certificate",
+					"ComplianceCheckFindingInfo":  "ComplianceCheck",
+					"ExploitFindingInfo":          "Exploit",
+					"MalwareFindingInfo":          "Malware",
+					"MisconfigurationFindingInfo": "Misconfiguration",
+					"PackageFindingInfo":          "Package",
+					"PluginFindingInfo":           "PluginFinding",
+					"RootkitFindingInfo":          "Rootkit",
+					"SecretFindingInfo":           "Secret",
+					"VulnerabilityFindingInfo":    "Vulnerability",
+				},
+			},
+			"foundOn":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"id":            odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"invalidatedOn": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scan": odatasql.FieldMeta{
+				FieldType:            odatasql.RelationshipFieldType,
+				RelationshipSchema:   "Scan",
+				RelationshipProperty: "id",
+			},
+			"scannersCount": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"suppression": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"FindingSuppression"},
+			},
+		},
+	},
+	"FindingBulkItemResult": {
+		Fields: odatasql.Schema{
+			"finding": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"Finding"},
+			},
+			"message": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"status":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"FindingDigest": {
+		Fields: odatasql.Schema{
+			"cadence":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"disabled": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"filter":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"id":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"lastDelivery": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"FindingDigestDelivery"},
+			},
+			"name":                 odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"nextDeliveryTime":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"notificationConfigID": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"recipients": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"revision": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"timezone": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"FindingDigestContent": {
+		Fields: odatasql.Schema{
+			"count":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"findingDigestID": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"findings": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Finding"},
+				},
+			},
+			"name":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"periodEnd":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"periodStart": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"renderedFindings": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"RenderedFinding"},
+				},
+			},
+		},
+	},
+	"FindingDigestDelivery": {
+		Fields: odatasql.Schema{
+			"findings": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"message":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"state":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"time":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"FindingDigests": {
+		Fields: odatasql.Schema{
+			"count": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"items": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"FindingDigest"},
+				},
+			},
+		},
+	},
+	"FindingException": {
+		Fields: odatasql.Schema{
+			"expiresAt": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"id":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"matchRules": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"FindingExceptionMatchRules"},
+			},
+			"name":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"reason":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"revision": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"FindingExceptionMatchRules": {
+		Fields: odatasql.Schema{
+			"assetFilter":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"findingType":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"packagePurl":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"vulnerabilityName": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"FindingExceptions": {
+		Fields: odatasql.Schema{
+			"count": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"items": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"FindingException"},
+				},
+			},
+		},
+	},
+	"FindingExists": {
+		Fields: odatasql.Schema{
+			"finding": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"Finding"},
+			},
+			"message": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"FindingSuppression": {
+		Fields: odatasql.Schema{
+			"expiresAt":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"findingExceptionID": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"reason":             odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"FindingTemplate": {
+		Fields: odatasql.Schema{
+			"description": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"title":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"FindingTemplatePreview": {
+		Fields: odatasql.Schema{
+			"finding": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"Finding"},
+			},
+			"findingID": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"templates": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"FindingTemplateSettings"},
+			},
+		},
+	},
+	"FindingTemplateSettings": {
+		Fields: odatasql.Schema{
+			"description": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"families":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"title":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"updatedAt":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"Findings": {
+		Fields: odatasql.Schema{
+			"count": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"items": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Finding"},
+				},
+			},
+		},
+	},
+	"FindingsBulkRequest": {
+		Fields: odatasql.Schema{
+			"items": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Finding"},
+				},
+			},
+		},
+	},
+	"FindingsBulkResult": {
+		Fields: odatasql.Schema{
+			"items": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"FindingBulkItemResult"},
+				},
+			},
+		},
+	},
+	"FindingsRetentionPolicy": {
+		Fields: odatasql.Schema{
+			"maxAgeDays": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"InstalledPackage": {
+		Fields: odatasql.Schema{
+			"installedVersions": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"language": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"licenses": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"name": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"type": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"versionHistory": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"PackageVersionHistory"},
+				},
+			},
+		},
+	},
+	"InstalledPackages": {
+		Fields: odatasql.Schema{
+			"items": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"InstalledPackage"},
+				},
+			},
+		},
+	},
+	"JSONPatchOperation": {
+		Fields: odatasql.Schema{
+			"from":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"op":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"path":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"value": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"KubernetesClusterScope": {
+		Fields: odatasql.Schema{
+			"clusterName": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"namespaces": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"nodes": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"objectType": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"KubernetesScanScope": {
+		Fields: odatasql.Schema{
+			"namespaces": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"nodeSelector": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Tag"},
+				},
+			},
+			"objectType":         odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"skipNodes":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"skipWorkloadImages": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"LocationMetadata": {
+		Fields: odatasql.Schema{
+			"cloud":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"country": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"region":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"zone":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"MaintenanceMode": {
+		Fields: odatasql.Schema{
+			"enabled":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"reason":            odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"retryAfterSeconds": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"updatedAt":         odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"Malware": {
+		Fields: odatasql.Schema{
+			"confidence":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"malwareName": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"malwareType": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"matchedStrings": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"MalwareMatchedString"},
+				},
+			},
+			"path":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"ruleName": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scanners": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"ScannerAttribution"},
+				},
+			},
+		},
+	},
+	"MalwareConfig": {
+		Fields: odatasql.Schema{
+			"enabled": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"MalwareFindingInfo": {
+		Fields: odatasql.Schema{
+			"confidence":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"malwareName": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"malwareType": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"matchedStrings": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"MalwareMatchedString"},
+				},
+			},
+			"objectType": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"path":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"ruleName":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scanners": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"ScannerAttribution"},
+				},
+			},
+		},
+	},
+	"MalwareMatchedString": {
+		Fields: odatasql.Schema{
+			"data":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"identifier": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"offset":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"MalwareScan": {
+		Fields: odatasql.Schema{
+			"malware": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Malware"},
+				},
+			},
+			"metadata": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"ScannerMetadata"},
+				},
+			},
+		},
+	},
+	"Misconfiguration": {
+		Fields: odatasql.Schema{
+			"message":         odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"remediation":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scannedPath":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scannerName":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"severity":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"testCategory":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"testDescription": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"testID":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"MisconfigurationFindingInfo": {
+		Fields: odatasql.Schema{
+			"complianceControls": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"ComplianceControl"},
+				},
+			},
+			"message":         odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"objectType":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"remediation":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scannedPath":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scannerName":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"severity":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"testCategory":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"testDescription": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"testID":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"MisconfigurationScan": {
+		Fields: odatasql.Schema{
+			"misconfigurations": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Misconfiguration"},
+				},
+			},
+			"scanners": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+		},
+	},
+	"MisconfigurationSkipTest": {
+		Fields: odatasql.Schema{
+			"audit": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"SuppressionAudit"},
+			},
+			"testID": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"MisconfigurationsConfig": {
+		Fields: odatasql.Schema{
+			"enabled": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"skipTests": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"MisconfigurationSkipTest"},
+				},
+			},
+		},
+	},
+	"NotificationConfig": {
+		Fields: odatasql.Schema{
+			"disabled": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"events": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"filter": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"id":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"lastDelivery": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"NotificationDelivery"},
+			},
+			"minConfidence":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"name":               odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"payloadContentType": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"payloadTemplate":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"revision":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"secret":             odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"url":                odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"NotificationConfigs": {
+		Fields: odatasql.Schema{
+			"count": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"items": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"NotificationConfig"},
+				},
+			},
+		},
+	},
+	"NotificationDelivery": {
+		Fields: odatasql.Schema{
+			"attempts": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"event":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"message":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"state":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"time":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"NotificationEvent": {
+		Fields: odatasql.Schema{
+			"digest": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"FindingDigestContent"},
+			},
+			"finding": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"Finding"},
+			},
+			"renderedFinding": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"RenderedFinding"},
+			},
+			"scan": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"Scan"},
+			},
+			"time":             odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"timeToFixSeconds": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"type":             odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ObjectCounts": {
+		Fields: odatasql.Schema{
+			"assets":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"findings":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scanConfigs": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scanResults": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scans":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"Operation": {
+		Fields: odatasql.Schema{
+			"completedAt": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"createdAt":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"error":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"expiresAt":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"id":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"kind":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"resultLink":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"startedAt":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"state":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"Package": {
+		Fields: odatasql.Schema{
+			"cpes": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"language": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"licenses": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"name":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"purl":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"type":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"version": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"PackageChange": {
+		Fields: odatasql.Schema{
+			"base": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"Package"},
+			},
+			"compare": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"Package"},
+			},
+		},
+	},
+	"PackageFindingInfo": {
+		Fields: odatasql.Schema{
+			"cpes": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"language": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"licenses": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"name":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"objectType": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"purl":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"type":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"version":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"PackageUpgrade": {
+		Fields: odatasql.Schema{
+			"currentVersion":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"fixVersion":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"name":             odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"resolvedFindings": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"type":             odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"vulnerabilities": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+		},
+	},
+	"PackageVersionHistory": {
+		Fields: odatasql.Schema{
+			"firstFoundOn": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"removedOn":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"version":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"PackagesDiff": {
+		Fields: odatasql.Schema{
+			"added": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Package"},
+				},
+			},
+			"changed": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"PackageChange"},
+				},
+			},
+			"removed": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Package"},
+				},
+			},
+		},
+	},
+	"PluginFinding": {
+		Fields: odatasql.Schema{
+			"description": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"findingID":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"path":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"pluginName":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"properties":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"remediation": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"severity":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"title":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"PluginFindingInfo": {
+		Fields: odatasql.Schema{
+			"description": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"findingID":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"objectType":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"path":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"pluginName":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"properties":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"remediation": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"severity":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"title":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"PluginScan": {
+		Fields: odatasql.Schema{
+			"pluginFindings": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"PluginFinding"},
+				},
+			},
+		},
+	},
+	"PluginsConfig": {
+		Fields: odatasql.Schema{
+			"enabled": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"plugins": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"ScannerPlugin"},
+				},
+			},
+		},
+	},
+	"PodInfo": {
+		Fields: odatasql.Schema{
+			"location":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"objectType": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"podName":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"PostureScore": {
+		Fields: odatasql.Schema{
+			"assets":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"criticalFindings": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"exposedAssets":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"highFindings":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"id":               odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"lowFindings":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"mediumFindings":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"score":            odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"slaBreaches":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"team":             odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"time":             odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"PostureScores": {
+		Fields: odatasql.Schema{
+			"count": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"items": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"PostureScore"},
+				},
+			},
+		},
+	},
+	"Provider": {
+		Fields: odatasql.Schema{
+			"capabilities": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ProviderCapabilities"},
+			},
+			"kind": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ProviderCapabilities": {
+		Fields: odatasql.Schema{
+			"crossRegion":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"encryptedVolumes":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scanStoppedInstances": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scannerPlacement":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"spotInstances":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ProviderOperation": {
+		Fields: odatasql.Schema{
+			"asset": odatasql.FieldMeta{
+				FieldType:            odatasql.RelationshipFieldType,
+				RelationshipSchema:   "Asset",
+				RelationshipProperty: "id",
+			},
+			"endTime":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"errorMessage": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"id":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"location":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"operation":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"provider":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"requestID":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"resourceID":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scan": odatasql.FieldMeta{
+				FieldType:            odatasql.RelationshipFieldType,
+				RelationshipSchema:   "Scan",
+				RelationshipProperty: "id",
+			},
+			"scanResultID": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"startTime":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"status":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ProviderOperations": {
+		Fields: odatasql.Schema{
+			"count": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"items": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"ProviderOperation"},
+				},
+			},
+		},
+	},
+	"Providers": {
+		Fields: odatasql.Schema{
+			"items": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Provider"},
+				},
+			},
+		},
+	},
+	"QueryError": {
+		Fields: odatasql.Schema{
+			"message":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"option":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"position": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"segment":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"suggestions": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+		},
+	},
+	"RenderedFinding": {
+		Fields: odatasql.Schema{
+			"description": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"title":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ReportDelivery": {
+		Fields: odatasql.Schema{
+			"message":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"reportKey": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"state":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"time":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ReportSchedule": {
+		Fields: odatasql.Schema{
+			"cronLine": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"disabled": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"id":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"lastDelivery": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ReportDelivery"},
+			},
+			"name":             odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"nextDeliveryTime": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"recipients": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"reportType": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"revision":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"timezone":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ReportSchedules": {
+		Fields: odatasql.Schema{
+			"count": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"items": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"ReportSchedule"},
+				},
+			},
+		},
+	},
+	"RetentionRun": {
+		Fields: odatasql.Schema{
+			"deletedFindings":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"deletedScanResults": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"deletedScans":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"endTime":            odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"error":              odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"startTime":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"RetentionSettings": {
+		Fields: odatasql.Schema{
+			"enabled": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"findings": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"FindingsRetentionPolicy"},
+			},
+			"lastRun": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"RetentionRun"},
+			},
+			"scans": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScansRetentionPolicy"},
+			},
+			"updatedAt": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"RootVolume": {
+		Fields: odatasql.Schema{
+			"encrypted": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"sizeGB":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"Rootkit": {
+		Fields: odatasql.Schema{
+			"message":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"rootkitName": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"rootkitType": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"RootkitFindingInfo": {
+		Fields: odatasql.Schema{
+			"message":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"objectType":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"rootkitName": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"rootkitType": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"RootkitScan": {
+		Fields: odatasql.Schema{
+			"rootkits": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Rootkit"},
+				},
+			},
+		},
+	},
+	"RootkitsConfig": {
+		Fields: odatasql.Schema{
+			"enabled": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"RuntimeScheduleScanConfig": {
+		Fields: odatasql.Schema{
+			"cronLine":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"operationTime": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"timezone":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"SBOMConfig": {
+		Fields: odatasql.Schema{
+			"enabled": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"SBOMInfo": {
+		Fields: odatasql.Schema{
+			"location":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"name":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"objectType": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"version":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"SSHHostsScope": {
+		Fields: odatasql.Schema{
+			"hosts": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"objectType": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"SSHScanScope": {
+		Fields: odatasql.Schema{
+			"hosts": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"objectType": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"SbomDiff": {
+		Fields: odatasql.Schema{
+			"added": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Package"},
+				},
+			},
+			"baseScanResultID": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"downgraded": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"PackageChange"},
+				},
+			},
+			"headScanResultID": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"removed": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Package"},
+				},
+			},
+			"upgraded": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"PackageChange"},
+				},
+			},
+		},
+	},
+	"SbomScan": {
+		Fields: odatasql.Schema{
+			"packages": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Package"},
+				},
+			},
+		},
+	},
+	"Scan": {
+		Fields: odatasql.Schema{
+			"assetIDs": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"dryRun":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"endTime": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"id":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"plan": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScanPlan"},
+			},
+			"revision": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"samplingCoverage": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScanSamplingCoverage"},
+			},
+			"scanConfig": odatasql.FieldMeta{
+				FieldType:            odatasql.RelationshipFieldType,
+				RelationshipSchema:   "ScanConfig",
+				RelationshipProperty: "id",
+			},
+			"scanConfigSnapshot": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScanConfigSnapshot"},
+			},
+			"startTime":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"state":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"stateMessage": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"stateReason":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"summary": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScanSummary"},
+			},
+		},
+	},
+	"ScanConfig": {
+		Fields: odatasql.Schema{
+			"assetGroupIDs": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"deltaScanEnabled":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"disabled":            odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"id":                  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"maxParallelScanners": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"name":                odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"overlapPolicy":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"priority":            odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"queuedRun":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"retentionPolicy": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScanResultRetentionPolicy"},
+			},
+			"revision": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"sampling": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScanSampling"},
+			},
+			"scanConfigTemplate": odatasql.FieldMeta{
+				FieldType:            odatasql.RelationshipFieldType,
+				RelationshipSchema:   "ScanConfigTemplate",
+				RelationshipProperty: "id",
+			},
+			"scanFamiliesConfig": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScanFamiliesConfig"},
+			},
+			"scannerInstanceCreationConfig": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScannerInstanceCreationConfig"},
+			},
+			"scannerPlacement": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScannerPlacement"},
+			},
+			"scheduled": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"RuntimeScheduleScanConfig"},
+			},
+			"scope": odatasql.FieldMeta{
+				FieldType: odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{
+					"AwsScanScope",
+					"AzureScanScope",
+					"SSHScanScope",
+					"KubernetesScanScope",
+				},
+				DiscriminatorProperty: "objectType",
+			},
+			"skippedRuns": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"ScanConfigSkippedRun"},
+				},
+			},
+			"timeoutSeconds": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"volumeSizeGuardrail": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"VolumeSizeGuardrail"},
+			},
+		},
+	},
+	"ScanConfigExists": {
+		Fields: odatasql.Schema{
+			"message": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scanConfig": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScanConfig"},
+			},
+		},
+	},
+	"ScanConfigNextRuns": {
+		Fields: odatasql.Schema{
+			"nextRuns": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"timezone": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ScanConfigRelationship": {
+		Fields: odatasql.Schema{
+			"assetGroupIDs": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"deltaScanEnabled":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"disabled":            odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"id":                  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"maxParallelScanners": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"name":                odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"overlapPolicy":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"priority":            odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"queuedRun":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"revision":            odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"sampling": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScanSampling"},
+			},
+			"scanConfigTemplate": odatasql.FieldMeta{
+				FieldType:            odatasql.RelationshipFieldType,
+				RelationshipSchema:   "ScanConfigTemplate",
+				RelationshipProperty: "id",
+			},
+			"scanFamiliesConfig": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScanFamiliesConfig"},
+			},
+			"scannerInstanceCreationConfig": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScannerInstanceCreationConfig"},
+			},
+			"scannerPlacement": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScannerPlacement"},
+			},
+			"scheduled": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"RuntimeScheduleScanConfig"},
+			},
+			"scope": odatasql.FieldMeta{
+				FieldType: odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{
+					"AwsScanScope",
+					"AzureScanScope",
+					"SSHScanScope",
+					"KubernetesScanScope",
+				},
+				DiscriminatorProperty: "objectType",
+			},
+			"skippedRuns": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"ScanConfigSkippedRun"},
+				},
+			},
+			"timeoutSeconds": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"volumeSizeGuardrail": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"VolumeSizeGuardrail"},
+			},
+		},
+	},
+	"ScanConfigSkippedRun": {
+		Fields: odatasql.Schema{
+			"inProgressScanIDs": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"operationTime": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"reason":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ScanConfigSnapshot": {
+		Fields: odatasql.Schema{
+			"assetGroupIDs": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"deltaScanEnabled":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"disabled":            odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"maxParallelScanners": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"name":                odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"overlapPolicy":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"priority":            odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"sampling": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScanSampling"},
+			},
+			"scanConfigTemplate": odatasql.FieldMeta{
+				FieldType:            odatasql.RelationshipFieldType,
+				RelationshipSchema:   "ScanConfigTemplate",
+				RelationshipProperty: "id",
+			},
+			"scanFamiliesConfig": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScanFamiliesConfig"},
+			},
+			"scannerInstanceCreationConfig": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScannerInstanceCreationConfig"},
+			},
+			"scannerPlacement": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScannerPlacement"},
+			},
+			"scheduled": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"RuntimeScheduleScanConfig"},
+			},
+			"scope": odatasql.FieldMeta{
+				FieldType: odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{
+					"AwsScanScope",
+					"AzureScanScope",
+					"SSHScanScope",
+					"KubernetesScanScope",
+				},
+				DiscriminatorProperty: "objectType",
+			},
+			"timeoutSeconds": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"volumeSizeGuardrail": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"VolumeSizeGuardrail"},
+			},
+		},
+	},
+	"ScanConfigTemplate": {
+		Fields: odatasql.Schema{
+			"deltaScanEnabled":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"description":         odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"id":                  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"maxParallelScanners": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"name":                odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"revision":            odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scanFamiliesConfig": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScanFamiliesConfig"},
+			},
+			"scannerInstanceCreationConfig": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScannerInstanceCreationConfig"},
+			},
+			"scannerPlacement": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScannerPlacement"},
+			},
+			"timeoutSeconds": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"volumeSizeGuardrail": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"VolumeSizeGuardrail"},
+			},
+		},
+	},
+	"ScanConfigTemplateExists": {
+		Fields: odatasql.Schema{
+			"message": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scanConfigTemplate": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScanConfigTemplate"},
+			},
+		},
+	},
+	"ScanConfigTemplateRelationship": {
+		Fields: odatasql.Schema{
+			"deltaScanEnabled":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"description":         odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"id":                  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"maxParallelScanners": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"name":                odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"revision":            odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scanFamiliesConfig": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScanFamiliesConfig"},
+			},
+			"scannerInstanceCreationConfig": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScannerInstanceCreationConfig"},
+			},
+			"scannerPlacement": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScannerPlacement"},
+			},
+			"timeoutSeconds": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"volumeSizeGuardrail": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"VolumeSizeGuardrail"},
+			},
+		},
+	},
+	"ScanConfigTemplates": {
+		Fields: odatasql.Schema{
+			"count": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"items": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"ScanConfigTemplate"},
+				},
+			},
+		},
+	},
+	"ScanConfigs": {
+		Fields: odatasql.Schema{
+			"count": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"items": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"ScanConfig"},
+				},
+			},
+		},
+	},
+	"ScanExists": {
+		Fields: odatasql.Schema{
+			"message": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scan": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"Scan"},
+			},
+		},
+	},
+	"ScanFamiliesConfig": {
+		Fields: odatasql.Schema{
+			"certificates": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"CertificatesConfig"},
+			},
+			"compliance": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ComplianceConfig"},
+			},
+			"excludedPaths": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"exploits": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ExploitsConfig"},
+			},
+			"malware": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"MalwareConfig"},
+			},
+			"misconfigurations": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"MisconfigurationsConfig"},
+			},
+			"plugins": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"PluginsConfig"},
+			},
+			"rootkits": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"RootkitsConfig"},
+			},
+			"sbom": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"SBOMConfig"},
+			},
+			"secrets": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"SecretsConfig"},
+			},
+			"vulnerabilities": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"VulnerabilitiesConfig"},
+			},
+		},
+	},
+	"ScanFamilyProgress": {
+		Fields: odatasql.Schema{
+			"assetsDone":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"assetsStarted": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"assetsTotal":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"errors":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"family":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"percent":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ScanFindingsSummary": {
+		Fields: odatasql.Schema{
+			"totalCertificates":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"totalComplianceChecks":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"totalExploits":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"totalMalware":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"totalMisconfigurations": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"totalPackages":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"totalPluginFindings":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"totalRootkits":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"totalSecrets":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"totalVulnerabilities": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"VulnerabilityScanSummary"},
+			},
+		},
+	},
+	"ScanPlan": {
+		Fields: odatasql.Schema{
+			"assets": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"ScanPlanAsset"},
+				},
+			},
+			"estimatedSnapshots":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"quotaExceededReason": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"regions": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+		},
+	},
+	"ScanPlanAsset": {
+		Fields: odatasql.Schema{
+			"assetID":            odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"assetType":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"estimatedSnapshots": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"location":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ScanProgress": {
+		Fields: odatasql.Schema{
+			"assetsDone":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"assetsTotal": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"families": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"ScanFamilyProgress"},
+				},
+			},
+			"percent": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ScanRelationship": {
+		Fields: odatasql.Schema{
+			"assetIDs": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"endTime":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"id":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"revision": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scanConfig": odatasql.FieldMeta{
+				FieldType:            odatasql.RelationshipFieldType,
+				RelationshipSchema:   "ScanConfig",
+				RelationshipProperty: "id",
+			},
+			"scanConfigSnapshot": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScanConfigSnapshot"},
+			},
+			"startTime":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"state":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"stateMessage": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"stateReason":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"summary": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScanSummary"},
+			},
+		},
+	},
+	"ScanResultArtifact": {
+		Fields: odatasql.Schema{
+			"contentType": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"family":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"key":         odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"size":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"uploadTime":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ScanResultDiff": {
+		Fields: odatasql.Schema{
+			"baseScanResultID":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"compareScanResultID": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"packages": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"PackagesDiff"},
+			},
+			"secrets": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"SecretsDiff"},
+			},
+			"vulnerabilities": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"VulnerabilitiesDiff"},
+			},
+		},
+	},
+	"ScanResultItems": {
+		Fields: odatasql.Schema{
+			"count": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"items": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"offset": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ScanResultRetention": {
+		Fields: odatasql.Schema{
+			"archiveKey":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"tier":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"transitionTime": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ScanResultRetentionPolicy": {
+		Fields: odatasql.Schema{
+			"archiveAfterDays":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"summaryOnlyAfterDays": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ScanResultTruncation": {
+		Fields: odatasql.Schema{
+			"count":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"family": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"limit":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ScanSampling": {
+		Fields: odatasql.Schema{
+			"percentage": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ScanSamplingCoverage": {
+		Fields: odatasql.Schema{
+			"assetsCovered": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"assetsMatched": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"assetsSampled": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"rotationRun":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ScanSummary": {
+		Fields: odatasql.Schema{
+			"jobsCompleted":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"jobsLeftToRun":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"totalCertificates":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"totalComplianceChecks":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"totalExploits":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"totalMalware":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"totalMisconfigurations": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"totalPackages":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"totalPluginFindings":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"totalRootkits":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"totalSecrets":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"totalVulnerabilities": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"VulnerabilityScanSummary"},
+			},
+		},
+	},
+	"ScannerAttribution": {
+		Fields: odatasql.Schema{
+			"confidence":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scannerName": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"severity":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ScannerConfig": {
+		Fields: odatasql.Schema{
+			"config":         odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"token":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"tokenExpiresAt": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"updatedAt":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ScannerInstanceCreationConfig": {
+		Fields: odatasql.Schema{
+			"maxPrice":         odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"retryMaxAttempts": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"useSpotInstances": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ScannerInstanceImage": {
+		Fields: odatasql.Schema{
+			"channel":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"reference": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"version":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ScannerMetadata": {
+		Fields: odatasql.Schema{
+			"scannerName": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scannerSummary": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ScannerSummary"},
+			},
+		},
+	},
+	"ScannerPlacement": {
+		Fields: odatasql.Schema{
+			"avoidTargetSubnet": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"aws": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"AwsScannerPlacement"},
+			},
+			"azure": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"AzureScannerPlacement"},
+			},
+			"spreadAcrossZones": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ScannerPlugin": {
+		Fields: odatasql.Schema{
+			"args": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"command":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"config":         odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"name":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"timeoutSeconds": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ScannerSummary": {
+		Fields: odatasql.Schema{
+			"DataRead":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"DataScanned":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"EngineVersion":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"InfectedFiles":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"KnownViruses":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"ScannedDirectories": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"ScannedFiles":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"SuspectedFiles":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"TimeTaken":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"ScannerSuppression": {
+		Fields: odatasql.Schema{
+			"misconfiguration": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"MisconfigurationSkipTest"},
+			},
+			"scanConfigID": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"secret": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"SecretAllowlistEntry"},
+			},
+			"vulnerability": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"VulnerabilityIgnoreRule"},
+			},
+		},
+	},
+	"ScannerSuppressionRequest": {
+		Fields: odatasql.Schema{
+			"dryRun":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"reason":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scanConfigID": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"Scans": {
+		Fields: odatasql.Schema{
+			"count": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"items": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Scan"},
+				},
+			},
+		},
+	},
+	"ScansRetentionPolicy": {
+		Fields: odatasql.Schema{
+			"maxAgeDays":            odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"maxScansPerScanConfig": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"Scopes": {
+		Fields: odatasql.Schema{
+			"scopeInfo": odatasql.FieldMeta{
+				FieldType: odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{
+					"AwsAccountScope",
+					"AwsOrganizationScope",
+					"AzureSubscriptionScope",
+					"SSHHostsScope",
+					"KubernetesClusterScope",
+				},
+				DiscriminatorProperty: "objectType",
+			},
+		},
+	},
+	"Secret": {
+		Fields: odatasql.Schema{
+			"confidence":            odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"credentialFingerprint": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"description":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"endColumn":             odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"endLine":               odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"filePath":              odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"fingerprint":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"startColumn":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"startLine":             odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"SecretAllowlistEntry": {
+		Fields: odatasql.Schema{
+			"audit": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"SuppressionAudit"},
+			},
+			"credentialFingerprint": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"filePath":              odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"SecretChange": {
+		Fields: odatasql.Schema{
+			"base": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"Secret"},
+			},
+			"compare": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"Secret"},
+			},
+		},
+	},
+	"SecretFindingInfo": {
+		Fields: odatasql.Schema{
+			"confidence":            odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"credentialFingerprint": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"description":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"endColumn":             odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"endLine":               odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"filePath":              odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"fingerprint":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"objectType":            odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"occurrences": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"SecretOccurrence"},
+				},
+			},
+			"occurrencesCount": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"startColumn":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"startLine":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"SecretOccurrence": {
+		Fields: odatasql.Schema{
+			"endColumn":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"endLine":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"filePath":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"startColumn": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"startLine":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"SecretScan": {
+		Fields: odatasql.Schema{
+			"secrets": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Secret"},
+				},
+			},
+		},
+	},
+	"SecretsConfig": {
+		Fields: odatasql.Schema{
+			"allowlist": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"SecretAllowlistEntry"},
+				},
+			},
+			"enabled": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"redact":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"SecretsDiff": {
+		Fields: odatasql.Schema{
+			"added": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Secret"},
+				},
+			},
+			"changed": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"SecretChange"},
+				},
+			},
+			"removed": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Secret"},
+				},
+			},
+		},
+	},
+	"SecurityGroup": {
+		Fields: odatasql.Schema{
+			"id":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"name": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"rules": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"SecurityGroupRule"},
+				},
+			},
+		},
+	},
+	"SecurityGroupRule": {
+		Fields: odatasql.Schema{
+			"direction": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"fromPort":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"protocol":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"sources": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"toPort": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"SuccessResponse": {
+		Fields: odatasql.Schema{
+			"message": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"SuppressionAudit": {
+		Fields: odatasql.Schema{
+			"createdAt": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"createdBy": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"findingID": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"reason":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"Tag": {
+		Fields: odatasql.Schema{
+			"key":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"value": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"TenantUsage": {
+		Fields: odatasql.Schema{
+			"assetScansThisMonth":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"assets":                        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scannerInstanceHoursThisMonth": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"tenant":                        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"UpgradePlan": {
+		Fields: odatasql.Schema{
+			"upgrades": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"PackageUpgrade"},
+				},
+			},
+		},
+	},
+	"Usage": {
+		Fields: odatasql.Schema{
+			"databaseSizeBytes": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"limits": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"UsageLimits"},
+			},
+			"objectCounts": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"ObjectCounts"},
+			},
+			"scannerInstanceHoursThisMonth": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scansThisMonth":                odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"tenants": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"TenantUsage"},
+				},
+			},
+		},
+	},
+	"UsageLimits": {
+		Fields: odatasql.Schema{
+			"maxScannerInstanceHoursPerMonth": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"maxScansPerMonth":                odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"UserPreferences": {
+		Fields: odatasql.Schema{
+			"dashboardLayout": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"defaultSeverityFilter": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"defaultTimeWindow": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"updatedAt":         odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"VMImageInfo": {
+		Fields: odatasql.Schema{
+			"commonFindingsCount":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"guidance":             odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"image":                odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"instanceCount":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"instanceProvider":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"objectType":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scannedInstanceCount": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"VMInfo": {
+		Fields: odatasql.Schema{
+			"accountID":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"image":            odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"instanceID":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"instanceProvider": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"instanceType":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"launchTime":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"location":         odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"locationMetadata": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"LocationMetadata"},
+			},
+			"objectType": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"platform":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"rootVolume": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"RootVolume"},
+			},
+			"securityGroups": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"SecurityGroup"},
+				},
+			},
+			"tags": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Tag"},
+				},
+			},
+		},
+	},
+	"VolumeSizeGuardrail": {
+		Fields: odatasql.Schema{
+			"maxVolumeSizeGB": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"optInTag": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"Tag"},
+			},
+		},
+	},
+	"VulnerabilitiesConfig": {
+		Fields: odatasql.Schema{
+			"enabled": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"ignoreRules": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"VulnerabilityIgnoreRule"},
+				},
+			},
+			"scanners": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+		},
+	},
+	"VulnerabilitiesDiff": {
+		Fields: odatasql.Schema{
+			"added": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Vulnerability"},
+				},
+			},
+			"changed": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"VulnerabilityChange"},
+				},
+			},
+			"removed": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Vulnerability"},
+				},
+			},
+		},
+	},
+	"Vulnerability": {
+		Fields: odatasql.Schema{
+			"cvss": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"VulnerabilityCvss"},
+				},
+			},
+			"description": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"distro": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"VulnerabilityDistro"},
+			},
+			"fix": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"VulnerabilityFix"},
+			},
+			"layerId": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"links": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"package": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"Package"},
+			},
+			"path": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scanners": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"ScannerAttribution"},
+				},
+			},
+			"severity":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"vulnerabilityName": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"VulnerabilityChange": {
+		Fields: odatasql.Schema{
+			"base": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"Vulnerability"},
+			},
+			"compare": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"Vulnerability"},
+			},
+		},
+	},
+	"VulnerabilityCvss": {
+		Fields: odatasql.Schema{
+			"metrics": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"VulnerabilityCvssMetrics"},
+			},
+			"vector":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"version": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"VulnerabilityCvssMetrics": {
+		Fields: odatasql.Schema{
+			"baseScore":           odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"exploitabilityScore": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"impactScore":         odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"VulnerabilityDistro": {
+		Fields: odatasql.Schema{
+			"IDLike": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"name":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"version": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"VulnerabilityFindingInfo": {
+		Fields: odatasql.Schema{
+			"cvss": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"VulnerabilityCvss"},
+				},
+			},
+			"description": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"distro": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"VulnerabilityDistro"},
+			},
+			"epssPercentile": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"epssScore":      odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"fix": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"VulnerabilityFix"},
+			},
+			"fixVersion":              odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"hasFix":                  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"knownExploited":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"knownExploitedDateAdded": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"layerId":                 odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"links": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+			"objectType": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"package": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"Package"},
+			},
+			"path": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"scanners": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"ScannerAttribution"},
+				},
+			},
+			"severity":          odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"vulnerabilityName": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"VulnerabilityFix": {
+		Fields: odatasql.Schema{
+			"state": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"versions": odatasql.FieldMeta{
+				FieldType:          odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			},
+		},
+	},
+	"VulnerabilityIgnoreRule": {
+		Fields: odatasql.Schema{
+			"audit": odatasql.FieldMeta{
+				FieldType:           odatasql.ComplexFieldType,
+				ComplexFieldSchemas: []string{"SuppressionAudit"},
+			},
+			"packageName":    odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"packageVersion": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"vulnerability":  odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+	"VulnerabilityScan": {
+		Fields: odatasql.Schema{
+			"vulnerabilities": odatasql.FieldMeta{
+				FieldType: odatasql.CollectionFieldType,
+				CollectionItemMeta: &odatasql.FieldMeta{
+					FieldType:           odatasql.ComplexFieldType,
+					ComplexFieldSchemas: []string{"Vulnerability"},
+				},
+			},
+		},
+	},
+	"VulnerabilityScanSummary": {
+		Fields: odatasql.Schema{
+			"totalCriticalVulnerabilities":   odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"totalHighVulnerabilities":       odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"totalLowVulnerabilities":        odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"totalMediumVulnerabilities":     odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+			"totalNegligibleVulnerabilities": odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType},
+		},
+	},
+}
@@ -0,0 +1,86 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/openclarity/vmclarity/backend/pkg/database/odatasql"
+)
+
+func TestSchemaMetas(t *testing.T) {
+	for name := range schemaTables {
+		if _, ok := generatedSchemaMetas[name]; !ok {
+			t.Errorf("table schema %s is not a schema of the API", name)
+		}
+	}
+
+	for name := range schemaStoredFields {
+		if _, ok := generatedSchemaMetas[name]; !ok {
+			t.Errorf("stored fields schema %s is not a schema of the API", name)
+		}
+	}
+
+	for name, searchFields := range schemaSearchFields {
+		for _, searchField := range searchFields {
+			if !hasFieldPath(name, strings.Split(searchField, "/")) {
+				t.Errorf("search field %s of schema %s is not a field of the schema", searchField, name)
+			}
+		}
+	}
+
+	for name, meta := range schemaMetas {
+		for field, fieldMeta := range meta.Fields {
+			for _, schema := range referencedSchemas(fieldMeta) {
+				if _, ok := schemaMetas[schema]; !ok {
+					t.Errorf("field %s of schema %s references unknown schema %s", field, name, schema)
+				}
+			}
+		}
+	}
+}
+
+// hasFieldPath returns whether the path is a field of the schema, or of one
+// of the schemas of its complex fields.
+func hasFieldPath(schema string, path []string) bool {
+	fieldMeta, ok := schemaMetas[schema].Fields[path[0]]
+	if !ok {
+		return false
+	}
+	if len(path) == 1 {
+		return true
+	}
+	for _, fieldSchema := range referencedSchemas(fieldMeta) {
+		if hasFieldPath(fieldSchema, path[1:]) {
+			return true
+		}
+	}
+	return false
+}
+
+func referencedSchemas(fieldMeta odatasql.FieldMeta) []string {
+	switch fieldMeta.FieldType {
+	case odatasql.CollectionFieldType:
+		return referencedSchemas(*fieldMeta.CollectionItemMeta)
+	case odatasql.ComplexFieldType:
+		return fieldMeta.ComplexFieldSchemas
+	case odatasql.RelationshipFieldType:
+		return []string{fieldMeta.RelationshipSchema}
+	default:
+		return nil
+	}
+}
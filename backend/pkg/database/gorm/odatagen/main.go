@@ -0,0 +1,321 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// odatagen generates the odatasql schema metas of the object schemas of the
+// OpenAPI spec, so that every field of the API models can be filtered,
+// selected and expanded without maintaining the metas by hand.
+//
+// The fields are mapped by their schema in the spec:
+//   - strings, numbers, booleans, enums and free-form objects are primitive
+//     fields,
+//   - arrays are collection fields of the meta of their items,
+//   - references to the <Schema>Relationship schemas are relationships to
+//     <Schema> by its id,
+//   - references to other object schemas are complex fields of that schema,
+//   - anyOf and oneOf unions are complex fields of their member schemas, with
+//     the property and the values of their discriminator.
+//
+// The tables and the search fields of the schemas stored in the database
+// aren't part of the spec, they are added to the generated metas by the gorm
+// package.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	schemaRefPrefix    = "#/components/schemas/"
+	relationshipSuffix = "Relationship"
+)
+
+type discriminator struct {
+	PropertyName string            `yaml:"propertyName"`
+	Mapping      map[string]string `yaml:"mapping"`
+}
+
+type schema struct {
+	Ref           string             `yaml:"$ref"`
+	Type          string             `yaml:"type"`
+	Properties    map[string]*schema `yaml:"properties"`
+	Items         *schema            `yaml:"items"`
+	AllOf         []*schema          `yaml:"allOf"`
+	AnyOf         []*schema          `yaml:"anyOf"`
+	OneOf         []*schema          `yaml:"oneOf"`
+	Discriminator *discriminator     `yaml:"discriminator"`
+}
+
+type spec struct {
+	Components struct {
+		Schemas map[string]*schema `yaml:"schemas"`
+	} `yaml:"components"`
+}
+
+type generator struct {
+	schemas map[string]*schema
+	buf     bytes.Buffer
+}
+
+func main() {
+	specPath := flag.String("spec", "", "path of the OpenAPI spec")
+	outPath := flag.String("out", "", "path of the generated Go file")
+	pkg := flag.String("package", "gorm", "package of the generated Go file")
+	flag.Parse()
+
+	if err := run(*specPath, *outPath, *pkg); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(specPath, outPath, pkg string) error {
+	if specPath == "" || outPath == "" {
+		return errors.New("-spec and -out are required")
+	}
+
+	data, err := os.ReadFile(specPath)
+	if err != nil {
+		return fmt.Errorf("failed to read spec: %w", err)
+	}
+	var s spec
+	if err = yaml.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("failed to parse spec: %w", err)
+	}
+
+	g := &generator{schemas: s.Components.Schemas}
+	if err = g.generate(pkg); err != nil {
+		return err
+	}
+
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to format generated code: %w", err)
+	}
+	if err = os.WriteFile(outPath, src, 0o600); err != nil { // nolint:gomnd
+		return fmt.Errorf("failed to write generated code: %w", err)
+	}
+
+	return nil
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func (g *generator) generate(pkg string) error {
+	g.printf("// Code generated by odatagen. DO NOT EDIT.\n\n")
+	g.printf("package %s\n\n", pkg)
+	g.printf("import \"github.com/openclarity/vmclarity/backend/pkg/database/odatasql\"\n\n")
+	g.printf("// generatedSchemaMetas are the schema metas of the object schemas of the API.\n")
+	g.printf("var generatedSchemaMetas = map[string]odatasql.SchemaMeta{\n")
+
+	for _, name := range sortedKeys(g.schemas) {
+		properties, err := g.properties(g.schemas[name])
+		if err != nil {
+			return fmt.Errorf("schema %s: %w", name, err)
+		}
+		if properties == nil {
+			continue
+		}
+
+		g.printf("%q: {\nFields: odatasql.Schema{\n", name)
+		for _, property := range sortedKeys(properties) {
+			g.printf("%q: ", property)
+			if err := g.fieldMeta(properties[property]); err != nil {
+				return fmt.Errorf("schema %s property %s: %w", name, property, err)
+			}
+			g.printf(",\n")
+		}
+		g.printf("},\n},\n")
+	}
+
+	g.printf("}\n")
+
+	return nil
+}
+
+// properties returns the properties of an object schema, merging the ones of
+// the parts of allOf. It returns nil if the schema isn't an object schema with
+// properties.
+func (g *generator) properties(s *schema) (map[string]*schema, error) {
+	if s.Ref != "" {
+		target, _, err := g.resolve(s.Ref)
+		if err != nil {
+			return nil, err
+		}
+		return g.properties(target)
+	}
+
+	if len(s.AllOf) == 0 {
+		return s.Properties, nil
+	}
+
+	properties := make(map[string]*schema)
+	for _, part := range s.AllOf {
+		partProperties, err := g.properties(part)
+		if err != nil {
+			return nil, err
+		}
+		for name, property := range partProperties {
+			properties[name] = property
+		}
+	}
+	for name, property := range s.Properties {
+		properties[name] = property
+	}
+	if len(properties) == 0 {
+		return nil, nil
+	}
+
+	return properties, nil
+}
+
+func (g *generator) resolve(ref string) (*schema, string, error) {
+	name, ok := strings.CutPrefix(ref, schemaRefPrefix)
+	if !ok {
+		return nil, "", fmt.Errorf("unsupported reference %q", ref)
+	}
+	s, ok := g.schemas[name]
+	if !ok {
+		return nil, "", fmt.Errorf("unknown schema %q", name)
+	}
+	return s, name, nil
+}
+
+// nolint:cyclop
+func (g *generator) fieldMeta(s *schema) error {
+	if len(s.AnyOf) > 0 || len(s.OneOf) > 0 {
+		return g.unionFieldMeta(s)
+	}
+
+	if s.Ref != "" {
+		target, name, err := g.resolve(s.Ref)
+		if err != nil {
+			return err
+		}
+
+		if base, ok := strings.CutSuffix(name, relationshipSuffix); ok {
+			if _, ok := g.schemas[base]; ok {
+				g.printf("odatasql.FieldMeta{\nFieldType: odatasql.RelationshipFieldType,\nRelationshipSchema: %q,\nRelationshipProperty: \"id\",\n}", base)
+				return nil
+			}
+		}
+
+		if len(target.AnyOf) > 0 || len(target.OneOf) > 0 {
+			return g.unionFieldMeta(target)
+		}
+
+		properties, err := g.properties(target)
+		if err != nil {
+			return err
+		}
+		if properties != nil {
+			g.printf("odatasql.FieldMeta{\nFieldType: odatasql.ComplexFieldType,\nComplexFieldSchemas: []string{%q},\n}", name)
+			return nil
+		}
+
+		return g.fieldMeta(&schema{Type: target.Type, Items: target.Items})
+	}
+
+	if s.Type == "array" {
+		if s.Items == nil {
+			return errors.New("array without items")
+		}
+		g.printf("odatasql.FieldMeta{\nFieldType: odatasql.CollectionFieldType,\nCollectionItemMeta: &")
+		if err := g.fieldMeta(s.Items); err != nil {
+			return err
+		}
+		g.printf(",\n}")
+		return nil
+	}
+
+	if len(s.AllOf) > 0 || len(s.Properties) > 0 {
+		return errors.New("inline object schemas are not supported, define them in the components")
+	}
+
+	g.printf("odatasql.FieldMeta{FieldType: odatasql.PrimitiveFieldType}")
+	return nil
+}
+
+func (g *generator) unionFieldMeta(s *schema) error {
+	members := s.AnyOf
+	if len(members) == 0 {
+		members = s.OneOf
+	}
+
+	names := make([]string, 0, len(members))
+	for _, member := range members {
+		if member.Ref == "" {
+			return errors.New("inline union member schemas are not supported, define them in the components")
+		}
+		_, name, err := g.resolve(member.Ref)
+		if err != nil {
+			return err
+		}
+		names = append(names, name)
+	}
+
+	g.printf("odatasql.FieldMeta{\nFieldType: odatasql.ComplexFieldType,\nComplexFieldSchemas: []string{\n")
+	for _, name := range names {
+		g.printf("%q,\n", name)
+	}
+	g.printf("},\n")
+
+	if s.Discriminator != nil {
+		g.printf("DiscriminatorProperty: %q,\n", s.Discriminator.PropertyName)
+
+		// The discriminator value of a schema is its name unless it is
+		// mapped to another value.
+		mapping := make(map[string]string)
+		for value, ref := range s.Discriminator.Mapping {
+			_, name, err := g.resolve(ref)
+			if err != nil {
+				return err
+			}
+			if name != value {
+				mapping[name] = value
+			}
+		}
+		if len(mapping) > 0 {
+			g.printf("DiscriminatorSchemaMapping: map[string]string{\n")
+			for _, name := range sortedKeys(mapping) {
+				g.printf("%q: %q,\n", name, mapping[name])
+			}
+			g.printf("},\n")
+		}
+	}
+
+	g.printf("}")
+
+	return nil
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,42 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGeneratedSchemaMetasUpToDate(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "odata_schemas.gen.go")
+	if err := run("../../../../../api/openapi.yaml", out, "gorm"); err != nil {
+		t.Fatalf("run() error = %v", err)
+	}
+
+	want, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatalf("failed to read generated code: %v", err)
+	}
+	got, err := os.ReadFile("../odata_schemas.gen.go")
+	if err != nil {
+		t.Fatalf("failed to read committed code: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("odata_schemas.gen.go is out of date with the API spec, run go generate in backend/pkg/database/gorm")
+	}
+}
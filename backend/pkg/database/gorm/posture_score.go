@@ -0,0 +1,99 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+type PostureScore struct {
+	ODataObject
+}
+
+type PostureScoresTableHandler struct {
+	DB *gorm.DB
+}
+
+func (db *Handler) PostureScoresTable() types.PostureScoresTable {
+	return &PostureScoresTableHandler{
+		DB: db.DB,
+	}
+}
+
+func (p *PostureScoresTableHandler) GetPostureScores(params models.GetPostureScoresParams) (models.PostureScores, error) {
+	var dbPostureScores []PostureScore
+	err := ODataQuery(p.DB, "PostureScore", params.Filter, nil, params.Select, nil, params.OrderBy, params.Top, params.Skip, true, &dbPostureScores)
+	if err != nil {
+		return models.PostureScores{}, err
+	}
+
+	items := []models.PostureScore{}
+	for _, dbPostureScore := range dbPostureScores {
+		var postureScore models.PostureScore
+		if err := json.Unmarshal(dbPostureScore.Data, &postureScore); err != nil {
+			return models.PostureScores{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+		}
+		items = append(items, postureScore)
+	}
+
+	output := models.PostureScores{Items: &items}
+
+	if params.Count != nil && *params.Count {
+		count, err := ODataCount(p.DB, "PostureScore", params.Filter, nil)
+		if err != nil {
+			return models.PostureScores{}, fmt.Errorf("failed to count records: %w", err)
+		}
+		output.Count = &count
+	}
+
+	return output, nil
+}
+
+// CreatePostureScores creates the scores of a computation in a single
+// transaction, so that the readers see the scores of all the teams or none.
+func (p *PostureScoresTableHandler) CreatePostureScores(postureScores []models.PostureScore) error {
+	if len(postureScores) == 0 {
+		return nil
+	}
+
+	dbPostureScores := make([]PostureScore, 0, len(postureScores))
+	for _, postureScore := range postureScores {
+		postureScore.Id = utils.PointerTo(uuid.New().String())
+
+		marshaled, err := json.Marshal(postureScore)
+		if err != nil {
+			return fmt.Errorf("failed to convert API model to DB model: %w", err)
+		}
+
+		dbPostureScore := PostureScore{}
+		dbPostureScore.Data = marshaled
+		dbPostureScores = append(dbPostureScores, dbPostureScore)
+	}
+
+	if err := p.DB.Create(&dbPostureScores).Error; err != nil {
+		return fmt.Errorf("failed to create posture scores in db: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+type ProviderOperation struct {
+	ODataObject
+}
+
+type ProviderOperationsTableHandler struct {
+	DB *gorm.DB
+}
+
+func (db *Handler) ProviderOperationsTable() types.ProviderOperationsTable {
+	return &ProviderOperationsTableHandler{
+		DB: db.DB,
+	}
+}
+
+func (p *ProviderOperationsTableHandler) GetProviderOperations(params models.GetProviderOperationsParams) (models.ProviderOperations, error) {
+	var providerOperations []ProviderOperation
+	err := ODataQuery(p.DB, "ProviderOperation", params.Filter, nil, params.Select, params.Expand, params.OrderBy, params.Top, params.Skip, true, &providerOperations)
+	if err != nil {
+		return models.ProviderOperations{}, err
+	}
+
+	items := []models.ProviderOperation{}
+	for _, providerOperation := range providerOperations {
+		var po models.ProviderOperation
+		err := json.Unmarshal(providerOperation.Data, &po)
+		if err != nil {
+			return models.ProviderOperations{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+		}
+		items = append(items, po)
+	}
+
+	output := models.ProviderOperations{Items: &items}
+
+	if params.Count != nil && *params.Count {
+		count, err := ODataCount(p.DB, "ProviderOperation", params.Filter, nil)
+		if err != nil {
+			return models.ProviderOperations{}, fmt.Errorf("failed to count records: %w", err)
+		}
+		output.Count = &count
+	}
+
+	return output, nil
+}
+
+func (p *ProviderOperationsTableHandler) GetProviderOperation(providerOperationID models.ProviderOperationID, params models.GetProviderOperationsProviderOperationIDParams) (models.ProviderOperation, error) {
+	var dbProviderOperation ProviderOperation
+	filter := fmt.Sprintf("id eq '%s'", providerOperationID)
+	err := ODataQuery(p.DB, "ProviderOperation", &filter, nil, params.Select, params.Expand, nil, nil, nil, false, &dbProviderOperation)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.ProviderOperation{}, types.ErrNotFound
+		}
+		return models.ProviderOperation{}, err
+	}
+
+	var po models.ProviderOperation
+	err = json.Unmarshal(dbProviderOperation.Data, &po)
+	if err != nil {
+		return models.ProviderOperation{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	return po, nil
+}
+
+func (p *ProviderOperationsTableHandler) CreateProviderOperation(providerOperation models.ProviderOperation) (models.ProviderOperation, error) {
+	// Check the user didn't provide an ID
+	if providerOperation.Id != nil {
+		return models.ProviderOperation{}, &common.BadRequestError{
+			Reason: "can not specify id field when creating a new ProviderOperation",
+		}
+	}
+
+	if err := validateProviderOperation(providerOperation); err != nil {
+		return models.ProviderOperation{}, err
+	}
+
+	// Generate a new UUID
+	providerOperation.Id = utils.PointerTo(uuid.New().String())
+
+	marshaled, err := json.Marshal(providerOperation)
+	if err != nil {
+		return models.ProviderOperation{}, fmt.Errorf("failed to convert API model to DB model: %w", err)
+	}
+
+	newProviderOperation := ProviderOperation{}
+	newProviderOperation.Data = marshaled
+
+	if err := p.DB.Create(&newProviderOperation).Error; err != nil {
+		return models.ProviderOperation{}, fmt.Errorf("failed to create provider operation in db: %w", err)
+	}
+
+	return providerOperation, nil
+}
+
+func validateProviderOperation(providerOperation models.ProviderOperation) error {
+	if providerOperation.Provider == "" {
+		return &common.BadRequestError{
+			Reason: "provider must be provided",
+		}
+	}
+
+	if providerOperation.Operation == "" {
+		return &common.BadRequestError{
+			Reason: "operation must be provided",
+		}
+	}
+
+	switch providerOperation.Status {
+	case models.Successful, models.Unsuccessful:
+	default:
+		return &common.BadRequestError{
+			Reason: fmt.Sprintf("unsupported status %q", providerOperation.Status),
+		}
+	}
+
+	if providerOperation.StartTime.IsZero() {
+		return &common.BadRequestError{
+			Reason: "startTime must be provided",
+		}
+	}
+
+	if providerOperation.EndTime != nil && providerOperation.EndTime.Before(providerOperation.StartTime) {
+		return &common.BadRequestError{
+			Reason: "endTime can not be before startTime",
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func Test_validateProviderOperation(t *testing.T) {
+	startTime := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	valid := func() models.ProviderOperation {
+		return models.ProviderOperation{
+			Provider:  models.AWS,
+			Operation: models.SnapshotCreate,
+			StartTime: startTime,
+			EndTime:   utils.PointerTo(startTime.Add(time.Second)),
+			Status:    models.Successful,
+		}
+	}
+	tests := []struct {
+		name    string
+		mutate  func(po *models.ProviderOperation)
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			mutate:  func(po *models.ProviderOperation) {},
+			wantErr: false,
+		},
+		{
+			name:    "not finished",
+			mutate:  func(po *models.ProviderOperation) { po.EndTime = nil },
+			wantErr: false,
+		},
+		{
+			name:    "missing provider",
+			mutate:  func(po *models.ProviderOperation) { po.Provider = "" },
+			wantErr: true,
+		},
+		{
+			name:    "missing operation",
+			mutate:  func(po *models.ProviderOperation) { po.Operation = "" },
+			wantErr: true,
+		},
+		{
+			name:    "unknown status",
+			mutate:  func(po *models.ProviderOperation) { po.Status = "Pending" },
+			wantErr: true,
+		},
+		{
+			name:    "missing start time",
+			mutate:  func(po *models.ProviderOperation) { po.StartTime = time.Time{} },
+			wantErr: true,
+		},
+		{
+			name:    "end time before start time",
+			mutate:  func(po *models.ProviderOperation) { po.EndTime = utils.PointerTo(startTime.Add(-time.Second)) },
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			po := valid()
+			tt.mutate(&po)
+			if err := validateProviderOperation(po); (err != nil) != tt.wantErr {
+				t.Errorf("validateProviderOperation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
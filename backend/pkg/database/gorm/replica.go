@@ -0,0 +1,190 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// DefaultReplicaMaxLag is the replication lag above which the reads
+	// are routed to the primary if the maximum lag of the read replica is
+	// not configured.
+	DefaultReplicaMaxLag = 5 * time.Second
+
+	// replicaLagInterval is how often the replication lag is measured.
+	replicaLagInterval = time.Second
+
+	// replicaLagTimeout is the deadline of measuring the replication lag,
+	// the lag is unknown if the replica doesn't answer in time.
+	replicaLagTimeout = 2 * time.Second
+
+	// replicaReadOnlyKey is the setting of the sessions whose queries are
+	// read-only and may be routed to the read replica.
+	replicaReadOnlyKey = "replica:read_only"
+)
+
+// replicaLagQuery measures the replication lag of a Postgres hot-standby in
+// seconds. A replica which replayed all the WAL it received has no lag, even
+// if the primary had no transactions recently.
+const replicaLagQuery = `SELECT CASE
+	WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+	ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+END`
+
+var errReplicaLagUnknown = errors.New("replication lag is not measured yet")
+
+// replicaRouter routes the queries of the read-only sessions, e.g. serving
+// the GET requests of the API, to a read replica, and everything else, the
+// reads of the writes included, to the primary. The replication lag is
+// measured before routing to the replica: the queries go to the primary if
+// the lag is above the maximum, or it is unknown, or if this process wrote
+// more recently than the lag, so that reading an object back after creating
+// or updating it returns the new version. The lag is measured in the
+// background, so that the queries are not held up by a slow replica.
+type replicaRouter struct {
+	replica gorm.ConnPool
+	maxLag  time.Duration
+	// measureLag returns the current replication lag of the replica.
+	measureLag func(ctx context.Context) (time.Duration, error)
+	// lastWrite is the Unix time in nanoseconds of the last write.
+	lastWrite atomic.Int64
+
+	mu         sync.Mutex
+	lag        time.Duration
+	lagErr     error
+	measuredAt time.Time
+	// measuring is true while the lag is measured in the background.
+	measuring bool
+}
+
+// readOnly returns a session of db whose queries are read-only, they are
+// routed to the read replica if there is one.
+func readOnly(db *gorm.DB) *gorm.DB {
+	return db.Set(replicaReadOnlyKey, true).Session(&gorm.Session{})
+}
+
+// useReadReplica registers the callbacks which route the queries of the
+// read-only sessions of db to replica.
+func useReadReplica(db *gorm.DB, replica gorm.ConnPool, maxLag time.Duration, measureLag func(ctx context.Context) (time.Duration, error)) error {
+	router := &replicaRouter{
+		replica:    replica,
+		maxLag:     maxLag,
+		measureLag: measureLag,
+		lagErr:     errReplicaLagUnknown,
+	}
+	// The lag is known from the start if the replica answers in time.
+	router.updateLag()
+
+	callbacks := db.Callback()
+	if err := callbacks.Query().Before("gorm:query").Register("replica:route", router.route); err != nil {
+		return fmt.Errorf("failed to register query callback: %w", err)
+	}
+	if err := callbacks.Row().Before("gorm:row").Register("replica:route", router.route); err != nil {
+		return fmt.Errorf("failed to register row callback: %w", err)
+	}
+	if err := callbacks.Create().After("gorm:create").Register("replica:record_write", router.recordWrite); err != nil {
+		return fmt.Errorf("failed to register create callback: %w", err)
+	}
+	if err := callbacks.Update().After("gorm:update").Register("replica:record_write", router.recordWrite); err != nil {
+		return fmt.Errorf("failed to register update callback: %w", err)
+	}
+	if err := callbacks.Delete().After("gorm:delete").Register("replica:record_write", router.recordWrite); err != nil {
+		return fmt.Errorf("failed to register delete callback: %w", err)
+	}
+	if err := callbacks.Raw().After("gorm:raw").Register("replica:record_write", router.recordWrite); err != nil {
+		return fmt.Errorf("failed to register raw callback: %w", err)
+	}
+
+	return nil
+}
+
+func (r *replicaRouter) route(db *gorm.DB) {
+	if db.Error != nil {
+		return
+	}
+	if readOnly, ok := db.Get(replicaReadOnlyKey); !ok || readOnly != true {
+		return
+	}
+	// The queries of a transaction must run on its connection to the
+	// primary.
+	if _, ok := db.Statement.ConnPool.(gorm.TxCommitter); ok {
+		return
+	}
+
+	lag, err := r.replicationLag()
+	if err != nil || lag > r.maxLag {
+		return
+	}
+	if time.Since(time.Unix(0, r.lastWrite.Load())) < lag {
+		return
+	}
+	db.Statement.ConnPool = r.replica
+}
+
+// replicationLag returns the last measured replication lag of the replica,
+// and starts measuring it again in the background if the measurement is
+// older than replicaLagInterval.
+func (r *replicaRouter) replicationLag() (time.Duration, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.measuring && time.Since(r.measuredAt) >= replicaLagInterval {
+		r.measuring = true
+		go r.updateLag()
+	}
+
+	return r.lag, r.lagErr
+}
+
+// updateLag measures the replication lag without holding the lock, the
+// measurement fails if it takes longer than replicaLagTimeout.
+func (r *replicaRouter) updateLag() {
+	ctx, cancel := context.WithTimeout(context.Background(), replicaLagTimeout)
+	defer cancel()
+
+	lag, err := r.measureLag(ctx)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lag, r.lagErr = lag, err
+	r.measuredAt = time.Now()
+	r.measuring = false
+}
+
+func (r *replicaRouter) recordWrite(*gorm.DB) {
+	r.lastWrite.Store(time.Now().UnixNano())
+}
+
+// postgresReplicaLag returns the function measuring the replication lag of
+// the Postgres hot-standby replica.
+func postgresReplicaLag(replica *gorm.DB) func(ctx context.Context) (time.Duration, error) {
+	return func(ctx context.Context) (time.Duration, error) {
+		var seconds float64
+		if err := replica.WithContext(ctx).Raw(replicaLagQuery).Scan(&seconds).Error; err != nil {
+			return 0, fmt.Errorf("failed to measure replication lag: %w", err)
+		}
+		return time.Duration(seconds * float64(time.Second)), nil
+	}
+}
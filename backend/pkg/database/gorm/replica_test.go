@@ -0,0 +1,186 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func openReplicaTestDB(t *testing.T, name string) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), name)), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open %s: %v", name, err)
+	}
+	if err := db.AutoMigrate(Setting{}); err != nil {
+		t.Fatalf("failed to migrate %s: %v", name, err)
+	}
+	return db
+}
+
+func Test_useReadReplica(t *testing.T) {
+	errMeasure := errors.New("replica is down")
+	tests := []struct {
+		name          string
+		lag           time.Duration
+		lagErr        error
+		writeFirst    bool
+		readOnly      bool
+		inTransaction bool
+		wantReplica   bool
+	}{
+		{
+			name:        "read-only query of an up to date replica is routed to the replica",
+			readOnly:    true,
+			wantReplica: true,
+		},
+		{
+			name:        "read-only query of a lagging replica without a recent write is routed to the replica",
+			lag:         time.Second,
+			readOnly:    true,
+			wantReplica: true,
+		},
+		{
+			name:        "query of a session which isn't read-only is routed to the primary",
+			readOnly:    false,
+			wantReplica: false,
+		},
+		{
+			name:        "read-only query after a write within the lag is routed to the primary",
+			lag:         time.Second,
+			writeFirst:  true,
+			readOnly:    true,
+			wantReplica: false,
+		},
+		{
+			name:        "read-only query of a replica lagging more than the maximum is routed to the primary",
+			lag:         time.Hour,
+			readOnly:    true,
+			wantReplica: false,
+		},
+		{
+			name:        "read-only query of a replica whose lag is unknown is routed to the primary",
+			lagErr:      errMeasure,
+			readOnly:    true,
+			wantReplica: false,
+		},
+		{
+			name:          "read-only query in a transaction is routed to the primary",
+			readOnly:      true,
+			inTransaction: true,
+			wantReplica:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primary := openReplicaTestDB(t, "primary.sqlite")
+			// The setting is only created in the primary, so not
+			// finding it shows the query was routed to the replica.
+			replica := openReplicaTestDB(t, "replica.sqlite")
+			if err := primary.Create(&Setting{Name: "test", Data: []byte("{}")}).Error; err != nil {
+				t.Fatalf("failed to create setting: %v", err)
+			}
+
+			measureLag := func(context.Context) (time.Duration, error) {
+				return tt.lag, tt.lagErr
+			}
+			if err := useReadReplica(primary, replica.ConnPool, time.Minute, measureLag); err != nil {
+				t.Fatalf("useReadReplica() error = %v", err)
+			}
+
+			if tt.writeFirst {
+				if err := primary.Create(&Setting{Name: "other", Data: []byte("{}")}).Error; err != nil {
+					t.Fatalf("failed to create setting: %v", err)
+				}
+			}
+
+			db := primary
+			if tt.readOnly {
+				db = readOnly(primary)
+			}
+			var count int64
+			countSettings := func(db *gorm.DB) error {
+				return db.Model(&Setting{}).Where("name = ?", "test").Count(&count).Error
+			}
+			var err error
+			if tt.inTransaction {
+				err = db.Transaction(countSettings)
+			} else {
+				err = countSettings(db)
+			}
+			if err != nil {
+				t.Fatalf("failed to count settings: %v", err)
+			}
+			if replicaUsed := count == 0; replicaUsed != tt.wantReplica {
+				t.Errorf("query routed to replica = %v, want %v", replicaUsed, tt.wantReplica)
+			}
+		})
+	}
+}
+
+func Test_replicaRouter_replicationLag(t *testing.T) {
+	release := make(chan struct{})
+	measured := make(chan struct{}, 2)
+	router := &replicaRouter{
+		measureLag: func(ctx context.Context) (time.Duration, error) {
+			measured <- struct{}{}
+			select {
+			case <-release:
+				return time.Second, nil
+			case <-ctx.Done():
+				return 0, ctx.Err()
+			}
+		},
+		lagErr: errReplicaLagUnknown,
+	}
+
+	// The lag is unknown while the replica doesn't answer, and the
+	// queries don't wait for it.
+	for i := 0; i < 2; i++ {
+		if _, err := router.replicationLag(); !errors.Is(err, errReplicaLagUnknown) {
+			t.Fatalf("replicationLag() error = %v, want %v", err, errReplicaLagUnknown)
+		}
+	}
+	<-measured
+	close(release)
+
+	deadline := time.Now().Add(replicaLagTimeout)
+	for {
+		lag, err := router.replicationLag()
+		if err == nil {
+			if lag != time.Second {
+				t.Errorf("replicationLag() = %v, want %v", lag, time.Second)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("replicationLag() error = %v, want lag to be measured", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// The lag is measured once at a time.
+	if len(measured) != 0 {
+		t.Errorf("lag measured %d more times, want 0", len(measured))
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/mail"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/report"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+type ReportSchedule struct {
+	ODataObject
+}
+
+type ReportSchedulesTableHandler struct {
+	DB *gorm.DB
+}
+
+func (db *Handler) ReportSchedulesTable() types.ReportSchedulesTable {
+	return &ReportSchedulesTableHandler{
+		DB: db.DB,
+	}
+}
+
+func (r *ReportSchedulesTableHandler) GetReportSchedules(params models.GetReportSchedulesParams) (models.ReportSchedules, error) {
+	var reportSchedules []ReportSchedule
+	err := ODataQuery(r.DB, "ReportSchedule", params.Filter, nil, params.Select, nil, params.OrderBy, params.Top, params.Skip, true, &reportSchedules)
+	if err != nil {
+		return models.ReportSchedules{}, err
+	}
+
+	items := []models.ReportSchedule{}
+	for _, reportSchedule := range reportSchedules {
+		var rs models.ReportSchedule
+		err := json.Unmarshal(reportSchedule.Data, &rs)
+		if err != nil {
+			return models.ReportSchedules{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+		}
+		items = append(items, rs)
+	}
+
+	output := models.ReportSchedules{Items: &items}
+
+	if params.Count != nil && *params.Count {
+		count, err := ODataCount(r.DB, "ReportSchedule", params.Filter, nil)
+		if err != nil {
+			return models.ReportSchedules{}, fmt.Errorf("failed to count records: %w", err)
+		}
+		output.Count = &count
+	}
+
+	return output, nil
+}
+
+func (r *ReportSchedulesTableHandler) GetReportSchedule(reportScheduleID models.ReportScheduleID, params models.GetReportSchedulesReportScheduleIDParams) (models.ReportSchedule, error) {
+	var dbReportSchedule ReportSchedule
+	filter := fmt.Sprintf("id eq '%s'", reportScheduleID)
+	err := ODataQuery(r.DB, "ReportSchedule", &filter, nil, params.Select, nil, nil, nil, nil, false, &dbReportSchedule)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.ReportSchedule{}, types.ErrNotFound
+		}
+		return models.ReportSchedule{}, err
+	}
+
+	var rs models.ReportSchedule
+	err = json.Unmarshal(dbReportSchedule.Data, &rs)
+	if err != nil {
+		return models.ReportSchedule{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	return rs, nil
+}
+
+func (r *ReportSchedulesTableHandler) CreateReportSchedule(reportSchedule models.ReportSchedule) (models.ReportSchedule, error) {
+	// Check the user didn't provide an ID
+	if reportSchedule.Id != nil {
+		return models.ReportSchedule{}, &common.BadRequestError{
+			Reason: "can not specify id field when creating a new ReportSchedule",
+		}
+	}
+
+	if reportSchedule.ReportType == nil {
+		reportSchedule.ReportType = utils.PointerTo(models.ExecutiveSummary)
+	}
+
+	if err := validateReportSchedule(reportSchedule); err != nil {
+		return models.ReportSchedule{}, err
+	}
+
+	if reportSchedule.NextDeliveryTime == nil {
+		next, err := report.NextDeliveryTime(*reportSchedule.CronLine, utils.ValueOrZero(reportSchedule.Timezone), time.Now())
+		if err != nil {
+			return models.ReportSchedule{}, &common.BadRequestError{Reason: err.Error()}
+		}
+		reportSchedule.NextDeliveryTime = &next
+	}
+
+	// Generate a new UUID
+	reportSchedule.Id = utils.PointerTo(uuid.New().String())
+
+	// Initialise revision
+	reportSchedule.Revision = utils.PointerTo(1)
+
+	marshaled, err := json.Marshal(reportSchedule)
+	if err != nil {
+		return models.ReportSchedule{}, fmt.Errorf("failed to convert API model to DB model: %w", err)
+	}
+
+	newReportSchedule := ReportSchedule{}
+	newReportSchedule.Data = marshaled
+
+	if err := r.DB.Create(&newReportSchedule).Error; err != nil {
+		return models.ReportSchedule{}, fmt.Errorf("failed to create report schedule in db: %w", err)
+	}
+
+	return reportSchedule, nil
+}
+
+func (r *ReportSchedulesTableHandler) UpdateReportSchedule(reportSchedule models.ReportSchedule, params models.PatchReportSchedulesReportScheduleIDParams) (models.ReportSchedule, error) {
+	if reportSchedule.Id == nil || *reportSchedule.Id == "" {
+		return models.ReportSchedule{}, &common.BadRequestError{
+			Reason: "id is required to update report schedule",
+		}
+	}
+
+	var dbObj ReportSchedule
+	if err := getExistingObjByID(r.DB, "ReportSchedule", *reportSchedule.Id, &dbObj); err != nil {
+		return models.ReportSchedule{}, fmt.Errorf("failed to get report schedule from db: %w", err)
+	}
+
+	var dbReportSchedule models.ReportSchedule
+	err := json.Unmarshal(dbObj.Data, &dbReportSchedule)
+	if err != nil {
+		return models.ReportSchedule{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	if err := checkRevisionEtag(params.IfMatch, dbReportSchedule.Revision); err != nil {
+		return models.ReportSchedule{}, err
+	}
+
+	reportSchedule.Revision = bumpRevision(dbReportSchedule.Revision)
+
+	dbObj.Data, err = patchObject(dbObj.Data, reportSchedule)
+	if err != nil {
+		return models.ReportSchedule{}, fmt.Errorf("failed to apply patch: %w", err)
+	}
+
+	var rs models.ReportSchedule
+	err = json.Unmarshal(dbObj.Data, &rs)
+	if err != nil {
+		return models.ReportSchedule{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	if err := validateReportSchedule(rs); err != nil {
+		return models.ReportSchedule{}, err
+	}
+
+	// Changing the schedule, or enabling it again, starts it over from now
+	// unless the patch sets the next delivery itself.
+	if reportSchedule.NextDeliveryTime == nil &&
+		(reportSchedule.CronLine != nil || reportSchedule.Timezone != nil || reportSchedule.Disabled != nil) {
+		next, err := report.NextDeliveryTime(*rs.CronLine, utils.ValueOrZero(rs.Timezone), time.Now())
+		if err != nil {
+			return models.ReportSchedule{}, &common.BadRequestError{Reason: err.Error()}
+		}
+		rs.NextDeliveryTime = &next
+
+		dbObj.Data, err = json.Marshal(rs)
+		if err != nil {
+			return models.ReportSchedule{}, fmt.Errorf("failed to convert API model to DB model: %w", err)
+		}
+	}
+
+	if err := r.DB.Save(&dbObj).Error; err != nil {
+		return models.ReportSchedule{}, fmt.Errorf("failed to save report schedule in db: %w", err)
+	}
+
+	return rs, nil
+}
+
+func (r *ReportSchedulesTableHandler) DeleteReportSchedule(reportScheduleID models.ReportScheduleID) error {
+	if err := deleteObjByID(r.DB, reportScheduleID, &ReportSchedule{}); err != nil {
+		return fmt.Errorf("failed to delete report schedule: %w", err)
+	}
+	return nil
+}
+
+func validateReportSchedule(reportSchedule models.ReportSchedule) error {
+	if reportSchedule.Name == nil || *reportSchedule.Name == "" {
+		return &common.BadRequestError{
+			Reason: "name must be provided and can not be empty",
+		}
+	}
+
+	if reportSchedule.ReportType == nil || *reportSchedule.ReportType != models.ExecutiveSummary {
+		return &common.BadRequestError{
+			Reason: fmt.Sprintf("unsupported report type %v", utils.ValueOrZero(reportSchedule.ReportType)),
+		}
+	}
+
+	if reportSchedule.Recipients == nil || len(*reportSchedule.Recipients) == 0 {
+		return &common.BadRequestError{
+			Reason: "at least one recipient must be provided",
+		}
+	}
+	for _, recipient := range *reportSchedule.Recipients {
+		if _, err := mail.ParseAddress(recipient); err != nil {
+			return &common.BadRequestError{
+				Reason: fmt.Sprintf("invalid recipient %q: %v", recipient, err),
+			}
+		}
+	}
+
+	if reportSchedule.CronLine == nil {
+		return &common.BadRequestError{
+			Reason: "cronLine must be provided",
+		}
+	}
+	if _, err := report.NextDeliveryTime(*reportSchedule.CronLine, utils.ValueOrZero(reportSchedule.Timezone), time.Now()); err != nil {
+		return &common.BadRequestError{
+			Reason: fmt.Sprintf("invalid schedule: %v", err),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"testing"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func Test_validateReportSchedule(t *testing.T) {
+	valid := func() models.ReportSchedule {
+		return models.ReportSchedule{
+			Name:       utils.PointerTo("weekly"),
+			ReportType: utils.PointerTo(models.ExecutiveSummary),
+			Recipients: &[]string{"ciso@example.com", "Security Team <security@example.com>"},
+			CronLine:   utils.PointerTo("0 8 * * 1"),
+			Timezone:   utils.PointerTo("America/New_York"),
+		}
+	}
+	tests := []struct {
+		name    string
+		mutate  func(rs *models.ReportSchedule)
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			mutate:  func(rs *models.ReportSchedule) {},
+			wantErr: false,
+		},
+		{
+			name:    "default timezone",
+			mutate:  func(rs *models.ReportSchedule) { rs.Timezone = nil },
+			wantErr: false,
+		},
+		{
+			name:    "missing name",
+			mutate:  func(rs *models.ReportSchedule) { rs.Name = utils.PointerTo("") },
+			wantErr: true,
+		},
+		{
+			name:    "no recipients",
+			mutate:  func(rs *models.ReportSchedule) { rs.Recipients = &[]string{} },
+			wantErr: true,
+		},
+		{
+			name:    "invalid recipient",
+			mutate:  func(rs *models.ReportSchedule) { rs.Recipients = &[]string{"not an address"} },
+			wantErr: true,
+		},
+		{
+			name:    "missing cron line",
+			mutate:  func(rs *models.ReportSchedule) { rs.CronLine = nil },
+			wantErr: true,
+		},
+		{
+			name:    "malformed cron line",
+			mutate:  func(rs *models.ReportSchedule) { rs.CronLine = utils.PointerTo("weekly") },
+			wantErr: true,
+		},
+		{
+			name:    "unknown timezone",
+			mutate:  func(rs *models.ReportSchedule) { rs.Timezone = utils.PointerTo("Nowhere/Town") },
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs := valid()
+			tt.mutate(&rs)
+			if err := validateReportSchedule(rs); (err != nil) != tt.wantErr {
+				t.Errorf("validateReportSchedule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
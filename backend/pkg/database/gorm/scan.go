@@ -49,7 +49,7 @@ func (db *Handler) ScansTable() types.ScansTable {
 
 func (s *ScansTableHandler) GetScans(params models.GetScansParams) (models.Scans, error) {
 	var scans []Scan
-	err := ODataQuery(s.DB, scanSchemaName, params.Filter, params.Select, params.Expand, params.OrderBy, params.Top, params.Skip, true, &scans)
+	err := ODataQuery(s.DB, scanSchemaName, params.Filter, params.Search, params.Select, params.Expand, params.OrderBy, params.Top, params.Skip, true, &scans)
 	if err != nil {
 		return models.Scans{}, err
 	}
@@ -67,7 +67,7 @@ func (s *ScansTableHandler) GetScans(params models.GetScansParams) (models.Scans
 	output := models.Scans{Items: &items}
 
 	if params.Count != nil && *params.Count {
-		count, err := ODataCount(s.DB, scanSchemaName, params.Filter)
+		count, err := ODataCount(s.DB, scanSchemaName, params.Filter, params.Search)
 		if err != nil {
 			return models.Scans{}, fmt.Errorf("failed to count records: %w", err)
 		}
@@ -77,10 +77,20 @@ func (s *ScansTableHandler) GetScans(params models.GetScansParams) (models.Scans
 	return output, nil
 }
 
+func (s *ScansTableHandler) StreamScans(params models.GetScansParams, fn func(models.Scan) error) error {
+	return ODataStream(s.DB, scanSchemaName, params.Filter, params.Search, params.Select, params.Expand, params.OrderBy, params.Top, params.Skip, func(data []byte) error {
+		var scan models.Scan
+		if err := json.Unmarshal(data, &scan); err != nil {
+			return fmt.Errorf("failed to convert DB model to API model: %w", err)
+		}
+		return fn(scan)
+	})
+}
+
 func (s *ScansTableHandler) GetScan(scanID models.ScanID, params models.GetScansScanIDParams) (models.Scan, error) {
 	var dbScan Scan
 	filter := fmt.Sprintf("id eq '%s'", scanID)
-	err := ODataQuery(s.DB, scanSchemaName, &filter, params.Select, params.Expand, nil, nil, nil, false, &dbScan)
+	err := ODataQuery(s.DB, scanSchemaName, &filter, nil, params.Select, params.Expand, nil, nil, nil, false, &dbScan)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return models.Scan{}, types.ErrNotFound
@@ -174,6 +184,10 @@ func (s *ScansTableHandler) SaveScan(scan models.Scan, params models.PutScansSca
 		return models.Scan{}, fmt.Errorf("scan config id validation failed: %w", err)
 	}
 
+	if err := validateScanStateTransition(scan, dbScan); err != nil {
+		return models.Scan{}, err
+	}
+
 	if scan.ScanConfig != nil {
 		existingScan, err := s.checkUniqueness(scan)
 		if err != nil {
@@ -236,6 +250,10 @@ func (s *ScansTableHandler) UpdateScan(scan models.Scan, params models.PatchScan
 		return models.Scan{}, fmt.Errorf("scan config id validation failed: %w", err)
 	}
 
+	if err := validateScanStateTransition(scan, dbScan); err != nil {
+		return models.Scan{}, err
+	}
+
 	scan.Revision = bumpRevision(dbScan.Revision)
 
 	var err error
@@ -280,7 +298,7 @@ func (s *ScansTableHandler) checkUniqueness(scan models.Scan) (models.Scan, erro
 	var scans []Scan
 	// In the case of creating or updating a scan, needs to be checked whether other running scan exists with same scan config id.
 	filter := fmt.Sprintf("id ne '%s' and scanConfig/id eq '%s' and endTime eq null", *scan.Id, scan.ScanConfig.Id)
-	err := ODataQuery(s.DB, scanSchemaName, &filter, nil, nil, nil, nil, nil, true, &scans)
+	err := ODataQuery(s.DB, scanSchemaName, &filter, nil, nil, nil, nil, nil, nil, true, &scans)
 	if err != nil {
 		return models.Scan{}, err
 	}
@@ -318,3 +336,22 @@ func validateScanConfigID(scan models.Scan, dbScan models.Scan) error {
 	}
 	return nil
 }
+
+// Scans can only be aborted while they are not finished yet.
+func validateScanStateTransition(scan models.Scan, dbScan models.Scan) error {
+	if scan.State == nil || *scan.State != models.ScanStateAborted {
+		return nil
+	}
+
+	state := utils.ValueOrZero(dbScan.State)
+	switch state {
+	case models.ScanStateDone, models.ScanStateFailed:
+		return &common.BadRequestError{
+			Reason: fmt.Sprintf("not allowed to abort scan in state %s", state),
+		}
+	case models.ScanStatePending, models.ScanStateDiscovered, models.ScanStateInProgress, models.ScanStateAborted:
+		fallthrough
+	default:
+		return nil
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/backend/pkg/common"
 	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/report"
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
@@ -47,7 +48,7 @@ func (db *Handler) ScanConfigsTable() types.ScanConfigsTable {
 
 func (s *ScanConfigsTableHandler) GetScanConfigs(params models.GetScanConfigsParams) (models.ScanConfigs, error) {
 	var scanConfigs []ScanConfig
-	err := ODataQuery(s.DB, "ScanConfig", params.Filter, params.Select, params.Expand, params.OrderBy, params.Top, params.Skip, true, &scanConfigs)
+	err := ODataQuery(s.DB, "ScanConfig", params.Filter, params.Search, params.Select, params.Expand, params.OrderBy, params.Top, params.Skip, true, &scanConfigs)
 	if err != nil {
 		return models.ScanConfigs{}, err
 	}
@@ -65,7 +66,7 @@ func (s *ScanConfigsTableHandler) GetScanConfigs(params models.GetScanConfigsPar
 	output := models.ScanConfigs{Items: &items}
 
 	if params.Count != nil && *params.Count {
-		count, err := ODataCount(s.DB, "ScanConfig", params.Filter)
+		count, err := ODataCount(s.DB, "ScanConfig", params.Filter, params.Search)
 		if err != nil {
 			return models.ScanConfigs{}, fmt.Errorf("failed to count records: %w", err)
 		}
@@ -75,10 +76,20 @@ func (s *ScanConfigsTableHandler) GetScanConfigs(params models.GetScanConfigsPar
 	return output, nil
 }
 
+func (s *ScanConfigsTableHandler) StreamScanConfigs(params models.GetScanConfigsParams, fn func(models.ScanConfig) error) error {
+	return ODataStream(s.DB, "ScanConfig", params.Filter, params.Search, params.Select, params.Expand, params.OrderBy, params.Top, params.Skip, func(data []byte) error {
+		var scanConfig models.ScanConfig
+		if err := json.Unmarshal(data, &scanConfig); err != nil {
+			return fmt.Errorf("failed to convert DB model to API model: %w", err)
+		}
+		return fn(scanConfig)
+	})
+}
+
 func (s *ScanConfigsTableHandler) GetScanConfig(scanConfigID models.ScanConfigID, params models.GetScanConfigsScanConfigIDParams) (models.ScanConfig, error) {
 	var dbScanConfig ScanConfig
 	filter := fmt.Sprintf("id eq '%s'", scanConfigID)
-	err := ODataQuery(s.DB, "ScanConfig", &filter, params.Select, params.Expand, nil, nil, nil, false, &dbScanConfig)
+	err := ODataQuery(s.DB, "ScanConfig", &filter, nil, params.Select, params.Expand, nil, nil, nil, false, &dbScanConfig)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return models.ScanConfig{}, types.ErrNotFound
@@ -117,6 +128,14 @@ func (s *ScanConfigsTableHandler) CreateScanConfig(scanConfig models.ScanConfig)
 		}
 	}
 
+	if err := validateScanConfigTemplateReference(s.DB, scanConfig); err != nil {
+		return models.ScanConfig{}, err
+	}
+
+	if err := validateAssetGroupReferences(s.DB, scanConfig.AssetGroupIDs); err != nil {
+		return models.ScanConfig{}, err
+	}
+
 	// Generate a new UUID
 	scanConfig.Id = utils.PointerTo(uuid.New().String())
 
@@ -179,6 +198,11 @@ func validateRuntimeScheduleScanConfig(scheduled *models.RuntimeScheduleScanConf
 			"at least one should be set")
 	}
 
+	loc, err := report.LoadTimezone(utils.ValueOrZero(scheduled.Timezone))
+	if err != nil {
+		return err
+	}
+
 	if scheduled.CronLine != nil {
 		// validate cron expression
 		expr, err := cronexpr.Parse(*scheduled.CronLine)
@@ -188,7 +212,7 @@ func validateRuntimeScheduleScanConfig(scheduled *models.RuntimeScheduleScanConf
 
 		// set operation time if missing
 		if isEmptyOperationTime(scheduled.OperationTime) {
-			operationTime := expr.Next(time.Now())
+			operationTime := expr.Next(time.Now().In(loc))
 			scheduled.OperationTime = &operationTime
 		}
 	}
@@ -221,6 +245,14 @@ func (s *ScanConfigsTableHandler) SaveScanConfig(scanConfig models.ScanConfig, p
 		}
 	}
 
+	if err := validateScanConfigTemplateReference(s.DB, scanConfig); err != nil {
+		return models.ScanConfig{}, err
+	}
+
+	if err := validateAssetGroupReferences(s.DB, scanConfig.AssetGroupIDs); err != nil {
+		return models.ScanConfig{}, err
+	}
+
 	var dbObj ScanConfig
 	if err := getExistingObjByID(s.DB, "ScanConfig", *scanConfig.Id, &dbObj); err != nil {
 		return models.ScanConfig{}, fmt.Errorf("failed to get scan config from db: %w", err)
@@ -316,6 +348,14 @@ func (s *ScanConfigsTableHandler) UpdateScanConfig(scanConfig models.ScanConfig,
 		return models.ScanConfig{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
 	}
 
+	if err := validateScanConfigTemplateReference(s.DB, sc); err != nil {
+		return models.ScanConfig{}, err
+	}
+
+	if err := validateAssetGroupReferences(s.DB, sc.AssetGroupIDs); err != nil {
+		return models.ScanConfig{}, err
+	}
+
 	// Check the existing DB entries to ensure that the name field is unique
 	existingScanConfig, err := s.checkUniqueness(sc)
 	if err != nil {
@@ -344,7 +384,7 @@ func (s *ScanConfigsTableHandler) checkUniqueness(scanConfig models.ScanConfig)
 	var scanConfigs []ScanConfig
 	// In the case of creating or updating a scan config, needs to be checked whether other scan config exists with same name.
 	filter := fmt.Sprintf("id ne '%s' and name eq '%s'", *scanConfig.Id, *scanConfig.Name)
-	err := ODataQuery(s.DB, "ScanConfig", &filter, nil, nil, nil, nil, nil, true, &scanConfigs)
+	err := ODataQuery(s.DB, "ScanConfig", &filter, nil, nil, nil, nil, nil, nil, true, &scanConfigs)
 	if err != nil {
 		return models.ScanConfig{}, err
 	}
@@ -0,0 +1,267 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+type ScanConfigTemplate struct {
+	ODataObject
+}
+
+type ScanConfigTemplatesTableHandler struct {
+	DB *gorm.DB
+}
+
+func (db *Handler) ScanConfigTemplatesTable() types.ScanConfigTemplatesTable {
+	return &ScanConfigTemplatesTableHandler{
+		DB: db.DB,
+	}
+}
+
+func (s *ScanConfigTemplatesTableHandler) GetScanConfigTemplates(params models.GetScanConfigTemplatesParams) (models.ScanConfigTemplates, error) {
+	var dbTemplates []ScanConfigTemplate
+	err := ODataQuery(s.DB, "ScanConfigTemplate", params.Filter, nil, params.Select, nil, params.OrderBy, params.Top, params.Skip, true, &dbTemplates)
+	if err != nil {
+		return models.ScanConfigTemplates{}, err
+	}
+
+	items := []models.ScanConfigTemplate{}
+	for _, dbTemplate := range dbTemplates {
+		var template models.ScanConfigTemplate
+		if err := json.Unmarshal(dbTemplate.Data, &template); err != nil {
+			return models.ScanConfigTemplates{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+		}
+		items = append(items, template)
+	}
+
+	output := models.ScanConfigTemplates{Items: &items}
+
+	if params.Count != nil && *params.Count {
+		count, err := ODataCount(s.DB, "ScanConfigTemplate", params.Filter, nil)
+		if err != nil {
+			return models.ScanConfigTemplates{}, fmt.Errorf("failed to count records: %w", err)
+		}
+		output.Count = &count
+	}
+
+	return output, nil
+}
+
+func (s *ScanConfigTemplatesTableHandler) GetScanConfigTemplate(templateID models.ScanConfigTemplateID, params models.GetScanConfigTemplatesScanConfigTemplateIDParams) (models.ScanConfigTemplate, error) {
+	var dbTemplate ScanConfigTemplate
+	filter := fmt.Sprintf("id eq '%s'", templateID)
+	err := ODataQuery(s.DB, "ScanConfigTemplate", &filter, nil, params.Select, nil, nil, nil, nil, false, &dbTemplate)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.ScanConfigTemplate{}, types.ErrNotFound
+		}
+		return models.ScanConfigTemplate{}, err
+	}
+
+	var template models.ScanConfigTemplate
+	if err := json.Unmarshal(dbTemplate.Data, &template); err != nil {
+		return models.ScanConfigTemplate{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	return template, nil
+}
+
+func (s *ScanConfigTemplatesTableHandler) CreateScanConfigTemplate(template models.ScanConfigTemplate) (models.ScanConfigTemplate, error) {
+	// Check the user didn't provide an ID
+	if template.Id != nil {
+		return models.ScanConfigTemplate{}, &common.BadRequestError{
+			Reason: "can not specify id field when creating a new ScanConfigTemplate",
+		}
+	}
+
+	if err := validateScanConfigTemplate(template); err != nil {
+		return models.ScanConfigTemplate{}, err
+	}
+
+	// Generate a new UUID
+	template.Id = utils.PointerTo(uuid.New().String())
+
+	// Initialise revision
+	template.Revision = utils.PointerTo(1)
+
+	// Check the existing DB entries to ensure that the name field is unique
+	existingTemplate, err := s.checkUniqueness(template)
+	if err != nil {
+		var conflictErr *common.ConflictError
+		if errors.As(err, &conflictErr) {
+			return existingTemplate, err
+		}
+		return models.ScanConfigTemplate{}, fmt.Errorf("failed to check existing scan config template: %w", err)
+	}
+
+	marshaled, err := json.Marshal(template)
+	if err != nil {
+		return models.ScanConfigTemplate{}, fmt.Errorf("failed to convert API model to DB model: %w", err)
+	}
+
+	newTemplate := ScanConfigTemplate{}
+	newTemplate.Data = marshaled
+
+	if err := s.DB.Create(&newTemplate).Error; err != nil {
+		return models.ScanConfigTemplate{}, fmt.Errorf("failed to create scan config template in db: %w", err)
+	}
+
+	return template, nil
+}
+
+func (s *ScanConfigTemplatesTableHandler) UpdateScanConfigTemplate(template models.ScanConfigTemplate, params models.PatchScanConfigTemplatesScanConfigTemplateIDParams) (models.ScanConfigTemplate, error) {
+	if template.Id == nil || *template.Id == "" {
+		return models.ScanConfigTemplate{}, &common.BadRequestError{
+			Reason: "id is required to update scan config template",
+		}
+	}
+
+	var dbObj ScanConfigTemplate
+	if err := getExistingObjByID(s.DB, "ScanConfigTemplate", *template.Id, &dbObj); err != nil {
+		return models.ScanConfigTemplate{}, fmt.Errorf("failed to get scan config template from db: %w", err)
+	}
+
+	var dbTemplate models.ScanConfigTemplate
+	err := json.Unmarshal(dbObj.Data, &dbTemplate)
+	if err != nil {
+		return models.ScanConfigTemplate{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	if err := checkRevisionEtag(params.IfMatch, dbTemplate.Revision); err != nil {
+		return models.ScanConfigTemplate{}, err
+	}
+
+	template.Revision = bumpRevision(dbTemplate.Revision)
+
+	dbObj.Data, err = patchObject(dbObj.Data, template)
+	if err != nil {
+		return models.ScanConfigTemplate{}, fmt.Errorf("failed to apply patch: %w", err)
+	}
+
+	var sct models.ScanConfigTemplate
+	err = json.Unmarshal(dbObj.Data, &sct)
+	if err != nil {
+		return models.ScanConfigTemplate{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	if err := validateScanConfigTemplate(sct); err != nil {
+		return models.ScanConfigTemplate{}, err
+	}
+
+	// Check the existing DB entries to ensure that the name field is unique
+	existingTemplate, err := s.checkUniqueness(sct)
+	if err != nil {
+		var conflictErr *common.ConflictError
+		if errors.As(err, &conflictErr) {
+			return existingTemplate, err
+		}
+		return models.ScanConfigTemplate{}, fmt.Errorf("failed to check existing scan config template: %w", err)
+	}
+
+	if err := s.DB.Save(&dbObj).Error; err != nil {
+		return models.ScanConfigTemplate{}, fmt.Errorf("failed to save scan config template in db: %w", err)
+	}
+
+	return sct, nil
+}
+
+// DeleteScanConfigTemplate deletes the template unless a scan config still
+// references it.
+func (s *ScanConfigTemplatesTableHandler) DeleteScanConfigTemplate(templateID models.ScanConfigTemplateID) error {
+	var scanConfigs []ScanConfig
+	filter := fmt.Sprintf("scanConfigTemplate/id eq '%s'", templateID)
+	err := ODataQuery(s.DB, "ScanConfig", &filter, nil, nil, nil, nil, utils.PointerTo(1), nil, true, &scanConfigs)
+	if err != nil {
+		return fmt.Errorf("failed to get scan configs referencing the template: %w", err)
+	}
+	if len(scanConfigs) > 0 {
+		return &common.ConflictError{
+			Reason: fmt.Sprintf("scan config template %s is referenced by scan configs", templateID),
+		}
+	}
+
+	if err := deleteObjByID(s.DB, templateID, &ScanConfigTemplate{}); err != nil {
+		return fmt.Errorf("failed to delete scan config template: %w", err)
+	}
+	return nil
+}
+
+func (s *ScanConfigTemplatesTableHandler) checkUniqueness(template models.ScanConfigTemplate) (models.ScanConfigTemplate, error) {
+	var dbTemplates []ScanConfigTemplate
+	filter := fmt.Sprintf("id ne '%s' and name eq '%s'", *template.Id, *template.Name)
+	err := ODataQuery(s.DB, "ScanConfigTemplate", &filter, nil, nil, nil, nil, nil, nil, true, &dbTemplates)
+	if err != nil {
+		return models.ScanConfigTemplate{}, err
+	}
+	if len(dbTemplates) > 0 {
+		var sct models.ScanConfigTemplate
+		if err := json.Unmarshal(dbTemplates[0].Data, &sct); err != nil {
+			return models.ScanConfigTemplate{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+		}
+		return sct, &common.ConflictError{
+			Reason: fmt.Sprintf("Scan config template exists with name=%s", *sct.Name),
+		}
+	}
+	return models.ScanConfigTemplate{}, nil
+}
+
+func validateScanConfigTemplate(template models.ScanConfigTemplate) error {
+	if template.Name == nil || *template.Name == "" {
+		return &common.BadRequestError{
+			Reason: "name must be provided and can not be empty",
+		}
+	}
+
+	if template.ScanFamiliesConfig == nil {
+		return &common.BadRequestError{
+			Reason: "scanFamiliesConfig must be provided",
+		}
+	}
+
+	return nil
+}
+
+// validateScanConfigTemplateReference checks that the template the scan config
+// references exists.
+func validateScanConfigTemplateReference(db *gorm.DB, scanConfig models.ScanConfig) error {
+	if scanConfig.ScanConfigTemplate == nil {
+		return nil
+	}
+
+	var dbTemplate ScanConfigTemplate
+	if err := getExistingObjByID(db, "ScanConfigTemplate", scanConfig.ScanConfigTemplate.Id, &dbTemplate); err != nil {
+		if errors.Is(err, types.ErrNotFound) {
+			return &common.BadRequestError{
+				Reason: fmt.Sprintf("scan config template %s not found", scanConfig.ScanConfigTemplate.Id),
+			}
+		}
+		return fmt.Errorf("failed to get scan config template from db: %w", err)
+	}
+
+	return nil
+}
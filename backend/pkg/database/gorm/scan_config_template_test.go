@@ -0,0 +1,93 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestScanConfigTemplatesTableHandler(t *testing.T) {
+	db, err := NewDatabase(types.DBConfig{
+		DriverType:  types.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("NewDatabase() error = %v", err)
+	}
+
+	if _, err := db.ScanConfigTemplatesTable().CreateScanConfigTemplate(models.ScanConfigTemplate{
+		Name: utils.PointerTo("PCI baseline"),
+	}); err == nil {
+		t.Errorf("CreateScanConfigTemplate() without families error = nil, want an error")
+	}
+
+	template, err := db.ScanConfigTemplatesTable().CreateScanConfigTemplate(models.ScanConfigTemplate{
+		Name: utils.PointerTo("PCI baseline"),
+		ScanFamiliesConfig: &models.ScanFamiliesConfig{
+			Vulnerabilities: &models.VulnerabilitiesConfig{Enabled: utils.PointerTo(true)},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateScanConfigTemplate() error = %v", err)
+	}
+
+	var conflictErr *common.ConflictError
+	if _, err := db.ScanConfigTemplatesTable().CreateScanConfigTemplate(models.ScanConfigTemplate{
+		Name:               utils.PointerTo("PCI baseline"),
+		ScanFamiliesConfig: &models.ScanFamiliesConfig{},
+	}); !errors.As(err, &conflictErr) {
+		t.Errorf("CreateScanConfigTemplate() with the same name error = %v, want a conflict", err)
+	}
+
+	newScanConfig := func(templateID string) models.ScanConfig {
+		return models.ScanConfig{
+			Name: utils.PointerTo("nightly " + templateID),
+			Scheduled: &models.RuntimeScheduleScanConfig{
+				OperationTime: utils.PointerTo(time.Now().Add(time.Hour)),
+			},
+			ScanConfigTemplate: &models.ScanConfigTemplateRelationship{Id: templateID},
+		}
+	}
+
+	var validationErr *common.BadRequestError
+	if _, err := db.ScanConfigsTable().CreateScanConfig(newScanConfig("unknown")); !errors.As(err, &validationErr) {
+		t.Errorf("CreateScanConfig() with an unknown template error = %v, want a validation error", err)
+	}
+
+	scanConfig, err := db.ScanConfigsTable().CreateScanConfig(newScanConfig(*template.Id))
+	if err != nil {
+		t.Fatalf("CreateScanConfig() error = %v", err)
+	}
+
+	if err := db.ScanConfigTemplatesTable().DeleteScanConfigTemplate(*template.Id); !errors.As(err, &conflictErr) {
+		t.Errorf("DeleteScanConfigTemplate() of a referenced template error = %v, want a conflict", err)
+	}
+
+	if err := db.ScanConfigsTable().DeleteScanConfig(*scanConfig.Id); err != nil {
+		t.Fatalf("DeleteScanConfig() error = %v", err)
+	}
+	if err := db.ScanConfigTemplatesTable().DeleteScanConfigTemplate(*template.Id); err != nil {
+		t.Errorf("DeleteScanConfigTemplate() error = %v", err)
+	}
+}
@@ -118,6 +118,26 @@ func Test_validateRuntimeScheduleScanConfig(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "operation time is missing - time zone is set",
+			args: args{
+				scheduled: &models.RuntimeScheduleScanConfig{
+					CronLine: utils.PointerTo("0 8 * * *"),
+					Timezone: utils.PointerTo("Europe/Budapest"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown time zone",
+			args: args{
+				scheduled: &models.RuntimeScheduleScanConfig{
+					CronLine: utils.PointerTo("0 8 * * *"),
+					Timezone: utils.PointerTo("Mars/Olympus"),
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "cron line is missing - do nothing",
 			args: args{
@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 
 	"github.com/openclarity/vmclarity/api/models"
@@ -30,7 +31,7 @@ import (
 )
 
 const (
-	targetScanResultsSchemaName = "TargetScanResult"
+	assetScanResultsSchemaName = "AssetScanResult"
 )
 
 type ScanResult struct {
@@ -38,37 +39,44 @@ type ScanResult struct {
 }
 
 type ScanResultsTableHandler struct {
-	DB *gorm.DB
+	DB     *gorm.DB
+	Limits types.ScanResultLimits
 }
 
 func (db *Handler) ScanResultsTable() types.ScanResultsTable {
 	return &ScanResultsTableHandler{
-		DB: db.DB,
+		DB:     db.DB,
+		Limits: db.ScanResultLimits,
 	}
 }
 
-func (s *ScanResultsTableHandler) GetScanResults(params models.GetScanResultsParams) (models.TargetScanResults, error) {
+func (s *ScanResultsTableHandler) GetScanResults(params models.GetScanResultsParams) (models.AssetScanResults, error) {
 	var scanResults []ScanResult
-	err := ODataQuery(s.DB, targetScanResultsSchemaName, params.Filter, params.Select, params.Expand, params.OrderBy, params.Top, params.Skip, true, &scanResults)
+	err := ODataQuery(s.DB, assetScanResultsSchemaName, params.Filter, nil, blobSelect(params.Select), params.Expand, params.OrderBy, params.Top, params.Skip, true, &scanResults)
 	if err != nil {
-		return models.TargetScanResults{}, err
+		return models.AssetScanResults{}, err
 	}
 
-	items := make([]models.TargetScanResult, len(scanResults))
+	blobs := make(map[string]datatypes.JSON)
+	items := make([]models.AssetScanResult, len(scanResults))
 	for i, scanResult := range scanResults {
-		var tsr models.TargetScanResult
-		if err = json.Unmarshal(scanResult.Data, &tsr); err != nil {
-			return models.TargetScanResults{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+		data, err := s.materialize(scanResult.Data, blobs)
+		if err != nil {
+			return models.AssetScanResults{}, err
+		}
+		var tsr models.AssetScanResult
+		if err = json.Unmarshal(data, &tsr); err != nil {
+			return models.AssetScanResults{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
 		}
 		items[i] = tsr
 	}
 
-	output := models.TargetScanResults{Items: &items}
+	output := models.AssetScanResults{Items: &items}
 
 	if params.Count != nil && *params.Count {
-		count, err := ODataCount(s.DB, targetScanResultsSchemaName, params.Filter)
+		count, err := ODataCount(s.DB, assetScanResultsSchemaName, params.Filter, nil)
 		if err != nil {
-			return models.TargetScanResults{}, fmt.Errorf("failed to count records: %w", err)
+			return models.AssetScanResults{}, fmt.Errorf("failed to count records: %w", err)
 		}
 		output.Count = &count
 	}
@@ -76,47 +84,71 @@ func (s *ScanResultsTableHandler) GetScanResults(params models.GetScanResultsPar
 	return output, nil
 }
 
-func (s *ScanResultsTableHandler) GetScanResult(scanResultID models.ScanResultID, params models.GetScanResultsScanResultIDParams) (models.TargetScanResult, error) {
+func (s *ScanResultsTableHandler) StreamScanResults(params models.GetScanResultsParams, fn func(models.AssetScanResult) error) error {
+	blobs := make(map[string]datatypes.JSON)
+	return ODataStream(s.DB, assetScanResultsSchemaName, params.Filter, nil, blobSelect(params.Select), params.Expand, params.OrderBy, params.Top, params.Skip, func(data []byte) error {
+		data, err := s.materialize(data, blobs)
+		if err != nil {
+			return err
+		}
+		var scanResult models.AssetScanResult
+		if err := json.Unmarshal(data, &scanResult); err != nil {
+			return fmt.Errorf("failed to convert DB model to API model: %w", err)
+		}
+		return fn(scanResult)
+	})
+}
+
+func (s *ScanResultsTableHandler) GetScanResult(scanResultID models.ScanResultID, params models.GetScanResultsScanResultIDParams) (models.AssetScanResult, error) {
 	var dbScanResult ScanResult
 	filter := fmt.Sprintf("id eq '%s'", scanResultID)
-	err := ODataQuery(s.DB, targetScanResultsSchemaName, &filter, params.Select, params.Expand, nil, nil, nil, false, &dbScanResult)
+	err := ODataQuery(s.DB, assetScanResultsSchemaName, &filter, nil, blobSelect(params.Select), params.Expand, nil, nil, nil, false, &dbScanResult)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return models.TargetScanResult{}, types.ErrNotFound
+			return models.AssetScanResult{}, types.ErrNotFound
 		}
-		return models.TargetScanResult{}, err
+		return models.AssetScanResult{}, err
+	}
+
+	data, err := s.materialize(dbScanResult.Data, make(map[string]datatypes.JSON))
+	if err != nil {
+		return models.AssetScanResult{}, err
 	}
 
-	var tsr models.TargetScanResult
-	err = json.Unmarshal(dbScanResult.Data, &tsr)
+	var tsr models.AssetScanResult
+	err = json.Unmarshal(data, &tsr)
 	if err != nil {
-		return models.TargetScanResult{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+		return models.AssetScanResult{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
 	}
 
 	return tsr, nil
 }
 
 // nolint:cyclop
-func (s *ScanResultsTableHandler) CreateScanResult(scanResult models.TargetScanResult) (models.TargetScanResult, error) {
+func (s *ScanResultsTableHandler) CreateScanResult(scanResult models.AssetScanResult) (models.AssetScanResult, error) {
 	// Check the user provided scan id and target id fields
 	if scanResult.Scan != nil && scanResult.Scan.Id == "" {
-		return models.TargetScanResult{}, &common.BadRequestError{
+		return models.AssetScanResult{}, &common.BadRequestError{
 			Reason: "scan.id is a required field",
 		}
 	}
-	if scanResult.Target != nil && scanResult.Target.Id == "" {
-		return models.TargetScanResult{}, &common.BadRequestError{
+	if scanResult.Asset != nil && scanResult.Asset.Id == "" {
+		return models.AssetScanResult{}, &common.BadRequestError{
 			Reason: "target.id is a required field",
 		}
 	}
 
 	// Check the user didn't provide an ID
 	if scanResult.Id != nil {
-		return models.TargetScanResult{}, &common.BadRequestError{
+		return models.AssetScanResult{}, &common.BadRequestError{
 			Reason: "can not specify id field when creating a new ScanResult",
 		}
 	}
 
+	if err := validateAnnotations(scanResult.Annotations); err != nil {
+		return models.AssetScanResult{}, err
+	}
+
 	// Generate a new UUID
 	scanResult.Id = utils.PointerTo(uuid.New().String())
 
@@ -142,49 +174,66 @@ func (s *ScanResultsTableHandler) CreateScanResult(scanResult models.TargetScanR
 		if errors.As(err, &conflictErr) {
 			return existingScanResult, err
 		}
-		return models.TargetScanResult{}, fmt.Errorf("failed to check existing scan: %w", err)
+		return models.AssetScanResult{}, fmt.Errorf("failed to check existing scan: %w", err)
 	}
 
 	marshaled, err := json.Marshal(scanResult)
 	if err != nil {
-		return models.TargetScanResult{}, fmt.Errorf("failed to convert API model to DB model: %w", err)
+		return models.AssetScanResult{}, fmt.Errorf("failed to convert API model to DB model: %w", err)
+	}
+
+	marshaled, err = truncateResults(marshaled, s.Limits)
+	if err != nil {
+		return models.AssetScanResult{}, fmt.Errorf("failed to truncate scan result: %w", err)
+	}
+
+	stored, blobs, err := extractBlobs(marshaled)
+	if err != nil {
+		return models.AssetScanResult{}, fmt.Errorf("failed to extract scan result blobs: %w", err)
 	}
 
 	newScanResult := ScanResult{}
-	newScanResult.Data = marshaled
+	newScanResult.Data = stored
 
-	if err := s.DB.Create(&newScanResult).Error; err != nil {
-		return models.TargetScanResult{}, fmt.Errorf("failed to create scan result in db: %w", err)
+	err = s.DB.Transaction(func(tx *gorm.DB) error {
+		if err := retainBlobs(tx, blobs); err != nil {
+			return err
+		}
+		return tx.Create(&newScanResult).Error
+	})
+	if err != nil {
+		return models.AssetScanResult{}, fmt.Errorf("failed to create scan result in db: %w", err)
 	}
 
-	// TODO(sambetts) Maybe this isn't required now because the DB isn't
-	// creating any of the data (like the ID) so we can just return the
-	// scanResult pre-marshal above.
-	var tsr models.TargetScanResult
-	err = json.Unmarshal(newScanResult.Data, &tsr)
+	var tsr models.AssetScanResult
+	err = json.Unmarshal(marshaled, &tsr)
 	if err != nil {
-		return models.TargetScanResult{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+		return models.AssetScanResult{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
 	}
 
 	return tsr, nil
 }
 
 // nolint:cyclop,gocognit
-func (s *ScanResultsTableHandler) SaveScanResult(scanResult models.TargetScanResult, params models.PutScanResultsScanResultIDParams) (models.TargetScanResult, error) {
+func (s *ScanResultsTableHandler) SaveScanResult(scanResult models.AssetScanResult, params models.PutScanResultsScanResultIDParams) (models.AssetScanResult, error) {
 	if scanResult.Id == nil || *scanResult.Id == "" {
-		return models.TargetScanResult{}, &common.BadRequestError{
+		return models.AssetScanResult{}, &common.BadRequestError{
 			Reason: "id is required to save scan result",
 		}
 	}
 
+	if err := validateAnnotations(scanResult.Annotations); err != nil {
+		return models.AssetScanResult{}, err
+	}
+
 	// Check the user provided scan id and target id fields
 	if scanResult.Scan != nil && scanResult.Scan.Id == "" {
-		return models.TargetScanResult{}, &common.BadRequestError{
+		return models.AssetScanResult{}, &common.BadRequestError{
 			Reason: "scan.id is a required field",
 		}
 	}
-	if scanResult.Target != nil && scanResult.Target.Id == "" {
-		return models.TargetScanResult{}, &common.BadRequestError{
+	if scanResult.Asset != nil && scanResult.Asset.Id == "" {
+		return models.AssetScanResult{}, &common.BadRequestError{
 			Reason: "target.id is a required field",
 		}
 	}
@@ -196,84 +245,103 @@ func (s *ScanResultsTableHandler) SaveScanResult(scanResult models.TargetScanRes
 		if errors.As(err, &conflictErr) {
 			return existingScanResult, err
 		}
-		return models.TargetScanResult{}, fmt.Errorf("failed to check existing scan: %w", err)
+		return models.AssetScanResult{}, fmt.Errorf("failed to check existing scan: %w", err)
 	}
 
 	var dbObj ScanResult
-	if err := getExistingObjByID(s.DB, targetScanResultsSchemaName, *scanResult.Id, &dbObj); err != nil {
-		return models.TargetScanResult{}, err
+	if err := getExistingObjByID(s.DB, assetScanResultsSchemaName, *scanResult.Id, &dbObj); err != nil {
+		return models.AssetScanResult{}, err
 	}
 
-	var dbScanResult models.TargetScanResult
+	var dbScanResult models.AssetScanResult
 	err = json.Unmarshal(dbObj.Data, &dbScanResult)
 	if err != nil {
-		return models.TargetScanResult{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+		return models.AssetScanResult{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
 	}
 
 	if err := checkRevisionEtag(params.IfMatch, dbScanResult.Revision); err != nil {
-		return models.TargetScanResult{}, err
+		return models.AssetScanResult{}, err
 	}
 
 	scanResult.Revision = bumpRevision(dbScanResult.Revision)
 
 	marshaled, err := json.Marshal(scanResult)
 	if err != nil {
-		return models.TargetScanResult{}, fmt.Errorf("failed to convert API model to DB model: %w", err)
+		return models.AssetScanResult{}, fmt.Errorf("failed to convert API model to DB model: %w", err)
 	}
 
-	dbObj.Data = marshaled
+	marshaled, err = truncateResults(marshaled, s.Limits)
+	if err != nil {
+		return models.AssetScanResult{}, fmt.Errorf("failed to truncate scan result: %w", err)
+	}
 
-	if err := s.DB.Save(&dbObj).Error; err != nil {
-		return models.TargetScanResult{}, fmt.Errorf("failed to save scan result in db: %w", err)
+	if err := s.saveWithBlobs(&dbObj, marshaled); err != nil {
+		return models.AssetScanResult{}, err
 	}
 
-	// TODO(sambetts) Maybe this isn't required now because the DB isn't
-	// creating any of the data (like the ID) so we can just return the
-	// scanResult pre-marshal above.
-	var tsr models.TargetScanResult
-	err = json.Unmarshal(dbObj.Data, &tsr)
+	var tsr models.AssetScanResult
+	err = json.Unmarshal(marshaled, &tsr)
 	if err != nil {
-		return models.TargetScanResult{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+		return models.AssetScanResult{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
 	}
 
 	return tsr, nil
 }
 
 // nolint:cyclop
-func (s *ScanResultsTableHandler) UpdateScanResult(scanResult models.TargetScanResult, params models.PatchScanResultsScanResultIDParams) (models.TargetScanResult, error) {
+func (s *ScanResultsTableHandler) UpdateScanResult(scanResult models.AssetScanResult, params models.PatchScanResultsScanResultIDParams) (models.AssetScanResult, error) {
 	if scanResult.Id == nil || *scanResult.Id == "" {
-		return models.TargetScanResult{}, &common.BadRequestError{
+		return models.AssetScanResult{}, &common.BadRequestError{
 			Reason: "id is required to update scan result",
 		}
 	}
 
+	if err := validateAnnotations(scanResult.Annotations); err != nil {
+		return models.AssetScanResult{}, err
+	}
+
 	var dbObj ScanResult
-	if err := getExistingObjByID(s.DB, targetScanResultsSchemaName, *scanResult.Id, &dbObj); err != nil {
-		return models.TargetScanResult{}, err
+	if err := getExistingObjByID(s.DB, assetScanResultsSchemaName, *scanResult.Id, &dbObj); err != nil {
+		return models.AssetScanResult{}, err
 	}
 
 	var err error
-	var dbScanResult models.TargetScanResult
+	var dbScanResult models.AssetScanResult
 	err = json.Unmarshal(dbObj.Data, &dbScanResult)
 	if err != nil {
-		return models.TargetScanResult{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+		return models.AssetScanResult{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
 	}
 
 	if err := checkRevisionEtag(params.IfMatch, dbScanResult.Revision); err != nil {
-		return models.TargetScanResult{}, err
+		return models.AssetScanResult{}, err
 	}
 
 	scanResult.Revision = bumpRevision(dbScanResult.Revision)
+	// The truncations are set by the backend, the patch must not reset the
+	// ones of the families it doesn't set.
+	scanResult.Truncations = dbScanResult.Truncations
+
+	// The patch is applied to the materialized scan result, so that
+	// patching a result list stored as a blob replaces or removes it.
+	materialized, err := s.materialize(dbObj.Data, make(map[string]datatypes.JSON))
+	if err != nil {
+		return models.AssetScanResult{}, err
+	}
+
+	patched, err := patchObject(materialized, scanResult)
+	if err != nil {
+		return models.AssetScanResult{}, fmt.Errorf("failed to apply patch: %w", err)
+	}
 
-	dbObj.Data, err = patchObject(dbObj.Data, scanResult)
+	patched, err = truncateResults(patched, s.Limits)
 	if err != nil {
-		return models.TargetScanResult{}, fmt.Errorf("failed to apply patch: %w", err)
+		return models.AssetScanResult{}, fmt.Errorf("failed to truncate scan result: %w", err)
 	}
 
-	var tsr models.TargetScanResult
-	err = json.Unmarshal(dbObj.Data, &tsr)
+	var tsr models.AssetScanResult
+	err = json.Unmarshal(patched, &tsr)
 	if err != nil {
-		return models.TargetScanResult{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+		return models.AssetScanResult{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
 	}
 
 	// Check the existing DB entries to ensure that the scan id and target id fields are unique
@@ -283,33 +351,130 @@ func (s *ScanResultsTableHandler) UpdateScanResult(scanResult models.TargetScanR
 		if errors.As(err, &conflictErr) {
 			return existingScanResult, err
 		}
-		return models.TargetScanResult{}, fmt.Errorf("failed to check existing scan: %w", err)
+		return models.AssetScanResult{}, fmt.Errorf("failed to check existing scan: %w", err)
 	}
 
-	if err := s.DB.Save(&dbObj).Error; err != nil {
-		return models.TargetScanResult{}, fmt.Errorf("failed to save scan result in db: %w", err)
+	if err := s.saveWithBlobs(&dbObj, patched); err != nil {
+		return models.AssetScanResult{}, err
 	}
 
 	return tsr, nil
 }
 
-func (s *ScanResultsTableHandler) checkUniqueness(scanResult models.TargetScanResult) (models.TargetScanResult, error) {
+// saveWithBlobs saves the scan result data to the existing dbObj with its
+// result lists stored as blobs, and releases the blobs which were referenced
+// by the previous data.
+func (s *ScanResultsTableHandler) saveWithBlobs(dbObj *ScanResult, data []byte) error {
+	oldRefs, err := blobRefs(dbObj.Data)
+	if err != nil {
+		return fmt.Errorf("failed to get scan result blob references: %w", err)
+	}
+
+	stored, blobs, err := extractBlobs(data)
+	if err != nil {
+		return fmt.Errorf("failed to extract scan result blobs: %w", err)
+	}
+	dbObj.Data = stored
+
+	err = s.DB.Transaction(func(tx *gorm.DB) error {
+		if err := retainBlobs(tx, blobs); err != nil {
+			return err
+		}
+		if err := releaseBlobs(tx, oldRefs); err != nil {
+			return err
+		}
+		return tx.Save(dbObj).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save scan result in db: %w", err)
+	}
+
+	return nil
+}
+
+// materialize returns the stored scan result data with the blob references
+// replaced by the result lists. The blobs are read from the DB unless they
+// are already in the blobs cache, which is shared by the scan results of a
+// collection as they likely reference the same blobs.
+func (s *ScanResultsTableHandler) materialize(data []byte, blobs map[string]datatypes.JSON) ([]byte, error) {
+	refs, err := blobRefs(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scan result blob references: %w", err)
+	}
+
+	var missing []string
+	for _, ref := range refs {
+		if _, ok := blobs[ref]; !ok {
+			missing = append(missing, ref)
+		}
+	}
+	loaded, err := loadBlobs(s.DB, missing)
+	if err != nil {
+		return nil, err
+	}
+	for hash, blob := range loaded {
+		blobs[hash] = blob
+	}
+
+	materialized, err := materializeBlobs(data, blobs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to materialize scan result: %w", err)
+	}
+
+	return materialized, nil
+}
+
+// DeleteScanResult deletes the scan result together with the scanner config
+// of it, and releases the blobs of its result lists.
+func (s *ScanResultsTableHandler) DeleteScanResult(scanResultID models.ScanResultID) error {
+	err := s.DB.Transaction(func(tx *gorm.DB) error {
+		var dbObj ScanResult
+		if err := getExistingObjByID(tx, assetScanResultsSchemaName, scanResultID, &dbObj); err != nil {
+			if errors.Is(err, types.ErrNotFound) {
+				return nil
+			}
+			return err
+		}
+		refs, err := blobRefs(dbObj.Data)
+		if err != nil {
+			return fmt.Errorf("failed to get scan result blob references: %w", err)
+		}
+
+		if err := deleteObjByID(tx, scanResultID, &ScanResult{}); err != nil {
+			return err
+		}
+		if err := releaseBlobs(tx, refs); err != nil {
+			return err
+		}
+		if err := tx.Where("scan_result_id = ?", scanResultID).Delete(&ScannerToken{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("scan_result_id = ?", scanResultID).Delete(&ScannerConfig{}).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete scan result: %w", err)
+	}
+
+	return nil
+}
+
+func (s *ScanResultsTableHandler) checkUniqueness(scanResult models.AssetScanResult) (models.AssetScanResult, error) {
 	var scanResults []ScanResult
 	// In the case of creating or updating a scan results, needs to be checked whether other scan results exists with same scan id and target id.
-	filter := fmt.Sprintf("id ne '%s' and target/id eq '%s' and scan/id eq '%s'", *scanResult.Id, scanResult.Target.Id, scanResult.Scan.Id)
-	err := ODataQuery(s.DB, targetScanResultsSchemaName, &filter, nil, nil, nil, nil, nil, true, &scanResults)
+	filter := fmt.Sprintf("id ne '%s' and asset/id eq '%s' and scan/id eq '%s'", *scanResult.Id, scanResult.Asset.Id, scanResult.Scan.Id)
+	err := ODataQuery(s.DB, assetScanResultsSchemaName, &filter, nil, nil, nil, nil, nil, nil, true, &scanResults)
 	if err != nil {
-		return models.TargetScanResult{}, err
+		return models.AssetScanResult{}, err
 	}
 
 	if len(scanResults) > 0 {
-		var tsr models.TargetScanResult
+		var tsr models.AssetScanResult
 		if err = json.Unmarshal(scanResults[0].Data, &tsr); err != nil {
-			return models.TargetScanResult{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+			return models.AssetScanResult{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
 		}
 		return tsr, &common.ConflictError{
-			Reason: fmt.Sprintf("Scan results exists with same target id=%s and scan id=%s)", scanResult.Target.Id, scanResult.Scan.Id),
+			Reason: fmt.Sprintf("Scan results exists with same target id=%s and scan id=%s)", scanResult.Asset.Id, scanResult.Scan.Id),
 		}
 	}
-	return models.TargetScanResult{}, nil
+	return models.AssetScanResult{}, nil
 }
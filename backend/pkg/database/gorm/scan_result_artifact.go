@@ -0,0 +1,86 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gorm.io/datatypes"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+)
+
+// SetScanResultArtifact records the artifact in the artifacts of the scan
+// result, replacing the previous artifact of its family.
+func (s *ScanResultsTableHandler) SetScanResultArtifact(scanResultID models.ScanResultID, artifact models.ScanResultArtifact) (models.ScanResultArtifact, error) {
+	if _, _, ok := models.ScanResultItemsFields(artifact.Family); !ok {
+		return models.ScanResultArtifact{}, &common.BadRequestError{Reason: fmt.Sprintf("unknown scan family %q", artifact.Family)}
+	}
+
+	var dbObj ScanResult
+	if err := getExistingObjByID(s.DB, assetScanResultsSchemaName, scanResultID, &dbObj); err != nil {
+		return models.ScanResultArtifact{}, err
+	}
+
+	var dbScanResult models.AssetScanResult
+	if err := json.Unmarshal(dbObj.Data, &dbScanResult); err != nil {
+		return models.ScanResultArtifact{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	// The result lists are materialized so that their blobs are retained
+	// again when the updated scan result is saved.
+	data, err := s.materialize(dbObj.Data, make(map[string]datatypes.JSON))
+	if err != nil {
+		return models.ScanResultArtifact{}, err
+	}
+
+	var scanResult map[string]json.RawMessage
+	if err := json.Unmarshal(data, &scanResult); err != nil {
+		return models.ScanResultArtifact{}, fmt.Errorf("failed to unmarshal scan result: %w", err)
+	}
+	if scanResult["artifacts"], err = json.Marshal(setArtifact(dbScanResult.Artifacts, artifact)); err != nil {
+		return models.ScanResultArtifact{}, fmt.Errorf("failed to marshal artifacts: %w", err)
+	}
+	if scanResult["revision"], err = json.Marshal(bumpRevision(dbScanResult.Revision)); err != nil {
+		return models.ScanResultArtifact{}, fmt.Errorf("failed to marshal revision: %w", err)
+	}
+
+	updated, err := json.Marshal(scanResult)
+	if err != nil {
+		return models.ScanResultArtifact{}, fmt.Errorf("failed to marshal scan result: %w", err)
+	}
+	if err := s.saveWithBlobs(&dbObj, updated); err != nil {
+		return models.ScanResultArtifact{}, err
+	}
+
+	return artifact, nil
+}
+
+// setArtifact returns the artifacts with the artifact of the same family
+// replaced by artifact.
+func setArtifact(artifacts *[]models.ScanResultArtifact, artifact models.ScanResultArtifact) []models.ScanResultArtifact {
+	var updated []models.ScanResultArtifact
+	if artifacts != nil {
+		for _, a := range *artifacts {
+			if a.Family != artifact.Family {
+				updated = append(updated, a)
+			}
+		}
+	}
+	return append(updated, artifact)
+}
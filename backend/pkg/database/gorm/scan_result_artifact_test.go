@@ -0,0 +1,98 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestScanResultsTableHandler_SetScanResultArtifact(t *testing.T) {
+	db, err := NewDatabase(types.DBConfig{
+		DriverType:  types.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("NewDatabase() error = %v", err)
+	}
+	table := db.ScanResultsTable()
+
+	created, err := table.CreateScanResult(models.AssetScanResult{
+		Scan:  &models.ScanRelationship{Id: "scan"},
+		Asset: &models.AssetRelationship{Id: "asset"},
+		Sboms: &models.SbomScan{Packages: &[]models.Package{{Name: utils.PointerTo("openssl")}}},
+	})
+	if err != nil {
+		t.Fatalf("CreateScanResult() error = %v", err)
+	}
+
+	artifact := func(family models.ScanFamily, key string) models.ScanResultArtifact {
+		return models.ScanResultArtifact{
+			Family:      family,
+			Key:         key,
+			ContentType: "application/json",
+			Size:        42,
+			UploadTime:  time.Now().UTC().Truncate(time.Second),
+		}
+	}
+	for _, a := range []models.ScanResultArtifact{
+		artifact(models.ScanFamilySbom, "sha256:1"),
+		artifact(models.ScanFamilyVulnerabilities, "sha256:2"),
+		// Replaces the first artifact of the family.
+		artifact(models.ScanFamilySbom, "sha256:3"),
+	} {
+		if _, err := table.SetScanResultArtifact(*created.Id, a); err != nil {
+			t.Fatalf("SetScanResultArtifact() error = %v", err)
+		}
+	}
+
+	got, err := table.GetScanResult(*created.Id, models.GetScanResultsScanResultIDParams{})
+	if err != nil {
+		t.Fatalf("GetScanResult() error = %v", err)
+	}
+	if got.Artifacts == nil || len(*got.Artifacts) != 2 {
+		t.Fatalf("scan result artifacts = %v, want 2 artifacts", got.Artifacts)
+	}
+	keys := map[models.ScanFamily]string{}
+	for _, a := range *got.Artifacts {
+		keys[a.Family] = a.Key
+	}
+	if keys[models.ScanFamilySbom] != "sha256:3" || keys[models.ScanFamilyVulnerabilities] != "sha256:2" {
+		t.Errorf("scan result artifact keys = %v", keys)
+	}
+	if utils.ValueOrZero(got.Revision) != utils.ValueOrZero(created.Revision)+3 {
+		t.Errorf("scan result revision = %d, want %d", utils.ValueOrZero(got.Revision), utils.ValueOrZero(created.Revision)+3)
+	}
+	// The result lists stored as blobs are kept.
+	if got.Sboms == nil || got.Sboms.Packages == nil || len(*got.Sboms.Packages) != 1 {
+		t.Errorf("scan result packages = %v, want the stored package", got.Sboms)
+	}
+
+	var validationErr *common.BadRequestError
+	if _, err := table.SetScanResultArtifact(*created.Id, artifact("unknown", "sha256:4")); !errors.As(err, &validationErr) {
+		t.Errorf("SetScanResultArtifact() of an unknown family error = %v, want BadRequestError", err)
+	}
+	if _, err := table.SetScanResultArtifact("missing", artifact(models.ScanFamilySbom, "sha256:4")); !errors.Is(err, types.ErrNotFound) {
+		t.Errorf("SetScanResultArtifact() of a missing scan result error = %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,291 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ScanResultBlob is a result list of a scan family stored once by the hash of
+// its content, so that the identical lists of the ScanResults of
+// fleet-homogeneous targets are not stored repeatedly. RefCount is the number
+// of ScanResults referencing the blob, it is deleted with the last of them.
+type ScanResultBlob struct {
+	Hash     string `gorm:"primaryKey"`
+	Data     datatypes.JSON
+	RefCount int
+}
+
+// blobField is a result list of a scan family which is stored as a
+// ScanResultBlob. The list is replaced by the hash of the blob under refField
+// in the stored ScanResult, and materialized back when the ScanResult is read.
+type blobField struct {
+	family   string
+	field    string
+	refField string
+}
+
+var scanResultBlobFields = []blobField{
+	{family: "sboms", field: "packages", refField: "packagesRef"},
+	{family: "vulnerabilities", field: "vulnerabilities", refField: "vulnerabilitiesRef"},
+}
+
+var jsonNull = []byte("null")
+
+func isJSONNull(raw json.RawMessage) bool {
+	return len(raw) == 0 || bytes.Equal(raw, jsonNull)
+}
+
+// extractBlobs replaces the result lists of the scan result data with the
+// references to their blobs, and returns the updated data and the blobs by
+// hash. The references of the lists which are not set are kept.
+func extractBlobs(data []byte) ([]byte, map[string][]byte, error) {
+	blobs := make(map[string][]byte)
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal scan result: %w", err)
+	}
+
+	for _, f := range scanResultBlobFields {
+		var family map[string]json.RawMessage
+		if isJSONNull(object[f.family]) {
+			continue
+		}
+		if err := json.Unmarshal(object[f.family], &family); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal %s: %w", f.family, err)
+		}
+		if isJSONNull(family[f.field]) {
+			continue
+		}
+
+		hash, blob, err := hashBlob(family[f.field])
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to hash %s/%s: %w", f.family, f.field, err)
+		}
+		blobs[hash] = blob
+
+		ref, err := json.Marshal(hash)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal blob reference: %w", err)
+		}
+		family[f.field] = jsonNull
+		family[f.refField] = ref
+
+		if object[f.family], err = json.Marshal(family); err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal %s: %w", f.family, err)
+		}
+	}
+
+	updated, err := json.Marshal(object)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal scan result: %w", err)
+	}
+
+	return updated, blobs, nil
+}
+
+// hashBlob returns the hash of the list and the list in canonical form, so
+// that the same list is stored once however its fields were ordered.
+func hashBlob(list json.RawMessage) (string, []byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(list))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return "", nil, fmt.Errorf("failed to decode list: %w", err)
+	}
+	canonical, err := json.Marshal(value)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to encode list: %w", err)
+	}
+
+	sum := sha256.Sum256(canonical)
+	return "sha256:" + hex.EncodeToString(sum[:]), canonical, nil
+}
+
+// blobRefs returns the hashes of the blobs referenced by the stored scan
+// result data.
+func blobRefs(data []byte) ([]string, error) {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal scan result: %w", err)
+	}
+
+	var refs []string
+	for _, f := range scanResultBlobFields {
+		if isJSONNull(object[f.family]) {
+			continue
+		}
+		var family map[string]json.RawMessage
+		if err := json.Unmarshal(object[f.family], &family); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s: %w", f.family, err)
+		}
+		if isJSONNull(family[f.refField]) {
+			continue
+		}
+		var ref string
+		if err := json.Unmarshal(family[f.refField], &ref); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s/%s: %w", f.family, f.refField, err)
+		}
+		refs = append(refs, ref)
+	}
+
+	return refs, nil
+}
+
+// materializeBlobs replaces the blob references of the stored scan result
+// data with the result lists of the blobs.
+func materializeBlobs(data []byte, blobs map[string]datatypes.JSON) ([]byte, error) {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal scan result: %w", err)
+	}
+
+	changed := false
+	for _, f := range scanResultBlobFields {
+		if isJSONNull(object[f.family]) {
+			continue
+		}
+		var family map[string]json.RawMessage
+		if err := json.Unmarshal(object[f.family], &family); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s: %w", f.family, err)
+		}
+		raw, ok := family[f.refField]
+		if !ok {
+			continue
+		}
+		delete(family, f.refField)
+
+		var ref string
+		if err := json.Unmarshal(raw, &ref); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s/%s: %w", f.family, f.refField, err)
+		}
+		if ref != "" {
+			blob, ok := blobs[ref]
+			if !ok {
+				return nil, fmt.Errorf("blob %s of %s/%s not found", ref, f.family, f.field)
+			}
+			family[f.field] = json.RawMessage(blob)
+		}
+
+		var err error
+		if object[f.family], err = json.Marshal(family); err != nil {
+			return nil, fmt.Errorf("failed to marshal %s: %w", f.family, err)
+		}
+		changed = true
+	}
+
+	if !changed {
+		return data, nil
+	}
+
+	materialized, err := json.Marshal(object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal scan result: %w", err)
+	}
+
+	return materialized, nil
+}
+
+// loadBlobs reads the blobs with the hashes from the DB.
+func loadBlobs(db *gorm.DB, hashes []string) (map[string]datatypes.JSON, error) {
+	blobs := make(map[string]datatypes.JSON, len(hashes))
+	if len(hashes) == 0 {
+		return blobs, nil
+	}
+
+	var dbBlobs []ScanResultBlob
+	if err := db.Where("hash IN ?", hashes).Find(&dbBlobs).Error; err != nil {
+		return nil, fmt.Errorf("failed to get scan result blobs from db: %w", err)
+	}
+	for _, blob := range dbBlobs {
+		blobs[blob.Hash] = blob.Data
+	}
+
+	return blobs, nil
+}
+
+// retainBlobs stores the blobs which don't exist yet and increments the
+// reference count of the others. A single upsert is used so that the
+// ScanResults saving the same new blob concurrently don't conflict.
+func retainBlobs(tx *gorm.DB, blobs map[string][]byte) error {
+	for hash, data := range blobs {
+		err := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "hash"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"ref_count": gorm.Expr("scan_result_blobs.ref_count + 1"),
+			}),
+		}).Create(&ScanResultBlob{Hash: hash, Data: data, RefCount: 1}).Error
+		if err != nil {
+			return fmt.Errorf("failed to retain scan result blob %s: %w", hash, err)
+		}
+	}
+
+	return nil
+}
+
+// releaseBlobs decrements the reference count of the blobs and deletes the
+// ones which are no longer referenced.
+func releaseBlobs(tx *gorm.DB, hashes []string) error {
+	if len(hashes) == 0 {
+		return nil
+	}
+
+	for _, hash := range hashes {
+		err := tx.Model(&ScanResultBlob{}).Where("hash = ?", hash).Update("ref_count", gorm.Expr("ref_count - 1")).Error
+		if err != nil {
+			return fmt.Errorf("failed to release scan result blob %s: %w", hash, err)
+		}
+	}
+	if err := tx.Where("hash IN ? AND ref_count <= 0", hashes).Delete(&ScanResultBlob{}).Error; err != nil {
+		return fmt.Errorf("failed to delete unreferenced scan result blobs: %w", err)
+	}
+
+	return nil
+}
+
+// blobSelect adds the blob references to the $select of the scan results
+// if it selects the result lists stored as blobs, so that they can be
+// materialized.
+func blobSelect(selectString *string) *string {
+	if selectString == nil || *selectString == "" {
+		return selectString
+	}
+
+	fields := strings.Split(*selectString, ",")
+	for _, f := range scanResultBlobFields {
+		path := f.family + "/" + f.field
+		for _, field := range fields {
+			field = strings.TrimSpace(field)
+			if field == path || strings.HasPrefix(field, path+"/") {
+				fields = append(fields, f.family+"/"+f.refField)
+				break
+			}
+		}
+	}
+
+	selectFields := strings.Join(fields, ",")
+	return &selectFields
+}
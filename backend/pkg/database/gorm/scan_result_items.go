@@ -0,0 +1,195 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gorm.io/datatypes"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// GetScanResultItems returns the page of the result list of the scan family
+// selected by $skip and $top.
+func (s *ScanResultsTableHandler) GetScanResultItems(scanResultID models.ScanResultID, family models.ScanFamily, params models.GetScanResultsScanResultIDFamiliesFamilyItemsParams) (models.ScanResultItems, error) {
+	var dbObj ScanResult
+	if err := getExistingObjByID(s.DB, assetScanResultsSchemaName, scanResultID, &dbObj); err != nil {
+		return models.ScanResultItems{}, err
+	}
+
+	data, err := s.materialize(dbObj.Data, make(map[string]datatypes.JSON))
+	if err != nil {
+		return models.ScanResultItems{}, err
+	}
+
+	_, items, err := resultItems(data, family)
+	if err != nil {
+		return models.ScanResultItems{}, err
+	}
+
+	skip := utils.ValueOrZero(params.Skip)
+	if skip < 0 {
+		return models.ScanResultItems{}, &common.BadRequestError{Reason: "$skip must not be negative"}
+	}
+	if skip > len(items) {
+		skip = len(items)
+	}
+	end := len(items)
+	if params.Top != nil {
+		if *params.Top < 0 {
+			return models.ScanResultItems{}, &common.BadRequestError{Reason: "$top must not be negative"}
+		}
+		if skip+*params.Top < end {
+			end = skip + *params.Top
+		}
+	}
+
+	page := make([]map[string]interface{}, 0, end-skip)
+	for _, item := range items[skip:end] {
+		var obj map[string]interface{}
+		if err := json.Unmarshal(item, &obj); err != nil {
+			return models.ScanResultItems{}, fmt.Errorf("failed to unmarshal item: %w", err)
+		}
+		page = append(page, obj)
+	}
+
+	return models.ScanResultItems{
+		Offset: skip,
+		Count:  utils.PointerTo(len(items)),
+		Items:  &page,
+	}, nil
+}
+
+// SetScanResultItems sets the items of the result list of the scan family
+// starting at the offset of the page and removes the items after it.
+// nolint:cyclop
+func (s *ScanResultsTableHandler) SetScanResultItems(scanResultID models.ScanResultID, family models.ScanFamily, page models.ScanResultItems) (models.ScanResultItems, error) {
+	if page.Offset < 0 {
+		return models.ScanResultItems{}, &common.BadRequestError{Reason: "offset must not be negative"}
+	}
+
+	var dbObj ScanResult
+	if err := getExistingObjByID(s.DB, assetScanResultsSchemaName, scanResultID, &dbObj); err != nil {
+		return models.ScanResultItems{}, err
+	}
+
+	var dbScanResult models.AssetScanResult
+	if err := json.Unmarshal(dbObj.Data, &dbScanResult); err != nil {
+		return models.ScanResultItems{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	data, err := s.materialize(dbObj.Data, make(map[string]datatypes.JSON))
+	if err != nil {
+		return models.ScanResultItems{}, err
+	}
+
+	result, items, err := resultItems(data, family)
+	if err != nil {
+		return models.ScanResultItems{}, err
+	}
+	if page.Offset > len(items) {
+		return models.ScanResultItems{}, &common.ConflictError{
+			Reason: fmt.Sprintf("offset %d is after the end of the list of %d items", page.Offset, len(items)),
+		}
+	}
+
+	items = items[:page.Offset]
+	if page.Items != nil {
+		for _, item := range *page.Items {
+			b, err := json.Marshal(item)
+			if err != nil {
+				return models.ScanResultItems{}, fmt.Errorf("failed to marshal item: %w", err)
+			}
+			items = append(items, b)
+		}
+	}
+
+	var scanResult map[string]json.RawMessage
+	if err := json.Unmarshal(data, &scanResult); err != nil {
+		return models.ScanResultItems{}, fmt.Errorf("failed to unmarshal scan result: %w", err)
+	}
+	resultField, itemsField, _ := models.ScanResultItemsFields(family)
+	if result[itemsField], err = json.Marshal(items); err != nil {
+		return models.ScanResultItems{}, fmt.Errorf("failed to marshal items: %w", err)
+	}
+	if scanResult[resultField], err = json.Marshal(result); err != nil {
+		return models.ScanResultItems{}, fmt.Errorf("failed to marshal result: %w", err)
+	}
+	if scanResult["revision"], err = json.Marshal(bumpRevision(dbScanResult.Revision)); err != nil {
+		return models.ScanResultItems{}, fmt.Errorf("failed to marshal revision: %w", err)
+	}
+
+	updated, err := json.Marshal(scanResult)
+	if err != nil {
+		return models.ScanResultItems{}, fmt.Errorf("failed to marshal scan result: %w", err)
+	}
+	// The items past the limit of the family are dropped as when the whole
+	// scan result is stored at once.
+	updated, err = truncateResults(updated, s.Limits)
+	if err != nil {
+		return models.ScanResultItems{}, fmt.Errorf("failed to truncate scan result: %w", err)
+	}
+	if err := s.saveWithBlobs(&dbObj, updated); err != nil {
+		return models.ScanResultItems{}, err
+	}
+
+	count := len(items)
+	if limit := s.Limits.Limit(family); limit > 0 && count > limit {
+		count = limit
+	}
+
+	return models.ScanResultItems{
+		Offset: page.Offset,
+		Count:  utils.PointerTo(count),
+	}, nil
+}
+
+// resultItems returns the fields of the result of the scan family in the
+// materialized scan result and the items of its list.
+func resultItems(data []byte, family models.ScanFamily) (map[string]json.RawMessage, []json.RawMessage, error) {
+	resultField, itemsField, ok := models.ScanResultItemsFields(family)
+	if !ok {
+		return nil, nil, &common.BadRequestError{Reason: fmt.Sprintf("unknown scan family %q", family)}
+	}
+
+	var scanResult map[string]json.RawMessage
+	if err := json.Unmarshal(data, &scanResult); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal scan result: %w", err)
+	}
+
+	var result map[string]json.RawMessage
+	if raw, ok := scanResult[resultField]; ok {
+		if err := json.Unmarshal(raw, &result); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal %s: %w", resultField, err)
+		}
+	}
+	if result == nil {
+		result = map[string]json.RawMessage{}
+	}
+
+	var items []json.RawMessage
+	if raw, ok := result[itemsField]; ok {
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal %s.%s: %w", resultField, itemsField, err)
+		}
+	}
+
+	return result, items, nil
+}
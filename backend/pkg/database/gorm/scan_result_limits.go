@@ -0,0 +1,111 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+)
+
+// truncateResults truncates the result lists of the scan families of the
+// materialized scan result data which have more items than the limit of their
+// family, and records the truncations in the scan result. The truncations
+// recorded when the scan result was previously stored are kept, as the lists
+// they truncated are not longer than their limit any more.
+// nolint:cyclop
+func truncateResults(data []byte, limits types.ScanResultLimits) ([]byte, error) {
+	var scanResult map[string]json.RawMessage
+	if err := json.Unmarshal(data, &scanResult); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal scan result: %w", err)
+	}
+
+	var truncations []models.ScanResultTruncation
+	if raw, ok := scanResult["truncations"]; ok {
+		if err := json.Unmarshal(raw, &truncations); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal truncations: %w", err)
+		}
+	}
+
+	var truncated bool
+	for _, family := range models.ScanFamilies {
+		limit := limits.Limit(family)
+		if limit <= 0 {
+			continue
+		}
+
+		resultField, itemsField, _ := models.ScanResultItemsFields(family)
+		raw, ok := scanResult[resultField]
+		if !ok {
+			continue
+		}
+		var result map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s: %w", resultField, err)
+		}
+		raw, ok = result[itemsField]
+		if !ok {
+			continue
+		}
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s.%s: %w", resultField, itemsField, err)
+		}
+		if len(items) <= limit {
+			continue
+		}
+
+		var err error
+		if result[itemsField], err = json.Marshal(items[:limit]); err != nil {
+			return nil, fmt.Errorf("failed to marshal %s.%s: %w", resultField, itemsField, err)
+		}
+		if scanResult[resultField], err = json.Marshal(result); err != nil {
+			return nil, fmt.Errorf("failed to marshal %s: %w", resultField, err)
+		}
+		truncations = setTruncation(truncations, models.ScanResultTruncation{
+			Family: family,
+			Limit:  limit,
+			Count:  len(items),
+		})
+		truncated = true
+	}
+	if !truncated {
+		return data, nil
+	}
+
+	var err error
+	if scanResult["truncations"], err = json.Marshal(truncations); err != nil {
+		return nil, fmt.Errorf("failed to marshal truncations: %w", err)
+	}
+	data, err = json.Marshal(scanResult)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal scan result: %w", err)
+	}
+	return data, nil
+}
+
+// setTruncation replaces the truncation of the same family, or adds it.
+func setTruncation(truncations []models.ScanResultTruncation, truncation models.ScanResultTruncation) []models.ScanResultTruncation {
+	for i := range truncations {
+		if truncations[i].Family == truncation.Family {
+			truncations[i] = truncation
+			return truncations
+		}
+	}
+	return append(truncations, truncation)
+}
@@ -0,0 +1,130 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestScanResultsTableHandler_Limits(t *testing.T) {
+	db, err := NewDatabase(types.DBConfig{
+		DriverType:  types.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.sqlite"),
+		ScanResultLimits: types.ScanResultLimits{
+			MaxFindings: 3,
+			Families: map[models.ScanFamily]int{
+				models.ScanFamilySecrets: 2,
+				models.ScanFamilySbom:    0,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewDatabase() error = %v", err)
+	}
+	table := db.ScanResultsTable()
+
+	secrets := func(n int) *models.SecretScan {
+		items := make([]models.Secret, 0, n)
+		for i := 0; i < n; i++ {
+			items = append(items, models.Secret{FilePath: utils.PointerTo(fmt.Sprintf("/repo/%d", i))})
+		}
+		return &models.SecretScan{Secrets: &items}
+	}
+	packages := make([]models.Package, 0, 5)
+	for i := 0; i < 5; i++ {
+		packages = append(packages, models.Package{Name: utils.PointerTo(fmt.Sprintf("pkg-%d", i))})
+	}
+
+	created, err := table.CreateScanResult(models.AssetScanResult{
+		Scan:    &models.ScanRelationship{Id: "scan"},
+		Asset:   &models.AssetRelationship{Id: "asset"},
+		Secrets: secrets(5),
+		// sbom is unlimited despite MaxFindings.
+		Sboms: &models.SbomScan{Packages: &packages},
+		Vulnerabilities: &models.VulnerabilityScan{
+			Vulnerabilities: &[]models.Vulnerability{{}, {}, {}, {}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateScanResult() error = %v", err)
+	}
+	got, err := table.GetScanResult(*created.Id, models.GetScanResultsScanResultIDParams{})
+	if err != nil {
+		t.Fatalf("GetScanResult() error = %v", err)
+	}
+	if n := len(*got.Secrets.Secrets); n != 2 {
+		t.Errorf("got %d secrets, want 2", n)
+	}
+	if n := len(*got.Vulnerabilities.Vulnerabilities); n != 3 {
+		t.Errorf("got %d vulnerabilities, want 3", n)
+	}
+	if n := len(*got.Sboms.Packages); n != 5 {
+		t.Errorf("got %d packages, want 5", n)
+	}
+	want := []models.ScanResultTruncation{
+		{Family: models.ScanFamilyVulnerabilities, Limit: 3, Count: 4},
+		{Family: models.ScanFamilySecrets, Limit: 2, Count: 5},
+	}
+	if diff := cmp.Diff(want, utils.ValueOrZero(got.Truncations)); diff != "" {
+		t.Errorf("CreateScanResult() truncations mismatch (-want +got):\n%s", diff)
+	}
+
+	// Patching other fields keeps the truncations, and patching a family
+	// records its new truncation.
+	updated, err := table.UpdateScanResult(models.AssetScanResult{
+		Id:                created.Id,
+		FindingsProcessed: utils.PointerTo(true),
+		Secrets:           secrets(7),
+	}, models.PatchScanResultsScanResultIDParams{})
+	if err != nil {
+		t.Fatalf("UpdateScanResult() error = %v", err)
+	}
+	want[1].Count = 7
+	if diff := cmp.Diff(want, utils.ValueOrZero(updated.Truncations)); diff != "" {
+		t.Errorf("UpdateScanResult() truncations mismatch (-want +got):\n%s", diff)
+	}
+
+	// The items set past the limit are dropped too.
+	page, err := table.SetScanResultItems(*created.Id, models.ScanFamilySecrets, models.ScanResultItems{
+		Offset: 1,
+		Items:  &[]map[string]interface{}{{"filePath": "/a"}, {"filePath": "/b"}, {"filePath": "/c"}},
+	})
+	if err != nil {
+		t.Fatalf("SetScanResultItems() error = %v", err)
+	}
+	if count := utils.ValueOrZero(page.Count); count != 2 {
+		t.Errorf("SetScanResultItems() count = %d, want 2", count)
+	}
+	got, err = table.GetScanResult(*created.Id, models.GetScanResultsScanResultIDParams{})
+	if err != nil {
+		t.Fatalf("GetScanResult() error = %v", err)
+	}
+	if n := len(*got.Secrets.Secrets); n != 2 {
+		t.Errorf("got %d secrets, want 2", n)
+	}
+	want[1].Count = 4
+	if diff := cmp.Diff(want, utils.ValueOrZero(got.Truncations)); diff != "" {
+		t.Errorf("SetScanResultItems() truncations mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,324 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestScanResultsTableHandler_Blobs(t *testing.T) {
+	db, err := NewDatabase(types.DBConfig{
+		DriverType:  types.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("NewDatabase() error = %v", err)
+	}
+	table := db.ScanResultsTable()
+	gormDB := db.(*Handler).DB
+
+	checkBlobs := func(t *testing.T, want []int) {
+		t.Helper()
+		var blobs []ScanResultBlob
+		if err := gormDB.Order("ref_count").Find(&blobs).Error; err != nil {
+			t.Fatalf("failed to get blobs: %v", err)
+		}
+		got := make([]int, 0, len(blobs))
+		for _, blob := range blobs {
+			got = append(got, blob.RefCount)
+		}
+		if len(got) != len(want) {
+			t.Fatalf("blob ref counts = %v, want %v", got, want)
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				t.Fatalf("blob ref counts = %v, want %v", got, want)
+			}
+		}
+	}
+	checkPackages := func(t *testing.T, scanResult models.AssetScanResult, want ...string) {
+		t.Helper()
+		if scanResult.Sboms == nil || scanResult.Sboms.Packages == nil {
+			t.Fatalf("scan result has no packages, want %v", want)
+		}
+		packages := *scanResult.Sboms.Packages
+		if len(packages) != len(want) {
+			t.Fatalf("scan result has %d packages, want %v", len(packages), want)
+		}
+		for i, name := range want {
+			if utils.ValueOrZero(packages[i].Name) != name {
+				t.Errorf("package %d = %q, want %q", i, utils.ValueOrZero(packages[i].Name), name)
+			}
+		}
+	}
+	newScanResult := func(scanID string, packages ...string) models.AssetScanResult {
+		pkgs := make([]models.Package, 0, len(packages))
+		for _, name := range packages {
+			pkgs = append(pkgs, models.Package{Name: utils.PointerTo(name), Version: utils.PointerTo("1.0")})
+		}
+		return models.AssetScanResult{
+			Scan:  &models.ScanRelationship{Id: scanID},
+			Asset: &models.AssetRelationship{Id: "target-" + scanID},
+			Sboms: &models.SbomScan{Packages: &pkgs},
+		}
+	}
+
+	first, err := table.CreateScanResult(newScanResult("1", "openssl", "zlib"))
+	if err != nil {
+		t.Fatalf("CreateScanResult() error = %v", err)
+	}
+	checkPackages(t, first, "openssl", "zlib")
+	second, err := table.CreateScanResult(newScanResult("2", "openssl", "zlib"))
+	if err != nil {
+		t.Fatalf("CreateScanResult() error = %v", err)
+	}
+	checkBlobs(t, []int{2})
+
+	got, err := table.GetScanResult(*first.Id, models.GetScanResultsScanResultIDParams{})
+	if err != nil {
+		t.Fatalf("GetScanResult() error = %v", err)
+	}
+	checkPackages(t, got, "openssl", "zlib")
+
+	got, err = table.GetScanResult(*first.Id, models.GetScanResultsScanResultIDParams{Select: utils.PointerTo("id,sboms/packages")})
+	if err != nil {
+		t.Fatalf("GetScanResult() with $select error = %v", err)
+	}
+	checkPackages(t, got, "openssl", "zlib")
+
+	list, err := table.GetScanResults(models.GetScanResultsParams{})
+	if err != nil {
+		t.Fatalf("GetScanResults() error = %v", err)
+	}
+	if len(*list.Items) != 2 {
+		t.Fatalf("GetScanResults() returned %d items, want 2", len(*list.Items))
+	}
+	for _, item := range *list.Items {
+		checkPackages(t, item, "openssl", "zlib")
+	}
+
+	// Patching a field outside of the blobs keeps the packages.
+	patched, err := table.UpdateScanResult(models.AssetScanResult{
+		Id:                second.Id,
+		FindingsProcessed: utils.PointerTo(true),
+	}, models.PatchScanResultsScanResultIDParams{})
+	if err != nil {
+		t.Fatalf("UpdateScanResult() error = %v", err)
+	}
+	checkPackages(t, patched, "openssl", "zlib")
+	checkBlobs(t, []int{2})
+
+	// Patching the packages stores a new blob.
+	patched, err = table.UpdateScanResult(models.AssetScanResult{
+		Id:    second.Id,
+		Sboms: newScanResult("2", "curl").Sboms,
+	}, models.PatchScanResultsScanResultIDParams{})
+	if err != nil {
+		t.Fatalf("UpdateScanResult() error = %v", err)
+	}
+	checkPackages(t, patched, "curl")
+	checkBlobs(t, []int{1, 1})
+
+	// Replacing the packages with the first list releases the second blob.
+	saved := newScanResult("2", "openssl", "zlib")
+	saved.Id = second.Id
+	if _, err = table.SaveScanResult(saved, models.PutScanResultsScanResultIDParams{}); err != nil {
+		t.Fatalf("SaveScanResult() error = %v", err)
+	}
+	checkBlobs(t, []int{2})
+
+	if err = table.DeleteScanResult(*first.Id); err != nil {
+		t.Fatalf("DeleteScanResult() error = %v", err)
+	}
+	checkBlobs(t, []int{1})
+	if err = table.DeleteScanResult(*second.Id); err != nil {
+		t.Fatalf("DeleteScanResult() error = %v", err)
+	}
+	checkBlobs(t, []int{})
+}
+
+// nolint:cyclop
+func TestScanResultsTableHandler_Items(t *testing.T) {
+	db, err := NewDatabase(types.DBConfig{
+		DriverType:  types.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("NewDatabase() error = %v", err)
+	}
+	table := db.ScanResultsTable()
+
+	created, err := table.CreateScanResult(models.AssetScanResult{
+		Scan:              &models.ScanRelationship{Id: "scan"},
+		Asset:             &models.AssetRelationship{Id: "asset"},
+		Misconfigurations: &models.MisconfigurationScan{Scanners: &[]string{"lynis"}},
+	})
+	if err != nil {
+		t.Fatalf("CreateScanResult() error = %v", err)
+	}
+	id := *created.Id
+
+	page := func(offset int, names ...string) models.ScanResultItems {
+		items := make([]map[string]interface{}, 0, len(names))
+		for _, name := range names {
+			items = append(items, map[string]interface{}{"name": name})
+		}
+		return models.ScanResultItems{Offset: offset, Items: &items}
+	}
+	checkNames := func(t *testing.T, family models.ScanFamily, field string, want ...string) {
+		t.Helper()
+		got, err := table.GetScanResultItems(id, family, models.GetScanResultsScanResultIDFamiliesFamilyItemsParams{})
+		if err != nil {
+			t.Fatalf("GetScanResultItems() error = %v", err)
+		}
+		if utils.ValueOrZero(got.Count) != len(want) || got.Items == nil || len(*got.Items) != len(want) {
+			t.Fatalf("GetScanResultItems() = %+v, want %v", got, want)
+		}
+		for i, item := range *got.Items {
+			if item[field] != want[i] {
+				t.Errorf("item %d = %v, want %q", i, item[field], want[i])
+			}
+		}
+	}
+
+	for _, p := range []models.ScanResultItems{
+		page(0, "openssl", "zlib"),
+		page(2, "curl"),
+		// A retried page replaces the items from its offset.
+		page(2, "curl", "bash"),
+	} {
+		if _, err := table.SetScanResultItems(id, models.ScanFamilySbom, p); err != nil {
+			t.Fatalf("SetScanResultItems() error = %v", err)
+		}
+	}
+	checkNames(t, models.ScanFamilySbom, "name", "openssl", "zlib", "curl", "bash")
+
+	got, err := table.GetScanResultItems(id, models.ScanFamilySbom, models.GetScanResultsScanResultIDFamiliesFamilyItemsParams{
+		Skip: utils.PointerTo(1),
+		Top:  utils.PointerTo(2),
+	})
+	if err != nil {
+		t.Fatalf("GetScanResultItems() error = %v", err)
+	}
+	if got.Offset != 1 || got.Items == nil || len(*got.Items) != 2 || (*got.Items)[0]["name"] != "zlib" {
+		t.Errorf("GetScanResultItems() page = %+v, want zlib and curl", got)
+	}
+
+	// The uploaded items are part of the scan result.
+	scanResult, err := table.GetScanResult(id, models.GetScanResultsScanResultIDParams{})
+	if err != nil {
+		t.Fatalf("GetScanResult() error = %v", err)
+	}
+	if scanResult.Sboms == nil || scanResult.Sboms.Packages == nil || len(*scanResult.Sboms.Packages) != 4 {
+		t.Errorf("GetScanResult() sboms = %+v, want 4 packages", scanResult.Sboms)
+	}
+	if utils.ValueOrZero(scanResult.Revision) != 4 {
+		t.Errorf("GetScanResult() revision = %v, want 4", utils.ValueOrZero(scanResult.Revision))
+	}
+
+	// A page at offset zero starts the list over, the other fields of the
+	// result are kept.
+	misconfiguration := models.ScanResultItems{Offset: 0, Items: &[]map[string]interface{}{{"message": "weak"}}}
+	if _, err := table.SetScanResultItems(id, models.ScanFamilyMisconfigurations, misconfiguration); err != nil {
+		t.Fatalf("SetScanResultItems() error = %v", err)
+	}
+	if _, err := table.SetScanResultItems(id, models.ScanFamilySbom, page(0, "curl")); err != nil {
+		t.Fatalf("SetScanResultItems() error = %v", err)
+	}
+	checkNames(t, models.ScanFamilySbom, "name", "curl")
+	checkNames(t, models.ScanFamilyMisconfigurations, "message", "weak")
+	scanResult, err = table.GetScanResult(id, models.GetScanResultsScanResultIDParams{})
+	if err != nil {
+		t.Fatalf("GetScanResult() error = %v", err)
+	}
+	if scanResult.Misconfigurations.Scanners == nil || len(*scanResult.Misconfigurations.Scanners) != 1 {
+		t.Errorf("GetScanResult() misconfigurations = %+v, want scanners kept", scanResult.Misconfigurations)
+	}
+
+	var conflictErr *common.ConflictError
+	if _, err := table.SetScanResultItems(id, models.ScanFamilySbom, page(5, "bash")); !errors.As(err, &conflictErr) {
+		t.Errorf("SetScanResultItems() after the end error = %v, want ConflictError", err)
+	}
+	var validationErr *common.BadRequestError
+	if _, err := table.SetScanResultItems(id, "unknown", page(0, "bash")); !errors.As(err, &validationErr) {
+		t.Errorf("SetScanResultItems() unknown family error = %v, want BadRequestError", err)
+	}
+	if _, err := table.SetScanResultItems("missing", models.ScanFamilySbom, page(0, "bash")); !errors.Is(err, types.ErrNotFound) {
+		t.Errorf("SetScanResultItems() missing scan result error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestScanResultsTableHandler_ConcurrentBlobs(t *testing.T) {
+	db, err := NewDatabase(types.DBConfig{
+		DriverType:  types.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("NewDatabase() error = %v", err)
+	}
+	gormDB := db.(*Handler).DB
+	sqlDB, err := gormDB.DB()
+	if err != nil {
+		t.Fatalf("failed to get sql db: %v", err)
+	}
+	// SQLite allows a single writer, the saves are serialized by waiting
+	// for the connection rather than failing as locked.
+	sqlDB.SetMaxOpenConns(1)
+	table := db.ScanResultsTable()
+
+	const scanners = 8
+	packages := []models.Package{{Name: utils.PointerTo("openssl"), Version: utils.PointerTo("1.0")}}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, scanners)
+	for i := 0; i < scanners; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := table.CreateScanResult(models.AssetScanResult{
+				Scan:  &models.ScanRelationship{Id: "scan"},
+				Asset: &models.AssetRelationship{Id: fmt.Sprintf("target-%d", i)},
+				Sboms: &models.SbomScan{Packages: &packages},
+			})
+			errs <- err
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("CreateScanResult() error = %v", err)
+		}
+	}
+
+	var blobs []ScanResultBlob
+	if err := gormDB.Find(&blobs).Error; err != nil {
+		t.Fatalf("failed to get blobs: %v", err)
+	}
+	if len(blobs) != 1 || blobs[0].RefCount != scanners {
+		t.Errorf("blobs = %+v, want a single blob referenced %d times", blobs, scanners)
+	}
+}
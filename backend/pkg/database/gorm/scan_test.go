@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
 func Test_validateScanConfigID(t *testing.T) {
@@ -78,3 +79,57 @@ func Test_validateScanConfigID(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateScanStateTransition(t *testing.T) {
+	tests := []struct {
+		name    string
+		state   *models.ScanState
+		dbState models.ScanState
+		wantErr bool
+	}{
+		{
+			name:    "state not changed",
+			dbState: models.ScanStateDone,
+			wantErr: false,
+		},
+		{
+			name:    "abort in progress scan",
+			state:   utils.PointerTo(models.ScanStateAborted),
+			dbState: models.ScanStateInProgress,
+			wantErr: false,
+		},
+		{
+			name:    "abort aborted scan",
+			state:   utils.PointerTo(models.ScanStateAborted),
+			dbState: models.ScanStateAborted,
+			wantErr: false,
+		},
+		{
+			name:    "abort done scan",
+			state:   utils.PointerTo(models.ScanStateAborted),
+			dbState: models.ScanStateDone,
+			wantErr: true,
+		},
+		{
+			name:    "abort failed scan",
+			state:   utils.PointerTo(models.ScanStateAborted),
+			dbState: models.ScanStateFailed,
+			wantErr: true,
+		},
+		{
+			name:    "fail aborted scan",
+			state:   utils.PointerTo(models.ScanStateFailed),
+			dbState: models.ScanStateAborted,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scan := models.Scan{State: tt.state}
+			dbScan := models.Scan{State: utils.PointerTo(tt.dbState)}
+			if err := validateScanStateTransition(scan, dbScan); (err != nil) != tt.wantErr {
+				t.Errorf("validateScanStateTransition() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// ScannerConfig is kept outside of the ScanResult as it may be large and it
+// is only needed by the scanner of the ScanResult.
+type ScannerConfig struct {
+	ScanResultID string `gorm:"primaryKey"`
+	Data         datatypes.JSON
+}
+
+// ScannerToken is the hash of a token the scanner of a ScanResult fetches
+// its config with. Every time the config is set a token is added, the
+// Scanners created with the previous ones can still fetch it until they
+// expire.
+type ScannerToken struct {
+	TokenHash    string `gorm:"primaryKey"`
+	ScanResultID string `gorm:"index"`
+	ExpiresAt    time.Time
+}
+
+type ScannerConfigsTableHandler struct {
+	DB *gorm.DB
+}
+
+func (db *Handler) ScannerConfigsTable() types.ScannerConfigsTable {
+	return &ScannerConfigsTableHandler{
+		DB: db.DB,
+	}
+}
+
+func (s *ScannerConfigsTableHandler) GetScannerConfig(scanResultID models.ScanResultID) (models.ScannerConfig, error) {
+	var dbScannerConfig ScannerConfig
+	if err := s.DB.Where("scan_result_id = ?", scanResultID).First(&dbScannerConfig).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.ScannerConfig{}, types.ErrNotFound
+		}
+		return models.ScannerConfig{}, fmt.Errorf("failed to get scanner config from db: %w", err)
+	}
+
+	var scannerConfig models.ScannerConfig
+	if err := json.Unmarshal(dbScannerConfig.Data, &scannerConfig); err != nil {
+		return models.ScannerConfig{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	return scannerConfig, nil
+}
+
+func (s *ScannerConfigsTableHandler) SetScannerConfig(scanResultID models.ScanResultID, scannerConfig models.ScannerConfig, tokenHash string, tokenExpiresAt time.Time) (models.ScannerConfig, error) {
+	if scannerConfig.Config == "" {
+		return models.ScannerConfig{}, &common.BadRequestError{
+			Reason: "config is required",
+		}
+	}
+
+	// The scanner config can only be set for an existing ScanResult.
+	var dbScanResult ScanResult
+	if err := getExistingObjByID(s.DB, assetScanResultsSchemaName, scanResultID, &dbScanResult); err != nil {
+		return models.ScannerConfig{}, err
+	}
+
+	if tokenHash == "" {
+		return models.ScannerConfig{}, errors.New("hash of the token is empty")
+	}
+
+	now := time.Now()
+	scannerConfig.UpdatedAt = utils.PointerTo(now)
+	// The token itself is never stored
+	scannerConfig.Token = nil
+	scannerConfig.TokenExpiresAt = nil
+
+	marshaled, err := json.Marshal(scannerConfig)
+	if err != nil {
+		return models.ScannerConfig{}, fmt.Errorf("failed to convert API model to DB model: %w", err)
+	}
+
+	dbScannerConfig := ScannerConfig{
+		ScanResultID: scanResultID,
+		Data:         marshaled,
+	}
+	dbScannerToken := ScannerToken{
+		TokenHash:    tokenHash,
+		ScanResultID: scanResultID,
+		ExpiresAt:    tokenExpiresAt,
+	}
+	err = s.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&dbScannerConfig).Error; err != nil {
+			return fmt.Errorf("failed to save scanner config in db: %w", err)
+		}
+		// The expired tokens of every ScanResult are dropped, including
+		// the ones of the deleted ScanResults.
+		if err := tx.Where("expires_at <= ?", now).Delete(&ScannerToken{}).Error; err != nil {
+			return fmt.Errorf("failed to delete expired scanner tokens from db: %w", err)
+		}
+		if err := tx.Create(&dbScannerToken).Error; err != nil {
+			return fmt.Errorf("failed to create scanner token in db: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return models.ScannerConfig{}, err // nolint:wrapcheck
+	}
+
+	scannerConfig.TokenExpiresAt = utils.PointerTo(tokenExpiresAt)
+	return scannerConfig, nil
+}
+
+func (s *ScannerConfigsTableHandler) IsScannerTokenValid(scanResultID models.ScanResultID, tokenHash string) (bool, error) {
+	var count int64
+	err := s.DB.Model(&ScannerToken{}).
+		Where("token_hash = ? AND scan_result_id = ? AND expires_at > ?", tokenHash, scanResultID, time.Now()).
+		Count(&count).Error
+	if err != nil {
+		return false, fmt.Errorf("failed to get scanner token from db: %w", err)
+	}
+
+	return count > 0, nil
+}
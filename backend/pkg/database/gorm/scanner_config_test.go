@@ -0,0 +1,69 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+)
+
+func TestScannerConfigsTableHandler_IsScannerTokenValid(t *testing.T) {
+	db, err := NewDatabase(types.DBConfig{
+		DriverType:  types.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("NewDatabase() error = %v", err)
+	}
+	scanResult, err := db.ScanResultsTable().CreateScanResult(models.AssetScanResult{
+		Scan:  &models.ScanRelationship{Id: "scan"},
+		Asset: &models.AssetRelationship{Id: "asset"},
+	})
+	if err != nil {
+		t.Fatalf("CreateScanResult() error = %v", err)
+	}
+	table := db.ScannerConfigsTable()
+	config := models.ScannerConfig{Config: "sbom:\n  enabled: true\n"}
+
+	if _, err := table.SetScannerConfig(*scanResult.Id, config, "expired", time.Now().Add(-time.Minute)); err != nil {
+		t.Fatalf("SetScannerConfig() error = %v", err)
+	}
+	if _, err := table.SetScannerConfig(*scanResult.Id, config, "valid", time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("SetScannerConfig() error = %v", err)
+	}
+
+	for tokenHash, want := range map[string]bool{"valid": true, "expired": false, "unknown": false} {
+		got, err := table.IsScannerTokenValid(*scanResult.Id, tokenHash)
+		if err != nil {
+			t.Fatalf("IsScannerTokenValid() error = %v", err)
+		}
+		if got != want {
+			t.Errorf("IsScannerTokenValid(%q) = %v, want %v", tokenHash, got, want)
+		}
+	}
+
+	// The tokens are deleted along with the ScanResult.
+	if err := db.ScanResultsTable().DeleteScanResult(*scanResult.Id); err != nil {
+		t.Fatalf("DeleteScanResult() error = %v", err)
+	}
+	if got, err := table.IsScannerTokenValid(*scanResult.Id, "valid"); err != nil || got {
+		t.Errorf("IsScannerTokenValid() = %v, %v after the ScanResult was deleted", got, err)
+	}
+}
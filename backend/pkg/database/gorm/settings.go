@@ -0,0 +1,269 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/findingtemplate"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const (
+	retentionSettingName        = "retention"
+	findingTemplatesSettingName = "findingTemplates"
+	maintenanceSettingName      = "maintenance"
+	feedsSettingName            = "feeds"
+)
+
+// Setting is a singleton object of the backend settings, keyed by the name of
+// the setting.
+type Setting struct {
+	Name string `gorm:"primaryKey"`
+	Data datatypes.JSON
+}
+
+type SettingsTableHandler struct {
+	DB *gorm.DB
+}
+
+func (db *Handler) SettingsTable() types.SettingsTable {
+	return &SettingsTableHandler{
+		DB: db.DB,
+	}
+}
+
+func (s *SettingsTableHandler) GetRetentionSettings() (models.RetentionSettings, error) {
+	var retentionSettings models.RetentionSettings
+	if err := s.getSetting(retentionSettingName, &retentionSettings); err != nil {
+		// Nothing is retained by default
+		if errors.Is(err, types.ErrNotFound) {
+			return models.RetentionSettings{}, nil
+		}
+		return models.RetentionSettings{}, err
+	}
+
+	return retentionSettings, nil
+}
+
+func (s *SettingsTableHandler) SetRetentionSettings(retentionSettings models.RetentionSettings) (models.RetentionSettings, error) {
+	if err := validateRetentionSettings(retentionSettings); err != nil {
+		return models.RetentionSettings{}, err
+	}
+
+	var updated models.RetentionSettings
+	err := s.DB.Transaction(func(tx *gorm.DB) error {
+		current, err := (&SettingsTableHandler{DB: tx}).GetRetentionSettings()
+		if err != nil {
+			return err
+		}
+
+		// The outcome of the last run is only recorded by the retention
+		// itself.
+		retentionSettings.LastRun = current.LastRun
+		retentionSettings.UpdatedAt = utils.PointerTo(time.Now())
+
+		updated = retentionSettings
+		return s.saveSetting(tx, retentionSettingName, retentionSettings)
+	})
+	if err != nil {
+		return models.RetentionSettings{}, err
+	}
+
+	return updated, nil
+}
+
+func (s *SettingsTableHandler) SetRetentionRun(run models.RetentionRun) error {
+	return s.DB.Transaction(func(tx *gorm.DB) error {
+		retentionSettings, err := (&SettingsTableHandler{DB: tx}).GetRetentionSettings()
+		if err != nil {
+			return err
+		}
+
+		retentionSettings.LastRun = &run
+		return s.saveSetting(tx, retentionSettingName, retentionSettings)
+	})
+}
+
+func (s *SettingsTableHandler) GetFindingTemplateSettings() (models.FindingTemplateSettings, error) {
+	var findingTemplateSettings models.FindingTemplateSettings
+	if err := s.getSetting(findingTemplatesSettingName, &findingTemplateSettings); err != nil {
+		// The built-in templates are used by default
+		if errors.Is(err, types.ErrNotFound) {
+			return models.FindingTemplateSettings{}, nil
+		}
+		return models.FindingTemplateSettings{}, err
+	}
+
+	return findingTemplateSettings, nil
+}
+
+func (s *SettingsTableHandler) SetFindingTemplateSettings(findingTemplateSettings models.FindingTemplateSettings) (models.FindingTemplateSettings, error) {
+	if _, err := findingtemplate.Parse(findingTemplateSettings); err != nil {
+		return models.FindingTemplateSettings{}, &common.BadRequestError{
+			Reason: err.Error(),
+		}
+	}
+
+	findingTemplateSettings.UpdatedAt = utils.PointerTo(time.Now())
+	if err := s.saveSetting(s.DB, findingTemplatesSettingName, findingTemplateSettings); err != nil {
+		return models.FindingTemplateSettings{}, err
+	}
+
+	return findingTemplateSettings, nil
+}
+
+func (s *SettingsTableHandler) GetMaintenanceMode() (models.MaintenanceMode, error) {
+	var maintenanceMode models.MaintenanceMode
+	if err := s.getSetting(maintenanceSettingName, &maintenanceMode); err != nil {
+		// The backend is not in maintenance mode by default
+		if errors.Is(err, types.ErrNotFound) {
+			return models.MaintenanceMode{}, nil
+		}
+		return models.MaintenanceMode{}, err
+	}
+
+	return maintenanceMode, nil
+}
+
+func (s *SettingsTableHandler) SetMaintenanceMode(maintenanceMode models.MaintenanceMode) (models.MaintenanceMode, error) {
+	if err := validateMaintenanceMode(maintenanceMode); err != nil {
+		return models.MaintenanceMode{}, err
+	}
+
+	maintenanceMode.UpdatedAt = utils.PointerTo(time.Now())
+	if err := s.saveSetting(s.DB, maintenanceSettingName, maintenanceMode); err != nil {
+		return models.MaintenanceMode{}, err
+	}
+
+	return maintenanceMode, nil
+}
+
+func (s *SettingsTableHandler) GetFeeds() ([]models.Feed, error) {
+	var feeds models.Feeds
+	if err := s.getSetting(feedsSettingName, &feeds); err != nil {
+		// No feed has been synced yet
+		if errors.Is(err, types.ErrNotFound) {
+			return []models.Feed{}, nil
+		}
+		return nil, err
+	}
+
+	return utils.ValueOrZero(feeds.Items), nil
+}
+
+func (s *SettingsTableHandler) SetFeed(feed models.Feed) error {
+	if feed.Name == nil || *feed.Name == "" {
+		return &common.BadRequestError{
+			Reason: "feed name is required",
+		}
+	}
+
+	return s.DB.Transaction(func(tx *gorm.DB) error {
+		feeds, err := (&SettingsTableHandler{DB: tx}).GetFeeds()
+		if err != nil {
+			return err
+		}
+
+		replaced := false
+		for i := range feeds {
+			if utils.ValueOrZero(feeds[i].Name) == *feed.Name {
+				feeds[i] = feed
+				replaced = true
+			}
+		}
+		if !replaced {
+			feeds = append(feeds, feed)
+		}
+
+		return s.saveSetting(tx, feedsSettingName, models.Feeds{Items: &feeds})
+	})
+}
+
+func (s *SettingsTableHandler) getSetting(name string, setting interface{}) error {
+	var dbSetting Setting
+	if err := s.DB.Where("name = ?", name).First(&dbSetting).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return types.ErrNotFound
+		}
+		return fmt.Errorf("failed to get %s setting from db: %w", name, err)
+	}
+
+	if err := json.Unmarshal(dbSetting.Data, setting); err != nil {
+		return fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	return nil
+}
+
+func (s *SettingsTableHandler) saveSetting(tx *gorm.DB, name string, setting interface{}) error {
+	marshaled, err := json.Marshal(setting)
+	if err != nil {
+		return fmt.Errorf("failed to convert API model to DB model: %w", err)
+	}
+
+	dbSetting := Setting{
+		Name: name,
+		Data: marshaled,
+	}
+	if err = tx.Save(&dbSetting).Error; err != nil {
+		return fmt.Errorf("failed to save %s setting in db: %w", name, err)
+	}
+
+	return nil
+}
+
+func validateRetentionSettings(retentionSettings models.RetentionSettings) error {
+	if retentionSettings.Scans != nil {
+		if utils.ValueOrZero(retentionSettings.Scans.MaxAgeDays) < 0 {
+			return &common.BadRequestError{
+				Reason: "scans.maxAgeDays can not be negative",
+			}
+		}
+		if utils.ValueOrZero(retentionSettings.Scans.MaxScansPerScanConfig) < 0 {
+			return &common.BadRequestError{
+				Reason: "scans.maxScansPerScanConfig can not be negative",
+			}
+		}
+	}
+
+	if retentionSettings.Findings != nil && utils.ValueOrZero(retentionSettings.Findings.MaxAgeDays) < 0 {
+		return &common.BadRequestError{
+			Reason: "findings.maxAgeDays can not be negative",
+		}
+	}
+
+	return nil
+}
+
+func validateMaintenanceMode(maintenanceMode models.MaintenanceMode) error {
+	if maintenanceMode.RetryAfterSeconds != nil && *maintenanceMode.RetryAfterSeconds < 1 {
+		return &common.BadRequestError{
+			Reason: "retryAfterSeconds must be positive",
+		}
+	}
+
+	return nil
+}
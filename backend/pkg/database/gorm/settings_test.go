@@ -0,0 +1,103 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"testing"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func Test_validateRetentionSettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings models.RetentionSettings
+		wantErr  bool
+	}{
+		{
+			name:     "empty",
+			settings: models.RetentionSettings{},
+			wantErr:  false,
+		},
+		{
+			name: "valid",
+			settings: models.RetentionSettings{
+				Enabled:  utils.PointerTo(true),
+				Scans:    &models.ScansRetentionPolicy{MaxAgeDays: utils.PointerTo(90), MaxScansPerScanConfig: utils.PointerTo(0)},
+				Findings: &models.FindingsRetentionPolicy{MaxAgeDays: utils.PointerTo(30)},
+			},
+			wantErr: false,
+		},
+		{
+			name:     "negative scans max age",
+			settings: models.RetentionSettings{Scans: &models.ScansRetentionPolicy{MaxAgeDays: utils.PointerTo(-1)}},
+			wantErr:  true,
+		},
+		{
+			name:     "negative scans per scan config",
+			settings: models.RetentionSettings{Scans: &models.ScansRetentionPolicy{MaxScansPerScanConfig: utils.PointerTo(-1)}},
+			wantErr:  true,
+		},
+		{
+			name:     "negative findings max age",
+			settings: models.RetentionSettings{Findings: &models.FindingsRetentionPolicy{MaxAgeDays: utils.PointerTo(-1)}},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateRetentionSettings(tt.settings); (err != nil) != tt.wantErr {
+				t.Errorf("validateRetentionSettings() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_validateMaintenanceMode(t *testing.T) {
+	tests := []struct {
+		name            string
+		maintenanceMode models.MaintenanceMode
+		wantErr         bool
+	}{
+		{
+			name:            "disabled",
+			maintenanceMode: models.MaintenanceMode{},
+			wantErr:         false,
+		},
+		{
+			name: "valid",
+			maintenanceMode: models.MaintenanceMode{
+				Enabled:           true,
+				Reason:            utils.PointerTo("database migration"),
+				RetryAfterSeconds: utils.PointerTo(60),
+			},
+			wantErr: false,
+		},
+		{
+			name:            "zero retry after",
+			maintenanceMode: models.MaintenanceMode{Enabled: true, RetryAfterSeconds: utils.PointerTo(0)},
+			wantErr:         true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateMaintenanceMode(tt.maintenanceMode); (err != nil) != tt.wantErr {
+				t.Errorf("validateMaintenanceMode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
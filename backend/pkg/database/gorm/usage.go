@@ -0,0 +1,80 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+type UsageStatsHandler struct {
+	DB *gorm.DB
+}
+
+func (db *Handler) UsageStats() types.UsageStats {
+	return &UsageStatsHandler{
+		DB: db.DB,
+	}
+}
+
+func (u *UsageStatsHandler) GetObjectCounts() (models.ObjectCounts, error) {
+	var assets, scanConfigs, scans, scanResults, findings int64
+	for _, c := range []struct {
+		model interface{}
+		count *int64
+	}{
+		{&Asset{}, &assets},
+		{&ScanConfig{}, &scanConfigs},
+		{&Scan{}, &scans},
+		{&ScanResult{}, &scanResults},
+		{&Finding{}, &findings},
+	} {
+		if err := u.DB.Model(c.model).Count(c.count).Error; err != nil {
+			return models.ObjectCounts{}, fmt.Errorf("failed to count objects: %w", err)
+		}
+	}
+
+	return models.ObjectCounts{
+		Assets:      utils.PointerTo(int(assets)),
+		ScanConfigs: utils.PointerTo(int(scanConfigs)),
+		Scans:       utils.PointerTo(int(scans)),
+		ScanResults: utils.PointerTo(int(scanResults)),
+		Findings:    utils.PointerTo(int(findings)),
+	}, nil
+}
+
+func (u *UsageStatsHandler) GetDatabaseSize() (int64, error) {
+	var query string
+	switch u.DB.Dialector.Name() {
+	case "sqlite":
+		query = "SELECT page_count * page_size FROM pragma_page_count(), pragma_page_size()"
+	case "postgres":
+		query = "SELECT pg_database_size(current_database())"
+	default:
+		return 0, fmt.Errorf("unsupported database dialect %s", u.DB.Dialector.Name())
+	}
+
+	var size int64
+	if err := u.DB.Raw(query).Scan(&size).Error; err != nil {
+		return 0, fmt.Errorf("failed to query database size: %w", err)
+	}
+	return size, nil
+}
@@ -0,0 +1,83 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gorm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// UserPreferences are keyed by the identity of the user as it is reported by
+// the authenticating proxy.
+type UserPreferences struct {
+	Identity string `gorm:"primaryKey"`
+	Data     datatypes.JSON
+}
+
+type UserPreferencesTableHandler struct {
+	DB *gorm.DB
+}
+
+func (db *Handler) UserPreferencesTable() types.UserPreferencesTable {
+	return &UserPreferencesTableHandler{
+		DB: db.DB,
+	}
+}
+
+func (u *UserPreferencesTableHandler) GetUserPreferences(identity string) (models.UserPreferences, error) {
+	var dbUserPreferences UserPreferences
+	if err := u.DB.Where("identity = ?", identity).First(&dbUserPreferences).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.UserPreferences{}, types.ErrNotFound
+		}
+		return models.UserPreferences{}, fmt.Errorf("failed to get user preferences from db: %w", err)
+	}
+
+	var userPreferences models.UserPreferences
+	if err := json.Unmarshal(dbUserPreferences.Data, &userPreferences); err != nil {
+		return models.UserPreferences{}, fmt.Errorf("failed to convert DB model to API model: %w", err)
+	}
+
+	return userPreferences, nil
+}
+
+func (u *UserPreferencesTableHandler) SetUserPreferences(identity string, userPreferences models.UserPreferences) (models.UserPreferences, error) {
+	userPreferences.UpdatedAt = utils.PointerTo(time.Now())
+
+	marshaled, err := json.Marshal(userPreferences)
+	if err != nil {
+		return models.UserPreferences{}, fmt.Errorf("failed to convert API model to DB model: %w", err)
+	}
+
+	dbUserPreferences := UserPreferences{
+		Identity: identity,
+		Data:     marshaled,
+	}
+	if err = u.DB.Save(&dbUserPreferences).Error; err != nil {
+		return models.UserPreferences{}, fmt.Errorf("failed to save user preferences in db: %w", err)
+	}
+
+	return userPreferences, nil
+}
@@ -0,0 +1,248 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package odatasql
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/CiscoM31/godata"
+)
+
+// maxSuggestions is the maximum number of property names suggested in place
+// of an unknown one.
+const maxSuggestions = 3
+
+// QueryError describes an invalid OData query option, with enough detail for
+// a client to point the user at the offending part of the option.
+type QueryError struct {
+	// Option is the name of the invalid query option, e.g. $filter.
+	Option string
+	// Message describes the problem.
+	Message string
+	// Position is the zero based byte offset of Segment in the value of the
+	// option, or -1 if it is unknown.
+	Position int
+	// Segment is the offending part of the option value, if known.
+	Segment string
+	// Suggestions are valid property paths which are close to Segment.
+	Suggestions []string
+}
+
+func (e *QueryError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "invalid %s: %s", e.Option, e.Message)
+	if e.Position >= 0 {
+		fmt.Fprintf(&b, " at position %d", e.Position)
+	}
+	if len(e.Suggestions) > 0 {
+		fmt.Fprintf(&b, ", did you mean %s?", strings.Join(e.Suggestions, " or "))
+	}
+	return b.String()
+}
+
+// invalidTokenRegex matches the error godata returns when it can't tokenize
+// the remainder of the value.
+var invalidTokenRegex = regexp.MustCompile(`^Token '(?s:(.*))' is invalid$`)
+
+// newParseError returns a QueryError for the err error returned by godata
+// while parsing the value of option.
+func newParseError(option, value string, err error) *QueryError {
+	queryErr := &QueryError{
+		Option:   option,
+		Message:  err.Error(),
+		Position: -1,
+	}
+
+	var godataErr *godata.GoDataError
+	if errors.As(err, &godataErr) {
+		queryErr.Message = godataErr.Message
+		// The tokenizer consumes the value from the start, so the
+		// invalid remainder is always a suffix of the value.
+		if m := invalidTokenRegex.FindStringSubmatch(godataErr.Message); m != nil && strings.HasSuffix(value, m[1]) {
+			queryErr.Message = "invalid token"
+			queryErr.Segment = m[1]
+			queryErr.Position = len(value) - len(m[1])
+		}
+	}
+
+	return queryErr
+}
+
+// unknownPropertyError is returned by findUnknownProperty for a path which
+// doesn't exist in the schema.
+type unknownPropertyError struct {
+	// Path is the path up to and including the unknown property.
+	Path string
+	// Candidates are the valid paths in place of Path.
+	Candidates []string
+}
+
+// findUnknownProperty returns the first property of the path, in OData
+// format like "Thing/Name", which doesn't exist in the field, or nil if all
+// of them do. Properties below a primitive field aren't checked as they may
+// be keys of a JSON object, e.g. an annotation.
+// nolint:cyclop
+func findUnknownProperty(schemaMetas map[string]SchemaMeta, field FieldMeta, prefix, path string) *unknownPropertyError {
+	if path == "" {
+		return nil
+	}
+
+	var schemas []string
+	switch field.FieldType {
+	case PrimitiveFieldType:
+		return nil
+	case CollectionFieldType:
+		return findUnknownProperty(schemaMetas, *field.CollectionItemMeta, prefix, path)
+	case RelationshipFieldType:
+		schemas = []string{field.RelationshipSchema}
+	case ComplexFieldType:
+		schemas = field.ComplexFieldSchemas
+	default:
+		return nil
+	}
+
+	name, remainder, _ := strings.Cut(path, "/")
+	fieldPath := name
+	if prefix != "" {
+		fieldPath = prefix + "/" + name
+	}
+
+	// A complex field may be one of several schemas, the path is valid if
+	// it exists in any of them.
+	var firstErr *unknownPropertyError
+	found := false
+	candidates := map[string]struct{}{}
+	for _, schemaName := range schemas {
+		schema := schemaMetas[schemaName]
+		for candidate := range schema.Fields {
+			candidates[candidate] = struct{}{}
+		}
+		newField, ok := schema.Fields[name]
+		if !ok {
+			continue
+		}
+		found = true
+		err := findUnknownProperty(schemaMetas, newField, fieldPath, remainder)
+		if err == nil {
+			return nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	if found {
+		return firstErr
+	}
+
+	err := &unknownPropertyError{Path: fieldPath}
+	for candidate := range candidates {
+		if prefix != "" {
+			candidate = prefix + "/" + candidate
+		}
+		err.Candidates = append(err.Candidates, candidate)
+	}
+	return err
+}
+
+// newUnknownPropertyError returns a QueryError for the unknown property err
+// found in the value of option.
+func newUnknownPropertyError(option, value string, err *unknownPropertyError) *QueryError {
+	return &QueryError{
+		Option:      option,
+		Message:     fmt.Sprintf("unknown property %s", err.Path),
+		Position:    strings.Index(value, err.Path),
+		Segment:     err.Path,
+		Suggestions: suggest(err.Path, err.Candidates),
+	}
+}
+
+// suggest returns the candidate paths whose last property is the closest to
+// the last property of path, ignoring the case, if they are close enough to
+// be a likely typo.
+func suggest(path string, candidates []string) []string {
+	type suggestion struct {
+		candidate string
+		distance  int
+	}
+
+	name := strings.ToLower(lastProperty(path))
+	var suggestions []suggestion
+	for _, candidate := range candidates {
+		candidateName := lastProperty(candidate)
+		distance := levenshtein(name, strings.ToLower(candidateName))
+		// nolint:gomnd
+		if distance > len(candidateName)/3+1 {
+			continue
+		}
+		suggestions = append(suggestions, suggestion{candidate, distance})
+	}
+
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].distance != suggestions[j].distance {
+			return suggestions[i].distance < suggestions[j].distance
+		}
+		return suggestions[i].candidate < suggestions[j].candidate
+	})
+	if len(suggestions) > maxSuggestions {
+		suggestions = suggestions[:maxSuggestions]
+	}
+
+	var result []string
+	for _, s := range suggestions {
+		result = append(result, s.candidate)
+	}
+	return result
+}
+
+func lastProperty(path string) string {
+	return path[strings.LastIndex(path, "/")+1:]
+}
+
+// levenshtein returns the edit distance of the a and b strings.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+func minInt(first int, rest ...int) int {
+	m := first
+	for _, v := range rest {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,197 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package odatasql
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/backend/pkg/database/odatasql/jsonsql"
+)
+
+func TestBuildSQLQueryErrors(t *testing.T) {
+	type args struct {
+		filterString  *string
+		expandString  *string
+		orderbyString *string
+	}
+	tests := []struct {
+		name string
+		args args
+		want *QueryError
+	}{
+		{
+			name: "invalid token in filter",
+			args: args{
+				filterString: PointerTo("ModelName eq $$"),
+			},
+			want: &QueryError{
+				Option:   "$filter",
+				Message:  "invalid token",
+				Position: 13,
+				Segment:  "$$",
+			},
+		},
+		{
+			name: "misspelled property in filter",
+			args: args{
+				filterString: PointerTo("Seats eq 4 and ModelNme eq 'A'"),
+			},
+			want: &QueryError{
+				Option:      "$filter",
+				Message:     "unknown property ModelNme",
+				Position:    15,
+				Segment:     "ModelNme",
+				Suggestions: []string{"ModelName"},
+			},
+		},
+		{
+			name: "misspelled nested property in filter",
+			args: args{
+				filterString: PointerTo("Engine/Options/supercharger eq true"),
+			},
+			want: &QueryError{
+				Option:      "$filter",
+				Message:     "unknown property Engine/Options/supercharger",
+				Position:    0,
+				Segment:     "Engine/Options/supercharger",
+				Suggestions: []string{"Engine/Options/Supercharger"},
+			},
+		},
+		{
+			name: "misspelled property in lambda predicate",
+			args: args{
+				filterString: PointerTo("Manufacturers/any(m: m/Nme eq 'manu1')"),
+			},
+			want: &QueryError{
+				Option:      "$filter",
+				Message:     "unknown property m/Nme",
+				Position:    21,
+				Segment:     "m/Nme",
+				Suggestions: []string{"m/Name"},
+			},
+		},
+		{
+			name: "property of one of the complex schemas in filter",
+			args: args{
+				filterString: PointerTo("MainStereo/Frequency eq '101.1'"),
+			},
+		},
+		{
+			name: "unknown property without suggestions in orderby",
+			args: args{
+				orderbyString: PointerTo("Color desc"),
+			},
+			want: &QueryError{
+				Option:   "$orderby",
+				Message:  "unknown property Color",
+				Position: 0,
+				Segment:  "Color",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := BuildSQLQuery(jsonsql.SQLite, carSchemaMetas, "Car", tt.args.filterString, nil, nil, tt.args.expandString, tt.args.orderbyString, nil, nil)
+			if tt.want == nil {
+				if err != nil {
+					t.Errorf("BuildSQLQuery() unexpected error = %v", err)
+				}
+				return
+			}
+
+			var queryErr *QueryError
+			if !errors.As(err, &queryErr) {
+				t.Fatalf("BuildSQLQuery() error = %v, want QueryError", err)
+			}
+			if diff := cmp.Diff(tt.want, queryErr); diff != "" {
+				t.Errorf("BuildSQLQuery() QueryError mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestQueryErrorError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *QueryError
+		want string
+	}{
+		{
+			name: "unknown position",
+			err: &QueryError{
+				Option:   "$filter",
+				Message:  "failed to build query",
+				Position: -1,
+			},
+			want: "invalid $filter: failed to build query",
+		},
+		{
+			name: "position and suggestions",
+			err: &QueryError{
+				Option:      "$filter",
+				Message:     "unknown property ModelNme",
+				Position:    15,
+				Segment:     "ModelNme",
+				Suggestions: []string{"ModelName"},
+			},
+			want: "invalid $filter: unknown property ModelNme at position 15, did you mean ModelName?",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.want {
+				t.Errorf("Error() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	tests := []struct {
+		name       string
+		path       string
+		candidates []string
+		want       []string
+	}{
+		{
+			name:       "case only",
+			path:       "asset/ID",
+			candidates: []string{"asset/id", "asset/revision", "asset/assetInfo"},
+			want:       []string{"asset/id"},
+		},
+		{
+			name:       "ordered by distance",
+			path:       "Brnd",
+			candidates: []string{"Bran", "Brand", "ObjectType"},
+			want:       []string{"Brand", "Bran"},
+		},
+		{
+			name:       "nothing close",
+			path:       "Color",
+			candidates: []string{"Brand", "ObjectType"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, suggest(tt.path, tt.candidates)); diff != "" {
+				t.Errorf("suggest() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
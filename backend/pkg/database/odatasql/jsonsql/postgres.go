@@ -73,3 +73,7 @@ func (postgres) JSONQuote(value string) string {
 func (postgres) JSONCast(value string) string {
 	return fmt.Sprintf("TO_JSONB(%s)", value)
 }
+
+func (postgres) TextContains(text, pattern string) string {
+	return fmt.Sprintf("%s ILIKE %s ESCAPE '\\'", text, pattern)
+}
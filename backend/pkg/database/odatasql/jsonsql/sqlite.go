@@ -59,3 +59,7 @@ func (sqlite) JSONQuote(value string) string {
 func (sqlite) JSONCast(value string) string {
 	return fmt.Sprintf("JSON(%s)", value)
 }
+
+func (sqlite) TextContains(text, pattern string) string {
+	return fmt.Sprintf("%s LIKE %s ESCAPE '\\'", text, pattern)
+}
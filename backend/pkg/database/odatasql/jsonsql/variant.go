@@ -25,4 +25,8 @@ type Variant interface {
 	JSONExtractText(source string, path string) string
 	JSONQuote(value string) string
 	JSONCast(value string) string
+	// TextContains returns the condition of the text matching the LIKE
+	// pattern, a string literal escaping the wildcards with a backslash,
+	// regardless of case.
+	TextContains(text string, pattern string) string
 }
@@ -18,6 +18,7 @@ package odatasql
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"sync"
 
@@ -32,7 +33,7 @@ type jsonExtractFunctionType func(string, string) string
 var fixSelectToken sync.Once
 
 // nolint:cyclop
-func BuildCountQuery(sqlVariant jsonsql.Variant, schemaMetas map[string]SchemaMeta, schema string, filterString *string) (string, error) {
+func BuildCountQuery(sqlVariant jsonsql.Variant, schemaMetas map[string]SchemaMeta, schema string, filterString, searchString *string) (string, error) {
 	table := schemaMetas[schema].Table
 	if table == "" {
 		return "", fmt.Errorf("trying to query complex type schema %s with no source table", schema)
@@ -43,28 +44,16 @@ func BuildCountQuery(sqlVariant jsonsql.Variant, schemaMetas map[string]SchemaMe
 	// complex field meta to represent that object
 	rootObject := FieldMeta{FieldType: ComplexFieldType, ComplexFieldSchemas: []string{schema}}
 
-	// Parse top level $filter and create the top level "WHERE"
-	var where string
-	if filterString != nil && *filterString != "" {
-		filterQuery, err := godata.ParseFilterString(context.TODO(), *filterString)
-		if err != nil {
-			return "", fmt.Errorf("failed to parse $filter: %w", err)
-		}
-
-		// Build the WHERE conditions based on the $filter tree
-		conditions, err := buildWhereFromFilter(sqlVariant, schemaMetas, rootObject, schema, fmt.Sprintf("%s.Data", table), filterQuery.Tree)
-		if err != nil {
-			return "", fmt.Errorf("failed to build DB query from $filter: %w", err)
-		}
-
-		where = fmt.Sprintf("WHERE %s", conditions)
+	where, err := buildWhere(sqlVariant, schemaMetas, rootObject, schema, table, filterString, searchString)
+	if err != nil {
+		return "", err
 	}
 
 	return fmt.Sprintf("SELECT COUNT(*) FROM %s %s", table, where), nil
 }
 
 // nolint:cyclop,gocognit
-func BuildSQLQuery(sqlVariant jsonsql.Variant, schemaMetas map[string]SchemaMeta, schema string, filterString, selectString, expandString, orderbyString *string, top, skip *int) (string, error) {
+func BuildSQLQuery(sqlVariant jsonsql.Variant, schemaMetas map[string]SchemaMeta, schema string, filterString, searchString, selectString, expandString, orderbyString *string, top, skip *int) (string, error) {
 	// Fix GlobalExpandTokenizer so that it allows for `-` characters in the Literal tokens
 	fixSelectToken.Do(func() {
 		godata.GlobalExpandTokenizer.Add("^[a-zA-Z0-9_\\'\\.:\\$ \\*-]+", godata.ExpandTokenLiteral)
@@ -80,33 +69,25 @@ func BuildSQLQuery(sqlVariant jsonsql.Variant, schemaMetas map[string]SchemaMeta
 	// complex field meta to represent that object
 	rootObject := FieldMeta{FieldType: ComplexFieldType, ComplexFieldSchemas: []string{schema}}
 
-	// Parse top level $filter and create the top level "WHERE"
-	var where string
-	if filterString != nil && *filterString != "" {
-		filterQuery, err := godata.ParseFilterString(context.TODO(), *filterString)
-		if err != nil {
-			return "", fmt.Errorf("failed to parse $filter: %w", err)
-		}
-
-		// Build the WHERE conditions based on the $filter tree
-		conditions, err := buildWhereFromFilter(sqlVariant, schemaMetas, rootObject, schema, fmt.Sprintf("%s.Data", table), filterQuery.Tree)
-		if err != nil {
-			return "", fmt.Errorf("failed to build DB query from $filter: %w", err)
-		}
-
-		where = fmt.Sprintf("WHERE %s", conditions)
+	where, err := buildWhere(sqlVariant, schemaMetas, rootObject, schema, table, filterString, searchString)
+	if err != nil {
+		return "", err
 	}
 
 	var orderby string
 	if orderbyString != nil && *orderbyString != "" {
 		orderbyQuery, err := godata.ParseOrderByString(context.TODO(), *orderbyString)
 		if err != nil {
-			return "", fmt.Errorf("failed to parse $orderby: %w", err)
+			return "", newParseError("$orderby", *orderbyString, err)
+		}
+
+		if err := validateOrderByProperties(schemaMetas, rootObject, *orderbyString, orderbyQuery.OrderByItems); err != nil {
+			return "", err
 		}
 
 		conditions, err := buildOrderByFromOdata(sqlVariant, schemaMetas, rootObject, schema, fmt.Sprintf("%s.Data", table), orderbyQuery.OrderByItems)
 		if err != nil {
-			return "", fmt.Errorf("failed to build DB query from $orderby: %w", err)
+			return "", &QueryError{Option: "$orderby", Message: err.Error(), Position: -1}
 		}
 
 		orderby = fmt.Sprintf("ORDER BY %s", conditions)
@@ -134,6 +115,43 @@ func BuildSQLQuery(sqlVariant jsonsql.Variant, schemaMetas map[string]SchemaMeta
 	return fmt.Sprintf("SELECT ID, %s AS Data FROM %s %s %s %s", selectFields, table, where, orderby, limitStm), nil
 }
 
+// buildWhere returns the top level "WHERE" of the $filter and $search, or an
+// empty string if neither is set.
+func buildWhere(sqlVariant jsonsql.Variant, schemaMetas map[string]SchemaMeta, rootObject FieldMeta, schema, table string, filterString, searchString *string) (string, error) {
+	var conditions []string
+
+	if filterString != nil && *filterString != "" {
+		filterQuery, err := godata.ParseFilterString(context.TODO(), *filterString)
+		if err != nil {
+			return "", newParseError("$filter", *filterString, err)
+		}
+
+		if err := validateFilterProperties(schemaMetas, rootObject, *filterString, filterQuery.Tree); err != nil {
+			return "", err
+		}
+
+		// Build the WHERE conditions based on the $filter tree
+		condition, err := buildWhereFromFilter(sqlVariant, schemaMetas, rootObject, schema, fmt.Sprintf("%s.Data", table), filterQuery.Tree)
+		if err != nil {
+			return "", &QueryError{Option: "$filter", Message: err.Error(), Position: -1}
+		}
+		conditions = append(conditions, condition)
+	}
+
+	if searchString != nil && strings.TrimSpace(*searchString) != "" {
+		condition, err := buildWhereFromSearch(sqlVariant, schemaMetas, rootObject, schema, fmt.Sprintf("%s.Data", table), *searchString)
+		if err != nil {
+			return "", err
+		}
+		conditions = append(conditions, condition)
+	}
+
+	if len(conditions) == 0 {
+		return "", nil
+	}
+	return fmt.Sprintf("WHERE %s", strings.Join(conditions, " AND ")), nil
+}
+
 func buildSelectFieldsFromSelectAndExpand(sqlVariant jsonsql.Variant, schemaMetas map[string]SchemaMeta, rootObject FieldMeta, identifier string, source string, selectString, expandString *string) (string, error) {
 	var selectQuery *godata.GoDataSelectQuery
 	if selectString != nil && *selectString != "" {
@@ -152,7 +170,7 @@ func buildSelectFieldsFromSelectAndExpand(sqlVariant jsonsql.Variant, schemaMeta
 		var err error
 		expandQuery, err = godata.ParseExpandString(context.TODO(), *expandString)
 		if err != nil {
-			return "", fmt.Errorf("failed to parse $expand ")
+			return "", newParseError("$expand", *expandString, err)
 		}
 	}
 
@@ -417,6 +435,13 @@ func singleQuote(s string) string {
 	return fmt.Sprintf("'%s'", s)
 }
 
+// sqlStringLiteral converts a single quoted OData string token, whose inner
+// quotes are already unescaped, to a SQL string literal.
+func sqlStringLiteral(token string) string {
+	inner := strings.TrimSuffix(strings.TrimPrefix(token, "'"), "'")
+	return singleQuote(strings.ReplaceAll(inner, "'", "''"))
+}
+
 func buildJSONPathFromParseNode(node *godata.ParseNode) (string, error) {
 	switch node.Token.Type {
 	case godata.ExpressionTokenNav:
@@ -498,6 +523,10 @@ func expandItemsToReachPath(schemaMetas map[string]SchemaMeta, field FieldMeta,
 // TODO: create a unit test
 // nolint:cyclop
 func buildWhereFromFilter(sqlVariant jsonsql.Variant, schemaMetas map[string]SchemaMeta, field FieldMeta, identifier string, source string, node *godata.ParseNode) (string, error) {
+	if node.Token.Type == godata.ExpressionTokenLambdaNav {
+		return buildWhereFromLambda(sqlVariant, schemaMetas, field, identifier, source, node)
+	}
+
 	operator := node.Token.Value
 
 	var query string
@@ -524,9 +553,10 @@ func buildWhereFromFilter(sqlVariant jsonsql.Variant, schemaMetas map[string]Sch
 		switch rhs.Token.Type { // TODO: implement all the relevant cases as ExpressionTokenDate and ExpressionTokenDateTime
 		case godata.ExpressionTokenString:
 			// rhs.Token.Value is already enforced to be single
-			// quoted by the odata validation so we can pass it
-			// straight in to json quote.
-			value = sqlVariant.JSONQuote(rhs.Token.Value)
+			// quoted by the odata validation, but the quotes
+			// escaped in it are unescaped by the parser so they
+			// need to be escaped again before the json quote.
+			value = sqlVariant.JSONQuote(sqlStringLiteral(rhs.Token.Value))
 		case godata.ExpressionTokenBoolean:
 			value = singleQuote(rhs.Token.Value)
 		case godata.ExpressionTokenInteger, godata.ExpressionTokenFloat:
@@ -604,6 +634,190 @@ func buildWhereFromFilter(sqlVariant jsonsql.Variant, schemaMetas map[string]Sch
 	return query, nil
 }
 
+// lambdaIdentifierRegex matches the characters which can't be part of a SQL
+// identifier.
+var lambdaIdentifierRegex = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// buildWhereFromLambda returns the condition for an any or all lambda
+// operator, like "Things/any(t: t/Name eq 'foo')", as an EXISTS sub query
+// over the items of the collection.
+//
+// The predicate is built against each item wrapped in an object with the
+// lambda variable as its only property, so that paths of the predicate like
+// "t/Name" can be resolved the same way as paths of the root object,
+// including expanding relationships.
+// nolint:cyclop
+func buildWhereFromLambda(sqlVariant jsonsql.Variant, schemaMetas map[string]SchemaMeta, field FieldMeta, identifier string, source string, node *godata.ParseNode) (string, error) {
+	collectionPath, err := buildJSONPathFromParseNode(node.Children[0])
+	if err != nil {
+		return "", fmt.Errorf("unable to covert oData path to json path: %w", err)
+	}
+
+	collectionField, err := fieldMetaFromPath(schemaMetas, field, collectionPath)
+	if err != nil {
+		return "", err
+	}
+	if collectionField.FieldType != CollectionFieldType {
+		return "", fmt.Errorf("lambda operator on %s which is not a collection", collectionPath)
+	}
+
+	fieldSource, err := sourceFromQueryPath(sqlVariant, schemaMetas, field, identifier, source, collectionPath)
+	if err != nil {
+		return "", fmt.Errorf("unable to build source for filter %w", err)
+	}
+
+	lambda := node.Children[1]
+	newIdentifier := fmt.Sprintf("%s%s", lambdaIdentifierRegex.ReplaceAllString(identifier, ""), strings.ReplaceAll(collectionPath, ".", ""))
+	from := fmt.Sprintf("%s AS %s", sqlVariant.JSONEach(sqlVariant.JSONExtract(fieldSource, fmt.Sprintf("$.%s", collectionPath))), newIdentifier)
+
+	// any() without a predicate matches non-empty collections.
+	if len(lambda.Children) == 0 {
+		if lambda.Token.Value != "any" {
+			return "", fmt.Errorf("lambda operator %s requires a predicate", lambda.Token.Value)
+		}
+		return fmt.Sprintf("EXISTS (SELECT 1 FROM %s)", from), nil
+	}
+	if len(lambda.Children) != 2 { // nolint:gomnd
+		return "", fmt.Errorf("invalid lambda operator %s", lambda.Token.Value)
+	}
+
+	variable := lambda.Children[0].Token.Value
+	lambdaSchemaMetas, lambdaField := newLambdaSchemaMetas(schemaMetas, variable, *collectionField.CollectionItemMeta)
+	itemSource := sqlVariant.JSONObject([]string{fmt.Sprintf("'%s', %s.value", variable, newIdentifier)})
+
+	predicate, err := buildWhereFromFilter(sqlVariant, lambdaSchemaMetas, lambdaField, newIdentifier, itemSource, lambda.Children[1])
+	if err != nil {
+		return "", fmt.Errorf("unable to build predicate of lambda operator %s: %w", lambda.Token.Value, err)
+	}
+
+	switch lambda.Token.Value {
+	case "any":
+		return fmt.Sprintf("EXISTS (SELECT 1 FROM %s WHERE %s)", from, predicate), nil
+	case "all":
+		// Items for which the predicate is NULL, e.g. because the
+		// compared property is missing, don't satisfy it.
+		return fmt.Sprintf("NOT EXISTS (SELECT 1 FROM %s WHERE (%s) IS NOT TRUE)", from, predicate), nil
+	default:
+		return "", fmt.Errorf("unsupported lambda operator %s", lambda.Token.Value)
+	}
+}
+
+// newLambdaSchemaMetas returns the schemaMetas extended with a schema for
+// the item of a lambda operator, and the field of an object holding the item
+// as the variable property.
+func newLambdaSchemaMetas(schemaMetas map[string]SchemaMeta, variable string, itemMeta FieldMeta) (map[string]SchemaMeta, FieldMeta) {
+	lambdaSchema := fmt.Sprintf("$lambda/%s", variable)
+
+	lambdaSchemaMetas := make(map[string]SchemaMeta, len(schemaMetas)+1)
+	for name, schema := range schemaMetas {
+		lambdaSchemaMetas[name] = schema
+	}
+	lambdaSchemaMetas[lambdaSchema] = SchemaMeta{
+		Fields: map[string]FieldMeta{
+			variable: itemMeta,
+		},
+	}
+
+	return lambdaSchemaMetas, FieldMeta{
+		FieldType:           ComplexFieldType,
+		ComplexFieldSchemas: []string{lambdaSchema},
+	}
+}
+
+// fieldMetaFromPath returns the FieldMeta of the property at the JSON path
+// like "Thing.Name" within the field.
+func fieldMetaFromPath(schemaMetas map[string]SchemaMeta, field FieldMeta, path string) (FieldMeta, error) {
+	if path == "" {
+		return field, nil
+	}
+
+	var schemas []string
+	switch field.FieldType {
+	case ComplexFieldType:
+		schemas = field.ComplexFieldSchemas
+	case RelationshipFieldType:
+		schemas = []string{field.RelationshipSchema}
+	default:
+		return FieldMeta{}, fmt.Errorf("unable to navigate to %s in a %s field", path, field.FieldType)
+	}
+
+	fieldName, pathRemainder, _ := strings.Cut(path, ".")
+	for _, schemaName := range schemas {
+		if newField, ok := schemaMetas[schemaName].Fields[fieldName]; ok {
+			return fieldMetaFromPath(schemaMetas, newField, pathRemainder)
+		}
+	}
+
+	return FieldMeta{}, fmt.Errorf("unknown property %s", fieldName)
+}
+
+// validateFilterProperties returns a QueryError for the first property
+// compared in the $filter tree which doesn't exist in the field.
+func validateFilterProperties(schemaMetas map[string]SchemaMeta, field FieldMeta, filter string, node *godata.ParseNode) error {
+	if node.Token.Type == godata.ExpressionTokenLambdaNav {
+		return validateLambdaProperties(schemaMetas, field, filter, node)
+	}
+
+	switch node.Token.Value {
+	case "and", "or":
+		for _, child := range node.Children {
+			if err := validateFilterProperties(schemaMetas, field, filter, child); err != nil {
+				return err
+			}
+		}
+	case "eq", "ne", "gt", "ge", "lt", "le", "contains", "endswith", "startswith":
+		queryPath, err := buildJSONPathFromParseNode(node.Children[0])
+		if err != nil {
+			// Reported when the query is built from the filter.
+			return nil
+		}
+		if unknown := findUnknownProperty(schemaMetas, field, "", strings.ReplaceAll(queryPath, ".", "/")); unknown != nil {
+			return newUnknownPropertyError("$filter", filter, unknown)
+		}
+	}
+	return nil
+}
+
+// validateLambdaProperties returns a QueryError for the collection of the
+// lambda operator or the first property compared in its predicate which
+// doesn't exist.
+func validateLambdaProperties(schemaMetas map[string]SchemaMeta, field FieldMeta, filter string, node *godata.ParseNode) error {
+	collectionPath, err := buildJSONPathFromParseNode(node.Children[0])
+	if err != nil {
+		// Reported when the query is built from the filter.
+		return nil
+	}
+	if unknown := findUnknownProperty(schemaMetas, field, "", strings.ReplaceAll(collectionPath, ".", "/")); unknown != nil {
+		return newUnknownPropertyError("$filter", filter, unknown)
+	}
+
+	lambda := node.Children[1]
+	collectionField, err := fieldMetaFromPath(schemaMetas, field, collectionPath)
+	if err != nil || collectionField.FieldType != CollectionFieldType || len(lambda.Children) != 2 { // nolint:gomnd
+		// Reported when the query is built from the filter.
+		return nil
+	}
+
+	lambdaSchemaMetas, lambdaField := newLambdaSchemaMetas(schemaMetas, lambda.Children[0].Token.Value, *collectionField.CollectionItemMeta)
+	return validateFilterProperties(lambdaSchemaMetas, lambdaField, filter, lambda.Children[1])
+}
+
+// validateOrderByProperties returns a QueryError for the first property of
+// the $orderby items which doesn't exist in the field.
+func validateOrderByProperties(schemaMetas map[string]SchemaMeta, field FieldMeta, orderby string, orderbyItems []*godata.OrderByItem) error {
+	for _, item := range orderbyItems {
+		queryPath, err := buildJSONPathFromParseNode(item.Tree.Tree)
+		if err != nil {
+			// Reported when the query is built from the items.
+			continue
+		}
+		if unknown := findUnknownProperty(schemaMetas, field, "", strings.ReplaceAll(queryPath, ".", "/")); unknown != nil {
+			return newUnknownPropertyError("$orderby", orderby, unknown)
+		}
+	}
+	return nil
+}
+
 func sourceFromQueryPath(sqlVariant jsonsql.Variant, schemaMetas map[string]SchemaMeta, field FieldMeta, identifier string, source string, queryPath string) (string, error) {
 	// ODATA path that would be present if we were to $select the
 	// field being filtered
@@ -192,6 +192,7 @@ var carSchemaMetas = map[string]SchemaMeta{
 			},
 			"BuiltOn": {FieldType: PrimitiveFieldType},
 		},
+		SearchFields: []string{"ModelName", "Manufacturer/Name"},
 	},
 	"Manufacturer": {
 		Table: "manufacturer_rows",
@@ -515,6 +516,7 @@ func TestBuildSQLQuery(t *testing.T) {
 
 	type args struct {
 		filterString  *string
+		searchString  *string
 		selectString  *string
 		expandString  *string
 		orderbyString *string
@@ -580,6 +582,13 @@ func TestBuildSQLQuery(t *testing.T) {
 			},
 			want: []Car{},
 		},
+		{
+			name: "eq filter with an escaped quote",
+			args: args{
+				filterString: PointerTo("ModelName eq 'model1'' or ''a'' = ''a'"),
+			},
+			want: []Car{},
+		},
 		{
 			name: "combined 'or' filter",
 			args: args{
@@ -615,6 +624,76 @@ func TestBuildSQLQuery(t *testing.T) {
 			},
 			want: []Car{car1, car2},
 		},
+		{
+			name: "any lambda on collection of primitive type",
+			args: args{
+				filterString: PointerTo("Engine/Options/OtherThings/any(t: t eq 'thing1')"),
+			},
+			want: []Car{car1, car2, car3, car4},
+		},
+		{
+			name: "any lambda on collection of primitive type with no results",
+			args: args{
+				filterString: PointerTo("Engine/Options/OtherThings/any(t: t eq 'thing3')"),
+			},
+			want: []Car{},
+		},
+		{
+			name: "any lambda on collection of complex type through a relationship",
+			args: args{
+				filterString: PointerTo("Engine/Options/SubOptions/any(o: o/Manufacturer/Name eq 'manu3')"),
+			},
+			want: []Car{car3, car4},
+		},
+		{
+			name: "all lambda on collection of complex type through a relationship",
+			args: args{
+				filterString: PointerTo("Engine/Options/SubOptions/all(o: o/Manufacturer/Name eq 'manu2' or o/Manufacturer/Name eq 'manu3')"),
+			},
+			want: []Car{car3, car4},
+		},
+		{
+			name: "any lambda on collection of relationships",
+			args: args{
+				filterString: PointerTo("Manufacturers/any(m: m/Name eq 'manu3')"),
+			},
+			want: []Car{car2},
+		},
+		{
+			name: "any lambda without predicate combined with and",
+			args: args{
+				filterString: PointerTo("Seats gt 2 and Manufacturers/any()"),
+			},
+			want: []Car{car2},
+		},
+		{
+			name: "any lambda on collection of polymorphic complex type",
+			args: args{
+				filterString: PointerTo("OtherStereos/any(s: s/Frequency eq '500mhz')"),
+			},
+			want: []Car{car1, car2, car3, car4},
+		},
+		{
+			name: "all lambda on property missing from some items",
+			args: args{
+				filterString: PointerTo("OtherStereos/all(s: s/NumberOfDisks ge 20)"),
+			},
+			want: []Car{},
+		},
+		{
+			name: "any lambda with several conditions on the same item",
+			args: args{
+				filterString: PointerTo("Engine/Options/SubOptions/any(o: o/Name eq 'greenPaint' and o/Manufacturer/Name eq 'manu2')"),
+			},
+			want: []Car{car1, car2},
+		},
+		{
+			name: "lambda on a property which is not a collection",
+			args: args{
+				filterString: PointerTo("ModelName/any(m: m eq 'model1')"),
+			},
+			wantErr: true,
+		},
 		{
 			name: "mismatched brackets",
 			args: args{
@@ -1146,6 +1225,63 @@ func TestBuildSQLQuery(t *testing.T) {
 				car2,
 			},
 		},
+		{
+			name: "search regardless of case",
+			args: args{
+				searchString: PointerTo("MODEL1"),
+			},
+			want: []Car{car1},
+		},
+		{
+			name: "search relationship field",
+			args: args{
+				searchString: PointerTo("manu1"),
+			},
+			want: []Car{car1, car2},
+		},
+		{
+			name: "search all terms",
+			args: args{
+				searchString: PointerTo("model manu2"),
+			},
+			want: []Car{car3},
+		},
+		{
+			name: "search any term",
+			args: args{
+				searchString: PointerTo("model1 OR model3"),
+			},
+			want: []Car{car1, car3},
+		},
+		{
+			name: "search without term",
+			args: args{
+				searchString: PointerTo("model AND NOT (manu1 OR \"manu3\")"),
+			},
+			want: []Car{car3},
+		},
+		{
+			name: "search wildcard characters literally",
+			args: args{
+				searchString: PointerTo("model_"),
+			},
+			want: []Car{},
+		},
+		{
+			name: "search and filter",
+			args: args{
+				filterString: PointerTo("Seats eq 2"),
+				searchString: PointerTo("manu2"),
+			},
+			want: []Car{car3},
+		},
+		{
+			name: "invalid search",
+			args: args{
+				searchString: PointerTo("(model1"),
+			},
+			wantErr: true,
+		},
 		{
 			name: "get object where field includes json escaped chars",
 			args: args{
@@ -1158,7 +1294,7 @@ func TestBuildSQLQuery(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			query, err := BuildSQLQuery(jsonsql.SQLite, carSchemaMetas, "Car", tt.args.filterString, tt.args.selectString, tt.args.expandString, tt.args.orderbyString, tt.args.top, tt.args.skip)
+			query, err := BuildSQLQuery(jsonsql.SQLite, carSchemaMetas, "Car", tt.args.filterString, tt.args.searchString, tt.args.selectString, tt.args.expandString, tt.args.orderbyString, tt.args.top, tt.args.skip)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("BuildSQLQuery() error = %v, wantErr %v", err, tt.wantErr)
 				return
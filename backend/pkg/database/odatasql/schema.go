@@ -43,6 +43,9 @@ type FieldMeta struct {
 type SchemaMeta struct {
 	Table  string
 	Fields map[string]FieldMeta
+	// SearchFields are the paths, like "Thing/Name", of the text fields
+	// matched by $search. $search isn't supported if there are none.
+	SearchFields []string
 }
 
 type Schema map[string]FieldMeta
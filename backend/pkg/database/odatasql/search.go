@@ -0,0 +1,231 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package odatasql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/openclarity/vmclarity/backend/pkg/database/odatasql/jsonsql"
+)
+
+// searchNode is a node of a parsed $search expression, either a term or an
+// AND, OR or NOT operator of its children.
+type searchNode struct {
+	operator string
+	term     string
+	children []*searchNode
+}
+
+const (
+	searchAnd = "AND"
+	searchOr  = "OR"
+	searchNot = "NOT"
+)
+
+// searchParser parses a $search expression: search terms, either words or
+// double quoted phrases, combined with the AND, OR and NOT operators and
+// grouped with parentheses. Terms without an operator between them must all
+// match, like with AND, which binds tighter than OR.
+type searchParser struct {
+	search string
+	pos    int
+}
+
+func parseSearch(search string) (*searchNode, error) {
+	p := &searchParser{search: search}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.skipSpaces(); p.pos < len(p.search) {
+		return nil, p.errorf("unexpected %q", p.search[p.pos:p.pos+1])
+	}
+	return node, nil
+}
+
+func (p *searchParser) parseOr() (*searchNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.acceptOperator(searchOr) {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &searchNode{operator: searchOr, children: []*searchNode{left, right}}
+	}
+	return left, nil
+}
+
+func (p *searchParser) parseAnd() (*searchNode, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if !p.acceptOperator(searchAnd) {
+			// Terms without an operator between them are ANDed.
+			if p.skipSpaces(); p.pos == len(p.search) || p.search[p.pos] == ')' || p.peekOperator(searchOr) {
+				return left, nil
+			}
+		}
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &searchNode{operator: searchAnd, children: []*searchNode{left, right}}
+	}
+}
+
+func (p *searchParser) parseNot() (*searchNode, error) {
+	if p.acceptOperator(searchNot) {
+		child, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &searchNode{operator: searchNot, children: []*searchNode{child}}, nil
+	}
+	return p.parseTerm()
+}
+
+func (p *searchParser) parseTerm() (*searchNode, error) {
+	p.skipSpaces()
+	if p.pos == len(p.search) {
+		return nil, p.errorf("search term expected")
+	}
+
+	switch p.search[p.pos] {
+	case '(':
+		p.pos++
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.skipSpaces(); p.pos == len(p.search) || p.search[p.pos] != ')' {
+			return nil, p.errorf("closing parenthesis expected")
+		}
+		p.pos++
+		return node, nil
+	case ')':
+		return nil, p.errorf("search term expected")
+	case '"':
+		end := strings.IndexByte(p.search[p.pos+1:], '"')
+		if end < 0 {
+			return nil, p.errorf("unterminated phrase")
+		}
+		term := p.search[p.pos+1 : p.pos+1+end]
+		if term == "" {
+			return nil, p.errorf("empty phrase")
+		}
+		p.pos += end + 2 // nolint:gomnd
+		return &searchNode{term: term}, nil
+	}
+
+	start := p.pos
+	for p.pos < len(p.search) && !strings.ContainsRune(" \t()\"", rune(p.search[p.pos])) {
+		p.pos++
+	}
+	return &searchNode{term: p.search[start:p.pos]}, nil
+}
+
+// acceptOperator consumes the operator if it is next.
+func (p *searchParser) acceptOperator(operator string) bool {
+	if !p.peekOperator(operator) {
+		return false
+	}
+	p.pos += len(operator)
+	return true
+}
+
+func (p *searchParser) peekOperator(operator string) bool {
+	p.skipSpaces()
+	rest := p.search[p.pos:]
+	if !strings.HasPrefix(rest, operator) {
+		return false
+	}
+	// The operator must be a word of its own, e.g. ORACLE is a term.
+	return len(rest) == len(operator) || strings.ContainsRune(" \t(\"", rune(rest[len(operator)]))
+}
+
+func (p *searchParser) skipSpaces() {
+	for p.pos < len(p.search) && (p.search[p.pos] == ' ' || p.search[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+func (p *searchParser) errorf(format string, args ...interface{}) *QueryError {
+	return &QueryError{
+		Option:   "$search",
+		Message:  fmt.Sprintf(format, args...),
+		Position: p.pos,
+		Segment:  p.search[p.pos:],
+	}
+}
+
+// buildWhereFromSearch returns the condition matching the objects of the
+// schema with a search field containing the terms of the $search
+// expression, regardless of case.
+func buildWhereFromSearch(sqlVariant jsonsql.Variant, schemaMetas map[string]SchemaMeta, field FieldMeta, schema string, source string, search string) (string, error) {
+	searchFields := schemaMetas[schema].SearchFields
+	if len(searchFields) == 0 {
+		return "", &QueryError{Option: "$search", Message: fmt.Sprintf("%s doesn't support $search", schema), Position: -1}
+	}
+
+	tree, err := parseSearch(search)
+	if err != nil {
+		return "", err
+	}
+
+	texts := make([]string, 0, len(searchFields))
+	for _, path := range searchFields {
+		queryPath := strings.ReplaceAll(path, "/", ".")
+		fieldSource, err := sourceFromQueryPath(sqlVariant, schemaMetas, field, schema, source, queryPath)
+		if err != nil {
+			return "", fmt.Errorf("unable to build source for search field %s: %w", path, err)
+		}
+		// Missing fields are matched as empty so that NOT matches them.
+		texts = append(texts, fmt.Sprintf("COALESCE(%s, '')", sqlVariant.JSONExtractText(fieldSource, fmt.Sprintf("$.%s", queryPath))))
+	}
+
+	return buildSearchCondition(sqlVariant, texts, tree), nil
+}
+
+func buildSearchCondition(sqlVariant jsonsql.Variant, texts []string, node *searchNode) string {
+	switch node.operator {
+	case searchAnd, searchOr:
+		return fmt.Sprintf("(%s %s %s)",
+			buildSearchCondition(sqlVariant, texts, node.children[0]),
+			node.operator,
+			buildSearchCondition(sqlVariant, texts, node.children[1]))
+	case searchNot:
+		return fmt.Sprintf("(NOT %s)", buildSearchCondition(sqlVariant, texts, node.children[0]))
+	}
+
+	pattern := singleQuote("%" + escapeLikePattern(node.term) + "%")
+	conditions := make([]string, 0, len(texts))
+	for _, text := range texts {
+		conditions = append(conditions, sqlVariant.TextContains(text, pattern))
+	}
+	return fmt.Sprintf("(%s)", strings.Join(conditions, " OR "))
+}
+
+// escapeLikePattern escapes the wildcards of LIKE with a backslash, and the
+// quotes of the SQL string literal.
+func escapeLikePattern(term string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`, `'`, `''`).Replace(term)
+}
@@ -18,6 +18,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/openclarity/vmclarity/api/models"
 )
@@ -47,19 +48,95 @@ type DBConfig struct {
 	DBName         string `json:"db-name,omitempty"`
 
 	LocalDBPath string `json:"local-db-path,omitempty"`
+
+	// DSN of a read replica of the Postgres database the read-only queries
+	// are routed to, all the queries go to the primary if not set.
+	ReadReplicaDSN string `json:"-"`
+	// ReadReplicaMaxLag is the replication lag above which the reads are
+	// routed to the primary.
+	ReadReplicaMaxLag time.Duration `json:"read-replica-max-lag,omitempty"`
+
+	// Base64 encoded keys the sensitive fields are encrypted with, the
+	// previous keys are only used to read the fields during a key rotation.
+	FieldEncryptionKey          string   `json:"-"`
+	FieldEncryptionPreviousKeys []string `json:"-"`
+
+	// Caps on the number of findings stored per scan family of a scan
+	// result.
+	ScanResultLimits ScanResultLimits `json:"scan-result-limits,omitempty"`
+}
+
+// ScanResultLimits caps the number of findings kept in the result lists of
+// the scan families of a scan result, a zero limit means unlimited.
+type ScanResultLimits struct {
+	// MaxFindings is the limit of the families without one in Families.
+	MaxFindings int                       `json:"max-findings,omitempty"`
+	Families    map[models.ScanFamily]int `json:"families,omitempty"`
+}
+
+// Limit returns the maximum number of findings kept for the scan family.
+func (l ScanResultLimits) Limit(family models.ScanFamily) int {
+	if limit, ok := l.Families[family]; ok {
+		return limit
+	}
+	return l.MaxFindings
+}
+
+// RotateKeysParams configures the re-encryption of the sensitive fields with
+// the current key.
+type RotateKeysParams struct {
+	// BatchSize is the number of objects re-encrypted in a transaction.
+	BatchSize int
+	// Checkpoint of an interrupted rotation to resume from, the objects up
+	// to it are skipped.
+	Checkpoint string
+}
+
+// RotateKeysProgress is reported after each batch of a key rotation.
+type RotateKeysProgress struct {
+	Table string
+	// Processed is the number of objects of the table checked so far, out
+	// of Total.
+	Processed int
+	Total     int
+	// Rotated is the number of objects of the table re-encrypted so far.
+	Rotated int
+	// Checkpoint the rotation can be resumed from.
+	Checkpoint string
 }
 
 type Database interface {
 	ScanResultsTable() ScanResultsTable
 	ScanConfigsTable() ScanConfigsTable
+	ScanConfigTemplatesTable() ScanConfigTemplatesTable
 	ScansTable() ScansTable
-	TargetsTable() TargetsTable
+	AssetsTable() AssetsTable
+	AssetGroupsTable() AssetGroupsTable
 	ScopesTable() ScopesTable
 	FindingsTable() FindingsTable
+	ReportSchedulesTable() ReportSchedulesTable
+	PostureScoresTable() PostureScoresTable
+	FindingDigestsTable() FindingDigestsTable
+	NotificationConfigsTable() NotificationConfigsTable
+	FindingExceptionsTable() FindingExceptionsTable
+	ScannerConfigsTable() ScannerConfigsTable
+	UserPreferencesTable() UserPreferencesTable
+	ProviderOperationsTable() ProviderOperationsTable
+	CorrelatedFindingsTable() CorrelatedFindingsTable
+	SettingsTable() SettingsTable
+	APIKeysTable() APIKeysTable
+	UsageStats() UsageStats
+
+	RotateFieldEncryptionKeys(params RotateKeysParams, progress func(RotateKeysProgress)) error
+
+	// ReadOnly returns the Database of the queries which don't lead to a
+	// write, they may be served by a read replica lagging behind.
+	ReadOnly() Database
 }
 
 type ScansTable interface {
 	GetScans(params models.GetScansParams) (models.Scans, error)
+	StreamScans(params models.GetScansParams, fn func(models.Scan) error) error
 	GetScan(scanID models.ScanID, params models.GetScansScanIDParams) (models.Scan, error)
 
 	CreateScan(scan models.Scan) (models.Scan, error)
@@ -70,18 +147,56 @@ type ScansTable interface {
 }
 
 type ScanResultsTable interface {
-	GetScanResults(params models.GetScanResultsParams) (models.TargetScanResults, error)
-	GetScanResult(scanResultID models.ScanResultID, params models.GetScanResultsScanResultIDParams) (models.TargetScanResult, error)
+	GetScanResults(params models.GetScanResultsParams) (models.AssetScanResults, error)
+	StreamScanResults(params models.GetScanResultsParams, fn func(models.AssetScanResult) error) error
+	GetScanResult(scanResultID models.ScanResultID, params models.GetScanResultsScanResultIDParams) (models.AssetScanResult, error)
+
+	CreateScanResult(scanResults models.AssetScanResult) (models.AssetScanResult, error)
+	UpdateScanResult(scanResults models.AssetScanResult, params models.PatchScanResultsScanResultIDParams) (models.AssetScanResult, error)
+	SaveScanResult(scanResults models.AssetScanResult, params models.PutScanResultsScanResultIDParams) (models.AssetScanResult, error)
 
-	CreateScanResult(scanResults models.TargetScanResult) (models.TargetScanResult, error)
-	UpdateScanResult(scanResults models.TargetScanResult, params models.PatchScanResultsScanResultIDParams) (models.TargetScanResult, error)
-	SaveScanResult(scanResults models.TargetScanResult, params models.PutScanResultsScanResultIDParams) (models.TargetScanResult, error)
+	GetScanResultItems(scanResultID models.ScanResultID, family models.ScanFamily, params models.GetScanResultsScanResultIDFamiliesFamilyItemsParams) (models.ScanResultItems, error)
+	SetScanResultItems(scanResultID models.ScanResultID, family models.ScanFamily, page models.ScanResultItems) (models.ScanResultItems, error)
+	SetScanResultArtifact(scanResultID models.ScanResultID, artifact models.ScanResultArtifact) (models.ScanResultArtifact, error)
+
+	DeleteScanResult(scanResultID models.ScanResultID) error
+}
 
-	// DeleteScanResult(scanResultID models.ScanResultID) error
+type ScannerConfigsTable interface {
+	GetScannerConfig(scanResultID models.ScanResultID) (models.ScannerConfig, error)
+	// SetScannerConfig sets the scanner config of the ScanResult and adds
+	// the hash of a token its scanner can fetch it with until it expires.
+	SetScannerConfig(scanResultID models.ScanResultID, scannerConfig models.ScannerConfig, tokenHash string, tokenExpiresAt time.Time) (models.ScannerConfig, error)
+	// IsScannerTokenValid reports whether the hash is of a token of the
+	// ScanResult which has not expired.
+	IsScannerTokenValid(scanResultID models.ScanResultID, tokenHash string) (bool, error)
+}
+
+type UserPreferencesTable interface {
+	GetUserPreferences(identity string) (models.UserPreferences, error)
+	SetUserPreferences(identity string, userPreferences models.UserPreferences) (models.UserPreferences, error)
+}
+
+// SettingsTable holds the settings of the backend. The default settings are
+// returned for the settings which have not been set yet.
+type SettingsTable interface {
+	GetRetentionSettings() (models.RetentionSettings, error)
+	SetRetentionSettings(retentionSettings models.RetentionSettings) (models.RetentionSettings, error)
+	// SetRetentionRun records the outcome of applying the retention settings.
+	SetRetentionRun(run models.RetentionRun) error
+	GetFindingTemplateSettings() (models.FindingTemplateSettings, error)
+	SetFindingTemplateSettings(findingTemplateSettings models.FindingTemplateSettings) (models.FindingTemplateSettings, error)
+	GetMaintenanceMode() (models.MaintenanceMode, error)
+	SetMaintenanceMode(maintenanceMode models.MaintenanceMode) (models.MaintenanceMode, error)
+	// GetFeeds returns the feeds recorded by the feed sync.
+	GetFeeds() ([]models.Feed, error)
+	// SetFeed records the feed, replacing the recorded feed of the same name.
+	SetFeed(feed models.Feed) error
 }
 
 type ScanConfigsTable interface {
 	GetScanConfigs(params models.GetScanConfigsParams) (models.ScanConfigs, error)
+	StreamScanConfigs(params models.GetScanConfigsParams, fn func(models.ScanConfig) error) error
 	GetScanConfig(scanConfigID models.ScanConfigID, params models.GetScanConfigsScanConfigIDParams) (models.ScanConfig, error)
 
 	CreateScanConfig(scanConfig models.ScanConfig) (models.ScanConfig, error)
@@ -91,15 +206,16 @@ type ScanConfigsTable interface {
 	DeleteScanConfig(scanConfigID models.ScanConfigID) error
 }
 
-type TargetsTable interface {
-	GetTargets(params models.GetTargetsParams) (models.Targets, error)
-	GetTarget(targetID models.TargetID, params models.GetTargetsTargetIDParams) (models.Target, error)
+type AssetsTable interface {
+	GetAssets(params models.GetAssetsParams) (models.Assets, error)
+	StreamAssets(params models.GetAssetsParams, fn func(models.Asset) error) error
+	GetAsset(targetID models.AssetID, params models.GetAssetsAssetIDParams) (models.Asset, error)
 
-	CreateTarget(target models.Target) (models.Target, error)
-	UpdateTarget(target models.Target, params models.PatchTargetsTargetIDParams) (models.Target, error)
-	SaveTarget(target models.Target, params models.PutTargetsTargetIDParams) (models.Target, error)
+	CreateAsset(target models.Asset) (models.Asset, error)
+	UpdateAsset(target models.Asset, params models.PatchAssetsAssetIDParams) (models.Asset, error)
+	SaveAsset(target models.Asset, params models.PutAssetsAssetIDParams) (models.Asset, error)
 
-	DeleteTarget(targetID models.TargetID) error
+	DeleteAsset(targetID models.AssetID) error
 }
 
 type ScopesTable interface {
@@ -107,13 +223,127 @@ type ScopesTable interface {
 	SetScopes(scopes models.Scopes) (models.Scopes, error)
 }
 
+// FindingResult is the result of creating or updating a single Finding in bulk,
+// either the stored Finding or the error which prevented storing it.
+type FindingResult struct {
+	Finding models.Finding
+	// Invalidated is set if the update invalidated the Finding, which was
+	// active before.
+	Invalidated bool
+	Err         error
+}
+
 type FindingsTable interface {
 	GetFindings(params models.GetFindingsParams) (models.Findings, error)
+	StreamFindings(params models.GetFindingsParams, fn func(models.Finding) error) error
 	GetFinding(findingID models.FindingID, params models.GetFindingsFindingIDParams) (models.Finding, error)
 
 	CreateFinding(finding models.Finding) (models.Finding, error)
+	CreateFindings(findings []models.Finding) ([]FindingResult, error)
 	UpdateFinding(finding models.Finding) (models.Finding, error)
+	UpdateFindings(findings []models.Finding) ([]FindingResult, error)
 	SaveFinding(finding models.Finding) (models.Finding, error)
 
 	DeleteFinding(findingID models.FindingID) error
 }
+
+type ScanConfigTemplatesTable interface {
+	GetScanConfigTemplates(params models.GetScanConfigTemplatesParams) (models.ScanConfigTemplates, error)
+	GetScanConfigTemplate(templateID models.ScanConfigTemplateID, params models.GetScanConfigTemplatesScanConfigTemplateIDParams) (models.ScanConfigTemplate, error)
+
+	CreateScanConfigTemplate(template models.ScanConfigTemplate) (models.ScanConfigTemplate, error)
+	UpdateScanConfigTemplate(template models.ScanConfigTemplate, params models.PatchScanConfigTemplatesScanConfigTemplateIDParams) (models.ScanConfigTemplate, error)
+
+	DeleteScanConfigTemplate(templateID models.ScanConfigTemplateID) error
+}
+
+type AssetGroupsTable interface {
+	GetAssetGroups(params models.GetAssetGroupsParams) (models.AssetGroups, error)
+	GetAssetGroup(assetGroupID models.AssetGroupID, params models.GetAssetGroupsAssetGroupIDParams) (models.AssetGroup, error)
+
+	CreateAssetGroup(group models.AssetGroup) (models.AssetGroup, error)
+	UpdateAssetGroup(group models.AssetGroup, params models.PatchAssetGroupsAssetGroupIDParams) (models.AssetGroup, error)
+
+	DeleteAssetGroup(assetGroupID models.AssetGroupID) error
+}
+
+type PostureScoresTable interface {
+	GetPostureScores(params models.GetPostureScoresParams) (models.PostureScores, error)
+
+	CreatePostureScores(postureScores []models.PostureScore) error
+}
+
+type ReportSchedulesTable interface {
+	GetReportSchedules(params models.GetReportSchedulesParams) (models.ReportSchedules, error)
+	GetReportSchedule(reportScheduleID models.ReportScheduleID, params models.GetReportSchedulesReportScheduleIDParams) (models.ReportSchedule, error)
+
+	CreateReportSchedule(reportSchedule models.ReportSchedule) (models.ReportSchedule, error)
+	UpdateReportSchedule(reportSchedule models.ReportSchedule, params models.PatchReportSchedulesReportScheduleIDParams) (models.ReportSchedule, error)
+
+	DeleteReportSchedule(reportScheduleID models.ReportScheduleID) error
+}
+
+type FindingDigestsTable interface {
+	GetFindingDigests(params models.GetFindingDigestsParams) (models.FindingDigests, error)
+	GetFindingDigest(findingDigestID models.FindingDigestID, params models.GetFindingDigestsFindingDigestIDParams) (models.FindingDigest, error)
+
+	CreateFindingDigest(findingDigest models.FindingDigest) (models.FindingDigest, error)
+	UpdateFindingDigest(findingDigest models.FindingDigest, params models.PatchFindingDigestsFindingDigestIDParams) (models.FindingDigest, error)
+
+	DeleteFindingDigest(findingDigestID models.FindingDigestID) error
+}
+
+type ProviderOperationsTable interface {
+	GetProviderOperations(params models.GetProviderOperationsParams) (models.ProviderOperations, error)
+	GetProviderOperation(providerOperationID models.ProviderOperationID, params models.GetProviderOperationsProviderOperationIDParams) (models.ProviderOperation, error)
+
+	CreateProviderOperation(providerOperation models.ProviderOperation) (models.ProviderOperation, error)
+}
+
+// CorrelatedFindingsTable holds the correlated findings computed by the
+// backend, they are only created and saved by the correlation task.
+type CorrelatedFindingsTable interface {
+	GetCorrelatedFindings(params models.GetCorrelatedFindingsParams) (models.CorrelatedFindings, error)
+	GetCorrelatedFinding(correlatedFindingID models.CorrelatedFindingID, params models.GetCorrelatedFindingsCorrelatedFindingIDParams) (models.CorrelatedFinding, error)
+
+	CreateCorrelatedFinding(correlatedFinding models.CorrelatedFinding) (models.CorrelatedFinding, error)
+	SaveCorrelatedFinding(correlatedFinding models.CorrelatedFinding) (models.CorrelatedFinding, error)
+}
+
+// APIKeysTable holds the API keys along with the hash of their secret key,
+// which is never returned by the API.
+type APIKeysTable interface {
+	GetAPIKeys(params models.GetAPIKeysParams) (models.APIKeys, error)
+	GetAPIKey(apiKeyID models.ApiKeyID, params models.GetAPIKeysAPIKeyIDParams) (models.APIKey, error)
+	// GetAPIKeyByHash returns the API key whose secret key has the hash.
+	GetAPIKeyByHash(keyHash string) (models.APIKey, error)
+
+	CreateAPIKey(apiKey models.APIKey, keyHash string) (models.APIKey, error)
+
+	DeleteAPIKey(apiKeyID models.ApiKeyID) error
+}
+
+type NotificationConfigsTable interface {
+	GetNotificationConfigs(params models.GetNotificationConfigsParams) (models.NotificationConfigs, error)
+	GetNotificationConfig(notificationConfigID models.NotificationConfigID, params models.GetNotificationConfigsNotificationConfigIDParams) (models.NotificationConfig, error)
+
+	CreateNotificationConfig(notificationConfig models.NotificationConfig) (models.NotificationConfig, error)
+	UpdateNotificationConfig(notificationConfig models.NotificationConfig, params models.PatchNotificationConfigsNotificationConfigIDParams) (models.NotificationConfig, error)
+
+	DeleteNotificationConfig(notificationConfigID models.NotificationConfigID) error
+}
+
+type FindingExceptionsTable interface {
+	GetFindingExceptions(params models.GetFindingExceptionsParams) (models.FindingExceptions, error)
+	GetFindingException(findingExceptionID models.FindingExceptionID, params models.GetFindingExceptionsFindingExceptionIDParams) (models.FindingException, error)
+
+	CreateFindingException(findingException models.FindingException) (models.FindingException, error)
+	UpdateFindingException(findingException models.FindingException, params models.PatchFindingExceptionsFindingExceptionIDParams) (models.FindingException, error)
+
+	DeleteFindingException(findingExceptionID models.FindingExceptionID) error
+}
+
+type UsageStats interface {
+	GetObjectCounts() (models.ObjectCounts, error)
+	GetDatabaseSize() (int64, error)
+}
@@ -0,0 +1,186 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exploitability
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/backend/pkg/tasks"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const (
+	TaskName           = "exploitability"
+	DefaultInterval    = 6 * time.Hour
+	DefaultEPSSFeedURL = "https://epss.cyentia.com/epss_scores-current.csv.gz"
+	DefaultKEVFeedURL  = "https://www.cisa.gov/sites/default/files/feeds/known_exploited_vulnerabilities.json"
+
+	defaultHTTPTimeout = 5 * time.Minute
+
+	// updateBatchSize is the number of findings updated in a transaction.
+	updateBatchSize = 500
+)
+
+type Config struct {
+	// Interval between fetching the feeds and enriching the findings.
+	Interval time.Duration
+	// EPSSFeedURL is the URL of the EPSS scores CSV, optionally gzip
+	// compressed.
+	EPSSFeedURL string
+	// KEVFeedURL is the URL of the CISA Known Exploited Vulnerabilities
+	// catalog JSON.
+	KEVFeedURL string
+}
+
+// Enricher annotates the active vulnerability findings with their EPSS score
+// and whether they are known to be exploited.
+type Enricher struct {
+	db     databaseTypes.Database
+	client *http.Client
+	config Config
+}
+
+func New(db databaseTypes.Database, config Config) *Enricher {
+	if config.Interval <= 0 {
+		config.Interval = DefaultInterval
+	}
+	if config.EPSSFeedURL == "" {
+		config.EPSSFeedURL = DefaultEPSSFeedURL
+	}
+	if config.KEVFeedURL == "" {
+		config.KEVFeedURL = DefaultKEVFeedURL
+	}
+
+	return &Enricher{
+		db:     db,
+		client: &http.Client{Timeout: defaultHTTPTimeout},
+		config: config,
+	}
+}
+
+func (e *Enricher) Task() tasks.Task {
+	return tasks.Task{
+		Name:       TaskName,
+		Interval:   e.config.Interval,
+		Jitter:     tasks.DefaultJitter(e.config.Interval),
+		RunOnStart: true,
+		Run:        e.Run,
+	}
+}
+
+func (e *Enricher) Run(ctx context.Context, _ time.Time) error {
+	scores, err := fetchEPSSScores(ctx, e.client, e.config.EPSSFeedURL)
+	if err != nil {
+		return fmt.Errorf("failed to fetch EPSS scores: %w", err)
+	}
+	knownExploited, err := fetchKnownExploited(ctx, e.client, e.config.KEVFeedURL)
+	if err != nil {
+		return fmt.Errorf("failed to fetch known exploited vulnerabilities: %w", err)
+	}
+
+	var updates []models.Finding
+	err = e.db.FindingsTable().StreamFindings(models.GetFindingsParams{
+		Filter: utils.PointerTo("findingInfo/objectType eq 'Vulnerability' and invalidatedOn eq null"),
+		Select: utils.PointerTo("id,findingInfo/objectType,findingInfo/vulnerabilityName,findingInfo/epssScore," +
+			"findingInfo/epssPercentile,findingInfo/knownExploited,findingInfo/knownExploitedDateAdded"),
+	}, func(finding models.Finding) error {
+		if finding.Id == nil || finding.FindingInfo == nil {
+			return nil
+		}
+		info, err := finding.FindingInfo.AsVulnerabilityFindingInfo()
+		if err != nil {
+			return fmt.Errorf("failed to get vulnerability finding info of %s: %w", *finding.Id, err)
+		}
+
+		patch, ok := enrich(info, scores, knownExploited)
+		if !ok {
+			return nil
+		}
+		findingInfo := &models.Finding_FindingInfo{}
+		if err := findingInfo.FromVulnerabilityFindingInfo(patch); err != nil {
+			return fmt.Errorf("failed to convert vulnerability finding info of %s: %w", *finding.Id, err)
+		}
+		updates = append(updates, models.Finding{
+			Id:          finding.Id,
+			FindingInfo: findingInfo,
+		})
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get vulnerability findings: %w", err)
+	}
+
+	for start := 0; start < len(updates); start += updateBatchSize {
+		end := start + updateBatchSize
+		if end > len(updates) {
+			end = len(updates)
+		}
+		// A finding deleted since it was streamed is reported in the
+		// results, which are fine to ignore.
+		if _, err := e.db.FindingsTable().UpdateFindings(updates[start:end]); err != nil {
+			return fmt.Errorf("failed to update findings: %w", err)
+		}
+	}
+
+	log.GetLoggerFromContextOrDiscard(ctx).Infof("Updated the exploitability of %d vulnerability findings", len(updates))
+
+	return nil
+}
+
+// enrich returns the patch of the exploitability fields of the vulnerability,
+// or false if they are up to date. Only CVEs are enriched as the feeds are
+// keyed by CVE ID, and the EPSS score is kept if the CVE is missing from the
+// feed, which happens for CVEs published after the feed was generated.
+func enrich(info models.VulnerabilityFindingInfo, scores map[string]epssScore, knownExploited map[string]string) (models.VulnerabilityFindingInfo, bool) {
+	if info.VulnerabilityName == nil {
+		return models.VulnerabilityFindingInfo{}, false
+	}
+	cve := strings.ToUpper(*info.VulnerabilityName)
+	if !strings.HasPrefix(cve, "CVE-") {
+		return models.VulnerabilityFindingInfo{}, false
+	}
+
+	var patch models.VulnerabilityFindingInfo
+	changed := false
+
+	if score, ok := scores[cve]; ok {
+		if info.EpssScore == nil || *info.EpssScore != score.score ||
+			info.EpssPercentile == nil || *info.EpssPercentile != score.percentile {
+			patch.EpssScore = utils.PointerTo(score.score)
+			patch.EpssPercentile = utils.PointerTo(score.percentile)
+			changed = true
+		}
+	}
+
+	dateAdded, exploited := knownExploited[cve]
+	if info.KnownExploited == nil || *info.KnownExploited != exploited {
+		patch.KnownExploited = utils.PointerTo(exploited)
+		changed = true
+	}
+	if exploited && (info.KnownExploitedDateAdded == nil || *info.KnownExploitedDateAdded != dateAdded) {
+		patch.KnownExploitedDateAdded = utils.PointerTo(dateAdded)
+		changed = true
+	}
+
+	return patch, changed
+}
@@ -0,0 +1,215 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exploitability
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/database/gorm"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const (
+	testEPSSFeed = `#model_version:v2023.03.01,score_date:2023-07-01T00:00:00+0000
+cve,epss,percentile
+CVE-2021-44228,0.97565,0.99996
+CVE-2023-0001,0.00042,0.05
+`
+	testKEVFeed = `{"vulnerabilities":[{"cveID":"CVE-2021-44228","dateAdded":"2021-12-10"}]}`
+)
+
+func gzipped(t *testing.T, s string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write([]byte(s)); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestParseEPSSScores(t *testing.T) {
+	for name, feed := range map[string][]byte{
+		"plain": []byte(testEPSSFeed),
+		"gzip":  gzipped(t, testEPSSFeed),
+	} {
+		t.Run(name, func(t *testing.T) {
+			scores, err := parseEPSSScores(bytes.NewReader(feed))
+			if err != nil {
+				t.Fatalf("parseEPSSScores() error = %v", err)
+			}
+			want := map[string]epssScore{
+				"CVE-2021-44228": {score: 0.97565, percentile: 0.99996},
+				"CVE-2023-0001":  {score: 0.00042, percentile: 0.05},
+			}
+			if len(scores) != len(want) {
+				t.Fatalf("parseEPSSScores() = %v, want %v", scores, want)
+			}
+			for cve, score := range want {
+				if scores[cve] != score {
+					t.Errorf("parseEPSSScores()[%s] = %v, want %v", cve, scores[cve], score)
+				}
+			}
+		})
+	}
+
+	if _, err := parseEPSSScores(strings.NewReader("id,score\nCVE-1,0.1\n")); err == nil {
+		t.Errorf("parseEPSSScores() expected an error for an unexpected header")
+	}
+}
+
+// nolint:cyclop
+func TestEnricher_Run(t *testing.T) {
+	db, err := gorm.NewDatabase(databaseTypes.DBConfig{
+		DriverType:  databaseTypes.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("NewDatabase() error = %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/epss.csv.gz":
+			_, _ = w.Write(gzipped(t, testEPSSFeed))
+		case "/kev.json":
+			_, _ = w.Write([]byte(testKEVFeed))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	createVulnerability := func(name string, invalidatedOn *time.Time) string {
+		info := &models.Finding_FindingInfo{}
+		if err := info.FromVulnerabilityFindingInfo(models.VulnerabilityFindingInfo{
+			VulnerabilityName: utils.PointerTo(name),
+			Severity:          utils.PointerTo(models.HIGH),
+		}); err != nil {
+			t.Fatalf("FromVulnerabilityFindingInfo() error = %v", err)
+		}
+		finding, err := db.FindingsTable().CreateFinding(models.Finding{
+			Asset:         &models.AssetRelationship{Id: "asset-1"},
+			FindingInfo:   info,
+			InvalidatedOn: invalidatedOn,
+		})
+		if err != nil {
+			t.Fatalf("CreateFinding() error = %v", err)
+		}
+		return *finding.Id
+	}
+	getVulnerability := func(id string) models.VulnerabilityFindingInfo {
+		finding, err := db.FindingsTable().GetFinding(id, models.GetFindingsFindingIDParams{})
+		if err != nil {
+			t.Fatalf("GetFinding() error = %v", err)
+		}
+		info, err := finding.FindingInfo.AsVulnerabilityFindingInfo()
+		if err != nil {
+			t.Fatalf("AsVulnerabilityFindingInfo() error = %v", err)
+		}
+		return info
+	}
+
+	log4shell := createVulnerability("CVE-2021-44228", nil)
+	lowRisk := createVulnerability("cve-2023-0001", nil)
+	unscored := createVulnerability("CVE-2023-9999", nil)
+	ghsa := createVulnerability("GHSA-jfh8-c2jp-5v3q", nil)
+	invalidated := createVulnerability("CVE-2021-44228", utils.PointerTo(time.Now()))
+
+	enricher := New(db, Config{
+		EPSSFeedURL: server.URL + "/epss.csv.gz",
+		KEVFeedURL:  server.URL + "/kev.json",
+	})
+	if err := enricher.Run(context.Background(), time.Now()); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	info := getVulnerability(log4shell)
+	if info.EpssScore == nil || *info.EpssScore != 0.97565 || info.EpssPercentile == nil || *info.EpssPercentile != 0.99996 {
+		t.Errorf("EPSS of %s = %v, %v", log4shell, info.EpssScore, info.EpssPercentile)
+	}
+	if info.KnownExploited == nil || !*info.KnownExploited || info.KnownExploitedDateAdded == nil || *info.KnownExploitedDateAdded != "2021-12-10" {
+		t.Errorf("KEV of %s = %v, %v", log4shell, info.KnownExploited, info.KnownExploitedDateAdded)
+	}
+	if info.Severity == nil || *info.Severity != models.HIGH {
+		t.Errorf("Severity of %s = %v, want the severity to be kept", log4shell, info.Severity)
+	}
+
+	info = getVulnerability(lowRisk)
+	if info.EpssScore == nil || *info.EpssScore != 0.00042 || info.KnownExploited == nil || *info.KnownExploited {
+		t.Errorf("enrichment of %s = %v, %v", lowRisk, info.EpssScore, info.KnownExploited)
+	}
+
+	info = getVulnerability(unscored)
+	if info.EpssScore != nil || info.KnownExploited == nil || *info.KnownExploited {
+		t.Errorf("enrichment of %s = %v, %v", unscored, info.EpssScore, info.KnownExploited)
+	}
+
+	for _, id := range []string{ghsa, invalidated} {
+		info = getVulnerability(id)
+		if info.EpssScore != nil || info.KnownExploited != nil {
+			t.Errorf("enrichment of %s = %v, %v, want not enriched", id, info.EpssScore, info.KnownExploited)
+		}
+	}
+
+	findings, err := db.FindingsTable().GetFindings(models.GetFindingsParams{
+		Filter: utils.PointerTo("findingInfo/knownExploited eq true or findingInfo/epssScore ge 0.5"),
+	})
+	if err != nil {
+		t.Fatalf("GetFindings() error = %v", err)
+	}
+	if len(*findings.Items) != 1 || *(*findings.Items)[0].Id != log4shell {
+		t.Errorf("GetFindings() = %d findings, want %s", len(*findings.Items), log4shell)
+	}
+}
+
+func TestEnricher_Run_emptyKEVCatalog(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/epss.csv":
+			_, _ = w.Write([]byte(testEPSSFeed))
+		case "/kev.json":
+			_, _ = w.Write([]byte(`{"vulnerabilities":[]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	// The run must fail before reading the findings, so no database is
+	// needed.
+	enricher := New(nil, Config{
+		EPSSFeedURL: server.URL + "/epss.csv",
+		KEVFeedURL:  server.URL + "/kev.json",
+	})
+	if err := enricher.Run(context.Background(), time.Now()); err == nil {
+		t.Errorf("Run() expected an error for an empty KEV catalog")
+	}
+}
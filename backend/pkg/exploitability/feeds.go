@@ -0,0 +1,172 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exploitability
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// epssScore is the score of a vulnerability in the EPSS feed.
+type epssScore struct {
+	score      float32
+	percentile float32
+}
+
+// kevEntry is a vulnerability of the CISA Known Exploited Vulnerabilities
+// catalog.
+type kevEntry struct {
+	CveID     string `json:"cveID"`
+	DateAdded string `json:"dateAdded"`
+}
+
+type kevCatalog struct {
+	Vulnerabilities []kevEntry `json:"vulnerabilities"`
+}
+
+func fetchFeed(ctx context.Context, client *http.Client, url string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", url, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("failed to get %s: unexpected status %s", url, resp.Status)
+	}
+
+	return resp.Body, nil
+}
+
+// fetchEPSSScores returns the EPSS scores of the feed by CVE ID.
+func fetchEPSSScores(ctx context.Context, client *http.Client, url string) (map[string]epssScore, error) {
+	body, err := fetchFeed(ctx, client, url)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	return parseEPSSScores(body)
+}
+
+// parseEPSSScores parses the EPSS CSV feed, which is gzip compressed when
+// downloaded from FIRST but may be served uncompressed by a mirror.
+func parseEPSSScores(r io.Reader) (map[string]epssScore, error) {
+	br := bufio.NewReader(r)
+	if magic, err := br.Peek(2); err == nil && bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress EPSS feed: %w", err)
+		}
+		defer gz.Close()
+		br = bufio.NewReader(gz)
+	}
+
+	reader := csv.NewReader(br)
+	// The header is preceded by a comment line with the model version.
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read EPSS feed header: %w", err)
+	}
+	cveCol, scoreCol, percentileCol := -1, -1, -1
+	for i, name := range header {
+		switch strings.TrimSpace(name) {
+		case "cve":
+			cveCol = i
+		case "epss":
+			scoreCol = i
+		case "percentile":
+			percentileCol = i
+		}
+	}
+	if cveCol < 0 || scoreCol < 0 || percentileCol < 0 {
+		return nil, fmt.Errorf("unexpected EPSS feed header %v", header)
+	}
+
+	scores := map[string]epssScore{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read EPSS feed: %w", err)
+		}
+		if len(record) <= cveCol || len(record) <= scoreCol || len(record) <= percentileCol {
+			continue
+		}
+
+		score, err := strconv.ParseFloat(record[scoreCol], 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EPSS score %q of %s: %w", record[scoreCol], record[cveCol], err)
+		}
+		percentile, err := strconv.ParseFloat(record[percentileCol], 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EPSS percentile %q of %s: %w", record[percentileCol], record[cveCol], err)
+		}
+		scores[strings.ToUpper(record[cveCol])] = epssScore{
+			score:      float32(score),
+			percentile: float32(percentile),
+		}
+	}
+
+	return scores, nil
+}
+
+// fetchKnownExploited returns the date each vulnerability of the CISA Known
+// Exploited Vulnerabilities catalog was added by CVE ID.
+func fetchKnownExploited(ctx context.Context, client *http.Client, url string) (map[string]string, error) {
+	body, err := fetchFeed(ctx, client, url)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var catalog kevCatalog
+	if err := json.NewDecoder(body).Decode(&catalog); err != nil {
+		return nil, fmt.Errorf("failed to decode KEV catalog: %w", err)
+	}
+	if len(catalog.Vulnerabilities) == 0 {
+		// The catalog is never empty, so this is most likely not the
+		// catalog, and clearing the flag of every finding is worse than
+		// keeping them as they are.
+		return nil, errors.New("KEV catalog has no vulnerabilities")
+	}
+
+	dateAdded := make(map[string]string, len(catalog.Vulnerabilities))
+	for _, vuln := range catalog.Vulnerabilities {
+		dateAdded[strings.ToUpper(vuln.CveID)] = vuln.DateAdded
+	}
+
+	return dateAdded, nil
+}
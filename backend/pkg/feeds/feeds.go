@@ -0,0 +1,325 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feeds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/backend/pkg/tasks"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/objectstore"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const (
+	TaskName           = "feed-sync"
+	DefaultInterval    = 6 * time.Hour
+	DefaultMaxVersions = 3
+
+	defaultHTTPTimeout = 30 * time.Minute
+)
+
+var (
+	ErrFeedNotFound    = errors.New("feed not found")
+	ErrVersionNotFound = errors.New("feed version not found")
+)
+
+// Source is a feed which the backend mirrors.
+type Source struct {
+	Name string
+	Kind models.FeedKind
+	// URL is the upstream URL the feed is downloaded from.
+	URL string
+}
+
+type Config struct {
+	// Interval between the syncs of the feeds.
+	Interval time.Duration
+	// MaxVersions is the number of versions of each feed kept in the object
+	// store, so that the scanners can pin a version while a new one is
+	// rolled out.
+	MaxVersions int
+	Sources     []Source
+}
+
+// Syncer downloads the feeds into the object store of the backend, so that
+// the scanners download them from the backend instead of from their upstream
+// on each boot.
+type Syncer struct {
+	db     databaseTypes.Database
+	store  objectstore.Store
+	client *http.Client
+	config Config
+}
+
+func New(db databaseTypes.Database, store objectstore.Store, config Config) *Syncer {
+	if config.Interval <= 0 {
+		config.Interval = DefaultInterval
+	}
+	if config.MaxVersions <= 0 {
+		config.MaxVersions = DefaultMaxVersions
+	}
+
+	return &Syncer{
+		db:     db,
+		store:  store,
+		client: &http.Client{Timeout: defaultHTTPTimeout},
+		config: config,
+	}
+}
+
+func (s *Syncer) Task() tasks.Task {
+	return tasks.Task{
+		Name:       TaskName,
+		Interval:   s.config.Interval,
+		Jitter:     tasks.DefaultJitter(s.config.Interval),
+		RunOnStart: true,
+		Run:        s.Run,
+	}
+}
+
+// Run syncs each feed. A feed which fails to sync keeps its current version,
+// and the other feeds are synced anyway.
+func (s *Syncer) Run(ctx context.Context, now time.Time) error {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	recorded, err := s.recordedFeeds()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	var evicted []string
+	for _, source := range s.config.Sources {
+		feed, ok := recorded[source.Name]
+		if !ok {
+			feed = models.Feed{Name: utils.PointerTo(source.Name)}
+		}
+		feed.Kind = utils.PointerTo(source.Kind)
+		feed.Url = utils.PointerTo(source.URL)
+
+		updated, dropped, err := s.sync(ctx, source, &feed, now)
+		feed.LastSync = &models.FeedSync{
+			StartTime: utils.PointerTo(now),
+			EndTime:   utils.PointerTo(time.Now()),
+			Updated:   utils.PointerTo(updated),
+		}
+		if err != nil {
+			feed.LastSync.Error = utils.PointerTo(err.Error())
+			errs = append(errs, fmt.Errorf("failed to sync feed %s: %w", source.Name, err))
+		}
+
+		if err := s.db.SettingsTable().SetFeed(feed); err != nil {
+			errs = append(errs, fmt.Errorf("failed to record feed %s: %w", source.Name, err))
+			continue
+		}
+		recorded[source.Name] = feed
+		evicted = append(evicted, dropped...)
+
+		if updated {
+			logger.Infof("Feed %s updated to version %s", source.Name, *feed.Version)
+		}
+	}
+
+	// The versions are only deleted once they are no longer recorded, and
+	// the feeds sharing their content keep it.
+	referenced := map[string]struct{}{}
+	for _, feed := range recorded {
+		for _, version := range utils.ValueOrZero(feed.Versions) {
+			referenced[utils.ValueOrZero(version.Version)] = struct{}{}
+		}
+	}
+	for _, version := range evicted {
+		if _, ok := referenced[version]; ok {
+			continue
+		}
+		if err := s.store.Delete(ctx, version); err != nil {
+			logger.Warnf("Failed to delete feed version %s: %v", version, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// sync downloads the feed and records it as its current version if its
+// content changed. It returns whether the feed was updated and the versions
+// which are no longer kept.
+func (s *Syncer) sync(ctx context.Context, source Source, feed *models.Feed, now time.Time) (bool, []string, error) {
+	data, err := fetchFeed(ctx, s.client, source.URL)
+	if err != nil {
+		return false, nil, err
+	}
+
+	version, err := s.store.Put(ctx, data)
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to store feed: %w", err)
+	}
+	if version == utils.ValueOrZero(feed.Version) {
+		return false, nil, nil
+	}
+
+	size := int64(len(data))
+	versions := []models.FeedVersion{
+		{
+			Version:      utils.PointerTo(version),
+			Size:         utils.PointerTo(size),
+			DownloadedAt: utils.PointerTo(now),
+		},
+	}
+	for _, v := range utils.ValueOrZero(feed.Versions) {
+		// A feed going back to a previous version has it once.
+		if utils.ValueOrZero(v.Version) != version {
+			versions = append(versions, v)
+		}
+	}
+
+	var evicted []string
+	if len(versions) > s.config.MaxVersions {
+		for _, v := range versions[s.config.MaxVersions:] {
+			evicted = append(evicted, utils.ValueOrZero(v.Version))
+		}
+		versions = versions[:s.config.MaxVersions]
+	}
+
+	feed.Version = utils.PointerTo(version)
+	feed.Size = utils.PointerTo(size)
+	feed.UpdatedAt = utils.PointerTo(now)
+	feed.Versions = &versions
+
+	return true, evicted, nil
+}
+
+// Feeds returns the configured feeds with their versions and the outcome of
+// their last sync.
+func (s *Syncer) Feeds() ([]models.Feed, error) {
+	recorded, err := s.recordedFeeds()
+	if err != nil {
+		return nil, err
+	}
+
+	feeds := make([]models.Feed, 0, len(s.config.Sources))
+	for _, source := range s.config.Sources {
+		feed, ok := recorded[source.Name]
+		if !ok {
+			feed = models.Feed{Name: utils.PointerTo(source.Name)}
+		}
+		feed.Kind = utils.PointerTo(source.Kind)
+		feed.Url = utils.PointerTo(source.URL)
+		feeds = append(feeds, feed)
+	}
+
+	return feeds, nil
+}
+
+// Content returns the content of the version of the feed, or of its current
+// version if version is empty, together with the version.
+func (s *Syncer) Content(ctx context.Context, name, version string) (string, []byte, error) {
+	if !s.configured(name) {
+		return "", nil, fmt.Errorf("%w: %s", ErrFeedNotFound, name)
+	}
+
+	recorded, err := s.recordedFeeds()
+	if err != nil {
+		return "", nil, err
+	}
+	feed := recorded[name]
+
+	if version == "" {
+		version = utils.ValueOrZero(feed.Version)
+		if version == "" {
+			return "", nil, fmt.Errorf("%w: feed %s has not been synced yet", ErrVersionNotFound, name)
+		}
+	} else if !hasVersion(feed, version) {
+		return "", nil, fmt.Errorf("%w: %s of feed %s", ErrVersionNotFound, version, name)
+	}
+
+	data, err := s.store.Get(ctx, version)
+	if err != nil {
+		if errors.Is(err, objectstore.ErrNotFound) {
+			return "", nil, fmt.Errorf("%w: %s of feed %s", ErrVersionNotFound, version, name)
+		}
+		return "", nil, fmt.Errorf("failed to get feed from object store: %w", err)
+	}
+
+	return version, data, nil
+}
+
+func (s *Syncer) configured(name string) bool {
+	for _, source := range s.config.Sources {
+		if source.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// recordedFeeds returns the feeds recorded by the previous syncs by name.
+func (s *Syncer) recordedFeeds() (map[string]models.Feed, error) {
+	feeds, err := s.db.SettingsTable().GetFeeds()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feeds: %w", err)
+	}
+
+	recorded := make(map[string]models.Feed, len(feeds))
+	for _, feed := range feeds {
+		recorded[utils.ValueOrZero(feed.Name)] = feed
+	}
+	return recorded, nil
+}
+
+func hasVersion(feed models.Feed, version string) bool {
+	for _, v := range utils.ValueOrZero(feed.Versions) {
+		if utils.ValueOrZero(v.Version) == version {
+			return true
+		}
+	}
+	return false
+}
+
+func fetchFeed(ctx context.Context, client *http.Client, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get %s: unexpected status %s", url, resp.Status)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", url, err)
+	}
+	if len(data) == 0 {
+		// An empty feed is most likely a broken mirror, and serving it to
+		// the scanners is worse than keeping the current version.
+		return nil, fmt.Errorf("feed %s is empty", url)
+	}
+
+	return data, nil
+}
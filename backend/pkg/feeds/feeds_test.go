@@ -0,0 +1,178 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feeds
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/objectstore"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+type fakeFeedsDatabase struct {
+	databaseTypes.Database
+	settings *fakeSettingsTable
+}
+
+func (d *fakeFeedsDatabase) SettingsTable() databaseTypes.SettingsTable {
+	return d.settings
+}
+
+type fakeSettingsTable struct {
+	databaseTypes.SettingsTable
+	mu    sync.Mutex
+	feeds []models.Feed
+}
+
+func (t *fakeSettingsTable) GetFeeds() ([]models.Feed, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return append([]models.Feed{}, t.feeds...), nil
+}
+
+func (t *fakeSettingsTable) SetFeed(feed models.Feed) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for i := range t.feeds {
+		if *t.feeds[i].Name == *feed.Name {
+			t.feeds[i] = feed
+			return nil
+		}
+	}
+	t.feeds = append(t.feeds, feed)
+	return nil
+}
+
+func TestSyncer_Run(t *testing.T) {
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	body := "v1"
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.URL.Path == "/broken" {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	defer upstream.Close()
+	setContent := func(c string) {
+		mu.Lock()
+		defer mu.Unlock()
+		body = c
+	}
+
+	store, err := objectstore.NewFilesystemStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFilesystemStore() error = %v", err)
+	}
+	syncer := New(&fakeFeedsDatabase{settings: &fakeSettingsTable{}}, store, Config{
+		MaxVersions: 2,
+		Sources: []Source{
+			{Name: "grype-db", Kind: models.FeedKindVulnerability, URL: upstream.URL + "/grype"},
+			{Name: "clamav", Kind: models.FeedKindMalware, URL: upstream.URL + "/broken"},
+		},
+	})
+
+	syncFeeds := func(now time.Time) models.Feed {
+		t.Helper()
+
+		// The broken feed fails each sync without failing the other one.
+		if err := syncer.Run(ctx, now); err == nil {
+			t.Fatalf("Run() error = nil, want the error of the broken feed")
+		}
+		feeds, err := syncer.Feeds()
+		if err != nil {
+			t.Fatalf("Feeds() error = %v", err)
+		}
+		if len(feeds) != 2 {
+			t.Fatalf("Feeds() = %v, want 2 feeds", feeds)
+		}
+		if feeds[1].LastSync == nil || feeds[1].LastSync.Error == nil || feeds[1].Version != nil {
+			t.Errorf("Feeds()[1] = %+v, want a failed sync without version", feeds[1])
+		}
+		return feeds[0]
+	}
+	readContent := func(version string) string {
+		t.Helper()
+
+		_, data, err := syncer.Content(ctx, "grype-db", version)
+		if err != nil {
+			t.Fatalf("Content() error = %v", err)
+		}
+		return string(data)
+	}
+
+	now := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+	first := syncFeeds(now)
+	if !utils.ValueOrZero(first.LastSync.Updated) || first.Version == nil || utils.ValueOrZero(first.Size) != 2 {
+		t.Fatalf("first sync = %+v, want version of 2 bytes", first)
+	}
+	v1 := *first.Version
+
+	// The content didn't change, the version is kept.
+	second := syncFeeds(now.Add(time.Hour))
+	if utils.ValueOrZero(second.LastSync.Updated) || *second.Version != v1 || !second.UpdatedAt.Equal(now) {
+		t.Errorf("second sync = %+v, want version %s unchanged", second, v1)
+	}
+
+	setContent("v2")
+	third := syncFeeds(now.Add(2 * time.Hour))
+	if !utils.ValueOrZero(third.LastSync.Updated) || *third.Version == v1 || len(*third.Versions) != 2 {
+		t.Fatalf("third sync = %+v, want a new version", third)
+	}
+	v2 := *third.Version
+	if got := readContent(""); got != "v2" {
+		t.Errorf("Content() of the current version = %q, want %q", got, "v2")
+	}
+	if got := readContent(v1); got != "v1" {
+		t.Errorf("Content() of %s = %q, want %q", v1, got, "v1")
+	}
+
+	// The oldest version is deleted once more than MaxVersions are kept.
+	setContent("v3")
+	fourth := syncFeeds(now.Add(3 * time.Hour))
+	versions := *fourth.Versions
+	if len(versions) != 2 || *versions[0].Version != *fourth.Version || *versions[1].Version != v2 {
+		t.Errorf("fourth sync versions = %+v, want the current version and %s", versions, v2)
+	}
+	if _, _, err := syncer.Content(ctx, "grype-db", v1); !errors.Is(err, ErrVersionNotFound) {
+		t.Errorf("Content() of %s error = %v, want %v", v1, err, ErrVersionNotFound)
+	}
+	if _, err := store.Get(ctx, v1); !errors.Is(err, objectstore.ErrNotFound) {
+		t.Errorf("Get() of %s error = %v, want %v", v1, err, objectstore.ErrNotFound)
+	}
+
+	if _, _, err := syncer.Content(ctx, "clamav", ""); !errors.Is(err, ErrVersionNotFound) {
+		t.Errorf("Content() of the broken feed error = %v, want %v", err, ErrVersionNotFound)
+	}
+	if _, _, err := syncer.Content(ctx, "unknown", ""); !errors.Is(err, ErrFeedNotFound) {
+		t.Errorf("Content() of an unknown feed error = %v, want %v", err, ErrFeedNotFound)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maintenance
+
+import (
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/api/models"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const (
+	// DefaultRetryAfterSeconds is the delay the clients of the rejected
+	// requests are asked to retry after if the maintenance mode doesn't set
+	// one.
+	DefaultRetryAfterSeconds = 300
+	// ModeTTL is how long the maintenance mode read from the DB is used, the
+	// replicas of the backend follow a change within this time.
+	ModeTTL = 5 * time.Second
+)
+
+// State caches the maintenance mode of the backend, so that it is not read
+// from the DB by each request.
+type State struct {
+	dbHandler databaseTypes.Database
+
+	mu        sync.Mutex
+	mode      models.MaintenanceMode
+	expiresAt time.Time
+}
+
+func NewState(dbHandler databaseTypes.Database) *State {
+	return &State{
+		dbHandler: dbHandler,
+	}
+}
+
+func (s *State) Get() (models.MaintenanceMode, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if time.Now().Before(s.expiresAt) {
+		return s.mode, nil
+	}
+
+	mode, err := s.dbHandler.SettingsTable().GetMaintenanceMode()
+	if err != nil {
+		return models.MaintenanceMode{}, err // nolint:wrapcheck
+	}
+	s.mode = mode
+	s.expiresAt = time.Now().Add(ModeTTL)
+
+	return mode, nil
+}
+
+// Set updates the cached maintenance mode after it was changed through the
+// API of this replica of the backend.
+func (s *State) Set(mode models.MaintenanceMode) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.mode = mode
+	s.expiresAt = time.Now().Add(ModeTTL)
+}
+
+// Enabled reports whether the backend is in maintenance mode and the message
+// the rejected work is reported with. The maintenance mode is reported as
+// disabled if it can't be read, as the work fails anyway if the DB is not
+// available.
+func (s *State) Enabled() (bool, string) {
+	mode, err := s.Get()
+	if err != nil {
+		log.Warnf("Failed to get maintenance mode: %v", err)
+		return false, ""
+	}
+	if !mode.Enabled {
+		return false, ""
+	}
+	return true, Message(mode)
+}
+
+// RetryAfterSeconds returns the delay the clients of the requests rejected in
+// the maintenance mode are asked to retry after.
+func RetryAfterSeconds(mode models.MaintenanceMode) int {
+	if mode.RetryAfterSeconds != nil {
+		return *mode.RetryAfterSeconds
+	}
+	return DefaultRetryAfterSeconds
+}
+
+// Message returns the message the requests rejected in the maintenance mode
+// are answered with.
+func Message(mode models.MaintenanceMode) string {
+	message := "the backend is in maintenance mode"
+	if reason := utils.ValueOrZero(mode.Reason); reason != "" {
+		message += ": " + reason
+	}
+	return message
+}
@@ -0,0 +1,241 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/notifications/payload"
+	"github.com/openclarity/vmclarity/backend/pkg/tasks"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/report"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const (
+	DigestTaskName = "finding-digests"
+
+	// maxDigestFindings is the number of findings listed in a digest.
+	maxDigestFindings = 100
+)
+
+// DigestTask returns the task delivering the finding digests which are due
+// every digest interval.
+func (n *Notifier) DigestTask() tasks.Task {
+	return tasks.Task{
+		Name:     DigestTaskName,
+		Interval: n.config.DigestInterval,
+		Jitter:   tasks.DefaultJitter(n.config.DigestInterval),
+		Run:      n.deliverDueDigests,
+	}
+}
+
+// deliverDueDigests delivers the enabled finding digests which are due at now.
+func (n *Notifier) deliverDueDigests(ctx context.Context, now time.Time) error {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	filter := fmt.Sprintf("(disabled eq null or disabled eq false) and nextDeliveryTime le %s", now.Format(time.RFC3339))
+	digests, err := n.db.FindingDigestsTable().GetFindingDigests(models.GetFindingDigestsParams{
+		Filter: &filter,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get due finding digests: %w", err)
+	}
+
+	for _, digest := range *digests.Items {
+		if err := n.deliverDigest(ctx, digest, now); err != nil {
+			logger.WithField("FindingDigestID", utils.ValueOrZero(digest.Id)).Errorf("Failed to deliver finding digest: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// deliverDigest sends the new findings of the digest since its previous
+// delivery to its recipients and webhook, and records the delivery and the
+// time of the next one. Nothing is sent if there are no new findings.
+func (n *Notifier) deliverDigest(ctx context.Context, digest models.FindingDigest, now time.Time) error {
+	logger := log.GetLoggerFromContextOrDiscard(ctx).WithField("FindingDigestID", utils.ValueOrZero(digest.Id))
+
+	cronLine, ok := utils.ValueOrZero(digest.Cadence).CronLine()
+	if !ok {
+		return fmt.Errorf("unsupported cadence %v", utils.ValueOrZero(digest.Cadence))
+	}
+	timezone := utils.ValueOrZero(digest.Timezone)
+	loc, err := report.LoadTimezone(timezone)
+	if err != nil {
+		return err
+	}
+	// A delivery missed while the backend wasn't running is made once and
+	// the digest continues from now.
+	nextDeliveryTime, err := report.NextDeliveryTime(cronLine, timezone, now)
+	if err != nil {
+		return fmt.Errorf("failed to determine next delivery: %w", err)
+	}
+
+	content, err := n.newDigestContent(ctx, digest, digestPeriodStart(digest, now), now)
+	if err != nil {
+		return err
+	}
+
+	delivery := models.FindingDigestDelivery{
+		Time:     now,
+		State:    models.ReportDeliveryStateDelivered,
+		Findings: content.Count,
+	}
+	if content.Count > 0 {
+		if err := n.sendDigest(ctx, digest, content, loc); err != nil {
+			logger.Warnf("Failed to deliver finding digest: %v", err)
+			delivery.State = models.ReportDeliveryStateUndelivered
+			delivery.Message = utils.PointerTo(err.Error())
+		} else {
+			logger.Infof("Delivered finding digest of %d finding(s)", content.Count)
+		}
+	}
+
+	_, err = n.db.FindingDigestsTable().UpdateFindingDigest(models.FindingDigest{
+		Id:               digest.Id,
+		LastDelivery:     &delivery,
+		NextDeliveryTime: &nextDeliveryTime,
+	}, models.PatchFindingDigestsFindingDigestIDParams{})
+	if err != nil {
+		return fmt.Errorf("failed to record delivery: %w", err)
+	}
+
+	return nil
+}
+
+// digestPeriodStart returns the start of the period the next delivery of the
+// digest reports on, the time of the previous delivery or a period of its
+// cadence before now if the previous one failed.
+func digestPeriodStart(digest models.FindingDigest, now time.Time) time.Time {
+	if digest.LastDelivery != nil && digest.LastDelivery.State == models.ReportDeliveryStateDelivered {
+		return digest.LastDelivery.Time
+	}
+	return now.Add(-utils.ValueOrZero(digest.Cadence).Period())
+}
+
+// newDigestContent collects the findings matching the filter of the digest
+// which were found between since and now, and renders them with the finding
+// templates.
+func (n *Notifier) newDigestContent(ctx context.Context, digest models.FindingDigest, since, now time.Time) (models.FindingDigestContent, error) {
+	filter := fmt.Sprintf("(%s) and foundOn ge %s and foundOn lt %s",
+		utils.ValueOrZero(digest.Filter), since.Format(time.RFC3339), now.Format(time.RFC3339))
+	findings, err := n.db.FindingsTable().GetFindings(models.GetFindingsParams{
+		Filter:  &filter,
+		Count:   utils.PointerTo(true),
+		Top:     utils.PointerTo(maxDigestFindings),
+		OrderBy: utils.PointerTo("foundOn desc"),
+	})
+	if err != nil {
+		return models.FindingDigestContent{}, fmt.Errorf("failed to get findings: %w", err)
+	}
+	items := utils.ValueOrZero(findings.Items)
+
+	var rendered []models.RenderedFinding
+	if len(items) > 0 {
+		templates := n.findingTemplates(ctx)
+		rendered = make([]models.RenderedFinding, 0, len(items))
+		for _, finding := range items {
+			rendered = append(rendered, renderFinding(ctx, templates, finding))
+		}
+	}
+
+	return models.FindingDigestContent{
+		FindingDigestID:  utils.ValueOrZero(digest.Id),
+		Name:             digest.Name,
+		PeriodStart:      since,
+		PeriodEnd:        now,
+		Count:            utils.ValueOrZero(findings.Count),
+		Findings:         items,
+		RenderedFindings: &rendered,
+	}, nil
+}
+
+// sendDigest emails the content to the recipients of the digest and posts it
+// to its webhook, the times in the email are shown in the loc location.
+func (n *Notifier) sendDigest(ctx context.Context, digest models.FindingDigest, content models.FindingDigestContent, loc *time.Location) error {
+	var errs []error
+
+	if recipients := utils.ValueOrZero(digest.Recipients); len(recipients) > 0 {
+		if err := n.config.SMTP.Send(newDigestMessage(n.config.SMTP.From, recipients, digest, content, loc)); err != nil {
+			errs = append(errs, fmt.Errorf("failed to email digest: %w", err))
+		}
+	}
+
+	if digest.NotificationConfigID != nil {
+		if err := n.postDigest(ctx, *digest.NotificationConfigID, content); err != nil {
+			errs = append(errs, fmt.Errorf("failed to post digest: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// postDigest posts the content to the webhook of the notification config and
+// records the delivery in it. Nothing is posted to a disabled webhook, nor if
+// the digest doesn't pass the filter of the webhook.
+func (n *Notifier) postDigest(ctx context.Context, notificationConfigID models.NotificationConfigID, content models.FindingDigestContent) error {
+	config, err := n.db.NotificationConfigsTable().GetNotificationConfig(notificationConfigID, models.GetNotificationConfigsNotificationConfigIDParams{})
+	if err != nil {
+		return fmt.Errorf("failed to get notification config %s: %w", notificationConfigID, err)
+	}
+	if utils.ValueOrZero(config.Disabled) {
+		return nil
+	}
+
+	event, err := payload.NewEvent(models.NotificationEvent{
+		Type:   models.FindingsDigest,
+		Time:   content.PeriodEnd,
+		Digest: &content,
+	})
+	if err != nil {
+		return err
+	}
+
+	delivery, ok := n.deliverAndRecord(ctx, config, models.FindingsDigest, event)
+	if ok && delivery.State == models.NotificationDeliveryStateUndelivered {
+		return fmt.Errorf("webhook delivery failed: %s", utils.ValueOrZero(delivery.Message))
+	}
+
+	return nil
+}
+
+func newDigestMessage(from string, to []string, digest models.FindingDigest, content models.FindingDigestContent, loc *time.Location) report.Message {
+	var body strings.Builder
+	fmt.Fprintf(&body, "%d new finding(s) matching %q were found between %s and %s.\r\n\r\n",
+		content.Count, utils.ValueOrZero(digest.Filter),
+		content.PeriodStart.In(loc).Format(time.RFC1123), content.PeriodEnd.In(loc).Format(time.RFC1123))
+	for _, finding := range utils.ValueOrZero(content.RenderedFindings) {
+		fmt.Fprintf(&body, "- %s\r\n", finding.Title)
+	}
+	if more := content.Count - len(content.Findings); more > 0 {
+		fmt.Fprintf(&body, "\r\n... and %d more.\r\n", more)
+	}
+
+	return report.Message{
+		From:    from,
+		To:      to,
+		Subject: fmt.Sprintf("%s - VMClarity finding digest %s", utils.ValueOrZero(digest.Name), content.PeriodEnd.In(loc).Format("2006-01-02")),
+		Date:    content.PeriodEnd.In(loc),
+		Body:    body.String(),
+	}
+}
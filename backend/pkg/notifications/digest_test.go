@@ -0,0 +1,279 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+type fakeDigestDatabase struct {
+	databaseTypes.Database
+	findings            fakeCountedFindingsTable
+	findingDigests      *fakeFindingDigestsTable
+	notificationConfigs *fakeNotificationConfigsTable
+	settings            fakeSettingsTable
+}
+
+func (d *fakeDigestDatabase) SettingsTable() databaseTypes.SettingsTable {
+	return d.settings
+}
+
+func (d *fakeDigestDatabase) FindingsTable() databaseTypes.FindingsTable {
+	return d.findings
+}
+
+func (d *fakeDigestDatabase) FindingDigestsTable() databaseTypes.FindingDigestsTable {
+	return d.findingDigests
+}
+
+func (d *fakeDigestDatabase) NotificationConfigsTable() databaseTypes.NotificationConfigsTable {
+	return d.notificationConfigs
+}
+
+type fakeCountedFindingsTable struct {
+	databaseTypes.FindingsTable
+	items []models.Finding
+	count int
+}
+
+func (t fakeCountedFindingsTable) GetFindings(models.GetFindingsParams) (models.Findings, error) {
+	return models.Findings{Items: &t.items, Count: &t.count}, nil
+}
+
+type fakeSettingsTable struct {
+	databaseTypes.SettingsTable
+	findingTemplates models.FindingTemplateSettings
+}
+
+func (t fakeSettingsTable) GetFindingTemplateSettings() (models.FindingTemplateSettings, error) {
+	return t.findingTemplates, nil
+}
+
+type fakeFindingDigestsTable struct {
+	databaseTypes.FindingDigestsTable
+	updates []models.FindingDigest
+}
+
+func (t *fakeFindingDigestsTable) UpdateFindingDigest(findingDigest models.FindingDigest, _ models.PatchFindingDigestsFindingDigestIDParams) (models.FindingDigest, error) {
+	t.updates = append(t.updates, findingDigest)
+	return findingDigest, nil
+}
+
+type fakeNotificationConfigsTable struct {
+	databaseTypes.NotificationConfigsTable
+	config models.NotificationConfig
+}
+
+func (t *fakeNotificationConfigsTable) GetNotificationConfig(models.NotificationConfigID, models.GetNotificationConfigsNotificationConfigIDParams) (models.NotificationConfig, error) {
+	return t.config, nil
+}
+
+func (t *fakeNotificationConfigsTable) UpdateNotificationConfig(notificationConfig models.NotificationConfig, _ models.PatchNotificationConfigsNotificationConfigIDParams) (models.NotificationConfig, error) {
+	return notificationConfig, nil
+}
+
+// nolint:cyclop
+func TestNotifier_deliverDigest(t *testing.T) {
+	now := time.Date(2023, 3, 27, 8, 0, 0, 0, time.UTC)
+	findingInfo := models.Finding_FindingInfo{}
+	if err := findingInfo.FromVulnerabilityFindingInfo(models.VulnerabilityFindingInfo{
+		ObjectType:        "Vulnerability",
+		VulnerabilityName: utils.PointerTo("CVE-2023-0001"),
+	}); err != nil {
+		t.Fatalf("failed to create finding info: %v", err)
+	}
+	finding := models.Finding{
+		Id:          utils.PointerTo("finding"),
+		FindingInfo: &findingInfo,
+		Asset:       &models.AssetRelationship{Id: "asset"},
+	}
+
+	tests := []struct {
+		name      string
+		findings  []models.Finding
+		count     int
+		wantPosts int
+	}{
+		{
+			name:      "new findings are posted",
+			findings:  []models.Finding{finding},
+			count:     150,
+			wantPosts: 1,
+		},
+		{
+			name:      "nothing is posted without new findings",
+			findings:  []models.Finding{},
+			count:     0,
+			wantPosts: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var events []models.NotificationEvent
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get(EventHeader); got != string(models.FindingsDigest) {
+					t.Errorf("unexpected event header %q", got)
+				}
+				body, _ := io.ReadAll(r.Body)
+				var event models.NotificationEvent
+				if err := json.Unmarshal(body, &event); err != nil {
+					t.Errorf("failed to unmarshal event: %v", err)
+				}
+				events = append(events, event)
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			db := &fakeDigestDatabase{
+				findings:       fakeCountedFindingsTable{items: tt.findings, count: tt.count},
+				findingDigests: &fakeFindingDigestsTable{},
+				notificationConfigs: &fakeNotificationConfigsTable{config: models.NotificationConfig{
+					Id:  utils.PointerTo("webhook"),
+					Url: utils.PointerTo(server.URL),
+				}},
+				settings: fakeSettingsTable{findingTemplates: models.FindingTemplateSettings{
+					Families: &map[string]models.FindingTemplate{
+						"Vulnerability": {Title: utils.PointerTo("{{.Info.VulnerabilityName}} on {{.AssetID}}")},
+					},
+				}},
+			}
+			n := New(db, Config{})
+			digest := models.FindingDigest{
+				Id:                   utils.PointerTo("digest"),
+				Name:                 utils.PointerTo("critical"),
+				Filter:               utils.PointerTo("findingInfo/objectType eq 'Vulnerability'"),
+				Cadence:              utils.PointerTo(models.Weekly),
+				NotificationConfigID: utils.PointerTo("webhook"),
+			}
+
+			if err := n.deliverDigest(context.Background(), digest, now); err != nil {
+				t.Fatalf("deliverDigest() error = %v", err)
+			}
+
+			if len(events) != tt.wantPosts {
+				t.Fatalf("got %d posts, want %d", len(events), tt.wantPosts)
+			}
+			for _, event := range events {
+				if event.Digest == nil || event.Digest.Count != tt.count || len(event.Digest.Findings) != len(tt.findings) {
+					t.Errorf("unexpected digest %+v", event.Digest)
+				} else if !event.Digest.PeriodStart.Equal(now.Add(-7 * 24 * time.Hour)) {
+					t.Errorf("digest period start = %v, want a week before %v", event.Digest.PeriodStart, now)
+				}
+				rendered := utils.ValueOrZero(event.Digest.RenderedFindings)
+				if len(rendered) != len(tt.findings) {
+					t.Errorf("got %d rendered findings, want %d", len(rendered), len(tt.findings))
+				}
+				for _, r := range rendered {
+					if r.Title != "CVE-2023-0001 on asset" {
+						t.Errorf("rendered finding title = %q, want the title of the vulnerability template", r.Title)
+					}
+				}
+			}
+
+			if len(db.findingDigests.updates) != 1 {
+				t.Fatalf("got %d updates of the digest, want 1", len(db.findingDigests.updates))
+			}
+			update := db.findingDigests.updates[0]
+			if update.LastDelivery == nil || update.LastDelivery.State != models.ReportDeliveryStateDelivered ||
+				update.LastDelivery.Findings != tt.count {
+				t.Errorf("unexpected last delivery %+v", update.LastDelivery)
+			}
+			if want := time.Date(2023, 4, 3, 8, 0, 0, 0, time.UTC); update.NextDeliveryTime == nil || !update.NextDeliveryTime.Equal(want) {
+				t.Errorf("next delivery time = %v, want %v", update.NextDeliveryTime, want)
+			}
+		})
+	}
+}
+
+func TestNotifier_deliverDigest_undelivered(t *testing.T) {
+	now := time.Date(2023, 3, 27, 8, 0, 0, 0, time.UTC)
+	db := &fakeDigestDatabase{
+		findings:       fakeCountedFindingsTable{items: []models.Finding{{Id: utils.PointerTo("finding")}}, count: 1},
+		findingDigests: &fakeFindingDigestsTable{},
+	}
+	// The SMTP server is not configured.
+	n := New(db, Config{})
+	digest := models.FindingDigest{
+		Id:         utils.PointerTo("digest"),
+		Filter:     utils.PointerTo("findingInfo/objectType eq 'Secret'"),
+		Cadence:    utils.PointerTo(models.Daily),
+		Recipients: &[]string{"security@example.com"},
+	}
+
+	if err := n.deliverDigest(context.Background(), digest, now); err != nil {
+		t.Fatalf("deliverDigest() error = %v", err)
+	}
+
+	if len(db.findingDigests.updates) != 1 {
+		t.Fatalf("got %d updates of the digest, want 1", len(db.findingDigests.updates))
+	}
+	delivery := db.findingDigests.updates[0].LastDelivery
+	if delivery == nil || delivery.State != models.ReportDeliveryStateUndelivered ||
+		!strings.Contains(utils.ValueOrZero(delivery.Message), "SMTP server is not configured") {
+		t.Errorf("unexpected last delivery %+v", delivery)
+	}
+}
+
+func Test_digestPeriodStart(t *testing.T) {
+	now := time.Date(2023, 3, 27, 8, 0, 0, 0, time.UTC)
+	lastDelivery := time.Date(2023, 3, 26, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		digest models.FindingDigest
+		want   time.Time
+	}{
+		{
+			name:   "first daily delivery",
+			digest: models.FindingDigest{Cadence: utils.PointerTo(models.Daily)},
+			want:   now.Add(-24 * time.Hour),
+		},
+		{
+			name: "since previous delivery",
+			digest: models.FindingDigest{
+				Cadence:      utils.PointerTo(models.Weekly),
+				LastDelivery: &models.FindingDigestDelivery{Time: lastDelivery, State: models.ReportDeliveryStateDelivered},
+			},
+			want: lastDelivery,
+		},
+		{
+			name: "previous weekly delivery failed",
+			digest: models.FindingDigest{
+				Cadence:      utils.PointerTo(models.Weekly),
+				LastDelivery: &models.FindingDigestDelivery{Time: lastDelivery, State: models.ReportDeliveryStateUndelivered},
+			},
+			want: now.Add(-7 * 24 * time.Hour),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := digestPeriodStart(tt.digest, now); !got.Equal(tt.want) {
+				t.Errorf("digestPeriodStart() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
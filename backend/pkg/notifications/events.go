@@ -0,0 +1,60 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifications
+
+import (
+	"github.com/openclarity/vmclarity/api/models"
+)
+
+// ScanEvent returns the event of a Scan changing from the old to the new
+// state, if there is one. A new Scan has an empty old state.
+func ScanEvent(oldState, newState models.ScanState) (models.NotificationEventType, bool) {
+	if oldState == newState {
+		return "", false
+	}
+
+	switch newState {
+	case models.ScanStateInProgress:
+		return models.ScanStarted, true
+	case models.ScanStateDone:
+		return models.ScanCompleted, true
+	case models.ScanStateFailed:
+		return models.ScanFailed, true
+	case models.ScanStateAborted, models.ScanStateDiscovered, models.ScanStatePending:
+		fallthrough
+	default:
+		return "", false
+	}
+}
+
+// FindingEvent returns the event of a new Finding, if there is one.
+func FindingEvent(finding models.Finding) (models.NotificationEventType, bool) {
+	if finding.FindingInfo == nil {
+		return "", false
+	}
+
+	discriminator, err := finding.FindingInfo.Discriminator()
+	if err != nil || discriminator != "Vulnerability" {
+		return "", false
+	}
+
+	vuln, err := finding.FindingInfo.AsVulnerabilityFindingInfo()
+	if err != nil || vuln.Severity == nil || *vuln.Severity != models.CRITICAL {
+		return "", false
+	}
+
+	return models.CriticalFindingFound, true
+}
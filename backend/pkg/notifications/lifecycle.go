@@ -0,0 +1,149 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifications
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/findingkey"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// findingHistory is what is known about the findings of a type on an asset
+// at the time some of them were invalidated. The findings invalidated by the
+// same scan share it, so it is only fetched once for all of them.
+type findingHistory struct {
+	assetID       string
+	findingType   string
+	invalidatedOn time.Time
+
+	// firstFoundOn is the time each finding key was first found on the asset.
+	firstFoundOn map[string]time.Time
+	// reported holds the keys of the findings found at invalidatedOn, which
+	// are still appearing.
+	reported map[string]struct{}
+	// scanned is whether a scan of the asset completed at invalidatedOn.
+	scanned bool
+}
+
+func (h *findingHistory) matches(assetID, findingType string, invalidatedOn time.Time) bool {
+	return h != nil && h.assetID == assetID && h.findingType == findingType && h.invalidatedOn.Equal(invalidatedOn)
+}
+
+// findingLifecycleEvent returns the FindingFixed or FindingInvalidated event
+// of an invalidated finding, if it stopped appearing. Findings are reported
+// again by each scan of their asset, so a finding invalidated by a scan which
+// reported it again is still appearing.
+func (n *Notifier) findingLifecycleEvent(finding models.Finding) (models.NotificationEvent, bool, error) {
+	if finding.InvalidatedOn == nil || finding.FoundOn == nil || finding.Asset == nil || finding.FindingInfo == nil {
+		return models.NotificationEvent{}, false, nil
+	}
+
+	findingType, err := finding.FindingInfo.Discriminator()
+	if err != nil {
+		return models.NotificationEvent{}, false, fmt.Errorf("failed to get finding type: %w", err)
+	}
+	key, err := findingkey.GenerateFindingKey(finding.FindingInfo)
+	if err != nil {
+		return models.NotificationEvent{}, false, fmt.Errorf("failed to generate finding key: %w", err)
+	}
+
+	history := n.findingHistory
+	if !history.matches(finding.Asset.Id, findingType, *finding.InvalidatedOn) {
+		history, err = n.getFindingHistory(finding.Asset.Id, findingType, *finding.InvalidatedOn)
+		if err != nil {
+			return models.NotificationEvent{}, false, err
+		}
+		n.findingHistory = history
+	}
+
+	if _, ok := history.reported[key]; ok {
+		return models.NotificationEvent{}, false, nil
+	}
+
+	eventType := models.FindingInvalidated
+	if history.scanned {
+		eventType = models.FindingFixed
+	}
+
+	firstFoundOn := *finding.FoundOn
+	if foundOn, ok := history.firstFoundOn[key]; ok && foundOn.Before(firstFoundOn) {
+		firstFoundOn = foundOn
+	}
+
+	return models.NotificationEvent{
+		Type:             eventType,
+		Time:             time.Now(),
+		Finding:          &finding,
+		TimeToFixSeconds: utils.PointerTo(int64(finding.InvalidatedOn.Sub(firstFoundOn).Seconds())),
+	}, true, nil
+}
+
+func (n *Notifier) getFindingHistory(assetID, findingType string, invalidatedOn time.Time) (*findingHistory, error) {
+	history := &findingHistory{
+		assetID:       assetID,
+		findingType:   findingType,
+		invalidatedOn: invalidatedOn,
+		firstFoundOn:  map[string]time.Time{},
+		reported:      map[string]struct{}{},
+	}
+
+	findings, err := n.db.FindingsTable().GetFindings(models.GetFindingsParams{
+		Filter: utils.PointerTo(fmt.Sprintf("findingInfo/objectType eq '%s' and asset/id eq '%s'", findingType, assetID)),
+		Select: utils.PointerTo("foundOn,findingInfo"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get findings of asset %s: %w", assetID, err)
+	}
+	for _, finding := range *findings.Items {
+		if finding.FoundOn == nil || finding.FindingInfo == nil || finding.FoundOn.After(invalidatedOn) {
+			continue
+		}
+		key, err := findingkey.GenerateFindingKey(finding.FindingInfo)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate finding key: %w", err)
+		}
+		if foundOn, ok := history.firstFoundOn[key]; !ok || finding.FoundOn.Before(foundOn) {
+			history.firstFoundOn[key] = *finding.FoundOn
+		}
+		if finding.FoundOn.Equal(invalidatedOn) {
+			history.reported[key] = struct{}{}
+		}
+	}
+
+	// The scans invalidate the findings of their asset with the time they
+	// completed at.
+	scanResults, err := n.db.ScanResultsTable().GetScanResults(models.GetScanResultsParams{
+		Filter: utils.PointerTo(fmt.Sprintf("asset/id eq '%s' and status/general/state eq '%s'", assetID, models.AssetScanStateStateDone)),
+		Select: utils.PointerTo("status/general/lastTransitionTime"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scan results of asset %s: %w", assetID, err)
+	}
+	for _, scanResult := range *scanResults.Items {
+		if scanResult.Status == nil || scanResult.Status.General == nil || scanResult.Status.General.LastTransitionTime == nil {
+			continue
+		}
+		if scanResult.Status.General.LastTransitionTime.Equal(invalidatedOn) {
+			history.scanned = true
+			break
+		}
+	}
+
+	return history, nil
+}
@@ -0,0 +1,174 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifications
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+type fakeDatabase struct {
+	databaseTypes.Database
+	findings    fakeFindingsTable
+	scanResults fakeScanResultsTable
+}
+
+func (d *fakeDatabase) FindingsTable() databaseTypes.FindingsTable {
+	return d.findings
+}
+
+func (d *fakeDatabase) ScanResultsTable() databaseTypes.ScanResultsTable {
+	return d.scanResults
+}
+
+type fakeFindingsTable struct {
+	databaseTypes.FindingsTable
+	items []models.Finding
+}
+
+func (t fakeFindingsTable) GetFindings(models.GetFindingsParams) (models.Findings, error) {
+	return models.Findings{Items: &t.items}, nil
+}
+
+type fakeScanResultsTable struct {
+	databaseTypes.ScanResultsTable
+	items []models.AssetScanResult
+}
+
+func (t fakeScanResultsTable) GetScanResults(models.GetScanResultsParams) (models.AssetScanResults, error) {
+	return models.AssetScanResults{Items: &t.items}, nil
+}
+
+func newVulnerabilityFinding(t *testing.T, name string, foundOn time.Time, invalidatedOn *time.Time) models.Finding {
+	t.Helper()
+
+	var findingInfo models.Finding_FindingInfo
+	err := findingInfo.FromVulnerabilityFindingInfo(models.VulnerabilityFindingInfo{
+		ObjectType:        "Vulnerability",
+		VulnerabilityName: utils.PointerTo(name),
+		Package: &models.Package{
+			Name:    utils.PointerTo("openssl"),
+			Version: utils.PointerTo("1.1.1"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create finding info: %v", err)
+	}
+
+	return models.Finding{
+		Id:            utils.PointerTo(name + foundOn.String()),
+		Asset:         &models.AssetRelationship{Id: "asset"},
+		FoundOn:       utils.PointerTo(foundOn),
+		InvalidatedOn: invalidatedOn,
+		FindingInfo:   &findingInfo,
+	}
+}
+
+func TestNotifier_findingLifecycleEvent(t *testing.T) {
+	firstScan := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	secondScan := firstScan.Add(24 * time.Hour)
+	thirdScan := secondScan.Add(24 * time.Hour)
+
+	scanResult := func(completed time.Time) models.AssetScanResult {
+		return models.AssetScanResult{
+			Status: &models.AssetScanStatus{
+				General: &models.AssetScanState{LastTransitionTime: utils.PointerTo(completed)},
+			},
+		}
+	}
+
+	tests := []struct {
+		name              string
+		findings          []models.Finding
+		scanResults       []models.AssetScanResult
+		finding           models.Finding
+		wantOk            bool
+		wantType          models.NotificationEventType
+		wantTimeToFixSecs int64
+	}{
+		{
+			name: "still reported by the invalidating scan",
+			findings: []models.Finding{
+				newVulnerabilityFinding(t, "CVE-1", firstScan, &secondScan),
+				newVulnerabilityFinding(t, "CVE-1", secondScan, nil),
+			},
+			scanResults: []models.AssetScanResult{scanResult(firstScan), scanResult(secondScan)},
+			finding:     newVulnerabilityFinding(t, "CVE-1", firstScan, &secondScan),
+			wantOk:      false,
+		},
+		{
+			name: "fixed since the first scan it was found in",
+			findings: []models.Finding{
+				newVulnerabilityFinding(t, "CVE-1", firstScan, &secondScan),
+				newVulnerabilityFinding(t, "CVE-1", secondScan, &thirdScan),
+				newVulnerabilityFinding(t, "CVE-2", thirdScan, nil),
+			},
+			scanResults:       []models.AssetScanResult{scanResult(firstScan), scanResult(secondScan), scanResult(thirdScan)},
+			finding:           newVulnerabilityFinding(t, "CVE-1", secondScan, &thirdScan),
+			wantOk:            true,
+			wantType:          models.FindingFixed,
+			wantTimeToFixSecs: int64((48 * time.Hour).Seconds()),
+		},
+		{
+			name: "invalidated without a scan",
+			findings: []models.Finding{
+				newVulnerabilityFinding(t, "CVE-1", firstScan, &secondScan),
+			},
+			scanResults:       []models.AssetScanResult{scanResult(firstScan)},
+			finding:           newVulnerabilityFinding(t, "CVE-1", firstScan, &secondScan),
+			wantOk:            true,
+			wantType:          models.FindingInvalidated,
+			wantTimeToFixSecs: int64((24 * time.Hour).Seconds()),
+		},
+		{
+			name:     "not invalidated",
+			finding:  newVulnerabilityFinding(t, "CVE-1", firstScan, nil),
+			findings: []models.Finding{newVulnerabilityFinding(t, "CVE-1", firstScan, nil)},
+			wantOk:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := New(&fakeDatabase{
+				findings:    fakeFindingsTable{items: tt.findings},
+				scanResults: fakeScanResultsTable{items: tt.scanResults},
+			}, Config{})
+
+			event, ok, err := n.findingLifecycleEvent(tt.finding)
+			if err != nil {
+				t.Fatalf("findingLifecycleEvent() unexpected error: %v", err)
+			}
+			if ok != tt.wantOk {
+				t.Fatalf("findingLifecycleEvent() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if !ok {
+				return
+			}
+			if event.Type != tt.wantType {
+				t.Errorf("findingLifecycleEvent() type = %v, want %v", event.Type, tt.wantType)
+			}
+			if diff := cmp.Diff(utils.PointerTo(tt.wantTimeToFixSecs), event.TimeToFixSeconds); diff != "" {
+				t.Errorf("findingLifecycleEvent() timeToFixSeconds mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
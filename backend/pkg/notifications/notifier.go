@@ -0,0 +1,376 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/backend/pkg/notifications/payload"
+	"github.com/openclarity/vmclarity/shared/pkg/findingtemplate"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/report"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const (
+	SignatureHeader = "X-VMClarity-Signature"
+	EventHeader     = "X-VMClarity-Event"
+
+	DefaultTimeout        = 10 * time.Second
+	DefaultMaxAttempts    = 5
+	DefaultInitialBackoff = time.Second
+	DefaultMaxBackoff     = time.Minute
+	DefaultDigestInterval = time.Minute
+
+	queueSize = 1000
+)
+
+type Config struct {
+	// Timeout of a single delivery attempt.
+	Timeout time.Duration
+	// MaxAttempts is the number of times a delivery is attempted before it is
+	// recorded as undelivered.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry, doubled after each retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between retries.
+	MaxBackoff time.Duration
+	// DigestInterval between checking for finding digests which are due.
+	DigestInterval time.Duration
+	// SMTP is the mail server the finding digests are emailed through.
+	SMTP report.SMTPConfig
+}
+
+// Notifier delivers the events to the webhooks subscribed to them and the
+// FindingDigests which are due, and records the status of the deliveries.
+type Notifier struct {
+	db     databaseTypes.Database
+	client *http.Client
+	config Config
+	events chan models.NotificationEvent
+
+	// invalidatedFindings are checked for having stopped appearing by the
+	// dispatch loop, which is the only user of findingHistory.
+	invalidatedFindings chan models.Finding
+	findingHistory      *findingHistory
+}
+
+func New(db databaseTypes.Database, config Config) *Notifier {
+	if config.Timeout <= 0 {
+		config.Timeout = DefaultTimeout
+	}
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = DefaultMaxAttempts
+	}
+	if config.InitialBackoff <= 0 {
+		config.InitialBackoff = DefaultInitialBackoff
+	}
+	if config.MaxBackoff <= 0 {
+		config.MaxBackoff = DefaultMaxBackoff
+	}
+	if config.DigestInterval <= 0 {
+		config.DigestInterval = DefaultDigestInterval
+	}
+
+	return &Notifier{
+		db: db,
+		client: &http.Client{
+			Timeout: config.Timeout,
+		},
+		config: config,
+		events: make(chan models.NotificationEvent, queueSize),
+
+		invalidatedFindings: make(chan models.Finding, queueSize),
+	}
+}
+
+// Notify queues the event for delivery, it never blocks the caller. Events are
+// dropped if the queue is full. Notify is a no-op on a nil Notifier.
+func (n *Notifier) Notify(eventType models.NotificationEventType, scan *models.Scan, finding *models.Finding) {
+	if n == nil {
+		return
+	}
+
+	event := models.NotificationEvent{
+		Type:    eventType,
+		Time:    time.Now(),
+		Scan:    scan,
+		Finding: finding,
+	}
+	select {
+	case n.events <- event:
+	default:
+	}
+}
+
+// NotifyFindingInvalidated queues the finding, which was just invalidated, to
+// be notified as fixed or invalidated if it stopped appearing. It never blocks
+// the caller. NotifyFindingInvalidated is a no-op on a nil Notifier.
+func (n *Notifier) NotifyFindingInvalidated(finding models.Finding) {
+	if n == nil {
+		return
+	}
+
+	select {
+	case n.invalidatedFindings <- finding:
+	default:
+	}
+}
+
+func (n *Notifier) Start(ctx context.Context) {
+	go func() {
+		logger := log.GetLoggerFromContextOrDiscard(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				logger.Infof("Notifier stopped")
+				return
+			case event := <-n.events:
+				if err := n.dispatch(ctx, event); err != nil {
+					logger.Errorf("Failed to dispatch %s event: %v", event.Type, err)
+				}
+			case finding := <-n.invalidatedFindings:
+				event, ok, err := n.findingLifecycleEvent(finding)
+				if err != nil {
+					logger.Errorf("Failed to check whether finding %s stopped appearing: %v", utils.ValueOrZero(finding.Id), err)
+					continue
+				}
+				if !ok {
+					continue
+				}
+				if err := n.dispatch(ctx, event); err != nil {
+					logger.Errorf("Failed to dispatch %s event: %v", event.Type, err)
+				}
+			}
+		}
+	}()
+}
+
+// dispatch delivers the event to the enabled webhooks subscribed to it, each
+// of them in the background so that a slow webhook doesn't hold back the others.
+func (n *Notifier) dispatch(ctx context.Context, event models.NotificationEvent) error {
+	filter := fmt.Sprintf("(disabled eq null or disabled eq false) and events/any(e: e eq '%s')", event.Type)
+	configs, err := n.db.NotificationConfigsTable().GetNotificationConfigs(models.GetNotificationConfigsParams{
+		Filter: &filter,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get notification configs: %w", err)
+	}
+
+	if event.Finding != nil {
+		rendered := renderFinding(ctx, n.findingTemplates(ctx), *event.Finding)
+		event.RenderedFinding = &rendered
+	}
+
+	data, err := payload.NewEvent(event)
+	if err != nil {
+		return err
+	}
+
+	for _, config := range *configs.Items {
+		if !meetsMinConfidence(config, event.Finding) {
+			continue
+		}
+		go n.deliverAndRecord(ctx, config, event.Type, data)
+	}
+
+	return nil
+}
+
+// meetsMinConfidence returns whether the finding of the event, if there is one,
+// has at least the minimal confidence of the webhook. Findings without a
+// confidence always meet it as their scanner doesn't report one.
+func meetsMinConfidence(config models.NotificationConfig, finding *models.Finding) bool {
+	if config.MinConfidence == nil || finding == nil || finding.Confidence == nil {
+		return true
+	}
+	return finding.Confidence.IsAtLeast(*config.MinConfidence)
+}
+
+// findingTemplates returns the finding templates of the settings, or the
+// built-in ones if the settings can't be loaded.
+func (n *Notifier) findingTemplates(ctx context.Context) *findingtemplate.Templates {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	settings, err := n.db.SettingsTable().GetFindingTemplateSettings()
+	if err != nil {
+		logger.Warnf("Failed to get finding templates, using the built-in ones: %v", err)
+		return findingtemplate.Default()
+	}
+	templates, err := findingtemplate.Parse(settings)
+	if err != nil {
+		logger.Warnf("Invalid finding templates, using the built-in ones: %v", err)
+		return findingtemplate.Default()
+	}
+	return templates
+}
+
+// renderFinding renders the finding with the templates, or with the built-in
+// templates if they fail to render it, e.g. as they refer to a field the
+// finding doesn't have.
+func renderFinding(ctx context.Context, templates *findingtemplate.Templates, finding models.Finding) models.RenderedFinding {
+	rendered, err := templates.Render(finding)
+	if err == nil {
+		return rendered
+	}
+	log.GetLoggerFromContextOrDiscard(ctx).Warnf("Failed to render finding %s, using the built-in templates: %v",
+		utils.ValueOrZero(finding.Id), err)
+
+	// The built-in templates handle any finding.
+	rendered, _ = findingtemplate.Default().Render(finding)
+	return rendered
+}
+
+// deliverAndRecord delivers the event to the webhook if it passes the filter
+// of the webhook, and records the delivery. It returns false if the event
+// didn't pass the filter, nothing is delivered nor recorded then. An invalid
+// filter or payload template is recorded as an undelivered delivery.
+func (n *Notifier) deliverAndRecord(ctx context.Context, config models.NotificationConfig, eventType models.NotificationEventType, event payload.Event) (models.NotificationDelivery, bool) {
+	logger := log.GetLoggerFromContextOrDiscard(ctx).WithField("NotificationConfigID", *config.Id)
+
+	var delivery models.NotificationDelivery
+	body, contentType, ok, err := renderPayload(config, event)
+	switch {
+	case err != nil:
+		delivery = models.NotificationDelivery{
+			Time:    time.Now(),
+			Event:   eventType,
+			State:   models.NotificationDeliveryStateUndelivered,
+			Message: utils.PointerTo(err.Error()),
+		}
+	case !ok:
+		return models.NotificationDelivery{}, false
+	default:
+		delivery = n.deliver(ctx, config, eventType, body, contentType)
+	}
+	if delivery.State == models.NotificationDeliveryStateUndelivered {
+		logger.Warnf("Failed to deliver %s event: %s", eventType, utils.ValueOrZero(delivery.Message))
+	}
+
+	_, err = n.db.NotificationConfigsTable().UpdateNotificationConfig(models.NotificationConfig{
+		Id:           config.Id,
+		LastDelivery: &delivery,
+	}, models.PatchNotificationConfigsNotificationConfigIDParams{})
+	if err != nil {
+		logger.Errorf("Failed to record the delivery of %s event: %v", eventType, err)
+	}
+
+	return delivery, true
+}
+
+// renderPayload returns the body posted to the webhook for the event and its
+// content type, and whether the event passes the filter of the webhook.
+func renderPayload(config models.NotificationConfig, event payload.Event) ([]byte, string, bool, error) {
+	p, err := payload.Parse(config)
+	if err != nil {
+		return nil, "", false, err
+	}
+	ok, err := p.Matches(event)
+	if err != nil || !ok {
+		return nil, "", false, err
+	}
+	body, contentType, err := p.Render(event)
+	if err != nil {
+		return nil, "", false, err
+	}
+	return body, contentType, true, nil
+}
+
+// deliver posts the body to the webhook, retrying with exponential backoff
+// on network errors, on server errors and when it is rate limited.
+func (n *Notifier) deliver(ctx context.Context, config models.NotificationConfig, eventType models.NotificationEventType, body []byte, contentType string) models.NotificationDelivery {
+	delivery := models.NotificationDelivery{
+		Event: eventType,
+		State: models.NotificationDeliveryStateUndelivered,
+	}
+
+	backoff := n.config.InitialBackoff
+	for delivery.Attempts < n.config.MaxAttempts {
+		if delivery.Attempts > 0 {
+			select {
+			case <-ctx.Done():
+				delivery.Message = utils.PointerTo(ctx.Err().Error())
+				delivery.Time = time.Now()
+				return delivery
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+			if backoff > n.config.MaxBackoff {
+				backoff = n.config.MaxBackoff
+			}
+		}
+		delivery.Attempts++
+
+		retry, err := n.post(ctx, config, eventType, body, contentType)
+		if err == nil {
+			delivery.State = models.NotificationDeliveryStateDelivered
+			delivery.Message = nil
+			break
+		}
+		delivery.Message = utils.PointerTo(err.Error())
+		if !retry {
+			break
+		}
+	}
+	delivery.Time = time.Now()
+
+	return delivery
+}
+
+// post returns whether the request should be retried if it failed.
+func (n *Notifier) post(ctx context.Context, config models.NotificationConfig, eventType models.NotificationEventType, body []byte, contentType string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, *config.Url, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set(EventHeader, string(eventType))
+	if secret := utils.ValueOrZero(config.Secret); secret != "" {
+		req.Header.Set(SignatureHeader, Sign([]byte(secret), body))
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return true, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError:
+		return true, fmt.Errorf("webhook responded with %s", resp.Status)
+	default:
+		return false, fmt.Errorf("webhook responded with %s", resp.Status)
+	}
+}
+
+// Sign returns the value of the signature header of the payload, the hex
+// encoded HMAC-SHA256 of the payload with the secret prefixed with "sha256=".
+func Sign(secret, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
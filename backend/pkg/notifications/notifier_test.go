@@ -0,0 +1,303 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifications
+
+import (
+	"context"
+	"crypto/hmac"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/findingtemplate"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestScanEvent(t *testing.T) {
+	tests := []struct {
+		name      string
+		oldState  models.ScanState
+		newState  models.ScanState
+		wantEvent models.NotificationEventType
+		wantOk    bool
+	}{
+		{
+			name:      "scan started",
+			oldState:  models.ScanStateDiscovered,
+			newState:  models.ScanStateInProgress,
+			wantEvent: models.ScanStarted,
+			wantOk:    true,
+		},
+		{
+			name:      "scan completed",
+			oldState:  models.ScanStateInProgress,
+			newState:  models.ScanStateDone,
+			wantEvent: models.ScanCompleted,
+			wantOk:    true,
+		},
+		{
+			name:      "scan failed",
+			oldState:  models.ScanStateDiscovered,
+			newState:  models.ScanStateFailed,
+			wantEvent: models.ScanFailed,
+			wantOk:    true,
+		},
+		{
+			name:      "new scan in progress",
+			oldState:  "",
+			newState:  models.ScanStateInProgress,
+			wantEvent: models.ScanStarted,
+			wantOk:    true,
+		},
+		{
+			name:     "unchanged state",
+			oldState: models.ScanStateInProgress,
+			newState: models.ScanStateInProgress,
+		},
+		{
+			name:     "scan aborted",
+			oldState: models.ScanStateInProgress,
+			newState: models.ScanStateAborted,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotEvent, gotOk := ScanEvent(tt.oldState, tt.newState)
+			if gotEvent != tt.wantEvent || gotOk != tt.wantOk {
+				t.Errorf("ScanEvent() = %v, %v, want %v, %v", gotEvent, gotOk, tt.wantEvent, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestFindingEvent(t *testing.T) {
+	newVulnerability := func(t *testing.T, severity models.VulnerabilitySeverity) models.Finding {
+		t.Helper()
+		findingInfo := models.Finding_FindingInfo{}
+		err := findingInfo.FromVulnerabilityFindingInfo(models.VulnerabilityFindingInfo{
+			ObjectType: "Vulnerability",
+			Severity:   utils.PointerTo(severity),
+		})
+		if err != nil {
+			t.Fatalf("failed to create finding info: %v", err)
+		}
+		return models.Finding{FindingInfo: &findingInfo}
+	}
+
+	if _, ok := FindingEvent(newVulnerability(t, models.CRITICAL)); !ok {
+		t.Errorf("FindingEvent() of a critical vulnerability didn't return an event")
+	}
+	if _, ok := FindingEvent(newVulnerability(t, models.HIGH)); ok {
+		t.Errorf("FindingEvent() of a high vulnerability returned an event")
+	}
+	if _, ok := FindingEvent(models.Finding{}); ok {
+		t.Errorf("FindingEvent() of a finding without info returned an event")
+	}
+}
+
+func TestMeetsMinConfidence(t *testing.T) {
+	tests := []struct {
+		name          string
+		minConfidence *models.FindingConfidence
+		finding       *models.Finding
+		want          bool
+	}{
+		{
+			name:    "no threshold",
+			finding: &models.Finding{Confidence: utils.PointerTo(models.Low)},
+			want:    true,
+		},
+		{
+			name:          "scan event",
+			minConfidence: utils.PointerTo(models.High),
+			want:          true,
+		},
+		{
+			name:          "finding without confidence",
+			minConfidence: utils.PointerTo(models.High),
+			finding:       &models.Finding{},
+			want:          true,
+		},
+		{
+			name:          "finding below threshold",
+			minConfidence: utils.PointerTo(models.Medium),
+			finding:       &models.Finding{Confidence: utils.PointerTo(models.Low)},
+			want:          false,
+		},
+		{
+			name:          "finding at threshold",
+			minConfidence: utils.PointerTo(models.Medium),
+			finding:       &models.Finding{Confidence: utils.PointerTo(models.Medium)},
+			want:          true,
+		},
+		{
+			name:          "finding above threshold",
+			minConfidence: utils.PointerTo(models.Medium),
+			finding:       &models.Finding{Confidence: utils.PointerTo(models.High)},
+			want:          true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := models.NotificationConfig{MinConfidence: tt.minConfidence}
+			if got := meetsMinConfidence(config, tt.finding); got != tt.want {
+				t.Errorf("meetsMinConfidence() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderFinding(t *testing.T) {
+	findingInfo := models.Finding_FindingInfo{}
+	if err := findingInfo.FromSecretFindingInfo(models.SecretFindingInfo{
+		Fingerprint: utils.PointerTo("fingerprint"),
+		StartColumn: utils.PointerTo(1),
+		EndColumn:   utils.PointerTo(2),
+	}); err != nil {
+		t.Fatalf("failed to create finding info: %v", err)
+	}
+	finding := models.Finding{
+		FindingInfo: &findingInfo,
+		Asset:       &models.AssetRelationship{Id: "asset"},
+	}
+
+	tests := []struct {
+		name      string
+		title     string
+		wantTitle string
+	}{
+		{
+			name:      "rendered with the templates",
+			title:     "Secret {{.Info.Fingerprint}}",
+			wantTitle: "Secret fingerprint",
+		},
+		{
+			name:      "built-in templates if the templates fail",
+			title:     "{{.Info.VulnerabilityName}}",
+			wantTitle: "Secret fingerprint.1.2 on asset asset",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			templates, err := findingtemplate.Parse(models.FindingTemplateSettings{Title: &tt.title})
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if got := renderFinding(context.Background(), templates, finding); got.Title != tt.wantTitle {
+				t.Errorf("renderFinding() title = %q, want %q", got.Title, tt.wantTitle)
+			}
+		})
+	}
+}
+
+func TestNotifier_deliver(t *testing.T) {
+	payload := []byte(`{"type":"ScanCompleted"}`)
+	secret := "s3cr3t"
+
+	tests := []struct {
+		name     string
+		statuses []int
+		want     models.NotificationDelivery
+	}{
+		{
+			name:     "delivered at first",
+			statuses: []int{http.StatusNoContent},
+			want: models.NotificationDelivery{
+				Event:    models.ScanCompleted,
+				State:    models.NotificationDeliveryStateDelivered,
+				Attempts: 1,
+			},
+		},
+		{
+			name:     "delivered after retries",
+			statuses: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK},
+			want: models.NotificationDelivery{
+				Event:    models.ScanCompleted,
+				State:    models.NotificationDeliveryStateDelivered,
+				Attempts: 3,
+			},
+		},
+		{
+			name:     "client errors are not retried",
+			statuses: []int{http.StatusNotFound},
+			want: models.NotificationDelivery{
+				Event:    models.ScanCompleted,
+				State:    models.NotificationDeliveryStateUndelivered,
+				Attempts: 1,
+				Message:  utils.PointerTo("webhook responded with 404 Not Found"),
+			},
+		},
+		{
+			name:     "undelivered after max attempts",
+			statuses: []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusOK},
+			want: models.NotificationDelivery{
+				Event:    models.ScanCompleted,
+				State:    models.NotificationDeliveryStateUndelivered,
+				Attempts: 3,
+				Message:  utils.PointerTo("webhook responded with 500 Internal Server Error"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if got := r.Header.Get(SignatureHeader); !hmac.Equal([]byte(got), []byte(Sign([]byte(secret), body))) {
+					t.Errorf("invalid signature %q", got)
+				}
+				if got := r.Header.Get(EventHeader); got != string(models.ScanCompleted) {
+					t.Errorf("unexpected event header %q", got)
+				}
+				if got := r.Header.Get("Content-Type"); got != "application/json" {
+					t.Errorf("unexpected content type %q", got)
+				}
+				w.WriteHeader(tt.statuses[calls])
+				calls++
+			}))
+			defer server.Close()
+
+			n := New(nil, Config{
+				MaxAttempts:    3,
+				InitialBackoff: time.Millisecond,
+			})
+			config := models.NotificationConfig{
+				Url:    utils.PointerTo(server.URL),
+				Secret: utils.PointerTo(secret),
+			}
+
+			got := n.deliver(context.Background(), config, models.ScanCompleted, payload, "application/json")
+			if diff := cmp.Diff(tt.want, got, cmpopts.IgnoreFields(models.NotificationDelivery{}, "Time")); diff != "" {
+				t.Errorf("deliver() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	// https://en.wikipedia.org/wiki/HMAC#Examples
+	got := Sign([]byte("key"), []byte("The quick brown fox jumps over the lazy dog"))
+	want := "sha256=f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8"
+	if got != want {
+		t.Errorf("Sign() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payload
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	"github.com/google/cel-go/cel"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const (
+	DefaultContentType = "application/json"
+
+	// eventVariable is the name the event is bound to in the filters.
+	eventVariable = "event"
+)
+
+// Payload is the parsed filter and payload template of a notification config.
+type Payload struct {
+	filter      cel.Program
+	template    *template.Template
+	contentType string
+}
+
+var env = mustNewEnv()
+
+// templateFuncs are the functions of the payload templates, the sprig functions
+// except the ones reading the environment of the backend, which holds its
+// secrets, or reaching the network from it.
+var templateFuncs = newTemplateFuncs()
+
+func newTemplateFuncs() template.FuncMap {
+	funcs := sprig.TxtFuncMap()
+	for _, name := range []string{"env", "expandenv", "getHostByName"} {
+		delete(funcs, name)
+	}
+	return funcs
+}
+
+func mustNewEnv() *cel.Env {
+	e, err := cel.NewEnv(cel.Variable(eventVariable, cel.DynType))
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// Parse parses the filter and the payload template of the notification config.
+// The filter is a CEL expression over the event, e.g.
+// `event.finding.findingInfo.severity == "CRITICAL"`, which must evaluate to a
+// bool. The payload template is a Go template executed on the event, with the
+// sprig functions but env, expandenv and getHostByName, e.g. `{"text": {{ .scan.id | toJson }}}`. Both refer to
+// the fields of the event by their JSON names.
+func Parse(config models.NotificationConfig) (*Payload, error) {
+	payload := &Payload{
+		contentType: DefaultContentType,
+	}
+
+	if filter := utils.ValueOrZero(config.Filter); filter != "" {
+		ast, issues := env.Compile(filter)
+		if issues != nil && issues.Err() != nil {
+			return nil, fmt.Errorf("invalid filter: %w", issues.Err())
+		}
+		if !ast.OutputType().IsAssignableType(cel.BoolType) {
+			return nil, fmt.Errorf("invalid filter: it evaluates to %v instead of bool", ast.OutputType())
+		}
+		program, err := env.Program(ast)
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter: %w", err)
+		}
+		payload.filter = program
+	}
+
+	if text := utils.ValueOrZero(config.PayloadTemplate); text != "" {
+		t, err := template.New("payloadTemplate").Funcs(templateFuncs).Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid payloadTemplate: %w", err)
+		}
+		payload.template = t
+	}
+
+	if contentType := utils.ValueOrZero(config.PayloadContentType); contentType != "" {
+		payload.contentType = contentType
+	}
+
+	return payload, nil
+}
+
+// Event is the event as the filters and the payload templates see it, the
+// JSON representation of it.
+type Event map[string]interface{}
+
+// NewEvent converts the event to the representation the filters and the
+// payload templates are evaluated on.
+func NewEvent(event models.NotificationEvent) (Event, error) {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event: %w", err)
+	}
+	var e Event
+	if err := json.Unmarshal(b, &e); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal event: %w", err)
+	}
+	return e, nil
+}
+
+// Matches returns whether the event passes the filter, every event does if
+// there is no filter.
+func (p *Payload) Matches(event Event) (bool, error) {
+	if p.filter == nil {
+		return true, nil
+	}
+
+	val, _, err := p.filter.Eval(map[string]interface{}{
+		eventVariable: map[string]interface{}(event),
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to evaluate filter: %w", err)
+	}
+	matches, ok := val.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("filter evaluated to %v instead of bool", val.Type())
+	}
+	return matches, nil
+}
+
+// Render returns the body posted for the event and its content type. It is
+// the JSON representation of the event if there is no payload template.
+func (p *Payload) Render(event Event) ([]byte, string, error) {
+	if p.template == nil {
+		b, err := json.Marshal(event)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to marshal event: %w", err)
+		}
+		return b, DefaultContentType, nil
+	}
+
+	var body bytes.Buffer
+	if err := p.template.Execute(&body, map[string]interface{}(event)); err != nil {
+		return nil, "", fmt.Errorf("failed to render payloadTemplate: %w", err)
+	}
+	return body.Bytes(), p.contentType, nil
+}
@@ -0,0 +1,155 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payload
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestPayload(t *testing.T) {
+	event, err := NewEvent(models.NotificationEvent{
+		Type: models.ScanFailed,
+		Time: time.Date(2023, 6, 10, 12, 0, 0, 0, time.UTC),
+		Scan: &models.Scan{
+			Id: utils.PointerTo("5b7d2c1e-8f3a-4e69-9d0b-7a41c6e2f3b8"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewEvent() error = %v", err)
+	}
+
+	tests := []struct {
+		name            string
+		config          models.NotificationConfig
+		wantParseErr    bool
+		wantMatches     bool
+		wantBody        string
+		wantContentType string
+	}{
+		{
+			name:            "no filter nor template",
+			config:          models.NotificationConfig{},
+			wantMatches:     true,
+			wantBody:        `{"scan":{"assetIDs":null,"id":"5b7d2c1e-8f3a-4e69-9d0b-7a41c6e2f3b8"},"time":"2023-06-10T12:00:00Z","type":"ScanFailed"}`,
+			wantContentType: DefaultContentType,
+		},
+		{
+			name: "matching filter and template",
+			config: models.NotificationConfig{
+				Filter:          utils.PointerTo(`event.type == "ScanFailed" && event.scan.id.startsWith("5b7d2c1e")`),
+				PayloadTemplate: utils.PointerTo(`{"text": {{ printf "%s failed" .scan.id | toJson }}}`),
+			},
+			wantMatches:     true,
+			wantBody:        `{"text": "5b7d2c1e-8f3a-4e69-9d0b-7a41c6e2f3b8 failed"}`,
+			wantContentType: DefaultContentType,
+		},
+		{
+			name: "template with a content type",
+			config: models.NotificationConfig{
+				PayloadTemplate:    utils.PointerTo(`{{ .type }}: {{ .scan.id }}`),
+				PayloadContentType: utils.PointerTo("text/plain"),
+			},
+			wantMatches:     true,
+			wantBody:        `ScanFailed: 5b7d2c1e-8f3a-4e69-9d0b-7a41c6e2f3b8`,
+			wantContentType: "text/plain",
+		},
+		{
+			name: "not matching filter",
+			config: models.NotificationConfig{
+				Filter: utils.PointerTo(`event.type == "ScanCompleted"`),
+			},
+			wantMatches:     false,
+			wantBody:        `{"scan":{"assetIDs":null,"id":"5b7d2c1e-8f3a-4e69-9d0b-7a41c6e2f3b8"},"time":"2023-06-10T12:00:00Z","type":"ScanFailed"}`,
+			wantContentType: DefaultContentType,
+		},
+		{
+			name: "invalid filter",
+			config: models.NotificationConfig{
+				Filter: utils.PointerTo(`event.type ==`),
+			},
+			wantParseErr: true,
+		},
+		{
+			name: "filter not evaluating to bool",
+			config: models.NotificationConfig{
+				Filter: utils.PointerTo(`"ScanFailed"`),
+			},
+			wantParseErr: true,
+		},
+		{
+			name: "template reading the environment",
+			config: models.NotificationConfig{
+				PayloadTemplate: utils.PointerTo(`{{ env "X" }}`),
+			},
+			wantParseErr: true,
+		},
+		{
+			name: "template expanding the environment",
+			config: models.NotificationConfig{
+				PayloadTemplate: utils.PointerTo(`{{ expandenv "$X" }}`),
+			},
+			wantParseErr: true,
+		},
+		{
+			name: "template resolving a host",
+			config: models.NotificationConfig{
+				PayloadTemplate: utils.PointerTo(`{{ getHostByName "example.com" }}`),
+			},
+			wantParseErr: true,
+		},
+		{
+			name: "invalid template",
+			config: models.NotificationConfig{
+				PayloadTemplate: utils.PointerTo(`{{ .type `),
+			},
+			wantParseErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := Parse(tt.config)
+			if (err != nil) != tt.wantParseErr {
+				t.Fatalf("Parse() error = %v, wantParseErr %v", err, tt.wantParseErr)
+			}
+			if err != nil {
+				return
+			}
+
+			matches, err := p.Matches(event)
+			if err != nil {
+				t.Fatalf("Matches() error = %v", err)
+			}
+			if matches != tt.wantMatches {
+				t.Errorf("Matches() = %v, want %v", matches, tt.wantMatches)
+			}
+
+			body, contentType, err := p.Render(event)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if string(body) != tt.wantBody {
+				t.Errorf("Render() body = %s, want %s", body, tt.wantBody)
+			}
+			if contentType != tt.wantContentType {
+				t.Errorf("Render() content type = %q, want %q", contentType, tt.wantContentType)
+			}
+		})
+	}
+}
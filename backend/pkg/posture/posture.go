@@ -0,0 +1,343 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package posture
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/backend/pkg/tasks"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const (
+	TaskName        = "posture-scores"
+	DefaultInterval = time.Hour
+	DefaultTeamTag  = "team"
+	DefaultSLADays  = 7
+
+	day = 24 * time.Hour
+
+	// The most each factor takes off the score of 100.
+	severityWeight = 50
+	exposureWeight = 30
+	slaWeight      = 20
+
+	// severityPointsPerAssetHalf is the severity points per asset which
+	// take half of severityWeight off the score.
+	severityPointsPerAssetHalf = 10
+)
+
+type severity int
+
+const (
+	severityNone severity = iota
+	severityLow
+	severityMedium
+	severityHigh
+	severityCritical
+)
+
+// severityPoints are the points an active finding of each severity adds.
+var severityPoints = map[severity]int{
+	severityLow:      1,
+	severityMedium:   2,
+	severityHigh:     5,
+	severityCritical: 10,
+}
+
+type Config struct {
+	// Interval between computing the posture scores.
+	Interval time.Duration
+	// TeamTag is the key of the tag holding the team of the assets.
+	TeamTag string
+	// SLADays is the number of days after its last scan an asset breaches
+	// the scan SLA.
+	SLADays int
+}
+
+// Scorer periodically computes the security posture score of each team and
+// stores it, so that the scores form a time series.
+type Scorer struct {
+	db     databaseTypes.Database
+	config Config
+}
+
+func New(db databaseTypes.Database, config Config) *Scorer {
+	if config.Interval <= 0 {
+		config.Interval = DefaultInterval
+	}
+	if config.TeamTag == "" {
+		config.TeamTag = DefaultTeamTag
+	}
+	if config.SLADays <= 0 {
+		config.SLADays = DefaultSLADays
+	}
+
+	return &Scorer{
+		db:     db,
+		config: config,
+	}
+}
+
+// Task returns the task computing the posture scores every interval, and
+// once when the backend starts.
+func (s *Scorer) Task() tasks.Task {
+	return tasks.Task{
+		Name:       TaskName,
+		Interval:   s.config.Interval,
+		Jitter:     tasks.DefaultJitter(s.config.Interval),
+		RunOnStart: true,
+		Run:        s.Run,
+	}
+}
+
+// Run computes the posture scores of the teams at now and stores them.
+func (s *Scorer) Run(ctx context.Context, now time.Time) error {
+	// The scores of a run share the time, which is looked up by equality
+	// so it must survive the round trip through the API.
+	scores, err := s.compute(now.UTC().Truncate(time.Second))
+	if err != nil {
+		return err
+	}
+
+	if err := s.db.PostureScoresTable().CreatePostureScores(scores); err != nil {
+		return fmt.Errorf("failed to store posture scores: %w", err)
+	}
+
+	log.GetLoggerFromContextOrDiscard(ctx).Infof("Computed the posture scores of %d teams", len(scores))
+
+	return nil
+}
+
+// teamStats are the counts the posture score of a team is computed from.
+type teamStats struct {
+	assets        int
+	exposedAssets int
+	slaBreaches   int
+	findings      map[severity]int
+}
+
+// nolint:cyclop
+func (s *Scorer) compute(now time.Time) ([]models.PostureScore, error) {
+	teamOfAsset, err := s.getTeamOfAssets()
+	if err != nil {
+		return nil, err
+	}
+
+	lastScanned, err := s.getLastScannedTimes()
+	if err != nil {
+		return nil, err
+	}
+
+	statsPerTeam := map[string]*teamStats{}
+	for assetID, team := range teamOfAsset {
+		stats, ok := statsPerTeam[team]
+		if !ok {
+			stats = &teamStats{findings: map[severity]int{}}
+			statsPerTeam[team] = stats
+		}
+		stats.assets++
+
+		scannedAt, ok := lastScanned[assetID]
+		if !ok || now.Sub(scannedAt) > time.Duration(s.config.SLADays)*day {
+			stats.slaBreaches++
+		}
+	}
+
+	exposed := map[string]bool{}
+	err = s.db.FindingsTable().StreamFindings(models.GetFindingsParams{
+		Filter: utils.PointerTo("invalidatedOn eq null and suppression eq null"),
+		Select: utils.PointerTo("asset/id,findingInfo"),
+	}, func(finding models.Finding) error {
+		if finding.Asset == nil {
+			return nil
+		}
+		team, ok := teamOfAsset[finding.Asset.Id]
+		if !ok {
+			return nil
+		}
+
+		sev := findingSeverity(finding)
+		if sev == severityNone {
+			return nil
+		}
+		stats := statsPerTeam[team]
+		stats.findings[sev]++
+		if sev >= severityHigh && !exposed[finding.Asset.Id] {
+			exposed[finding.Asset.Id] = true
+			stats.exposedAssets++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get findings: %w", err)
+	}
+
+	scores := make([]models.PostureScore, 0, len(statsPerTeam))
+	for team, stats := range statsPerTeam {
+		scores = append(scores, models.PostureScore{
+			Team:             utils.PointerTo(team),
+			Time:             utils.PointerTo(now),
+			Score:            utils.PointerTo(stats.score()),
+			Assets:           utils.PointerTo(stats.assets),
+			ExposedAssets:    utils.PointerTo(stats.exposedAssets),
+			SlaBreaches:      utils.PointerTo(stats.slaBreaches),
+			CriticalFindings: utils.PointerTo(stats.findings[severityCritical]),
+			HighFindings:     utils.PointerTo(stats.findings[severityHigh]),
+			MediumFindings:   utils.PointerTo(stats.findings[severityMedium]),
+			LowFindings:      utils.PointerTo(stats.findings[severityLow]),
+		})
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		return *scores[i].Team < *scores[j].Team
+	})
+
+	return scores, nil
+}
+
+// score returns the posture score of the team from 0 to 100. The severity
+// points per asset approach severityWeight off the score as they grow, while
+// the exposed assets and the SLA breaches take their share of the assets of
+// exposureWeight and slaWeight off it.
+func (t *teamStats) score() int {
+	if t.assets == 0 {
+		return 100
+	}
+	assets := float64(t.assets)
+
+	points := 0
+	for sev, count := range t.findings {
+		points += severityPoints[sev] * count
+	}
+	pointsPerAsset := float64(points) / assets
+
+	penalty := severityWeight*pointsPerAsset/(pointsPerAsset+severityPointsPerAssetHalf) +
+		exposureWeight*float64(t.exposedAssets)/assets +
+		slaWeight*float64(t.slaBreaches)/assets
+
+	return int(math.Round(math.Max(0, 100-penalty)))
+}
+
+// getTeamOfAssets returns the team of the VM assets which are still
+// discovered, by the ID of the asset. The team of the assets without the team
+// tag is empty.
+func (s *Scorer) getTeamOfAssets() (map[string]string, error) {
+	teamOfAsset := map[string]string{}
+	err := s.db.AssetsTable().StreamAssets(models.GetAssetsParams{
+		Filter: utils.PointerTo("assetInfo/objectType eq 'VMInfo' and terminatedOn eq null"),
+		Select: utils.PointerTo("id,assetInfo"),
+	}, func(asset models.Asset) error {
+		if asset.Id == nil || asset.AssetInfo == nil {
+			return nil
+		}
+		info, err := asset.AssetInfo.AsVMInfo()
+		if err != nil {
+			return fmt.Errorf("failed to get VM info of asset %s: %w", *asset.Id, err)
+		}
+
+		team := ""
+		if info.Tags != nil {
+			for _, tag := range *info.Tags {
+				if tag.Key == s.config.TeamTag {
+					team = tag.Value
+					break
+				}
+			}
+		}
+		teamOfAsset[*asset.Id] = team
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get assets: %w", err)
+	}
+
+	return teamOfAsset, nil
+}
+
+// getLastScannedTimes returns the time of the last done scan of each asset.
+func (s *Scorer) getLastScannedTimes() (map[string]time.Time, error) {
+	lastScanned := map[string]time.Time{}
+	err := s.db.ScanResultsTable().StreamScanResults(models.GetScanResultsParams{
+		Filter: utils.PointerTo(fmt.Sprintf("status/general/state eq '%s'", models.AssetScanStateStateDone)),
+		Select: utils.PointerTo("asset/id,status/general/lastTransitionTime"),
+	}, func(scanResult models.AssetScanResult) error {
+		if scanResult.Asset == nil || scanResult.Status == nil || scanResult.Status.General == nil ||
+			scanResult.Status.General.LastTransitionTime == nil {
+			return nil
+		}
+		scannedAt := *scanResult.Status.General.LastTransitionTime
+		if scannedAt.After(lastScanned[scanResult.Asset.Id]) {
+			lastScanned[scanResult.Asset.Id] = scannedAt
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scan results: %w", err)
+	}
+
+	return lastScanned, nil
+}
+
+// findingSeverity returns the severity of the finding the posture score is
+// lowered by. Malware and rootkits are critical, secrets and exploits high.
+// nolint:cyclop
+func findingSeverity(finding models.Finding) severity {
+	if finding.FindingInfo == nil {
+		return severityNone
+	}
+	info, err := finding.FindingInfo.ValueByDiscriminator()
+	if err != nil {
+		return severityNone
+	}
+
+	switch info := info.(type) {
+	case models.VulnerabilityFindingInfo:
+		switch utils.ValueOrZero(info.Severity) {
+		case models.CRITICAL:
+			return severityCritical
+		case models.HIGH:
+			return severityHigh
+		case models.MEDIUM:
+			return severityMedium
+		case models.LOW:
+			return severityLow
+		case models.NEGLIGIBLE:
+			return severityNone
+		}
+	case models.MisconfigurationFindingInfo:
+		switch utils.ValueOrZero(info.Severity) {
+		case models.MisconfigurationHighSeverity:
+			return severityHigh
+		case models.MisconfigurationMediumSeverity:
+			return severityMedium
+		case models.MisconfigurationLowSeverity:
+			return severityLow
+		}
+	case models.MalwareFindingInfo, models.RootkitFindingInfo:
+		return severityCritical
+	case models.SecretFindingInfo, models.ExploitFindingInfo:
+		return severityHigh
+	}
+
+	return severityNone
+}
@@ -0,0 +1,187 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package posture
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/database/gorm"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// nolint:cyclop
+func TestScorer_Run(t *testing.T) {
+	db, err := gorm.NewDatabase(databaseTypes.DBConfig{
+		DriverType:  databaseTypes.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("NewDatabase() error = %v", err)
+	}
+
+	now := time.Date(2023, 6, 30, 12, 0, 0, 0, time.UTC)
+	daysAgo := func(days int) *time.Time {
+		return utils.PointerTo(now.Add(-time.Duration(days) * day))
+	}
+
+	createAsset := func(instanceID string, tags []models.Tag, terminatedOn *time.Time) string {
+		info := models.AssetType{}
+		if err := info.FromVMInfo(models.VMInfo{InstanceID: instanceID, Tags: &tags}); err != nil {
+			t.Fatalf("FromVMInfo() error = %v", err)
+		}
+		asset, err := db.AssetsTable().CreateAsset(models.Asset{AssetInfo: &info, TerminatedOn: terminatedOn})
+		if err != nil {
+			t.Fatalf("CreateAsset() error = %v", err)
+		}
+		return *asset.Id
+	}
+	createScanResult := func(assetID string, scannedAt *time.Time) {
+		_, err := db.ScanResultsTable().CreateScanResult(models.AssetScanResult{
+			Scan:  &models.ScanRelationship{Id: "scan-1"},
+			Asset: &models.AssetRelationship{Id: assetID},
+			Status: &models.AssetScanStatus{
+				General: &models.AssetScanState{
+					State:              utils.PointerTo(models.AssetScanStateStateDone),
+					LastTransitionTime: scannedAt,
+				},
+			},
+		})
+		if err != nil {
+			t.Fatalf("CreateScanResult() error = %v", err)
+		}
+	}
+	createVulnerability := func(assetID, name string, severity models.VulnerabilitySeverity, invalidatedOn *time.Time) {
+		info := &models.Finding_FindingInfo{}
+		if err := info.FromVulnerabilityFindingInfo(models.VulnerabilityFindingInfo{
+			VulnerabilityName: utils.PointerTo(name),
+			Severity:          utils.PointerTo(severity),
+		}); err != nil {
+			t.Fatalf("FromVulnerabilityFindingInfo() error = %v", err)
+		}
+		_, err := db.FindingsTable().CreateFinding(models.Finding{
+			Asset:         &models.AssetRelationship{Id: assetID},
+			FindingInfo:   info,
+			FoundOn:       daysAgo(3),
+			InvalidatedOn: invalidatedOn,
+		})
+		if err != nil {
+			t.Fatalf("CreateFinding() error = %v", err)
+		}
+	}
+
+	webTag := []models.Tag{{Key: DefaultTeamTag, Value: "web"}}
+	scanned := createAsset("i-web-1", webTag, nil)
+	createScanResult(scanned, daysAgo(1))
+	createVulnerability(scanned, "CVE-2023-0001", models.CRITICAL, nil)
+	createVulnerability(scanned, "CVE-2023-0002", models.HIGH, daysAgo(2))
+
+	// Never scanned
+	createAsset("i-web-2", webTag, nil)
+
+	// Not counted once terminated
+	terminated := createAsset("i-web-3", webTag, daysAgo(1))
+	createVulnerability(terminated, "CVE-2023-0003", models.CRITICAL, nil)
+
+	// Without the team tag, last scanned out of the SLA
+	untagged := createAsset("i-1", nil, nil)
+	createScanResult(untagged, daysAgo(10))
+	createVulnerability(untagged, "CVE-2023-0004", models.LOW, nil)
+
+	if err := New(db, Config{}).Run(context.Background(), now); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	got, err := db.PostureScoresTable().GetPostureScores(models.GetPostureScoresParams{
+		OrderBy: utils.PointerTo("team"),
+	})
+	if err != nil {
+		t.Fatalf("GetPostureScores() error = %v", err)
+	}
+
+	want := []models.PostureScore{
+		{
+			Team:             utils.PointerTo(""),
+			Time:             utils.PointerTo(now),
+			Score:            utils.PointerTo(75),
+			Assets:           utils.PointerTo(1),
+			ExposedAssets:    utils.PointerTo(0),
+			SlaBreaches:      utils.PointerTo(1),
+			CriticalFindings: utils.PointerTo(0),
+			HighFindings:     utils.PointerTo(0),
+			MediumFindings:   utils.PointerTo(0),
+			LowFindings:      utils.PointerTo(1),
+		},
+		{
+			Team:             utils.PointerTo("web"),
+			Time:             utils.PointerTo(now),
+			Score:            utils.PointerTo(58),
+			Assets:           utils.PointerTo(2),
+			ExposedAssets:    utils.PointerTo(1),
+			SlaBreaches:      utils.PointerTo(1),
+			CriticalFindings: utils.PointerTo(1),
+			HighFindings:     utils.PointerTo(0),
+			MediumFindings:   utils.PointerTo(0),
+			LowFindings:      utils.PointerTo(0),
+		},
+	}
+	if diff := cmp.Diff(want, *got.Items, cmpopts.IgnoreFields(models.PostureScore{}, "Id")); diff != "" {
+		t.Errorf("GetPostureScores() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestTeamStats_Score(t *testing.T) {
+	tests := []struct {
+		name  string
+		stats teamStats
+		want  int
+	}{
+		{
+			name:  "no assets",
+			stats: teamStats{},
+			want:  100,
+		},
+		{
+			name:  "clean",
+			stats: teamStats{assets: 4, findings: map[severity]int{}},
+			want:  100,
+		},
+		{
+			name: "all exposed and out of the SLA",
+			stats: teamStats{
+				assets:        1,
+				exposedAssets: 1,
+				slaBreaches:   1,
+				findings:      map[severity]int{severityCritical: 1000},
+			},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.stats.score(); got != tt.want {
+				t.Errorf("score() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/auth"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func (s *ServerImpl) GetAPIKeys(ctx echo.Context, params models.GetAPIKeysParams) error {
+	apiKeys, err := s.dbHandler.APIKeysTable().GetAPIKeys(params)
+	if err != nil {
+		return sendDBReadError(ctx, err, "failed to get API keys from db")
+	}
+	return sendResponse(ctx, http.StatusOK, apiKeys)
+}
+
+func (s *ServerImpl) GetAPIKeysAPIKeyID(ctx echo.Context, apiKeyID models.ApiKeyID, params models.GetAPIKeysAPIKeyIDParams) error {
+	apiKey, err := s.dbHandler.APIKeysTable().GetAPIKey(apiKeyID, params)
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("APIKey with ID %v not found", apiKeyID))
+		}
+		return sendDBReadError(ctx, err, fmt.Sprintf("failed to get API key from db. apiKeyID=%v", apiKeyID))
+	}
+	return sendResponse(ctx, http.StatusOK, apiKey)
+}
+
+func (s *ServerImpl) PostAPIKeys(ctx echo.Context) error {
+	var apiKey models.APIKey
+	err := ctx.Bind(&apiKey)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+
+	key, prefix, keyHash, err := auth.GenerateAPIKey()
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, err.Error())
+	}
+	apiKey.Prefix = &prefix
+
+	createdAPIKey, err := s.dbHandler.APIKeysTable().CreateAPIKey(apiKey, keyHash)
+	if err != nil {
+		var validationErr *common.BadRequestError
+		if errors.As(err, &validationErr) {
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to create API key in db: %v", err))
+	}
+
+	// The key is only ever returned here
+	createdAPIKey.Key = &key
+
+	return sendResponse(ctx, http.StatusCreated, createdAPIKey)
+}
+
+func (s *ServerImpl) DeleteAPIKeysAPIKeyID(ctx echo.Context, apiKeyID models.ApiKeyID) error {
+	success := models.Success{
+		Message: utils.PointerTo(fmt.Sprintf("API key %v deleted", apiKeyID)),
+	}
+
+	if err := s.dbHandler.APIKeysTable().DeleteAPIKey(apiKeyID); err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("APIKey with ID %v not found", apiKeyID))
+		}
+		return sendError(ctx, http.StatusInternalServerError, err.Error())
+	}
+
+	return sendResponse(ctx, http.StatusOK, &success)
+}
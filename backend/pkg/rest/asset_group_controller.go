@@ -0,0 +1,175 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func (s *ServerImpl) GetAssetGroups(ctx echo.Context, params models.GetAssetGroupsParams) error {
+	groups, err := s.dbHandler.AssetGroupsTable().GetAssetGroups(params)
+	if err != nil {
+		return sendDBReadError(ctx, err, "failed to get asset groups from db")
+	}
+	return sendResponse(ctx, http.StatusOK, groups)
+}
+
+func (s *ServerImpl) GetAssetGroupsAssetGroupID(ctx echo.Context, assetGroupID models.AssetGroupID, params models.GetAssetGroupsAssetGroupIDParams) error {
+	group, err := s.dbHandler.AssetGroupsTable().GetAssetGroup(assetGroupID, params)
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("AssetGroup with ID %v not found", assetGroupID))
+		}
+		return sendDBReadError(ctx, err, fmt.Sprintf("failed to get asset group from db. assetGroupID=%v", assetGroupID))
+	}
+	return sendResponse(ctx, http.StatusOK, group)
+}
+
+// GetAssetGroupsAssetGroupIDAssets evaluates the membership of the group at
+// the time of the request, so that the assets discovered or relabeled since
+// the group was created are included.
+func (s *ServerImpl) GetAssetGroupsAssetGroupIDAssets(ctx echo.Context, assetGroupID models.AssetGroupID, params models.GetAssetGroupsAssetGroupIDAssetsParams) error {
+	group, err := s.dbHandler.AssetGroupsTable().GetAssetGroup(assetGroupID, models.GetAssetGroupsAssetGroupIDParams{})
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("AssetGroup with ID %v not found", assetGroupID))
+		}
+		return sendDBReadError(ctx, err, fmt.Sprintf("failed to get asset group from db. assetGroupID=%v", assetGroupID))
+	}
+
+	assets, err := s.dbHandler.AssetsTable().GetAssets(models.GetAssetsParams{
+		Filter:  utils.PointerTo(andFilters(group.MembersFilter(""), utils.ValueOrZero(params.Filter))),
+		Select:  params.Select,
+		Count:   params.Count,
+		Top:     params.Top,
+		Skip:    params.Skip,
+		OrderBy: params.OrderBy,
+	})
+	if err != nil {
+		return sendDBReadError(ctx, err, fmt.Sprintf("failed to get assets of asset group from db. assetGroupID=%v", assetGroupID))
+	}
+	return sendResponse(ctx, http.StatusOK, assets)
+}
+
+func (s *ServerImpl) PostAssetGroups(ctx echo.Context) error {
+	var group models.AssetGroup
+	err := ctx.Bind(&group)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+
+	createdGroup, err := s.dbHandler.AssetGroupsTable().CreateAssetGroup(group)
+	if err != nil {
+		var conflictErr *common.ConflictError
+		var validationErr *common.BadRequestError
+		switch true {
+		case errors.As(err, &conflictErr):
+			existResponse := &models.AssetGroupExists{
+				Message:    utils.PointerTo(conflictErr.Reason),
+				AssetGroup: &createdGroup,
+			}
+			return sendResponse(ctx, http.StatusConflict, existResponse)
+		case errors.As(err, &validationErr):
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		default:
+			return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to create asset group in db: %v", err))
+		}
+	}
+
+	return sendResponse(ctx, http.StatusCreated, createdGroup)
+}
+
+func (s *ServerImpl) PatchAssetGroupsAssetGroupID(ctx echo.Context, assetGroupID models.AssetGroupID, params models.PatchAssetGroupsAssetGroupIDParams) error {
+	var group models.AssetGroup
+	err := ctx.Bind(&group)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+
+	// PATCH request might not contain the ID in the body, so set it from
+	// the URL field so that the DB layer knows which object is being updated.
+	if group.Id != nil && *group.Id != assetGroupID {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("id in body %s does not match object %s to be updated", *group.Id, assetGroupID))
+	}
+	group.Id = &assetGroupID
+
+	updatedGroup, err := s.dbHandler.AssetGroupsTable().UpdateAssetGroup(group, params)
+	if err != nil {
+		var validationErr *common.BadRequestError
+		var conflictErr *common.ConflictError
+		var preconditionFailedErr *databaseTypes.PreconditionFailedError
+		switch true {
+		case errors.Is(err, databaseTypes.ErrNotFound):
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("AssetGroup with ID %v not found", assetGroupID))
+		case errors.As(err, &conflictErr):
+			existResponse := &models.AssetGroupExists{
+				Message:    utils.PointerTo(conflictErr.Reason),
+				AssetGroup: &updatedGroup,
+			}
+			return sendResponse(ctx, http.StatusConflict, existResponse)
+		case errors.As(err, &validationErr):
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		case errors.As(err, &preconditionFailedErr):
+			return sendError(ctx, http.StatusPreconditionFailed, err.Error())
+		default:
+			return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to update asset group in db. assetGroupID=%v: %v", assetGroupID, err))
+		}
+	}
+
+	return sendResponse(ctx, http.StatusOK, updatedGroup)
+}
+
+func (s *ServerImpl) DeleteAssetGroupsAssetGroupID(ctx echo.Context, assetGroupID models.AssetGroupID) error {
+	success := models.Success{
+		Message: utils.PointerTo(fmt.Sprintf("asset group %v deleted", assetGroupID)),
+	}
+
+	if err := s.dbHandler.AssetGroupsTable().DeleteAssetGroup(assetGroupID); err != nil {
+		var conflictErr *common.ConflictError
+		switch true {
+		case errors.Is(err, databaseTypes.ErrNotFound):
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("AssetGroup with ID %v not found", assetGroupID))
+		case errors.As(err, &conflictErr):
+			return sendError(ctx, http.StatusConflict, err.Error())
+		default:
+			return sendError(ctx, http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	return sendResponse(ctx, http.StatusOK, &success)
+}
+
+// andFilters returns the OData filter matching both filters, either of which
+// can be empty.
+func andFilters(filter, other string) string {
+	switch {
+	case filter == "":
+		return other
+	case other == "":
+		return filter
+	default:
+		return fmt.Sprintf("(%s) and (%s)", filter, other)
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/CiscoM31/godata"
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/auth"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// assetScopeMiddleware restricts the requests of the API keys scoped to asset
+// groups to reading the members of the groups, their scan results and
+// findings. The collections are filtered to the members, the objects of the
+// other assets are reported as not found, and any other request is rejected.
+// It must run after the authentication and before the request validation.
+// nolint:cyclop
+func assetScopeMiddleware(dbHandler databaseTypes.Database) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			groupIDs := auth.AssetGroupIDs(ctx)
+			if len(groupIDs) == 0 {
+				return next(ctx)
+			}
+
+			request := ctx.Request()
+			route := strings.TrimPrefix(ctx.Path(), BaseURL)
+			if matchesRoute(request.URL.Path, readOnlyRoutes) {
+				return next(ctx)
+			}
+			if request.Method != http.MethodGet && request.Method != http.MethodHead {
+				return sendError(ctx, http.StatusForbidden, "API key is restricted to asset groups and can only read their assets")
+			}
+
+			groups := make([]models.AssetGroup, 0, len(groupIDs))
+			for _, id := range groupIDs {
+				group, err := dbHandler.AssetGroupsTable().GetAssetGroup(id, models.GetAssetGroupsAssetGroupIDParams{})
+				if err != nil {
+					if errors.Is(err, databaseTypes.ErrNotFound) {
+						continue
+					}
+					return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get asset group from db: %v", err))
+				}
+				groups = append(groups, group)
+			}
+
+			var found bool
+			var err error
+			switch route {
+			case "/assets":
+				err = restrictFilter(request, models.AssetGroupsMembersFilter(groups, ""))
+				found = true
+			case "/scanResults", "/findings":
+				err = restrictFilter(request, models.AssetGroupsMembersFilter(groups, "asset/"))
+				found = true
+			case "/assetGroups":
+				ids := make([]string, 0, len(groupIDs))
+				for _, id := range groupIDs {
+					ids = append(ids, "id eq "+models.ODataString(id))
+				}
+				err = restrictFilter(request, strings.Join(ids, " or "))
+				found = true
+			case "/assets/:assetID", "/assets/:assetID/upgradePlan", "/assets/:assetID/packages", "/assets/:assetID/scanResultDiff",
+				"/assets/:assetID/sbomDiff":
+				found, err = assetInScope(dbHandler, groups, ctx.Param("assetID"))
+			case "/scanResults/:scanResultID", "/scanResults/:scanResultID/families/:family/items", "/scanResults/:scanResultID/scannerConfig":
+				found, err = scanResultInScope(dbHandler, groups, ctx.Param("scanResultID"))
+			case "/findings/:findingID", "/findings/:findingID/scannerSuppression":
+				found, err = findingInScope(dbHandler, groups, ctx.Param("findingID"))
+			case "/assetGroups/:assetGroupID", "/assetGroups/:assetGroupID/assets":
+				found = utils.Contains(groupIDs, ctx.Param("assetGroupID"))
+			default:
+				return sendError(ctx, http.StatusForbidden, "API key is restricted to asset groups and can only read their assets")
+			}
+			var filterErr *invalidFilterError
+			switch {
+			case errors.As(err, &filterErr):
+				return sendError(ctx, http.StatusBadRequest, err.Error())
+			case err != nil:
+				return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to check the asset groups of the object: %v", err))
+			}
+			if !found {
+				return sendError(ctx, http.StatusNotFound, "Object not found")
+			}
+
+			return next(ctx)
+		}
+	}
+}
+
+type invalidFilterError struct {
+	err error
+}
+
+func (e *invalidFilterError) Error() string {
+	return fmt.Sprintf("invalid $filter: %v", e.err)
+}
+
+// restrictFilter replaces the $filter of the request with the one matching
+// both the filter and the requested one. The requested filter must be valid
+// on its own, otherwise it could escape its parentheses in the combined one.
+func restrictFilter(request *http.Request, filter string) error {
+	query := request.URL.Query()
+	requested := query.Get("$filter")
+	if requested != "" {
+		if _, err := godata.ParseFilterString(context.TODO(), requested); err != nil {
+			return &invalidFilterError{err: err}
+		}
+	}
+	query.Set("$filter", andFilters(filter, requested))
+	request.URL.RawQuery = query.Encode()
+	return nil
+}
+
+func assetInScope(dbHandler databaseTypes.Database, groups []models.AssetGroup, assetID string) (bool, error) {
+	if strings.Contains(assetID, "'") {
+		return false, nil
+	}
+	filter := andFilters(fmt.Sprintf("id eq '%s'", assetID), models.AssetGroupsMembersFilter(groups, ""))
+	assets, err := dbHandler.AssetsTable().GetAssets(models.GetAssetsParams{
+		Filter: &filter,
+		Select: utils.PointerTo("id"),
+		Top:    utils.PointerTo(1),
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(utils.ValueOrZero(assets.Items)) > 0, nil
+}
+
+func scanResultInScope(dbHandler databaseTypes.Database, groups []models.AssetGroup, scanResultID string) (bool, error) {
+	if strings.Contains(scanResultID, "'") {
+		return false, nil
+	}
+	filter := andFilters(fmt.Sprintf("id eq '%s'", scanResultID), models.AssetGroupsMembersFilter(groups, "asset/"))
+	scanResults, err := dbHandler.ScanResultsTable().GetScanResults(models.GetScanResultsParams{
+		Filter: &filter,
+		Select: utils.PointerTo("id"),
+		Top:    utils.PointerTo(1),
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(utils.ValueOrZero(scanResults.Items)) > 0, nil
+}
+
+func findingInScope(dbHandler databaseTypes.Database, groups []models.AssetGroup, findingID string) (bool, error) {
+	if strings.Contains(findingID, "'") {
+		return false, nil
+	}
+	filter := andFilters(fmt.Sprintf("id eq '%s'", findingID), models.AssetGroupsMembersFilter(groups, "asset/"))
+	findings, err := dbHandler.FindingsTable().GetFindings(models.GetFindingsParams{
+		Filter: &filter,
+		Select: utils.PointerTo("id"),
+		Top:    utils.PointerTo(1),
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(utils.ValueOrZero(findings.Items)) > 0, nil
+}
@@ -0,0 +1,119 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/auth"
+	"github.com/openclarity/vmclarity/backend/pkg/database/gorm"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func newScopeTestAsset(t *testing.T, db databaseTypes.Database, instanceID, env string) models.Asset {
+	t.Helper()
+
+	var assetInfo models.AssetType
+	err := assetInfo.FromVMInfo(models.VMInfo{
+		ObjectType: "VMInfo",
+		InstanceID: instanceID,
+		Location:   "eu-central-1",
+		Tags:       &[]models.Tag{{Key: "env", Value: env}},
+	})
+	assert.NilError(t, err)
+
+	asset, err := db.AssetsTable().CreateAsset(models.Asset{AssetInfo: &assetInfo})
+	assert.NilError(t, err)
+	return asset
+}
+
+func Test_assetScopeMiddleware(t *testing.T) {
+	db, err := gorm.NewDatabase(databaseTypes.DBConfig{
+		DriverType:  databaseTypes.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.sqlite"),
+	})
+	assert.NilError(t, err)
+
+	prod := newScopeTestAsset(t, db, "i-prod", "prod")
+	dev := newScopeTestAsset(t, db, "i-dev", "dev")
+
+	group, err := db.AssetGroupsTable().CreateAssetGroup(models.AssetGroup{
+		Name:        utils.PointerTo("production"),
+		TagSelector: &[]models.Tag{{Key: "env", Value: "prod"}},
+	})
+	assert.NilError(t, err)
+
+	key, prefix, keyHash, err := auth.GenerateAPIKey()
+	assert.NilError(t, err)
+	_, err = db.APIKeysTable().CreateAPIKey(models.APIKey{
+		Name:          "production dashboards",
+		Role:          models.ReadOnly,
+		Prefix:        &prefix,
+		AssetGroupIDs: &[]string{*group.Id},
+	}, keyHash)
+	assert.NilError(t, err)
+
+	authenticator, err := auth.New(context.Background(), auth.Config{APIKeys: db.APIKeysTable()})
+	assert.NilError(t, err)
+	e, err := createEchoServer(db, UsageLimits{}, nil, nil, nil, nil, nil, 0, nil, authenticator, "", t.TempDir(), nil)
+	assert.NilError(t, err)
+
+	get := func(path string, query url.Values) *httptest.ResponseRecorder {
+		target := path
+		if query != nil {
+			target += "?" + query.Encode()
+		}
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		req.Header.Set("Authorization", "Bearer "+key)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := get("/api/assets", nil)
+	assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+	var assets models.Assets
+	assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &assets))
+	assert.Equal(t, len(*assets.Items), 1)
+	assert.Equal(t, *(*assets.Items)[0].Id, *prod.Id)
+
+	// The requested filter can not escape the filter of the asset groups.
+	rec = get("/api/assets", url.Values{"$filter": []string{"id eq 'x') or (id ne 'x'"}})
+	assert.Equal(t, rec.Code, http.StatusBadRequest, rec.Body.String())
+
+	rec = get("/api/assets/"+*prod.Id, nil)
+	assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+	rec = get("/api/assets/"+*dev.Id, nil)
+	assert.Equal(t, rec.Code, http.StatusNotFound, rec.Body.String())
+
+	rec = get("/api/assetGroups/"+*group.Id+"/assets", nil)
+	assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+	assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &assets))
+	assert.Equal(t, len(*assets.Items), 1)
+
+	rec = get("/api/scans", nil)
+	assert.Equal(t, rec.Code, http.StatusForbidden, rec.Body.String())
+}
@@ -0,0 +1,90 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/auth"
+)
+
+// adminRoutes are the routes which only the admins can use, whatever the
+// method of the request.
+var adminRoutes = []string{
+	BaseURL + "/apiKeys",
+	BaseURL + "/admin",
+}
+
+// readOnlyRoutes are the routes which change nothing but the data of the
+// caller, or nothing at all, whatever the method of the request.
+var readOnlyRoutes = []string{
+	BaseURL + "/userPreferences",
+	BaseURL + "/settings/findingTemplates/preview",
+}
+
+// requiredRole returns the role required for a request. Reading requires the
+// read-only role, deleting and changing the settings require the admin role,
+// and any other change, e.g. starting a scan, requires the operator role. The
+// maintenance mode can be read by everyone, so that the clients can tell why
+// their changes are rejected.
+func requiredRole(method, path string) models.APIKeyRole {
+	switch {
+	case method == http.MethodGet && path == BaseURL+"/admin/maintenance":
+		return models.ReadOnly
+	case matchesRoute(path, adminRoutes):
+		return models.Admin
+	case matchesRoute(path, readOnlyRoutes):
+		return models.ReadOnly
+	case method == http.MethodGet || method == http.MethodHead:
+		return models.ReadOnly
+	case method == http.MethodDelete:
+		return models.Admin
+	case matchesRoute(path, []string{BaseURL + "/settings"}):
+		return models.Admin
+	default:
+		return models.Operator
+	}
+}
+
+func matchesRoute(path string, routes []string) bool {
+	for _, route := range routes {
+		if path == route || strings.HasPrefix(path, route+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// authorizationMiddleware rejects the requests which the role of the caller
+// doesn't allow. It must run after the authentication.
+func authorizationMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			request := ctx.Request()
+			role := auth.Role(ctx)
+			required := requiredRole(request.Method, request.URL.Path)
+			if !auth.Allows(role, required) {
+				return sendError(ctx, http.StatusForbidden, fmt.Sprintf("%s role is required, got %q", required, role))
+			}
+			return next(ctx)
+		}
+	}
+}
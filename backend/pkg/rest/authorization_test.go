@@ -0,0 +1,53 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"net/http"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/openclarity/vmclarity/api/models"
+)
+
+func Test_requiredRole(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		want   models.APIKeyRole
+	}{
+		{method: http.MethodGet, path: "/api/findings", want: models.ReadOnly},
+		{method: http.MethodPost, path: "/api/scans", want: models.Operator},
+		{method: http.MethodPatch, path: "/api/scans/1", want: models.Operator},
+		{method: http.MethodDelete, path: "/api/findings/1", want: models.Admin},
+		{method: http.MethodGet, path: "/api/apiKeys", want: models.Admin},
+		{method: http.MethodGet, path: "/api/admin/usage", want: models.Admin},
+		{method: http.MethodGet, path: "/api/admin/maintenance", want: models.ReadOnly},
+		{method: http.MethodPut, path: "/api/admin/maintenance", want: models.Admin},
+		{method: http.MethodPost, path: "/api/admin/feeds/refresh", want: models.Admin},
+		{method: http.MethodGet, path: "/api/feeds/grype-db", want: models.ReadOnly},
+		{method: http.MethodGet, path: "/api/settings/retention", want: models.ReadOnly},
+		{method: http.MethodPut, path: "/api/settings/retention", want: models.Admin},
+		{method: http.MethodPost, path: "/api/settings/findingTemplates/preview", want: models.ReadOnly},
+		{method: http.MethodPut, path: "/api/userPreferences", want: models.ReadOnly},
+		{method: http.MethodPost, path: "/api/apiKeysOther", want: models.Operator},
+		{method: http.MethodPost, path: "/ui/api/dashboard", want: models.Operator},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, requiredRole(tt.method, tt.path), tt.want, "%s %s", tt.method, tt.path)
+	}
+}
@@ -16,10 +16,16 @@
 package rest
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
+
 	"github.com/labstack/echo/v4"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/database/odatasql"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
 // nolint:wrapcheck
@@ -29,6 +35,29 @@ func sendError(ctx echo.Context, code int, message string) error {
 	return ctx.JSON(code, response)
 }
 
+// sendDBReadError responds with the details of the query error if the read
+// failed because of invalid OData query options, and with an internal server
+// error otherwise.
+func sendDBReadError(ctx echo.Context, err error, message string) error {
+	var queryErr *odatasql.QueryError
+	if errors.As(err, &queryErr) {
+		log.Debugf("%s: %v", message, err)
+		response := &models.QueryError{
+			Message:  utils.PointerTo(queryErr.Error()),
+			Option:   utils.PointerTo(queryErr.Option),
+			Position: utils.PointerTo(queryErr.Position),
+		}
+		if queryErr.Segment != "" {
+			response.Segment = utils.PointerTo(queryErr.Segment)
+		}
+		if len(queryErr.Suggestions) > 0 {
+			response.Suggestions = utils.PointerTo(queryErr.Suggestions)
+		}
+		return sendResponse(ctx, http.StatusBadRequest, response)
+	}
+	return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("%s: %v", message, err))
+}
+
 // nolint:wrapcheck,unparam
 func sendResponse(ctx echo.Context, code int, object interface{}) error {
 	return ctx.JSON(code, object)
@@ -0,0 +1,350 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/backend/pkg/assetrename"
+)
+
+const (
+	legacyTargetsPath = BaseURL + "/targets"
+	assetsPath        = BaseURL + "/assets"
+)
+
+// legacyRouteFields are the renamed fields of the objects of the routes
+// served before the rename, including the fields of the expanded objects
+// they relate to. The fields of the other objects, e.g. the annotations set
+// by the users, are never renamed.
+var legacyRouteFields = map[string][]assetrename.Field{
+	assetsPath: assetrename.AssetFields,
+	BaseURL + "/scanResults": concatFields(
+		assetrename.ScanResultFields,
+		assetrename.Nested("asset", assetrename.AssetFields),
+		assetrename.Nested("scan", assetrename.ScanFields),
+	),
+	BaseURL + "/scans": assetrename.ScanFields,
+	BaseURL + "/providerOperations": concatFields(
+		assetrename.ProviderOperationFields,
+		assetrename.Nested("asset", assetrename.AssetFields),
+	),
+	BaseURL + "/findings": assetrename.Nested("asset", assetrename.AssetFields),
+}
+
+func concatFields(lists ...[]assetrename.Field) []assetrename.Field {
+	var ret []assetrename.Field
+	for _, fields := range lists {
+		ret = append(ret, fields...)
+	}
+	return ret
+}
+
+// legacyObjectPaths are the paths of the objects of a route in its request
+// and response bodies, the object itself or the items of a list of them.
+var legacyObjectPaths = []string{"", "items[]"}
+
+// legacyQueryParams are the OData query parameters which refer to fields.
+var legacyQueryParams = []string{"$filter", "$select", "$expand", "$orderby"}
+
+// targetsCompatMiddleware keeps the clients of the API from before the rename
+// of the Target objects to Asset working. The /targets routes are routed to
+// the /assets ones, and the legacy names of the renamed fields of the objects
+// of the route in the request body and the OData query parameters are
+// renamed. The response of a request to the deprecated /targets routes is
+// renamed back, so that the client gets the objects as it knows them, the
+// other routes always respond with the current names. It must run before the
+// routing.
+func targetsCompatMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			request := ctx.Request()
+			if !strings.HasPrefix(request.URL.Path, BaseURL+"/") {
+				return next(ctx)
+			}
+
+			legacy := false
+			if request.URL.Path == legacyTargetsPath || strings.HasPrefix(request.URL.Path, legacyTargetsPath+"/") {
+				request.URL.Path = assetsPath + strings.TrimPrefix(request.URL.Path, legacyTargetsPath)
+				request.URL.RawPath = ""
+				request.RequestURI = request.URL.RequestURI()
+				legacy = true
+			}
+
+			fields := fieldsForRoute(request.URL.Path)
+			if len(fields) == 0 {
+				return next(ctx)
+			}
+			renameQueryFields(request, fields)
+			if err := renameBodyFields(request, fields); err != nil {
+				return sendError(ctx, http.StatusBadRequest, err.Error())
+			}
+			if !legacy {
+				return next(ctx)
+			}
+
+			// The response is renamed before it is compressed.
+			request.Header.Del(echo.HeaderAcceptEncoding)
+
+			response := ctx.Response()
+			writer := &legacyResponseWriter{
+				ResponseWriter: response.Writer,
+				fields:         assetrename.Invert(fields),
+			}
+			response.Writer = writer
+
+			err := next(ctx)
+			response.Writer = writer.ResponseWriter
+			if closeErr := writer.Close(); closeErr != nil {
+				log.Errorf("Failed to write legacy response: %v", closeErr)
+			}
+
+			return err
+		}
+	}
+}
+
+// fieldsForRoute returns the renamed fields of the objects of the collection
+// and item routes of path, the other routes serve other objects.
+func fieldsForRoute(path string) []assetrename.Field {
+	for route, fields := range legacyRouteFields {
+		if path == route {
+			return fields
+		}
+		if id, ok := strings.CutPrefix(path, route+"/"); ok && id != "" && !strings.Contains(id, "/") {
+			return fields
+		}
+	}
+	return nil
+}
+
+func renameQueryFields(request *http.Request, fields []assetrename.Field) {
+	query := request.URL.Query()
+	renamed := false
+	for _, param := range legacyQueryParams {
+		values, ok := query[param]
+		if !ok {
+			continue
+		}
+		for i, value := range values {
+			if v, ok := renameODataFields(value, fields); ok {
+				values[i] = v
+				renamed = true
+			}
+		}
+	}
+	if renamed {
+		request.URL.RawQuery = query.Encode()
+		request.RequestURI = request.URL.RequestURI()
+	}
+}
+
+// renameODataFields renames the renamed fields in the property paths of an
+// OData expression, leaving the string literals as they are.
+func renameODataFields(expr string, fields []assetrename.Field) (string, bool) {
+	var b strings.Builder
+	renamed := false
+	inLiteral := false
+	// path holds the elements of the property path being read.
+	var path []string
+	for i := 0; i < len(expr); {
+		c := expr[i]
+		switch {
+		case c == '\'':
+			inLiteral = !inLiteral
+			path = nil
+			b.WriteByte(c)
+			i++
+		case !inLiteral && isIdentifierStart(c):
+			j := i + 1
+			for j < len(expr) && (isIdentifierStart(expr[j]) || (expr[j] >= '0' && expr[j] <= '9')) {
+				j++
+			}
+			word := expr[i:j]
+			if to, ok := renamedFieldName(fields, strings.Join(path, "."), word); ok {
+				word = to
+				renamed = true
+			}
+			path = append(path, word)
+			b.WriteString(word)
+			i = j
+		case !inLiteral && c == '/':
+			b.WriteByte(c)
+			i++
+		default:
+			path = nil
+			b.WriteByte(c)
+			i++
+		}
+	}
+	return b.String(), renamed
+}
+
+// renamedFieldName returns the new name of the field of the object at the
+// parent path, the lists of the path are not marked in OData.
+func renamedFieldName(fields []assetrename.Field, parent, name string) (string, bool) {
+	for _, f := range fields {
+		if f.From == name && strings.ReplaceAll(f.Parent, "[]", "") == parent {
+			return f.To, true
+		}
+	}
+	return "", false
+}
+
+func isIdentifierStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func renameBodyFields(request *http.Request, fields []assetrename.Field) error {
+	if request.Body == nil || !strings.HasPrefix(request.Header.Get(echo.HeaderContentType), echo.MIMEApplicationJSON) {
+		return nil
+	}
+	body, err := io.ReadAll(request.Body)
+	_ = request.Body.Close()
+	if err != nil {
+		return err // nolint:wrapcheck
+	}
+
+	if renamed, ok := renameJSONFields(body, fields); ok {
+		body = renamed
+		request.ContentLength = int64(len(body))
+	}
+	request.Body = io.NopCloser(bytes.NewReader(body))
+	return nil
+}
+
+// renameJSONFields returns the JSON document with the renamed fields of its
+// objects renamed, and whether any of them was found. Documents which can't
+// be parsed are left to the handlers to reject.
+func renameJSONFields(data []byte, fields []assetrename.Field) ([]byte, bool) {
+	var doc interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return data, false
+	}
+
+	renamed := false
+	for _, objectPath := range legacyObjectPaths {
+		if assetrename.Rename(doc, objectPath, fields) {
+			renamed = true
+		}
+	}
+	if !renamed {
+		return data, false
+	}
+
+	updated, err := json.Marshal(doc)
+	if err != nil {
+		return data, false
+	}
+	return updated, true
+}
+
+// legacyResponseWriter renames the fields of the JSON response. A JSON
+// document is buffered and renamed when the response is closed, a newline
+// delimited JSON stream is renamed line by line so it is still streamed.
+type legacyResponseWriter struct {
+	http.ResponseWriter
+
+	fields []assetrename.Field
+	buf    bytes.Buffer
+	mode   string
+}
+
+const (
+	legacyResponseRaw    = "raw"
+	legacyResponseJSON   = "json"
+	legacyResponseNDJSON = "ndjson"
+)
+
+func (w *legacyResponseWriter) WriteHeader(code int) {
+	if w.mode == "" {
+		contentType := w.Header().Get(echo.HeaderContentType)
+		switch {
+		case strings.HasPrefix(contentType, mimeApplicationNDJSON):
+			w.mode = legacyResponseNDJSON
+		case strings.HasPrefix(contentType, echo.MIMEApplicationJSON):
+			w.mode = legacyResponseJSON
+			w.Header().Del(echo.HeaderContentLength)
+		default:
+			w.mode = legacyResponseRaw
+		}
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *legacyResponseWriter) Write(b []byte) (int, error) {
+	if w.mode == "" {
+		w.WriteHeader(http.StatusOK)
+	}
+	switch w.mode {
+	case legacyResponseJSON:
+		return w.buf.Write(b) // nolint:wrapcheck
+	case legacyResponseNDJSON:
+		w.buf.Write(b)
+		if err := w.writeLines(false); err != nil {
+			return 0, err
+		}
+		return len(b), nil
+	default:
+		return w.ResponseWriter.Write(b) // nolint:wrapcheck
+	}
+}
+
+// writeLines writes the complete lines of the buffer renamed, and the
+// incomplete last one as well if all is set.
+func (w *legacyResponseWriter) writeLines(all bool) error {
+	for w.buf.Len() > 0 {
+		line, err := w.buf.ReadBytes('\n')
+		if err != nil && !all {
+			// Wait for the rest of the line.
+			w.buf.Write(line)
+			return nil
+		}
+		renamed, _ := renameJSONFields(bytes.TrimRight(line, "\n"), w.fields)
+		if _, err := w.ResponseWriter.Write(append(renamed, '\n')); err != nil {
+			return err // nolint:wrapcheck
+		}
+	}
+	return nil
+}
+
+func (w *legacyResponseWriter) Flush() {
+	if w.mode == legacyResponseJSON {
+		return
+	}
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *legacyResponseWriter) Close() error {
+	switch w.mode {
+	case legacyResponseJSON:
+		renamed, _ := renameJSONFields(w.buf.Bytes(), w.fields)
+		_, err := w.ResponseWriter.Write(renamed)
+		return err // nolint:wrapcheck
+	case legacyResponseNDJSON:
+		return w.writeLines(true)
+	}
+	return nil
+}
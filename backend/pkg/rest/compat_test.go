@@ -0,0 +1,170 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"gotest.tools/v3/assert"
+)
+
+func Test_targetsCompatMiddleware(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		filter       string
+		body         string
+		response     string
+		wantPath     string
+		wantFilter   string
+		wantBody     string
+		wantResponse string
+	}{
+		{
+			name:         "current client",
+			method:       http.MethodGet,
+			path:         "/api/assets",
+			filter:       "assetInfo/instanceID eq 'i-1'",
+			response:     `{"items":[{"id":"1","assetInfo":{"instanceID":"i-1"}}]}`,
+			wantPath:     "/api/assets",
+			wantFilter:   "assetInfo/instanceID eq 'i-1'",
+			wantResponse: `{"items":[{"id":"1","assetInfo":{"instanceID":"i-1"}}]}`,
+		},
+		{
+			name:         "legacy route",
+			method:       http.MethodGet,
+			path:         "/api/targets",
+			filter:       "targetInfo/instanceID eq 'targetInfo'",
+			response:     `{"items":[{"id":"1","assetInfo":{"instanceID":"i-1"}}]}`,
+			wantPath:     "/api/assets",
+			wantFilter:   "assetInfo/instanceID eq 'targetInfo'",
+			wantResponse: `{"items":[{"id":"1","targetInfo":{"instanceID":"i-1"}}]}`,
+		},
+		{
+			name:         "legacy route response",
+			method:       http.MethodGet,
+			path:         "/api/targets/1",
+			response:     `{"id":"1","assetInfo":{"instanceID":"i-1"}}`,
+			wantPath:     "/api/assets/1",
+			wantResponse: `{"id":"1","targetInfo":{"instanceID":"i-1"}}`,
+		},
+		{
+			name:         "legacy scan result fields",
+			method:       http.MethodPatch,
+			path:         "/api/scanResults/1",
+			body:         `{"target":{"id":"t1"},"status":{"general":{"state":"DONE"}}}`,
+			response:     `{"id":"1","asset":{"id":"t1"},"scan":{"id":"s1","assetIDs":["t1"]}}`,
+			wantPath:     "/api/scanResults/1",
+			wantBody:     `{"asset":{"id":"t1"},"status":{"general":{"state":"DONE"}}}`,
+			wantResponse: `{"id":"1","asset":{"id":"t1"},"scan":{"id":"s1","assetIDs":["t1"]}}`,
+		},
+		{
+			name:         "legacy expanded fields",
+			method:       http.MethodGet,
+			path:         "/api/scanResults",
+			filter:       "target/targetInfo/instanceID eq 'i-1' and scan/targetIDs/any(a: a eq 't1')",
+			response:     `{"items":[{"id":"1","asset":{"id":"t1"}}]}`,
+			wantPath:     "/api/scanResults",
+			wantFilter:   "asset/assetInfo/instanceID eq 'i-1' and scan/assetIDs/any(a: a eq 't1')",
+			wantResponse: `{"items":[{"id":"1","asset":{"id":"t1"}}]}`,
+		},
+		{
+			name:         "legacy scan plan fields",
+			method:       http.MethodPost,
+			path:         "/api/scans",
+			body:         `{"targetIDs":["t1"],"plan":{"targets":[{"targetID":"t1","targetType":"VMInfo"}]}}`,
+			response:     `{"id":"s1","assetIDs":["t1"]}`,
+			wantPath:     "/api/scans",
+			wantBody:     `{"assetIDs":["t1"],"plan":{"assets":[{"assetID":"t1","assetType":"VMInfo"}]}}`,
+			wantResponse: `{"id":"s1","assetIDs":["t1"]}`,
+		},
+		{
+			name:         "user data with legacy field names",
+			method:       http.MethodPatch,
+			path:         "/api/scanResults/1",
+			filter:       "annotations/target eq 'prod'",
+			body:         `{"annotations":{"target":"prod","targetID":"t1"}}`,
+			response:     `{"id":"1","annotations":{"target":"prod","targetID":"t1"}}`,
+			wantPath:     "/api/scanResults/1",
+			wantFilter:   "annotations/target eq 'prod'",
+			wantBody:     `{"annotations":{"target":"prod","targetID":"t1"}}`,
+			wantResponse: `{"id":"1","annotations":{"target":"prod","targetID":"t1"}}`,
+		},
+		{
+			name:         "user data of a legacy route",
+			method:       http.MethodGet,
+			path:         "/api/targets/1",
+			response:     `{"id":"1","assetInfo":{"tags":[{"key":"assetInfo","value":"v"}]},"labels":[{"key":"assetInfo","value":"v"}]}`,
+			wantPath:     "/api/assets/1",
+			wantResponse: `{"id":"1","labels":[{"key":"assetInfo","value":"v"}],"targetInfo":{"tags":[{"key":"assetInfo","value":"v"}]}}`,
+		},
+		{
+			name:         "other objects of a route",
+			method:       http.MethodGet,
+			path:         "/api/assets/1/packages",
+			filter:       "targetInfo eq 'x'",
+			response:     `{"items":[{"targetInfo":"x"}]}`,
+			wantPath:     "/api/assets/1/packages",
+			wantFilter:   "targetInfo eq 'x'",
+			wantResponse: `{"items":[{"targetInfo":"x"}]}`,
+		},
+		{
+			name:         "legacy field of a finding",
+			method:       http.MethodGet,
+			path:         "/api/findings",
+			filter:       "asset/targetInfo/instanceID eq 'i-1'",
+			response:     `{"items":[{"asset":{"id":"t1","assetInfo":{"instanceID":"i-1"}}}]}`,
+			wantPath:     "/api/findings",
+			wantFilter:   "asset/assetInfo/instanceID eq 'i-1'",
+			wantResponse: `{"items":[{"asset":{"id":"t1","assetInfo":{"instanceID":"i-1"}}}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := tt.path
+			if tt.filter != "" {
+				target += "?" + url.Values{"$filter": []string{tt.filter}}.Encode()
+			}
+			req := httptest.NewRequest(tt.method, target, strings.NewReader(tt.body))
+			if tt.body != "" {
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			}
+			rec := httptest.NewRecorder()
+
+			e := echo.New()
+			e.Pre(targetsCompatMiddleware())
+			e.Any("/*", func(ctx echo.Context) error {
+				assert.Equal(t, ctx.Request().URL.Path, tt.wantPath)
+				assert.Equal(t, ctx.QueryParam("$filter"), tt.wantFilter)
+				body, err := io.ReadAll(ctx.Request().Body)
+				assert.NilError(t, err)
+				assert.Equal(t, string(body), tt.wantBody)
+				return ctx.JSONBlob(http.StatusOK, []byte(tt.response))
+			})
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, rec.Code, http.StatusOK)
+			assert.Equal(t, rec.Body.String(), tt.wantResponse)
+		})
+	}
+}
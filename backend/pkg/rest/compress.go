@@ -0,0 +1,254 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	encodingGzip    = "gzip"
+	encodingDeflate = "deflate"
+
+	headerRange = "Range"
+)
+
+// compressedExtensions are the extensions of the static files which are
+// already compressed, so compressing them again only wastes CPU.
+var compressedExtensions = map[string]struct{}{
+	".br":    {},
+	".gif":   {},
+	".gz":    {},
+	".jpeg":  {},
+	".jpg":   {},
+	".png":   {},
+	".tgz":   {},
+	".webp":  {},
+	".woff":  {},
+	".woff2": {},
+	".xz":    {},
+	".zip":   {},
+	".zst":   {},
+}
+
+// compressedContentTypes are the prefixes of the content types of the
+// responses which are already compressed.
+var compressedContentTypes = []string{
+	"application/gzip",
+	"application/octet-stream",
+	"application/x-gzip",
+	"application/zip",
+	"application/zstd",
+	"audio/",
+	"font/woff",
+	"image/gif",
+	"image/jpeg",
+	"image/png",
+	"image/webp",
+	"video/",
+}
+
+// compressMiddleware compresses the responses with gzip or deflate as
+// negotiated by the Accept-Encoding header of the request. Static files and
+// responses which are already compressed, partial and HEAD responses are sent
+// as is.
+func compressMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			request := ctx.Request()
+			response := ctx.Response()
+			response.Header().Add(echo.HeaderVary, echo.HeaderAcceptEncoding)
+
+			encoding := negotiateEncoding(request.Header.Get(echo.HeaderAcceptEncoding))
+			if encoding == "" || request.Method == http.MethodHead || request.Header.Get(headerRange) != "" {
+				return next(ctx)
+			}
+			if _, ok := compressedExtensions[strings.ToLower(path.Ext(request.URL.Path))]; ok {
+				return next(ctx)
+			}
+
+			writer := &compressResponseWriter{
+				ResponseWriter: response.Writer,
+				encoding:       encoding,
+			}
+			response.Writer = writer
+
+			// The writer is not closed if the handler panics, so that
+			// the client can tell that the response was truncated.
+			err := next(ctx)
+			response.Writer = writer.ResponseWriter
+			if closeErr := writer.Close(); closeErr != nil {
+				log.Errorf("Failed to close compressed response: %v", closeErr)
+			}
+
+			return err
+		}
+	}
+}
+
+// negotiateEncoding returns the content coding to compress the response with
+// based on the Accept-Encoding header, or an empty string if the response
+// must not be compressed. gzip is preferred over deflate with the same
+// quality value.
+func negotiateEncoding(acceptEncoding string) string {
+	var encoding string
+	var quality float64
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		coding = strings.ToLower(strings.TrimSpace(coding))
+		if coding == "*" {
+			coding = encodingGzip
+		}
+		if coding != encodingGzip && coding != encodingDeflate {
+			continue
+		}
+
+		q := 1.0
+		if name, value, ok := strings.Cut(strings.TrimSpace(params), "="); ok && strings.TrimSpace(name) == "q" {
+			v, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				continue
+			}
+			q = v
+		}
+
+		if q <= 0 {
+			continue
+		}
+		if q > quality || (q == quality && coding == encodingGzip) {
+			encoding = coding
+			quality = q
+		}
+	}
+
+	return encoding
+}
+
+func isCompressedContentType(contentType string) bool {
+	contentType = strings.ToLower(contentType)
+	for _, prefix := range compressedContentTypes {
+		if strings.HasPrefix(contentType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+type compressWriter interface {
+	io.WriteCloser
+	Flush() error
+}
+
+// compressResponseWriter decides whether to compress the response when the
+// headers are written, as the content type and encoding are not known before.
+type compressResponseWriter struct {
+	http.ResponseWriter
+
+	encoding string
+	writer   compressWriter
+	decided  bool
+}
+
+func (w *compressResponseWriter) WriteHeader(code int) {
+	if !w.decided {
+		w.decided = true
+		if err := w.init(code); err != nil {
+			log.Errorf("Failed to initialize response compression: %v", err)
+		}
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *compressResponseWriter) init(code int) error {
+	header := w.Header()
+	if code < http.StatusOK || code == http.StatusNoContent || code == http.StatusNotModified || code == http.StatusPartialContent {
+		return nil
+	}
+	if header.Get(echo.HeaderContentEncoding) != "" || isCompressedContentType(header.Get(echo.HeaderContentType)) {
+		return nil
+	}
+
+	var writer compressWriter
+	switch w.encoding {
+	case encodingGzip:
+		writer = gzip.NewWriter(w.ResponseWriter)
+	case encodingDeflate:
+		fw, err := flate.NewWriter(w.ResponseWriter, flate.DefaultCompression)
+		if err != nil {
+			return fmt.Errorf("failed to create deflate writer: %w", err)
+		}
+		writer = fw
+	default:
+		return nil
+	}
+
+	header.Set(echo.HeaderContentEncoding, w.encoding)
+	header.Del(echo.HeaderContentLength)
+	w.writer = writer
+
+	return nil
+}
+
+func (w *compressResponseWriter) Write(b []byte) (int, error) {
+	if !w.decided {
+		if w.Header().Get(echo.HeaderContentType) == "" {
+			w.Header().Set(echo.HeaderContentType, http.DetectContentType(b))
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.writer == nil {
+		// nolint:wrapcheck
+		return w.ResponseWriter.Write(b)
+	}
+	// nolint:wrapcheck
+	return w.writer.Write(b)
+}
+
+// Flush writes the data compressed so far to the client.
+func (w *compressResponseWriter) Flush() {
+	if w.writer != nil {
+		if err := w.writer.Flush(); err != nil {
+			log.Errorf("Failed to flush compressed response: %v", err)
+		}
+	}
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Close writes the remaining compressed data and the trailer of the
+// compressed stream.
+func (w *compressResponseWriter) Close() error {
+	if w.writer == nil {
+		return nil
+	}
+	// nolint:wrapcheck
+	return w.writer.Close()
+}
+
+func (w *compressResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
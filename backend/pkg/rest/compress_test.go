@@ -0,0 +1,192 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"gotest.tools/v3/assert"
+)
+
+func Test_negotiateEncoding(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptEncoding string
+		want           string
+	}{
+		{
+			name:           "no accept encoding header",
+			acceptEncoding: "",
+			want:           "",
+		},
+		{
+			name:           "unsupported encoding",
+			acceptEncoding: "br",
+			want:           "",
+		},
+		{
+			name:           "gzip",
+			acceptEncoding: "gzip",
+			want:           encodingGzip,
+		},
+		{
+			name:           "deflate",
+			acceptEncoding: "deflate, br",
+			want:           encodingDeflate,
+		},
+		{
+			name:           "gzip is preferred",
+			acceptEncoding: "deflate, gzip, br",
+			want:           encodingGzip,
+		},
+		{
+			name:           "higher quality is preferred",
+			acceptEncoding: "gzip;q=0.5, deflate;q=0.8",
+			want:           encodingDeflate,
+		},
+		{
+			name:           "refused encoding",
+			acceptEncoding: "gzip;q=0, deflate",
+			want:           encodingDeflate,
+		},
+		{
+			name:           "wildcard",
+			acceptEncoding: "*",
+			want:           encodingGzip,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, negotiateEncoding(tt.acceptEncoding), tt.want)
+		})
+	}
+}
+
+func Test_compressMiddleware(t *testing.T) {
+	const body = `{"items":[{"id":"1"},{"id":"2"},{"id":"3"}]}`
+
+	tests := []struct {
+		name           string
+		path           string
+		acceptEncoding string
+		contentType    string
+		wantEncoding   string
+	}{
+		{
+			name:         "not accepted",
+			path:         "/api/scans",
+			contentType:  echo.MIMEApplicationJSON,
+			wantEncoding: "",
+		},
+		{
+			name:           "gzip",
+			path:           "/api/scans",
+			acceptEncoding: "gzip, deflate",
+			contentType:    echo.MIMEApplicationJSON,
+			wantEncoding:   encodingGzip,
+		},
+		{
+			name:           "deflate",
+			path:           "/api/scans",
+			acceptEncoding: "deflate",
+			contentType:    echo.MIMEApplicationJSON,
+			wantEncoding:   encodingDeflate,
+		},
+		{
+			name:           "compressed file",
+			path:           "/static/logo.png",
+			acceptEncoding: "gzip",
+			contentType:    echo.MIMEApplicationJSON,
+			wantEncoding:   "",
+		},
+		{
+			name:           "compressed content type",
+			path:           "/api/export",
+			acceptEncoding: "gzip",
+			contentType:    echo.MIMEOctetStream,
+			wantEncoding:   "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			req.Header.Set(echo.HeaderAcceptEncoding, tt.acceptEncoding)
+			rec := httptest.NewRecorder()
+			ctx := echo.New().NewContext(req, rec)
+
+			handler := compressMiddleware()(func(ctx echo.Context) error {
+				return ctx.Blob(http.StatusOK, tt.contentType, []byte(body))
+			})
+			assert.NilError(t, handler(ctx))
+
+			assert.Equal(t, rec.Code, http.StatusOK)
+			assert.Equal(t, rec.Header().Get(echo.HeaderContentEncoding), tt.wantEncoding)
+			assert.Equal(t, rec.Header().Get(echo.HeaderVary), echo.HeaderAcceptEncoding)
+
+			var reader io.Reader = rec.Body
+			switch tt.wantEncoding {
+			case encodingGzip:
+				gr, err := gzip.NewReader(rec.Body)
+				assert.NilError(t, err)
+				reader = gr
+			case encodingDeflate:
+				reader = flate.NewReader(rec.Body)
+			}
+			got, err := io.ReadAll(reader)
+			assert.NilError(t, err)
+			assert.Equal(t, string(got), body)
+		})
+	}
+}
+
+func Test_compressMiddlewareNDJSONStream(t *testing.T) {
+	type object struct {
+		ID int `json:"id"`
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/findings", nil)
+	req.Header.Set(echo.HeaderAcceptEncoding, encodingGzip)
+	rec := httptest.NewRecorder()
+	ctx := echo.New().NewContext(req, rec)
+
+	handler := compressMiddleware()(func(ctx echo.Context) error {
+		return sendNDJSONStream(ctx, func(fn func(object) error) error {
+			for i := 1; i <= 2; i++ {
+				if err := fn(object{ID: i}); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+	assert.NilError(t, handler(ctx))
+
+	assert.Equal(t, rec.Header().Get(echo.HeaderContentType), mimeApplicationNDJSON)
+	assert.Equal(t, rec.Header().Get(echo.HeaderContentEncoding), encodingGzip)
+	assert.Assert(t, rec.Flushed)
+
+	gr, err := gzip.NewReader(rec.Body)
+	assert.NilError(t, err)
+	got, err := io.ReadAll(gr)
+	assert.NilError(t, err)
+	assert.Equal(t, string(got), "{\"id\":1}\n{\"id\":2}\n")
+}
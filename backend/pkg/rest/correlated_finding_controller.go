@@ -0,0 +1,46 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+)
+
+func (s *ServerImpl) GetCorrelatedFindings(ctx echo.Context, params models.GetCorrelatedFindingsParams) error {
+	correlatedFindings, err := s.dbHandler.CorrelatedFindingsTable().GetCorrelatedFindings(params)
+	if err != nil {
+		return sendDBReadError(ctx, err, "failed to get correlated findings from db")
+	}
+	return sendResponse(ctx, http.StatusOK, correlatedFindings)
+}
+
+func (s *ServerImpl) GetCorrelatedFindingsCorrelatedFindingID(ctx echo.Context, correlatedFindingID models.CorrelatedFindingID, params models.GetCorrelatedFindingsCorrelatedFindingIDParams) error {
+	cf, err := s.dbHandler.CorrelatedFindingsTable().GetCorrelatedFinding(correlatedFindingID, params)
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("CorrelatedFinding with ID %v not found", correlatedFindingID))
+		}
+		return sendDBReadError(ctx, err, fmt.Sprintf("failed to get correlated finding from db. correlatedFindingID=%v", correlatedFindingID))
+	}
+	return sendResponse(ctx, http.StatusOK, cf)
+}
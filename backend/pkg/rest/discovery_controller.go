@@ -27,7 +27,7 @@ import (
 func (s *ServerImpl) GetDiscoveryScopes(ctx echo.Context, params models.GetDiscoveryScopesParams) error {
 	dbScopes, err := s.dbHandler.ScopesTable().GetScopes(params)
 	if err != nil {
-		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scopes from db: %v", err))
+		return sendDBReadError(ctx, err, "failed to get scopes from db")
 	}
 
 	return sendResponse(ctx, http.StatusOK, dbScopes)
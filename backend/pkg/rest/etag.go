@@ -0,0 +1,67 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const headerETag = "ETag"
+
+// sendResponseWithETag responds with the object and the ETag of its JSON
+// representation, or with 304 and no body if the ETag is listed in
+// ifNoneMatch, so that polling clients don't download unchanged objects
+// again. The ETag is weak as the response may be compressed.
+func sendResponseWithETag(ctx echo.Context, ifNoneMatch *string, object interface{}) error {
+	body, err := json.Marshal(object)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to marshal response: %v", err))
+	}
+
+	etag := computeETag(body)
+	ctx.Response().Header().Set(headerETag, etag)
+	if ifNoneMatch != nil && etagMatches(*ifNoneMatch, etag) {
+		return ctx.NoContent(http.StatusNotModified) // nolint:wrapcheck
+	}
+
+	return ctx.JSONBlob(http.StatusOK, body) // nolint:wrapcheck
+}
+
+func computeETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return fmt.Sprintf("W/%q", hex.EncodeToString(sum[:16]))
+}
+
+// etagMatches returns whether the etag is listed in the If-None-Match header
+// value. The tags are compared with the weak comparison, which ignores the W/
+// prefix, as required for If-None-Match.
+func etagMatches(ifNoneMatch string, etag string) bool {
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
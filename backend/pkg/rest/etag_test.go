@@ -0,0 +1,100 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"gotest.tools/v3/assert"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func Test_etagMatches(t *testing.T) {
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		etag        string
+		want        bool
+	}{
+		{
+			name:        "same tag",
+			ifNoneMatch: `W/"abc"`,
+			etag:        `W/"abc"`,
+			want:        true,
+		},
+		{
+			name:        "strong tag matches weak tag",
+			ifNoneMatch: `"abc"`,
+			etag:        `W/"abc"`,
+			want:        true,
+		},
+		{
+			name:        "tag in list",
+			ifNoneMatch: `W/"xyz", W/"abc"`,
+			etag:        `W/"abc"`,
+			want:        true,
+		},
+		{
+			name:        "any tag",
+			ifNoneMatch: "*",
+			etag:        `W/"abc"`,
+			want:        true,
+		},
+		{
+			name:        "different tag",
+			ifNoneMatch: `W/"xyz"`,
+			etag:        `W/"abc"`,
+			want:        false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, etagMatches(tt.ifNoneMatch, tt.etag), tt.want)
+		})
+	}
+}
+
+func Test_sendResponseWithETag(t *testing.T) {
+	target := models.Asset{Id: utils.PointerTo("target-1"), Revision: utils.PointerTo(1)}
+
+	send := func(ifNoneMatch *string, object interface{}) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/targets/target-1", nil), rec)
+		assert.NilError(t, sendResponseWithETag(ctx, ifNoneMatch, object))
+		return rec
+	}
+
+	rec := send(nil, target)
+	assert.Equal(t, rec.Code, http.StatusOK)
+	assert.Equal(t, rec.Body.String(), `{"id":"target-1","revision":1}`)
+	etag := rec.Header().Get(headerETag)
+	assert.Assert(t, etag != "")
+
+	rec = send(&etag, target)
+	assert.Equal(t, rec.Code, http.StatusNotModified)
+	assert.Equal(t, rec.Body.Len(), 0)
+	assert.Equal(t, rec.Header().Get(headerETag), etag)
+
+	target.Revision = utils.PointerTo(2)
+	rec = send(&etag, target)
+	assert.Equal(t, rec.Code, http.StatusOK)
+	assert.Assert(t, rec.Header().Get(headerETag) != etag)
+}
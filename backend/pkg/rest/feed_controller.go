@@ -0,0 +1,77 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/feeds"
+	"github.com/openclarity/vmclarity/backend/pkg/tasks"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func (s *ServerImpl) GetAdminFeeds(ctx echo.Context) error {
+	items := []models.Feed{}
+	if s.feedSyncer != nil {
+		var err error
+		items, err = s.feedSyncer.Feeds()
+		if err != nil {
+			return sendError(ctx, http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	return sendResponse(ctx, http.StatusOK, models.Feeds{Items: &items})
+}
+
+func (s *ServerImpl) PostAdminFeedsRefresh(ctx echo.Context) error {
+	if s.scheduler == nil || s.feedSyncer == nil {
+		return sendError(ctx, http.StatusNotFound, "feeds are not synced by the backend")
+	}
+
+	task, err := s.scheduler.Trigger(feeds.TaskName)
+	if err != nil {
+		if errors.Is(err, tasks.ErrTaskNotFound) {
+			return sendError(ctx, http.StatusNotFound, err.Error())
+		}
+		return sendError(ctx, http.StatusInternalServerError, err.Error())
+	}
+
+	return sendResponse(ctx, http.StatusAccepted, task)
+}
+
+func (s *ServerImpl) GetFeedsFeedName(ctx echo.Context, feedName models.FeedName, params models.GetFeedsFeedNameParams) error {
+	if s.feedSyncer == nil {
+		return sendError(ctx, http.StatusNotFound, fmt.Sprintf("%v: %s", feeds.ErrFeedNotFound, feedName))
+	}
+
+	version, data, err := s.feedSyncer.Content(ctx.Request().Context(), feedName, utils.ValueOrZero(params.Version))
+	if err != nil {
+		if errors.Is(err, feeds.ErrFeedNotFound) || errors.Is(err, feeds.ErrVersionNotFound) {
+			return sendError(ctx, http.StatusNotFound, err.Error())
+		}
+		return sendError(ctx, http.StatusInternalServerError, err.Error())
+	}
+
+	ctx.Response().Header().Set(headerETag, fmt.Sprintf("%q", version))
+	ctx.Response().Header().Set(echo.HeaderContentDisposition,
+		fmt.Sprintf("attachment; filename=\"%s\"", feedName))
+	return ctx.Blob(http.StatusOK, echo.MIMEOctetStream, data)
+}
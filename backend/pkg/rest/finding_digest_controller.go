@@ -0,0 +1,115 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func (s *ServerImpl) GetFindingDigests(ctx echo.Context, params models.GetFindingDigestsParams) error {
+	findingDigests, err := s.dbHandler.FindingDigestsTable().GetFindingDigests(params)
+	if err != nil {
+		return sendDBReadError(ctx, err, "failed to get finding digests from db")
+	}
+	return sendResponse(ctx, http.StatusOK, findingDigests)
+}
+
+func (s *ServerImpl) GetFindingDigestsFindingDigestID(ctx echo.Context, findingDigestID models.FindingDigestID, params models.GetFindingDigestsFindingDigestIDParams) error {
+	fd, err := s.dbHandler.FindingDigestsTable().GetFindingDigest(findingDigestID, params)
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("FindingDigest with ID %v not found", findingDigestID))
+		}
+		return sendDBReadError(ctx, err, fmt.Sprintf("failed to get finding digest from db. findingDigestID=%v", findingDigestID))
+	}
+	return sendResponse(ctx, http.StatusOK, fd)
+}
+
+func (s *ServerImpl) PostFindingDigests(ctx echo.Context) error {
+	var findingDigest models.FindingDigest
+	err := ctx.Bind(&findingDigest)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+
+	createdFindingDigest, err := s.dbHandler.FindingDigestsTable().CreateFindingDigest(findingDigest)
+	if err != nil {
+		var validationErr *common.BadRequestError
+		if errors.As(err, &validationErr) {
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to create finding digest in db: %v", err))
+	}
+
+	return sendResponse(ctx, http.StatusCreated, createdFindingDigest)
+}
+
+func (s *ServerImpl) DeleteFindingDigestsFindingDigestID(ctx echo.Context, findingDigestID models.FindingDigestID) error {
+	success := models.Success{
+		Message: utils.PointerTo(fmt.Sprintf("finding digest %v deleted", findingDigestID)),
+	}
+
+	if err := s.dbHandler.FindingDigestsTable().DeleteFindingDigest(findingDigestID); err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("FindingDigest with ID %v not found", findingDigestID))
+		}
+		return sendError(ctx, http.StatusInternalServerError, err.Error())
+	}
+
+	return sendResponse(ctx, http.StatusOK, &success)
+}
+
+func (s *ServerImpl) PatchFindingDigestsFindingDigestID(ctx echo.Context, findingDigestID models.FindingDigestID, params models.PatchFindingDigestsFindingDigestIDParams) error {
+	var findingDigest models.FindingDigest
+	err := ctx.Bind(&findingDigest)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+
+	// PATCH request might not contain the ID in the body, so set it from
+	// the URL field so that the DB layer knows which object is being updated.
+	if findingDigest.Id != nil && *findingDigest.Id != findingDigestID {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("id in body %s does not match object %s to be updated", *findingDigest.Id, findingDigestID))
+	}
+	findingDigest.Id = &findingDigestID
+
+	updatedFindingDigest, err := s.dbHandler.FindingDigestsTable().UpdateFindingDigest(findingDigest, params)
+	if err != nil {
+		var validationErr *common.BadRequestError
+		var preconditionFailedErr *databaseTypes.PreconditionFailedError
+		switch true {
+		case errors.Is(err, databaseTypes.ErrNotFound):
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("FindingDigest with ID %v not found", findingDigestID))
+		case errors.As(err, &validationErr):
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		case errors.As(err, &preconditionFailedErr):
+			return sendError(ctx, http.StatusPreconditionFailed, err.Error())
+		default:
+			return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to update finding digest in db. findingDigestID=%v: %v", findingDigestID, err))
+		}
+	}
+
+	return sendResponse(ctx, http.StatusOK, updatedFindingDigest)
+}
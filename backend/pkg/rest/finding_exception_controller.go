@@ -0,0 +1,115 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func (s *ServerImpl) GetFindingExceptions(ctx echo.Context, params models.GetFindingExceptionsParams) error {
+	findingExceptions, err := s.dbHandler.FindingExceptionsTable().GetFindingExceptions(params)
+	if err != nil {
+		return sendDBReadError(ctx, err, "failed to get finding exceptions from db")
+	}
+	return sendResponse(ctx, http.StatusOK, findingExceptions)
+}
+
+func (s *ServerImpl) GetFindingExceptionsFindingExceptionID(ctx echo.Context, findingExceptionID models.FindingExceptionID, params models.GetFindingExceptionsFindingExceptionIDParams) error {
+	fe, err := s.dbHandler.FindingExceptionsTable().GetFindingException(findingExceptionID, params)
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("FindingException with ID %v not found", findingExceptionID))
+		}
+		return sendDBReadError(ctx, err, fmt.Sprintf("failed to get finding exception from db. findingExceptionID=%v", findingExceptionID))
+	}
+	return sendResponse(ctx, http.StatusOK, fe)
+}
+
+func (s *ServerImpl) PostFindingExceptions(ctx echo.Context) error {
+	var findingException models.FindingException
+	err := ctx.Bind(&findingException)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+
+	createdFindingException, err := s.dbHandler.FindingExceptionsTable().CreateFindingException(findingException)
+	if err != nil {
+		var validationErr *common.BadRequestError
+		if errors.As(err, &validationErr) {
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to create finding exception in db: %v", err))
+	}
+
+	return sendResponse(ctx, http.StatusCreated, createdFindingException)
+}
+
+func (s *ServerImpl) DeleteFindingExceptionsFindingExceptionID(ctx echo.Context, findingExceptionID models.FindingExceptionID) error {
+	success := models.Success{
+		Message: utils.PointerTo(fmt.Sprintf("finding exception %v deleted", findingExceptionID)),
+	}
+
+	if err := s.dbHandler.FindingExceptionsTable().DeleteFindingException(findingExceptionID); err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("FindingException with ID %v not found", findingExceptionID))
+		}
+		return sendError(ctx, http.StatusInternalServerError, err.Error())
+	}
+
+	return sendResponse(ctx, http.StatusOK, &success)
+}
+
+func (s *ServerImpl) PatchFindingExceptionsFindingExceptionID(ctx echo.Context, findingExceptionID models.FindingExceptionID, params models.PatchFindingExceptionsFindingExceptionIDParams) error {
+	var findingException models.FindingException
+	err := ctx.Bind(&findingException)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+
+	// PATCH request might not contain the ID in the body, so set it from
+	// the URL field so that the DB layer knows which object is being updated.
+	if findingException.Id != nil && *findingException.Id != findingExceptionID {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("id in body %s does not match object %s to be updated", *findingException.Id, findingExceptionID))
+	}
+	findingException.Id = &findingExceptionID
+
+	updatedFindingException, err := s.dbHandler.FindingExceptionsTable().UpdateFindingException(findingException, params)
+	if err != nil {
+		var validationErr *common.BadRequestError
+		var preconditionFailedErr *databaseTypes.PreconditionFailedError
+		switch true {
+		case errors.Is(err, databaseTypes.ErrNotFound):
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("FindingException with ID %v not found", findingExceptionID))
+		case errors.As(err, &validationErr):
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		case errors.As(err, &preconditionFailedErr):
+			return sendError(ctx, http.StatusPreconditionFailed, err.Error())
+		default:
+			return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to update finding exception in db. findingExceptionID=%v: %v", findingExceptionID, err))
+		}
+	}
+
+	return sendResponse(ctx, http.StatusOK, updatedFindingException)
+}
@@ -25,13 +25,20 @@ import (
 	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/backend/pkg/common"
 	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/backend/pkg/notifications"
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
 func (s *ServerImpl) GetFindings(ctx echo.Context, params models.GetFindingsParams) error {
+	if acceptsNDJSON(ctx) {
+		return sendNDJSONStream(ctx, func(fn func(models.Finding) error) error {
+			return s.dbHandler.FindingsTable().StreamFindings(params, fn)
+		})
+	}
+
 	findings, err := s.dbHandler.FindingsTable().GetFindings(params)
 	if err != nil {
-		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get findings from db: %v", err))
+		return sendDBReadError(ctx, err, "failed to get findings from db")
 	}
 	return sendResponse(ctx, http.StatusOK, findings)
 }
@@ -42,7 +49,7 @@ func (s *ServerImpl) GetFindingsFindingID(ctx echo.Context, findingID models.Fin
 		if errors.Is(err, databaseTypes.ErrNotFound) {
 			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("Finding with ID %v not found", findingID))
 		}
-		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get finding from db. findingID=%v: %v", findingID, err))
+		return sendDBReadError(ctx, err, fmt.Sprintf("failed to get finding from db. findingID=%v", findingID))
 	}
 	return sendResponse(ctx, http.StatusOK, sc)
 }
@@ -72,9 +79,101 @@ func (s *ServerImpl) PostFindings(ctx echo.Context) error {
 		}
 	}
 
+	if eventType, ok := notifications.FindingEvent(createdFinding); ok {
+		s.notifier.Notify(eventType, nil, &createdFinding)
+	}
+
 	return sendResponse(ctx, http.StatusCreated, createdFinding)
 }
 
+func (s *ServerImpl) PostFindingsBulk(ctx echo.Context) error {
+	var request models.FindingsBulkRequest
+	err := ctx.Bind(&request)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+
+	results, err := s.dbHandler.FindingsTable().CreateFindings(request.Items)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to create findings in db: %v", err))
+	}
+
+	response := models.FindingsBulkResult{
+		Items: make([]models.FindingBulkItemResult, 0, len(results)),
+	}
+	for _, result := range results {
+		if result.Err != nil {
+			response.Items = append(response.Items, newFindingBulkItemError(result.Err))
+			continue
+		}
+
+		createdFinding := result.Finding
+		if eventType, ok := notifications.FindingEvent(createdFinding); ok {
+			s.notifier.Notify(eventType, nil, &createdFinding)
+		}
+
+		response.Items = append(response.Items, models.FindingBulkItemResult{
+			Status:  http.StatusCreated,
+			Finding: &createdFinding,
+		})
+	}
+
+	return sendResponse(ctx, http.StatusOK, response)
+}
+
+func (s *ServerImpl) PatchFindingsBulk(ctx echo.Context) error {
+	var request models.FindingsBulkRequest
+	err := ctx.Bind(&request)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+
+	results, err := s.dbHandler.FindingsTable().UpdateFindings(request.Items)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to update findings in db: %v", err))
+	}
+
+	response := models.FindingsBulkResult{
+		Items: make([]models.FindingBulkItemResult, 0, len(results)),
+	}
+	for _, result := range results {
+		if result.Err != nil {
+			response.Items = append(response.Items, newFindingBulkItemError(result.Err))
+			continue
+		}
+
+		updatedFinding := result.Finding
+		if result.Invalidated {
+			s.notifier.NotifyFindingInvalidated(updatedFinding)
+		}
+
+		response.Items = append(response.Items, models.FindingBulkItemResult{
+			Status:  http.StatusOK,
+			Finding: &updatedFinding,
+		})
+	}
+
+	return sendResponse(ctx, http.StatusOK, response)
+}
+
+// newFindingBulkItemError returns the result of a finding of a bulk request
+// which could not be stored because of err.
+func newFindingBulkItemError(err error) models.FindingBulkItemResult {
+	status := http.StatusInternalServerError
+	var validationErr *common.BadRequestError
+	switch true {
+	case errors.Is(err, databaseTypes.ErrNotFound):
+		status = http.StatusNotFound
+	case errors.As(err, &validationErr):
+		status = http.StatusBadRequest
+	}
+
+	return models.FindingBulkItemResult{
+		Status:  status,
+		Message: utils.PointerTo(err.Error()),
+	}
+}
+
 func (s *ServerImpl) DeleteFindingsFindingID(ctx echo.Context, findingID models.FindingID) error {
 	success := models.Success{
 		Message: utils.PointerTo(fmt.Sprintf("finding %v deleted", findingID)),
@@ -91,6 +190,10 @@ func (s *ServerImpl) DeleteFindingsFindingID(ctx echo.Context, findingID models.
 }
 
 func (s *ServerImpl) PatchFindingsFindingID(ctx echo.Context, findingID models.FindingID) error {
+	if isJSONPatch(ctx) {
+		return s.jsonPatchFinding(ctx, findingID)
+	}
+
 	var finding models.Finding
 	err := ctx.Bind(&finding)
 	if err != nil {
@@ -103,6 +206,9 @@ func (s *ServerImpl) PatchFindingsFindingID(ctx echo.Context, findingID models.F
 		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("id in body %s does not match object %s to be updated", *finding.Id, findingID))
 	}
 	finding.Id = &findingID
+
+	invalidated := finding.InvalidatedOn != nil && s.isFindingActive(findingID)
+
 	updatedFinding, err := s.dbHandler.FindingsTable().UpdateFinding(finding)
 	if err != nil {
 		var validationErr *common.BadRequestError
@@ -116,6 +222,10 @@ func (s *ServerImpl) PatchFindingsFindingID(ctx echo.Context, findingID models.F
 		}
 	}
 
+	if invalidated {
+		s.notifier.NotifyFindingInvalidated(updatedFinding)
+	}
+
 	return sendResponse(ctx, http.StatusOK, updatedFinding)
 }
 
@@ -126,6 +236,10 @@ func (s *ServerImpl) PutFindingsFindingID(ctx echo.Context, findingID models.Fin
 		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
 	}
 
+	return s.saveFinding(ctx, findingID, finding)
+}
+
+func (s *ServerImpl) saveFinding(ctx echo.Context, findingID models.FindingID, finding models.Finding) error {
 	// PUT request might not contain the ID in the body, so set it from the
 	// URL field so that the DB layer knows which object is being updated.
 	if finding.Id != nil && *finding.Id != findingID {
@@ -133,6 +247,8 @@ func (s *ServerImpl) PutFindingsFindingID(ctx echo.Context, findingID models.Fin
 	}
 	finding.Id = &findingID
 
+	invalidated := finding.InvalidatedOn != nil && s.isFindingActive(findingID)
+
 	updatedFinding, err := s.dbHandler.FindingsTable().SaveFinding(finding)
 	if err != nil {
 		var validationErr *common.BadRequestError
@@ -146,5 +262,40 @@ func (s *ServerImpl) PutFindingsFindingID(ctx echo.Context, findingID models.Fin
 		}
 	}
 
+	if invalidated {
+		s.notifier.NotifyFindingInvalidated(updatedFinding)
+	}
+
 	return sendResponse(ctx, http.StatusOK, updatedFinding)
 }
+
+// jsonPatchFinding applies the JSON Patch of the request to the finding and
+// replaces the finding with the result.
+func (s *ServerImpl) jsonPatchFinding(ctx echo.Context, findingID models.FindingID) error {
+	finding, err := s.dbHandler.FindingsTable().GetFinding(findingID, models.GetFindingsFindingIDParams{})
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("Finding with ID %v not found", findingID))
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get finding from db. findingID=%v: %v", findingID, err))
+	}
+
+	var patched models.Finding
+	if err := applyJSONPatch(ctx, finding, &patched); err != nil {
+		return sendJSONPatchError(ctx, err)
+	}
+
+	return s.saveFinding(ctx, findingID, patched)
+}
+
+// isFindingActive returns whether the finding is not invalidated yet, so that
+// the findings which stop appearing are only notified once.
+func (s *ServerImpl) isFindingActive(findingID models.FindingID) bool {
+	finding, err := s.dbHandler.FindingsTable().GetFinding(findingID, models.GetFindingsFindingIDParams{
+		Select: utils.PointerTo("invalidatedOn"),
+	})
+	if err != nil {
+		return false
+	}
+	return finding.InvalidatedOn == nil
+}
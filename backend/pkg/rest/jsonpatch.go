@@ -0,0 +1,139 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+)
+
+const mimeApplicationJSONPatch = "application/json-patch+json"
+
+// jsonPatchRoutes are the routes of the PATCH operations accepting a JSON
+// Patch (RFC 6902). These are the objects which can be replaced with PUT, as
+// the result of a JSON Patch replaces the object.
+var jsonPatchRoutes = map[string]bool{
+	BaseURL + "/assets/:assetID":           true,
+	BaseURL + "/scans/:scanID":             true,
+	BaseURL + "/scanConfigs/:scanConfigID": true,
+	BaseURL + "/scanResults/:scanResultID": true,
+	BaseURL + "/findings/:findingID":       true,
+}
+
+var errInvalidJSONPatch = errors.New("invalid JSON Patch")
+
+// isJSONPatch returns whether the body of the request is a JSON Patch instead
+// of a JSON merge patch (RFC 7386).
+func isJSONPatch(ctx echo.Context) bool {
+	return strings.HasPrefix(ctx.Request().Header.Get(echo.HeaderContentType), mimeApplicationJSONPatch)
+}
+
+// skipJSONPatchValidation skips the OpenAPI validation of the JSON Patch
+// requests of jsonPatchRoutes, as the generated code only supports one JSON
+// body per operation. The patch is validated by applyJSONPatch instead.
+func skipJSONPatchValidation(ctx echo.Context) bool {
+	return ctx.Request().Method == http.MethodPatch && jsonPatchRoutes[ctx.Path()] && isJSONPatch(ctx)
+}
+
+// applyJSONPatch applies the JSON Patch in the body of the request to object
+// and decodes the result into patched.
+func applyJSONPatch(ctx echo.Context, object interface{}, patched interface{}) error {
+	body, err := io.ReadAll(ctx.Request().Body)
+	if err != nil {
+		return fmt.Errorf("%w: failed to read request: %v", errInvalidJSONPatch, err)
+	}
+
+	if err := validateJSONPatch(body); err != nil {
+		return err
+	}
+
+	patch, err := jsonpatch.DecodePatch(body)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errInvalidJSONPatch, err)
+	}
+
+	original, err := json.Marshal(object)
+	if err != nil {
+		return fmt.Errorf("failed to marshal object: %w", err)
+	}
+
+	result, err := patch.Apply(original)
+	if err != nil {
+		return fmt.Errorf("failed to apply JSON Patch: %w", err)
+	}
+
+	// Unknown fields are rejected, as they would be silently dropped from
+	// the object, for example when a path is misspelled.
+	decoder := json.NewDecoder(bytes.NewReader(result))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(patched); err != nil {
+		return fmt.Errorf("patched object is invalid: %w", err)
+	}
+
+	return nil
+}
+
+func validateJSONPatch(body []byte) error {
+	var operations models.JSONPatch
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&operations); err != nil {
+		return fmt.Errorf("%w: %v", errInvalidJSONPatch, err)
+	}
+
+	for i, operation := range operations {
+		switch operation.Op {
+		case models.Add, models.Remove, models.Replace, models.Test:
+		case models.Move, models.Copy:
+			if operation.From == nil {
+				return fmt.Errorf("%w: operation %d: from is required for %s", errInvalidJSONPatch, i, operation.Op)
+			}
+		default:
+			return fmt.Errorf("%w: operation %d: unknown op %q", errInvalidJSONPatch, i, operation.Op)
+		}
+	}
+
+	return nil
+}
+
+// sendJSONPatchError responds with 400 if the JSON Patch is invalid, and with
+// 422 if it can't be applied to the object.
+func sendJSONPatchError(ctx echo.Context, err error) error {
+	if errors.Is(err, errInvalidJSONPatch) {
+		return sendError(ctx, http.StatusBadRequest, err.Error())
+	}
+	return sendError(ctx, http.StatusUnprocessableEntity, err.Error())
+}
+
+// jsonPatchIfMatch returns the revision which the object patched with a JSON
+// Patch is saved with, so that the object isn't replaced if it was modified
+// since the patch was applied to it.
+func jsonPatchIfMatch(ifMatch *models.Ifmatch, revision *int) *models.Ifmatch {
+	if ifMatch != nil {
+		return ifMatch
+	}
+	return revision
+}
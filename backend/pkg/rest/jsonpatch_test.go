@@ -0,0 +1,172 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"gotest.tools/v3/assert"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func newJSONPatchContext(patch string) echo.Context {
+	request := httptest.NewRequest(http.MethodPatch, "/scanResults/result-1", strings.NewReader(patch))
+	request.Header.Set(echo.HeaderContentType, mimeApplicationJSONPatch)
+	return echo.New().NewContext(request, httptest.NewRecorder())
+}
+
+func Test_applyJSONPatch(t *testing.T) {
+	scanResult := models.AssetScanResult{
+		Id:          utils.PointerTo("result-1"),
+		Annotations: &models.Annotations{"ticket": "SEC-1", "owner": "team-a"},
+		Vulnerabilities: &models.VulnerabilityScan{
+			Vulnerabilities: &[]models.Vulnerability{
+				{VulnerabilityName: utils.PointerTo("CVE-1")},
+				{VulnerabilityName: utils.PointerTo("CVE-2")},
+				{VulnerabilityName: utils.PointerTo("CVE-3")},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		patch       string
+		want        models.AssetScanResult
+		wantErr     bool
+		wantInvalid bool
+	}{
+		{
+			name: "remove array element after test",
+			patch: `[
+				{"op": "test", "path": "/vulnerabilities/vulnerabilities/1/vulnerabilityName", "value": "CVE-2"},
+				{"op": "remove", "path": "/vulnerabilities/vulnerabilities/1"}
+			]`,
+			want: models.AssetScanResult{
+				Id:          utils.PointerTo("result-1"),
+				Annotations: &models.Annotations{"ticket": "SEC-1", "owner": "team-a"},
+				Vulnerabilities: &models.VulnerabilityScan{
+					Vulnerabilities: &[]models.Vulnerability{
+						{VulnerabilityName: utils.PointerTo("CVE-1")},
+						{VulnerabilityName: utils.PointerTo("CVE-3")},
+					},
+				},
+			},
+		},
+		{
+			name:  "remove map key",
+			patch: `[{"op": "remove", "path": "/annotations/owner"}]`,
+			want: models.AssetScanResult{
+				Id:              utils.PointerTo("result-1"),
+				Annotations:     &models.Annotations{"ticket": "SEC-1"},
+				Vulnerabilities: scanResult.Vulnerabilities,
+			},
+		},
+		{
+			name:    "failed test",
+			patch:   `[{"op": "test", "path": "/annotations/ticket", "value": "SEC-2"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "missing path",
+			patch:   `[{"op": "remove", "path": "/vulnerabilities/vulnerabilities/5"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown field in result",
+			patch:   `[{"op": "add", "path": "/annotationz", "value": {}}]`,
+			wantErr: true,
+		},
+		{
+			name:        "unknown op",
+			patch:       `[{"op": "delete", "path": "/annotations"}]`,
+			wantErr:     true,
+			wantInvalid: true,
+		},
+		{
+			name:        "move without from",
+			patch:       `[{"op": "move", "path": "/annotations/ticket"}]`,
+			wantErr:     true,
+			wantInvalid: true,
+		},
+		{
+			name:        "not a patch document",
+			patch:       `{"annotations": {}}`,
+			wantErr:     true,
+			wantInvalid: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got models.AssetScanResult
+			err := applyJSONPatch(newJSONPatchContext(tt.patch), scanResult, &got)
+			if tt.wantErr {
+				assert.Assert(t, err != nil)
+				assert.Equal(t, errors.Is(err, errInvalidJSONPatch), tt.wantInvalid)
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, got, tt.want)
+		})
+	}
+}
+
+func Test_skipJSONPatchValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		contentType string
+		want        bool
+	}{
+		{
+			name:        "json patch of scan result",
+			method:      http.MethodPatch,
+			path:        BaseURL + "/scanResults/:scanResultID",
+			contentType: mimeApplicationJSONPatch,
+			want:        true,
+		},
+		{
+			name:        "merge patch of scan result",
+			method:      http.MethodPatch,
+			path:        BaseURL + "/scanResults/:scanResultID",
+			contentType: echo.MIMEApplicationJSON,
+			want:        false,
+		},
+		{
+			name:        "json patch of object without PUT",
+			method:      http.MethodPatch,
+			path:        BaseURL + "/findingExceptions/:findingExceptionID",
+			contentType: mimeApplicationJSONPatch,
+			want:        false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(tt.method, "/", nil)
+			request.Header.Set(echo.HeaderContentType, tt.contentType)
+			ctx := echo.New().NewContext(request, httptest.NewRecorder())
+			ctx.SetPath(tt.path)
+			assert.Equal(t, skipJSONPatchValidation(ctx), tt.want)
+		})
+	}
+}
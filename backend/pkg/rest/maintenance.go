@@ -0,0 +1,65 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/backend/pkg/maintenance"
+)
+
+// maintenanceRoutes are the routes which can be used for changes in
+// maintenance mode, as they change nothing but the maintenance mode, or
+// nothing at all.
+var maintenanceRoutes = []string{
+	BaseURL + "/admin/maintenance",
+	BaseURL + "/settings/findingTemplates/preview",
+}
+
+// maintenanceMiddleware rejects the requests which change anything while the
+// backend is in maintenance mode, so that the DB can be migrated or backed up
+// while the API stays available for reading.
+func maintenanceMiddleware(state *maintenance.State) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			request := ctx.Request()
+			switch request.Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				return next(ctx)
+			}
+			if matchesRoute(request.URL.Path, maintenanceRoutes) {
+				return next(ctx)
+			}
+
+			mode, err := state.Get()
+			if err != nil {
+				// The request fails anyway if the DB is not available.
+				log.Warnf("Failed to get maintenance mode: %v", err)
+				return next(ctx)
+			}
+			if !mode.Enabled {
+				return next(ctx)
+			}
+
+			ctx.Response().Header().Set(echo.HeaderRetryAfter, strconv.Itoa(maintenance.RetryAfterSeconds(mode)))
+			return sendError(ctx, http.StatusServiceUnavailable, maintenance.Message(mode))
+		}
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+)
+
+func (s *ServerImpl) GetAdminMaintenance(ctx echo.Context) error {
+	maintenanceMode, err := s.dbHandler.SettingsTable().GetMaintenanceMode()
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get maintenance mode from db: %v", err))
+	}
+	return sendResponse(ctx, http.StatusOK, maintenanceMode)
+}
+
+func (s *ServerImpl) PutAdminMaintenance(ctx echo.Context) error {
+	var maintenanceMode models.MaintenanceMode
+	err := ctx.Bind(&maintenanceMode)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+
+	updatedMaintenanceMode, err := s.dbHandler.SettingsTable().SetMaintenanceMode(maintenanceMode)
+	if err != nil {
+		var validationErr *common.BadRequestError
+		if errors.As(err, &validationErr) {
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to set maintenance mode in db: %v", err))
+	}
+	s.maintenance.Set(updatedMaintenanceMode)
+
+	return sendResponse(ctx, http.StatusOK, updatedMaintenanceMode)
+}
@@ -0,0 +1,72 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/openclarity/vmclarity/backend/pkg/database/gorm"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+)
+
+func Test_maintenanceMiddleware(t *testing.T) {
+	db, err := gorm.NewDatabase(databaseTypes.DBConfig{
+		DriverType:  databaseTypes.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.sqlite"),
+	})
+	assert.NilError(t, err)
+
+	e, err := createEchoServer(db, UsageLimits{}, nil, nil, nil, nil, nil, 0, nil, nil, "", t.TempDir(), nil)
+	assert.NilError(t, err)
+
+	do := func(method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := do(http.MethodPut, "/api/settings/retention", `{"enabled": false}`)
+	assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+	rec = do(http.MethodPut, "/api/admin/maintenance", `{"enabled": true, "reason": "backup", "retryAfterSeconds": 60}`)
+	assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+	// Writes are rejected while reads are still served.
+	rec = do(http.MethodPut, "/api/settings/retention", `{"enabled": true}`)
+	assert.Equal(t, rec.Code, http.StatusServiceUnavailable, rec.Body.String())
+	assert.Equal(t, rec.Header().Get("Retry-After"), "60")
+	assert.Assert(t, strings.Contains(rec.Body.String(), "backup"), rec.Body.String())
+
+	rec = do(http.MethodGet, "/api/settings/retention", "")
+	assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+	rec = do(http.MethodPut, "/api/admin/maintenance", `{"enabled": true, "retryAfterSeconds": 0}`)
+	assert.Equal(t, rec.Code, http.StatusBadRequest, rec.Body.String())
+
+	rec = do(http.MethodPut, "/api/admin/maintenance", `{"enabled": false}`)
+	assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+	rec = do(http.MethodPut, "/api/settings/retention", `{"enabled": true}`)
+	assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+}
@@ -0,0 +1,82 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	mimeApplicationNDJSON = "application/x-ndjson"
+
+	// ndjsonFlushInterval is the number of objects written between flushes
+	// of the response.
+	ndjsonFlushInterval = 100
+)
+
+// acceptsNDJSON returns true if the client requested a newline delimited
+// JSON stream instead of a single JSON document.
+func acceptsNDJSON(ctx echo.Context) bool {
+	return strings.Contains(ctx.Request().Header.Get(echo.HeaderAccept), mimeApplicationNDJSON)
+}
+
+// sendNDJSONStream writes every object produced by stream as a line of JSON.
+// Writes to the response block while the client is not reading, so the
+// database cursor is only advanced as fast as the client consumes the
+// stream, which doesn't block the writes meanwhile as SQLite is in WAL mode.
+// If streaming fails after the response was committed the
+// connection is aborted so that the client can tell the stream was
+// truncated.
+func sendNDJSONStream[T any](ctx echo.Context, stream func(fn func(T) error) error) error {
+	response := ctx.Response()
+	encoder := json.NewEncoder(response)
+	commit := func() {
+		if !response.Committed {
+			response.Header().Set(echo.HeaderContentType, mimeApplicationNDJSON)
+			response.WriteHeader(http.StatusOK)
+		}
+	}
+
+	var written int
+	err := stream(func(obj T) error {
+		commit()
+		if err := encoder.Encode(obj); err != nil {
+			return fmt.Errorf("failed to write object: %w", err)
+		}
+		written++
+		if written%ndjsonFlushInterval == 0 {
+			response.Flush()
+		}
+		return nil
+	})
+	if err != nil {
+		if !response.Committed {
+			return sendDBReadError(ctx, err, "failed to stream objects")
+		}
+		log.Errorf("Failed to stream objects after %d objects were written: %v", written, err)
+		panic(http.ErrAbortHandler)
+	}
+
+	commit()
+	response.Flush()
+	return nil
+}
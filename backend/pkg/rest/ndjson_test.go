@@ -0,0 +1,138 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"gotest.tools/v3/assert"
+)
+
+func Test_acceptsNDJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		accept string
+		want   bool
+	}{
+		{
+			name:   "no accept header",
+			accept: "",
+			want:   false,
+		},
+		{
+			name:   "json",
+			accept: "application/json",
+			want:   false,
+		},
+		{
+			name:   "ndjson",
+			accept: "application/x-ndjson",
+			want:   true,
+		},
+		{
+			name:   "ndjson with other types",
+			accept: "application/x-ndjson, application/json;q=0.9",
+			want:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set(echo.HeaderAccept, tt.accept)
+			ctx := echo.New().NewContext(req, httptest.NewRecorder())
+
+			assert.Equal(t, acceptsNDJSON(ctx), tt.want)
+		})
+	}
+}
+
+func Test_sendNDJSONStream(t *testing.T) {
+	type object struct {
+		ID int `json:"id"`
+	}
+
+	tests := []struct {
+		name            string
+		objects         []object
+		streamErr       error
+		wantCode        int
+		wantContentType string
+		wantBody        string
+	}{
+		{
+			name:            "objects are written one per line",
+			objects:         []object{{ID: 1}, {ID: 2}},
+			wantCode:        http.StatusOK,
+			wantContentType: mimeApplicationNDJSON,
+			wantBody:        "{\"id\":1}\n{\"id\":2}\n",
+		},
+		{
+			name:            "empty stream",
+			wantCode:        http.StatusOK,
+			wantContentType: mimeApplicationNDJSON,
+			wantBody:        "",
+		},
+		{
+			name:            "error before the first object",
+			streamErr:       errors.New("db error"),
+			wantCode:        http.StatusInternalServerError,
+			wantContentType: echo.MIMEApplicationJSONCharsetUTF8,
+			wantBody:        "{\"message\":\"failed to stream objects: db error\"}\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+
+			err := sendNDJSONStream(ctx, func(fn func(object) error) error {
+				for _, o := range tt.objects {
+					if err := fn(o); err != nil {
+						return err
+					}
+				}
+				return tt.streamErr
+			})
+			assert.NilError(t, err)
+			assert.Equal(t, rec.Code, tt.wantCode)
+			assert.Equal(t, rec.Header().Get(echo.HeaderContentType), tt.wantContentType)
+			assert.Equal(t, rec.Body.String(), tt.wantBody)
+		})
+	}
+}
+
+func Test_sendNDJSONStream_abortsOnErrorAfterCommit(t *testing.T) {
+	rec := httptest.NewRecorder()
+	ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+
+	defer func() {
+		assert.Equal(t, recover(), http.ErrAbortHandler)
+		assert.Assert(t, strings.HasPrefix(rec.Body.String(), "1\n"))
+	}()
+
+	_ = sendNDJSONStream(ctx, func(fn func(int) error) error {
+		if err := fn(1); err != nil {
+			return err
+		}
+		return errors.New("db error")
+	})
+	t.Fatal("expected stream to be aborted")
+}
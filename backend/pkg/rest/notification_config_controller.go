@@ -0,0 +1,124 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func (s *ServerImpl) GetNotificationConfigs(ctx echo.Context, params models.GetNotificationConfigsParams) error {
+	notificationConfigs, err := s.dbHandler.NotificationConfigsTable().GetNotificationConfigs(params)
+	if err != nil {
+		return sendDBReadError(ctx, err, "failed to get notification configs from db")
+	}
+	for i := range *notificationConfigs.Items {
+		(*notificationConfigs.Items)[i] = redactNotificationConfig((*notificationConfigs.Items)[i])
+	}
+	return sendResponse(ctx, http.StatusOK, notificationConfigs)
+}
+
+func (s *ServerImpl) GetNotificationConfigsNotificationConfigID(ctx echo.Context, notificationConfigID models.NotificationConfigID, params models.GetNotificationConfigsNotificationConfigIDParams) error {
+	nc, err := s.dbHandler.NotificationConfigsTable().GetNotificationConfig(notificationConfigID, params)
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("NotificationConfig with ID %v not found", notificationConfigID))
+		}
+		return sendDBReadError(ctx, err, fmt.Sprintf("failed to get notification config from db. notificationConfigID=%v", notificationConfigID))
+	}
+	return sendResponse(ctx, http.StatusOK, redactNotificationConfig(nc))
+}
+
+func (s *ServerImpl) PostNotificationConfigs(ctx echo.Context) error {
+	var notificationConfig models.NotificationConfig
+	err := ctx.Bind(&notificationConfig)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+
+	createdNotificationConfig, err := s.dbHandler.NotificationConfigsTable().CreateNotificationConfig(notificationConfig)
+	if err != nil {
+		var validationErr *common.BadRequestError
+		if errors.As(err, &validationErr) {
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to create notification config in db: %v", err))
+	}
+
+	return sendResponse(ctx, http.StatusCreated, redactNotificationConfig(createdNotificationConfig))
+}
+
+func (s *ServerImpl) DeleteNotificationConfigsNotificationConfigID(ctx echo.Context, notificationConfigID models.NotificationConfigID) error {
+	success := models.Success{
+		Message: utils.PointerTo(fmt.Sprintf("notification config %v deleted", notificationConfigID)),
+	}
+
+	if err := s.dbHandler.NotificationConfigsTable().DeleteNotificationConfig(notificationConfigID); err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("NotificationConfig with ID %v not found", notificationConfigID))
+		}
+		return sendError(ctx, http.StatusInternalServerError, err.Error())
+	}
+
+	return sendResponse(ctx, http.StatusOK, &success)
+}
+
+func (s *ServerImpl) PatchNotificationConfigsNotificationConfigID(ctx echo.Context, notificationConfigID models.NotificationConfigID, params models.PatchNotificationConfigsNotificationConfigIDParams) error {
+	var notificationConfig models.NotificationConfig
+	err := ctx.Bind(&notificationConfig)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+
+	// PATCH request might not contain the ID in the body, so set it from
+	// the URL field so that the DB layer knows which object is being updated.
+	if notificationConfig.Id != nil && *notificationConfig.Id != notificationConfigID {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("id in body %s does not match object %s to be updated", *notificationConfig.Id, notificationConfigID))
+	}
+	notificationConfig.Id = &notificationConfigID
+
+	updatedNotificationConfig, err := s.dbHandler.NotificationConfigsTable().UpdateNotificationConfig(notificationConfig, params)
+	if err != nil {
+		var validationErr *common.BadRequestError
+		var preconditionFailedErr *databaseTypes.PreconditionFailedError
+		switch true {
+		case errors.Is(err, databaseTypes.ErrNotFound):
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("NotificationConfig with ID %v not found", notificationConfigID))
+		case errors.As(err, &validationErr):
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		case errors.As(err, &preconditionFailedErr):
+			return sendError(ctx, http.StatusPreconditionFailed, err.Error())
+		default:
+			return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to update notification config in db. notificationConfigID=%v: %v", notificationConfigID, err))
+		}
+	}
+
+	return sendResponse(ctx, http.StatusOK, redactNotificationConfig(updatedNotificationConfig))
+}
+
+// redactNotificationConfig removes the signing secret which is never returned by the API.
+func redactNotificationConfig(notificationConfig models.NotificationConfig) models.NotificationConfig {
+	notificationConfig.Secret = nil
+	return notificationConfig
+}
@@ -0,0 +1,220 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const (
+	// operationRetention is how long a completed operation and its result
+	// are kept before they are removed.
+	operationRetention = time.Hour
+	// maxActiveOperations limits the number of queued and running operations.
+	maxActiveOperations = 10
+)
+
+var errTooManyOperations = errors.New("too many active operations")
+
+// operationFunc computes the result of an operation, the result is returned
+// as the response body of the operation result endpoint.
+type operationFunc func() (interface{}, error)
+
+type operation struct {
+	models.Operation
+	result []byte
+}
+
+// operations keeps track of the asynchronous operations started by
+// long-running endpoints. Operations are held in memory only, so they don't
+// survive a restart of the backend.
+type operations struct {
+	lock       sync.Mutex
+	items      map[string]*operation
+	retention  time.Duration
+	maxActive  int
+	timeNowUTC func() time.Time
+}
+
+func newOperations() *operations {
+	return &operations{
+		items:      make(map[string]*operation),
+		retention:  operationRetention,
+		maxActive:  maxActiveOperations,
+		timeNowUTC: func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Start queues a new operation of the given kind and runs fn in the
+// background. The returned operation is a snapshot of its initial state.
+func (o *operations) Start(kind models.OperationKind, fn operationFunc) (models.Operation, error) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	o.removeExpired()
+
+	active := 0
+	for _, op := range o.items {
+		if !isOperationCompleted(op.Operation) {
+			active++
+		}
+	}
+	if active >= o.maxActive {
+		return models.Operation{}, errTooManyOperations
+	}
+
+	id := uuid.NewString()
+	op := &operation{
+		Operation: models.Operation{
+			Id:        utils.PointerTo(id),
+			Kind:      utils.PointerTo(kind),
+			State:     utils.PointerTo(models.Queued),
+			CreatedAt: utils.PointerTo(o.timeNowUTC()),
+		},
+	}
+	o.items[id] = op
+
+	go o.run(id, fn)
+
+	return op.Operation, nil
+}
+
+// Get returns a snapshot of the operation and its result, the result is nil
+// unless the operation has succeeded.
+func (o *operations) Get(id string) (models.Operation, []byte, bool) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	o.removeExpired()
+
+	op, ok := o.items[id]
+	if !ok {
+		return models.Operation{}, nil, false
+	}
+	return op.Operation, op.result, true
+}
+
+func (o *operations) run(id string, fn operationFunc) {
+	o.update(id, func(op *operation) {
+		op.State = utils.PointerTo(models.Running)
+		op.StartedAt = utils.PointerTo(o.timeNowUTC())
+	})
+
+	result, err := fn()
+	var data []byte
+	if err == nil {
+		data, err = json.Marshal(result)
+		if err != nil {
+			err = fmt.Errorf("failed to marshal result: %w", err)
+		}
+	}
+
+	o.update(id, func(op *operation) {
+		now := o.timeNowUTC()
+		op.CompletedAt = utils.PointerTo(now)
+		op.ExpiresAt = utils.PointerTo(now.Add(o.retention))
+		if err != nil {
+			log.Errorf("Operation %s failed: %v", id, err)
+			op.State = utils.PointerTo(models.Errored)
+			op.Error = utils.PointerTo(err.Error())
+			return
+		}
+		op.State = utils.PointerTo(models.Succeeded)
+		op.ResultLink = utils.PointerTo(operationResultLink(id))
+		op.result = data
+	})
+}
+
+func (o *operations) update(id string, f func(op *operation)) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	if op, ok := o.items[id]; ok {
+		f(op)
+	}
+}
+
+// removeExpired must be called with the lock held.
+func (o *operations) removeExpired() {
+	now := o.timeNowUTC()
+	for id, op := range o.items {
+		if op.ExpiresAt != nil && !now.Before(*op.ExpiresAt) {
+			delete(o.items, id)
+		}
+	}
+}
+
+func isOperationCompleted(op models.Operation) bool {
+	state := utils.ValueOrZero(op.State)
+	return state == models.Succeeded || state == models.Errored
+}
+
+func operationLink(id string) string {
+	return fmt.Sprintf("%s/operations/%s", BaseURL, id)
+}
+
+func operationResultLink(id string) string {
+	return operationLink(id) + "/result"
+}
+
+// startOperation runs fn as an asynchronous operation and responds with 202
+// and the location of the operation.
+func (s *ServerImpl) startOperation(ctx echo.Context, kind models.OperationKind, fn operationFunc) error {
+	op, err := s.operations.Start(kind, fn)
+	if err != nil {
+		if errors.Is(err, errTooManyOperations) {
+			return sendError(ctx, http.StatusServiceUnavailable, fmt.Sprintf("failed to start %s operation: %v", kind, err))
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to start %s operation: %v", kind, err))
+	}
+
+	ctx.Response().Header().Set(echo.HeaderLocation, operationLink(*op.Id))
+	return sendResponse(ctx, http.StatusAccepted, op)
+}
+
+func (s *ServerImpl) GetOperationsOperationID(ctx echo.Context, operationID models.OperationID) error {
+	op, _, ok := s.operations.Get(operationID)
+	if !ok {
+		return sendError(ctx, http.StatusNotFound, fmt.Sprintf("Operation with ID %v not found", operationID))
+	}
+
+	return sendResponse(ctx, http.StatusOK, op)
+}
+
+// nolint:wrapcheck
+func (s *ServerImpl) GetOperationsOperationIDResult(ctx echo.Context, operationID models.OperationID) error {
+	op, result, ok := s.operations.Get(operationID)
+	if !ok {
+		return sendError(ctx, http.StatusNotFound, fmt.Sprintf("Operation with ID %v not found", operationID))
+	}
+	if utils.ValueOrZero(op.State) != models.Succeeded {
+		return sendResponse(ctx, http.StatusConflict, op)
+	}
+
+	return ctx.JSONBlob(http.StatusOK, result)
+}
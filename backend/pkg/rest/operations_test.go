@@ -0,0 +1,109 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func waitForOperation(t *testing.T, ops *operations, id string) (models.Operation, []byte) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		op, result, ok := ops.Get(id)
+		assert.Assert(t, ok)
+		if isOperationCompleted(op) {
+			return op, result
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("operation %s did not complete", id)
+	return models.Operation{}, nil
+}
+
+func TestOperations(t *testing.T) {
+	t.Run("succeeded", func(t *testing.T) {
+		ops := newOperations()
+		op, err := ops.Start(models.AdminUsage, func() (interface{}, error) {
+			return models.Usage{ScansThisMonth: utils.PointerTo(3)}, nil
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, *op.Kind, models.AdminUsage)
+		assert.Equal(t, *op.State, models.Queued)
+
+		op, result := waitForOperation(t, ops, *op.Id)
+		assert.Equal(t, *op.State, models.Succeeded)
+		assert.Equal(t, *op.ResultLink, "/api/operations/"+*op.Id+"/result")
+		assert.Assert(t, op.ExpiresAt != nil)
+		assert.Equal(t, string(result), `{"scansThisMonth":3}`)
+	})
+
+	t.Run("errored", func(t *testing.T) {
+		ops := newOperations()
+		op, err := ops.Start(models.AssetUpgradePlan, func() (interface{}, error) {
+			return nil, errors.New("boom")
+		})
+		assert.NilError(t, err)
+
+		op, result := waitForOperation(t, ops, *op.Id)
+		assert.Equal(t, *op.State, models.Errored)
+		assert.Equal(t, *op.Error, "boom")
+		assert.Assert(t, op.ResultLink == nil)
+		assert.Assert(t, result == nil)
+	})
+
+	t.Run("too many active operations", func(t *testing.T) {
+		ops := newOperations()
+		ops.maxActive = 1
+		release := make(chan struct{})
+		defer close(release)
+
+		_, err := ops.Start(models.AdminUsage, func() (interface{}, error) {
+			<-release
+			return nil, nil
+		})
+		assert.NilError(t, err)
+
+		_, err = ops.Start(models.AdminUsage, func() (interface{}, error) {
+			return nil, nil
+		})
+		assert.ErrorIs(t, err, errTooManyOperations)
+	})
+
+	t.Run("expired operations are removed", func(t *testing.T) {
+		ops := newOperations()
+		now := time.Now().UTC()
+		ops.timeNowUTC = func() time.Time { return now }
+
+		op, err := ops.Start(models.AdminUsage, func() (interface{}, error) {
+			return nil, nil
+		})
+		assert.NilError(t, err)
+		waitForOperation(t, ops, *op.Id)
+
+		now = now.Add(operationRetention)
+		_, _, ok := ops.Get(*op.Id)
+		assert.Assert(t, !ok)
+	})
+}
@@ -0,0 +1,170 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func (s *ServerImpl) GetAssetsAssetIDPackages(ctx echo.Context, assetID models.AssetID) error {
+	_, err := s.dbHandler.AssetsTable().GetAsset(assetID, models.GetAssetsAssetIDParams{
+		Select: utils.PointerTo("id"),
+	})
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("Asset with ID %v not found", assetID))
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get asset from db. assetID=%v: %v", assetID, err))
+	}
+
+	findings, err := s.dbHandler.FindingsTable().GetFindings(models.GetFindingsParams{
+		Filter: utils.PointerTo(fmt.Sprintf("findingInfo/objectType eq 'Package' and asset/id eq '%s'", assetID)),
+		Select: utils.PointerTo("foundOn,invalidatedOn,findingInfo/objectType,findingInfo/name,findingInfo/type,findingInfo/version,findingInfo/language,findingInfo/licenses"),
+	})
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get findings from db: %v", err))
+	}
+
+	var items []models.Finding
+	if findings.Items != nil {
+		items = *findings.Items
+	}
+	packages, err := buildPackageInventory(items)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to build package inventory: %v", err))
+	}
+
+	return sendResponse(ctx, http.StatusOK, models.InstalledPackages{Items: &packages})
+}
+
+type inventoryPackageKey struct {
+	name string
+	typ  string
+}
+
+type inventoryPackage struct {
+	pkg      models.InstalledPackage
+	foundOn  time.Time
+	versions map[string]*models.PackageVersionHistory
+}
+
+// buildPackageInventory deduplicates the package findings of an asset, as
+// every scan reports all the packages again, into the packages which are
+// currently installed. A version is installed as long as one of its findings
+// isn't invalidated by a newer scan. The details of a package are taken from
+// its most recently found finding.
+// nolint:cyclop
+func buildPackageInventory(findings []models.Finding) ([]models.InstalledPackage, error) {
+	packages := map[inventoryPackageKey]*inventoryPackage{}
+	for _, finding := range findings {
+		if finding.FindingInfo == nil || finding.FoundOn == nil {
+			continue
+		}
+		info, err := finding.FindingInfo.AsPackageFindingInfo()
+		if err != nil {
+			return nil, fmt.Errorf("unable to get package finding info: %w", err)
+		}
+
+		key := inventoryPackageKey{
+			name: utils.ValueOrZero(info.Name),
+			typ:  utils.ValueOrZero(info.Type),
+		}
+		p, ok := packages[key]
+		if !ok {
+			p = &inventoryPackage{
+				versions: map[string]*models.PackageVersionHistory{},
+			}
+			packages[key] = p
+		}
+		if !finding.FoundOn.Before(p.foundOn) {
+			p.pkg.Name = info.Name
+			p.pkg.Type = info.Type
+			p.pkg.Language = info.Language
+			p.pkg.Licenses = info.Licenses
+			p.foundOn = *finding.FoundOn
+		}
+
+		version := utils.ValueOrZero(info.Version)
+		history, ok := p.versions[version]
+		if !ok {
+			p.versions[version] = &models.PackageVersionHistory{
+				Version:      info.Version,
+				FirstFoundOn: finding.FoundOn,
+				RemovedOn:    finding.InvalidatedOn,
+			}
+			continue
+		}
+		if finding.FoundOn.Before(*history.FirstFoundOn) {
+			history.FirstFoundOn = finding.FoundOn
+		}
+		switch {
+		case history.RemovedOn == nil:
+			// Already installed.
+		case finding.InvalidatedOn == nil:
+			history.RemovedOn = nil
+		case finding.InvalidatedOn.After(*history.RemovedOn):
+			history.RemovedOn = finding.InvalidatedOn
+		}
+	}
+
+	inventory := make([]models.InstalledPackage, 0, len(packages))
+	for _, p := range packages {
+		installedVersions := []string{}
+		versionHistory := make([]models.PackageVersionHistory, 0, len(p.versions))
+		for version, history := range p.versions {
+			if history.RemovedOn == nil {
+				installedVersions = append(installedVersions, version)
+			}
+			versionHistory = append(versionHistory, *history)
+		}
+		// Packages which were removed aren't part of the inventory.
+		if len(installedVersions) == 0 {
+			continue
+		}
+
+		sort.Slice(installedVersions, func(i, j int) bool {
+			return utils.CompareVersions(installedVersions[i], installedVersions[j]) < 0
+		})
+		sort.Slice(versionHistory, func(i, j int) bool {
+			if !versionHistory[i].FirstFoundOn.Equal(*versionHistory[j].FirstFoundOn) {
+				return versionHistory[i].FirstFoundOn.Before(*versionHistory[j].FirstFoundOn)
+			}
+			return utils.CompareVersions(utils.ValueOrZero(versionHistory[i].Version), utils.ValueOrZero(versionHistory[j].Version)) < 0
+		})
+
+		p.pkg.InstalledVersions = &installedVersions
+		p.pkg.VersionHistory = &versionHistory
+		inventory = append(inventory, p.pkg)
+	}
+	sort.Slice(inventory, func(i, j int) bool {
+		if utils.ValueOrZero(inventory[i].Name) != utils.ValueOrZero(inventory[j].Name) {
+			return utils.ValueOrZero(inventory[i].Name) < utils.ValueOrZero(inventory[j].Name)
+		}
+		return utils.ValueOrZero(inventory[i].Type) < utils.ValueOrZero(inventory[j].Type)
+	})
+
+	return inventory, nil
+}
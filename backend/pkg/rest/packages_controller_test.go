@@ -0,0 +1,153 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"gotest.tools/v3/assert"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func newPackageFinding(t *testing.T, name, version string, licenses []string, foundOn time.Time, invalidatedOn *time.Time) models.Finding {
+	t.Helper()
+
+	findingInfo := models.Finding_FindingInfo{}
+	err := findingInfo.FromPackageFindingInfo(models.PackageFindingInfo{
+		ObjectType: "Package",
+		Name:       utils.PointerTo(name),
+		Type:       utils.PointerTo("deb"),
+		Version:    utils.PointerTo(version),
+		Licenses:   &licenses,
+	})
+	assert.NilError(t, err)
+
+	return models.Finding{
+		FindingInfo:   &findingInfo,
+		FoundOn:       &foundOn,
+		InvalidatedOn: invalidatedOn,
+	}
+}
+
+func Test_buildPackageInventory(t *testing.T) {
+	scan1 := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	scan2 := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+	scan3 := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		findings func(t *testing.T) []models.Finding
+		want     []models.InstalledPackage
+	}{
+		{
+			name: "no findings",
+			findings: func(t *testing.T) []models.Finding {
+				t.Helper()
+				return nil
+			},
+			want: []models.InstalledPackage{},
+		},
+		{
+			name: "packages are deduplicated across scans with their version history",
+			findings: func(t *testing.T) []models.Finding {
+				t.Helper()
+				return []models.Finding{
+					// openssl was upgraded by the second scan
+					newPackageFinding(t, "openssl", "1.1.1", []string{"OpenSSL"}, scan1, &scan2),
+					newPackageFinding(t, "openssl", "1.1.1t", []string{"Apache-2.0"}, scan2, &scan3),
+					newPackageFinding(t, "openssl", "1.1.1t", []string{"Apache-2.0"}, scan3, nil),
+					// curl is unchanged since the first scan
+					newPackageFinding(t, "curl", "7.74.0", []string{"curl"}, scan1, &scan2),
+					newPackageFinding(t, "curl", "7.74.0", []string{"curl"}, scan2, &scan3),
+					newPackageFinding(t, "curl", "7.74.0", []string{"curl"}, scan3, nil),
+					// wget was removed by the third scan
+					newPackageFinding(t, "wget", "1.21", []string{"GPL-3.0"}, scan2, &scan3),
+				}
+			},
+			want: []models.InstalledPackage{
+				{
+					Name:              utils.PointerTo("curl"),
+					Type:              utils.PointerTo("deb"),
+					Licenses:          &[]string{"curl"},
+					InstalledVersions: &[]string{"7.74.0"},
+					VersionHistory: &[]models.PackageVersionHistory{
+						{
+							Version:      utils.PointerTo("7.74.0"),
+							FirstFoundOn: &scan1,
+						},
+					},
+				},
+				{
+					Name:              utils.PointerTo("openssl"),
+					Type:              utils.PointerTo("deb"),
+					Licenses:          &[]string{"Apache-2.0"},
+					InstalledVersions: &[]string{"1.1.1t"},
+					VersionHistory: &[]models.PackageVersionHistory{
+						{
+							Version:      utils.PointerTo("1.1.1"),
+							FirstFoundOn: &scan1,
+							RemovedOn:    &scan2,
+						},
+						{
+							Version:      utils.PointerTo("1.1.1t"),
+							FirstFoundOn: &scan2,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "several versions of a package are installed",
+			findings: func(t *testing.T) []models.Finding {
+				t.Helper()
+				return []models.Finding{
+					newPackageFinding(t, "log4j", "2.17.1", nil, scan1, nil),
+					newPackageFinding(t, "log4j", "2.9.0", nil, scan1, nil),
+				}
+			},
+			want: []models.InstalledPackage{
+				{
+					Name:              utils.PointerTo("log4j"),
+					Type:              utils.PointerTo("deb"),
+					InstalledVersions: &[]string{"2.9.0", "2.17.1"},
+					VersionHistory: &[]models.PackageVersionHistory{
+						{
+							Version:      utils.PointerTo("2.9.0"),
+							FirstFoundOn: &scan1,
+						},
+						{
+							Version:      utils.PointerTo("2.17.1"),
+							FirstFoundOn: &scan1,
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildPackageInventory(tt.findings(t))
+			assert.NilError(t, err)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("buildPackageInventory() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+)
+
+func (s *ServerImpl) GetProviderOperations(ctx echo.Context, params models.GetProviderOperationsParams) error {
+	providerOperations, err := s.dbHandler.ProviderOperationsTable().GetProviderOperations(params)
+	if err != nil {
+		return sendDBReadError(ctx, err, "failed to get provider operations from db")
+	}
+	return sendResponse(ctx, http.StatusOK, providerOperations)
+}
+
+func (s *ServerImpl) GetProviderOperationsProviderOperationID(ctx echo.Context, providerOperationID models.ProviderOperationID, params models.GetProviderOperationsProviderOperationIDParams) error {
+	po, err := s.dbHandler.ProviderOperationsTable().GetProviderOperation(providerOperationID, params)
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("ProviderOperation with ID %v not found", providerOperationID))
+		}
+		return sendDBReadError(ctx, err, fmt.Sprintf("failed to get provider operation from db. providerOperationID=%v", providerOperationID))
+	}
+	return sendResponse(ctx, http.StatusOK, po)
+}
+
+func (s *ServerImpl) PostProviderOperations(ctx echo.Context) error {
+	var providerOperation models.ProviderOperation
+	err := ctx.Bind(&providerOperation)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+
+	createdProviderOperation, err := s.dbHandler.ProviderOperationsTable().CreateProviderOperation(providerOperation)
+	if err != nil {
+		var validationErr *common.BadRequestError
+		if errors.As(err, &validationErr) {
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to create provider operation in db: %v", err))
+	}
+
+	return sendResponse(ctx, http.StatusCreated, createdProviderOperation)
+}
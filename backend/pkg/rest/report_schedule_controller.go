@@ -0,0 +1,142 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/objectstore"
+	"github.com/openclarity/vmclarity/shared/pkg/report"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func (s *ServerImpl) GetReportSchedules(ctx echo.Context, params models.GetReportSchedulesParams) error {
+	reportSchedules, err := s.dbHandler.ReportSchedulesTable().GetReportSchedules(params)
+	if err != nil {
+		return sendDBReadError(ctx, err, "failed to get report schedules from db")
+	}
+	return sendResponse(ctx, http.StatusOK, reportSchedules)
+}
+
+func (s *ServerImpl) GetReportSchedulesReportScheduleID(ctx echo.Context, reportScheduleID models.ReportScheduleID, params models.GetReportSchedulesReportScheduleIDParams) error {
+	rs, err := s.dbHandler.ReportSchedulesTable().GetReportSchedule(reportScheduleID, params)
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("ReportSchedule with ID %v not found", reportScheduleID))
+		}
+		return sendDBReadError(ctx, err, fmt.Sprintf("failed to get report schedule from db. reportScheduleID=%v", reportScheduleID))
+	}
+	return sendResponse(ctx, http.StatusOK, rs)
+}
+
+func (s *ServerImpl) PostReportSchedules(ctx echo.Context) error {
+	var reportSchedule models.ReportSchedule
+	err := ctx.Bind(&reportSchedule)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+
+	createdReportSchedule, err := s.dbHandler.ReportSchedulesTable().CreateReportSchedule(reportSchedule)
+	if err != nil {
+		var validationErr *common.BadRequestError
+		if errors.As(err, &validationErr) {
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to create report schedule in db: %v", err))
+	}
+
+	return sendResponse(ctx, http.StatusCreated, createdReportSchedule)
+}
+
+func (s *ServerImpl) DeleteReportSchedulesReportScheduleID(ctx echo.Context, reportScheduleID models.ReportScheduleID) error {
+	success := models.Success{
+		Message: utils.PointerTo(fmt.Sprintf("report schedule %v deleted", reportScheduleID)),
+	}
+
+	if err := s.dbHandler.ReportSchedulesTable().DeleteReportSchedule(reportScheduleID); err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("ReportSchedule with ID %v not found", reportScheduleID))
+		}
+		return sendError(ctx, http.StatusInternalServerError, err.Error())
+	}
+
+	return sendResponse(ctx, http.StatusOK, &success)
+}
+
+func (s *ServerImpl) PatchReportSchedulesReportScheduleID(ctx echo.Context, reportScheduleID models.ReportScheduleID, params models.PatchReportSchedulesReportScheduleIDParams) error {
+	var reportSchedule models.ReportSchedule
+	err := ctx.Bind(&reportSchedule)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+
+	// PATCH request might not contain the ID in the body, so set it from
+	// the URL field so that the DB layer knows which object is being updated.
+	if reportSchedule.Id != nil && *reportSchedule.Id != reportScheduleID {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("id in body %s does not match object %s to be updated", *reportSchedule.Id, reportScheduleID))
+	}
+	reportSchedule.Id = &reportScheduleID
+
+	updatedReportSchedule, err := s.dbHandler.ReportSchedulesTable().UpdateReportSchedule(reportSchedule, params)
+	if err != nil {
+		var validationErr *common.BadRequestError
+		var preconditionFailedErr *databaseTypes.PreconditionFailedError
+		switch true {
+		case errors.Is(err, databaseTypes.ErrNotFound):
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("ReportSchedule with ID %v not found", reportScheduleID))
+		case errors.As(err, &validationErr):
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		case errors.As(err, &preconditionFailedErr):
+			return sendError(ctx, http.StatusPreconditionFailed, err.Error())
+		default:
+			return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to update report schedule in db. reportScheduleID=%v: %v", reportScheduleID, err))
+		}
+	}
+
+	return sendResponse(ctx, http.StatusOK, updatedReportSchedule)
+}
+
+func (s *ServerImpl) GetReportSchedulesReportScheduleIDReport(ctx echo.Context, reportScheduleID models.ReportScheduleID) error {
+	rs, err := s.dbHandler.ReportSchedulesTable().GetReportSchedule(reportScheduleID, models.GetReportSchedulesReportScheduleIDParams{})
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("ReportSchedule with ID %v not found", reportScheduleID))
+		}
+		return sendDBReadError(ctx, err, fmt.Sprintf("failed to get report schedule from db. reportScheduleID=%v", reportScheduleID))
+	}
+	if s.objectStore == nil || rs.LastDelivery == nil || rs.LastDelivery.ReportKey == nil {
+		return sendError(ctx, http.StatusNotFound, fmt.Sprintf("no report of ReportSchedule with ID %v is stored", reportScheduleID))
+	}
+
+	data, err := s.objectStore.Get(ctx.Request().Context(), *rs.LastDelivery.ReportKey)
+	if err != nil {
+		if errors.Is(err, objectstore.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("report of ReportSchedule with ID %v not found", reportScheduleID))
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get report from object store: %v", err))
+	}
+
+	ctx.Response().Header().Set(echo.HeaderContentDisposition,
+		fmt.Sprintf("attachment; filename=%q", report.SummaryFileName(rs.LastDelivery.Time)))
+	return ctx.Blob(http.StatusOK, "application/pdf", data)
+}
@@ -0,0 +1,81 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"gotest.tools/v3/assert"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/database/gorm"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/objectstore"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestServerImpl_GetReportSchedulesReportScheduleIDReport(t *testing.T) {
+	db, err := gorm.NewDatabase(databaseTypes.DBConfig{
+		DriverType:  databaseTypes.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.sqlite"),
+	})
+	assert.NilError(t, err)
+	store, err := objectstore.NewFilesystemStore(t.TempDir())
+	assert.NilError(t, err)
+
+	reportSchedule, err := db.ReportSchedulesTable().CreateReportSchedule(models.ReportSchedule{
+		Name:       utils.PointerTo("weekly"),
+		Recipients: &[]string{"security@example.com"},
+		CronLine:   utils.PointerTo("0 8 * * 1"),
+	})
+	assert.NilError(t, err)
+
+	s := &ServerImpl{dbHandler: db, objectStore: store}
+	get := func() *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		assert.NilError(t, s.GetReportSchedulesReportScheduleIDReport(ctx, *reportSchedule.Id))
+		return rec
+	}
+
+	// Nothing was delivered yet.
+	rec := get()
+	assert.Equal(t, rec.Code, http.StatusNotFound, rec.Body.String())
+
+	key, err := store.Put(context.Background(), []byte("%PDF-1.4"))
+	assert.NilError(t, err)
+	_, err = db.ReportSchedulesTable().UpdateReportSchedule(models.ReportSchedule{
+		Id: reportSchedule.Id,
+		LastDelivery: &models.ReportDelivery{
+			Time:      time.Date(2023, 6, 12, 8, 0, 0, 0, time.UTC),
+			State:     models.ReportDeliveryStateDelivered,
+			ReportKey: &key,
+		},
+	}, models.PatchReportSchedulesReportScheduleIDParams{})
+	assert.NilError(t, err)
+
+	rec = get()
+	assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+	assert.Equal(t, rec.Header().Get(echo.HeaderContentType), "application/pdf")
+	assert.Equal(t, rec.Header().Get(echo.HeaderContentDisposition), `attachment; filename="executive-summary-2023-06-12.pdf"`)
+	assert.Equal(t, rec.Body.String(), "%PDF-1.4")
+}
@@ -0,0 +1,170 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	"github.com/openclarity/vmclarity/backend/pkg/sbomscan"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const sbomTargetObjectType = "SBOMInfo"
+
+func (s *ServerImpl) PostSboms(ctx echo.Context, params models.PostSbomsParams) error {
+	data, err := io.ReadAll(ctx.Request().Body)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to read request body: %v", err))
+	}
+
+	bom, err := sbomscan.Decode(data)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, err.Error())
+	}
+
+	info := models.SBOMInfo{
+		ObjectType: sbomTargetObjectType,
+		Name:       params.Name,
+		Version:    params.Version,
+		Location:   params.Location,
+	}
+	return s.startOperation(ctx, models.SbomUpload, func() (interface{}, error) {
+		return s.scanSBOM(context.Background(), info, bom)
+	})
+}
+
+// scanSBOM scans the SBOM and records the results in a completed Scan of the
+// SBOM target, the findings are then created from the ScanResult by the
+// orchestrator like for any other scan.
+func (s *ServerImpl) scanSBOM(ctx context.Context, info models.SBOMInfo, bom *cdx.BOM) (models.AssetScanResult, error) {
+	target, err := s.getOrCreateSBOMTarget(info)
+	if err != nil {
+		return models.AssetScanResult{}, err
+	}
+
+	startTime := time.Now()
+	results, scanErr := s.sbomScanner.Scan(ctx, bom)
+	endTime := time.Now()
+
+	scanResult := newSBOMScanResult(results, scanErr, endTime)
+	scan, err := s.dbHandler.ScansTable().CreateScan(models.Scan{
+		StartTime:    &startTime,
+		EndTime:      &endTime,
+		AssetIDs:     &[]string{*target.Id},
+		State:        utils.PointerTo(models.ScanStateDone),
+		StateReason:  utils.PointerTo(models.ScanStateReasonSuccess),
+		StateMessage: utils.PointerTo(fmt.Sprintf("SBOM of %s was scanned", info.Name)),
+		Summary: &models.ScanSummary{
+			JobsCompleted:        utils.PointerTo(1),
+			JobsLeftToRun:        utils.PointerTo(0),
+			TotalPackages:        scanResult.Summary.TotalPackages,
+			TotalVulnerabilities: scanResult.Summary.TotalVulnerabilities,
+		},
+	})
+	if err != nil {
+		return models.AssetScanResult{}, fmt.Errorf("failed to create scan in db: %w", err)
+	}
+	s.notifyScanStateChange("", scan)
+
+	scanResult.Scan = &models.ScanRelationship{Id: *scan.Id}
+	scanResult.Asset = &models.AssetRelationship{Id: *target.Id}
+	createdScanResult, err := s.dbHandler.ScanResultsTable().CreateScanResult(scanResult)
+	if err != nil {
+		return models.AssetScanResult{}, fmt.Errorf("failed to create scan result in db: %w", err)
+	}
+
+	return createdScanResult, nil
+}
+
+// getOrCreateSBOMTarget returns the target of the SBOM, the same target is
+// used for all the uploads with the same name and version.
+func (s *ServerImpl) getOrCreateSBOMTarget(info models.SBOMInfo) (models.Asset, error) {
+	var targetInfo models.AssetType
+	if err := targetInfo.FromSBOMInfo(info); err != nil {
+		return models.Asset{}, fmt.Errorf("failed to create target info: %w", err)
+	}
+
+	target, err := s.dbHandler.AssetsTable().CreateAsset(models.Asset{
+		AssetInfo: &targetInfo,
+	})
+	if err != nil {
+		var conflictErr *common.ConflictError
+		if !errors.As(err, &conflictErr) {
+			return models.Asset{}, fmt.Errorf("failed to create target in db: %w", err)
+		}
+	}
+
+	return target, nil
+}
+
+// newSBOMScanResult returns a completed ScanResult of the SBOM and
+// vulnerabilities families, the vulnerabilities family is reported as failed
+// if the SBOM couldn't be scanned for vulnerabilities.
+func newSBOMScanResult(results sbomscan.Results, scanErr error, completedTime time.Time) models.AssetScanResult {
+	summary := &models.ScanFindingsSummary{}
+	vulnerabilitiesErrors := []string{}
+	if scanErr != nil {
+		vulnerabilitiesErrors = append(vulnerabilitiesErrors, scanErr.Error())
+	}
+	if results.Sbom != nil && results.Sbom.Packages != nil {
+		summary.TotalPackages = utils.PointerTo(len(*results.Sbom.Packages))
+	}
+	if results.Vulnerabilities != nil {
+		summary.TotalVulnerabilities = utils.GetVulnerabilityTotalsPerSeverity(results.Vulnerabilities.Vulnerabilities)
+	}
+
+	doneState := func(errs []string) *models.AssetScanState {
+		return &models.AssetScanState{
+			State:              utils.PointerTo(models.AssetScanStateStateDone),
+			LastTransitionTime: &completedTime,
+			Errors:             &errs,
+		}
+	}
+	notScannedState := func() *models.AssetScanState {
+		return &models.AssetScanState{
+			State: utils.PointerTo(models.AssetScanStateStateNotScanned),
+		}
+	}
+
+	return models.AssetScanResult{
+		Sboms:           results.Sbom,
+		Vulnerabilities: results.Vulnerabilities,
+		Summary:         summary,
+		Status: &models.AssetScanStatus{
+			General:           doneState([]string{}),
+			Sbom:              doneState([]string{}),
+			Vulnerabilities:   doneState(vulnerabilitiesErrors),
+			Certificates:      notScannedState(),
+			Exploits:          notScannedState(),
+			Malware:           notScannedState(),
+			Misconfigurations: notScannedState(),
+			Rootkits:          notScannedState(),
+			Secrets:           notScannedState(),
+		},
+		// Nothing was provisioned to scan the SBOM.
+		ResourceCleanup: utils.PointerTo(models.ResourceCleanupStateSkipped),
+	}
+}
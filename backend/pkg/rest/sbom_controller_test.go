@@ -0,0 +1,68 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/sbomscan"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func Test_newSBOMScanResult(t *testing.T) {
+	completedTime := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	results := sbomscan.Results{
+		Sbom: &models.SbomScan{
+			Packages: &[]models.Package{
+				{Name: utils.PointerTo("lodash")},
+				{Name: utils.PointerTo("express")},
+			},
+		},
+		Vulnerabilities: &models.VulnerabilityScan{
+			Vulnerabilities: &[]models.Vulnerability{
+				{VulnerabilityName: utils.PointerTo("CVE-2021-23337"), Severity: utils.PointerTo(models.HIGH)},
+			},
+		},
+	}
+
+	t.Run("scanned", func(t *testing.T) {
+		got := newSBOMScanResult(results, nil, completedTime)
+
+		assert.Equal(t, *got.Summary.TotalPackages, 2)
+		assert.Equal(t, *got.Summary.TotalVulnerabilities.TotalHighVulnerabilities, 1)
+		assert.Equal(t, *got.Status.General.State, models.AssetScanStateStateDone)
+		assert.Equal(t, *got.Status.Sbom.State, models.AssetScanStateStateDone)
+		assert.Equal(t, *got.Status.Vulnerabilities.State, models.AssetScanStateStateDone)
+		assert.Equal(t, len(*got.Status.Vulnerabilities.Errors), 0)
+		assert.Equal(t, *got.Status.Vulnerabilities.LastTransitionTime, completedTime)
+		assert.Equal(t, *got.Status.Malware.State, models.AssetScanStateStateNotScanned)
+		assert.Equal(t, *got.ResourceCleanup, models.ResourceCleanupStateSkipped)
+	})
+
+	t.Run("vulnerability scan failed", func(t *testing.T) {
+		got := newSBOMScanResult(sbomscan.Results{Sbom: results.Sbom}, errors.New("grype is unavailable"), completedTime)
+
+		assert.Equal(t, *got.Summary.TotalPackages, 2)
+		assert.Assert(t, got.Summary.TotalVulnerabilities == nil)
+		assert.Equal(t, len(*got.Status.Sbom.Errors), 0)
+		assert.DeepEqual(t, *got.Status.Vulnerabilities.Errors, []string{"grype is unavailable"})
+	})
+}
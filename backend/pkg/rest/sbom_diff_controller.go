@@ -0,0 +1,81 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func (s *ServerImpl) GetAssetsAssetIDSbomDiff(ctx echo.Context, assetID models.AssetID, params models.GetAssetsAssetIDSbomDiffParams) error {
+	_, err := s.dbHandler.AssetsTable().GetAsset(assetID, models.GetAssetsAssetIDParams{
+		Select: utils.PointerTo("id"),
+	})
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("Asset with ID %v not found", assetID))
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get asset from db. assetID=%v: %v", assetID, err))
+	}
+
+	base, err := s.getAssetScanResult(assetID, params.Base, "id,sboms/packages")
+	if err != nil {
+		return sendScanResultDiffError(ctx, err)
+	}
+	head, err := s.getAssetScanResult(assetID, params.Head, "id,sboms/packages")
+	if err != nil {
+		return sendScanResultDiffError(ctx, err)
+	}
+
+	return sendResponse(ctx, http.StatusOK, createSbomDiff(base, head))
+}
+
+// createSbomDiff compares the packages of the head scan result with the ones
+// of the base scan result. The packages found by both in different versions
+// are upgraded, unless the version of the head scan result is lower.
+func createSbomDiff(base, head models.AssetScanResult) models.SbomDiff {
+	packages := diffItems(getPackages(base), getPackages(head), packageDiffKey, nil)
+
+	upgraded := []models.PackageChange{}
+	downgraded := []models.PackageChange{}
+	for _, change := range packages.changed {
+		packageChange := models.PackageChange{
+			Base:    utils.PointerTo(change[0]),
+			Compare: utils.PointerTo(change[1]),
+		}
+		if utils.CompareVersions(utils.ValueOrZero(change[0].Version), utils.ValueOrZero(change[1].Version)) > 0 {
+			downgraded = append(downgraded, packageChange)
+		} else {
+			upgraded = append(upgraded, packageChange)
+		}
+	}
+
+	return models.SbomDiff{
+		BaseScanResultID: base.Id,
+		HeadScanResultID: head.Id,
+		Added:            &packages.added,
+		Removed:          &packages.removed,
+		Upgraded:         &upgraded,
+		Downgraded:       &downgraded,
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func Test_createSbomDiff(t *testing.T) {
+	base := newDiffScanResult("base", nil,
+		[]models.Package{
+			newDiffPackage("openssl", "1.1.1"),
+			newDiffPackage("zlib", "1.2.11"),
+			newDiffPackage("curl", "7.0"),
+			newDiffPackage("glibc", "2.36"),
+		},
+		nil,
+	)
+	head := newDiffScanResult("head", nil,
+		[]models.Package{
+			newDiffPackage("openssl", "3.0.2"),
+			newDiffPackage("zlib", "1.2.11"),
+			newDiffPackage("glibc", "2.31"),
+			newDiffPackage("sudo", "1.9"),
+		},
+		nil,
+	)
+
+	got := createSbomDiff(base, head)
+
+	assert.DeepEqual(t, got, models.SbomDiff{
+		BaseScanResultID: utils.PointerTo("base"),
+		HeadScanResultID: utils.PointerTo("head"),
+		Added: &[]models.Package{
+			newDiffPackage("sudo", "1.9"),
+		},
+		Removed: &[]models.Package{
+			newDiffPackage("curl", "7.0"),
+		},
+		Upgraded: &[]models.PackageChange{
+			{
+				Base:    utils.PointerTo(newDiffPackage("openssl", "1.1.1")),
+				Compare: utils.PointerTo(newDiffPackage("openssl", "3.0.2")),
+			},
+		},
+		Downgraded: &[]models.PackageChange{
+			{
+				Base:    utils.PointerTo(newDiffPackage("glibc", "2.36")),
+				Compare: utils.PointerTo(newDiffPackage("glibc", "2.31")),
+			},
+		},
+	})
+}
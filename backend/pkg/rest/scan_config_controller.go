@@ -19,19 +19,28 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 
 	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/backend/pkg/common"
 	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/scanconfigwatcher"
+	"github.com/openclarity/vmclarity/shared/pkg/report"
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
 func (s *ServerImpl) GetScanConfigs(ctx echo.Context, params models.GetScanConfigsParams) error {
+	if acceptsNDJSON(ctx) {
+		return sendNDJSONStream(ctx, func(fn func(models.ScanConfig) error) error {
+			return s.dbHandler.ScanConfigsTable().StreamScanConfigs(params, fn)
+		})
+	}
+
 	scanConfigs, err := s.dbHandler.ScanConfigsTable().GetScanConfigs(params)
 	if err != nil {
-		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scan configs from db: %v", err))
+		return sendDBReadError(ctx, err, "failed to get scan configs from db")
 	}
 	return sendResponse(ctx, http.StatusOK, scanConfigs)
 }
@@ -42,9 +51,9 @@ func (s *ServerImpl) GetScanConfigsScanConfigID(ctx echo.Context, scanConfigID m
 		if errors.Is(err, databaseTypes.ErrNotFound) {
 			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("ScanConfig with ID %v not found", scanConfigID))
 		}
-		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scan config from db. scanConfigID=%v: %v", scanConfigID, err))
+		return sendDBReadError(ctx, err, fmt.Sprintf("failed to get scan config from db. scanConfigID=%v", scanConfigID))
 	}
-	return sendResponse(ctx, http.StatusOK, sc)
+	return sendResponseWithETag(ctx, params.IfNoneMatch, sc)
 }
 
 func (s *ServerImpl) PostScanConfigs(ctx echo.Context) error {
@@ -91,6 +100,10 @@ func (s *ServerImpl) DeleteScanConfigsScanConfigID(ctx echo.Context, scanConfigI
 }
 
 func (s *ServerImpl) PatchScanConfigsScanConfigID(ctx echo.Context, scanConfigID models.ScanConfigID, params models.PatchScanConfigsScanConfigIDParams) error {
+	if isJSONPatch(ctx) {
+		return s.jsonPatchScanConfig(ctx, scanConfigID, params)
+	}
+
 	var scanConfig models.ScanConfig
 	err := ctx.Bind(&scanConfig)
 	if err != nil {
@@ -137,6 +150,10 @@ func (s *ServerImpl) PutScanConfigsScanConfigID(ctx echo.Context, scanConfigID m
 		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
 	}
 
+	return s.saveScanConfig(ctx, scanConfigID, scanConfig, params)
+}
+
+func (s *ServerImpl) saveScanConfig(ctx echo.Context, scanConfigID models.ScanConfigID, scanConfig models.ScanConfig, params models.PutScanConfigsScanConfigIDParams) error {
 	// PUT request might not contain the ID in the body, so set it from the
 	// URL field so that the DB layer knows which object is being updated.
 	if scanConfig.Id != nil && *scanConfig.Id != scanConfigID {
@@ -169,3 +186,69 @@ func (s *ServerImpl) PutScanConfigsScanConfigID(ctx echo.Context, scanConfigID m
 
 	return sendResponse(ctx, http.StatusOK, updatedScanConfig)
 }
+
+// jsonPatchScanConfig applies the JSON Patch of the request to the scan
+// config and replaces the scan config with the result.
+func (s *ServerImpl) jsonPatchScanConfig(ctx echo.Context, scanConfigID models.ScanConfigID, params models.PatchScanConfigsScanConfigIDParams) error {
+	scanConfig, err := s.dbHandler.ScanConfigsTable().GetScanConfig(scanConfigID, models.GetScanConfigsScanConfigIDParams{})
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("ScanConfig with ID %v not found", scanConfigID))
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scan config from db. scanConfigID=%v: %v", scanConfigID, err))
+	}
+
+	var patched models.ScanConfig
+	if err := applyJSONPatch(ctx, scanConfig, &patched); err != nil {
+		return sendJSONPatchError(ctx, err)
+	}
+
+	return s.saveScanConfig(ctx, scanConfigID, patched, models.PutScanConfigsScanConfigIDParams{
+		IfMatch: jsonPatchIfMatch(params.IfMatch, scanConfig.Revision),
+	})
+}
+
+const (
+	defaultScanConfigNextRuns = 5
+	maxScanConfigNextRuns     = 100
+)
+
+func (s *ServerImpl) GetScanConfigsScanConfigIDNextRuns(ctx echo.Context, scanConfigID models.ScanConfigID, params models.GetScanConfigsScanConfigIDNextRunsParams) error {
+	count := defaultScanConfigNextRuns
+	if params.Count != nil {
+		count = *params.Count
+	}
+	if count < 1 || count > maxScanConfigNextRuns {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("count must be between 1 and %d", maxScanConfigNextRuns))
+	}
+
+	sc, err := s.dbHandler.ScanConfigsTable().GetScanConfig(scanConfigID, models.GetScanConfigsScanConfigIDParams{})
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("ScanConfig with ID %v not found", scanConfigID))
+		}
+		return sendDBReadError(ctx, err, fmt.Sprintf("failed to get scan config from db. scanConfigID=%v", scanConfigID))
+	}
+
+	var timezone string
+	if sc.Scheduled != nil {
+		timezone = utils.ValueOrZero(sc.Scheduled.Timezone)
+	}
+	loc, err := report.LoadTimezone(timezone)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, err.Error())
+	}
+
+	nextRuns := []time.Time{}
+	if !utils.ValueOrZero(sc.Disabled) {
+		nextRuns, err = scanconfigwatcher.NextOperationTimes(sc.Scheduled, time.Now(), count)
+		if err != nil {
+			return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to calculate next runs: %v", err))
+		}
+	}
+
+	return sendResponse(ctx, http.StatusOK, models.ScanConfigNextRuns{
+		Timezone: loc.String(),
+		NextRuns: nextRuns,
+	})
+}
@@ -0,0 +1,136 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func (s *ServerImpl) GetScanConfigTemplates(ctx echo.Context, params models.GetScanConfigTemplatesParams) error {
+	templates, err := s.dbHandler.ScanConfigTemplatesTable().GetScanConfigTemplates(params)
+	if err != nil {
+		return sendDBReadError(ctx, err, "failed to get scan config templates from db")
+	}
+	return sendResponse(ctx, http.StatusOK, templates)
+}
+
+func (s *ServerImpl) GetScanConfigTemplatesScanConfigTemplateID(ctx echo.Context, scanConfigTemplateID models.ScanConfigTemplateID, params models.GetScanConfigTemplatesScanConfigTemplateIDParams) error {
+	template, err := s.dbHandler.ScanConfigTemplatesTable().GetScanConfigTemplate(scanConfigTemplateID, params)
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("ScanConfigTemplate with ID %v not found", scanConfigTemplateID))
+		}
+		return sendDBReadError(ctx, err, fmt.Sprintf("failed to get scan config template from db. scanConfigTemplateID=%v", scanConfigTemplateID))
+	}
+	return sendResponse(ctx, http.StatusOK, template)
+}
+
+func (s *ServerImpl) PostScanConfigTemplates(ctx echo.Context) error {
+	var template models.ScanConfigTemplate
+	err := ctx.Bind(&template)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+
+	createdTemplate, err := s.dbHandler.ScanConfigTemplatesTable().CreateScanConfigTemplate(template)
+	if err != nil {
+		var conflictErr *common.ConflictError
+		var validationErr *common.BadRequestError
+		switch true {
+		case errors.As(err, &conflictErr):
+			existResponse := &models.ScanConfigTemplateExists{
+				Message:            utils.PointerTo(conflictErr.Reason),
+				ScanConfigTemplate: &createdTemplate,
+			}
+			return sendResponse(ctx, http.StatusConflict, existResponse)
+		case errors.As(err, &validationErr):
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		default:
+			return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to create scan config template in db: %v", err))
+		}
+	}
+
+	return sendResponse(ctx, http.StatusCreated, createdTemplate)
+}
+
+func (s *ServerImpl) PatchScanConfigTemplatesScanConfigTemplateID(ctx echo.Context, scanConfigTemplateID models.ScanConfigTemplateID, params models.PatchScanConfigTemplatesScanConfigTemplateIDParams) error {
+	var template models.ScanConfigTemplate
+	err := ctx.Bind(&template)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+
+	// PATCH request might not contain the ID in the body, so set it from
+	// the URL field so that the DB layer knows which object is being updated.
+	if template.Id != nil && *template.Id != scanConfigTemplateID {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("id in body %s does not match object %s to be updated", *template.Id, scanConfigTemplateID))
+	}
+	template.Id = &scanConfigTemplateID
+
+	updatedTemplate, err := s.dbHandler.ScanConfigTemplatesTable().UpdateScanConfigTemplate(template, params)
+	if err != nil {
+		var validationErr *common.BadRequestError
+		var conflictErr *common.ConflictError
+		var preconditionFailedErr *databaseTypes.PreconditionFailedError
+		switch true {
+		case errors.Is(err, databaseTypes.ErrNotFound):
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("ScanConfigTemplate with ID %v not found", scanConfigTemplateID))
+		case errors.As(err, &conflictErr):
+			existResponse := &models.ScanConfigTemplateExists{
+				Message:            utils.PointerTo(conflictErr.Reason),
+				ScanConfigTemplate: &updatedTemplate,
+			}
+			return sendResponse(ctx, http.StatusConflict, existResponse)
+		case errors.As(err, &validationErr):
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		case errors.As(err, &preconditionFailedErr):
+			return sendError(ctx, http.StatusPreconditionFailed, err.Error())
+		default:
+			return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to update scan config template in db. scanConfigTemplateID=%v: %v", scanConfigTemplateID, err))
+		}
+	}
+
+	return sendResponse(ctx, http.StatusOK, updatedTemplate)
+}
+
+func (s *ServerImpl) DeleteScanConfigTemplatesScanConfigTemplateID(ctx echo.Context, scanConfigTemplateID models.ScanConfigTemplateID) error {
+	success := models.Success{
+		Message: utils.PointerTo(fmt.Sprintf("scan config template %v deleted", scanConfigTemplateID)),
+	}
+
+	if err := s.dbHandler.ScanConfigTemplatesTable().DeleteScanConfigTemplate(scanConfigTemplateID); err != nil {
+		var conflictErr *common.ConflictError
+		switch true {
+		case errors.Is(err, databaseTypes.ErrNotFound):
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("ScanConfigTemplate with ID %v not found", scanConfigTemplateID))
+		case errors.As(err, &conflictErr):
+			return sendError(ctx, http.StatusConflict, err.Error())
+		default:
+			return sendError(ctx, http.StatusInternalServerError, err.Error())
+		}
+	}
+
+	return sendResponse(ctx, http.StatusOK, &success)
+}
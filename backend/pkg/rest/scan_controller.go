@@ -25,13 +25,20 @@ import (
 	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/backend/pkg/common"
 	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/backend/pkg/notifications"
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
 func (s *ServerImpl) GetScans(ctx echo.Context, params models.GetScansParams) error {
+	if acceptsNDJSON(ctx) {
+		return sendNDJSONStream(ctx, func(fn func(models.Scan) error) error {
+			return s.dbHandler.ScansTable().StreamScans(params, fn)
+		})
+	}
+
 	scans, err := s.dbHandler.ScansTable().GetScans(params)
 	if err != nil {
-		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scans from db: %v", err))
+		return sendDBReadError(ctx, err, "failed to get scans from db")
 	}
 
 	return sendResponse(ctx, http.StatusOK, scans)
@@ -44,6 +51,21 @@ func (s *ServerImpl) PostScans(ctx echo.Context) error {
 		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
 	}
 
+	reason, err := s.checkScanQuota()
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to check scan quota: %v", err))
+	}
+	if utils.ValueOrZero(scan.DryRun) {
+		// A dry run reports the quota check in its plan instead of being rejected by it.
+		if reason != "" {
+			scan.Plan = &models.ScanPlan{
+				QuotaExceededReason: utils.PointerTo(reason),
+			}
+		}
+	} else if reason != "" {
+		return sendError(ctx, http.StatusTooManyRequests, fmt.Sprintf("scan quota exceeded: %s", reason))
+	}
+
 	createdScan, err := s.dbHandler.ScansTable().CreateScan(scan)
 	if err != nil {
 		var conflictErr *common.ConflictError
@@ -62,6 +84,8 @@ func (s *ServerImpl) PostScans(ctx echo.Context) error {
 		}
 	}
 
+	s.notifyScanStateChange("", createdScan)
+
 	return sendResponse(ctx, http.StatusCreated, createdScan)
 }
 
@@ -86,12 +110,16 @@ func (s *ServerImpl) GetScansScanID(ctx echo.Context, scanID models.ScanID, para
 		if errors.Is(err, databaseTypes.ErrNotFound) {
 			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("Scan with ID %v not found", scanID))
 		}
-		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scan from db. id=%v: %v", scanID, err))
+		return sendDBReadError(ctx, err, fmt.Sprintf("failed to get scan from db. id=%v", scanID))
 	}
-	return sendResponse(ctx, http.StatusOK, scan)
+	return sendResponseWithETag(ctx, params.IfNoneMatch, scan)
 }
 
 func (s *ServerImpl) PatchScansScanID(ctx echo.Context, scanID models.ScanID, params models.PatchScansScanIDParams) error {
+	if isJSONPatch(ctx) {
+		return s.jsonPatchScan(ctx, scanID, params)
+	}
+
 	var scan models.Scan
 	err := ctx.Bind(&scan)
 	if err != nil {
@@ -105,10 +133,16 @@ func (s *ServerImpl) PatchScansScanID(ctx echo.Context, scanID models.ScanID, pa
 	}
 	scan.Id = &scanID
 
+	var oldState models.ScanState
+	var stateKnown bool
+	if scan.State != nil {
+		oldState, stateKnown = s.getScanState(scanID)
+	}
+
 	updatedScan, err := s.dbHandler.ScansTable().UpdateScan(scan, params)
 	if err != nil {
 		var validationErr *common.BadRequestError
-		var conflictErr *common.BadRequestError
+		var conflictErr *common.ConflictError
 		var preconditionFailedErr *databaseTypes.PreconditionFailedError
 		switch true {
 		case errors.Is(err, databaseTypes.ErrNotFound):
@@ -128,6 +162,10 @@ func (s *ServerImpl) PatchScansScanID(ctx echo.Context, scanID models.ScanID, pa
 		}
 	}
 
+	if stateKnown {
+		s.notifyScanStateChange(oldState, updatedScan)
+	}
+
 	return sendResponse(ctx, http.StatusOK, updatedScan)
 }
 
@@ -138,6 +176,10 @@ func (s *ServerImpl) PutScansScanID(ctx echo.Context, scanID models.ScanID, para
 		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
 	}
 
+	return s.saveScan(ctx, scanID, scan, params)
+}
+
+func (s *ServerImpl) saveScan(ctx echo.Context, scanID models.ScanID, scan models.Scan, params models.PutScansScanIDParams) error {
 	// PUT request might not contain the ID in the body, so set it from the
 	// URL field so that the DB layer knows which object is being updated.
 	if scan.Id != nil && *scan.Id != scanID {
@@ -145,6 +187,8 @@ func (s *ServerImpl) PutScansScanID(ctx echo.Context, scanID models.ScanID, para
 	}
 	scan.Id = &scanID
 
+	oldState, stateKnown := s.getScanState(scanID)
+
 	updatedScan, err := s.dbHandler.ScansTable().SaveScan(scan, params)
 	if err != nil {
 		var validationErr *common.BadRequestError
@@ -168,5 +212,57 @@ func (s *ServerImpl) PutScansScanID(ctx echo.Context, scanID models.ScanID, para
 		}
 	}
 
+	if stateKnown {
+		s.notifyScanStateChange(oldState, updatedScan)
+	}
+
 	return sendResponse(ctx, http.StatusOK, updatedScan)
 }
+
+// jsonPatchScan applies the JSON Patch of the request to the scan and
+// replaces the scan with the result.
+func (s *ServerImpl) jsonPatchScan(ctx echo.Context, scanID models.ScanID, params models.PatchScansScanIDParams) error {
+	scan, err := s.dbHandler.ScansTable().GetScan(scanID, models.GetScansScanIDParams{})
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("Scan with ID %v not found", scanID))
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scan from db. scanID=%v: %v", scanID, err))
+	}
+
+	var patched models.Scan
+	if err := applyJSONPatch(ctx, scan, &patched); err != nil {
+		return sendJSONPatchError(ctx, err)
+	}
+
+	return s.saveScan(ctx, scanID, patched, models.PutScansScanIDParams{
+		IfMatch: jsonPatchIfMatch(params.IfMatch, scan.Revision),
+	})
+}
+
+// getScanState returns the current state of the Scan if there are webhooks to
+// notify about its changes.
+func (s *ServerImpl) getScanState(scanID models.ScanID) (models.ScanState, bool) {
+	if s.notifier == nil {
+		return "", false
+	}
+
+	scan, err := s.dbHandler.ScansTable().GetScan(scanID, models.GetScansScanIDParams{
+		Select: utils.PointerTo("state"),
+	})
+	if err != nil {
+		return "", false
+	}
+
+	return utils.ValueOrZero(scan.State), true
+}
+
+func (s *ServerImpl) notifyScanStateChange(oldState models.ScanState, scan models.Scan) {
+	if utils.ValueOrZero(scan.DryRun) {
+		return
+	}
+
+	if eventType, ok := notifications.ScanEvent(oldState, utils.ValueOrZero(scan.State)); ok {
+		s.notifier.Notify(eventType, &scan, nil)
+	}
+}
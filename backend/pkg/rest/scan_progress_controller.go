@@ -0,0 +1,156 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func (s *ServerImpl) GetScansScanIDProgress(ctx echo.Context, scanID models.ScanID) error {
+	scan, err := s.dbHandler.ScansTable().GetScan(scanID, models.GetScansScanIDParams{
+		Select: utils.PointerTo("id,assetIDs"),
+	})
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("Scan with ID %v not found", scanID))
+		}
+		return sendDBReadError(ctx, err, fmt.Sprintf("failed to get scan from db. id=%v", scanID))
+	}
+
+	scanResults, err := s.dbHandler.ScanResultsTable().GetScanResults(models.GetScanResultsParams{
+		Filter: utils.PointerTo(fmt.Sprintf("scan/id eq '%s'", scanID)),
+		Select: utils.PointerTo("id,status"),
+	})
+	if err != nil {
+		return sendDBReadError(ctx, err, fmt.Sprintf("failed to get scan results from db. scanID=%v", scanID))
+	}
+
+	var results []models.AssetScanResult
+	if scanResults.Items != nil {
+		results = *scanResults.Items
+	}
+
+	return sendResponse(ctx, http.StatusOK, newScanProgress(len(utils.ValueOrZero(scan.AssetIDs)), results))
+}
+
+// newScanProgress aggregates the progress of a scan of assetsTotal assets from
+// the family states of its scan results. The assets without a scan result yet
+// didn't start. The family which is not scanned on an asset is not part of the
+// progress of the asset.
+func newScanProgress(assetsTotal int, scanResults []models.AssetScanResult) models.ScanProgress {
+	if assetsTotal < len(scanResults) {
+		assetsTotal = len(scanResults)
+	}
+
+	families := make(map[models.ScanFamily]*models.ScanFamilyProgress, len(models.ScanFamilies))
+	familiesPercentSum := make(map[models.ScanFamily]int, len(models.ScanFamilies))
+	var assetsDone, assetsPercentSum int
+	for _, scanResult := range scanResults {
+		status := utils.ValueOrZero(scanResult.Status)
+		generalState, _ := status.GetGeneralState()
+		assetDone := generalState == models.AssetScanStateStateDone
+		if assetDone {
+			assetsDone++
+		}
+
+		var assetFamilies, assetPercentSum int
+		for _, family := range models.ScanFamilies {
+			familyState := status.GetFamilyState(family)
+			if familyState == nil {
+				continue
+			}
+			state, ok := familyState.GetState()
+			if !ok || state == models.AssetScanStateStateNotScanned {
+				continue
+			}
+
+			progress, ok := families[family]
+			if !ok {
+				progress = &models.ScanFamilyProgress{Family: family}
+				families[family] = progress
+			}
+			// The families of a done asset are done, whatever state they
+			// were left in.
+			percent := 100 // nolint:gomnd
+			if !assetDone {
+				percent = familyStatePercent(state, familyState.Progress)
+			}
+			progress.AssetsTotal++
+			if state != models.AssetScanStateStatePending && state != models.AssetScanStateStateScheduled && state != models.AssetScanStateStateReadyToScan {
+				progress.AssetsStarted++
+			}
+			if assetDone || state == models.AssetScanStateStateDone || state == models.AssetScanStateStateAborted {
+				progress.AssetsDone++
+			}
+			progress.Errors += len(familyState.GetErrors())
+			familiesPercentSum[family] += percent
+
+			assetFamilies++
+			assetPercentSum += percent
+		}
+
+		switch {
+		case assetDone:
+			assetsPercentSum += 100 // nolint:gomnd
+		case assetFamilies > 0:
+			assetsPercentSum += assetPercentSum / assetFamilies
+		}
+	}
+
+	familiesProgress := make([]models.ScanFamilyProgress, 0, len(families))
+	for _, family := range models.ScanFamilies {
+		progress, ok := families[family]
+		if !ok {
+			continue
+		}
+		progress.Percent = familiesPercentSum[family] / progress.AssetsTotal
+		familiesProgress = append(familiesProgress, *progress)
+	}
+
+	var percent int
+	if assetsTotal > 0 {
+		percent = assetsPercentSum / assetsTotal
+	}
+
+	return models.ScanProgress{
+		Percent:     percent,
+		AssetsTotal: assetsTotal,
+		AssetsDone:  assetsDone,
+		Families:    familiesProgress,
+	}
+}
+
+// familyStatePercent returns the percentage of the scan of a family in the
+// given state which is done, a family which finished is done even if it was
+// aborted.
+func familyStatePercent(state models.AssetScanStateState, progress *int) int {
+	switch state {
+	case models.AssetScanStateStateDone, models.AssetScanStateStateAborted:
+		return 100 // nolint:gomnd
+	case models.AssetScanStateStateInProgress:
+		return utils.ValueOrZero(progress)
+	default:
+		return 0
+	}
+}
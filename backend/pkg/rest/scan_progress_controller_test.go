@@ -0,0 +1,97 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func newProgressState(state models.AssetScanStateState, progress *int, errs ...string) *models.AssetScanState {
+	return &models.AssetScanState{
+		State:    utils.PointerTo(state),
+		Progress: progress,
+		Errors:   &errs,
+	}
+}
+
+func Test_newScanProgress(t *testing.T) {
+	scanResults := []models.AssetScanResult{
+		{
+			Status: &models.AssetScanStatus{
+				General:         newProgressState(models.AssetScanStateStateInProgress, nil),
+				Sbom:            newProgressState(models.AssetScanStateStateDone, nil),
+				Vulnerabilities: newProgressState(models.AssetScanStateStateInProgress, utils.PointerTo(50)),
+				Secrets:         newProgressState(models.AssetScanStateStatePending, nil),
+				Malware:         newProgressState(models.AssetScanStateStateNotScanned, nil),
+			},
+		},
+		{
+			Status: &models.AssetScanStatus{
+				General:         newProgressState(models.AssetScanStateStateDone, nil),
+				Sbom:            newProgressState(models.AssetScanStateStateDone, nil),
+				Vulnerabilities: newProgressState(models.AssetScanStateStateAborted, nil, "scanner failed"),
+				Secrets:         newProgressState(models.AssetScanStateStateDone, nil),
+			},
+		},
+	}
+
+	got := newScanProgress(4, scanResults)
+
+	assert.DeepEqual(t, got, models.ScanProgress{
+		// (50 + 100 + 0 + 0) / 4, the first asset is (100 + 50 + 0) / 3 done.
+		Percent:     37,
+		AssetsTotal: 4,
+		AssetsDone:  1,
+		Families: []models.ScanFamilyProgress{
+			{
+				Family:        models.ScanFamilySbom,
+				Percent:       100,
+				AssetsTotal:   2,
+				AssetsStarted: 2,
+				AssetsDone:    2,
+			},
+			{
+				Family:        models.ScanFamilyVulnerabilities,
+				Percent:       75,
+				AssetsTotal:   2,
+				AssetsStarted: 2,
+				AssetsDone:    1,
+				Errors:        1,
+			},
+			{
+				Family:        models.ScanFamilySecrets,
+				Percent:       50,
+				AssetsTotal:   2,
+				AssetsStarted: 1,
+				AssetsDone:    1,
+			},
+		},
+	})
+}
+
+func Test_newScanProgressWithoutScanResults(t *testing.T) {
+	got := newScanProgress(2, nil)
+
+	assert.DeepEqual(t, got, models.ScanProgress{
+		AssetsTotal: 2,
+		Families:    []models.ScanFamilyProgress{},
+	})
+}
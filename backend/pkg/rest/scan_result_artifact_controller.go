@@ -0,0 +1,141 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/objectstore"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// DefaultMaxArtifactSize is the default maximum size of an uploaded artifact.
+const DefaultMaxArtifactSize = 256 << 20 // 256 MiB
+
+const defaultArtifactContentType = "application/octet-stream"
+
+func (s *ServerImpl) GetScanResultsScanResultIDFamiliesFamilyArtifact(ctx echo.Context, scanResultID models.ScanResultID, family models.ScanResultFamily) error {
+	scanResult, err := s.dbHandler.ScanResultsTable().GetScanResult(scanResultID, models.GetScanResultsScanResultIDParams{
+		Select: utils.PointerTo("id,artifacts"),
+	})
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("ScanResult with ID %v not found", scanResultID))
+		}
+		return sendDBReadError(ctx, err, fmt.Sprintf("failed to get scan result from db. scanResultID=%v", scanResultID))
+	}
+
+	artifact, ok := familyArtifact(scanResult.Artifacts, family)
+	if s.objectStore == nil || !ok {
+		return sendError(ctx, http.StatusNotFound, fmt.Sprintf("no %s artifact of ScanResult with ID %v is stored", family, scanResultID))
+	}
+
+	data, err := s.objectStore.Get(ctx.Request().Context(), artifact.Key)
+	if err != nil {
+		if errors.Is(err, objectstore.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("%s artifact of ScanResult with ID %v not found", family, scanResultID))
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get artifact from object store: %v", err))
+	}
+
+	ctx.Response().Header().Set(echo.HeaderContentDisposition,
+		fmt.Sprintf("attachment; filename=\"%s-%s\"", scanResultID, family))
+	return ctx.Blob(http.StatusOK, artifact.ContentType, data)
+}
+
+func (s *ServerImpl) PutScanResultsScanResultIDFamiliesFamilyArtifact(ctx echo.Context, scanResultID models.ScanResultID, family models.ScanResultFamily) error {
+	if s.objectStore == nil {
+		return sendError(ctx, http.StatusServiceUnavailable, "artifact store is not configured")
+	}
+	if _, _, ok := models.ScanResultItemsFields(family); !ok {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("unknown scan family %q", family))
+	}
+
+	// The scan result is checked before the artifact is stored so that the
+	// artifacts of unknown scan results don't fill the store.
+	_, err := s.dbHandler.ScanResultsTable().GetScanResult(scanResultID, models.GetScanResultsScanResultIDParams{
+		Select: utils.PointerTo("id"),
+	})
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("ScanResult with ID %v not found", scanResultID))
+		}
+		return sendDBReadError(ctx, err, fmt.Sprintf("failed to get scan result from db. scanResultID=%v", scanResultID))
+	}
+
+	var body io.Reader = ctx.Request().Body
+	if s.maxArtifactSize > 0 {
+		body = io.LimitReader(body, s.maxArtifactSize+1)
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to read request: %v", err))
+	}
+	if s.maxArtifactSize > 0 && int64(len(data)) > s.maxArtifactSize {
+		return sendError(ctx, http.StatusRequestEntityTooLarge, fmt.Sprintf("artifact is larger than the maximum artifact size of %d bytes", s.maxArtifactSize))
+	}
+
+	key, err := s.objectStore.Put(ctx.Request().Context(), data)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to put artifact in object store: %v", err))
+	}
+
+	contentType := ctx.Request().Header.Get(echo.HeaderContentType)
+	if contentType == "" {
+		contentType = defaultArtifactContentType
+	}
+	artifact, err := s.dbHandler.ScanResultsTable().SetScanResultArtifact(scanResultID, models.ScanResultArtifact{
+		Family:      family,
+		Key:         key,
+		ContentType: contentType,
+		Size:        int64(len(data)),
+		UploadTime:  time.Now().UTC(),
+	})
+	if err != nil {
+		var validationErr *common.BadRequestError
+		switch {
+		case errors.Is(err, databaseTypes.ErrNotFound):
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("ScanResult with ID %v not found", scanResultID))
+		case errors.As(err, &validationErr):
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		default:
+			return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to set scan result artifact in db. scanResultID=%v: %v", scanResultID, err))
+		}
+	}
+
+	return sendResponse(ctx, http.StatusOK, artifact)
+}
+
+func familyArtifact(artifacts *[]models.ScanResultArtifact, family models.ScanFamily) (models.ScanResultArtifact, bool) {
+	if artifacts == nil {
+		return models.ScanResultArtifact{}, false
+	}
+	for _, artifact := range *artifacts {
+		if artifact.Family == family {
+			return artifact, true
+		}
+	}
+	return models.ScanResultArtifact{}, false
+}
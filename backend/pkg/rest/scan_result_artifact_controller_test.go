@@ -0,0 +1,97 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"gotest.tools/v3/assert"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/database/gorm"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/objectstore"
+)
+
+func TestServerImpl_ScanResultArtifact(t *testing.T) {
+	db, err := gorm.NewDatabase(databaseTypes.DBConfig{
+		DriverType:  databaseTypes.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.sqlite"),
+	})
+	assert.NilError(t, err)
+	store, err := objectstore.NewFilesystemStore(t.TempDir())
+	assert.NilError(t, err)
+
+	scanResult, err := db.ScanResultsTable().CreateScanResult(models.AssetScanResult{
+		Scan:  &models.ScanRelationship{Id: "scan"},
+		Asset: &models.AssetRelationship{Id: "asset"},
+	})
+	assert.NilError(t, err)
+
+	s := &ServerImpl{dbHandler: db, objectStore: store, maxArtifactSize: 16}
+	put := func(scanResultID string, family models.ScanFamily, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		assert.NilError(t, s.PutScanResultsScanResultIDFamiliesFamilyArtifact(echo.New().NewContext(req, rec), scanResultID, family))
+		return rec
+	}
+	get := func(family models.ScanFamily) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		assert.NilError(t, s.GetScanResultsScanResultIDFamiliesFamilyArtifact(ctx, *scanResult.Id, family))
+		return rec
+	}
+
+	// Nothing was uploaded yet.
+	rec := get(models.ScanFamilyVulnerabilities)
+	assert.Equal(t, rec.Code, http.StatusNotFound, rec.Body.String())
+
+	rec = put(*scanResult.Id, models.ScanFamilyVulnerabilities, `{"matches":[]}`)
+	assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+	var artifact models.ScanResultArtifact
+	assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &artifact))
+	assert.Equal(t, artifact.Family, models.ScanFamilyVulnerabilities)
+	assert.Equal(t, artifact.ContentType, echo.MIMEApplicationJSON)
+	assert.Equal(t, artifact.Size, int64(14))
+
+	rec = get(models.ScanFamilyVulnerabilities)
+	assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+	assert.Equal(t, rec.Header().Get(echo.HeaderContentType), echo.MIMEApplicationJSON)
+	assert.Equal(t, rec.Header().Get(echo.HeaderContentDisposition), `attachment; filename="`+*scanResult.Id+`-vulnerabilities"`)
+	assert.Equal(t, rec.Body.String(), `{"matches":[]}`)
+
+	got, err := db.ScanResultsTable().GetScanResult(*scanResult.Id, models.GetScanResultsScanResultIDParams{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, *got.Artifacts, []models.ScanResultArtifact{artifact})
+
+	rec = put(*scanResult.Id, models.ScanFamilyVulnerabilities, strings.Repeat("x", 17))
+	assert.Equal(t, rec.Code, http.StatusRequestEntityTooLarge, rec.Body.String())
+	rec = put(*scanResult.Id, "unknown", "{}")
+	assert.Equal(t, rec.Code, http.StatusBadRequest, rec.Body.String())
+	rec = put("missing", models.ScanFamilyVulnerabilities, "{}")
+	assert.Equal(t, rec.Code, http.StatusNotFound, rec.Body.String())
+
+	s.objectStore = nil
+	rec = put(*scanResult.Id, models.ScanFamilyVulnerabilities, "{}")
+	assert.Equal(t, rec.Code, http.StatusServiceUnavailable, rec.Body.String())
+}
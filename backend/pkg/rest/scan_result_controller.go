@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"gorm.io/gorm"
@@ -30,16 +31,22 @@ import (
 )
 
 func (s *ServerImpl) GetScanResults(ctx echo.Context, params models.GetScanResultsParams) error {
+	if acceptsNDJSON(ctx) {
+		return sendNDJSONStream(ctx, func(fn func(models.AssetScanResult) error) error {
+			return s.dbHandler.ScanResultsTable().StreamScanResults(params, fn)
+		})
+	}
+
 	dbScanResults, err := s.dbHandler.ScanResultsTable().GetScanResults(params)
 	if err != nil {
-		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scans results from db: %v", err))
+		return sendDBReadError(ctx, err, "failed to get scans results from db")
 	}
 
 	return sendResponse(ctx, http.StatusOK, dbScanResults)
 }
 
 func (s *ServerImpl) PostScanResults(ctx echo.Context) error {
-	var scanResult models.TargetScanResult
+	var scanResult models.AssetScanResult
 	err := ctx.Bind(&scanResult)
 	if err != nil {
 		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
@@ -49,9 +56,9 @@ func (s *ServerImpl) PostScanResults(ctx echo.Context) error {
 	if err != nil {
 		var conflictErr *common.ConflictError
 		if errors.As(err, &conflictErr) {
-			existResponse := &models.TargetScanResultExists{
-				Message:          utils.PointerTo(conflictErr.Reason),
-				TargetScanResult: &createdScanResult,
+			existResponse := &models.AssetScanResultExists{
+				Message:         utils.PointerTo(conflictErr.Reason),
+				AssetScanResult: &createdScanResult,
 			}
 			return sendResponse(ctx, http.StatusConflict, existResponse)
 		}
@@ -67,16 +74,20 @@ func (s *ServerImpl) GetScanResultsScanResultID(ctx echo.Context, scanResultID m
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return sendError(ctx, http.StatusNotFound, err.Error())
 		}
-		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scan result from db. scanResultID=%v: %v", scanResultID, err))
+		return sendDBReadError(ctx, err, fmt.Sprintf("failed to get scan result from db. scanResultID=%v", scanResultID))
 	}
 
-	return sendResponse(ctx, http.StatusOK, dbScanResult)
+	return sendResponseWithETag(ctx, params.IfNoneMatch, dbScanResult)
 }
 
 // nolint:cyclop
 func (s *ServerImpl) PatchScanResultsScanResultID(ctx echo.Context, scanResultID models.ScanResultID, params models.PatchScanResultsScanResultIDParams) error {
+	if isJSONPatch(ctx) {
+		return s.jsonPatchScanResult(ctx, scanResultID, params)
+	}
+
 	// TODO: check that the provided scan and target IDs are valid
-	var scanResult models.TargetScanResult
+	var scanResult models.AssetScanResult
 	err := ctx.Bind(&scanResult)
 	if err != nil {
 		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
@@ -105,9 +116,9 @@ func (s *ServerImpl) PatchScanResultsScanResultID(ctx echo.Context, scanResultID
 		var preconditionFailedErr *databaseTypes.PreconditionFailedError
 		switch true {
 		case errors.As(err, &conflictErr):
-			existResponse := &models.TargetScanResultExists{
-				Message:          utils.PointerTo(conflictErr.Reason),
-				TargetScanResult: &updatedScanResult,
+			existResponse := &models.AssetScanResultExists{
+				Message:         utils.PointerTo(conflictErr.Reason),
+				AssetScanResult: &updatedScanResult,
 			}
 			return sendResponse(ctx, http.StatusConflict, existResponse)
 		case errors.As(err, &validationErr):
@@ -125,7 +136,7 @@ func (s *ServerImpl) PatchScanResultsScanResultID(ctx echo.Context, scanResultID
 // nolint:cyclop
 func (s *ServerImpl) PutScanResultsScanResultID(ctx echo.Context, scanResultID models.ScanResultID, params models.PutScanResultsScanResultIDParams) error {
 	// TODO: check that the provided scan and target IDs are valid
-	var scanResult models.TargetScanResult
+	var scanResult models.AssetScanResult
 	err := ctx.Bind(&scanResult)
 	if err != nil {
 		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
@@ -140,6 +151,10 @@ func (s *ServerImpl) PutScanResultsScanResultID(ctx echo.Context, scanResultID m
 		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scan result. scanResultID=%v: %v", scanResultID, err))
 	}
 
+	return s.saveScanResult(ctx, scanResultID, scanResult, params)
+}
+
+func (s *ServerImpl) saveScanResult(ctx echo.Context, scanResultID models.ScanResultID, scanResult models.AssetScanResult, params models.PutScanResultsScanResultIDParams) error {
 	// PUT request might not contain the ID in the body, so set it from
 	// the URL field so that the DB layer knows which object is being updated.
 	if scanResult.Id != nil && *scanResult.Id != scanResultID {
@@ -154,9 +169,9 @@ func (s *ServerImpl) PutScanResultsScanResultID(ctx echo.Context, scanResultID m
 		var preconditionFailedErr *databaseTypes.PreconditionFailedError
 		switch true {
 		case errors.As(err, &conflictErr):
-			existResponse := &models.TargetScanResultExists{
-				Message:          utils.PointerTo(conflictErr.Reason),
-				TargetScanResult: &updatedScanResult,
+			existResponse := &models.AssetScanResultExists{
+				Message:         utils.PointerTo(conflictErr.Reason),
+				AssetScanResult: &updatedScanResult,
 			}
 			return sendResponse(ctx, http.StatusConflict, existResponse)
 		case errors.As(err, &validationErr):
@@ -170,3 +185,117 @@ func (s *ServerImpl) PutScanResultsScanResultID(ctx echo.Context, scanResultID m
 
 	return sendResponse(ctx, http.StatusOK, updatedScanResult)
 }
+
+// jsonPatchScanResult applies the JSON Patch of the request to the scan
+// result and replaces the scan result with the result.
+func (s *ServerImpl) jsonPatchScanResult(ctx echo.Context, scanResultID models.ScanResultID, params models.PatchScanResultsScanResultIDParams) error {
+	scanResult, err := s.dbHandler.ScanResultsTable().GetScanResult(scanResultID, models.GetScanResultsScanResultIDParams{})
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("scan result was not found. scanResultID=%v: %v", scanResultID, err))
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scan result. scanResultID=%v: %v", scanResultID, err))
+	}
+
+	var patched models.AssetScanResult
+	if err := applyJSONPatch(ctx, scanResult, &patched); err != nil {
+		return sendJSONPatchError(ctx, err)
+	}
+
+	return s.saveScanResult(ctx, scanResultID, patched, models.PutScanResultsScanResultIDParams{
+		IfMatch: jsonPatchIfMatch(params.IfMatch, scanResult.Revision),
+	})
+}
+
+// nolint:cyclop
+func (s *ServerImpl) PostScanResultsScanResultIDRerun(ctx echo.Context, scanResultID models.ScanResultID, params models.PostScanResultsScanResultIDRerunParams) error {
+	if len(params.Families) == 0 {
+		return sendError(ctx, http.StatusBadRequest, "at least one scan family must be provided")
+	}
+
+	scanResult, err := s.dbHandler.ScanResultsTable().GetScanResult(scanResultID, models.GetScanResultsScanResultIDParams{})
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("scan result was not found. scanResultID=%v: %v", scanResultID, err))
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scan result. scanResultID=%v: %v", scanResultID, err))
+	}
+
+	// The scan families can only be re-run once the previous run has finished
+	// and its resources were cleaned up, otherwise the re-run would reuse the
+	// snapshot of the previous run instead of taking a fresh one.
+	if done, ok := scanResult.IsDone(); !ok || !done {
+		return sendError(ctx, http.StatusConflict, fmt.Sprintf("scan result is still in progress. scanResultID=%v", scanResultID))
+	}
+	if scanResult.ResourceCleanup != nil && *scanResult.ResourceCleanup == models.ResourceCleanupStatePending {
+		return sendError(ctx, http.StatusConflict, fmt.Sprintf("resources of scan result are not cleaned up yet. scanResultID=%v", scanResultID))
+	}
+
+	now := time.Now()
+	status := scanResult.Status
+	if status == nil {
+		status = &models.AssetScanStatus{}
+	}
+	status.General = &models.AssetScanState{
+		State:              utils.PointerTo(models.AssetScanStateStatePending),
+		LastTransitionTime: &now,
+	}
+	for _, family := range params.Families {
+		if !status.SetFamilyState(family, &models.AssetScanState{
+			State:              utils.PointerTo(models.AssetScanStateStatePending),
+			LastTransitionTime: &now,
+		}) {
+			return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("unknown scan family %s", family))
+		}
+	}
+
+	// Results of the families which are not re-run are left untouched, the
+	// results of the re-run families are replaced by the scanner once it has
+	// finished. The scan result is replaced rather than patched to unset the
+	// scanner times of the previous run, the times of the re-run's scanner
+	// are recorded by the scan result watcher.
+	scanResult.Id = &scanResultID
+	scanResult.Status = status
+	scanResult.RerunFamilies = &params.Families
+	scanResult.FindingsProcessed = utils.PointerTo(false)
+	scanResult.ResourceCleanup = utils.PointerTo(models.ResourceCleanupStatePending)
+	scanResult.ScannerStartTime = nil
+	scanResult.ScannerEndTime = nil
+	scanResult.Truncations = withoutTruncations(scanResult.Truncations, params.Families)
+	updatedScanResult, err := s.dbHandler.ScanResultsTable().SaveScanResult(scanResult, models.PutScanResultsScanResultIDParams{
+		IfMatch: scanResult.Revision,
+	})
+	if err != nil {
+		var preconditionFailedErr *databaseTypes.PreconditionFailedError
+		if errors.As(err, &preconditionFailedErr) {
+			return sendError(ctx, http.StatusConflict, fmt.Sprintf("scan result was modified concurrently. scanResultID=%v", scanResultID))
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to update scan result in db. scanResultID=%v: %v", scanResultID, err))
+	}
+
+	return sendResponse(ctx, http.StatusAccepted, updatedScanResult)
+}
+
+// withoutTruncations returns the truncations except those of the families,
+// which are recorded again if the results of the re-run are truncated.
+func withoutTruncations(truncations *[]models.ScanResultTruncation, families []models.ScanFamily) *[]models.ScanResultTruncation {
+	if truncations == nil {
+		return nil
+	}
+
+	rerun := make(map[models.ScanFamily]bool, len(families))
+	for _, family := range families {
+		rerun[family] = true
+	}
+
+	var kept []models.ScanResultTruncation
+	for _, truncation := range *truncations {
+		if !rerun[truncation.Family] {
+			kept = append(kept, truncation)
+		}
+	}
+	if len(kept) == 0 {
+		return nil
+	}
+	return &kept
+}
@@ -0,0 +1,165 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/labstack/echo/v4"
+	"gotest.tools/v3/assert"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/database/gorm"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestServerImpl_PostScanResultsScanResultIDRerun(t *testing.T) {
+	db, err := gorm.NewDatabase(databaseTypes.DBConfig{
+		DriverType:  databaseTypes.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.sqlite"),
+	})
+	assert.NilError(t, err)
+
+	start := time.Date(2023, 6, 10, 12, 0, 0, 0, time.UTC)
+	scanResult, err := db.ScanResultsTable().CreateScanResult(models.AssetScanResult{
+		Scan:  &models.ScanRelationship{Id: "scan"},
+		Asset: &models.AssetRelationship{Id: "asset"},
+		Status: &models.AssetScanStatus{
+			General: &models.AssetScanState{State: utils.PointerTo(models.AssetScanStateStateDone)},
+		},
+		ResourceCleanup:  utils.PointerTo(models.ResourceCleanupStateDone),
+		ScannerStartTime: utils.PointerTo(start),
+		ScannerEndTime:   utils.PointerTo(start.Add(time.Hour)),
+		Sboms: &models.SbomScan{
+			Packages: &[]models.Package{{Name: utils.PointerTo("openssl"), Version: utils.PointerTo("1.0")}},
+		},
+	})
+	assert.NilError(t, err)
+
+	s := &ServerImpl{dbHandler: db}
+	rec := httptest.NewRecorder()
+	ctx := echo.New().NewContext(httptest.NewRequest(http.MethodPost, "/", nil), rec)
+	err = s.PostScanResultsScanResultIDRerun(ctx, *scanResult.Id, models.PostScanResultsScanResultIDRerunParams{
+		Families: []models.ScanFamily{models.ScanFamilyVulnerabilities},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, rec.Code, http.StatusAccepted, rec.Body.String())
+
+	got, err := db.ScanResultsTable().GetScanResult(*scanResult.Id, models.GetScanResultsScanResultIDParams{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, utils.ValueOrZero(got.RerunFamilies), []models.ScanFamily{models.ScanFamilyVulnerabilities})
+	assert.Equal(t, *got.ResourceCleanup, models.ResourceCleanupStatePending)
+	assert.Equal(t, *got.Status.General.State, models.AssetScanStateStatePending)
+	// The scanner of the previous run is no longer accounted for, the
+	// re-run's scanner times are recorded once it starts.
+	assert.Assert(t, got.ScannerStartTime == nil)
+	assert.Assert(t, got.ScannerEndTime == nil)
+	// Results of the families which are not re-run are kept.
+	assert.Equal(t, len(*got.Sboms.Packages), 1)
+
+	// A re-run can't be started while one is in progress.
+	rec = httptest.NewRecorder()
+	ctx = echo.New().NewContext(httptest.NewRequest(http.MethodPost, "/", nil), rec)
+	err = s.PostScanResultsScanResultIDRerun(ctx, *scanResult.Id, models.PostScanResultsScanResultIDRerunParams{
+		Families: []models.ScanFamily{models.ScanFamilyVulnerabilities},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, rec.Code, http.StatusConflict, rec.Body.String())
+
+	// An unknown scan result can't be re-run.
+	rec = httptest.NewRecorder()
+	ctx = echo.New().NewContext(httptest.NewRequest(http.MethodPost, "/", nil), rec)
+	err = s.PostScanResultsScanResultIDRerun(ctx, "unknown", models.PostScanResultsScanResultIDRerunParams{
+		Families: []models.ScanFamily{models.ScanFamilyVulnerabilities},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, rec.Code, http.StatusNotFound, rec.Body.String())
+}
+
+func TestServerImpl_GetScanResultsScanResultIDSbom(t *testing.T) {
+	db, err := gorm.NewDatabase(databaseTypes.DBConfig{
+		DriverType:  databaseTypes.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.sqlite"),
+	})
+	assert.NilError(t, err)
+
+	var assetInfo models.AssetType
+	err = assetInfo.FromSBOMInfo(models.SBOMInfo{ObjectType: "SBOMInfo", Name: "app", Version: utils.PointerTo("1.2")})
+	assert.NilError(t, err)
+	asset, err := db.AssetsTable().CreateAsset(models.Asset{AssetInfo: &assetInfo})
+	assert.NilError(t, err)
+
+	scanResult, err := db.ScanResultsTable().CreateScanResult(models.AssetScanResult{
+		Scan:  &models.ScanRelationship{Id: "scan"},
+		Asset: &models.AssetRelationship{Id: *asset.Id},
+		Sboms: &models.SbomScan{
+			Packages: &[]models.Package{{
+				Name:     utils.PointerTo("openssl"),
+				Version:  utils.PointerTo("1.0"),
+				Purl:     utils.PointerTo("pkg:deb/debian/openssl@1.0"),
+				Cpes:     &[]string{"cpe:2.3:a:openssl:openssl:1.0:*:*:*:*:*:*:*"},
+				Licenses: &[]string{"Apache-2.0"},
+			}},
+		},
+	})
+	assert.NilError(t, err)
+
+	s := &ServerImpl{dbHandler: db}
+	rec := httptest.NewRecorder()
+	ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+	err = s.GetScanResultsScanResultIDSbom(ctx, *scanResult.Id, models.GetScanResultsScanResultIDSbomParams{
+		Format: models.CyclonedxJson,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+	var bom cdx.BOM
+	assert.NilError(t, cdx.NewBOMDecoder(rec.Body, cdx.BOMFileFormatJSON).Decode(&bom))
+	assert.Equal(t, bom.Metadata.Component.Name, "app")
+	assert.Equal(t, bom.Metadata.Component.Version, "1.2")
+	assert.DeepEqual(t, *bom.Metadata.Properties, []cdx.Property{
+		{Name: "vmclarity:scanResultID", Value: *scanResult.Id},
+		{Name: "vmclarity:scanID", Value: "scan"},
+		{Name: "vmclarity:assetID", Value: *asset.Id},
+	})
+	assert.Equal(t, len(*bom.Components), 1)
+	component := (*bom.Components)[0]
+	assert.Equal(t, component.Name, "openssl")
+	assert.Equal(t, component.Type, cdx.ComponentTypeLibrary)
+	assert.Equal(t, component.PackageURL, "pkg:deb/debian/openssl@1.0")
+	assert.Equal(t, component.CPE, "cpe:2.3:a:openssl:openssl:1.0:*:*:*:*:*:*:*")
+	assert.Equal(t, (*component.Licenses)[0].License.ID, "Apache-2.0")
+
+	// A scan result without an SBOM has nothing to export.
+	scanResult, err = db.ScanResultsTable().CreateScanResult(models.AssetScanResult{
+		Scan:  &models.ScanRelationship{Id: "other-scan"},
+		Asset: &models.AssetRelationship{Id: *asset.Id},
+	})
+	assert.NilError(t, err)
+	rec = httptest.NewRecorder()
+	ctx = echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+	err = s.GetScanResultsScanResultIDSbom(ctx, *scanResult.Id, models.GetScanResultsScanResultIDSbomParams{
+		Format: models.SpdxJson,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, rec.Code, http.StatusNotFound, rec.Body.String())
+}
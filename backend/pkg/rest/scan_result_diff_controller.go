@@ -0,0 +1,292 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+var errScanResultNotFound = errors.New("scan result not found")
+
+const scanResultDiffSelector = "id,vulnerabilities/vulnerabilities,sboms/packages,secrets/secrets"
+
+func (s *ServerImpl) GetAssetsAssetIDScanResultDiff(ctx echo.Context, assetID models.AssetID, params models.GetAssetsAssetIDScanResultDiffParams) error {
+	_, err := s.dbHandler.AssetsTable().GetAsset(assetID, models.GetAssetsAssetIDParams{
+		Select: utils.PointerTo("id"),
+	})
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("Asset with ID %v not found", assetID))
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get asset from db. assetID=%v: %v", assetID, err))
+	}
+
+	base, err := s.getAssetScanResult(assetID, params.BaseScanId, scanResultDiffSelector)
+	if err != nil {
+		return sendScanResultDiffError(ctx, err)
+	}
+	compare, err := s.getAssetScanResult(assetID, params.CompareScanId, scanResultDiffSelector)
+	if err != nil {
+		return sendScanResultDiffError(ctx, err)
+	}
+
+	return sendResponse(ctx, http.StatusOK, createScanResultDiff(base, compare))
+}
+
+func sendScanResultDiffError(ctx echo.Context, err error) error {
+	if errors.Is(err, errScanResultNotFound) {
+		return sendError(ctx, http.StatusNotFound, err.Error())
+	}
+	return sendError(ctx, http.StatusInternalServerError, err.Error())
+}
+
+// getAssetScanResult returns the selected fields of the scan result of the
+// asset in the scan.
+func (s *ServerImpl) getAssetScanResult(assetID models.AssetID, scanID, selector string) (models.AssetScanResult, error) {
+	scanResults, err := s.dbHandler.ScanResultsTable().GetScanResults(models.GetScanResultsParams{
+		Filter: utils.PointerTo(fmt.Sprintf("asset/id eq '%s' and scan/id eq '%s'", assetID, scanID)),
+		Select: utils.PointerTo(selector),
+		Top:    utils.PointerTo(1),
+	})
+	if err != nil {
+		return models.AssetScanResult{}, fmt.Errorf("failed to get scan result from db. assetID=%v scanID=%v: %w", assetID, scanID, err)
+	}
+	if scanResults.Items == nil || len(*scanResults.Items) == 0 {
+		return models.AssetScanResult{}, fmt.Errorf("%w for asset %v in scan %v", errScanResultNotFound, assetID, scanID)
+	}
+
+	return (*scanResults.Items)[0], nil
+}
+
+func createScanResultDiff(base, compare models.AssetScanResult) models.ScanResultDiff {
+	vulnerabilities := diffItems(getVulnerabilities(base), getVulnerabilities(compare), vulnerabilityDiffKey, vulnerabilityChanged)
+	packages := diffItems(getPackages(base), getPackages(compare), packageDiffKey, nil)
+	secrets := diffItems(getSecrets(base), getSecrets(compare), secretDiffKey, nil)
+
+	vulnerabilityChanges := make([]models.VulnerabilityChange, 0, len(vulnerabilities.changed))
+	for _, change := range vulnerabilities.changed {
+		vulnerabilityChanges = append(vulnerabilityChanges, models.VulnerabilityChange{
+			Base:    utils.PointerTo(change[0]),
+			Compare: utils.PointerTo(change[1]),
+		})
+	}
+	packageChanges := make([]models.PackageChange, 0, len(packages.changed))
+	for _, change := range packages.changed {
+		packageChanges = append(packageChanges, models.PackageChange{
+			Base:    utils.PointerTo(change[0]),
+			Compare: utils.PointerTo(change[1]),
+		})
+	}
+	secretChanges := make([]models.SecretChange, 0, len(secrets.changed))
+	for _, change := range secrets.changed {
+		secretChanges = append(secretChanges, models.SecretChange{
+			Base:    utils.PointerTo(change[0]),
+			Compare: utils.PointerTo(change[1]),
+		})
+	}
+
+	return models.ScanResultDiff{
+		BaseScanResultID:    base.Id,
+		CompareScanResultID: compare.Id,
+		Vulnerabilities: &models.VulnerabilitiesDiff{
+			Added:   &vulnerabilities.added,
+			Removed: &vulnerabilities.removed,
+			Changed: &vulnerabilityChanges,
+		},
+		Packages: &models.PackagesDiff{
+			Added:   &packages.added,
+			Removed: &packages.removed,
+			Changed: &packageChanges,
+		},
+		Secrets: &models.SecretsDiff{
+			Added:   &secrets.added,
+			Removed: &secrets.removed,
+			Changed: &secretChanges,
+		},
+	}
+}
+
+func getVulnerabilities(scanResult models.AssetScanResult) []models.Vulnerability {
+	if scanResult.Vulnerabilities == nil || scanResult.Vulnerabilities.Vulnerabilities == nil {
+		return nil
+	}
+	return *scanResult.Vulnerabilities.Vulnerabilities
+}
+
+func getPackages(scanResult models.AssetScanResult) []models.Package {
+	if scanResult.Sboms == nil || scanResult.Sboms.Packages == nil {
+		return nil
+	}
+	return *scanResult.Sboms.Packages
+}
+
+func getSecrets(scanResult models.AssetScanResult) []models.Secret {
+	if scanResult.Secrets == nil || scanResult.Secrets.Secrets == nil {
+		return nil
+	}
+	return *scanResult.Secrets.Secrets
+}
+
+// diffKey identifies an item of a scan result across scans. The items with
+// the same identity are the same item, which changed if its instance, e.g.
+// the version of a package, is different.
+type diffKey struct {
+	identity string
+	instance string
+}
+
+func vulnerabilityDiffKey(vulnerability models.Vulnerability) diffKey {
+	var packageName, packageVersion string
+	if vulnerability.Package != nil {
+		packageName = utils.ValueOrZero(vulnerability.Package.Name)
+		packageVersion = utils.ValueOrZero(vulnerability.Package.Version)
+	}
+	return diffKey{
+		identity: utils.ValueOrZero(vulnerability.VulnerabilityName) + "\x00" + packageName,
+		instance: packageVersion,
+	}
+}
+
+// vulnerabilityChanged returns whether the same vulnerability in the same
+// package version changed, which happens when the vulnerability databases of
+// the scanners are updated.
+func vulnerabilityChanged(base, compare models.Vulnerability) bool {
+	return utils.ValueOrZero(base.Severity) != utils.ValueOrZero(compare.Severity) ||
+		!reflect.DeepEqual(base.Fix, compare.Fix)
+}
+
+func packageDiffKey(pkg models.Package) diffKey {
+	return diffKey{
+		identity: utils.ValueOrZero(pkg.Name) + "\x00" + utils.ValueOrZero(pkg.Type),
+		instance: utils.ValueOrZero(pkg.Version),
+	}
+}
+
+func secretDiffKey(secret models.Secret) diffKey {
+	fingerprint := utils.ValueOrZero(secret.CredentialFingerprint)
+	if fingerprint == "" {
+		fingerprint = utils.ValueOrZero(secret.Fingerprint)
+	}
+	return diffKey{
+		identity: fingerprint + "\x00" + utils.ValueOrZero(secret.FilePath),
+		instance: fmt.Sprintf("%d:%d", utils.ValueOrZero(secret.StartLine), utils.ValueOrZero(secret.StartColumn)),
+	}
+}
+
+type itemsDiff[T any] struct {
+	added   []T
+	removed []T
+	changed [][2]T
+}
+
+// diffItems compares the items of the compare scan result with the ones of
+// the base scan result. The items with the same identity are matched by
+// their instance first, and the items left are paired in the order of their
+// instances, the items which can't be paired were added or removed. The items
+// matched by their instance changed if changed reports so.
+// nolint:cyclop
+func diffItems[T any](base, compare []T, key func(T) diffKey, changed func(T, T) bool) itemsDiff[T] {
+	type instances struct {
+		base    map[string]T
+		compare map[string]T
+	}
+	byIdentity := map[string]*instances{}
+	get := func(identity string) *instances {
+		i, ok := byIdentity[identity]
+		if !ok {
+			i = &instances{base: map[string]T{}, compare: map[string]T{}}
+			byIdentity[identity] = i
+		}
+		return i
+	}
+	for _, item := range base {
+		k := key(item)
+		get(k.identity).base[k.instance] = item
+	}
+	for _, item := range compare {
+		k := key(item)
+		get(k.identity).compare[k.instance] = item
+	}
+
+	identities := make([]string, 0, len(byIdentity))
+	for identity := range byIdentity {
+		identities = append(identities, identity)
+	}
+	sort.Strings(identities)
+
+	diff := itemsDiff[T]{
+		added:   []T{},
+		removed: []T{},
+		changed: [][2]T{},
+	}
+	for _, identity := range identities {
+		i := byIdentity[identity]
+
+		var baseLeft, compareLeft []string
+		for _, instance := range sortedInstances(i.base) {
+			compareItem, ok := i.compare[instance]
+			if !ok {
+				baseLeft = append(baseLeft, instance)
+				continue
+			}
+			if changed != nil && changed(i.base[instance], compareItem) {
+				diff.changed = append(diff.changed, [2]T{i.base[instance], compareItem})
+			}
+		}
+		for _, instance := range sortedInstances(i.compare) {
+			if _, ok := i.base[instance]; !ok {
+				compareLeft = append(compareLeft, instance)
+			}
+		}
+
+		for len(baseLeft) > 0 && len(compareLeft) > 0 {
+			diff.changed = append(diff.changed, [2]T{i.base[baseLeft[0]], i.compare[compareLeft[0]]})
+			baseLeft, compareLeft = baseLeft[1:], compareLeft[1:]
+		}
+		for _, instance := range baseLeft {
+			diff.removed = append(diff.removed, i.base[instance])
+		}
+		for _, instance := range compareLeft {
+			diff.added = append(diff.added, i.compare[instance])
+		}
+	}
+
+	return diff
+}
+
+func sortedInstances[T any](items map[string]T) []string {
+	instances := make([]string, 0, len(items))
+	for instance := range items {
+		instances = append(instances, instance)
+	}
+	sort.Slice(instances, func(a, b int) bool {
+		if c := utils.CompareVersions(instances[a], instances[b]); c != 0 {
+			return c < 0
+		}
+		return instances[a] < instances[b]
+	})
+	return instances
+}
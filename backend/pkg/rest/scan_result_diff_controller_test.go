@@ -0,0 +1,166 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func newDiffPackage(name, version string) models.Package {
+	return models.Package{
+		Name:    utils.PointerTo(name),
+		Type:    utils.PointerTo("deb"),
+		Version: utils.PointerTo(version),
+	}
+}
+
+func newDiffVulnerability(name, packageName, packageVersion string, severity models.VulnerabilitySeverity) models.Vulnerability {
+	return models.Vulnerability{
+		VulnerabilityName: utils.PointerTo(name),
+		Package:           utils.PointerTo(newDiffPackage(packageName, packageVersion)),
+		Severity:          utils.PointerTo(severity),
+	}
+}
+
+func newDiffSecret(fingerprint, filePath string, startLine int) models.Secret {
+	return models.Secret{
+		CredentialFingerprint: utils.PointerTo(fingerprint),
+		FilePath:              utils.PointerTo(filePath),
+		StartLine:             utils.PointerTo(startLine),
+	}
+}
+
+func newDiffScanResult(id string, vulnerabilities []models.Vulnerability, packages []models.Package, secrets []models.Secret) models.AssetScanResult {
+	return models.AssetScanResult{
+		Id:              utils.PointerTo(id),
+		Vulnerabilities: &models.VulnerabilityScan{Vulnerabilities: &vulnerabilities},
+		Sboms:           &models.SbomScan{Packages: &packages},
+		Secrets:         &models.SecretScan{Secrets: &secrets},
+	}
+}
+
+func Test_createScanResultDiff(t *testing.T) {
+	base := newDiffScanResult("base",
+		[]models.Vulnerability{
+			newDiffVulnerability("CVE-1", "openssl", "1.1.1", models.HIGH),
+			newDiffVulnerability("CVE-2", "zlib", "1.2.11", models.LOW),
+			newDiffVulnerability("CVE-3", "curl", "7.0", models.MEDIUM),
+			newDiffVulnerability("CVE-4", "bash", "5.0", models.LOW),
+		},
+		[]models.Package{
+			newDiffPackage("openssl", "1.1.1"),
+			newDiffPackage("zlib", "1.2.11"),
+			newDiffPackage("curl", "7.0"),
+			newDiffPackage("kernel", "5.10"),
+			newDiffPackage("kernel", "5.15"),
+		},
+		[]models.Secret{
+			newDiffSecret("aws-key", "/etc/app.conf", 3),
+			newDiffSecret("token", "/root/.env", 1),
+		},
+	)
+	compare := newDiffScanResult("compare",
+		[]models.Vulnerability{
+			// Package upgraded but still vulnerable.
+			newDiffVulnerability("CVE-1", "openssl", "1.1.2", models.HIGH),
+			// Severity updated.
+			newDiffVulnerability("CVE-2", "zlib", "1.2.11", models.CRITICAL),
+			newDiffVulnerability("CVE-4", "bash", "5.0", models.LOW),
+			newDiffVulnerability("CVE-5", "sudo", "1.9", models.HIGH),
+		},
+		[]models.Package{
+			newDiffPackage("openssl", "1.1.2"),
+			newDiffPackage("zlib", "1.2.11"),
+			newDiffPackage("kernel", "5.15"),
+			newDiffPackage("kernel", "6.1"),
+			newDiffPackage("sudo", "1.9"),
+		},
+		[]models.Secret{
+			newDiffSecret("aws-key", "/etc/app.conf", 5),
+			newDiffSecret("github", "/root/.env", 2),
+		},
+	)
+
+	got := createScanResultDiff(base, compare)
+
+	assert.DeepEqual(t, got, models.ScanResultDiff{
+		BaseScanResultID:    utils.PointerTo("base"),
+		CompareScanResultID: utils.PointerTo("compare"),
+		Vulnerabilities: &models.VulnerabilitiesDiff{
+			Added: &[]models.Vulnerability{
+				newDiffVulnerability("CVE-5", "sudo", "1.9", models.HIGH),
+			},
+			Removed: &[]models.Vulnerability{
+				newDiffVulnerability("CVE-3", "curl", "7.0", models.MEDIUM),
+			},
+			Changed: &[]models.VulnerabilityChange{
+				{
+					Base:    utils.PointerTo(newDiffVulnerability("CVE-1", "openssl", "1.1.1", models.HIGH)),
+					Compare: utils.PointerTo(newDiffVulnerability("CVE-1", "openssl", "1.1.2", models.HIGH)),
+				},
+				{
+					Base:    utils.PointerTo(newDiffVulnerability("CVE-2", "zlib", "1.2.11", models.LOW)),
+					Compare: utils.PointerTo(newDiffVulnerability("CVE-2", "zlib", "1.2.11", models.CRITICAL)),
+				},
+			},
+		},
+		Packages: &models.PackagesDiff{
+			Added: &[]models.Package{
+				newDiffPackage("sudo", "1.9"),
+			},
+			Removed: &[]models.Package{
+				newDiffPackage("curl", "7.0"),
+			},
+			Changed: &[]models.PackageChange{
+				{
+					Base:    utils.PointerTo(newDiffPackage("kernel", "5.10")),
+					Compare: utils.PointerTo(newDiffPackage("kernel", "6.1")),
+				},
+				{
+					Base:    utils.PointerTo(newDiffPackage("openssl", "1.1.1")),
+					Compare: utils.PointerTo(newDiffPackage("openssl", "1.1.2")),
+				},
+			},
+		},
+		Secrets: &models.SecretsDiff{
+			Added: &[]models.Secret{
+				newDiffSecret("github", "/root/.env", 2),
+			},
+			Removed: &[]models.Secret{
+				newDiffSecret("token", "/root/.env", 1),
+			},
+			Changed: &[]models.SecretChange{
+				{
+					Base:    utils.PointerTo(newDiffSecret("aws-key", "/etc/app.conf", 3)),
+					Compare: utils.PointerTo(newDiffSecret("aws-key", "/etc/app.conf", 5)),
+				},
+			},
+		},
+	})
+}
+
+func Test_createScanResultDiff_emptyResults(t *testing.T) {
+	got := createScanResultDiff(models.AssetScanResult{Id: utils.PointerTo("base")}, models.AssetScanResult{Id: utils.PointerTo("compare")})
+
+	assert.Equal(t, len(*got.Vulnerabilities.Added), 0)
+	assert.Equal(t, len(*got.Packages.Removed), 0)
+	assert.Equal(t, len(*got.Secrets.Changed), 0)
+}
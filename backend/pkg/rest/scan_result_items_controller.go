@@ -0,0 +1,71 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+)
+
+func (s *ServerImpl) GetScanResultsScanResultIDFamiliesFamilyItems(ctx echo.Context, scanResultID models.ScanResultID, family models.ScanResultFamily, params models.GetScanResultsScanResultIDFamiliesFamilyItemsParams) error {
+	items, err := s.dbHandler.ScanResultsTable().GetScanResultItems(scanResultID, family, params)
+	if err != nil {
+		var validationErr *common.BadRequestError
+		switch {
+		case errors.Is(err, databaseTypes.ErrNotFound):
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("ScanResult with ID %v not found", scanResultID))
+		case errors.As(err, &validationErr):
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		default:
+			return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scan result items from db. scanResultID=%v: %v", scanResultID, err))
+		}
+	}
+
+	return sendResponse(ctx, http.StatusOK, items)
+}
+
+func (s *ServerImpl) PostScanResultsScanResultIDFamiliesFamilyItems(ctx echo.Context, scanResultID models.ScanResultID, family models.ScanResultFamily) error {
+	var page models.ScanResultItems
+	err := ctx.Bind(&page)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+
+	items, err := s.dbHandler.ScanResultsTable().SetScanResultItems(scanResultID, family, page)
+	if err != nil {
+		var validationErr *common.BadRequestError
+		var conflictErr *common.ConflictError
+		switch {
+		case errors.Is(err, databaseTypes.ErrNotFound):
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("ScanResult with ID %v not found", scanResultID))
+		case errors.As(err, &validationErr):
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		case errors.As(err, &conflictErr):
+			return sendError(ctx, http.StatusConflict, err.Error())
+		default:
+			return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to set scan result items in db. scanResultID=%v: %v", scanResultID, err))
+		}
+	}
+
+	return sendResponse(ctx, http.StatusOK, items)
+}
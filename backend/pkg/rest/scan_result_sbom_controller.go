@@ -0,0 +1,186 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/version"
+	"github.com/openclarity/vmclarity/shared/pkg/families/sbom"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const sbomToolName = "vmclarity"
+
+func (s *ServerImpl) GetScanResultsScanResultIDSbom(ctx echo.Context, scanResultID models.ScanResultID, params models.GetScanResultsScanResultIDSbomParams) error {
+	switch params.Format {
+	case models.CyclonedxJson, models.SpdxJson:
+	default:
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("unsupported SBOM format %q", params.Format))
+	}
+
+	scanResult, err := s.dbHandler.ScanResultsTable().GetScanResult(scanResultID, models.GetScanResultsScanResultIDParams{
+		Expand: utils.PointerTo("asset"),
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return sendError(ctx, http.StatusNotFound, err.Error())
+		}
+		return sendDBReadError(ctx, err, fmt.Sprintf("failed to get scan result from db. scanResultID=%v", scanResultID))
+	}
+	if scanResult.Sboms == nil || scanResult.Sboms.Packages == nil {
+		return sendError(ctx, http.StatusNotFound, fmt.Sprintf("scan result has no SBOM. scanResultID=%v", scanResultID))
+	}
+
+	bom, err := newScanResultBOM(scanResult)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to create SBOM: %v", err))
+	}
+	data, err := (&sbom.Results{SBOM: bom}).EncodeToBytes(string(params.Format))
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to encode SBOM: %v", err))
+	}
+
+	return ctx.JSONBlob(http.StatusOK, data)
+}
+
+// newScanResultBOM reconstructs the SBOM of the scan result from its stored
+// packages. The asset is the component the SBOM describes, and the scan
+// result, scan and asset IDs are recorded in the metadata properties.
+func newScanResultBOM(scanResult models.AssetScanResult) (*cdx.BOM, error) {
+	bom := cdx.NewBOM()
+	bom.SerialNumber = "urn:uuid:" + uuid.NewString()
+
+	timestamp := time.Now()
+	if scanResult.Status != nil && scanResult.Status.General != nil && scanResult.Status.General.LastTransitionTime != nil {
+		timestamp = *scanResult.Status.General.LastTransitionTime
+	}
+
+	properties := []cdx.Property{
+		{Name: "vmclarity:scanResultID", Value: utils.ValueOrZero(scanResult.Id)},
+	}
+	if scanResult.Scan != nil {
+		properties = append(properties, cdx.Property{Name: "vmclarity:scanID", Value: scanResult.Scan.Id})
+	}
+	var component *cdx.Component
+	if scanResult.Asset != nil {
+		properties = append(properties, cdx.Property{Name: "vmclarity:assetID", Value: scanResult.Asset.Id})
+		if scanResult.Asset.AssetInfo != nil {
+			assetComponent, err := assetToComponent(*scanResult.Asset.AssetInfo)
+			if err != nil {
+				return nil, err
+			}
+			component = &assetComponent
+		}
+	}
+
+	bom.Metadata = &cdx.Metadata{
+		Timestamp: timestamp.UTC().Format(time.RFC3339),
+		Tools: &[]cdx.Tool{
+			{Name: sbomToolName, Version: version.Version},
+		},
+		Component:  component,
+		Properties: &properties,
+	}
+
+	components := make([]cdx.Component, 0, len(*scanResult.Sboms.Packages))
+	for _, pkg := range *scanResult.Sboms.Packages {
+		components = append(components, packageToComponent(pkg))
+	}
+	bom.Components = &components
+
+	return bom, nil
+}
+
+// assetToComponent returns the component of the asset the SBOM describes.
+func assetToComponent(assetInfo models.AssetType) (cdx.Component, error) {
+	discriminator, err := assetInfo.ValueByDiscriminator()
+	if err != nil {
+		return cdx.Component{}, fmt.Errorf("failed to get value by discriminator: %w", err)
+	}
+
+	switch info := discriminator.(type) {
+	case models.VMInfo:
+		return cdx.Component{
+			Type:        cdx.ComponentTypeDevice,
+			Name:        info.InstanceID,
+			Description: info.Image,
+		}, nil
+	case models.VMImageInfo:
+		return cdx.Component{
+			Type: cdx.ComponentTypeOS,
+			Name: info.Image,
+		}, nil
+	case models.ContainerImageInfo:
+		return cdx.Component{
+			Type:    cdx.ComponentTypeContainer,
+			Name:    info.Repository,
+			Version: info.ImageID,
+		}, nil
+	case models.DirInfo:
+		return cdx.Component{
+			Type: cdx.ComponentTypeFile,
+			Name: utils.ValueOrZero(info.DirName),
+		}, nil
+	case models.PodInfo:
+		return cdx.Component{
+			Type: cdx.ComponentTypeContainer,
+			Name: utils.ValueOrZero(info.PodName),
+		}, nil
+	case models.SBOMInfo:
+		return cdx.Component{
+			Type:    cdx.ComponentTypeApplication,
+			Name:    info.Name,
+			Version: utils.ValueOrZero(info.Version),
+		}, nil
+	default:
+		return cdx.Component{}, fmt.Errorf("asset type is not supported (%T)", discriminator)
+	}
+}
+
+// packageToComponent is the reverse of the conversion of the analyzed
+// components to packages done by the CLI.
+func packageToComponent(pkg models.Package) cdx.Component {
+	component := cdx.Component{
+		Type:       cdx.ComponentType(utils.ValueOrZero(pkg.Type)),
+		Name:       utils.ValueOrZero(pkg.Name),
+		Version:    utils.ValueOrZero(pkg.Version),
+		PackageURL: utils.ValueOrZero(pkg.Purl),
+	}
+	if component.Type == "" {
+		component.Type = cdx.ComponentTypeLibrary
+	}
+	if pkg.Cpes != nil && len(*pkg.Cpes) > 0 {
+		component.CPE = (*pkg.Cpes)[0]
+	}
+	if pkg.Licenses != nil && len(*pkg.Licenses) > 0 {
+		licenses := make(cdx.Licenses, 0, len(*pkg.Licenses))
+		for _, license := range *pkg.Licenses {
+			licenses = append(licenses, cdx.LicenseChoice{License: &cdx.License{ID: license}})
+		}
+		component.Licenses = &licenses
+	}
+	return component
+}
@@ -0,0 +1,85 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/auth"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
+)
+
+func (s *ServerImpl) GetScanResultsScanResultIDScannerConfig(ctx echo.Context, scanResultID models.ScanResultID) error {
+	token := ctx.Request().Header.Get(backendclient.ScannerTokenHeader)
+	if token == "" {
+		return sendError(ctx, http.StatusUnauthorized, fmt.Sprintf("missing %s header", backendclient.ScannerTokenHeader))
+	}
+	valid, err := s.dbHandler.ScannerConfigsTable().IsScannerTokenValid(scanResultID, auth.HashScannerToken(token))
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scanner token from db. scanResultID=%v: %v", scanResultID, err))
+	}
+	if !valid {
+		return sendError(ctx, http.StatusUnauthorized, "invalid or expired scanner token")
+	}
+
+	scannerConfig, err := s.dbHandler.ScannerConfigsTable().GetScannerConfig(scanResultID)
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("Scanner config of ScanResult with ID %v not found", scanResultID))
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scanner config from db. scanResultID=%v: %v", scanResultID, err))
+	}
+	return sendResponse(ctx, http.StatusOK, scannerConfig)
+}
+
+func (s *ServerImpl) PutScanResultsScanResultIDScannerConfig(ctx echo.Context, scanResultID models.ScanResultID) error {
+	var scannerConfig models.ScannerConfig
+	err := ctx.Bind(&scannerConfig)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+
+	token, tokenHash, err := auth.GenerateScannerToken()
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, err.Error())
+	}
+
+	updatedScannerConfig, err := s.dbHandler.ScannerConfigsTable().SetScannerConfig(scanResultID, scannerConfig, tokenHash, time.Now().Add(auth.ScannerTokenTTL))
+	if err != nil {
+		var validationErr *common.BadRequestError
+		switch {
+		case errors.Is(err, databaseTypes.ErrNotFound):
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("ScanResult with ID %v not found", scanResultID))
+		case errors.As(err, &validationErr):
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		default:
+			return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to set scanner config in db. scanResultID=%v: %v", scanResultID, err))
+		}
+	}
+
+	// The token is only ever returned here
+	updatedScannerConfig.Token = &token
+
+	return sendResponse(ctx, http.StatusOK, updatedScannerConfig)
+}
@@ -0,0 +1,103 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"gotest.tools/v3/assert"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/database/gorm"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestServerImpl_ScannerConfigToken(t *testing.T) {
+	db, err := gorm.NewDatabase(databaseTypes.DBConfig{
+		DriverType:  databaseTypes.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.sqlite"),
+	})
+	assert.NilError(t, err)
+
+	scanResult, err := db.ScanResultsTable().CreateScanResult(models.AssetScanResult{
+		Scan:  &models.ScanRelationship{Id: "scan"},
+		Asset: &models.AssetRelationship{Id: "asset"},
+		Status: &models.AssetScanStatus{
+			General: &models.AssetScanState{State: utils.PointerTo(models.AssetScanStateStatePending)},
+		},
+	})
+	assert.NilError(t, err)
+	s := &ServerImpl{dbHandler: db}
+
+	putScannerConfig := func() models.ScannerConfig {
+		req := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(`{"config":"sbom:\n  enabled: true\n"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		err := s.PutScanResultsScanResultIDScannerConfig(echo.New().NewContext(req, rec), *scanResult.Id)
+		assert.NilError(t, err)
+		assert.Equal(t, rec.Code, http.StatusOK, rec.Body.String())
+
+		var scannerConfig models.ScannerConfig
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &scannerConfig))
+		assert.Assert(t, scannerConfig.Token != nil && *scannerConfig.Token != "")
+		assert.Assert(t, scannerConfig.TokenExpiresAt != nil)
+		return scannerConfig
+	}
+	getScannerConfig := func(scanResultID, token string) int {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if token != "" {
+			req.Header.Set(backendclient.ScannerTokenHeader, token)
+		}
+		rec := httptest.NewRecorder()
+		err := s.GetScanResultsScanResultIDScannerConfig(echo.New().NewContext(req, rec), scanResultID)
+		assert.NilError(t, err)
+		if rec.Code == http.StatusOK {
+			assert.Assert(t, !strings.Contains(rec.Body.String(), "token"), rec.Body.String())
+		}
+		return rec.Code
+	}
+
+	first := putScannerConfig()
+	assert.Equal(t, getScannerConfig(*scanResult.Id, *first.Token), http.StatusOK)
+	assert.Equal(t, getScannerConfig(*scanResult.Id, ""), http.StatusUnauthorized)
+	assert.Equal(t, getScannerConfig(*scanResult.Id, "invalid"), http.StatusUnauthorized)
+
+	// The Scanners created with the previous tokens still fetch the config
+	// once it is set again.
+	second := putScannerConfig()
+	assert.Assert(t, *first.Token != *second.Token)
+	assert.Equal(t, getScannerConfig(*scanResult.Id, *first.Token), http.StatusOK)
+	assert.Equal(t, getScannerConfig(*scanResult.Id, *second.Token), http.StatusOK)
+
+	// The token is only valid for its own ScanResult.
+	other, err := db.ScanResultsTable().CreateScanResult(models.AssetScanResult{
+		Scan:  &models.ScanRelationship{Id: "scan"},
+		Asset: &models.AssetRelationship{Id: "other"},
+		Status: &models.AssetScanStatus{
+			General: &models.AssetScanState{State: utils.PointerTo(models.AssetScanStateStatePending)},
+		},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, getScannerConfig(*other.Id, *first.Token), http.StatusUnauthorized)
+}
@@ -0,0 +1,228 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// scannerSuppressionAttempts is the number of times the suppression is added
+// to the scan config if it is modified concurrently.
+const scannerSuppressionAttempts = 3
+
+func (s *ServerImpl) PostFindingsFindingIDScannerSuppression(ctx echo.Context, findingID models.FindingID) error {
+	var request models.ScannerSuppressionRequest
+	if err := ctx.Bind(&request); err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+	if request.ScanConfigID == "" {
+		return sendError(ctx, http.StatusBadRequest, "scanConfigID must be provided")
+	}
+
+	finding, err := s.dbHandler.FindingsTable().GetFinding(findingID, models.GetFindingsFindingIDParams{})
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("Finding with ID %v not found", findingID))
+		}
+		return sendDBReadError(ctx, err, fmt.Sprintf("failed to get finding from db. findingID=%v", findingID))
+	}
+
+	audit := models.SuppressionAudit{
+		FindingID: &findingID,
+		Reason:    request.Reason,
+		CreatedBy: utils.PointerTo(userIdentity(ctx, s.userIdentityHeader)),
+		CreatedAt: utils.PointerTo(time.Now().UTC()),
+	}
+	suppression, err := newScannerSuppression(finding, audit)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, err.Error())
+	}
+	suppression.ScanConfigID = &request.ScanConfigID
+
+	for attempt := 0; attempt < scannerSuppressionAttempts; attempt++ {
+		scanConfig, err := s.dbHandler.ScanConfigsTable().GetScanConfig(request.ScanConfigID, models.GetScanConfigsScanConfigIDParams{})
+		if err != nil {
+			if errors.Is(err, databaseTypes.ErrNotFound) {
+				return sendError(ctx, http.StatusNotFound, fmt.Sprintf("ScanConfig with ID %v not found", request.ScanConfigID))
+			}
+			return sendDBReadError(ctx, err, fmt.Sprintf("failed to get scan config from db. scanConfigID=%v", request.ScanConfigID))
+		}
+
+		familiesConfig := utils.ValueOrZero(scanConfig.ScanFamiliesConfig)
+		if existing, ok := findScannerSuppression(familiesConfig, suppression); ok {
+			existing.ScanConfigID = suppression.ScanConfigID
+			return sendResponse(ctx, http.StatusOK, existing)
+		}
+		if request.DryRun != nil && *request.DryRun {
+			return sendResponse(ctx, http.StatusOK, suppression)
+		}
+
+		addScannerSuppression(&familiesConfig, suppression)
+		_, err = s.dbHandler.ScanConfigsTable().UpdateScanConfig(models.ScanConfig{
+			Id:                 scanConfig.Id,
+			ScanFamiliesConfig: &familiesConfig,
+		}, models.PatchScanConfigsScanConfigIDParams{
+			IfMatch: scanConfig.Revision,
+		})
+		if err == nil {
+			return sendResponse(ctx, http.StatusCreated, suppression)
+		}
+
+		var preconditionFailedErr *databaseTypes.PreconditionFailedError
+		if !errors.As(err, &preconditionFailedErr) {
+			return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to update scan config in db. scanConfigID=%v: %v", request.ScanConfigID, err))
+		}
+	}
+
+	return sendError(ctx, http.StatusConflict, fmt.Sprintf("scan config %v was modified concurrently, please retry", request.ScanConfigID))
+}
+
+// newScannerSuppression returns the suppression of the finding in the scanner
+// which reported it.
+func newScannerSuppression(finding models.Finding, audit models.SuppressionAudit) (models.ScannerSuppression, error) {
+	if finding.FindingInfo == nil {
+		return models.ScannerSuppression{}, errors.New("finding has no finding info")
+	}
+	objectType, err := finding.FindingInfo.Discriminator()
+	if err != nil {
+		return models.ScannerSuppression{}, fmt.Errorf("failed to get finding type: %w", err)
+	}
+
+	switch objectType {
+	case "Secret":
+		info, err := finding.FindingInfo.AsSecretFindingInfo()
+		if err != nil {
+			return models.ScannerSuppression{}, fmt.Errorf("failed to get secret finding info: %w", err)
+		}
+		if info.CredentialFingerprint == nil || *info.CredentialFingerprint == "" {
+			return models.ScannerSuppression{}, errors.New("secret finding has no credential fingerprint")
+		}
+		// The finding groups the occurrences of the credential in all
+		// the files, so it is allowed in any file.
+		return models.ScannerSuppression{
+			Secret: &models.SecretAllowlistEntry{
+				CredentialFingerprint: *info.CredentialFingerprint,
+				Audit:                 &audit,
+			},
+		}, nil
+	case "Vulnerability":
+		info, err := finding.FindingInfo.AsVulnerabilityFindingInfo()
+		if err != nil {
+			return models.ScannerSuppression{}, fmt.Errorf("failed to get vulnerability finding info: %w", err)
+		}
+		if info.VulnerabilityName == nil || *info.VulnerabilityName == "" {
+			return models.ScannerSuppression{}, errors.New("vulnerability finding has no vulnerability name")
+		}
+		rule := models.VulnerabilityIgnoreRule{
+			Vulnerability: *info.VulnerabilityName,
+			Audit:         &audit,
+		}
+		if info.Package != nil {
+			rule.PackageName = info.Package.Name
+			rule.PackageVersion = info.Package.Version
+		}
+		return models.ScannerSuppression{
+			Vulnerability: &rule,
+		}, nil
+	case "Misconfiguration":
+		info, err := finding.FindingInfo.AsMisconfigurationFindingInfo()
+		if err != nil {
+			return models.ScannerSuppression{}, fmt.Errorf("failed to get misconfiguration finding info: %w", err)
+		}
+		if scanner := utils.ValueOrZero(info.ScannerName); scanner != "" && scanner != "lynis" {
+			return models.ScannerSuppression{}, fmt.Errorf("misconfigurations of scanner %s can not be suppressed", scanner)
+		}
+		if info.TestID == nil || *info.TestID == "" {
+			return models.ScannerSuppression{}, errors.New("misconfiguration finding has no test ID")
+		}
+		return models.ScannerSuppression{
+			Misconfiguration: &models.MisconfigurationSkipTest{
+				TestID: *info.TestID,
+				Audit:  &audit,
+			},
+		}, nil
+	default:
+		return models.ScannerSuppression{}, fmt.Errorf("%s findings can not be suppressed in the scanner", objectType)
+	}
+}
+
+// findScannerSuppression returns the suppression of the families config which
+// suppresses the same findings, if any.
+func findScannerSuppression(config models.ScanFamiliesConfig, suppression models.ScannerSuppression) (models.ScannerSuppression, bool) {
+	switch {
+	case suppression.Secret != nil:
+		if config.Secrets == nil || config.Secrets.Allowlist == nil {
+			return models.ScannerSuppression{}, false
+		}
+		for _, e := range *config.Secrets.Allowlist {
+			if e.CredentialFingerprint == suppression.Secret.CredentialFingerprint &&
+				utils.ValueOrZero(e.FilePath) == utils.ValueOrZero(suppression.Secret.FilePath) {
+				return models.ScannerSuppression{Secret: utils.PointerTo(e)}, true
+			}
+		}
+	case suppression.Vulnerability != nil:
+		if config.Vulnerabilities == nil || config.Vulnerabilities.IgnoreRules == nil {
+			return models.ScannerSuppression{}, false
+		}
+		for _, r := range *config.Vulnerabilities.IgnoreRules {
+			if r.Vulnerability == suppression.Vulnerability.Vulnerability &&
+				utils.ValueOrZero(r.PackageName) == utils.ValueOrZero(suppression.Vulnerability.PackageName) &&
+				utils.ValueOrZero(r.PackageVersion) == utils.ValueOrZero(suppression.Vulnerability.PackageVersion) {
+				return models.ScannerSuppression{Vulnerability: utils.PointerTo(r)}, true
+			}
+		}
+	case suppression.Misconfiguration != nil:
+		if config.Misconfigurations == nil || config.Misconfigurations.SkipTests == nil {
+			return models.ScannerSuppression{}, false
+		}
+		for _, t := range *config.Misconfigurations.SkipTests {
+			if t.TestID == suppression.Misconfiguration.TestID {
+				return models.ScannerSuppression{Misconfiguration: utils.PointerTo(t)}, true
+			}
+		}
+	}
+	return models.ScannerSuppression{}, false
+}
+
+// addScannerSuppression adds the suppression to the families config.
+func addScannerSuppression(config *models.ScanFamiliesConfig, suppression models.ScannerSuppression) {
+	switch {
+	case suppression.Secret != nil:
+		if config.Secrets == nil {
+			config.Secrets = &models.SecretsConfig{}
+		}
+		config.Secrets.Allowlist = utils.PointerTo(append(utils.ValueOrZero(config.Secrets.Allowlist), *suppression.Secret))
+	case suppression.Vulnerability != nil:
+		if config.Vulnerabilities == nil {
+			config.Vulnerabilities = &models.VulnerabilitiesConfig{}
+		}
+		config.Vulnerabilities.IgnoreRules = utils.PointerTo(append(utils.ValueOrZero(config.Vulnerabilities.IgnoreRules), *suppression.Vulnerability))
+	case suppression.Misconfiguration != nil:
+		if config.Misconfigurations == nil {
+			config.Misconfigurations = &models.MisconfigurationsConfig{}
+		}
+		config.Misconfigurations.SkipTests = utils.PointerTo(append(utils.ValueOrZero(config.Misconfigurations.SkipTests), *suppression.Misconfiguration))
+	}
+}
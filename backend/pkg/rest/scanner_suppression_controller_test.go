@@ -0,0 +1,167 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func Test_newScannerSuppression(t *testing.T) {
+	audit := models.SuppressionAudit{
+		FindingID: utils.PointerTo("finding-1"),
+		Reason:    utils.PointerTo("test fixture"),
+		CreatedBy: utils.PointerTo("alice"),
+	}
+
+	secret := models.Finding_FindingInfo{}
+	assert.NilError(t, secret.FromSecretFindingInfo(models.SecretFindingInfo{
+		ObjectType:            "Secret",
+		FilePath:              utils.PointerTo("/app/test/fixture.pem"),
+		CredentialFingerprint: utils.PointerTo("abc123"),
+	}))
+	secretWithoutFingerprint := models.Finding_FindingInfo{}
+	assert.NilError(t, secretWithoutFingerprint.FromSecretFindingInfo(models.SecretFindingInfo{
+		ObjectType: "Secret",
+	}))
+	lynis := models.Finding_FindingInfo{}
+	assert.NilError(t, lynis.FromMisconfigurationFindingInfo(models.MisconfigurationFindingInfo{
+		ObjectType:  "Misconfiguration",
+		ScannerName: utils.PointerTo("lynis"),
+		TestID:      utils.PointerTo("SSH-7408"),
+	}))
+	otherScanner := models.Finding_FindingInfo{}
+	assert.NilError(t, otherScanner.FromMisconfigurationFindingInfo(models.MisconfigurationFindingInfo{
+		ObjectType:  "Misconfiguration",
+		ScannerName: utils.PointerTo("other"),
+		TestID:      utils.PointerTo("X-1"),
+	}))
+	malware := models.Finding_FindingInfo{}
+	assert.NilError(t, malware.FromMalwareFindingInfo(models.MalwareFindingInfo{
+		ObjectType: "Malware",
+	}))
+
+	tests := []struct {
+		name    string
+		finding models.Finding
+		want    models.ScannerSuppression
+		wantErr bool
+	}{
+		{
+			name:    "secret",
+			finding: models.Finding{FindingInfo: &secret},
+			want: models.ScannerSuppression{
+				Secret: &models.SecretAllowlistEntry{
+					CredentialFingerprint: "abc123",
+					Audit:                 &audit,
+				},
+			},
+		},
+		{
+			name:    "secret without credential fingerprint",
+			finding: models.Finding{FindingInfo: &secretWithoutFingerprint},
+			wantErr: true,
+		},
+		{
+			name:    "vulnerability",
+			finding: newVulnerabilityFinding(t, "CVE-2023-0001", "openssl", "1.1.1", "1.1.2"),
+			want: models.ScannerSuppression{
+				Vulnerability: &models.VulnerabilityIgnoreRule{
+					Vulnerability:  "CVE-2023-0001",
+					PackageName:    utils.PointerTo("openssl"),
+					PackageVersion: utils.PointerTo("1.1.1"),
+					Audit:          &audit,
+				},
+			},
+		},
+		{
+			name:    "lynis misconfiguration",
+			finding: models.Finding{FindingInfo: &lynis},
+			want: models.ScannerSuppression{
+				Misconfiguration: &models.MisconfigurationSkipTest{
+					TestID: "SSH-7408",
+					Audit:  &audit,
+				},
+			},
+		},
+		{
+			name:    "misconfiguration of another scanner",
+			finding: models.Finding{FindingInfo: &otherScanner},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported finding type",
+			finding: models.Finding{FindingInfo: &malware},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newScannerSuppression(tt.finding, audit)
+			if tt.wantErr {
+				assert.Assert(t, err != nil)
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, tt.want, got)
+		})
+	}
+}
+
+func Test_addScannerSuppression(t *testing.T) {
+	config := models.ScanFamiliesConfig{
+		Secrets: &models.SecretsConfig{Enabled: utils.PointerTo(true)},
+	}
+
+	suppressions := []models.ScannerSuppression{
+		{Secret: &models.SecretAllowlistEntry{CredentialFingerprint: "abc123"}},
+		{Vulnerability: &models.VulnerabilityIgnoreRule{Vulnerability: "CVE-2023-0001", PackageName: utils.PointerTo("openssl")}},
+		{Misconfiguration: &models.MisconfigurationSkipTest{TestID: "SSH-7408"}},
+	}
+	for _, s := range suppressions {
+		_, ok := findScannerSuppression(config, s)
+		assert.Assert(t, !ok)
+		addScannerSuppression(&config, s)
+		got, ok := findScannerSuppression(config, s)
+		assert.Assert(t, ok)
+		assert.DeepEqual(t, s, got)
+	}
+
+	want := models.ScanFamiliesConfig{
+		Secrets: &models.SecretsConfig{
+			Enabled:   utils.PointerTo(true),
+			Allowlist: &[]models.SecretAllowlistEntry{{CredentialFingerprint: "abc123"}},
+		},
+		Vulnerabilities: &models.VulnerabilitiesConfig{
+			IgnoreRules: &[]models.VulnerabilityIgnoreRule{{Vulnerability: "CVE-2023-0001", PackageName: utils.PointerTo("openssl")}},
+		},
+		Misconfigurations: &models.MisconfigurationsConfig{
+			SkipTests: &[]models.MisconfigurationSkipTest{{TestID: "SSH-7408"}},
+		},
+	}
+	assert.DeepEqual(t, want, config)
+
+	// The same vulnerability in another version of the package is not
+	// suppressed by the rule.
+	_, ok := findScannerSuppression(config, models.ScannerSuppression{
+		Vulnerability: &models.VulnerabilityIgnoreRule{Vulnerability: "CVE-2023-0001", PackageName: utils.PointerTo("openssl"), PackageVersion: utils.PointerTo("3.0")},
+	})
+	assert.Assert(t, !ok)
+}
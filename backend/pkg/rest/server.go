@@ -18,16 +18,27 @@ package rest
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/deepmap/oapi-codegen/pkg/middleware"
 	"github.com/labstack/echo/v4"
 	echomiddleware "github.com/labstack/echo/v4/middleware"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"golang.org/x/net/http2"
 
+	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/api/server"
+	"github.com/openclarity/vmclarity/backend/pkg/auth"
 	"github.com/openclarity/vmclarity/backend/pkg/common"
 	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/backend/pkg/feeds"
+	"github.com/openclarity/vmclarity/backend/pkg/maintenance"
+	"github.com/openclarity/vmclarity/backend/pkg/notifications"
+	"github.com/openclarity/vmclarity/backend/pkg/sbomscan"
+	"github.com/openclarity/vmclarity/backend/pkg/tasks"
 	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/objectstore"
 	uiserver "github.com/openclarity/vmclarity/ui_backend/api/server"
 	uirest "github.com/openclarity/vmclarity/ui_backend/pkg/rest"
 )
@@ -36,10 +47,47 @@ const (
 	shutdownTimeoutSec = 10
 	BaseURL            = "/api"
 	UIBackendBaseURL   = "/ui/api"
+	MetricsURL         = "/metrics"
 )
 
 type ServerImpl struct {
-	dbHandler databaseTypes.Database
+	dbHandler  databaseTypes.Database
+	limits     UsageLimits
+	operations *operations
+	providers  []models.Provider
+	notifier   *notifications.Notifier
+	scheduler  *tasks.Scheduler
+
+	// maintenance is the maintenance mode of the backend, the writes are
+	// rejected while it is enabled.
+	maintenance *maintenance.State
+
+	// sbomScanner scans the SBOMs uploaded to the backend.
+	sbomScanner *sbomscan.Scanner
+
+	// objectStore stores the generated reports and the artifacts of the
+	// scan results, it is nil if no object store is configured.
+	objectStore objectstore.Store
+	// maxArtifactSize is the maximum size of an uploaded artifact in bytes,
+	// zero means unlimited.
+	maxArtifactSize int64
+
+	// feedSyncer mirrors the vulnerability, exploit and malware feeds for
+	// the scanners, it is nil if no feed is configured.
+	feedSyncer *feeds.Syncer
+
+	// userIdentityHeader is the request header the authenticating proxy
+	// reports the identity of the user in.
+	userIdentityHeader string
+}
+
+// UsageLimits holds the configured quota limits, a zero value means unlimited.
+type UsageLimits struct {
+	MaxScansPerMonth                int
+	MaxScannerInstanceHoursPerMonth int
+	// TenantTag is the key of the tag holding the tenant of the VM assets
+	// the usage is reported for, DefaultUsageTenantTag if not set.
+	TenantTag string
 }
 
 type Server struct {
@@ -47,8 +95,8 @@ type Server struct {
 	echoServer *echo.Echo
 }
 
-func CreateRESTServer(port int, dbHandler databaseTypes.Database, uiSitePath string, uiBackendAPIImpl *uirest.ServerImpl) (*Server, error) {
-	e, err := createEchoServer(dbHandler, uiSitePath, uiBackendAPIImpl)
+func CreateRESTServer(port int, dbHandler databaseTypes.Database, limits UsageLimits, providers []models.Provider, notifier *notifications.Notifier, scheduler *tasks.Scheduler, sbomScanner *sbomscan.Scanner, objectStore objectstore.Store, maxArtifactSize int64, feedSyncer *feeds.Syncer, authenticator *auth.Authenticator, userIdentityHeader string, uiSitePath string, uiBackendAPIImpl *uirest.ServerImpl) (*Server, error) {
+	e, err := createEchoServer(dbHandler, limits, providers, notifier, scheduler, sbomScanner, objectStore, maxArtifactSize, feedSyncer, authenticator, userIdentityHeader, uiSitePath, uiBackendAPIImpl)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create rest server: %v", err)
 	}
@@ -58,7 +106,7 @@ func CreateRESTServer(port int, dbHandler databaseTypes.Database, uiSitePath str
 	}, nil
 }
 
-func createEchoServer(dbHandler databaseTypes.Database, uiSitePath string, uiBackendAPIImpl *uirest.ServerImpl) (*echo.Echo, error) {
+func createEchoServer(dbHandler databaseTypes.Database, limits UsageLimits, providers []models.Provider, notifier *notifications.Notifier, scheduler *tasks.Scheduler, sbomScanner *sbomscan.Scanner, objectStore objectstore.Store, maxArtifactSize int64, feedSyncer *feeds.Syncer, authenticator *auth.Authenticator, userIdentityHeader string, uiSitePath string, uiBackendAPIImpl *uirest.ServerImpl) (*echo.Echo, error) {
 	swagger, err := server.GetSwagger()
 	if err != nil {
 		return nil, fmt.Errorf("failed to load swagger spec: %v", err)
@@ -66,24 +114,65 @@ func createEchoServer(dbHandler databaseTypes.Database, uiSitePath string, uiBac
 
 	e := echo.New()
 
+	// Translate the requests of the clients of the API from before the
+	// rename of the Target objects to Asset
+	e.Pre(targetsCompatMiddleware())
+
 	// Log all requests
 	e.Use(echomiddleware.Logger())
 
 	// Recover any panics into HTTP 500
 	e.Use(echomiddleware.Recover())
 
+	// Compress responses for the clients accepting it
+	e.Use(compressMiddleware())
+
+	// Expose the metrics of the backend and its orchestrator
+	e.GET(MetricsURL, echo.WrapHandler(promhttp.Handler()))
+
 	// Create a router group for the backend /api base URL
 	apiGroup := e.Group(BaseURL)
 
+	// Authenticate the requests before validating them if enabled
+	if authenticator != nil {
+		apiGroup.Use(authenticator.Middleware())
+		apiGroup.Use(authorizationMiddleware())
+		apiGroup.Use(assetScopeMiddleware(dbHandler))
+	}
+
+	// Reject the writes while the backend is in maintenance mode
+	maintenanceState := maintenance.NewState(dbHandler)
+	apiGroup.Use(maintenanceMiddleware(maintenanceState))
+
 	// Use oapi-codegen validation middleware to validate
 	// the API group against the OpenAPI schema.
-	apiGroup.Use(middleware.OapiRequestValidator(swagger))
+	apiGroup.Use(middleware.OapiRequestValidatorWithOptions(swagger, &middleware.Options{
+		Skipper: skipJSONPatchValidation,
+	}))
 
 	apiImpl := &ServerImpl{
-		dbHandler: dbHandler,
+		dbHandler:  dbHandler,
+		limits:     limits,
+		operations: newOperations(),
+		providers:  providers,
+		notifier:   notifier,
+		scheduler:  scheduler,
+
+		maintenance: maintenanceState,
+
+		sbomScanner:     sbomScanner,
+		objectStore:     objectStore,
+		maxArtifactSize: maxArtifactSize,
+		feedSyncer:      feedSyncer,
+
+		userIdentityHeader: userIdentityHeader,
 	}
-	// Register paths with the backend implementation
-	server.RegisterHandlers(apiGroup, apiImpl)
+	// Register paths with the backend implementation, the GET requests are
+	// served from the read replica of the database if there is one.
+	readOnlyAPIImpl := *apiImpl
+	readOnlyAPIImpl.dbHandler = dbHandler.ReadOnly()
+	server.RegisterHandlers(&methodRouter{EchoRouter: apiGroup, get: true}, &readOnlyAPIImpl)
+	server.RegisterHandlers(&methodRouter{EchoRouter: apiGroup, get: false}, apiImpl)
 
 	uiBackendSwagger, err := uiserver.GetSwagger()
 	if err != nil {
@@ -92,6 +181,12 @@ func createEchoServer(dbHandler databaseTypes.Database, uiSitePath string, uiBac
 	// Create a router group for the UI backend /ui/api base URL
 	uiBackendAPIGroup := e.Group(UIBackendBaseURL)
 
+	if authenticator != nil {
+		uiBackendAPIGroup.Use(authenticator.Middleware())
+		uiBackendAPIGroup.Use(authorizationMiddleware())
+		uiBackendAPIGroup.Use(assetScopeMiddleware(dbHandler))
+	}
+
 	uiBackendAPIGroup.Use(middleware.OapiRequestValidator(uiBackendSwagger))
 
 	// Register paths with the UI backend implementation
@@ -108,12 +203,19 @@ func createEchoServer(dbHandler databaseTypes.Database, uiSitePath string, uiBac
 	return e, nil
 }
 
+// Handler returns the http.Handler of the server, e.g. to serve it in-process
+// with httptest.
+func (s *Server) Handler() http.Handler {
+	return s.echoServer
+}
+
 func (s *Server) Start(ctx context.Context, errChan chan struct{}) {
 	logger := log.GetLoggerFromContextOrDiscard(ctx)
 
 	logger.Infof("Starting REST server")
 	go func() {
-		if err := s.echoServer.Start(fmt.Sprintf("0.0.0.0:%d", s.port)); err != nil {
+		// Serve HTTP/2 over cleartext (h2c) along with HTTP/1.1
+		if err := s.echoServer.StartH2CServer(fmt.Sprintf("0.0.0.0:%d", s.port), &http2.Server{}); err != nil {
 			logger.Errorf("Failed to start REST server: %v", err)
 			errChan <- common.Empty
 		}
@@ -133,3 +235,74 @@ func (s *Server) Stop(ctx context.Context) {
 		}
 	}
 }
+
+// methodRouter only registers the routes of the GET requests with the
+// EchoRouter if get is set, and the routes of the other requests otherwise,
+// so that they can be served by different implementations.
+type methodRouter struct {
+	server.EchoRouter
+	get bool
+}
+
+func (r *methodRouter) CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	if r.get {
+		return nil
+	}
+	return r.EchoRouter.CONNECT(path, h, m...)
+}
+
+func (r *methodRouter) DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	if r.get {
+		return nil
+	}
+	return r.EchoRouter.DELETE(path, h, m...)
+}
+
+func (r *methodRouter) GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	if !r.get {
+		return nil
+	}
+	return r.EchoRouter.GET(path, h, m...)
+}
+
+func (r *methodRouter) HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	if r.get {
+		return nil
+	}
+	return r.EchoRouter.HEAD(path, h, m...)
+}
+
+func (r *methodRouter) OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	if r.get {
+		return nil
+	}
+	return r.EchoRouter.OPTIONS(path, h, m...)
+}
+
+func (r *methodRouter) PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	if r.get {
+		return nil
+	}
+	return r.EchoRouter.PATCH(path, h, m...)
+}
+
+func (r *methodRouter) POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	if r.get {
+		return nil
+	}
+	return r.EchoRouter.POST(path, h, m...)
+}
+
+func (r *methodRouter) PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	if r.get {
+		return nil
+	}
+	return r.EchoRouter.PUT(path, h, m...)
+}
+
+func (r *methodRouter) TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	if r.get {
+		return nil
+	}
+	return r.EchoRouter.TRACE(path, h, m...)
+}
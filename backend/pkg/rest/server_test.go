@@ -0,0 +1,65 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"net/http"
+	"sort"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"gotest.tools/v3/assert"
+)
+
+func Test_methodRouter(t *testing.T) {
+	tests := []struct {
+		name       string
+		get        bool
+		wantRoutes []string
+	}{
+		{
+			name:       "GET routes only",
+			get:        true,
+			wantRoutes: []string{"GET /api/scans"},
+		},
+		{
+			name:       "all the other routes",
+			get:        false,
+			wantRoutes: []string{"DELETE /api/scans/:id", "PATCH /api/scans/:id", "POST /api/scans"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			router := &methodRouter{EchoRouter: e.Group("/api"), get: tt.get}
+			h := func(echo.Context) error { return nil }
+			router.GET("/scans", h)
+			router.POST("/scans", h)
+			router.PATCH("/scans/:id", h)
+			router.DELETE("/scans/:id", h)
+
+			var routes []string
+			for _, route := range e.Routes() {
+				if route.Method == http.MethodGet || route.Method == http.MethodPost ||
+					route.Method == http.MethodPatch || route.Method == http.MethodDelete {
+					routes = append(routes, route.Method+" "+route.Path)
+				}
+			}
+			sort.Strings(routes)
+			assert.DeepEqual(t, routes, tt.wantRoutes)
+		})
+	}
+}
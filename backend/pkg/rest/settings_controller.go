@@ -0,0 +1,127 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/findingtemplate"
+)
+
+func (s *ServerImpl) GetSettingsRetention(ctx echo.Context) error {
+	retentionSettings, err := s.dbHandler.SettingsTable().GetRetentionSettings()
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get retention settings from db: %v", err))
+	}
+	return sendResponse(ctx, http.StatusOK, retentionSettings)
+}
+
+func (s *ServerImpl) PutSettingsRetention(ctx echo.Context) error {
+	var retentionSettings models.RetentionSettings
+	err := ctx.Bind(&retentionSettings)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+
+	updatedRetentionSettings, err := s.dbHandler.SettingsTable().SetRetentionSettings(retentionSettings)
+	if err != nil {
+		var validationErr *common.BadRequestError
+		if errors.As(err, &validationErr) {
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to set retention settings in db: %v", err))
+	}
+
+	return sendResponse(ctx, http.StatusOK, updatedRetentionSettings)
+}
+
+func (s *ServerImpl) GetSettingsFindingTemplates(ctx echo.Context) error {
+	findingTemplateSettings, err := s.dbHandler.SettingsTable().GetFindingTemplateSettings()
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get finding templates from db: %v", err))
+	}
+	return sendResponse(ctx, http.StatusOK, findingTemplateSettings)
+}
+
+func (s *ServerImpl) PutSettingsFindingTemplates(ctx echo.Context) error {
+	var findingTemplateSettings models.FindingTemplateSettings
+	err := ctx.Bind(&findingTemplateSettings)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+
+	updatedFindingTemplateSettings, err := s.dbHandler.SettingsTable().SetFindingTemplateSettings(findingTemplateSettings)
+	if err != nil {
+		var validationErr *common.BadRequestError
+		if errors.As(err, &validationErr) {
+			return sendError(ctx, http.StatusBadRequest, err.Error())
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to set finding templates in db: %v", err))
+	}
+
+	return sendResponse(ctx, http.StatusOK, updatedFindingTemplateSettings)
+}
+
+func (s *ServerImpl) PostSettingsFindingTemplatesPreview(ctx echo.Context) error {
+	var preview models.FindingTemplatePreview
+	err := ctx.Bind(&preview)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+
+	findingTemplateSettings := preview.Templates
+	if findingTemplateSettings == nil {
+		current, err := s.dbHandler.SettingsTable().GetFindingTemplateSettings()
+		if err != nil {
+			return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get finding templates from db: %v", err))
+		}
+		findingTemplateSettings = &current
+	}
+	templates, err := findingtemplate.Parse(*findingTemplateSettings)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, err.Error())
+	}
+
+	var finding models.Finding
+	switch {
+	case preview.Finding != nil:
+		finding = *preview.Finding
+	case preview.FindingID != nil:
+		finding, err = s.dbHandler.FindingsTable().GetFinding(*preview.FindingID, models.GetFindingsFindingIDParams{})
+		if err != nil {
+			if errors.Is(err, databaseTypes.ErrNotFound) {
+				return sendError(ctx, http.StatusNotFound, fmt.Sprintf("Finding with ID %v not found", *preview.FindingID))
+			}
+			return sendDBReadError(ctx, err, fmt.Sprintf("failed to get finding from db. findingID=%v", *preview.FindingID))
+		}
+	default:
+		return sendError(ctx, http.StatusBadRequest, "either finding or findingID must be set")
+	}
+
+	rendered, err := templates.Render(finding)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, err.Error())
+	}
+
+	return sendResponse(ctx, http.StatusOK, rendered)
+}
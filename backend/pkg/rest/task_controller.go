@@ -0,0 +1,51 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/tasks"
+)
+
+func (s *ServerImpl) GetAdminTasks(ctx echo.Context) error {
+	items := []models.BackgroundTask{}
+	if s.scheduler != nil {
+		items = s.scheduler.Tasks()
+	}
+
+	return sendResponse(ctx, http.StatusOK, models.BackgroundTasks{Items: &items})
+}
+
+func (s *ServerImpl) PostAdminTasksTaskNameRun(ctx echo.Context, taskName string) error {
+	if s.scheduler == nil {
+		return sendError(ctx, http.StatusNotFound, "background tasks are not scheduled")
+	}
+
+	task, err := s.scheduler.Trigger(taskName)
+	if err != nil {
+		if errors.Is(err, tasks.ErrTaskNotFound) {
+			return sendError(ctx, http.StatusNotFound, err.Error())
+		}
+		return sendError(ctx, http.StatusInternalServerError, err.Error())
+	}
+
+	return sendResponse(ctx, http.StatusAccepted, task)
+}
@@ -0,0 +1,143 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func (s *ServerImpl) GetAssetsAssetIDUpgradePlan(ctx echo.Context, assetID models.AssetID, params models.GetAssetsAssetIDUpgradePlanParams) error {
+	_, err := s.dbHandler.AssetsTable().GetAsset(assetID, models.GetAssetsAssetIDParams{
+		Select: utils.PointerTo("id"),
+	})
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendError(ctx, http.StatusNotFound, fmt.Sprintf("Asset with ID %v not found", assetID))
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get asset from db. assetID=%v: %v", assetID, err))
+	}
+
+	if utils.ValueOrZero(params.Async) {
+		return s.startOperation(ctx, models.AssetUpgradePlan, func() (interface{}, error) {
+			return s.getUpgradePlan(assetID)
+		})
+	}
+
+	upgradePlan, err := s.getUpgradePlan(assetID)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, err.Error())
+	}
+
+	return sendResponse(ctx, http.StatusOK, upgradePlan)
+}
+
+func (s *ServerImpl) getUpgradePlan(assetID models.AssetID) (models.UpgradePlan, error) {
+	findings, err := s.dbHandler.FindingsTable().GetFindings(models.GetFindingsParams{
+		Filter: utils.PointerTo(fmt.Sprintf(
+			"findingInfo/objectType eq 'Vulnerability' and asset/id eq '%s' and invalidatedOn eq null and findingInfo/hasFix eq true",
+			assetID)),
+		Select: utils.PointerTo("findingInfo/objectType,findingInfo/vulnerabilityName,findingInfo/package,findingInfo/fixVersion"),
+	})
+	if err != nil {
+		return models.UpgradePlan{}, fmt.Errorf("failed to get findings from db: %w", err)
+	}
+
+	var items []models.Finding
+	if findings.Items != nil {
+		items = *findings.Items
+	}
+	upgrades, err := buildUpgradePlan(items)
+	if err != nil {
+		return models.UpgradePlan{}, fmt.Errorf("failed to build upgrade plan: %w", err)
+	}
+
+	return models.UpgradePlan{Upgrades: &upgrades}, nil
+}
+
+type upgradePlanPackageKey struct {
+	name    string
+	typ     string
+	version string
+}
+
+// buildUpgradePlan groups the fixable vulnerability findings by package,
+// the upgrade version of a package is the highest fix version of its
+// vulnerabilities so that a single upgrade resolves all of them. Upgrades
+// are ordered by the number of findings they resolve.
+func buildUpgradePlan(findings []models.Finding) ([]models.PackageUpgrade, error) {
+	upgradesByPackage := map[upgradePlanPackageKey]*models.PackageUpgrade{}
+	for _, finding := range findings {
+		if finding.FindingInfo == nil {
+			continue
+		}
+		vuln, err := finding.FindingInfo.AsVulnerabilityFindingInfo()
+		if err != nil {
+			return nil, fmt.Errorf("unable to get vulnerability finding info: %w", err)
+		}
+		if vuln.Package == nil || vuln.FixVersion == nil || *vuln.FixVersion == "" {
+			continue
+		}
+
+		key := upgradePlanPackageKey{
+			name:    utils.ValueOrZero(vuln.Package.Name),
+			typ:     utils.ValueOrZero(vuln.Package.Type),
+			version: utils.ValueOrZero(vuln.Package.Version),
+		}
+		upgrade, ok := upgradesByPackage[key]
+		if !ok {
+			upgrade = &models.PackageUpgrade{
+				Name:             vuln.Package.Name,
+				Type:             vuln.Package.Type,
+				CurrentVersion:   vuln.Package.Version,
+				FixVersion:       vuln.FixVersion,
+				ResolvedFindings: utils.PointerTo(0),
+				Vulnerabilities:  &[]string{},
+			}
+			upgradesByPackage[key] = upgrade
+		}
+
+		if utils.CompareVersions(*vuln.FixVersion, *upgrade.FixVersion) > 0 {
+			upgrade.FixVersion = vuln.FixVersion
+		}
+		*upgrade.ResolvedFindings++
+		if vuln.VulnerabilityName != nil && !utils.Contains(*upgrade.Vulnerabilities, *vuln.VulnerabilityName) {
+			*upgrade.Vulnerabilities = append(*upgrade.Vulnerabilities, *vuln.VulnerabilityName)
+		}
+	}
+
+	upgrades := make([]models.PackageUpgrade, 0, len(upgradesByPackage))
+	for _, upgrade := range upgradesByPackage {
+		sort.Strings(*upgrade.Vulnerabilities)
+		upgrades = append(upgrades, *upgrade)
+	}
+	sort.Slice(upgrades, func(i, j int) bool {
+		if *upgrades[i].ResolvedFindings != *upgrades[j].ResolvedFindings {
+			return *upgrades[i].ResolvedFindings > *upgrades[j].ResolvedFindings
+		}
+		return utils.ValueOrZero(upgrades[i].Name) < utils.ValueOrZero(upgrades[j].Name)
+	})
+
+	return upgrades, nil
+}
@@ -0,0 +1,121 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"gotest.tools/v3/assert"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func newVulnerabilityFinding(t *testing.T, name, pkgName, pkgVersion, fixVersion string) models.Finding {
+	t.Helper()
+
+	findingInfo := models.Finding_FindingInfo{}
+	err := findingInfo.FromVulnerabilityFindingInfo(models.VulnerabilityFindingInfo{
+		ObjectType:        "Vulnerability",
+		VulnerabilityName: utils.PointerTo(name),
+		Package: &models.Package{
+			Name:    utils.PointerTo(pkgName),
+			Type:    utils.PointerTo("deb"),
+			Version: utils.PointerTo(pkgVersion),
+		},
+		HasFix:     utils.PointerTo(true),
+		FixVersion: utils.PointerTo(fixVersion),
+	})
+	assert.NilError(t, err)
+
+	return models.Finding{FindingInfo: &findingInfo}
+}
+
+func Test_buildUpgradePlan(t *testing.T) {
+	tests := []struct {
+		name     string
+		findings func(t *testing.T) []models.Finding
+		want     []models.PackageUpgrade
+	}{
+		{
+			name: "no findings",
+			findings: func(t *testing.T) []models.Finding {
+				t.Helper()
+				return nil
+			},
+			want: []models.PackageUpgrade{},
+		},
+		{
+			name: "upgrades are grouped per package and ordered by resolved findings",
+			findings: func(t *testing.T) []models.Finding {
+				t.Helper()
+				return []models.Finding{
+					newVulnerabilityFinding(t, "CVE-3", "curl", "7.74.0", "7.74.1"),
+					newVulnerabilityFinding(t, "CVE-1", "openssl", "1.1.1", "1.1.1n"),
+					newVulnerabilityFinding(t, "CVE-2", "openssl", "1.1.1", "1.1.1t"),
+				}
+			},
+			want: []models.PackageUpgrade{
+				{
+					Name:             utils.PointerTo("openssl"),
+					Type:             utils.PointerTo("deb"),
+					CurrentVersion:   utils.PointerTo("1.1.1"),
+					FixVersion:       utils.PointerTo("1.1.1t"),
+					ResolvedFindings: utils.PointerTo(2),
+					Vulnerabilities:  &[]string{"CVE-1", "CVE-2"},
+				},
+				{
+					Name:             utils.PointerTo("curl"),
+					Type:             utils.PointerTo("deb"),
+					CurrentVersion:   utils.PointerTo("7.74.0"),
+					FixVersion:       utils.PointerTo("7.74.1"),
+					ResolvedFindings: utils.PointerTo(1),
+					Vulnerabilities:  &[]string{"CVE-3"},
+				},
+			},
+		},
+		{
+			name: "release is preferred over its pre-release",
+			findings: func(t *testing.T) []models.Finding {
+				t.Helper()
+				return []models.Finding{
+					newVulnerabilityFinding(t, "CVE-1", "libssh", "0.10.4", "0.10.5-rc1"),
+					newVulnerabilityFinding(t, "CVE-2", "libssh", "0.10.4", "0.10.5"),
+				}
+			},
+			want: []models.PackageUpgrade{
+				{
+					Name:             utils.PointerTo("libssh"),
+					Type:             utils.PointerTo("deb"),
+					CurrentVersion:   utils.PointerTo("0.10.4"),
+					FixVersion:       utils.PointerTo("0.10.5"),
+					ResolvedFindings: utils.PointerTo(2),
+					Vulnerabilities:  &[]string{"CVE-1", "CVE-2"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildUpgradePlan(tt.findings(t))
+			assert.NilError(t, err)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("buildUpgradePlan() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
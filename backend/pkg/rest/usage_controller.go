@@ -0,0 +1,321 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// usageScanResultsPageSize is the number of scan results read at once to sum
+// the scanner instance hours.
+const usageScanResultsPageSize = 500
+
+// DefaultUsageTenantTag is the key of the tag holding the tenant of the VM
+// assets if it is not configured.
+const DefaultUsageTenantTag = "tenant"
+
+func (s *ServerImpl) GetAdminUsage(ctx echo.Context, params models.GetAdminUsageParams) error {
+	if utils.ValueOrZero(params.Async) {
+		return s.startOperation(ctx, models.AdminUsage, func() (interface{}, error) {
+			return s.getUsage()
+		})
+	}
+
+	usage, err := s.getUsage()
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, err.Error())
+	}
+
+	return sendResponse(ctx, http.StatusOK, usage)
+}
+
+func (s *ServerImpl) getUsage() (models.Usage, error) {
+	counts, err := s.dbHandler.UsageStats().GetObjectCounts()
+	if err != nil {
+		return models.Usage{}, fmt.Errorf("failed to get object counts from db: %w", err)
+	}
+
+	size, err := s.dbHandler.UsageStats().GetDatabaseSize()
+	if err != nil {
+		return models.Usage{}, fmt.Errorf("failed to get database size: %w", err)
+	}
+
+	now := time.Now()
+	monthStart := startOfMonth(now)
+
+	scans, err := s.countScansSince(monthStart)
+	if err != nil {
+		return models.Usage{}, err
+	}
+
+	hours, err := s.scannerInstanceHoursSince(monthStart, now)
+	if err != nil {
+		return models.Usage{}, err
+	}
+
+	tenants, err := s.getTenantUsage(monthStart, now)
+	if err != nil {
+		return models.Usage{}, err
+	}
+
+	return models.Usage{
+		ObjectCounts:                  &counts,
+		DatabaseSizeBytes:             &size,
+		ScansThisMonth:                &scans,
+		ScannerInstanceHoursThisMonth: utils.PointerTo(float32(hours)),
+		Limits:                        s.limits.toAPIModel(),
+		Tenants:                       &tenants,
+	}, nil
+}
+
+// getTenantUsage returns the usage of the assets of each tenant since the
+// given time.
+func (s *ServerImpl) getTenantUsage(since, now time.Time) ([]models.TenantUsage, error) {
+	tenantTag := s.limits.TenantTag
+	if tenantTag == "" {
+		tenantTag = DefaultUsageTenantTag
+	}
+	aggregator := newTenantUsageAggregator(tenantTag, now)
+
+	err := s.dbHandler.AssetsTable().StreamAssets(models.GetAssetsParams{
+		Select: utils.PointerTo("id,assetInfo,terminatedOn"),
+	}, aggregator.addAsset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get assets from db: %w", err)
+	}
+
+	if err := s.scanResultsSince(since, aggregator.addScanResults); err != nil {
+		return nil, err
+	}
+
+	return aggregator.usage(), nil
+}
+
+// checkScanQuota returns a non-empty reason if starting a new scan would
+// exceed one of the configured limits.
+func (s *ServerImpl) checkScanQuota() (string, error) {
+	now := time.Now()
+	monthStart := startOfMonth(now)
+
+	if s.limits.MaxScansPerMonth > 0 {
+		scans, err := s.countScansSince(monthStart)
+		if err != nil {
+			return "", err
+		}
+		if scans >= s.limits.MaxScansPerMonth {
+			return fmt.Sprintf("monthly scan limit of %d reached", s.limits.MaxScansPerMonth), nil
+		}
+	}
+
+	if s.limits.MaxScannerInstanceHoursPerMonth > 0 {
+		hours, err := s.scannerInstanceHoursSince(monthStart, now)
+		if err != nil {
+			return "", err
+		}
+		if hours >= float64(s.limits.MaxScannerInstanceHoursPerMonth) {
+			return fmt.Sprintf("monthly scanner instance hours limit of %d reached", s.limits.MaxScannerInstanceHoursPerMonth), nil
+		}
+	}
+
+	return "", nil
+}
+
+func (s *ServerImpl) countScansSince(since time.Time) (int, error) {
+	scans, err := s.dbHandler.ScansTable().GetScans(models.GetScansParams{
+		Filter: utils.PointerTo(fmt.Sprintf("startTime ge %s and (dryRun eq null or dryRun eq false)", since.Format(time.RFC3339))),
+		Count:  utils.PointerTo(true),
+		Top:    utils.PointerTo(0),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count scans from db: %w", err)
+	}
+	if scans.Count == nil {
+		return 0, nil
+	}
+	return *scans.Count, nil
+}
+
+func (s *ServerImpl) scannerInstanceHoursSince(since, now time.Time) (float64, error) {
+	var hours float64
+	err := s.scanResultsSince(since, func(scanResults []models.AssetScanResult) {
+		hours += scannerInstanceHours(scanResults, now)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return hours, nil
+}
+
+// scanResultsSince calls fn with the pages of the scan results whose scanner
+// instance was created since the given time.
+func (s *ServerImpl) scanResultsSince(since time.Time, fn func([]models.AssetScanResult)) error {
+	filter := fmt.Sprintf("scannerStartTime ge %s", since.Format(time.RFC3339))
+	top := usageScanResultsPageSize
+	skip := 0
+	for {
+		scanResults, err := s.dbHandler.ScanResultsTable().GetScanResults(models.GetScanResultsParams{
+			Filter:  &filter,
+			Select:  utils.PointerTo("asset/id,scannerStartTime,scannerEndTime"),
+			OrderBy: utils.PointerTo("id"),
+			Top:     &top,
+			Skip:    &skip,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get scan results from db: %w", err)
+		}
+		if scanResults.Items == nil {
+			break
+		}
+
+		fn(*scanResults.Items)
+
+		if len(*scanResults.Items) < top {
+			break
+		}
+		skip += top
+	}
+	return nil
+}
+
+// scannerInstanceHours sums the time the scanner instances were running,
+// instances which were not removed yet are counted until now.
+func scannerInstanceHours(scanResults []models.AssetScanResult, now time.Time) float64 {
+	var total time.Duration
+	for _, scanResult := range scanResults {
+		if scanResult.ScannerStartTime == nil {
+			continue
+		}
+		end := now
+		if scanResult.ScannerEndTime != nil {
+			end = *scanResult.ScannerEndTime
+		}
+		if end.After(*scanResult.ScannerStartTime) {
+			total += end.Sub(*scanResult.ScannerStartTime)
+		}
+	}
+	return total.Hours()
+}
+
+// tenantUsageAggregator sums the usage of the assets by tenant, the value of
+// the tenant tag of the VM assets. The other assets, the VM assets without
+// the tag and the assets which are not stored any more belong to the empty
+// tenant.
+type tenantUsageAggregator struct {
+	tenantTag     string
+	now           time.Time
+	tenantOfAsset map[string]string
+	tenants       map[string]*models.TenantUsage
+}
+
+func newTenantUsageAggregator(tenantTag string, now time.Time) *tenantUsageAggregator {
+	return &tenantUsageAggregator{
+		tenantTag:     tenantTag,
+		now:           now,
+		tenantOfAsset: map[string]string{},
+		tenants:       map[string]*models.TenantUsage{},
+	}
+}
+
+func (a *tenantUsageAggregator) addAsset(asset models.Asset) error {
+	if asset.Id == nil {
+		return nil
+	}
+
+	tenant := ""
+	if asset.AssetInfo != nil {
+		discriminator, err := asset.AssetInfo.ValueByDiscriminator()
+		if err != nil {
+			return fmt.Errorf("failed to get asset info of asset %s: %w", *asset.Id, err)
+		}
+		if info, ok := discriminator.(models.VMInfo); ok && info.Tags != nil {
+			for _, tag := range *info.Tags {
+				if tag.Key == a.tenantTag {
+					tenant = tag.Value
+					break
+				}
+			}
+		}
+	}
+	a.tenantOfAsset[*asset.Id] = tenant
+
+	if asset.TerminatedOn == nil {
+		usage := a.tenant(tenant)
+		usage.Assets = utils.PointerTo(*usage.Assets + 1)
+	}
+	return nil
+}
+
+func (a *tenantUsageAggregator) addScanResults(scanResults []models.AssetScanResult) {
+	for _, scanResult := range scanResults {
+		tenant := ""
+		if scanResult.Asset != nil {
+			tenant = a.tenantOfAsset[scanResult.Asset.Id]
+		}
+		usage := a.tenant(tenant)
+		usage.AssetScansThisMonth = utils.PointerTo(*usage.AssetScansThisMonth + 1)
+		hours := scannerInstanceHours([]models.AssetScanResult{scanResult}, a.now)
+		usage.ScannerInstanceHoursThisMonth = utils.PointerTo(*usage.ScannerInstanceHoursThisMonth + float32(hours))
+	}
+}
+
+func (a *tenantUsageAggregator) tenant(tenant string) *models.TenantUsage {
+	usage, ok := a.tenants[tenant]
+	if !ok {
+		usage = &models.TenantUsage{
+			Tenant:                        utils.PointerTo(tenant),
+			Assets:                        utils.PointerTo(0),
+			AssetScansThisMonth:           utils.PointerTo(0),
+			ScannerInstanceHoursThisMonth: utils.PointerTo(float32(0)),
+		}
+		a.tenants[tenant] = usage
+	}
+	return usage
+}
+
+// usage returns the usage of the tenants ordered by tenant.
+func (a *tenantUsageAggregator) usage() []models.TenantUsage {
+	tenants := make([]models.TenantUsage, 0, len(a.tenants))
+	for _, usage := range a.tenants {
+		tenants = append(tenants, *usage)
+	}
+	sort.Slice(tenants, func(i, j int) bool {
+		return *tenants[i].Tenant < *tenants[j].Tenant
+	})
+	return tenants
+}
+
+func startOfMonth(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+}
+
+func (l UsageLimits) toAPIModel() *models.UsageLimits {
+	limits := &models.UsageLimits{}
+	if l.MaxScansPerMonth > 0 {
+		limits.MaxScansPerMonth = utils.PointerTo(l.MaxScansPerMonth)
+	}
+	if l.MaxScannerInstanceHoursPerMonth > 0 {
+		limits.MaxScannerInstanceHoursPerMonth = utils.PointerTo(l.MaxScannerInstanceHoursPerMonth)
+	}
+	return limits
+}
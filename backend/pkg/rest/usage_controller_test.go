@@ -0,0 +1,119 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func Test_scannerInstanceHours(t *testing.T) {
+	now := time.Date(2023, 6, 10, 12, 0, 0, 0, time.UTC)
+
+	scanResults := []models.AssetScanResult{
+		// Removed after two hours.
+		{
+			ScannerStartTime: utils.PointerTo(now.Add(-5 * time.Hour)),
+			ScannerEndTime:   utils.PointerTo(now.Add(-3 * time.Hour)),
+		},
+		// Still running, counted until now.
+		{
+			ScannerStartTime: utils.PointerTo(now.Add(-time.Hour)),
+		},
+		// Never started.
+		{},
+	}
+
+	assert.Equal(t, scannerInstanceHours(scanResults, now), 3.0)
+}
+
+func Test_tenantUsageAggregator(t *testing.T) {
+	now := time.Date(2023, 6, 10, 12, 0, 0, 0, time.UTC)
+
+	vm := func(id, tenant string, terminated bool) models.Asset {
+		var info models.AssetType
+		tags := []models.Tag{{Key: "env", Value: "prod"}}
+		if tenant != "" {
+			tags = append(tags, models.Tag{Key: "owner", Value: tenant})
+		}
+		assert.NilError(t, info.FromVMInfo(models.VMInfo{InstanceID: id, Tags: &tags}))
+		asset := models.Asset{Id: utils.PointerTo(id), AssetInfo: &info}
+		if terminated {
+			asset.TerminatedOn = utils.PointerTo(now)
+		}
+		return asset
+	}
+	image := func(id string) models.Asset {
+		var info models.AssetType
+		assert.NilError(t, info.FromContainerImageInfo(models.ContainerImageInfo{ImageID: id}))
+		return models.Asset{Id: utils.PointerTo(id), AssetInfo: &info}
+	}
+	scanResult := func(assetID string, hours int) models.AssetScanResult {
+		return models.AssetScanResult{
+			Asset:            &models.AssetRelationship{Id: assetID},
+			ScannerStartTime: utils.PointerTo(now.Add(-time.Duration(hours) * time.Hour)),
+			ScannerEndTime:   utils.PointerTo(now),
+		}
+	}
+
+	aggregator := newTenantUsageAggregator("owner", now)
+	for _, asset := range []models.Asset{
+		vm("vm-a1", "a", false),
+		vm("vm-a2", "a", false),
+		// Terminated assets are not counted, but their scans are.
+		vm("vm-a3", "a", true),
+		vm("vm-b", "b", false),
+		vm("vm-untagged", "", false),
+		image("image"),
+	} {
+		assert.NilError(t, aggregator.addAsset(asset))
+	}
+	aggregator.addScanResults([]models.AssetScanResult{
+		scanResult("vm-a1", 1),
+		scanResult("vm-a3", 2),
+		scanResult("vm-b", 4),
+		scanResult("image", 1),
+		// The scans of the assets which are not stored any more belong
+		// to the empty tenant.
+		scanResult("deleted", 1),
+	})
+
+	assert.DeepEqual(t, aggregator.usage(), []models.TenantUsage{
+		{
+			Tenant:                        utils.PointerTo(""),
+			Assets:                        utils.PointerTo(2),
+			AssetScansThisMonth:           utils.PointerTo(2),
+			ScannerInstanceHoursThisMonth: utils.PointerTo(float32(2)),
+		},
+		{
+			Tenant:                        utils.PointerTo("a"),
+			Assets:                        utils.PointerTo(2),
+			AssetScansThisMonth:           utils.PointerTo(2),
+			ScannerInstanceHoursThisMonth: utils.PointerTo(float32(3)),
+		},
+		{
+			Tenant:                        utils.PointerTo("b"),
+			Assets:                        utils.PointerTo(1),
+			AssetScansThisMonth:           utils.PointerTo(1),
+			ScannerInstanceHoursThisMonth: utils.PointerTo(float32(4)),
+		},
+	})
+}
@@ -0,0 +1,77 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/auth"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+)
+
+// anonymousIdentity owns the preferences of the requests without an identity
+// header, for example when the backend is not behind an authenticating proxy.
+const anonymousIdentity = "anonymous"
+
+func (s *ServerImpl) GetUserPreferences(ctx echo.Context) error {
+	identity := userIdentity(ctx, s.userIdentityHeader)
+
+	userPreferences, err := s.dbHandler.UserPreferencesTable().GetUserPreferences(identity)
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return sendResponse(ctx, http.StatusOK, models.UserPreferences{})
+		}
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get user preferences from db. identity=%v: %v", identity, err))
+	}
+	return sendResponse(ctx, http.StatusOK, userPreferences)
+}
+
+func (s *ServerImpl) PutUserPreferences(ctx echo.Context) error {
+	identity := userIdentity(ctx, s.userIdentityHeader)
+
+	var userPreferences models.UserPreferences
+	err := ctx.Bind(&userPreferences)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("failed to bind request: %v", err))
+	}
+
+	updatedUserPreferences, err := s.dbHandler.UserPreferencesTable().SetUserPreferences(identity, userPreferences)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to set user preferences in db. identity=%v: %v", identity, err))
+	}
+
+	return sendResponse(ctx, http.StatusOK, updatedUserPreferences)
+}
+
+// userIdentity returns the identity of the user of the request, which is the
+// subject of its verified token or is read from the given header, falling
+// back to the anonymous identity if neither is set.
+func userIdentity(ctx echo.Context, header string) string {
+	if subject := auth.Subject(ctx); subject != "" {
+		return subject
+	}
+	if header != "" {
+		if identity := ctx.Request().Header.Get(header); identity != "" {
+			return identity
+		}
+	}
+	return anonymousIdentity
+}
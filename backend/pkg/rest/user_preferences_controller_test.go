@@ -0,0 +1,70 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"gotest.tools/v3/assert"
+)
+
+func Test_userIdentity(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  string
+		headers map[string]string
+		want    string
+	}{
+		{
+			name:    "identity from the configured header",
+			header:  "X-Forwarded-User",
+			headers: map[string]string{"X-Forwarded-User": "alice@example.com"},
+			want:    "alice@example.com",
+		},
+		{
+			name:    "missing header falls back to anonymous",
+			header:  "X-Forwarded-User",
+			headers: map[string]string{},
+			want:    anonymousIdentity,
+		},
+		{
+			name:    "other headers are ignored",
+			header:  "X-Forwarded-User",
+			headers: map[string]string{"X-Forwarded-Email": "alice@example.com"},
+			want:    anonymousIdentity,
+		},
+		{
+			name:    "no configured header",
+			header:  "",
+			headers: map[string]string{"X-Forwarded-User": "alice@example.com"},
+			want:    anonymousIdentity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/userPreferences", nil)
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			ctx := echo.New().NewContext(req, httptest.NewRecorder())
+
+			assert.Equal(t, userIdentity(ctx, tt.header), tt.want)
+		})
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retention
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/backend/pkg/tasks"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const (
+	TaskName        = "retention"
+	DefaultInterval = time.Hour
+
+	// pageSize is the number of objects deleted after each query.
+	pageSize = 100
+
+	day = 24 * time.Hour
+)
+
+type Config struct {
+	// Interval between applying the retention settings.
+	Interval time.Duration
+}
+
+// Retention periodically deletes the scans, scan results and findings which
+// are not retained by the retention settings.
+type Retention struct {
+	db     databaseTypes.Database
+	config Config
+}
+
+func New(db databaseTypes.Database, config Config) *Retention {
+	if config.Interval <= 0 {
+		config.Interval = DefaultInterval
+	}
+
+	return &Retention{
+		db:     db,
+		config: config,
+	}
+}
+
+// Task returns the task applying the retention settings every interval.
+func (r *Retention) Task() tasks.Task {
+	return tasks.Task{
+		Name:     TaskName,
+		Interval: r.config.Interval,
+		Jitter:   tasks.DefaultJitter(r.config.Interval),
+		Run:      r.Run,
+	}
+}
+
+// Run applies the retention settings once and records its outcome in them.
+// Nothing is done if the retention is disabled.
+func (r *Retention) Run(ctx context.Context, now time.Time) error {
+	settings, err := r.db.SettingsTable().GetRetentionSettings()
+	if err != nil {
+		return fmt.Errorf("failed to get retention settings: %w", err)
+	}
+	if !utils.ValueOrZero(settings.Enabled) {
+		return nil
+	}
+
+	run := &models.RetentionRun{
+		StartTime:          utils.PointerTo(now),
+		DeletedScans:       utils.PointerTo(0),
+		DeletedScanResults: utils.PointerTo(0),
+		DeletedFindings:    utils.PointerTo(0),
+	}
+
+	err = r.apply(ctx, settings, now, run)
+	if err != nil {
+		run.Error = utils.PointerTo(err.Error())
+	}
+	run.EndTime = utils.PointerTo(time.Now())
+
+	if recordErr := r.db.SettingsTable().SetRetentionRun(*run); recordErr != nil {
+		log.GetLoggerFromContextOrDiscard(ctx).Errorf("Failed to record retention run: %v", recordErr)
+	}
+
+	return err
+}
+
+func (r *Retention) apply(ctx context.Context, settings models.RetentionSettings, now time.Time, run *models.RetentionRun) error {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	if settings.Scans != nil {
+		if days := utils.ValueOrZero(settings.Scans.MaxAgeDays); days > 0 {
+			cutoff := now.Add(-time.Duration(days) * day)
+			if err := r.deleteScansEndedBefore(cutoff, run); err != nil {
+				return err
+			}
+		}
+		if maxScans := utils.ValueOrZero(settings.Scans.MaxScansPerScanConfig); maxScans > 0 {
+			if err := r.deleteScansExceedingLimit(maxScans, run); err != nil {
+				return err
+			}
+		}
+	}
+
+	if settings.Findings != nil {
+		if days := utils.ValueOrZero(settings.Findings.MaxAgeDays); days > 0 {
+			cutoff := now.Add(-time.Duration(days) * day)
+			if err := r.deleteFindingsInvalidatedBefore(cutoff, run); err != nil {
+				return err
+			}
+		}
+	}
+
+	logger.Infof("Retention deleted %d scans, %d scan results and %d findings",
+		*run.DeletedScans, *run.DeletedScanResults, *run.DeletedFindings)
+
+	return nil
+}
+
+func (r *Retention) deleteScansEndedBefore(cutoff time.Time, run *models.RetentionRun) error {
+	filter := fmt.Sprintf("endTime ne null and endTime lt %s", cutoff.Format(time.RFC3339))
+	for {
+		scanIDs, err := r.getScanIDs(models.GetScansParams{
+			Filter: &filter,
+			Top:    utils.PointerTo(pageSize),
+		})
+		if err != nil {
+			return err
+		}
+		if len(scanIDs) == 0 {
+			return nil
+		}
+		for _, scanID := range scanIDs {
+			if err := r.deleteScan(scanID, run); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// deleteScansExceedingLimit deletes the finished scans of each scan config
+// except the most recent maxScans ones.
+func (r *Retention) deleteScansExceedingLimit(maxScans int, run *models.RetentionRun) error {
+	var scanConfigIDs []string
+	err := r.db.ScanConfigsTable().StreamScanConfigs(models.GetScanConfigsParams{
+		Select: utils.PointerTo("id"),
+	}, func(scanConfig models.ScanConfig) error {
+		scanConfigIDs = append(scanConfigIDs, utils.ValueOrZero(scanConfig.Id))
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get scan configs: %w", err)
+	}
+
+	for _, scanConfigID := range scanConfigIDs {
+		scanIDs, err := r.getScanIDs(models.GetScansParams{
+			Filter:  utils.PointerTo(fmt.Sprintf("scanConfig/id eq '%s' and endTime ne null", scanConfigID)),
+			OrderBy: utils.PointerTo("endTime desc"),
+			Skip:    utils.PointerTo(maxScans),
+		})
+		if err != nil {
+			return err
+		}
+		for _, scanID := range scanIDs {
+			if err := r.deleteScan(scanID, run); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *Retention) getScanIDs(params models.GetScansParams) ([]string, error) {
+	params.Select = utils.PointerTo("id")
+	scans, err := r.db.ScansTable().GetScans(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scans: %w", err)
+	}
+
+	scanIDs := make([]string, 0, len(*scans.Items))
+	for _, scan := range *scans.Items {
+		scanIDs = append(scanIDs, utils.ValueOrZero(scan.Id))
+	}
+	return scanIDs, nil
+}
+
+// deleteScan deletes the scan after its scan results, so that the scan
+// results are deleted by the next run if it fails midway.
+func (r *Retention) deleteScan(scanID string, run *models.RetentionRun) error {
+	filter := fmt.Sprintf("scan/id eq '%s'", scanID)
+	for {
+		scanResults, err := r.db.ScanResultsTable().GetScanResults(models.GetScanResultsParams{
+			Filter: &filter,
+			Select: utils.PointerTo("id"),
+			Top:    utils.PointerTo(pageSize),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get scan results of scan %s: %w", scanID, err)
+		}
+		if len(*scanResults.Items) == 0 {
+			break
+		}
+		for _, scanResult := range *scanResults.Items {
+			if err := r.db.ScanResultsTable().DeleteScanResult(utils.ValueOrZero(scanResult.Id)); err != nil {
+				return fmt.Errorf("failed to delete scan result %s: %w", utils.ValueOrZero(scanResult.Id), err)
+			}
+			*run.DeletedScanResults++
+		}
+	}
+
+	if err := r.db.ScansTable().DeleteScan(scanID); err != nil {
+		return fmt.Errorf("failed to delete scan %s: %w", scanID, err)
+	}
+	*run.DeletedScans++
+
+	return nil
+}
+
+func (r *Retention) deleteFindingsInvalidatedBefore(cutoff time.Time, run *models.RetentionRun) error {
+	filter := fmt.Sprintf("invalidatedOn ne null and invalidatedOn lt %s", cutoff.Format(time.RFC3339))
+	for {
+		findings, err := r.db.FindingsTable().GetFindings(models.GetFindingsParams{
+			Filter: &filter,
+			Select: utils.PointerTo("id"),
+			Top:    utils.PointerTo(pageSize),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get findings: %w", err)
+		}
+		if len(*findings.Items) == 0 {
+			return nil
+		}
+		for _, finding := range *findings.Items {
+			if err := r.db.FindingsTable().DeleteFinding(utils.ValueOrZero(finding.Id)); err != nil {
+				return fmt.Errorf("failed to delete finding %s: %w", utils.ValueOrZero(finding.Id), err)
+			}
+			*run.DeletedFindings++
+		}
+	}
+}
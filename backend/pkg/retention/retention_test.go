@@ -0,0 +1,163 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retention
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/database/gorm"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestRetention_Run(t *testing.T) {
+	db, err := gorm.NewDatabase(databaseTypes.DBConfig{
+		DriverType:  databaseTypes.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("NewDatabase() error = %v", err)
+	}
+
+	now := time.Date(2023, 6, 30, 12, 0, 0, 0, time.UTC)
+	daysAgo := func(days int) *time.Time {
+		return utils.PointerTo(now.Add(-time.Duration(days) * day))
+	}
+
+	scanConfig, err := db.ScanConfigsTable().CreateScanConfig(models.ScanConfig{
+		Name:      utils.PointerTo("daily"),
+		Scheduled: &models.RuntimeScheduleScanConfig{CronLine: utils.PointerTo("0 0 * * *")},
+	})
+	if err != nil {
+		t.Fatalf("CreateScanConfig() error = %v", err)
+	}
+
+	createScan := func(endTime *time.Time) string {
+		scan, err := db.ScansTable().CreateScan(models.Scan{
+			ScanConfig: &models.ScanConfigRelationship{Id: *scanConfig.Id},
+			StartTime:  daysAgo(30),
+			EndTime:    endTime,
+		})
+		if err != nil {
+			t.Fatalf("CreateScan() error = %v", err)
+		}
+		return *scan.Id
+	}
+	createScanResult := func(scanID string) {
+		_, err := db.ScanResultsTable().CreateScanResult(models.AssetScanResult{
+			Scan:  &models.ScanRelationship{Id: scanID},
+			Asset: &models.AssetRelationship{Id: "target-1"},
+		})
+		if err != nil {
+			t.Fatalf("CreateScanResult() error = %v", err)
+		}
+	}
+	createFinding := func(invalidatedOn *time.Time) {
+		if _, err := db.FindingsTable().CreateFinding(models.Finding{FoundOn: daysAgo(30), InvalidatedOn: invalidatedOn}); err != nil {
+			t.Fatalf("CreateFinding() error = %v", err)
+		}
+	}
+
+	expired := createScan(daysAgo(20))
+	createScanResult(expired)
+	exceeding := createScan(daysAgo(5))
+	createScanResult(exceeding)
+	secondLatest := createScan(daysAgo(3))
+	latest := createScan(daysAgo(1))
+	createScanResult(latest)
+
+	createFinding(daysAgo(20))
+	createFinding(daysAgo(2))
+	createFinding(nil)
+
+	retention := New(db, Config{})
+
+	// Nothing is deleted until the retention is enabled.
+	if err := retention.Run(context.Background(), now); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if settings, _ := db.SettingsTable().GetRetentionSettings(); settings.LastRun != nil {
+		t.Errorf("Run() recorded run %+v while disabled", settings.LastRun)
+	}
+
+	_, err = db.SettingsTable().SetRetentionSettings(models.RetentionSettings{
+		Enabled: utils.PointerTo(true),
+		Scans: &models.ScansRetentionPolicy{
+			MaxAgeDays:            utils.PointerTo(10),
+			MaxScansPerScanConfig: utils.PointerTo(2),
+		},
+		Findings: &models.FindingsRetentionPolicy{
+			MaxAgeDays: utils.PointerTo(7),
+		},
+	})
+	if err != nil {
+		t.Fatalf("SetRetentionSettings() error = %v", err)
+	}
+
+	if err := retention.Run(context.Background(), now); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	settings, err := db.SettingsTable().GetRetentionSettings()
+	if err != nil {
+		t.Fatalf("GetRetentionSettings() error = %v", err)
+	}
+	if settings.LastRun == nil || settings.LastRun.Error != nil {
+		t.Fatalf("Run() recorded run %+v, want successful run", settings.LastRun)
+	}
+	gotCounts := []int{*settings.LastRun.DeletedScans, *settings.LastRun.DeletedScanResults, *settings.LastRun.DeletedFindings}
+	if diff := cmp.Diff([]int{2, 2, 1}, gotCounts); diff != "" {
+		t.Errorf("Run() deleted scans, scan results and findings mismatch (-want +got):\n%s", diff)
+	}
+
+	scans, err := db.ScansTable().GetScans(models.GetScansParams{})
+	if err != nil {
+		t.Fatalf("GetScans() error = %v", err)
+	}
+	var scanIDs []string
+	for _, scan := range *scans.Items {
+		scanIDs = append(scanIDs, *scan.Id)
+	}
+	wantScanIDs := []string{latest, secondLatest}
+	sort.Strings(scanIDs)
+	sort.Strings(wantScanIDs)
+	if diff := cmp.Diff(wantScanIDs, scanIDs); diff != "" {
+		t.Errorf("Run() retained scans mismatch (-want +got):\n%s", diff)
+	}
+
+	scanResults, err := db.ScanResultsTable().GetScanResults(models.GetScanResultsParams{})
+	if err != nil {
+		t.Fatalf("GetScanResults() error = %v", err)
+	}
+	if len(*scanResults.Items) != 1 || (*scanResults.Items)[0].Scan.Id != latest {
+		t.Errorf("Run() retained scan results %+v, want the one of the latest scan", *scanResults.Items)
+	}
+
+	findings, err := db.FindingsTable().GetFindings(models.GetFindingsParams{})
+	if err != nil {
+		t.Fatalf("GetFindings() error = %v", err)
+	}
+	if len(*findings.Items) != 2 {
+		t.Errorf("Run() retained %d findings, want 2", len(*findings.Items))
+	}
+}
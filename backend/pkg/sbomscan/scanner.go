@@ -0,0 +1,185 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbomscan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/anchore/syft/syft/source"
+	kubeclarityConfig "github.com/openclarity/kubeclarity/shared/pkg/config"
+	"github.com/openclarity/kubeclarity/shared/pkg/converter"
+
+	"github.com/openclarity/vmclarity/api/models"
+	cliutils "github.com/openclarity/vmclarity/cli/pkg/utils"
+	"github.com/openclarity/vmclarity/shared/pkg/families/results"
+	"github.com/openclarity/vmclarity/shared/pkg/families/sbom"
+	"github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities"
+)
+
+const (
+	DefaultGrypeServerTimeout = 2 * time.Minute
+	DefaultTrivyServerTimeout = 5 * time.Minute
+)
+
+var ErrEmptySBOM = errors.New("SBOM is empty")
+
+type Config struct {
+	// GrypeServerAddress is the address of the grype server the SBOMs are
+	// scanned with, grype runs with a local database if it is not set.
+	GrypeServerAddress string
+	GrypeServerTimeout time.Duration
+	// TrivyServerAddress is the address of the trivy server the SBOMs are
+	// scanned with, trivy runs with a local database if it is not set.
+	TrivyServerAddress string
+	TrivyServerTimeout time.Duration
+}
+
+// Results of the scan of an SBOM in the API model.
+type Results struct {
+	Sbom            *models.SbomScan
+	Vulnerabilities *models.VulnerabilityScan
+}
+
+// Scanner scans the uploaded SBOMs with the same vulnerability scanners the
+// scanner instances use, so that their results can be processed into
+// findings like the results of the runtime scans.
+type Scanner struct {
+	config Config
+}
+
+func New(config Config) *Scanner {
+	if config.GrypeServerTimeout <= 0 {
+		config.GrypeServerTimeout = DefaultGrypeServerTimeout
+	}
+	if config.TrivyServerTimeout <= 0 {
+		config.TrivyServerTimeout = DefaultTrivyServerTimeout
+	}
+
+	return &Scanner{
+		config: config,
+	}
+}
+
+// Decode decodes a CycloneDX (JSON or XML) or SPDX (JSON) document.
+func Decode(data []byte) (*cdx.BOM, error) {
+	if len(data) == 0 {
+		return nil, ErrEmptySBOM
+	}
+
+	bom, err := converter.GetCycloneDXSBOMFromBytes(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode SBOM: %w", err)
+	}
+
+	return bom, nil
+}
+
+// Scan lists the packages of the SBOM and scans them for vulnerabilities.
+// The packages are returned even if the vulnerability scan fails.
+func (s *Scanner) Scan(ctx context.Context, bom *cdx.BOM) (Results, error) {
+	ret := Results{
+		Sbom: cliutils.ConvertSBOMResultToAPIModel(&sbom.Results{SBOM: bom}),
+	}
+
+	sbomFile, err := writeSBOMFile(bom)
+	if err != nil {
+		return ret, err
+	}
+	defer os.Remove(sbomFile)
+
+	res, err := vulnerabilities.New(s.vulnerabilitiesConfig(sbomFile)).Run(ctx, results.New())
+	if err != nil {
+		return ret, fmt.Errorf("failed to scan SBOM for vulnerabilities: %w", err)
+	}
+	vulnerabilitiesResults, ok := res.(*vulnerabilities.Results)
+	if !ok {
+		return ret, fmt.Errorf("unexpected vulnerabilities results type %T", res)
+	}
+	ret.Vulnerabilities = cliutils.ConvertVulnResultToAPIModel(vulnerabilitiesResults)
+
+	return ret, nil
+}
+
+func (s *Scanner) vulnerabilitiesConfig(sbomFile string) vulnerabilities.Config {
+	var grypeConfig kubeclarityConfig.GrypeConfig
+	if s.config.GrypeServerAddress != "" {
+		grypeConfig = kubeclarityConfig.GrypeConfig{
+			Mode: kubeclarityConfig.ModeRemote,
+			RemoteGrypeConfig: kubeclarityConfig.RemoteGrypeConfig{
+				GrypeServerAddress: s.config.GrypeServerAddress,
+				GrypeServerTimeout: s.config.GrypeServerTimeout,
+			},
+		}
+	} else {
+		grypeConfig = kubeclarityConfig.GrypeConfig{
+			Mode: kubeclarityConfig.ModeLocal,
+			LocalGrypeConfig: kubeclarityConfig.LocalGrypeConfig{
+				UpdateDB:   true,
+				DBRootDir:  "/tmp/",
+				ListingURL: "https://toolbox-data.anchore.io/grype/databases/listing.json",
+				Scope:      source.SquashedScope,
+			},
+		}
+	}
+
+	return vulnerabilities.Config{
+		Enabled:      true,
+		ScannersList: []string{"grype", "trivy"},
+		Inputs: []vulnerabilities.Input{
+			{
+				Input:     sbomFile,
+				InputType: "sbom",
+			},
+		},
+		ScannersConfig: &kubeclarityConfig.Config{
+			Registry: &kubeclarityConfig.Registry{},
+			Scanner: &kubeclarityConfig.Scanner{
+				GrypeConfig: grypeConfig,
+				TrivyConfig: kubeclarityConfig.ScannerTrivyConfig{
+					Timeout:    int(s.config.TrivyServerTimeout),
+					ServerAddr: s.config.TrivyServerAddress,
+				},
+			},
+		},
+	}
+}
+
+// writeSBOMFile writes the SBOM as CycloneDX JSON to a temporary file, which
+// is how the scanners take an SBOM as input.
+func writeSBOMFile(bom *cdx.BOM) (string, error) {
+	data, err := (&sbom.Results{SBOM: bom}).EncodeToBytes("cyclonedx-json")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode SBOM: %w", err)
+	}
+
+	f, err := os.CreateTemp("", "sbom-*.json")
+	if err != nil {
+		return "", fmt.Errorf("failed to create SBOM file: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(data); err != nil {
+		os.Remove(f.Name())
+		return "", fmt.Errorf("failed to write SBOM file: %w", err)
+	}
+
+	return f.Name(), nil
+}
@@ -0,0 +1,102 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbomscan
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const cycloneDXJSON = `{
+  "bomFormat": "CycloneDX",
+  "specVersion": "1.4",
+  "version": 1,
+  "components": [
+    {"type": "library", "name": "lodash", "version": "4.17.20", "purl": "pkg:npm/lodash@4.17.20"}
+  ]
+}`
+
+const spdxJSON = `{
+  "spdxVersion": "SPDX-2.3",
+  "dataLicense": "CC0-1.0",
+  "SPDXID": "SPDXRef-DOCUMENT",
+  "name": "app",
+  "documentNamespace": "https://example.com/app",
+  "creationInfo": {"created": "2023-01-01T00:00:00Z", "creators": ["Tool: test"]},
+  "packages": [
+    {
+      "SPDXID": "SPDXRef-Package-lodash",
+      "name": "lodash",
+      "versionInfo": "4.17.20",
+      "downloadLocation": "NOASSERTION",
+      "externalRefs": [
+        {"referenceCategory": "PACKAGE-MANAGER", "referenceType": "purl", "referenceLocator": "pkg:npm/lodash@4.17.20"}
+      ]
+    }
+  ]
+}`
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		wantPurls []string
+		wantErr   bool
+	}{
+		{
+			name:      "CycloneDX JSON",
+			data:      cycloneDXJSON,
+			wantPurls: []string{"pkg:npm/lodash@4.17.20"},
+		},
+		{
+			name:      "SPDX JSON",
+			data:      spdxJSON,
+			wantPurls: []string{"pkg:npm/lodash@4.17.20"},
+		},
+		{
+			name:    "empty",
+			data:    "",
+			wantErr: true,
+		},
+		{
+			name:    "not an SBOM",
+			data:    `{"foo": "bar"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bom, err := Decode([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Decode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var purls []string
+			if bom.Components != nil {
+				for _, component := range *bom.Components {
+					purls = append(purls, component.PackageURL)
+				}
+			}
+			if diff := cmp.Diff(tt.wantPurls, purls); diff != "" {
+				t.Errorf("Decode() purls mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
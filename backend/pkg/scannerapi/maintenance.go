@@ -0,0 +1,55 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scannerapi
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openclarity/vmclarity/backend/pkg/maintenance"
+)
+
+// Every call of the scanner API changes a scan result, so they are all
+// rejected while the backend is in maintenance mode, like the writes of the
+// REST API.
+
+func maintenanceUnaryInterceptor(state *maintenance.State) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := checkMaintenance(state); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func maintenanceStreamInterceptor(state *maintenance.State) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkMaintenance(state); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
+
+func checkMaintenance(state *maintenance.State) error {
+	if enabled, message := state.Enabled(); enabled {
+		return status.Error(codes.Unavailable, message) // nolint:wrapcheck
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scannerapi
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/auth"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/backend/pkg/maintenance"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/scannerpb"
+)
+
+const (
+	shutdownTimeout = 10 * time.Second
+
+	// maxRecvMsgSize is the maximum size of a message, the results are
+	// split into chunks well below it.
+	maxRecvMsgSize = 16 * 1024 * 1024
+)
+
+// Server serves the gRPC API the scanners report the progress and the
+// results of the scans with.
+type Server struct {
+	service    *service
+	grpcServer *grpc.Server
+	port       int
+}
+
+// CreateGRPCServer creates the server of the scanner API, served over TLS
+// with the given certificate and key. The calls are authenticated like the
+// requests of the REST API, and require the Operator role, unless the
+// authenticator is nil.
+func CreateGRPCServer(port int, dbHandler databaseTypes.Database, authenticator *auth.Authenticator, tlsCertFile, tlsKeyFile string) (*Server, error) {
+	cert, err := tls.LoadX509KeyPair(tlsCertFile, tlsKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+	creds := credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	})
+
+	return newServer(port, dbHandler, authenticator, grpc.Creds(creds)), nil
+}
+
+func newServer(port int, dbHandler databaseTypes.Database, authenticator *auth.Authenticator, opts ...grpc.ServerOption) *Server {
+	var unaryInterceptors []grpc.UnaryServerInterceptor
+	var streamInterceptors []grpc.StreamServerInterceptor
+	if authenticator != nil {
+		unaryInterceptors = append(unaryInterceptors, authenticator.UnaryServerInterceptor(models.Operator))
+		streamInterceptors = append(streamInterceptors, authenticator.StreamServerInterceptor(models.Operator))
+	}
+	// Reject the calls while the backend is in maintenance mode
+	maintenanceState := maintenance.NewState(dbHandler)
+	unaryInterceptors = append(unaryInterceptors, maintenanceUnaryInterceptor(maintenanceState))
+	streamInterceptors = append(streamInterceptors, maintenanceStreamInterceptor(maintenanceState))
+
+	opts = append(opts,
+		grpc.MaxRecvMsgSize(maxRecvMsgSize),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
+	s := &Server{
+		service:    &service{dbHandler: dbHandler},
+		grpcServer: grpc.NewServer(opts...),
+		port:       port,
+	}
+	scannerpb.RegisterScannerServiceServer(s.grpcServer, s.service)
+
+	return s
+}
+
+func (s *Server) Start(ctx context.Context, errChan chan struct{}) {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	logger.Infof("Starting scanner gRPC server")
+	lis, err := net.Listen("tcp", fmt.Sprintf("0.0.0.0:%d", s.port))
+	if err != nil {
+		logger.Errorf("Failed to listen on the scanner gRPC port: %v", err)
+		errChan <- common.Empty
+		return
+	}
+	go func() {
+		if err := s.grpcServer.Serve(lis); err != nil {
+			logger.Errorf("Failed to start scanner gRPC server: %v", err)
+			errChan <- common.Empty
+		}
+	}()
+	logger.Infof("Scanner gRPC server is running")
+}
+
+func (s *Server) Stop(ctx context.Context) {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	logger.Infof("Stopping scanner gRPC server")
+	stopped := make(chan struct{})
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(shutdownTimeout):
+		logger.Errorf("Failed to gracefully stop scanner gRPC server, stopping it")
+		s.grpcServer.Stop()
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scannerapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/common"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/scannerpb"
+)
+
+type service struct {
+	scannerpb.UnimplementedScannerServiceServer
+
+	dbHandler databaseTypes.Database
+}
+
+func (s *service) UpdateScanStatus(_ context.Context, req *scannerpb.UpdateScanStatusRequest) (*scannerpb.UpdateScanStatusResponse, error) {
+	if req.GetScanResultId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "scan result id must be set")
+	}
+
+	scanResult, err := s.getScanResult(req.GetScanResultId())
+	if err != nil {
+		return nil, err
+	}
+
+	statusPatch := map[string]models.AssetScanState{}
+	for _, familyState := range req.GetStates() {
+		field, state, err := toStatePatch(familyState.GetFamily(), familyState.GetState())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		statusPatch[field] = withErrors(state, existingErrors(scanResult.Status, field), familyState.GetAppendErrors())
+	}
+
+	patch := map[string]interface{}{
+		"status": statusPatch,
+	}
+	if err := s.patchScanResult(req.GetScanResultId(), patch); err != nil {
+		return nil, err
+	}
+
+	return &scannerpb.UpdateScanStatusResponse{}, nil
+}
+
+// nolint:cyclop
+func (s *service) UploadScanResult(stream scannerpb.ScannerService_UploadScanResultServer) error {
+	var header *scannerpb.UploadHeader
+	var state *scannerpb.TargetScanState
+	var summary *scannerpb.ScanFindingsSummary
+	merger := scannerpb.NewResultMerger()
+
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to receive upload request: %w", err)
+		}
+
+		if header == nil && req.GetHeader() == nil {
+			return status.Error(codes.InvalidArgument, "first upload request must have the header")
+		}
+
+		switch payload := req.GetPayload().(type) {
+		case *scannerpb.UploadScanResultRequest_Header:
+			if header != nil {
+				return status.Error(codes.InvalidArgument, "header must be sent once")
+			}
+			header = payload.Header
+			if header.GetScanResultId() == "" {
+				return status.Error(codes.InvalidArgument, "scan result id must be set")
+			}
+			if _, err := scannerpb.ResultField(header.GetFamily()); err != nil {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
+		case *scannerpb.UploadScanResultRequest_Chunk:
+			if err := merger.Add(payload.Chunk.GetResult()); err != nil {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
+		case *scannerpb.UploadScanResultRequest_State:
+			state = payload.State
+		case *scannerpb.UploadScanResultRequest_Summary:
+			summary = payload.Summary
+		default:
+			return status.Error(codes.InvalidArgument, "upload request has no payload")
+		}
+	}
+
+	if header == nil {
+		return status.Error(codes.InvalidArgument, "upload has no header")
+	}
+
+	scanResult, err := s.getScanResult(header.GetScanResultId())
+	if err != nil {
+		return err
+	}
+
+	patch, err := uploadPatch(scanResult, header.GetFamily(), merger, state, summary)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.patchScanResult(header.GetScanResultId(), patch); err != nil {
+		return err
+	}
+
+	// nolint:wrapcheck
+	return stream.SendAndClose(&scannerpb.UploadScanResultResponse{
+		Chunks: int64(merger.Chunks()),
+	})
+}
+
+// uploadPatch returns the patch of the scan result which sets the uploaded
+// result, state and summary of the family.
+func uploadPatch(scanResult models.AssetScanResult, family scannerpb.ScanFamily, merger *scannerpb.ResultMerger, state *scannerpb.TargetScanState, summary *scannerpb.ScanFindingsSummary) (map[string]interface{}, error) {
+	patch := map[string]interface{}{}
+
+	result, err := merger.Result()
+	if err != nil {
+		return nil, err
+	}
+	if result != nil {
+		field, err := scannerpb.ResultField(family)
+		if err != nil {
+			return nil, err
+		}
+		patch[field] = result
+	}
+
+	if state != nil {
+		field, state, err := toStatePatch(family, state)
+		if err != nil {
+			return nil, err
+		}
+		patch["status"] = map[string]models.AssetScanState{
+			field: withErrors(state, existingErrors(scanResult.Status, field), false),
+		}
+	}
+
+	if summary != nil {
+		patch["summary"] = summary.ToModel()
+	}
+
+	return patch, nil
+}
+
+func toStatePatch(family scannerpb.ScanFamily, state *scannerpb.TargetScanState) (string, models.AssetScanState, error) {
+	field, err := scannerpb.StatusField(family)
+	if err != nil {
+		return "", models.AssetScanState{}, err
+	}
+	if state == nil {
+		return "", models.AssetScanState{}, fmt.Errorf("state of family %v must be set", family)
+	}
+	modelState, err := state.ToModel()
+	if err != nil {
+		return "", models.AssetScanState{}, err
+	}
+	return field, modelState, nil
+}
+
+// existingErrors returns the errors reported for the family with the given
+// status field.
+func existingErrors(scanStatus *models.AssetScanStatus, field string) *[]string {
+	if scanStatus == nil {
+		return nil
+	}
+	b, err := json.Marshal(scanStatus)
+	if err != nil {
+		return nil
+	}
+	var states map[string]models.AssetScanState
+	if err := json.Unmarshal(b, &states); err != nil {
+		return nil
+	}
+	return states[field].Errors
+}
+
+// withErrors returns the state with its errors appended to the existing ones
+// if appendErrs is set. The existing errors are kept if the state has none,
+// as a null would remove them from the patched scan result.
+func withErrors(state models.AssetScanState, existing *[]string, appendErrs bool) models.AssetScanState {
+	switch {
+	case existing == nil:
+	case state.Errors == nil:
+		state.Errors = existing
+	case appendErrs:
+		errs := append(append([]string{}, *existing...), *state.Errors...)
+		state.Errors = &errs
+	}
+	return state
+}
+
+func (s *service) getScanResult(scanResultID models.ScanResultID) (models.AssetScanResult, error) {
+	scanResult, err := s.dbHandler.ScanResultsTable().GetScanResult(scanResultID, models.GetScanResultsScanResultIDParams{})
+	if err != nil {
+		if errors.Is(err, databaseTypes.ErrNotFound) {
+			return scanResult, status.Errorf(codes.NotFound, "scan result was not found. scanResultID=%v", scanResultID)
+		}
+		return scanResult, status.Errorf(codes.Internal, "failed to get scan result. scanResultID=%v: %v", scanResultID, err)
+	}
+	return scanResult, nil
+}
+
+// patchScanResult applies the JSON merge patch to the scan result, the same
+// way as the PATCH of the REST API.
+func (s *service) patchScanResult(scanResultID models.ScanResultID, patch map[string]interface{}) error {
+	b, err := json.Marshal(patch)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to marshal patch: %v", err)
+	}
+	var scanResult models.AssetScanResult
+	if err := json.Unmarshal(b, &scanResult); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid scan result: %v", err)
+	}
+	scanResult.Id = &scanResultID
+
+	_, err = s.dbHandler.ScanResultsTable().UpdateScanResult(scanResult, models.PatchScanResultsScanResultIDParams{})
+	if err != nil {
+		var validationErr *common.BadRequestError
+		var conflictErr *common.ConflictError
+		switch {
+		case errors.As(err, &conflictErr):
+			return status.Error(codes.AlreadyExists, conflictErr.Reason)
+		case errors.As(err, &validationErr):
+			return status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return status.Errorf(codes.Internal, "failed to update scan result in db. scanResultID=%v: %v", scanResultID, err)
+		}
+	}
+
+	return nil
+}
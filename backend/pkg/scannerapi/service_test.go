@@ -0,0 +1,281 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scannerapi
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/backend/pkg/auth"
+	"github.com/openclarity/vmclarity/backend/pkg/database/gorm"
+	databaseTypes "github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/shared/pkg/scannerpb"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func newTestClient(t *testing.T, authenticator *auth.Authenticator) (scannerpb.ScannerServiceClient, databaseTypes.Database) {
+	t.Helper()
+
+	db, err := gorm.NewDatabase(databaseTypes.DBConfig{
+		DriverType:  databaseTypes.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("NewDatabase() error = %v", err)
+	}
+
+	lis := bufconn.Listen(1024 * 1024)
+	server := newServer(0, db, authenticator)
+	go func() {
+		_ = server.grpcServer.Serve(lis)
+	}()
+	t.Cleanup(server.grpcServer.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial server: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return scannerpb.NewScannerServiceClient(conn), db
+}
+
+func TestService_UploadScanResult(t *testing.T) {
+	client, db := newTestClient(t, nil)
+	ctx := context.Background()
+
+	scanResult, err := db.ScanResultsTable().CreateScanResult(models.AssetScanResult{
+		Scan:  &models.ScanRelationship{Id: "scan"},
+		Asset: &models.AssetRelationship{Id: "asset"},
+		Status: &models.AssetScanStatus{
+			General: &models.AssetScanState{
+				State:  utils.PointerTo(models.AssetScanStateStateInProgress),
+				Errors: &[]string{"general error"},
+			},
+			Sbom: &models.AssetScanState{
+				State: utils.PointerTo(models.AssetScanStateStateInProgress),
+			},
+		},
+		Summary: &models.ScanFindingsSummary{TotalSecrets: utils.PointerTo(1)},
+	})
+	if err != nil {
+		t.Fatalf("CreateScanResult() error = %v", err)
+	}
+	scanResultID := *scanResult.Id
+
+	packages := make([]models.Package, 0, 5)
+	for i := 0; i < 5; i++ {
+		packages = append(packages, models.Package{Name: utils.PointerTo(fmt.Sprintf("package-%d", i))})
+	}
+	chunks, err := scannerpb.SplitResult(&models.SbomScan{Packages: &packages}, 2)
+	if err != nil {
+		t.Fatalf("SplitResult() error = %v", err)
+	}
+
+	stream, err := client.UploadScanResult(ctx)
+	if err != nil {
+		t.Fatalf("UploadScanResult() error = %v", err)
+	}
+	reqs := []*scannerpb.UploadScanResultRequest{
+		{Payload: &scannerpb.UploadScanResultRequest_Header{Header: &scannerpb.UploadHeader{
+			ScanResultId: scanResultID,
+			Family:       scannerpb.ScanFamily_SCAN_FAMILY_SBOM,
+		}}},
+	}
+	for _, chunk := range chunks {
+		reqs = append(reqs, &scannerpb.UploadScanResultRequest{
+			Payload: &scannerpb.UploadScanResultRequest_Chunk{Chunk: &scannerpb.ResultChunk{Result: chunk}},
+		})
+	}
+	total := int64(len(packages))
+	reqs = append(reqs,
+		&scannerpb.UploadScanResultRequest{
+			Payload: &scannerpb.UploadScanResultRequest_Summary{Summary: &scannerpb.ScanFindingsSummary{TotalPackages: &total}},
+		},
+		&scannerpb.UploadScanResultRequest{
+			Payload: &scannerpb.UploadScanResultRequest_State{State: &scannerpb.TargetScanState{State: scannerpb.TargetScanState_STATE_DONE}},
+		},
+	)
+	for _, req := range reqs {
+		if err := stream.Send(req); err != nil {
+			t.Fatalf("Send() error = %v", err)
+		}
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Fatalf("CloseAndRecv() error = %v", err)
+	}
+	if resp.GetChunks() != int64(len(chunks)) {
+		t.Errorf("UploadScanResult() chunks = %d, want %d", resp.GetChunks(), len(chunks))
+	}
+
+	_, err = client.UpdateScanStatus(ctx, &scannerpb.UpdateScanStatusRequest{
+		ScanResultId: scanResultID,
+		States: []*scannerpb.FamilyState{
+			{
+				Family:       scannerpb.ScanFamily_SCAN_FAMILY_GENERAL,
+				State:        &scannerpb.TargetScanState{State: scannerpb.TargetScanState_STATE_DONE, Errors: []string{"export error"}},
+				AppendErrors: true,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("UpdateScanStatus() error = %v", err)
+	}
+
+	got, err := db.ScanResultsTable().GetScanResult(scanResultID, models.GetScanResultsScanResultIDParams{})
+	if err != nil {
+		t.Fatalf("GetScanResult() error = %v", err)
+	}
+	if got.Sboms == nil || got.Sboms.Packages == nil || len(*got.Sboms.Packages) != len(packages) {
+		t.Fatalf("scan result sboms = %+v, want %d packages", got.Sboms, len(packages))
+	}
+	for i, p := range *got.Sboms.Packages {
+		if utils.ValueOrZero(p.Name) != fmt.Sprintf("package-%d", i) {
+			t.Errorf("package %d = %q, want package-%d", i, utils.ValueOrZero(p.Name), i)
+		}
+	}
+	if utils.ValueOrZero(got.Summary.TotalPackages) != len(packages) || utils.ValueOrZero(got.Summary.TotalSecrets) != 1 {
+		t.Errorf("scan result summary = %+v, want patched totals", got.Summary)
+	}
+	if utils.ValueOrZero(got.Status.Sbom.State) != models.AssetScanStateStateDone {
+		t.Errorf("sbom state = %v, want Done", utils.ValueOrZero(got.Status.Sbom.State))
+	}
+	if state := got.Status.General; utils.ValueOrZero(state.State) != models.AssetScanStateStateDone ||
+		state.Errors == nil || len(*state.Errors) != 2 {
+		t.Errorf("general state = %+v, want Done with appended errors", state)
+	}
+}
+
+func TestService_UploadScanResult_Invalid(t *testing.T) {
+	client, _ := newTestClient(t, nil)
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		reqs     []*scannerpb.UploadScanResultRequest
+		wantCode codes.Code
+	}{
+		{
+			name: "no header",
+			reqs: []*scannerpb.UploadScanResultRequest{
+				{Payload: &scannerpb.UploadScanResultRequest_Chunk{Chunk: &scannerpb.ResultChunk{Result: []byte("{}")}}},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "family without result",
+			reqs: []*scannerpb.UploadScanResultRequest{
+				{Payload: &scannerpb.UploadScanResultRequest_Header{Header: &scannerpb.UploadHeader{
+					ScanResultId: "id",
+					Family:       scannerpb.ScanFamily_SCAN_FAMILY_GENERAL,
+				}}},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "unknown scan result",
+			reqs: []*scannerpb.UploadScanResultRequest{
+				{Payload: &scannerpb.UploadScanResultRequest_Header{Header: &scannerpb.UploadHeader{
+					ScanResultId: "missing",
+					Family:       scannerpb.ScanFamily_SCAN_FAMILY_SBOM,
+				}}},
+			},
+			wantCode: codes.NotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream, err := client.UploadScanResult(ctx)
+			if err != nil {
+				t.Fatalf("UploadScanResult() error = %v", err)
+			}
+			for _, req := range tt.reqs {
+				_ = stream.Send(req)
+			}
+			_, err = stream.CloseAndRecv()
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("CloseAndRecv() error = %v, want code %v", err, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestServer_Authentication(t *testing.T) {
+	authenticator, err := auth.New(context.Background(), auth.Config{})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	client, _ := newTestClient(t, authenticator)
+	ctx := context.Background()
+
+	_, err = client.UpdateScanStatus(ctx, &scannerpb.UpdateScanStatusRequest{ScanResultId: "id"})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("UpdateScanStatus() error = %v, want code %v", err, codes.Unauthenticated)
+	}
+
+	stream, err := client.UploadScanResult(ctx)
+	if err != nil {
+		t.Fatalf("UploadScanResult() error = %v", err)
+	}
+	_, err = stream.CloseAndRecv()
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("CloseAndRecv() error = %v, want code %v", err, codes.Unauthenticated)
+	}
+}
+
+func TestServer_MaintenanceMode(t *testing.T) {
+	client, db := newTestClient(t, nil)
+	ctx := context.Background()
+
+	_, err := db.SettingsTable().SetMaintenanceMode(models.MaintenanceMode{
+		Enabled: true,
+		Reason:  utils.PointerTo("backup"),
+	})
+	if err != nil {
+		t.Fatalf("SetMaintenanceMode() error = %v", err)
+	}
+
+	_, err = client.UpdateScanStatus(ctx, &scannerpb.UpdateScanStatusRequest{ScanResultId: "id"})
+	if status.Code(err) != codes.Unavailable || !strings.Contains(status.Convert(err).Message(), "backup") {
+		t.Errorf("UpdateScanStatus() error = %v, want code %v with the reason", err, codes.Unavailable)
+	}
+
+	stream, err := client.UploadScanResult(ctx)
+	if err != nil {
+		t.Fatalf("UploadScanResult() error = %v", err)
+	}
+	_, err = stream.CloseAndRecv()
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("CloseAndRecv() error = %v, want code %v", err, codes.Unavailable)
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+var ErrTaskNotFound = errors.New("task not found")
+
+// Func does the work of a task run started at now.
+type Func func(ctx context.Context, now time.Time) error
+
+// Task is background work which the Scheduler runs periodically.
+type Task struct {
+	// Name identifies the task in the API.
+	Name string
+	// Interval between the end of a run and the start of the next one.
+	Interval time.Duration
+	// Jitter is the maximum random delay added to Interval, so that the
+	// replicas of the backend don't run the task at the same time.
+	Jitter time.Duration
+	// RunOnStart runs the task when the Scheduler starts instead of after
+	// the first interval.
+	RunOnStart bool
+	Run        Func
+}
+
+type task struct {
+	Task
+
+	// trigger requests a run of the task. It is buffered, so that a run
+	// requested during a run is started once the run ends.
+	trigger chan struct{}
+
+	running     bool
+	nextRunTime time.Time
+	runs        int
+	failures    int
+	lastRun     *models.BackgroundTaskRun
+}
+
+// PausedFunc reports whether the runs of the tasks are paused, e.g. while the
+// backend is in maintenance mode, and why.
+type PausedFunc func() (paused bool, reason string)
+
+// Scheduler runs the registered tasks in the background and keeps the status
+// of their runs, so that the operators can see and trigger them.
+type Scheduler struct {
+	mu    sync.Mutex
+	tasks []*task
+
+	// paused is checked before each run, the runs are skipped while it
+	// reports true. The runs in progress are not stopped.
+	paused PausedFunc
+}
+
+// NewScheduler creates a Scheduler which skips the runs of the tasks while
+// paused reports true, or never if paused is nil.
+func NewScheduler(paused PausedFunc) *Scheduler {
+	return &Scheduler{
+		paused: paused,
+	}
+}
+
+// Register adds a task to the Scheduler. The tasks must be registered before
+// the Scheduler is started.
+func (s *Scheduler) Register(t Task) error {
+	if t.Name == "" {
+		return errors.New("task name is required")
+	}
+	if t.Interval <= 0 {
+		return fmt.Errorf("interval of task %s must be positive", t.Name)
+	}
+	if t.Run == nil {
+		return fmt.Errorf("run function of task %s is required", t.Name)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, registered := range s.tasks {
+		if registered.Name == t.Name {
+			return fmt.Errorf("task %s is already registered", t.Name)
+		}
+	}
+
+	s.tasks = append(s.tasks, &task{
+		Task:    t,
+		trigger: make(chan struct{}, 1),
+	})
+
+	return nil
+}
+
+// Start runs each registered task in the background until ctx is done.
+func (s *Scheduler) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, t := range s.tasks {
+		go s.schedule(ctx, t)
+	}
+}
+
+// Tasks returns the status of the registered tasks.
+func (s *Scheduler) Tasks() []models.BackgroundTask {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tasks := make([]models.BackgroundTask, 0, len(s.tasks))
+	for _, t := range s.tasks {
+		tasks = append(tasks, t.status())
+	}
+	return tasks
+}
+
+// Trigger requests a run of the task now, or once its current run ends if it
+// is running.
+func (s *Scheduler) Trigger(name string) (models.BackgroundTask, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, t := range s.tasks {
+		if t.Name != name {
+			continue
+		}
+		select {
+		case t.trigger <- struct{}{}:
+		default:
+			// A run is requested already.
+		}
+		return t.status(), nil
+	}
+
+	return models.BackgroundTask{}, fmt.Errorf("%w: %s", ErrTaskNotFound, name)
+}
+
+func (s *Scheduler) schedule(ctx context.Context, t *task) {
+	logger := log.GetLoggerFromContextOrDiscard(ctx).WithField("task", t.Name)
+
+	var delay time.Duration
+	if !t.RunOnStart {
+		delay = t.delay()
+	}
+
+	for {
+		s.setNextRunTime(t, time.Now().Add(delay))
+
+		timer := time.NewTimer(delay)
+		triggered := false
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			logger.Infof("Task stopped")
+			return
+		case <-timer.C:
+		case <-t.trigger:
+			timer.Stop()
+			triggered = true
+		}
+
+		if paused, reason := s.isPaused(); paused {
+			logger.Infof("Task run skipped: %s", reason)
+		} else if err := s.run(ctx, t, triggered); err != nil {
+			logger.Errorf("Task failed: %v", err)
+		}
+
+		delay = t.delay()
+	}
+}
+
+func (s *Scheduler) isPaused() (bool, string) {
+	if s.paused == nil {
+		return false, ""
+	}
+	return s.paused()
+}
+
+func (s *Scheduler) run(ctx context.Context, t *task, triggered bool) error {
+	run := &models.BackgroundTaskRun{
+		StartTime: utils.PointerTo(time.Now()),
+		Triggered: utils.PointerTo(triggered),
+	}
+
+	s.mu.Lock()
+	t.running = true
+	s.mu.Unlock()
+
+	err := t.Run(ctx, *run.StartTime)
+
+	run.EndTime = utils.PointerTo(time.Now())
+	if err != nil {
+		run.Error = utils.PointerTo(err.Error())
+	}
+
+	s.mu.Lock()
+	t.running = false
+	t.runs++
+	if err != nil {
+		t.failures++
+	}
+	t.lastRun = run
+	s.mu.Unlock()
+
+	return err
+}
+
+func (s *Scheduler) setNextRunTime(t *task, nextRunTime time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t.nextRunTime = nextRunTime
+}
+
+func (t *task) delay() time.Duration {
+	if t.Jitter <= 0 {
+		return t.Interval
+	}
+	// nolint:gosec
+	return t.Interval + time.Duration(rand.Int63n(int64(t.Jitter)))
+}
+
+func (t *task) status() models.BackgroundTask {
+	status := models.BackgroundTask{
+		Name:            utils.PointerTo(t.Name),
+		IntervalSeconds: utils.PointerTo(int(t.Interval.Seconds())),
+		JitterSeconds:   utils.PointerTo(int(t.Jitter.Seconds())),
+		Running:         utils.PointerTo(t.running),
+		Runs:            utils.PointerTo(t.runs),
+		Failures:        utils.PointerTo(t.failures),
+		LastRun:         t.lastRun,
+	}
+	if !t.nextRunTime.IsZero() && !t.running {
+		status.NextRunTime = utils.PointerTo(t.nextRunTime)
+	}
+	return status
+}
+
+// DefaultJitter returns a tenth of the interval, which spreads the runs of the
+// replicas of the backend without delaying them much.
+func DefaultJitter(interval time.Duration) time.Duration {
+	return interval / 10 // nolint:gomnd
+}
@@ -0,0 +1,182 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tasks
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const waitTimeout = 5 * time.Second
+
+func waitFor(t *testing.T, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(waitTimeout)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for condition")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestScheduler_Register(t *testing.T) {
+	run := func(context.Context, time.Time) error { return nil }
+
+	s := NewScheduler(nil)
+	if err := s.Register(Task{Name: "a", Interval: time.Minute, Run: run}); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		task Task
+	}{
+		{name: "duplicate name", task: Task{Name: "a", Interval: time.Minute, Run: run}},
+		{name: "missing name", task: Task{Interval: time.Minute, Run: run}},
+		{name: "zero interval", task: Task{Name: "b", Run: run}},
+		{name: "missing run", task: Task{Name: "b", Interval: time.Minute}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := s.Register(tt.task); err == nil {
+				t.Errorf("Register() expected error")
+			}
+		})
+	}
+}
+
+func TestScheduler_RunOnStartAndTrigger(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var runs atomic.Int32
+	s := NewScheduler(nil)
+	err := s.Register(Task{
+		Name:       "failing",
+		Interval:   time.Hour,
+		RunOnStart: true,
+		Run: func(context.Context, time.Time) error {
+			runs.Add(1)
+			return errors.New("failed")
+		},
+	})
+	if err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	s.Start(ctx)
+
+	waitFor(t, func() bool {
+		return utils.ValueOrZero(s.Tasks()[0].Runs) == 1
+	})
+
+	task := s.Tasks()[0]
+	if utils.ValueOrZero(task.Failures) != 1 {
+		t.Errorf("Failures = %d, want 1", utils.ValueOrZero(task.Failures))
+	}
+	if task.LastRun == nil || utils.ValueOrZero(task.LastRun.Error) != "failed" || utils.ValueOrZero(task.LastRun.Triggered) {
+		t.Errorf("LastRun = %+v, want a failed scheduled run", task.LastRun)
+	}
+
+	if _, err := s.Trigger("failing"); err != nil {
+		t.Fatalf("Trigger() error = %v", err)
+	}
+	waitFor(t, func() bool {
+		return utils.ValueOrZero(s.Tasks()[0].Runs) == 2
+	})
+
+	task = s.Tasks()[0]
+	if !utils.ValueOrZero(task.LastRun.Triggered) {
+		t.Errorf("LastRun.Triggered = false, want true")
+	}
+	if task.NextRunTime == nil || task.NextRunTime.Before(time.Now().Add(59*time.Minute)) {
+		t.Errorf("NextRunTime = %v, want an interval after the triggered run", task.NextRunTime)
+	}
+	if runs.Load() != 2 {
+		t.Errorf("runs = %d, want 2", runs.Load())
+	}
+
+	if _, err := s.Trigger("unknown"); !errors.Is(err, ErrTaskNotFound) {
+		t.Errorf("Trigger() error = %v, want ErrTaskNotFound", err)
+	}
+}
+
+func TestScheduler_Paused(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var paused atomic.Bool
+	paused.Store(true)
+	var checks atomic.Int32
+	s := NewScheduler(func() (bool, string) {
+		checks.Add(1)
+		return paused.Load(), "the backend is in maintenance mode"
+	})
+	var runs atomic.Int32
+	err := s.Register(Task{
+		Name:       "task",
+		Interval:   time.Hour,
+		RunOnStart: true,
+		Run: func(context.Context, time.Time) error {
+			runs.Add(1)
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	s.Start(ctx)
+
+	// Both the scheduled and the triggered runs are skipped while paused.
+	waitFor(t, func() bool {
+		return checks.Load() == 1
+	})
+	if _, err := s.Trigger("task"); err != nil {
+		t.Fatalf("Trigger() error = %v", err)
+	}
+	waitFor(t, func() bool {
+		return checks.Load() == 2
+	})
+	if task := s.Tasks()[0]; runs.Load() != 0 || utils.ValueOrZero(task.Runs) != 0 || task.LastRun != nil {
+		t.Errorf("runs = %d, task = %+v, want no run while paused", runs.Load(), task)
+	}
+
+	paused.Store(false)
+	if _, err := s.Trigger("task"); err != nil {
+		t.Fatalf("Trigger() error = %v", err)
+	}
+	waitFor(t, func() bool {
+		return utils.ValueOrZero(s.Tasks()[0].Runs) == 1
+	})
+	if runs.Load() != 1 {
+		t.Errorf("runs = %d, want 1", runs.Load())
+	}
+}
+
+func TestTask_delay(t *testing.T) {
+	task := &task{Task: Task{Interval: time.Minute, Jitter: 10 * time.Second}}
+	for i := 0; i < 100; i++ {
+		delay := task.delay()
+		if delay < time.Minute || delay >= time.Minute+10*time.Second {
+			t.Fatalf("delay() = %v, want within jitter of the interval", delay)
+		}
+	}
+}
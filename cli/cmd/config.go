@@ -0,0 +1,91 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
+)
+
+const (
+	fetchConfigAttempts = 10
+	fetchConfigInterval = 10 * time.Second
+	fetchConfigTimeout  = 30 * time.Second
+)
+
+// fetchScannerConfig returns the families config YAML of the ScannerConfig
+// served at url to the token of the ScanResult. As the scanner may start before its network is fully up,
+// failed requests are retried unless the server rejected the request.
+func fetchScannerConfig(ctx context.Context, url, token string, attempts int, interval time.Duration) (string, error) {
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		var config string
+		var retry bool
+		config, retry, err = getScannerConfig(ctx, url, token)
+		if err == nil {
+			return config, nil
+		}
+		if !retry || attempt == attempts {
+			break
+		}
+
+		logger.Warnf("Failed to fetch config, retrying in %v (%d/%d): %v", interval, attempt, attempts, err)
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("failed to fetch config from %s: %w", url, ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+
+	return "", fmt.Errorf("failed to fetch config from %s: %w", url, err)
+}
+
+// getScannerConfig returns the families config YAML of the ScannerConfig
+// served at url and whether the request should be retried if it failed.
+func getScannerConfig(ctx context.Context, url, token string) (string, bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, fetchConfigTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set(backendclient.ScannerTokenHeader, token)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", true, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", resp.StatusCode >= http.StatusInternalServerError, fmt.Errorf("unexpected status code=%v", resp.StatusCode)
+	}
+
+	var scannerConfig models.ScannerConfig
+	if err = json.NewDecoder(resp.Body).Decode(&scannerConfig); err != nil {
+		return "", true, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return scannerConfig.Config, false, nil
+}
@@ -0,0 +1,102 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
+)
+
+func Test_fetchScannerConfig(t *testing.T) {
+	logger = logrus.NewEntry(logrus.New())
+
+	tests := []struct {
+		name         string
+		statusCodes  []int
+		want         string
+		wantErr      bool
+		wantRequests int
+	}{
+		{
+			name:         "served at the first attempt",
+			statusCodes:  []int{http.StatusOK},
+			want:         "sbom:\n  enabled: true\n",
+			wantRequests: 1,
+		},
+		{
+			name:         "retried after server errors",
+			statusCodes:  []int{http.StatusServiceUnavailable, http.StatusInternalServerError, http.StatusOK},
+			want:         "sbom:\n  enabled: true\n",
+			wantRequests: 3,
+		},
+		{
+			name:         "not retried if not found",
+			statusCodes:  []int{http.StatusNotFound, http.StatusOK},
+			wantErr:      true,
+			wantRequests: 1,
+		},
+		{
+			name:         "not retried if the token is rejected",
+			statusCodes:  []int{http.StatusUnauthorized, http.StatusOK},
+			wantErr:      true,
+			wantRequests: 1,
+		},
+		{
+			name:         "attempts exhausted",
+			statusCodes:  []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway, http.StatusOK},
+			wantErr:      true,
+			wantRequests: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				statusCode := tt.statusCodes[requests]
+				requests++
+				if r.Header.Get(backendclient.ScannerTokenHeader) != "scanner-token" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				if statusCode != http.StatusOK {
+					w.WriteHeader(statusCode)
+					return
+				}
+				_ = json.NewEncoder(w).Encode(models.ScannerConfig{Config: tt.want})
+			}))
+			defer server.Close()
+
+			got, err := fetchScannerConfig(context.Background(), server.URL, "scanner-token", 3, 0)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("fetchScannerConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("fetchScannerConfig() got = %q, want %q", got, tt.want)
+			}
+			if requests != tt.wantRequests {
+				t.Errorf("fetchScannerConfig() requests = %v, want %v", requests, tt.wantRequests)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/ghodss/yaml"
@@ -34,30 +35,44 @@ import (
 	"github.com/openclarity/vmclarity/cli/pkg/state"
 	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
 	"github.com/openclarity/vmclarity/shared/pkg/families"
+	"github.com/openclarity/vmclarity/shared/pkg/families/certificates"
+	"github.com/openclarity/vmclarity/shared/pkg/families/compliance"
 	"github.com/openclarity/vmclarity/shared/pkg/families/malware"
 	misconfigurationTypes "github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration/types"
+	"github.com/openclarity/vmclarity/shared/pkg/families/plugins"
 	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits"
 	"github.com/openclarity/vmclarity/shared/pkg/families/sbom"
 	"github.com/openclarity/vmclarity/shared/pkg/families/secrets"
+	familiesutils "github.com/openclarity/vmclarity/shared/pkg/families/utils"
 	"github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities"
 	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/scanexport"
+	"github.com/openclarity/vmclarity/shared/pkg/scannerclient"
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
 const (
 	DefaultWatcherInterval = 2 * time.Minute
 	DefaultMountTimeout    = 10 * time.Minute
+	DefaultPullTimeout     = 20 * time.Minute
 )
 
 var (
-	cfgFile string
-	config  *families.Config
-	logger  *logrus.Entry
-	output  string
+	cfgFile     string
+	configURL   string
+	configToken string
+	config      *families.Config
+	logger      *logrus.Entry
+	output      string
 
 	server       string
+	grpcServer   string
+	grpcCAFile   string
+	exportDir    string
 	scanResultID string
 	mountVolume  bool
+	inputRootfs  string
+	inputImage   string
 )
 
 // rootCmd represents the base command when called without any subcommands.
@@ -108,6 +123,24 @@ var rootCmd = &cobra.Command{
 				return err
 			}
 			setMountPointsForFamiliesInput(mountPoints, config)
+			cli.PrepareDeltaScan(abortCtx, mountPoints)
+		}
+
+		if inputRootfs != "" {
+			setRootfsForFamiliesInput([]string{inputRootfs}, false, config)
+		}
+
+		if inputImage != "" {
+			imageRootfs, err := pullImageRootfs(abortCtx, inputImage)
+			if err != nil {
+				err = fmt.Errorf("failed to pull image %s: %w", inputImage, err)
+				if e := cli.MarkDone(ctx, []error{err}); e != nil {
+					logger.Errorf("Failed to update scan result stat to completed with errors: %v", e)
+				}
+				return err
+			}
+			defer os.RemoveAll(imageRootfs)
+			setRootfsForFamiliesInput([]string{imageRootfs}, true, config)
 		}
 
 		err = cli.MarkInProgress(ctx)
@@ -134,7 +167,29 @@ var rootCmd = &cobra.Command{
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
-	cobra.CheckErr(rootCmd.Execute())
+	err := rootCmd.Execute()
+
+	// The error is already printed by cobra
+	var exitErr *exitCodeError
+	if errors.As(err, &exitErr) {
+		os.Exit(exitErr.code)
+	}
+	cobra.CheckErr(err)
+}
+
+// exitCodeError is returned by the commands which exit with a specific code
+// instead of 1.
+type exitCodeError struct {
+	code int
+	err  error
+}
+
+func (e *exitCodeError) Error() string {
+	return e.err.Error()
+}
+
+func (e *exitCodeError) Unwrap() error {
+	return e.err
 }
 
 // nolint: gochecknoinits
@@ -148,24 +203,40 @@ func init() {
 	// Cobra supports persistent flags, which, if defined here,
 	// will be global for your application.
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.vmclarity.yaml)")
+	rootCmd.PersistentFlags().StringVar(&configURL, "config-url", "", "URL to fetch the config from instead of a config file, for example: http://localhost:9999/api/scanResults/<id>/scannerConfig")
+	rootCmd.PersistentFlags().StringVar(&configToken, "config-token", "", "token of the ScanResult to fetch the config from the config URL with")
 	rootCmd.PersistentFlags().StringVar(&output, "output", "", "set output directory path. Stdout is used if not set.")
 	rootCmd.PersistentFlags().StringVar(&server, "server", "", "VMClarity server to export scan results to, for example: http://localhost:9999/api")
+	rootCmd.PersistentFlags().StringVar(&grpcServer, "grpc-server", "", "VMClarity scanner gRPC API to report the scan state and export the scan results to instead of the server, for example: localhost:9991")
+	rootCmd.PersistentFlags().StringVar(&grpcCAFile, "grpc-ca-file", "", "CA certificates to verify the certificate of the scanner gRPC API with, the system CA certificates are used if not set")
+	rootCmd.PersistentFlags().StringVar(&exportDir, "export-dir", "", "directory to write the scan results to for the orchestrator to collect them, instead of exporting them to the server")
 	rootCmd.PersistentFlags().StringVar(&scanResultID, "scan-result-id", "", "the ScanResult ID to export the scan results to")
 	rootCmd.PersistentFlags().BoolVar(&mountVolume, "mount-attached-volume", false, "discover for an attached volume and mount it before the scan")
+	rootCmd.PersistentFlags().StringVar(&inputRootfs, "input-rootfs", "", "scan the given directory as rootfs in place, for example / when running on the scanned host")
+	rootCmd.PersistentFlags().StringVar(&inputImage, "input-image", "", "pull the given container image and scan its filesystem, for example docker.io/library/alpine@sha256:<digest>")
 
 	// TODO(sambetts) we may have to change this to our own validation when
 	// we add the CI/CD scenario and there isn't an existing scan-result-id
 	// in the backend to PATCH
 	rootCmd.MarkFlagsRequiredTogether("server", "scan-result-id")
+	rootCmd.MarkFlagsMutuallyExclusive("config", "config-url")
+	rootCmd.MarkFlagsMutuallyExclusive("server", "export-dir")
+	rootCmd.MarkFlagsMutuallyExclusive("mount-attached-volume", "input-image")
 }
 
 // initConfig reads in config file and ENV variables if set.
 func initConfig() {
 	logger.Infof("Initializing configuration...")
-	if cfgFile != "" {
+	switch {
+	case configURL != "":
+		// The config is served by the VMClarity server so that it doesn't
+		// have to be embedded in the size limited user data of the
+		// scanner instance.
+		viper.SetConfigType("yaml")
+	case cfgFile != "":
 		// Use config file from the flag.
 		viper.SetConfigFile(cfgFile)
-	} else {
+	default:
 		// Find home directory.
 		home, err := os.UserHomeDir()
 		cobra.CheckErr(err)
@@ -179,19 +250,26 @@ func initConfig() {
 
 	viper.AutomaticEnv() // read in environment variables that match
 
-	// If a config file is found, read it in.
-	err := viper.ReadInConfig()
-	cobra.CheckErr(err)
+	configSource := configURL
+	if configURL != "" {
+		scannerConfig, err := fetchScannerConfig(context.Background(), configURL, configToken, fetchConfigAttempts, fetchConfigInterval)
+		cobra.CheckErr(err)
+		cobra.CheckErr(viper.ReadConfig(strings.NewReader(scannerConfig)))
+	} else {
+		// If a config file is found, read it in.
+		cobra.CheckErr(viper.ReadInConfig())
+		configSource = viper.ConfigFileUsed()
+	}
 
 	// Load config
 	config = &families.Config{}
-	err = viper.Unmarshal(config)
+	err := viper.Unmarshal(config)
 	cobra.CheckErr(err)
 
 	if logrus.IsLevelEnabled(logrus.InfoLevel) {
 		configB, err := yaml.Marshal(config)
 		cobra.CheckErr(err)
-		logger.Infof("Using config file (%s):\n%s", viper.ConfigFileUsed(), string(configB))
+		logger.Infof("Using config (%s):\n%s", configSource, string(configB))
 	}
 }
 
@@ -209,6 +287,10 @@ func newCli() (*cli.CLI, error) {
 		return nil, errors.New("families config must not be nil")
 	}
 
+	if grpcServer != "" && server == "" {
+		return nil, errors.New("the server must be set along with the gRPC server")
+	}
+
 	if server != "" {
 		var client *backendclient.BackendClient
 		var p presenter.Presenter
@@ -218,14 +300,46 @@ func newCli() (*cli.CLI, error) {
 			return nil, fmt.Errorf("failed to create VMClarity API client: %w", err)
 		}
 
-		manager, err = state.NewVMClarityState(client, scanResultID)
+		if grpcServer != "" {
+			var scannerClient *scannerclient.ScannerClient
+			scannerClient, err = scannerclient.Create(grpcServer, grpcCAFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create VMClarity scanner API client: %w", err)
+			}
+
+			manager, err = state.NewVMClarityGRPCState(client, scannerClient, scanResultID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create VMClarity state manager: %w", err)
+			}
+
+			p, err = presenter.NewVMClarityGRPCPresenter(scannerClient, scanResultID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create VMClarity presenter: %w", err)
+			}
+		} else {
+			manager, err = state.NewVMClarityState(client, scanResultID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create VMClarity state manager: %w", err)
+			}
+
+			p, err = presenter.NewVMClarityPresenter(client, scanResultID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create VMClarity presenter: %w", err)
+			}
+		}
+		presenters = append(presenters, p)
+	} else if exportDir != "" {
+		var p presenter.Presenter
+
+		writer := scanexport.NewWriter(exportDir)
+		manager, err = state.NewExportState(writer)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create VMClarity state manager: %w", err)
+			return nil, fmt.Errorf("failed to create export state: %w", err)
 		}
 
-		p, err = presenter.NewVMClarityPresenter(client, scanResultID)
+		p, err = presenter.NewExportPresenter(writer)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create VMClarity presenter: %w", err)
+			return nil, fmt.Errorf("failed to create export presenter: %w", err)
 		}
 		presenters = append(presenters, p)
 	} else {
@@ -251,9 +365,36 @@ func newCli() (*cli.CLI, error) {
 	return &cli.CLI{Manager: manager, Presenter: p, FamiliesConfig: config}, nil
 }
 
+// pullImageRootfs pulls imageRef and unpacks its filesystem into a new
+// temporary directory which is returned. The caller is responsible for
+// removing it.
+func pullImageRootfs(ctx context.Context, imageRef string) (string, error) {
+	pullCtx, cancel := context.WithTimeout(ctx, DefaultPullTimeout)
+	defer cancel()
+
+	dir, err := os.MkdirTemp("", "vmclarity-image-")
+	if err != nil {
+		return "", fmt.Errorf("failed to create image rootfs directory: %w", err)
+	}
+
+	if err := familiesutils.PullImageFilesystem(pullCtx, imageRef, dir); err != nil {
+		_ = os.RemoveAll(dir)
+		return "", err
+	}
+
+	return dir, nil
+}
+
 func setMountPointsForFamiliesInput(mountPoints []string, familiesConfig *families.Config) *families.Config {
-	// update families inputs with the mount point as rootfs
-	for _, mountDir := range mountPoints {
+	return setRootfsForFamiliesInput(mountPoints, true, familiesConfig)
+}
+
+// setRootfsForFamiliesInput updates the families inputs with the given
+// directories as rootfs. The directories are stripped from the paths in the
+// results if stripPathFromResult is set, which is required if the directory
+// is a mount point of the scanned filesystem.
+func setRootfsForFamiliesInput(rootfsDirs []string, stripPathFromResult bool, familiesConfig *families.Config) *families.Config {
+	for _, mountDir := range rootfsDirs {
 		if familiesConfig.SBOM.Enabled {
 			familiesConfig.SBOM.Inputs = append(familiesConfig.SBOM.Inputs, sbom.Input{
 				Input:     mountDir,
@@ -274,7 +415,7 @@ func setMountPointsForFamiliesInput(mountPoints []string, familiesConfig *famili
 
 		if familiesConfig.Secrets.Enabled {
 			familiesConfig.Secrets.Inputs = append(familiesConfig.Secrets.Inputs, secrets.Input{
-				StripPathFromResult: utils.PointerTo(true),
+				StripPathFromResult: utils.PointerTo(stripPathFromResult),
 				Input:               mountDir,
 				InputType:           string(kubeclarityutils.ROOTFS),
 			})
@@ -282,7 +423,7 @@ func setMountPointsForFamiliesInput(mountPoints []string, familiesConfig *famili
 
 		if familiesConfig.Malware.Enabled {
 			familiesConfig.Malware.Inputs = append(familiesConfig.Malware.Inputs, malware.Input{
-				StripPathFromResult: utils.PointerTo(true),
+				StripPathFromResult: utils.PointerTo(stripPathFromResult),
 				Input:               mountDir,
 				InputType:           string(kubeclarityutils.ROOTFS),
 			})
@@ -290,7 +431,7 @@ func setMountPointsForFamiliesInput(mountPoints []string, familiesConfig *famili
 
 		if familiesConfig.Rootkits.Enabled {
 			familiesConfig.Rootkits.Inputs = append(familiesConfig.Rootkits.Inputs, rootkits.Input{
-				StripPathFromResult: utils.PointerTo(true),
+				StripPathFromResult: utils.PointerTo(stripPathFromResult),
 				Input:               mountDir,
 				InputType:           string(kubeclarityutils.ROOTFS),
 			})
@@ -300,12 +441,36 @@ func setMountPointsForFamiliesInput(mountPoints []string, familiesConfig *famili
 			familiesConfig.Misconfiguration.Inputs = append(
 				familiesConfig.Misconfiguration.Inputs,
 				misconfigurationTypes.Input{
-					StripPathFromResult: utils.PointerTo(true),
+					StripPathFromResult: utils.PointerTo(stripPathFromResult),
 					Input:               mountDir,
 					InputType:           string(kubeclarityutils.ROOTFS),
 				},
 			)
 		}
+
+		if familiesConfig.Certificates.Enabled {
+			familiesConfig.Certificates.Inputs = append(familiesConfig.Certificates.Inputs, certificates.Input{
+				StripPathFromResult: utils.PointerTo(stripPathFromResult),
+				Input:               mountDir,
+				InputType:           string(kubeclarityutils.ROOTFS),
+			})
+		}
+
+		if familiesConfig.Compliance.Enabled {
+			familiesConfig.Compliance.Inputs = append(familiesConfig.Compliance.Inputs, compliance.Input{
+				StripPathFromResult: utils.PointerTo(stripPathFromResult),
+				Input:               mountDir,
+				InputType:           string(kubeclarityutils.ROOTFS),
+			})
+		}
+
+		if familiesConfig.Plugins.Enabled {
+			familiesConfig.Plugins.Inputs = append(familiesConfig.Plugins.Inputs, plugins.Input{
+				StripPathFromResult: utils.PointerTo(stripPathFromResult),
+				Input:               mountDir,
+				InputType:           string(kubeclarityutils.ROOTFS),
+			})
+		}
 	}
 	return familiesConfig
 }
@@ -0,0 +1,180 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openclarity/vmclarity/cli/pkg/cli"
+	"github.com/openclarity/vmclarity/cli/pkg/presenter"
+	"github.com/openclarity/vmclarity/cli/pkg/state"
+	"github.com/openclarity/vmclarity/shared/pkg/families"
+	familiesutils "github.com/openclarity/vmclarity/shared/pkg/families/utils"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+)
+
+// Exit codes of the local scans, a scan which failed to run exits with 1.
+const (
+	exitCodeFindings = 2
+)
+
+var (
+	reportFormat      string
+	failOnFindings    bool
+	severityThreshold string
+	baselineFile      string
+)
+
+var scanCmd = &cobra.Command{
+	Use:   "scan",
+	Short: "Scan without a VMClarity server",
+}
+
+var scanLocalCmd = &cobra.Command{
+	Use:   "local <path>",
+	Short: "Scan a local directory, block device or image tarball",
+	Long: `Run the configured families against a local directory, block device or
+container image tarball, as written by docker save, and print the results to
+stdout without a VMClarity server. A block device is mounted read-only before
+the scan, so it must have a filesystem, e.g. /dev/sdb1.
+
+The command exits with 1 if the scan failed to run and with 2 if a new
+finding, one which is not in the --baseline report, is of --severity-threshold
+or higher.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := log.SetLoggerForContext(cmd.Context(), logger)
+
+		var err error
+		var threshold presenter.Severity
+		if severityThreshold != "" {
+			threshold, err = presenter.ParseSeverity(severityThreshold)
+			if err != nil {
+				return err
+			}
+		}
+		if failOnFindings {
+			threshold = presenter.SeverityInfo
+		}
+
+		var baseline presenter.Baseline
+		if baselineFile != "" {
+			baseline, err = presenter.LoadBaseline(baselineFile)
+			if err != nil {
+				return err
+			}
+		}
+
+		p, err := presenter.NewReportPresenter(cmd.OutOrStdout(), presenter.ReportFormat(reportFormat), baseline)
+		if err != nil {
+			return err
+		}
+
+		manager, err := state.NewLocalState()
+		if err != nil {
+			return fmt.Errorf("failed to create local state: %w", err)
+		}
+
+		c := &cli.CLI{Manager: manager, Presenter: p, FamiliesConfig: config}
+
+		rootfsDirs, stripPathFromResult, cleanup, err := prepareLocalInput(ctx, c, args[0])
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+		setRootfsForFamiliesInput(rootfsDirs, stripPathFromResult, config)
+
+		logger.Infof("Running scanners...")
+		runErrors := families.New(config).Run(ctx, c)
+
+		if err := p.Print(); err != nil {
+			return err
+		}
+
+		if len(runErrors) > 0 {
+			return fmt.Errorf("failed to run families: %w", errors.Join(runErrors...))
+		}
+
+		if threshold == "" {
+			return nil
+		}
+		if total := p.Report.NewFindings(threshold); total > 0 {
+			return &exitCodeError{
+				code: exitCodeFindings,
+				err:  fmt.Errorf("found %d new findings of %s severity or higher", total, threshold),
+			}
+		}
+
+		return nil
+	},
+}
+
+// nolint: gochecknoinits
+func init() {
+	// The output flag of the local scans shadows the output directory of
+	// the root command, the results are always printed to stdout.
+	scanLocalCmd.Flags().StringVar(&reportFormat, "output", string(presenter.ReportFormatTable), "output format of the results, json, sarif or table")
+	scanLocalCmd.Flags().StringVar(&reportFormat, "format", string(presenter.ReportFormatTable), "output format of the results, json, sarif or table")
+	_ = scanLocalCmd.Flags().MarkDeprecated("format", "use --output instead")
+	scanLocalCmd.Flags().StringVar(&severityThreshold, "severity-threshold", "", "exit with code 2 if a new finding is of this severity or higher: critical, high, medium, low or info")
+	scanLocalCmd.Flags().BoolVar(&failOnFindings, "fail-on-findings", false, "exit with code 2 if any family reported a new finding, the packages of the SBOM are not findings")
+	scanLocalCmd.Flags().StringVar(&baselineFile, "baseline", "", "JSON report of a previous local scan, the findings in it are not new")
+
+	scanCmd.AddCommand(scanLocalCmd)
+	rootCmd.AddCommand(scanCmd)
+}
+
+// prepareLocalInput returns the directories to scan as rootfs for path and
+// whether they have to be stripped from the paths in the results. A block
+// device is mounted and an image tarball is extracted into a temporary
+// directory, which is removed by the returned cleanup function.
+func prepareLocalInput(ctx context.Context, c *cli.CLI, path string) ([]string, bool, func(), error) {
+	noop := func() {}
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, false, noop, fmt.Errorf("failed to stat input: %w", err)
+	}
+
+	switch mode := fi.Mode(); {
+	case mode.IsDir():
+		return []string{path}, false, noop, nil
+	case mode&os.ModeDevice != 0:
+		mountPoints, err := c.MountDevice(ctx, path)
+		if err != nil {
+			return nil, false, noop, fmt.Errorf("failed to mount device %s: %w", path, err)
+		}
+		return mountPoints, true, noop, nil
+	case mode.IsRegular():
+		dir, err := os.MkdirTemp("", "vmclarity-image-")
+		if err != nil {
+			return nil, false, noop, fmt.Errorf("failed to create image rootfs directory: %w", err)
+		}
+		cleanup := func() { _ = os.RemoveAll(dir) }
+		if err := familiesutils.ExtractImageTarball(path, dir); err != nil {
+			cleanup()
+			return nil, false, noop, err
+		}
+		return []string{dir}, true, cleanup, nil
+	default:
+		return nil, false, noop, fmt.Errorf("unsupported input %s, must be a directory, a block device or an image tarball", path)
+	}
+}
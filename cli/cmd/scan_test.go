@@ -0,0 +1,75 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/crane"
+)
+
+func Test_prepareLocalInput(t *testing.T) {
+	dir := t.TempDir()
+
+	img, err := crane.Image(map[string][]byte{
+		"etc/os-release": []byte("ID=alpine\n"),
+	})
+	if err != nil {
+		t.Fatalf("failed to create image: %v", err)
+	}
+	tarball := filepath.Join(t.TempDir(), "image.tar")
+	if err := crane.Save(img, "alpine:test", tarball); err != nil {
+		t.Fatalf("failed to save image: %v", err)
+	}
+
+	t.Run("directory", func(t *testing.T) {
+		rootfsDirs, strip, cleanup, err := prepareLocalInput(context.Background(), nil, dir)
+		if err != nil {
+			t.Fatalf("prepareLocalInput() error = %v", err)
+		}
+		defer cleanup()
+		if !reflect.DeepEqual(rootfsDirs, []string{dir}) || strip {
+			t.Errorf("prepareLocalInput() = %v, %v, want %v, false", rootfsDirs, strip, []string{dir})
+		}
+	})
+
+	t.Run("image tarball", func(t *testing.T) {
+		rootfsDirs, strip, cleanup, err := prepareLocalInput(context.Background(), nil, tarball)
+		if err != nil {
+			t.Fatalf("prepareLocalInput() error = %v", err)
+		}
+		if len(rootfsDirs) != 1 || !strip {
+			t.Fatalf("prepareLocalInput() = %v, %v, want one stripped directory", rootfsDirs, strip)
+		}
+		if _, err := os.Stat(filepath.Join(rootfsDirs[0], "etc/os-release")); err != nil {
+			t.Errorf("image filesystem was not extracted: %v", err)
+		}
+		cleanup()
+		if _, err := os.Stat(rootfsDirs[0]); !os.IsNotExist(err) {
+			t.Errorf("cleanup() did not remove %s, err = %v", rootfsDirs[0], err)
+		}
+	})
+
+	t.Run("missing input", func(t *testing.T) {
+		if _, _, _, err := prepareLocalInput(context.Background(), nil, filepath.Join(dir, "missing")); err == nil {
+			t.Errorf("prepareLocalInput() expected error for missing input")
+		}
+	})
+}
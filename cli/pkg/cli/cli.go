@@ -18,8 +18,10 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -30,8 +32,10 @@ import (
 	"github.com/openclarity/vmclarity/shared/pkg/families"
 	"github.com/openclarity/vmclarity/shared/pkg/families/types"
 	"github.com/openclarity/vmclarity/shared/pkg/fsutils/blockdevice"
+	"github.com/openclarity/vmclarity/shared/pkg/fsutils/btrfs"
 	"github.com/openclarity/vmclarity/shared/pkg/fsutils/filesystem"
 	"github.com/openclarity/vmclarity/shared/pkg/fsutils/mount"
+	"github.com/openclarity/vmclarity/shared/pkg/fsutils/zfs"
 	"github.com/openclarity/vmclarity/shared/pkg/log"
 )
 
@@ -40,6 +44,8 @@ const (
 	MountPointDirPerm  = 0o770
 )
 
+var errUnsupportedFilesystem = errors.New("unsupported filesystem")
+
 // DefaultMountOptions is a set of filesystem independent mount options.
 var DefaultMountOptions = []string{
 	"noatime",    // Do not update inode access times on this filesystem (e.g. for faster access on the news spool to speed up news servers).
@@ -61,6 +67,10 @@ func (c *CLI) FamilyStarted(ctx context.Context, famType types.FamilyType) error
 	return c.Manager.MarkFamilyScanInProgress(ctx, famType)
 }
 
+func (c *CLI) FamilyProgress(ctx context.Context, famType types.FamilyType, percent int) error {
+	return c.Manager.MarkFamilyScanProgress(ctx, famType, percent)
+}
+
 func (c *CLI) FamilyFinished(ctx context.Context, res families.FamilyResult) error {
 	return c.Presenter.ExportFamilyResult(ctx, res)
 }
@@ -75,25 +85,148 @@ func (c *CLI) MountVolumes(ctx context.Context) ([]string, error) {
 	logger.Debugf("Found block devices: %s", blockDevices)
 
 	var mountPoints []string
+	var unsupported []string
+	importedPools := map[string]struct{}{}
 	for _, device := range blockDevices {
 		// It is assumed that the device is part of the attached volume if it is not mounted
 		// and it has a supported filesystem.
-		if device.MountPoint == "" && isSupportedFS(device.FSType) {
-			mountPoint := fmt.Sprintf(MountPointTemplate, uuid.New())
+		if device.MountPoint != "" {
+			continue
+		}
 
-			if err := os.MkdirAll(mountPoint, MountPointDirPerm); err != nil {
-				return nil, fmt.Errorf("failed to create mountpoint. Device=%s MountPoint=%s: %w",
-					device.Path, mountPoint, err)
-			}
+		devMountPoints, err := mountDevice(ctx, device, importedPools)
+		if errors.Is(err, errUnsupportedFilesystem) {
+			unsupported = append(unsupported, fmt.Sprintf("%s (%s)", device.Path, device.FSType))
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		mountPoints = append(mountPoints, devMountPoints...)
+	}
 
-			if err := mount.Mount(ctx, device.Path, mountPoint, device.FSType, DefaultMountOptions); err != nil {
-				return nil, fmt.Errorf("failed to mount device. Device=%s MountPoint=%s: %w",
-					device.Path, mountPoint, err)
-			}
-			logger.Infof("Device is mounted. Device=%s MountPoint=%s", device.Path, mountPoint)
+	// Scanning nothing would report no findings without an error.
+	if len(mountPoints) == 0 && len(unsupported) > 0 {
+		return nil, fmt.Errorf("no supported filesystem found on attached volume. Unsupported=%s",
+			strings.Join(unsupported, ", "))
+	}
+
+	return mountPoints, nil
+}
+
+// MountDevice mounts the filesystem of the block device at devicePath
+// read-only, or the datasets of the ZFS pools it is a member of, and returns
+// the mount points. A device which is mounted already is scanned at its mount
+// point.
+func (c *CLI) MountDevice(ctx context.Context, devicePath string) ([]string, error) {
+	blockDevices, err := blockdevice.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list block devices: %w", err)
+	}
+
+	// The device may be given with a symlink, e.g. /dev/disk/by-label/<label>.
+	realPath, err := filepath.EvalSymlinks(devicePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve device path. Device=%s: %w", devicePath, err)
+	}
+
+	for _, device := range blockDevices {
+		if device.Path != devicePath && device.Path != realPath {
+			continue
+		}
+
+		if device.MountPoint != "" {
+			return []string{device.MountPoint}, nil
+		}
+
+		mountPoints, err := mountDevice(ctx, device, map[string]struct{}{})
+		if err != nil {
+			return nil, err
+		}
+		if len(mountPoints) == 0 {
+			return nil, fmt.Errorf("no filesystem found on device. Device=%s", devicePath)
+		}
+		return mountPoints, nil
+	}
+
+	return nil, fmt.Errorf("block device not found. Device=%s", devicePath)
+}
+
+// mountDevice mounts the filesystem of the device, or the ZFS pools it is a
+// member of, and returns the mount points. No mount point is returned if the
+// device has no filesystem.
+func mountDevice(ctx context.Context, device blockdevice.BlockDevice, importedPools map[string]struct{}) ([]string, error) {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	switch {
+	case strings.EqualFold(device.FSType, string(filesystem.ZfsMember)):
+		return mountZFSPools(ctx, device, importedPools)
+	case isSupportedFS(device.FSType):
+		mountPoint, err := createMountPoint(device)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := mount.Mount(ctx, device.Path, mountPoint, device.FSType, DefaultMountOptions); err != nil {
+			return nil, fmt.Errorf("failed to mount device. Device=%s MountPoint=%s: %w",
+				device.Path, mountPoint, err)
+		}
+		logger.Infof("Device is mounted. Device=%s MountPoint=%s", device.Path, mountPoint)
 
-			mountPoints = append(mountPoints, mountPoint)
+		// The default subvolume of btrfs may be the top level one
+		// which has the root filesystem in a child subvolume.
+		if strings.EqualFold(device.FSType, string(filesystem.Btrfs)) {
+			mountPoint = btrfs.RootPath(mountPoint)
 		}
+
+		return []string{mountPoint}, nil
+	case device.FSType != "":
+		return nil, fmt.Errorf("%w. Device=%s FSType=%s", errUnsupportedFilesystem, device.Path, device.FSType)
+	}
+
+	return nil, nil
+}
+
+func createMountPoint(device blockdevice.BlockDevice) (string, error) {
+	mountPoint := fmt.Sprintf(MountPointTemplate, uuid.New())
+
+	if err := os.MkdirAll(mountPoint, MountPointDirPerm); err != nil {
+		return "", fmt.Errorf("failed to create mountpoint. Device=%s MountPoint=%s: %w",
+			device.Path, mountPoint, err)
+	}
+
+	return mountPoint, nil
+}
+
+// mountZFSPools imports the pools which have a member on the device read-only
+// and mounts their datasets, unless they were imported already.
+func mountZFSPools(ctx context.Context, device blockdevice.BlockDevice, importedPools map[string]struct{}) ([]string, error) {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	z := zfs.New()
+	pools, err := z.ImportablePools(ctx, device.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find ZFS pools. Device=%s: %w", device.Path, err)
+	}
+
+	var mountPoints []string
+	for _, pool := range pools {
+		if _, ok := importedPools[pool.ID]; ok {
+			continue
+		}
+
+		mountPoint, err := createMountPoint(device)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := z.ImportReadOnly(ctx, device.Path, pool, mountPoint); err != nil {
+			return nil, fmt.Errorf("failed to import ZFS pool. Device=%s Pool=%s: %w", device.Path, pool.Name, err)
+		}
+		importedPools[pool.ID] = struct{}{}
+		logger.Infof("ZFS pool is imported. Device=%s Pool=%s MountPoint=%s", device.Path, pool.Name, mountPoint)
+
+		mountPoints = append(mountPoints, mountPoint)
 	}
 
 	return mountPoints, nil
@@ -133,6 +266,11 @@ func isSupportedFS(fs string) bool {
 		return true
 	case string(filesystem.Xfs):
 		return true
+	case string(filesystem.Btrfs):
+		return true
+	case string(filesystem.Vfat):
+		// EFI system partitions are scanned for tampered bootloaders.
+		return true
 	default:
 		return false
 	}
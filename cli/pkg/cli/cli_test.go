@@ -59,9 +59,16 @@ func Test_isSupportedFS(t *testing.T) {
 			want: true,
 		},
 		{
-			name: "btrfs is not supported",
+			name: "btrfs is supported",
 			args: args{
-				fs: "btrfs",
+				fs: filesystem.Btrfs,
+			},
+			want: true,
+		},
+		{
+			name: "ntfs is not supported",
+			args: args{
+				fs: filesystem.Ntfs,
 			},
 			want: false,
 		},
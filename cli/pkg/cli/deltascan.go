@@ -0,0 +1,181 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families"
+	"github.com/openclarity/vmclarity/shared/pkg/fsutils/blockdevice"
+	"github.com/openclarity/vmclarity/shared/pkg/fsutils/changes"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+)
+
+const (
+	// MaxDeltaScanChangedPaths is the maximum number of changed files and
+	// directories for which a delta scan is done.
+	MaxDeltaScanChangedPaths = 10000
+
+	DeltaScanDirPattern = "vmclarity-delta-"
+	DeltaScanDirPerm    = 0o700
+)
+
+// PrepareDeltaScan limits the secrets and malware scans of the mounted volumes
+// to the files changed since the previous scan of the target, if the changed
+// blocks of the volume are known. The volumes are scanned in full if the
+// changed blocks cannot be mapped to files.
+func (c *CLI) PrepareDeltaScan(ctx context.Context, mountPoints []string) {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	if err := c.prepareDeltaScan(ctx, mountPoints); err != nil {
+		logger.Warnf("Failed to prepare delta scan, volumes are scanned in full: %v", err)
+	}
+}
+
+func (c *CLI) prepareDeltaScan(ctx context.Context, mountPoints []string) error {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	if !c.FamiliesConfig.Secrets.Enabled && !c.FamiliesConfig.Malware.Enabled {
+		return nil
+	}
+
+	deltaScan, err := c.GetDeltaScanInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get delta scan info: %w", err)
+	}
+	if deltaScan == nil || deltaScan.ChangedBlocks == nil {
+		return nil
+	}
+
+	changedBlocks := make([]changes.Extent, 0, len(*deltaScan.ChangedBlocks))
+	for _, block := range *deltaScan.ChangedBlocks {
+		changedBlocks = append(changedBlocks, changes.Extent{
+			Offset: block.Offset,
+			Length: block.Length,
+		})
+	}
+
+	blockDevices, err := blockdevice.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list block devices: %w", err)
+	}
+	devicePaths := make(map[string]string, len(blockDevices))
+	for _, device := range blockDevices {
+		devicePaths[device.MountPoint] = device.Path
+	}
+
+	changedPaths := []string{}
+	deltaInputs := make(map[string]string, len(mountPoints))
+	for _, mountPoint := range mountPoints {
+		devicePath, ok := devicePaths[mountPoint]
+		if !ok {
+			return fmt.Errorf("failed to find device mounted to %s", mountPoint)
+		}
+
+		offset, err := changes.PartitionOffset(devicePath)
+		if err != nil {
+			return fmt.Errorf("failed to get partition offset of device %s: %w", devicePath, err)
+		}
+
+		changed, err := changes.Find(ctx, mountPoint, offset, changedBlocks)
+		if err != nil {
+			return fmt.Errorf("failed to find changed files of device %s: %w", devicePath, err)
+		}
+
+		changedPaths = append(changedPaths, changed.Paths...)
+		if len(changedPaths) > MaxDeltaScanChangedPaths {
+			return fmt.Errorf("too many changed paths: %d", len(changedPaths))
+		}
+
+		deltaDir, err := copyFiles(mountPoint, changed.Files)
+		if err != nil {
+			return fmt.Errorf("failed to copy changed files of device %s: %w", devicePath, err)
+		}
+		deltaInputs[mountPoint] = deltaDir
+
+		logger.Infof("Found changed files. Device=%s MountPoint=%s ChangedPaths=%d Files=%d",
+			devicePath, mountPoint, len(changed.Paths), len(changed.Files))
+	}
+
+	// The changed paths must be reported before the inputs are changed as
+	// the results of a delta scan are combined with the previous findings.
+	if err = c.SetDeltaScanChangedPaths(ctx, changedPaths); err != nil {
+		return fmt.Errorf("failed to report changed paths: %w", err)
+	}
+	setDeltaScanInputs(c.FamiliesConfig, deltaInputs)
+
+	return nil
+}
+
+// copyFiles copies the files relative to root into a new temporary directory
+// with the same directory structure.
+func copyFiles(root string, files []string) (string, error) {
+	dir, err := os.MkdirTemp("", DeltaScanDirPattern)
+	if err != nil {
+		return "", fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	for _, file := range files {
+		dst := filepath.Join(dir, file)
+		if err := os.MkdirAll(filepath.Dir(dst), DeltaScanDirPerm); err != nil {
+			return "", fmt.Errorf("failed to create directory for %s: %w", file, err)
+		}
+		if err := copyFile(filepath.Join(root, file), dst); err != nil {
+			return "", err
+		}
+	}
+
+	return dir, nil
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", src, err)
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", dst, err)
+	}
+	defer out.Close()
+
+	if _, err = io.Copy(out, in); err != nil {
+		return fmt.Errorf("failed to copy %s: %w", src, err)
+	}
+
+	return nil
+}
+
+// setDeltaScanInputs replaces the mount points in the secrets and malware
+// inputs with the directories containing only their changed files.
+func setDeltaScanInputs(familiesConfig *families.Config, deltaInputs map[string]string) {
+	for i, input := range familiesConfig.Secrets.Inputs {
+		if dir, ok := deltaInputs[input.Input]; ok {
+			familiesConfig.Secrets.Inputs[i].Input = dir
+		}
+	}
+	for i, input := range familiesConfig.Malware.Inputs {
+		if dir, ok := deltaInputs[input.Input]; ok {
+			familiesConfig.Malware.Inputs[i].Input = dir
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families"
+	"github.com/openclarity/vmclarity/shared/pkg/families/malware"
+	"github.com/openclarity/vmclarity/shared/pkg/families/secrets"
+)
+
+func Test_copyFiles(t *testing.T) {
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "etc"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range []string{"etc/passwd", "etc/hosts"} {
+		if err := os.WriteFile(filepath.Join(root, file), []byte(file), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	dir, err := copyFiles(root, []string{"/etc/passwd"})
+	if err != nil {
+		t.Fatalf("copyFiles() error = %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	got, err := os.ReadFile(filepath.Join(dir, "etc/passwd"))
+	if err != nil {
+		t.Fatalf("failed to read copied file: %v", err)
+	}
+	if string(got) != "etc/passwd" {
+		t.Errorf("copyFiles() copied content = %q, want %q", got, "etc/passwd")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "etc/hosts")); !os.IsNotExist(err) {
+		t.Errorf("copyFiles() copied unchanged file, err = %v", err)
+	}
+}
+
+func Test_setDeltaScanInputs(t *testing.T) {
+	config := &families.Config{
+		Secrets: secrets.Config{
+			Inputs: []secrets.Input{{Input: "/mnt/a"}, {Input: "/mnt/b"}},
+		},
+		Malware: malware.Config{
+			Inputs: []malware.Input{{Input: "/mnt/a"}},
+		},
+	}
+
+	setDeltaScanInputs(config, map[string]string{"/mnt/a": "/tmp/delta-a"})
+
+	wantSecrets := []secrets.Input{{Input: "/tmp/delta-a"}, {Input: "/mnt/b"}}
+	if diff := cmp.Diff(wantSecrets, config.Secrets.Inputs); diff != "" {
+		t.Errorf("setDeltaScanInputs() secrets inputs mismatch (-want +got):\n%s", diff)
+	}
+	wantMalware := []malware.Input{{Input: "/tmp/delta-a"}}
+	if diff := cmp.Diff(wantMalware, config.Malware.Inputs); diff != "" {
+		t.Errorf("setDeltaScanInputs() malware inputs mismatch (-want +got):\n%s", diff)
+	}
+}
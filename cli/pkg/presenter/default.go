@@ -21,8 +21,11 @@ import (
 	"fmt"
 
 	"github.com/openclarity/vmclarity/shared/pkg/families"
+	"github.com/openclarity/vmclarity/shared/pkg/families/certificates"
+	"github.com/openclarity/vmclarity/shared/pkg/families/compliance"
 	"github.com/openclarity/vmclarity/shared/pkg/families/exploits"
 	"github.com/openclarity/vmclarity/shared/pkg/families/malware"
+	"github.com/openclarity/vmclarity/shared/pkg/families/plugins"
 	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits"
 	"github.com/openclarity/vmclarity/shared/pkg/families/sbom"
 	"github.com/openclarity/vmclarity/shared/pkg/families/secrets"
@@ -54,6 +57,12 @@ func (p *DefaultPresenter) ExportFamilyResult(ctx context.Context, res families.
 		err = p.ExportRootkitResult(ctx, res)
 	case types.Malware:
 		err = p.ExportMalwareResult(ctx, res)
+	case types.Certificates:
+		err = p.ExportCertificatesResult(ctx, res)
+	case types.Compliance:
+		err = p.ExportComplianceResult(ctx, res)
+	case types.Plugins:
+		err = p.ExportPluginsResult(ctx, res)
 	}
 
 	return err
@@ -167,3 +176,54 @@ func (p *DefaultPresenter) ExportRootkitResult(_ context.Context, res families.F
 	}
 	return nil
 }
+
+func (p *DefaultPresenter) ExportCertificatesResult(_ context.Context, res families.FamilyResult) error {
+	certificatesResults, ok := res.Result.(*certificates.Results)
+	if !ok {
+		return fmt.Errorf("failed to convert to certificates results")
+	}
+
+	bytes, err := json.Marshal(certificatesResults)
+	if err != nil {
+		return fmt.Errorf("failed to marshal certificates results: %w", err)
+	}
+	err = p.Write(bytes, "certificates.json")
+	if err != nil {
+		return fmt.Errorf("failed to output certificates results: %w", err)
+	}
+	return nil
+}
+
+func (p *DefaultPresenter) ExportComplianceResult(_ context.Context, res families.FamilyResult) error {
+	complianceResults, ok := res.Result.(*compliance.Results)
+	if !ok {
+		return fmt.Errorf("failed to convert to compliance results")
+	}
+
+	bytes, err := json.Marshal(complianceResults)
+	if err != nil {
+		return fmt.Errorf("failed to marshal compliance results: %w", err)
+	}
+	err = p.Write(bytes, "compliance.json")
+	if err != nil {
+		return fmt.Errorf("failed to output compliance results: %w", err)
+	}
+	return nil
+}
+
+func (p *DefaultPresenter) ExportPluginsResult(_ context.Context, res families.FamilyResult) error {
+	pluginsResults, ok := res.Result.(*plugins.Results)
+	if !ok {
+		return fmt.Errorf("failed to convert to plugins results")
+	}
+
+	bytes, err := json.Marshal(pluginsResults)
+	if err != nil {
+		return fmt.Errorf("failed to marshal plugins results: %w", err)
+	}
+	err = p.Write(bytes, "plugins.json")
+	if err != nil {
+		return fmt.Errorf("failed to output plugins results: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package presenter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	cliutils "github.com/openclarity/vmclarity/cli/pkg/utils"
+	"github.com/openclarity/vmclarity/shared/pkg/families"
+	"github.com/openclarity/vmclarity/shared/pkg/scanexport"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// ExportPresenter collects the results of the families converted to the API
+// model for the orchestrator, which uploads them to the backend once the scan
+// is done, see state.ExportState.
+type ExportPresenter struct {
+	writer *scanexport.Writer
+}
+
+func (e *ExportPresenter) ExportFamilyResult(_ context.Context, res families.FamilyResult) error {
+	family, err := cliutils.ConvertFamilyTypeToAPIModel(res.FamilyType)
+	if err != nil {
+		return fmt.Errorf("failed to convert family type: %w", err)
+	}
+
+	errs := []string{}
+	var result interface{}
+	var summary *models.ScanFindingsSummary
+	if res.Err != nil {
+		errs = append(errs, res.Err.Error())
+	} else {
+		result, summary, err = convertFamilyResult(res)
+		if err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	state := models.AssetScanState{
+		State:              utils.PointerTo(models.AssetScanStateStateDone),
+		LastTransitionTime: utils.PointerTo(time.Now()),
+		Errors:             &errs,
+	}
+
+	// nolint:wrapcheck
+	return e.writer.AddFamilyResult(family, result, state, summary)
+}
+
+func NewExportPresenter(writer *scanexport.Writer) (*ExportPresenter, error) {
+	if writer == nil {
+		return nil, errors.New("export writer must not be nil")
+	}
+	return &ExportPresenter{
+		writer: writer,
+	}, nil
+}
@@ -0,0 +1,295 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package presenter
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/openclarity/vmclarity/api/models"
+	cliutils "github.com/openclarity/vmclarity/cli/pkg/utils"
+	"github.com/openclarity/vmclarity/shared/pkg/families/certificates"
+	"github.com/openclarity/vmclarity/shared/pkg/families/compliance"
+	"github.com/openclarity/vmclarity/shared/pkg/families/exploits"
+	"github.com/openclarity/vmclarity/shared/pkg/families/interfaces"
+	"github.com/openclarity/vmclarity/shared/pkg/families/malware"
+	"github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration"
+	"github.com/openclarity/vmclarity/shared/pkg/families/plugins"
+	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits"
+	"github.com/openclarity/vmclarity/shared/pkg/families/secrets"
+	"github.com/openclarity/vmclarity/shared/pkg/families/types"
+	"github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+type Severity string
+
+const (
+	SeverityCritical Severity = "critical"
+	SeverityHigh     Severity = "high"
+	SeverityMedium   Severity = "medium"
+	SeverityLow      Severity = "low"
+	SeverityInfo     Severity = "info"
+)
+
+var severityRanks = map[Severity]int{
+	SeverityInfo:     1,
+	SeverityLow:      2,
+	SeverityMedium:   3,
+	SeverityHigh:     4,
+	SeverityCritical: 5,
+}
+
+func ParseSeverity(s string) (Severity, error) {
+	severity := Severity(strings.ToLower(s))
+	if _, ok := severityRanks[severity]; !ok {
+		return "", fmt.Errorf("unsupported severity %q, must be one of: %s, %s, %s, %s, %s",
+			s, SeverityCritical, SeverityHigh, SeverityMedium, SeverityLow, SeverityInfo)
+	}
+	return severity, nil
+}
+
+// AtLeast returns whether the severity is the same as or higher than other.
+func (s Severity) AtLeast(other Severity) bool {
+	return severityRanks[s] >= severityRanks[other]
+}
+
+// Finding is a single finding of a family in a local scan. Key identifies the
+// finding across scans from the same fields the findings are deduplicated by
+// in the VMClarity server.
+type Finding struct {
+	FamilyType types.FamilyType `json:"family"`
+	Key        string           `json:"key"`
+	RuleID     string           `json:"ruleID"`
+	Severity   Severity         `json:"severity"`
+	Title      string           `json:"title"`
+	Location   string           `json:"location,omitempty"`
+	// Baseline is set if the finding is in the baseline, the findings in
+	// the baseline are not new so they don't fail the scan.
+	Baseline bool `json:"baseline,omitempty"`
+}
+
+// Baseline is the set of findings known before a scan.
+type Baseline map[string]struct{}
+
+func baselineKey(family types.FamilyType, key string) string {
+	return string(family) + "/" + key
+}
+
+// LoadBaseline reads the findings of a JSON report written by a previous local
+// scan from path.
+func LoadBaseline(path string) (Baseline, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read baseline: %w", err)
+	}
+
+	var report struct {
+		Findings []Finding `json:"findings"`
+	}
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("failed to parse baseline %s, it must be a JSON report of a local scan: %w", path, err)
+	}
+
+	baseline := Baseline{}
+	for _, f := range report.Findings {
+		baseline[baselineKey(f.FamilyType, f.Key)] = struct{}{}
+	}
+	return baseline, nil
+}
+
+// Contains returns whether the finding is in the baseline.
+func (b Baseline) Contains(f Finding) bool {
+	_, ok := b[baselineKey(f.FamilyType, f.Key)]
+	return ok
+}
+
+func joinKey(parts ...string) string {
+	return strings.Join(parts, ".")
+}
+
+// newFindings returns the findings of the results of a family, the packages of
+// the SBOM family are not findings. Malware and rootkits are critical, secrets
+// and exploits high.
+// nolint:cyclop,maintidx
+func newFindings(familyType types.FamilyType, res interfaces.IsResults) ([]Finding, error) {
+	var findings []Finding
+	add := func(f Finding) {
+		f.FamilyType = familyType
+		findings = append(findings, f)
+	}
+
+	switch r := res.(type) {
+	case *vulnerabilities.Results:
+		for _, v := range utils.ValueOrZero(cliutils.ConvertVulnResultToAPIModel(r).Vulnerabilities) {
+			name := utils.ValueOrZero(v.VulnerabilityName)
+			var pkgName, pkgVersion string
+			if v.Package != nil {
+				pkgName, pkgVersion = utils.ValueOrZero(v.Package.Name), utils.ValueOrZero(v.Package.Version)
+			}
+			add(Finding{
+				Key:      joinKey(name, pkgName, pkgVersion),
+				RuleID:   name,
+				Severity: vulnerabilitySeverity(utils.ValueOrZero(v.Severity)),
+				Title:    fmt.Sprintf("%s in %s %s", name, pkgName, pkgVersion),
+				Location: utils.ValueOrZero(v.Path),
+			})
+		}
+	case *secrets.Results:
+		for _, s := range utils.ValueOrZero(cliutils.ConvertSecretsResultToAPIModel(r).Secrets) {
+			add(Finding{
+				Key: joinKey(utils.ValueOrZero(s.Fingerprint),
+					fmt.Sprint(utils.ValueOrZero(s.StartColumn)), fmt.Sprint(utils.ValueOrZero(s.EndColumn))),
+				RuleID:   "secret",
+				Severity: SeverityHigh,
+				Title:    utils.ValueOrZero(s.Description),
+				Location: utils.ValueOrZero(s.FilePath),
+			})
+		}
+	case *exploits.Results:
+		for _, e := range utils.ValueOrZero(cliutils.ConvertExploitsResultToAPIModel(r).Exploits) {
+			hash := sha256.New()
+			hash.Write([]byte(utils.ValueOrZero(e.SourceDB)))
+			hash.Write([]byte(utils.ValueOrZero(e.CveID)))
+			for _, url := range utils.ValueOrZero(e.Urls) {
+				hash.Write([]byte(url))
+			}
+			add(Finding{
+				Key:      hex.EncodeToString(hash.Sum(nil)),
+				RuleID:   utils.ValueOrZero(e.CveID),
+				Severity: SeverityHigh,
+				Title:    utils.ValueOrZero(e.Title),
+			})
+		}
+	case *misconfiguration.Results:
+		scan, err := cliutils.ConvertMisconfigurationResultToAPIModel(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert misconfigurations: %w", err)
+		}
+		for _, m := range utils.ValueOrZero(scan.Misconfigurations) {
+			add(Finding{
+				Key:      joinKey(utils.ValueOrZero(m.ScannerName), utils.ValueOrZero(m.TestID), utils.ValueOrZero(m.Message)),
+				RuleID:   utils.ValueOrZero(m.TestID),
+				Severity: misconfigurationSeverity(utils.ValueOrZero(m.Severity)),
+				Title:    utils.ValueOrZero(m.Message),
+				Location: utils.ValueOrZero(m.ScannedPath),
+			})
+		}
+	case *rootkits.Results:
+		for _, rk := range utils.ValueOrZero(cliutils.ConvertRootkitsResultToAPIModel(r).Rootkits) {
+			name := utils.ValueOrZero(rk.RootkitName)
+			add(Finding{
+				Key:      joinKey(name, string(utils.ValueOrZero(rk.RootkitType)), utils.ValueOrZero(rk.Message)),
+				RuleID:   name,
+				Severity: SeverityCritical,
+				Title:    utils.ValueOrZero(rk.Message),
+			})
+		}
+	case *malware.MergedResults:
+		for _, m := range utils.ValueOrZero(cliutils.ConvertMalwareResultToAPIModel(r).Malware) {
+			name := utils.ValueOrZero(m.MalwareName)
+			add(Finding{
+				Key:      joinKey(name, string(utils.ValueOrZero(m.MalwareType)), utils.ValueOrZero(m.Path)),
+				RuleID:   name,
+				Severity: SeverityCritical,
+				Title:    fmt.Sprintf("%s %s", utils.ValueOrZero(m.MalwareType), name),
+				Location: utils.ValueOrZero(m.Path),
+			})
+		}
+	case *certificates.Results:
+		for _, c := range utils.ValueOrZero(cliutils.ConvertCertificatesResultToAPIModel(r).Certificates) {
+			findingType := utils.ValueOrZero(c.FindingType)
+			add(Finding{
+				Key:      joinKey(string(findingType), utils.ValueOrZero(c.FilePath), utils.ValueOrZero(c.Fingerprint)),
+				RuleID:   string(findingType),
+				Severity: certificateSeverity(findingType),
+				Title:    utils.ValueOrZero(c.Message),
+				Location: utils.ValueOrZero(c.FilePath),
+			})
+		}
+	case *compliance.Results:
+		for _, c := range utils.ValueOrZero(cliutils.ConvertComplianceResultToAPIModel(r).ComplianceChecks) {
+			checkID := utils.ValueOrZero(c.CheckID)
+			add(Finding{
+				Key: joinKey(string(utils.ValueOrZero(c.Benchmark)), checkID,
+					utils.ValueOrZero(c.FilePath), utils.ValueOrZero(c.Message)),
+				RuleID:   checkID,
+				Severity: SeverityMedium,
+				Title:    utils.ValueOrZero(c.Title),
+				Location: utils.ValueOrZero(c.FilePath),
+			})
+		}
+	case *plugins.Results:
+		for _, p := range utils.ValueOrZero(cliutils.ConvertPluginsResultToAPIModel(r).PluginFindings) {
+			findingID := utils.ValueOrZero(p.FindingID)
+			severity, err := ParseSeverity(utils.ValueOrZero(p.Severity))
+			if err != nil {
+				severity = SeverityMedium
+			}
+			add(Finding{
+				Key:      joinKey(utils.ValueOrZero(p.PluginName), findingID, utils.ValueOrZero(p.Path)),
+				RuleID:   findingID,
+				Severity: severity,
+				Title:    utils.ValueOrZero(p.Title),
+				Location: utils.ValueOrZero(p.Path),
+			})
+		}
+	}
+
+	return findings, nil
+}
+
+func vulnerabilitySeverity(severity models.VulnerabilitySeverity) Severity {
+	switch severity {
+	case models.CRITICAL:
+		return SeverityCritical
+	case models.HIGH:
+		return SeverityHigh
+	case models.MEDIUM:
+		return SeverityMedium
+	case models.LOW:
+		return SeverityLow
+	case models.NEGLIGIBLE:
+		return SeverityInfo
+	}
+	return SeverityInfo
+}
+
+func misconfigurationSeverity(severity models.MisconfigurationSeverity) Severity {
+	switch severity {
+	case models.MisconfigurationHighSeverity:
+		return SeverityHigh
+	case models.MisconfigurationMediumSeverity:
+		return SeverityMedium
+	case models.MisconfigurationLowSeverity:
+		return SeverityLow
+	}
+	return SeverityInfo
+}
+
+func certificateSeverity(findingType models.CertificateFindingType) Severity {
+	switch findingType {
+	case models.EXPIREDCERTIFICATE, models.PRIVATEKEY:
+		return SeverityHigh
+	case models.EXPIRINGCERTIFICATE, models.WEAKKEY, models.WEAKSIGNATUREALGORITHM:
+		return SeverityMedium
+	}
+	return SeverityInfo
+}
@@ -0,0 +1,207 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package presenter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	cliutils "github.com/openclarity/vmclarity/cli/pkg/utils"
+	"github.com/openclarity/vmclarity/shared/pkg/families"
+	"github.com/openclarity/vmclarity/shared/pkg/families/certificates"
+	"github.com/openclarity/vmclarity/shared/pkg/families/compliance"
+	"github.com/openclarity/vmclarity/shared/pkg/families/exploits"
+	"github.com/openclarity/vmclarity/shared/pkg/families/interfaces"
+	"github.com/openclarity/vmclarity/shared/pkg/families/malware"
+	"github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration"
+	"github.com/openclarity/vmclarity/shared/pkg/families/plugins"
+	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits"
+	"github.com/openclarity/vmclarity/shared/pkg/families/sbom"
+	"github.com/openclarity/vmclarity/shared/pkg/families/secrets"
+	"github.com/openclarity/vmclarity/shared/pkg/families/types"
+	"github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities"
+)
+
+type ReportFormat string
+
+const (
+	ReportFormatJSON  ReportFormat = "json"
+	ReportFormatSARIF ReportFormat = "sarif"
+	ReportFormatTable ReportFormat = "table"
+)
+
+// FamilyReport is the outcome of a family in a local scan. Findings is the
+// number of packages for the SBOM family and the number of findings for the
+// others, NewFindings the number of those which are not in the baseline.
+type FamilyReport struct {
+	FamilyType  types.FamilyType     `json:"family"`
+	Findings    int                  `json:"findings"`
+	NewFindings int                  `json:"newFindings"`
+	Error       string               `json:"error,omitempty"`
+	Result      interfaces.IsResults `json:"result,omitempty"`
+}
+
+type Report struct {
+	Families []FamilyReport `json:"families"`
+	Findings []Finding      `json:"findings"`
+}
+
+// NewFindings returns the number of findings which are not in the baseline and
+// are at least of the severity.
+func (r *Report) NewFindings(severity Severity) int {
+	var total int
+	for _, f := range r.Findings {
+		if !f.Baseline && f.Severity.AtLeast(severity) {
+			total++
+		}
+	}
+	return total
+}
+
+// ReportPresenter collects the results of the families and prints them at
+// once in Format when the scan is done, so that the output can be parsed by
+// CI pipelines. The findings in Baseline, if set, are reported but are not new.
+type ReportPresenter struct {
+	Output   io.Writer
+	Format   ReportFormat
+	Baseline Baseline
+	Report   Report
+}
+
+func NewReportPresenter(output io.Writer, format ReportFormat, baseline Baseline) (*ReportPresenter, error) {
+	switch format {
+	case ReportFormatJSON, ReportFormatSARIF, ReportFormatTable:
+	default:
+		return nil, fmt.Errorf("unsupported report format %q, must be one of: %s, %s, %s",
+			format, ReportFormatJSON, ReportFormatSARIF, ReportFormatTable)
+	}
+
+	return &ReportPresenter{
+		Output:   output,
+		Format:   format,
+		Baseline: baseline,
+		Report:   Report{Findings: []Finding{}},
+	}, nil
+}
+
+func (r *ReportPresenter) ExportFamilyResult(_ context.Context, res families.FamilyResult) error {
+	report := FamilyReport{
+		FamilyType: res.FamilyType,
+		Result:     res.Result,
+	}
+
+	if res.Err != nil {
+		report.Error = res.Err.Error()
+	} else {
+		findings, err := countFindings(res.Result)
+		if err != nil {
+			report.Error = err.Error()
+		}
+		report.Findings = findings
+
+		newFindings, err := newFindings(res.FamilyType, res.Result)
+		if err != nil {
+			report.Error = err.Error()
+		}
+		for i := range newFindings {
+			newFindings[i].Baseline = r.Baseline.Contains(newFindings[i])
+			if !newFindings[i].Baseline {
+				report.NewFindings++
+			}
+		}
+		r.Report.Findings = append(r.Report.Findings, newFindings...)
+	}
+
+	r.Report.Families = append(r.Report.Families, report)
+
+	return nil
+}
+
+// Print writes the collected results to Output.
+func (r *ReportPresenter) Print() error {
+	switch r.Format {
+	case ReportFormatJSON:
+		encoder := json.NewEncoder(r.Output)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(r.Report); err != nil {
+			return fmt.Errorf("failed to write report: %w", err)
+		}
+	case ReportFormatSARIF:
+		return writeSarif(r.Output, r.Report, r.Baseline != nil)
+	case ReportFormatTable:
+		w := tabwriter.NewWriter(r.Output, 0, 0, 2, ' ', 0) // nolint:gomnd
+		fmt.Fprintln(w, "FAMILY\tFINDINGS\tNEW\tERROR")
+		for _, f := range r.Report.Families {
+			fmt.Fprintf(w, "%s\t%d\t%d\t%s\n", f.FamilyType, f.Findings, f.NewFindings, f.Error)
+		}
+		if err := w.Flush(); err != nil {
+			return fmt.Errorf("failed to write report: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// nolint:cyclop
+func countFindings(res interfaces.IsResults) (int, error) {
+	switch r := res.(type) {
+	case *sbom.Results:
+		if packages := cliutils.ConvertSBOMResultToAPIModel(r).Packages; packages != nil {
+			return len(*packages), nil
+		}
+	case *vulnerabilities.Results:
+		if vulns := cliutils.ConvertVulnResultToAPIModel(r).Vulnerabilities; vulns != nil {
+			return len(*vulns), nil
+		}
+	case *secrets.Results:
+		if secrets := cliutils.ConvertSecretsResultToAPIModel(r).Secrets; secrets != nil {
+			return len(*secrets), nil
+		}
+	case *exploits.Results:
+		if exploits := cliutils.ConvertExploitsResultToAPIModel(r).Exploits; exploits != nil {
+			return len(*exploits), nil
+		}
+	case *misconfiguration.Results:
+		return len(r.Misconfigurations), nil
+	case *rootkits.Results:
+		if rootkits := cliutils.ConvertRootkitsResultToAPIModel(r).Rootkits; rootkits != nil {
+			return len(*rootkits), nil
+		}
+	case *malware.MergedResults:
+		if malware := cliutils.ConvertMalwareResultToAPIModel(r).Malware; malware != nil {
+			return len(*malware), nil
+		}
+	case *certificates.Results:
+		if certificates := cliutils.ConvertCertificatesResultToAPIModel(r).Certificates; certificates != nil {
+			return len(*certificates), nil
+		}
+	case *compliance.Results:
+		if checks := cliutils.ConvertComplianceResultToAPIModel(r).ComplianceChecks; checks != nil {
+			return len(*checks), nil
+		}
+	case *plugins.Results:
+		if findings := cliutils.ConvertPluginsResultToAPIModel(r).PluginFindings; findings != nil {
+			return len(*findings), nil
+		}
+	default:
+		return 0, fmt.Errorf("unsupported results type %T", res)
+	}
+
+	return 0, nil
+}
@@ -0,0 +1,134 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package presenter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families"
+	"github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration"
+	misconfigurationTypes "github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration/types"
+	"github.com/openclarity/vmclarity/shared/pkg/families/types"
+)
+
+func newMisconfigurationResult(severities ...misconfigurationTypes.Severity) families.FamilyResult {
+	res := misconfiguration.NewResults()
+	for i, severity := range severities {
+		res.Misconfigurations = append(res.Misconfigurations, misconfiguration.FlattenedMisconfiguration{
+			ScannerName: "lynis",
+			Misconfiguration: misconfigurationTypes.Misconfiguration{
+				ScannedPath: "/etc/ssh/sshd_config",
+				TestID:      "SSH-7408",
+				Severity:    severity,
+				Message:     string(rune('a' + i)),
+			},
+		})
+	}
+	return families.FamilyResult{FamilyType: types.Misconfiguration, Result: res}
+}
+
+func TestReportPresenter_Baseline(t *testing.T) {
+	ctx := context.Background()
+
+	// The baseline is the JSON report of a previous scan
+	var previous bytes.Buffer
+	p, err := NewReportPresenter(&previous, ReportFormatJSON, nil)
+	if err != nil {
+		t.Fatalf("NewReportPresenter() error = %v", err)
+	}
+	if err := p.ExportFamilyResult(ctx, newMisconfigurationResult(misconfigurationTypes.HighSeverity)); err != nil {
+		t.Fatalf("ExportFamilyResult() error = %v", err)
+	}
+	if err := p.Print(); err != nil {
+		t.Fatalf("Print() error = %v", err)
+	}
+	baselineFile := filepath.Join(t.TempDir(), "baseline.json")
+	if err := os.WriteFile(baselineFile, previous.Bytes(), 0o600); err != nil {
+		t.Fatalf("failed to write baseline: %v", err)
+	}
+	baseline, err := LoadBaseline(baselineFile)
+	if err != nil {
+		t.Fatalf("LoadBaseline() error = %v", err)
+	}
+
+	var out bytes.Buffer
+	p, err = NewReportPresenter(&out, ReportFormatSARIF, baseline)
+	if err != nil {
+		t.Fatalf("NewReportPresenter() error = %v", err)
+	}
+	res := newMisconfigurationResult(misconfigurationTypes.HighSeverity, misconfigurationTypes.MediumSeverity, misconfigurationTypes.LowSeverity)
+	if err := p.ExportFamilyResult(ctx, res); err != nil {
+		t.Fatalf("ExportFamilyResult() error = %v", err)
+	}
+
+	// The high finding is in the baseline
+	for severity, want := range map[Severity]int{
+		SeverityCritical: 0,
+		SeverityHigh:     0,
+		SeverityMedium:   1,
+		SeverityInfo:     2,
+	} {
+		if got := p.Report.NewFindings(severity); got != want {
+			t.Errorf("NewFindings(%s) = %d, want %d", severity, got, want)
+		}
+	}
+	if got := p.Report.Families[0].NewFindings; got != 2 {
+		t.Errorf("family NewFindings = %d, want 2", got)
+	}
+
+	if err := p.Print(); err != nil {
+		t.Fatalf("Print() error = %v", err)
+	}
+	var log sarifLog
+	if err := json.Unmarshal(out.Bytes(), &log); err != nil {
+		t.Fatalf("failed to parse SARIF report: %v", err)
+	}
+	results := log.Runs[0].Results
+	if len(results) != 3 {
+		t.Fatalf("got %d SARIF results, want 3", len(results))
+	}
+	for i, want := range []struct {
+		level         string
+		baselineState string
+	}{
+		{level: "error", baselineState: "unchanged"},
+		{level: "warning", baselineState: "new"},
+		{level: "note", baselineState: "new"},
+	} {
+		if results[i].Level != want.level || results[i].BaselineState != want.baselineState {
+			t.Errorf("result %d level = %s, baselineState = %s, want %s, %s",
+				i, results[i].Level, results[i].BaselineState, want.level, want.baselineState)
+		}
+	}
+	if got := log.Runs[0].Tool.Driver.Rules; len(got) != 1 || got[0].ID != "misconfiguration/SSH-7408" {
+		t.Errorf("rules = %v, want misconfiguration/SSH-7408 only", got)
+	}
+}
+
+func TestParseSeverity(t *testing.T) {
+	severity, err := ParseSeverity("HIGH")
+	if err != nil || severity != SeverityHigh {
+		t.Errorf("ParseSeverity() = %v, %v, want %v", severity, err, SeverityHigh)
+	}
+	if _, err := ParseSeverity("severe"); err == nil {
+		t.Errorf("ParseSeverity() error = nil, want an error")
+	}
+}
@@ -17,21 +17,27 @@ package presenter
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/openclarity/vmclarity/api/models"
 	cliutils "github.com/openclarity/vmclarity/cli/pkg/utils"
 	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
 	"github.com/openclarity/vmclarity/shared/pkg/families"
+	"github.com/openclarity/vmclarity/shared/pkg/families/certificates"
+	"github.com/openclarity/vmclarity/shared/pkg/families/compliance"
 	"github.com/openclarity/vmclarity/shared/pkg/families/exploits"
 	"github.com/openclarity/vmclarity/shared/pkg/families/malware"
 	"github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration"
+	"github.com/openclarity/vmclarity/shared/pkg/families/plugins"
 	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits"
 	"github.com/openclarity/vmclarity/shared/pkg/families/sbom"
 	"github.com/openclarity/vmclarity/shared/pkg/families/secrets"
 	"github.com/openclarity/vmclarity/shared/pkg/families/types"
 	"github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
@@ -44,328 +50,189 @@ type VMClarityPresenter struct {
 }
 
 func (v *VMClarityPresenter) ExportFamilyResult(ctx context.Context, res families.FamilyResult) error {
-	var err error
-
-	switch res.FamilyType {
-	case types.SBOM:
-		err = v.ExportSbomResult(ctx, res)
-	case types.Vulnerabilities:
-		err = v.ExportVulResult(ctx, res)
-	case types.Secrets:
-		err = v.ExportSecretsResult(ctx, res)
-	case types.Exploits:
-		err = v.ExportExploitsResult(ctx, res)
-	case types.Misconfiguration:
-		err = v.ExportMisconfigurationResult(ctx, res)
-	case types.Rootkits:
-		err = v.ExportRootkitResult(ctx, res)
-	case types.Malware:
-		err = v.ExportMalwareResult(ctx, res)
-	}
-
-	return err
-}
-
-func (v *VMClarityPresenter) ExportSbomResult(ctx context.Context, res families.FamilyResult) error {
-	scanResult, err := v.client.GetScanResult(ctx, v.scanResultID, models.GetScanResultsScanResultIDParams{})
+	family, err := cliutils.ConvertFamilyTypeToAPIModel(res.FamilyType)
 	if err != nil {
-		return fmt.Errorf("failed to get scan result: %w", err)
-	}
-
-	if scanResult.Status == nil {
-		scanResult.Status = &models.TargetScanStatus{}
-	}
-	if scanResult.Status.Sbom == nil {
-		scanResult.Status.Sbom = &models.TargetScanState{}
-	}
-	if scanResult.Summary == nil {
-		scanResult.Summary = &models.ScanFindingsSummary{}
+		return fmt.Errorf("failed to convert family type: %w", err)
 	}
 
 	errs := []string{}
-
+	var result interface{}
+	var summary *models.ScanFindingsSummary
 	if res.Err != nil {
 		errs = append(errs, res.Err.Error())
 	} else {
-		sbomResults, ok := res.Result.(*sbom.Results)
-		if !ok {
-			errs = append(errs, fmt.Errorf("failed to convert to sbom results").Error())
-		} else {
-			scanResult.Sboms = cliutils.ConvertSBOMResultToAPIModel(sbomResults)
-			if scanResult.Sboms.Packages != nil {
-				scanResult.Summary.TotalPackages = utils.PointerTo(len(*scanResult.Sboms.Packages))
-			}
+		result, summary, err = convertFamilyResult(res)
+		if err != nil {
+			errs = append(errs, err.Error())
 		}
 	}
 
-	state := models.TargetScanStateStateDone
-	scanResult.Status.Sbom.State = &state
-	scanResult.Status.Sbom.Errors = &errs
-
-	err = v.client.PatchScanResult(ctx, scanResult, v.scanResultID)
-	if err != nil {
-		return fmt.Errorf("failed to patch scan result: %w", err)
+	state := models.AssetScanState{
+		State:              utils.PointerTo(models.AssetScanStateStateDone),
+		LastTransitionTime: utils.PointerTo(time.Now()),
+		Errors:             &errs,
 	}
 
-	return nil
-}
-
-func (v *VMClarityPresenter) ExportVulResult(ctx context.Context, res families.FamilyResult) error {
-	scanResult, err := v.client.GetScanResult(ctx, v.scanResultID, models.GetScanResultsScanResultIDParams{})
+	err = v.client.UploadFamilyResult(ctx, v.scanResultID, family, result, state, summary)
 	if err != nil {
-		return fmt.Errorf("failed to get scan result: %w", err)
-	}
-
-	if scanResult.Status == nil {
-		scanResult.Status = &models.TargetScanStatus{}
-	}
-	if scanResult.Status.Vulnerabilities == nil {
-		scanResult.Status.Vulnerabilities = &models.TargetScanState{}
-	}
-	if scanResult.Summary == nil {
-		scanResult.Summary = &models.ScanFindingsSummary{}
-	}
-
-	errs := []string{}
-
-	if res.Err != nil {
-		errs = append(errs, res.Err.Error())
-	} else {
-		vulnerabilitiesResults, ok := res.Result.(*vulnerabilities.Results)
-		if !ok {
-			errs = append(errs, fmt.Errorf("failed to convert to vulnerabilities results").Error())
-		} else {
-			scanResult.Vulnerabilities = cliutils.ConvertVulnResultToAPIModel(vulnerabilitiesResults)
-		}
-		scanResult.Summary.TotalVulnerabilities = utils.GetVulnerabilityTotalsPerSeverity(scanResult.Vulnerabilities.Vulnerabilities)
+		return fmt.Errorf("failed to upload scan result: %w", err)
 	}
 
-	state := models.TargetScanStateStateDone
-	scanResult.Status.Vulnerabilities.State = &state
-	scanResult.Status.Vulnerabilities.Errors = &errs
-
-	err = v.client.PatchScanResult(ctx, scanResult, v.scanResultID)
-	if err != nil {
-		return fmt.Errorf("failed to patch scan result: %w", err)
+	if result != nil {
+		v.exportArtifact(ctx, family, res)
 	}
 
 	return nil
 }
 
-func (v *VMClarityPresenter) ExportSecretsResult(ctx context.Context, res families.FamilyResult) error {
-	scanResult, err := v.client.GetScanResult(ctx, v.scanResultID, models.GetScanResultsScanResultIDParams{})
-	if err != nil {
-		return fmt.Errorf("failed to get scan result: %w", err)
-	}
+// exportArtifact uploads the raw output of the family, i.e. its result before
+// the conversion to the API model, to the artifact store of the backend. The
+// scan result is complete without it, so a failed upload is only logged.
+func (v *VMClarityPresenter) exportArtifact(ctx context.Context, family models.ScanFamily, res families.FamilyResult) {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
 
-	if scanResult.Status == nil {
-		scanResult.Status = &models.TargetScanStatus{}
-	}
-	if scanResult.Status.Secrets == nil {
-		scanResult.Status.Secrets = &models.TargetScanState{}
+	data, contentType, err := rawFamilyOutput(res)
+	if err != nil {
+		logger.Warnf("Failed to encode the raw output of %s: %v", family, err)
+		return
 	}
 
-	if scanResult.Summary == nil {
-		scanResult.Summary = &models.ScanFindingsSummary{}
+	_, err = v.client.PutScanResultArtifact(ctx, v.scanResultID, family, contentType, data)
+	switch {
+	case errors.Is(err, backendclient.ErrArtifactStoreNotConfigured):
+		logger.Debugf("Raw output of %s is not uploaded: %v", family, err)
+	case err != nil:
+		logger.Warnf("Failed to upload the raw output of %s: %v", family, err)
 	}
+}
 
-	errs := []string{}
-
-	if res.Err != nil {
-		errs = append(errs, res.Err.Error())
-	} else {
-		secretsResults, ok := res.Result.(*secrets.Results)
-		if !ok {
-			errs = append(errs, fmt.Errorf("failed to convert to secrets results").Error())
-		} else {
-			scanResult.Secrets = cliutils.ConvertSecretsResultToAPIModel(secretsResults)
-			if scanResult.Secrets.Secrets != nil {
-				scanResult.Summary.TotalSecrets = utils.PointerTo(len(*scanResult.Secrets.Secrets))
-			}
+// rawFamilyOutput returns the raw output of the family and its media type,
+// the SBOM is a CycloneDX document and the other results are JSON encoded.
+func rawFamilyOutput(res families.FamilyResult) ([]byte, string, error) {
+	if sbomResults, ok := res.Result.(*sbom.Results); ok {
+		data, err := sbomResults.EncodeToBytes("cyclonedx-json")
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to encode sbom: %w", err)
 		}
+		return data, "application/vnd.cyclonedx+json", nil
 	}
 
-	state := models.TargetScanStateStateDone
-	scanResult.Status.Secrets.State = &state
-	scanResult.Status.Secrets.Errors = &errs
-
-	err = v.client.PatchScanResult(ctx, scanResult, v.scanResultID)
+	data, err := json.Marshal(res.Result)
 	if err != nil {
-		return fmt.Errorf("failed to patch scan result: %w", err)
+		return nil, "", fmt.Errorf("failed to marshal result: %w", err)
 	}
-
-	return nil
+	return data, "application/json", nil
 }
 
-func (v *VMClarityPresenter) ExportMalwareResult(ctx context.Context, res families.FamilyResult) error {
-	scanResult, err := v.client.GetScanResult(ctx, v.scanResultID, models.GetScanResultsScanResultIDParams{})
-	if err != nil {
-		return fmt.Errorf("failed to get scan result: %w", err)
-	}
-
-	if scanResult.Status == nil {
-		scanResult.Status = &models.TargetScanStatus{}
-	}
-	if scanResult.Status.Malware == nil {
-		scanResult.Status.Malware = &models.TargetScanState{}
-	}
+// convertFamilyResult converts the result of the family to its API model and
+// the summary of its findings.
+// nolint:cyclop
+func convertFamilyResult(res families.FamilyResult) (interface{}, *models.ScanFindingsSummary, error) {
+	summary := &models.ScanFindingsSummary{}
 
-	errs := []string{}
-
-	if res.Err != nil {
-		errs = append(errs, res.Err.Error())
-	} else {
-		malwareResults, ok := res.Result.(*malware.MergedResults)
+	switch res.FamilyType {
+	case types.SBOM:
+		sbomResults, ok := res.Result.(*sbom.Results)
 		if !ok {
-			errs = append(errs, fmt.Errorf("failed to convert to malware results").Error())
-		} else {
-			scanResult.Malware = cliutils.ConvertMalwareResultToAPIModel(malwareResults)
-			if scanResult.Malware.Malware != nil {
-				scanResult.Summary.TotalMalware = utils.PointerTo[int](len(*scanResult.Malware.Malware))
-			}
+			return nil, nil, errors.New("failed to convert to sbom results")
 		}
-	}
-
-	state := models.TargetScanStateStateDone
-	scanResult.Status.Malware.State = &state
-	scanResult.Status.Malware.Errors = &errs
-
-	if err = v.client.PatchScanResult(ctx, scanResult, v.scanResultID); err != nil {
-		return fmt.Errorf("failed to patch scan result: %w", err)
-	}
-
-	return nil
-}
-
-func (v *VMClarityPresenter) ExportExploitsResult(ctx context.Context, res families.FamilyResult) error {
-	scanResult, err := v.client.GetScanResult(ctx, v.scanResultID, models.GetScanResultsScanResultIDParams{})
-	if err != nil {
-		return fmt.Errorf("failed to get scan result: %w", err)
-	}
-
-	if scanResult.Status == nil {
-		scanResult.Status = &models.TargetScanStatus{}
-	}
-	if scanResult.Status.Exploits == nil {
-		scanResult.Status.Exploits = &models.TargetScanState{}
-	}
-	if scanResult.Summary == nil {
-		scanResult.Summary = &models.ScanFindingsSummary{}
-	}
-
-	errs := []string{}
-
-	if res.Err != nil {
-		errs = append(errs, res.Err.Error())
-	} else {
+		result := cliutils.ConvertSBOMResultToAPIModel(sbomResults)
+		if result.Packages != nil {
+			summary.TotalPackages = utils.PointerTo(len(*result.Packages))
+		}
+		return result, summary, nil
+	case types.Vulnerabilities:
+		vulnerabilitiesResults, ok := res.Result.(*vulnerabilities.Results)
+		if !ok {
+			return nil, nil, errors.New("failed to convert to vulnerabilities results")
+		}
+		result := cliutils.ConvertVulnResultToAPIModel(vulnerabilitiesResults)
+		summary.TotalVulnerabilities = utils.GetVulnerabilityTotalsPerSeverity(result.Vulnerabilities)
+		return result, summary, nil
+	case types.Secrets:
+		secretsResults, ok := res.Result.(*secrets.Results)
+		if !ok {
+			return nil, nil, errors.New("failed to convert to secrets results")
+		}
+		result := cliutils.ConvertSecretsResultToAPIModel(secretsResults)
+		if result.Secrets != nil {
+			summary.TotalSecrets = utils.PointerTo(len(*result.Secrets))
+		}
+		return result, summary, nil
+	case types.Exploits:
 		exploitsResults, ok := res.Result.(*exploits.Results)
 		if !ok {
-			errs = append(errs, fmt.Errorf("failed to convert to exploits results").Error())
-		} else {
-			scanResult.Exploits = cliutils.ConvertExploitsResultToAPIModel(exploitsResults)
-			if scanResult.Exploits.Exploits != nil {
-				scanResult.Summary.TotalExploits = utils.PointerTo(len(*scanResult.Exploits.Exploits))
-			}
+			return nil, nil, errors.New("failed to convert to exploits results")
 		}
-	}
-
-	state := models.TargetScanStateStateDone
-	scanResult.Status.Exploits.State = &state
-	scanResult.Status.Exploits.Errors = &errs
-
-	err = v.client.PatchScanResult(ctx, scanResult, v.scanResultID)
-	if err != nil {
-		return fmt.Errorf("failed to patch scan result: %w", err)
-	}
-
-	return nil
-}
-
-func (v *VMClarityPresenter) ExportMisconfigurationResult(ctx context.Context, res families.FamilyResult) error {
-	scanResult, err := v.client.GetScanResult(ctx, v.scanResultID, models.GetScanResultsScanResultIDParams{})
-	if err != nil {
-		return fmt.Errorf("failed to get scan result: %w", err)
-	}
-
-	if scanResult.Status == nil {
-		scanResult.Status = &models.TargetScanStatus{}
-	}
-	if scanResult.Status.Misconfigurations == nil {
-		scanResult.Status.Misconfigurations = &models.TargetScanState{}
-	}
-	if scanResult.Summary == nil {
-		scanResult.Summary = &models.ScanFindingsSummary{}
-	}
-
-	var errs []string
-
-	if res.Err != nil {
-		errs = append(errs, res.Err.Error())
-	} else {
+		result := cliutils.ConvertExploitsResultToAPIModel(exploitsResults)
+		if result.Exploits != nil {
+			summary.TotalExploits = utils.PointerTo(len(*result.Exploits))
+		}
+		return result, summary, nil
+	case types.Misconfiguration:
 		misconfigurationResults, ok := res.Result.(*misconfiguration.Results)
 		if !ok {
-			errs = append(errs, fmt.Errorf("failed to convert to misconfiguration results").Error())
-		} else {
-			apiMisconfigurations, err := cliutils.ConvertMisconfigurationResultToAPIModel(misconfigurationResults)
-			if err != nil {
-				errs = append(errs, fmt.Sprintf("failed to convert misconfiguration results from scan to API model: %v", err))
-			} else {
-				scanResult.Misconfigurations = apiMisconfigurations
-				scanResult.Summary.TotalMisconfigurations = utils.PointerTo(len(misconfigurationResults.Misconfigurations))
-			}
+			return nil, nil, errors.New("failed to convert to misconfiguration results")
 		}
-	}
-
-	state := models.TargetScanStateStateDone
-	scanResult.Status.Misconfigurations.State = &state
-	scanResult.Status.Misconfigurations.Errors = &errs
-
-	err = v.client.PatchScanResult(ctx, scanResult, v.scanResultID)
-	if err != nil {
-		return fmt.Errorf("failed to patch scan result: %w", err)
-	}
-
-	return nil
-}
-
-func (v *VMClarityPresenter) ExportRootkitResult(ctx context.Context, res families.FamilyResult) error {
-	scanResult, err := v.client.GetScanResult(ctx, v.scanResultID, models.GetScanResultsScanResultIDParams{})
-	if err != nil {
-		return fmt.Errorf("failed to get scan result: %w", err)
-	}
-
-	if scanResult.Status == nil {
-		scanResult.Status = &models.TargetScanStatus{}
-	}
-	if scanResult.Status.Rootkits == nil {
-		scanResult.Status.Rootkits = &models.TargetScanState{}
-	}
-
-	var errs []string
-
-	if res.Err != nil {
-		errs = append(errs, res.Err.Error())
-	} else {
+		result, err := cliutils.ConvertMisconfigurationResultToAPIModel(misconfigurationResults)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to convert misconfiguration results from scan to API model: %w", err)
+		}
+		summary.TotalMisconfigurations = utils.PointerTo(len(misconfigurationResults.Misconfigurations))
+		return result, summary, nil
+	case types.Rootkits:
 		rootkitsResults, ok := res.Result.(*rootkits.Results)
 		if !ok {
-			errs = append(errs, fmt.Errorf("failed to convert to rootkits results").Error())
-		} else {
-			scanResult.Rootkits = cliutils.ConvertRootkitsResultToAPIModel(rootkitsResults)
-			if scanResult.Rootkits.Rootkits != nil {
-				scanResult.Summary.TotalRootkits = utils.PointerTo[int](len(*scanResult.Rootkits.Rootkits))
-			}
+			return nil, nil, errors.New("failed to convert to rootkits results")
 		}
+		result := cliutils.ConvertRootkitsResultToAPIModel(rootkitsResults)
+		if result.Rootkits != nil {
+			summary.TotalRootkits = utils.PointerTo(len(*result.Rootkits))
+		}
+		return result, summary, nil
+	case types.Malware:
+		malwareResults, ok := res.Result.(*malware.MergedResults)
+		if !ok {
+			return nil, nil, errors.New("failed to convert to malware results")
+		}
+		result := cliutils.ConvertMalwareResultToAPIModel(malwareResults)
+		if result.Malware != nil {
+			summary.TotalMalware = utils.PointerTo(len(*result.Malware))
+		}
+		return result, summary, nil
+	case types.Certificates:
+		certificatesResults, ok := res.Result.(*certificates.Results)
+		if !ok {
+			return nil, nil, errors.New("failed to convert to certificates results")
+		}
+		result := cliutils.ConvertCertificatesResultToAPIModel(certificatesResults)
+		if result.Certificates != nil {
+			summary.TotalCertificates = utils.PointerTo(len(*result.Certificates))
+		}
+		return result, summary, nil
+	case types.Compliance:
+		complianceResults, ok := res.Result.(*compliance.Results)
+		if !ok {
+			return nil, nil, errors.New("failed to convert to compliance results")
+		}
+		result := cliutils.ConvertComplianceResultToAPIModel(complianceResults)
+		if result.ComplianceChecks != nil {
+			summary.TotalComplianceChecks = utils.PointerTo(len(*result.ComplianceChecks))
+		}
+		return result, summary, nil
+	case types.Plugins:
+		pluginsResults, ok := res.Result.(*plugins.Results)
+		if !ok {
+			return nil, nil, errors.New("failed to convert to plugins results")
+		}
+		result := cliutils.ConvertPluginsResultToAPIModel(pluginsResults)
+		if result.PluginFindings != nil {
+			summary.TotalPluginFindings = utils.PointerTo(len(*result.PluginFindings))
+		}
+		return result, summary, nil
 	}
 
-	state := models.TargetScanStateStateDone
-	scanResult.Status.Rootkits.State = &state
-	scanResult.Status.Rootkits.Errors = &errs
-
-	if err = v.client.PatchScanResult(ctx, scanResult, v.scanResultID); err != nil {
-		return fmt.Errorf("failed to patch scan result: %w", err)
-	}
-
-	return nil
+	return nil, nil, fmt.Errorf("unknown family type %s", res.FamilyType)
 }
 
 func NewVMClarityPresenter(client *backendclient.BackendClient, id ScanResultID) (*VMClarityPresenter, error) {
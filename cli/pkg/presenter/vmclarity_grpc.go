@@ -0,0 +1,79 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package presenter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	cliutils "github.com/openclarity/vmclarity/cli/pkg/utils"
+	"github.com/openclarity/vmclarity/shared/pkg/families"
+	"github.com/openclarity/vmclarity/shared/pkg/scannerclient"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// VMClarityGRPCPresenter streams the results of the families to the scanner
+// gRPC API instead of patching the whole scan result through the REST API.
+type VMClarityGRPCPresenter struct {
+	client *scannerclient.ScannerClient
+
+	scanResultID models.ScanResultID
+}
+
+func (v *VMClarityGRPCPresenter) ExportFamilyResult(ctx context.Context, res families.FamilyResult) error {
+	family, err := cliutils.ConvertFamilyTypeToScanFamily(res.FamilyType)
+	if err != nil {
+		return fmt.Errorf("failed to convert family type: %w", err)
+	}
+
+	errs := []string{}
+	var result interface{}
+	var summary *models.ScanFindingsSummary
+	if res.Err != nil {
+		errs = append(errs, res.Err.Error())
+	} else {
+		result, summary, err = convertFamilyResult(res)
+		if err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	state := models.AssetScanState{
+		State:              utils.PointerTo(models.AssetScanStateStateDone),
+		LastTransitionTime: utils.PointerTo(time.Now()),
+		Errors:             &errs,
+	}
+
+	err = v.client.UploadScanResult(ctx, v.scanResultID, family, result, state, summary)
+	if err != nil {
+		return fmt.Errorf("failed to upload scan result: %w", err)
+	}
+
+	return nil
+}
+
+func NewVMClarityGRPCPresenter(client *scannerclient.ScannerClient, id ScanResultID) (*VMClarityGRPCPresenter, error) {
+	if client == nil {
+		return nil, errors.New("scanner API client must not be nil")
+	}
+	return &VMClarityGRPCPresenter{
+		client:       client,
+		scanResultID: id,
+	}, nil
+}
@@ -0,0 +1,78 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/scanexport"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// ExportState is the state of a scan whose scanner can't reach the backend.
+// The scan is run right away, like a local one, and its results are written
+// for the orchestrator to collect once it is done.
+type ExportState struct {
+	*LocalState
+
+	writer *scanexport.Writer
+}
+
+func (e *ExportState) MarkDone(ctx context.Context, errs []error) error {
+	if err := e.LocalState.MarkDone(ctx, errs); err != nil {
+		return err
+	}
+
+	state := models.AssetScanState{
+		State:              utils.PointerTo(models.AssetScanStateStateDone),
+		LastTransitionTime: utils.PointerTo(time.Now()),
+	}
+	var errorStrs []string
+	for _, err := range errs {
+		if err != nil {
+			errorStrs = append(errorStrs, err.Error())
+		}
+	}
+	if len(errorStrs) > 0 {
+		state.Errors = &errorStrs
+	}
+
+	if err := e.writer.WriteDone(state); err != nil {
+		return fmt.Errorf("failed to write scan results: %w", err)
+	}
+
+	return nil
+}
+
+func NewExportState(writer *scanexport.Writer) (*ExportState, error) {
+	if writer == nil {
+		return nil, errors.New("export writer must not be nil")
+	}
+
+	state, err := NewLocalState()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExportState{
+		LocalState: state,
+		writer:     writer,
+	}, nil
+}
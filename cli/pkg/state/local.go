@@ -18,6 +18,7 @@ package state
 import (
 	"context"
 
+	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/shared/pkg/families/types"
 	"github.com/openclarity/vmclarity/shared/pkg/log"
 )
@@ -52,10 +53,22 @@ func (l *LocalState) MarkFamilyScanInProgress(ctx context.Context, familyType ty
 		logger.Info("Rootkit scan is in progress")
 	case types.Malware:
 		logger.Info("Malware scan is in progress")
+	case types.Certificates:
+		logger.Info("Certificates scan is in progress")
+	case types.Compliance:
+		logger.Info("Compliance scan is in progress")
+	case types.Plugins:
+		logger.Info("Plugins scan is in progress")
 	}
 	return nil
 }
 
+func (l *LocalState) MarkFamilyScanProgress(ctx context.Context, familyType types.FamilyType, percent int) error {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+	logger.Infof("%s scan is %d%% done", familyType, percent)
+	return nil
+}
+
 func (l *LocalState) MarkDone(ctx context.Context, errs []error) error {
 	logger := log.GetLoggerFromContextOrDiscard(ctx)
 
@@ -71,6 +84,14 @@ func (l *LocalState) IsAborted(context.Context) (bool, error) {
 	return false, nil
 }
 
+func (l *LocalState) GetDeltaScanInfo(context.Context) (*models.DeltaScanInfo, error) {
+	return nil, nil
+}
+
+func (l *LocalState) SetDeltaScanChangedPaths(context.Context, []string) error {
+	return nil
+}
+
 func NewLocalState() (*LocalState, error) {
 	return &LocalState{}, nil
 }
@@ -18,6 +18,7 @@ package state
 import (
 	"context"
 
+	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/shared/pkg/families/types"
 )
 
@@ -25,6 +26,9 @@ type Manager interface {
 	WaitForReadyState(context.Context) error
 	MarkInProgress(context.Context) error
 	MarkFamilyScanInProgress(context.Context, types.FamilyType) error
+	MarkFamilyScanProgress(context.Context, types.FamilyType, int) error
 	MarkDone(context.Context, []error) error
 	IsAborted(ctx context.Context) (bool, error)
+	GetDeltaScanInfo(context.Context) (*models.DeltaScanInfo, error)
+	SetDeltaScanChangedPaths(context.Context, []string) error
 }
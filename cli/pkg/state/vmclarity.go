@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/openclarity/vmclarity/api/models"
+	cliutils "github.com/openclarity/vmclarity/cli/pkg/utils"
 	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
 	"github.com/openclarity/vmclarity/shared/pkg/families/types"
 	"github.com/openclarity/vmclarity/shared/pkg/log"
@@ -61,12 +62,12 @@ func (v *VMClarityState) WaitForReadyState(ctx context.Context) error {
 			}
 
 			switch *status.General.State {
-			case models.TargetScanStateStatePending, models.TargetScanStateStateScheduled:
-			case models.TargetScanStateStateAborted:
+			case models.AssetScanStateStatePending, models.AssetScanStateStateScheduled:
+			case models.AssetScanStateStateAborted:
 				// Do nothing as WaitForAborted is responsible for handling this case
-			case models.TargetScanStateStateReadyToScan, models.TargetScanStateStateInProgress:
+			case models.AssetScanStateStateReadyToScan, models.AssetScanStateStateInProgress:
 				return nil
-			case models.TargetScanStateStateDone, models.TargetScanStateStateNotScanned:
+			case models.AssetScanStateStateDone, models.AssetScanStateStateNotScanned:
 				return fmt.Errorf("failed to wait for ScanResult become ready as it is in %s state", *status.General.State)
 			}
 		case <-ctx.Done():
@@ -82,13 +83,13 @@ func (v *VMClarityState) MarkInProgress(ctx context.Context) error {
 	}
 
 	if scanResult.Status == nil {
-		scanResult.Status = &models.TargetScanStatus{}
+		scanResult.Status = &models.AssetScanStatus{}
 	}
 	if scanResult.Status.General == nil {
-		scanResult.Status.General = &models.TargetScanState{}
+		scanResult.Status.General = &models.AssetScanState{}
 	}
 
-	state := models.TargetScanStateStateInProgress
+	state := models.AssetScanStateStateInProgress
 	scanResult.Status.General.State = &state
 	scanResult.Status.General.LastTransitionTime = utils.PointerTo(time.Now())
 
@@ -107,13 +108,13 @@ func (v *VMClarityState) MarkDone(ctx context.Context, errors []error) error {
 	}
 
 	if scanResult.Status == nil {
-		scanResult.Status = &models.TargetScanStatus{}
+		scanResult.Status = &models.AssetScanStatus{}
 	}
 	if scanResult.Status.General == nil {
-		scanResult.Status.General = &models.TargetScanState{}
+		scanResult.Status.General = &models.AssetScanState{}
 	}
 
-	state := models.TargetScanStateStateDone
+	state := models.AssetScanStateStateDone
 	scanResult.Status.General.State = &state
 	scanResult.Status.General.LastTransitionTime = utils.PointerTo(time.Now())
 
@@ -162,6 +163,12 @@ func (v *VMClarityState) MarkFamilyScanInProgress(ctx context.Context, familyTyp
 		err = v.markRootkitsScanInProgress(ctx)
 	case types.Malware:
 		err = v.markMalwareScanInProgress(ctx)
+	case types.Certificates:
+		err = v.markCertificatesScanInProgress(ctx)
+	case types.Compliance:
+		err = v.markComplianceScanInProgress(ctx)
+	case types.Plugins:
+		err = v.markPluginsScanInProgress(ctx)
 	}
 	return err
 }
@@ -173,13 +180,13 @@ func (v *VMClarityState) markExploitsScanInProgress(ctx context.Context) error {
 	}
 
 	if scanResult.Status == nil {
-		scanResult.Status = &models.TargetScanStatus{}
+		scanResult.Status = &models.AssetScanStatus{}
 	}
 	if scanResult.Status.Exploits == nil {
-		scanResult.Status.Exploits = &models.TargetScanState{}
+		scanResult.Status.Exploits = &models.AssetScanState{}
 	}
 
-	state := models.TargetScanStateStateInProgress
+	state := models.AssetScanStateStateInProgress
 	scanResult.Status.Exploits.State = &state
 	scanResult.Status.Exploits.LastTransitionTime = utils.PointerTo(time.Now())
 
@@ -198,13 +205,13 @@ func (v *VMClarityState) markSecretsScanInProgress(ctx context.Context) error {
 	}
 
 	if scanResult.Status == nil {
-		scanResult.Status = &models.TargetScanStatus{}
+		scanResult.Status = &models.AssetScanStatus{}
 	}
 	if scanResult.Status.Secrets == nil {
-		scanResult.Status.Secrets = &models.TargetScanState{}
+		scanResult.Status.Secrets = &models.AssetScanState{}
 	}
 
-	state := models.TargetScanStateStateInProgress
+	state := models.AssetScanStateStateInProgress
 	scanResult.Status.Secrets.State = &state
 	scanResult.Status.Secrets.LastTransitionTime = utils.PointerTo(time.Now())
 
@@ -223,13 +230,13 @@ func (v *VMClarityState) markSBOMScanInProgress(ctx context.Context) error {
 	}
 
 	if scanResult.Status == nil {
-		scanResult.Status = &models.TargetScanStatus{}
+		scanResult.Status = &models.AssetScanStatus{}
 	}
 	if scanResult.Status.Sbom == nil {
-		scanResult.Status.Sbom = &models.TargetScanState{}
+		scanResult.Status.Sbom = &models.AssetScanState{}
 	}
 
-	state := models.TargetScanStateStateInProgress
+	state := models.AssetScanStateStateInProgress
 	scanResult.Status.Sbom.State = &state
 	scanResult.Status.Sbom.LastTransitionTime = utils.PointerTo(time.Now())
 
@@ -248,13 +255,13 @@ func (v *VMClarityState) markVulnerabilitiesScanInProgress(ctx context.Context)
 	}
 
 	if scanResult.Status == nil {
-		scanResult.Status = &models.TargetScanStatus{}
+		scanResult.Status = &models.AssetScanStatus{}
 	}
 	if scanResult.Status.Vulnerabilities == nil {
-		scanResult.Status.Vulnerabilities = &models.TargetScanState{}
+		scanResult.Status.Vulnerabilities = &models.AssetScanState{}
 	}
 
-	state := models.TargetScanStateStateInProgress
+	state := models.AssetScanStateStateInProgress
 	scanResult.Status.Vulnerabilities.State = &state
 	scanResult.Status.Vulnerabilities.LastTransitionTime = utils.PointerTo(time.Now())
 
@@ -273,13 +280,13 @@ func (v *VMClarityState) markMalwareScanInProgress(ctx context.Context) error {
 	}
 
 	if scanResult.Status == nil {
-		scanResult.Status = &models.TargetScanStatus{}
+		scanResult.Status = &models.AssetScanStatus{}
 	}
 	if scanResult.Status.Malware == nil {
-		scanResult.Status.Malware = &models.TargetScanState{}
+		scanResult.Status.Malware = &models.AssetScanState{}
 	}
 
-	state := models.TargetScanStateStateInProgress
+	state := models.AssetScanStateStateInProgress
 	scanResult.Status.Malware.State = &state
 	scanResult.Status.Malware.LastTransitionTime = utils.PointerTo(time.Now())
 
@@ -298,13 +305,13 @@ func (v *VMClarityState) markMisconfigurationsScanInProgress(ctx context.Context
 	}
 
 	if scanResult.Status == nil {
-		scanResult.Status = &models.TargetScanStatus{}
+		scanResult.Status = &models.AssetScanStatus{}
 	}
 	if scanResult.Status.Misconfigurations == nil {
-		scanResult.Status.Misconfigurations = &models.TargetScanState{}
+		scanResult.Status.Misconfigurations = &models.AssetScanState{}
 	}
 
-	state := models.TargetScanStateStateInProgress
+	state := models.AssetScanStateStateInProgress
 	scanResult.Status.Misconfigurations.State = &state
 	scanResult.Status.Misconfigurations.LastTransitionTime = utils.PointerTo(time.Now())
 
@@ -323,13 +330,13 @@ func (v *VMClarityState) markRootkitsScanInProgress(ctx context.Context) error {
 	}
 
 	if scanResult.Status == nil {
-		scanResult.Status = &models.TargetScanStatus{}
+		scanResult.Status = &models.AssetScanStatus{}
 	}
 	if scanResult.Status.Rootkits == nil {
-		scanResult.Status.Rootkits = &models.TargetScanState{}
+		scanResult.Status.Rootkits = &models.AssetScanState{}
 	}
 
-	state := models.TargetScanStateStateInProgress
+	state := models.AssetScanStateStateInProgress
 	scanResult.Status.Rootkits.State = &state
 	scanResult.Status.Rootkits.LastTransitionTime = utils.PointerTo(time.Now())
 
@@ -341,6 +348,112 @@ func (v *VMClarityState) markRootkitsScanInProgress(ctx context.Context) error {
 	return nil
 }
 
+func (v *VMClarityState) markCertificatesScanInProgress(ctx context.Context) error {
+	scanResult, err := v.client.GetScanResult(ctx, v.scanResultID, models.GetScanResultsScanResultIDParams{})
+	if err != nil {
+		return fmt.Errorf("failed to get scan result: %w", err)
+	}
+
+	if scanResult.Status == nil {
+		scanResult.Status = &models.AssetScanStatus{}
+	}
+	if scanResult.Status.Certificates == nil {
+		scanResult.Status.Certificates = &models.AssetScanState{}
+	}
+
+	state := models.AssetScanStateStateInProgress
+	scanResult.Status.Certificates.State = &state
+	scanResult.Status.Certificates.LastTransitionTime = utils.PointerTo(time.Now())
+
+	err = v.client.PatchScanResult(ctx, scanResult, v.scanResultID)
+	if err != nil {
+		return fmt.Errorf("failed to patch scan result: %w", err)
+	}
+
+	return nil
+}
+
+func (v *VMClarityState) markComplianceScanInProgress(ctx context.Context) error {
+	scanResult, err := v.client.GetScanResult(ctx, v.scanResultID, models.GetScanResultsScanResultIDParams{})
+	if err != nil {
+		return fmt.Errorf("failed to get scan result: %w", err)
+	}
+
+	if scanResult.Status == nil {
+		scanResult.Status = &models.AssetScanStatus{}
+	}
+	if scanResult.Status.Compliance == nil {
+		scanResult.Status.Compliance = &models.AssetScanState{}
+	}
+
+	state := models.AssetScanStateStateInProgress
+	scanResult.Status.Compliance.State = &state
+	scanResult.Status.Compliance.LastTransitionTime = utils.PointerTo(time.Now())
+
+	err = v.client.PatchScanResult(ctx, scanResult, v.scanResultID)
+	if err != nil {
+		return fmt.Errorf("failed to patch scan result: %w", err)
+	}
+
+	return nil
+}
+
+func (v *VMClarityState) markPluginsScanInProgress(ctx context.Context) error {
+	scanResult, err := v.client.GetScanResult(ctx, v.scanResultID, models.GetScanResultsScanResultIDParams{})
+	if err != nil {
+		return fmt.Errorf("failed to get scan result: %w", err)
+	}
+
+	if scanResult.Status == nil {
+		scanResult.Status = &models.AssetScanStatus{}
+	}
+	if scanResult.Status.Plugins == nil {
+		scanResult.Status.Plugins = &models.AssetScanState{}
+	}
+
+	state := models.AssetScanStateStateInProgress
+	scanResult.Status.Plugins.State = &state
+	scanResult.Status.Plugins.LastTransitionTime = utils.PointerTo(time.Now())
+
+	err = v.client.PatchScanResult(ctx, scanResult, v.scanResultID)
+	if err != nil {
+		return fmt.Errorf("failed to patch scan result: %w", err)
+	}
+
+	return nil
+}
+
+// MarkFamilyScanProgress publishes the percentage of the scan of the family
+// which is done. The progress is only recorded while the family is in
+// progress, so a late notification can't modify a finished family.
+func (v *VMClarityState) MarkFamilyScanProgress(ctx context.Context, familyType types.FamilyType, percent int) error {
+	family, err := cliutils.ConvertFamilyTypeToAPIModel(familyType)
+	if err != nil {
+		return fmt.Errorf("failed to convert family type: %w", err)
+	}
+
+	scanResult, err := v.client.GetScanResult(ctx, v.scanResultID, models.GetScanResultsScanResultIDParams{})
+	if err != nil {
+		return fmt.Errorf("failed to get scan result: %w", err)
+	}
+
+	if scanResult.Status == nil {
+		return nil
+	}
+	familyState := scanResult.Status.GetFamilyState(family)
+	if familyState == nil || familyState.State == nil || *familyState.State != models.AssetScanStateStateInProgress {
+		return nil
+	}
+	familyState.Progress = &percent
+
+	err = v.client.PatchScanResult(ctx, scanResult, v.scanResultID)
+	if err != nil {
+		return fmt.Errorf("failed to patch scan result: %w", err)
+	}
+
+	return nil
+}
+
 func (v *VMClarityState) IsAborted(ctx context.Context) (bool, error) {
 	scanResult, err := v.client.GetScanResult(ctx, v.scanResultID, models.GetScanResultsScanResultIDParams{
 		Select: utils.PointerTo("id,status"),
@@ -354,13 +467,42 @@ func (v *VMClarityState) IsAborted(ctx context.Context) (bool, error) {
 		return false, errors.New("failed to get general state of scan result")
 	}
 
-	if state == models.TargetScanStateStateAborted {
+	if state == models.AssetScanStateStateAborted {
 		return true, nil
 	}
 
 	return false, nil
 }
 
+func (v *VMClarityState) GetDeltaScanInfo(ctx context.Context) (*models.DeltaScanInfo, error) {
+	scanResult, err := v.client.GetScanResult(ctx, v.scanResultID, models.GetScanResultsScanResultIDParams{
+		Select: utils.PointerTo("id,deltaScan"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scan result: %w", err)
+	}
+
+	return scanResult.DeltaScan, nil
+}
+
+func (v *VMClarityState) SetDeltaScanChangedPaths(ctx context.Context, paths []string) error {
+	deltaScan, err := v.GetDeltaScanInfo(ctx)
+	if err != nil {
+		return err
+	}
+	if deltaScan == nil {
+		return errors.New("scan result is not a delta scan")
+	}
+	deltaScan.ChangedPaths = &paths
+
+	err = v.client.PatchScanResult(ctx, models.AssetScanResult{DeltaScan: deltaScan}, v.scanResultID)
+	if err != nil {
+		return fmt.Errorf("failed to patch scan result: %w", err)
+	}
+
+	return nil
+}
+
 func NewVMClarityState(client *backendclient.BackendClient, id ScanResultID) (*VMClarityState, error) {
 	if client == nil {
 		return nil, errors.New("backend client must not be nil")
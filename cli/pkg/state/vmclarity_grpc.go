@@ -0,0 +1,113 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	cliutils "github.com/openclarity/vmclarity/cli/pkg/utils"
+	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
+	"github.com/openclarity/vmclarity/shared/pkg/families/types"
+	"github.com/openclarity/vmclarity/shared/pkg/scannerclient"
+	"github.com/openclarity/vmclarity/shared/pkg/scannerpb"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// VMClarityGRPCState reports the state of the scan over the scanner gRPC
+// API, waiting for the scan result to be ready and the other operations are
+// done with the REST API.
+type VMClarityGRPCState struct {
+	*VMClarityState
+
+	scannerClient *scannerclient.ScannerClient
+}
+
+func (v *VMClarityGRPCState) MarkInProgress(ctx context.Context) error {
+	state := models.AssetScanState{
+		State:              utils.PointerTo(models.AssetScanStateStateInProgress),
+		LastTransitionTime: utils.PointerTo(time.Now()),
+	}
+	err := v.scannerClient.UpdateScanStatus(ctx, v.scanResultID, scannerpb.ScanFamily_SCAN_FAMILY_GENERAL, state, false)
+	if err != nil {
+		return fmt.Errorf("failed to update scan result status: %w", err)
+	}
+
+	return nil
+}
+
+func (v *VMClarityGRPCState) MarkDone(ctx context.Context, errs []error) error {
+	state := models.AssetScanState{
+		State:              utils.PointerTo(models.AssetScanStateStateDone),
+		LastTransitionTime: utils.PointerTo(time.Now()),
+	}
+
+	// If we had any errors running the family or exporting results add them
+	// to the general errors.
+	var errorStrs []string
+	for _, err := range errs {
+		if err != nil {
+			errorStrs = append(errorStrs, err.Error())
+		}
+	}
+	if len(errorStrs) > 0 {
+		state.Errors = &errorStrs
+	}
+
+	err := v.scannerClient.UpdateScanStatus(ctx, v.scanResultID, scannerpb.ScanFamily_SCAN_FAMILY_GENERAL, state, true)
+	if err != nil {
+		return fmt.Errorf("failed to update scan result status: %w", err)
+	}
+
+	return nil
+}
+
+func (v *VMClarityGRPCState) MarkFamilyScanInProgress(ctx context.Context, familyType types.FamilyType) error {
+	family, err := cliutils.ConvertFamilyTypeToScanFamily(familyType)
+	if err != nil {
+		return fmt.Errorf("failed to convert family type: %w", err)
+	}
+
+	state := models.AssetScanState{
+		State:              utils.PointerTo(models.AssetScanStateStateInProgress),
+		LastTransitionTime: utils.PointerTo(time.Now()),
+	}
+	err = v.scannerClient.UpdateScanStatus(ctx, v.scanResultID, family, state, false)
+	if err != nil {
+		return fmt.Errorf("failed to update scan result status: %w", err)
+	}
+
+	return nil
+}
+
+func NewVMClarityGRPCState(client *backendclient.BackendClient, scannerClient *scannerclient.ScannerClient, id ScanResultID) (*VMClarityGRPCState, error) {
+	if scannerClient == nil {
+		return nil, errors.New("scanner API client must not be nil")
+	}
+
+	state, err := NewVMClarityState(client, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VMClarityGRPCState{
+		VMClarityState: state,
+		scannerClient:  scannerClient,
+	}, nil
+}
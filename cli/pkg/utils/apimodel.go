@@ -26,15 +26,22 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/families/certificates"
+	"github.com/openclarity/vmclarity/shared/pkg/families/compliance"
 	"github.com/openclarity/vmclarity/shared/pkg/families/exploits"
 	"github.com/openclarity/vmclarity/shared/pkg/families/malware"
+	"github.com/openclarity/vmclarity/shared/pkg/families/malware/common"
 	"github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration"
 	misconfigurationTypes "github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration/types"
+	"github.com/openclarity/vmclarity/shared/pkg/families/plugins"
 	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits"
 	rootkitsTypes "github.com/openclarity/vmclarity/shared/pkg/families/rootkits/types"
 	"github.com/openclarity/vmclarity/shared/pkg/families/sbom"
 	"github.com/openclarity/vmclarity/shared/pkg/families/secrets"
+	secretsCommon "github.com/openclarity/vmclarity/shared/pkg/families/secrets/common"
+	familiestypes "github.com/openclarity/vmclarity/shared/pkg/families/types"
 	"github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities"
+	"github.com/openclarity/vmclarity/shared/pkg/scannerpb"
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
@@ -74,6 +81,19 @@ func ConvertVulnResultToAPIModel(vulnerabilitiesResults *vulnerabilities.Results
 
 		vulCandidate := vulCandidates[0]
 
+		// Each candidate is a different version of the same
+		// vulnerability reported by one or more scanners, so attribute
+		// it to all of them together with the severity they reported.
+		var scanners []models.ScannerAttribution
+		for _, candidate := range vulCandidates {
+			for _, info := range candidate.ScannersInfo {
+				scanners = append(scanners, models.ScannerAttribution{
+					ScannerName: utils.PointerTo(info.Name),
+					Severity:    utils.PointerTo(candidate.Vulnerability.Severity),
+				})
+			}
+		}
+
 		vul := models.Vulnerability{
 			Cvss:              ConvertVulnCvssToAPIModel(vulCandidate.Vulnerability.CVSS),
 			Description:       utils.PointerTo(vulCandidate.Vulnerability.Description),
@@ -86,6 +106,9 @@ func ConvertVulnResultToAPIModel(vulnerabilitiesResults *vulnerabilities.Results
 			Severity:          ConvertVulnSeverityToAPIModel(vulCandidate.Vulnerability.Severity),
 			VulnerabilityName: utils.PointerTo(vulCandidate.Vulnerability.ID),
 		}
+		if len(scanners) > 0 {
+			vul.Scanners = &scanners
+		}
 		vuls = append(vuls, vul)
 	}
 
@@ -173,14 +196,49 @@ func ConvertMalwareResultToAPIModel(malwareResults *malware.MergedResults) *mode
 		return &models.MalwareScan{}
 	}
 
+	// The same malware may be detected by multiple scanners, so group the
+	// detections by malware and path and attribute each of them to all the
+	// scanners which detected it.
 	malwareList := []models.Malware{}
+	type malwareKey struct {
+		name, malwareType, path string
+	}
+	malwareIndex := map[malwareKey]int{}
 	for _, m := range malwareResults.DetectedMalware {
 		mal := m // Prevent loop variable pointer export
-		malwareList = append(malwareList, models.Malware{
-			MalwareName: &mal.MalwareName,
-			MalwareType: &mal.MalwareType,
-			Path:        &mal.Path,
-		})
+		key := malwareKey{
+			name:        mal.MalwareName,
+			malwareType: mal.MalwareType,
+			path:        mal.Path,
+		}
+		i, ok := malwareIndex[key]
+		if !ok {
+			i = len(malwareList)
+			malwareIndex[key] = i
+			malwareList = append(malwareList, models.Malware{
+				MalwareName: &mal.MalwareName,
+				MalwareType: &mal.MalwareType,
+				Path:        &mal.Path,
+			})
+		}
+		if mal.RuleName != "" && malwareList[i].RuleName == nil {
+			malwareList[i].RuleName = &mal.RuleName
+			malwareList[i].MatchedStrings = convertMatchedStringsToAPIModel(mal.MatchedStrings)
+		}
+		// Keep the highest confidence of the scanners which detected it.
+		confidence := ConvertConfidenceToAPIModel(mal.Confidence)
+		if confidence != nil && confidence.Rank() > utils.ValueOrZero(malwareList[i].Confidence).Rank() {
+			malwareList[i].Confidence = confidence
+		}
+		if mal.ScannerName != "" {
+			if malwareList[i].Scanners == nil {
+				malwareList[i].Scanners = &[]models.ScannerAttribution{}
+			}
+			*malwareList[i].Scanners = append(*malwareList[i].Scanners, models.ScannerAttribution{
+				ScannerName: &mal.ScannerName,
+				Confidence:  confidence,
+			})
+		}
 	}
 
 	metadata := []models.ScannerMetadata{}
@@ -208,6 +266,23 @@ func ConvertMalwareResultToAPIModel(malwareResults *malware.MergedResults) *mode
 	}
 }
 
+func convertMatchedStringsToAPIModel(matchedStrings []common.MatchedString) *[]models.MalwareMatchedString {
+	if len(matchedStrings) == 0 {
+		return nil
+	}
+
+	ret := make([]models.MalwareMatchedString, 0, len(matchedStrings))
+	for _, m := range matchedStrings {
+		ret = append(ret, models.MalwareMatchedString{
+			Identifier: utils.PointerTo(m.Identifier),
+			Offset:     utils.PointerTo(m.Offset),
+			Data:       utils.PointerTo(m.Data),
+		})
+	}
+
+	return &ret
+}
+
 func ConvertSecretsResultToAPIModel(secretsResults *secrets.Results) *models.SecretScan {
 	if secretsResults == nil || secretsResults.MergedResults == nil {
 		return &models.SecretScan{}
@@ -225,6 +300,9 @@ func ConvertSecretsResultToAPIModel(secretsResults *secrets.Results) *models.Sec
 				StartLine:   &finding.StartLine,
 				StartColumn: &finding.StartColumn,
 				EndColumn:   &finding.EndColumn,
+
+				CredentialFingerprint: getCredentialFingerprint(finding),
+				Confidence:            ConvertConfidenceToAPIModel(finding.Confidence()),
 			})
 		}
 	}
@@ -238,6 +316,30 @@ func ConvertSecretsResultToAPIModel(secretsResults *secrets.Results) *models.Sec
 	}
 }
 
+// getCredentialFingerprint returns the credential fingerprint of the finding,
+// or nil if the secret is not known.
+func getCredentialFingerprint(finding secretsCommon.Findings) *string {
+	fingerprint := finding.CredentialFingerprint()
+	if fingerprint == "" {
+		return nil
+	}
+	return &fingerprint
+}
+
+// ConvertConfidenceToAPIModel returns nil if the confidence is not known.
+func ConvertConfidenceToAPIModel(confidence familiestypes.Confidence) *models.FindingConfidence {
+	switch confidence {
+	case familiestypes.ConfidenceHigh:
+		return utils.PointerTo(models.High)
+	case familiestypes.ConfidenceMedium:
+		return utils.PointerTo(models.Medium)
+	case familiestypes.ConfidenceLow:
+		return utils.PointerTo(models.Low)
+	default:
+		return nil
+	}
+}
+
 func ConvertExploitsResultToAPIModel(exploitsResults *exploits.Results) *models.ExploitScan {
 	if exploitsResults == nil || exploitsResults.Exploits == nil {
 		return &models.ExploitScan{}
@@ -340,6 +442,8 @@ func ConvertRootkitTypeToAPIModel(rootkitType rootkitsTypes.RootkitType) *models
 	switch rootkitType {
 	case rootkitsTypes.APPLICATION:
 		return utils.PointerTo(models.APPLICATION)
+	case rootkitsTypes.BOOT:
+		return utils.PointerTo(models.BOOT)
 	case rootkitsTypes.FIRMWARE:
 		return utils.PointerTo(models.FIRMWARE)
 	case rootkitsTypes.KERNEL:
@@ -353,3 +457,136 @@ func ConvertRootkitTypeToAPIModel(rootkitType rootkitsTypes.RootkitType) *models
 		return utils.PointerTo(models.UNKNOWN)
 	}
 }
+
+func ConvertCertificatesResultToAPIModel(certificatesResults *certificates.Results) *models.CertificateScan {
+	if certificatesResults == nil || certificatesResults.MergedResults == nil {
+		return &models.CertificateScan{}
+	}
+
+	certificatesList := []models.Certificate{}
+	for _, c := range certificatesResults.MergedResults.Certificates {
+		cert := c // Prevent loop variable pointer export
+		certificatesList = append(certificatesList, models.Certificate{
+			FilePath:           &cert.FilePath,
+			FindingType:        utils.PointerTo(models.CertificateFindingType(cert.FindingType)),
+			Fingerprint:        &cert.Fingerprint,
+			Issuer:             &cert.Issuer,
+			KeyAlgorithm:       &cert.KeyAlgorithm,
+			KeySize:            &cert.KeySize,
+			Message:            &cert.Message,
+			NotAfter:           cert.NotAfter,
+			NotBefore:          cert.NotBefore,
+			SerialNumber:       &cert.SerialNumber,
+			SignatureAlgorithm: &cert.SignatureAlgorithm,
+			Subject:            &cert.Subject,
+		})
+	}
+
+	return &models.CertificateScan{
+		Certificates: &certificatesList,
+	}
+}
+
+func ConvertComplianceResultToAPIModel(complianceResults *compliance.Results) *models.ComplianceScan {
+	if complianceResults == nil || complianceResults.MergedResults == nil {
+		return &models.ComplianceScan{}
+	}
+
+	checks := []models.ComplianceCheck{}
+	for _, c := range complianceResults.MergedResults.Checks {
+		check := c // Prevent loop variable pointer export
+		checks = append(checks, models.ComplianceCheck{
+			Benchmark:   utils.PointerTo(models.ComplianceBenchmark(check.Benchmark)),
+			CheckID:     &check.CheckID,
+			FilePath:    &check.FilePath,
+			Message:     &check.Message,
+			Remediation: &check.Remediation,
+			Title:       &check.Title,
+		})
+	}
+
+	return &models.ComplianceScan{
+		ComplianceChecks: &checks,
+	}
+}
+
+func ConvertPluginsResultToAPIModel(pluginsResults *plugins.Results) *models.PluginScan {
+	if pluginsResults == nil || pluginsResults.MergedResults == nil {
+		return &models.PluginScan{}
+	}
+
+	findings := []models.PluginFinding{}
+	for _, f := range pluginsResults.MergedResults.Findings {
+		finding := f // Prevent loop variable pointer export
+		var properties *map[string]string
+		if len(finding.Properties) > 0 {
+			properties = &finding.Properties
+		}
+		findings = append(findings, models.PluginFinding{
+			Description: &finding.Description,
+			FindingID:   &finding.ID,
+			Path:        &finding.Path,
+			PluginName:  &finding.PluginName,
+			Properties:  properties,
+			Remediation: &finding.Remediation,
+			Severity:    &finding.Severity,
+			Title:       &finding.Title,
+		})
+	}
+
+	return &models.PluginScan{
+		PluginFindings: &findings,
+	}
+}
+
+func ConvertFamilyTypeToAPIModel(familyType familiestypes.FamilyType) (models.ScanFamily, error) {
+	switch familyType {
+	case familiestypes.SBOM:
+		return models.ScanFamilySbom, nil
+	case familiestypes.Vulnerabilities:
+		return models.ScanFamilyVulnerabilities, nil
+	case familiestypes.Secrets:
+		return models.ScanFamilySecrets, nil
+	case familiestypes.Exploits:
+		return models.ScanFamilyExploits, nil
+	case familiestypes.Misconfiguration:
+		return models.ScanFamilyMisconfigurations, nil
+	case familiestypes.Rootkits:
+		return models.ScanFamilyRootkits, nil
+	case familiestypes.Malware:
+		return models.ScanFamilyMalware, nil
+	case familiestypes.Certificates:
+		return models.ScanFamilyCertificates, nil
+	case familiestypes.Compliance:
+		return models.ScanFamilyCompliance, nil
+	case familiestypes.Plugins:
+		return models.ScanFamilyPlugins, nil
+	}
+	return "", fmt.Errorf("unknown family type %s", familyType)
+}
+
+func ConvertFamilyTypeToScanFamily(familyType familiestypes.FamilyType) (scannerpb.ScanFamily, error) {
+	switch familyType {
+	case familiestypes.SBOM:
+		return scannerpb.ScanFamily_SCAN_FAMILY_SBOM, nil
+	case familiestypes.Vulnerabilities:
+		return scannerpb.ScanFamily_SCAN_FAMILY_VULNERABILITIES, nil
+	case familiestypes.Secrets:
+		return scannerpb.ScanFamily_SCAN_FAMILY_SECRETS, nil
+	case familiestypes.Exploits:
+		return scannerpb.ScanFamily_SCAN_FAMILY_EXPLOITS, nil
+	case familiestypes.Misconfiguration:
+		return scannerpb.ScanFamily_SCAN_FAMILY_MISCONFIGURATIONS, nil
+	case familiestypes.Rootkits:
+		return scannerpb.ScanFamily_SCAN_FAMILY_ROOTKITS, nil
+	case familiestypes.Malware:
+		return scannerpb.ScanFamily_SCAN_FAMILY_MALWARE, nil
+	case familiestypes.Certificates:
+		return scannerpb.ScanFamily_SCAN_FAMILY_CERTIFICATES, nil
+	case familiestypes.Compliance:
+		return scannerpb.ScanFamily_SCAN_FAMILY_COMPLIANCE, nil
+	case familiestypes.Plugins:
+		return scannerpb.ScanFamily_SCAN_FAMILY_PLUGINS, nil
+	}
+	return scannerpb.ScanFamily_SCAN_FAMILY_UNSPECIFIED, fmt.Errorf("unknown family type %s", familyType)
+}
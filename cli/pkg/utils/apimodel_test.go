@@ -27,15 +27,24 @@ import (
 	"github.com/openclarity/kubeclarity/shared/pkg/utils/vulnerability"
 
 	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/families/certificates"
+	certificatesCommon "github.com/openclarity/vmclarity/shared/pkg/families/certificates/common"
+	"github.com/openclarity/vmclarity/shared/pkg/families/compliance"
+	complianceCommon "github.com/openclarity/vmclarity/shared/pkg/families/compliance/common"
+	complianceTypes "github.com/openclarity/vmclarity/shared/pkg/families/compliance/types"
 	"github.com/openclarity/vmclarity/shared/pkg/families/exploits"
 	common2 "github.com/openclarity/vmclarity/shared/pkg/families/exploits/common"
 	"github.com/openclarity/vmclarity/shared/pkg/families/malware"
 	malwarecommon "github.com/openclarity/vmclarity/shared/pkg/families/malware/common"
 	"github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration"
 	misconfigurationTypes "github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration/types"
+	"github.com/openclarity/vmclarity/shared/pkg/families/plugins"
+	pluginsCommon "github.com/openclarity/vmclarity/shared/pkg/families/plugins/common"
+	pluginsTypes "github.com/openclarity/vmclarity/shared/pkg/families/plugins/types"
 	"github.com/openclarity/vmclarity/shared/pkg/families/sbom"
 	"github.com/openclarity/vmclarity/shared/pkg/families/secrets"
 	"github.com/openclarity/vmclarity/shared/pkg/families/secrets/common"
+	familiestypes "github.com/openclarity/vmclarity/shared/pkg/families/types"
 	"github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities"
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
@@ -221,6 +230,10 @@ func Test_ConvertVulnResultToAPIModel(t *testing.T) {
 										LayerID: "lid1",
 										Path:    "path1",
 									},
+									ScannersInfo: []scanner.Info{
+										{Name: "grype"},
+										{Name: "trivy"},
+									},
 								},
 							},
 							"vulkey2": {
@@ -324,7 +337,17 @@ func Test_ConvertVulnResultToAPIModel(t *testing.T) {
 								Type:     utils.PointerTo("pt1"),
 								Version:  utils.PointerTo("pv1"),
 							},
-							Path:              utils.PointerTo("path1"),
+							Path: utils.PointerTo("path1"),
+							Scanners: &[]models.ScannerAttribution{
+								{
+									ScannerName: utils.PointerTo("grype"),
+									Severity:    utils.PointerTo(string(models.CRITICAL)),
+								},
+								{
+									ScannerName: utils.PointerTo("trivy"),
+									Severity:    utils.PointerTo(string(models.CRITICAL)),
+								},
+							},
 							Severity:          utils.PointerTo[models.VulnerabilitySeverity](models.CRITICAL),
 							VulnerabilityName: utils.PointerTo("CVE-test-test-foo"),
 						},
@@ -417,6 +440,9 @@ func Test_ConvertSecretsResultToAPIModel(t *testing.T) {
 		EndColumn:   133,
 		File:        "File3",
 		Fingerprint: "Fingerprint3",
+		RuleID:      "generic-api-key",
+		Secret:      "secret",
+		Entropy:     4.2,
 	}
 	type args struct {
 		secretsResults *secrets.Results
@@ -505,6 +531,10 @@ func Test_ConvertSecretsResultToAPIModel(t *testing.T) {
 						StartLine:   &finding3.StartLine,
 						StartColumn: &finding3.StartColumn,
 						EndColumn:   &finding3.EndColumn,
+
+						// sha256 of "generic-api-key:secret"
+						CredentialFingerprint: utils.PointerTo("484cf0fe1a588ad46c6337b655cd262acd76b3b318b3dad990af1bb49fdad4f0"),
+						Confidence:            utils.PointerTo(models.High),
 					},
 				},
 			},
@@ -567,6 +597,15 @@ func Test_ConvertMalwareResultToAPIModel(t *testing.T) {
 							MalwareName: "Ransom!",
 							MalwareType: "RANSOMWARE",
 							Path:        "/somepath/givememoney.exe",
+							ScannerName: "clam",
+							Confidence:  familiestypes.ConfidenceLow,
+						},
+						{
+							MalwareName: "Ransom!",
+							MalwareType: "RANSOMWARE",
+							Path:        "/somepath/givememoney.exe",
+							ScannerName: "yara",
+							Confidence:  familiestypes.ConfidenceHigh,
 						},
 					},
 					Metadata: map[string]*malwarecommon.ScanSummary{
@@ -590,6 +629,11 @@ func Test_ConvertMalwareResultToAPIModel(t *testing.T) {
 						MalwareName: utils.PointerTo("Ransom!"),
 						MalwareType: utils.PointerTo[models.MalwareType]("RANSOMWARE"),
 						Path:        utils.PointerTo("/somepath/givememoney.exe"),
+						Scanners: &[]models.ScannerAttribution{
+							{ScannerName: utils.PointerTo("clam"), Confidence: utils.PointerTo(models.Low)},
+							{ScannerName: utils.PointerTo("yara"), Confidence: utils.PointerTo(models.High)},
+						},
+						Confidence: utils.PointerTo(models.High),
 					},
 					{
 						MalwareName: utils.PointerTo("Trojan:)"),
@@ -1025,3 +1069,210 @@ func Test_ConvertVulnSeverityToAPIModel(t *testing.T) {
 		})
 	}
 }
+
+func Test_ConvertCertificatesResultToAPIModel(t *testing.T) {
+	notAfter := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	type args struct {
+		certificatesResults *certificates.Results
+	}
+	tests := []struct {
+		name string
+		args args
+		want *models.CertificateScan
+	}{
+		{
+			name: "nil certificatesResults",
+			args: args{
+				certificatesResults: nil,
+			},
+			want: &models.CertificateScan{},
+		},
+		{
+			name: "sanity",
+			args: args{
+				certificatesResults: &certificates.Results{
+					MergedResults: &certificates.MergedResults{
+						Certificates: []certificatesCommon.Certificate{
+							{
+								FindingType:  "EXPIRED_CERTIFICATE",
+								FilePath:     "/etc/nginx/tls.crt",
+								Subject:      "CN=example.com",
+								Fingerprint:  "fp1",
+								NotAfter:     &notAfter,
+								KeyAlgorithm: "RSA",
+								KeySize:      2048,
+							},
+						},
+					},
+				},
+			},
+			want: &models.CertificateScan{
+				Certificates: &[]models.Certificate{
+					{
+						FilePath:           utils.PointerTo("/etc/nginx/tls.crt"),
+						FindingType:        utils.PointerTo(models.EXPIREDCERTIFICATE),
+						Fingerprint:        utils.PointerTo("fp1"),
+						Issuer:             utils.PointerTo(""),
+						KeyAlgorithm:       utils.PointerTo("RSA"),
+						KeySize:            utils.PointerTo(2048),
+						Message:            utils.PointerTo(""),
+						NotAfter:           &notAfter,
+						SerialNumber:       utils.PointerTo(""),
+						SignatureAlgorithm: utils.PointerTo(""),
+						Subject:            utils.PointerTo("CN=example.com"),
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ConvertCertificatesResultToAPIModel(tt.args.certificatesResults)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ConvertCertificatesResultToAPIModel() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_ConvertComplianceResultToAPIModel(t *testing.T) {
+	type args struct {
+		complianceResults *compliance.Results
+	}
+	tests := []struct {
+		name string
+		args args
+		want *models.ComplianceScan
+	}{
+		{
+			name: "nil complianceResults",
+			args: args{
+				complianceResults: nil,
+			},
+			want: &models.ComplianceScan{},
+		},
+		{
+			name: "sanity",
+			args: args{
+				complianceResults: &compliance.Results{
+					MergedResults: &compliance.MergedResults{
+						Checks: []complianceCommon.Check{
+							{
+								Benchmark:   complianceTypes.CISLinux,
+								CheckID:     "5.2.8",
+								Title:       "Ensure SSH root login is disabled",
+								FilePath:    "/etc/ssh/sshd_config",
+								Message:     "PermitRootLogin is set to yes",
+								Remediation: "Set PermitRootLogin to no",
+							},
+						},
+					},
+				},
+			},
+			want: &models.ComplianceScan{
+				ComplianceChecks: &[]models.ComplianceCheck{
+					{
+						Benchmark:   utils.PointerTo(models.CISLINUX),
+						CheckID:     utils.PointerTo("5.2.8"),
+						FilePath:    utils.PointerTo("/etc/ssh/sshd_config"),
+						Message:     utils.PointerTo("PermitRootLogin is set to yes"),
+						Remediation: utils.PointerTo("Set PermitRootLogin to no"),
+						Title:       utils.PointerTo("Ensure SSH root login is disabled"),
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ConvertComplianceResultToAPIModel(tt.args.complianceResults)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ConvertComplianceResultToAPIModel() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_ConvertPluginsResultToAPIModel(t *testing.T) {
+	type args struct {
+		pluginsResults *plugins.Results
+	}
+	tests := []struct {
+		name string
+		args args
+		want *models.PluginScan
+	}{
+		{
+			name: "nil pluginsResults",
+			args: args{
+				pluginsResults: nil,
+			},
+			want: &models.PluginScan{},
+		},
+		{
+			name: "sanity",
+			args: args{
+				pluginsResults: &plugins.Results{
+					MergedResults: &plugins.MergedResults{
+						Findings: []pluginsCommon.Finding{
+							{
+								PluginName: "license-checker",
+								Finding: pluginsTypes.Finding{
+									ID:          "GPL-3.0",
+									Title:       "GPL licensed file",
+									Description: "File is distributed under GPL-3.0",
+									Severity:    "LOW",
+									Path:        "/usr/share/app/LICENSE",
+									Remediation: "Review license obligations",
+									Properties: map[string]string{
+										"spdx": "GPL-3.0-only",
+									},
+								},
+							},
+							{
+								PluginName: "license-checker",
+								Finding: pluginsTypes.Finding{
+									ID:    "NO-LICENSE",
+									Title: "Missing license",
+								},
+							},
+						},
+					},
+				},
+			},
+			want: &models.PluginScan{
+				PluginFindings: &[]models.PluginFinding{
+					{
+						Description: utils.PointerTo("File is distributed under GPL-3.0"),
+						FindingID:   utils.PointerTo("GPL-3.0"),
+						Path:        utils.PointerTo("/usr/share/app/LICENSE"),
+						PluginName:  utils.PointerTo("license-checker"),
+						Properties: &map[string]string{
+							"spdx": "GPL-3.0-only",
+						},
+						Remediation: utils.PointerTo("Review license obligations"),
+						Severity:    utils.PointerTo("LOW"),
+						Title:       utils.PointerTo("GPL licensed file"),
+					},
+					{
+						Description: utils.PointerTo(""),
+						FindingID:   utils.PointerTo("NO-LICENSE"),
+						Path:        utils.PointerTo(""),
+						PluginName:  utils.PointerTo("license-checker"),
+						Remediation: utils.PointerTo(""),
+						Severity:    utils.PointerTo(""),
+						Title:       utils.PointerTo("Missing license"),
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ConvertPluginsResultToAPIModel(tt.args.pluginsResults)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ConvertPluginsResultToAPIModel() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"time"
+)
+
+// Clock is the source of time of the controllers, which allows running them
+// on a virtual clock in simulations.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// WithTimeout returns a copy of ctx which is canceled once d has
+	// elapsed on the clock.
+	WithTimeout(ctx context.Context, d time.Duration) (context.Context, context.CancelFunc)
+}
+
+// RealClock is the Clock of the wall time.
+var RealClock Clock = realClock{}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) WithTimeout(ctx context.Context, d time.Duration) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, d)
+}
+
+// ClockOrDefault returns c, or RealClock if c is nil.
+func ClockOrDefault(c Clock) Clock {
+	if c == nil {
+		return RealClock
+	}
+	return c
+}
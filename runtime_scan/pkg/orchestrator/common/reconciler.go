@@ -36,6 +36,11 @@ func (rae RequeueAfterError) Error() string {
 	return fmt.Sprintf("requeuing after %v", rae.d)
 }
 
+// RequeueAfter returns the time after which the item is requeued.
+func (rae RequeueAfterError) RequeueAfter() time.Duration {
+	return rae.d
+}
+
 func NewRequeueAfterError(d time.Duration, msg string) error {
 	return RequeueAfterError{d, msg}
 }
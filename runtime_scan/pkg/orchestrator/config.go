@@ -26,10 +26,15 @@ import (
 
 	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/discovery"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/reportschedulewatcher"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/scanconfigwatcher"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/scanresultprocessor"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/scanresultretention"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/scanresultwatcher"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/scanwatcher"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/targetsummarywatcher"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/vmimagewatcher"
+	"github.com/openclarity/vmclarity/shared/pkg/report"
 )
 
 const (
@@ -39,15 +44,22 @@ const (
 	ClamBinaryPath                = "CLAM_BINARY_PATH"
 	FreshclamBinaryPath           = "FRESHCLAM_BINARY_PATH"
 	AlternativeFreshclamMirrorURL = "ALTERNATIVE_FRESHCLAM_MIRROR_URL"
+	YaraBinaryPath                = "YARA_BINARY_PATH"
+	YaracBinaryPath               = "YARAC_BINARY_PATH"
+	YaraRuleSources               = "YARA_RULE_SOURCES"
 	LynisInstallPath              = "LYNIS_INSTALL_PATH"
 	ScannerBackendAddress         = "SCANNER_VMCLARITY_BACKEND_ADDRESS"
 	ExploitDBAddress              = "EXPLOIT_DB_ADDRESS"
 	TrivyServerAddress            = "TRIVY_SERVER_ADDRESS"
 	TrivyServerTimeout            = "TRIVY_SERVER_TIMEOUT"
+	TrivyCacheDir                 = "TRIVY_CACHE_DIR"
+	TrivyOffline                  = "TRIVY_OFFLINE"
 	GrypeServerAddress            = "GRYPE_SERVER_ADDRESS"
 	GrypeServerTimeout            = "GRYPE_SERVER_TIMEOUT"
 	ChkrootkitBinaryPath          = "CHKROOTKIT_BINARY_PATH"
 
+	BootIntegrityKnownGoodHashesFile = "BOOT_INTEGRITY_KNOWN_GOOD_HASHES_FILE"
+
 	ScanConfigPollingInterval  = "SCAN_CONFIG_POLLING_INTERVAL"
 	ScanConfigReconcileTimeout = "SCAN_CONFIG_RECONCILE_TIMEOUT"
 
@@ -57,14 +69,50 @@ const (
 
 	ScanResultPollingInterval  = "SCAN_RESULT_POLLING_INTERVAL"
 	ScanResultReconcileTimeout = "SCAN_RESULT_RECONCILE_TIMEOUT"
+	SnapshotPrewarmConcurrency = "SNAPSHOT_PREWARM_CONCURRENCY"
+	MaxConcurrentScanners      = "MAX_CONCURRENT_SCANNERS"
+
+	ScanResultProcessorPollingInterval      = "SCAN_RESULT_PROCESSOR_POLLING_INTERVAL"
+	ScanResultProcessorReconcileTimeout     = "SCAN_RESULT_PROCESSOR_RECONCILE_TIMEOUT"
+	ScanResultProcessorMaxSecretOccurrences = "SCAN_RESULT_PROCESSOR_MAX_SECRET_OCCURRENCES"
+
+	ComplianceMappingsDir = "COMPLIANCE_MAPPINGS_DIR"
+
+	NetworkPolicyFile = "NETWORK_POLICY_FILE"
+
+	RegistryDiscoveryFile = "REGISTRY_DISCOVERY_FILE"
+
+	ScanResultRetentionPollingInterval      = "SCAN_RESULT_RETENTION_POLLING_INTERVAL"
+	ScanResultRetentionReconcileTimeout     = "SCAN_RESULT_RETENTION_RECONCILE_TIMEOUT"
+	ScanResultRetentionSummaryOnlyAfterDays = "SCAN_RESULT_RETENTION_SUMMARY_ONLY_AFTER_DAYS"
+	ScanResultRetentionArchiveAfterDays     = "SCAN_RESULT_RETENTION_ARCHIVE_AFTER_DAYS"
+	ScanResultRetentionArchiveDir           = "SCAN_RESULT_RETENTION_ARCHIVE_DIR"
+
+	TargetSummaryPollingInterval  = "TARGET_SUMMARY_POLLING_INTERVAL"
+	TargetSummaryReconcileTimeout = "TARGET_SUMMARY_RECONCILE_TIMEOUT"
+
+	VMImagePollingInterval  = "VM_IMAGE_POLLING_INTERVAL"
+	VMImageReconcileTimeout = "VM_IMAGE_RECONCILE_TIMEOUT"
+	VMImageMinInstances     = "VM_IMAGE_MIN_INSTANCES"
 
-	ScanResultProcessorPollingInterval  = "SCAN_RESULT_PROCESSOR_POLLING_INTERVAL"
-	ScanResultProcessorReconcileTimeout = "SCAN_RESULT_PROCESSOR_RECONCILE_TIMEOUT"
+	ReportSchedulePollingInterval  = "REPORT_SCHEDULE_POLLING_INTERVAL"
+	ReportScheduleReconcileTimeout = "REPORT_SCHEDULE_RECONCILE_TIMEOUT"
+	ReportSMTPAddress              = "REPORT_SMTP_ADDRESS"
+	ReportSMTPUsername             = "REPORT_SMTP_USERNAME"
+	ReportSMTPPassword             = "REPORT_SMTP_PASSWORD"
+	ReportSMTPFrom                 = "REPORT_SMTP_FROM"
 
 	DiscoveryInterval = "DISCOVERY_INTERVAL"
 
+	TargetDiscoveryInterval = "TARGET_DISCOVERY_INTERVAL"
+
 	ControllerStartupDelay = "CONTROLLER_STARTUP_DELAY"
 
+	MaintenancePollingInterval = "MAINTENANCE_POLLING_INTERVAL"
+
+	MaxConcurrentSnapshots          = "MAX_CONCURRENT_SNAPSHOTS"
+	MaxConcurrentSnapshotsPerRegion = "MAX_CONCURRENT_SNAPSHOTS_PER_REGION"
+
 	ProviderKind = "PROVIDER"
 )
 
@@ -72,8 +120,15 @@ const (
 	DefaultTrivyServerTimeout = 5 * time.Minute
 	DefaultGrypeServerTimeout = 2 * time.Minute
 
-	DefaultControllerStartupDelay = 15 * time.Second
+	DefaultControllerStartupDelay     = 15 * time.Second
+	DefaultMaintenancePollingInterval = 30 * time.Second
+	// DefaultMaxConcurrentSnapshots is the AWS quota of concurrent snapshot
+	// copies per destination region, as the snapshots are all copied to the
+	// region of the Scanners.
+	DefaultMaxConcurrentSnapshots = 20
 	DefaultProviderKind           = models.AWS
+
+	DefaultReportSMTPFrom = "vmclarity@localhost"
 )
 
 type Config struct {
@@ -86,11 +141,42 @@ type Config struct {
 	// to pick up an event generated by the other without waiting until the next polling cycle.
 	ControllerStartupDelay time.Duration
 
-	DiscoveryConfig           discovery.Config
-	ScanConfigWatcherConfig   scanconfigwatcher.Config
-	ScanWatcherConfig         scanwatcher.Config
-	ScanResultWatcherConfig   scanresultwatcher.Config
-	ScanResultProcessorConfig scanresultprocessor.Config
+	// MaintenancePollingInterval is how often the Orchestrator checks whether
+	// the backend is in maintenance mode, the Controller(s) are stopped while
+	// it is.
+	MaintenancePollingInterval time.Duration
+
+	// MaxConcurrentSnapshots is the maximum number of Targets whose volume
+	// snapshots are created or copied at the same time, unlimited if 0.
+	MaxConcurrentSnapshots int
+	// MaxConcurrentSnapshotsPerRegion is the maximum number of Targets whose
+	// volume snapshots are created or copied at the same time in each account
+	// and region, unlimited if 0.
+	MaxConcurrentSnapshotsPerRegion int
+
+	// ComplianceMappingsDir is an optional directory of mapping files which
+	// extend the bundled compliance mappings of misconfiguration findings.
+	ComplianceMappingsDir string
+
+	// NetworkPolicyFile is an optional file of the network policy rules the
+	// discovered security groups are evaluated against, the default policy
+	// is used if not set.
+	NetworkPolicyFile string
+
+	// RegistryDiscoveryFile is an optional file of the container registries
+	// the images to scan are discovered in, no images are discovered if not
+	// set.
+	RegistryDiscoveryFile string
+
+	DiscoveryConfig             discovery.Config
+	ScanConfigWatcherConfig     scanconfigwatcher.Config
+	ScanWatcherConfig           scanwatcher.Config
+	ScanResultWatcherConfig     scanresultwatcher.Config
+	ScanResultProcessorConfig   scanresultprocessor.Config
+	ScanResultRetentionConfig   scanresultretention.Config
+	TargetSummaryWatcherConfig  targetsummarywatcher.Config
+	VMImageWatcherConfig        vmimagewatcher.Config
+	ReportScheduleWatcherConfig reportschedulewatcher.Config
 }
 
 func setConfigDefaults(backendHost string, backendPort int, backendBaseURL string) {
@@ -105,6 +191,8 @@ func setConfigDefaults(backendHost string, backendPort int, backendBaseURL strin
 	viper.SetDefault(ExploitDBAddress, fmt.Sprintf("http://%s", net.JoinHostPort(backendHost, "1326")))
 	viper.SetDefault(ClamBinaryPath, "clamscan")
 	viper.SetDefault(FreshclamBinaryPath, "freshclam")
+	viper.SetDefault(YaraBinaryPath, "yara")
+	viper.SetDefault(YaracBinaryPath, "yarac")
 	viper.SetDefault(TrivyServerTimeout, DefaultTrivyServerTimeout)
 	viper.SetDefault(GrypeServerTimeout, DefaultGrypeServerTimeout)
 	viper.SetDefault(ScanConfigPollingInterval, scanconfigwatcher.DefaultPollInterval.String())
@@ -114,10 +202,26 @@ func setConfigDefaults(backendHost string, backendPort int, backendBaseURL strin
 	viper.SetDefault(ScanTimeout, scanwatcher.DefaultScanTimeout.String())
 	viper.SetDefault(ScanResultPollingInterval, scanresultwatcher.DefaultPollInterval.String())
 	viper.SetDefault(ScanResultReconcileTimeout, scanresultwatcher.DefaultReconcileTimeout.String())
+	viper.SetDefault(SnapshotPrewarmConcurrency, scanresultwatcher.DefaultSnapshotPrewarmConcurrency)
+	viper.SetDefault(MaxConcurrentScanners, scanresultwatcher.DefaultMaxConcurrentScanners)
 	viper.SetDefault(ScanResultProcessorPollingInterval, scanresultprocessor.DefaultPollInterval.String())
 	viper.SetDefault(ScanResultProcessorReconcileTimeout, scanresultprocessor.DefaultReconcileTimeout.String())
+	viper.SetDefault(ScanResultProcessorMaxSecretOccurrences, scanresultprocessor.DefaultMaxSecretOccurrences)
+	viper.SetDefault(ScanResultRetentionPollingInterval, scanresultretention.DefaultPollInterval.String())
+	viper.SetDefault(ScanResultRetentionReconcileTimeout, scanresultretention.DefaultReconcileTimeout.String())
+	viper.SetDefault(TargetSummaryPollingInterval, targetsummarywatcher.DefaultPollInterval.String())
+	viper.SetDefault(TargetSummaryReconcileTimeout, targetsummarywatcher.DefaultReconcileTimeout.String())
+	viper.SetDefault(VMImagePollingInterval, vmimagewatcher.DefaultPollInterval.String())
+	viper.SetDefault(VMImageReconcileTimeout, vmimagewatcher.DefaultReconcileTimeout.String())
+	viper.SetDefault(VMImageMinInstances, vmimagewatcher.DefaultMinInstances)
+	viper.SetDefault(ReportSchedulePollingInterval, reportschedulewatcher.DefaultPollInterval.String())
+	viper.SetDefault(ReportScheduleReconcileTimeout, reportschedulewatcher.DefaultReconcileTimeout.String())
+	viper.SetDefault(ReportSMTPFrom, DefaultReportSMTPFrom)
 	viper.SetDefault(DiscoveryInterval, discovery.DefaultInterval.String())
+	viper.SetDefault(TargetDiscoveryInterval, discovery.DefaultTargetInterval.String())
 	viper.SetDefault(ControllerStartupDelay, DefaultControllerStartupDelay.String())
+	viper.SetDefault(MaintenancePollingInterval, DefaultMaintenancePollingInterval.String())
+	viper.SetDefault(MaxConcurrentSnapshots, DefaultMaxConcurrentSnapshots)
 	viper.SetDefault(ProviderKind, DefaultProviderKind)
 
 	viper.AutomaticEnv()
@@ -130,6 +234,10 @@ func LoadConfig(backendHost string, backendPort int, baseURL string) (*Config, e
 	switch strings.ToLower(viper.GetString(ProviderKind)) {
 	case strings.ToLower(string(models.Azure)):
 		providerKind = models.Azure
+	case strings.ToLower(string(models.SSH)):
+		providerKind = models.SSH
+	case strings.ToLower(string(models.Kubernetes)):
+		providerKind = models.Kubernetes
 	case strings.ToLower(string(models.AWS)):
 		fallthrough
 	default:
@@ -137,10 +245,17 @@ func LoadConfig(backendHost string, backendPort int, baseURL string) (*Config, e
 	}
 
 	c := &Config{
-		ProviderKind:           providerKind,
-		ControllerStartupDelay: viper.GetDuration(ControllerStartupDelay),
+		ProviderKind:                    providerKind,
+		ControllerStartupDelay:          viper.GetDuration(ControllerStartupDelay),
+		MaintenancePollingInterval:      viper.GetDuration(MaintenancePollingInterval),
+		MaxConcurrentSnapshots:          viper.GetInt(MaxConcurrentSnapshots),
+		MaxConcurrentSnapshotsPerRegion: viper.GetInt(MaxConcurrentSnapshotsPerRegion),
+		ComplianceMappingsDir:           viper.GetString(ComplianceMappingsDir),
+		NetworkPolicyFile:               viper.GetString(NetworkPolicyFile),
+		RegistryDiscoveryFile:           viper.GetString(RegistryDiscoveryFile),
 		DiscoveryConfig: discovery.Config{
-			DiscoveryInterval: viper.GetDuration(DiscoveryInterval),
+			DiscoveryInterval:       viper.GetDuration(DiscoveryInterval),
+			TargetDiscoveryInterval: viper.GetDuration(TargetDiscoveryInterval),
 		},
 		ScannerBackendAddress: viper.GetString(ScannerBackendAddress),
 		ScanConfigWatcherConfig: scanconfigwatcher.Config{
@@ -155,6 +270,9 @@ func LoadConfig(backendHost string, backendPort int, baseURL string) (*Config, e
 		ScanResultWatcherConfig: scanresultwatcher.Config{
 			PollPeriod:       viper.GetDuration(ScanResultPollingInterval),
 			ReconcileTimeout: viper.GetDuration(ScanResultReconcileTimeout),
+
+			SnapshotPrewarmConcurrency: viper.GetInt(SnapshotPrewarmConcurrency),
+			MaxConcurrentScanners:      viper.GetInt(MaxConcurrentScanners),
 			ScannerConfig: scanresultwatcher.ScannerConfig{
 				DeleteJobPolicy:               scanresultwatcher.GetDeleteJobPolicyType(viper.GetString(DeleteJobPolicy)),
 				ScannerImage:                  viper.GetString(ScannerContainerImage),
@@ -165,18 +283,66 @@ func LoadConfig(backendHost string, backendPort int, baseURL string) (*Config, e
 				ClamBinaryPath:                viper.GetString(ClamBinaryPath),
 				FreshclamBinaryPath:           viper.GetString(FreshclamBinaryPath),
 				AlternativeFreshclamMirrorURL: viper.GetString(AlternativeFreshclamMirrorURL),
+				YaraBinaryPath:                viper.GetString(YaraBinaryPath),
+				YaracBinaryPath:               viper.GetString(YaracBinaryPath),
+				YaraRuleSources:               splitList(viper.GetString(YaraRuleSources)),
 				TrivyServerAddress:            viper.GetString(TrivyServerAddress),
 				TrivyServerTimeout:            viper.GetDuration(TrivyServerTimeout),
+				TrivyCacheDir:                 viper.GetString(TrivyCacheDir),
+				TrivyOffline:                  viper.GetBool(TrivyOffline),
 				GrypeServerAddress:            viper.GetString(GrypeServerAddress),
 				GrypeServerTimeout:            viper.GetDuration(GrypeServerTimeout),
 				ChkrootkitBinaryPath:          viper.GetString(ChkrootkitBinaryPath),
+
+				BootIntegrityKnownGoodHashesFile: viper.GetString(BootIntegrityKnownGoodHashesFile),
 			},
 		},
 		ScanResultProcessorConfig: scanresultprocessor.Config{
 			PollPeriod:       viper.GetDuration(ScanResultProcessorPollingInterval),
 			ReconcileTimeout: viper.GetDuration(ScanResultProcessorReconcileTimeout),
+
+			MaxSecretOccurrences: viper.GetInt(ScanResultProcessorMaxSecretOccurrences),
+		},
+		ScanResultRetentionConfig: scanresultretention.Config{
+			PollPeriod:       viper.GetDuration(ScanResultRetentionPollingInterval),
+			ReconcileTimeout: viper.GetDuration(ScanResultRetentionReconcileTimeout),
+			DefaultPolicy: scanresultretention.Policy{
+				SummaryOnlyAfterDays: viper.GetInt(ScanResultRetentionSummaryOnlyAfterDays),
+				ArchiveAfterDays:     viper.GetInt(ScanResultRetentionArchiveAfterDays),
+			},
+			ArchiveDir: viper.GetString(ScanResultRetentionArchiveDir),
+		},
+		TargetSummaryWatcherConfig: targetsummarywatcher.Config{
+			PollPeriod:       viper.GetDuration(TargetSummaryPollingInterval),
+			ReconcileTimeout: viper.GetDuration(TargetSummaryReconcileTimeout),
+		},
+		VMImageWatcherConfig: vmimagewatcher.Config{
+			PollPeriod:       viper.GetDuration(VMImagePollingInterval),
+			ReconcileTimeout: viper.GetDuration(VMImageReconcileTimeout),
+			MinInstances:     viper.GetInt(VMImageMinInstances),
+		},
+		ReportScheduleWatcherConfig: reportschedulewatcher.Config{
+			PollPeriod:       viper.GetDuration(ReportSchedulePollingInterval),
+			ReconcileTimeout: viper.GetDuration(ReportScheduleReconcileTimeout),
+			SMTP: report.SMTPConfig{
+				Address:  viper.GetString(ReportSMTPAddress),
+				Username: viper.GetString(ReportSMTPUsername),
+				Password: viper.GetString(ReportSMTPPassword),
+				From:     viper.GetString(ReportSMTPFrom),
+			},
 		},
 	}
 
 	return c, nil
 }
+
+// splitList splits a comma separated list, dropping the empty items.
+func splitList(s string) []string {
+	var ret []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			ret = append(ret, item)
+		}
+	}
+	return ret
+}
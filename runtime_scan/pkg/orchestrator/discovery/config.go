@@ -23,13 +23,17 @@ import (
 )
 
 const (
-	DefaultInterval = 2 * time.Minute
+	DefaultInterval       = 2 * time.Minute
+	DefaultTargetInterval = time.Hour
 )
 
 type Config struct {
 	Backend           *backendclient.BackendClient
 	Provider          provider.Provider
 	DiscoveryInterval time.Duration
+	// TargetDiscoveryInterval is the interval the targets in the scopes of
+	// the ScanConfigs are discovered at, zero disables target discovery.
+	TargetDiscoveryInterval time.Duration
 }
 
 func (c Config) WithBackendClient(b *backendclient.BackendClient) Config {
@@ -0,0 +1,200 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// TargetDiscoverer keeps the Targets up to date with the targets the provider
+// discovers in the scopes of the ScanConfigs, so that the inventory of assets
+// is known before they are scanned. The VM targets which are no longer
+// discovered are marked as terminated.
+type TargetDiscoverer struct {
+	backendClient  *backendclient.BackendClient
+	providerClient provider.Provider
+	interval       time.Duration
+}
+
+func NewTargetDiscoverer(config Config) *TargetDiscoverer {
+	return &TargetDiscoverer{
+		backendClient:  config.Backend,
+		providerClient: config.Provider,
+		interval:       config.TargetDiscoveryInterval,
+	}
+}
+
+func (td *TargetDiscoverer) Start(ctx context.Context) {
+	logger := log.GetLoggerFromContextOrDiscard(ctx).WithField("controller", "TargetDiscoverer")
+	if td.interval <= 0 {
+		logger.Info("Target discovery is disabled")
+		return
+	}
+
+	go func() {
+		for {
+			logger.Debug("Discovering targets")
+			if err := td.discover(ctx); err != nil {
+				logger.Warnf("Failed to discover targets: %v", err)
+			}
+			select {
+			case <-time.After(td.interval):
+				logger.Debug("Target discovery interval elapsed")
+			case <-ctx.Done():
+				logger.Infof("Stop discovering targets.")
+				return
+			}
+		}
+	}()
+}
+
+func (td *TargetDiscoverer) discover(ctx context.Context) error {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	scopes, err := td.getScanScopes(ctx)
+	if err != nil {
+		return err
+	}
+	if len(scopes) == 0 {
+		logger.Debug("No ScanConfig scopes to discover targets in")
+		return nil
+	}
+
+	// The targets are only marked as terminated if all the scopes were
+	// discovered, otherwise the targets of the failed scopes would be.
+	var discoveryErrs []error
+	discovered := map[string]struct{}{}
+	for _, scope := range scopes {
+		scope := scope
+		targetTypes, err := td.providerClient.DiscoverTargets(ctx, &scope)
+		if err != nil {
+			discoveryErrs = append(discoveryErrs, err)
+			continue
+		}
+		for _, targetType := range targetTypes {
+			targetID, err := td.createTarget(ctx, targetType)
+			if err != nil {
+				discoveryErrs = append(discoveryErrs, err)
+				continue
+			}
+			discovered[targetID] = struct{}{}
+		}
+	}
+
+	targets, err := td.backendClient.GetAssets(ctx, models.GetAssetsParams{
+		Filter: utils.PointerTo(fmt.Sprintf("assetInfo/objectType eq 'VMInfo' and assetInfo/instanceProvider eq '%s'", td.providerClient.Kind())),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get targets: %w", err)
+	}
+
+	terminated, restored := reconcileTerminatedTargets(*targets.Items, discovered, len(discoveryErrs) == 0, time.Now())
+	for _, target := range terminated {
+		logger.WithField("TargetID", *target.Id).Info("Target is no longer discovered, marking it as terminated")
+		err := td.backendClient.PatchAsset(ctx, models.Asset{TerminatedOn: target.TerminatedOn}, *target.Id)
+		if err != nil {
+			discoveryErrs = append(discoveryErrs, err)
+		}
+	}
+	for _, target := range restored {
+		logger.WithField("TargetID", *target.Id).Info("Target is discovered again")
+		if err := td.backendClient.PutAsset(ctx, target, *target.Id); err != nil {
+			discoveryErrs = append(discoveryErrs, err)
+		}
+	}
+
+	return errors.Join(discoveryErrs...)
+}
+
+// getScanScopes returns the distinct scopes of the enabled ScanConfigs.
+func (td *TargetDiscoverer) getScanScopes(ctx context.Context) ([]models.ScanScopeType, error) {
+	scanConfigs, err := td.backendClient.GetScanConfigs(ctx, models.GetScanConfigsParams{
+		Filter: utils.PointerTo("disabled eq null or disabled eq false"),
+		Select: utils.PointerTo("scope"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scan configs: %w", err)
+	}
+
+	seen := map[string]struct{}{}
+	scopes := make([]models.ScanScopeType, 0, len(*scanConfigs.Items))
+	for _, scanConfig := range *scanConfigs.Items {
+		if scanConfig.Scope == nil {
+			continue
+		}
+		key, err := json.Marshal(scanConfig.Scope)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal scope: %w", err)
+		}
+		if _, ok := seen[string(key)]; ok {
+			continue
+		}
+		seen[string(key)] = struct{}{}
+		scopes = append(scopes, *scanConfig.Scope)
+	}
+
+	return scopes, nil
+}
+
+func (td *TargetDiscoverer) createTarget(ctx context.Context, targetType models.AssetType) (string, error) {
+	target, err := td.backendClient.PostAsset(ctx, models.Asset{
+		AssetInfo: &targetType,
+	})
+	if err != nil {
+		var conErr backendclient.AssetConflictError
+		if errors.As(err, &conErr) {
+			return *conErr.ConflictingTarget.Id, nil
+		}
+		return "", fmt.Errorf("failed to post Target: %w", err)
+	}
+
+	return *target.Id, nil
+}
+
+// reconcileTerminatedTargets returns the targets which are to be marked as
+// terminated because they were not discovered, and the terminated targets
+// which were discovered again with the terminated mark removed. Targets are
+// only marked as terminated if the discovery was complete.
+func reconcileTerminatedTargets(targets []models.Asset, discovered map[string]struct{}, complete bool, now time.Time) ([]models.Asset, []models.Asset) {
+	var terminated, restored []models.Asset
+	for _, target := range targets {
+		if target.Id == nil {
+			continue
+		}
+
+		_, ok := discovered[*target.Id]
+		switch {
+		case ok && target.TerminatedOn != nil:
+			target.TerminatedOn = nil
+			restored = append(restored, target)
+		case !ok && target.TerminatedOn == nil && complete:
+			target.TerminatedOn = utils.PointerTo(now)
+			terminated = append(terminated, target)
+		}
+	}
+
+	return terminated, restored
+}
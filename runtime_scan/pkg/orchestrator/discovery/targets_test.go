@@ -0,0 +1,100 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discovery
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestReconcileTerminatedTargets(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+
+	tests := []struct {
+		Name       string
+		Targets    []models.Asset
+		Discovered map[string]struct{}
+		Complete   bool
+
+		ExpectedTerminated []models.Asset
+		ExpectedRestored   []models.Asset
+	}{
+		{
+			Name: "Discovered targets are unchanged",
+			Targets: []models.Asset{
+				{Id: utils.PointerTo("a")},
+			},
+			Discovered: map[string]struct{}{"a": {}},
+			Complete:   true,
+		},
+		{
+			Name: "Undiscovered target is terminated",
+			Targets: []models.Asset{
+				{Id: utils.PointerTo("a")},
+				{Id: utils.PointerTo("b")},
+			},
+			Discovered: map[string]struct{}{"a": {}},
+			Complete:   true,
+			ExpectedTerminated: []models.Asset{
+				{Id: utils.PointerTo("b"), TerminatedOn: utils.PointerTo(now)},
+			},
+		},
+		{
+			Name: "Undiscovered target is not terminated if discovery is incomplete",
+			Targets: []models.Asset{
+				{Id: utils.PointerTo("a")},
+				{Id: utils.PointerTo("b")},
+			},
+			Discovered: map[string]struct{}{"a": {}},
+			Complete:   false,
+		},
+		{
+			Name: "Terminated target is not terminated again",
+			Targets: []models.Asset{
+				{Id: utils.PointerTo("a"), TerminatedOn: utils.PointerTo(earlier)},
+			},
+			Discovered: map[string]struct{}{},
+			Complete:   true,
+		},
+		{
+			Name: "Terminated target discovered again is restored",
+			Targets: []models.Asset{
+				{Id: utils.PointerTo("a"), TerminatedOn: utils.PointerTo(earlier)},
+			},
+			Discovered: map[string]struct{}{"a": {}},
+			Complete:   false,
+			ExpectedRestored: []models.Asset{
+				{Id: utils.PointerTo("a")},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			terminated, restored := reconcileTerminatedTargets(test.Targets, test.Discovered, test.Complete, now)
+			g.Expect(terminated).Should(BeEquivalentTo(test.ExpectedTerminated))
+			g.Expect(restored).Should(BeEquivalentTo(test.ExpectedRestored))
+		})
+	}
+}
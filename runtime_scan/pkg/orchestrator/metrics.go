@@ -0,0 +1,61 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orchestrator
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+)
+
+// retryCollector exports the provider.RetryStats of the operations of a
+// provider.RetryingProvider as Prometheus metrics.
+type retryCollector struct {
+	provider *provider.RetryingProvider
+
+	retries   *prometheus.Desc
+	exhausted *prometheus.Desc
+	waits     *prometheus.Desc
+}
+
+func newRetryCollector(p *provider.RetryingProvider) *retryCollector {
+	labels := []string{"operation"}
+
+	return &retryCollector{
+		provider: p,
+		retries: prometheus.NewDesc("vmclarity_provider_operation_retries_total",
+			"Number of times a provider operation was retried after an error.", labels, nil),
+		exhausted: prometheus.NewDesc("vmclarity_provider_operation_retries_exhausted_total",
+			"Number of provider operations which failed after using up their retry attempts.", labels, nil),
+		waits: prometheus.NewDesc("vmclarity_provider_operation_waits_total",
+			"Number of times a provider operation was retried as it was in progress.", labels, nil),
+	}
+}
+
+func (c *retryCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.retries
+	ch <- c.exhausted
+	ch <- c.waits
+}
+
+func (c *retryCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, class := range c.provider.OperationClasses() {
+		stats := c.provider.Stats(class)
+		ch <- prometheus.MustNewConstMetric(c.retries, prometheus.CounterValue, float64(stats.Retries), string(class))
+		ch <- prometheus.MustNewConstMetric(c.exhausted, prometheus.CounterValue, float64(stats.Exhausted), string(class))
+		ch <- prometheus.MustNewConstMetric(c.waits, prometheus.CounterValue, float64(stats.Waits), string(class))
+	}
+}
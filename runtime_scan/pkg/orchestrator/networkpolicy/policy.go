@@ -0,0 +1,274 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	_ "embed"
+	"fmt"
+	"net/netip"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const (
+	// ScannerName is the scanner name of the misconfigurations reported
+	// for the violations of the network policy.
+	ScannerName = "network-policy"
+	// TestCategory is the test category of the misconfigurations reported
+	// for the violations of the network policy.
+	TestCategory = "network"
+)
+
+//go:embed default-policy.yaml
+var defaultPolicy []byte
+
+// Rule is violated by the security group rules allowing traffic on any of
+// Ports from any of Sources. A source of a security group rule matches if it
+// contains a source of the Rule, so 0.0.0.0/0 matches every IPv4 source.
+type Rule struct {
+	ID          string                            `yaml:"id"`
+	Description string                            `yaml:"description"`
+	Severity    models.MisconfigurationSeverity   `yaml:"severity"`
+	Direction   models.SecurityGroupRuleDirection `yaml:"direction"`
+	// Protocol is tcp, udp, icmp or all for any protocol.
+	Protocol string `yaml:"protocol"`
+	// Ports are matched by any port if empty.
+	Ports       []int    `yaml:"ports"`
+	Sources     []string `yaml:"sources"`
+	Remediation string   `yaml:"remediation"`
+}
+
+// Policy holds the network policy rules the discovered security groups are
+// evaluated against.
+type Policy struct {
+	Rules []Rule `yaml:"rules"`
+}
+
+// LoadPolicy returns the Policy defined in the file at path, or the default
+// Policy if path is empty.
+func LoadPolicy(path string) (*Policy, error) {
+	data := defaultPolicy
+	if path != "" {
+		var err error
+		data, err = os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read network policy file: %w", err)
+		}
+	}
+
+	var policy Policy
+	if err := yaml.Unmarshal(data, &policy); err != nil {
+		return nil, fmt.Errorf("failed to parse network policy: %w", err)
+	}
+
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid network policy: %w", err)
+	}
+
+	return &policy, nil
+}
+
+// nolint:cyclop
+func (p *Policy) Validate() error {
+	ids := make(map[string]struct{}, len(p.Rules))
+	for i, rule := range p.Rules {
+		if rule.ID == "" {
+			return fmt.Errorf("id must be provided for rule %d", i)
+		}
+		if _, ok := ids[rule.ID]; ok {
+			return fmt.Errorf("rule %s is defined multiple times", rule.ID)
+		}
+		ids[rule.ID] = struct{}{}
+
+		switch rule.Severity {
+		case models.MisconfigurationHighSeverity, models.MisconfigurationMediumSeverity, models.MisconfigurationLowSeverity:
+		default:
+			return fmt.Errorf("invalid severity %q of rule %s", rule.Severity, rule.ID)
+		}
+
+		switch rule.Direction {
+		case models.Inbound, models.Outbound:
+		default:
+			return fmt.Errorf("invalid direction %q of rule %s", rule.Direction, rule.ID)
+		}
+
+		switch rule.Protocol {
+		case provider.ProtocolAll, provider.ProtocolTCP, provider.ProtocolUDP, provider.ProtocolICMP:
+		default:
+			return fmt.Errorf("invalid protocol %q of rule %s", rule.Protocol, rule.ID)
+		}
+
+		if len(rule.Sources) == 0 {
+			return fmt.Errorf("sources must be provided for rule %s", rule.ID)
+		}
+		for _, source := range rule.Sources {
+			if _, err := netip.ParsePrefix(source); err != nil {
+				return fmt.Errorf("invalid source %q of rule %s: %w", source, rule.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Evaluate returns a misconfiguration for each security group and Rule it
+// violates.
+func (p *Policy) Evaluate(securityGroups []models.SecurityGroup) []models.Misconfiguration {
+	var misconfigurations []models.Misconfiguration
+	for _, securityGroup := range securityGroups {
+		if securityGroup.Rules == nil {
+			continue
+		}
+		for _, rule := range p.Rules {
+			if misconfiguration, ok := rule.evaluate(securityGroup); ok {
+				misconfigurations = append(misconfigurations, misconfiguration)
+			}
+		}
+	}
+
+	return misconfigurations
+}
+
+func (r Rule) evaluate(securityGroup models.SecurityGroup) (models.Misconfiguration, bool) {
+	ports := map[string]struct{}{}
+	sources := map[string]struct{}{}
+	for _, sgRule := range *securityGroup.Rules {
+		if sgRule.Direction != r.Direction || !r.matchesProtocol(sgRule.Protocol) {
+			continue
+		}
+		matchedPorts, ok := r.matchPorts(sgRule)
+		if !ok {
+			continue
+		}
+		matchedSources := r.matchSources(sgRule)
+		if len(matchedSources) == 0 {
+			continue
+		}
+		for _, port := range matchedPorts {
+			ports[port] = struct{}{}
+		}
+		for _, source := range matchedSources {
+			sources[source] = struct{}{}
+		}
+	}
+	if len(sources) == 0 {
+		return models.Misconfiguration{}, false
+	}
+
+	name := securityGroup.Id
+	if securityGroup.Name != nil && *securityGroup.Name != "" {
+		name = fmt.Sprintf("%s (%s)", securityGroup.Id, *securityGroup.Name)
+	}
+	peer := "from"
+	if r.Direction == models.Outbound {
+		peer = "to"
+	}
+
+	return models.Misconfiguration{
+		ScannerName:     utils.PointerTo(ScannerName),
+		ScannedPath:     utils.PointerTo(securityGroup.Id),
+		TestCategory:    utils.PointerTo(TestCategory),
+		TestID:          utils.PointerTo(r.ID),
+		TestDescription: utils.PointerTo(r.Description),
+		Severity:        utils.PointerTo(r.Severity),
+		Message: utils.PointerTo(fmt.Sprintf("Security group %s allows %s %s traffic on ports %s %s %s",
+			name, strings.ToLower(string(r.Direction)), r.Protocol, strings.Join(sortedPorts(ports), ", "),
+			peer, strings.Join(sortedKeys(sources), ", "))),
+		Remediation: utils.PointerTo(r.Remediation),
+	}, true
+}
+
+func (r Rule) matchesProtocol(protocol string) bool {
+	return protocol == provider.ProtocolAll || r.Protocol == provider.ProtocolAll || protocol == r.Protocol
+}
+
+// matchPorts returns the ports of the Rule allowed by the security group rule.
+func (r Rule) matchPorts(sgRule models.SecurityGroupRule) ([]string, bool) {
+	allPorts := sgRule.FromPort == nil || sgRule.ToPort == nil
+	if len(r.Ports) == 0 {
+		if allPorts {
+			return []string{"all"}, true
+		}
+		return []string{fmt.Sprintf("%d-%d", *sgRule.FromPort, *sgRule.ToPort)}, true
+	}
+
+	var ports []string
+	for _, port := range r.Ports {
+		if allPorts || (*sgRule.FromPort <= port && port <= *sgRule.ToPort) {
+			ports = append(ports, strconv.Itoa(port))
+		}
+	}
+
+	return ports, len(ports) > 0
+}
+
+// matchSources returns the sources of the security group rule containing a
+// source of the Rule.
+func (r Rule) matchSources(sgRule models.SecurityGroupRule) []string {
+	if sgRule.Sources == nil {
+		return nil
+	}
+
+	var sources []string
+	for _, source := range *sgRule.Sources {
+		sgPrefix, err := netip.ParsePrefix(source)
+		if err != nil {
+			// Service tags, e.g. VirtualNetwork, are not addresses.
+			continue
+		}
+		for _, s := range r.Sources {
+			prefix := netip.MustParsePrefix(s)
+			if sgPrefix.Addr().Is4() == prefix.Addr().Is4() && sgPrefix.Bits() <= prefix.Bits() && sgPrefix.Contains(prefix.Addr()) {
+				sources = append(sources, source)
+				break
+			}
+		}
+	}
+
+	return sources
+}
+
+// sortedPorts returns the ports in numeric order, followed by the port
+// descriptions which are not numbers.
+func sortedPorts(m map[string]struct{}) []string {
+	ports := sortedKeys(m)
+	sort.SliceStable(ports, func(i, j int) bool {
+		a, errA := strconv.Atoi(ports[i])
+		b, errB := strconv.Atoi(ports[j])
+		if errA != nil || errB != nil {
+			return errA == nil && errB != nil
+		}
+		return a < b
+	})
+	return ports
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
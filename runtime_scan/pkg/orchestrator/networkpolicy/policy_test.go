@@ -0,0 +1,193 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func newSecurityGroup(id string, rules ...models.SecurityGroupRule) models.SecurityGroup {
+	return models.SecurityGroup{
+		Id:    id,
+		Rules: &rules,
+	}
+}
+
+func newRule(protocol string, fromPort, toPort *int, sources ...string) models.SecurityGroupRule {
+	return models.SecurityGroupRule{
+		Direction: models.Inbound,
+		Protocol:  protocol,
+		FromPort:  fromPort,
+		ToPort:    toPort,
+		Sources:   &sources,
+	}
+}
+
+func TestLoadPolicy(t *testing.T) {
+	policy, err := LoadPolicy("")
+	if err != nil {
+		t.Fatalf("failed to load default policy: %v", err)
+	}
+	if len(policy.Rules) == 0 {
+		t.Errorf("default policy has no rules")
+	}
+}
+
+func TestPolicyValidate(t *testing.T) {
+	valid := Rule{
+		ID:        "NET-001",
+		Severity:  models.MisconfigurationHighSeverity,
+		Direction: models.Inbound,
+		Protocol:  "tcp",
+		Ports:     []int{22},
+		Sources:   []string{"0.0.0.0/0"},
+	}
+
+	tests := []struct {
+		name    string
+		rules   []Rule
+		wantErr bool
+	}{
+		{
+			name:  "valid",
+			rules: []Rule{valid},
+		},
+		{
+			name:    "duplicate id",
+			rules:   []Rule{valid, valid},
+			wantErr: true,
+		},
+		{
+			name: "invalid source",
+			rules: []Rule{func() Rule {
+				r := valid
+				r.Sources = []string{"anywhere"}
+				return r
+			}()},
+			wantErr: true,
+		},
+		{
+			name: "invalid protocol",
+			rules: []Rule{func() Rule {
+				r := valid
+				r.Protocol = "sctp"
+				return r
+			}()},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&Policy{Rules: tt.rules}).Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPolicyEvaluate(t *testing.T) {
+	policy := &Policy{
+		Rules: []Rule{
+			{
+				ID:          "NET-001",
+				Description: "Admin ports must not be reachable from the internet",
+				Severity:    models.MisconfigurationHighSeverity,
+				Direction:   models.Inbound,
+				Protocol:    "tcp",
+				Ports:       []int{22, 3389},
+				Sources:     []string{"0.0.0.0/0", "::/0"},
+				Remediation: "Restrict the sources.",
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		securityGroups []models.SecurityGroup
+		want           []models.Misconfiguration
+	}{
+		{
+			name: "no rules discovered",
+			securityGroups: []models.SecurityGroup{
+				{Id: "sg-1"},
+			},
+		},
+		{
+			name: "restricted source",
+			securityGroups: []models.SecurityGroup{
+				newSecurityGroup("sg-1", newRule("tcp", utils.PointerTo(22), utils.PointerTo(22), "10.0.0.0/8")),
+			},
+		},
+		{
+			name: "other port",
+			securityGroups: []models.SecurityGroup{
+				newSecurityGroup("sg-1", newRule("tcp", utils.PointerTo(443), utils.PointerTo(443), "0.0.0.0/0")),
+			},
+		},
+		{
+			name: "udp only",
+			securityGroups: []models.SecurityGroup{
+				newSecurityGroup("sg-1", newRule("udp", utils.PointerTo(22), utils.PointerTo(22), "0.0.0.0/0")),
+			},
+		},
+		{
+			name: "violations are merged per security group",
+			securityGroups: []models.SecurityGroup{
+				newSecurityGroup("sg-1",
+					newRule("tcp", utils.PointerTo(3389), utils.PointerTo(3389), "::/0"),
+					newRule("tcp", utils.PointerTo(20), utils.PointerTo(25), "0.0.0.0/0"),
+				),
+				newSecurityGroup("sg-2", newRule("all", nil, nil, "0.0.0.0/0", "10.0.0.0/8")),
+			},
+			want: []models.Misconfiguration{
+				{
+					ScannerName:     utils.PointerTo(ScannerName),
+					ScannedPath:     utils.PointerTo("sg-1"),
+					TestCategory:    utils.PointerTo(TestCategory),
+					TestID:          utils.PointerTo("NET-001"),
+					TestDescription: utils.PointerTo("Admin ports must not be reachable from the internet"),
+					Severity:        utils.PointerTo(models.MisconfigurationHighSeverity),
+					Message:         utils.PointerTo("Security group sg-1 allows inbound tcp traffic on ports 22, 3389 from 0.0.0.0/0, ::/0"),
+					Remediation:     utils.PointerTo("Restrict the sources."),
+				},
+				{
+					ScannerName:     utils.PointerTo(ScannerName),
+					ScannedPath:     utils.PointerTo("sg-2"),
+					TestCategory:    utils.PointerTo(TestCategory),
+					TestID:          utils.PointerTo("NET-001"),
+					TestDescription: utils.PointerTo("Admin ports must not be reachable from the internet"),
+					Severity:        utils.PointerTo(models.MisconfigurationHighSeverity),
+					Message:         utils.PointerTo("Security group sg-2 allows inbound tcp traffic on ports 22, 3389 from 0.0.0.0/0"),
+					Remediation:     utils.PointerTo("Restrict the sources."),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := policy.Evaluate(tt.securityGroups)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Evaluate() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
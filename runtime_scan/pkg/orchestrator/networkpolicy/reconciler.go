@@ -0,0 +1,160 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
+	"github.com/openclarity/vmclarity/shared/pkg/findingkey"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// Reconciler keeps the network misconfiguration findings of the targets in
+// sync with the violations of the Policy by their security groups.
+type Reconciler struct {
+	backend *backendclient.BackendClient
+	policy  *Policy
+}
+
+func NewReconciler(backend *backendclient.BackendClient, policy *Policy) *Reconciler {
+	return &Reconciler{
+		backend: backend,
+		policy:  policy,
+	}
+}
+
+// Reconcile creates a finding for each new violation of the Policy by the
+// security groups of the discovered target, and invalidates the findings of
+// the violations which are resolved.
+// nolint:cyclop
+func (r *Reconciler) Reconcile(ctx context.Context, targetID string, targetInfo models.AssetType) error {
+	logger := log.GetLoggerFromContextOrDiscard(ctx).WithField("TargetID", targetID)
+
+	discriminator, err := targetInfo.Discriminator()
+	if err != nil {
+		return fmt.Errorf("failed to get target info type: %w", err)
+	}
+	if discriminator != "VMInfo" {
+		return nil
+	}
+
+	vmInfo, err := targetInfo.AsVMInfo()
+	if err != nil {
+		return fmt.Errorf("failed to get VMInfo from target info: %w", err)
+	}
+	// Skip the targets of the providers which don't discover the rules of
+	// the security groups, so that the findings are not invalidated.
+	if vmInfo.SecurityGroups == nil || !hasRules(*vmInfo.SecurityGroups) {
+		return nil
+	}
+
+	existing, err := r.getActiveFindings(ctx, targetID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var created int
+	for _, misconfiguration := range r.policy.Evaluate(*vmInfo.SecurityGroups) {
+		findingInfo := models.MisconfigurationFindingInfo{
+			Message:         misconfiguration.Message,
+			Remediation:     misconfiguration.Remediation,
+			ScannedPath:     misconfiguration.ScannedPath,
+			ScannerName:     misconfiguration.ScannerName,
+			Severity:        misconfiguration.Severity,
+			TestCategory:    misconfiguration.TestCategory,
+			TestDescription: misconfiguration.TestDescription,
+			TestID:          misconfiguration.TestID,
+		}
+
+		key := findingkey.GenerateMisconfigurationKey(findingInfo)
+		if _, ok := existing[key]; ok {
+			delete(existing, key)
+			continue
+		}
+
+		info := models.Finding_FindingInfo{}
+		if err = info.FromMisconfigurationFindingInfo(findingInfo); err != nil {
+			return fmt.Errorf("unable to convert MisconfigurationFindingInfo into FindingInfo: %w", err)
+		}
+		_, err = r.backend.PostFinding(ctx, models.Finding{
+			Asset:       &models.AssetRelationship{Id: targetID},
+			FoundOn:     &now,
+			FindingInfo: &info,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create finding: %w", err)
+		}
+		created++
+	}
+
+	// The remaining findings are not violated anymore.
+	for _, id := range existing {
+		err = r.backend.PatchFinding(ctx, id, models.Finding{InvalidatedOn: &now})
+		if err != nil {
+			return fmt.Errorf("failed to invalidate finding %s: %w", id, err)
+		}
+	}
+
+	if created > 0 || len(existing) > 0 {
+		logger.Infof("Network policy findings are updated. Created=%d Invalidated=%d", created, len(existing))
+	}
+
+	return nil
+}
+
+func (r *Reconciler) getActiveFindings(ctx context.Context, targetID string) (map[findingkey.MisconfigurationKey]string, error) {
+	filter := fmt.Sprintf("findingInfo/objectType eq 'Misconfiguration' and findingInfo/scannerName eq '%s' and asset/id eq '%s' and invalidatedOn eq null",
+		ScannerName, targetID)
+	findings, err := r.backend.GetFindings(ctx, models.GetFindingsParams{
+		Filter: &filter,
+		Select: utils.PointerTo("id,findingInfo/scannerName,findingInfo/testID,findingInfo/message"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query for findings: %w", err)
+	}
+
+	existing := map[findingkey.MisconfigurationKey]string{}
+	if findings.Items == nil {
+		return existing, nil
+	}
+	for _, finding := range *findings.Items {
+		if finding.Id == nil || finding.FindingInfo == nil {
+			continue
+		}
+		info, err := finding.FindingInfo.AsMisconfigurationFindingInfo()
+		if err != nil {
+			return nil, fmt.Errorf("unable to get misconfiguration finding info: %w", err)
+		}
+		existing[findingkey.GenerateMisconfigurationKey(info)] = *finding.Id
+	}
+
+	return existing, nil
+}
+
+func hasRules(securityGroups []models.SecurityGroup) bool {
+	for _, sg := range securityGroups {
+		if sg.Rules != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -20,45 +20,99 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+
 	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/discovery"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/networkpolicy"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/registrydiscovery"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/reportschedulewatcher"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/scanconfigwatcher"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/scanresultprocessor"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/scanresultretention"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/scanresultwatcher"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/scanwatcher"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/targetsummarywatcher"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/vmimagewatcher"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider/aws"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider/azure"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider/kubernetes"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider/ssh"
 	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
+	"github.com/openclarity/vmclarity/shared/pkg/compliance"
 	"github.com/openclarity/vmclarity/shared/pkg/log"
 )
 
 type Orchestrator struct {
 	controllers []Controller
+	backend     *backendclient.BackendClient
 	cancelFunc  context.CancelFunc
+	metrics     prometheus.Collector
 
-	controllerStartupDelay time.Duration
+	controllerStartupDelay     time.Duration
+	maintenancePollingInterval time.Duration
 }
 
 // NewWithProvider returns an Orchestrator initialized using the p provider.Provider.
 // Use this method when Orchestrator needs to rely on custom provider.Provider implementation.
 // E.g. End-to-End testing.
 func NewWithProvider(config *Config, p provider.Provider, b *backendclient.BackendClient) (*Orchestrator, error) {
+	// The Targets throttled while waiting for a snapshot token are in progress,
+	// so their retries don't use up the attempts of the operation.
+	p = provider.WithThrottle(p, config.MaxConcurrentSnapshotsPerRegion, config.MaxConcurrentSnapshots)
+	retryingProvider := provider.WithRetry(p, provider.DefaultRetryPolicies)
+	p = retryingProvider
+
+	complianceMapper, err := compliance.NewMapper(config.ComplianceMappingsDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load compliance mappings: %w", err)
+	}
+
+	networkPolicy, err := networkpolicy.LoadPolicy(config.NetworkPolicyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load network policy: %w", err)
+	}
+
+	registryDiscoveryConfig, err := registrydiscovery.LoadConfig(config.RegistryDiscoveryFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load registry discovery config: %w", err)
+	}
+
+	// nolint:contextcheck
+	imageDiscoverer, err := registrydiscovery.New(context.Background(), registryDiscoveryConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize registry discovery: %w", err)
+	}
+
 	scanConfigWatcherConfig := config.ScanConfigWatcherConfig.WithBackendClient(b)
 	discoveryConfig := config.DiscoveryConfig.WithBackendClient(b).WithProviderClient(p)
-	scanWatcherConfig := config.ScanWatcherConfig.WithBackendClient(b).WithProviderClient(p)
+	scanWatcherConfig := config.ScanWatcherConfig.WithBackendClient(b).WithProviderClient(p).
+		WithNetworkPolicyReconciler(networkpolicy.NewReconciler(b, networkPolicy)).WithImageDiscoverer(imageDiscoverer)
 	scanResultWatcherConfig := config.ScanResultWatcherConfig.WithBackendClient(b).WithProviderClient(p)
-	scanResultProcessorConfig := config.ScanResultProcessorConfig.WithBackendClient(b)
+	scanResultProcessorConfig := config.ScanResultProcessorConfig.WithBackendClient(b).WithComplianceMapper(complianceMapper)
+	scanResultRetentionConfig := config.ScanResultRetentionConfig.WithBackendClient(b)
+	targetSummaryWatcherConfig := config.TargetSummaryWatcherConfig.WithBackendClient(b)
+	vmImageWatcherConfig := config.VMImageWatcherConfig.WithBackendClient(b)
+	reportScheduleWatcherConfig := config.ReportScheduleWatcherConfig.WithBackendClient(b)
 
 	return &Orchestrator{
 		controllers: []Controller{
 			scanconfigwatcher.New(scanConfigWatcherConfig),
 			discovery.New(discoveryConfig),
+			discovery.NewTargetDiscoverer(discoveryConfig),
 			scanresultprocessor.New(scanResultProcessorConfig),
 			scanwatcher.New(scanWatcherConfig),
 			scanresultwatcher.New(scanResultWatcherConfig),
+			scanresultretention.New(scanResultRetentionConfig),
+			targetsummarywatcher.New(targetSummaryWatcherConfig),
+			vmimagewatcher.New(vmImageWatcherConfig),
+			reportschedulewatcher.New(reportScheduleWatcherConfig),
 		},
-		controllerStartupDelay: config.ControllerStartupDelay,
+		backend:                    b,
+		metrics:                    newRetryCollector(retryingProvider),
+		controllerStartupDelay:     config.ControllerStartupDelay,
+		maintenancePollingInterval: config.MaintenancePollingInterval,
 	}, nil
 }
 
@@ -72,16 +126,72 @@ func New(ctx context.Context, config *Config, b *backendclient.BackendClient) (*
 	return NewWithProvider(config, p, b)
 }
 
-// Start makes the Orchestrator to start all Controller(s).
+// Metrics returns the collector of the metrics of the Orchestrator, e.g. the
+// retries of the operations of its provider.Provider.
+func (o *Orchestrator) Metrics() prometheus.Collector {
+	return o.metrics
+}
+
+// Start makes the Orchestrator to start all Controller(s). The Controller(s)
+// are stopped while the backend is in maintenance mode and started again once
+// it leaves the maintenance mode.
 func (o *Orchestrator) Start(ctx context.Context) {
 	log.GetLoggerFromContextOrDiscard(ctx).Info("Starting Orchestrator server")
 
 	ctx, cancel := context.WithCancel(ctx)
 	o.cancelFunc = cancel
 
+	go o.watchMaintenanceMode(ctx)
+}
+
+func (o *Orchestrator) watchMaintenanceMode(ctx context.Context) {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	var started bool
+	var stopControllers context.CancelFunc
+	for {
+		maintenanceMode, err := o.backend.GetMaintenanceMode(ctx)
+		if err != nil {
+			logger.Warnf("Failed to get maintenance mode: %v", err)
+		}
+		// The Controller(s) are kept in their state while the maintenance
+		// mode is unknown, unless they have never been started.
+		enabled := err == nil && maintenanceMode.Enabled
+		switch {
+		case err != nil && started:
+		case !enabled && stopControllers == nil:
+			if started {
+				logger.Info("Backend left maintenance mode, resuming Controllers")
+			}
+			var controllersCtx context.Context
+			controllersCtx, stopControllers = context.WithCancel(ctx)
+			o.startControllers(controllersCtx)
+			started = true
+		case enabled && stopControllers != nil:
+			logger.Info("Backend is in maintenance mode, pausing Controllers")
+			stopControllers()
+			stopControllers = nil
+		}
+
+		select {
+		case <-time.After(o.maintenancePollingInterval):
+		case <-ctx.Done():
+			if stopControllers != nil {
+				stopControllers()
+			}
+			return
+		}
+	}
+}
+
+func (o *Orchestrator) startControllers(ctx context.Context) {
 	for _, controller := range o.controllers {
 		controller.Start(ctx)
-		time.Sleep(o.controllerStartupDelay)
+		select {
+		case <-time.After(o.controllerStartupDelay):
+		case <-ctx.Done():
+			return
+		}
 	}
 }
 
@@ -102,6 +212,10 @@ func NewProvider(ctx context.Context, kind models.CloudProvider) (provider.Provi
 		return azure.New(ctx)
 	case models.AWS:
 		return aws.New(ctx)
+	case models.SSH:
+		return ssh.New(ctx)
+	case models.Kubernetes:
+		return kubernetes.New(ctx)
 	default:
 		return nil, fmt.Errorf("unsupported provider: %s", kind)
 	}
@@ -0,0 +1,109 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registrydiscovery
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+type RegistryKind string
+
+const (
+	RegistryKindECR       RegistryKind = "ecr"
+	RegistryKindACR       RegistryKind = "acr"
+	RegistryKindDockerHub RegistryKind = "dockerhub"
+)
+
+// Registry is a container registry the images are discovered in.
+type Registry struct {
+	Kind RegistryKind `yaml:"kind"`
+	// Host of the ACR registry, for example myregistry.azurecr.io. The host
+	// of an ECR registry is derived from the AWS credentials and Region.
+	Host string `yaml:"host"`
+	// Region of the ECR registry.
+	Region string `yaml:"region"`
+	// Namespace is the Docker Hub user or organization the repositories are
+	// listed for.
+	Namespace string `yaml:"namespace"`
+	// Repositories limits the discovery to the given repositories, all the
+	// repositories of the registry are discovered if empty.
+	Repositories []string `yaml:"repositories"`
+	// Username and Password authenticate to ACR and Docker Hub, anonymous
+	// access is used if not set. ECR uses the default AWS credentials.
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+}
+
+// Config holds the registries the container images to scan are discovered in.
+type Config struct {
+	Registries []Registry `yaml:"registries"`
+	// MaxTagsPerRepository limits the number of tags resolved in each
+	// repository, zero means unlimited.
+	MaxTagsPerRepository int `yaml:"maxTagsPerRepository"`
+}
+
+// LoadConfig returns the Config defined in the file at path, or an empty
+// Config if path is empty.
+func LoadConfig(path string) (*Config, error) {
+	config := &Config{}
+	if path == "" {
+		return config, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read registry discovery file: %w", err)
+	}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse registry discovery file: %w", err)
+	}
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid registry discovery file: %w", err)
+	}
+
+	return config, nil
+}
+
+func (c *Config) Validate() error {
+	if c.MaxTagsPerRepository < 0 {
+		return errors.New("maxTagsPerRepository must not be negative")
+	}
+
+	for i, r := range c.Registries {
+		switch r.Kind {
+		case RegistryKindECR:
+			if r.Region == "" {
+				return fmt.Errorf("registry %d: region is required for %s", i, r.Kind)
+			}
+		case RegistryKindACR:
+			if r.Host == "" {
+				return fmt.Errorf("registry %d: host is required for %s", i, r.Kind)
+			}
+		case RegistryKindDockerHub:
+			if r.Namespace == "" && len(r.Repositories) == 0 {
+				return fmt.Errorf("registry %d: namespace or repositories are required for %s", i, r.Kind)
+			}
+		default:
+			return fmt.Errorf("registry %d: unsupported kind %q", i, r.Kind)
+		}
+	}
+
+	return nil
+}
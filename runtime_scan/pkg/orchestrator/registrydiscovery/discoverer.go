@@ -0,0 +1,173 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registrydiscovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// catalog lists the repositories of a registry.
+type catalog interface {
+	// Registry returns the registry the repositories are served from.
+	Registry() name.Registry
+	// Authenticator returns the credentials to access the registry with.
+	Authenticator(ctx context.Context) (authn.Authenticator, error)
+	// Repositories returns the names of the repositories in the registry.
+	Repositories(ctx context.Context, auth authn.Authenticator) ([]string, error)
+}
+
+// Discoverer discovers the container images in the configured registries.
+type Discoverer struct {
+	catalogs             []catalog
+	maxTagsPerRepository int
+}
+
+// New returns a Discoverer for the registries in config, or nil if there are
+// none configured.
+func New(ctx context.Context, config *Config) (*Discoverer, error) {
+	if config == nil || len(config.Registries) == 0 {
+		return nil, nil // nolint:nilnil
+	}
+
+	catalogs := make([]catalog, 0, len(config.Registries))
+	for _, r := range config.Registries {
+		var c catalog
+		var err error
+		switch r.Kind {
+		case RegistryKindECR:
+			c, err = newECRCatalog(ctx, r)
+		case RegistryKindACR:
+			c, err = newV2Catalog(r)
+		case RegistryKindDockerHub:
+			c, err = newDockerHubCatalog(r)
+		default:
+			err = fmt.Errorf("unsupported registry kind %q", r.Kind)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to create %s registry client: %w", r.Kind, err)
+		}
+		catalogs = append(catalogs, c)
+	}
+
+	return &Discoverer{
+		catalogs:             catalogs,
+		maxTagsPerRepository: config.MaxTagsPerRepository,
+	}, nil
+}
+
+// DiscoverImages returns a ContainerImageInfo TargetType for each image found
+// in the registries. The tags pointing to the same digest are reported as a
+// single image. The images discovered are returned even if some of the
+// registries failed.
+func (d *Discoverer) DiscoverImages(ctx context.Context) ([]models.AssetType, error) {
+	var targets []models.AssetType
+	var errs []error
+	for _, c := range d.catalogs {
+		t, err := d.discoverRegistry(ctx, c)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to discover images in registry %s: %w", c.Registry(), err))
+		}
+		targets = append(targets, t...)
+	}
+
+	return targets, errors.Join(errs...)
+}
+
+func (d *Discoverer) discoverRegistry(ctx context.Context, c catalog) ([]models.AssetType, error) {
+	logger := log.GetLoggerFromContextOrDiscard(ctx).WithField("Registry", c.Registry().String())
+
+	auth, err := c.Authenticator(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get registry credentials: %w", err)
+	}
+
+	repositories, err := c.Repositories(ctx, auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repositories: %w", err)
+	}
+
+	opts := []remote.Option{remote.WithAuth(auth), remote.WithContext(ctx)}
+
+	var targets []models.AssetType
+	for _, repositoryName := range repositories {
+		repository, err := name.NewRepository(c.Registry().RegistryStr() + "/" + repositoryName)
+		if err != nil {
+			logger.Warnf("Invalid repository name %s: %v", repositoryName, err)
+			continue
+		}
+		images, err := d.discoverRepository(repository, opts)
+		if err != nil {
+			// A single repository, for example with restricted access, must not fail the whole registry.
+			logger.Warnf("Failed to discover images in repository %s: %v", repositoryName, err)
+			continue
+		}
+		targets = append(targets, images...)
+	}
+
+	return targets, nil
+}
+
+func (d *Discoverer) discoverRepository(repository name.Repository, opts []remote.Option) ([]models.AssetType, error) {
+	tags, err := remote.List(repository, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	if d.maxTagsPerRepository > 0 && len(tags) > d.maxTagsPerRepository {
+		tags = tags[:d.maxTagsPerRepository]
+	}
+
+	var digests []string
+	tagsByDigest := make(map[string][]string)
+	for _, tag := range tags {
+		desc, err := remote.Head(repository.Tag(tag), opts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get digest of tag %s: %w", tag, err)
+		}
+		digest := desc.Digest.String()
+		if _, ok := tagsByDigest[digest]; !ok {
+			digests = append(digests, digest)
+		}
+		tagsByDigest[digest] = append(tagsByDigest[digest], tag)
+	}
+
+	targets := make([]models.AssetType, 0, len(digests))
+	for _, digest := range digests {
+		var targetType models.AssetType
+		err := targetType.FromContainerImageInfo(models.ContainerImageInfo{
+			ImageID:    digest,
+			Registry:   utils.PointerTo(repository.RegistryStr()),
+			Repository: repository.RepositoryStr(),
+			Tags:       utils.PointerTo(tagsByDigest[digest]),
+			Location:   repository.Digest(digest).String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create target type: %w", err)
+		}
+		targets = append(targets, targetType)
+	}
+
+	return targets, nil
+}
@@ -0,0 +1,180 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registrydiscovery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+
+	"github.com/openclarity/vmclarity/api/models"
+)
+
+func pushImage(t *testing.T, host, repository string, tags ...string) string {
+	t.Helper()
+
+	img, err := random.Image(64, 1)
+	if err != nil {
+		t.Fatalf("failed to create image: %v", err)
+	}
+	digest, err := img.Digest()
+	if err != nil {
+		t.Fatalf("failed to get image digest: %v", err)
+	}
+	for _, tag := range tags {
+		ref, err := name.NewTag(fmt.Sprintf("%s/%s:%s", host, repository, tag))
+		if err != nil {
+			t.Fatalf("failed to create tag: %v", err)
+		}
+		if err := remote.Write(ref, img); err != nil {
+			t.Fatalf("failed to push image: %v", err)
+		}
+	}
+
+	return digest.String()
+}
+
+func TestDiscoverImages(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	defer server.Close()
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	alpineDigest := pushImage(t, host, "library/alpine", "3.17", "latest")
+	nginxDigest := pushImage(t, host, "nginx", "1.25")
+
+	tests := []struct {
+		name     string
+		registry Registry
+		maxTags  int
+		want     []models.ContainerImageInfo
+	}{
+		{
+			name:     "all repositories",
+			registry: Registry{Kind: RegistryKindACR, Host: host},
+			want: []models.ContainerImageInfo{
+				{
+					ObjectType: "ContainerImageInfo",
+					ImageID:    alpineDigest,
+					Registry:   &host,
+					Repository: "library/alpine",
+					Tags:       &[]string{"3.17", "latest"},
+					Location:   host + "/library/alpine@" + alpineDigest,
+				},
+				{
+					ObjectType: "ContainerImageInfo",
+					ImageID:    nginxDigest,
+					Registry:   &host,
+					Repository: "nginx",
+					Tags:       &[]string{"1.25"},
+					Location:   host + "/nginx@" + nginxDigest,
+				},
+			},
+		},
+		{
+			name:     "configured repositories with tags limit",
+			registry: Registry{Kind: RegistryKindACR, Host: host, Repositories: []string{"library/alpine"}},
+			maxTags:  1,
+			want: []models.ContainerImageInfo{
+				{
+					ObjectType: "ContainerImageInfo",
+					ImageID:    alpineDigest,
+					Registry:   &host,
+					Repository: "library/alpine",
+					Tags:       &[]string{"3.17"},
+					Location:   host + "/library/alpine@" + alpineDigest,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := New(context.Background(), &Config{
+				Registries:           []Registry{tt.registry},
+				MaxTagsPerRepository: tt.maxTags,
+			})
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+
+			targets, err := d.DiscoverImages(context.Background())
+			if err != nil {
+				t.Fatalf("DiscoverImages() error = %v", err)
+			}
+
+			got := make([]models.ContainerImageInfo, 0, len(targets))
+			for _, target := range targets {
+				info, err := target.AsContainerImageInfo()
+				if err != nil {
+					t.Fatalf("failed to get ContainerImageInfo: %v", err)
+				}
+				got = append(got, info)
+			}
+			// The in-memory registry does not list the repositories in a stable order.
+			sortByRepository := cmpopts.SortSlices(func(a, b models.ContainerImageInfo) bool { return a.Repository < b.Repository })
+			if diff := cmp.Diff(tt.want, got, sortByRepository); diff != "" {
+				t.Errorf("DiscoverImages() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDockerHubRepositories(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/v2/users/login":
+			_ = json.NewEncoder(w).Encode(map[string]string{"token": "secret"})
+		case r.Header.Get("Authorization") != "Bearer secret":
+			w.WriteHeader(http.StatusUnauthorized)
+		case r.URL.Query().Get("page") == "2":
+			_, _ = fmt.Fprint(w, `{"results":[{"name":"worker"}]}`)
+		default:
+			_, _ = fmt.Fprintf(w, `{"next":"%s/v2/repositories/myorg/?page=2","results":[{"name":"api"},{"name":"ui"}]}`, server.URL)
+		}
+	}))
+	defer server.Close()
+
+	c, err := newDockerHubCatalog(Registry{
+		Kind:      RegistryKindDockerHub,
+		Namespace: "myorg",
+		Username:  "user",
+		Password:  "password",
+	})
+	if err != nil {
+		t.Fatalf("newDockerHubCatalog() error = %v", err)
+	}
+	c.apiAddress = server.URL
+
+	got, err := c.Repositories(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Repositories() error = %v", err)
+	}
+	want := []string{"myorg/api", "myorg/ui", "myorg/worker"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Repositories() mismatch (-want +got):\n%s", diff)
+	}
+}
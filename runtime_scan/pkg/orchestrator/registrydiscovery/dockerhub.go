@@ -0,0 +1,157 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registrydiscovery
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+)
+
+const (
+	dockerHubAPIAddress = "https://hub.docker.com"
+	dockerHubPageSize   = 100
+)
+
+// dockerHubCatalog lists the repositories of a Docker Hub namespace using the
+// Docker Hub API, as Docker Hub doesn't implement the catalog endpoint.
+type dockerHubCatalog struct {
+	apiAddress   string
+	httpClient   *http.Client
+	namespace    string
+	username     string
+	password     string
+	repositories []string
+}
+
+func newDockerHubCatalog(r Registry) (*dockerHubCatalog, error) {
+	return &dockerHubCatalog{
+		apiAddress:   dockerHubAPIAddress,
+		httpClient:   http.DefaultClient,
+		namespace:    r.Namespace,
+		username:     r.Username,
+		password:     r.Password,
+		repositories: r.Repositories,
+	}, nil
+}
+
+func (c *dockerHubCatalog) Registry() name.Registry {
+	// nolint:errcheck
+	registry, _ := name.NewRegistry(name.DefaultRegistry)
+	return registry
+}
+
+func (c *dockerHubCatalog) Authenticator(_ context.Context) (authn.Authenticator, error) {
+	return newBasicAuthenticator(c.username, c.password), nil
+}
+
+type dockerHubRepositoriesPage struct {
+	Next    string `json:"next"`
+	Results []struct {
+		Name string `json:"name"`
+	} `json:"results"`
+}
+
+func (c *dockerHubCatalog) Repositories(ctx context.Context, _ authn.Authenticator) ([]string, error) {
+	if len(c.repositories) > 0 {
+		return c.repositories, nil
+	}
+
+	var token string
+	if c.username != "" {
+		var err error
+		if token, err = c.login(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	var repositories []string
+	next := fmt.Sprintf("%s/v2/repositories/%s/?page_size=%d", c.apiAddress, url.PathEscape(c.namespace), dockerHubPageSize)
+	for next != "" {
+		var page dockerHubRepositoriesPage
+		if err := c.get(ctx, next, token, &page); err != nil {
+			return nil, fmt.Errorf("failed to list repositories: %w", err)
+		}
+		for _, r := range page.Results {
+			repositories = append(repositories, c.namespace+"/"+r.Name)
+		}
+		next = page.Next
+	}
+
+	return repositories, nil
+}
+
+// login returns a Docker Hub API token which grants access to the private
+// repositories of the user.
+func (c *dockerHubCatalog) login(ctx context.Context) (string, error) {
+	body, err := json.Marshal(map[string]string{
+		"username": c.username,
+		"password": c.password,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal login request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.apiAddress+"/v2/users/login", bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to create login request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	var out struct {
+		Token string `json:"token"`
+	}
+	if err := c.do(req, &out); err != nil {
+		return "", fmt.Errorf("failed to login to Docker Hub: %w", err)
+	}
+
+	return out.Token, nil
+}
+
+func (c *dockerHubCatalog) get(ctx context.Context, address, token string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, address, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	return c.do(req, out)
+}
+
+func (c *dockerHubCatalog) do(req *http.Request, out any) error {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registrydiscovery
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+)
+
+// ecrCatalog lists the repositories of the private ECR registry of the AWS
+// account the default credentials belong to.
+type ecrCatalog struct {
+	client       *ecr.Client
+	registry     name.Registry
+	repositories []string
+}
+
+func newECRCatalog(ctx context.Context, r Registry) (*ecrCatalog, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(r.Region))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load aws config: %w", err)
+	}
+
+	c := &ecrCatalog{
+		client:       ecr.NewFromConfig(cfg),
+		repositories: r.Repositories,
+	}
+
+	// The registry host contains the account ID, which is only known once
+	// an authorization token is issued.
+	out, err := c.client.GetAuthorizationToken(ctx, &ecr.GetAuthorizationTokenInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get authorization token: %w", err)
+	}
+	if len(out.AuthorizationData) < 1 || out.AuthorizationData[0].ProxyEndpoint == nil {
+		return nil, errors.New("failed to get authorization token: no authorization data in response")
+	}
+	host := strings.TrimPrefix(*out.AuthorizationData[0].ProxyEndpoint, "https://")
+	c.registry, err = name.NewRegistry(host)
+	if err != nil {
+		return nil, fmt.Errorf("invalid registry host %s: %w", host, err)
+	}
+
+	return c, nil
+}
+
+func (c *ecrCatalog) Registry() name.Registry {
+	return c.registry
+}
+
+// Authenticator returns the credentials from a new authorization token as
+// the tokens expire after 12 hours.
+func (c *ecrCatalog) Authenticator(ctx context.Context) (authn.Authenticator, error) {
+	out, err := c.client.GetAuthorizationToken(ctx, &ecr.GetAuthorizationTokenInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get authorization token: %w", err)
+	}
+	if len(out.AuthorizationData) < 1 || out.AuthorizationData[0].AuthorizationToken == nil {
+		return nil, errors.New("failed to get authorization token: no authorization data in response")
+	}
+
+	token, err := base64.StdEncoding.DecodeString(*out.AuthorizationData[0].AuthorizationToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode authorization token: %w", err)
+	}
+	username, password, ok := strings.Cut(string(token), ":")
+	if !ok {
+		return nil, errors.New("failed to decode authorization token: invalid format")
+	}
+
+	return &authn.Basic{
+		Username: username,
+		Password: password,
+	}, nil
+}
+
+func (c *ecrCatalog) Repositories(ctx context.Context, _ authn.Authenticator) ([]string, error) {
+	if len(c.repositories) > 0 {
+		return c.repositories, nil
+	}
+
+	var repositories []string
+	paginator := ecr.NewDescribeRepositoriesPaginator(c.client, &ecr.DescribeRepositoriesInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe repositories: %w", err)
+		}
+		for _, repository := range page.Repositories {
+			repositories = append(repositories, aws.ToString(repository.RepositoryName))
+		}
+	}
+
+	return repositories, nil
+}
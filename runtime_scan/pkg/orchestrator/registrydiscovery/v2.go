@@ -0,0 +1,76 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registrydiscovery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+// v2Catalog lists the repositories of a registry implementing the catalog
+// endpoint of the Docker Registry HTTP API V2, like ACR.
+type v2Catalog struct {
+	registry     name.Registry
+	auth         authn.Authenticator
+	repositories []string
+}
+
+func newV2Catalog(r Registry) (*v2Catalog, error) {
+	registry, err := name.NewRegistry(r.Host)
+	if err != nil {
+		return nil, fmt.Errorf("invalid registry host %s: %w", r.Host, err)
+	}
+
+	return &v2Catalog{
+		registry:     registry,
+		auth:         newBasicAuthenticator(r.Username, r.Password),
+		repositories: r.Repositories,
+	}, nil
+}
+
+func (c *v2Catalog) Registry() name.Registry {
+	return c.registry
+}
+
+func (c *v2Catalog) Authenticator(_ context.Context) (authn.Authenticator, error) {
+	return c.auth, nil
+}
+
+func (c *v2Catalog) Repositories(ctx context.Context, auth authn.Authenticator) ([]string, error) {
+	if len(c.repositories) > 0 {
+		return c.repositories, nil
+	}
+
+	// nolint:wrapcheck
+	return remote.Catalog(ctx, c.registry, remote.WithAuth(auth))
+}
+
+// newBasicAuthenticator returns an authenticator with the given credentials,
+// or an anonymous one if they are empty.
+func newBasicAuthenticator(username, password string) authn.Authenticator {
+	if username == "" && password == "" {
+		return authn.Anonymous
+	}
+
+	return &authn.Basic{
+		Username: username,
+		Password: password,
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reportschedulewatcher
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/report"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// DefaultReportPeriod is the period reported on by the first delivery of a
+// schedule, later deliveries report on the period since the previous one.
+const DefaultReportPeriod = 7 * 24 * time.Hour
+
+var findingTypes = []struct {
+	name       string
+	objectType string
+}{
+	{"Vulnerabilities", "Vulnerability"},
+	{"Exploits", "Exploit"},
+	{"Malware", "Malware"},
+	{"Misconfigurations", "Misconfiguration"},
+	{"Rootkits", "Rootkit"},
+	{"Secrets", "Secret"},
+	{"Certificates", "Certificate"},
+	{"Compliance checks", "ComplianceCheck"},
+	{"Plugin findings", "PluginFinding"},
+	{"Packages", "Package"},
+}
+
+var vulnerabilitySeverities = []struct {
+	name     string
+	severity models.VulnerabilitySeverity
+}{
+	{"Critical", models.CRITICAL},
+	{"High", models.HIGH},
+	{"Medium", models.MEDIUM},
+	{"Low", models.LOW},
+	{"Negligible", models.NEGLIGIBLE},
+}
+
+// reportPeriodStart returns the start of the period the next delivery of the
+// reportSchedule reports on.
+func reportPeriodStart(reportSchedule *models.ReportSchedule, now time.Time) time.Time {
+	if reportSchedule.LastDelivery != nil && reportSchedule.LastDelivery.State == models.ReportDeliveryStateDelivered {
+		return reportSchedule.LastDelivery.Time
+	}
+	return now.Add(-DefaultReportPeriod)
+}
+
+// newExecutiveSummary collects the ExecutiveSummary of the period from since
+// to now, with the times shown in the loc location.
+func (w *Watcher) newExecutiveSummary(ctx context.Context, title string, since, now time.Time, loc *time.Location) (report.ExecutiveSummary, error) {
+	summary := report.ExecutiveSummary{
+		Title:       title,
+		PeriodStart: since.In(loc),
+		GeneratedAt: now.In(loc),
+	}
+
+	targets, err := w.backend.GetAssets(ctx, models.GetAssetsParams{
+		Count:  utils.PointerTo(true),
+		Top:    utils.PointerTo(1),
+		Select: utils.PointerTo("id"),
+	})
+	if err != nil {
+		return summary, fmt.Errorf("failed to count targets: %w", err)
+	}
+	summary.Targets = utils.ValueOrZero(targets.Count)
+
+	scans, err := w.backend.GetScans(ctx, models.GetScansParams{
+		Count: utils.PointerTo(true),
+		Filter: utils.PointerTo(fmt.Sprintf("state eq '%s' and endTime ge %s",
+			models.ScanStateDone, since.Format(time.RFC3339))),
+		Top:    utils.PointerTo(1),
+		Select: utils.PointerTo("id"),
+	})
+	if err != nil {
+		return summary, fmt.Errorf("failed to count completed scans: %w", err)
+	}
+	summary.CompletedScans = utils.ValueOrZero(scans.Count)
+
+	summary.NewFindings, err = w.countFindings(ctx, fmt.Sprintf("foundOn ge %s", since.Format(time.RFC3339)))
+	if err != nil {
+		return summary, err
+	}
+	summary.ResolvedFindings, err = w.countFindings(ctx, fmt.Sprintf("invalidatedOn ge %s", since.Format(time.RFC3339)))
+	if err != nil {
+		return summary, err
+	}
+
+	for _, t := range findingTypes {
+		count, err := w.countFindings(ctx, fmt.Sprintf(
+			"findingInfo/objectType eq '%s' and invalidatedOn eq null and suppression eq null", t.objectType))
+		if err != nil {
+			return summary, err
+		}
+		summary.ActiveFindings = append(summary.ActiveFindings, report.Count{Name: t.name, Count: count})
+	}
+
+	for _, s := range vulnerabilitySeverities {
+		count, err := w.countFindings(ctx, fmt.Sprintf(
+			"findingInfo/objectType eq 'Vulnerability' and invalidatedOn eq null and suppression eq null and findingInfo/severity eq '%s'", s.severity))
+		if err != nil {
+			return summary, err
+		}
+		summary.ActiveVulnerabilities = append(summary.ActiveVulnerabilities, report.Count{Name: s.name, Count: count})
+	}
+
+	return summary, nil
+}
+
+func (w *Watcher) countFindings(ctx context.Context, filter string) (int, error) {
+	findings, err := w.backend.GetFindings(ctx, models.GetFindingsParams{
+		Count:  utils.PointerTo(true),
+		Filter: &filter,
+
+		// select the smallest amount of data to return in items, we
+		// only care about the count.
+		Top:    utils.PointerTo(1),
+		Select: utils.PointerTo("id"),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count findings: %w", err)
+	}
+	return utils.ValueOrZero(findings.Count), nil
+}
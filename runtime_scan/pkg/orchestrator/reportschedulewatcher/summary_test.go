@@ -0,0 +1,61 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reportschedulewatcher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+)
+
+func Test_reportPeriodStart(t *testing.T) {
+	now := time.Date(2023, 3, 27, 8, 0, 0, 0, time.UTC)
+	lastDelivery := time.Date(2023, 3, 20, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		reportSchedule *models.ReportSchedule
+		want           time.Time
+	}{
+		{
+			name:           "first delivery",
+			reportSchedule: &models.ReportSchedule{},
+			want:           now.Add(-DefaultReportPeriod),
+		},
+		{
+			name: "since previous delivery",
+			reportSchedule: &models.ReportSchedule{
+				LastDelivery: &models.ReportDelivery{Time: lastDelivery, State: models.ReportDeliveryStateDelivered},
+			},
+			want: lastDelivery,
+		},
+		{
+			name: "previous delivery failed",
+			reportSchedule: &models.ReportSchedule{
+				LastDelivery: &models.ReportDelivery{Time: lastDelivery, State: models.ReportDeliveryStateUndelivered},
+			},
+			want: now.Add(-DefaultReportPeriod),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := reportPeriodStart(tt.reportSchedule, now); !got.Equal(tt.want) {
+				t.Errorf("reportPeriodStart() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reportschedulewatcher
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/common"
+	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/objectstore"
+	"github.com/openclarity/vmclarity/shared/pkg/report"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// Watcher delivers the reports of the ReportSchedules which are due by email
+// and records the status of the delivery and the time of the next one.
+type Watcher struct {
+	backend          *backendclient.BackendClient
+	pollPeriod       time.Duration
+	reconcileTimeout time.Duration
+	smtp             report.SMTPConfig
+	objectStore      objectstore.Store
+}
+
+func New(c Config) *Watcher {
+	return &Watcher{
+		backend:          c.Backend,
+		pollPeriod:       c.PollPeriod,
+		reconcileTimeout: c.ReconcileTimeout,
+		smtp:             c.SMTP,
+		objectStore:      c.ObjectStore,
+	}
+}
+
+type ReportScheduleReconcileEvent struct {
+	ReportScheduleID models.ReportScheduleID
+}
+
+func (e ReportScheduleReconcileEvent) ToFields() logrus.Fields {
+	return logrus.Fields{
+		"ReportScheduleID": e.ReportScheduleID,
+	}
+}
+
+func (e ReportScheduleReconcileEvent) String() string {
+	return fmt.Sprintf("ReportScheduleID=%s", e.ReportScheduleID)
+}
+
+func (e ReportScheduleReconcileEvent) Hash() string {
+	return e.ReportScheduleID
+}
+
+func (w *Watcher) GetItems(ctx context.Context) ([]ReportScheduleReconcileEvent, error) {
+	reportSchedules, err := w.backend.GetReportSchedules(ctx, models.GetReportSchedulesParams{
+		Filter: utils.PointerTo(fmt.Sprintf("(disabled eq null or disabled eq false) and nextDeliveryTime le %s",
+			time.Now().Format(time.RFC3339))),
+		Select: utils.PointerTo("id"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due report schedules from API: %w", err)
+	}
+	if reportSchedules.Items == nil {
+		return []ReportScheduleReconcileEvent{}, nil
+	}
+
+	items := make([]ReportScheduleReconcileEvent, 0, len(*reportSchedules.Items))
+	for _, reportSchedule := range *reportSchedules.Items {
+		if reportSchedule.Id == nil {
+			continue
+		}
+		items = append(items, ReportScheduleReconcileEvent{ReportScheduleID: *reportSchedule.Id})
+	}
+
+	return items, nil
+}
+
+func (w *Watcher) Reconcile(ctx context.Context, event ReportScheduleReconcileEvent) error {
+	logger := log.GetLoggerFromContextOrDiscard(ctx).WithFields(event.ToFields())
+
+	reportSchedule, err := w.backend.GetReportSchedule(ctx, event.ReportScheduleID, models.GetReportSchedulesReportScheduleIDParams{})
+	if err != nil {
+		return fmt.Errorf("failed to get report schedule %s: %w", event.ReportScheduleID, err)
+	}
+
+	now := time.Now()
+	if utils.ValueOrZero(reportSchedule.Disabled) || reportSchedule.CronLine == nil ||
+		(reportSchedule.NextDeliveryTime != nil && reportSchedule.NextDeliveryTime.After(now)) {
+		return nil
+	}
+
+	timezone := utils.ValueOrZero(reportSchedule.Timezone)
+	loc, err := report.LoadTimezone(timezone)
+	if err != nil {
+		return fmt.Errorf("failed to load time zone of report schedule %s: %w", event.ReportScheduleID, err)
+	}
+
+	// A delivery missed while the orchestrator wasn't running is made once
+	// and the schedule continues from now.
+	nextDeliveryTime, err := report.NextDeliveryTime(*reportSchedule.CronLine, timezone, now)
+	if err != nil {
+		return fmt.Errorf("failed to determine next delivery of report schedule %s: %w", event.ReportScheduleID, err)
+	}
+
+	summary, err := w.newExecutiveSummary(ctx, utils.ValueOrZero(reportSchedule.Name), reportPeriodStart(reportSchedule, now), now, loc)
+	if err != nil {
+		return fmt.Errorf("failed to generate report of report schedule %s: %w", event.ReportScheduleID, err)
+	}
+
+	pdf := summary.PDF()
+
+	delivery := models.ReportDelivery{
+		Time:  now,
+		State: models.ReportDeliveryStateDelivered,
+	}
+	// The report is stored even if it can't be delivered, so that it can
+	// still be downloaded.
+	if w.objectStore != nil {
+		key, err := w.objectStore.Put(ctx, pdf)
+		if err != nil {
+			return fmt.Errorf("failed to store report of report schedule %s: %w", event.ReportScheduleID, err)
+		}
+		delivery.ReportKey = &key
+	}
+	if err := w.smtp.Send(newSummaryMessage(w.smtp.From, *reportSchedule.Recipients, summary, pdf)); err != nil {
+		logger.Warnf("Failed to deliver report: %v", err)
+		delivery.State = models.ReportDeliveryStateUndelivered
+		delivery.Message = utils.PointerTo(err.Error())
+	} else {
+		logger.Infof("Delivered report to %d recipient(s)", len(*reportSchedule.Recipients))
+	}
+
+	err = w.backend.PatchReportSchedule(ctx, event.ReportScheduleID, &models.ReportSchedule{
+		LastDelivery:     &delivery,
+		NextDeliveryTime: &nextDeliveryTime,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to patch report schedule %s: %w", event.ReportScheduleID, err)
+	}
+
+	return nil
+}
+
+func newSummaryMessage(from string, to []string, summary report.ExecutiveSummary, pdf []byte) report.Message {
+	return report.Message{
+		From:    from,
+		To:      to,
+		Subject: fmt.Sprintf("%s - VMClarity executive summary %s", summary.Title, summary.GeneratedAt.Format("2006-01-02")),
+		Date:    summary.GeneratedAt,
+		Body: fmt.Sprintf("The VMClarity executive summary for %s - %s is attached.\r\n\r\n"+
+			"Targets: %d\r\nCompleted scans: %d\r\nNew findings: %d\r\nResolved findings: %d\r\n",
+			summary.PeriodStart.Format(time.RFC1123), summary.GeneratedAt.Format(time.RFC1123),
+			summary.Targets, summary.CompletedScans, summary.NewFindings, summary.ResolvedFindings),
+		Attachment: &report.Attachment{
+			Name:        summary.FileName(),
+			ContentType: "application/pdf",
+			Data:        pdf,
+		},
+	}
+}
+
+func (w *Watcher) Start(ctx context.Context) {
+	logger := log.GetLoggerFromContextOrDiscard(ctx).WithField("controller", "ReportScheduleWatcher")
+	ctx = log.SetLoggerForContext(ctx, logger)
+
+	queue := common.NewQueue[ReportScheduleReconcileEvent]()
+
+	poller := common.Poller[ReportScheduleReconcileEvent]{
+		PollPeriod: w.pollPeriod,
+		GetItems:   w.GetItems,
+		Queue:      queue,
+	}
+	poller.Start(ctx)
+
+	reconciler := common.Reconciler[ReportScheduleReconcileEvent]{
+		ReconcileFunction: w.Reconcile,
+		ReconcileTimeout:  w.reconcileTimeout,
+		Queue:             queue,
+	}
+	reconciler.Start(ctx)
+}
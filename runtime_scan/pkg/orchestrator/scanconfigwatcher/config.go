@@ -18,6 +18,7 @@ package scanconfigwatcher
 import (
 	"time"
 
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/common"
 	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
 )
 
@@ -30,6 +31,9 @@ type Config struct {
 	Backend          *backendclient.BackendClient
 	PollPeriod       time.Duration
 	ReconcileTimeout time.Duration
+
+	// Clock is the source of time of the Watcher, RealClock if nil.
+	Clock common.Clock
 }
 
 func (c Config) WithBackendClient(b *backendclient.BackendClient) Config {
@@ -37,6 +41,11 @@ func (c Config) WithBackendClient(b *backendclient.BackendClient) Config {
 	return c
 }
 
+func (c Config) WithClock(clock common.Clock) Config {
+	c.Clock = clock
+	return c
+}
+
 func (c Config) WithReconcileTimeout(t time.Duration) Config {
 	c.ReconcileTimeout = t
 	return c
@@ -20,8 +20,10 @@ import (
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
-func newScanFromScanConfig(scanConfig *models.ScanConfig) *models.Scan {
-	return &models.Scan{
+// newScanFromScanConfig returns the scan to start from the scan config, with
+// the settings of its template, if it has one, resolved into the snapshot.
+func newScanFromScanConfig(scanConfig *models.ScanConfig, template *models.ScanConfigTemplate) *models.Scan {
+	scan := &models.Scan{
 		ScanConfig: &models.ScanConfigRelationship{
 			Id: *scanConfig.Id,
 		},
@@ -32,15 +34,27 @@ func newScanFromScanConfig(scanConfig *models.ScanConfig) *models.Scan {
 			Scheduled:           scanConfig.Scheduled,
 			Scope:               scanConfig.Scope,
 			TimeoutSeconds:      scanConfig.TimeoutSeconds,
+			VolumeSizeGuardrail: scanConfig.VolumeSizeGuardrail,
+			DeltaScanEnabled:    scanConfig.DeltaScanEnabled,
+			OverlapPolicy:       scanConfig.OverlapPolicy,
+			Priority:            scanConfig.Priority,
+			AssetGroupIDs:       scanConfig.AssetGroupIDs,
+			Sampling:            scanConfig.Sampling,
+
+			ScannerInstanceCreationConfig: scanConfig.ScannerInstanceCreationConfig,
+			ScannerPlacement:              scanConfig.ScannerPlacement,
 		},
 		State: utils.PointerTo(models.ScanStatePending),
 		Summary: &models.ScanSummary{
 			JobsCompleted:          utils.PointerTo(0),
 			JobsLeftToRun:          utils.PointerTo(0),
+			TotalCertificates:      utils.PointerTo(0),
+			TotalComplianceChecks:  utils.PointerTo(0),
 			TotalExploits:          utils.PointerTo(0),
 			TotalMalware:           utils.PointerTo(0),
 			TotalMisconfigurations: utils.PointerTo(0),
 			TotalPackages:          utils.PointerTo(0),
+			TotalPluginFindings:    utils.PointerTo(0),
 			TotalRootkits:          utils.PointerTo(0),
 			TotalSecrets:           utils.PointerTo(0),
 			TotalVulnerabilities: &models.VulnerabilityScanSummary{
@@ -52,4 +66,10 @@ func newScanFromScanConfig(scanConfig *models.ScanConfig) *models.Scan {
 			},
 		},
 	}
+
+	if template != nil {
+		template.ApplyTo(scan.ScanConfigSnapshot)
+	}
+
+	return scan
 }
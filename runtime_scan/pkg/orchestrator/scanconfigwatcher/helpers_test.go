@@ -0,0 +1,151 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanconfigwatcher
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestNewScanFromScanConfig(t *testing.T) {
+	scope := &models.ScanScopeType{}
+	template := &models.ScanConfigTemplate{
+		Id:   utils.PointerTo("template-1"),
+		Name: utils.PointerTo("PCI baseline"),
+		ScanFamiliesConfig: &models.ScanFamiliesConfig{
+			Malware: &models.MalwareConfig{
+				Enabled: utils.PointerTo(true),
+			},
+			Vulnerabilities: &models.VulnerabilitiesConfig{
+				Enabled: utils.PointerTo(true),
+			},
+		},
+		TimeoutSeconds:      utils.PointerTo(3600),
+		MaxParallelScanners: utils.PointerTo(5),
+		ScannerPlacement: &models.ScannerPlacement{
+			AvoidTargetSubnet: utils.PointerTo(true),
+		},
+	}
+
+	tests := []struct {
+		Name       string
+		ScanConfig *models.ScanConfig
+		Template   *models.ScanConfigTemplate
+
+		ExpectedSnapshot *models.ScanConfigSnapshot
+	}{
+		{
+			Name: "Without template",
+			ScanConfig: &models.ScanConfig{
+				Id:   utils.PointerTo("config-1"),
+				Name: utils.PointerTo("nightly"),
+				ScanFamiliesConfig: &models.ScanFamiliesConfig{
+					Sbom: &models.SBOMConfig{
+						Enabled: utils.PointerTo(true),
+					},
+				},
+				Scope:    scope,
+				Priority: utils.PointerTo(10),
+			},
+			ExpectedSnapshot: &models.ScanConfigSnapshot{
+				Name: utils.PointerTo("nightly"),
+				ScanFamiliesConfig: &models.ScanFamiliesConfig{
+					Sbom: &models.SBOMConfig{
+						Enabled: utils.PointerTo(true),
+					},
+				},
+				Scope:    scope,
+				Priority: utils.PointerTo(10),
+			},
+		},
+		{
+			Name: "Template only",
+			ScanConfig: &models.ScanConfig{
+				Id:    utils.PointerTo("config-1"),
+				Name:  utils.PointerTo("nightly"),
+				Scope: scope,
+			},
+			Template: template,
+			ExpectedSnapshot: &models.ScanConfigSnapshot{
+				Name:                utils.PointerTo("nightly"),
+				ScanFamiliesConfig:  template.ScanFamiliesConfig,
+				Scope:               scope,
+				TimeoutSeconds:      utils.PointerTo(3600),
+				MaxParallelScanners: utils.PointerTo(5),
+				ScannerPlacement:    template.ScannerPlacement,
+				ScanConfigTemplate: &models.ScanConfigTemplateRelationship{
+					Id: "template-1",
+				},
+			},
+		},
+		{
+			Name: "Scan config overrides template",
+			ScanConfig: &models.ScanConfig{
+				Id:   utils.PointerTo("config-1"),
+				Name: utils.PointerTo("nightly"),
+				ScanFamiliesConfig: &models.ScanFamiliesConfig{
+					Malware: &models.MalwareConfig{
+						Enabled: utils.PointerTo(false),
+					},
+				},
+				Scope:          scope,
+				TimeoutSeconds: utils.PointerTo(60),
+				ScannerPlacement: &models.ScannerPlacement{
+					SpreadAcrossZones: utils.PointerTo(true),
+				},
+			},
+			Template: template,
+			ExpectedSnapshot: &models.ScanConfigSnapshot{
+				Name: utils.PointerTo("nightly"),
+				ScanFamiliesConfig: &models.ScanFamiliesConfig{
+					Malware: &models.MalwareConfig{
+						Enabled: utils.PointerTo(false),
+					},
+					Vulnerabilities: &models.VulnerabilitiesConfig{
+						Enabled: utils.PointerTo(true),
+					},
+				},
+				Scope:               scope,
+				TimeoutSeconds:      utils.PointerTo(60),
+				MaxParallelScanners: utils.PointerTo(5),
+				ScannerPlacement: &models.ScannerPlacement{
+					SpreadAcrossZones: utils.PointerTo(true),
+				},
+				ScanConfigTemplate: &models.ScanConfigTemplateRelationship{
+					Id: "template-1",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			scan := newScanFromScanConfig(test.ScanConfig, test.Template)
+			g.Expect(scan.ScanConfigSnapshot).Should(Equal(test.ExpectedSnapshot))
+			g.Expect(scan.ScanConfig.Id).Should(Equal("config-1"))
+		})
+	}
+
+	// The template itself is not changed by the scan configs using it
+	g := NewGomegaWithT(t)
+	g.Expect(template.ScanFamiliesConfig.Malware.Enabled).Should(Equal(utils.PointerTo(true)))
+}
@@ -0,0 +1,179 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanconfigwatcher
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// maxSkippedRuns is the number of skipped runs kept in the audit record of a ScanConfig.
+const maxSkippedRuns = 10
+
+// getOverlapPolicy returns the overlap policy of the ScanConfig, which defaults to skipping the run.
+func getOverlapPolicy(scanConfig *models.ScanConfig) models.ScanOverlapPolicy {
+	if scanConfig.OverlapPolicy == nil {
+		return models.Skip
+	}
+	return *scanConfig.OverlapPolicy
+}
+
+// appendSkippedRun returns the skipped runs with run appended, keeping only the most recent maxSkippedRuns.
+func appendSkippedRun(runs *[]models.ScanConfigSkippedRun, run models.ScanConfigSkippedRun) *[]models.ScanConfigSkippedRun {
+	var skippedRuns []models.ScanConfigSkippedRun
+	if runs != nil {
+		skippedRuns = append(skippedRuns, *runs...)
+	}
+	skippedRuns = append(skippedRuns, run)
+
+	if len(skippedRuns) > maxSkippedRuns {
+		skippedRuns = skippedRuns[len(skippedRuns)-maxSkippedRuns:]
+	}
+
+	return &skippedRuns
+}
+
+func scanIDs(scans []models.Scan) []string {
+	ids := make([]string, 0, len(scans))
+	for _, scan := range scans {
+		if scan.Id != nil {
+			ids = append(ids, *scan.Id)
+		}
+	}
+	return ids
+}
+
+// runScheduled starts a new Scan for the run of the ScanConfig scheduled for operationTime unless there are Scan(s)
+// from the ScanConfig in progress, in which case the run is handled according to the overlap policy. The changes to
+// the ScanConfig are recorded in scanConfigPatch.
+func (w *Watcher) runScheduled(ctx context.Context, scanConfig *models.ScanConfig, operationTime time.Time, scanConfigPatch *models.ScanConfig) error {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	scans, err := w.getScansInProgress(ctx, scanConfig)
+	if err != nil {
+		return err
+	}
+
+	if len(scans) == 0 {
+		return w.createScan(ctx, scanConfig)
+	}
+
+	policy := getOverlapPolicy(scanConfig)
+	skippedRun := models.ScanConfigSkippedRun{
+		OperationTime:     operationTime,
+		InProgressScanIDs: utils.PointerTo(scanIDs(scans)),
+	}
+
+	switch policy {
+	case models.Queue, models.CancelPrevious:
+		if utils.ValueOrZero(scanConfig.QueuedRun) {
+			logger.Warnf("Skipping scheduled run as a run is already queued for ScanConfig")
+			skippedRun.Reason = utils.PointerTo("A run is already queued until the in-progress scans are finished")
+			scanConfigPatch.SkippedRuns = appendSkippedRun(scanConfig.SkippedRuns, skippedRun)
+			return nil
+		}
+
+		if policy == models.CancelPrevious {
+			if err = w.abortScans(ctx, scans); err != nil {
+				return err
+			}
+		}
+
+		logger.Infof("Queueing scheduled run until the in-progress Scan(s) are finished. OverlapPolicy=%s", policy)
+		scanConfigPatch.QueuedRun = utils.PointerTo(true)
+	default:
+		logger.Warnf("Skipping ScanConfig as it has Scan(s) already in-progress")
+		skippedRun.Reason = utils.PointerTo("Scans from the ScanConfig are still in progress")
+		scanConfigPatch.SkippedRuns = appendSkippedRun(scanConfig.SkippedRuns, skippedRun)
+	}
+
+	return nil
+}
+
+// reconcileQueued starts the Scan for the run queued by the overlap policy once there are no Scan(s) from the
+// ScanConfig in progress.
+func (w *Watcher) reconcileQueued(ctx context.Context, scanConfig *models.ScanConfig) error {
+	scans, err := w.getScansInProgress(ctx, scanConfig)
+	if err != nil {
+		return err
+	}
+
+	if len(scans) > 0 {
+		if getOverlapPolicy(scanConfig) == models.CancelPrevious {
+			return w.abortScans(ctx, scans)
+		}
+		return nil
+	}
+
+	if err = w.createScan(ctx, scanConfig); err != nil {
+		return err
+	}
+
+	scanConfigPatch := &models.ScanConfig{
+		QueuedRun: utils.PointerTo(false),
+	}
+	if err = w.backend.PatchScanConfig(ctx, *scanConfig.Id, scanConfigPatch); err != nil {
+		return fmt.Errorf("failed to patch ScanConfig. ScanConfigID=%s: %w", *scanConfig.Id, err)
+	}
+	scanConfig.QueuedRun = scanConfigPatch.QueuedRun
+
+	return nil
+}
+
+func (w *Watcher) getScansInProgress(ctx context.Context, scanConfig *models.ScanConfig) ([]models.Scan, error) {
+	filter := fmt.Sprintf("scanConfig/id eq '%s' and state ne '%s' and state ne '%s'", *scanConfig.Id,
+		models.ScanStateDone, models.ScanStateFailed)
+	scans, err := w.backend.GetScans(ctx, models.GetScansParams{
+		Filter: utils.PointerTo(filter),
+		Select: utils.PointerTo("id,state"),
+	})
+	if err != nil || scans == nil {
+		return nil, fmt.Errorf("failed to fetch scans for ScanConfig. ScanConfigID=%s: %w", *scanConfig.Id, err)
+	}
+
+	if scans.Items == nil {
+		return nil, nil
+	}
+
+	return *scans.Items, nil
+}
+
+func (w *Watcher) abortScans(ctx context.Context, scans []models.Scan) error {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	for _, scan := range scans {
+		if scan.Id == nil || utils.ValueOrZero(scan.State) == models.ScanStateAborted {
+			continue
+		}
+
+		logger.Infof("Aborting in-progress Scan due to overlap policy. ScanID=%s", *scan.Id)
+		scanPatch := &models.Scan{
+			State:        utils.PointerTo(models.ScanStateAborted),
+			StateReason:  utils.PointerTo(models.ScanStateReasonAborted),
+			StateMessage: utils.PointerTo("Scan has been cancelled by a newer scheduled run of the ScanConfig"),
+		}
+		if err := w.backend.PatchScan(ctx, *scan.Id, scanPatch); err != nil {
+			return fmt.Errorf("failed to abort Scan. ScanID=%s: %w", *scan.Id, err)
+		}
+	}
+
+	return nil
+}
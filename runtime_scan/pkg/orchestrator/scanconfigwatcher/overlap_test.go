@@ -0,0 +1,106 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanconfigwatcher
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestGetOverlapPolicy(t *testing.T) {
+	tests := []struct {
+		Name       string
+		ScanConfig *models.ScanConfig
+
+		ExpectedPolicy models.ScanOverlapPolicy
+	}{
+		{
+			Name:           "Policy is not set",
+			ScanConfig:     &models.ScanConfig{},
+			ExpectedPolicy: models.Skip,
+		},
+		{
+			Name: "Policy is set",
+			ScanConfig: &models.ScanConfig{
+				OverlapPolicy: utils.PointerTo(models.CancelPrevious),
+			},
+			ExpectedPolicy: models.CancelPrevious,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			g.Expect(getOverlapPolicy(test.ScanConfig)).Should(Equal(test.ExpectedPolicy))
+		})
+	}
+}
+
+func TestAppendSkippedRun(t *testing.T) {
+	start := time.Date(2023, 5, 17, 10, 0, 0, 0, time.UTC)
+	newSkippedRuns := func(n int) []models.ScanConfigSkippedRun {
+		runs := make([]models.ScanConfigSkippedRun, 0, n)
+		for i := 0; i < n; i++ {
+			runs = append(runs, models.ScanConfigSkippedRun{
+				OperationTime: start.Add(time.Duration(i) * time.Hour),
+			})
+		}
+		return runs
+	}
+
+	tests := []struct {
+		Name string
+		Runs *[]models.ScanConfigSkippedRun
+		Run  models.ScanConfigSkippedRun
+
+		ExpectedRuns []models.ScanConfigSkippedRun
+	}{
+		{
+			Name:         "No skipped runs",
+			Runs:         nil,
+			Run:          models.ScanConfigSkippedRun{OperationTime: start},
+			ExpectedRuns: newSkippedRuns(1),
+		},
+		{
+			Name:         "Skipped runs below the limit",
+			Runs:         utils.PointerTo(newSkippedRuns(2)),
+			Run:          models.ScanConfigSkippedRun{OperationTime: start.Add(2 * time.Hour)},
+			ExpectedRuns: newSkippedRuns(3),
+		},
+		{
+			Name:         "Oldest skipped run is dropped at the limit",
+			Runs:         utils.PointerTo(newSkippedRuns(maxSkippedRuns)),
+			Run:          models.ScanConfigSkippedRun{OperationTime: start.Add(maxSkippedRuns * time.Hour)},
+			ExpectedRuns: newSkippedRuns(maxSkippedRuns + 1)[1:],
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			runs := appendSkippedRun(test.Runs, test.Run)
+			g.Expect(runs).ShouldNot(BeNil())
+			g.Expect(*runs).Should(Equal(test.ExpectedRuns))
+		})
+	}
+}
@@ -22,6 +22,8 @@ import (
 	"github.com/aptible/supercronic/cronexpr"
 
 	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/report"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
 // The ScheduleWindow represents a timeframe defined by the start and end timestamps.
@@ -157,7 +159,7 @@ func (o OperationTime) String() string {
 func NewOperationTime(t time.Time, c *cronexpr.Expression) *OperationTime {
 	// Check if c cron expression represents a single point in time which case it is used instead of t time.
 	if c != nil {
-		cronTime, ok := isCronPointInTime(c)
+		cronTime, ok := isCronPointInTime(c, t.Location())
 		if ok {
 			t = cronTime
 		}
@@ -169,11 +171,11 @@ func NewOperationTime(t time.Time, c *cronexpr.Expression) *OperationTime {
 	}
 }
 
-func isCronPointInTime(c *cronexpr.Expression) (time.Time, bool) {
+func isCronPointInTime(c *cronexpr.Expression, loc *time.Location) (time.Time, bool) {
 	// NOTE: from.Add(1) is needed as `from` represents zero time and cronexpr returns zero time if it is provided
 	//       with zero time as first parameter. Non-standard cron expressions (Quartz) may include year field
 	//       representing time which might be in the past hence the `from` time is set to zero time.
-	from := time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)
+	from := time.Date(1, 1, 1, 0, 0, 0, 0, loc)
 	t := c.Next(from.Add(1))
 	n := c.Next(t)
 	if n.IsZero() || t.Equal(n) {
@@ -257,22 +259,11 @@ func NewScanConfigSchedule(scanConfig *models.ScanConfig, window *ScheduleWindow
 		}, nil
 	}
 
-	var cronExpr *cronexpr.Expression
-	var err error
-	if scanConfig.Scheduled.CronLine != nil {
-		cronExpr, err = cronexpr.Parse(*scanConfig.Scheduled.CronLine)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse cron expression %s: %w", *scanConfig.Scheduled.CronLine, err)
-		}
+	operationTime, err := newScheduledOperationTime(scanConfig.Scheduled)
+	if err != nil {
+		return nil, err
 	}
 
-	var oTime time.Time
-	if scanConfig.Scheduled.OperationTime != nil {
-		oTime = *scanConfig.Scheduled.OperationTime
-	}
-
-	operationTime := NewOperationTime(oTime, cronExpr)
-
 	if window.In(operationTime.Time()) {
 		return &ScanConfigSchedule{
 			State:         ScheduleStateDue,
@@ -307,3 +298,62 @@ func NewScanConfigSchedule(scanConfig *models.ScanConfig, window *ScheduleWindow
 		Window:        window,
 	}, nil
 }
+
+// newScheduledOperationTime returns the OperationTime defined by the scheduled schedule where the cron expression is
+// evaluated in the time zone of the schedule.
+func newScheduledOperationTime(scheduled *models.RuntimeScheduleScanConfig) (*OperationTime, error) {
+	var cronExpr *cronexpr.Expression
+	var err error
+	if scheduled.CronLine != nil {
+		cronExpr, err = cronexpr.Parse(*scheduled.CronLine)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse cron expression %s: %w", *scheduled.CronLine, err)
+		}
+	}
+
+	loc, err := report.LoadTimezone(utils.ValueOrZero(scheduled.Timezone))
+	if err != nil {
+		return nil, err
+	}
+
+	var oTime time.Time
+	if scheduled.OperationTime != nil {
+		oTime = *scheduled.OperationTime
+	}
+
+	return NewOperationTime(oTime.In(loc), cronExpr), nil
+}
+
+// NextOperationTimes returns at most n OperationTimes of the scheduled schedule which are not before the after time
+// in ascending order. They are calculated the same way as the ScanConfigs are scheduled, so it returns fewer times
+// if the schedule is not recurring.
+func NextOperationTimes(scheduled *models.RuntimeScheduleScanConfig, after time.Time, n int) ([]time.Time, error) {
+	times := make([]time.Time, 0, n)
+	if scheduled == nil || (scheduled.CronLine == nil && scheduled.OperationTime == nil) {
+		return times, nil
+	}
+
+	operationTime, err := newScheduledOperationTime(scheduled)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without operation time the schedule starts at the first cadence after the after time instead of
+	// iterating the cadences from zero time.
+	if operationTime.cron != nil && operationTime.Time().IsZero() {
+		operationTime.time = operationTime.cron.Next(after.In(operationTime.Time().Location()))
+	}
+
+	operationTime = operationTime.NextAfter(after)
+	for len(times) < n && !operationTime.Time().Before(after) {
+		times = append(times, operationTime.Time())
+
+		next := operationTime.Next()
+		if next.Time().Equal(operationTime.Time()) {
+			break
+		}
+		operationTime = next
+	}
+
+	return times, nil
+}
@@ -320,7 +320,7 @@ func TestCronHelpers(t *testing.T) {
 			g := NewGomegaWithT(t)
 
 			next := test.Cron.Next(test.FromTime)
-			cronTime, ok := isCronPointInTime(test.Cron)
+			cronTime, ok := isCronPointInTime(test.Cron, time.UTC)
 			recurring := isCronPeriodic(test.Cron)
 
 			g.Expect(next).Should(Equal(test.ExpectedNextTime))
@@ -330,3 +330,99 @@ func TestCronHelpers(t *testing.T) {
 		})
 	}
 }
+
+func TestNextOperationTimes(t *testing.T) {
+	budapest, err := time.LoadLocation("Europe/Budapest")
+	if err != nil {
+		t.Fatalf("failed to load time zone: %v", err)
+	}
+	after := time.Date(2023, 3, 24, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		Name      string
+		Scheduled *models.RuntimeScheduleScanConfig
+		N         int
+
+		ExpectedErrorMatcher types.GomegaMatcher
+		ExpectedTimes        []time.Time
+	}{
+		{
+			Name:                 "Not scheduled",
+			Scheduled:            &models.RuntimeScheduleScanConfig{},
+			N:                    3,
+			ExpectedErrorMatcher: Not(HaveOccurred()),
+			ExpectedTimes:        []time.Time{},
+		},
+		{
+			Name: "Cron is evaluated in UTC by default",
+			Scheduled: &models.RuntimeScheduleScanConfig{
+				CronLine:      utils.PointerTo("0 8 * * *"),
+				OperationTime: utils.PointerTo(time.Date(2023, 3, 1, 8, 0, 0, 0, time.UTC)),
+			},
+			N:                    2,
+			ExpectedErrorMatcher: Not(HaveOccurred()),
+			ExpectedTimes: []time.Time{
+				time.Date(2023, 3, 25, 8, 0, 0, 0, time.UTC),
+				time.Date(2023, 3, 26, 8, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			Name: "Cron is evaluated in time zone across daylight saving change",
+			Scheduled: &models.RuntimeScheduleScanConfig{
+				CronLine: utils.PointerTo("0 8 * * *"),
+				Timezone: utils.PointerTo("Europe/Budapest"),
+			},
+			N:                    3,
+			ExpectedErrorMatcher: Not(HaveOccurred()),
+			ExpectedTimes: []time.Time{
+				time.Date(2023, 3, 25, 8, 0, 0, 0, budapest),
+				time.Date(2023, 3, 26, 8, 0, 0, 0, budapest),
+				time.Date(2023, 3, 27, 8, 0, 0, 0, budapest),
+			},
+		},
+		{
+			Name: "Single future operation time",
+			Scheduled: &models.RuntimeScheduleScanConfig{
+				OperationTime: utils.PointerTo(time.Date(2023, 4, 1, 10, 0, 0, 0, time.UTC)),
+			},
+			N:                    3,
+			ExpectedErrorMatcher: Not(HaveOccurred()),
+			ExpectedTimes:        []time.Time{time.Date(2023, 4, 1, 10, 0, 0, 0, time.UTC)},
+		},
+		{
+			Name: "Single past operation time",
+			Scheduled: &models.RuntimeScheduleScanConfig{
+				OperationTime: utils.PointerTo(time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC)),
+			},
+			N:                    3,
+			ExpectedErrorMatcher: Not(HaveOccurred()),
+			ExpectedTimes:        []time.Time{},
+		},
+		{
+			Name: "Unknown time zone",
+			Scheduled: &models.RuntimeScheduleScanConfig{
+				CronLine: utils.PointerTo("0 8 * * *"),
+				Timezone: utils.PointerTo("Mars/Olympus"),
+			},
+			N:                    3,
+			ExpectedErrorMatcher: HaveOccurred(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			times, err := NextOperationTimes(test.Scheduled, after, test.N)
+
+			g.Expect(err).Should(test.ExpectedErrorMatcher)
+			if err != nil {
+				return
+			}
+			g.Expect(times).Should(HaveLen(len(test.ExpectedTimes)))
+			for i := range times {
+				g.Expect(times[i]).Should(BeTemporally("==", test.ExpectedTimes[i]))
+			}
+		})
+	}
+}
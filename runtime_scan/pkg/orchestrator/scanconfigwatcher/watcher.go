@@ -37,6 +37,7 @@ type (
 func New(c Config) *Watcher {
 	return &Watcher{
 		backend:          c.Backend,
+		clock:            common.ClockOrDefault(c.Clock),
 		pollPeriod:       c.PollPeriod,
 		reconcileTimeout: c.ReconcileTimeout,
 		queue:            common.NewQueue[ScanConfigReconcileEvent](),
@@ -45,6 +46,7 @@ func New(c Config) *Watcher {
 
 type Watcher struct {
 	backend          *backendclient.BackendClient
+	clock            common.Clock
 	pollPeriod       time.Duration
 	reconcileTimeout time.Duration
 
@@ -123,13 +125,20 @@ func (w *Watcher) Reconcile(ctx context.Context, event ScanConfigReconcileEvent)
 
 	// nolint:gomnd
 	scheduleWindowSize := w.pollPeriod * 2
-	scheduleWindow := NewScheduleWindow(time.Now(), scheduleWindowSize)
+	scheduleWindow := NewScheduleWindow(w.clock.Now(), scheduleWindowSize)
 
 	scanConfigSchedule, err := NewScanConfigSchedule(scanConfig, scheduleWindow)
 	if err != nil {
 		return fmt.Errorf("failed to determine ScanConfig state: %w", err)
 	}
 
+	if scanConfigSchedule.State != ScheduleStateDisabled && utils.ValueOrZero(scanConfig.QueuedRun) {
+		logger.Debug("Run queued Scan for ScanConfig")
+		if err = w.reconcileQueued(ctx, scanConfig); err != nil {
+			return fmt.Errorf("failed to run queued Scan for ScanConfig: %w", err)
+		}
+	}
+
 	switch scanConfigSchedule.State {
 	case ScheduleStateDisabled:
 		logger.Debug("Skipping ScanConfig as it is disabled")
@@ -168,16 +177,16 @@ func (w *Watcher) reconcileUnscheduled(ctx context.Context, scanConfig *models.S
 }
 
 func (w *Watcher) reconcileDue(ctx context.Context, scanConfig *models.ScanConfig, schedule *ScanConfigSchedule) error {
-	if err := w.createScan(ctx, scanConfig); err != nil {
+	scanConfigPatch := &models.ScanConfig{}
+	if err := w.runScheduled(ctx, scanConfig, schedule.OperationTime.Time(), scanConfigPatch); err != nil {
 		return fmt.Errorf("failed to reconcile new Scan for ScanConfig. ScanConfigID=%s: %w", *scanConfig.Id, err)
 	}
 	nextOperationTime := schedule.OperationTime.NextAfter(schedule.Window.Next().Start())
 	// FIXME: disable ScanConfig if it was a oneshot
-	scanConfigPatch := &models.ScanConfig{
-		Scheduled: &models.RuntimeScheduleScanConfig{
-			CronLine:      scanConfig.Scheduled.CronLine,
-			OperationTime: utils.PointerTo(nextOperationTime.Time()),
-		},
+	scanConfigPatch.Scheduled = &models.RuntimeScheduleScanConfig{
+		CronLine:      scanConfig.Scheduled.CronLine,
+		OperationTime: utils.PointerTo(nextOperationTime.Time()),
+		Timezone:      scanConfig.Scheduled.Timezone,
 	}
 
 	if err := w.backend.PatchScanConfig(ctx, *scanConfig.Id, scanConfigPatch); err != nil {
@@ -190,33 +199,21 @@ func (w *Watcher) reconcileDue(ctx context.Context, scanConfig *models.ScanConfi
 func (w *Watcher) createScan(ctx context.Context, scanConfig *models.ScanConfig) error {
 	logger := log.GetLoggerFromContextOrDiscard(ctx)
 
-	filter := fmt.Sprintf("scanConfig/id eq '%s' and status ne '%s' and status ne '%s'", *scanConfig.Id,
-		models.ScanStateDone, models.ScanStateFailed)
-	scans, err := w.backend.GetScans(ctx, models.GetScansParams{
-		Filter: utils.PointerTo(filter),
-		Select: utils.PointerTo("id"),
-		Count:  utils.PointerTo(true),
-	})
-	if err != nil || scans == nil {
-		return fmt.Errorf("failed to fetch scans for ScanConfig. ScanConfigID=%s: %w", *scanConfig.Id, err)
-	}
-
-	var scansInProgress int
-	if scans.Count != nil {
-		scansInProgress = *scans.Count
-	} else {
-		scansInProgress = len(*scans.Items)
-	}
-
-	if scansInProgress > 0 {
-		logger.Warnf("Skipping ScanConfig as it has Scan(s) already in-progress")
-		return nil
+	// The template is resolved when the scan starts, so that the changes of
+	// the template apply to the following scans but not the existing ones.
+	var template *models.ScanConfigTemplate
+	if scanConfig.ScanConfigTemplate != nil {
+		var err error
+		template, err = w.backend.GetScanConfigTemplate(ctx, scanConfig.ScanConfigTemplate.Id, models.GetScanConfigTemplatesScanConfigTemplateIDParams{})
+		if err != nil {
+			return fmt.Errorf("failed to get ScanConfigTemplate of ScanConfig. ScanConfigID=%s: %w", *scanConfig.Id, err)
+		}
 	}
 
-	scan := newScanFromScanConfig(scanConfig)
-	scan.StartTime = utils.PointerTo(time.Now())
+	scan := newScanFromScanConfig(scanConfig, template)
+	scan.StartTime = utils.PointerTo(w.clock.Now())
 
-	_, err = w.backend.PostScan(ctx, *scan)
+	_, err := w.backend.PostScan(ctx, *scan)
 	if err != nil {
 		var conflictErr backendclient.ScanConflictError
 		if errors.As(err, &conflictErr) {
@@ -237,6 +234,7 @@ func (w *Watcher) reconcileOverdue(ctx context.Context, scanConfig *models.ScanC
 		Scheduled: &models.RuntimeScheduleScanConfig{
 			CronLine:      scanConfig.Scheduled.CronLine,
 			OperationTime: utils.PointerTo(nextOperationTime.Time()),
+			Timezone:      scanConfig.Scheduled.Timezone,
 		},
 	}
 
@@ -0,0 +1,161 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanresultprocessor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/findingkey"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func (srp *ScanResultProcessor) getExistingCertificateFindingsForScan(ctx context.Context, scanResult models.AssetScanResult) (map[findingkey.CertificateKey]string, error) {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	existingMap := map[findingkey.CertificateKey]string{}
+
+	existingFilter := fmt.Sprintf("findingInfo/objectType eq 'Certificate' and asset/id eq '%s' and scan/id eq '%s'",
+		scanResult.Asset.Id, scanResult.Scan.Id)
+	existingFindings, err := srp.client.GetFindings(ctx, models.GetFindingsParams{
+		Filter: &existingFilter,
+		Select: utils.PointerTo("id,findingInfo/findingType,findingInfo/filePath,findingInfo/fingerprint"),
+	})
+	if err != nil {
+		return existingMap, fmt.Errorf("failed to query for findings: %w", err)
+	}
+
+	for _, finding := range *existingFindings.Items {
+		info, err := (*finding.FindingInfo).AsCertificateFindingInfo()
+		if err != nil {
+			return existingMap, fmt.Errorf("unable to get certificate finding info: %w", err)
+		}
+
+		key := findingkey.GenerateCertificateKey(info)
+		if _, ok := existingMap[key]; ok {
+			return existingMap, fmt.Errorf("found multiple matching existing findings for certificate %v", key)
+		}
+		existingMap[key] = *finding.Id
+	}
+
+	logger.Infof("Found %d existing certificate findings for this scan", len(existingMap))
+	logger.Debugf("Existing certificate map: %v", existingMap)
+
+	return existingMap, nil
+}
+
+// nolint:cyclop
+func (srp *ScanResultProcessor) reconcileResultCertificatesToFindings(ctx context.Context, scanResult models.AssetScanResult, exceptions findingExceptions) error {
+	completedTime := scanResult.Status.General.LastTransitionTime
+
+	newerFound, newerTime, err := srp.newerExistingFindingTime(ctx, scanResult.Asset.Id, "Certificate", *completedTime)
+	if err != nil {
+		return fmt.Errorf("failed to check for newer existing certificate findings: %v", err)
+	}
+
+	// Build a map of existing findings for this scan to prevent us
+	// recreating existings ones as we might be re-reconciling the same
+	// scan result because of downtime or a previous failure.
+	existingMap, err := srp.getExistingCertificateFindingsForScan(ctx, scanResult)
+	if err != nil {
+		return fmt.Errorf("failed to check existing certificate findings: %w", err)
+	}
+
+	var batch findingsBatch
+
+	if scanResult.Certificates != nil && scanResult.Certificates.Certificates != nil {
+		// Create new or update existing findings all the certificate and key
+		// issues found by the scan.
+		for _, item := range *scanResult.Certificates.Certificates {
+			itemFindingInfo := models.CertificateFindingInfo{
+				FilePath:           item.FilePath,
+				FindingType:        item.FindingType,
+				Fingerprint:        item.Fingerprint,
+				Issuer:             item.Issuer,
+				KeyAlgorithm:       item.KeyAlgorithm,
+				KeySize:            item.KeySize,
+				Message:            item.Message,
+				NotAfter:           item.NotAfter,
+				NotBefore:          item.NotBefore,
+				SerialNumber:       item.SerialNumber,
+				SignatureAlgorithm: item.SignatureAlgorithm,
+				Subject:            item.Subject,
+			}
+
+			findingInfo := models.Finding_FindingInfo{}
+			err = findingInfo.FromCertificateFindingInfo(itemFindingInfo)
+			if err != nil {
+				return fmt.Errorf("unable to convert CertificateFindingInfo into FindingInfo: %w", err)
+			}
+
+			finding := models.Finding{
+				Scan:        scanResult.Scan,
+				Asset:       scanResult.Asset,
+				FoundOn:     scanResult.Status.General.LastTransitionTime,
+				FindingInfo: &findingInfo,
+			}
+
+			// Set InvalidatedOn time to the FoundOn time of the oldest
+			// finding, found after this scan result.
+			if newerFound {
+				finding.InvalidatedOn = &newerTime
+			}
+
+			// Flag the finding if it is suppressed by an exception.
+			exceptions.apply(&finding)
+
+			key := findingkey.GenerateCertificateKey(itemFindingInfo)
+			batch.add(existingMap[key], finding)
+		}
+	}
+
+	err = srp.saveFindings(ctx, batch)
+	if err != nil {
+		return fmt.Errorf("failed to save findings: %w", err)
+	}
+
+	// Invalidate any findings of this type for this asset where foundOn is
+	// older than this scan result, and has not already been invalidated by
+	// a scan result older than this scan result.
+	err = srp.invalidateOlderFindingsByType(ctx, "Certificate", scanResult.Asset.Id, *completedTime)
+	if err != nil {
+		return fmt.Errorf("failed to invalidate older certificate finding: %v", err)
+	}
+
+	// Get all findings which aren't invalidated, and then update the asset's summary
+	target, err := srp.client.GetAsset(ctx, scanResult.Asset.Id, models.GetAssetsAssetIDParams{})
+	if err != nil {
+		return fmt.Errorf("failed to get target %s: %w", scanResult.Asset.Id, err)
+	}
+	if target.Summary == nil {
+		target.Summary = &models.ScanFindingsSummary{}
+	}
+
+	totalCertificates, err := srp.getActiveFindingsByType(ctx, "Certificate", scanResult.Asset.Id)
+	if err != nil {
+		return fmt.Errorf("failed to list active certificate findings: %w", err)
+	}
+	target.Summary.TotalCertificates = &totalCertificates
+
+	err = srp.client.PatchAsset(ctx, target, scanResult.Asset.Id)
+	if err != nil {
+		return fmt.Errorf("failed to patch target %s: %w", scanResult.Asset.Id, err)
+	}
+
+	return nil
+}
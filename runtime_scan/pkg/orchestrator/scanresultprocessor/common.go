@@ -18,12 +18,35 @@ package scanresultprocessor
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/networkpolicy"
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
+// findingTypeFilter returns the filter of the findings of the type which are
+// reported by the scans. The network misconfigurations are reported from the
+// discovery data, so they are neither invalidated by nor invalidate the
+// misconfigurations found by scanning the volumes. Likewise the truncation
+// warnings are plugin findings which are reported for the scan results
+// rather than by the plugins, they are selected by truncationFindingType.
+func findingTypeFilter(findingType string) string {
+	if findingType == truncationFindingType {
+		return fmt.Sprintf("findingInfo/objectType eq 'PluginFinding' and findingInfo/pluginName eq '%s'", TruncationPluginName)
+	}
+
+	filter := fmt.Sprintf("findingInfo/objectType eq '%s'", findingType)
+	switch findingType {
+	case "Misconfiguration":
+		filter += fmt.Sprintf(" and (findingInfo/scannerName eq null or findingInfo/scannerName ne '%s')", networkpolicy.ScannerName)
+	case "PluginFinding":
+		filter += fmt.Sprintf(" and (findingInfo/pluginName eq null or findingInfo/pluginName ne '%s')", TruncationPluginName)
+	}
+	return filter
+}
+
 func (srp *ScanResultProcessor) newerExistingFindingTime(ctx context.Context, targetID string, findingType string, completedTime time.Time) (bool, time.Time, error) {
 	var found bool
 	var newerTime time.Time
@@ -43,8 +66,8 @@ func (srp *ScanResultProcessor) newerExistingFindingTime(ctx context.Context, ta
 	// this scan.
 	newerFindings, err := srp.client.GetFindings(ctx, models.GetFindingsParams{
 		Filter: utils.PointerTo(fmt.Sprintf(
-			"findingInfo/objectType eq '%s' and asset/id eq '%s' and foundOn gt %s",
-			findingType, targetID, completedTime.Format(time.RFC3339))),
+			"%s and asset/id eq '%s' and foundOn gt %s",
+			findingTypeFilter(findingType), targetID, completedTime.Format(time.RFC3339))),
 		OrderBy: utils.PointerTo("foundOn asc"),
 		Top:     utils.PointerTo(1), // because of the ordering we only need to get one result here and it'll be the oldest finding which matches the filter
 	})
@@ -66,19 +89,93 @@ func (srp *ScanResultProcessor) invalidateOlderFindingsByType(ctx context.Contex
 	// a scan result older than this scan result.
 	findingsToInvalidate, err := srp.client.GetFindings(ctx, models.GetFindingsParams{
 		Filter: utils.PointerTo(fmt.Sprintf(
-			"findingInfo/objectType eq '%s' and asset/id eq '%s' and foundOn lt %s and (invalidatedOn gt %s or invalidatedOn eq null)",
-			findingType, targetID, completedTime.Format(time.RFC3339), completedTime.Format(time.RFC3339))),
+			"%s and asset/id eq '%s' and foundOn lt %s and (invalidatedOn gt %s or invalidatedOn eq null)",
+			findingTypeFilter(findingType), targetID, completedTime.Format(time.RFC3339), completedTime.Format(time.RFC3339))),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to query findings to invalidate: %w", err)
 	}
 
+	var batch findingsBatch
 	for _, finding := range *findingsToInvalidate.Items {
 		finding.InvalidatedOn = &completedTime
+		batch.add(*finding.Id, finding)
+	}
+
+	err = srp.saveFindings(ctx, batch)
+	if err != nil {
+		return fmt.Errorf("failed to update existing findings: %w", err)
+	}
+
+	return nil
+}
 
-		err := srp.client.PatchFinding(ctx, *finding.Id, finding)
+// findingsBatch collects the findings of a scan result, so that they are
+// created and updated in bulk instead of with a request per finding.
+type findingsBatch struct {
+	create []models.Finding
+	update []models.Finding
+}
+
+// add queues the finding to be updated if id, the ID of the existing finding,
+// is not empty, or to be created otherwise.
+func (b *findingsBatch) add(id string, finding models.Finding) {
+	if id == "" {
+		b.create = append(b.create, finding)
+		return
+	}
+
+	finding.Id = &id
+	b.update = append(b.update, finding)
+}
+
+// saveFindings updates and creates the findings of the batch, at most
+// findingsBulkSize per request. It returns an error if any of the findings
+// failed to be saved.
+func (srp *ScanResultProcessor) saveFindings(ctx context.Context, batch findingsBatch) error {
+	for _, chunk := range chunkFindings(batch.update, findingsBulkSize) {
+		results, err := srp.client.PatchFindingsBulk(ctx, chunk)
 		if err != nil {
-			return fmt.Errorf("failed to update existing finding %s: %w", *finding.Id, err)
+			return fmt.Errorf("failed to update findings: %w", err)
+		}
+		if err = checkFindingBulkResults(results, http.StatusOK); err != nil {
+			return fmt.Errorf("failed to update finding: %w", err)
+		}
+	}
+
+	for _, chunk := range chunkFindings(batch.create, findingsBulkSize) {
+		results, err := srp.client.PostFindingsBulk(ctx, chunk)
+		if err != nil {
+			return fmt.Errorf("failed to create findings: %w", err)
+		}
+		if err = checkFindingBulkResults(results, http.StatusCreated); err != nil {
+			return fmt.Errorf("failed to create finding: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// chunkFindings splits the findings into chunks of at most size findings.
+func chunkFindings(findings []models.Finding, size int) [][]models.Finding {
+	var chunks [][]models.Finding
+	for len(findings) > size {
+		chunks = append(chunks, findings[:size])
+		findings = findings[size:]
+	}
+	if len(findings) > 0 {
+		chunks = append(chunks, findings)
+	}
+
+	return chunks
+}
+
+// checkFindingBulkResults returns an error for the first finding of a bulk
+// request which doesn't have the expected status.
+func checkFindingBulkResults(results []models.FindingBulkItemResult, expected int) error {
+	for _, result := range results {
+		if result.Status != expected {
+			return fmt.Errorf("status code=%v: %v", result.Status, utils.ValueOrZero(result.Message))
 		}
 	}
 
@@ -86,7 +183,7 @@ func (srp *ScanResultProcessor) invalidateOlderFindingsByType(ctx context.Contex
 }
 
 func (srp *ScanResultProcessor) getActiveFindingsByType(ctx context.Context, findingType string, targetID string) (int, error) {
-	filter := fmt.Sprintf("findingInfo/objectType eq '%s' and asset/id eq '%s' and invalidatedOn eq null",
+	filter := fmt.Sprintf("findingInfo/objectType eq '%s' and asset/id eq '%s' and invalidatedOn eq null and suppression eq null",
 		findingType, targetID)
 	activeFindings, err := srp.client.GetFindings(ctx, models.GetFindingsParams{
 		Count:  utils.PointerTo(true),
@@ -102,3 +199,35 @@ func (srp *ScanResultProcessor) getActiveFindingsByType(ctx context.Context, fin
 	}
 	return *activeFindings.Count, nil
 }
+
+// countDistinctScanners returns the number of distinct scanners which
+// reported a finding, or nil if the scanners are not known.
+func countDistinctScanners(scanners *[]models.ScannerAttribution) *int {
+	if scanners == nil {
+		return nil
+	}
+
+	names := map[string]struct{}{}
+	for _, scanner := range *scanners {
+		if scanner.ScannerName != nil {
+			names[*scanner.ScannerName] = struct{}{}
+		}
+	}
+	return utils.PointerTo(len(names))
+}
+
+// findingConfidence returns the confidence of a finding, the confidence
+// merged from its scanners or else the highest confidence reported by one of
+// them, or nil if none of them reports one.
+func findingConfidence(confidence *models.FindingConfidence, scanners *[]models.ScannerAttribution) *models.FindingConfidence {
+	if confidence != nil || scanners == nil {
+		return confidence
+	}
+
+	for _, scanner := range *scanners {
+		if scanner.Confidence != nil && scanner.Confidence.Rank() > utils.ValueOrZero(confidence).Rank() {
+			confidence = scanner.Confidence
+		}
+	}
+	return confidence
+}
@@ -0,0 +1,99 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanresultprocessor
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestFindingsBatch_add(t *testing.T) {
+	var batch findingsBatch
+	batch.add("", models.Finding{ScannersCount: utils.PointerTo(1)})
+	batch.add("existing", models.Finding{ScannersCount: utils.PointerTo(2)})
+
+	wantCreate := []models.Finding{{ScannersCount: utils.PointerTo(1)}}
+	if diff := cmp.Diff(wantCreate, batch.create); diff != "" {
+		t.Errorf("add() create mismatch (-want +got):\n%s", diff)
+	}
+	wantUpdate := []models.Finding{{Id: utils.PointerTo("existing"), ScannersCount: utils.PointerTo(2)}}
+	if diff := cmp.Diff(wantUpdate, batch.update); diff != "" {
+		t.Errorf("add() update mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestChunkFindings(t *testing.T) {
+	findings := make([]models.Finding, 5)
+
+	tests := []struct {
+		name     string
+		findings []models.Finding
+		size     int
+		want     []int
+	}{
+		{
+			name:     "no findings",
+			findings: nil,
+			size:     2,
+			want:     nil,
+		},
+		{
+			name:     "last chunk is partial",
+			findings: findings,
+			size:     2,
+			want:     []int{2, 2, 1},
+		},
+		{
+			name:     "single full chunk",
+			findings: findings,
+			size:     5,
+			want:     []int{5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			for _, chunk := range chunkFindings(tt.findings, tt.size) {
+				got = append(got, len(chunk))
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("chunkFindings() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindingConfidence(t *testing.T) {
+	scanners := &[]models.ScannerAttribution{
+		{ScannerName: utils.PointerTo("clam"), Confidence: utils.PointerTo(models.Low)},
+		{ScannerName: utils.PointerTo("yara"), Confidence: utils.PointerTo(models.Medium)},
+		{ScannerName: utils.PointerTo("grype")},
+	}
+
+	if got := findingConfidence(nil, scanners); utils.ValueOrZero(got) != models.Medium {
+		t.Errorf("findingConfidence() = %v, want the highest confidence of the scanners", utils.ValueOrZero(got))
+	}
+	if got := findingConfidence(utils.PointerTo(models.High), scanners); utils.ValueOrZero(got) != models.High {
+		t.Errorf("findingConfidence() = %v, want the merged confidence", utils.ValueOrZero(got))
+	}
+	if got := findingConfidence(nil, &[]models.ScannerAttribution{{ScannerName: utils.PointerTo("grype")}}); got != nil {
+		t.Errorf("findingConfidence() = %v, want nil if no scanner reports one", *got)
+	}
+}
@@ -19,17 +19,29 @@ import (
 	"time"
 
 	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
+	"github.com/openclarity/vmclarity/shared/pkg/compliance"
 )
 
+// findingsBulkSize is the maximum number of findings created or updated by a
+// single request, which must not exceed the maxItems of the bulk API.
+const findingsBulkSize = 500
+
 const (
-	DefaultPollInterval     = 2 * time.Minute
-	DefaultReconcileTimeout = 5 * time.Minute
+	DefaultPollInterval         = 2 * time.Minute
+	DefaultReconcileTimeout     = 5 * time.Minute
+	DefaultMaxSecretOccurrences = 100
 )
 
 type Config struct {
 	Backend          *backendclient.BackendClient
 	PollPeriod       time.Duration
 	ReconcileTimeout time.Duration
+	// MaxSecretOccurrences caps the number of locations stored for a
+	// secret finding, 0 means unlimited.
+	MaxSecretOccurrences int
+	// ComplianceMapper tags the misconfiguration findings with the compliance
+	// controls they map to, no findings are tagged if nil.
+	ComplianceMapper *compliance.Mapper
 }
 
 func (c Config) WithBackendClient(b *backendclient.BackendClient) Config {
@@ -37,6 +49,11 @@ func (c Config) WithBackendClient(b *backendclient.BackendClient) Config {
 	return c
 }
 
+func (c Config) WithComplianceMapper(m *compliance.Mapper) Config {
+	c.ComplianceMapper = m
+	return c
+}
+
 func (c Config) WithReconcileTimeout(t time.Duration) Config {
 	c.ReconcileTimeout = t
 	return c
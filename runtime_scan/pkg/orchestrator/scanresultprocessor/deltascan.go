@@ -0,0 +1,167 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanresultprocessor
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// A delta scan only scans the files changed since the previous scan of the
+// target for secrets and malware. The findings of the previous scans in the
+// unchanged files are carried over to the delta scan, so that they are not
+// invalidated by it.
+
+// changedPaths is the set of files and directories changed since the
+// previous scan of the target.
+type changedPaths map[string]struct{}
+
+// getChangedPaths returns the changed paths of a delta scan, or nil if the
+// scan result is not a delta scan.
+func getChangedPaths(scanResult models.AssetScanResult) changedPaths {
+	if scanResult.DeltaScan == nil || scanResult.DeltaScan.ChangedPaths == nil {
+		return nil
+	}
+
+	ret := make(changedPaths, len(*scanResult.DeltaScan.ChangedPaths))
+	for _, p := range *scanResult.DeltaScan.ChangedPaths {
+		ret[p] = struct{}{}
+	}
+	return ret
+}
+
+// isScanned returns true if the file was scanned by the delta scan, which is
+// the case if the file or its directory is changed.
+func (c changedPaths) isScanned(filePath string) bool {
+	_, changed := c[filePath]
+	_, dirChanged := c[path.Dir(filePath)]
+	return changed || dirChanged
+}
+
+func (srp *ScanResultProcessor) getActiveFindings(ctx context.Context, findingType string, targetID string) ([]models.Finding, error) {
+	activeFindings, err := srp.client.GetFindings(ctx, models.GetFindingsParams{
+		Filter: utils.PointerTo(fmt.Sprintf(
+			"findingInfo/objectType eq '%s' and asset/id eq '%s' and invalidatedOn eq null",
+			findingType, targetID)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query active findings: %w", err)
+	}
+	return *activeFindings.Items, nil
+}
+
+// getMalwareToReconcile returns the malware found by the scan, and for delta
+// scans the active malware findings in the files which were not scanned.
+func (srp *ScanResultProcessor) getMalwareToReconcile(ctx context.Context, scanResult models.AssetScanResult) ([]models.Malware, error) {
+	var ret []models.Malware
+	if scanResult.Malware != nil && scanResult.Malware.Malware != nil {
+		ret = append(ret, *scanResult.Malware.Malware...)
+	}
+
+	changed := getChangedPaths(scanResult)
+	if changed == nil {
+		return ret, nil
+	}
+
+	findings, err := srp.getActiveFindings(ctx, "Malware", scanResult.Asset.Id)
+	if err != nil {
+		return nil, err
+	}
+	for _, finding := range findings {
+		info, err := (*finding.FindingInfo).AsMalwareFindingInfo()
+		if err != nil {
+			return nil, fmt.Errorf("unable to get malware finding info: %w", err)
+		}
+		if info.Path == nil || changed.isScanned(*info.Path) {
+			continue
+		}
+		ret = append(ret, models.Malware{
+			MalwareName: info.MalwareName,
+			MalwareType: info.MalwareType,
+			Path:        info.Path,
+			Scanners:    info.Scanners,
+		})
+	}
+
+	return ret, nil
+}
+
+// getSecretsToReconcile returns the secrets found by the scan, and for delta
+// scans the occurrences of the active secret findings in the files which were
+// not scanned. Occurrences which were not stored because of the occurrences
+// limit cannot be carried over.
+func (srp *ScanResultProcessor) getSecretsToReconcile(ctx context.Context, scanResult models.AssetScanResult) ([]models.Secret, error) {
+	var ret []models.Secret
+	if scanResult.Secrets != nil && scanResult.Secrets.Secrets != nil {
+		ret = append(ret, *scanResult.Secrets.Secrets...)
+	}
+
+	changed := getChangedPaths(scanResult)
+	if changed == nil {
+		return ret, nil
+	}
+
+	findings, err := srp.getActiveFindings(ctx, "Secret", scanResult.Asset.Id)
+	if err != nil {
+		return nil, err
+	}
+	for _, finding := range findings {
+		info, err := (*finding.FindingInfo).AsSecretFindingInfo()
+		if err != nil {
+			return nil, fmt.Errorf("unable to get secret finding info: %w", err)
+		}
+		ret = append(ret, unscannedSecretOccurrences(info, changed)...)
+	}
+
+	return ret, nil
+}
+
+func unscannedSecretOccurrences(info models.SecretFindingInfo, changed changedPaths) []models.Secret {
+	occurrences := []models.SecretOccurrence{
+		{
+			FilePath:    info.FilePath,
+			StartLine:   info.StartLine,
+			EndLine:     info.EndLine,
+			StartColumn: info.StartColumn,
+			EndColumn:   info.EndColumn,
+		},
+	}
+	if info.Occurrences != nil {
+		occurrences = *info.Occurrences
+	}
+
+	var ret []models.Secret
+	for _, occurrence := range occurrences {
+		if occurrence.FilePath == nil || changed.isScanned(*occurrence.FilePath) {
+			continue
+		}
+		ret = append(ret, models.Secret{
+			CredentialFingerprint: info.CredentialFingerprint,
+			Description:           info.Description,
+			EndColumn:             occurrence.EndColumn,
+			EndLine:               occurrence.EndLine,
+			FilePath:              occurrence.FilePath,
+			Fingerprint:           info.Fingerprint,
+			StartColumn:           occurrence.StartColumn,
+			StartLine:             occurrence.StartLine,
+		})
+	}
+	return ret
+}
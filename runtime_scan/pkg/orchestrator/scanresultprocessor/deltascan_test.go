@@ -0,0 +1,104 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanresultprocessor
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func Test_getChangedPaths(t *testing.T) {
+	tests := []struct {
+		name       string
+		scanResult models.AssetScanResult
+		want       changedPaths
+	}{
+		{
+			name:       "not a delta scan",
+			scanResult: models.AssetScanResult{},
+			want:       nil,
+		},
+		{
+			name: "changed blocks not mapped to files",
+			scanResult: models.AssetScanResult{
+				DeltaScan: &models.DeltaScanInfo{
+					ChangedBlocks: &[]models.ChangedBlockRange{{Offset: 0, Length: 512}},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "no changed paths",
+			scanResult: models.AssetScanResult{
+				DeltaScan: &models.DeltaScanInfo{
+					ChangedPaths: &[]string{},
+				},
+			},
+			want: changedPaths{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getChangedPaths(tt.scanResult)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("getChangedPaths() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_unscannedSecretOccurrences(t *testing.T) {
+	credential := utils.PointerTo("credential")
+	info := models.SecretFindingInfo{
+		CredentialFingerprint: credential,
+		Description:           utils.PointerTo("AWS key"),
+		FilePath:              utils.PointerTo("/etc/app.conf"),
+		Fingerprint:           utils.PointerTo("/etc/app.conf:aws:1"),
+		StartLine:             utils.PointerTo(1),
+		EndLine:               utils.PointerTo(1),
+		Occurrences: &[]models.SecretOccurrence{
+			{FilePath: utils.PointerTo("/etc/app.conf"), StartLine: utils.PointerTo(1), EndLine: utils.PointerTo(1)},
+			{FilePath: utils.PointerTo("/home/user/notes"), StartLine: utils.PointerTo(5), EndLine: utils.PointerTo(5)},
+			{FilePath: utils.PointerTo("/opt/app/env"), StartLine: utils.PointerTo(2), EndLine: utils.PointerTo(2)},
+		},
+	}
+
+	// /etc/app.conf is changed, and the files of /home/user are scanned
+	// again because the directory is changed.
+	changed := changedPaths{
+		"/etc/app.conf": {},
+		"/home/user":    {},
+	}
+
+	got := unscannedSecretOccurrences(info, changed)
+	want := []models.Secret{
+		{
+			CredentialFingerprint: credential,
+			Description:           utils.PointerTo("AWS key"),
+			FilePath:              utils.PointerTo("/opt/app/env"),
+			Fingerprint:           utils.PointerTo("/etc/app.conf:aws:1"),
+			StartLine:             utils.PointerTo(2),
+			EndLine:               utils.PointerTo(2),
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unscannedSecretOccurrences() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanresultprocessor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// findingExceptions are the FindingExceptions in effect for the asset of a
+// ScanResult, their asset filters are already evaluated.
+type findingExceptions []models.FindingException
+
+// getFindingExceptions returns the FindingExceptions which were in effect when
+// the ScanResult completed and which select its asset.
+func (srp *ScanResultProcessor) getFindingExceptions(ctx context.Context, targetID string, completedTime time.Time) (findingExceptions, error) {
+	exceptions, err := srp.client.GetFindingExceptions(ctx, models.GetFindingExceptionsParams{
+		Filter: utils.PointerTo(fmt.Sprintf("expiresAt eq null or expiresAt gt %s", completedTime.Format(time.RFC3339))),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get finding exceptions: %w", err)
+	}
+
+	var ret findingExceptions
+	for _, exception := range *exceptions.Items {
+		if exception.MatchRules == nil {
+			continue
+		}
+
+		if exception.MatchRules.AssetFilter != nil {
+			targets, err := srp.client.GetAssets(ctx, models.GetAssetsParams{
+				Filter: utils.PointerTo(fmt.Sprintf("id eq '%s' and (%s)", targetID, *exception.MatchRules.AssetFilter)),
+				Count:  utils.PointerTo(true),
+				Top:    utils.PointerTo(0),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to evaluate asset filter of finding exception %s: %w", *exception.Id, err)
+			}
+			if *targets.Count == 0 {
+				continue
+			}
+		}
+
+		ret = append(ret, exception)
+	}
+
+	return ret, nil
+}
+
+// apply flags the finding as suppressed by the first exception matching it.
+func (e findingExceptions) apply(finding *models.Finding) {
+	if finding.FindingInfo == nil {
+		return
+	}
+
+	for _, exception := range e {
+		if matchesFindingInfo(*exception.MatchRules, *finding.FindingInfo) {
+			finding.Suppression = &models.FindingSuppression{
+				FindingExceptionID: *exception.Id,
+				Reason:             exception.Reason,
+				ExpiresAt:          exception.ExpiresAt,
+			}
+			return
+		}
+	}
+}
+
+// matchesFindingInfo returns true if the finding info matches all the rules
+// besides the asset filter, which is evaluated when the exceptions are fetched.
+func matchesFindingInfo(rules models.FindingExceptionMatchRules, info models.Finding_FindingInfo) bool {
+	findingType, err := info.Discriminator()
+	if err != nil {
+		return false
+	}
+	if rules.FindingType != nil && *rules.FindingType != findingType {
+		return false
+	}
+
+	var vulnerabilityName, purl *string
+	switch findingType {
+	case "Vulnerability":
+		vuln, err := info.AsVulnerabilityFindingInfo()
+		if err != nil {
+			return false
+		}
+		vulnerabilityName = vuln.VulnerabilityName
+		if vuln.Package != nil {
+			purl = vuln.Package.Purl
+		}
+	case "Package":
+		pkg, err := info.AsPackageFindingInfo()
+		if err != nil {
+			return false
+		}
+		purl = pkg.Purl
+	}
+
+	if rules.VulnerabilityName != nil && utils.ValueOrZero(vulnerabilityName) != *rules.VulnerabilityName {
+		return false
+	}
+	if rules.PackagePurl != nil && utils.ValueOrZero(purl) != *rules.PackagePurl {
+		return false
+	}
+
+	return true
+}
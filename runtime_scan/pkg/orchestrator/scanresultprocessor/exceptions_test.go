@@ -0,0 +1,153 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanresultprocessor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func newVulnerabilityFinding(t *testing.T, name, purl string) models.Finding {
+	t.Helper()
+
+	findingInfo := models.Finding_FindingInfo{}
+	err := findingInfo.FromVulnerabilityFindingInfo(models.VulnerabilityFindingInfo{
+		ObjectType:        "Vulnerability",
+		VulnerabilityName: utils.PointerTo(name),
+		Package: &models.Package{
+			Name: utils.PointerTo("openssl"),
+			Purl: utils.PointerTo(purl),
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create vulnerability finding info: %v", err)
+	}
+	return models.Finding{FindingInfo: &findingInfo}
+}
+
+func newSecretFinding(t *testing.T) models.Finding {
+	t.Helper()
+
+	findingInfo := models.Finding_FindingInfo{}
+	err := findingInfo.FromSecretFindingInfo(models.SecretFindingInfo{
+		ObjectType: "Secret",
+		FilePath:   utils.PointerTo("/etc/app.conf"),
+	})
+	if err != nil {
+		t.Fatalf("failed to create secret finding info: %v", err)
+	}
+	return models.Finding{FindingInfo: &findingInfo}
+}
+
+func Test_findingExceptions_apply(t *testing.T) {
+	expiresAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	exceptions := findingExceptions{
+		{
+			Id:     utils.PointerTo("cve"),
+			Reason: utils.PointerTo("false positive"),
+			MatchRules: &models.FindingExceptionMatchRules{
+				VulnerabilityName: utils.PointerTo("CVE-2023-0001"),
+			},
+			ExpiresAt: &expiresAt,
+		},
+		{
+			Id:     utils.PointerTo("cve-and-package"),
+			Reason: utils.PointerTo("not reachable"),
+			MatchRules: &models.FindingExceptionMatchRules{
+				VulnerabilityName: utils.PointerTo("CVE-2023-0002"),
+				PackagePurl:       utils.PointerTo("pkg:deb/debian/openssl@1.1.1"),
+			},
+		},
+		{
+			Id:     utils.PointerTo("secrets"),
+			Reason: utils.PointerTo("accepted risk"),
+			MatchRules: &models.FindingExceptionMatchRules{
+				FindingType: utils.PointerTo("Secret"),
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		finding func(t *testing.T) models.Finding
+		want    *models.FindingSuppression
+	}{
+		{
+			name: "matching vulnerability name",
+			finding: func(t *testing.T) models.Finding {
+				t.Helper()
+				return newVulnerabilityFinding(t, "CVE-2023-0001", "pkg:deb/debian/openssl@1.1.1")
+			},
+			want: &models.FindingSuppression{
+				FindingExceptionID: "cve",
+				Reason:             utils.PointerTo("false positive"),
+				ExpiresAt:          &expiresAt,
+			},
+		},
+		{
+			name: "matching vulnerability name and package",
+			finding: func(t *testing.T) models.Finding {
+				t.Helper()
+				return newVulnerabilityFinding(t, "CVE-2023-0002", "pkg:deb/debian/openssl@1.1.1")
+			},
+			want: &models.FindingSuppression{
+				FindingExceptionID: "cve-and-package",
+				Reason:             utils.PointerTo("not reachable"),
+			},
+		},
+		{
+			name: "matching vulnerability name of other package",
+			finding: func(t *testing.T) models.Finding {
+				t.Helper()
+				return newVulnerabilityFinding(t, "CVE-2023-0002", "pkg:deb/debian/openssl@3.0.0")
+			},
+			want: nil,
+		},
+		{
+			name: "matching finding type",
+			finding: func(t *testing.T) models.Finding {
+				t.Helper()
+				return newSecretFinding(t)
+			},
+			want: &models.FindingSuppression{
+				FindingExceptionID: "secrets",
+				Reason:             utils.PointerTo("accepted risk"),
+			},
+		},
+		{
+			name: "not matching",
+			finding: func(t *testing.T) models.Finding {
+				t.Helper()
+				return newVulnerabilityFinding(t, "CVE-2023-0003", "pkg:deb/debian/openssl@1.1.1")
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			finding := tt.finding(t)
+			exceptions.apply(&finding)
+			if diff := cmp.Diff(tt.want, finding.Suppression); diff != "" {
+				t.Errorf("apply() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
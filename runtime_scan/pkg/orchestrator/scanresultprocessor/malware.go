@@ -25,13 +25,13 @@ import (
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
-func (srp *ScanResultProcessor) getExistingMalwareFindingsForScan(ctx context.Context, scanResult models.TargetScanResult) (map[findingkey.MalwareKey]string, error) {
+func (srp *ScanResultProcessor) getExistingMalwareFindingsForScan(ctx context.Context, scanResult models.AssetScanResult) (map[findingkey.MalwareKey]string, error) {
 	logger := logutils.GetLoggerFromContextOrDiscard(ctx)
 
 	existingMap := map[findingkey.MalwareKey]string{}
 
 	existingFilter := fmt.Sprintf("findingInfo/objectType eq 'Malware' and asset/id eq '%s' and scan/id eq '%s'",
-		scanResult.Target.Id, scanResult.Scan.Id)
+		scanResult.Asset.Id, scanResult.Scan.Id)
 	existingFindings, err := srp.client.GetFindings(ctx, models.GetFindingsParams{
 		Filter: &existingFilter,
 		Select: utils.PointerTo("id,findingInfo/malwareName,findingInfo/malwareType,findingInfo/path"),
@@ -60,10 +60,10 @@ func (srp *ScanResultProcessor) getExistingMalwareFindingsForScan(ctx context.Co
 }
 
 // nolint:cyclop
-func (srp *ScanResultProcessor) reconcileResultMalwareToFindings(ctx context.Context, scanResult models.TargetScanResult) error {
+func (srp *ScanResultProcessor) reconcileResultMalwareToFindings(ctx context.Context, scanResult models.AssetScanResult, exceptions findingExceptions) error {
 	completedTime := scanResult.Status.General.LastTransitionTime
 
-	newerFound, newerTime, err := srp.newerExistingFindingTime(ctx, scanResult.Target.Id, "Malware", *completedTime)
+	newerFound, newerTime, err := srp.newerExistingFindingTime(ctx, scanResult.Asset.Id, "Malware", *completedTime)
 	if err != nil {
 		return fmt.Errorf("failed to check for newer existing malware findings: %v", err)
 	}
@@ -76,76 +76,85 @@ func (srp *ScanResultProcessor) reconcileResultMalwareToFindings(ctx context.Con
 		return fmt.Errorf("failed to check existing malware findings: %w", err)
 	}
 
-	if scanResult.Malware != nil && scanResult.Malware.Malware != nil {
-		// Create new or update existing findings all the malwares found by the
-		// scan.
-		for _, item := range *scanResult.Malware.Malware {
-			itemFindingInfo := models.MalwareFindingInfo{
-				MalwareName: item.MalwareName,
-				MalwareType: item.MalwareType,
-				Path:        item.Path,
-			}
-
-			findingInfo := models.Finding_FindingInfo{}
-			err = findingInfo.FromMalwareFindingInfo(itemFindingInfo)
-			if err != nil {
-				return fmt.Errorf("unable to convert MalwareFindingInfo into FindingInfo: %w", err)
-			}
-
-			finding := models.Finding{
-				Scan:        scanResult.Scan,
-				Asset:       scanResult.Target,
-				FoundOn:     scanResult.Status.General.LastTransitionTime,
-				FindingInfo: &findingInfo,
-			}
-
-			// Set InvalidatedOn time to the FoundOn time of the oldest
-			// finding, found after this scan result.
-			if newerFound {
-				finding.InvalidatedOn = &newerTime
-			}
-
-			key := findingkey.GenerateMalwareKey(itemFindingInfo)
-			if id, ok := existingMap[key]; ok {
-				err = srp.client.PatchFinding(ctx, id, finding)
-				if err != nil {
-					return fmt.Errorf("failed to create finding: %w", err)
-				}
-			} else {
-				_, err = srp.client.PostFinding(ctx, finding)
-				if err != nil {
-					return fmt.Errorf("failed to create finding: %w", err)
-				}
-			}
+	malware, err := srp.getMalwareToReconcile(ctx, scanResult)
+	if err != nil {
+		return fmt.Errorf("failed to get malware to reconcile: %w", err)
+	}
+
+	var batch findingsBatch
+
+	// Create new or update existing findings all the malwares found by the
+	// scan.
+	for _, item := range malware {
+		itemFindingInfo := models.MalwareFindingInfo{
+			MalwareName:    item.MalwareName,
+			MalwareType:    item.MalwareType,
+			Path:           item.Path,
+			Scanners:       item.Scanners,
+			Confidence:     item.Confidence,
+			RuleName:       item.RuleName,
+			MatchedStrings: item.MatchedStrings,
+		}
+
+		findingInfo := models.Finding_FindingInfo{}
+		err = findingInfo.FromMalwareFindingInfo(itemFindingInfo)
+		if err != nil {
+			return fmt.Errorf("unable to convert MalwareFindingInfo into FindingInfo: %w", err)
+		}
+
+		finding := models.Finding{
+			Scan:          scanResult.Scan,
+			Asset:         scanResult.Asset,
+			FoundOn:       scanResult.Status.General.LastTransitionTime,
+			FindingInfo:   &findingInfo,
+			ScannersCount: countDistinctScanners(item.Scanners),
+			Confidence:    findingConfidence(item.Confidence, item.Scanners),
 		}
+
+		// Set InvalidatedOn time to the FoundOn time of the oldest
+		// finding, found after this scan result.
+		if newerFound {
+			finding.InvalidatedOn = &newerTime
+		}
+
+		// Flag the finding if it is suppressed by an exception.
+		exceptions.apply(&finding)
+
+		key := findingkey.GenerateMalwareKey(itemFindingInfo)
+		batch.add(existingMap[key], finding)
+	}
+
+	err = srp.saveFindings(ctx, batch)
+	if err != nil {
+		return fmt.Errorf("failed to save findings: %w", err)
 	}
 
 	// Invalidate any findings of this type for this asset where foundOn is
 	// older than this scan result, and has not already been invalidated by
 	// a scan result older than this scan result.
-	err = srp.invalidateOlderFindingsByType(ctx, "Malware", scanResult.Target.Id, *completedTime)
+	err = srp.invalidateOlderFindingsByType(ctx, "Malware", scanResult.Asset.Id, *completedTime)
 	if err != nil {
 		return fmt.Errorf("failed to invalidate older malware finding: %v", err)
 	}
 
 	// Get all findings which aren't invalidated, and then update the asset's summary
-	target, err := srp.client.GetTarget(ctx, scanResult.Target.Id, models.GetTargetsTargetIDParams{})
+	target, err := srp.client.GetAsset(ctx, scanResult.Asset.Id, models.GetAssetsAssetIDParams{})
 	if err != nil {
-		return fmt.Errorf("failed to get target %s: %w", scanResult.Target.Id, err)
+		return fmt.Errorf("failed to get target %s: %w", scanResult.Asset.Id, err)
 	}
 	if target.Summary == nil {
 		target.Summary = &models.ScanFindingsSummary{}
 	}
 
-	totalMalware, err := srp.getActiveFindingsByType(ctx, "Malware", scanResult.Target.Id)
+	totalMalware, err := srp.getActiveFindingsByType(ctx, "Malware", scanResult.Asset.Id)
 	if err != nil {
 		return fmt.Errorf("failed to list active malware: %w", err)
 	}
 	target.Summary.TotalMalware = &totalMalware
 
-	err = srp.client.PatchTarget(ctx, target, scanResult.Target.Id)
+	err = srp.client.PatchAsset(ctx, target, scanResult.Asset.Id)
 	if err != nil {
-		return fmt.Errorf("failed to patch target %s: %w", scanResult.Target.Id, err)
+		return fmt.Errorf("failed to patch target %s: %w", scanResult.Asset.Id, err)
 	}
 
 	return nil
@@ -25,13 +25,13 @@ import (
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
-func (srp *ScanResultProcessor) getExistingMisconfigurationFindingsForScan(ctx context.Context, scanResult models.TargetScanResult) (map[findingkey.MisconfigurationKey]string, error) {
+func (srp *ScanResultProcessor) getExistingMisconfigurationFindingsForScan(ctx context.Context, scanResult models.AssetScanResult) (map[findingkey.MisconfigurationKey]string, error) {
 	logger := logutils.GetLoggerFromContextOrDiscard(ctx)
 
 	existingMap := map[findingkey.MisconfigurationKey]string{}
 
 	existingFilter := fmt.Sprintf("findingInfo/objectType eq 'Misconfiguration' and asset/id eq '%s' and scan/id eq '%s'",
-		scanResult.Target.Id, scanResult.Scan.Id)
+		scanResult.Asset.Id, scanResult.Scan.Id)
 	existingFindings, err := srp.client.GetFindings(ctx, models.GetFindingsParams{
 		Filter: &existingFilter,
 		Select: utils.PointerTo("id,findingInfo/scannerName,findingInfo/testId,findingInfo/message"),
@@ -60,10 +60,10 @@ func (srp *ScanResultProcessor) getExistingMisconfigurationFindingsForScan(ctx c
 }
 
 // nolint:cyclop
-func (srp *ScanResultProcessor) reconcileResultMisconfigurationsToFindings(ctx context.Context, scanResult models.TargetScanResult) error {
+func (srp *ScanResultProcessor) reconcileResultMisconfigurationsToFindings(ctx context.Context, scanResult models.AssetScanResult, exceptions findingExceptions) error {
 	completedTime := scanResult.Status.General.LastTransitionTime
 
-	newerFound, newerTime, err := srp.newerExistingFindingTime(ctx, scanResult.Target.Id, "Misconfiguration", *completedTime)
+	newerFound, newerTime, err := srp.newerExistingFindingTime(ctx, scanResult.Asset.Id, "Misconfiguration", *completedTime)
 	if err != nil {
 		return fmt.Errorf("failed to check for newer existing misconfiguration findings: %v", err)
 	}
@@ -76,6 +76,8 @@ func (srp *ScanResultProcessor) reconcileResultMisconfigurationsToFindings(ctx c
 		return fmt.Errorf("failed to check existing misconfiguration findings: %w", err)
 	}
 
+	var batch findingsBatch
+
 	if scanResult.Misconfigurations != nil && scanResult.Misconfigurations.Misconfigurations != nil {
 		// Create new or update existing findings all the misconfigurations found by the
 		// scan.
@@ -90,6 +92,7 @@ func (srp *ScanResultProcessor) reconcileResultMisconfigurationsToFindings(ctx c
 				TestDescription: item.TestDescription,
 				TestID:          item.TestID,
 			}
+			itemFindingInfo.ComplianceControls = srp.getComplianceControls(item)
 
 			findingInfo := models.Finding_FindingInfo{}
 			err = findingInfo.FromMisconfigurationFindingInfo(itemFindingInfo)
@@ -99,7 +102,7 @@ func (srp *ScanResultProcessor) reconcileResultMisconfigurationsToFindings(ctx c
 
 			finding := models.Finding{
 				Scan:        scanResult.Scan,
-				Asset:       scanResult.Target,
+				Asset:       scanResult.Asset,
 				FoundOn:     scanResult.Status.General.LastTransitionTime,
 				FindingInfo: &findingInfo,
 			}
@@ -110,48 +113,60 @@ func (srp *ScanResultProcessor) reconcileResultMisconfigurationsToFindings(ctx c
 				finding.InvalidatedOn = &newerTime
 			}
 
+			// Flag the finding if it is suppressed by an exception.
+			exceptions.apply(&finding)
+
 			key := findingkey.GenerateMisconfigurationKey(itemFindingInfo)
-			if id, ok := existingMap[key]; ok {
-				err = srp.client.PatchFinding(ctx, id, finding)
-				if err != nil {
-					return fmt.Errorf("failed to create finding: %w", err)
-				}
-			} else {
-				_, err = srp.client.PostFinding(ctx, finding)
-				if err != nil {
-					return fmt.Errorf("failed to create finding: %w", err)
-				}
-			}
+			batch.add(existingMap[key], finding)
 		}
 	}
 
+	err = srp.saveFindings(ctx, batch)
+	if err != nil {
+		return fmt.Errorf("failed to save findings: %w", err)
+	}
+
 	// Invalidate any findings of this type for this asset where foundOn is
 	// older than this scan result, and has not already been invalidated by
 	// a scan result older than this scan result.
-	err = srp.invalidateOlderFindingsByType(ctx, "Misconfiguration", scanResult.Target.Id, *completedTime)
+	err = srp.invalidateOlderFindingsByType(ctx, "Misconfiguration", scanResult.Asset.Id, *completedTime)
 	if err != nil {
 		return fmt.Errorf("failed to invalidate older misconfiguration finding: %v", err)
 	}
 
 	// Get all findings which aren't invalidated, and then update the asset's summary
-	target, err := srp.client.GetTarget(ctx, scanResult.Target.Id, models.GetTargetsTargetIDParams{})
+	target, err := srp.client.GetAsset(ctx, scanResult.Asset.Id, models.GetAssetsAssetIDParams{})
 	if err != nil {
-		return fmt.Errorf("failed to get target %s: %w", scanResult.Target.Id, err)
+		return fmt.Errorf("failed to get target %s: %w", scanResult.Asset.Id, err)
 	}
 	if target.Summary == nil {
 		target.Summary = &models.ScanFindingsSummary{}
 	}
 
-	totalMisconfigurations, err := srp.getActiveFindingsByType(ctx, "Misconfiguration", scanResult.Target.Id)
+	totalMisconfigurations, err := srp.getActiveFindingsByType(ctx, "Misconfiguration", scanResult.Asset.Id)
 	if err != nil {
 		return fmt.Errorf("failed to list active critial vulnerabilities: %w", err)
 	}
 	target.Summary.TotalMisconfigurations = &totalMisconfigurations
 
-	err = srp.client.PatchTarget(ctx, target, scanResult.Target.Id)
+	err = srp.client.PatchAsset(ctx, target, scanResult.Asset.Id)
 	if err != nil {
-		return fmt.Errorf("failed to patch target %s: %w", scanResult.Target.Id, err)
+		return fmt.Errorf("failed to patch target %s: %w", scanResult.Asset.Id, err)
 	}
 
 	return nil
 }
+
+// getComplianceControls returns the compliance controls the misconfiguration maps to, or nil if there are none.
+func (srp *ScanResultProcessor) getComplianceControls(misconfiguration models.Misconfiguration) *[]models.ComplianceControl {
+	if srp.complianceMapper == nil || misconfiguration.ScannerName == nil || misconfiguration.TestID == nil {
+		return nil
+	}
+
+	controls := srp.complianceMapper.ControlsFor(*misconfiguration.ScannerName, *misconfiguration.TestID)
+	if len(controls) == 0 {
+		return nil
+	}
+
+	return &controls
+}
@@ -0,0 +1,157 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanresultprocessor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/findingkey"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func (srp *ScanResultProcessor) getExistingPluginFindingsForScan(ctx context.Context, scanResult models.AssetScanResult) (map[findingkey.PluginFindingKey]string, error) {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	existingMap := map[findingkey.PluginFindingKey]string{}
+
+	existingFilter := fmt.Sprintf("findingInfo/objectType eq 'PluginFinding' and asset/id eq '%s' and scan/id eq '%s'",
+		scanResult.Asset.Id, scanResult.Scan.Id)
+	existingFindings, err := srp.client.GetFindings(ctx, models.GetFindingsParams{
+		Filter: &existingFilter,
+		Select: utils.PointerTo("id,findingInfo/pluginName,findingInfo/findingID,findingInfo/path"),
+	})
+	if err != nil {
+		return existingMap, fmt.Errorf("failed to query for findings: %w", err)
+	}
+
+	for _, finding := range *existingFindings.Items {
+		info, err := (*finding.FindingInfo).AsPluginFindingInfo()
+		if err != nil {
+			return existingMap, fmt.Errorf("unable to get plugin finding info: %w", err)
+		}
+
+		key := findingkey.GeneratePluginFindingKey(info)
+		if _, ok := existingMap[key]; ok {
+			return existingMap, fmt.Errorf("found multiple matching existing findings for plugin finding %v", key)
+		}
+		existingMap[key] = *finding.Id
+	}
+
+	logger.Infof("Found %d existing plugin findings for this scan", len(existingMap))
+	logger.Debugf("Existing plugin finding map: %v", existingMap)
+
+	return existingMap, nil
+}
+
+// nolint:cyclop
+func (srp *ScanResultProcessor) reconcileResultPluginsToFindings(ctx context.Context, scanResult models.AssetScanResult, exceptions findingExceptions) error {
+	completedTime := scanResult.Status.General.LastTransitionTime
+
+	newerFound, newerTime, err := srp.newerExistingFindingTime(ctx, scanResult.Asset.Id, "PluginFinding", *completedTime)
+	if err != nil {
+		return fmt.Errorf("failed to check for newer existing plugin findings: %v", err)
+	}
+
+	// Build a map of existing findings for this scan to prevent us
+	// recreating existings ones as we might be re-reconciling the same
+	// scan result because of downtime or a previous failure.
+	existingMap, err := srp.getExistingPluginFindingsForScan(ctx, scanResult)
+	if err != nil {
+		return fmt.Errorf("failed to check existing plugin findings: %w", err)
+	}
+
+	var batch findingsBatch
+
+	if scanResult.Plugins != nil && scanResult.Plugins.PluginFindings != nil {
+		// Create new or update existing findings all the findings reported
+		// by the plugins during the scan.
+		for _, item := range *scanResult.Plugins.PluginFindings {
+			itemFindingInfo := models.PluginFindingInfo{
+				Description: item.Description,
+				FindingID:   item.FindingID,
+				Path:        item.Path,
+				PluginName:  item.PluginName,
+				Properties:  item.Properties,
+				Remediation: item.Remediation,
+				Severity:    item.Severity,
+				Title:       item.Title,
+			}
+
+			findingInfo := models.Finding_FindingInfo{}
+			err = findingInfo.FromPluginFindingInfo(itemFindingInfo)
+			if err != nil {
+				return fmt.Errorf("unable to convert PluginFindingInfo into FindingInfo: %w", err)
+			}
+
+			finding := models.Finding{
+				Scan:        scanResult.Scan,
+				Asset:       scanResult.Asset,
+				FoundOn:     scanResult.Status.General.LastTransitionTime,
+				FindingInfo: &findingInfo,
+			}
+
+			// Set InvalidatedOn time to the FoundOn time of the oldest
+			// finding, found after this scan result.
+			if newerFound {
+				finding.InvalidatedOn = &newerTime
+			}
+
+			// Flag the finding if it is suppressed by an exception.
+			exceptions.apply(&finding)
+
+			key := findingkey.GeneratePluginFindingKey(itemFindingInfo)
+			batch.add(existingMap[key], finding)
+		}
+	}
+
+	err = srp.saveFindings(ctx, batch)
+	if err != nil {
+		return fmt.Errorf("failed to save findings: %w", err)
+	}
+
+	// Invalidate any findings of this type for this asset where foundOn is
+	// older than this scan result, and has not already been invalidated by
+	// a scan result older than this scan result.
+	err = srp.invalidateOlderFindingsByType(ctx, "PluginFinding", scanResult.Asset.Id, *completedTime)
+	if err != nil {
+		return fmt.Errorf("failed to invalidate older plugin finding: %v", err)
+	}
+
+	// Get all findings which aren't invalidated, and then update the asset's summary
+	target, err := srp.client.GetAsset(ctx, scanResult.Asset.Id, models.GetAssetsAssetIDParams{})
+	if err != nil {
+		return fmt.Errorf("failed to get target %s: %w", scanResult.Asset.Id, err)
+	}
+	if target.Summary == nil {
+		target.Summary = &models.ScanFindingsSummary{}
+	}
+
+	totalPluginFindings, err := srp.getActiveFindingsByType(ctx, "PluginFinding", scanResult.Asset.Id)
+	if err != nil {
+		return fmt.Errorf("failed to list active plugin findings: %w", err)
+	}
+	target.Summary.TotalPluginFindings = &totalPluginFindings
+
+	err = srp.client.PatchAsset(ctx, target, scanResult.Asset.Id)
+	if err != nil {
+		return fmt.Errorf("failed to patch target %s: %w", scanResult.Asset.Id, err)
+	}
+
+	return nil
+}
@@ -25,27 +25,32 @@ import (
 	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/common"
 	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
+	"github.com/openclarity/vmclarity/shared/pkg/compliance"
 	"github.com/openclarity/vmclarity/shared/pkg/log"
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
 type ScanResultProcessor struct {
-	client           *backendclient.BackendClient
-	pollPeriod       time.Duration
-	reconcileTimeout time.Duration
+	client               *backendclient.BackendClient
+	pollPeriod           time.Duration
+	reconcileTimeout     time.Duration
+	maxSecretOccurrences int
+	complianceMapper     *compliance.Mapper
 }
 
 func New(config Config) *ScanResultProcessor {
 	return &ScanResultProcessor{
-		client:           config.Backend,
-		pollPeriod:       config.PollPeriod,
-		reconcileTimeout: config.ReconcileTimeout,
+		client:               config.Backend,
+		pollPeriod:           config.PollPeriod,
+		reconcileTimeout:     config.ReconcileTimeout,
+		maxSecretOccurrences: config.MaxSecretOccurrences,
+		complianceMapper:     config.ComplianceMapper,
 	}
 }
 
-// Returns true if TargetScanStatus.State is DONE and there are no Errors.
-func statusCompletedWithNoErrors(tss *models.TargetScanState) bool {
-	return tss != nil && tss.State != nil && *tss.State == models.TargetScanStateStateDone && (tss.Errors == nil || len(*tss.Errors) == 0)
+// Returns true if AssetScanStatus.State is DONE and there are no Errors.
+func statusCompletedWithNoErrors(tss *models.AssetScanState) bool {
+	return tss != nil && tss.State != nil && *tss.State == models.AssetScanStateStateDone && (tss.Errors == nil || len(*tss.Errors) == 0)
 }
 
 // nolint:cyclop
@@ -67,49 +72,77 @@ func (srp *ScanResultProcessor) Reconcile(ctx context.Context, event ScanResultR
 		return fmt.Errorf("failed to reconcile scan result %s %s to findings: %w", *scanResult.Id, t, err)
 	}
 
+	exceptions, err := srp.getFindingExceptions(ctx, scanResult.Asset.Id, *scanResult.Status.General.LastTransitionTime)
+	if err != nil {
+		return fmt.Errorf("failed to get finding exceptions of scan result %s: %w", *scanResult.Id, err)
+	}
+
 	// Process each of the successfully scanned (state DONE and no errors) families into findings.
 	if statusCompletedWithNoErrors(scanResult.Status.Vulnerabilities) {
-		if err := srp.reconcileResultVulnerabilitiesToFindings(ctx, scanResult); err != nil {
+		if err := srp.reconcileResultVulnerabilitiesToFindings(ctx, scanResult, exceptions); err != nil {
 			return newFailedToReconcileTypeError(err, "vulnerabilities")
 		}
 	}
 
 	if statusCompletedWithNoErrors(scanResult.Status.Sbom) {
-		if err := srp.reconcileResultPackagesToFindings(ctx, scanResult); err != nil {
+		if err := srp.reconcileResultPackagesToFindings(ctx, scanResult, exceptions); err != nil {
 			return newFailedToReconcileTypeError(err, "sbom")
 		}
 	}
 
 	if statusCompletedWithNoErrors(scanResult.Status.Exploits) {
-		if err := srp.reconcileResultExploitsToFindings(ctx, scanResult); err != nil {
+		if err := srp.reconcileResultExploitsToFindings(ctx, scanResult, exceptions); err != nil {
 			return newFailedToReconcileTypeError(err, "exploits")
 		}
 	}
 
 	if statusCompletedWithNoErrors(scanResult.Status.Secrets) {
-		if err := srp.reconcileResultSecretsToFindings(ctx, scanResult); err != nil {
+		if err := srp.reconcileResultSecretsToFindings(ctx, scanResult, exceptions); err != nil {
 			return newFailedToReconcileTypeError(err, "secrets")
 		}
 	}
 
 	if statusCompletedWithNoErrors(scanResult.Status.Malware) {
-		if err := srp.reconcileResultMalwareToFindings(ctx, scanResult); err != nil {
+		if err := srp.reconcileResultMalwareToFindings(ctx, scanResult, exceptions); err != nil {
 			return newFailedToReconcileTypeError(err, "malware")
 		}
 	}
 
 	if statusCompletedWithNoErrors(scanResult.Status.Rootkits) {
-		if err := srp.reconcileResultRootkitsToFindings(ctx, scanResult); err != nil {
+		if err := srp.reconcileResultRootkitsToFindings(ctx, scanResult, exceptions); err != nil {
 			return newFailedToReconcileTypeError(err, "rootkits")
 		}
 	}
 
 	if statusCompletedWithNoErrors(scanResult.Status.Misconfigurations) {
-		if err := srp.reconcileResultMisconfigurationsToFindings(ctx, scanResult); err != nil {
+		if err := srp.reconcileResultMisconfigurationsToFindings(ctx, scanResult, exceptions); err != nil {
 			return newFailedToReconcileTypeError(err, "misconfigurations")
 		}
 	}
 
+	if statusCompletedWithNoErrors(scanResult.Status.Certificates) {
+		if err := srp.reconcileResultCertificatesToFindings(ctx, scanResult, exceptions); err != nil {
+			return newFailedToReconcileTypeError(err, "certificates")
+		}
+	}
+
+	if statusCompletedWithNoErrors(scanResult.Status.Compliance) {
+		if err := srp.reconcileResultComplianceToFindings(ctx, scanResult, exceptions); err != nil {
+			return newFailedToReconcileTypeError(err, "compliance")
+		}
+	}
+
+	if statusCompletedWithNoErrors(scanResult.Status.Plugins) {
+		if err := srp.reconcileResultPluginsToFindings(ctx, scanResult, exceptions); err != nil {
+			return newFailedToReconcileTypeError(err, "plugins")
+		}
+	}
+
+	// Warn about the scan families whose results were truncated.
+	if err := srp.reconcileResultTruncationsToFindings(ctx, scanResult, exceptions); err != nil {
+		return newFailedToReconcileTypeError(err, "truncations")
+	}
+
 	// Mark post-processing completed for this scan result
 	scanResult.FindingsProcessed = utils.PointerTo(true)
 	err = srp.client.PatchScanResult(ctx, scanResult, *scanResult.Id)
@@ -140,7 +173,7 @@ func (e ScanResultReconcileEvent) Hash() string {
 
 func (srp *ScanResultProcessor) GetItems(ctx context.Context) ([]ScanResultReconcileEvent, error) {
 	filter := fmt.Sprintf("status/general/state eq '%s' and (findingsProcessed eq false or findingsProcessed eq null)",
-		models.TargetScanStateStateDone)
+		models.AssetScanStateStateDone)
 	scanResults, err := srp.client.GetScanResults(ctx, models.GetScanResultsParams{
 		Filter: utils.PointerTo(filter),
 		Select: utils.PointerTo("id"),
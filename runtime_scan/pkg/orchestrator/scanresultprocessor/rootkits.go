@@ -25,13 +25,13 @@ import (
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
-func (srp *ScanResultProcessor) getExistingRootkitFindingsForScan(ctx context.Context, scanResult models.TargetScanResult) (map[findingkey.RootkitKey]string, error) {
+func (srp *ScanResultProcessor) getExistingRootkitFindingsForScan(ctx context.Context, scanResult models.AssetScanResult) (map[findingkey.RootkitKey]string, error) {
 	logger := log.GetLoggerFromContextOrDiscard(ctx)
 
 	existingMap := map[findingkey.RootkitKey]string{}
 
 	existingFilter := fmt.Sprintf("findingInfo/objectType eq 'Rootkit' and asset/id eq '%s' and scan/id eq '%s'",
-		scanResult.Target.Id, scanResult.Scan.Id)
+		scanResult.Asset.Id, scanResult.Scan.Id)
 	existingFindings, err := srp.client.GetFindings(ctx, models.GetFindingsParams{
 		Filter: &existingFilter,
 		Select: utils.PointerTo("id,findingInfo/rootkitName,findingInfo/rootkitType,findingInfo/path"),
@@ -60,10 +60,10 @@ func (srp *ScanResultProcessor) getExistingRootkitFindingsForScan(ctx context.Co
 }
 
 // nolint:cyclop
-func (srp *ScanResultProcessor) reconcileResultRootkitsToFindings(ctx context.Context, scanResult models.TargetScanResult) error {
+func (srp *ScanResultProcessor) reconcileResultRootkitsToFindings(ctx context.Context, scanResult models.AssetScanResult, exceptions findingExceptions) error {
 	completedTime := scanResult.Status.General.LastTransitionTime
 
-	newerFound, newerTime, err := srp.newerExistingFindingTime(ctx, scanResult.Target.Id, "Rootkit", *completedTime)
+	newerFound, newerTime, err := srp.newerExistingFindingTime(ctx, scanResult.Asset.Id, "Rootkit", *completedTime)
 	if err != nil {
 		return fmt.Errorf("failed to check for newer existing rootkit findings: %v", err)
 	}
@@ -76,6 +76,8 @@ func (srp *ScanResultProcessor) reconcileResultRootkitsToFindings(ctx context.Co
 		return fmt.Errorf("failed to check existing rootkit findings: %w", err)
 	}
 
+	var batch findingsBatch
+
 	if scanResult.Rootkits != nil && scanResult.Rootkits.Rootkits != nil {
 		// Create new or update existing findings all the rootkits found by the
 		// scan.
@@ -94,7 +96,7 @@ func (srp *ScanResultProcessor) reconcileResultRootkitsToFindings(ctx context.Co
 
 			finding := models.Finding{
 				Scan:        scanResult.Scan,
-				Asset:       scanResult.Target,
+				Asset:       scanResult.Asset,
 				FoundOn:     scanResult.Status.General.LastTransitionTime,
 				FindingInfo: &findingInfo,
 			}
@@ -105,47 +107,45 @@ func (srp *ScanResultProcessor) reconcileResultRootkitsToFindings(ctx context.Co
 				finding.InvalidatedOn = &newerTime
 			}
 
+			// Flag the finding if it is suppressed by an exception.
+			exceptions.apply(&finding)
+
 			key := findingkey.GenerateRootkitKey(itemFindingInfo)
-			if id, ok := existingMap[key]; ok {
-				err = srp.client.PatchFinding(ctx, id, finding)
-				if err != nil {
-					return fmt.Errorf("failed to create finding: %w", err)
-				}
-			} else {
-				_, err = srp.client.PostFinding(ctx, finding)
-				if err != nil {
-					return fmt.Errorf("failed to create finding: %w", err)
-				}
-			}
+			batch.add(existingMap[key], finding)
 		}
 	}
 
+	err = srp.saveFindings(ctx, batch)
+	if err != nil {
+		return fmt.Errorf("failed to save findings: %w", err)
+	}
+
 	// Invalidate any findings of this type for this asset where foundOn is
 	// older than this scan result, and has not already been invalidated by
 	// a scan result older than this scan result.
-	err = srp.invalidateOlderFindingsByType(ctx, "Rootkit", scanResult.Target.Id, *completedTime)
+	err = srp.invalidateOlderFindingsByType(ctx, "Rootkit", scanResult.Asset.Id, *completedTime)
 	if err != nil {
 		return fmt.Errorf("failed to invalidate older rootkit finding: %v", err)
 	}
 
 	// Get all findings which aren't invalidated, and then update the asset's summary
-	target, err := srp.client.GetTarget(ctx, scanResult.Target.Id, models.GetTargetsTargetIDParams{})
+	target, err := srp.client.GetAsset(ctx, scanResult.Asset.Id, models.GetAssetsAssetIDParams{})
 	if err != nil {
-		return fmt.Errorf("failed to get target %s: %w", scanResult.Target.Id, err)
+		return fmt.Errorf("failed to get target %s: %w", scanResult.Asset.Id, err)
 	}
 	if target.Summary == nil {
 		target.Summary = &models.ScanFindingsSummary{}
 	}
 
-	totalRootkits, err := srp.getActiveFindingsByType(ctx, "Rootkit", scanResult.Target.Id)
+	totalRootkits, err := srp.getActiveFindingsByType(ctx, "Rootkit", scanResult.Asset.Id)
 	if err != nil {
 		return fmt.Errorf("failed to list active critial vulnerabilities: %w", err)
 	}
 	target.Summary.TotalRootkits = &totalRootkits
 
-	err = srp.client.PatchTarget(ctx, target, scanResult.Target.Id)
+	err = srp.client.PatchAsset(ctx, target, scanResult.Asset.Id)
 	if err != nil {
-		return fmt.Errorf("failed to patch target %s: %w", scanResult.Target.Id, err)
+		return fmt.Errorf("failed to patch target %s: %w", scanResult.Asset.Id, err)
 	}
 
 	return nil
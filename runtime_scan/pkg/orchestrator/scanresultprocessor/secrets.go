@@ -25,13 +25,13 @@ import (
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
-func (srp *ScanResultProcessor) getExistingSecretFindingsForScan(ctx context.Context, scanResult models.TargetScanResult) (map[findingkey.SecretKey]string, error) {
+func (srp *ScanResultProcessor) getExistingSecretFindingsForScan(ctx context.Context, scanResult models.AssetScanResult) (map[findingkey.SecretKey]string, error) {
 	logger := log.GetLoggerFromContextOrDiscard(ctx)
 
 	existingMap := map[findingkey.SecretKey]string{}
 
 	existingFilter := fmt.Sprintf("findingInfo/objectType eq 'Secret' and asset/id eq '%s' and scan/id eq '%s'",
-		scanResult.Target.Id, scanResult.Scan.Id)
+		scanResult.Asset.Id, scanResult.Scan.Id)
 	existingFindings, err := srp.client.GetFindings(ctx, models.GetFindingsParams{
 		Filter: &existingFilter,
 		Select: utils.PointerTo("id,findingInfo/fingerprint,findingInfo/startColumn,findingInfo/endColumn"),
@@ -60,10 +60,10 @@ func (srp *ScanResultProcessor) getExistingSecretFindingsForScan(ctx context.Con
 }
 
 // nolint:cyclop
-func (srp *ScanResultProcessor) reconcileResultSecretsToFindings(ctx context.Context, scanResult models.TargetScanResult) error {
+func (srp *ScanResultProcessor) reconcileResultSecretsToFindings(ctx context.Context, scanResult models.AssetScanResult, exceptions findingExceptions) error {
 	completedTime := scanResult.Status.General.LastTransitionTime
 
-	newerFound, newerTime, err := srp.newerExistingFindingTime(ctx, scanResult.Target.Id, "Secret", *completedTime)
+	newerFound, newerTime, err := srp.newerExistingFindingTime(ctx, scanResult.Asset.Id, "Secret", *completedTime)
 	if err != nil {
 		return fmt.Errorf("failed to check for newer existing secret findings: %v", err)
 	}
@@ -76,81 +76,123 @@ func (srp *ScanResultProcessor) reconcileResultSecretsToFindings(ctx context.Con
 		return fmt.Errorf("failed to check existing secret findings: %w", err)
 	}
 
-	if scanResult.Secrets != nil && scanResult.Secrets.Secrets != nil {
-		// Create new or update existing findings all the secrets found by the
-		// scan.
-		for _, item := range *scanResult.Secrets.Secrets {
-			itemFindingInfo := models.SecretFindingInfo{
-				Description: item.Description,
-				EndLine:     item.EndLine,
-				FilePath:    item.FilePath,
-				Fingerprint: item.Fingerprint,
-				StartLine:   item.StartLine,
-				StartColumn: item.StartColumn,
-				EndColumn:   item.EndColumn,
-			}
+	secrets, err := srp.getSecretsToReconcile(ctx, scanResult)
+	if err != nil {
+		return fmt.Errorf("failed to get secrets to reconcile: %w", err)
+	}
 
-			findingInfo := models.Finding_FindingInfo{}
-			err = findingInfo.FromSecretFindingInfo(itemFindingInfo)
-			if err != nil {
-				return fmt.Errorf("unable to convert SecretFindingInfo into FindingInfo: %w", err)
-			}
+	var batch findingsBatch
 
-			finding := models.Finding{
-				Scan:        scanResult.Scan,
-				Asset:       scanResult.Target,
-				FoundOn:     scanResult.Status.General.LastTransitionTime,
-				FindingInfo: &findingInfo,
-			}
+	// Create new or update existing findings for all the credentials
+	// found by the scan, every credential is a single finding listing
+	// all the locations it was found in.
+	for _, itemFindingInfo := range groupSecretsByCredential(secrets, srp.maxSecretOccurrences) {
+		findingInfo := models.Finding_FindingInfo{}
+		err = findingInfo.FromSecretFindingInfo(itemFindingInfo)
+		if err != nil {
+			return fmt.Errorf("unable to convert SecretFindingInfo into FindingInfo: %w", err)
+		}
 
-			// Set InvalidatedOn time to the FoundOn time of the oldest
-			// finding, found after this scan result.
-			if newerFound {
-				finding.InvalidatedOn = &newerTime
-			}
+		finding := models.Finding{
+			Scan:        scanResult.Scan,
+			Asset:       scanResult.Asset,
+			FoundOn:     scanResult.Status.General.LastTransitionTime,
+			FindingInfo: &findingInfo,
+			Confidence:  itemFindingInfo.Confidence,
+		}
 
-			key := findingkey.GenerateSecretKey(itemFindingInfo)
-			if id, ok := existingMap[key]; ok {
-				err = srp.client.PatchFinding(ctx, id, finding)
-				if err != nil {
-					return fmt.Errorf("failed to create finding: %w", err)
-				}
-			} else {
-				_, err = srp.client.PostFinding(ctx, finding)
-				if err != nil {
-					return fmt.Errorf("failed to create finding: %w", err)
-				}
-			}
+		// Set InvalidatedOn time to the FoundOn time of the oldest
+		// finding, found after this scan result.
+		if newerFound {
+			finding.InvalidatedOn = &newerTime
 		}
+
+		// Flag the finding if it is suppressed by an exception.
+		exceptions.apply(&finding)
+
+		key := findingkey.GenerateSecretKey(itemFindingInfo)
+		batch.add(existingMap[key], finding)
+	}
+
+	err = srp.saveFindings(ctx, batch)
+	if err != nil {
+		return fmt.Errorf("failed to save findings: %w", err)
 	}
 
 	// Invalidate any findings of this type for this asset where foundOn is
 	// older than this scan result, and has not already been invalidated by
 	// a scan result older than this scan result.
-	err = srp.invalidateOlderFindingsByType(ctx, "Secret", scanResult.Target.Id, *completedTime)
+	err = srp.invalidateOlderFindingsByType(ctx, "Secret", scanResult.Asset.Id, *completedTime)
 	if err != nil {
 		return fmt.Errorf("failed to invalidate older secret finding: %v", err)
 	}
 
 	// Get all findings which aren't invalidated, and then update the asset's summary
-	target, err := srp.client.GetTarget(ctx, scanResult.Target.Id, models.GetTargetsTargetIDParams{})
+	target, err := srp.client.GetAsset(ctx, scanResult.Asset.Id, models.GetAssetsAssetIDParams{})
 	if err != nil {
-		return fmt.Errorf("failed to get target %s: %w", scanResult.Target.Id, err)
+		return fmt.Errorf("failed to get target %s: %w", scanResult.Asset.Id, err)
 	}
 	if target.Summary == nil {
 		target.Summary = &models.ScanFindingsSummary{}
 	}
 
-	totalSecrets, err := srp.getActiveFindingsByType(ctx, "Secret", scanResult.Target.Id)
+	totalSecrets, err := srp.getActiveFindingsByType(ctx, "Secret", scanResult.Asset.Id)
 	if err != nil {
 		return fmt.Errorf("failed to list active critial vulnerabilities: %w", err)
 	}
 	target.Summary.TotalSecrets = &totalSecrets
 
-	err = srp.client.PatchTarget(ctx, target, scanResult.Target.Id)
+	err = srp.client.PatchAsset(ctx, target, scanResult.Asset.Id)
 	if err != nil {
-		return fmt.Errorf("failed to patch target %s: %w", scanResult.Target.Id, err)
+		return fmt.Errorf("failed to patch target %s: %w", scanResult.Asset.Id, err)
 	}
 
 	return nil
 }
+
+// groupSecretsByCredential groups the secrets which have the same credential
+// fingerprint into a single finding info. The first occurrence of the
+// credential is used for the finding info itself and up to maxOccurrences
+// (0 means unlimited) locations are stored in its occurrences. Secrets
+// without a credential fingerprint are not grouped.
+func groupSecretsByCredential(secrets []models.Secret, maxOccurrences int) []models.SecretFindingInfo {
+	var infos []models.SecretFindingInfo
+	indexByCredential := map[string]int{}
+	for _, secret := range secrets {
+		occurrence := models.SecretOccurrence{
+			FilePath:    secret.FilePath,
+			StartLine:   secret.StartLine,
+			EndLine:     secret.EndLine,
+			StartColumn: secret.StartColumn,
+			EndColumn:   secret.EndColumn,
+		}
+
+		if secret.CredentialFingerprint != nil {
+			if i, ok := indexByCredential[*secret.CredentialFingerprint]; ok {
+				*infos[i].OccurrencesCount++
+				if maxOccurrences == 0 || len(*infos[i].Occurrences) < maxOccurrences {
+					*infos[i].Occurrences = append(*infos[i].Occurrences, occurrence)
+				}
+				continue
+			}
+			indexByCredential[*secret.CredentialFingerprint] = len(infos)
+		}
+
+		occurrences := []models.SecretOccurrence{occurrence}
+		infos = append(infos, models.SecretFindingInfo{
+			Description:           secret.Description,
+			EndLine:               secret.EndLine,
+			FilePath:              secret.FilePath,
+			Fingerprint:           secret.Fingerprint,
+			StartLine:             secret.StartLine,
+			StartColumn:           secret.StartColumn,
+			EndColumn:             secret.EndColumn,
+			CredentialFingerprint: secret.CredentialFingerprint,
+			Confidence:            secret.Confidence,
+			OccurrencesCount:      utils.PointerTo(1),
+			Occurrences:           &occurrences,
+		})
+	}
+
+	return infos
+}
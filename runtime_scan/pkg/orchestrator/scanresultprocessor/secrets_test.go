@@ -0,0 +1,129 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanresultprocessor
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func newSecret(file string, line int, credential *string) models.Secret {
+	return models.Secret{
+		Description:           utils.PointerTo("AWS key"),
+		FilePath:              utils.PointerTo(file),
+		Fingerprint:           utils.PointerTo(fmt.Sprintf("%s:aws:%d", file, line)),
+		StartLine:             utils.PointerTo(line),
+		EndLine:               utils.PointerTo(line),
+		StartColumn:           utils.PointerTo(1),
+		EndColumn:             utils.PointerTo(10),
+		CredentialFingerprint: credential,
+		Confidence:            utils.PointerTo(models.Medium),
+	}
+}
+
+func newOccurrence(file string, line int) models.SecretOccurrence {
+	return models.SecretOccurrence{
+		FilePath:    utils.PointerTo(file),
+		StartLine:   utils.PointerTo(line),
+		EndLine:     utils.PointerTo(line),
+		StartColumn: utils.PointerTo(1),
+		EndColumn:   utils.PointerTo(10),
+	}
+}
+
+func newSecretFindingInfo(secret models.Secret, count int, occurrences ...models.SecretOccurrence) models.SecretFindingInfo {
+	return models.SecretFindingInfo{
+		Description:           secret.Description,
+		FilePath:              secret.FilePath,
+		Fingerprint:           secret.Fingerprint,
+		StartLine:             secret.StartLine,
+		EndLine:               secret.EndLine,
+		StartColumn:           secret.StartColumn,
+		EndColumn:             secret.EndColumn,
+		CredentialFingerprint: secret.CredentialFingerprint,
+		Confidence:            secret.Confidence,
+		OccurrencesCount:      utils.PointerTo(count),
+		Occurrences:           &occurrences,
+	}
+}
+
+func Test_groupSecretsByCredential(t *testing.T) {
+	keyA := utils.PointerTo("a")
+	keyB := utils.PointerTo("b")
+
+	tests := []struct {
+		name           string
+		secrets        []models.Secret
+		maxOccurrences int
+		want           []models.SecretFindingInfo
+	}{
+		{
+			name:    "no secrets",
+			secrets: nil,
+			want:    nil,
+		},
+		{
+			name: "same credential is grouped",
+			secrets: []models.Secret{
+				newSecret("/etc/app.conf", 1, keyA),
+				newSecret("/etc/other.conf", 2, keyB),
+				newSecret("/backup/app.conf", 1, keyA),
+			},
+			want: []models.SecretFindingInfo{
+				newSecretFindingInfo(newSecret("/etc/app.conf", 1, keyA), 2,
+					newOccurrence("/etc/app.conf", 1), newOccurrence("/backup/app.conf", 1)),
+				newSecretFindingInfo(newSecret("/etc/other.conf", 2, keyB), 1,
+					newOccurrence("/etc/other.conf", 2)),
+			},
+		},
+		{
+			name: "secrets without credential fingerprint are not grouped",
+			secrets: []models.Secret{
+				newSecret("/etc/app.conf", 1, nil),
+				newSecret("/backup/app.conf", 1, nil),
+			},
+			want: []models.SecretFindingInfo{
+				newSecretFindingInfo(newSecret("/etc/app.conf", 1, nil), 1, newOccurrence("/etc/app.conf", 1)),
+				newSecretFindingInfo(newSecret("/backup/app.conf", 1, nil), 1, newOccurrence("/backup/app.conf", 1)),
+			},
+		},
+		{
+			name: "stored occurrences are capped",
+			secrets: []models.Secret{
+				newSecret("/a", 1, keyA),
+				newSecret("/b", 1, keyA),
+				newSecret("/c", 1, keyA),
+			},
+			maxOccurrences: 2,
+			want: []models.SecretFindingInfo{
+				newSecretFindingInfo(newSecret("/a", 1, keyA), 3, newOccurrence("/a", 1), newOccurrence("/b", 1)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := groupSecretsByCredential(tt.secrets, tt.maxOccurrences)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("groupSecretsByCredential() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanresultprocessor
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/findingkey"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const (
+	// TruncationPluginName is the plugin name of the warning findings
+	// reported for the scan families whose results were truncated by the
+	// backend.
+	TruncationPluginName = "vmclarity"
+
+	// truncationFindingType selects the truncation warnings, which are
+	// plugin findings, in findingTypeFilter.
+	truncationFindingType = "ResultTruncation"
+
+	truncationSeverity = "LOW"
+)
+
+// truncationFindingInfo returns the warning finding of the truncation.
+func truncationFindingInfo(truncation models.ScanResultTruncation) models.PluginFindingInfo {
+	return models.PluginFindingInfo{
+		PluginName: utils.PointerTo(TruncationPluginName),
+		FindingID:  utils.PointerTo(fmt.Sprintf("ResultTruncated:%s", truncation.Family)),
+		Title:      utils.PointerTo(fmt.Sprintf("The %s results of the scan were truncated", truncation.Family)),
+		Description: utils.PointerTo(fmt.Sprintf(
+			"Only %d of the %d %s findings of the scan were stored, the others were dropped as the scan result "+
+				"exceeded the maximum number of findings per scan family. The findings of the family are incomplete.",
+			truncation.Limit, truncation.Count, truncation.Family)),
+		Severity: utils.PointerTo(truncationSeverity),
+		Properties: &map[string]string{
+			"family": string(truncation.Family),
+			"limit":  strconv.Itoa(truncation.Limit),
+			"count":  strconv.Itoa(truncation.Count),
+		},
+	}
+}
+
+// reconcileResultTruncationsToFindings reports a warning finding for each
+// scan family of the scan result whose results were truncated, and
+// invalidates the warnings of the older scan results of the asset.
+func (srp *ScanResultProcessor) reconcileResultTruncationsToFindings(ctx context.Context, scanResult models.AssetScanResult, exceptions findingExceptions) error {
+	completedTime := scanResult.Status.General.LastTransitionTime
+
+	newerFound, newerTime, err := srp.newerExistingFindingTime(ctx, scanResult.Asset.Id, truncationFindingType, *completedTime)
+	if err != nil {
+		return fmt.Errorf("failed to check for newer existing truncation findings: %v", err)
+	}
+
+	existingMap, err := srp.getExistingPluginFindingsForScan(ctx, scanResult)
+	if err != nil {
+		return fmt.Errorf("failed to check existing truncation findings: %w", err)
+	}
+
+	var batch findingsBatch
+
+	if scanResult.Truncations != nil {
+		for _, truncation := range *scanResult.Truncations {
+			itemFindingInfo := truncationFindingInfo(truncation)
+
+			findingInfo := models.Finding_FindingInfo{}
+			err = findingInfo.FromPluginFindingInfo(itemFindingInfo)
+			if err != nil {
+				return fmt.Errorf("unable to convert PluginFindingInfo into FindingInfo: %w", err)
+			}
+
+			finding := models.Finding{
+				Scan:        scanResult.Scan,
+				Asset:       scanResult.Asset,
+				FoundOn:     scanResult.Status.General.LastTransitionTime,
+				FindingInfo: &findingInfo,
+			}
+
+			// Set InvalidatedOn time to the FoundOn time of the oldest
+			// finding, found after this scan result.
+			if newerFound {
+				finding.InvalidatedOn = &newerTime
+			}
+
+			// Flag the finding if it is suppressed by an exception.
+			exceptions.apply(&finding)
+
+			key := findingkey.GeneratePluginFindingKey(itemFindingInfo)
+			batch.add(existingMap[key], finding)
+		}
+	}
+
+	err = srp.saveFindings(ctx, batch)
+	if err != nil {
+		return fmt.Errorf("failed to save findings: %w", err)
+	}
+
+	// The warnings of the older scan results are invalidated even if this
+	// scan result reports no truncations, as its results are complete.
+	err = srp.invalidateOlderFindingsByType(ctx, truncationFindingType, scanResult.Asset.Id, *completedTime)
+	if err != nil {
+		return fmt.Errorf("failed to invalidate older truncation findings: %v", err)
+	}
+
+	return nil
+}
@@ -26,18 +26,18 @@ import (
 )
 
 // nolint:cyclop,gocognit
-func (srp *ScanResultProcessor) reconcileResultVulnerabilitiesToFindings(ctx context.Context, scanResult models.TargetScanResult) error {
+func (srp *ScanResultProcessor) reconcileResultVulnerabilitiesToFindings(ctx context.Context, scanResult models.AssetScanResult, exceptions findingExceptions) error {
 	logger := log.GetLoggerFromContextOrDiscard(ctx)
 
 	completedTime := scanResult.Status.General.LastTransitionTime
 
-	newerFound, newerTime, err := srp.newerExistingFindingTime(ctx, scanResult.Target.Id, "Vulnerability", *completedTime)
+	newerFound, newerTime, err := srp.newerExistingFindingTime(ctx, scanResult.Asset.Id, "Vulnerability", *completedTime)
 	if err != nil {
 		return fmt.Errorf("failed to check for newer existing vulnerability findings: %v", err)
 	}
 
 	existingFilter := fmt.Sprintf("findingInfo/objectType eq 'Vulnerability' and asset/id eq '%s' and scan/id eq '%s'",
-		scanResult.Target.Id, scanResult.Scan.Id)
+		scanResult.Asset.Id, scanResult.Scan.Id)
 	existingFindings, err := srp.client.GetFindings(ctx, models.GetFindingsParams{
 		Filter: &existingFilter,
 		Select: utils.PointerTo("id,findingInfo/vulnerabilityName,findingInfo/package/name,findingInfo/package/version"),
@@ -63,6 +63,8 @@ func (srp *ScanResultProcessor) reconcileResultVulnerabilitiesToFindings(ctx con
 	logger.Infof("Found %d existing vulnerabilities findings for this scan", len(existingMap))
 	logger.Debugf("Existing vulnerabilities map: %v", existingMap)
 
+	var batch findingsBatch
+
 	if scanResult.Vulnerabilities != nil && scanResult.Vulnerabilities.Vulnerabilities != nil {
 		// Create new findings for all the found vulnerabilities
 		for _, vuln := range *scanResult.Vulnerabilities.Vulnerabilities {
@@ -77,6 +79,13 @@ func (srp *ScanResultProcessor) reconcileResultVulnerabilitiesToFindings(ctx con
 				Fix:               vuln.Fix,
 				LayerId:           vuln.LayerId,
 				Path:              vuln.Path,
+				Scanners:          vuln.Scanners,
+			}
+			if fixVersion, ok := getFixVersion(vuln); ok {
+				vulFindingInfo.HasFix = utils.PointerTo(true)
+				vulFindingInfo.FixVersion = utils.PointerTo(fixVersion)
+			} else {
+				vulFindingInfo.HasFix = utils.PointerTo(false)
 			}
 
 			findingInfo := models.Finding_FindingInfo{}
@@ -86,10 +95,12 @@ func (srp *ScanResultProcessor) reconcileResultVulnerabilitiesToFindings(ctx con
 			}
 
 			finding := models.Finding{
-				Scan:        scanResult.Scan,
-				Asset:       scanResult.Target,
-				FoundOn:     scanResult.Status.General.LastTransitionTime,
-				FindingInfo: &findingInfo,
+				Scan:          scanResult.Scan,
+				Asset:         scanResult.Asset,
+				FoundOn:       scanResult.Status.General.LastTransitionTime,
+				FindingInfo:   &findingInfo,
+				ScannersCount: countDistinctScanners(vuln.Scanners),
+				Confidence:    findingConfidence(nil, vuln.Scanners),
 			}
 
 			// Set InvalidatedOn time to the FoundOn time of the oldest
@@ -98,56 +109,54 @@ func (srp *ScanResultProcessor) reconcileResultVulnerabilitiesToFindings(ctx con
 				finding.InvalidatedOn = &newerTime
 			}
 
+			// Flag the finding if it is suppressed by an exception.
+			exceptions.apply(&finding)
+
 			key := findingkey.GenerateVulnerabilityKey(vulFindingInfo)
-			if id, ok := existingMap[key]; ok {
-				err = srp.client.PatchFinding(ctx, id, finding)
-				if err != nil {
-					return fmt.Errorf("failed to create finding: %w", err)
-				}
-			} else {
-				_, err = srp.client.PostFinding(ctx, finding)
-				if err != nil {
-					return fmt.Errorf("failed to create finding: %w", err)
-				}
-			}
+			batch.add(existingMap[key], finding)
 		}
 	}
 
+	err = srp.saveFindings(ctx, batch)
+	if err != nil {
+		return fmt.Errorf("failed to save findings: %w", err)
+	}
+
 	// Invalidate any findings of this type for this asset where foundOn is
 	// older than this scan result, and has not already been invalidated by
 	// a scan result older than this scan result.
-	err = srp.invalidateOlderFindingsByType(ctx, "Vulnerability", scanResult.Target.Id, *completedTime)
+	err = srp.invalidateOlderFindingsByType(ctx, "Vulnerability", scanResult.Asset.Id, *completedTime)
 	if err != nil {
 		return fmt.Errorf("failed to invalidate older vulnerability finding: %v", err)
 	}
 
 	// Get all findings which aren't invalidated, and then update the asset's summary
-	target, err := srp.client.GetTarget(ctx, scanResult.Target.Id, models.GetTargetsTargetIDParams{})
+	target, err := srp.client.GetAsset(ctx, scanResult.Asset.Id, models.GetAssetsAssetIDParams{})
 	if err != nil {
-		return fmt.Errorf("failed to get target %s: %w", scanResult.Target.Id, err)
+		return fmt.Errorf("failed to get target %s: %w", scanResult.Asset.Id, err)
 	}
 
 	if target.Summary == nil {
 		target.Summary = &models.ScanFindingsSummary{}
 	}
 
-	critialVuls, err := srp.getActiveVulnerabilityFindingsCount(ctx, scanResult.Target.Id, models.CRITICAL)
+	critialVuls, err := srp.getActiveVulnerabilityFindingsCount(ctx, scanResult.Asset.Id, models.CRITICAL)
 	if err != nil {
 		return fmt.Errorf("failed to list active critial vulnerabilities: %w", err)
 	}
-	highVuls, err := srp.getActiveVulnerabilityFindingsCount(ctx, scanResult.Target.Id, models.HIGH)
+	highVuls, err := srp.getActiveVulnerabilityFindingsCount(ctx, scanResult.Asset.Id, models.HIGH)
 	if err != nil {
 		return fmt.Errorf("failed to list active high vulnerabilities: %w", err)
 	}
-	mediumVuls, err := srp.getActiveVulnerabilityFindingsCount(ctx, scanResult.Target.Id, models.MEDIUM)
+	mediumVuls, err := srp.getActiveVulnerabilityFindingsCount(ctx, scanResult.Asset.Id, models.MEDIUM)
 	if err != nil {
 		return fmt.Errorf("failed to list active medium vulnerabilities: %w", err)
 	}
-	lowVuls, err := srp.getActiveVulnerabilityFindingsCount(ctx, scanResult.Target.Id, models.LOW)
+	lowVuls, err := srp.getActiveVulnerabilityFindingsCount(ctx, scanResult.Asset.Id, models.LOW)
 	if err != nil {
 		return fmt.Errorf("failed to list active low vulnerabilities: %w", err)
 	}
-	negligibleVuls, err := srp.getActiveVulnerabilityFindingsCount(ctx, scanResult.Target.Id, models.NEGLIGIBLE)
+	negligibleVuls, err := srp.getActiveVulnerabilityFindingsCount(ctx, scanResult.Asset.Id, models.NEGLIGIBLE)
 	if err != nil {
 		return fmt.Errorf("failed to list active negligible vulnerabilities: %w", err)
 	}
@@ -160,16 +169,16 @@ func (srp *ScanResultProcessor) reconcileResultVulnerabilitiesToFindings(ctx con
 		TotalNegligibleVulnerabilities: &negligibleVuls,
 	}
 
-	err = srp.client.PatchTarget(ctx, target, scanResult.Target.Id)
+	err = srp.client.PatchAsset(ctx, target, scanResult.Asset.Id)
 	if err != nil {
-		return fmt.Errorf("failed to patch target %s: %w", scanResult.Target.Id, err)
+		return fmt.Errorf("failed to patch target %s: %w", scanResult.Asset.Id, err)
 	}
 
 	return nil
 }
 
 func (srp *ScanResultProcessor) getActiveVulnerabilityFindingsCount(ctx context.Context, assetID string, severity models.VulnerabilitySeverity) (int, error) {
-	filter := fmt.Sprintf("findingInfo/objectType eq 'Vulnerability' and asset/id eq '%s' and invalidatedOn eq null and findingInfo/severity eq '%s'", assetID, string(severity))
+	filter := fmt.Sprintf("findingInfo/objectType eq 'Vulnerability' and asset/id eq '%s' and invalidatedOn eq null and suppression eq null and findingInfo/severity eq '%s'", assetID, string(severity))
 	activeFindings, err := srp.client.GetFindings(ctx, models.GetFindingsParams{
 		Count:  utils.PointerTo(true),
 		Filter: &filter,
@@ -184,3 +193,28 @@ func (srp *ScanResultProcessor) getActiveVulnerabilityFindingsCount(ctx context.
 	}
 	return *activeFindings.Count, nil
 }
+
+// getFixVersion returns the lowest fix version which is newer than the
+// installed package version, if the vulnerability has a fix available.
+func getFixVersion(vuln models.Vulnerability) (string, bool) {
+	if vuln.Fix == nil || vuln.Fix.Versions == nil {
+		return "", false
+	}
+
+	var currentVersion string
+	if vuln.Package != nil && vuln.Package.Version != nil {
+		currentVersion = *vuln.Package.Version
+	}
+
+	var fixVersion string
+	for _, version := range *vuln.Fix.Versions {
+		if version == "" || utils.CompareVersions(version, currentVersion) <= 0 {
+			continue
+		}
+		if fixVersion == "" || utils.CompareVersions(version, fixVersion) < 0 {
+			fixVersion = version
+		}
+	}
+
+	return fixVersion, fixVersion != ""
+}
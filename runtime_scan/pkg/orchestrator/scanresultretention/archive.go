@@ -0,0 +1,58 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanresultretention
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Archive stores the archived scan result summaries.
+type Archive interface {
+	// Put stores data under key, replacing the existing data.
+	Put(ctx context.Context, key string, data []byte) error
+}
+
+// dirArchive stores the archived data as files in a directory, which is
+// expected to be a mounted object storage bucket or persistent volume.
+type dirArchive struct {
+	dir string
+}
+
+func newDirArchive(dir string) *dirArchive {
+	return &dirArchive{dir: dir}
+}
+
+func (a *dirArchive) Put(_ context.Context, key string, data []byte) error {
+	path := filepath.Join(a.dir, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil { // nolint:gomnd
+		return fmt.Errorf("failed to create archive directory: %w", err)
+	}
+
+	// The data is written to a temporary file first so that a partially
+	// written archive is never visible under key.
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil { // nolint:gomnd,gosec
+		return fmt.Errorf("failed to write archive: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to write archive: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanresultretention
+
+import (
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+)
+
+const day = 24 * time.Hour
+
+// Policy defines after how many days a done scan result is moved to each of
+// the retention tiers, zero disables the tier.
+type Policy struct {
+	SummaryOnlyAfterDays int
+	ArchiveAfterDays     int
+}
+
+// WithOverride returns the Policy with the values set in override.
+func (p Policy) WithOverride(override *models.ScanResultRetentionPolicy) Policy {
+	if override == nil {
+		return p
+	}
+	if override.SummaryOnlyAfterDays != nil {
+		p.SummaryOnlyAfterDays = *override.SummaryOnlyAfterDays
+	}
+	if override.ArchiveAfterDays != nil {
+		p.ArchiveAfterDays = *override.ArchiveAfterDays
+	}
+	return p
+}
+
+// DueTier returns the retention tier of a scan result which was done at
+// doneTime.
+func (p Policy) DueTier(doneTime, now time.Time) models.ScanResultRetentionTier {
+	age := now.Sub(doneTime)
+	switch {
+	case p.ArchiveAfterDays > 0 && age >= time.Duration(p.ArchiveAfterDays)*day:
+		return models.Archived
+	case p.SummaryOnlyAfterDays > 0 && age >= time.Duration(p.SummaryOnlyAfterDays)*day:
+		return models.SummaryOnly
+	default:
+		return models.Full
+	}
+}
+
+// tierRank orders the retention tiers, as a scan result only ever moves to a
+// tier with a higher rank.
+func tierRank(tier models.ScanResultRetentionTier) int {
+	switch tier {
+	case models.Full:
+		return 0
+	case models.SummaryOnly:
+		return 1
+	case models.Archived:
+		return 2 // nolint:gomnd
+	default:
+		return 0
+	}
+}
+
+// currentTier returns the retention tier of scanResult.
+func currentTier(scanResult models.AssetScanResult) models.ScanResultRetentionTier {
+	if scanResult.Retention == nil {
+		return models.Full
+	}
+	return scanResult.Retention.Tier
+}
+
+// compactScanResult returns scanResult without the raw results of the scan
+// families, which are only needed until the findings are processed.
+func compactScanResult(scanResult models.AssetScanResult) models.AssetScanResult {
+	return models.AssetScanResult{
+		Id:                   scanResult.Id,
+		Scan:                 scanResult.Scan,
+		Asset:                scanResult.Asset,
+		Status:               scanResult.Status,
+		Summary:              scanResult.Summary,
+		FindingsProcessed:    scanResult.FindingsProcessed,
+		ResourceCleanup:      scanResult.ResourceCleanup,
+		ScannerStartTime:     scanResult.ScannerStartTime,
+		ScannerEndTime:       scanResult.ScannerEndTime,
+		ScannerInstanceImage: scanResult.ScannerInstanceImage,
+		RerunFamilies:        scanResult.RerunFamilies,
+		DeltaScan:            scanResult.DeltaScan,
+		Annotations:          scanResult.Annotations,
+		Retention:            scanResult.Retention,
+	}
+}
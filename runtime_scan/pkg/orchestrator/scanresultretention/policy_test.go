@@ -0,0 +1,143 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanresultretention
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestPolicy_DueTier(t *testing.T) {
+	now := time.Date(2023, 6, 30, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		policy   Policy
+		override *models.ScanResultRetentionPolicy
+		doneTime time.Time
+		want     models.ScanResultRetentionTier
+	}{
+		{
+			name:     "disabled",
+			policy:   Policy{},
+			doneTime: now.Add(-365 * day),
+			want:     models.Full,
+		},
+		{
+			name:     "not due yet",
+			policy:   Policy{SummaryOnlyAfterDays: 30, ArchiveAfterDays: 90},
+			doneTime: now.Add(-29 * day),
+			want:     models.Full,
+		},
+		{
+			name:     "summary only due",
+			policy:   Policy{SummaryOnlyAfterDays: 30, ArchiveAfterDays: 90},
+			doneTime: now.Add(-30 * day),
+			want:     models.SummaryOnly,
+		},
+		{
+			name:     "archive due",
+			policy:   Policy{SummaryOnlyAfterDays: 30, ArchiveAfterDays: 90},
+			doneTime: now.Add(-100 * day),
+			want:     models.Archived,
+		},
+		{
+			name:     "archive due without summary only tier",
+			policy:   Policy{ArchiveAfterDays: 90},
+			doneTime: now.Add(-100 * day),
+			want:     models.Archived,
+		},
+		{
+			name:     "scan config override",
+			policy:   Policy{SummaryOnlyAfterDays: 30, ArchiveAfterDays: 90},
+			override: &models.ScanResultRetentionPolicy{SummaryOnlyAfterDays: utils.PointerTo(7)},
+			doneTime: now.Add(-10 * day),
+			want:     models.SummaryOnly,
+		},
+		{
+			name:     "scan config override disables tier",
+			policy:   Policy{SummaryOnlyAfterDays: 30, ArchiveAfterDays: 90},
+			override: &models.ScanResultRetentionPolicy{ArchiveAfterDays: utils.PointerTo(0)},
+			doneTime: now.Add(-100 * day),
+			want:     models.SummaryOnly,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.policy.WithOverride(tt.override).DueTier(tt.doneTime, now)
+			if got != tt.want {
+				t.Errorf("DueTier() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_compactScanResult(t *testing.T) {
+	scanResult := models.AssetScanResult{
+		Id:                utils.PointerTo("result-1"),
+		Revision:          utils.PointerTo(3),
+		Scan:              &models.ScanRelationship{Id: "scan-1"},
+		Asset:             &models.AssetRelationship{Id: "target-1"},
+		FindingsProcessed: utils.PointerTo(true),
+		Summary:           &models.ScanFindingsSummary{TotalSecrets: utils.PointerTo(1)},
+		Secrets: &models.SecretScan{
+			Secrets: &[]models.Secret{{Fingerprint: utils.PointerTo("fingerprint")}},
+		},
+		Sboms: &models.SbomScan{
+			Packages: &[]models.Package{{Name: utils.PointerTo("openssl")}},
+		},
+	}
+
+	want := models.AssetScanResult{
+		Id:                utils.PointerTo("result-1"),
+		Scan:              &models.ScanRelationship{Id: "scan-1"},
+		Asset:             &models.AssetRelationship{Id: "target-1"},
+		FindingsProcessed: utils.PointerTo(true),
+		Summary:           &models.ScanFindingsSummary{TotalSecrets: utils.PointerTo(1)},
+	}
+
+	if diff := cmp.Diff(want, compactScanResult(scanResult)); diff != "" {
+		t.Errorf("compactScanResult() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_dirArchive_Put(t *testing.T) {
+	dir := t.TempDir()
+	archive := newDirArchive(dir)
+
+	if err := archive.Put(context.Background(), "scanResults/result-1.json", []byte("{}")); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if err := archive.Put(context.Background(), "scanResults/result-1.json", []byte(`{"id":"result-1"}`)); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	got, err := os.ReadFile(filepath.Join(dir, "scanResults", "result-1.json"))
+	if err != nil {
+		t.Fatalf("failed to read archive: %v", err)
+	}
+	if string(got) != `{"id":"result-1"}` {
+		t.Errorf("archived data = %s, want %s", got, `{"id":"result-1"}`)
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanresultretention
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/common"
+	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// Watcher periodically moves the done ScanResults to the retention tier due
+// according to the retention policy of their ScanConfig, so that the database
+// growth stays bounded. The raw results of the scan families are dropped
+// first, then the summaries are moved to the archive.
+type Watcher struct {
+	backend          *backendclient.BackendClient
+	pollPeriod       time.Duration
+	reconcileTimeout time.Duration
+	defaultPolicy    Policy
+	archive          Archive
+}
+
+func New(c Config) *Watcher {
+	var archive Archive
+	if c.ArchiveDir != "" {
+		archive = newDirArchive(c.ArchiveDir)
+	}
+
+	return &Watcher{
+		backend:          c.Backend,
+		pollPeriod:       c.PollPeriod,
+		reconcileTimeout: c.ReconcileTimeout,
+		defaultPolicy:    c.DefaultPolicy,
+		archive:          archive,
+	}
+}
+
+type ScanResultRetentionEvent struct {
+	ScanResultID models.ScanResultID
+	Tier         models.ScanResultRetentionTier
+}
+
+func (e ScanResultRetentionEvent) ToFields() logrus.Fields {
+	return logrus.Fields{
+		"ScanResultID": e.ScanResultID,
+		"Tier":         e.Tier,
+	}
+}
+
+func (e ScanResultRetentionEvent) String() string {
+	return fmt.Sprintf("ScanResultID=%s Tier=%s", e.ScanResultID, e.Tier)
+}
+
+func (e ScanResultRetentionEvent) Hash() string {
+	return e.ScanResultID
+}
+
+func (w *Watcher) GetItems(ctx context.Context) ([]ScanResultRetentionEvent, error) {
+	policies, err := w.getScanConfigPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The raw results are only dropped once the findings were created from them.
+	filter := fmt.Sprintf("status/general/state eq '%s' and findingsProcessed eq true and (retention eq null or retention/tier ne '%s')",
+		models.AssetScanStateStateDone, models.Archived)
+	scanResults, err := w.backend.GetScanResults(ctx, models.GetScanResultsParams{
+		Filter: &filter,
+		Select: utils.PointerTo("id,scan,status/general,retention"),
+		Expand: utils.PointerTo("scan($select=scanConfig)"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scan results from API: %w", err)
+	}
+	if scanResults.Items == nil {
+		return []ScanResultRetentionEvent{}, nil
+	}
+
+	now := time.Now()
+	items := make([]ScanResultRetentionEvent, 0)
+	for _, scanResult := range *scanResults.Items {
+		if scanResult.Id == nil {
+			continue
+		}
+		if scanResult.Status == nil || scanResult.Status.General == nil || scanResult.Status.General.LastTransitionTime == nil {
+			continue
+		}
+
+		policy := w.defaultPolicy
+		if scanResult.Scan != nil && scanResult.Scan.ScanConfig != nil {
+			policy = policy.WithOverride(policies[scanResult.Scan.ScanConfig.Id])
+		}
+		if w.archive == nil {
+			policy.ArchiveAfterDays = 0
+		}
+
+		tier := policy.DueTier(*scanResult.Status.General.LastTransitionTime, now)
+		if tierRank(tier) <= tierRank(currentTier(scanResult)) {
+			continue
+		}
+		items = append(items, ScanResultRetentionEvent{
+			ScanResultID: *scanResult.Id,
+			Tier:         tier,
+		})
+	}
+
+	return items, nil
+}
+
+// getScanConfigPolicies returns the retention policy overrides by ScanConfig ID.
+func (w *Watcher) getScanConfigPolicies(ctx context.Context) (map[string]*models.ScanResultRetentionPolicy, error) {
+	scanConfigs, err := w.backend.GetScanConfigs(ctx, models.GetScanConfigsParams{
+		Filter: utils.PointerTo("retentionPolicy ne null"),
+		Select: utils.PointerTo("id,retentionPolicy"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scan configs from API: %w", err)
+	}
+
+	policies := make(map[string]*models.ScanResultRetentionPolicy)
+	if scanConfigs.Items == nil {
+		return policies, nil
+	}
+	for _, scanConfig := range *scanConfigs.Items {
+		if scanConfig.Id == nil {
+			continue
+		}
+		policies[*scanConfig.Id] = scanConfig.RetentionPolicy
+	}
+
+	return policies, nil
+}
+
+func (w *Watcher) Reconcile(ctx context.Context, event ScanResultRetentionEvent) error {
+	logger := log.GetLoggerFromContextOrDiscard(ctx).WithFields(event.ToFields())
+
+	scanResult, err := w.backend.GetScanResult(ctx, event.ScanResultID, models.GetScanResultsScanResultIDParams{})
+	if err != nil {
+		return fmt.Errorf("failed to get scan result %s: %w", event.ScanResultID, err)
+	}
+	if tierRank(event.Tier) <= tierRank(currentTier(scanResult)) {
+		return nil
+	}
+
+	compacted := compactScanResult(scanResult)
+	retention := &models.ScanResultRetention{
+		Tier:           event.Tier,
+		TransitionTime: utils.PointerTo(time.Now()),
+	}
+
+	if event.Tier == models.Archived {
+		if w.archive == nil {
+			return fmt.Errorf("no archive configured for scan result %s", event.ScanResultID)
+		}
+
+		key := fmt.Sprintf("scanResults/%s.json", event.ScanResultID)
+		data, err := json.Marshal(compacted)
+		if err != nil {
+			return fmt.Errorf("failed to marshal scan result %s: %w", event.ScanResultID, err)
+		}
+		if err := w.archive.Put(ctx, key, data); err != nil {
+			return fmt.Errorf("failed to archive scan result %s: %w", event.ScanResultID, err)
+		}
+
+		compacted.Summary = nil
+		retention.ArchiveKey = &key
+	}
+	compacted.Retention = retention
+
+	logger.Infof("Moving scan result to retention tier")
+	// The revision guards against dropping results which were changed since they were read.
+	err = w.backend.PutScanResult(ctx, compacted, event.ScanResultID, scanResult.Revision)
+	if err != nil {
+		return fmt.Errorf("failed to update scan result %s: %w", event.ScanResultID, err)
+	}
+
+	return nil
+}
+
+func (w *Watcher) Start(ctx context.Context) {
+	logger := log.GetLoggerFromContextOrDiscard(ctx).WithField("controller", "ScanResultRetentionWatcher")
+	ctx = log.SetLoggerForContext(ctx, logger)
+
+	queue := common.NewQueue[ScanResultRetentionEvent]()
+
+	poller := common.Poller[ScanResultRetentionEvent]{
+		PollPeriod: w.pollPeriod,
+		GetItems:   w.GetItems,
+		Queue:      queue,
+	}
+	poller.Start(ctx)
+
+	reconciler := common.Reconciler[ScanResultRetentionEvent]{
+		ReconcileFunction: w.Reconcile,
+		ReconcileTimeout:  w.reconcileTimeout,
+		Queue:             queue,
+	}
+	reconciler.Start(ctx)
+}
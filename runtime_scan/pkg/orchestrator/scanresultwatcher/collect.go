@@ -0,0 +1,122 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanresultwatcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/common"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// reconcileRunning collects the results of the scan from the provider once
+// the Scanner is done if the provider is a ResultCollector, as its Scanners
+// don't export them to the backend. The results of the families are uploaded
+// before the ScanResult is set to done, so that they are all available once it
+// is.
+// nolint:cyclop
+func (w *Watcher) reconcileRunning(ctx context.Context, scanResult *models.AssetScanResult) error {
+	collector, ok := provider.ResultCollectorOf(w.provider)
+	if !ok {
+		// TODO(chrisgacsal): make sure that AssetScanResult state is set to ABORTED state once the AssetScanResult
+		//                    schema is extended with timeout field and the deadline is missed.
+		return nil
+	}
+
+	scanResultID, ok := scanResult.GetID()
+	if !ok {
+		return errors.New("invalid ScanResult: ID is nil")
+	}
+
+	if scanResult.Scan == nil || scanResult.Scan.ScanConfigSnapshot == nil {
+		return errors.New("invalid ScanResult: Scan or ScanConfigSnapshot is nil")
+	}
+
+	if scanResult.Asset == nil || scanResult.Asset.AssetInfo == nil {
+		return errors.New("invalid ScanResult: Target or AssetInfo is nil")
+	}
+
+	// The ScanResult is aborted together with the Scan, the Scanner is
+	// removed by the cleanup then.
+	if isScanStopped(scanResult.Scan) {
+		log.GetLoggerFromContextOrDiscard(ctx).Info("Reconciliation is skipped as the Scan has been stopped")
+		return nil
+	}
+
+	jobConfig, err := newJobConfig(&jobConfigInput{
+		config:     &w.scannerConfig,
+		scanResult: scanResult,
+		scanConfig: scanResult.Scan.ScanConfigSnapshot,
+		target:     newTargetFromScanResult(scanResult),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create ScanJobConfig for ScanResult. ScanResult=%s: %w", scanResultID, err)
+	}
+
+	results, err := collector.CollectTargetScanResults(w.withOperationRecorder(ctx, jobConfig), jobConfig)
+
+	general := scanResult.Status.General
+	var fatalError provider.FatalError
+	var retryableError provider.RetryableError
+	switch {
+	case errors.As(err, &fatalError):
+		general.Errors = utils.PointerTo(append(utils.ValueOrZero(general.Errors), fatalError.Error()))
+	case errors.As(err, &retryableError):
+		// nolint:wrapcheck
+		return common.NewRequeueAfterError(retryableError.RetryAfter(), retryableError.Error())
+	case err != nil:
+		general.Errors = utils.PointerTo(append(utils.ValueOrZero(general.Errors), utils.UnwrapErrorStrings(err)...))
+	case results == nil:
+		// The Scanners of the provider export the results themselves.
+		return nil
+	default:
+		for _, familyResult := range results.Families {
+			// A nil json.RawMessage is not a nil interface.
+			var result interface{}
+			if len(familyResult.Result) > 0 {
+				result = familyResult.Result
+			}
+			err = w.backend.UploadFamilyResult(ctx, scanResultID, familyResult.Family, result, familyResult.State, familyResult.Summary)
+			if err != nil {
+				return fmt.Errorf("failed to upload %s result of ScanResult. ScanResult=%s: %w", familyResult.Family, scanResultID, err)
+			}
+		}
+		if results.General.Errors != nil {
+			general.Errors = utils.PointerTo(append(utils.ValueOrZero(general.Errors), *results.General.Errors...))
+		}
+	}
+
+	general.State = utils.PointerTo(models.AssetScanStateStateDone)
+	general.LastTransitionTime = utils.PointerTo(w.clock.Now())
+
+	// Only the general state is patched as the states of the families are
+	// set along with their results.
+	scanResultPatch := models.AssetScanResult{
+		Status: &models.AssetScanStatus{
+			General: general,
+		},
+	}
+	if err = w.backend.PatchScanResult(ctx, scanResultPatch, scanResultID); err != nil {
+		return fmt.Errorf("failed to update ScanResult. ScanResult=%s: %w", scanResultID, err)
+	}
+
+	return nil
+}
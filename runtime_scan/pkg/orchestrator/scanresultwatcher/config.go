@@ -18,6 +18,7 @@ package scanresultwatcher
 import (
 	"time"
 
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/common"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
 	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
 )
@@ -25,6 +26,12 @@ import (
 const (
 	DefaultPollInterval     = time.Minute
 	DefaultReconcileTimeout = 5 * time.Minute
+	// DefaultSnapshotPrewarmConcurrency is kept below the AWS quota of 20
+	// concurrent snapshot copies per destination region.
+	DefaultSnapshotPrewarmConcurrency = 5
+	// DefaultMaxConcurrentScanners doesn't limit the number of Scanners
+	// across the Scans.
+	DefaultMaxConcurrentScanners = 0
 )
 
 type Config struct {
@@ -33,6 +40,16 @@ type Config struct {
 	PollPeriod       time.Duration
 	ReconcileTimeout time.Duration
 	ScannerConfig    ScannerConfig
+	// SnapshotPrewarmConcurrency is the maximum number of target volume
+	// snapshots created in parallel for the ScanResults waiting for a free
+	// Scanner slot. Pre-warming snapshots is disabled if it is 0.
+	SnapshotPrewarmConcurrency int
+	// MaxConcurrentScanners is the maximum number of Scanners running at the
+	// same time across all the Scans, unlimited if it is 0.
+	MaxConcurrentScanners int
+
+	// Clock is the source of time of the Watcher, RealClock if nil.
+	Clock common.Clock
 }
 
 func (c Config) WithBackendClient(b *backendclient.BackendClient) Config {
@@ -45,6 +62,11 @@ func (c Config) WithProviderClient(p provider.Provider) Config {
 	return c
 }
 
+func (c Config) WithClock(clock common.Clock) Config {
+	c.Clock = clock
+	return c
+}
+
 func (c Config) WithReconcileTimeout(t time.Duration) Config {
 	c.ReconcileTimeout = t
 	return c
@@ -73,6 +95,11 @@ type ScannerConfig struct {
 	TrivyServerAddress string
 	TrivyServerTimeout time.Duration
 
+	// The trivy vulnerability DB cache directory in the scanner image
+	// container, and whether trivy scans with it without updating it.
+	TrivyCacheDir string
+	TrivyOffline  bool
+
 	GrypeServerAddress string
 	GrypeServerTimeout time.Duration
 
@@ -95,9 +122,23 @@ type ScannerConfig struct {
 	// The freshclam mirror url to use if it's enabled
 	AlternativeFreshclamMirrorURL string
 
+	// The yara binary path in the scanner image container.
+	YaraBinaryPath string
+
+	// The yarac binary path in the scanner image container.
+	YaracBinaryPath string
+
+	// The sources of the YARA rules, the YARA scanner is only enabled if
+	// there are any.
+	YaraRuleSources []string
+
 	// The location where Lynis is installed in the scanner image
 	LynisInstallPath string
 
 	// The chkrootkit binary path in the scanner image container.
 	ChkrootkitBinaryPath string
+
+	// The file listing the known good hashes of bootloaders and kernels in
+	// the scanner image container.
+	BootIntegrityKnownGoodHashesFile string
 }
@@ -26,7 +26,7 @@ import (
 type ScanResultReconcileEvent struct {
 	ScanResultID models.ScanResultID
 	ScanID       models.ScanID
-	TargetID     models.TargetID
+	TargetID     models.AssetID
 }
 
 func (e ScanResultReconcileEvent) ToFields() log.Fields {
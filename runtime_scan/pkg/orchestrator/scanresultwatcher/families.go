@@ -16,19 +16,30 @@
 package scanresultwatcher
 
 import (
+	"time"
+
 	"github.com/anchore/syft/syft/source"
 	kubeclarityConfig "github.com/openclarity/kubeclarity/shared/pkg/config"
 
 	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/shared/pkg/families"
+	"github.com/openclarity/vmclarity/shared/pkg/families/certificates"
+	certinspectorConfig "github.com/openclarity/vmclarity/shared/pkg/families/certificates/certinspector/config"
+	certificatesCommon "github.com/openclarity/vmclarity/shared/pkg/families/certificates/common"
+	"github.com/openclarity/vmclarity/shared/pkg/families/compliance"
+	cisbenchmarkConfig "github.com/openclarity/vmclarity/shared/pkg/families/compliance/cisbenchmark/config"
+	complianceCommon "github.com/openclarity/vmclarity/shared/pkg/families/compliance/common"
 	"github.com/openclarity/vmclarity/shared/pkg/families/exploits"
 	exploitsCommon "github.com/openclarity/vmclarity/shared/pkg/families/exploits/common"
 	exploitdbConfig "github.com/openclarity/vmclarity/shared/pkg/families/exploits/exploitdb/config"
 	"github.com/openclarity/vmclarity/shared/pkg/families/malware"
 	clamconfig "github.com/openclarity/vmclarity/shared/pkg/families/malware/clam/config"
 	malwarecommon "github.com/openclarity/vmclarity/shared/pkg/families/malware/common"
+	yaraconfig "github.com/openclarity/vmclarity/shared/pkg/families/malware/yara/config"
 	misconfiguration "github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration/types"
+	"github.com/openclarity/vmclarity/shared/pkg/families/plugins"
 	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits"
+	bootintegrityConfig "github.com/openclarity/vmclarity/shared/pkg/families/rootkits/bootintegrity/config"
 	chkrootkitConfig "github.com/openclarity/vmclarity/shared/pkg/families/rootkits/chkrootkit/config"
 	rootkitsCommon "github.com/openclarity/vmclarity/shared/pkg/families/rootkits/common"
 	"github.com/openclarity/vmclarity/shared/pkg/families/sbom"
@@ -36,6 +47,8 @@ import (
 	secretscommon "github.com/openclarity/vmclarity/shared/pkg/families/secrets/common"
 	gitleaksconfig "github.com/openclarity/vmclarity/shared/pkg/families/secrets/gitleaks/config"
 	"github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities"
+	"github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities/trivy"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
 type FamiliesConfigOption func(*families.Config)
@@ -93,9 +106,8 @@ func withVulnerabilities(config *models.VulnerabilitiesConfig, opts *ScannerConf
 		}
 
 		c.Vulnerabilities = vulnerabilities.Config{
-			Enabled: true,
-			// TODO(sambetts) This choice should come from the user's configuration
-			ScannersList:  []string{"grype", "trivy"},
+			Enabled:       true,
+			ScannersList:  vulnerabilityScanners(config.Scanners),
 			InputFromSbom: false, // will be determined by the CLI.
 			ScannersConfig: &kubeclarityConfig.Config{
 				// TODO(sambetts) The user needs to be able to provide this configuration
@@ -108,10 +120,45 @@ func withVulnerabilities(config *models.VulnerabilitiesConfig, opts *ScannerConf
 					},
 				},
 			},
+			IgnoreRules: vulnerabilityIgnoreRules(config.IgnoreRules),
+			TrivyConfig: trivy.Config{
+				CacheDir: opts.TrivyCacheDir,
+				Offline:  opts.TrivyOffline,
+			},
 		}
 	}
 }
 
+// vulnerabilityScanners returns the scanners selected in the config, all of
+// them if none is.
+func vulnerabilityScanners(scanners *[]models.VulnerabilityScanner) []string {
+	if scanners == nil || len(*scanners) == 0 {
+		return []string{string(models.Grype), string(models.Trivy)}
+	}
+
+	ret := make([]string, 0, len(*scanners))
+	for _, s := range *scanners {
+		ret = append(ret, string(s))
+	}
+	return ret
+}
+
+func vulnerabilityIgnoreRules(rules *[]models.VulnerabilityIgnoreRule) []vulnerabilities.IgnoreRule {
+	if rules == nil {
+		return nil
+	}
+
+	ret := make([]vulnerabilities.IgnoreRule, 0, len(*rules))
+	for _, r := range *rules {
+		ret = append(ret, vulnerabilities.IgnoreRule{
+			Vulnerability:  r.Vulnerability,
+			PackageName:    utils.ValueOrZero(r.PackageName),
+			PackageVersion: utils.ValueOrZero(r.PackageVersion),
+		})
+	}
+	return ret
+}
+
 func withSecretsConfig(config *models.SecretsConfig, opts *ScannerConfig) FamiliesConfigOption {
 	return func(c *families.Config) {
 		if !config.IsEnabled() {
@@ -120,18 +167,35 @@ func withSecretsConfig(config *models.SecretsConfig, opts *ScannerConfig) Famili
 
 		c.Secrets = secrets.Config{
 			Enabled: true,
+			Redact:  utils.ValueOrZero(config.Redact),
 			// TODO(idanf) This choice should come from the user's configuration
 			ScannersList: []string{"gitleaks"},
 			Inputs:       nil, // rootfs directory will be determined by the CLI after mount.
 			ScannersConfig: &secretscommon.ScannersConfig{
 				Gitleaks: gitleaksconfig.Config{
 					BinaryPath: opts.GitleaksBinaryPath,
+					Allowlist:  secretsAllowlist(config.Allowlist),
 				},
 			},
 		}
 	}
 }
 
+func secretsAllowlist(allowlist *[]models.SecretAllowlistEntry) []gitleaksconfig.AllowlistEntry {
+	if allowlist == nil {
+		return nil
+	}
+
+	ret := make([]gitleaksconfig.AllowlistEntry, 0, len(*allowlist))
+	for _, e := range *allowlist {
+		ret = append(ret, gitleaksconfig.AllowlistEntry{
+			CredentialFingerprint: e.CredentialFingerprint,
+			FilePath:              utils.ValueOrZero(e.FilePath),
+		})
+	}
+	return ret
+}
+
 func withExploitsConfig(config *models.ExploitsConfig, opts *ScannerConfig) FamiliesConfigOption {
 	return func(c *families.Config) {
 		if !config.IsEnabled() {
@@ -157,9 +221,14 @@ func withMalwareConfig(config *models.MalwareConfig, opts *ScannerConfig) Famili
 			return
 		}
 
+		scanners := []string{"clam"}
+		if len(opts.YaraRuleSources) > 0 {
+			scanners = append(scanners, "yara")
+		}
+
 		c.Malware = malware.Config{
 			Enabled:      true,
-			ScannersList: []string{"clam"},
+			ScannersList: scanners,
 			Inputs:       nil, // rootfs directory will be determined by the CLI after mount.
 			ScannersConfig: &malwarecommon.ScannersConfig{
 				Clam: clamconfig.Config{
@@ -167,6 +236,11 @@ func withMalwareConfig(config *models.MalwareConfig, opts *ScannerConfig) Famili
 					FreshclamBinaryPath:           opts.FreshclamBinaryPath,
 					AlternativeFreshclamMirrorURL: opts.AlternativeFreshclamMirrorURL,
 				},
+				Yara: yaraconfig.Config{
+					YaraBinaryPath:  opts.YaraBinaryPath,
+					YaracBinaryPath: opts.YaracBinaryPath,
+					RuleSources:     opts.YaraRuleSources,
+				},
 			},
 		}
 	}
@@ -187,12 +261,25 @@ func withMisconfigurationConfig(config *models.MisconfigurationsConfig, opts *Sc
 				// TODO(sambetts) Add scanner configurations here as we add them like Lynis
 				Lynis: misconfiguration.LynisConfig{
 					InstallPath: opts.LynisInstallPath,
+					SkipTests:   lynisSkipTests(config.SkipTests),
 				},
 			},
 		}
 	}
 }
 
+func lynisSkipTests(skipTests *[]models.MisconfigurationSkipTest) []string {
+	if skipTests == nil {
+		return nil
+	}
+
+	ret := make([]string, 0, len(*skipTests))
+	for _, t := range *skipTests {
+		ret = append(ret, t.TestID)
+	}
+	return ret
+}
+
 func withRootkitsConfig(config *models.RootkitsConfig, opts *ScannerConfig) FamiliesConfigOption {
 	return func(c *families.Config) {
 		if !config.IsEnabled() {
@@ -201,17 +288,125 @@ func withRootkitsConfig(config *models.RootkitsConfig, opts *ScannerConfig) Fami
 
 		c.Rootkits = rootkits.Config{
 			Enabled:      true,
-			ScannersList: []string{"chkrootkit"},
+			ScannersList: []string{"chkrootkit", "bootintegrity"},
 			Inputs:       nil,
 			ScannersConfig: &rootkitsCommon.ScannersConfig{
 				Chkrootkit: chkrootkitConfig.Config{
 					BinaryPath: opts.ChkrootkitBinaryPath,
 				},
+				BootIntegrity: bootintegrityConfig.Config{
+					KnownGoodHashesFile: opts.BootIntegrityKnownGoodHashesFile,
+				},
 			},
 		}
 	}
 }
 
+func withCertificatesConfig(config *models.CertificatesConfig) FamiliesConfigOption {
+	return func(c *families.Config) {
+		if !config.IsEnabled() {
+			return
+		}
+
+		var expiryWarningDays int
+		if config.ExpiryWarningDays != nil {
+			expiryWarningDays = *config.ExpiryWarningDays
+		}
+
+		c.Certificates = certificates.Config{
+			Enabled:      true,
+			ScannersList: []string{"certinspector"},
+			Inputs:       nil, // rootfs directory will be determined by the CLI after mount.
+			ScannersConfig: &certificatesCommon.ScannersConfig{
+				CertInspector: certinspectorConfig.Config{
+					ExpiryWarningDays: expiryWarningDays,
+				},
+			},
+		}
+	}
+}
+
+func withComplianceConfig(config *models.ComplianceConfig) FamiliesConfigOption {
+	return func(c *families.Config) {
+		if !config.IsEnabled() {
+			return
+		}
+
+		var benchmarks []string
+		if config.Benchmarks != nil {
+			for _, b := range *config.Benchmarks {
+				benchmarks = append(benchmarks, string(b))
+			}
+		}
+
+		c.Compliance = compliance.Config{
+			Enabled:      true,
+			ScannersList: []string{"cisbenchmark"},
+			Inputs:       nil, // rootfs directory will be determined by the CLI after mount.
+			ScannersConfig: &complianceCommon.ScannersConfig{
+				CISBenchmark: cisbenchmarkConfig.Config{
+					Benchmarks: benchmarks,
+				},
+			},
+		}
+	}
+}
+
+func withPluginsConfig(config *models.PluginsConfig) FamiliesConfigOption {
+	return func(c *families.Config) {
+		if !config.IsEnabled() || config.Plugins == nil {
+			return
+		}
+
+		var pluginConfigs []plugins.PluginConfig
+		for _, p := range *config.Plugins {
+			pluginConfig := plugins.PluginConfig{
+				Name:    p.Name,
+				Command: p.Command,
+			}
+			if p.Args != nil {
+				pluginConfig.Args = *p.Args
+			}
+			if p.Config != nil {
+				pluginConfig.Config = *p.Config
+			}
+			if p.TimeoutSeconds != nil {
+				pluginConfig.Timeout = time.Duration(*p.TimeoutSeconds) * time.Second
+			}
+			pluginConfigs = append(pluginConfigs, pluginConfig)
+		}
+
+		c.Plugins = plugins.Config{
+			Enabled: true,
+			Plugins: pluginConfigs,
+			Inputs:  nil, // rootfs directory will be determined by the CLI after mount.
+		}
+	}
+}
+
+// withExcludedPaths configures the families walking the filesystem to exclude
+// the paths in addition to their default excluded paths.
+func withExcludedPaths(paths *[]string) FamiliesConfigOption {
+	return func(c *families.Config) {
+		if paths == nil {
+			return
+		}
+
+		c.SBOM.ExcludedPaths = *paths
+		if c.Secrets.ScannersConfig != nil {
+			c.Secrets.ScannersConfig.Gitleaks.ExcludedPaths = *paths
+		}
+		if c.Malware.ScannersConfig != nil {
+			c.Malware.ScannersConfig.Clam.ExcludedPaths = *paths
+			c.Malware.ScannersConfig.Yara.ExcludedPaths = *paths
+		}
+		if c.Certificates.ScannersConfig != nil {
+			c.Certificates.ScannersConfig.CertInspector.ExcludedPaths = *paths
+		}
+		c.Plugins.ExcludedPaths = *paths
+	}
+}
+
 func NewFamiliesConfigFrom(config *ScannerConfig, scanConfig *models.ScanConfigSnapshot) *families.Config {
 	c := families.NewConfig()
 
@@ -223,6 +418,11 @@ func NewFamiliesConfigFrom(config *ScannerConfig, scanConfig *models.ScanConfigS
 		withMalwareConfig(scanConfig.ScanFamiliesConfig.Malware, config),
 		withMisconfigurationConfig(scanConfig.ScanFamiliesConfig.Misconfigurations, config),
 		withRootkitsConfig(scanConfig.ScanFamiliesConfig.Rootkits, config),
+		withCertificatesConfig(scanConfig.ScanFamiliesConfig.Certificates),
+		withComplianceConfig(scanConfig.ScanFamiliesConfig.Compliance),
+		withPluginsConfig(scanConfig.ScanFamiliesConfig.Plugins),
+		// Must come after the families it configures.
+		withExcludedPaths(scanConfig.ScanFamiliesConfig.ExcludedPaths),
 	}
 
 	for _, o := range opts {
@@ -18,6 +18,7 @@ package scanresultwatcher
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"gopkg.in/yaml.v3"
 
@@ -28,9 +29,9 @@ import (
 
 type jobConfigInput struct {
 	config     *ScannerConfig
-	scanResult *models.TargetScanResult
+	scanResult *models.AssetScanResult
 	scanConfig *models.ScanConfigSnapshot
-	target     *models.Target
+	target     *models.Asset
 }
 
 func (i *jobConfigInput) Validate() error {
@@ -67,23 +68,160 @@ func newJobConfig(i *jobConfigInput) (*provider.ScanJobConfig, error) {
 		instanceCreationConfig = *i.scanConfig.ScannerInstanceCreationConfig
 	}
 
-	scannerConfig := NewFamiliesConfigFrom(i.config, i.scanConfig)
+	scanConfig := i.scanConfig
+	if i.scanResult.RerunFamilies != nil {
+		// Only the requested families are run when the ScanResult is re-run,
+		// with the same configuration as in the original scan.
+		snapshot := *i.scanConfig
+		snapshot.ScanFamiliesConfig = i.scanConfig.ScanFamiliesConfig.WithOnlyFamilies(*i.scanResult.RerunFamilies)
+		scanConfig = &snapshot
+	}
+
+	// Snapshots of failed scans must not become the baseline of the next
+	// delta scan, otherwise their changes would never be scanned.
+	deltaScan := i.scanConfig.DeltaScanEnabled != nil && *i.scanConfig.DeltaScanEnabled
+	if isDone, ok := i.scanResult.IsDone(); ok && isDone && i.scanResult.HasErrors() {
+		deltaScan = false
+	}
+
+	scannerConfig := NewFamiliesConfigFrom(i.config, scanConfig)
 	scannerConfigYAML, err := yaml.Marshal(scannerConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert ScannerConfig to YAML for ScanResult with %s id",
 			*i.scanResult.Id)
 	}
 
+	inputImage, err := newInputImage(i.target.AssetInfo)
+	if err != nil {
+		return nil, err
+	}
+	// Container images are pulled by the scanner, there is no volume snapshot to keep.
+	if inputImage != "" {
+		deltaScan = false
+	}
+
+	var scannerInstanceImage string
+	if i.scanResult.ScannerInstanceImage != nil {
+		scannerInstanceImage = i.scanResult.ScannerInstanceImage.Reference
+	}
+
 	return &provider.ScanJobConfig{
-		ScannerImage:     i.config.ScannerImage,
-		ScannerCLIConfig: string(scannerConfigYAML),
-		VMClarityAddress: i.config.ScannerBackendAddress,
+		ScannerImage:         i.config.ScannerImage,
+		ScannerCLIConfig:     string(scannerConfigYAML),
+		VMClarityAddress:     i.config.ScannerBackendAddress,
+		ScannerConfigURL:     newScannerConfigURL(i.config.ScannerBackendAddress, *i.scanResult.Id),
+		DeltaScan:            deltaScan,
+		InputImage:           inputImage,
+		ScannerInstanceImage: scannerInstanceImage,
+		MaxParallelScanners:  i.scanConfig.GetMaxParallelScanners(),
+		ScannerPlacement:     i.scanConfig.ScannerPlacement,
 		ScanMetadata: provider.ScanMetadata{
 			ScanID:       i.scanResult.Scan.Id,
 			ScanResultID: *i.scanResult.Id,
-			TargetID:     i.scanResult.Target.Id,
+			TargetID:     i.scanResult.Asset.Id,
 		},
 		ScannerInstanceCreationConfig: instanceCreationConfig,
-		Target:                        *i.target,
+		Asset:                         *i.target,
 	}, nil
 }
+
+// newTargetFromScanResult returns the Target of the ScanResult expanded with
+// the scan and target relationships.
+func newTargetFromScanResult(scanResult *models.AssetScanResult) *models.Asset {
+	return &models.Asset{
+		Id:         utils.PointerTo(scanResult.Asset.Id),
+		Revision:   scanResult.Asset.Revision,
+		ScansCount: scanResult.Asset.ScansCount,
+		Summary:    scanResult.Asset.Summary,
+		AssetInfo:  scanResult.Asset.AssetInfo,
+	}
+}
+
+// newScannerConfigURL returns the URL of the scanner config of the ScanResult
+// served by the backend at address.
+func newScannerConfigURL(address, scanResultID string) string {
+	return fmt.Sprintf("%s/scanResults/%s/scannerConfig", strings.TrimSuffix(address, "/"), scanResultID)
+}
+
+// newInputImage returns the reference of the image to scan if the target is a
+// container image, or an empty string if the target is scanned through its
+// attached volume.
+func newInputImage(targetInfo *models.AssetType) (string, error) {
+	if targetInfo == nil {
+		return "", nil
+	}
+
+	discriminator, err := targetInfo.Discriminator()
+	if err != nil {
+		return "", fmt.Errorf("failed to get target info type: %w", err)
+	}
+	if discriminator != "ContainerImageInfo" {
+		return "", nil
+	}
+
+	imageInfo, err := targetInfo.AsContainerImageInfo()
+	if err != nil {
+		return "", fmt.Errorf("failed to get ContainerImageInfo from target info: %w", err)
+	}
+
+	return imageInfo.Location, nil
+}
+
+// checkVolumeSizeGuardrail returns a non-empty reason if the target must not
+// be scanned because its root volume is larger than allowed by the guardrail.
+// Targets with the opt-in tag and targets with unknown volume size are
+// allowed.
+func checkVolumeSizeGuardrail(guardrail *models.VolumeSizeGuardrail, targetInfo *models.AssetType) (string, error) {
+	if guardrail == nil || targetInfo == nil {
+		return "", nil
+	}
+
+	discriminator, err := targetInfo.Discriminator()
+	if err != nil {
+		return "", fmt.Errorf("failed to get target info type: %w", err)
+	}
+	if discriminator != "VMInfo" {
+		return "", nil
+	}
+
+	vmInfo, err := targetInfo.AsVMInfo()
+	if err != nil {
+		return "", fmt.Errorf("failed to get VMInfo from target info: %w", err)
+	}
+
+	if vmInfo.RootVolume == nil || vmInfo.RootVolume.SizeGB == nil {
+		return "", nil
+	}
+	if *vmInfo.RootVolume.SizeGB <= guardrail.MaxVolumeSizeGB {
+		return "", nil
+	}
+
+	if guardrail.OptInTag != nil && vmInfo.Tags != nil {
+		for _, tag := range *vmInfo.Tags {
+			if tag.Key == guardrail.OptInTag.Key && tag.Value == guardrail.OptInTag.Value {
+				return "", nil
+			}
+		}
+	}
+
+	return fmt.Sprintf("root volume size %dGB exceeds the maximum volume size %dGB of the scan config",
+		*vmInfo.RootVolume.SizeGB, guardrail.MaxVolumeSizeGB), nil
+}
+
+// isScanStopped reports whether the Scan of the ScanResult has been aborted
+// or has failed, in which case no scanner is to be launched for it.
+func isScanStopped(scan *models.ScanRelationship) bool {
+	if scan == nil || scan.State == nil {
+		return false
+	}
+
+	switch *scan.State {
+	case models.ScanRelationshipStateAborted, models.ScanRelationshipStateFailed:
+		return true
+	case models.ScanRelationshipStatePending, models.ScanRelationshipStateDiscovered, models.ScanRelationshipStateInProgress,
+		models.ScanRelationshipStateDone:
+		fallthrough
+	default:
+		return false
+	}
+}
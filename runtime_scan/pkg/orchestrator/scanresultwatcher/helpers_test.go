@@ -0,0 +1,243 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanresultwatcher
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/families"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func newVMTargetInfo(t *testing.T, sizeGB *int, tags []models.Tag) *models.AssetType {
+	t.Helper()
+
+	var rootVolume *models.RootVolume
+	if sizeGB != nil {
+		rootVolume = &models.RootVolume{SizeGB: sizeGB}
+	}
+	targetInfo := models.AssetType{}
+	if err := targetInfo.FromVMInfo(models.VMInfo{
+		ObjectType: "VMInfo",
+		InstanceID: "i-123",
+		RootVolume: rootVolume,
+		Tags:       &tags,
+	}); err != nil {
+		t.Fatalf("failed to create target info: %v", err)
+	}
+	return &targetInfo
+}
+
+func Test_checkVolumeSizeGuardrail(t *testing.T) {
+	optInTag := models.Tag{Key: "scan-large-volumes", Value: "true"}
+	guardrail := &models.VolumeSizeGuardrail{
+		MaxVolumeSizeGB: 100,
+		OptInTag:        &optInTag,
+	}
+
+	tests := []struct {
+		name       string
+		guardrail  *models.VolumeSizeGuardrail
+		targetInfo *models.AssetType
+		wantReason bool
+	}{
+		{
+			name:       "no guardrail",
+			guardrail:  nil,
+			targetInfo: newVMTargetInfo(t, utils.PointerTo(500), nil),
+			wantReason: false,
+		},
+		{
+			name:       "unknown volume size",
+			guardrail:  guardrail,
+			targetInfo: newVMTargetInfo(t, nil, nil),
+			wantReason: false,
+		},
+		{
+			name:       "volume size within limit",
+			guardrail:  guardrail,
+			targetInfo: newVMTargetInfo(t, utils.PointerTo(100), nil),
+			wantReason: false,
+		},
+		{
+			name:       "volume size exceeds limit",
+			guardrail:  guardrail,
+			targetInfo: newVMTargetInfo(t, utils.PointerTo(101), []models.Tag{{Key: "scan-large-volumes", Value: "false"}}),
+			wantReason: true,
+		},
+		{
+			name:       "volume size exceeds limit with opt-in tag",
+			guardrail:  guardrail,
+			targetInfo: newVMTargetInfo(t, utils.PointerTo(101), []models.Tag{optInTag}),
+			wantReason: false,
+		},
+		{
+			name:       "volume size exceeds limit without opt-in tag configured",
+			guardrail:  &models.VolumeSizeGuardrail{MaxVolumeSizeGB: 100},
+			targetInfo: newVMTargetInfo(t, utils.PointerTo(101), []models.Tag{optInTag}),
+			wantReason: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason, err := checkVolumeSizeGuardrail(tt.guardrail, tt.targetInfo)
+			if err != nil {
+				t.Fatalf("checkVolumeSizeGuardrail() unexpected error: %v", err)
+			}
+			if gotReason := reason != ""; gotReason != tt.wantReason {
+				t.Errorf("checkVolumeSizeGuardrail() reason = %q, wantReason %v", reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func Test_newInputImage(t *testing.T) {
+	imageTargetInfo := models.AssetType{}
+	if err := imageTargetInfo.FromContainerImageInfo(models.ContainerImageInfo{
+		ImageID:    "sha256:1234",
+		Repository: "library/alpine",
+		Location:   "docker.io/library/alpine@sha256:1234",
+	}); err != nil {
+		t.Fatalf("failed to create target info: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		targetInfo *models.AssetType
+		want       string
+	}{
+		{
+			name:       "no target info",
+			targetInfo: nil,
+			want:       "",
+		},
+		{
+			name:       "VM target",
+			targetInfo: newVMTargetInfo(t, nil, nil),
+			want:       "",
+		},
+		{
+			name:       "container image target",
+			targetInfo: &imageTargetInfo,
+			want:       "docker.io/library/alpine@sha256:1234",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newInputImage(tt.targetInfo)
+			if err != nil {
+				t.Fatalf("newInputImage() unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("newInputImage() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_isScanStopped(t *testing.T) {
+	tests := []struct {
+		name string
+		scan *models.ScanRelationship
+		want bool
+	}{
+		{
+			name: "no scan",
+			scan: nil,
+			want: false,
+		},
+		{
+			name: "scan in progress",
+			scan: &models.ScanRelationship{State: utils.PointerTo(models.ScanRelationshipStateInProgress)},
+			want: false,
+		},
+		{
+			name: "scan aborted",
+			scan: &models.ScanRelationship{State: utils.PointerTo(models.ScanRelationshipStateAborted)},
+			want: true,
+		},
+		{
+			name: "scan failed",
+			scan: &models.ScanRelationship{State: utils.PointerTo(models.ScanRelationshipStateFailed)},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isScanStopped(tt.scan); got != tt.want {
+				t.Errorf("isScanStopped() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_newJobConfig_rerunFamilies(t *testing.T) {
+	scanConfig := &models.ScanConfigSnapshot{
+		ScanFamiliesConfig: &models.ScanFamiliesConfig{
+			Secrets: &models.SecretsConfig{
+				Enabled: utils.PointerTo(true),
+				Redact:  utils.PointerTo(true),
+			},
+			Plugins: &models.PluginsConfig{
+				Enabled: utils.PointerTo(true),
+				Plugins: &[]models.ScannerPlugin{
+					{Name: "custom", Command: "/usr/local/bin/custom-scanner"},
+				},
+			},
+			Malware: &models.MalwareConfig{
+				Enabled: utils.PointerTo(true),
+			},
+		},
+	}
+	scanResult := &models.AssetScanResult{
+		Id:            utils.PointerTo("sr-1"),
+		Scan:          &models.ScanRelationship{Id: "scan-1"},
+		Asset:         &models.AssetRelationship{Id: "asset-1"},
+		RerunFamilies: &[]models.ScanFamily{models.ScanFamilySecrets, models.ScanFamilyPlugins},
+	}
+
+	jobConfig, err := newJobConfig(&jobConfigInput{
+		config:     &ScannerConfig{},
+		scanResult: scanResult,
+		scanConfig: scanConfig,
+		target:     &models.Asset{AssetInfo: newVMTargetInfo(t, nil, nil)},
+	})
+	if err != nil {
+		t.Fatalf("newJobConfig() unexpected error: %v", err)
+	}
+
+	var got families.Config
+	if err := yaml.Unmarshal([]byte(jobConfig.ScannerCLIConfig), &got); err != nil {
+		t.Fatalf("failed to unmarshal scanner config: %v", err)
+	}
+	if !got.Secrets.Enabled || !got.Secrets.Redact {
+		t.Errorf("secrets config = %+v, want enabled with redaction", got.Secrets)
+	}
+	if !got.Plugins.Enabled || len(got.Plugins.Plugins) != 1 || got.Plugins.Plugins[0].Name != "custom" {
+		t.Errorf("plugins config = %+v, want enabled with the custom plugin", got.Plugins)
+	}
+	if got.Malware.Enabled {
+		t.Errorf("malware config = %+v, want disabled as it is not re-run", got.Malware)
+	}
+
+	// The config of the scan is not changed by the re-run.
+	if !scanConfig.ScanFamiliesConfig.Malware.IsEnabled() {
+		t.Errorf("malware of the scan config was disabled by the re-run")
+	}
+}
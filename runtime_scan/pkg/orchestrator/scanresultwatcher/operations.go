@@ -0,0 +1,53 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanresultwatcher
+
+import (
+	"context"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+)
+
+// operationRecorder is the provider.OperationRecorder which saves the
+// operations performed for a scan result in the backend, linked to its scan
+// and target.
+type operationRecorder struct {
+	metadata provider.ScanMetadata
+	post     func(context.Context, models.ProviderOperation) (*models.ProviderOperation, error)
+}
+
+func (r *operationRecorder) RecordOperation(ctx context.Context, operation models.ProviderOperation) {
+	operation.Scan = &models.ScanRelationship{Id: r.metadata.ScanID}
+	operation.ScanResultID = &r.metadata.ScanResultID
+	operation.Asset = &models.AssetRelationship{Id: r.metadata.TargetID}
+
+	// The operation has already been performed, so failing to record it
+	// must not fail the scan.
+	if _, err := r.post(ctx, operation); err != nil {
+		log.GetLoggerFromContextOrDiscard(ctx).Warnf("Failed to record provider operation %s: %v", operation.Operation, err)
+	}
+}
+
+// withOperationRecorder returns a copy of ctx in which the provider records
+// the operations performed for jobConfig.
+func (w *Watcher) withOperationRecorder(ctx context.Context, jobConfig *provider.ScanJobConfig) context.Context {
+	return provider.WithOperationRecorder(ctx, &operationRecorder{
+		metadata: jobConfig.ScanMetadata,
+		post:     w.backend.PostProviderOperation,
+	})
+}
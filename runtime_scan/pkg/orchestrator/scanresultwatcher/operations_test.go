@@ -0,0 +1,65 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanresultwatcher
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func Test_operationRecorder(t *testing.T) {
+	var posted []models.ProviderOperation
+	recorder := &operationRecorder{
+		metadata: provider.ScanMetadata{
+			ScanID:       "scan-1",
+			ScanResultID: "scan-result-1",
+			TargetID:     "target-1",
+		},
+		post: func(_ context.Context, operation models.ProviderOperation) (*models.ProviderOperation, error) {
+			posted = append(posted, operation)
+			return nil, errors.New("backend unavailable")
+		},
+	}
+
+	recorder.RecordOperation(context.Background(), models.ProviderOperation{
+		Provider:   models.AWS,
+		Operation:  models.VolumeAttach,
+		ResourceID: utils.PointerTo("vol-1"),
+		Status:     models.Successful,
+	})
+
+	want := []models.ProviderOperation{
+		{
+			Provider:     models.AWS,
+			Operation:    models.VolumeAttach,
+			ResourceID:   utils.PointerTo("vol-1"),
+			Status:       models.Successful,
+			Scan:         &models.ScanRelationship{Id: "scan-1"},
+			ScanResultID: utils.PointerTo("scan-result-1"),
+			Asset:        &models.AssetRelationship{Id: "target-1"},
+		},
+	}
+	if diff := cmp.Diff(want, posted); diff != "" {
+		t.Errorf("RecordOperation() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanresultwatcher
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/common"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+)
+
+// snapshotPrewarms keeps track of the ScanResults whose target volume snapshot
+// is created ahead of their Scanner instance. The number of snapshots created
+// in parallel is bounded by limit as providers have quotas on the number of
+// concurrent snapshot operations.
+type snapshotPrewarms struct {
+	mu      sync.Mutex
+	limit   int
+	running map[string]struct{}
+	// finished holds the ScanResults whose snapshot is either ready or
+	// failed to be created, which are not retried until the Scanner instance
+	// is provisioned.
+	finished map[string]struct{}
+}
+
+func newSnapshotPrewarms(limit int) *snapshotPrewarms {
+	return &snapshotPrewarms{
+		limit:    limit,
+		running:  map[string]struct{}{},
+		finished: map[string]struct{}{},
+	}
+}
+
+// start returns true if the snapshot of the ScanResult is being created or
+// there is room for creating it, false if it is finished or the limit is
+// reached.
+func (p *snapshotPrewarms) start(scanResultID string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.finished[scanResultID]; ok {
+		return false
+	}
+	if _, ok := p.running[scanResultID]; ok {
+		return true
+	}
+	if len(p.running) >= p.limit {
+		return false
+	}
+	p.running[scanResultID] = struct{}{}
+
+	return true
+}
+
+// done marks the snapshot of the ScanResult as finished.
+func (p *snapshotPrewarms) done(scanResultID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.running, scanResultID)
+	p.finished[scanResultID] = struct{}{}
+}
+
+// forget drops the ScanResult once its Scanner instance is provisioned.
+func (p *snapshotPrewarms) forget(scanResultID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.running, scanResultID)
+	delete(p.finished, scanResultID)
+}
+
+// prewarmSnapshot creates the target volume snapshot of a ScanResult which is
+// waiting for a free Scanner slot, so that its Scanner instance is provisioned
+// right away instead of waiting for the snapshot once a slot frees up.
+// Failures are only logged as the snapshot is created again by RunTargetScan.
+func (w *Watcher) prewarmSnapshot(ctx context.Context, scanResult *models.AssetScanResult) error {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	prewarmer, ok := provider.SnapshotPrewarmerOf(w.provider)
+	if !ok || w.prewarms == nil {
+		return nil
+	}
+
+	scanResultID, ok := scanResult.GetID()
+	if !ok || scanResult.Asset == nil || scanResult.Asset.AssetInfo == nil {
+		return nil
+	}
+
+	// Targets skipped by the guardrail must not be snapshotted at all.
+	reason, err := checkVolumeSizeGuardrail(scanResult.Scan.ScanConfigSnapshot.VolumeSizeGuardrail, scanResult.Asset.AssetInfo)
+	if err != nil || reason != "" {
+		return nil
+	}
+
+	if !w.prewarms.start(scanResultID) {
+		return nil
+	}
+
+	jobConfig, err := newJobConfig(&jobConfigInput{
+		config:     &w.scannerConfig,
+		scanResult: scanResult,
+		scanConfig: scanResult.Scan.ScanConfigSnapshot,
+		target:     newTargetFromScanResult(scanResult),
+	})
+	if err != nil {
+		w.prewarms.done(scanResultID)
+		logger.Warnf("Failed to create ScanJobConfig for pre-warming target volume snapshot: %v", err)
+		return nil
+	}
+
+	err = prewarmer.PrewarmTargetScan(w.withOperationRecorder(ctx, jobConfig), jobConfig)
+
+	var retryableError provider.RetryableError
+	switch {
+	case errors.As(err, &retryableError):
+		// nolint:wrapcheck
+		return common.NewRequeueAfterError(retryableError.RetryAfter(), retryableError.Error())
+	case err != nil:
+		w.prewarms.done(scanResultID)
+		logger.Warnf("Failed to pre-warm target volume snapshot: %v", err)
+	default:
+		w.prewarms.done(scanResultID)
+		logger.Info("Target volume snapshot is pre-warmed")
+	}
+
+	return nil
+}
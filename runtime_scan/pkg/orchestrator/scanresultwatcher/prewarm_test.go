@@ -0,0 +1,50 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanresultwatcher
+
+import (
+	"testing"
+)
+
+func Test_snapshotPrewarms(t *testing.T) {
+	prewarms := newSnapshotPrewarms(2)
+
+	steps := []struct {
+		name   string
+		action func() bool
+		want   bool
+	}{
+		{"start first", func() bool { return prewarms.start("sr-1") }, true},
+		{"start second", func() bool { return prewarms.start("sr-2") }, true},
+		{"limit reached", func() bool { return prewarms.start("sr-3") }, false},
+		{"running is started again", func() bool { return prewarms.start("sr-1") }, true},
+		{"finished is not started again", func() bool {
+			prewarms.done("sr-1")
+			return prewarms.start("sr-1")
+		}, false},
+		{"finished frees up a slot", func() bool { return prewarms.start("sr-3") }, true},
+		{"forgotten is started again", func() bool {
+			prewarms.forget("sr-1")
+			prewarms.forget("sr-2")
+			return prewarms.start("sr-1")
+		}, true},
+	}
+	for _, step := range steps {
+		if got := step.action(); got != step.want {
+			t.Errorf("%s: got %t, want %t", step.name, got, step.want)
+		}
+	}
+}
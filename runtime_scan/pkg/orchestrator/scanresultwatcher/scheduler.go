@@ -0,0 +1,278 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanresultwatcher
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// queuedScanResult is a pending ScanResult waiting for a Scanner.
+type queuedScanResult struct {
+	ScanResultID string
+	ScanID       string
+	// ScanConfigID is the ScanConfig which started the Scan, empty if the
+	// Scan was created without one.
+	ScanConfigID        string
+	Priority            int
+	MaxParallelScanners int
+	ScanStartTime       time.Time
+}
+
+// activeScanResult is a ScanResult which has a Scanner.
+type activeScanResult struct {
+	ScanID       string
+	ScanConfigID string
+}
+
+// queueKey returns the queue of the ScanResults of the same ScanConfig, the
+// Scans created without a ScanConfig have a queue of their own.
+func queueKey(scanID, scanConfigID string) string {
+	if scanConfigID != "" {
+		return "scanConfig/" + scanConfigID
+	}
+	return "scan/" + scanID
+}
+
+// planScanners returns the pending ScanResults which get a Scanner, in the
+// order they get one. The ScanResults of the Scans with a higher priority get
+// a Scanner first, and the ScanConfigs of the same priority take turns
+// starting with the one having the fewest Scanners, so that a ScanConfig with
+// many targets doesn't hold up the others. The ScanResults of the oldest Scan
+// are first within a ScanConfig. A ScanResult is skipped while its Scan has
+// maxParallelScanners Scanners, and none gets a Scanner once there are
+// maxConcurrentScanners in total, which is unlimited if it is 0.
+// nolint:cyclop
+func planScanners(queued []queuedScanResult, active []activeScanResult, maxConcurrentScanners int) []string {
+	running := len(active)
+	runningPerScan := map[string]int{}
+	runningPerQueue := map[string]int{}
+	for _, scanResult := range active {
+		runningPerScan[scanResult.ScanID]++
+		runningPerQueue[queueKey(scanResult.ScanID, scanResult.ScanConfigID)]++
+	}
+
+	sorted := make([]queuedScanResult, len(queued))
+	copy(sorted, queued)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		switch {
+		case a.Priority != b.Priority:
+			return a.Priority > b.Priority
+		case !a.ScanStartTime.Equal(b.ScanStartTime):
+			return a.ScanStartTime.Before(b.ScanStartTime)
+		case a.ScanID != b.ScanID:
+			return a.ScanID < b.ScanID
+		default:
+			return a.ScanResultID < b.ScanResultID
+		}
+	})
+
+	var planned []string
+	for start := 0; start < len(sorted); {
+		end := start
+		for end < len(sorted) && sorted[end].Priority == sorted[start].Priority {
+			end++
+		}
+
+		// The queues of the priority in the order of their oldest Scan, which
+		// breaks the ties between the queues having the same number of
+		// Scanners.
+		var keys []string
+		queues := map[string][]queuedScanResult{}
+		for _, scanResult := range sorted[start:end] {
+			key := queueKey(scanResult.ScanID, scanResult.ScanConfigID)
+			if _, ok := queues[key]; !ok {
+				keys = append(keys, key)
+			}
+			queues[key] = append(queues[key], scanResult)
+		}
+
+		for {
+			if maxConcurrentScanners > 0 && running >= maxConcurrentScanners {
+				return planned
+			}
+
+			next := ""
+			for _, key := range keys {
+				// Drop the ScanResults whose Scan has no room for more
+				// Scanners, the Scanners are not freed up while planning.
+				queue := queues[key]
+				for len(queue) > 0 && runningPerScan[queue[0].ScanID] >= queue[0].MaxParallelScanners {
+					queue = queue[1:]
+				}
+				queues[key] = queue
+
+				if len(queue) > 0 && (next == "" || runningPerQueue[key] < runningPerQueue[next]) {
+					next = key
+				}
+			}
+			if next == "" {
+				break
+			}
+
+			scanResult := queues[next][0]
+			queues[next] = queues[next][1:]
+			planned = append(planned, scanResult.ScanResultID)
+			running++
+			runningPerScan[scanResult.ScanID]++
+			runningPerQueue[next]++
+		}
+
+		start = end
+	}
+
+	return planned
+}
+
+// scannerSchedule holds the pending ScanResults which get a Scanner according
+// to the plan of the last poll.
+type scannerSchedule struct {
+	mu      sync.Mutex
+	planned map[string]struct{}
+}
+
+func (s *scannerSchedule) set(scanResultIDs []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.planned = make(map[string]struct{}, len(scanResultIDs))
+	for _, id := range scanResultIDs {
+		s.planned[id] = struct{}{}
+	}
+}
+
+// take returns true if the ScanResult gets a Scanner, which it only gets
+// once per plan.
+func (s *scannerSchedule) take(scanResultID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.planned[scanResultID]; !ok {
+		return false
+	}
+	delete(s.planned, scanResultID)
+
+	return true
+}
+
+// scheduleScanners plans which of the pending ScanResults get a Scanner until
+// the next poll.
+func (w *Watcher) scheduleScanners(ctx context.Context) error {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	queued, err := w.getQueuedScanResults(ctx)
+	if err != nil {
+		return err
+	}
+
+	active, err := w.getActiveScanResults(ctx)
+	if err != nil {
+		return err
+	}
+
+	planned := planScanners(queued, active, w.maxConcurrentScanners)
+	logger.Debugf("Scheduled Scanners for %d of the %d pending ScanResults", len(planned), len(queued))
+	w.schedule.set(planned)
+
+	return nil
+}
+
+func (w *Watcher) getQueuedScanResults(ctx context.Context) ([]queuedScanResult, error) {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	scanResults, err := w.backend.GetScanResults(ctx, models.GetScanResultsParams{
+		Filter: utils.PointerTo(fmt.Sprintf("status/general/state eq '%s'", models.AssetScanStateStatePending)),
+		Select: utils.PointerTo("id,scan"),
+		Expand: utils.PointerTo("scan($select=id,state,startTime,scanConfig,scanConfigSnapshot)"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending ScanResults: %w", err)
+	}
+	if scanResults.Items == nil {
+		return nil, nil
+	}
+
+	queued := make([]queuedScanResult, 0, len(*scanResults.Items))
+	for _, scanResult := range *scanResults.Items {
+		scanResultID, ok := scanResult.GetID()
+		if !ok || scanResult.Scan == nil || scanResult.Scan.ScanConfigSnapshot == nil {
+			logger.Warnf("Skipping to invalid ScanResult: ID, Scan or ScanConfigSnapshot is nil: %v", scanResult)
+			continue
+		}
+		if isScanStopped(scanResult.Scan) {
+			continue
+		}
+
+		var scanConfigID string
+		if scanResult.Scan.ScanConfig != nil {
+			scanConfigID = scanResult.Scan.ScanConfig.Id
+		}
+
+		queued = append(queued, queuedScanResult{
+			ScanResultID:        scanResultID,
+			ScanID:              scanResult.Scan.Id,
+			ScanConfigID:        scanConfigID,
+			Priority:            scanResult.Scan.ScanConfigSnapshot.GetPriority(),
+			MaxParallelScanners: scanResult.Scan.ScanConfigSnapshot.GetMaxParallelScanners(),
+			ScanStartTime:       utils.ValueOrZero(scanResult.Scan.StartTime),
+		})
+	}
+
+	return queued, nil
+}
+
+func (w *Watcher) getActiveScanResults(ctx context.Context) ([]activeScanResult, error) {
+	filter := fmt.Sprintf("status/general/state ne '%s' and status/general/state ne '%s' and resourceCleanup eq '%s'",
+		models.AssetScanStateStateDone, models.AssetScanStateStatePending, models.ResourceCleanupStatePending)
+	scanResults, err := w.backend.GetScanResults(ctx, models.GetScanResultsParams{
+		Filter: utils.PointerTo(filter),
+		Select: utils.PointerTo("id,scan"),
+		Expand: utils.PointerTo("scan($select=id,scanConfig)"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ScanResults with a Scanner: %w", err)
+	}
+	if scanResults.Items == nil {
+		return nil, nil
+	}
+
+	active := make([]activeScanResult, 0, len(*scanResults.Items))
+	for _, scanResult := range *scanResults.Items {
+		if scanResult.Scan == nil {
+			continue
+		}
+
+		var scanConfigID string
+		if scanResult.Scan.ScanConfig != nil {
+			scanConfigID = scanResult.Scan.ScanConfig.Id
+		}
+
+		active = append(active, activeScanResult{
+			ScanID:       scanResult.Scan.Id,
+			ScanConfigID: scanConfigID,
+		})
+	}
+
+	return active, nil
+}
@@ -0,0 +1,129 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanresultwatcher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_planScanners(t *testing.T) {
+	start := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	queued := func(id, scanID, scanConfigID string, priority, maxParallelScanners int, age time.Duration) queuedScanResult {
+		return queuedScanResult{
+			ScanResultID:        id,
+			ScanID:              scanID,
+			ScanConfigID:        scanConfigID,
+			Priority:            priority,
+			MaxParallelScanners: maxParallelScanners,
+			ScanStartTime:       start.Add(-age),
+		}
+	}
+
+	tests := []struct {
+		name                  string
+		queued                []queuedScanResult
+		active                []activeScanResult
+		maxConcurrentScanners int
+		want                  []string
+	}{
+		{
+			name: "higher priority first",
+			queued: []queuedScanResult{
+				queued("low-1", "scan-low", "config-low", 0, 10, time.Hour),
+				queued("high-1", "scan-high", "config-high", 5, 10, time.Minute),
+			},
+			maxConcurrentScanners: 1,
+			want:                  []string{"high-1"},
+		},
+		{
+			name: "older scan first within a scan config",
+			queued: []queuedScanResult{
+				queued("new-1", "scan-new", "config", 0, 10, time.Minute),
+				queued("old-1", "scan-old", "config", 0, 10, time.Hour),
+			},
+			want: []string{"old-1", "new-1"},
+		},
+		{
+			name: "scan configs of the same priority take turns",
+			queued: []queuedScanResult{
+				queued("a-1", "scan-a", "config-a", 0, 10, time.Hour),
+				queued("a-2", "scan-a", "config-a", 0, 10, time.Hour),
+				queued("a-3", "scan-a", "config-a", 0, 10, time.Hour),
+				queued("b-1", "scan-b", "config-b", 0, 10, time.Minute),
+				queued("b-2", "scan-b", "config-b", 0, 10, time.Minute),
+			},
+			maxConcurrentScanners: 4,
+			want:                  []string{"a-1", "b-1", "a-2", "b-2"},
+		},
+		{
+			name: "scan config with fewer running scanners first",
+			queued: []queuedScanResult{
+				queued("a-1", "scan-a", "config-a", 0, 10, time.Hour),
+				queued("b-1", "scan-b", "config-b", 0, 10, time.Minute),
+				queued("b-2", "scan-b", "config-b", 0, 10, time.Minute),
+			},
+			active: []activeScanResult{
+				{ScanID: "scan-a", ScanConfigID: "config-a"},
+			},
+			maxConcurrentScanners: 3,
+			want:                  []string{"b-1", "a-1"},
+		},
+		{
+			name: "scan at its max parallel scanners is skipped",
+			queued: []queuedScanResult{
+				queued("high-1", "scan-high", "config-high", 5, 1, time.Hour),
+				queued("high-2", "scan-high", "config-high", 5, 1, time.Hour),
+				queued("low-1", "scan-low", "config-low", 0, 10, time.Hour),
+			},
+			active: []activeScanResult{
+				{ScanID: "scan-high", ScanConfigID: "config-high"},
+			},
+			want: []string{"low-1"},
+		},
+		{
+			name: "scans without scan config have their own queue",
+			queued: []queuedScanResult{
+				queued("a-1", "scan-a", "", 0, 10, time.Hour),
+				queued("a-2", "scan-a", "", 0, 10, time.Hour),
+				queued("b-1", "scan-b", "", 0, 10, time.Minute),
+			},
+			want: []string{"a-1", "b-1", "a-2"},
+		},
+		{
+			name: "nothing once the global limit is reached",
+			queued: []queuedScanResult{
+				queued("a-1", "scan-a", "config-a", 0, 10, time.Hour),
+			},
+			active: []activeScanResult{
+				{ScanID: "scan-b", ScanConfigID: "config-b"},
+				{ScanID: "scan-c"},
+			},
+			maxConcurrentScanners: 2,
+			want:                  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := planScanners(tt.queued, tt.active, tt.maxConcurrentScanners)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("planScanners() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
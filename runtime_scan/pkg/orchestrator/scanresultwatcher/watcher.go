@@ -36,22 +36,39 @@ type (
 )
 
 func New(c Config) *Watcher {
+	var prewarms *snapshotPrewarms
+	if c.SnapshotPrewarmConcurrency > 0 {
+		prewarms = newSnapshotPrewarms(c.SnapshotPrewarmConcurrency)
+	}
+
 	return &Watcher{
 		backend:          c.Backend,
 		provider:         c.Provider,
+		clock:            common.ClockOrDefault(c.Clock),
 		scannerConfig:    c.ScannerConfig,
 		pollPeriod:       c.PollPeriod,
 		reconcileTimeout: c.ReconcileTimeout,
+		prewarms:         prewarms,
+		schedule:         &scannerSchedule{},
 		queue:            common.NewQueue[ScanResultReconcileEvent](),
+
+		maxConcurrentScanners: c.MaxConcurrentScanners,
 	}
 }
 
 type Watcher struct {
 	backend          *backendclient.BackendClient
 	provider         provider.Provider
+	clock            common.Clock
 	scannerConfig    ScannerConfig
 	pollPeriod       time.Duration
 	reconcileTimeout time.Duration
+	prewarms         *snapshotPrewarms
+	schedule         *scannerSchedule
+
+	// maxConcurrentScanners is the maximum number of Scanners across all the
+	// Scans, unlimited if it is 0.
+	maxConcurrentScanners int
 
 	queue *ScanResultQueue
 }
@@ -80,9 +97,16 @@ func (w *Watcher) GetScanResults(ctx context.Context) ([]ScanResultReconcileEven
 	logger := log.GetLoggerFromContextOrDiscard(ctx)
 	logger.Debugf("Fetching ScanResults which need to be reconciled")
 
-	filter := fmt.Sprintf("(status/general/state ne '%s' and status/general/state ne '%s') or resourceCleanup eq '%s'",
-		models.TargetScanStateStateDone, models.TargetScanStateStateNotScanned, models.ResourceCleanupStatePending)
-	selector := "id,scan/id,target/id"
+	// The pending ScanResults which get a Scanner are planned once per poll,
+	// before they are reconciled.
+	if err := w.scheduleScanners(ctx); err != nil {
+		return nil, fmt.Errorf("failed to schedule Scanners: %w", err)
+	}
+
+	filter := fmt.Sprintf("(status/general/state ne '%s' and status/general/state ne '%s' and status/general/state ne '%s') or resourceCleanup eq '%s'",
+		models.AssetScanStateStateDone, models.AssetScanStateStateNotScanned, models.AssetScanStateStateAborted,
+		models.ResourceCleanupStatePending)
+	selector := "id,scan/id,asset/id"
 	params := models.GetScanResultsParams{
 		Filter: &filter,
 		Select: &selector,
@@ -114,7 +138,7 @@ func (w *Watcher) GetScanResults(ctx context.Context) ([]ScanResultReconcileEven
 			logger.Warnf("Skipping to invalid ScanResult: Scan.ID is nil: %v", scanResult)
 			continue
 		}
-		targetID, ok := scanResult.GetTargetID()
+		targetID, ok := scanResult.GetAssetID()
 		if !ok {
 			logger.Warnf("Skipping to invalid ScanResult: Target.ID is nil: %v", scanResult)
 			continue
@@ -136,7 +160,7 @@ func (w *Watcher) Reconcile(ctx context.Context, event ScanResultReconcileEvent)
 	ctx = log.SetLoggerForContext(ctx, logger)
 
 	scanResult, err := w.backend.GetScanResult(ctx, event.ScanResultID, models.GetScanResultsScanResultIDParams{
-		Expand: utils.PointerTo("scan,target"),
+		Expand: utils.PointerTo("scan,asset"),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to get ScanResult with %s id: %w", event.ScanResultID, err)
@@ -150,21 +174,21 @@ func (w *Watcher) Reconcile(ctx context.Context, event ScanResultReconcileEvent)
 	logger.Tracef("Reconciling ScanResult state: %s", state)
 
 	switch state {
-	case models.TargetScanStateStatePending:
+	case models.AssetScanStateStatePending:
 		if err = w.reconcilePending(ctx, &scanResult); err != nil {
 			return err
 		}
-	case models.TargetScanStateStateScheduled:
+	case models.AssetScanStateStateScheduled:
 		if err = w.reconcileScheduled(ctx, &scanResult); err != nil {
 			return err
 		}
-	case models.TargetScanStateStateReadyToScan, models.TargetScanStateStateInProgress:
-		// TODO(chrisgacsal): make sure that TargetScanResult state is set to ABORTED state once the TargetScanResult
-		//                    schema is extended with timeout field and the deadline is missed.
-		break
-	case models.TargetScanStateStateAborted, models.TargetScanStateStateNotScanned:
+	case models.AssetScanStateStateReadyToScan, models.AssetScanStateStateInProgress:
+		if err = w.reconcileRunning(ctx, &scanResult); err != nil {
+			return err
+		}
+	case models.AssetScanStateStateNotScanned:
 		break
-	case models.TargetScanStateStateDone:
+	case models.AssetScanStateStateAborted, models.AssetScanStateStateDone:
 		if err = w.reconcileDone(ctx, &scanResult); err != nil {
 			return err
 		}
@@ -175,7 +199,7 @@ func (w *Watcher) Reconcile(ctx context.Context, event ScanResultReconcileEvent)
 }
 
 // nolint:cyclop
-func (w *Watcher) reconcilePending(ctx context.Context, scanResult *models.TargetScanResult) error {
+func (w *Watcher) reconcilePending(ctx context.Context, scanResult *models.AssetScanResult) error {
 	logger := log.GetLoggerFromContextOrDiscard(ctx)
 
 	scanResultID, ok := scanResult.GetID()
@@ -187,40 +211,28 @@ func (w *Watcher) reconcilePending(ctx context.Context, scanResult *models.Targe
 		return errors.New("invalid ScanResult: Scan or ScanConfigSnapshot is nil")
 	}
 
-	// Check whether we have reached the maximum number of running scans
-	// TODO(chrisgacsal): the number of concurrent scans needs to be part of the provider config and handled there
-	filter := fmt.Sprintf("scan/id eq '%s' and status/general/state ne '%s' and status/general/state ne '%s' and resourceCleanup eq '%s'",
-		scanResult.Scan.Id, models.TargetScanStateStateDone, models.TargetScanStateStatePending, models.ResourceCleanupStatePending)
-	scanResults, err := w.backend.GetScanResults(ctx, models.GetScanResultsParams{
-		Filter: utils.PointerTo(filter),
-		Count:  utils.PointerTo(true),
-		Top:    utils.PointerTo(0),
-	})
-	if err != nil {
-		return fmt.Errorf("failed to fetch in-progress TargetScanResults for Scan. ScanID=%s: %w",
-			scanResult.Scan.Id, err)
+	if isScanStopped(scanResult.Scan) {
+		logger.Info("Reconciliation is skipped as the Scan has been stopped")
+		return nil
 	}
 
-	var scanResultsInProgress int
-	if scanResults.Count != nil {
-		scanResultsInProgress = *scanResults.Count
-	} else {
-		return errors.New("invalid API response: Count is nil")
+	// Check whether the ScanResult got a Scanner, the Scanners are given by
+	// priority and age of the Scans with respect to the maximum number of
+	// Scanners per Scan and in total.
+	if !w.schedule.take(scanResultID) {
+		logger.Info("Reconciliation is skipped as the ScanResult is waiting for a Scanner")
+		// Create the target volume snapshot meanwhile, so it is ready by the
+		// time a Scanner instance can be provisioned.
+		return w.prewarmSnapshot(ctx, scanResult)
 	}
 
-	maxParallelScanners := scanResult.Scan.ScanConfigSnapshot.GetMaxParallelScanners()
-	if scanResultsInProgress >= maxParallelScanners {
-		logger.Infof("Reconciliation is skipped as maximum number of running scans is reached: %d", maxParallelScanners)
-		return nil
-	}
+	scanResult.Status.General.State = utils.PointerTo(models.AssetScanStateStateScheduled)
+	scanResult.Status.General.LastTransitionTime = utils.PointerTo(w.clock.Now())
 
-	scanResult.Status.General.State = utils.PointerTo(models.TargetScanStateStateScheduled)
-	scanResult.Status.General.LastTransitionTime = utils.PointerTo(time.Now())
-
-	scanResultPatch := models.TargetScanResult{
+	scanResultPatch := models.AssetScanResult{
 		Status: scanResult.Status,
 	}
-	err = w.backend.PatchScanResult(ctx, scanResultPatch, scanResultID)
+	err := w.backend.PatchScanResult(ctx, scanResultPatch, scanResultID)
 	if err != nil {
 		return fmt.Errorf("failed to update ScanResult. ScanResult=%s: %w", scanResultID, err)
 	}
@@ -232,7 +244,7 @@ func (w *Watcher) reconcilePending(ctx context.Context, scanResult *models.Targe
 }
 
 // nolint:cyclop
-func (w *Watcher) reconcileScheduled(ctx context.Context, scanResult *models.TargetScanResult) error {
+func (w *Watcher) reconcileScheduled(ctx context.Context, scanResult *models.AssetScanResult) error {
 	scanResultID, ok := scanResult.GetID()
 	if !ok {
 		return errors.New("invalid ScanResult: ID is nil")
@@ -242,15 +254,74 @@ func (w *Watcher) reconcileScheduled(ctx context.Context, scanResult *models.Tar
 		return errors.New("invalid ScanResult: Scan or ScanConfigSnapshot is nil")
 	}
 
-	if scanResult.Target == nil || scanResult.Target.TargetInfo == nil {
-		return errors.New("invalid ScanResult: Target or TargetInfo is nil")
+	if scanResult.Asset == nil || scanResult.Asset.AssetInfo == nil {
+		return errors.New("invalid ScanResult: Target or AssetInfo is nil")
+	}
+
+	// The ScanResult is aborted together with the Scan, no scanner is
+	// launched for it meanwhile.
+	if isScanStopped(scanResult.Scan) {
+		log.GetLoggerFromContextOrDiscard(ctx).Info("Reconciliation is skipped as the Scan has been stopped")
+		return nil
+	}
+
+	// The Scanner instance is provisioned now, using the pre-warmed snapshot
+	// if there is one.
+	if w.prewarms != nil {
+		w.prewarms.forget(scanResultID)
+	}
+
+	target := newTargetFromScanResult(scanResult)
+
+	// Skip targets which would be too expensive to snapshot before creating
+	// any resources for them.
+	reason, err := checkVolumeSizeGuardrail(scanResult.Scan.ScanConfigSnapshot.VolumeSizeGuardrail, target.AssetInfo)
+	if err != nil {
+		return fmt.Errorf("failed to check volume size guardrail for ScanResult. ScanResult=%s: %w", scanResultID, err)
 	}
-	target := &models.Target{
-		Id:         utils.PointerTo(scanResult.Target.Id),
-		Revision:   scanResult.Target.Revision,
-		ScansCount: scanResult.Target.ScansCount,
-		Summary:    scanResult.Target.Summary,
-		TargetInfo: scanResult.Target.TargetInfo,
+	if reason != "" {
+		scanResult.Status.General.State = utils.PointerTo(models.AssetScanStateStateNotScanned)
+		scanResult.Status.General.Errors = utils.PointerTo([]string{reason})
+		scanResult.Status.General.LastTransitionTime = utils.PointerTo(w.clock.Now())
+
+		scanResultPatch := models.AssetScanResult{
+			Status:          scanResult.Status,
+			ResourceCleanup: utils.PointerTo(models.ResourceCleanupStateSkipped),
+		}
+		err = w.backend.PatchScanResult(ctx, scanResultPatch, scanResultID)
+		if err != nil {
+			return fmt.Errorf("failed to update ScanResult. ScanResult=%s: %w", scanResultID, err)
+		}
+
+		return nil
+	}
+
+	// Resolve the scanner instance image once, so that all the attempts of
+	// the scan use the same image even if a new one is published meanwhile.
+	if scanResult.ScannerInstanceImage == nil {
+		image, err := w.resolveScannerImage(ctx)
+
+		var fatalError provider.FatalError
+		switch {
+		case errors.As(err, &fatalError):
+			scanResult.Status.General.State = utils.PointerTo(models.AssetScanStateStateDone)
+			scanResult.Status.General.Errors = utils.PointerTo([]string{fatalError.Error()})
+			scanResult.Status.General.LastTransitionTime = utils.PointerTo(w.clock.Now())
+
+			err = w.backend.PatchScanResult(ctx, models.AssetScanResult{Status: scanResult.Status}, scanResultID)
+			if err != nil {
+				return fmt.Errorf("failed to update ScanResult. ScanResult=%s: %w", scanResultID, err)
+			}
+			return nil
+		case err != nil:
+			return fmt.Errorf("failed to resolve scanner image for ScanResult. ScanResult=%s: %w", scanResultID, err)
+		case image != nil:
+			err = w.backend.PatchScanResult(ctx, models.AssetScanResult{ScannerInstanceImage: image}, scanResultID)
+			if err != nil {
+				return fmt.Errorf("failed to update ScanResult. ScanResult=%s: %w", scanResultID, err)
+			}
+			scanResult.ScannerInstanceImage = image
+		}
 	}
 
 	// Run scan for ScanResult
@@ -264,29 +335,45 @@ func (w *Watcher) reconcileScheduled(ctx context.Context, scanResult *models.Tar
 		return fmt.Errorf("failed to create ScanJobConfig for ScanResult. ScanResult=%s: %w", scanResultID, err)
 	}
 
-	err = w.provider.RunTargetScan(ctx, jobConfig)
+	// The scanner fetches its config from the backend when it starts, so
+	// the config is updated on every attempt to run the scan.
+	scannerConfig, err := w.backend.PutScannerConfig(ctx, scanResultID, models.ScannerConfig{Config: jobConfig.ScannerCLIConfig})
+	if err != nil {
+		return fmt.Errorf("failed to set scanner config for ScanResult. ScanResult=%s: %w", scanResultID, err)
+	}
+	jobConfig.ScannerConfigToken = utils.ValueOrZero(scannerConfig.Token)
+
+	err = w.provider.RunTargetScan(w.withOperationRecorder(ctx, jobConfig), jobConfig)
 
 	var fatalError provider.FatalError
 	var retryableError provider.RetryableError
 	switch {
 	case errors.As(err, &fatalError):
-		scanResult.Status.General.State = utils.PointerTo(models.TargetScanStateStateDone)
+		scanResult.Status.General.State = utils.PointerTo(models.AssetScanStateStateDone)
 		scanResult.Status.General.Errors = utils.PointerTo([]string{fatalError.Error()})
-		scanResult.Status.General.LastTransitionTime = utils.PointerTo(time.Now())
+		scanResult.Status.General.LastTransitionTime = utils.PointerTo(w.clock.Now())
 	case errors.As(err, &retryableError):
 		// nolint:wrapcheck
 		return common.NewRequeueAfterError(retryableError.RetryAfter(), retryableError.Error())
 	case err != nil:
-		scanResult.Status.General.State = utils.PointerTo(models.TargetScanStateStateDone)
+		scanResult.Status.General.State = utils.PointerTo(models.AssetScanStateStateDone)
 		scanResult.Status.General.Errors = utils.PointerTo(utils.UnwrapErrorStrings(err))
-		scanResult.Status.General.LastTransitionTime = utils.PointerTo(time.Now())
+		scanResult.Status.General.LastTransitionTime = utils.PointerTo(w.clock.Now())
 	default:
-		scanResult.Status.General.State = utils.PointerTo(models.TargetScanStateStateReadyToScan)
-		scanResult.Status.General.LastTransitionTime = utils.PointerTo(time.Now())
+		scanResult.Status.General.State = utils.PointerTo(models.AssetScanStateStateReadyToScan)
+		scanResult.Status.General.LastTransitionTime = utils.PointerTo(w.clock.Now())
+		if scanResult.ScannerStartTime == nil {
+			scanResult.ScannerStartTime = utils.PointerTo(w.clock.Now())
+		}
+		if jobConfig.DeltaScan && scanResult.DeltaScan == nil {
+			scanResult.DeltaScan = w.getDeltaScanInfo(ctx, jobConfig)
+		}
 	}
 
-	scanResultPatch := models.TargetScanResult{
-		Status: scanResult.Status,
+	scanResultPatch := models.AssetScanResult{
+		Status:           scanResult.Status,
+		ScannerStartTime: scanResult.ScannerStartTime,
+		DeltaScan:        scanResult.DeltaScan,
 	}
 	err = w.backend.PatchScanResult(ctx, scanResultPatch, scanResultID)
 	if err != nil {
@@ -296,11 +383,15 @@ func (w *Watcher) reconcileScheduled(ctx context.Context, scanResult *models.Tar
 	return nil
 }
 
-func (w *Watcher) reconcileDone(ctx context.Context, scanResult *models.TargetScanResult) error {
+func (w *Watcher) reconcileDone(ctx context.Context, scanResult *models.AssetScanResult) error {
 	if scanResult.Scan == nil || scanResult.ResourceCleanup == nil {
 		return errors.New("invalid ScanResult: Scan and/or ResourceCleanup are nil")
 	}
 
+	if scanResultID, ok := scanResult.GetID(); ok && w.prewarms != nil {
+		w.prewarms.forget(scanResultID)
+	}
+
 	if *scanResult.ResourceCleanup != models.ResourceCleanupStatePending {
 		return nil
 	}
@@ -313,7 +404,7 @@ func (w *Watcher) reconcileDone(ctx context.Context, scanResult *models.TargetSc
 }
 
 // nolint:cyclop
-func (w *Watcher) cleanupResources(ctx context.Context, scanResult *models.TargetScanResult) error {
+func (w *Watcher) cleanupResources(ctx context.Context, scanResult *models.AssetScanResult) error {
 	scanResultID, ok := scanResult.GetID()
 	if !ok {
 		return errors.New("invalid ScanResult: ID is nil")
@@ -349,14 +440,14 @@ func (w *Watcher) cleanupResources(ctx context.Context, scanResult *models.Targe
 		scanConfig := scan.ScanConfigSnapshot
 
 		// Get Target
-		target, err := w.backend.GetTarget(ctx, scanResult.Target.Id, models.GetTargetsTargetIDParams{
-			Select: utils.PointerTo("id,targetInfo"),
+		target, err := w.backend.GetAsset(ctx, scanResult.Asset.Id, models.GetAssetsAssetIDParams{
+			Select: utils.PointerTo("id,assetInfo"),
 		})
 		if err != nil {
-			return fmt.Errorf("failed to fetch Target. TargetID=%s: %w", scanResult.Target.Id, err)
+			return fmt.Errorf("failed to fetch Target. TargetID=%s: %w", scanResult.Asset.Id, err)
 		}
-		if target.TargetInfo == nil {
-			return errors.New("invalid API response: TargetInfo is nil")
+		if target.AssetInfo == nil {
+			return errors.New("invalid API response: AssetInfo is nil")
 		}
 
 		// Create JobConfig
@@ -370,25 +461,44 @@ func (w *Watcher) cleanupResources(ctx context.Context, scanResult *models.Targe
 			return fmt.Errorf("failed to to create ScanJobConfigg for ScanResult. ScanResultID=%s: %w", scanResultID, err)
 		}
 
-		err = w.provider.RemoveTargetScan(ctx, jobConfig)
+		err = w.provider.RemoveTargetScan(w.withOperationRecorder(ctx, jobConfig), jobConfig)
 
 		var fatalError provider.FatalError
 		var retryableError provider.RetryableError
+		// The scanner instance is no longer accounted for once the cleanup
+		// is given up on, otherwise it would be billed until now forever.
 		switch {
 		case errors.As(err, &fatalError):
 			scanResult.ResourceCleanup = utils.PointerTo(models.ResourceCleanupStateFailed)
+			scanResult.ScannerEndTime = utils.PointerTo(w.clock.Now())
 		case errors.As(err, &retryableError):
 			// nolint:wrapcheck
 			return common.NewRequeueAfterError(retryableError.RetryAfter(), retryableError.Error())
 		case err != nil:
 			scanResult.ResourceCleanup = utils.PointerTo(models.ResourceCleanupStateFailed)
+			scanResult.ScannerEndTime = utils.PointerTo(w.clock.Now())
 		default:
 			scanResult.ResourceCleanup = utils.PointerTo(models.ResourceCleanupStateDone)
+			scanResult.ScannerEndTime = utils.PointerTo(w.clock.Now())
 		}
 	}
 
-	scanResultPatch := models.TargetScanResult{
+	// The re-run of the families is over, the next run of the scan result
+	// scans all the families of its scan config again. It is removed before
+	// the cleanup is recorded as the scan result is not reconciled anymore
+	// after that.
+	if scanResult.RerunFamilies != nil {
+		err := w.backend.JSONPatchScanResult(ctx, scanResultID, models.JSONPatch{
+			{Op: models.Remove, Path: "/rerunFamilies"},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to clear re-run families of ScanResult. ScanResultID=%s: %w", scanResultID, err)
+		}
+	}
+
+	scanResultPatch := models.AssetScanResult{
 		ResourceCleanup: scanResult.ResourceCleanup,
+		ScannerEndTime:  scanResult.ScannerEndTime,
 	}
 	if err := w.backend.PatchScanResult(ctx, scanResultPatch, scanResultID); err != nil {
 		return fmt.Errorf("failed to patch for ScanResult. ScanResultID=%s: %w", scanResultID, err)
@@ -396,3 +506,45 @@ func (w *Watcher) cleanupResources(ctx context.Context, scanResult *models.Targe
 
 	return nil
 }
+
+// getDeltaScanInfo returns the changes of the scanned volume since the
+// previous scan of the target if the provider supports it. The volume is
+// scanned in full if the changes cannot be determined.
+// resolveScannerImage returns the scanner instance image resolved by the
+// provider, or nil if the provider uses the configured image.
+func (w *Watcher) resolveScannerImage(ctx context.Context) (*models.ScannerInstanceImage, error) {
+	resolver, ok := w.provider.(provider.ScannerImageResolver)
+	if !ok {
+		return nil, nil
+	}
+
+	image, err := resolver.ResolveScannerImage(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve scanner image: %w", err)
+	}
+
+	if image != nil {
+		log.GetLoggerFromContextOrDiscard(ctx).Infof("Resolved scanner image. Reference=%s Version=%s",
+			image.Reference, utils.ValueOrZero(image.Version))
+	}
+
+	return image, nil
+}
+
+func (w *Watcher) getDeltaScanInfo(ctx context.Context, jobConfig *provider.ScanJobConfig) *models.DeltaScanInfo {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	deltaScanner, ok := w.provider.(provider.DeltaScanner)
+	if !ok {
+		logger.Debugf("Delta scanning is not supported by provider %s", w.provider.Kind())
+		return nil
+	}
+
+	info, err := deltaScanner.GetDeltaScanInfo(ctx, jobConfig)
+	if err != nil {
+		logger.Warnf("Failed to get changed blocks, the target will be scanned in full: %v", err)
+		return nil
+	}
+
+	return info
+}
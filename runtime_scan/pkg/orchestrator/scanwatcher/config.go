@@ -18,6 +18,9 @@ package scanwatcher
 import (
 	"time"
 
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/common"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/networkpolicy"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/registrydiscovery"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
 	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
 )
@@ -34,6 +37,11 @@ type Config struct {
 	PollPeriod       time.Duration
 	ReconcileTimeout time.Duration
 	ScanTimeout      time.Duration
+	NetworkPolicy    *networkpolicy.Reconciler
+	ImageDiscoverer  *registrydiscovery.Discoverer
+
+	// Clock is the source of time of the Watcher, RealClock if nil.
+	Clock common.Clock
 }
 
 func (c Config) WithBackendClient(b *backendclient.BackendClient) Config {
@@ -46,6 +54,11 @@ func (c Config) WithProviderClient(p provider.Provider) Config {
 	return c
 }
 
+func (c Config) WithClock(clock common.Clock) Config {
+	c.Clock = clock
+	return c
+}
+
 func (c Config) WithReconcileTimeout(t time.Duration) Config {
 	c.ReconcileTimeout = t
 	return c
@@ -60,3 +73,13 @@ func (c Config) WithScanTimeout(t time.Duration) Config {
 	c.ScanTimeout = t
 	return c
 }
+
+func (c Config) WithNetworkPolicyReconciler(r *networkpolicy.Reconciler) Config {
+	c.NetworkPolicy = r
+	return c
+}
+
+func (c Config) WithImageDiscoverer(d *registrydiscovery.Discoverer) Config {
+	c.ImageDiscoverer = d
+	return c
+}
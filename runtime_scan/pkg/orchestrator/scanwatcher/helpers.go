@@ -18,6 +18,8 @@ package scanwatcher
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
@@ -35,17 +37,20 @@ func newVulnerabilityScanSummary() *models.VulnerabilityScanSummary {
 
 func newScanResultSummary() *models.ScanFindingsSummary {
 	return &models.ScanFindingsSummary{
+		TotalCertificates:      utils.PointerTo[int](0),
+		TotalComplianceChecks:  utils.PointerTo[int](0),
 		TotalExploits:          utils.PointerTo[int](0),
 		TotalMalware:           utils.PointerTo[int](0),
 		TotalMisconfigurations: utils.PointerTo[int](0),
 		TotalPackages:          utils.PointerTo[int](0),
+		TotalPluginFindings:    utils.PointerTo[int](0),
 		TotalRootkits:          utils.PointerTo[int](0),
 		TotalSecrets:           utils.PointerTo[int](0),
 		TotalVulnerabilities:   newVulnerabilityScanSummary(),
 	}
 }
 
-func newScanResultFromScan(scan *models.Scan, targetID string) (*models.TargetScanResult, error) {
+func newScanResultFromScan(scan *models.Scan, targetID string) (*models.AssetScanResult, error) {
 	if scan == nil {
 		return nil, errors.New("failed to create ScanResult: Scan is nil")
 	}
@@ -55,44 +60,56 @@ func newScanResultFromScan(scan *models.Scan, targetID string) (*models.TargetSc
 	}
 	familiesConfig := scan.ScanConfigSnapshot.ScanFamiliesConfig
 
-	return &models.TargetScanResult{
+	return &models.AssetScanResult{
 		Summary: newScanResultSummary(),
 		Scan: &models.ScanRelationship{
 			Id: *scan.Id,
 		},
-		Target: &models.TargetRelationship{
+		Asset: &models.AssetRelationship{
 			Id: targetID,
 		},
-		Status: &models.TargetScanStatus{
-			Exploits: &models.TargetScanState{
+		Status: &models.AssetScanStatus{
+			Certificates: &models.AssetScanState{
+				Errors: nil,
+				State:  getInitStateFromFamilyConfig(familiesConfig.Certificates),
+			},
+			Compliance: &models.AssetScanState{
+				Errors: nil,
+				State:  getInitStateFromFamilyConfig(familiesConfig.Compliance),
+			},
+			Exploits: &models.AssetScanState{
 				Errors: nil,
 				State:  getInitStateFromFamilyConfig(familiesConfig.Exploits),
 			},
-			General: &models.TargetScanState{
+			General: &models.AssetScanState{
 				Errors: nil,
-				State:  utils.PointerTo(models.TargetScanStateStatePending),
+				State:  utils.PointerTo(models.AssetScanStateStatePending),
 			},
-			Malware: &models.TargetScanState{
+			Malware: &models.AssetScanState{
 				Errors: nil,
 				State:  getInitStateFromFamilyConfig(familiesConfig.Malware),
 			},
-			Misconfigurations: &models.TargetScanState{
+			Misconfigurations: &models.AssetScanState{
 				Errors: nil,
 				State:  getInitStateFromFamilyConfig(familiesConfig.Misconfigurations),
 			},
-			Rootkits: &models.TargetScanState{
+			Plugins: &models.AssetScanState{
+				Errors: nil,
+				State:  getInitStateFromFamilyConfig(familiesConfig.Plugins),
+			},
+			Rootkits: &models.AssetScanState{
 				Errors: nil,
 				State:  getInitStateFromFamilyConfig(familiesConfig.Rootkits),
 			},
-			Sbom: &models.TargetScanState{
+			Sbom: &models.AssetScanState{
 				Errors: nil,
 				State:  getInitStateFromFamilyConfig(familiesConfig.Sbom),
 			},
-			Secrets: &models.TargetScanState{
+			Secrets: &models.AssetScanState{
 				Errors: nil,
 				State:  getInitStateFromFamilyConfig(familiesConfig.Secrets),
 			},
-			Vulnerabilities: &models.TargetScanState{
+			Vulnerabilities: &models.AssetScanState{
 				Errors: nil,
 				State:  getInitStateFromFamilyConfig(familiesConfig.Vulnerabilities),
 			},
@@ -101,22 +118,25 @@ func newScanResultFromScan(scan *models.Scan, targetID string) (*models.TargetSc
 	}, nil
 }
 
-func getInitStateFromFamilyConfig(config models.FamilyConfigEnabler) *models.TargetScanStateState {
+func getInitStateFromFamilyConfig(config models.FamilyConfigEnabler) *models.AssetScanStateState {
 	if config == nil || !config.IsEnabled() {
-		return utils.PointerTo(models.TargetScanStateStateNotScanned)
+		return utils.PointerTo(models.AssetScanStateStateNotScanned)
 	}
 
-	return utils.PointerTo(models.TargetScanStateStatePending)
+	return utils.PointerTo(models.AssetScanStateStatePending)
 }
 
 func newScanSummary() *models.ScanSummary {
 	return &models.ScanSummary{
 		JobsCompleted:          utils.PointerTo(0),
 		JobsLeftToRun:          utils.PointerTo(0),
+		TotalCertificates:      utils.PointerTo(0),
+		TotalComplianceChecks:  utils.PointerTo(0),
 		TotalExploits:          utils.PointerTo(0),
 		TotalMalware:           utils.PointerTo(0),
 		TotalMisconfigurations: utils.PointerTo(0),
 		TotalPackages:          utils.PointerTo(0),
+		TotalPluginFindings:    utils.PointerTo(0),
 		TotalRootkits:          utils.PointerTo(0),
 		TotalSecrets:           utils.PointerTo(0),
 		TotalVulnerabilities: &models.VulnerabilityScanSummary{
@@ -129,7 +149,7 @@ func newScanSummary() *models.ScanSummary {
 	}
 }
 
-func updateScanSummaryFromScanResult(scan *models.Scan, result models.TargetScanResult) error {
+func updateScanSummaryFromScanResult(scan *models.Scan, result models.AssetScanResult) error {
 	if result.Summary == nil {
 		return errors.New("invalid ScanResult: Summary field is nil")
 	}
@@ -146,17 +166,20 @@ func updateScanSummaryFromScanResult(scan *models.Scan, result models.TargetScan
 	s, r := scan.Summary, result.Summary
 
 	switch state {
-	case models.TargetScanStateStateNotScanned:
-	case models.TargetScanStateStatePending, models.TargetScanStateStateScheduled, models.TargetScanStateStateReadyToScan:
+	case models.AssetScanStateStateNotScanned:
+	case models.AssetScanStateStatePending, models.AssetScanStateStateScheduled, models.AssetScanStateStateReadyToScan:
 		fallthrough
-	case models.TargetScanStateStateInProgress, models.TargetScanStateStateAborted:
+	case models.AssetScanStateStateInProgress, models.AssetScanStateStateAborted:
 		s.JobsLeftToRun = utils.PointerTo(*s.JobsLeftToRun + 1)
-	case models.TargetScanStateStateDone:
+	case models.AssetScanStateStateDone:
 		s.JobsCompleted = utils.PointerTo(*s.JobsCompleted + 1)
+		s.TotalCertificates = utils.PointerTo(utils.ValueOrZero(s.TotalCertificates) + utils.ValueOrZero(r.TotalCertificates))
+		s.TotalComplianceChecks = utils.PointerTo(utils.ValueOrZero(s.TotalComplianceChecks) + utils.ValueOrZero(r.TotalComplianceChecks))
 		s.TotalExploits = utils.PointerTo(*s.TotalExploits + *r.TotalExploits)
 		s.TotalMalware = utils.PointerTo(*s.TotalMalware + *r.TotalMalware)
 		s.TotalMisconfigurations = utils.PointerTo(*s.TotalMisconfigurations + *r.TotalMisconfigurations)
 		s.TotalPackages = utils.PointerTo(*s.TotalPackages + *r.TotalPackages)
+		s.TotalPluginFindings = utils.PointerTo(utils.ValueOrZero(s.TotalPluginFindings) + utils.ValueOrZero(r.TotalPluginFindings))
 		s.TotalRootkits = utils.PointerTo(*s.TotalRootkits + *r.TotalRootkits)
 		s.TotalSecrets = utils.PointerTo(*s.TotalSecrets + *r.TotalSecrets)
 		s.TotalVulnerabilities = &models.VulnerabilityScanSummary{
@@ -175,3 +198,74 @@ func updateScanSummaryFromScanResult(scan *models.Scan, result models.TargetScan
 
 	return nil
 }
+
+// newScanPlan returns the plan of a dry run Scan of the targets, keeping the
+// quota check of the existing plan. Scanning a VM takes a snapshot of its root
+// volume, the other targets are scanned without snapshots.
+func newScanPlan(existing *models.ScanPlan, targets []models.Asset) *models.ScanPlan {
+	plan := &models.ScanPlan{
+		Assets:             &[]models.ScanPlanAsset{},
+		Regions:            &[]string{},
+		EstimatedSnapshots: utils.PointerTo(0),
+	}
+	if existing != nil {
+		plan.QuotaExceededReason = existing.QuotaExceededReason
+	}
+
+	regions := map[string]struct{}{}
+	for _, target := range targets {
+		planTarget := newScanPlanTarget(target)
+		if planTarget.AssetType == "VMInfo" && planTarget.Location != nil {
+			// The location of a VM is either its region or prefixed
+			// with it, e.g. <region>/<vpc> on AWS.
+			region, _, _ := strings.Cut(*planTarget.Location, "/")
+			regions[region] = struct{}{}
+		}
+
+		*plan.EstimatedSnapshots += *planTarget.EstimatedSnapshots
+		*plan.Assets = append(*plan.Assets, planTarget)
+	}
+
+	sort.Slice(*plan.Assets, func(i, j int) bool {
+		return (*plan.Assets)[i].AssetID < (*plan.Assets)[j].AssetID
+	})
+	for region := range regions {
+		*plan.Regions = append(*plan.Regions, region)
+	}
+	sort.Strings(*plan.Regions)
+
+	return plan
+}
+
+func newScanPlanTarget(target models.Asset) models.ScanPlanAsset {
+	planTarget := models.ScanPlanAsset{
+		AssetID:            *target.Id,
+		EstimatedSnapshots: utils.PointerTo(0),
+	}
+	if target.AssetInfo == nil {
+		return planTarget
+	}
+
+	planTarget.AssetType, _ = target.AssetInfo.Discriminator()
+
+	value, err := target.AssetInfo.ValueByDiscriminator()
+	if err != nil {
+		return planTarget
+	}
+
+	switch info := value.(type) {
+	case models.VMInfo:
+		planTarget.Location = utils.PointerTo(info.Location)
+		planTarget.EstimatedSnapshots = utils.PointerTo(1)
+	case models.PodInfo:
+		planTarget.Location = info.Location
+	case models.DirInfo:
+		planTarget.Location = info.Location
+	case models.ContainerImageInfo:
+		planTarget.Location = utils.PointerTo(info.Location)
+	case models.SBOMInfo:
+		planTarget.Location = info.Location
+	}
+
+	return planTarget
+}
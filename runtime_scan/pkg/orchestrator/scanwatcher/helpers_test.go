@@ -36,7 +36,7 @@ func TestNewScanResultFromScan(t *testing.T) {
 		TargetID string
 
 		ExpectedErrorMatcher types.GomegaMatcher
-		ExpectedScanResult   *models.TargetScanResult
+		ExpectedScanResult   *models.AssetScanResult
 	}{
 		{
 			Name: "TargetResult from valid Scan",
@@ -67,47 +67,59 @@ func TestNewScanResultFromScan(t *testing.T) {
 			},
 			TargetID:             targetID,
 			ExpectedErrorMatcher: Not(HaveOccurred()),
-			ExpectedScanResult: &models.TargetScanResult{
+			ExpectedScanResult: &models.AssetScanResult{
 				ResourceCleanup: utils.PointerTo(models.ResourceCleanupStatePending),
 				Scan: &models.ScanRelationship{
 					Id: scanID,
 				},
-				Status: &models.TargetScanStatus{
-					Exploits: &models.TargetScanState{
+				Status: &models.AssetScanStatus{
+					Certificates: &models.AssetScanState{
 						Errors: nil,
-						State:  utils.PointerTo(models.TargetScanStateStatePending),
+						State:  utils.PointerTo(models.AssetScanStateStateNotScanned),
 					},
-					General: &models.TargetScanState{
+					Compliance: &models.AssetScanState{
 						Errors: nil,
-						State:  utils.PointerTo(models.TargetScanStateStatePending),
+						State:  utils.PointerTo(models.AssetScanStateStateNotScanned),
 					},
-					Malware: &models.TargetScanState{
+					Exploits: &models.AssetScanState{
 						Errors: nil,
-						State:  utils.PointerTo(models.TargetScanStateStatePending),
+						State:  utils.PointerTo(models.AssetScanStateStatePending),
 					},
-					Misconfigurations: &models.TargetScanState{
+					General: &models.AssetScanState{
 						Errors: nil,
-						State:  utils.PointerTo(models.TargetScanStateStateNotScanned),
+						State:  utils.PointerTo(models.AssetScanStateStatePending),
 					},
-					Rootkits: &models.TargetScanState{
+					Malware: &models.AssetScanState{
 						Errors: nil,
-						State:  utils.PointerTo(models.TargetScanStateStateNotScanned),
+						State:  utils.PointerTo(models.AssetScanStateStatePending),
 					},
-					Sbom: &models.TargetScanState{
+					Misconfigurations: &models.AssetScanState{
 						Errors: nil,
-						State:  utils.PointerTo(models.TargetScanStateStatePending),
+						State:  utils.PointerTo(models.AssetScanStateStateNotScanned),
 					},
-					Secrets: &models.TargetScanState{
+					Plugins: &models.AssetScanState{
 						Errors: nil,
-						State:  utils.PointerTo(models.TargetScanStateStateNotScanned),
+						State:  utils.PointerTo(models.AssetScanStateStateNotScanned),
 					},
-					Vulnerabilities: &models.TargetScanState{
+					Rootkits: &models.AssetScanState{
 						Errors: nil,
-						State:  utils.PointerTo(models.TargetScanStateStatePending),
+						State:  utils.PointerTo(models.AssetScanStateStateNotScanned),
+					},
+					Sbom: &models.AssetScanState{
+						Errors: nil,
+						State:  utils.PointerTo(models.AssetScanStateStatePending),
+					},
+					Secrets: &models.AssetScanState{
+						Errors: nil,
+						State:  utils.PointerTo(models.AssetScanStateStateNotScanned),
+					},
+					Vulnerabilities: &models.AssetScanState{
+						Errors: nil,
+						State:  utils.PointerTo(models.AssetScanStateStatePending),
 					},
 				},
 				Summary: newScanResultSummary(),
-				Target: &models.TargetRelationship{
+				Asset: &models.AssetRelationship{
 					Id: targetID,
 				},
 			},
@@ -125,3 +137,70 @@ func TestNewScanResultFromScan(t *testing.T) {
 		})
 	}
 }
+
+func TestNewScanPlan(t *testing.T) {
+	newTarget := func(t *testing.T, id string, info interface{}) models.Asset {
+		t.Helper()
+
+		targetType := models.AssetType{}
+		var err error
+		switch i := info.(type) {
+		case models.VMInfo:
+			err = targetType.FromVMInfo(i)
+		case models.ContainerImageInfo:
+			err = targetType.FromContainerImageInfo(i)
+		}
+		if err != nil {
+			t.Fatalf("failed to create target type: %v", err)
+		}
+
+		return models.Asset{
+			Id:        utils.PointerTo(id),
+			AssetInfo: &targetType,
+		}
+	}
+
+	g := NewGomegaWithT(t)
+
+	targets := []models.Asset{
+		newTarget(t, "vm-2", models.VMInfo{ObjectType: "VMInfo", Location: "us-east-1/vpc-2"}),
+		newTarget(t, "image", models.ContainerImageInfo{ObjectType: "ContainerImageInfo", Location: "alpine@sha256:abc"}),
+		newTarget(t, "vm-1", models.VMInfo{ObjectType: "VMInfo", Location: "eu-west-1/vpc-1"}),
+		newTarget(t, "vm-3", models.VMInfo{ObjectType: "VMInfo", Location: "us-east-1/vpc-3"}),
+	}
+	existing := &models.ScanPlan{
+		QuotaExceededReason: utils.PointerTo("monthly scan limit of 10 reached"),
+	}
+
+	g.Expect(newScanPlan(existing, targets)).Should(Equal(&models.ScanPlan{
+		Assets: &[]models.ScanPlanAsset{
+			{
+				AssetID:            "image",
+				AssetType:          "ContainerImageInfo",
+				Location:           utils.PointerTo("alpine@sha256:abc"),
+				EstimatedSnapshots: utils.PointerTo(0),
+			},
+			{
+				AssetID:            "vm-1",
+				AssetType:          "VMInfo",
+				Location:           utils.PointerTo("eu-west-1/vpc-1"),
+				EstimatedSnapshots: utils.PointerTo(1),
+			},
+			{
+				AssetID:            "vm-2",
+				AssetType:          "VMInfo",
+				Location:           utils.PointerTo("us-east-1/vpc-2"),
+				EstimatedSnapshots: utils.PointerTo(1),
+			},
+			{
+				AssetID:            "vm-3",
+				AssetType:          "VMInfo",
+				Location:           utils.PointerTo("us-east-1/vpc-3"),
+				EstimatedSnapshots: utils.PointerTo(1),
+			},
+		},
+		Regions:             &[]string{"eu-west-1", "us-east-1"},
+		EstimatedSnapshots:  utils.PointerTo(3),
+		QuotaExceededReason: utils.PointerTo("monthly scan limit of 10 reached"),
+	}))
+}
@@ -0,0 +1,210 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanwatcher
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// sampleTargets keeps the sample of the Targets of the Scan if its ScanConfig
+// has sampling configured, and records the coverage of the rotation.
+func (w *Watcher) sampleTargets(ctx context.Context, scan *models.Scan, targets []models.Asset) ([]models.Asset, error) {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	if scan.ScanConfigSnapshot == nil || scan.ScanConfigSnapshot.Sampling == nil {
+		return targets, nil
+	}
+	sampling := *scan.ScanConfigSnapshot.Sampling
+
+	var previousScans []models.Scan
+	if scan.ScanConfig != nil {
+		// Twice the runs of a rotation are fetched, so that the rotations
+		// extended by new targets are still found.
+		scans, err := w.backend.GetScans(ctx, models.GetScansParams{
+			Filter:  utils.PointerTo(fmt.Sprintf("scanConfig/id eq '%s' and id ne '%s'", scan.ScanConfig.Id, *scan.Id)),
+			Select:  utils.PointerTo("id,assetIDs,dryRun,samplingCoverage"),
+			OrderBy: utils.PointerTo("startTime desc"),
+			Top:     utils.PointerTo(2 * samplingRotationRuns(sampling)), // nolint:gomnd
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the previous Scans of ScanConfig. ScanConfigID=%s: %w", scan.ScanConfig.Id, err)
+		}
+		for _, previous := range utils.ValueOrZero(scans.Items) {
+			// Only the sampled scans are part of the rotations.
+			if utils.ValueOrZero(previous.DryRun) || previous.SamplingCoverage == nil {
+				continue
+			}
+			previousScans = append(previousScans, previous)
+		}
+	}
+
+	rnd := rand.New(rand.NewSource(time.Now().UnixNano())) // nolint:gosec
+	sampled, coverage := newTargetSample(targets, sampling, previousScans, rnd)
+
+	targetIDs := make([]string, 0, len(sampled))
+	for _, target := range sampled {
+		targetIDs = append(targetIDs, *target.Id)
+	}
+	scan.AssetIDs = &targetIDs
+	scan.SamplingCoverage = &coverage
+
+	logger.Infof("Sampled %d of %d Target(s) for run %d of the rotation, %d Target(s) are covered",
+		coverage.AssetsSampled, coverage.AssetsMatched, coverage.RotationRun, coverage.AssetsCovered)
+
+	return sampled, nil
+}
+
+// samplingPercentage returns the percentage of the targets sampled by a run,
+// limited to the range allowed by the API.
+func samplingPercentage(sampling models.ScanSampling) int {
+	switch {
+	case sampling.Percentage < 1:
+		return 1
+	case sampling.Percentage > 100: // nolint:gomnd
+		return 100 // nolint:gomnd
+	default:
+		return sampling.Percentage
+	}
+}
+
+// samplingRotationRuns returns the number of runs which scan all the targets.
+func samplingRotationRuns(sampling models.ScanSampling) int {
+	return int(math.Ceil(100 / float64(samplingPercentage(sampling)))) // nolint:gomnd
+}
+
+// newTargetSample samples the percentage of the targets configured by
+// sampling. The previous sampled scans of the ScanConfig, the most recent
+// first, tell which targets were scanned in the current rotation and how many
+// runs ago each target was scanned last.
+//
+// The targets not scanned yet in the rotation are sampled first, so that a
+// rotation of a stable fleet is done in samplingRotationRuns runs. A new
+// rotation is started once all the targets are scanned. Within the two groups
+// the targets are sampled with a probability weighted by the number of runs
+// since their last scan, the targets never scanned having the highest weight.
+func newTargetSample(targets []models.Asset, sampling models.ScanSampling, previousScans []models.Scan, rnd *rand.Rand) ([]models.Asset, models.ScanSamplingCoverage) {
+	runsSinceScanned := make(map[string]int)
+	for i, scan := range previousScans {
+		for _, id := range utils.ValueOrZero(scan.AssetIDs) {
+			if _, ok := runsSinceScanned[id]; !ok {
+				runsSinceScanned[id] = i + 1
+			}
+		}
+	}
+	weight := func(target models.Asset) float64 {
+		if runs, ok := runsSinceScanned[*target.Id]; ok {
+			return float64(runs)
+		}
+		return float64(len(previousScans) + 1)
+	}
+
+	// The rotation of the previous scan goes back to its first run.
+	rotationRun := 1
+	covered := make(map[string]struct{})
+	if len(previousScans) > 0 {
+		rotationRun = previousScans[0].SamplingCoverage.RotationRun + 1
+		for _, scan := range previousScans {
+			for _, id := range utils.ValueOrZero(scan.AssetIDs) {
+				covered[id] = struct{}{}
+			}
+			if scan.SamplingCoverage.RotationRun <= 1 {
+				break
+			}
+		}
+	}
+
+	var notCovered, alreadyCovered []models.Asset
+	for _, target := range targets {
+		if _, ok := covered[*target.Id]; ok {
+			alreadyCovered = append(alreadyCovered, target)
+		} else {
+			notCovered = append(notCovered, target)
+		}
+	}
+	if len(notCovered) == 0 {
+		rotationRun = 1
+		covered = make(map[string]struct{})
+		notCovered, alreadyCovered = alreadyCovered, nil
+	}
+
+	sampleSize := int(math.Ceil(float64(len(targets)*samplingPercentage(sampling)) / 100)) // nolint:gomnd
+
+	sampled := weightedSample(notCovered, sampleSize, weight, rnd)
+	if len(sampled) < sampleSize {
+		sampled = append(sampled, weightedSample(alreadyCovered, sampleSize-len(sampled), weight, rnd)...)
+	}
+
+	for _, target := range sampled {
+		covered[*target.Id] = struct{}{}
+	}
+	var assetsCovered int
+	for _, target := range targets {
+		if _, ok := covered[*target.Id]; ok {
+			assetsCovered++
+		}
+	}
+
+	return sampled, models.ScanSamplingCoverage{
+		AssetsMatched: len(targets),
+		AssetsSampled: len(sampled),
+		AssetsCovered: assetsCovered,
+		RotationRun:   rotationRun,
+	}
+}
+
+// weightedSample returns n of the targets sampled without replacement with a
+// probability proportional to their weight, using the keys of the
+// Efraimidis-Spirakis algorithm.
+func weightedSample(targets []models.Asset, n int, weight func(models.Asset) float64, rnd *rand.Rand) []models.Asset {
+	if n >= len(targets) {
+		return targets
+	}
+	if n <= 0 {
+		return nil
+	}
+
+	type keyedTarget struct {
+		target models.Asset
+		key    float64
+	}
+	keyed := make([]keyedTarget, 0, len(targets))
+	for _, target := range targets {
+		// log(u) / w orders the targets as the u^(1/w) key of the
+		// algorithm does, without losing precision for large weights.
+		keyed = append(keyed, keyedTarget{
+			target: target,
+			key:    math.Log(1-rnd.Float64()) / weight(target),
+		})
+	}
+	sort.Slice(keyed, func(i, j int) bool {
+		return keyed[i].key > keyed[j].key
+	})
+
+	sampled := make([]models.Asset, 0, n)
+	for _, k := range keyed[:n] {
+		sampled = append(sampled, k.target)
+	}
+	return sampled
+}
@@ -0,0 +1,138 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanwatcher
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func newSamplingTargets(n int) []models.Asset {
+	targets := make([]models.Asset, 0, n)
+	for i := 0; i < n; i++ {
+		targets = append(targets, models.Asset{Id: utils.PointerTo(fmt.Sprintf("target-%03d", i))})
+	}
+	return targets
+}
+
+func TestNewTargetSampleRotation(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	targets := newSamplingTargets(100)
+	sampling := models.ScanSampling{Percentage: 10}
+	rnd := rand.New(rand.NewSource(1)) // nolint:gosec
+
+	// A rotation of 10 runs scans each of the targets once.
+	var previousScans []models.Scan
+	scanned := make(map[string]int)
+	for run := 1; run <= 10; run++ {
+		sampled, coverage := newTargetSample(targets, sampling, previousScans, rnd)
+
+		g.Expect(sampled).Should(HaveLen(10))
+		g.Expect(coverage.RotationRun).Should(Equal(run))
+		g.Expect(coverage.AssetsMatched).Should(Equal(100))
+		g.Expect(coverage.AssetsSampled).Should(Equal(len(sampled)))
+
+		ids := make([]string, 0, len(sampled))
+		for _, target := range sampled {
+			scanned[*target.Id]++
+			ids = append(ids, *target.Id)
+		}
+		g.Expect(coverage.AssetsCovered).Should(Equal(len(scanned)))
+
+		previousScans = append([]models.Scan{{
+			AssetIDs:         &ids,
+			SamplingCoverage: &coverage,
+		}}, previousScans...)
+	}
+	g.Expect(scanned).Should(HaveLen(100))
+	for id, count := range scanned {
+		g.Expect(count).Should(Equal(1), "target %s", id)
+	}
+
+	// The next run starts a new rotation.
+	sampled, coverage := newTargetSample(targets, sampling, previousScans, rnd)
+	g.Expect(sampled).Should(HaveLen(10))
+	g.Expect(coverage.RotationRun).Should(Equal(1))
+	g.Expect(coverage.AssetsCovered).Should(Equal(10))
+}
+
+func TestNewTargetSampleNewTargets(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	targets := newSamplingTargets(10)
+	sampling := models.ScanSampling{Percentage: 50}
+	rnd := rand.New(rand.NewSource(1)) // nolint:gosec
+
+	// The previous run scanned the first 8 targets, so the new ones are
+	// sampled first and the rest of the sample is taken from the covered
+	// ones to start the next rotation.
+	ids := make([]string, 0, 8)
+	for _, target := range targets[:8] {
+		ids = append(ids, *target.Id)
+	}
+	previousScans := []models.Scan{{
+		AssetIDs: &ids,
+		SamplingCoverage: &models.ScanSamplingCoverage{
+			AssetsMatched: 8,
+			AssetsSampled: 8,
+			AssetsCovered: 8,
+			RotationRun:   2,
+		},
+	}}
+
+	sampled, coverage := newTargetSample(targets, sampling, previousScans, rnd)
+	g.Expect(sampled).Should(HaveLen(5))
+	g.Expect(sampled[:2]).Should(ConsistOf(targets[8], targets[9]))
+	g.Expect(coverage).Should(Equal(models.ScanSamplingCoverage{
+		AssetsMatched: 10,
+		AssetsSampled: 5,
+		AssetsCovered: 10,
+		RotationRun:   3,
+	}))
+}
+
+func TestWeightedSample(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	targets := newSamplingTargets(2)
+	rnd := rand.New(rand.NewSource(1)) // nolint:gosec
+	weight := func(target models.Asset) float64 {
+		if *target.Id == *targets[0].Id {
+			return 99
+		}
+		return 1
+	}
+
+	g.Expect(weightedSample(targets, 3, weight, rnd)).Should(Equal(targets))
+	g.Expect(weightedSample(targets, 0, weight, rnd)).Should(BeEmpty())
+
+	// The target with 99 times the weight is sampled about 99 times
+	// out of 100.
+	var heavy int
+	for i := 0; i < 1000; i++ {
+		if *weightedSample(targets, 1, weight, rnd)[0].Id == *targets[0].Id {
+			heavy++
+		}
+	}
+	g.Expect(heavy).Should(BeNumerically(">", 950))
+}
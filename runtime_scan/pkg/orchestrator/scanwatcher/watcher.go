@@ -24,6 +24,8 @@ import (
 
 	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/common"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/networkpolicy"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/registrydiscovery"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
 	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
 	"github.com/openclarity/vmclarity/shared/pkg/log"
@@ -40,9 +42,12 @@ func New(c Config) *Watcher {
 	return &Watcher{
 		backend:          c.Backend,
 		provider:         c.Provider,
+		clock:            common.ClockOrDefault(c.Clock),
 		pollPeriod:       c.PollPeriod,
 		reconcileTimeout: c.ReconcileTimeout,
 		scanTimeout:      c.ScanTimeout,
+		networkPolicy:    c.NetworkPolicy,
+		imageDiscoverer:  c.ImageDiscoverer,
 		queue:            common.NewQueue[ScanReconcileEvent](),
 	}
 }
@@ -50,9 +55,12 @@ func New(c Config) *Watcher {
 type Watcher struct {
 	backend          *backendclient.BackendClient
 	provider         provider.Provider
+	clock            common.Clock
 	pollPeriod       time.Duration
 	reconcileTimeout time.Duration
 	scanTimeout      time.Duration
+	networkPolicy    *networkpolicy.Reconciler
+	imageDiscoverer  *registrydiscovery.Discoverer
 
 	queue *ScanQueue
 }
@@ -131,7 +139,7 @@ func (w *Watcher) Reconcile(ctx context.Context, event ScanReconcileEvent) error
 		return fmt.Errorf("failed to fetch Scan. ScanID=%s: %w", event.ScanID, err)
 	}
 
-	if scan.IsTimedOut(w.scanTimeout) {
+	if scan.IsTimedOutAt(w.scanTimeout, w.clock.Now()) {
 		scan.State = utils.PointerTo(models.ScanStateAborted)
 		scan.StateMessage = utils.PointerTo("Scan has been timed out")
 		scan.StateReason = utils.PointerTo(models.ScanStateReasonTimedOut)
@@ -200,12 +208,34 @@ func (w *Watcher) reconcilePending(ctx context.Context, scan *models.Scan) error
 	if err != nil {
 		return fmt.Errorf("failed to discover Targets for Scan. ScanID=%s: %w", scanID, err)
 	}
-	numOfTargets := len(targets)
 
-	if numOfTargets > 0 {
-		if err = w.createTargets(ctx, scan, targets); err != nil {
+	if w.imageDiscoverer != nil {
+		// The images are discovered even if some of the registries failed,
+		// which must not prevent scanning the rest of the targets.
+		images, err := w.imageDiscoverer.DiscoverImages(ctx)
+		if err != nil {
+			logger.Warnf("Failed to discover container images for Scan: %v", err)
+		}
+		targets = append(targets, images...)
+	}
+
+	var createdTargets []models.Asset
+	if len(targets) > 0 {
+		if createdTargets, err = w.createTargets(ctx, scan, targets); err != nil {
 			return fmt.Errorf("failed to create Targets for Scan. ScanID=%s: %w", scanID, err)
 		}
+		// All the discovered Targets are created, so that the ones which
+		// are not yet members of the asset groups can be labeled to join.
+		if createdTargets, err = w.filterAssetGroupMembers(ctx, scan, createdTargets); err != nil {
+			return fmt.Errorf("failed to filter Targets of the asset groups for Scan. ScanID=%s: %w", scanID, err)
+		}
+		if createdTargets, err = w.sampleTargets(ctx, scan, createdTargets); err != nil {
+			return fmt.Errorf("failed to sample Targets for Scan. ScanID=%s: %w", scanID, err)
+		}
+	}
+	numOfTargets := len(createdTargets)
+
+	if numOfTargets > 0 {
 		scan.State = utils.PointerTo(models.ScanStateDiscovered)
 		scan.StateMessage = utils.PointerTo("Targets for Scan are successfully discovered")
 	} else {
@@ -215,11 +245,26 @@ func (w *Watcher) reconcilePending(ctx context.Context, scan *models.Scan) error
 	}
 	logger.Debugf("%d Target(s) have been created for Scan", numOfTargets)
 
+	// A dry run finishes with the plan of the Scan instead of creating the
+	// ScanResults which provision the scanner resources.
+	if utils.ValueOrZero(scan.DryRun) {
+		scan.Plan = newScanPlan(scan.Plan, createdTargets)
+		if numOfTargets > 0 {
+			scan.State = utils.PointerTo(models.ScanStateDone)
+			scan.StateReason = utils.PointerTo(models.ScanStateReasonSuccess)
+			scan.StateMessage = utils.PointerTo(fmt.Sprintf("Dry run planned %d Target(s), no resources were provisioned", numOfTargets))
+		}
+		logger.Infof("Dry run of Scan planned %d Target(s)", numOfTargets)
+	}
+
 	scanPatch := &models.Scan{
-		TargetIDs:    scan.TargetIDs,
+		AssetIDs:     scan.AssetIDs,
 		State:        scan.State,
 		StateReason:  scan.StateReason,
 		StateMessage: scan.StateMessage,
+		Plan:         scan.Plan,
+
+		SamplingCoverage: scan.SamplingCoverage,
 	}
 
 	if err = w.backend.PatchScan(ctx, scanID, scanPatch); err != nil {
@@ -229,13 +274,13 @@ func (w *Watcher) reconcilePending(ctx context.Context, scan *models.Scan) error
 	return nil
 }
 
-func (w *Watcher) createTargets(ctx context.Context, scan *models.Scan, targetTypes []models.TargetType) error {
+func (w *Watcher) createTargets(ctx context.Context, scan *models.Scan, targetTypes []models.AssetType) ([]models.Asset, error) {
 	logger := log.GetLoggerFromContextOrDiscard(ctx)
 
 	var creatingTargetsFailed bool
 	var wg sync.WaitGroup
 
-	results := make(chan string, len(targetTypes))
+	results := make(chan models.Asset, len(targetTypes))
 	for _, t := range targetTypes {
 		targetType := t
 
@@ -249,8 +294,19 @@ func (w *Watcher) createTargets(ctx context.Context, scan *models.Scan, targetTy
 				return
 			}
 
+			// Network policy findings are based on the discovery data,
+			// so failing to update them doesn't fail the Scan.
+			if w.networkPolicy != nil {
+				if err = w.networkPolicy.Reconcile(ctx, targetID, targetType); err != nil {
+					logger.WithField("TargetID", targetID).Warnf("Failed to reconcile network policy findings: %v", err)
+				}
+			}
+
 			logger.WithField("TargetID", targetID).Trace("Pushing Target to channel")
-			results <- targetID
+			results <- models.Asset{
+				Id:        &targetID,
+				AssetInfo: &targetType,
+			}
 		}()
 	}
 	logger.Trace("Waiting until all Target(s) are created")
@@ -258,28 +314,63 @@ func (w *Watcher) createTargets(ctx context.Context, scan *models.Scan, targetTy
 	close(results)
 
 	if creatingTargetsFailed {
-		return fmt.Errorf("failed to create Target(s) for Scan. ScanID=%s", *scan.Id)
+		return nil, fmt.Errorf("failed to create Target(s) for Scan. ScanID=%s", *scan.Id)
 	}
 
-	targetIDs := make([]string, 0)
-	for targetID := range results {
-		targetIDs = append(targetIDs, targetID)
+	targets := make([]models.Asset, 0, len(targetTypes))
+	targetIDs := make([]string, 0, len(targetTypes))
+	for target := range results {
+		targets = append(targets, target)
+		targetIDs = append(targetIDs, *target.Id)
 	}
-	scan.TargetIDs = &targetIDs
+	scan.AssetIDs = &targetIDs
 
 	logger.Tracef("Created Target(s): %v", targetIDs)
 
-	return nil
+	return targets, nil
+}
+
+// filterAssetGroupMembers keeps the Targets which are members of any of the
+// asset groups the Scan is restricted to, if any.
+func (w *Watcher) filterAssetGroupMembers(ctx context.Context, scan *models.Scan, targets []models.Asset) ([]models.Asset, error) {
+	if scan.ScanConfigSnapshot == nil || len(utils.ValueOrZero(scan.ScanConfigSnapshot.AssetGroupIDs)) == 0 {
+		return targets, nil
+	}
+
+	members := make(map[string]struct{})
+	for _, groupID := range *scan.ScanConfigSnapshot.AssetGroupIDs {
+		assets, err := w.backend.GetAssetGroupAssets(ctx, groupID, models.GetAssetGroupsAssetGroupIDAssetsParams{
+			Select: utils.PointerTo("id"),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the members of asset group %s: %w", groupID, err)
+		}
+		for _, asset := range utils.ValueOrZero(assets.Items) {
+			members[utils.ValueOrZero(asset.Id)] = struct{}{}
+		}
+	}
+
+	filtered := make([]models.Asset, 0, len(targets))
+	targetIDs := make([]string, 0, len(targets))
+	for _, target := range targets {
+		if _, ok := members[*target.Id]; ok {
+			filtered = append(filtered, target)
+			targetIDs = append(targetIDs, *target.Id)
+		}
+	}
+	scan.AssetIDs = &targetIDs
+
+	return filtered, nil
 }
 
-func (w *Watcher) createTarget(ctx context.Context, targetType models.TargetType) (string, error) {
+func (w *Watcher) createTarget(ctx context.Context, targetType models.AssetType) (string, error) {
 	logger := log.GetLoggerFromContextOrDiscard(ctx)
 
-	target, err := w.backend.PostTarget(ctx, models.Target{
-		TargetInfo: &targetType,
+	target, err := w.backend.PostAsset(ctx, models.Asset{
+		AssetInfo: &targetType,
 	})
 	if err != nil {
-		var conErr backendclient.TargetConflictError
+		var conErr backendclient.AssetConflictError
 		if errors.As(err, &conErr) {
 			logger.WithField("TargetID", *conErr.ConflictingTarget.Id).Trace("Target already exist")
 			return *conErr.ConflictingTarget.Id, nil
@@ -309,16 +400,16 @@ func (w *Watcher) reconcileDiscovered(ctx context.Context, scan *models.Scan) er
 	scan.State = utils.PointerTo(models.ScanStateInProgress)
 
 	scanPatch := &models.Scan{
-		State:     scan.State,
-		Summary:   scan.Summary,
-		TargetIDs: scan.TargetIDs,
+		State:    scan.State,
+		Summary:  scan.Summary,
+		AssetIDs: scan.AssetIDs,
 	}
 	err := w.backend.PatchScan(ctx, scanID, scanPatch)
 	if err != nil {
 		return fmt.Errorf("failed to update Scan. ScanID=%s: %w", scanID, err)
 	}
 
-	logger.Infof("Total %d unique targets for Scan", len(*scan.TargetIDs))
+	logger.Infof("Total %d unique targets for Scan", len(*scan.AssetIDs))
 
 	return nil
 }
@@ -326,14 +417,14 @@ func (w *Watcher) reconcileDiscovered(ctx context.Context, scan *models.Scan) er
 func (w *Watcher) createScanResultsForScan(ctx context.Context, scan *models.Scan) error {
 	logger := log.GetLoggerFromContextOrDiscard(ctx)
 
-	if scan.TargetIDs == nil || *scan.TargetIDs == nil {
+	if scan.AssetIDs == nil || *scan.AssetIDs == nil {
 		return nil
 	}
-	numOfTargets := len(*scan.TargetIDs)
+	numOfTargets := len(*scan.AssetIDs)
 
 	errs := make(chan error, numOfTargets)
 	var wg sync.WaitGroup
-	for _, id := range *scan.TargetIDs {
+	for _, id := range *scan.AssetIDs {
 		wg.Add(1)
 		targetID := id
 		go func() {
@@ -341,7 +432,7 @@ func (w *Watcher) createScanResultsForScan(ctx context.Context, scan *models.Sca
 
 			err := w.createScanResultForTarget(ctx, scan, targetID)
 			if err != nil {
-				logger.WithField("TargetID", targetID).Errorf("Failed to create TargetScanResult: %v", err)
+				logger.WithField("TargetID", targetID).Errorf("Failed to create AssetScanResult: %v", err)
 				errs <- err
 
 				return
@@ -450,15 +541,16 @@ func (w *Watcher) reconcileInProgress(ctx context.Context, scan *models.Scan) er
 		scan.StateMessage = utils.PointerTo(fmt.Sprintf("%d succeeded, %d failed out of %d total target scans",
 			*targetScanResults.Count-targetScanResultsWithErr, targetScanResultsWithErr, *targetScanResults.Count))
 
-		scan.EndTime = utils.PointerTo(time.Now())
+		scan.EndTime = utils.PointerTo(w.clock.Now())
 	}
 
 	scanPatch := &models.Scan{
 		State:        scan.State,
 		Summary:      scan.Summary,
 		StateMessage: scan.StateMessage,
+		StateReason:  scan.StateReason,
 		EndTime:      scan.EndTime,
-		TargetIDs:    scan.TargetIDs,
+		AssetIDs:     scan.AssetIDs,
 	}
 	err = w.backend.PatchScan(ctx, scanID, scanPatch)
 	if err != nil {
@@ -482,7 +574,7 @@ func (w *Watcher) reconcileAborted(ctx context.Context, scan *models.Scan) error
 	}
 
 	filter := fmt.Sprintf("scan/id eq '%s' and status/general/state ne '%s' and status/general/state ne '%s'",
-		scanID, models.TargetScanStateStateAborted, models.TargetScanStateStateDone)
+		scanID, models.AssetScanStateStateAborted, models.AssetScanStateStateDone)
 	selector := "id,status"
 	params := models.GetScanResultsParams{
 		Filter: &filter,
@@ -494,6 +586,8 @@ func (w *Watcher) reconcileAborted(ctx context.Context, scan *models.Scan) error
 		return fmt.Errorf("failed to fetch ScanResult(s) for Scan. ScanID=%s: %w", scanID, err)
 	}
 
+	_, prewarmsSnapshots := provider.SnapshotPrewarmerOf(w.provider)
+
 	if scanResults.Items != nil && len(*scanResults.Items) > 0 {
 		var reconciliationFailed bool
 		var wg sync.WaitGroup
@@ -503,17 +597,25 @@ func (w *Watcher) reconcileAborted(ctx context.Context, scan *models.Scan) error
 				continue
 			}
 			scanResultID := *scanResult.Id
+			state, _ := scanResult.GetGeneralState()
 
 			wg.Add(1)
 			go func() {
 				defer wg.Done()
-				sr := models.TargetScanResult{
-					Status: &models.TargetScanStatus{
-						General: &models.TargetScanState{
-							State: utils.PointerTo(models.TargetScanStateStateAborted),
+				sr := models.AssetScanResult{
+					Status: &models.AssetScanStatus{
+						General: &models.AssetScanState{
+							State: utils.PointerTo(models.AssetScanStateStateAborted),
 						},
 					},
 				}
+				// No scanner has been launched for pending ScanResults,
+				// the others are cleaned up by the ScanResult watcher.
+				// Pending ScanResults may still have a pre-warmed target
+				// volume snapshot if the provider creates them.
+				if state == models.AssetScanStateStatePending && !prewarmsSnapshots {
+					sr.ResourceCleanup = utils.PointerTo(models.ResourceCleanupStateSkipped)
+				}
 
 				err = w.backend.PatchScanResult(ctx, sr, scanResultID)
 				if err != nil {
@@ -533,7 +635,7 @@ func (w *Watcher) reconcileAborted(ctx context.Context, scan *models.Scan) error
 		}
 	}
 
-	scan.EndTime = utils.PointerTo(time.Now())
+	scan.EndTime = utils.PointerTo(w.clock.Now())
 	scan.State = utils.PointerTo(models.ScanStateFailed)
 	scan.StateReason = utils.PointerTo(models.ScanStateReasonAborted)
 	scan.StateMessage = utils.PointerTo("Scan has been aborted")
@@ -543,7 +645,7 @@ func (w *Watcher) reconcileAborted(ctx context.Context, scan *models.Scan) error
 		EndTime:      scan.EndTime,
 		StateReason:  scan.StateReason,
 		StateMessage: scan.StateMessage,
-		TargetIDs:    scan.TargetIDs,
+		AssetIDs:     scan.AssetIDs,
 	}
 	err = w.backend.PatchScan(ctx, scanID, scanPatch)
 	if err != nil {
@@ -0,0 +1,70 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulation
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"path/filepath"
+
+	"github.com/openclarity/vmclarity/backend/pkg/database"
+	"github.com/openclarity/vmclarity/backend/pkg/database/types"
+	"github.com/openclarity/vmclarity/backend/pkg/rest"
+	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
+)
+
+// Backend is the VMClarity backend served in-process, with a local database
+// in a directory.
+type Backend struct {
+	Client   *backendclient.BackendClient
+	Database types.Database
+
+	server *httptest.Server
+}
+
+// NewBackend starts a Backend with its database in dir, which must be
+// closed with Close.
+func NewBackend(dir string) (*Backend, error) {
+	db, err := database.InitializeDatabase(types.DBConfig{
+		DriverType:  types.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(dir, "db.sqlite"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialise database: %w", err)
+	}
+
+	restServer, err := rest.CreateRESTServer(0, db, rest.UsageLimits{}, nil, nil, nil, nil, nil, nil, "", dir, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rest server: %w", err)
+	}
+	server := httptest.NewServer(restServer.Handler())
+
+	client, err := backendclient.Create(server.URL + rest.BaseURL)
+	if err != nil {
+		server.Close()
+		return nil, fmt.Errorf("failed to create backend client: %w", err)
+	}
+
+	return &Backend{
+		Client:   client,
+		Database: db,
+		server:   server,
+	}, nil
+}
+
+func (b *Backend) Close() {
+	b.server.Close()
+}
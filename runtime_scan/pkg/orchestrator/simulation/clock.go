@@ -0,0 +1,175 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulation
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Clock is a virtual clock which only advances when the Simulation or a
+// Sleep advances it. It implements common.Clock and fake.Clock.
+type Clock struct {
+	now    time.Time
+	timers []*timeoutContext
+
+	mu sync.Mutex
+}
+
+func NewClock(start time.Time) *Clock {
+	return &Clock{now: start}
+}
+
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// WithTimeout returns a copy of ctx which is canceled once the clock is
+// advanced by d. Its Err, and the Cause of the contexts derived from it, is
+// context.DeadlineExceeded once it timed out.
+// Note that the returned context has no Deadline as it is measured on the
+// virtual clock.
+func (c *Clock) WithTimeout(ctx context.Context, d time.Duration) (context.Context, context.CancelFunc) {
+	cancelCtx, cancel := context.WithCancelCause(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	timer := &timeoutContext{
+		Context:  cancelCtx,
+		deadline: c.now.Add(d),
+		cancel:   cancel,
+	}
+	c.timers = append(c.timers, timer)
+
+	return timer, func() {
+		c.removeTimer(timer)
+		cancel(context.Canceled)
+	}
+}
+
+// Sleep advances the clock by d, or until ctx is done if it times out on
+// the clock meanwhile, in which case the error of ctx is returned.
+func (c *Clock) Sleep(ctx context.Context, d time.Duration) error {
+	c.mu.Lock()
+	end := c.now.Add(d)
+	c.mu.Unlock()
+
+	for {
+		if err := ctx.Err(); err != nil {
+			// nolint:wrapcheck
+			return err
+		}
+
+		next, ok := c.nextDeadline()
+		if !ok || next.After(end) {
+			break
+		}
+		c.AdvanceTo(next)
+	}
+	c.AdvanceTo(end)
+
+	// nolint:wrapcheck
+	return ctx.Err()
+}
+
+// Advance advances the clock by d.
+func (c *Clock) Advance(d time.Duration) {
+	c.AdvanceTo(c.Now().Add(d))
+}
+
+// AdvanceTo advances the clock to t, timing out the contexts whose deadline
+// is reached. The clock never goes backwards.
+func (c *Clock) AdvanceTo(t time.Time) {
+	c.mu.Lock()
+	if t.After(c.now) {
+		c.now = t
+	}
+
+	var expired []*timeoutContext
+	timers := c.timers[:0]
+	for _, timer := range c.timers {
+		if timer.deadline.After(c.now) {
+			timers = append(timers, timer)
+		} else {
+			expired = append(expired, timer)
+		}
+	}
+	c.timers = timers
+	c.mu.Unlock()
+
+	for _, timer := range expired {
+		timer.timeout()
+	}
+}
+
+func (c *Clock) nextDeadline() (time.Time, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var next time.Time
+	for _, timer := range c.timers {
+		if next.IsZero() || timer.deadline.Before(next) {
+			next = timer.deadline
+		}
+	}
+	return next, !next.IsZero()
+}
+
+func (c *Clock) removeTimer(timer *timeoutContext) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, t := range c.timers {
+		if t == timer {
+			c.timers = append(c.timers[:i], c.timers[i+1:]...)
+			return
+		}
+	}
+}
+
+type timeoutContext struct {
+	context.Context
+
+	deadline time.Time
+	cancel   context.CancelCauseFunc
+	timedOut bool
+
+	mu sync.Mutex
+}
+
+func (t *timeoutContext) timeout() {
+	t.mu.Lock()
+	t.timedOut = true
+	t.mu.Unlock()
+
+	t.cancel(context.DeadlineExceeded)
+}
+
+func (t *timeoutContext) Err() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.timedOut {
+		return context.DeadlineExceeded
+	}
+	// nolint:wrapcheck
+	return t.Context.Err()
+}
@@ -0,0 +1,179 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulation
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/common"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+)
+
+// Controller polls and reconciles the items of an orchestrator controller on
+// the virtual clock of the Simulation, in place of the common.Poller,
+// common.Queue and common.Reconciler which run it on goroutines. The items
+// are processed one at a time in the order they were polled or requeued,
+// and are deduplicated the same way as by the common.Queue.
+type Controller[T common.ReconcileEvent] struct {
+	// Name of the controller in the logs.
+	Name string
+	// How often GetItems is called.
+	PollPeriod time.Duration
+	// The time after which the context of ReconcileFunction times out.
+	ReconcileTimeout time.Duration
+
+	GetItems          func(context.Context) ([]T, error)
+	ReconcileFunction func(context.Context, T) error
+
+	// Errors returned by GetItems and ReconcileFunction, except the
+	// common.RequeueAfterError ones.
+	Errors []error
+
+	started  bool
+	nextPoll time.Time
+	queue    []T
+	inqueue  map[string]struct{}
+	waiting  map[string]waitingItem[T]
+}
+
+type waitingItem[T common.ReconcileEvent] struct {
+	item T
+	at   time.Time
+}
+
+// nextEventTime returns the time of the next poll or requeue of the
+// controller, or the zero time if it has queued items to reconcile now.
+func (c *Controller[T]) nextEventTime() time.Time {
+	if len(c.queue) > 0 {
+		return time.Time{}
+	}
+
+	next := c.nextPoll
+	for _, w := range c.waiting {
+		if w.at.Before(next) {
+			next = w.at
+		}
+	}
+	return next
+}
+
+// step processes the events due at now, and then reconciles the first
+// queued item if there is one. It returns whether an item was reconciled.
+func (c *Controller[T]) step(ctx context.Context, clock *Clock) bool {
+	logger := log.GetLoggerFromContextOrDiscard(ctx).WithField("controller", c.Name)
+	ctx = log.SetLoggerForContext(ctx, logger)
+	now := clock.Now()
+
+	if !c.started {
+		c.started = true
+		c.nextPoll = now
+		c.inqueue = make(map[string]struct{})
+		c.waiting = make(map[string]waitingItem[T])
+	}
+
+	c.enqueueWaiting(now)
+
+	if !c.nextPoll.After(now) {
+		c.poll(ctx, clock)
+		c.nextPoll = now.Add(c.PollPeriod)
+	}
+
+	if len(c.queue) == 0 {
+		return false
+	}
+
+	item := c.queue[0]
+	c.queue = c.queue[1:]
+	delete(c.inqueue, item.Hash())
+
+	c.reconcile(ctx, clock, item)
+	return true
+}
+
+func (c *Controller[T]) poll(ctx context.Context, clock *Clock) {
+	pollCtx, cancel := clock.WithTimeout(ctx, c.PollPeriod)
+	defer cancel()
+
+	items, err := c.GetItems(pollCtx)
+	if err != nil {
+		log.GetLoggerFromContextOrDiscard(ctx).Errorf("Failed to get items to reconcile: %v", err)
+		c.Errors = append(c.Errors, err)
+		return
+	}
+
+	for _, item := range items {
+		c.enqueue(item)
+	}
+}
+
+func (c *Controller[T]) reconcile(ctx context.Context, clock *Clock, item T) {
+	logger := log.GetLoggerFromContextOrDiscard(ctx).WithFields(item.ToFields())
+
+	reconcileCtx, cancel := clock.WithTimeout(ctx, c.ReconcileTimeout)
+	err := c.ReconcileFunction(reconcileCtx, item)
+	cancel()
+
+	var requeueAfterError common.RequeueAfterError
+	switch {
+	case errors.As(err, &requeueAfterError):
+		logger.Debugf("Requeue item: %v", err)
+		c.waiting[item.Hash()] = waitingItem[T]{
+			item: item,
+			at:   clock.Now().Add(requeueAfterError.RequeueAfter()),
+		}
+	case err != nil:
+		logger.Errorf("Failed to reconcile item: %v", err)
+		c.Errors = append(c.Errors, err)
+	}
+}
+
+func (c *Controller[T]) enqueue(item T) {
+	key := item.Hash()
+	if _, ok := c.inqueue[key]; ok {
+		return
+	}
+	if _, ok := c.waiting[key]; ok {
+		return
+	}
+
+	c.queue = append(c.queue, item)
+	c.inqueue[key] = struct{}{}
+}
+
+// enqueueWaiting moves the requeued items which are due at now to the
+// queue, ordered by their due time.
+func (c *Controller[T]) enqueueWaiting(now time.Time) {
+	var due []waitingItem[T]
+	for key, w := range c.waiting {
+		if !w.at.After(now) {
+			due = append(due, w)
+			delete(c.waiting, key)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		if !due[i].at.Equal(due[j].at) {
+			return due[i].at.Before(due[j].at)
+		}
+		return due[i].item.Hash() < due[j].item.Hash()
+	})
+
+	for _, w := range due {
+		c.enqueue(w.item)
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/scanconfigwatcher"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/scanresultwatcher"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/scanwatcher"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider/fake"
+)
+
+const (
+	DefaultPollPeriod       = time.Minute
+	DefaultReconcileTimeout = 5 * time.Minute
+	DefaultScanTimeout      = 48 * time.Hour
+)
+
+// Config of the Orchestrator, the defaults are used for the zero values.
+type Config struct {
+	// Start is the initial time of the virtual clock.
+	Start            time.Time
+	PollPeriod       time.Duration
+	ReconcileTimeout time.Duration
+	ScanTimeout      time.Duration
+	// RetryPolicies are applied to the fake provider if set. Their Jitter
+	// must be zero for the simulation to be deterministic.
+	RetryPolicies   map[provider.OperationClass]provider.RetryPolicy
+	DeleteJobPolicy scanresultwatcher.DeleteJobPolicyType
+	// MaxConcurrentScanners is the maximum number of Scanners across all the
+	// Scans, unlimited if it is 0.
+	MaxConcurrentScanners int
+	// SnapshotPrewarmConcurrency makes the fake provider create the target
+	// volume snapshots of the ScanResults waiting for a Scanner, at most
+	// this many at the same time. Snapshots are not pre-warmed if it is 0.
+	SnapshotPrewarmConcurrency int
+}
+
+// Orchestrator is a Simulation of the ScanConfig, Scan and ScanResult
+// watchers of the orchestrator, provisioning the scans of the instances of
+// the fake provider which are scanned by the simulated Scanner.
+type Orchestrator struct {
+	*Simulation
+
+	Backend  *Backend
+	Provider *fake.Client
+
+	ScanConfigWatcher *Controller[scanconfigwatcher.ScanConfigReconcileEvent]
+	ScanWatcher       *Controller[scanwatcher.ScanReconcileEvent]
+	ScanResultWatcher *Controller[scanresultwatcher.ScanResultReconcileEvent]
+	Scanner           *Controller[ScannerEvent]
+}
+
+// NewOrchestrator returns an Orchestrator with its backend database in dir,
+// which must be closed with Close.
+func NewOrchestrator(dir string, config Config) (*Orchestrator, error) {
+	if config.Start.IsZero() {
+		config.Start = time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	}
+	if config.PollPeriod == 0 {
+		config.PollPeriod = DefaultPollPeriod
+	}
+	if config.ReconcileTimeout == 0 {
+		config.ReconcileTimeout = DefaultReconcileTimeout
+	}
+	if config.ScanTimeout == 0 {
+		config.ScanTimeout = DefaultScanTimeout
+	}
+
+	backend, err := NewBackend(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start backend: %w", err)
+	}
+
+	sim := New(config.Start)
+	fakeProvider := fake.New(models.AWS, sim.Clock)
+
+	var p provider.Provider = fakeProvider
+	if config.SnapshotPrewarmConcurrency > 0 {
+		p = &fake.PrewarmingClient{Client: fakeProvider}
+	}
+	if config.RetryPolicies != nil {
+		p = provider.WithRetry(p, config.RetryPolicies)
+	}
+
+	scanConfigWatcher := scanconfigwatcher.New(scanconfigwatcher.Config{
+		Backend:          backend.Client,
+		PollPeriod:       config.PollPeriod,
+		ReconcileTimeout: config.ReconcileTimeout,
+		Clock:            sim.Clock,
+	})
+	scanWatcher := scanwatcher.New(scanwatcher.Config{
+		Backend:          backend.Client,
+		Provider:         p,
+		PollPeriod:       config.PollPeriod,
+		ReconcileTimeout: config.ReconcileTimeout,
+		ScanTimeout:      config.ScanTimeout,
+		Clock:            sim.Clock,
+	})
+	scanResultWatcher := scanresultwatcher.New(scanresultwatcher.Config{
+		Backend:          backend.Client,
+		Provider:         p,
+		PollPeriod:       config.PollPeriod,
+		ReconcileTimeout: config.ReconcileTimeout,
+		ScannerConfig: scanresultwatcher.ScannerConfig{
+			DeleteJobPolicy: config.DeleteJobPolicy,
+			ScannerImage:    "vmclarity-cli:simulation",
+		},
+		MaxConcurrentScanners:      config.MaxConcurrentScanners,
+		SnapshotPrewarmConcurrency: config.SnapshotPrewarmConcurrency,
+		Clock:                      sim.Clock,
+	})
+
+	o := &Orchestrator{
+		Simulation: sim,
+		Backend:    backend,
+		Provider:   fakeProvider,
+		ScanConfigWatcher: &Controller[scanconfigwatcher.ScanConfigReconcileEvent]{
+			Name:              "ScanConfigWatcher",
+			PollPeriod:        config.PollPeriod,
+			ReconcileTimeout:  config.ReconcileTimeout,
+			GetItems:          scanConfigWatcher.GetScanConfigs,
+			ReconcileFunction: scanConfigWatcher.Reconcile,
+		},
+		ScanWatcher: &Controller[scanwatcher.ScanReconcileEvent]{
+			Name:              "ScanWatcher",
+			PollPeriod:        config.PollPeriod,
+			ReconcileTimeout:  config.ReconcileTimeout,
+			GetItems:          scanWatcher.GetRunningScans,
+			ReconcileFunction: scanWatcher.Reconcile,
+		},
+		ScanResultWatcher: &Controller[scanresultwatcher.ScanResultReconcileEvent]{
+			Name:              "ScanResultWatcher",
+			PollPeriod:        config.PollPeriod,
+			ReconcileTimeout:  config.ReconcileTimeout,
+			GetItems:          scanResultWatcher.GetScanResults,
+			ReconcileFunction: scanResultWatcher.Reconcile,
+		},
+		Scanner: NewScanner(backend.Client, fakeProvider, sim.Clock).Controller(config.PollPeriod),
+	}
+	sim.Add(o.ScanConfigWatcher)
+	sim.Add(o.ScanWatcher)
+	sim.Add(o.ScanResultWatcher)
+	sim.Add(o.Scanner)
+
+	return o, nil
+}
+
+func (o *Orchestrator) Close() {
+	o.Backend.Close()
+}
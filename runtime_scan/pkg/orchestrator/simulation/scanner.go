@@ -0,0 +1,146 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/common"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider/fake"
+	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+type ScannerEvent struct {
+	ScanResultID models.ScanResultID
+}
+
+func (e ScannerEvent) ToFields() log.Fields {
+	return log.Fields{
+		"ScanResultID": e.ScanResultID,
+	}
+}
+
+func (e ScannerEvent) String() string {
+	return fmt.Sprintf("ScanResultID=%s", e.ScanResultID)
+}
+
+func (e ScannerEvent) Hash() string {
+	return e.ScanResultID
+}
+
+// Scanner simulates the scanner CLI running on the scanner resources created
+// by the fake provider. Once the resources of a ScanResult are created, it
+// moves the ScanResult to InProgress, and to Done after the ScanDuration of
+// the script of the instance with the ScanErrors of the script.
+type Scanner struct {
+	backend  *backendclient.BackendClient
+	provider *fake.Client
+	clock    *Clock
+}
+
+func NewScanner(backend *backendclient.BackendClient, provider *fake.Client, clock *Clock) *Scanner {
+	return &Scanner{
+		backend:  backend,
+		provider: provider,
+		clock:    clock,
+	}
+}
+
+// Controller returns the Controller which runs the Scanner in a Simulation.
+func (s *Scanner) Controller(pollPeriod time.Duration) *Controller[ScannerEvent] {
+	return &Controller[ScannerEvent]{
+		Name:              "Scanner",
+		PollPeriod:        pollPeriod,
+		ReconcileTimeout:  pollPeriod,
+		GetItems:          s.GetScanResults,
+		ReconcileFunction: s.Reconcile,
+	}
+}
+
+func (s *Scanner) GetScanResults(ctx context.Context) ([]ScannerEvent, error) {
+	filter := fmt.Sprintf("status/general/state eq '%s' or status/general/state eq '%s'",
+		models.AssetScanStateStateReadyToScan, models.AssetScanStateStateInProgress)
+	scanResults, err := s.backend.GetScanResults(ctx, models.GetScanResultsParams{
+		Filter: &filter,
+		Select: utils.PointerTo("id"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ScanResults: %w", err)
+	}
+
+	var events []ScannerEvent
+	for _, scanResult := range utils.ValueOrZero(scanResults.Items) {
+		if scanResultID, ok := scanResult.GetID(); ok {
+			events = append(events, ScannerEvent{ScanResultID: scanResultID})
+		}
+	}
+	return events, nil
+}
+
+func (s *Scanner) Reconcile(ctx context.Context, event ScannerEvent) error {
+	// The scanner only runs while its resources exist.
+	scan, ok := s.provider.Scan(event.ScanResultID)
+	if !ok || scan.ProvisionedAt == nil || scan.RemovedAt != nil {
+		return nil
+	}
+	script, ok := s.provider.Script(scan.InstanceID)
+	if !ok {
+		return fmt.Errorf("no script for instance %s", scan.InstanceID)
+	}
+
+	scanResult, err := s.backend.GetScanResult(ctx, event.ScanResultID, models.GetScanResultsScanResultIDParams{
+		Select: utils.PointerTo("id,status"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get ScanResult. ScanResultID=%s: %w", event.ScanResultID, err)
+	}
+	state, ok := scanResult.GetGeneralState()
+	if !ok {
+		return errors.New("invalid ScanResult: general state is nil")
+	}
+
+	now := s.clock.Now()
+	if state == models.AssetScanStateStateReadyToScan {
+		scanResult.Status.General.State = utils.PointerTo(models.AssetScanStateStateInProgress)
+		scanResult.Status.General.LastTransitionTime = utils.PointerTo(now)
+		if err := s.backend.PatchScanResult(ctx, models.AssetScanResult{Status: scanResult.Status}, event.ScanResultID); err != nil {
+			return fmt.Errorf("failed to patch ScanResult. ScanResultID=%s: %w", event.ScanResultID, err)
+		}
+	}
+
+	if remaining := scan.ProvisionedAt.Add(script.ScanDuration).Sub(now); remaining > 0 {
+		// nolint:wrapcheck
+		return common.NewRequeueAfterError(remaining, "scan is in progress")
+	}
+
+	scanResult.Status.General.State = utils.PointerTo(models.AssetScanStateStateDone)
+	scanResult.Status.General.LastTransitionTime = utils.PointerTo(now)
+	if len(script.ScanErrors) > 0 {
+		scanResult.Status.General.Errors = utils.PointerTo(script.ScanErrors)
+	}
+	if err := s.backend.PatchScanResult(ctx, models.AssetScanResult{Status: scanResult.Status}, event.ScanResultID); err != nil {
+		return fmt.Errorf("failed to patch ScanResult. ScanResultID=%s: %w", event.ScanResultID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package simulation runs the controllers of the orchestrator
+// deterministically on a virtual clock, for testing their scheduling, retry
+// and timeout logic against the fake provider without any cloud
+// credentials. The controllers are run on a single goroutine: the clock
+// jumps to the time of the next poll or requeue once all the due items are
+// reconciled, so hours of orchestration are simulated in moments.
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Reconciler is a controller run by the Simulation, which is implemented by
+// Controller.
+type Reconciler interface {
+	nextEventTime() time.Time
+	step(ctx context.Context, clock *Clock) bool
+}
+
+type Simulation struct {
+	Clock *Clock
+
+	reconcilers []Reconciler
+}
+
+func New(start time.Time) *Simulation {
+	return &Simulation{
+		Clock: NewClock(start),
+	}
+}
+
+// Add adds a controller to the simulation. The controllers reconcile their
+// due items in the order they were added.
+func (s *Simulation) Add(r Reconciler) {
+	s.reconcilers = append(s.reconcilers, r)
+}
+
+// Run runs the controllers until the clock advanced by d.
+func (s *Simulation) Run(ctx context.Context, d time.Duration) error {
+	_, err := s.run(ctx, s.Clock.Now().Add(d), func() bool { return false })
+	return err
+}
+
+// RunUntil runs the controllers until done returns true, which is checked
+// whenever the controllers processed their due items. It returns an error
+// if done doesn't return true before the clock advanced by limit.
+func (s *Simulation) RunUntil(ctx context.Context, limit time.Duration, done func() bool) error {
+	finished, err := s.run(ctx, s.Clock.Now().Add(limit), done)
+	if err != nil {
+		return err
+	}
+	if !finished {
+		return fmt.Errorf("simulation did not finish in %s", limit)
+	}
+	return nil
+}
+
+func (s *Simulation) run(ctx context.Context, end time.Time, done func() bool) (bool, error) {
+	for {
+		if err := ctx.Err(); err != nil {
+			return false, fmt.Errorf("simulation was canceled: %w", err)
+		}
+
+		reconciled := false
+		for _, r := range s.reconcilers {
+			if r.step(ctx, s.Clock) {
+				reconciled = true
+			}
+		}
+		// The clock is advanced by the reconciles which time out, so the
+		// end is checked even if there are more due items.
+		if !reconciled || !s.Clock.Now().Before(end) {
+			if done() {
+				return true, nil
+			}
+			if !s.Clock.Now().Before(end) {
+				return false, nil
+			}
+		}
+		if reconciled {
+			continue
+		}
+
+		next := end
+		for _, r := range s.reconcilers {
+			if t := r.nextEventTime(); t.Before(next) {
+				next = t
+			}
+		}
+		s.Clock.AdvanceTo(next)
+	}
+}
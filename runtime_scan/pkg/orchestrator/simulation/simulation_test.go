@@ -0,0 +1,413 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider/fake"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func newTestOrchestrator(t *testing.T, config Config) *Orchestrator {
+	t.Helper()
+
+	o, err := NewOrchestrator(t.TempDir(), config)
+	if err != nil {
+		t.Fatalf("NewOrchestrator() error = %v", err)
+	}
+	t.Cleanup(o.Close)
+
+	return o
+}
+
+// createScanConfig creates a ScanConfig which runs a scan of all the
+// instances of the fake provider at operationTime.
+func createScanConfig(t *testing.T, o *Orchestrator, operationTime time.Time, maxParallelScanners int) {
+	t.Helper()
+
+	createPriorityScanConfig(t, o, "simulation", operationTime, maxParallelScanners, 0)
+}
+
+// createPriorityScanConfig creates a ScanConfig of priority which runs a scan
+// of all the instances of the fake provider at operationTime, and returns its
+// ID.
+func createPriorityScanConfig(t *testing.T, o *Orchestrator, name string, operationTime time.Time, maxParallelScanners, priority int) string {
+	t.Helper()
+
+	scope := models.ScanScopeType{}
+	err := scope.FromAwsScanScope(models.AwsScanScope{
+		AllRegions: utils.PointerTo(true),
+		ObjectType: "AwsScanScope",
+	})
+	if err != nil {
+		t.Fatalf("FromAwsScanScope() error = %v", err)
+	}
+
+	scanConfig, err := o.Backend.Database.ScanConfigsTable().CreateScanConfig(models.ScanConfig{
+		Name: utils.PointerTo(name),
+		ScanFamiliesConfig: &models.ScanFamiliesConfig{
+			Sbom: &models.SBOMConfig{Enabled: utils.PointerTo(true)},
+		},
+		Scheduled: &models.RuntimeScheduleScanConfig{
+			OperationTime: &operationTime,
+		},
+		Scope:               &scope,
+		MaxParallelScanners: utils.PointerTo(maxParallelScanners),
+		Priority:            utils.PointerTo(priority),
+	})
+	if err != nil {
+		t.Fatalf("CreateScanConfig() error = %v", err)
+	}
+	return utils.ValueOrZero(scanConfig.Id)
+}
+
+// finishedScan returns the Scan once it is finished and the resources of its
+// ScanResults are cleaned up.
+func finishedScan(t *testing.T, o *Orchestrator) (models.Scan, bool) {
+	t.Helper()
+
+	scans, err := o.Backend.Database.ScansTable().GetScans(models.GetScansParams{})
+	if err != nil {
+		t.Fatalf("GetScans() error = %v", err)
+	}
+	if len(*scans.Items) != 1 {
+		return models.Scan{}, false
+	}
+	scan := (*scans.Items)[0]
+	if state, _ := scan.GetState(); state != models.ScanStateDone && state != models.ScanStateFailed {
+		return models.Scan{}, false
+	}
+
+	pending, err := o.Backend.Database.ScanResultsTable().GetScanResults(models.GetScanResultsParams{
+		Filter: utils.PointerTo("resourceCleanup eq 'Pending'"),
+		Count:  utils.PointerTo(true),
+		Top:    utils.PointerTo(0),
+	})
+	if err != nil {
+		t.Fatalf("GetScanResults() error = %v", err)
+	}
+	return scan, *pending.Count == 0
+}
+
+func runUntilScanFinished(t *testing.T, o *Orchestrator, limit time.Duration) models.Scan {
+	t.Helper()
+
+	var scan models.Scan
+	err := o.RunUntil(context.Background(), limit, func() bool {
+		var ok bool
+		scan, ok = finishedScan(t, o)
+		return ok
+	})
+	if err != nil {
+		t.Fatalf("RunUntil() error = %v", err)
+	}
+	return scan
+}
+
+func TestOrchestrator_ScheduledScan(t *testing.T) {
+	o := newTestOrchestrator(t, Config{})
+	start := o.Clock.Now()
+
+	for _, instanceID := range []string{"i-1", "i-2", "i-3"} {
+		o.Provider.AddInstance(instanceID, fake.Script{
+			ProvisioningDelay:   2 * time.Minute,
+			DeprovisioningDelay: time.Minute,
+			ScanDuration:        10 * time.Minute,
+		})
+	}
+	createScanConfig(t, o, start.Add(10*time.Minute), 2)
+
+	scan := runUntilScanFinished(t, o, 6*time.Hour)
+
+	if state, _ := scan.GetState(); state != models.ScanStateDone {
+		t.Fatalf("scan state = %s, want %s: %s", state, models.ScanStateDone, utils.ValueOrZero(scan.StateMessage))
+	}
+	// The scan is started by the first poll whose schedule window, which
+	// spans a poll period around it, includes the operation time.
+	if want := start.Add(9 * time.Minute); scan.StartTime == nil || !scan.StartTime.Equal(want) {
+		t.Errorf("scan start time = %v, want %v", scan.StartTime, want)
+	}
+	if got := o.Provider.MaxRunning(); got != 2 {
+		t.Errorf("max running scanners = %d, want 2", got)
+	}
+
+	scans := o.Provider.Scans()
+	if len(scans) != 3 {
+		t.Fatalf("provisioned scans = %d, want 3", len(scans))
+	}
+	for _, s := range scans {
+		if s.ProvisionedAt == nil || s.RemovedAt == nil {
+			t.Errorf("scan of %s was not provisioned and removed: %+v", s.InstanceID, s)
+		}
+		if s.RunCalls < 2 {
+			t.Errorf("scan of %s RunTargetScan calls = %d, want retries while provisioning", s.InstanceID, s.RunCalls)
+		}
+	}
+	if n := len(o.ScanWatcher.Errors) + len(o.ScanResultWatcher.Errors) + len(o.Scanner.Errors); n != 0 {
+		t.Errorf("reconcile errors = %d, want none: %v %v %v", n,
+			o.ScanWatcher.Errors, o.ScanResultWatcher.Errors, o.Scanner.Errors)
+	}
+}
+
+func TestOrchestrator_RetriesExhausted(t *testing.T) {
+	o := newTestOrchestrator(t, Config{
+		RetryPolicies: map[provider.OperationClass]provider.RetryPolicy{
+			provider.OperationRunTargetScan: {
+				InitialInterval: 30 * time.Second,
+				MaxInterval:     5 * time.Minute,
+				Multiplier:      2,
+				MaxAttempts:     2,
+			},
+		},
+	})
+
+	throttled := provider.RetryableErrorf(time.Minute, "request was throttled")
+	o.Provider.AddInstance("i-throttled", fake.Script{
+		RunErrors:    []error{throttled, throttled, throttled},
+		ScanDuration: 10 * time.Minute,
+	})
+	o.Provider.AddInstance("i-ok", fake.Script{
+		RunErrors:    []error{throttled},
+		ScanDuration: 10 * time.Minute,
+	})
+	createScanConfig(t, o, o.Clock.Now(), 2)
+
+	scan := runUntilScanFinished(t, o, 6*time.Hour)
+
+	if state, _ := scan.GetState(); state != models.ScanStateFailed {
+		t.Fatalf("scan state = %s, want %s: %s", state, models.ScanStateFailed, utils.ValueOrZero(scan.StateMessage))
+	}
+	if reason := utils.ValueOrZero(scan.StateReason); reason != models.ScanStateReasonOneOrMoreTargetFailedToScan {
+		t.Errorf("scan state reason = %s, want %s", reason, models.ScanStateReasonOneOrMoreTargetFailedToScan)
+	}
+
+	for _, s := range o.Provider.Scans() {
+		switch s.InstanceID {
+		case "i-throttled":
+			if s.RunCalls != 3 || s.ProvisionedAt != nil {
+				t.Errorf("throttled scan = %+v, want 3 calls without provisioning", s)
+			}
+			assertScanResultErrors(t, o, s.ScanResultID, "failed after 2 attempts")
+		case "i-ok":
+			if s.RunCalls != 2 || s.ProvisionedAt == nil {
+				t.Errorf("retried scan = %+v, want provisioning on the second call", s)
+			}
+			assertScanResultErrors(t, o, s.ScanResultID, "")
+		}
+	}
+}
+
+func TestOrchestrator_ProvisioningTimeout(t *testing.T) {
+	o := newTestOrchestrator(t, Config{
+		ReconcileTimeout: 5 * time.Minute,
+		ScanTimeout:      time.Hour,
+	})
+
+	o.Provider.AddInstance("i-hanging", fake.Script{
+		RunLatency:   10 * time.Minute,
+		ScanDuration: 10 * time.Minute,
+	})
+	createScanConfig(t, o, o.Clock.Now(), 1)
+
+	scan := runUntilScanFinished(t, o, 6*time.Hour)
+
+	if state, _ := scan.GetState(); state != models.ScanStateFailed {
+		t.Fatalf("scan state = %s, want %s: %s", state, models.ScanStateFailed, utils.ValueOrZero(scan.StateMessage))
+	}
+	if elapsed := scan.EndTime.Sub(*scan.StartTime); elapsed < time.Hour || elapsed > 2*time.Hour {
+		t.Errorf("scan ended after %s, want after the scan timeout", elapsed)
+	}
+
+	var timeouts int
+	for _, err := range o.ScanResultWatcher.Errors {
+		if errors.Is(err, context.DeadlineExceeded) {
+			timeouts++
+		}
+	}
+	if timeouts == 0 {
+		t.Errorf("ScanResultWatcher errors = %v, want reconcile timeouts", o.ScanResultWatcher.Errors)
+	}
+	for _, s := range o.Provider.Scans() {
+		if s.ProvisionedAt != nil {
+			t.Errorf("scan of %s was provisioned, want none", s.InstanceID)
+		}
+	}
+}
+
+func TestOrchestrator_ScanPriority(t *testing.T) {
+	o := newTestOrchestrator(t, Config{MaxConcurrentScanners: 1})
+	start := o.Clock.Now()
+
+	for _, instanceID := range []string{"i-1", "i-2", "i-3"} {
+		o.Provider.AddInstance(instanceID, fake.Script{
+			ProvisioningDelay:   2 * time.Minute,
+			DeprovisioningDelay: time.Minute,
+			ScanDuration:        10 * time.Minute,
+		})
+	}
+	// The high priority scan starts while the low priority one still has
+	// pending targets, which are scanned only after the high priority ones.
+	lowID := createPriorityScanConfig(t, o, "low", start, 3, 0)
+	highID := createPriorityScanConfig(t, o, "high", start.Add(20*time.Minute), 3, 10)
+
+	err := o.RunUntil(context.Background(), 12*time.Hour, func() bool {
+		scans, err := o.Backend.Database.ScansTable().GetScans(models.GetScansParams{
+			Filter: utils.PointerTo(fmt.Sprintf("state eq '%s'", models.ScanStateDone)),
+		})
+		if err != nil {
+			t.Fatalf("GetScans() error = %v", err)
+		}
+		if len(*scans.Items) != 2 {
+			return false
+		}
+		pending, err := o.Backend.Database.ScanResultsTable().GetScanResults(models.GetScanResultsParams{
+			Filter: utils.PointerTo("resourceCleanup eq 'Pending'"),
+			Count:  utils.PointerTo(true),
+			Top:    utils.PointerTo(0),
+		})
+		if err != nil {
+			t.Fatalf("GetScanResults() error = %v", err)
+		}
+		return *pending.Count == 0
+	})
+	if err != nil {
+		t.Fatalf("RunUntil() error = %v", err)
+	}
+
+	if got := o.Provider.MaxRunning(); got != 1 {
+		t.Errorf("max running scanners = %d, want 1", got)
+	}
+
+	scans, err := o.Backend.Database.ScansTable().GetScans(models.GetScansParams{})
+	if err != nil {
+		t.Fatalf("GetScans() error = %v", err)
+	}
+	scanConfigOfScan := map[string]string{}
+	for _, scan := range *scans.Items {
+		if scan.ScanConfig != nil {
+			scanConfigOfScan[utils.ValueOrZero(scan.Id)] = scan.ScanConfig.Id
+		}
+	}
+
+	// The scanners of the high priority scan are provisioned before the last
+	// one of the low priority scan.
+	var lastLow, lastHigh time.Time
+	for _, s := range o.Provider.Scans() {
+		if s.ProvisionedAt == nil {
+			t.Fatalf("scan of %s was not provisioned: %+v", s.InstanceID, s)
+		}
+		scanResult, err := o.Backend.Database.ScanResultsTable().GetScanResult(s.ScanResultID, models.GetScanResultsScanResultIDParams{})
+		if err != nil {
+			t.Fatalf("GetScanResult() error = %v", err)
+		}
+		if scanResult.Scan == nil {
+			t.Fatalf("ScanResult %s has no Scan", s.ScanResultID)
+		}
+		switch scanConfigOfScan[scanResult.Scan.Id] {
+		case lowID:
+			if s.ProvisionedAt.After(lastLow) {
+				lastLow = *s.ProvisionedAt
+			}
+		case highID:
+			if s.ProvisionedAt.After(lastHigh) {
+				lastHigh = *s.ProvisionedAt
+			}
+		default:
+			t.Errorf("scan of %s belongs to an unknown ScanConfig", s.InstanceID)
+		}
+	}
+	if !lastHigh.Before(lastLow) {
+		t.Errorf("last high priority scanner provisioned at %v, want before the last low priority one at %v", lastHigh, lastLow)
+	}
+}
+
+func TestOrchestrator_SnapshotPrewarm(t *testing.T) {
+	// The provider is decorated with the retries as in the orchestrator,
+	// which must not hide that it creates the snapshots ahead.
+	o := newTestOrchestrator(t, Config{
+		RetryPolicies: map[provider.OperationClass]provider.RetryPolicy{
+			provider.OperationRunTargetScan: {
+				InitialInterval: 30 * time.Second,
+				MaxInterval:     5 * time.Minute,
+				Multiplier:      2,
+				MaxAttempts:     10,
+			},
+		},
+		MaxConcurrentScanners:      1,
+		SnapshotPrewarmConcurrency: 2,
+	})
+
+	for _, instanceID := range []string{"i-1", "i-2", "i-3"} {
+		o.Provider.AddInstance(instanceID, fake.Script{
+			ProvisioningDelay:   2 * time.Minute,
+			DeprovisioningDelay: time.Minute,
+			ScanDuration:        10 * time.Minute,
+		})
+	}
+	createScanConfig(t, o, o.Clock.Now(), 3)
+
+	scan := runUntilScanFinished(t, o, 12*time.Hour)
+
+	if state, _ := scan.GetState(); state != models.ScanStateDone {
+		t.Fatalf("scan state = %s, want %s: %s", state, models.ScanStateDone, utils.ValueOrZero(scan.StateMessage))
+	}
+
+	// Only one Scanner runs at a time, the snapshots of the other targets
+	// are created while they wait for it.
+	var prewarmed int
+	for _, s := range o.Provider.Scans() {
+		if s.ProvisionedAt == nil {
+			t.Fatalf("scan of %s was not provisioned: %+v", s.InstanceID, s)
+		}
+		if s.PrewarmedAt == nil {
+			continue
+		}
+		prewarmed++
+		if !s.PrewarmedAt.Before(*s.ProvisionedAt) {
+			t.Errorf("scan of %s pre-warmed at %v, want before its provisioning at %v", s.InstanceID, s.PrewarmedAt, s.ProvisionedAt)
+		}
+	}
+	if prewarmed < 2 {
+		t.Errorf("pre-warmed snapshots = %d, want the 2 targets waiting for the Scanner", prewarmed)
+	}
+}
+
+func assertScanResultErrors(t *testing.T, o *Orchestrator, scanResultID, want string) {
+	t.Helper()
+
+	scanResult, err := o.Backend.Database.ScanResultsTable().GetScanResult(scanResultID, models.GetScanResultsScanResultIDParams{})
+	if err != nil {
+		t.Fatalf("GetScanResult() error = %v", err)
+	}
+	errs := scanResult.GetGeneralErrors()
+	if want == "" {
+		if len(errs) != 0 {
+			t.Errorf("ScanResult errors = %v, want none", errs)
+		}
+		return
+	}
+	if len(errs) != 1 || !strings.Contains(errs[0], want) {
+		t.Errorf("ScanResult errors = %v, want %q", errs, want)
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package targetsummarywatcher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// findingCounts holds the number of active findings of a Target per finding
+// type, and per severity for vulnerabilities.
+type findingCounts struct {
+	Packages          int
+	Exploits          int
+	Malware           int
+	Misconfigurations int
+	Rootkits          int
+	Secrets           int
+	Certificates      int
+	ComplianceChecks  int
+	PluginFindings    int
+	Vulnerabilities   map[models.VulnerabilitySeverity]int
+}
+
+func (w *Watcher) getFindingCounts(ctx context.Context, targetID string) (findingCounts, error) {
+	counts := findingCounts{
+		Vulnerabilities: make(map[models.VulnerabilitySeverity]int),
+	}
+
+	byType := []struct {
+		findingType string
+		count       *int
+	}{
+		{"Package", &counts.Packages},
+		{"Exploit", &counts.Exploits},
+		{"Malware", &counts.Malware},
+		{"Misconfiguration", &counts.Misconfigurations},
+		{"Rootkit", &counts.Rootkits},
+		{"Secret", &counts.Secrets},
+		{"Certificate", &counts.Certificates},
+		{"ComplianceCheck", &counts.ComplianceChecks},
+		{"PluginFinding", &counts.PluginFindings},
+	}
+	for _, t := range byType {
+		count, err := w.countActiveFindings(ctx, fmt.Sprintf(
+			"findingInfo/objectType eq '%s' and asset/id eq '%s' and invalidatedOn eq null and suppression eq null", t.findingType, targetID))
+		if err != nil {
+			return counts, err
+		}
+		*t.count = count
+	}
+
+	for _, severity := range []models.VulnerabilitySeverity{models.CRITICAL, models.HIGH, models.MEDIUM, models.LOW, models.NEGLIGIBLE} {
+		count, err := w.countActiveFindings(ctx, fmt.Sprintf(
+			"findingInfo/objectType eq 'Vulnerability' and asset/id eq '%s' and invalidatedOn eq null and suppression eq null and findingInfo/severity eq '%s'",
+			targetID, severity))
+		if err != nil {
+			return counts, err
+		}
+		counts.Vulnerabilities[severity] = count
+	}
+
+	return counts, nil
+}
+
+func (w *Watcher) countActiveFindings(ctx context.Context, filter string) (int, error) {
+	findings, err := w.backend.GetFindings(ctx, models.GetFindingsParams{
+		Count:  utils.PointerTo(true),
+		Filter: &filter,
+
+		// select the smallest amount of data to return in items, we
+		// only care about the count.
+		Top:    utils.PointerTo(1),
+		Select: utils.PointerTo("id"),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count active findings: %w", err)
+	}
+	return utils.ValueOrZero(findings.Count), nil
+}
+
+// updateSummary returns the summary with the counts applied and whether it
+// differs from the current summary. A total which was never set, because its
+// family wasn't scanned, is only set if there are active findings for it.
+func updateSummary(current *models.ScanFindingsSummary, counts findingCounts) (*models.ScanFindingsSummary, bool) {
+	summary := models.ScanFindingsSummary{}
+	if current != nil {
+		summary = *current
+	}
+
+	changed := false
+	for _, u := range []struct {
+		total **int
+		count int
+	}{
+		{&summary.TotalPackages, counts.Packages},
+		{&summary.TotalExploits, counts.Exploits},
+		{&summary.TotalMalware, counts.Malware},
+		{&summary.TotalMisconfigurations, counts.Misconfigurations},
+		{&summary.TotalRootkits, counts.Rootkits},
+		{&summary.TotalSecrets, counts.Secrets},
+		{&summary.TotalCertificates, counts.Certificates},
+		{&summary.TotalComplianceChecks, counts.ComplianceChecks},
+		{&summary.TotalPluginFindings, counts.PluginFindings},
+	} {
+		changed = updateTotal(u.total, u.count) || changed
+	}
+
+	vulnerabilities := models.VulnerabilityScanSummary{}
+	if summary.TotalVulnerabilities != nil {
+		vulnerabilities = *summary.TotalVulnerabilities
+	}
+	vulnerabilitiesChanged := false
+	for _, u := range []struct {
+		total    **int
+		severity models.VulnerabilitySeverity
+	}{
+		{&vulnerabilities.TotalCriticalVulnerabilities, models.CRITICAL},
+		{&vulnerabilities.TotalHighVulnerabilities, models.HIGH},
+		{&vulnerabilities.TotalMediumVulnerabilities, models.MEDIUM},
+		{&vulnerabilities.TotalLowVulnerabilities, models.LOW},
+		{&vulnerabilities.TotalNegligibleVulnerabilities, models.NEGLIGIBLE},
+	} {
+		vulnerabilitiesChanged = updateTotal(u.total, counts.Vulnerabilities[u.severity]) || vulnerabilitiesChanged
+	}
+	if vulnerabilitiesChanged {
+		summary.TotalVulnerabilities = &vulnerabilities
+		changed = true
+	}
+
+	return &summary, changed
+}
+
+// updateTotal sets total to count and returns true if it changed. A nil total
+// is left unset if count is zero.
+func updateTotal(total **int, count int) bool {
+	if *total == nil && count == 0 {
+		return false
+	}
+	if *total != nil && **total == count {
+		return false
+	}
+	*total = utils.PointerTo(count)
+	return true
+}
@@ -0,0 +1,114 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package targetsummarywatcher
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func Test_updateSummary(t *testing.T) {
+	tests := []struct {
+		name        string
+		current     *models.ScanFindingsSummary
+		counts      findingCounts
+		want        *models.ScanFindingsSummary
+		wantChanged bool
+	}{
+		{
+			name:        "nil summary and no findings",
+			current:     nil,
+			counts:      findingCounts{},
+			want:        &models.ScanFindingsSummary{},
+			wantChanged: false,
+		},
+		{
+			name: "summary up to date",
+			current: &models.ScanFindingsSummary{
+				TotalSecrets: utils.PointerTo(2),
+				TotalMalware: utils.PointerTo(0),
+			},
+			counts: findingCounts{
+				Secrets: 2,
+			},
+			want: &models.ScanFindingsSummary{
+				TotalSecrets: utils.PointerTo(2),
+				TotalMalware: utils.PointerTo(0),
+			},
+			wantChanged: false,
+		},
+		{
+			name: "invalidated findings are removed from the summary",
+			current: &models.ScanFindingsSummary{
+				TotalSecrets: utils.PointerTo(5),
+				TotalVulnerabilities: &models.VulnerabilityScanSummary{
+					TotalCriticalVulnerabilities: utils.PointerTo(3),
+					TotalHighVulnerabilities:     utils.PointerTo(1),
+				},
+			},
+			counts: findingCounts{
+				Secrets: 1,
+				Vulnerabilities: map[models.VulnerabilitySeverity]int{
+					models.CRITICAL: 1,
+					models.HIGH:     1,
+				},
+			},
+			want: &models.ScanFindingsSummary{
+				TotalSecrets: utils.PointerTo(1),
+				TotalVulnerabilities: &models.VulnerabilityScanSummary{
+					TotalCriticalVulnerabilities: utils.PointerTo(1),
+					TotalHighVulnerabilities:     utils.PointerTo(1),
+				},
+			},
+			wantChanged: true,
+		},
+		{
+			name: "findings of families missing from the summary are added",
+			current: &models.ScanFindingsSummary{
+				TotalSecrets: utils.PointerTo(0),
+			},
+			counts: findingCounts{
+				Rootkits: 2,
+				Vulnerabilities: map[models.VulnerabilitySeverity]int{
+					models.LOW: 4,
+				},
+			},
+			want: &models.ScanFindingsSummary{
+				TotalSecrets:  utils.PointerTo(0),
+				TotalRootkits: utils.PointerTo(2),
+				TotalVulnerabilities: &models.VulnerabilityScanSummary{
+					TotalLowVulnerabilities: utils.PointerTo(4),
+				},
+			},
+			wantChanged: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotChanged := updateSummary(tt.current, tt.counts)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("updateSummary() mismatch (-want +got):\n%s", diff)
+			}
+			if gotChanged != tt.wantChanged {
+				t.Errorf("updateSummary() changed = %v, want %v", gotChanged, tt.wantChanged)
+			}
+		})
+	}
+}
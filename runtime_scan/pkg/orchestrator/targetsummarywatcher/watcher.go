@@ -0,0 +1,137 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package targetsummarywatcher
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/common"
+	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// Watcher periodically recomputes the ScanFindingsSummary of each Target from
+// its active findings, so the summary doesn't drift when findings are changed
+// outside of the processing of a ScanResult.
+type Watcher struct {
+	backend          *backendclient.BackendClient
+	pollPeriod       time.Duration
+	reconcileTimeout time.Duration
+}
+
+func New(c Config) *Watcher {
+	return &Watcher{
+		backend:          c.Backend,
+		pollPeriod:       c.PollPeriod,
+		reconcileTimeout: c.ReconcileTimeout,
+	}
+}
+
+type TargetReconcileEvent struct {
+	TargetID models.AssetID
+}
+
+func (e TargetReconcileEvent) ToFields() logrus.Fields {
+	return logrus.Fields{
+		"TargetID": e.TargetID,
+	}
+}
+
+func (e TargetReconcileEvent) String() string {
+	return fmt.Sprintf("TargetID=%s", e.TargetID)
+}
+
+func (e TargetReconcileEvent) Hash() string {
+	return e.TargetID
+}
+
+func (w *Watcher) GetItems(ctx context.Context) ([]TargetReconcileEvent, error) {
+	targets, err := w.backend.GetAssets(ctx, models.GetAssetsParams{
+		Select: utils.PointerTo("id"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get targets from API: %w", err)
+	}
+	if targets.Items == nil {
+		return []TargetReconcileEvent{}, nil
+	}
+
+	items := make([]TargetReconcileEvent, 0, len(*targets.Items))
+	for _, target := range *targets.Items {
+		if target.Id == nil {
+			continue
+		}
+		items = append(items, TargetReconcileEvent{TargetID: *target.Id})
+	}
+
+	return items, nil
+}
+
+func (w *Watcher) Reconcile(ctx context.Context, event TargetReconcileEvent) error {
+	logger := log.GetLoggerFromContextOrDiscard(ctx).WithFields(event.ToFields())
+
+	target, err := w.backend.GetAsset(ctx, event.TargetID, models.GetAssetsAssetIDParams{
+		Select: utils.PointerTo("id,summary"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get target %s: %w", event.TargetID, err)
+	}
+
+	counts, err := w.getFindingCounts(ctx, event.TargetID)
+	if err != nil {
+		return fmt.Errorf("failed to count active findings of target %s: %w", event.TargetID, err)
+	}
+
+	summary, changed := updateSummary(target.Summary, counts)
+	if !changed {
+		return nil
+	}
+
+	logger.Infof("Updating drifted target summary")
+	err = w.backend.PatchAsset(ctx, models.Asset{Summary: summary}, event.TargetID)
+	if err != nil {
+		return fmt.Errorf("failed to patch target %s: %w", event.TargetID, err)
+	}
+
+	return nil
+}
+
+func (w *Watcher) Start(ctx context.Context) {
+	logger := log.GetLoggerFromContextOrDiscard(ctx).WithField("controller", "TargetSummaryWatcher")
+	ctx = log.SetLoggerForContext(ctx, logger)
+
+	queue := common.NewQueue[TargetReconcileEvent]()
+
+	poller := common.Poller[TargetReconcileEvent]{
+		PollPeriod: w.pollPeriod,
+		GetItems:   w.GetItems,
+		Queue:      queue,
+	}
+	poller.Start(ctx)
+
+	reconciler := common.Reconciler[TargetReconcileEvent]{
+		ReconcileFunction: w.Reconcile,
+		ReconcileTimeout:  w.reconcileTimeout,
+		Queue:             queue,
+	}
+	reconciler.Start(ctx)
+}
@@ -0,0 +1,224 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vmimagewatcher
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/findingkey"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// findingsBulkSize is the maximum number of findings created or updated by a
+// single request, which must not exceed the maxItems of the bulk API.
+const findingsBulkSize = 500
+
+// imageEvents returns the events of the images shared by at least
+// minInstances VM Targets and of the images which have a Target, sorted so
+// that they are reconciled in a stable order.
+func imageEvents(vms []models.Asset, images []models.Asset, minInstances int) []ImageReconcileEvent {
+	counts := make(map[ImageReconcileEvent]int)
+	for _, vm := range vms {
+		if vm.AssetInfo == nil {
+			continue
+		}
+		info, err := vm.AssetInfo.AsVMInfo()
+		if err != nil || info.Image == "" {
+			continue
+		}
+		counts[ImageReconcileEvent{Image: info.Image, InstanceProvider: utils.ValueOrZero(info.InstanceProvider)}]++
+	}
+
+	events := make(map[ImageReconcileEvent]struct{})
+	for event, count := range counts {
+		if count >= minInstances {
+			events[event] = struct{}{}
+		}
+	}
+	for _, image := range images {
+		if image.AssetInfo == nil {
+			continue
+		}
+		info, err := image.AssetInfo.AsVMImageInfo()
+		if err != nil || info.Image == "" {
+			continue
+		}
+		events[ImageReconcileEvent{Image: info.Image, InstanceProvider: utils.ValueOrZero(info.InstanceProvider)}] = struct{}{}
+	}
+
+	items := make([]ImageReconcileEvent, 0, len(events))
+	for event := range events {
+		items = append(items, event)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Hash() < items[j].Hash()
+	})
+
+	return items
+}
+
+// scannedInstances returns the IDs of the instances which were scanned at
+// least once, the others have no findings to compare.
+func scannedInstances(instances []models.Asset) []string {
+	var ids []string
+	for _, instance := range instances {
+		if instance.Id == nil || utils.ValueOrZero(instance.ScansCount) == 0 {
+			continue
+		}
+		ids = append(ids, *instance.Id)
+	}
+
+	return ids
+}
+
+// commonFindings returns the info of the findings reported on every one of
+// the instances, by finding key. Findings of types without a key are ignored.
+func commonFindings(instanceFindings [][]models.Finding) map[string]models.Finding_FindingInfo {
+	common := make(map[string]models.Finding_FindingInfo)
+	if len(instanceFindings) == 0 {
+		return common
+	}
+
+	for _, finding := range instanceFindings[0] {
+		if finding.FindingInfo == nil {
+			continue
+		}
+		key, err := findingkey.GenerateFindingKey(finding.FindingInfo)
+		if err != nil {
+			continue
+		}
+		common[key] = *finding.FindingInfo
+	}
+
+	for _, findings := range instanceFindings[1:] {
+		keys := findingKeys(findings)
+		for key := range common {
+			if _, ok := keys[key]; !ok {
+				delete(common, key)
+			}
+		}
+	}
+
+	return common
+}
+
+// findingKeys returns the IDs of the findings by finding key.
+func findingKeys(findings []models.Finding) map[string]string {
+	keys := make(map[string]string, len(findings))
+	for _, finding := range findings {
+		if finding.FindingInfo == nil {
+			continue
+		}
+		key, err := findingkey.GenerateFindingKey(finding.FindingInfo)
+		if err != nil {
+			continue
+		}
+		keys[key] = utils.ValueOrZero(finding.Id)
+	}
+
+	return keys
+}
+
+// diffFindings returns the findings to create on the image Target for the
+// common findings it doesn't have yet, and the existing findings of the image
+// Target to invalidate because they are no longer common to the instances.
+func diffFindings(existing []models.Finding, common map[string]models.Finding_FindingInfo, imageTargetID string, now time.Time) ([]models.Finding, []models.Finding) {
+	existingKeys := findingKeys(existing)
+
+	var invalidate []models.Finding
+	for key, id := range existingKeys {
+		if _, ok := common[key]; ok || id == "" {
+			continue
+		}
+		invalidate = append(invalidate, models.Finding{
+			Id:            utils.PointerTo(id),
+			InvalidatedOn: utils.PointerTo(now),
+		})
+	}
+	sort.Slice(invalidate, func(i, j int) bool {
+		return *invalidate[i].Id < *invalidate[j].Id
+	})
+
+	keys := make([]string, 0, len(common))
+	for key := range common {
+		if _, ok := existingKeys[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	create := make([]models.Finding, 0, len(keys))
+	for _, key := range keys {
+		info := common[key]
+		create = append(create, models.Finding{
+			Asset:       &models.AssetRelationship{Id: imageTargetID},
+			FindingInfo: &info,
+			FoundOn:     utils.PointerTo(now),
+		})
+	}
+
+	return create, invalidate
+}
+
+// imageGuidance returns the remediation guidance of the image, or nil if the
+// instances have no findings in common.
+func imageGuidance(image string, commonFindingsCount int, instanceCount int) *string {
+	if commonFindingsCount == 0 {
+		return nil
+	}
+
+	findings := "findings"
+	if commonFindingsCount == 1 {
+		findings = "finding"
+	}
+	instances := "instances"
+	if instanceCount == 1 {
+		instances = "instance"
+	}
+
+	return utils.PointerTo(fmt.Sprintf(
+		"Fix the %d %s common to all the scanned instances in the base image %s and relaunch the %d %s from it, instead of fixing them on each instance.",
+		commonFindingsCount, findings, image, instanceCount, instances))
+}
+
+// chunkFindings splits the findings into chunks of at most size findings.
+func chunkFindings(findings []models.Finding, size int) [][]models.Finding {
+	var chunks [][]models.Finding
+	for len(findings) > size {
+		chunks = append(chunks, findings[:size])
+		findings = findings[size:]
+	}
+	if len(findings) > 0 {
+		chunks = append(chunks, findings)
+	}
+
+	return chunks
+}
+
+// checkFindingBulkResults returns an error for the first finding of a bulk
+// request which doesn't have the expected status.
+func checkFindingBulkResults(results []models.FindingBulkItemResult, expected int) error {
+	for _, result := range results {
+		if result.Status != expected {
+			return fmt.Errorf("status code=%v: %v", result.Status, utils.ValueOrZero(result.Message))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vmimagewatcher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func newVMTarget(t *testing.T, image string, provider models.CloudProvider) models.Asset {
+	t.Helper()
+
+	info := models.AssetType{}
+	if err := info.FromVMInfo(models.VMInfo{Image: image, InstanceProvider: utils.PointerTo(provider)}); err != nil {
+		t.Fatalf("failed to create VM info: %v", err)
+	}
+	return models.Asset{AssetInfo: &info}
+}
+
+func newImageTarget(t *testing.T, image string, provider models.CloudProvider) models.Asset {
+	t.Helper()
+
+	info := models.AssetType{}
+	if err := info.FromVMImageInfo(models.VMImageInfo{Image: image, InstanceProvider: utils.PointerTo(provider)}); err != nil {
+		t.Fatalf("failed to create VM image info: %v", err)
+	}
+	return models.Asset{AssetInfo: &info}
+}
+
+func newSecretFinding(t *testing.T, id string, fingerprint string) models.Finding {
+	t.Helper()
+
+	info := models.Finding_FindingInfo{}
+	if err := info.FromSecretFindingInfo(models.SecretFindingInfo{
+		Fingerprint: utils.PointerTo(fingerprint),
+		StartColumn: utils.PointerTo(1),
+		EndColumn:   utils.PointerTo(2),
+	}); err != nil {
+		t.Fatalf("failed to create secret finding info: %v", err)
+	}
+	return models.Finding{Id: utils.PointerTo(id), FindingInfo: &info}
+}
+
+func Test_imageEvents(t *testing.T) {
+	vms := []models.Asset{
+		newVMTarget(t, "ami-1", models.AWS),
+		newVMTarget(t, "ami-1", models.AWS),
+		newVMTarget(t, "ami-2", models.AWS),
+		newVMTarget(t, "ami-3", models.AWS),
+		newVMTarget(t, "ami-3", models.Azure),
+		newVMTarget(t, "", models.AWS),
+		newVMTarget(t, "", models.AWS),
+	}
+	images := []models.Asset{
+		newImageTarget(t, "ami-2", models.AWS),
+	}
+
+	got := imageEvents(vms, images, 2)
+	want := []ImageReconcileEvent{
+		{Image: "ami-1", InstanceProvider: models.AWS},
+		{Image: "ami-2", InstanceProvider: models.AWS},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("imageEvents() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_scannedInstances(t *testing.T) {
+	instances := []models.Asset{
+		{Id: utils.PointerTo("scanned"), ScansCount: utils.PointerTo(2)},
+		{Id: utils.PointerTo("never-scanned"), ScansCount: utils.PointerTo(0)},
+		{Id: utils.PointerTo("no-count")},
+	}
+
+	got := scannedInstances(instances)
+	if diff := cmp.Diff([]string{"scanned"}, got); diff != "" {
+		t.Errorf("scannedInstances() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_commonFindings(t *testing.T) {
+	tests := []struct {
+		name             string
+		instanceFindings [][]models.Finding
+		wantKeys         int
+	}{
+		{
+			name:             "no instances",
+			instanceFindings: nil,
+			wantKeys:         0,
+		},
+		{
+			name: "findings common to all instances",
+			instanceFindings: [][]models.Finding{
+				{newSecretFinding(t, "1", "a"), newSecretFinding(t, "2", "b"), newSecretFinding(t, "3", "c")},
+				{newSecretFinding(t, "4", "b"), newSecretFinding(t, "5", "a")},
+				{newSecretFinding(t, "6", "a"), newSecretFinding(t, "7", "b"), newSecretFinding(t, "8", "d")},
+			},
+			wantKeys: 2,
+		},
+		{
+			name: "no findings in common",
+			instanceFindings: [][]models.Finding{
+				{newSecretFinding(t, "1", "a")},
+				{newSecretFinding(t, "2", "b")},
+			},
+			wantKeys: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := commonFindings(tt.instanceFindings)
+			if len(got) != tt.wantKeys {
+				t.Errorf("commonFindings() returned %d findings, want %d", len(got), tt.wantKeys)
+			}
+		})
+	}
+}
+
+func Test_diffFindings(t *testing.T) {
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	common := commonFindings([][]models.Finding{
+		{newSecretFinding(t, "1", "a"), newSecretFinding(t, "2", "b")},
+	})
+	existing := []models.Finding{
+		newSecretFinding(t, "image-a", "a"),
+		newSecretFinding(t, "image-stale", "stale"),
+	}
+
+	create, invalidate := diffFindings(existing, common, "image-target", now)
+
+	if len(create) != 1 {
+		t.Fatalf("diffFindings() created %d findings, want 1", len(create))
+	}
+	if create[0].Asset == nil || create[0].Asset.Id != "image-target" {
+		t.Errorf("created finding asset = %v, want image-target", create[0].Asset)
+	}
+	info, err := create[0].FindingInfo.AsSecretFindingInfo()
+	if err != nil {
+		t.Fatalf("failed to get created finding info: %v", err)
+	}
+	if utils.ValueOrZero(info.Fingerprint) != "b" {
+		t.Errorf("created finding fingerprint = %q, want %q", utils.ValueOrZero(info.Fingerprint), "b")
+	}
+
+	wantInvalidate := []models.Finding{
+		{Id: utils.PointerTo("image-stale"), InvalidatedOn: utils.PointerTo(now)},
+	}
+	if diff := cmp.Diff(wantInvalidate, invalidate); diff != "" {
+		t.Errorf("diffFindings() invalidate mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_imageGuidance(t *testing.T) {
+	if got := imageGuidance("ami-1", 0, 3); got != nil {
+		t.Errorf("imageGuidance() = %q, want nil", *got)
+	}
+
+	want := "Fix the 1 finding common to all the scanned instances in the base image ami-1 and relaunch the 3 instances from it, instead of fixing them on each instance."
+	if got := imageGuidance("ami-1", 1, 3); got == nil || *got != want {
+		t.Errorf("imageGuidance() = %v, want %q", got, want)
+	}
+}
@@ -0,0 +1,276 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vmimagewatcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/orchestrator/common"
+	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const vmImageObjectType = "VMImageInfo"
+
+// Watcher periodically attributes the findings which are common to all the
+// scanned VM Targets launched from the same image to a Target of the image
+// itself, so they can be fixed once in the base image.
+type Watcher struct {
+	backend          *backendclient.BackendClient
+	pollPeriod       time.Duration
+	reconcileTimeout time.Duration
+	minInstances     int
+}
+
+func New(c Config) *Watcher {
+	return &Watcher{
+		backend:          c.Backend,
+		pollPeriod:       c.PollPeriod,
+		reconcileTimeout: c.ReconcileTimeout,
+		minInstances:     c.MinInstances,
+	}
+}
+
+type ImageReconcileEvent struct {
+	Image            string
+	InstanceProvider models.CloudProvider
+}
+
+func (e ImageReconcileEvent) ToFields() logrus.Fields {
+	return logrus.Fields{
+		"Image":            e.Image,
+		"InstanceProvider": e.InstanceProvider,
+	}
+}
+
+func (e ImageReconcileEvent) String() string {
+	return fmt.Sprintf("Image=%s InstanceProvider=%s", e.Image, e.InstanceProvider)
+}
+
+func (e ImageReconcileEvent) Hash() string {
+	return fmt.Sprintf("%s/%s", e.InstanceProvider, e.Image)
+}
+
+// GetItems returns an event for each image shared by at least minInstances
+// active VM Targets, and for each image which already has a Target so that its
+// findings are invalidated once the image is no longer shared.
+func (w *Watcher) GetItems(ctx context.Context) ([]ImageReconcileEvent, error) {
+	vms, err := w.backend.GetAssets(ctx, models.GetAssetsParams{
+		Filter: utils.PointerTo("assetInfo/objectType eq 'VMInfo' and terminatedOn eq null"),
+		Select: utils.PointerTo("id,assetInfo"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get VM targets from API: %w", err)
+	}
+
+	images, err := w.backend.GetAssets(ctx, models.GetAssetsParams{
+		Filter: utils.PointerTo(fmt.Sprintf("assetInfo/objectType eq '%s'", vmImageObjectType)),
+		Select: utils.PointerTo("id,assetInfo"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get VM image targets from API: %w", err)
+	}
+
+	return imageEvents(utils.ValueOrZero(vms.Items), utils.ValueOrZero(images.Items), w.minInstances), nil
+}
+
+func (w *Watcher) Reconcile(ctx context.Context, event ImageReconcileEvent) error {
+	logger := log.GetLoggerFromContextOrDiscard(ctx).WithFields(event.ToFields())
+
+	instances, err := w.getInstances(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	imageTarget, err := w.getImageTarget(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	scanned := scannedInstances(instances)
+	if imageTarget == nil && len(scanned) < w.minInstances {
+		return nil
+	}
+
+	var instanceFindings [][]models.Finding
+	if len(scanned) >= w.minInstances {
+		for _, id := range scanned {
+			findings, err := w.getActiveFindings(ctx, id)
+			if err != nil {
+				return fmt.Errorf("failed to get active findings of target %s: %w", id, err)
+			}
+			instanceFindings = append(instanceFindings, findings)
+		}
+	}
+	shared := commonFindings(instanceFindings)
+
+	info := models.VMImageInfo{
+		Image:                event.Image,
+		InstanceProvider:     utils.PointerTo(event.InstanceProvider),
+		InstanceCount:        utils.PointerTo(len(instances)),
+		ScannedInstanceCount: utils.PointerTo(len(scanned)),
+		CommonFindingsCount:  utils.PointerTo(len(shared)),
+		Guidance:             imageGuidance(event.Image, len(shared), len(instances)),
+	}
+	imageTargetID, err := w.saveImageTarget(ctx, imageTarget, info)
+	if err != nil {
+		return err
+	}
+
+	existing, err := w.getActiveFindings(ctx, imageTargetID)
+	if err != nil {
+		return fmt.Errorf("failed to get active findings of image target %s: %w", imageTargetID, err)
+	}
+
+	create, invalidate := diffFindings(existing, shared, imageTargetID, time.Now())
+	if len(create) == 0 && len(invalidate) == 0 {
+		return nil
+	}
+
+	logger.Infof("Attributing %d new findings to the image and invalidating %d", len(create), len(invalidate))
+	if err = w.saveFindings(ctx, create, invalidate); err != nil {
+		return fmt.Errorf("failed to save findings of image target %s: %w", imageTargetID, err)
+	}
+
+	return nil
+}
+
+// getInstances returns the active VM Targets launched from the image.
+func (w *Watcher) getInstances(ctx context.Context, event ImageReconcileEvent) ([]models.Asset, error) {
+	targets, err := w.backend.GetAssets(ctx, models.GetAssetsParams{
+		Filter: utils.PointerTo(fmt.Sprintf(
+			"assetInfo/objectType eq 'VMInfo' and terminatedOn eq null and assetInfo/image eq '%s' and assetInfo/instanceProvider eq '%s'",
+			event.Image, event.InstanceProvider)),
+		Select: utils.PointerTo("id,scansCount"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get instances of image %s: %w", event.Image, err)
+	}
+
+	return utils.ValueOrZero(targets.Items), nil
+}
+
+// getImageTarget returns the Target of the image, or nil if it doesn't exist.
+func (w *Watcher) getImageTarget(ctx context.Context, event ImageReconcileEvent) (*models.Asset, error) {
+	targets, err := w.backend.GetAssets(ctx, models.GetAssetsParams{
+		Filter: utils.PointerTo(fmt.Sprintf(
+			"assetInfo/objectType eq '%s' and assetInfo/image eq '%s' and assetInfo/instanceProvider eq '%s'",
+			vmImageObjectType, event.Image, event.InstanceProvider)),
+		Select: utils.PointerTo("id"),
+		Top:    utils.PointerTo(1),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get target of image %s: %w", event.Image, err)
+	}
+	if targets.Items == nil || len(*targets.Items) == 0 {
+		return nil, nil // nolint:nilnil
+	}
+
+	return &(*targets.Items)[0], nil
+}
+
+// saveImageTarget creates the Target of the image if it doesn't exist yet, or
+// updates its info otherwise, and returns its ID.
+func (w *Watcher) saveImageTarget(ctx context.Context, imageTarget *models.Asset, info models.VMImageInfo) (string, error) {
+	targetInfo := models.AssetType{}
+	if err := targetInfo.FromVMImageInfo(info); err != nil {
+		return "", fmt.Errorf("failed to create image target info: %w", err)
+	}
+
+	if imageTarget != nil {
+		err := w.backend.PatchAsset(ctx, models.Asset{AssetInfo: &targetInfo}, *imageTarget.Id)
+		if err != nil {
+			return "", fmt.Errorf("failed to patch image target %s: %w", *imageTarget.Id, err)
+		}
+		return *imageTarget.Id, nil
+	}
+
+	target, err := w.backend.PostAsset(ctx, models.Asset{AssetInfo: &targetInfo})
+	if err != nil {
+		var conErr backendclient.AssetConflictError
+		if errors.As(err, &conErr) {
+			return *conErr.ConflictingTarget.Id, nil
+		}
+		return "", fmt.Errorf("failed to post image target: %w", err)
+	}
+
+	return *target.Id, nil
+}
+
+func (w *Watcher) getActiveFindings(ctx context.Context, targetID string) ([]models.Finding, error) {
+	findings, err := w.backend.GetFindings(ctx, models.GetFindingsParams{
+		Filter: utils.PointerTo(fmt.Sprintf("asset/id eq '%s' and invalidatedOn eq null and suppression eq null", targetID)),
+		Select: utils.PointerTo("id,findingInfo"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active findings: %w", err)
+	}
+
+	return utils.ValueOrZero(findings.Items), nil
+}
+
+func (w *Watcher) saveFindings(ctx context.Context, create, invalidate []models.Finding) error {
+	for _, chunk := range chunkFindings(invalidate, findingsBulkSize) {
+		results, err := w.backend.PatchFindingsBulk(ctx, chunk)
+		if err != nil {
+			return fmt.Errorf("failed to invalidate findings: %w", err)
+		}
+		if err = checkFindingBulkResults(results, http.StatusOK); err != nil {
+			return fmt.Errorf("failed to invalidate finding: %w", err)
+		}
+	}
+
+	for _, chunk := range chunkFindings(create, findingsBulkSize) {
+		results, err := w.backend.PostFindingsBulk(ctx, chunk)
+		if err != nil {
+			return fmt.Errorf("failed to create findings: %w", err)
+		}
+		if err = checkFindingBulkResults(results, http.StatusCreated); err != nil {
+			return fmt.Errorf("failed to create finding: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (w *Watcher) Start(ctx context.Context) {
+	logger := log.GetLoggerFromContextOrDiscard(ctx).WithField("controller", "VMImageWatcher")
+	ctx = log.SetLoggerForContext(ctx, logger)
+
+	queue := common.NewQueue[ImageReconcileEvent]()
+
+	poller := common.Poller[ImageReconcileEvent]{
+		PollPeriod: w.pollPeriod,
+		GetItems:   w.GetItems,
+		Queue:      queue,
+	}
+	poller.Start(ctx)
+
+	reconciler := common.Reconciler[ImageReconcileEvent]{
+		ReconcileFunction: w.Reconcile,
+		ReconcileTimeout:  w.reconcileTimeout,
+		Queue:             queue,
+	}
+	reconciler.Start(ctx)
+}
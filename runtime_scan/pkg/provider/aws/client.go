@@ -20,12 +20,16 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 
 	awstype "github.com/aws/aws-sdk-go-v2/aws"
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/ebs"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/sirupsen/logrus"
 
 	"github.com/openclarity/vmclarity/api/models"
@@ -37,7 +41,18 @@ import (
 
 type Client struct {
 	ec2Client *ec2.Client
+	ebsClient *ebs.Client
 	config    *Config
+
+	// accountID is the account the EC2 and EBS clients act in, it is only
+	// known if the organization is scanned.
+	accountID string
+	// scannerAccountID is the account the scanner instances are created in,
+	// it is only known if the organization is scanned.
+	scannerAccountID    string
+	awsConfig           awstype.Config
+	organizationsClient *organizations.Client
+	memberClients       *memberClients
 }
 
 func New(ctx context.Context) (*Client, error) {
@@ -51,16 +66,40 @@ func New(ctx context.Context) (*Client, error) {
 	}
 
 	awsClient := Client{
-		config: config,
+		config:        config,
+		memberClients: newMemberClients(),
 	}
 
-	cfg, err := awsconfig.LoadDefaultConfig(ctx)
+	opts := []func(*awsconfig.LoadOptions) error{
+		// Resolve the endpoints of the partition the Scanner is deployed in.
+		awsconfig.WithRegion(config.ScannerRegion),
+	}
+	if config.UseFIPSEndpoint {
+		opts = append(opts, awsconfig.WithUseFIPSEndpoint(awstype.FIPSEndpointStateEnabled))
+	}
+
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load aws config: %w", err)
 	}
 
+	awsClient.awsConfig = cfg
 	// nolint:contextcheck
 	awsClient.ec2Client = ec2.NewFromConfig(cfg)
+	// nolint:contextcheck
+	awsClient.ebsClient = ebs.NewFromConfig(cfg)
+
+	if config.OrganizationRoleName != "" {
+		// nolint:contextcheck
+		identity, err := sts.NewFromConfig(cfg).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get caller identity: %w", err)
+		}
+		awsClient.scannerAccountID = getPointerValOrEmpty(identity.Account)
+		awsClient.accountID = awsClient.scannerAccountID
+		// nolint:contextcheck
+		awsClient.organizationsClient = organizations.NewFromConfig(cfg)
+	}
 
 	return &awsClient, nil
 }
@@ -69,7 +108,22 @@ func (c Client) Kind() models.CloudProvider {
 	return models.AWS
 }
 
+func (c Client) Capabilities() models.ProviderCapabilities {
+	return models.ProviderCapabilities{
+		ScanStoppedInstances: true,
+		// Instances in other regions are scanned by copying their snapshots to the scanner region.
+		CrossRegion:      !c.config.DisableSnapshotCopy,
+		EncryptedVolumes: true,
+		SpotInstances:    !c.config.DisableSpotInstances,
+		ScannerPlacement: true,
+	}
+}
+
 func (c *Client) DiscoverScopes(ctx context.Context) (*models.Scopes, error) {
+	if c.organizationEnabled() {
+		return c.discoverOrganizationScopes(ctx)
+	}
+
 	regions, err := c.ListAllRegions(ctx, true)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list all regions: %w", err)
@@ -90,10 +144,42 @@ func (c *Client) DiscoverScopes(ctx context.Context) (*models.Scopes, error) {
 	}, nil
 }
 
-// nolint:cyclop
-func (c *Client) DiscoverTargets(ctx context.Context, scanScope *models.ScanScopeType) ([]models.TargetType, error) {
-	var filters []ec2types.Filter
+// discoverOrganizationScopes discovers the regions of the member accounts of
+// the organization. The accounts the role can't be assumed in are skipped.
+func (c *Client) discoverOrganizationScopes(ctx context.Context) (*models.Scopes, error) {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
 
+	accounts, err := c.ListAccounts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization accounts: %w", err)
+	}
+
+	apiAccounts := make([]models.AwsAccount, 0, len(accounts))
+	for _, account := range accounts {
+		regions, err := c.forAccount(account.ID).ListAllRegions(ctx, true)
+		if err != nil {
+			logger.Warnf("Failed to list all regions. AccountID=%s: %v", account.ID, err)
+			continue
+		}
+		apiAccounts = append(apiAccounts, convertToAPIAccount(account, regions))
+	}
+
+	scopes := models.ScopeType{}
+	err = scopes.FromAwsOrganizationScope(models.AwsOrganizationScope{
+		Accounts: &apiAccounts,
+	})
+	if err != nil {
+		return nil, FatalError{
+			Err: fmt.Errorf("failed to cast AwsOrganizationScope to ScopeType: %w", err),
+		}
+	}
+
+	return &models.Scopes{
+		ScopeInfo: &scopes,
+	}, nil
+}
+
+func (c *Client) DiscoverTargets(ctx context.Context, scanScope *models.ScanScopeType) ([]models.AssetType, error) {
 	awsScanScope, err := scanScope.AsAwsScanScope()
 	if err != nil {
 		return nil, FatalError{
@@ -103,6 +189,36 @@ func (c *Client) DiscoverTargets(ctx context.Context, scanScope *models.ScanScop
 
 	scope := convertFromAPIScanScope(&awsScanScope)
 
+	if !c.organizationEnabled() {
+		return c.discoverTargets(ctx, scope)
+	}
+
+	accounts, err := c.getAccountsToScan(ctx, scope)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accounts to scan: %w", err)
+	}
+
+	// The accounts the role can't be assumed in are skipped so that they don't
+	// prevent scanning the rest of the organization.
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+	targets := make([]models.AssetType, 0)
+	for _, account := range accounts {
+		accountTargets, err := c.forAccount(account.ID).discoverTargets(ctx, scope)
+		if err != nil {
+			logger.Warnf("Failed to discover targets. AccountID=%s: %v", account.ID, err)
+			continue
+		}
+		targets = append(targets, accountTargets...)
+	}
+
+	return targets, nil
+}
+
+// discoverTargets discovers the instances in the scan scope in the account of the client.
+// nolint:cyclop
+func (c *Client) discoverTargets(ctx context.Context, scope *ScanScope) ([]models.AssetType, error) {
+	var filters []ec2types.Filter
+
 	regions, err := c.getRegionsToScan(ctx, scope)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get regions to scan: %w", err)
@@ -121,7 +237,7 @@ func (c *Client) DiscoverTargets(ctx context.Context, scanScope *models.ScanScop
 	}
 	filters = append(filters, EC2FiltersFromInstanceState(instanceStates...)...)
 
-	targets := make([]models.TargetType, 0)
+	targets := make([]models.AssetType, 0)
 	for _, region := range regions {
 		// if no vpcs, that mean that we don't need any vpc filters
 		if len(region.VPCs) == 0 {
@@ -193,7 +309,7 @@ func (c *Client) getInstanceWithID(ctx context.Context, id string, region string
 }
 
 // nolint:cyclop
-func (c *Client) createInstance(ctx context.Context, region string, config *provider.ScanJobConfig) (*Instance, error) {
+func (c *Client) createInstance(ctx context.Context, region string, network ScannerNetwork, config *provider.ScanJobConfig) (*Instance, error) {
 	options := func(options *ec2.Options) {
 		options.Region = region
 	}
@@ -235,7 +351,7 @@ func (c *Client) createInstance(ctx context.Context, region string, config *prov
 	runParams := &ec2.RunInstancesInput{
 		MaxCount:     utils.PointerTo[int32](1),
 		MinCount:     utils.PointerTo[int32](1),
-		ImageId:      utils.PointerTo(c.config.ScannerImage),
+		ImageId:      utils.PointerTo(c.scannerImage(config)),
 		InstanceType: ec2types.InstanceType(c.config.ScannerInstanceType),
 		TagSpecifications: []ec2types.TagSpecification{
 			{
@@ -260,14 +376,16 @@ func (c *Client) createInstance(ctx context.Context, region string, config *prov
 			AssociatePublicIpAddress: utils.PointerTo(false),
 			DeleteOnTermination:      utils.PointerTo(true),
 			DeviceIndex:              utils.PointerTo[int32](0),
-			Groups:                   []string{c.config.SecurityGroupID},
-			SubnetId:                 &c.config.SubnetID,
+			Groups:                   []string{network.SecurityGroupID},
+			SubnetId:                 utils.PointerTo(network.SubnetID),
 		},
 	}
 
 	var retryMaxAttempts int
 	// Use spot instances if there is a configuration for it.
-	if config.ScannerInstanceCreationConfig.UseSpotInstances {
+	if config.ScannerInstanceCreationConfig.UseSpotInstances && c.config.DisableSpotInstances {
+		log.GetLoggerFromContextOrDiscard(ctx).Warn("Spot instances are disabled, creating on-demand scanner instance")
+	} else if config.ScannerInstanceCreationConfig.UseSpotInstances {
 		runParams.InstanceMarketOptions = &ec2types.InstanceMarketOptionsRequest{
 			MarketType: ec2types.MarketTypeSpot,
 			SpotOptions: &ec2types.SpotMarketOptions{
@@ -289,23 +407,32 @@ func (c *Client) createInstance(ctx context.Context, region string, config *prov
 	}
 
 	// if retryMaxAttempts value is 0 it will be ignored
+	op := provider.StartOperation(ctx, models.AWS, models.InstanceCreate, region)
 	out, err := c.ec2Client.RunInstances(ctx, runParams, options, func(options *ec2.Options) {
 		options.RetryMaxAttempts = retryMaxAttempts
 		options.RetryMode = awstype.RetryModeStandard
 	})
 	if err != nil {
+		op.Finish(ctx, "", requestIDFromError(err), err)
 		return nil, fmt.Errorf("failed to create instance: %w", err)
 	}
 	if len(out.Instances) < 1 {
-		return nil, errors.New("failed to create instance: 0 instance in response")
+		err = errors.New("failed to create instance: 0 instance in response")
+		op.Finish(ctx, "", requestIDFromMetadata(out.ResultMetadata), err)
+		return nil, err
 	}
+	op.Finish(ctx, getPointerValOrEmpty(out.Instances[0].InstanceId), requestIDFromMetadata(out.ResultMetadata), nil)
 
 	return instanceFromEC2Instance(&out.Instances[0], c.ec2Client, region, config), nil
 }
 
 // nolint:cyclop,gocognit,maintidx
 func (c *Client) RunTargetScan(ctx context.Context, config *provider.ScanJobConfig) error {
-	vmInfo, err := config.TargetInfo.AsVMInfo()
+	if config.InputImage != "" {
+		return c.runImageScan(ctx, config)
+	}
+
+	vmInfo, err := config.AssetInfo.AsVMInfo()
 	if err != nil {
 		return FatalError{Err: err}
 	}
@@ -317,6 +444,11 @@ func (c *Client) RunTargetScan(ctx context.Context, config *provider.ScanJobConf
 		"Provider":         string(c.Kind()),
 	})
 
+	network, err := c.scannerNetwork(ctx, config, &vmInfo)
+	if err != nil {
+		return WrapError(fmt.Errorf("failed to get scanner network: %w", err))
+	}
+
 	// Note(chrisgacsal): In order to speed up the initialization process the scanner instance and the volume are created
 	//                    in parallel.
 
@@ -332,7 +464,7 @@ func (c *Client) RunTargetScan(ctx context.Context, config *provider.ScanJobConf
 		logger.Trace("Creating scanner VM instance")
 
 		var err error
-		scannnerInstance, err = c.createInstance(ctx, c.config.ScannerRegion, config)
+		scannnerInstance, err = c.createInstance(ctx, c.config.ScannerRegion, network, config)
 		if err != nil {
 			errs <- WrapError(fmt.Errorf("failed to create scanner VM instance: %w", err))
 			return
@@ -348,116 +480,23 @@ func (c *Client) RunTargetScan(ctx context.Context, config *provider.ScanJobConf
 		}).Debugf("Scanner instance is ready: %t", ready)
 		if !ready {
 			errs <- RetryableError{
-				Err:   errors.New("scanner instance is not ready"),
-				After: InstanceReadynessAfter,
+				Err:        errors.New("scanner instance is not ready"),
+				After:      InstanceReadynessAfter,
+				InProgress: true,
 			}
 		}
 	}()
 
-	// Create volume snapshot from the root volume of the Target Instance used for scanning by:
-	// * fetching the Target Instance from provider
-	// * creating a volume snapshot from the root volume of the Target Instance
-	// * copying the volume snapshot to the region/location of the scanner instance if they are deployed in separate locations
+	// Create volume snapshot from the root volume of the Target Instance used for scanning
 	var destVolSnapshot *Snapshot
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
 
-		logger.Debug("Getting target VM instance")
-
-		targetVMLocation, err := NewLocation(vmInfo.Location)
-		if err != nil {
-			errs <- FatalError{
-				Err: fmt.Errorf("failed to parse Location for target VM instance: %w", err),
-			}
-			return
-		}
-
-		var SrcEC2Instance *ec2types.Instance
-		SrcEC2Instance, err = c.getInstanceWithID(ctx, vmInfo.InstanceID, targetVMLocation.Region)
-		if err != nil {
-			errs <- WrapError(fmt.Errorf("failed to fetch target VM instance: %w", err))
-			return
-		}
-		if SrcEC2Instance == nil {
-			errs <- FatalError{
-				Err: fmt.Errorf("failed to find target VM instance. InstanceID=%s", vmInfo.InstanceID),
-			}
-			return
-		}
-
-		srcInstance := instanceFromEC2Instance(SrcEC2Instance, c.ec2Client, targetVMLocation.Region, config)
-
-		logger.WithField("TargetInstanceID", srcInstance.ID).Trace("Found target VM instance")
-
-		srcVol := srcInstance.RootVolume()
-		if srcVol == nil {
-			errs <- FatalError{
-				Err: errors.New("failed to get root block device for target VM instance"),
-			}
-			return
-		}
-
-		logger.WithField("TargetVolumeID", srcVol.ID).Debug("Creating target volume snapshot for target VM instance")
-		srcVolSnapshot, err := srcVol.CreateSnapshot(ctx)
-		if err != nil {
-			errs <- WrapError(fmt.Errorf("failed to create volume snapshot from target volume. TargetVolumeID=%s: %w",
-				srcVol.ID, err))
-			return
-		}
-
-		ready, err := srcVolSnapshot.IsReady(ctx)
-		if err != nil {
-			err = fmt.Errorf("failed to get volume snapshot state. TargetVolumeSnapshotID=%s: %w",
-				srcVolSnapshot.ID, err)
-			errs <- WrapError(err)
-			return
-		}
-
-		logger.WithFields(logrus.Fields{
-			"TargetVolumeID":         srcVol.ID,
-			"TargetVolumeSnapshotID": srcVolSnapshot.ID,
-		}).Debugf("Target volume snapshot is ready: %t", ready)
-		if !ready {
-			errs <- RetryableError{
-				Err:   errors.New("target volume snapshot is not ready"),
-				After: SnapshotReadynessAfter,
-			}
-			return
-		}
-
-		logger.WithFields(logrus.Fields{
-			"TargetVolumeID":         srcVol.ID,
-			"TargetVolumeSnapshotID": srcVolSnapshot.ID,
-		}).Debug("Copying target volume snapshot to scanner location")
-		destVolSnapshot, err = srcVolSnapshot.Copy(ctx, c.config.ScannerRegion)
-		if err != nil {
-			err = fmt.Errorf("failed to copy target volume snapshot to location. TargetVolumeSnapshotID=%s Location=%s: %w",
-				srcVolSnapshot.ID, c.config.ScannerRegion, err)
-			errs <- WrapError(err)
-			return
-		}
-
-		ready, err = destVolSnapshot.IsReady(ctx)
+		var err error
+		destVolSnapshot, err = c.createScannerSnapshot(ctx, config, vmInfo, logger)
 		if err != nil {
-			err = fmt.Errorf("failed to get volume snapshot state. ScannerVolumeSnapshotID=%s: %w",
-				srcVolSnapshot.ID, err)
-			errs <- WrapError(err)
-			return
-		}
-
-		logger.WithFields(logrus.Fields{
-			"TargetVolumeID":          srcVol.ID,
-			"TargetVolumeSnapshotID":  srcVolSnapshot.ID,
-			"ScannerVolumeSnapshotID": destVolSnapshot.ID,
-		}).Debugf("Scanner volume snapshot is ready: %t", ready)
-
-		if !ready {
-			errs <- RetryableError{
-				Err:   errors.New("scanner volume snapshot is not ready"),
-				After: SnapshotReadynessAfter,
-			}
-			return
+			errs <- err
 		}
 	}()
 	wg.Wait()
@@ -498,8 +537,9 @@ func (c *Client) RunTargetScan(ctx context.Context, config *provider.ScanJobConf
 	}).Debugf("Scanner volume is ready: %t", ready)
 	if !ready {
 		return RetryableError{
-			Err:   fmt.Errorf("scanner volume is not ready. ScannerVolumeID=%s", scannerVol.ID),
-			After: VolumeReadynessAfter,
+			Err:        fmt.Errorf("scanner volume is not ready. ScannerVolumeID=%s", scannerVol.ID),
+			After:      VolumeReadynessAfter,
+			InProgress: true,
 		}
 	}
 
@@ -532,14 +572,177 @@ func (c *Client) RunTargetScan(ctx context.Context, config *provider.ScanJobConf
 	}
 	if !ready {
 		return RetryableError{
-			Err:   fmt.Errorf("scanner volume is not attached yet. ScannerVolumeID=%s", scannerVol.ID),
-			After: VolumeAttachmentReadynessAfter,
+			Err:        fmt.Errorf("scanner volume is not attached yet. ScannerVolumeID=%s", scannerVol.ID),
+			After:      VolumeAttachmentReadynessAfter,
+			InProgress: true,
 		}
 	}
 
 	return nil
 }
 
+// createScannerSnapshot creates the volume snapshot from the root volume of the
+// Target Instance used for scanning by:
+// * fetching the Target Instance from provider
+// * creating a volume snapshot from the root volume of the Target Instance
+// * copying the volume snapshot to the region/location of the scanner instance if they are deployed in separate locations
+// It returns RetryableError until the snapshot in the scanner location is ready.
+func (c *Client) createScannerSnapshot(ctx context.Context, config *provider.ScanJobConfig, vmInfo models.VMInfo, logger *logrus.Entry) (*Snapshot, error) {
+	logger.Debug("Getting target VM instance")
+
+	targetVMLocation, err := NewLocation(vmInfo.Location)
+	if err != nil {
+		return nil, FatalError{
+			Err: fmt.Errorf("failed to parse Location for target VM instance: %w", err),
+		}
+	}
+
+	target, err := c.targetClient(vmInfo)
+	if err != nil {
+		return nil, err
+	}
+	crossAccount := target != c
+
+	if crossAccount && c.config.DisableSnapshotCopy {
+		return nil, FatalError{
+			Err: fmt.Errorf("snapshot copy is disabled, target VM instance account %s must match scanner account %s",
+				target.accountID, c.scannerAccountID),
+		}
+	}
+
+	if targetVMLocation.Region != c.config.ScannerRegion {
+		if partition := c.config.GetPartition(); !partition.Contains(targetVMLocation.Region) {
+			return nil, FatalError{
+				Err: fmt.Errorf("target VM instance region %s is not in partition %s", targetVMLocation.Region, partition),
+			}
+		}
+		if c.config.DisableSnapshotCopy {
+			return nil, FatalError{
+				Err: fmt.Errorf("snapshot copy is disabled, target VM instance region %s must match scanner region %s",
+					targetVMLocation.Region, c.config.ScannerRegion),
+			}
+		}
+	}
+
+	var SrcEC2Instance *ec2types.Instance
+	SrcEC2Instance, err = target.getInstanceWithID(ctx, vmInfo.InstanceID, targetVMLocation.Region)
+	if err != nil {
+		return nil, WrapError(fmt.Errorf("failed to fetch target VM instance: %w", err))
+	}
+	if SrcEC2Instance == nil {
+		return nil, FatalError{
+			Err: fmt.Errorf("failed to find target VM instance. InstanceID=%s", vmInfo.InstanceID),
+		}
+	}
+
+	srcInstance := instanceFromEC2Instance(SrcEC2Instance, target.ec2Client, targetVMLocation.Region, config)
+
+	logger.WithField("TargetInstanceID", srcInstance.ID).Trace("Found target VM instance")
+
+	srcVol := srcInstance.RootVolume()
+	if srcVol == nil {
+		return nil, FatalError{
+			Err: errors.New("failed to get root block device for target VM instance"),
+		}
+	}
+
+	logger.WithField("TargetVolumeID", srcVol.ID).Debug("Creating target volume snapshot for target VM instance")
+	srcVolSnapshot, err := srcVol.CreateSnapshot(ctx)
+	if err != nil {
+		return nil, WrapError(fmt.Errorf("failed to create volume snapshot from target volume. TargetVolumeID=%s: %w",
+			srcVol.ID, err))
+	}
+
+	ready, err := srcVolSnapshot.IsReady(ctx)
+	if err != nil {
+		err = fmt.Errorf("failed to get volume snapshot state. TargetVolumeSnapshotID=%s: %w",
+			srcVolSnapshot.ID, err)
+		return nil, WrapError(err)
+	}
+
+	logger.WithFields(logrus.Fields{
+		"TargetVolumeID":         srcVol.ID,
+		"TargetVolumeSnapshotID": srcVolSnapshot.ID,
+	}).Debugf("Target volume snapshot is ready: %t", ready)
+	if !ready {
+		return nil, RetryableError{
+			Err:        errors.New("target volume snapshot is not ready"),
+			After:      SnapshotReadynessAfter,
+			InProgress: true,
+		}
+	}
+
+	// The snapshot in the member account is shared with the account of the
+	// scanner to copy it there, so that the scanner volume can be created from it.
+	if crossAccount {
+		logger.WithFields(logrus.Fields{
+			"TargetVolumeID":         srcVol.ID,
+			"TargetVolumeSnapshotID": srcVolSnapshot.ID,
+			"TargetAccountID":        target.accountID,
+		}).Debug("Sharing target volume snapshot with scanner account")
+		if err = srcVolSnapshot.ShareWith(ctx, c.scannerAccountID); err != nil {
+			err = fmt.Errorf("failed to share target volume snapshot with scanner account. TargetVolumeSnapshotID=%s AccountID=%s: %w",
+				srcVolSnapshot.ID, c.scannerAccountID, err)
+			return nil, WrapError(err)
+		}
+	}
+
+	logger.WithFields(logrus.Fields{
+		"TargetVolumeID":         srcVol.ID,
+		"TargetVolumeSnapshotID": srcVolSnapshot.ID,
+	}).Debug("Copying target volume snapshot to scanner location")
+	destVolSnapshot, err := srcVolSnapshot.CopyTo(ctx, c.ec2Client, c.config.ScannerRegion)
+	if err != nil {
+		err = fmt.Errorf("failed to copy target volume snapshot to location. TargetVolumeSnapshotID=%s Location=%s: %w",
+			srcVolSnapshot.ID, c.config.ScannerRegion, err)
+		return nil, WrapError(err)
+	}
+
+	ready, err = destVolSnapshot.IsReady(ctx)
+	if err != nil {
+		err = fmt.Errorf("failed to get volume snapshot state. ScannerVolumeSnapshotID=%s: %w",
+			srcVolSnapshot.ID, err)
+		return nil, WrapError(err)
+	}
+
+	logger.WithFields(logrus.Fields{
+		"TargetVolumeID":          srcVol.ID,
+		"TargetVolumeSnapshotID":  srcVolSnapshot.ID,
+		"ScannerVolumeSnapshotID": destVolSnapshot.ID,
+	}).Debugf("Scanner volume snapshot is ready: %t", ready)
+
+	if !ready {
+		return nil, RetryableError{
+			Err:        errors.New("scanner volume snapshot is not ready"),
+			After:      SnapshotReadynessAfter,
+			InProgress: true,
+		}
+	}
+
+	return destVolSnapshot, nil
+}
+
+func (c *Client) PrewarmTargetScan(ctx context.Context, config *provider.ScanJobConfig) error {
+	if config.InputImage != "" {
+		return nil
+	}
+
+	vmInfo, err := config.AssetInfo.AsVMInfo()
+	if err != nil {
+		return FatalError{Err: err}
+	}
+
+	logger := log.GetLoggerFromContextOrDefault(ctx).WithFields(logrus.Fields{
+		"TargetInstanceID": vmInfo.InstanceID,
+		"TargetLocation":   vmInfo.Location,
+		"ScannerLocation":  c.config.ScannerRegion,
+		"Provider":         string(c.Kind()),
+	})
+
+	_, err = c.createScannerSnapshot(ctx, config, vmInfo, logger)
+	return err
+}
+
 // deleteInstances terminates all instances which meet the conditions defined by the filters argument.
 // It returns:
 //   - nil, error: if error happened during the operation
@@ -574,10 +777,13 @@ func (c *Client) deleteInstances(ctx context.Context, filters []ec2types.Filter,
 		terminateParams := &ec2.TerminateInstancesInput{
 			InstanceIds: instances,
 		}
-		_, err = c.ec2Client.TerminateInstances(ctx, terminateParams, options)
+		op := provider.StartOperation(ctx, models.AWS, models.InstanceDelete, region)
+		out, err := c.ec2Client.TerminateInstances(ctx, terminateParams, options)
 		if err != nil {
+			op.Finish(ctx, strings.Join(instances, ","), requestIDFromError(err), err)
 			return false, fmt.Errorf("failed to terminate instances %v: %w", instances, err)
 		}
+		op.Finish(ctx, strings.Join(instances, ","), requestIDFromMetadata(out.ResultMetadata), nil)
 		return false, nil
 	}
 
@@ -615,10 +821,13 @@ func (c *Client) deleteVolumes(ctx context.Context, filters []ec2types.Filter, r
 			terminateParams := &ec2.DeleteVolumeInput{
 				VolumeId: utils.PointerTo(vol),
 			}
-			_, err = c.ec2Client.DeleteVolume(ctx, terminateParams, options)
+			op := provider.StartOperation(ctx, models.AWS, models.VolumeDelete, region)
+			out, err := c.ec2Client.DeleteVolume(ctx, terminateParams, options)
 			if err != nil {
+				op.Finish(ctx, vol, requestIDFromError(err), err)
 				return false, fmt.Errorf("failed to delete volume with %s id: %w", vol, err)
 			}
+			op.Finish(ctx, vol, requestIDFromMetadata(out.ResultMetadata), nil)
 		}
 		return false, nil
 	}
@@ -653,10 +862,13 @@ func (c *Client) deleteVolumeSnapshots(ctx context.Context, filters []ec2types.F
 		deleteParams := &ec2.DeleteSnapshotInput{
 			SnapshotId: utils.PointerTo(snap),
 		}
-		_, err = c.ec2Client.DeleteSnapshot(ctx, deleteParams, options)
+		op := provider.StartOperation(ctx, models.AWS, models.SnapshotDelete, region)
+		out, err := c.ec2Client.DeleteSnapshot(ctx, deleteParams, options)
 		if err != nil {
+			op.Finish(ctx, snap, requestIDFromError(err), err)
 			return false, fmt.Errorf("failed to delete volume snapshot with %s id: %w", snap, err)
 		}
+		op.Finish(ctx, snap, requestIDFromMetadata(out.ResultMetadata), nil)
 	}
 
 	return true, nil
@@ -665,8 +877,46 @@ func (c *Client) deleteVolumeSnapshots(ctx context.Context, filters []ec2types.F
 // RemoveTargetScan removes all the cloud resources associated with a Scan defined by config parameter.
 // The operation is idempotent, therefore it is safe to call it multiple times.
 // nolint:cyclop,gocognit
+// runImageScan creates the scanner instance for a container image target. No
+// volume is attached to it as the scanner CLI pulls the image itself.
+func (c *Client) runImageScan(ctx context.Context, config *provider.ScanJobConfig) error {
+	logger := log.GetLoggerFromContextOrDefault(ctx).WithFields(logrus.Fields{
+		"TargetImage":     config.InputImage,
+		"ScannerLocation": c.config.ScannerRegion,
+		"Provider":        string(c.Kind()),
+	})
+
+	network, err := c.scannerNetwork(ctx, config, nil)
+	if err != nil {
+		return WrapError(fmt.Errorf("failed to get scanner network: %w", err))
+	}
+
+	logger.Trace("Creating scanner VM instance")
+	scannerInstance, err := c.createInstance(ctx, c.config.ScannerRegion, network, config)
+	if err != nil {
+		return WrapError(fmt.Errorf("failed to create scanner VM instance: %w", err))
+	}
+
+	ready, err := scannerInstance.IsReady(ctx)
+	if err != nil {
+		return WrapError(fmt.Errorf("failed to get scanner VM instance state: %w", err))
+	}
+	logger.WithFields(logrus.Fields{
+		"ScannerInstanceID": scannerInstance.ID,
+	}).Debugf("Scanner instance is ready: %t", ready)
+	if !ready {
+		return RetryableError{
+			Err:        errors.New("scanner instance is not ready"),
+			After:      InstanceReadynessAfter,
+			InProgress: true,
+		}
+	}
+
+	return nil
+}
+
 func (c *Client) RemoveTargetScan(ctx context.Context, config *provider.ScanJobConfig) error {
-	vmInfo, err := config.TargetInfo.AsVMInfo()
+	vmInfo, err := config.AssetInfo.AsVMInfo()
 	if err != nil {
 		return FatalError{Err: err}
 	}
@@ -676,6 +926,27 @@ func (c *Client) RemoveTargetScan(ctx context.Context, config *provider.ScanJobC
 		"Provider":        string(c.Kind()),
 	})
 
+	target, err := c.targetClient(vmInfo)
+	if err != nil {
+		return err
+	}
+
+	// Keep the target volume snapshot for the next delta scan before the scan
+	// resources are removed, as it is tagged with the scan tags as well.
+	if config.DeltaScan && !c.config.DisableEBSDirectAPIs {
+		location, err := NewLocation(vmInfo.Location)
+		if err != nil {
+			return FatalError{
+				Err: fmt.Errorf("failed to parse Location string. Location=%s: %w", vmInfo.Location, err),
+			}
+		}
+
+		logger.WithField("TargetLocation", vmInfo.Location).Debug("Keeping target volume snapshot as delta scan baseline.")
+		if err = target.keepBaselineSnapshot(ctx, config.ScanMetadata, location.Region); err != nil {
+			return WrapError(fmt.Errorf("failed to keep target volume snapshot as baseline: %w", err))
+		}
+	}
+
 	ec2Tags := EC2TagsFromScanMetadata(config.ScanMetadata)
 	ec2Filters := EC2FiltersFromEC2Tags(ec2Tags)
 
@@ -697,8 +968,9 @@ func (c *Client) RemoveTargetScan(ctx context.Context, config *provider.ScanJobC
 		// Deleting scanner VM instance is in-progress, thus cannot proceed with deleting the scanner volume.
 		if !done {
 			errs <- RetryableError{
-				Err:   errors.New("deleting Scanner VM instance is in-progress"),
-				After: InstanceReadynessAfter,
+				Err:        errors.New("deleting Scanner VM instance is in-progress"),
+				After:      InstanceReadynessAfter,
+				InProgress: true,
 			}
 			return
 		}
@@ -714,8 +986,9 @@ func (c *Client) RemoveTargetScan(ctx context.Context, config *provider.ScanJobC
 
 		if !done {
 			errs <- RetryableError{
-				Err:   errors.New("deleting Scanner volume is in-progress"),
-				After: VolumeReadynessAfter,
+				Err:        errors.New("deleting Scanner volume is in-progress"),
+				After:      VolumeReadynessAfter,
+				InProgress: true,
 			}
 			return
 		}
@@ -734,8 +1007,9 @@ func (c *Client) RemoveTargetScan(ctx context.Context, config *provider.ScanJobC
 		}
 		if !done {
 			errs <- RetryableError{
-				Err:   errors.New("deleting Scanner volume snapshot is in-progress"),
-				After: SnapshotReadynessAfter,
+				Err:        errors.New("deleting Scanner volume snapshot is in-progress"),
+				After:      SnapshotReadynessAfter,
+				InProgress: true,
 			}
 			return
 		}
@@ -746,6 +1020,11 @@ func (c *Client) RemoveTargetScan(ctx context.Context, config *provider.ScanJobC
 	go func() {
 		defer wg.Done()
 
+		// Container image targets are pulled by the scanner, there is no target volume snapshot.
+		if config.InputImage != "" {
+			return
+		}
+
 		location, err := NewLocation(vmInfo.Location)
 		if err != nil {
 			errs <- FatalError{
@@ -754,12 +1033,14 @@ func (c *Client) RemoveTargetScan(ctx context.Context, config *provider.ScanJobC
 			return
 		}
 
-		if location.Region == c.config.ScannerRegion {
+		// The target volume snapshot is the scanner volume snapshot if it
+		// was not copied to another region or account.
+		if location.Region == c.config.ScannerRegion && target == c {
 			return
 		}
 
 		logger.WithField("TargetLocation", vmInfo.Location).Debug("Deleting target volume snapshot.")
-		done, err := c.deleteVolumeSnapshots(ctx, ec2Filters, location.Region)
+		done, err := target.deleteVolumeSnapshots(ctx, ec2Filters, location.Region)
 		if err != nil {
 			errs <- fmt.Errorf("failed to delete target volume snapshot: %w", err)
 			return
@@ -767,8 +1048,9 @@ func (c *Client) RemoveTargetScan(ctx context.Context, config *provider.ScanJobC
 
 		if !done {
 			errs <- RetryableError{
-				Err:   errors.New("deleting Target volume snapshot is in-progress"),
-				After: SnapshotReadynessAfter,
+				Err:        errors.New("deleting Target volume snapshot is in-progress"),
+				After:      SnapshotReadynessAfter,
+				InProgress: true,
 			}
 			return
 		}
@@ -799,7 +1081,7 @@ func (c *Client) GetInstances(ctx context.Context, filters []ec2types.Filter, ex
 	if err != nil {
 		return nil, fmt.Errorf("failed to describe instances: %v", err)
 	}
-	ret = append(ret, c.getInstancesFromDescribeInstancesOutput(ctx, out, excludeTags, regionID)...)
+	ret = append(ret, c.getInstancesWithRootVolumeInfo(ctx, out, excludeTags, regionID)...)
 
 	// use pagination
 	// TODO we can make it better by not saving all results in memory. See https://github.com/openclarity/vmclarity/pull/3#discussion_r1021656861
@@ -814,10 +1096,58 @@ func (c *Client) GetInstances(ctx context.Context, filters []ec2types.Filter, ex
 		if err != nil {
 			return nil, fmt.Errorf("failed to describe instances: %v", err)
 		}
-		ret = append(ret, c.getInstancesFromDescribeInstancesOutput(ctx, out, excludeTags, regionID)...)
+		ret = append(ret, c.getInstancesWithRootVolumeInfo(ctx, out, excludeTags, regionID)...)
 	}
 
-	return ret, nil
+	return c.getInstancesWithSecurityGroupRules(ctx, ret, regionID), nil
+}
+
+// getInstancesWithRootVolumeInfo returns the instances from the output with
+// the size of their root volumes, which is used to skip scanning targets with
+// large volumes. Failing to get the volumes is not fatal for the discovery.
+func (c *Client) getInstancesWithRootVolumeInfo(ctx context.Context, result *ec2.DescribeInstancesOutput, excludeTags []models.Tag, regionID string) []Instance {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	instances := c.getInstancesFromDescribeInstancesOutput(ctx, result, excludeTags, regionID)
+
+	rootVolumeIDs := getRootVolumeIDs(result)
+	if len(rootVolumeIDs) == 0 {
+		return instances
+	}
+
+	volumeIDs := make([]string, 0, len(rootVolumeIDs))
+	for _, volumeID := range rootVolumeIDs {
+		volumeIDs = append(volumeIDs, volumeID)
+	}
+	out, err := c.ec2Client.DescribeVolumes(ctx, &ec2.DescribeVolumesInput{
+		VolumeIds: volumeIDs,
+	}, func(options *ec2.Options) {
+		options.Region = regionID
+	})
+	if err != nil {
+		logger.Warnf("Failed to describe root volumes of instances in region %s: %v", regionID, err)
+		return instances
+	}
+
+	volumes := make(map[string]ec2types.Volume, len(out.Volumes))
+	for _, volume := range out.Volumes {
+		if volume.VolumeId != nil {
+			volumes[*volume.VolumeId] = volume
+		}
+	}
+
+	for i := range instances {
+		volume, ok := volumes[rootVolumeIDs[instances[i].ID]]
+		if !ok {
+			continue
+		}
+		if volume.Size != nil {
+			instances[i].RootVolumeSizeGB = utils.PointerTo(int(*volume.Size))
+		}
+		instances[i].RootVolumeEncrypted = volume.Encrypted
+	}
+
+	return instances
 }
 
 func (c *Client) getInstancesFromDescribeInstancesOutput(ctx context.Context, result *ec2.DescribeInstancesOutput, excludeTags []models.Tag, regionID string) []Instance {
@@ -844,6 +1174,7 @@ func (c *Client) getInstancesFromDescribeInstancesOutput(ctx context.Context, re
 				LaunchTime:       *instance.LaunchTime,
 				VpcID:            *instance.VpcId,
 				SecurityGroups:   getSecurityGroupsIDs(instance.SecurityGroups),
+				AccountID:        c.accountID,
 
 				ec2Client: c.ec2Client,
 			})
@@ -857,7 +1188,22 @@ func (c *Client) getRegionsToScan(ctx context.Context, scope *ScanScope) ([]Regi
 		return c.ListAllRegions(ctx, false)
 	}
 
-	return scope.Regions, nil
+	return filterRegionsInPartition(ctx, scope.Regions, c.config.GetPartition()), nil
+}
+
+// filterRegionsInPartition drops the regions which can't be reached from the partition.
+func filterRegionsInPartition(ctx context.Context, regions []Region, partition Partition) []Region {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	ret := make([]Region, 0, len(regions))
+	for _, region := range regions {
+		if !partition.Contains(region.Name) {
+			logger.Warnf("Skipping region which is not in partition %s. Region=%s", partition, region.Name)
+			continue
+		}
+		ret = append(ret, region)
+	}
+	return ret
 }
 
 func (c *Client) ListAllRegions(ctx context.Context, isRecursive bool) ([]Region, error) {
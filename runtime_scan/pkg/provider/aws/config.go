@@ -19,12 +19,17 @@ import (
 	"fmt"
 
 	"github.com/spf13/viper"
+
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
 )
 
 const (
 	DefaultEnvPrefix           = "VMCLARITY_AWS"
 	DefaultScannerInstanceType = "t2.large"
 	DefaultBlockDeviceName     = "xvdh"
+	// DefaultScannerImageNamePrefix is the name prefix of the published
+	// Scanner AMIs, the name ends with the scanner version.
+	DefaultScannerImageNamePrefix = "vmclarity-scanner-"
 )
 
 type Config struct {
@@ -36,12 +41,40 @@ type Config struct {
 	SecurityGroupID string `mapstructure:"security_group_id"`
 	// KeyPairName is the name of the SSH KeyPair to use for Scanner instance launch
 	KeyPairName string `mapstructure:"keypair_name"`
-	// ScannerImage is the AMI image used for creating Scanner instance
+	// ScannerImage is the AMI image used for creating Scanner instance,
+	// the latest AMI of ScannerImageChannel is used if not provided
 	ScannerImage string `mapstructure:"scanner_ami_id"`
+	// ScannerImageOwner is the AWS account publishing the Scanner AMIs
+	ScannerImageOwner string `mapstructure:"scanner_ami_owner"`
+	// ScannerImageNamePrefix is the name prefix of the Scanner AMIs
+	ScannerImageNamePrefix string `mapstructure:"scanner_ami_name_prefix"`
+	// ScannerImageChannel is the release channel (stable or candidate) the
+	// Scanner AMI is resolved from
+	ScannerImageChannel string `mapstructure:"scanner_ami_channel"`
 	// ScannerInstanceType is the instance type used for Scanner instance
 	ScannerInstanceType string `mapstructure:"scanner_instance_type"`
 	// BlockDeviceName contains the block device name used for attaching Scanner volume to the Scanner instance
 	BlockDeviceName string `mapstructure:"block_device_name"`
+	// Partition is the AWS partition of the deployment (aws, aws-cn or aws-us-gov),
+	// it is detected from ScannerRegion if not provided
+	Partition string `mapstructure:"partition"`
+	// UseFIPSEndpoint makes the clients use the FIPS endpoints of the AWS services
+	UseFIPSEndpoint bool `mapstructure:"use_fips_endpoint"`
+	// DisableSpotInstances makes the Scanner instances run on-demand even if
+	// spot instances are requested, for partitions or accounts without spot capacity
+	DisableSpotInstances bool `mapstructure:"disable_spot_instances"`
+	// DisableSnapshotCopy disables copying snapshots between regions, targets
+	// which are not in ScannerRegion can't be scanned then
+	DisableSnapshotCopy bool `mapstructure:"disable_snapshot_copy"`
+	// DisableEBSDirectAPIs disables delta scanning which relies on the EBS direct APIs
+	DisableEBSDirectAPIs bool `mapstructure:"disable_ebs_direct_apis"`
+	// OrganizationRoleName is the name of the role assumed in the member accounts
+	// of the AWS organization to discover and scan their instances, only the
+	// account of the credentials is scanned if not provided
+	OrganizationRoleName string `mapstructure:"organization_role_name"`
+	// OrganizationRoleExternalID is the external ID required by the trust policy
+	// of the role assumed in the member accounts
+	OrganizationRoleExternalID string `mapstructure:"organization_role_external_id"`
 }
 
 func (c *Config) Validate() error {
@@ -57,17 +90,43 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("parameter SecurityGroupID must be provided")
 	}
 
-	if c.ScannerImage == "" {
-		return fmt.Errorf("parameter ScannerImage must be provided")
+	if c.ScannerImage == "" && c.ScannerImageOwner == "" {
+		return fmt.Errorf("parameter ScannerImage or ScannerImageOwner must be provided")
+	}
+
+	if _, err := provider.NewScannerImageChannel(c.ScannerImageChannel); err != nil {
+		return fmt.Errorf("parameter ScannerImageChannel is invalid: %w", err)
 	}
 
 	if c.ScannerInstanceType == "" {
 		return fmt.Errorf("parameter ScannerInstanceType must be provided")
 	}
 
+	if c.Partition != "" {
+		partition, err := NewPartition(c.Partition)
+		if err != nil {
+			return fmt.Errorf("parameter Partition is invalid: %w", err)
+		}
+		if !partition.Contains(c.ScannerRegion) {
+			return fmt.Errorf("parameter ScannerRegion %s is not in partition %s", c.ScannerRegion, partition)
+		}
+	}
+
+	if c.OrganizationRoleExternalID != "" && c.OrganizationRoleName == "" {
+		return fmt.Errorf("parameter OrganizationRoleName must be provided with OrganizationRoleExternalID")
+	}
+
 	return nil
 }
 
+// GetPartition returns the configured partition or the partition of ScannerRegion.
+func (c *Config) GetPartition() Partition {
+	if c.Partition != "" {
+		return Partition(c.Partition)
+	}
+	return PartitionForRegion(c.ScannerRegion)
+}
+
 func NewConfig() (*Config, error) {
 	// Avoid modifying the global instance
 	v := viper.New()
@@ -81,6 +140,13 @@ func NewConfig() (*Config, error) {
 	_ = v.BindEnv("security_group_id")
 	_ = v.BindEnv("keypair_name")
 	_ = v.BindEnv("scanner_ami_id")
+	_ = v.BindEnv("scanner_ami_owner")
+
+	_ = v.BindEnv("scanner_ami_name_prefix")
+	v.SetDefault("scanner_ami_name_prefix", DefaultScannerImageNamePrefix)
+
+	_ = v.BindEnv("scanner_ami_channel")
+	v.SetDefault("scanner_ami_channel", string(provider.DefaultScannerImageChannel))
 
 	_ = v.BindEnv("scanner_instance_type")
 	v.SetDefault("scanner_instance_type", DefaultScannerInstanceType)
@@ -88,6 +154,14 @@ func NewConfig() (*Config, error) {
 	_ = v.BindEnv("block_device_name")
 	v.SetDefault("block_device_name", DefaultBlockDeviceName)
 
+	_ = v.BindEnv("partition")
+	_ = v.BindEnv("use_fips_endpoint")
+	_ = v.BindEnv("disable_spot_instances")
+	_ = v.BindEnv("disable_snapshot_copy")
+	_ = v.BindEnv("disable_ebs_direct_apis")
+	_ = v.BindEnv("organization_role_name")
+	_ = v.BindEnv("organization_role_external_id")
+
 	config := &Config{}
 	if err := v.Unmarshal(config); err != nil {
 		return nil, fmt.Errorf("failed to parse provider configuration. Provider=AWS: %w", err)
@@ -21,6 +21,8 @@ import (
 
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
+
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
 )
 
 func TestConfig(t *testing.T) {
@@ -45,16 +47,183 @@ func TestConfig(t *testing.T) {
 			},
 			ExpectedNewErrorMatcher: Not(HaveOccurred()),
 			ExpectedConfig: &Config{
-				ScannerRegion:       "eu-west-1",
-				SubnetID:            "subnet-038f85dc621fd5b5d",
-				SecurityGroupID:     "sg-02cfdc854e18664d4",
-				KeyPairName:         "vmclarity-ssh-key",
-				ScannerImage:        "ami-0568773882d492fc8",
-				ScannerInstanceType: "t3.large",
-				BlockDeviceName:     "xvdh",
+				ScannerRegion:          "eu-west-1",
+				SubnetID:               "subnet-038f85dc621fd5b5d",
+				SecurityGroupID:        "sg-02cfdc854e18664d4",
+				KeyPairName:            "vmclarity-ssh-key",
+				ScannerImage:           "ami-0568773882d492fc8",
+				ScannerImageNamePrefix: DefaultScannerImageNamePrefix,
+				ScannerImageChannel:    string(provider.DefaultScannerImageChannel),
+				ScannerInstanceType:    "t3.large",
+				BlockDeviceName:        "xvdh",
+			},
+			ExpectedValidateErrorMatcher: Not(HaveOccurred()),
+		},
+		{
+			Name: "Valid GovCloud config",
+			EnvVars: map[string]string{
+				"VMCLARITY_AWS_SCANNER_REGION":          "us-gov-west-1",
+				"VMCLARITY_AWS_SUBNET_ID":               "subnet-038f85dc621fd5b5d",
+				"VMCLARITY_AWS_SECURITY_GROUP_ID":       "sg-02cfdc854e18664d4",
+				"VMCLARITY_AWS_SCANNER_AMI_ID":          "ami-0568773882d492fc8",
+				"VMCLARITY_AWS_PARTITION":               "aws-us-gov",
+				"VMCLARITY_AWS_USE_FIPS_ENDPOINT":       "true",
+				"VMCLARITY_AWS_DISABLE_SPOT_INSTANCES":  "true",
+				"VMCLARITY_AWS_DISABLE_SNAPSHOT_COPY":   "true",
+				"VMCLARITY_AWS_DISABLE_EBS_DIRECT_APIS": "true",
+			},
+			ExpectedNewErrorMatcher: Not(HaveOccurred()),
+			ExpectedConfig: &Config{
+				ScannerRegion:          "us-gov-west-1",
+				SubnetID:               "subnet-038f85dc621fd5b5d",
+				SecurityGroupID:        "sg-02cfdc854e18664d4",
+				ScannerImage:           "ami-0568773882d492fc8",
+				ScannerImageNamePrefix: DefaultScannerImageNamePrefix,
+				ScannerImageChannel:    string(provider.DefaultScannerImageChannel),
+				ScannerInstanceType:    DefaultScannerInstanceType,
+				BlockDeviceName:        DefaultBlockDeviceName,
+				Partition:              "aws-us-gov",
+				UseFIPSEndpoint:        true,
+				DisableSpotInstances:   true,
+				DisableSnapshotCopy:    true,
+				DisableEBSDirectAPIs:   true,
 			},
 			ExpectedValidateErrorMatcher: Not(HaveOccurred()),
 		},
+		{
+			Name: "Scanner region not in partition",
+			EnvVars: map[string]string{
+				"VMCLARITY_AWS_SCANNER_REGION":    "eu-west-1",
+				"VMCLARITY_AWS_SUBNET_ID":         "subnet-038f85dc621fd5b5d",
+				"VMCLARITY_AWS_SECURITY_GROUP_ID": "sg-02cfdc854e18664d4",
+				"VMCLARITY_AWS_SCANNER_AMI_ID":    "ami-0568773882d492fc8",
+				"VMCLARITY_AWS_PARTITION":         "aws-cn",
+			},
+			ExpectedNewErrorMatcher: Not(HaveOccurred()),
+			ExpectedConfig: &Config{
+				ScannerRegion:          "eu-west-1",
+				SubnetID:               "subnet-038f85dc621fd5b5d",
+				SecurityGroupID:        "sg-02cfdc854e18664d4",
+				ScannerImage:           "ami-0568773882d492fc8",
+				ScannerImageNamePrefix: DefaultScannerImageNamePrefix,
+				ScannerImageChannel:    string(provider.DefaultScannerImageChannel),
+				ScannerInstanceType:    DefaultScannerInstanceType,
+				BlockDeviceName:        DefaultBlockDeviceName,
+				Partition:              "aws-cn",
+			},
+			ExpectedValidateErrorMatcher: HaveOccurred(),
+		},
+		{
+			Name: "Valid config with resolved scanner image",
+			EnvVars: map[string]string{
+				"VMCLARITY_AWS_SCANNER_REGION":      "eu-west-1",
+				"VMCLARITY_AWS_SUBNET_ID":           "subnet-038f85dc621fd5b5d",
+				"VMCLARITY_AWS_SECURITY_GROUP_ID":   "sg-02cfdc854e18664d4",
+				"VMCLARITY_AWS_SCANNER_AMI_OWNER":   "123456789012",
+				"VMCLARITY_AWS_SCANNER_AMI_CHANNEL": "candidate",
+			},
+			ExpectedNewErrorMatcher: Not(HaveOccurred()),
+			ExpectedConfig: &Config{
+				ScannerRegion:          "eu-west-1",
+				SubnetID:               "subnet-038f85dc621fd5b5d",
+				SecurityGroupID:        "sg-02cfdc854e18664d4",
+				ScannerImageOwner:      "123456789012",
+				ScannerImageNamePrefix: DefaultScannerImageNamePrefix,
+				ScannerImageChannel:    "candidate",
+				ScannerInstanceType:    DefaultScannerInstanceType,
+				BlockDeviceName:        DefaultBlockDeviceName,
+			},
+			ExpectedValidateErrorMatcher: Not(HaveOccurred()),
+		},
+		{
+			Name: "Invalid scanner image channel",
+			EnvVars: map[string]string{
+				"VMCLARITY_AWS_SCANNER_REGION":      "eu-west-1",
+				"VMCLARITY_AWS_SUBNET_ID":           "subnet-038f85dc621fd5b5d",
+				"VMCLARITY_AWS_SECURITY_GROUP_ID":   "sg-02cfdc854e18664d4",
+				"VMCLARITY_AWS_SCANNER_AMI_OWNER":   "123456789012",
+				"VMCLARITY_AWS_SCANNER_AMI_CHANNEL": "nightly",
+			},
+			ExpectedNewErrorMatcher: Not(HaveOccurred()),
+			ExpectedConfig: &Config{
+				ScannerRegion:          "eu-west-1",
+				SubnetID:               "subnet-038f85dc621fd5b5d",
+				SecurityGroupID:        "sg-02cfdc854e18664d4",
+				ScannerImageOwner:      "123456789012",
+				ScannerImageNamePrefix: DefaultScannerImageNamePrefix,
+				ScannerImageChannel:    "nightly",
+				ScannerInstanceType:    DefaultScannerInstanceType,
+				BlockDeviceName:        DefaultBlockDeviceName,
+			},
+			ExpectedValidateErrorMatcher: HaveOccurred(),
+		},
+		{
+			Name: "Valid organization config",
+			EnvVars: map[string]string{
+				"VMCLARITY_AWS_SCANNER_REGION":                "eu-west-1",
+				"VMCLARITY_AWS_SUBNET_ID":                     "subnet-038f85dc621fd5b5d",
+				"VMCLARITY_AWS_SECURITY_GROUP_ID":             "sg-02cfdc854e18664d4",
+				"VMCLARITY_AWS_SCANNER_AMI_ID":                "ami-0568773882d492fc8",
+				"VMCLARITY_AWS_ORGANIZATION_ROLE_NAME":        "VMClarityMemberRole",
+				"VMCLARITY_AWS_ORGANIZATION_ROLE_EXTERNAL_ID": "vmclarity",
+			},
+			ExpectedNewErrorMatcher: Not(HaveOccurred()),
+			ExpectedConfig: &Config{
+				ScannerRegion:              "eu-west-1",
+				SubnetID:                   "subnet-038f85dc621fd5b5d",
+				SecurityGroupID:            "sg-02cfdc854e18664d4",
+				ScannerImage:               "ami-0568773882d492fc8",
+				ScannerImageNamePrefix:     DefaultScannerImageNamePrefix,
+				ScannerImageChannel:        string(provider.DefaultScannerImageChannel),
+				ScannerInstanceType:        DefaultScannerInstanceType,
+				BlockDeviceName:            DefaultBlockDeviceName,
+				OrganizationRoleName:       "VMClarityMemberRole",
+				OrganizationRoleExternalID: "vmclarity",
+			},
+			ExpectedValidateErrorMatcher: Not(HaveOccurred()),
+		},
+		{
+			Name: "Organization role external ID without role name",
+			EnvVars: map[string]string{
+				"VMCLARITY_AWS_SCANNER_REGION":                "eu-west-1",
+				"VMCLARITY_AWS_SUBNET_ID":                     "subnet-038f85dc621fd5b5d",
+				"VMCLARITY_AWS_SECURITY_GROUP_ID":             "sg-02cfdc854e18664d4",
+				"VMCLARITY_AWS_SCANNER_AMI_ID":                "ami-0568773882d492fc8",
+				"VMCLARITY_AWS_ORGANIZATION_ROLE_EXTERNAL_ID": "vmclarity",
+			},
+			ExpectedNewErrorMatcher: Not(HaveOccurred()),
+			ExpectedConfig: &Config{
+				ScannerRegion:              "eu-west-1",
+				SubnetID:                   "subnet-038f85dc621fd5b5d",
+				SecurityGroupID:            "sg-02cfdc854e18664d4",
+				ScannerImage:               "ami-0568773882d492fc8",
+				ScannerImageNamePrefix:     DefaultScannerImageNamePrefix,
+				ScannerImageChannel:        string(provider.DefaultScannerImageChannel),
+				ScannerInstanceType:        DefaultScannerInstanceType,
+				BlockDeviceName:            DefaultBlockDeviceName,
+				OrganizationRoleExternalID: "vmclarity",
+			},
+			ExpectedValidateErrorMatcher: HaveOccurred(),
+		},
+		{
+			Name: "Missing scanner image",
+			EnvVars: map[string]string{
+				"VMCLARITY_AWS_SCANNER_REGION":    "eu-west-1",
+				"VMCLARITY_AWS_SUBNET_ID":         "subnet-038f85dc621fd5b5d",
+				"VMCLARITY_AWS_SECURITY_GROUP_ID": "sg-02cfdc854e18664d4",
+			},
+			ExpectedNewErrorMatcher: Not(HaveOccurred()),
+			ExpectedConfig: &Config{
+				ScannerRegion:          "eu-west-1",
+				SubnetID:               "subnet-038f85dc621fd5b5d",
+				SecurityGroupID:        "sg-02cfdc854e18664d4",
+				ScannerImageNamePrefix: DefaultScannerImageNamePrefix,
+				ScannerImageChannel:    string(provider.DefaultScannerImageChannel),
+				ScannerInstanceType:    DefaultScannerInstanceType,
+				BlockDeviceName:        DefaultBlockDeviceName,
+			},
+			ExpectedValidateErrorMatcher: HaveOccurred(),
+		},
 	}
 
 	for _, test := range tests {
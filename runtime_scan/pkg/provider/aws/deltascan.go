@@ -0,0 +1,296 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/service/ebs"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// MaxChangedBlockRanges is the maximum number of changed block ranges reported
+// for a delta scan. Volumes with more changes are scanned in full.
+const MaxChangedBlockRanges = 10000
+
+// GetDeltaScanInfo compares the target volume snapshot created for the scan
+// with the baseline snapshot kept from the previous successful scan of the
+// same volume using the EBS direct APIs.
+func (c *Client) GetDeltaScanInfo(ctx context.Context, config *provider.ScanJobConfig) (*models.DeltaScanInfo, error) {
+	if c.config.DisableEBSDirectAPIs {
+		return nil, nil
+	}
+
+	vmInfo, err := config.AssetInfo.AsVMInfo()
+	if err != nil {
+		return nil, FatalError{Err: err}
+	}
+
+	location, err := NewLocation(vmInfo.Location)
+	if err != nil {
+		return nil, FatalError{
+			Err: fmt.Errorf("failed to parse Location string. Location=%s: %w", vmInfo.Location, err),
+		}
+	}
+
+	logger := log.GetLoggerFromContextOrDiscard(ctx).WithFields(logrus.Fields{
+		"TargetInstanceID": vmInfo.InstanceID,
+		"TargetLocation":   vmInfo.Location,
+		"Provider":         string(c.Kind()),
+	})
+
+	// The target volume snapshots are in the account of the target.
+	target, err := c.targetClient(vmInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := target.getTargetVolumeSnapshot(ctx, config.ScanMetadata, location.Region)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, fmt.Errorf("failed to find target volume snapshot. ScanResultID=%s", config.ScanResultID)
+	}
+
+	baseline, err := target.getBaselineSnapshot(ctx, *snapshot.VolumeId, location.Region)
+	if err != nil {
+		return nil, err
+	}
+	if baseline == nil {
+		logger.WithField("TargetVolumeID", *snapshot.VolumeId).Debug("No baseline snapshot found for target volume")
+		return nil, nil
+	}
+
+	changedBlocks, err := target.listChangedBlocks(ctx, *baseline.SnapshotId, *snapshot.SnapshotId, location.Region)
+	if err != nil {
+		return nil, err
+	}
+	if len(changedBlocks) > MaxChangedBlockRanges {
+		logger.WithField("TargetVolumeID", *snapshot.VolumeId).Infof(
+			"Too many changed block ranges for delta scan: %d", len(changedBlocks))
+		return nil, nil
+	}
+
+	return &models.DeltaScanInfo{
+		BaseScanResultID: getTagValue(baseline.Tags, EC2TagKeyDeltaScanBaseline),
+		ChangedBlocks:    &changedBlocks,
+	}, nil
+}
+
+// getTargetVolumeSnapshot returns the completed snapshot of the target volume
+// created for the scan, or nil if there is none.
+func (c *Client) getTargetVolumeSnapshot(ctx context.Context, meta provider.ScanMetadata, region string) (*ec2types.Snapshot, error) {
+	out, err := c.ec2Client.DescribeSnapshots(ctx, &ec2.DescribeSnapshotsInput{
+		Filters: EC2FiltersFromEC2Tags(EC2TagsFromScanMetadata(meta)),
+	}, func(options *ec2.Options) {
+		options.Region = region
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch target volume snapshot. ScanResultID=%s: %w", meta.ScanResultID, err)
+	}
+
+	for _, snap := range out.Snapshots {
+		if snap.SnapshotId != nil && snap.VolumeId != nil && snap.State == ec2types.SnapshotStateCompleted {
+			snap := snap
+			return &snap, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// getBaselineSnapshot returns the latest completed baseline snapshot of the
+// volume, or nil if there is none.
+func (c *Client) getBaselineSnapshot(ctx context.Context, volumeID string, region string) (*ec2types.Snapshot, error) {
+	snapshots, err := c.getBaselineSnapshots(ctx, volumeID, region)
+	if err != nil {
+		return nil, err
+	}
+
+	var baseline *ec2types.Snapshot
+	for i, snap := range snapshots {
+		if snap.SnapshotId == nil || snap.State != ec2types.SnapshotStateCompleted || snap.StartTime == nil {
+			continue
+		}
+		if baseline == nil || snap.StartTime.After(*baseline.StartTime) {
+			baseline = &snapshots[i]
+		}
+	}
+
+	return baseline, nil
+}
+
+func (c *Client) getBaselineSnapshots(ctx context.Context, volumeID string, region string) ([]ec2types.Snapshot, error) {
+	out, err := c.ec2Client.DescribeSnapshots(ctx, &ec2.DescribeSnapshotsInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   utils.PointerTo("tag:" + EC2TagKeyTargetVolumeID),
+				Values: []string{volumeID},
+			},
+			{
+				Name:   utils.PointerTo(TagKeyFilterName),
+				Values: []string{EC2TagKeyDeltaScanBaseline},
+			},
+		},
+	}, func(options *ec2.Options) {
+		options.Region = region
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch baseline snapshots. VolumeID=%s: %w", volumeID, err)
+	}
+
+	return out.Snapshots, nil
+}
+
+// keepBaselineSnapshot keeps the target volume snapshot created for the scan as
+// the baseline of the next delta scan of the volume by replacing its scan tags
+// with the baseline tag, so that it is not removed with the other resources of
+// the scan. The previous baseline snapshots of the volume are deleted.
+func (c *Client) keepBaselineSnapshot(ctx context.Context, meta provider.ScanMetadata, region string) error {
+	snapshot, err := c.getTargetVolumeSnapshot(ctx, meta, region)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return nil
+	}
+
+	options := func(options *ec2.Options) {
+		options.Region = region
+	}
+
+	previous, err := c.getBaselineSnapshots(ctx, *snapshot.VolumeId, region)
+	if err != nil {
+		return err
+	}
+	for _, snap := range previous {
+		if snap.SnapshotId == nil || *snap.SnapshotId == *snapshot.SnapshotId {
+			continue
+		}
+		op := provider.StartOperation(ctx, models.AWS, models.SnapshotDelete, region)
+		out, err := c.ec2Client.DeleteSnapshot(ctx, &ec2.DeleteSnapshotInput{
+			SnapshotId: snap.SnapshotId,
+		}, options)
+		if err != nil {
+			op.Finish(ctx, *snap.SnapshotId, requestIDFromError(err), err)
+			return fmt.Errorf("failed to delete previous baseline snapshot. SnapshotID=%s: %w", *snap.SnapshotId, err)
+		}
+		op.Finish(ctx, *snap.SnapshotId, requestIDFromMetadata(out.ResultMetadata), nil)
+	}
+
+	_, err = c.ec2Client.CreateTags(ctx, &ec2.CreateTagsInput{
+		Resources: []string{*snapshot.SnapshotId},
+		Tags: []ec2types.Tag{
+			{
+				Key:   utils.PointerTo(EC2TagKeyDeltaScanBaseline),
+				Value: utils.PointerTo(meta.ScanResultID),
+			},
+		},
+	}, options)
+	if err != nil {
+		return fmt.Errorf("failed to tag baseline snapshot. SnapshotID=%s: %w", *snapshot.SnapshotId, err)
+	}
+
+	_, err = c.ec2Client.DeleteTags(ctx, &ec2.DeleteTagsInput{
+		Resources: []string{*snapshot.SnapshotId},
+		Tags: []ec2types.Tag{
+			{Key: utils.PointerTo(EC2TagKeyScanID)},
+			{Key: utils.PointerTo(EC2TagKeyScanResultID)},
+		},
+	}, options)
+	if err != nil {
+		return fmt.Errorf("failed to remove scan tags from baseline snapshot. SnapshotID=%s: %w", *snapshot.SnapshotId, err)
+	}
+
+	return nil
+}
+
+func (c *Client) listChangedBlocks(ctx context.Context, firstSnapshotID, secondSnapshotID string, region string) ([]models.ChangedBlockRange, error) {
+	var blockSize int64
+	var indexes []int32
+
+	paginator := ebs.NewListChangedBlocksPaginator(c.ebsClient, &ebs.ListChangedBlocksInput{
+		FirstSnapshotId:  &firstSnapshotID,
+		SecondSnapshotId: &secondSnapshotID,
+	})
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx, func(options *ebs.Options) {
+			options.Region = region
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list changed blocks. FirstSnapshotID=%s SecondSnapshotID=%s: %w",
+				firstSnapshotID, secondSnapshotID, err)
+		}
+		if out.BlockSize != nil {
+			blockSize = int64(*out.BlockSize)
+		}
+		for _, block := range out.ChangedBlocks {
+			if block.BlockIndex != nil {
+				indexes = append(indexes, *block.BlockIndex)
+			}
+		}
+	}
+
+	return changedBlockRanges(indexes, blockSize), nil
+}
+
+// changedBlockRanges merges the indexes of the changed blocks into ranges of
+// consecutive changed bytes.
+func changedBlockRanges(indexes []int32, blockSize int64) []models.ChangedBlockRange {
+	sort.Slice(indexes, func(i, j int) bool {
+		return indexes[i] < indexes[j]
+	})
+
+	ranges := []models.ChangedBlockRange{}
+	for _, index := range indexes {
+		offset := int64(index) * blockSize
+		if last := len(ranges) - 1; last >= 0 {
+			end := ranges[last].Offset + ranges[last].Length
+			if offset < end {
+				continue
+			}
+			if offset == end {
+				ranges[last].Length += blockSize
+				continue
+			}
+		}
+		ranges = append(ranges, models.ChangedBlockRange{
+			Offset: offset,
+			Length: blockSize,
+		})
+	}
+
+	return ranges
+}
+
+func getTagValue(tags []ec2types.Tag, key string) *string {
+	for _, tag := range tags {
+		if tag.Key != nil && *tag.Key == key {
+			return tag.Value
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/openclarity/vmclarity/api/models"
+)
+
+func TestChangedBlockRanges(t *testing.T) {
+	const blockSize = 512 * 1024
+
+	tests := []struct {
+		Name           string
+		Indexes        []int32
+		ExpectedRanges []models.ChangedBlockRange
+	}{
+		{
+			Name:           "No changed blocks",
+			Indexes:        nil,
+			ExpectedRanges: []models.ChangedBlockRange{},
+		},
+		{
+			Name:    "Consecutive blocks are merged",
+			Indexes: []int32{3, 1, 2, 7},
+			ExpectedRanges: []models.ChangedBlockRange{
+				{Offset: 1 * blockSize, Length: 3 * blockSize},
+				{Offset: 7 * blockSize, Length: blockSize},
+			},
+		},
+		{
+			Name:    "Duplicate blocks are ignored",
+			Indexes: []int32{0, 0, 1},
+			ExpectedRanges: []models.ChangedBlockRange{
+				{Offset: 0, Length: 2 * blockSize},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			ranges := changedBlockRanges(test.Indexes, blockSize)
+			g.Expect(ranges).Should(BeEquivalentTo(test.ExpectedRanges))
+		})
+	}
+}
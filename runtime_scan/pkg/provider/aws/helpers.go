@@ -16,11 +16,15 @@
 package aws
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go/middleware"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/openclarity/vmclarity/api/models"
@@ -152,7 +156,13 @@ func convertFromAPIScanScope(scope *models.AwsScanScope) *ScanScope {
 		excludeTags = *scope.InstanceTagExclusion
 	}
 
+	var accountIDs []string
+	if scope.AccountIDs != nil {
+		accountIDs = *scope.AccountIDs
+	}
+
 	return &ScanScope{
+		AccountIDs:  accountIDs,
 		AllRegions:  convertBool(scope.AllRegions),
 		Regions:     convertFromAPIRegions(scope.Regions),
 		ScanStopped: convertBool(scope.ShouldScanStoppedInstances),
@@ -414,17 +424,55 @@ func hasExcludeTags(excludeTags []models.Tag, instanceTags []ec2types.Tag) bool
 	return true
 }
 
-func getVMInfoFromInstance(i Instance) (models.TargetType, error) {
-	targetType := models.TargetType{}
+// getRootVolumeIDs returns the ID of the root EBS volume for every instance
+// in the output, keyed by instance ID.
+func getRootVolumeIDs(result *ec2.DescribeInstancesOutput) map[string]string {
+	ret := map[string]string{}
+	for _, reservation := range result.Reservations {
+		for _, instance := range reservation.Instances {
+			if instance.InstanceId == nil || instance.RootDeviceName == nil {
+				continue
+			}
+			for _, mapping := range instance.BlockDeviceMappings {
+				if mapping.DeviceName == nil || *mapping.DeviceName != *instance.RootDeviceName {
+					continue
+				}
+				if mapping.Ebs != nil && mapping.Ebs.VolumeId != nil {
+					ret[*instance.InstanceId] = *mapping.Ebs.VolumeId
+				}
+			}
+		}
+	}
+	return ret
+}
+
+func getVMInfoFromInstance(i Instance) (models.AssetType, error) {
+	var rootVolume *models.RootVolume
+	if i.RootVolumeSizeGB != nil {
+		rootVolume = &models.RootVolume{
+			SizeGB:    i.RootVolumeSizeGB,
+			Encrypted: i.RootVolumeEncrypted,
+		}
+	}
+
+	var accountID *string
+	if i.AccountID != "" {
+		accountID = utils.PointerTo(i.AccountID)
+	}
+
+	targetType := models.AssetType{}
 	err := targetType.FromVMInfo(models.VMInfo{
+		AccountID:        accountID,
 		Image:            i.Image,
 		InstanceID:       i.ID,
 		InstanceProvider: utils.PointerTo(models.AWS),
 		InstanceType:     i.InstanceType,
 		LaunchTime:       i.LaunchTime,
 		Location:         i.Location(),
+		LocationMetadata: newLocationMetadata(i.Region, i.AvailabilityZone),
 		ObjectType:       "VMInfo",
 		Platform:         i.Platform,
+		RootVolume:       rootVolume,
 		SecurityGroups:   utils.PointerTo(i.SecurityGroups),
 		Tags:             utils.PointerTo(i.Tags),
 	})
@@ -434,3 +482,19 @@ func getVMInfoFromInstance(i Instance) (models.TargetType, error) {
 
 	return targetType, err
 }
+
+// requestIDFromMetadata returns the ID AWS assigned to a successful request.
+func requestIDFromMetadata(metadata middleware.Metadata) string {
+	requestID, _ := awsmiddleware.GetRequestIDMetadata(metadata)
+	return requestID
+}
+
+// requestIDFromError returns the ID AWS assigned to a failed request, or an
+// empty string if the request didn't reach AWS.
+func requestIDFromError(err error) string {
+	var respErr *awshttp.ResponseError
+	if errors.As(err, &respErr) {
+		return respErr.ServiceRequestID()
+	}
+	return ""
+}
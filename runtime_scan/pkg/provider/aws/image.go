@@ -0,0 +1,103 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// ResolveScannerImage returns the latest available AMI of the configured
+// channel in the scanner region, or nil if the AMI is pinned in the config.
+func (c *Client) ResolveScannerImage(ctx context.Context) (*models.ScannerInstanceImage, error) {
+	if c.config.ScannerImage != "" {
+		return nil, nil
+	}
+
+	channel, err := provider.NewScannerImageChannel(c.config.ScannerImageChannel)
+	if err != nil {
+		return nil, FatalError{Err: err}
+	}
+
+	out, err := c.ec2Client.DescribeImages(ctx, &ec2.DescribeImagesInput{
+		Owners: []string{c.config.ScannerImageOwner},
+		Filters: []ec2types.Filter{
+			{
+				Name:   utils.PointerTo("name"),
+				Values: []string{c.config.ScannerImageNamePrefix + "*"},
+			},
+			{
+				Name:   utils.PointerTo("state"),
+				Values: []string{string(ec2types.ImageStateAvailable)},
+			},
+		},
+	})
+	if err != nil {
+		return nil, WrapError(fmt.Errorf("failed to describe scanner images: %w", err))
+	}
+
+	image, ok := latestScannerImage(out.Images, c.config.ScannerImageNamePrefix, channel)
+	if !ok {
+		return nil, FatalError{
+			Err: fmt.Errorf("no scanner image found on channel %s. Owner=%s NamePrefix=%s",
+				channel, c.config.ScannerImageOwner, c.config.ScannerImageNamePrefix),
+		}
+	}
+
+	return image, nil
+}
+
+// latestScannerImage returns the image with the highest scanner version on the
+// channel. The scanner version is the suffix of the image name after prefix.
+func latestScannerImage(images []ec2types.Image, prefix string, channel models.ScannerImageChannel) (*models.ScannerInstanceImage, bool) {
+	imageIDs := make(map[string]string, len(images))
+	versions := make([]string, 0, len(images))
+	for _, image := range images {
+		if image.ImageId == nil || image.Name == nil || !strings.HasPrefix(*image.Name, prefix) {
+			continue
+		}
+		version := strings.TrimPrefix(*image.Name, prefix)
+		imageIDs[version] = *image.ImageId
+		versions = append(versions, version)
+	}
+
+	version, ok := provider.LatestVersion(versions, channel)
+	if !ok {
+		return nil, false
+	}
+
+	return &models.ScannerInstanceImage{
+		Reference: imageIDs[version],
+		Version:   utils.PointerTo(version),
+		Channel:   utils.PointerTo(channel),
+	}, true
+}
+
+// scannerImage returns the AMI resolved for the scan or the configured one.
+func (c *Client) scannerImage(config *provider.ScanJobConfig) string {
+	if config.ScannerInstanceImage != "" {
+		return config.ScannerInstanceImage
+	}
+	return c.config.ScannerImage
+}
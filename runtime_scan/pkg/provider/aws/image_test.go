@@ -0,0 +1,85 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"testing"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/gomega"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestLatestScannerImage(t *testing.T) {
+	images := []ec2types.Image{
+		{ImageId: utils.PointerTo("ami-1"), Name: utils.PointerTo("vmclarity-scanner-v0.5.0")},
+		{ImageId: utils.PointerTo("ami-2"), Name: utils.PointerTo("vmclarity-scanner-v0.5.1")},
+		{ImageId: utils.PointerTo("ami-3"), Name: utils.PointerTo("vmclarity-scanner-v0.6.0-rc.1")},
+		{ImageId: utils.PointerTo("ami-4"), Name: utils.PointerTo("vmclarity-scanner-latest")},
+		{ImageId: utils.PointerTo("ami-5"), Name: utils.PointerTo("other-v1.0.0")},
+	}
+
+	tests := []struct {
+		Name    string
+		Images  []ec2types.Image
+		Channel models.ScannerImageChannel
+
+		ExpectedImage *models.ScannerInstanceImage
+		ExpectedOK    bool
+	}{
+		{
+			Name:    "Stable channel skips pre-releases",
+			Images:  images,
+			Channel: models.Stable,
+			ExpectedImage: &models.ScannerInstanceImage{
+				Reference: "ami-2",
+				Version:   utils.PointerTo("v0.5.1"),
+				Channel:   utils.PointerTo(models.Stable),
+			},
+			ExpectedOK: true,
+		},
+		{
+			Name:    "Candidate channel includes pre-releases",
+			Images:  images,
+			Channel: models.Candidate,
+			ExpectedImage: &models.ScannerInstanceImage{
+				Reference: "ami-3",
+				Version:   utils.PointerTo("v0.6.0-rc.1"),
+				Channel:   utils.PointerTo(models.Candidate),
+			},
+			ExpectedOK: true,
+		},
+		{
+			Name:       "No versioned image",
+			Images:     images[3:],
+			Channel:    models.Stable,
+			ExpectedOK: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			image, ok := latestScannerImage(test.Images, DefaultScannerImageNamePrefix, test.Channel)
+
+			g.Expect(ok).Should(Equal(test.ExpectedOK))
+			g.Expect(image).Should(BeEquivalentTo(test.ExpectedImage))
+		})
+	}
+}
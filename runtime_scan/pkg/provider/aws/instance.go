@@ -44,6 +44,13 @@ type Instance struct {
 	RootDeviceName   string
 	Volumes          []Volume
 
+	RootVolumeSizeGB    *int
+	RootVolumeEncrypted *bool
+
+	// AccountID is the member account of the organization the instance is
+	// in, it is empty if the organization is not scanned.
+	AccountID string
+
 	Metadata provider.ScanMetadata
 
 	ec2Client *ec2.Client
@@ -91,14 +98,17 @@ func (i *Instance) Delete(ctx context.Context) error {
 		return nil
 	}
 
-	_, err := i.ec2Client.TerminateInstances(ctx, &ec2.TerminateInstancesInput{
+	op := provider.StartOperation(ctx, models.AWS, models.InstanceDelete, i.Region)
+	out, err := i.ec2Client.TerminateInstances(ctx, &ec2.TerminateInstancesInput{
 		InstanceIds: []string{i.ID},
 	}, func(options *ec2.Options) {
 		options.Region = i.Region
 	})
 	if err != nil {
+		op.Finish(ctx, i.ID, requestIDFromError(err), err)
 		return fmt.Errorf("failed to terminate instances: %v", err)
 	}
+	op.Finish(ctx, i.ID, requestIDFromMetadata(out.ResultMetadata), nil)
 
 	return nil
 }
@@ -147,10 +157,13 @@ func (i *Instance) AttachVolume(ctx context.Context, volume *Volume, deviceName
 				InstanceId: utils.PointerTo(i.ID),
 				VolumeId:   utils.PointerTo(volume.ID),
 			}
-			_, err := i.ec2Client.AttachVolume(ctx, attachVolParams, options)
+			op := provider.StartOperation(ctx, models.AWS, models.VolumeAttach, volume.Region)
+			out, err := i.ec2Client.AttachVolume(ctx, attachVolParams, options)
 			if err != nil {
+				op.Finish(ctx, volume.ID, requestIDFromError(err), err)
 				return fmt.Errorf("failed to attach volume: %w", err)
 			}
+			op.Finish(ctx, volume.ID, requestIDFromMetadata(out.ResultMetadata), nil)
 			return nil
 		case ec2types.VolumeStateDeleted, ec2types.VolumeStateDeleting, ec2types.VolumeStateError:
 			return FatalError{
@@ -158,8 +171,9 @@ func (i *Instance) AttachVolume(ctx context.Context, volume *Volume, deviceName
 			}
 		case ec2types.VolumeStateCreating:
 			return RetryableError{
-				Err:   fmt.Errorf("cannot attach volume with state: %s", vol.State),
-				After: VolumeReadynessAfter,
+				Err:        fmt.Errorf("cannot attach volume with state: %s", vol.State),
+				After:      VolumeReadynessAfter,
+				InProgress: true,
 			}
 		}
 	}
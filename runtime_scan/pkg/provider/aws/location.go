@@ -18,6 +18,9 @@ package aws
 import (
 	"fmt"
 	"strings"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
 const LocationSeparator = "/"
@@ -44,3 +47,60 @@ func NewLocation(l string) (*Location, error) {
 		Vpc:    s[1],
 	}, nil
 }
+
+// regionCountries are the ISO 3166-1 alpha-2 codes of the countries of the
+// AWS regions.
+var regionCountries = map[string]string{
+	"af-south-1":     "ZA",
+	"ap-east-1":      "HK",
+	"ap-northeast-1": "JP",
+	"ap-northeast-2": "KR",
+	"ap-northeast-3": "JP",
+	"ap-south-1":     "IN",
+	"ap-south-2":     "IN",
+	"ap-southeast-1": "SG",
+	"ap-southeast-2": "AU",
+	"ap-southeast-3": "ID",
+	"ap-southeast-4": "AU",
+	"ap-southeast-5": "MY",
+	"ap-southeast-7": "TH",
+	"ca-central-1":   "CA",
+	"ca-west-1":      "CA",
+	"cn-north-1":     "CN",
+	"cn-northwest-1": "CN",
+	"eu-central-1":   "DE",
+	"eu-central-2":   "CH",
+	"eu-north-1":     "SE",
+	"eu-south-1":     "IT",
+	"eu-south-2":     "ES",
+	"eu-west-1":      "IE",
+	"eu-west-2":      "GB",
+	"eu-west-3":      "FR",
+	"il-central-1":   "IL",
+	"me-central-1":   "AE",
+	"me-south-1":     "BH",
+	"mx-central-1":   "MX",
+	"sa-east-1":      "BR",
+	"us-east-1":      "US",
+	"us-east-2":      "US",
+	"us-gov-east-1":  "US",
+	"us-gov-west-1":  "US",
+	"us-west-1":      "US",
+	"us-west-2":      "US",
+}
+
+// newLocationMetadata returns the normalized location of an instance in the
+// region and availability zone.
+func newLocationMetadata(region, availabilityZone string) *models.LocationMetadata {
+	metadata := &models.LocationMetadata{
+		Cloud:  utils.PointerTo(models.AWS),
+		Region: utils.PointerTo(region),
+	}
+	if availabilityZone != "" {
+		metadata.Zone = utils.PointerTo(availabilityZone)
+	}
+	if country, ok := regionCountries[region]; ok {
+		metadata.Country = utils.PointerTo(country)
+	}
+	return metadata
+}
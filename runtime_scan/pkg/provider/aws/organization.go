@@ -0,0 +1,192 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	awstype "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/ebs"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	orgtypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// OrganizationRoleSessionName is the name of the sessions of the role assumed
+// in the member accounts, it shows up in their CloudTrail logs.
+const OrganizationRoleSessionName = "vmclarity"
+
+type Account struct {
+	ID   string
+	Name string
+}
+
+// memberClients caches the clients of the member accounts of the organization
+// so that the credentials of the assumed roles are reused until they expire.
+type memberClients struct {
+	mu      sync.Mutex
+	clients map[string]*Client
+}
+
+func newMemberClients() *memberClients {
+	return &memberClients{
+		clients: make(map[string]*Client),
+	}
+}
+
+// organizationEnabled returns true if the instances of the member accounts of
+// the organization are discovered and scanned.
+func (c *Client) organizationEnabled() bool {
+	return c.config.OrganizationRoleName != ""
+}
+
+// forAccount returns the client acting in the member account of the
+// organization with the credentials of the role assumed in it. The client
+// itself is returned for the account of the scanner.
+func (c *Client) forAccount(accountID string) *Client {
+	if accountID == "" || accountID == c.scannerAccountID {
+		return c
+	}
+
+	c.memberClients.mu.Lock()
+	defer c.memberClients.mu.Unlock()
+
+	if client, ok := c.memberClients.clients[accountID]; ok {
+		return client
+	}
+
+	roleARN := memberRoleARN(c.config.GetPartition(), accountID, c.config.OrganizationRoleName)
+	credentials := stscreds.NewAssumeRoleProvider(sts.NewFromConfig(c.awsConfig), roleARN, func(options *stscreds.AssumeRoleOptions) {
+		options.RoleSessionName = OrganizationRoleSessionName
+		if c.config.OrganizationRoleExternalID != "" {
+			options.ExternalID = utils.PointerTo(c.config.OrganizationRoleExternalID)
+		}
+	})
+
+	cfg := c.awsConfig.Copy()
+	cfg.Credentials = awstype.NewCredentialsCache(credentials)
+
+	client := *c
+	client.accountID = accountID
+	// nolint:contextcheck
+	client.ec2Client = ec2.NewFromConfig(cfg)
+	// nolint:contextcheck
+	client.ebsClient = ebs.NewFromConfig(cfg)
+	c.memberClients.clients[accountID] = &client
+
+	return &client
+}
+
+// targetClient returns the client acting in the account of the target VM instance.
+func (c *Client) targetClient(vmInfo models.VMInfo) (*Client, error) {
+	accountID := utils.ValueOrZero(vmInfo.AccountID)
+	if accountID == "" || accountID == c.scannerAccountID {
+		return c, nil
+	}
+
+	if !c.organizationEnabled() {
+		return nil, FatalError{
+			Err: fmt.Errorf("target VM instance is in account %s but no organization role is configured", accountID),
+		}
+	}
+
+	return c.forAccount(accountID), nil
+}
+
+// ListAccounts returns the active member accounts of the organization.
+func (c *Client) ListAccounts(ctx context.Context) ([]Account, error) {
+	ret := make([]Account, 0)
+
+	paginator := organizations.NewListAccountsPaginator(c.organizationsClient, &organizations.ListAccountsInput{})
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list accounts: %w", err)
+		}
+
+		for _, account := range out.Accounts {
+			if account.Id == nil || account.Status != orgtypes.AccountStatusActive {
+				continue
+			}
+			ret = append(ret, Account{
+				ID:   *account.Id,
+				Name: getPointerValOrEmpty(account.Name),
+			})
+		}
+	}
+
+	return ret, nil
+}
+
+// getAccountsToScan returns the active member accounts of the organization
+// selected by the account IDs of the scan scope, all of them if there are none.
+func (c *Client) getAccountsToScan(ctx context.Context, scope *ScanScope) ([]Account, error) {
+	accounts, err := c.ListAccounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterAccounts(ctx, accounts, scope.AccountIDs), nil
+}
+
+func filterAccounts(ctx context.Context, accounts []Account, accountIDs []string) []Account {
+	if len(accountIDs) == 0 {
+		return accounts
+	}
+
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	byID := make(map[string]Account, len(accounts))
+	for _, account := range accounts {
+		byID[account.ID] = account
+	}
+
+	ret := make([]Account, 0, len(accountIDs))
+	for _, accountID := range accountIDs {
+		account, ok := byID[accountID]
+		if !ok {
+			logger.Warnf("Skipping account which is not an active member of the organization. AccountID=%s", accountID)
+			continue
+		}
+		ret = append(ret, account)
+	}
+
+	return ret
+}
+
+// memberRoleARN returns the ARN of the role assumed in the member account.
+func memberRoleARN(partition Partition, accountID, roleName string) string {
+	return fmt.Sprintf("arn:%s:iam::%s:role/%s", partition, accountID, roleName)
+}
+
+func convertToAPIAccount(account Account, regions []Region) models.AwsAccount {
+	ret := models.AwsAccount{
+		AccountID: account.ID,
+		Regions:   convertToAPIRegions(regions),
+	}
+	if account.Name != "" {
+		ret.Name = utils.PointerTo(account.Name)
+	}
+	return ret
+}
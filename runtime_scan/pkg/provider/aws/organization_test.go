@@ -0,0 +1,101 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestFilterAccounts(t *testing.T) {
+	accounts := []Account{
+		{ID: "111111111111", Name: "production"},
+		{ID: "222222222222", Name: "staging"},
+		{ID: "333333333333", Name: "development"},
+	}
+
+	tests := []struct {
+		Name       string
+		AccountIDs []string
+
+		ExpectedAccounts []Account
+	}{
+		{
+			Name:             "All accounts",
+			AccountIDs:       nil,
+			ExpectedAccounts: accounts,
+		},
+		{
+			Name:       "Selected accounts",
+			AccountIDs: []string{"333333333333", "111111111111"},
+			ExpectedAccounts: []Account{
+				{ID: "333333333333", Name: "development"},
+				{ID: "111111111111", Name: "production"},
+			},
+		},
+		{
+			Name:       "Unknown account",
+			AccountIDs: []string{"444444444444", "222222222222"},
+			ExpectedAccounts: []Account{
+				{ID: "222222222222", Name: "staging"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			g.Expect(filterAccounts(context.Background(), accounts, test.AccountIDs)).Should(Equal(test.ExpectedAccounts))
+		})
+	}
+}
+
+func TestMemberRoleARN(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(memberRoleARN(PartitionStandard, "111111111111", "VMClarityMemberRole")).Should(
+		Equal("arn:aws:iam::111111111111:role/VMClarityMemberRole"))
+	g.Expect(memberRoleARN(PartitionGovCloud, "111111111111", "VMClarityMemberRole")).Should(
+		Equal("arn:aws-us-gov:iam::111111111111:role/VMClarityMemberRole"))
+}
+
+func TestClient_targetClient(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client := &Client{
+		config:           &Config{},
+		scannerAccountID: "111111111111",
+		memberClients:    newMemberClients(),
+	}
+
+	target, err := client.targetClient(models.VMInfo{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(target).Should(BeIdenticalTo(client))
+
+	target, err = client.targetClient(models.VMInfo{AccountID: utils.PointerTo("111111111111")})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(target).Should(BeIdenticalTo(client))
+
+	// Instances of member accounts can't be scanned without the organization role.
+	_, err = client.targetClient(models.VMInfo{AccountID: utils.PointerTo("222222222222")})
+	g.Expect(err).Should(BeAssignableToTypeOf(FatalError{}))
+}
@@ -0,0 +1,62 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Partition is the AWS partition the provider is deployed in. Regions,
+// credentials and resources can't be shared between partitions.
+type Partition string
+
+const (
+	PartitionStandard Partition = "aws"
+	PartitionChina    Partition = "aws-cn"
+	PartitionGovCloud Partition = "aws-us-gov"
+)
+
+// partitionRegionPrefixes holds the region name prefixes of the non-standard
+// partitions, every other region belongs to the standard partition.
+var partitionRegionPrefixes = map[Partition]string{
+	PartitionChina:    "cn-",
+	PartitionGovCloud: "us-gov-",
+}
+
+// PartitionForRegion returns the partition the region belongs to.
+func PartitionForRegion(region string) Partition {
+	for partition, prefix := range partitionRegionPrefixes {
+		if strings.HasPrefix(region, prefix) {
+			return partition
+		}
+	}
+	return PartitionStandard
+}
+
+func NewPartition(s string) (Partition, error) {
+	switch p := Partition(s); p {
+	case PartitionStandard, PartitionChina, PartitionGovCloud:
+		return p, nil
+	default:
+		return "", fmt.Errorf("unsupported partition: %s", s)
+	}
+}
+
+// Contains returns true if the region belongs to the partition.
+func (p Partition) Contains(region string) bool {
+	return PartitionForRegion(region) == p
+}
@@ -0,0 +1,80 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestPartitionForRegion(t *testing.T) {
+	tests := []struct {
+		Name   string
+		Region string
+
+		ExpectedPartition Partition
+	}{
+		{
+			Name:              "Standard region",
+			Region:            "eu-west-1",
+			ExpectedPartition: PartitionStandard,
+		},
+		{
+			Name:              "China region",
+			Region:            "cn-northwest-1",
+			ExpectedPartition: PartitionChina,
+		},
+		{
+			Name:              "GovCloud region",
+			Region:            "us-gov-east-1",
+			ExpectedPartition: PartitionGovCloud,
+		},
+		{
+			Name:              "Standard US region",
+			Region:            "us-east-1",
+			ExpectedPartition: PartitionStandard,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			g.Expect(PartitionForRegion(test.Region)).Should(Equal(test.ExpectedPartition))
+		})
+	}
+}
+
+func TestFilterRegionsInPartition(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	regions := []Region{
+		{Name: "us-east-1"},
+		{Name: "cn-north-1"},
+		{Name: "us-gov-west-1"},
+		{Name: "cn-northwest-1"},
+	}
+
+	g.Expect(filterRegionsInPartition(context.Background(), regions, PartitionChina)).Should(Equal([]Region{
+		{Name: "cn-north-1"},
+		{Name: "cn-northwest-1"},
+	}))
+	g.Expect(filterRegionsInPartition(context.Background(), regions, PartitionStandard)).Should(Equal([]Region{
+		{Name: "us-east-1"},
+	}))
+}
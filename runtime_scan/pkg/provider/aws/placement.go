@@ -0,0 +1,176 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// ScannerNetwork is the subnet and the security group a Scanner instance is
+// created with.
+type ScannerNetwork struct {
+	SubnetID        string
+	SecurityGroupID string
+}
+
+// scannerNetwork returns the network of the Scanner instance of the scan job
+// honoring its models.ScannerPlacement. The target VM instance is only fetched
+// if the scanner must not be created in its subnet, vmInfo is nil for targets
+// which are not VM instances.
+func (c *Client) scannerNetwork(ctx context.Context, config *provider.ScanJobConfig, vmInfo *models.VMInfo) (ScannerNetwork, error) {
+	placement := config.ScannerPlacement
+
+	network := ScannerNetwork{
+		SubnetID:        c.config.SubnetID,
+		SecurityGroupID: c.config.SecurityGroupID,
+	}
+	subnetIDs := []string{c.config.SubnetID}
+	if placement != nil && placement.Aws != nil {
+		if ids := utils.ValueOrZero(placement.Aws.SubnetIDs); len(ids) > 0 {
+			subnetIDs = ids
+		}
+		if id := utils.ValueOrZero(placement.Aws.SecurityGroupID); id != "" {
+			network.SecurityGroupID = id
+		}
+	}
+
+	if placement.GetAvoidTargetSubnet() && vmInfo != nil {
+		targetSubnetID, err := c.getTargetSubnetID(ctx, *vmInfo)
+		if err != nil {
+			return ScannerNetwork{}, err
+		}
+		subnetIDs = excludeSubnet(subnetIDs, targetSubnetID)
+		if len(subnetIDs) == 0 {
+			return ScannerNetwork{}, FatalError{
+				Err: fmt.Errorf("all scanner subnets are the subnet of the target VM instance. SubnetID=%s", targetSubnetID),
+			}
+		}
+	}
+
+	network.SubnetID = subnetIDs[0]
+	if !placement.GetSpreadAcrossZones() || len(subnetIDs) == 1 {
+		return network, nil
+	}
+
+	subnetZones, err := c.getSubnetZones(ctx, subnetIDs)
+	if err != nil {
+		return ScannerNetwork{}, err
+	}
+	network.SubnetID = spreadSubnetsAcrossZones(subnetIDs, subnetZones, config)
+
+	return network, nil
+}
+
+// getTargetSubnetID returns the subnet of the target VM instance, it is empty
+// if the instance is not in the scanner region, as then it can't share a
+// subnet with the scanner.
+func (c *Client) getTargetSubnetID(ctx context.Context, vmInfo models.VMInfo) (string, error) {
+	location, err := NewLocation(vmInfo.Location)
+	if err != nil {
+		return "", FatalError{
+			Err: fmt.Errorf("failed to parse Location for target VM instance: %w", err),
+		}
+	}
+	if location.Region != c.config.ScannerRegion {
+		return "", nil
+	}
+
+	target, err := c.targetClient(vmInfo)
+	if err != nil {
+		return "", err
+	}
+
+	instance, err := target.getInstanceWithID(ctx, vmInfo.InstanceID, location.Region)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch target VM instance: %w", err)
+	}
+	if instance == nil {
+		return "", FatalError{
+			Err: fmt.Errorf("failed to find target VM instance. InstanceID=%s", vmInfo.InstanceID),
+		}
+	}
+
+	return getPointerValOrEmpty(instance.SubnetId), nil
+}
+
+// getSubnetZones returns the availability zones of the subnets in the scanner
+// region by subnet ID.
+func (c *Client) getSubnetZones(ctx context.Context, subnetIDs []string) (map[string]string, error) {
+	out, err := c.ec2Client.DescribeSubnets(ctx, &ec2.DescribeSubnetsInput{
+		SubnetIds: subnetIDs,
+	}, func(options *ec2.Options) {
+		options.Region = c.config.ScannerRegion
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe scanner subnets: %w", err)
+	}
+
+	zones := make(map[string]string, len(out.Subnets))
+	for _, subnet := range out.Subnets {
+		if subnet.SubnetId == nil || subnet.AvailabilityZone == nil {
+			continue
+		}
+		zones[*subnet.SubnetId] = *subnet.AvailabilityZone
+	}
+
+	return zones, nil
+}
+
+func excludeSubnet(subnetIDs []string, subnetID string) []string {
+	if subnetID == "" {
+		return subnetIDs
+	}
+
+	ret := make([]string, 0, len(subnetIDs))
+	for _, id := range subnetIDs {
+		if id != subnetID {
+			ret = append(ret, id)
+		}
+	}
+
+	return ret
+}
+
+// spreadSubnetsAcrossZones returns the first of the subnets in the availability
+// zone of the scan job, so that the scanners are spread evenly across zones
+// even if a zone has more subnets than another.
+func spreadSubnetsAcrossZones(subnetIDs []string, subnetZones map[string]string, config *provider.ScanJobConfig) string {
+	zones := make([]string, 0, len(subnetIDs))
+	firstSubnets := make(map[string]string, len(subnetIDs))
+	for _, id := range subnetIDs {
+		zone, ok := subnetZones[id]
+		if !ok {
+			continue
+		}
+		if _, ok := firstSubnets[zone]; ok {
+			continue
+		}
+		zones = append(zones, zone)
+		firstSubnets[zone] = id
+	}
+	if len(zones) == 0 {
+		return subnetIDs[0]
+	}
+
+	return firstSubnets[provider.ZoneForScanJob(zones, config)]
+}
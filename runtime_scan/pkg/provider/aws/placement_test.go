@@ -0,0 +1,62 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+)
+
+func TestExcludeSubnet(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(excludeSubnet([]string{"subnet-1", "subnet-2"}, "subnet-1")).Should(Equal([]string{"subnet-2"}))
+	g.Expect(excludeSubnet([]string{"subnet-1"}, "subnet-1")).Should(BeEmpty())
+	g.Expect(excludeSubnet([]string{"subnet-1", "subnet-2"}, "")).Should(Equal([]string{"subnet-1", "subnet-2"}))
+}
+
+func TestSpreadSubnetsAcrossZones(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	subnetIDs := []string{"subnet-1", "subnet-2", "subnet-3", "subnet-4"}
+	subnetZones := map[string]string{
+		"subnet-1": "us-east-1a",
+		"subnet-2": "us-east-1a",
+		"subnet-3": "us-east-1b",
+		"subnet-4": "us-east-1c",
+	}
+
+	// The first subnet of each zone is used, so that a zone with more
+	// subnets doesn't get more scanners.
+	counts := make(map[string]int)
+	for i := 0; i < 300; i++ {
+		config := &provider.ScanJobConfig{
+			ScanMetadata: provider.ScanMetadata{ScanResultID: fmt.Sprintf("scan-result-%d", i)},
+		}
+		counts[spreadSubnetsAcrossZones(subnetIDs, subnetZones, config)]++
+	}
+	g.Expect(counts).Should(HaveLen(3))
+	g.Expect(counts).Should(HaveKey("subnet-1"))
+	g.Expect(counts).Should(HaveKey("subnet-3"))
+	g.Expect(counts).Should(HaveKey("subnet-4"))
+
+	// Subnets with unknown zones fall back to the first subnet
+	g.Expect(spreadSubnetsAcrossZones(subnetIDs, nil, &provider.ScanJobConfig{})).Should(Equal("subnet-1"))
+}
@@ -0,0 +1,135 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// ec2ProtocolAll is the IpProtocol of the permissions allowing all protocols.
+const ec2ProtocolAll = "-1"
+
+// getInstancesWithSecurityGroupRules returns the instances with the rules of
+// their security groups, which are evaluated against the network policy.
+// Failing to get the security groups is not fatal for the discovery.
+func (c *Client) getInstancesWithSecurityGroupRules(ctx context.Context, instances []Instance, regionID string) []Instance {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	groupIDs := make(map[string]struct{})
+	for _, instance := range instances {
+		for _, sg := range instance.SecurityGroups {
+			groupIDs[sg.Id] = struct{}{}
+		}
+	}
+	if len(groupIDs) == 0 {
+		return instances
+	}
+
+	ids := make([]string, 0, len(groupIDs))
+	for id := range groupIDs {
+		ids = append(ids, id)
+	}
+
+	groups := make(map[string]models.SecurityGroup, len(ids))
+	paginator := ec2.NewDescribeSecurityGroupsPaginator(c.ec2Client, &ec2.DescribeSecurityGroupsInput{
+		GroupIds: ids,
+	})
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx, func(options *ec2.Options) {
+			options.Region = regionID
+		})
+		if err != nil {
+			logger.Warnf("Failed to describe security groups of instances in region %s: %v", regionID, err)
+			return instances
+		}
+		for _, sg := range out.SecurityGroups {
+			if sg.GroupId != nil {
+				groups[*sg.GroupId] = securityGroupFromEC2SecurityGroup(sg)
+			}
+		}
+	}
+
+	for i := range instances {
+		for j, sg := range instances[i].SecurityGroups {
+			if group, ok := groups[sg.Id]; ok {
+				instances[i].SecurityGroups[j] = group
+			}
+		}
+	}
+
+	return instances
+}
+
+func securityGroupFromEC2SecurityGroup(sg ec2types.SecurityGroup) models.SecurityGroup {
+	rules := securityGroupRulesFromEC2IpPermissions(models.Inbound, sg.IpPermissions)
+	rules = append(rules, securityGroupRulesFromEC2IpPermissions(models.Outbound, sg.IpPermissionsEgress)...)
+
+	return models.SecurityGroup{
+		Id:    *sg.GroupId,
+		Name:  sg.GroupName,
+		Rules: &rules,
+	}
+}
+
+func securityGroupRulesFromEC2IpPermissions(direction models.SecurityGroupRuleDirection, permissions []ec2types.IpPermission) []models.SecurityGroupRule {
+	rules := make([]models.SecurityGroupRule, 0, len(permissions))
+	for _, permission := range permissions {
+		sources := make([]string, 0, len(permission.IpRanges)+len(permission.Ipv6Ranges))
+		for _, r := range permission.IpRanges {
+			if r.CidrIp != nil {
+				sources = append(sources, *r.CidrIp)
+			}
+		}
+		for _, r := range permission.Ipv6Ranges {
+			if r.CidrIpv6 != nil {
+				sources = append(sources, *r.CidrIpv6)
+			}
+		}
+		// Permissions referencing other security groups or prefix
+		// lists don't open the ports to addresses.
+		if len(sources) == 0 {
+			continue
+		}
+
+		rule := models.SecurityGroupRule{
+			Direction: direction,
+			Protocol:  strings.ToLower(utils.ValueOrZero(permission.IpProtocol)),
+			Sources:   &sources,
+		}
+		switch rule.Protocol {
+		case ec2ProtocolAll:
+			rule.Protocol = provider.ProtocolAll
+		case provider.ProtocolTCP, provider.ProtocolUDP:
+			if permission.FromPort != nil && permission.ToPort != nil {
+				rule.FromPort = utils.PointerTo(int(*permission.FromPort))
+				rule.ToPort = utils.PointerTo(int(*permission.ToPort))
+			}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
@@ -0,0 +1,74 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aws
+
+import (
+	"testing"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/gomega"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestSecurityGroupFromEC2SecurityGroup(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	sg := ec2types.SecurityGroup{
+		GroupId:   utils.PointerTo("sg-1"),
+		GroupName: utils.PointerTo("web"),
+		IpPermissions: []ec2types.IpPermission{
+			{
+				IpProtocol: utils.PointerTo("tcp"),
+				FromPort:   utils.PointerTo[int32](22),
+				ToPort:     utils.PointerTo[int32](22),
+				IpRanges:   []ec2types.IpRange{{CidrIp: utils.PointerTo("0.0.0.0/0")}},
+				Ipv6Ranges: []ec2types.Ipv6Range{{CidrIpv6: utils.PointerTo("::/0")}},
+			},
+			{
+				// Permissions referencing security groups are skipped
+				IpProtocol:       utils.PointerTo("-1"),
+				UserIdGroupPairs: []ec2types.UserIdGroupPair{{GroupId: utils.PointerTo("sg-2")}},
+			},
+		},
+		IpPermissionsEgress: []ec2types.IpPermission{
+			{
+				IpProtocol: utils.PointerTo("-1"),
+				IpRanges:   []ec2types.IpRange{{CidrIp: utils.PointerTo("0.0.0.0/0")}},
+			},
+		},
+	}
+
+	g.Expect(securityGroupFromEC2SecurityGroup(sg)).Should(BeEquivalentTo(models.SecurityGroup{
+		Id:   "sg-1",
+		Name: utils.PointerTo("web"),
+		Rules: &[]models.SecurityGroupRule{
+			{
+				Direction: models.Inbound,
+				Protocol:  "tcp",
+				FromPort:  utils.PointerTo(22),
+				ToPort:    utils.PointerTo(22),
+				Sources:   &[]string{"0.0.0.0/0", "::/0"},
+			},
+			{
+				Direction: models.Outbound,
+				Protocol:  "all",
+				Sources:   &[]string{"0.0.0.0/0"},
+			},
+		},
+	}))
+}
@@ -23,6 +23,7 @@ import (
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/sirupsen/logrus"
 
+	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
 	"github.com/openclarity/vmclarity/shared/pkg/log"
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
@@ -38,13 +39,20 @@ type Snapshot struct {
 }
 
 func (s *Snapshot) Copy(ctx context.Context, region string) (*Snapshot, error) {
+	return s.CopyTo(ctx, s.ec2Client, region)
+}
+
+// CopyTo copies the snapshot to the region of the account the client acts in.
+// The snapshot must be shared with the account if it is another one.
+// nolint:cyclop
+func (s *Snapshot) CopyTo(ctx context.Context, ec2Client *ec2.Client, region string) (*Snapshot, error) {
 	logger := log.GetLoggerFromContextOrDiscard(ctx).WithFields(logrus.Fields{
 		"SnapshotID":     s.ID,
 		"Operation":      "Copy",
 		"TargetVolumeID": s.VolumeID,
 	})
 
-	if s.Region == region {
+	if s.Region == region && s.ec2Client == ec2Client {
 		logger.Debugf("Copying snapshot is skipped. SourceRegion=%s TargetRegion=%s", s.Region, region)
 		return s, nil
 	}
@@ -63,7 +71,7 @@ func (s *Snapshot) Copy(ctx context.Context, region string) (*Snapshot, error) {
 	describeParams := &ec2.DescribeSnapshotsInput{
 		Filters: ec2Filters,
 	}
-	describeOut, err := s.ec2Client.DescribeSnapshots(ctx, describeParams, options)
+	describeOut, err := ec2Client.DescribeSnapshots(ctx, describeParams, options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch target snapshots. TargetRegion=%s SourceSnapshot=%s SourceRegion=%s: %w",
 			region, s.ID, s.Region, err)
@@ -81,7 +89,7 @@ func (s *Snapshot) Copy(ctx context.Context, region string) (*Snapshot, error) {
 			}
 		case ec2types.SnapshotStateRecovering, ec2types.SnapshotStatePending, ec2types.SnapshotStateCompleted:
 			return &Snapshot{
-				ec2Client: s.ec2Client,
+				ec2Client: ec2Client,
 				ID:        *snap.SnapshotId,
 				Region:    region,
 				Metadata:  s.Metadata,
@@ -102,14 +110,17 @@ func (s *Snapshot) Copy(ctx context.Context, region string) (*Snapshot, error) {
 		},
 	}
 
-	snap, err := s.ec2Client.CopySnapshot(ctx, copySnapParams, options)
+	op := provider.StartOperation(ctx, models.AWS, models.SnapshotCopy, region)
+	snap, err := ec2Client.CopySnapshot(ctx, copySnapParams, options)
 	if err != nil {
+		op.Finish(ctx, "", requestIDFromError(err), err)
 		return nil, fmt.Errorf("failed to copy snapshot between regions. SnapshotID=%s SourceRegion=%s DestinationRegion=%s: %w",
 			s.ID, s.Region, region, err)
 	}
+	op.Finish(ctx, *snap.SnapshotId, requestIDFromMetadata(snap.ResultMetadata), nil)
 
 	return &Snapshot{
-		ec2Client: s.ec2Client,
+		ec2Client: ec2Client,
 		ID:        *snap.SnapshotId,
 		Region:    region,
 		Metadata:  s.Metadata,
@@ -117,19 +128,45 @@ func (s *Snapshot) Copy(ctx context.Context, region string) (*Snapshot, error) {
 	}, nil
 }
 
+// ShareWith permits the account to create volumes from the completed snapshot
+// and to copy it. Sharing the snapshot again is a no-op.
+func (s *Snapshot) ShareWith(ctx context.Context, accountID string) error {
+	_, err := s.ec2Client.ModifySnapshotAttribute(ctx, &ec2.ModifySnapshotAttributeInput{
+		SnapshotId: &s.ID,
+		Attribute:  ec2types.SnapshotAttributeNameCreateVolumePermission,
+		CreateVolumePermission: &ec2types.CreateVolumePermissionModifications{
+			Add: []ec2types.CreateVolumePermission{
+				{
+					UserId: utils.PointerTo(accountID),
+				},
+			},
+		},
+	}, func(options *ec2.Options) {
+		options.Region = s.Region
+	})
+	if err != nil {
+		return fmt.Errorf("failed to modify snapshot attribute. SnapshotID=%s: %w", s.ID, err)
+	}
+
+	return nil
+}
+
 func (s *Snapshot) Delete(ctx context.Context) error {
 	if s == nil {
 		return nil
 	}
 
-	_, err := s.ec2Client.DeleteSnapshot(ctx, &ec2.DeleteSnapshotInput{
+	op := provider.StartOperation(ctx, models.AWS, models.SnapshotDelete, s.Region)
+	out, err := s.ec2Client.DeleteSnapshot(ctx, &ec2.DeleteSnapshotInput{
 		SnapshotId: &s.ID,
 	}, func(options *ec2.Options) {
 		options.Region = s.Region
 	})
 	if err != nil {
+		op.Finish(ctx, s.ID, requestIDFromError(err), err)
 		return fmt.Errorf("failed to delete snapshot: %v", err)
 	}
+	op.Finish(ctx, s.ID, requestIDFromMetadata(out.ResultMetadata), nil)
 
 	return nil
 }
@@ -221,10 +258,13 @@ func (s *Snapshot) CreateVolume(ctx context.Context, az string) (*Volume, error)
 		},
 		VolumeType: ec2types.VolumeTypeGp2,
 	}
+	op := provider.StartOperation(ctx, models.AWS, models.VolumeCreate, s.Region)
 	out, err := s.ec2Client.CreateVolume(ctx, createParams, options)
 	if err != nil {
+		op.Finish(ctx, "", requestIDFromError(err), err)
 		return nil, fmt.Errorf("failed to create volume from snapshot. SnapshotID=%s: %w", s.ID, err)
 	}
+	op.Finish(ctx, *out.VolumeId, requestIDFromMetadata(out.ResultMetadata), nil)
 
 	return &Volume{
 		ec2Client: s.ec2Client,
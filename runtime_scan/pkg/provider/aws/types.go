@@ -33,6 +33,10 @@ const (
 	EC2TagKeyTargetID       = "VMClarity.TargetID"
 	EC2TagKeyTargetVolumeID = "VMClarity.TargetVolumeID"
 
+	// EC2TagKeyDeltaScanBaseline marks the target volume snapshot kept for
+	// delta scanning, its value is the ID of the ScanResult which scanned it.
+	EC2TagKeyDeltaScanBaseline = "VMClarity.DeltaScanBaseline"
+
 	EC2SnapshotDescription = "Volume snapshot created by VMClarity for scanning"
 )
 
@@ -41,9 +45,12 @@ const (
 	SecurityGroupIDFilterName = "instance.group-id"
 	InstanceStateFilterName   = "instance-state-name"
 	SnapshotIDFilterName      = "snapshot-id"
+	TagKeyFilterName          = "tag-key"
 )
 
 type ScanScope struct {
+	// Member accounts of the organization to scan, all of them if empty.
+	AccountIDs  []string
 	AllRegions  bool
 	Regions     []Region
 	ScanStopped bool
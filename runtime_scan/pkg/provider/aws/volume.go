@@ -24,6 +24,7 @@ import (
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/sirupsen/logrus"
 
+	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
 	"github.com/openclarity/vmclarity/shared/pkg/log"
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
@@ -97,10 +98,13 @@ func (v *Volume) CreateSnapshot(ctx context.Context) (*Snapshot, error) {
 			},
 		},
 	}
+	op := provider.StartOperation(ctx, models.AWS, models.SnapshotCreate, v.Region)
 	createOut, err := v.ec2Client.CreateSnapshot(ctx, &createParams, options)
 	if err != nil {
+		op.Finish(ctx, "", requestIDFromError(err), err)
 		return nil, fmt.Errorf("failed to create snapshot for volume. VolumeID=%s: %w", v.ID, err)
 	}
+	op.Finish(ctx, *createOut.SnapshotId, requestIDFromMetadata(createOut.ResultMetadata), nil)
 
 	return &Snapshot{
 		ec2Client: v.ec2Client,
@@ -194,14 +198,17 @@ func (v *Volume) Delete(ctx context.Context) error {
 		return nil
 	}
 
-	_, err := v.ec2Client.DeleteVolume(ctx, &ec2.DeleteVolumeInput{
+	op := provider.StartOperation(ctx, models.AWS, models.VolumeDelete, v.Region)
+	out, err := v.ec2Client.DeleteVolume(ctx, &ec2.DeleteVolumeInput{
 		VolumeId: &v.ID,
 	}, func(options *ec2.Options) {
 		options.Region = v.Region
 	})
 	if err != nil {
+		op.Finish(ctx, v.ID, requestIDFromError(err), err)
 		return fmt.Errorf("failed to delete volume: %w", err)
 	}
+	op.Finish(ctx, v.ID, requestIDFromMetadata(out.ResultMetadata), nil)
 
 	return nil
 }
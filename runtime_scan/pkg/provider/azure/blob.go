@@ -18,7 +18,6 @@ package azure
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
@@ -26,10 +25,12 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
 
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
 var (
 	estimatedBlobCopyTime    = 2 * time.Minute
+	estimatedSASGrantTime    = 15 * time.Second
 	estimatedBlobAbortTime   = 2 * time.Minute
 	estimatedBlobDeleteTime  = 2 * time.Minute
 	snapshotSASAccessSeconds = 3600
@@ -43,6 +44,11 @@ func (c *Client) blobURLFromBlobName(blobName string) string {
 	return fmt.Sprintf("https://%s.blob.core.windows.net/%s/%s", c.azureConfig.ScannerStorageAccountName, c.azureConfig.ScannerStorageContainerName, blobName)
 }
 
+// ensureBlobFromSnapshot copies the snapshot to a blob in the scanner region
+// from its SAS URL. The grant of the SAS access and the copy run
+// asynchronously, they are checked on the following calls until the copy is
+// done.
+// nolint:cyclop
 func (c *Client) ensureBlobFromSnapshot(ctx context.Context, config *provider.ScanJobConfig, snapshot armcompute.Snapshot) (string, error) {
 	blobName := blobNameFromJobConfig(config)
 	blobURL := c.blobURLFromBlobName(blobName)
@@ -54,23 +60,33 @@ func (c *Client) ensureBlobFromSnapshot(ctx context.Context, config *provider.Sc
 	getMetadata, err := blobClient.GetProperties(ctx, nil)
 	if err == nil {
 		copyStatus := *getMetadata.CopyStatus
-		if copyStatus != blob.CopyStatusTypeSuccess {
-			log.Print("blob is still copying, status is ", copyStatus)
-			return blobURL, provider.RetryableErrorf(estimatedBlobCopyTime, "blob is still copying")
+		switch copyStatus {
+		case blob.CopyStatusTypeSuccess:
+			return blobURL, c.ensureSnapshotAccessRevoked(ctx, config, snapshot)
+		case blob.CopyStatusTypePending:
+			retryAfter := estimatedBlobCopyTime
+			if getMetadata.CopyProgress != nil {
+				copied, total, err := parseCopyProgress(*getMetadata.CopyProgress)
+				if err == nil {
+					copyState := c.blobCopies.get(config.ScanID, blobName)
+					retryAfter = c.blobCopies.estimateRemaining(copyState, copied, total, time.Now())
+				}
+			}
+			return blobURL, provider.InProgressErrorf(retryAfter, "blob is still copying, progress is %s",
+				utils.ValueOrZero(getMetadata.CopyProgress))
+		case blob.CopyStatusTypeAborted, blob.CopyStatusTypeFailed:
+			// The copy is restarted from a new SAS URL once the blob
+			// is deleted.
+			_, err = blobClient.Delete(ctx, nil)
+			if err != nil {
+				_, err := handleAzureRequestError(err, "deleting blob %s", blobName)
+				return blobURL, err
+			}
+			c.blobCopies.release(blobName)
+			return blobURL, provider.RetryableErrorf(estimatedBlobDeleteTime, "blob copy %s: %s, restarting it",
+				copyStatus, utils.ValueOrZero(getMetadata.CopyStatusDescription))
 		}
-
-		revokepoller, err := c.snapshotsClient.BeginRevokeAccess(ctx, c.azureConfig.ScannerResourceGroup, *snapshot.Name, nil)
-		if err != nil {
-			_, err := handleAzureRequestError(err, "revoking SAS access for snapshot %s", *snapshot.Name)
-			return blobURL, err
-		}
-		_, err = revokepoller.PollUntilDone(ctx, nil)
-		if err != nil {
-			_, err := handleAzureRequestError(err, "waiting for SAS access to be revoked for snapshot %s", *snapshot.Name)
-			return blobURL, err
-		}
-
-		return blobURL, nil
+		return blobURL, provider.InProgressErrorf(estimatedBlobCopyTime, "blob copy status is %s", copyStatus)
 	}
 
 	notFound, err := handleAzureRequestError(err, "getting blob %s", blobName)
@@ -78,20 +94,41 @@ func (c *Client) ensureBlobFromSnapshot(ctx context.Context, config *provider.Sc
 		return blobURL, err
 	}
 
+	copyState, ok := c.blobCopies.acquire(config.ScanID, blobName, config.MaxParallelScanners)
+	if !ok {
+		return blobURL, provider.InProgressErrorf(estimatedBlobCopyTime, "waiting for one of the %d blob copies of the scan to finish",
+			config.MaxParallelScanners)
+	}
+
 	// NOTE(sambetts) Granting SAS access to a snapshot must be done
 	// atomically with starting the CopyFromUrl Operation because
 	// GrantAccess only provides the URL once, and we don't want to store
-	// it.
-	poller, err := c.snapshotsClient.BeginGrantAccess(ctx, c.azureConfig.ScannerResourceGroup, *snapshot.Name, armcompute.GrantAccessData{
-		Access:            to.Ptr(armcompute.AccessLevelRead),
-		DurationInSeconds: to.Ptr[int32](int32(snapshotSASAccessSeconds)),
-	}, nil)
-	if err != nil {
-		_, err := handleAzureRequestError(err, "granting SAS access to snapshot %s", *snapshot.Name)
-		return blobURL, err
+	// it. The poller of the grant is therefore only kept in memory, the
+	// access is granted again if it is lost.
+	if copyState.grant == nil {
+		copyState.grant, err = c.snapshotsClient.BeginGrantAccess(ctx, c.azureConfig.ScannerResourceGroup, *snapshot.Name, armcompute.GrantAccessData{
+			Access:            to.Ptr(armcompute.AccessLevelRead),
+			DurationInSeconds: to.Ptr[int32](int32(snapshotSASAccessSeconds)),
+		}, nil)
+		if err != nil {
+			_, err := handleAzureRequestError(err, "granting SAS access to snapshot %s", *snapshot.Name)
+			return blobURL, err
+		}
 	}
 
-	res, err := poller.PollUntilDone(ctx, nil)
+	if !copyState.grant.Done() {
+		if _, err := copyState.grant.Poll(ctx); err != nil {
+			copyState.grant = nil
+			_, err := handleAzureRequestError(err, "waiting for SAS access to snapshot %s be granted", *snapshot.Name)
+			return blobURL, err
+		}
+		if !copyState.grant.Done() {
+			return blobURL, provider.InProgressErrorf(estimatedSASGrantTime, "SAS access to snapshot is being granted")
+		}
+	}
+
+	res, err := copyState.grant.Result(ctx)
+	copyState.grant = nil
 	if err != nil {
 		_, err := handleAzureRequestError(err, "waiting for SAS access to snapshot %s be granted", *snapshot.Name)
 		return blobURL, err
@@ -105,7 +142,26 @@ func (c *Client) ensureBlobFromSnapshot(ctx context.Context, config *provider.Sc
 		return blobURL, err
 	}
 
-	return blobURL, provider.RetryableErrorf(estimatedBlobCopyTime, "blob copy from url started")
+	return blobURL, provider.InProgressErrorf(estimatedBlobCopyTime, "blob copy from url started")
+}
+
+// ensureSnapshotAccessRevoked starts revoking the SAS access to the snapshot
+// once it is copied. The revoke is not waited for as the blob no longer needs
+// the access.
+func (c *Client) ensureSnapshotAccessRevoked(ctx context.Context, config *provider.ScanJobConfig, snapshot armcompute.Snapshot) error {
+	copyState := c.blobCopies.get(config.ScanID, blobNameFromJobConfig(config))
+	if copyState.revoked {
+		return nil
+	}
+
+	_, err := c.snapshotsClient.BeginRevokeAccess(ctx, c.azureConfig.ScannerResourceGroup, *snapshot.Name, nil)
+	if err != nil {
+		_, err := handleAzureRequestError(err, "revoking SAS access for snapshot %s", *snapshot.Name)
+		return err
+	}
+	copyState.revoked = true
+
+	return nil
 }
 
 func (c *Client) ensureBlobDeleted(ctx context.Context, config *provider.ScanJobConfig) error {
@@ -120,6 +176,7 @@ func (c *Client) ensureBlobDeleted(ctx context.Context, config *provider.ScanJob
 	if err != nil {
 		notFound, err := handleAzureRequestError(err, "getting blob %s", blobName)
 		if notFound {
+			c.blobCopies.release(blobName)
 			return nil
 		}
 		return err
@@ -132,7 +189,7 @@ func (c *Client) ensureBlobDeleted(ctx context.Context, config *provider.ScanJob
 			_, err := handleAzureRequestError(err, "aborting copy from url for blob %s", blobName)
 			return err
 		}
-		return provider.RetryableErrorf(estimatedBlobAbortTime, "blob copy aborting")
+		return provider.InProgressErrorf(estimatedBlobAbortTime, "blob copy aborting")
 	}
 
 	_, err = blobClient.Delete(ctx, nil)
@@ -140,6 +197,7 @@ func (c *Client) ensureBlobDeleted(ctx context.Context, config *provider.ScanJob
 		_, err := handleAzureRequestError(err, "deleting blob %s", blobName)
 		return err
 	}
+	c.blobCopies.release(blobName)
 
-	return provider.RetryableErrorf(estimatedBlobDeleteTime, "blob %s delete started", blobName)
+	return provider.InProgressErrorf(estimatedBlobDeleteTime, "blob %s delete started", blobName)
 }
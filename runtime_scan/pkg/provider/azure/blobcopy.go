@@ -0,0 +1,148 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v4"
+)
+
+var minBlobCopyPollTime = 15 * time.Second
+
+// blobCopy is a copy of a snapshot to a blob in the scanner region.
+type blobCopy struct {
+	scanID string
+	// grant is the pending grant of the SAS access to the snapshot, the copy
+	// is started from the SAS URL as soon as it is granted.
+	grant *runtime.Poller[armcompute.SnapshotsClientGrantAccessResponse]
+	// revoked is set once the revoke of the SAS access is started.
+	revoked bool
+
+	copiedBytes int64
+	sampledAt   time.Time
+}
+
+// blobCopies tracks the copies of the snapshots to blobs, so that they are
+// run asynchronously from the reconcile loop and the copies of a scan are
+// capped. It is kept in memory only, a copy lost on restart is recovered from
+// the state of its blob.
+type blobCopies struct {
+	mu     sync.Mutex
+	copies map[string]*blobCopy
+}
+
+func newBlobCopies() *blobCopies {
+	return &blobCopies{
+		copies: map[string]*blobCopy{},
+	}
+}
+
+// acquire returns the copy to the blob, and whether it may proceed. A new copy
+// may only proceed if the scan has less than maxCopies copies in progress.
+func (b *blobCopies) acquire(scanID, blobName string, maxCopies int) (*blobCopy, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if c, ok := b.copies[blobName]; ok {
+		return c, true
+	}
+
+	if maxCopies > 0 {
+		inProgress := 0
+		for _, c := range b.copies {
+			if c.scanID == scanID && !c.revoked {
+				inProgress++
+			}
+		}
+		if inProgress >= maxCopies {
+			return nil, false
+		}
+	}
+
+	c := &blobCopy{scanID: scanID}
+	b.copies[blobName] = c
+	return c, true
+}
+
+// get returns the copy to the blob, a new one if it is not tracked.
+func (b *blobCopies) get(scanID, blobName string) *blobCopy {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c, ok := b.copies[blobName]
+	if !ok {
+		c = &blobCopy{scanID: scanID}
+		b.copies[blobName] = c
+	}
+	return c
+}
+
+// release stops tracking the copy to the blob.
+func (b *blobCopies) release(blobName string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.copies, blobName)
+}
+
+// estimateRemaining returns the time the copy is expected to be done in from
+// its progress since it was last sampled, within minBlobCopyPollTime and
+// estimatedBlobCopyTime.
+func (b *blobCopies) estimateRemaining(c *blobCopy, copied, total int64, now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	estimate := estimatedBlobCopyTime
+	if !c.sampledAt.IsZero() && copied > c.copiedBytes && now.After(c.sampledAt) {
+		rate := float64(copied-c.copiedBytes) / now.Sub(c.sampledAt).Seconds()
+		estimate = time.Duration(float64(total-copied) / rate * float64(time.Second))
+	}
+	c.copiedBytes = copied
+	c.sampledAt = now
+
+	switch {
+	case estimate < minBlobCopyPollTime:
+		return minBlobCopyPollTime
+	case estimate > estimatedBlobCopyTime:
+		return estimatedBlobCopyTime
+	default:
+		return estimate
+	}
+}
+
+// parseCopyProgress parses the "<copied bytes>/<total bytes>" copy progress of
+// a blob.
+func parseCopyProgress(progress string) (int64, int64, error) {
+	copiedStr, totalStr, ok := strings.Cut(progress, "/")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid copy progress %q", progress)
+	}
+	copied, err := strconv.ParseInt(copiedStr, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid copied bytes in copy progress %q: %w", progress, err)
+	}
+	total, err := strconv.ParseInt(totalStr, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid total bytes in copy progress %q: %w", progress, err)
+	}
+	return copied, total, nil
+}
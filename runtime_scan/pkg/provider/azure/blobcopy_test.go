@@ -0,0 +1,89 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestBlobCopies_Acquire(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	copies := newBlobCopies()
+
+	first, ok := copies.acquire("scan-1", "result-1.vhd", 2)
+	g.Expect(ok).Should(BeTrue())
+	_, ok = copies.acquire("scan-1", "result-2.vhd", 2)
+	g.Expect(ok).Should(BeTrue())
+
+	// The copies of the scan are capped, the ones of other scans are not
+	_, ok = copies.acquire("scan-1", "result-3.vhd", 2)
+	g.Expect(ok).Should(BeFalse())
+	_, ok = copies.acquire("scan-2", "result-4.vhd", 2)
+	g.Expect(ok).Should(BeTrue())
+
+	// A copy in progress can always proceed
+	again, ok := copies.acquire("scan-1", "result-1.vhd", 2)
+	g.Expect(ok).Should(BeTrue())
+	g.Expect(again).Should(BeIdenticalTo(first))
+
+	// A done copy frees its slot
+	first.revoked = true
+	_, ok = copies.acquire("scan-1", "result-3.vhd", 2)
+	g.Expect(ok).Should(BeTrue())
+
+	copies.release("result-2.vhd")
+	_, ok = copies.acquire("scan-1", "result-5.vhd", 2)
+	g.Expect(ok).Should(BeTrue())
+	_, ok = copies.acquire("scan-1", "result-6.vhd", 2)
+	g.Expect(ok).Should(BeFalse())
+
+	// Not capped without a limit
+	_, ok = copies.acquire("scan-1", "result-6.vhd", 0)
+	g.Expect(ok).Should(BeTrue())
+}
+
+func TestBlobCopies_EstimateRemaining(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	copies := newBlobCopies()
+	c := copies.get("scan-1", "result-1.vhd")
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	// Nothing to estimate from before the second sample
+	g.Expect(copies.estimateRemaining(c, 0, 1000, now)).Should(Equal(estimatedBlobCopyTime))
+	// 10 bytes per second with 600 bytes remaining
+	g.Expect(copies.estimateRemaining(c, 400, 1000, now.Add(40*time.Second))).Should(Equal(time.Minute))
+	// Almost done
+	g.Expect(copies.estimateRemaining(c, 990, 1000, now.Add(60*time.Second))).Should(Equal(minBlobCopyPollTime))
+}
+
+func TestParseCopyProgress(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	copied, total, err := parseCopyProgress("512/2048")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(copied).Should(Equal(int64(512)))
+	g.Expect(total).Should(Equal(int64(2048)))
+
+	_, _, err = parseCopyProgress("512")
+	g.Expect(err).Should(HaveOccurred())
+	_, _, err = parseCopyProgress("a/2048")
+	g.Expect(err).Should(HaveOccurred())
+}
@@ -38,12 +38,19 @@ const (
 )
 
 type Client struct {
-	cred             azcore.TokenCredential
-	rgClient         *armresources.ResourceGroupsClient
-	vmClient         *armcompute.VirtualMachinesClient
-	snapshotsClient  *armcompute.SnapshotsClient
-	disksClient      *armcompute.DisksClient
-	interfacesClient *armnetwork.InterfacesClient
+	cred                 azcore.TokenCredential
+	rgClient             *armresources.ResourceGroupsClient
+	vmClient             *armcompute.VirtualMachinesClient
+	snapshotsClient      *armcompute.SnapshotsClient
+	disksClient          *armcompute.DisksClient
+	interfacesClient     *armnetwork.InterfacesClient
+	securityGroupsClient *armnetwork.SecurityGroupsClient
+	imagesClient         *armcompute.VirtualMachineImagesClient
+
+	communityGalleryImageVersionsClient *armcompute.CommunityGalleryImageVersionsClient
+	sharedGalleryImageVersionsClient    *armcompute.SharedGalleryImageVersionsClient
+
+	blobCopies *blobCopies
 
 	azureConfig Config
 }
@@ -61,6 +68,7 @@ func New(_ context.Context) (*Client, error) {
 
 	client := Client{
 		azureConfig: config,
+		blobCopies:  newBlobCopies(),
 	}
 
 	cred, err := azidentity.NewManagedIdentityCredential(nil)
@@ -79,6 +87,7 @@ func New(_ context.Context) (*Client, error) {
 		return nil, fmt.Errorf("failed to create network client factory: %w", err)
 	}
 	client.interfacesClient = networkClientFactory.NewInterfacesClient()
+	client.securityGroupsClient = networkClientFactory.NewSecurityGroupsClient()
 
 	computeClientFactory, err := armcompute.NewClientFactory(config.SubscriptionID, cred, nil)
 	if err != nil {
@@ -87,6 +96,9 @@ func New(_ context.Context) (*Client, error) {
 	client.vmClient = computeClientFactory.NewVirtualMachinesClient()
 	client.disksClient = computeClientFactory.NewDisksClient()
 	client.snapshotsClient = computeClientFactory.NewSnapshotsClient()
+	client.imagesClient = computeClientFactory.NewVirtualMachineImagesClient()
+	client.communityGalleryImageVersionsClient = computeClientFactory.NewCommunityGalleryImageVersionsClient()
+	client.sharedGalleryImageVersionsClient = computeClientFactory.NewSharedGalleryImageVersionsClient()
 
 	return &client, nil
 }
@@ -95,9 +107,23 @@ func (c Client) Kind() models.CloudProvider {
 	return models.Azure
 }
 
+func (c Client) Capabilities() models.ProviderCapabilities {
+	return models.ProviderCapabilities{
+		ScanStoppedInstances: true,
+		CrossRegion:          true,
+		EncryptedVolumes:     true,
+		SpotInstances:        false,
+		ScannerPlacement:     true,
+	}
+}
+
 // nolint:cyclop
 func (c *Client) RunTargetScan(ctx context.Context, config *provider.ScanJobConfig) error {
-	vmInfo, err := config.TargetInfo.AsVMInfo()
+	if config.InputImage != "" {
+		return provider.FatalErrorf("container image targets are not supported")
+	}
+
+	vmInfo, err := config.AssetInfo.AsVMInfo()
 	if err != nil {
 		return provider.FatalErrorf("unable to get vminfo from target: %w", err)
 	}
@@ -113,6 +139,13 @@ func (c *Client) RunTargetScan(ctx context.Context, config *provider.ScanJobConf
 		return err
 	}
 
+	if config.ScannerPlacement.GetAvoidTargetSubnet() {
+		err = c.ensureScannerNotInTargetSubnet(ctx, targetVM.VirtualMachine, c.scannerPlacement(config))
+		if err != nil {
+			return fmt.Errorf("failed to ensure scanner is not in target subnet: %w", err)
+		}
+	}
+
 	snapshot, err := c.ensureSnapshotForVMRootVolume(ctx, config, targetVM.VirtualMachine)
 	if err != nil {
 		return fmt.Errorf("failed to ensure snapshot for vm root volume: %w", err)
@@ -141,7 +174,7 @@ func (c *Client) RunTargetScan(ctx context.Context, config *provider.ScanJobConf
 		return fmt.Errorf("failed to ensure scanner virtual machine: %w", err)
 	}
 
-	err = c.ensureDiskAttachedToScannerVM(ctx, scannerVM, disk)
+	err = c.ensureDiskAttachedToScannerVM(ctx, config, scannerVM, disk)
 	if err != nil {
 		return fmt.Errorf("failed to ensure target disk is attached to virtual machine: %w", err)
 	}
@@ -170,6 +203,14 @@ func (c *Client) RemoveTargetScan(ctx context.Context, config *provider.ScanJobC
 		return fmt.Errorf("failed to ensure snapshot copy blob deleted: %w", err)
 	}
 
+	if config.DeltaScan {
+		err = c.ensureBaselineSnapshot(ctx, config)
+		if err != nil {
+			return fmt.Errorf("failed to ensure baseline snapshot kept: %w", err)
+		}
+		return nil
+	}
+
 	err = c.ensureSnapshotDeleted(ctx, config)
 	if err != nil {
 		return fmt.Errorf("failed to ensure snapshot deleted: %w", err)
@@ -207,8 +248,9 @@ func (c *Client) DiscoverScopes(ctx context.Context) (*models.Scopes, error) {
 }
 
 // nolint: cyclop
-func (c *Client) DiscoverTargets(ctx context.Context, scanScope *models.ScanScopeType) ([]models.TargetType, error) {
-	var ret []models.TargetType
+func (c *Client) DiscoverTargets(ctx context.Context, scanScope *models.ScanScopeType) ([]models.AssetType, error) {
+	var ret []models.AssetType
+	securityGroups := securityGroupCache{}
 
 	azureScanScope, err := scanScope.AsAzureScanScope()
 	if err != nil {
@@ -223,7 +265,7 @@ func (c *Client) DiscoverTargets(ctx context.Context, scanScope *models.ScanScop
 			if err != nil {
 				return nil, fmt.Errorf("failed to get next page: %w", err)
 			}
-			ts, err := processVirtualMachineListIntoTargetTypes(page.VirtualMachineListResult, azureScanScope)
+			ts, err := c.processVirtualMachineListIntoTargetTypes(ctx, page.VirtualMachineListResult, azureScanScope, securityGroups)
 			if err != nil {
 				return nil, err
 			}
@@ -240,7 +282,7 @@ func (c *Client) DiscoverTargets(ctx context.Context, scanScope *models.ScanScop
 			if err != nil {
 				return nil, fmt.Errorf("failed to get next page: %w", err)
 			}
-			ts, err := processVirtualMachineListIntoTargetTypes(page.VirtualMachineListResult, azureScanScope)
+			ts, err := c.processVirtualMachineListIntoTargetTypes(ctx, page.VirtualMachineListResult, azureScanScope, securityGroups)
 			if err != nil {
 				return nil, err
 			}
@@ -263,8 +305,8 @@ func resourceGroupAndNameFromInstanceID(instanceID string) (string, string, erro
 	return idParts[resourceGroupPartIdx], idParts[vmNamePartIdx], nil
 }
 
-func processVirtualMachineListIntoTargetTypes(vmList armcompute.VirtualMachineListResult, azureScanScope models.AzureScanScope) ([]models.TargetType, error) {
-	ret := make([]models.TargetType, 0, len(vmList.Value))
+func (c *Client) processVirtualMachineListIntoTargetTypes(ctx context.Context, vmList armcompute.VirtualMachineListResult, azureScanScope models.AzureScanScope, securityGroups securityGroupCache) ([]models.AssetType, error) {
+	ret := make([]models.AssetType, 0, len(vmList.Value))
 	for _, vm := range vmList.Value {
 		// filter by tags:
 		if !hasIncludeTags(vm, azureScanScope.InstanceTagSelector) {
@@ -273,7 +315,14 @@ func processVirtualMachineListIntoTargetTypes(vmList armcompute.VirtualMachineLi
 		if hasExcludeTags(vm, azureScanScope.InstanceTagExclusion) {
 			continue
 		}
-		info, err := getVMInfoFromVirtualMachine(vm)
+		powerState, err := c.getVMPowerState(ctx, vm)
+		if err != nil {
+			return nil, err
+		}
+		if !isScannablePowerState(powerState, utils.ValueOrZero(azureScanScope.ShouldScanStoppedInstances)) {
+			continue
+		}
+		info, err := getVMInfoFromVirtualMachine(vm, c.getVMSecurityGroups(ctx, vm, securityGroups))
 		if err != nil {
 			return nil, fmt.Errorf("unable to convert instance to vminfo: %w", err)
 		}
@@ -282,8 +331,51 @@ func processVirtualMachineListIntoTargetTypes(vmList armcompute.VirtualMachineLi
 	return ret, nil
 }
 
-func getVMInfoFromVirtualMachine(vm *armcompute.VirtualMachine) (models.TargetType, error) {
-	targetType := models.TargetType{}
+// getVMPowerState returns the power state of the VM instance from its instance
+// view, e.g. running or deallocated.
+func (c *Client) getVMPowerState(ctx context.Context, vm *armcompute.VirtualMachine) (string, error) {
+	resourceGroup, vmName, err := resourceGroupAndNameFromInstanceID(*vm.ID)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := c.vmClient.InstanceView(ctx, resourceGroup, vmName, nil)
+	if err != nil {
+		_, err = handleAzureRequestError(err, "getting instance view of virtual machine %s", vmName)
+		return "", err
+	}
+
+	return powerStateFromStatuses(res.Statuses), nil
+}
+
+func powerStateFromStatuses(statuses []*armcompute.InstanceViewStatus) string {
+	for _, status := range statuses {
+		if status == nil || status.Code == nil {
+			continue
+		}
+		if strings.HasPrefix(*status.Code, PowerStateCodePrefix) {
+			return strings.TrimPrefix(*status.Code, PowerStateCodePrefix)
+		}
+	}
+	return ""
+}
+
+// isScannablePowerState returns true if the VM instance in the power state is
+// scanned. The disks of the stopped and deallocated instances still exist, so
+// they are scanned from their snapshots like the running ones if requested.
+func isScannablePowerState(powerState string, scanStopped bool) bool {
+	switch powerState {
+	case PowerStateRunning:
+		return true
+	case PowerStateStopped, PowerStateDeallocated:
+		return scanStopped
+	default:
+		return false
+	}
+}
+
+func getVMInfoFromVirtualMachine(vm *armcompute.VirtualMachine, securityGroups []models.SecurityGroup) (models.AssetType, error) {
+	targetType := models.AssetType{}
 	err := targetType.FromVMInfo(models.VMInfo{
 		ObjectType:       "VMInfo",
 		InstanceProvider: utils.PointerTo(models.Azure),
@@ -292,8 +384,9 @@ func getVMInfoFromVirtualMachine(vm *armcompute.VirtualMachine) (models.TargetTy
 		InstanceType:     *vm.Type,
 		LaunchTime:       *vm.Properties.TimeCreated,
 		Location:         *vm.Location,
+		LocationMetadata: newLocationMetadata(*vm.Location, vm.Zones),
 		Platform:         string(*vm.Properties.StorageProfile.OSDisk.OSType),
-		SecurityGroups:   &[]models.SecurityGroup{},
+		SecurityGroups:   &securityGroups,
 		Tags:             convertTags(vm.Tags),
 	})
 	if err != nil {
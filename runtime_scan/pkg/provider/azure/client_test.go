@@ -0,0 +1,107 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v4"
+	. "github.com/onsi/gomega"
+
+	"github.com/openclarity/vmclarity/api/models"
+)
+
+func TestPowerStateFromStatuses(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(powerStateFromStatuses([]*armcompute.InstanceViewStatus{
+		{Code: to.Ptr("ProvisioningState/succeeded")},
+		{Code: to.Ptr("PowerState/deallocated")},
+	})).Should(Equal(PowerStateDeallocated))
+	g.Expect(powerStateFromStatuses([]*armcompute.InstanceViewStatus{
+		nil,
+		{Code: to.Ptr("ProvisioningState/updating")},
+	})).Should(BeEmpty())
+}
+
+func TestIsScannablePowerState(t *testing.T) {
+	tests := []struct {
+		Name        string
+		PowerState  string
+		ScanStopped bool
+
+		ExpectedScannable bool
+	}{
+		{
+			Name:              "Running",
+			PowerState:        PowerStateRunning,
+			ExpectedScannable: true,
+		},
+		{
+			Name:              "Deallocated",
+			PowerState:        PowerStateDeallocated,
+			ExpectedScannable: false,
+		},
+		{
+			Name:              "Deallocated with stopped instances",
+			PowerState:        PowerStateDeallocated,
+			ScanStopped:       true,
+			ExpectedScannable: true,
+		},
+		{
+			Name:              "Stopped with stopped instances",
+			PowerState:        PowerStateStopped,
+			ScanStopped:       true,
+			ExpectedScannable: true,
+		},
+		{
+			Name:              "Deallocating with stopped instances",
+			PowerState:        "deallocating",
+			ScanStopped:       true,
+			ExpectedScannable: false,
+		},
+		{
+			Name:              "Unknown",
+			PowerState:        "",
+			ScanStopped:       true,
+			ExpectedScannable: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			g.Expect(isScannablePowerState(test.PowerState, test.ScanStopped)).Should(Equal(test.ExpectedScannable))
+		})
+	}
+}
+
+func TestNewLocationMetadata(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(newLocationMetadata("West Europe", []*string{to.Ptr("2")})).Should(Equal(&models.LocationMetadata{
+		Cloud:   to.Ptr(models.Azure),
+		Region:  to.Ptr("westeurope"),
+		Zone:    to.Ptr("westeurope-2"),
+		Country: to.Ptr("NL"),
+	}))
+	g.Expect(newLocationMetadata("newregion", nil)).Should(Equal(&models.LocationMetadata{
+		Cloud:  to.Ptr(models.Azure),
+		Region: to.Ptr("newregion"),
+	}))
+}
@@ -16,13 +16,16 @@
 package azure
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 
+	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
 )
 
@@ -69,5 +72,27 @@ func ensureDeleted(resourceType string, getFunc func() error, deleteFunc func()
 		return err
 	}
 
-	return provider.RetryableErrorf(estimateTime, "%s delete issued", resourceType)
+	return provider.InProgressErrorf(estimateTime, "%s delete issued", resourceType)
+}
+
+// startOperation starts the provider.Operation of the given type. The request
+// must be sent with the returned context so that the ID Azure assigned to it
+// is captured, and the returned function must be called with its outcome.
+func startOperation(ctx context.Context, operationType models.ProviderOperationType, location string) (context.Context, func(resourceName string, err error)) {
+	var resp *http.Response
+	op := provider.StartOperation(ctx, models.Azure, operationType, location)
+	return runtime.WithCaptureResponse(ctx, &resp), func(resourceName string, err error) {
+		op.Finish(ctx, resourceName, requestIDFromResponse(resp, err), err)
+	}
+}
+
+func requestIDFromResponse(resp *http.Response, err error) string {
+	var respError *azcore.ResponseError
+	if errors.As(err, &respError) && respError.RawResponse != nil {
+		resp = respError.RawResponse
+	}
+	if resp == nil {
+		return ""
+	}
+	return resp.Header.Get("x-ms-request-id")
 }
@@ -21,10 +21,18 @@ import (
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
+
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
 )
 
 const (
 	DefaultEnvPrefix = "VMCLARITY_AZURE"
+	// LatestScannerImageVersion makes the provider resolve the latest
+	// version of the Scanner image on ScannerImageChannel at scan time.
+	LatestScannerImageVersion = "latest"
+	// CandidateScannerImageSKUSuffix is appended to ScannerImageSKU for the
+	// SKU of the Scanner images on the candidate channel.
+	CandidateScannerImageSKUSuffix = "-candidate"
 )
 
 type AzurePublicKey string
@@ -51,6 +59,8 @@ type Config struct {
 	ScannerImageOffer           string         `mapstructure:"scanner_image_offer"`
 	ScannerImageSKU             string         `mapstructure:"scanner_image_sku"`
 	ScannerImageVersion         string         `mapstructure:"scanner_image_version"`
+	ScannerImageChannel         string         `mapstructure:"scanner_image_channel"`
+	ScannerImageGalleryImageID  string         `mapstructure:"scanner_image_gallery_image_id"`
 	ScannerSecurityGroup        string         `mapstructure:"scanner_security_group"`
 	ScannerStorageAccountName   string         `mapstructure:"scanner_storage_account_name"`
 	ScannerStorageContainerName string         `mapstructure:"scanner_storage_container_name"`
@@ -74,6 +84,8 @@ func NewConfig() (Config, error) {
 	_ = v.BindEnv("scanner_image_offer")
 	_ = v.BindEnv("scanner_image_sku")
 	_ = v.BindEnv("scanner_image_version")
+	_ = v.BindEnv("scanner_image_channel")
+	_ = v.BindEnv("scanner_image_gallery_image_id")
 	_ = v.BindEnv("scanner_security_group")
 	_ = v.BindEnv("scanner_storage_account_name")
 	_ = v.BindEnv("scanner_storage_container_name")
@@ -107,20 +119,30 @@ func (c Config) Validate() error {
 		return fmt.Errorf("parameter ScannerVMSize must be provided")
 	}
 
-	if c.ScannerImagePublisher == "" {
-		return fmt.Errorf("parameter ScannerImagePublisher must be provided")
-	}
+	if c.ScannerImageGalleryImageID != "" {
+		_, version, err := parseGalleryImageID(c.ScannerImageGalleryImageID)
+		if err != nil {
+			return fmt.Errorf("parameter ScannerImageGalleryImageID is invalid: %w", err)
+		}
+		if version != "" {
+			return fmt.Errorf("parameter ScannerImageGalleryImageID must not include the version, which is set by ScannerImageVersion")
+		}
+	} else {
+		if c.ScannerImagePublisher == "" {
+			return fmt.Errorf("parameter ScannerImagePublisher must be provided")
+		}
 
-	if c.ScannerImageOffer == "" {
-		return fmt.Errorf("parameter ScannerImageOffer must be provided")
-	}
+		if c.ScannerImageOffer == "" {
+			return fmt.Errorf("parameter ScannerImageOffer must be provided")
+		}
 
-	if c.ScannerImageSKU == "" {
-		return fmt.Errorf("parameter ScannerImageSKU must be provided")
+		if c.ScannerImageSKU == "" {
+			return fmt.Errorf("parameter ScannerImageSKU must be provided")
+		}
 	}
 
-	if c.ScannerImageVersion == "" {
-		return fmt.Errorf("parameter ScannerImageVersion must be provided")
+	if _, err := provider.NewScannerImageChannel(c.ScannerImageChannel); err != nil {
+		return fmt.Errorf("parameter ScannerImageChannel is invalid: %w", err)
 	}
 
 	if c.ScannerSecurityGroup == "" {
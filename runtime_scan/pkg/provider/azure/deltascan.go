@@ -0,0 +1,262 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v4"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/pageblob"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// TagKeyDeltaScanBaseline marks the incremental snapshot kept for delta
+// scanning, its value is the ID of the ScanResult which scanned it.
+const TagKeyDeltaScanBaseline = "VMClarity.DeltaScanBaseline"
+
+// MaxChangedBlockRanges is the maximum number of changed block ranges reported
+// for a delta scan. Disks with more changes are scanned in full.
+const MaxChangedBlockRanges = 10000
+
+// GetDeltaScanInfo compares the incremental snapshot created for the scan with
+// the baseline snapshot kept from the previous successful scan of the same
+// disk.
+func (c *Client) GetDeltaScanInfo(ctx context.Context, config *provider.ScanJobConfig) (*models.DeltaScanInfo, error) {
+	snapshotName := snapshotNameFromJobConfig(config)
+	snapshotRes, err := c.snapshotsClient.Get(ctx, c.azureConfig.ScannerResourceGroup, snapshotName, nil)
+	if err != nil {
+		_, err = handleAzureRequestError(err, "getting snapshot %s", snapshotName)
+		return nil, err
+	}
+
+	baseline, err := c.getBaselineSnapshot(ctx, snapshotRes.Snapshot)
+	if err != nil {
+		return nil, err
+	}
+	if baseline == nil {
+		return nil, nil
+	}
+
+	snapshotURL, err := c.grantSnapshotAccess(ctx, snapshotName)
+	if err != nil {
+		return nil, err
+	}
+	defer c.revokeSnapshotAccess(ctx, snapshotName)
+
+	baselineURL, err := c.grantSnapshotAccess(ctx, *baseline.Name)
+	if err != nil {
+		return nil, err
+	}
+	defer c.revokeSnapshotAccess(ctx, *baseline.Name)
+
+	pageBlobClient, err := pageblob.NewClientWithNoCredential(snapshotURL, nil)
+	if err != nil {
+		return nil, provider.FatalErrorf("failed to init page blob client: %w", err)
+	}
+
+	var pageRanges []pageRange
+	pager := pageBlobClient.NewGetPageRangesDiffPager(&pageblob.GetPageRangesDiffOptions{
+		PrevSnapshotURL: &baselineURL,
+	})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			_, err = handleAzureRequestError(err, "getting changed pages of snapshot %s", snapshotName)
+			return nil, err
+		}
+		for _, r := range page.PageRange {
+			pageRanges = append(pageRanges, pageRange{start: *r.Start, end: *r.End})
+		}
+		// Cleared pages are changed as well.
+		for _, r := range page.ClearRange {
+			pageRanges = append(pageRanges, pageRange{start: *r.Start, end: *r.End})
+		}
+	}
+
+	changedBlocks := changedBlockRanges(pageRanges)
+	if len(changedBlocks) > MaxChangedBlockRanges {
+		return nil, nil
+	}
+
+	return &models.DeltaScanInfo{
+		BaseScanResultID: baseline.Tags[TagKeyDeltaScanBaseline],
+		ChangedBlocks:    &changedBlocks,
+	}, nil
+}
+
+// getBaselineSnapshots returns the baseline snapshots of the disk the snapshot
+// was created from.
+func (c *Client) getBaselineSnapshots(ctx context.Context, snapshot armcompute.Snapshot) ([]*armcompute.Snapshot, error) {
+	sourceID := snapshot.Properties.CreationData.SourceResourceID
+
+	var ret []*armcompute.Snapshot
+	pager := c.snapshotsClient.NewListByResourceGroupPager(c.azureConfig.ScannerResourceGroup, nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			_, err = handleAzureRequestError(err, "listing snapshots")
+			return nil, err
+		}
+		for _, snap := range page.Value {
+			if snap.Name == nil || *snap.Name == *snapshot.Name || snap.Tags[TagKeyDeltaScanBaseline] == nil {
+				continue
+			}
+			if snap.Properties == nil || snap.Properties.CreationData == nil || snap.Properties.CreationData.SourceResourceID == nil {
+				continue
+			}
+			if *snap.Properties.CreationData.SourceResourceID == *sourceID {
+				ret = append(ret, snap)
+			}
+		}
+	}
+
+	return ret, nil
+}
+
+// getBaselineSnapshot returns the latest incremental baseline snapshot of the
+// disk the snapshot was created from, or nil if there is none.
+func (c *Client) getBaselineSnapshot(ctx context.Context, snapshot armcompute.Snapshot) (*armcompute.Snapshot, error) {
+	snapshots, err := c.getBaselineSnapshots(ctx, snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	var baseline *armcompute.Snapshot
+	for _, snap := range snapshots {
+		props := snap.Properties
+		if props.Incremental == nil || !*props.Incremental || props.TimeCreated == nil {
+			continue
+		}
+		if props.ProvisioningState == nil || *props.ProvisioningState != ProvisioningStateSucceeded {
+			continue
+		}
+		if baseline == nil || props.TimeCreated.After(*baseline.Properties.TimeCreated) {
+			baseline = snap
+		}
+	}
+
+	return baseline, nil
+}
+
+// ensureBaselineSnapshot keeps the snapshot created for the scan as the
+// baseline of the next delta scan of the disk, and deletes the previous
+// baseline snapshots of the disk.
+func (c *Client) ensureBaselineSnapshot(ctx context.Context, config *provider.ScanJobConfig) error {
+	snapshotName := snapshotNameFromJobConfig(config)
+	snapshotRes, err := c.snapshotsClient.Get(ctx, c.azureConfig.ScannerResourceGroup, snapshotName, nil)
+	if err != nil {
+		notFound, err := handleAzureRequestError(err, "getting snapshot %s", snapshotName)
+		if notFound {
+			return nil
+		}
+		return err
+	}
+
+	previous, err := c.getBaselineSnapshots(ctx, snapshotRes.Snapshot)
+	if err != nil {
+		return err
+	}
+	for _, snap := range previous {
+		opCtx, finish := startOperation(ctx, models.SnapshotDelete, utils.ValueOrZero(snap.Location))
+		_, err = c.snapshotsClient.BeginDelete(opCtx, c.azureConfig.ScannerResourceGroup, *snap.Name, nil)
+		finish(*snap.Name, err)
+		if err != nil {
+			_, err := handleAzureRequestError(err, "deleting previous baseline snapshot %s", *snap.Name)
+			return err
+		}
+	}
+
+	if snapshotRes.Tags[TagKeyDeltaScanBaseline] != nil {
+		return nil
+	}
+
+	tags := snapshotRes.Tags
+	if tags == nil {
+		tags = map[string]*string{}
+	}
+	tags[TagKeyDeltaScanBaseline] = to.Ptr(config.ScanResultID)
+	_, err = c.snapshotsClient.BeginUpdate(ctx, c.azureConfig.ScannerResourceGroup, snapshotName, armcompute.SnapshotUpdate{
+		Tags: tags,
+	}, nil)
+	if err != nil {
+		_, err := handleAzureRequestError(err, "tagging baseline snapshot %s", snapshotName)
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) grantSnapshotAccess(ctx context.Context, snapshotName string) (string, error) {
+	poller, err := c.snapshotsClient.BeginGrantAccess(ctx, c.azureConfig.ScannerResourceGroup, snapshotName, armcompute.GrantAccessData{
+		Access:            to.Ptr(armcompute.AccessLevelRead),
+		DurationInSeconds: to.Ptr[int32](int32(snapshotSASAccessSeconds)),
+	}, nil)
+	if err != nil {
+		_, err := handleAzureRequestError(err, "granting SAS access to snapshot %s", snapshotName)
+		return "", err
+	}
+
+	res, err := poller.PollUntilDone(ctx, nil)
+	if err != nil {
+		_, err := handleAzureRequestError(err, "waiting for SAS access to snapshot %s be granted", snapshotName)
+		return "", err
+	}
+
+	return *res.AccessURI.AccessSAS, nil
+}
+
+func (c *Client) revokeSnapshotAccess(ctx context.Context, snapshotName string) {
+	poller, err := c.snapshotsClient.BeginRevokeAccess(ctx, c.azureConfig.ScannerResourceGroup, snapshotName, nil)
+	if err != nil {
+		return
+	}
+	_, _ = poller.PollUntilDone(ctx, nil)
+}
+
+// pageRange is a range of bytes of a page blob, the end is inclusive.
+type pageRange struct {
+	start int64
+	end   int64
+}
+
+// changedBlockRanges merges the changed page ranges into ranges of
+// consecutive changed bytes.
+func changedBlockRanges(pageRanges []pageRange) []models.ChangedBlockRange {
+	sort.Slice(pageRanges, func(i, j int) bool {
+		return pageRanges[i].start < pageRanges[j].start
+	})
+
+	ranges := []models.ChangedBlockRange{}
+	for _, r := range pageRanges {
+		if last := len(ranges) - 1; last >= 0 && r.start <= ranges[last].Offset+ranges[last].Length {
+			if end := r.end + 1; end > ranges[last].Offset+ranges[last].Length {
+				ranges[last].Length = end - ranges[last].Offset
+			}
+			continue
+		}
+		ranges = append(ranges, models.ChangedBlockRange{
+			Offset: r.start,
+			Length: r.end - r.start + 1,
+		})
+	}
+
+	return ranges
+}
@@ -0,0 +1,149 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v4"
+)
+
+type galleryKind string
+
+const (
+	communityGallery galleryKind = "CommunityGalleries"
+	sharedGallery    galleryKind = "SharedGalleries"
+)
+
+// galleryImage is an image definition of a community or a direct shared
+// gallery, which is referenced by its ID in the
+// /CommunityGalleries/<gallery>/Images/<image> or
+// /SharedGalleries/<gallery>/Images/<image> format.
+type galleryImage struct {
+	kind    galleryKind
+	gallery string
+	image   string
+}
+
+// parseGalleryImageID parses the ID of a gallery image definition, optionally
+// followed by /Versions/<version> in which case the version is returned too.
+func parseGalleryImageID(id string) (galleryImage, string, error) {
+	parts := strings.Split(id, "/")
+	if (len(parts) != 5 && len(parts) != 7) || parts[0] != "" || parts[2] == "" ||
+		!strings.EqualFold(parts[3], "Images") || parts[4] == "" {
+		return galleryImage{}, "", fmt.Errorf("gallery image %s is not in /CommunityGalleries/<gallery>/Images/<image> or /SharedGalleries/<gallery>/Images/<image> format", id)
+	}
+
+	var kind galleryKind
+	switch {
+	case strings.EqualFold(parts[1], string(communityGallery)):
+		kind = communityGallery
+	case strings.EqualFold(parts[1], string(sharedGallery)):
+		kind = sharedGallery
+	default:
+		return galleryImage{}, "", fmt.Errorf("gallery image %s is not in a community or a shared gallery", id)
+	}
+
+	var version string
+	if len(parts) == 7 {
+		if !strings.EqualFold(parts[5], "Versions") || parts[6] == "" {
+			return galleryImage{}, "", fmt.Errorf("gallery image version %s is not in <image>/Versions/<version> format", id)
+		}
+		version = parts[6]
+	}
+
+	return galleryImage{
+		kind:    kind,
+		gallery: parts[2],
+		image:   parts[4],
+	}, version, nil
+}
+
+// withImage returns the image definition with the given name in the same
+// gallery.
+func (g galleryImage) withImage(image string) galleryImage {
+	g.image = image
+	return g
+}
+
+// versionID returns the ID of the version of the image, which can be latest.
+func (g galleryImage) versionID(version string) string {
+	return fmt.Sprintf("/%s/%s/Images/%s/Versions/%s", g.kind, g.gallery, g.image, version)
+}
+
+func (g galleryImage) imageReference(version string) *armcompute.ImageReference {
+	if g.kind == communityGallery {
+		return &armcompute.ImageReference{CommunityGalleryImageID: to.Ptr(g.versionID(version))}
+	}
+	return &armcompute.ImageReference{SharedGalleryImageID: to.Ptr(g.versionID(version))}
+}
+
+// listGalleryImageVersions returns the versions of the gallery image which
+// are not excluded from latest, the same versions Azure picks latest from.
+func (c *Client) listGalleryImageVersions(ctx context.Context, image galleryImage) ([]string, error) {
+	var versions []string
+	addVersion := func(name *string, excludeFromLatest *bool) {
+		if name == nil || (excludeFromLatest != nil && *excludeFromLatest) {
+			return
+		}
+		versions = append(versions, *name)
+	}
+
+	if image.kind == communityGallery {
+		pager := c.communityGalleryImageVersionsClient.NewListPager(c.azureConfig.ScannerLocation, image.gallery, image.image, nil)
+		for pager.More() {
+			page, err := pager.NextPage(ctx)
+			if err != nil {
+				_, err = handleAzureRequestError(err, "listing community gallery image versions")
+				return nil, err
+			}
+			for _, version := range page.Value {
+				if version == nil {
+					continue
+				}
+				var excludeFromLatest *bool
+				if version.Properties != nil {
+					excludeFromLatest = version.Properties.ExcludeFromLatest
+				}
+				addVersion(version.Name, excludeFromLatest)
+			}
+		}
+		return versions, nil
+	}
+
+	pager := c.sharedGalleryImageVersionsClient.NewListPager(c.azureConfig.ScannerLocation, image.gallery, image.image, nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			_, err = handleAzureRequestError(err, "listing shared gallery image versions")
+			return nil, err
+		}
+		for _, version := range page.Value {
+			if version == nil {
+				continue
+			}
+			var excludeFromLatest *bool
+			if version.Properties != nil {
+				excludeFromLatest = version.Properties.ExcludeFromLatest
+			}
+			addVersion(version.Name, excludeFromLatest)
+		}
+	}
+	return versions, nil
+}
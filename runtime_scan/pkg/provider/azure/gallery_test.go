@@ -0,0 +1,146 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v4"
+	. "github.com/onsi/gomega"
+
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+)
+
+func TestParseGalleryImageID(t *testing.T) {
+	tests := []struct {
+		Name string
+		ID   string
+
+		ExpectedImage   galleryImage
+		ExpectedVersion string
+		ExpectedErr     bool
+	}{
+		{
+			Name:          "Community gallery image",
+			ID:            "/CommunityGalleries/vmclarity-1a2b3c/Images/scanner",
+			ExpectedImage: galleryImage{kind: communityGallery, gallery: "vmclarity-1a2b3c", image: "scanner"},
+		},
+		{
+			Name:            "Shared gallery image version",
+			ID:              "/sharedGalleries/0000-1111-acme/images/scanner/versions/1.2.0",
+			ExpectedImage:   galleryImage{kind: sharedGallery, gallery: "0000-1111-acme", image: "scanner"},
+			ExpectedVersion: "1.2.0",
+		},
+		{
+			Name:        "Private gallery image",
+			ID:          "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/galleries/acme/images/scanner",
+			ExpectedErr: true,
+		},
+		{
+			Name:        "Marketplace image",
+			ID:          "openclarity:vmclarity:scanner:latest",
+			ExpectedErr: true,
+		},
+		{
+			Name:        "Missing version",
+			ID:          "/CommunityGalleries/vmclarity-1a2b3c/Images/scanner/Versions/",
+			ExpectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			image, version, err := parseGalleryImageID(test.ID)
+			if test.ExpectedErr {
+				g.Expect(err).Should(HaveOccurred())
+				return
+			}
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(image).Should(Equal(test.ExpectedImage))
+			g.Expect(version).Should(Equal(test.ExpectedVersion))
+		})
+	}
+}
+
+func TestScannerImageReference(t *testing.T) {
+	tests := []struct {
+		Name                 string
+		Config               Config
+		ScannerInstanceImage string
+
+		ExpectedReference *armcompute.ImageReference
+	}{
+		{
+			Name: "Marketplace image",
+			Config: Config{
+				ScannerImagePublisher: "openclarity",
+				ScannerImageOffer:     "vmclarity",
+				ScannerImageSKU:       "scanner",
+			},
+			ExpectedReference: &armcompute.ImageReference{
+				Publisher: to.Ptr("openclarity"),
+				Offer:     to.Ptr("vmclarity"),
+				SKU:       to.Ptr("scanner"),
+				Version:   to.Ptr("latest"),
+			},
+		},
+		{
+			Name: "Pinned community gallery image",
+			Config: Config{
+				ScannerImageGalleryImageID: "/CommunityGalleries/vmclarity-1a2b3c/Images/scanner",
+				ScannerImageVersion:        "1.2.0",
+			},
+			ExpectedReference: &armcompute.ImageReference{
+				CommunityGalleryImageID: to.Ptr("/CommunityGalleries/vmclarity-1a2b3c/Images/scanner/Versions/1.2.0"),
+			},
+		},
+		{
+			Name: "Latest shared gallery image",
+			Config: Config{
+				ScannerImageGalleryImageID: "/SharedGalleries/0000-1111-acme/Images/scanner",
+			},
+			ExpectedReference: &armcompute.ImageReference{
+				SharedGalleryImageID: to.Ptr("/SharedGalleries/0000-1111-acme/Images/scanner/Versions/latest"),
+			},
+		},
+		{
+			Name: "Resolved shared gallery image",
+			Config: Config{
+				ScannerImageGalleryImageID: "/SharedGalleries/0000-1111-acme/Images/scanner",
+			},
+			ScannerInstanceImage: "/SharedGalleries/0000-1111-acme/Images/scanner-candidate/Versions/1.3.0",
+			ExpectedReference: &armcompute.ImageReference{
+				SharedGalleryImageID: to.Ptr("/SharedGalleries/0000-1111-acme/Images/scanner-candidate/Versions/1.3.0"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			client := &Client{azureConfig: test.Config}
+			reference, err := client.scannerImageReference(&provider.ScanJobConfig{
+				ScannerInstanceImage: test.ScannerInstanceImage,
+			})
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(reference).Should(Equal(test.ExpectedReference))
+		})
+	}
+}
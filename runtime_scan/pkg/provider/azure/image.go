@@ -0,0 +1,165 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v4"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// imageURNParts is the number of parts of an image URN in the
+// publisher:offer:sku:version format.
+const imageURNParts = 4
+
+// ResolveScannerImage returns the latest version of the Scanner image on the
+// configured channel in the scanner location, or nil if the version is pinned
+// in the config.
+func (c *Client) ResolveScannerImage(ctx context.Context) (*models.ScannerInstanceImage, error) {
+	if c.azureConfig.ScannerImageVersion != "" && c.azureConfig.ScannerImageVersion != LatestScannerImageVersion {
+		return nil, nil
+	}
+
+	channel, err := provider.NewScannerImageChannel(c.azureConfig.ScannerImageChannel)
+	if err != nil {
+		return nil, provider.FatalErrorf("invalid scanner image channel: %w", err)
+	}
+
+	if c.azureConfig.ScannerImageGalleryImageID != "" {
+		return c.resolveGalleryScannerImage(ctx, channel)
+	}
+
+	sku := scannerImageSKU(c.azureConfig.ScannerImageSKU, channel)
+	res, err := c.imagesClient.List(ctx, c.azureConfig.ScannerLocation, c.azureConfig.ScannerImagePublisher,
+		c.azureConfig.ScannerImageOffer, sku, nil)
+	if err != nil {
+		_, err = handleAzureRequestError(err, "listing scanner image versions")
+		return nil, err
+	}
+
+	versions := make([]string, 0, len(res.VirtualMachineImageResourceArray))
+	for _, image := range res.VirtualMachineImageResourceArray {
+		if image == nil || image.Name == nil {
+			continue
+		}
+		versions = append(versions, *image.Name)
+	}
+
+	version, ok := provider.LatestVersion(versions, channel)
+	if !ok {
+		return nil, provider.FatalErrorf("no scanner image found on channel %s. Publisher=%s Offer=%s SKU=%s",
+			channel, c.azureConfig.ScannerImagePublisher, c.azureConfig.ScannerImageOffer, sku)
+	}
+
+	return &models.ScannerInstanceImage{
+		Reference: strings.Join([]string{c.azureConfig.ScannerImagePublisher, c.azureConfig.ScannerImageOffer, sku, version}, ":"),
+		Version:   utils.PointerTo(version),
+		Channel:   utils.PointerTo(channel),
+	}, nil
+}
+
+// resolveGalleryScannerImage returns the latest version of the Scanner image
+// in the configured gallery, the candidate images are published as a separate
+// image definition like the SKU of the marketplace images.
+func (c *Client) resolveGalleryScannerImage(ctx context.Context, channel models.ScannerImageChannel) (*models.ScannerInstanceImage, error) {
+	image, _, err := parseGalleryImageID(c.azureConfig.ScannerImageGalleryImageID)
+	if err != nil {
+		return nil, provider.FatalErrorf("invalid scanner gallery image: %w", err)
+	}
+	image = image.withImage(scannerImageSKU(image.image, channel))
+
+	versions, err := c.listGalleryImageVersions(ctx, image)
+	if err != nil {
+		return nil, err
+	}
+
+	version, ok := provider.LatestVersion(versions, channel)
+	if !ok {
+		return nil, provider.FatalErrorf("no scanner image found on channel %s. GalleryImage=%s",
+			channel, image.versionID(LatestScannerImageVersion))
+	}
+
+	return &models.ScannerInstanceImage{
+		Reference: image.versionID(version),
+		Version:   utils.PointerTo(version),
+		Channel:   utils.PointerTo(channel),
+	}, nil
+}
+
+// scannerImageSKU returns the SKU of the Scanner images on the channel. Azure
+// image versions can't have pre-release identifiers, so candidate images are
+// published with a separate SKU.
+func scannerImageSKU(sku string, channel models.ScannerImageChannel) string {
+	if channel == models.Candidate {
+		return sku + CandidateScannerImageSKUSuffix
+	}
+	return sku
+}
+
+// scannerImageReference returns the image resolved for the scan in the
+// publisher:offer:sku:version format or as a gallery image version ID, or the
+// configured one.
+func (c *Client) scannerImageReference(config *provider.ScanJobConfig) (*armcompute.ImageReference, error) {
+	if config.ScannerInstanceImage == "" {
+		version := c.azureConfig.ScannerImageVersion
+		if version == "" {
+			version = LatestScannerImageVersion
+		}
+		if c.azureConfig.ScannerImageGalleryImageID != "" {
+			image, _, err := parseGalleryImageID(c.azureConfig.ScannerImageGalleryImageID)
+			if err != nil {
+				return nil, err
+			}
+			return image.imageReference(version), nil
+		}
+		return &armcompute.ImageReference{
+			Offer:     to.Ptr(c.azureConfig.ScannerImageOffer),
+			Publisher: to.Ptr(c.azureConfig.ScannerImagePublisher),
+			SKU:       to.Ptr(c.azureConfig.ScannerImageSKU),
+			Version:   to.Ptr(version),
+		}, nil
+	}
+
+	if strings.HasPrefix(config.ScannerInstanceImage, "/") {
+		image, version, err := parseGalleryImageID(config.ScannerInstanceImage)
+		if err != nil {
+			return nil, err
+		}
+		if version == "" {
+			return nil, fmt.Errorf("gallery image %s has no version", config.ScannerInstanceImage)
+		}
+		return image.imageReference(version), nil
+	}
+
+	parts := strings.Split(config.ScannerInstanceImage, ":")
+	if len(parts) != imageURNParts {
+		return nil, fmt.Errorf("image reference %s is not in publisher:offer:sku:version format", config.ScannerInstanceImage)
+	}
+
+	return &armcompute.ImageReference{
+		Publisher: to.Ptr(parts[0]),
+		Offer:     to.Ptr(parts[1]),
+		SKU:       to.Ptr(parts[2]),
+		Version:   to.Ptr(parts[3]),
+	}, nil
+}
@@ -0,0 +1,105 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"strings"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// regionCountries are the ISO 3166-1 alpha-2 codes of the countries of the
+// Azure regions by their normalized name.
+var regionCountries = map[string]string{
+	"australiacentral":   "AU",
+	"australiacentral2":  "AU",
+	"australiaeast":      "AU",
+	"australiasoutheast": "AU",
+	"brazilsouth":        "BR",
+	"brazilsoutheast":    "BR",
+	"canadacentral":      "CA",
+	"canadaeast":         "CA",
+	"centralindia":       "IN",
+	"centralus":          "US",
+	"chinaeast":          "CN",
+	"chinaeast2":         "CN",
+	"chinanorth":         "CN",
+	"chinanorth2":        "CN",
+	"eastasia":           "HK",
+	"eastus":             "US",
+	"eastus2":            "US",
+	"francecentral":      "FR",
+	"francesouth":        "FR",
+	"germanynorth":       "DE",
+	"germanywestcentral": "DE",
+	"israelcentral":      "IL",
+	"italynorth":         "IT",
+	"japaneast":          "JP",
+	"japanwest":          "JP",
+	"koreacentral":       "KR",
+	"koreasouth":         "KR",
+	"mexicocentral":      "MX",
+	"northcentralus":     "US",
+	"northeurope":        "IE",
+	"norwayeast":         "NO",
+	"norwaywest":         "NO",
+	"polandcentral":      "PL",
+	"qatarcentral":       "QA",
+	"southafricanorth":   "ZA",
+	"southafricawest":    "ZA",
+	"southcentralus":     "US",
+	"southeastasia":      "SG",
+	"southindia":         "IN",
+	"spaincentral":       "ES",
+	"swedencentral":      "SE",
+	"switzerlandnorth":   "CH",
+	"switzerlandwest":    "CH",
+	"uaecentral":         "AE",
+	"uaenorth":           "AE",
+	"uksouth":            "GB",
+	"ukwest":             "GB",
+	"westcentralus":      "US",
+	"westeurope":         "NL",
+	"westindia":          "IN",
+	"westus":             "US",
+	"westus2":            "US",
+	"westus3":            "US",
+}
+
+// normalizeRegion returns the name of the region in the form of the Azure
+// APIs, e.g. westeurope, from either it or its display name, e.g. West Europe.
+func normalizeRegion(location string) string {
+	return strings.ToLower(strings.ReplaceAll(location, " ", ""))
+}
+
+// newLocationMetadata returns the normalized location of a virtual machine in
+// the location and the availability zones. The zones are numbered within the
+// regions, so they are prefixed by the region.
+func newLocationMetadata(location string, zones []*string) *models.LocationMetadata {
+	region := normalizeRegion(location)
+	metadata := &models.LocationMetadata{
+		Cloud:  utils.PointerTo(models.Azure),
+		Region: utils.PointerTo(region),
+	}
+	if len(zones) > 0 && zones[0] != nil && *zones[0] != "" {
+		metadata.Zone = utils.PointerTo(region + "-" + *zones[0])
+	}
+	if country, ok := regionCountries[region]; ok {
+		metadata.Country = utils.PointerTo(country)
+	}
+	return metadata
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v3"
 
+	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
 )
 
@@ -37,11 +38,12 @@ func networkInterfaceNameFromJobConfig(config *provider.ScanJobConfig) string {
 
 func (c *Client) ensureNetworkInterface(ctx context.Context, config *provider.ScanJobConfig) (armnetwork.Interface, error) {
 	nicName := networkInterfaceNameFromJobConfig(config)
+	placement := c.scannerPlacement(config)
 
-	nicResp, err := c.interfacesClient.Get(ctx, c.azureConfig.ScannerResourceGroup, nicName, nil)
+	nicResp, err := c.interfacesClient.Get(ctx, placement.ResourceGroup, nicName, nil)
 	if err == nil {
 		if *nicResp.Interface.Properties.ProvisioningState != ProvisioningStateSucceeded {
-			return nicResp.Interface, provider.RetryableErrorf(NetworkInterfaceEstimateProvisionTime, "interface is not ready yet, provisioning state: %s", *nicResp.Interface.Properties.ProvisioningState)
+			return nicResp.Interface, provider.InProgressErrorf(NetworkInterfaceEstimateProvisionTime, "interface is not ready yet, provisioning state: %s", *nicResp.Interface.Properties.ProvisioningState)
 		}
 
 		return nicResp.Interface, nil
@@ -61,37 +63,42 @@ func (c *Client) ensureNetworkInterface(ctx context.Context, config *provider.Sc
 					Properties: &armnetwork.InterfaceIPConfigurationPropertiesFormat{
 						PrivateIPAllocationMethod: to.Ptr(armnetwork.IPAllocationMethodDynamic),
 						Subnet: &armnetwork.Subnet{
-							ID: to.Ptr(c.azureConfig.ScannerSubnet),
+							ID: to.Ptr(placement.SubnetID),
 						},
 					},
 				},
 			},
 			NetworkSecurityGroup: &armnetwork.SecurityGroup{
-				ID: to.Ptr(c.azureConfig.ScannerSecurityGroup),
+				ID: to.Ptr(placement.SecurityGroupID),
 			},
 		},
 	}
 
-	_, err = c.interfacesClient.BeginCreateOrUpdate(ctx, c.azureConfig.ScannerResourceGroup, nicName, parameters, nil)
+	opCtx, finish := startOperation(ctx, models.NetworkInterfaceCreate, c.azureConfig.ScannerLocation)
+	_, err = c.interfacesClient.BeginCreateOrUpdate(opCtx, placement.ResourceGroup, nicName, parameters, nil)
+	finish(nicName, err)
 	if err != nil {
 		_, err := handleAzureRequestError(err, "creating interface %s", nicName)
 		return armnetwork.Interface{}, err
 	}
 
-	return armnetwork.Interface{}, provider.RetryableErrorf(NetworkInterfaceEstimateProvisionTime, "interface creating")
+	return armnetwork.Interface{}, provider.InProgressErrorf(NetworkInterfaceEstimateProvisionTime, "interface creating")
 }
 
 func (c *Client) ensureNetworkInterfaceDeleted(ctx context.Context, config *provider.ScanJobConfig) error {
 	nicName := networkInterfaceNameFromJobConfig(config)
+	placement := c.scannerPlacement(config)
 
 	return ensureDeleted(
 		"interface",
 		func() error {
-			_, err := c.interfacesClient.Get(ctx, c.azureConfig.ScannerResourceGroup, nicName, nil)
+			_, err := c.interfacesClient.Get(ctx, placement.ResourceGroup, nicName, nil)
 			return err // nolint: wrapcheck
 		},
 		func() error {
-			_, err := c.interfacesClient.BeginDelete(ctx, c.azureConfig.ScannerResourceGroup, nicName, nil)
+			ctx, finish := startOperation(ctx, models.NetworkInterfaceDelete, c.azureConfig.ScannerLocation)
+			_, err := c.interfacesClient.BeginDelete(ctx, placement.ResourceGroup, nicName, nil)
+			finish(nicName, err)
 			return err // nolint: wrapcheck
 		},
 		NetworkInterfaceDeleteEstimateTime,
@@ -0,0 +1,128 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v4"
+
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// DefaultScannerZones are the availability zones the scanners are spread
+// across if the scanner placement doesn't set them.
+var DefaultScannerZones = []string{"1", "2", "3"}
+
+// ScannerPlacement is the resource group, the network and the zone of a
+// Scanner virtual machine.
+type ScannerPlacement struct {
+	ResourceGroup   string
+	SubnetID        string
+	SecurityGroupID string
+	// Zone is the availability zone of the Scanner virtual machine and of
+	// the disk attached to it, it is nil if the scanners are not spread
+	// across zones.
+	Zone *string
+}
+
+// Zones returns the zones of the Scanner virtual machine and of the disk
+// attached to it, which must be in the same zone.
+func (p ScannerPlacement) Zones() []*string {
+	if p.Zone == nil {
+		return nil
+	}
+	return []*string{p.Zone}
+}
+
+// scannerPlacement returns the placement of the Scanner virtual machine of the
+// scan job, the settings its models.ScannerPlacement doesn't set are taken
+// from the provider configuration.
+func (c *Client) scannerPlacement(config *provider.ScanJobConfig) ScannerPlacement {
+	placement := ScannerPlacement{
+		ResourceGroup:   c.azureConfig.ScannerResourceGroup,
+		SubnetID:        c.azureConfig.ScannerSubnet,
+		SecurityGroupID: c.azureConfig.ScannerSecurityGroup,
+	}
+	if config.ScannerPlacement == nil {
+		return placement
+	}
+
+	if azure := config.ScannerPlacement.Azure; azure != nil {
+		if rg := utils.ValueOrZero(azure.ResourceGroup); rg != "" {
+			placement.ResourceGroup = rg
+		}
+		if id := utils.ValueOrZero(azure.SubnetID); id != "" {
+			placement.SubnetID = id
+		}
+		if id := utils.ValueOrZero(azure.SecurityGroupID); id != "" {
+			placement.SecurityGroupID = id
+		}
+	}
+
+	if config.ScannerPlacement.GetSpreadAcrossZones() {
+		zones := DefaultScannerZones
+		if azure := config.ScannerPlacement.Azure; azure != nil && len(utils.ValueOrZero(azure.Zones)) > 0 {
+			zones = *azure.Zones
+		}
+		placement.Zone = utils.PointerTo(provider.ZoneForScanJob(zones, config))
+	}
+
+	return placement
+}
+
+// ensureScannerNotInTargetSubnet returns a FatalError if any network interface
+// of the target virtual machine is in the subnet of the scanner.
+func (c *Client) ensureScannerNotInTargetSubnet(ctx context.Context, targetVM armcompute.VirtualMachine, placement ScannerPlacement) error {
+	if targetVM.Properties == nil || targetVM.Properties.NetworkProfile == nil {
+		return nil
+	}
+
+	for _, nicRef := range targetVM.Properties.NetworkProfile.NetworkInterfaces {
+		if nicRef == nil || nicRef.ID == nil {
+			continue
+		}
+
+		// Network interface IDs have the same format as instance IDs.
+		resourceGroup, nicName, err := resourceGroupAndNameFromInstanceID(*nicRef.ID)
+		if err != nil {
+			return err
+		}
+
+		nicResp, err := c.interfacesClient.Get(ctx, resourceGroup, nicName, nil)
+		if err != nil {
+			_, err = handleAzureRequestError(err, "getting target interface %s", nicName)
+			return err
+		}
+		if nicResp.Properties == nil {
+			continue
+		}
+
+		for _, ipConfig := range nicResp.Properties.IPConfigurations {
+			if ipConfig == nil || ipConfig.Properties == nil || ipConfig.Properties.Subnet == nil {
+				continue
+			}
+			// Resource IDs are case-insensitive.
+			if strings.EqualFold(utils.ValueOrZero(ipConfig.Properties.Subnet.ID), placement.SubnetID) {
+				return provider.FatalErrorf("scanner subnet is the subnet of the target virtual machine. SubnetID=%s", placement.SubnetID)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestScannerPlacement(t *testing.T) {
+	client := &Client{
+		azureConfig: Config{
+			ScannerResourceGroup: "scanner-rg",
+			ScannerSubnet:        "scanner-subnet",
+			ScannerSecurityGroup: "scanner-nsg",
+		},
+	}
+
+	tests := []struct {
+		Name      string
+		Placement *models.ScannerPlacement
+
+		ExpectedPlacement ScannerPlacement
+		ExpectedZones     []string
+	}{
+		{
+			Name: "Provider configuration",
+			ExpectedPlacement: ScannerPlacement{
+				ResourceGroup:   "scanner-rg",
+				SubnetID:        "scanner-subnet",
+				SecurityGroupID: "scanner-nsg",
+			},
+		},
+		{
+			Name: "Dedicated resource group and network",
+			Placement: &models.ScannerPlacement{
+				Azure: &models.AzureScannerPlacement{
+					ResourceGroup:   utils.PointerTo("dedicated-rg"),
+					SubnetID:        utils.PointerTo("dedicated-subnet"),
+					SecurityGroupID: utils.PointerTo("dedicated-nsg"),
+				},
+			},
+			ExpectedPlacement: ScannerPlacement{
+				ResourceGroup:   "dedicated-rg",
+				SubnetID:        "dedicated-subnet",
+				SecurityGroupID: "dedicated-nsg",
+			},
+		},
+		{
+			Name: "Spread across the default zones",
+			Placement: &models.ScannerPlacement{
+				SpreadAcrossZones: utils.PointerTo(true),
+			},
+			ExpectedPlacement: ScannerPlacement{
+				ResourceGroup:   "scanner-rg",
+				SubnetID:        "scanner-subnet",
+				SecurityGroupID: "scanner-nsg",
+			},
+			ExpectedZones: DefaultScannerZones,
+		},
+		{
+			Name: "Spread across the configured zones",
+			Placement: &models.ScannerPlacement{
+				SpreadAcrossZones: utils.PointerTo(true),
+				Azure: &models.AzureScannerPlacement{
+					ResourceGroup: utils.PointerTo("dedicated-rg"),
+					Zones:         &[]string{"2"},
+				},
+			},
+			ExpectedPlacement: ScannerPlacement{
+				ResourceGroup:   "dedicated-rg",
+				SubnetID:        "scanner-subnet",
+				SecurityGroupID: "scanner-nsg",
+			},
+			ExpectedZones: []string{"2"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			placement := client.scannerPlacement(&provider.ScanJobConfig{
+				ScannerPlacement: test.Placement,
+				ScanMetadata: provider.ScanMetadata{
+					ScanResultID: "scan-result-1",
+				},
+			})
+
+			if test.ExpectedZones == nil {
+				g.Expect(placement.Zone).Should(BeNil())
+				g.Expect(placement.Zones()).Should(BeNil())
+			} else {
+				g.Expect(placement.Zone).ShouldNot(BeNil())
+				g.Expect(test.ExpectedZones).Should(ContainElement(*placement.Zone))
+				g.Expect(placement.Zones()).Should(Equal([]*string{placement.Zone}))
+			}
+
+			placement.Zone = nil
+			g.Expect(placement).Should(Equal(test.ExpectedPlacement))
+		})
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v4"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v3"
 
+	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider/cloudinit"
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
@@ -42,11 +43,12 @@ func scannerVMNameFromJobConfig(config *provider.ScanJobConfig) string {
 
 func (c *Client) ensureScannerVirtualMachine(ctx context.Context, config *provider.ScanJobConfig, networkInterface armnetwork.Interface) (armcompute.VirtualMachine, error) {
 	vmName := scannerVMNameFromJobConfig(config)
+	placement := c.scannerPlacement(config)
 
-	vmResp, err := c.vmClient.Get(ctx, c.azureConfig.ScannerResourceGroup, vmName, nil)
+	vmResp, err := c.vmClient.Get(ctx, placement.ResourceGroup, vmName, nil)
 	if err == nil {
 		if *vmResp.VirtualMachine.Properties.ProvisioningState != ProvisioningStateSucceeded {
-			return vmResp.VirtualMachine, provider.RetryableErrorf(VMCreateEstimateProvisionTime, "VM is not ready yet, provisioning state: %s", *vmResp.VirtualMachine.Properties.ProvisioningState)
+			return vmResp.VirtualMachine, provider.InProgressErrorf(VMCreateEstimateProvisionTime, "VM is not ready yet, provisioning state: %s", *vmResp.VirtualMachine.Properties.ProvisioningState)
 		}
 		return vmResp.VirtualMachine, nil
 	}
@@ -62,6 +64,11 @@ func (c *Client) ensureScannerVirtualMachine(ctx context.Context, config *provid
 	}
 	userDataBase64 := base64.StdEncoding.EncodeToString([]byte(userData))
 
+	imageReference, err := c.scannerImageReference(config)
+	if err != nil {
+		return armcompute.VirtualMachine{}, provider.FatalErrorf("invalid scanner image: %w", err)
+	}
+
 	parameters := armcompute.VirtualMachine{
 		Location: to.Ptr(c.azureConfig.ScannerLocation),
 		Identity: &armcompute.VirtualMachineIdentity{
@@ -73,12 +80,7 @@ func (c *Client) ensureScannerVirtualMachine(ctx context.Context, config *provid
 				VMSize: to.Ptr(armcompute.VirtualMachineSizeTypes(c.azureConfig.ScannerVMSize)),
 			},
 			StorageProfile: &armcompute.StorageProfile{
-				ImageReference: &armcompute.ImageReference{
-					Offer:     to.Ptr(c.azureConfig.ScannerImageOffer),
-					Publisher: to.Ptr(c.azureConfig.ScannerImagePublisher),
-					SKU:       to.Ptr(c.azureConfig.ScannerImageSKU),
-					Version:   to.Ptr(c.azureConfig.ScannerImageVersion),
-				},
+				ImageReference: imageReference,
 				OSDisk: &armcompute.OSDisk{
 					Name:         to.Ptr(fmt.Sprintf("%s-rootvolume", vmName)),
 					CreateOption: to.Ptr(armcompute.DiskCreateOptionTypesFromImage),
@@ -108,6 +110,7 @@ func (c *Client) ensureScannerVirtualMachine(ctx context.Context, config *provid
 			},
 			UserData: &userDataBase64,
 		},
+		Zones: placement.Zones(),
 	}
 
 	if c.azureConfig.ScannerPublicKey != "" {
@@ -121,33 +124,38 @@ func (c *Client) ensureScannerVirtualMachine(ctx context.Context, config *provid
 		}
 	}
 
-	_, err = c.vmClient.BeginCreateOrUpdate(ctx, c.azureConfig.ScannerResourceGroup, vmName, parameters, nil)
+	opCtx, finish := startOperation(ctx, models.InstanceCreate, c.azureConfig.ScannerLocation)
+	_, err = c.vmClient.BeginCreateOrUpdate(opCtx, placement.ResourceGroup, vmName, parameters, nil)
+	finish(vmName, err)
 	if err != nil {
 		_, err = handleAzureRequestError(err, "creating virtual machine")
 		return armcompute.VirtualMachine{}, err
 	}
 
-	return armcompute.VirtualMachine{}, provider.RetryableErrorf(VMCreateEstimateProvisionTime, "vm created")
+	return armcompute.VirtualMachine{}, provider.InProgressErrorf(VMCreateEstimateProvisionTime, "vm created")
 }
 
 func (c *Client) ensureScannerVirtualMachineDeleted(ctx context.Context, config *provider.ScanJobConfig) error {
 	vmName := scannerVMNameFromJobConfig(config)
+	placement := c.scannerPlacement(config)
 
 	return ensureDeleted(
 		"virtual machine",
 		func() error {
-			_, err := c.vmClient.Get(ctx, c.azureConfig.ScannerResourceGroup, vmName, nil)
+			_, err := c.vmClient.Get(ctx, placement.ResourceGroup, vmName, nil)
 			return err // nolint: wrapcheck
 		},
 		func() error {
-			_, err := c.vmClient.BeginDelete(ctx, c.azureConfig.ScannerResourceGroup, vmName, nil)
+			ctx, finish := startOperation(ctx, models.InstanceDelete, c.azureConfig.ScannerLocation)
+			_, err := c.vmClient.BeginDelete(ctx, placement.ResourceGroup, vmName, nil)
+			finish(vmName, err)
 			return err // nolint: wrapcheck
 		},
 		VMDeleteEstimateTime,
 	)
 }
 
-func (c *Client) ensureDiskAttachedToScannerVM(ctx context.Context, vm armcompute.VirtualMachine, disk armcompute.Disk) error {
+func (c *Client) ensureDiskAttachedToScannerVM(ctx context.Context, config *provider.ScanJobConfig, vm armcompute.VirtualMachine, disk armcompute.Disk) error {
 	var vmAttachedToDisk bool
 	for _, dataDisk := range vm.Properties.StorageProfile.DataDisks {
 		if dataDisk.ManagedDisk.ID == disk.ID {
@@ -168,7 +176,9 @@ func (c *Client) ensureDiskAttachedToScannerVM(ctx context.Context, vm armcomput
 			},
 		}
 
-		_, err := c.vmClient.BeginCreateOrUpdate(ctx, c.azureConfig.ScannerResourceGroup, *vm.Name, vm, nil)
+		opCtx, finish := startOperation(ctx, models.VolumeAttach, c.azureConfig.ScannerLocation)
+		_, err := c.vmClient.BeginCreateOrUpdate(opCtx, c.scannerPlacement(config).ResourceGroup, *vm.Name, vm, nil)
+		finish(*disk.Name, err)
 		if err != nil {
 			_, err := handleAzureRequestError(err, "attaching disk %s to VM %s", *disk.Name, *vm.Name)
 			return err
@@ -182,7 +192,7 @@ func (c *Client) ensureDiskAttachedToScannerVM(ctx context.Context, vm armcomput
 	}
 
 	if *diskResp.Disk.Properties.DiskState != armcompute.DiskStateAttached {
-		return provider.RetryableErrorf(VMDiskAttachEstimateTime, "volume is not yet attached, disk is in state: %v", *diskResp.Disk.Properties.DiskState)
+		return provider.InProgressErrorf(VMDiskAttachEstimateTime, "volume is not yet attached, disk is in state: %v", *diskResp.Disk.Properties.DiskState)
 	}
 
 	return nil
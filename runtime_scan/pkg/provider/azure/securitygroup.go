@@ -0,0 +1,195 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v4"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v3"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const (
+	// anyAddressPrefix and internetAddressPrefix are the address prefixes
+	// of the security rules matching every address.
+	anyAddressPrefix      = "*"
+	internetAddressPrefix = "Internet"
+	anyPortRange          = "*"
+)
+
+// securityGroupCache holds the network security groups fetched during a
+// discovery, as they are usually shared by many virtual machines.
+type securityGroupCache map[string]*models.SecurityGroup
+
+// getVMSecurityGroups returns the network security groups of the network
+// interfaces of the virtual machine with their allow rules. Failing to get
+// them is not fatal for the discovery.
+func (c *Client) getVMSecurityGroups(ctx context.Context, vm *armcompute.VirtualMachine, cache securityGroupCache) []models.SecurityGroup {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	securityGroups := []models.SecurityGroup{}
+	if vm.Properties == nil || vm.Properties.NetworkProfile == nil {
+		return securityGroups
+	}
+
+	for _, nicRef := range vm.Properties.NetworkProfile.NetworkInterfaces {
+		if nicRef == nil || nicRef.ID == nil {
+			continue
+		}
+		resourceGroup, name, err := resourceGroupAndNameFromInstanceID(*nicRef.ID)
+		if err != nil {
+			logger.Warnf("Failed to parse network interface ID %s: %v", *nicRef.ID, err)
+			continue
+		}
+		nic, err := c.interfacesClient.Get(ctx, resourceGroup, name, nil)
+		if err != nil {
+			logger.Warnf("Failed to get network interface %s: %v", *nicRef.ID, err)
+			continue
+		}
+		if nic.Properties == nil || nic.Properties.NetworkSecurityGroup == nil || nic.Properties.NetworkSecurityGroup.ID == nil {
+			continue
+		}
+
+		securityGroup := c.getSecurityGroup(ctx, *nic.Properties.NetworkSecurityGroup.ID, cache)
+		if securityGroup != nil {
+			securityGroups = append(securityGroups, *securityGroup)
+		}
+	}
+
+	return securityGroups
+}
+
+func (c *Client) getSecurityGroup(ctx context.Context, id string, cache securityGroupCache) *models.SecurityGroup {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	if securityGroup, ok := cache[id]; ok {
+		return securityGroup
+	}
+
+	resourceGroup, name, err := resourceGroupAndNameFromInstanceID(id)
+	if err != nil {
+		logger.Warnf("Failed to parse network security group ID %s: %v", id, err)
+		return nil
+	}
+	res, err := c.securityGroupsClient.Get(ctx, resourceGroup, name, nil)
+	if err != nil {
+		logger.Warnf("Failed to get network security group %s: %v", id, err)
+		return nil
+	}
+
+	rules := []models.SecurityGroupRule{}
+	if res.Properties != nil {
+		for _, rule := range res.Properties.SecurityRules {
+			rules = append(rules, securityGroupRulesFromSecurityRule(rule)...)
+		}
+	}
+
+	securityGroup := &models.SecurityGroup{
+		Id:    id,
+		Name:  res.Name,
+		Rules: &rules,
+	}
+	cache[id] = securityGroup
+
+	return securityGroup
+}
+
+// securityGroupRulesFromSecurityRule converts an allow rule to a
+// models.SecurityGroupRule per port range. Deny rules are ignored.
+func securityGroupRulesFromSecurityRule(rule *armnetwork.SecurityRule) []models.SecurityGroupRule {
+	if rule == nil || rule.Properties == nil {
+		return nil
+	}
+	properties := rule.Properties
+	if properties.Access == nil || *properties.Access != armnetwork.SecurityRuleAccessAllow {
+		return nil
+	}
+
+	direction := models.Inbound
+	addressPrefixes := append([]*string{properties.SourceAddressPrefix}, properties.SourceAddressPrefixes...)
+	if properties.Direction != nil && *properties.Direction == armnetwork.SecurityRuleDirectionOutbound {
+		direction = models.Outbound
+		addressPrefixes = append([]*string{properties.DestinationAddressPrefix}, properties.DestinationAddressPrefixes...)
+	}
+
+	sources := make([]string, 0, len(addressPrefixes))
+	for _, prefix := range addressPrefixes {
+		switch {
+		case prefix == nil || *prefix == "":
+			continue
+		case *prefix == anyAddressPrefix || *prefix == internetAddressPrefix:
+			sources = append(sources, provider.AnyIPv4, provider.AnyIPv6)
+		default:
+			sources = append(sources, *prefix)
+		}
+	}
+	if len(sources) == 0 {
+		return nil
+	}
+
+	protocol := provider.ProtocolAll
+	if properties.Protocol != nil && *properties.Protocol != armnetwork.SecurityRuleProtocolAsterisk {
+		protocol = strings.ToLower(string(*properties.Protocol))
+	}
+
+	portRanges := append([]*string{properties.DestinationPortRange}, properties.DestinationPortRanges...)
+	rules := make([]models.SecurityGroupRule, 0, len(portRanges))
+	for _, portRange := range portRanges {
+		if portRange == nil || *portRange == "" {
+			continue
+		}
+		rule := models.SecurityGroupRule{
+			Direction: direction,
+			Protocol:  protocol,
+			Sources:   utils.PointerTo(sources),
+		}
+		if *portRange != anyPortRange {
+			fromPort, toPort, ok := parsePortRange(*portRange)
+			if !ok {
+				continue
+			}
+			rule.FromPort = utils.PointerTo(fromPort)
+			rule.ToPort = utils.PointerTo(toPort)
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+// parsePortRange parses a port (22) or port range (1000-2000).
+func parsePortRange(portRange string) (int, int, bool) {
+	from, to, isRange := strings.Cut(portRange, "-")
+	fromPort, err := strconv.Atoi(strings.TrimSpace(from))
+	if err != nil {
+		return 0, 0, false
+	}
+	if !isRange {
+		return fromPort, fromPort, true
+	}
+	toPort, err := strconv.Atoi(strings.TrimSpace(to))
+	if err != nil {
+		return 0, 0, false
+	}
+	return fromPort, toPort, true
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v4"
 
+	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
 )
 
@@ -41,7 +42,7 @@ func (c *Client) ensureSnapshotForVMRootVolume(ctx context.Context, config *prov
 	snapshotRes, err := c.snapshotsClient.Get(ctx, c.azureConfig.ScannerResourceGroup, snapshotName, nil)
 	if err == nil {
 		if *snapshotRes.Properties.ProvisioningState != ProvisioningStateSucceeded {
-			return snapshotRes.Snapshot, provider.RetryableErrorf(SnapshotCreateEstimateProvisionTime, "snapshot is not ready yet")
+			return snapshotRes.Snapshot, provider.InProgressErrorf(SnapshotCreateEstimateProvisionTime, "snapshot is not ready yet")
 		}
 
 		// Everything is good, the snapshot exists and is provisioned successfully
@@ -53,21 +54,26 @@ func (c *Client) ensureSnapshotForVMRootVolume(ctx context.Context, config *prov
 		return armcompute.Snapshot{}, err
 	}
 
-	_, err = c.snapshotsClient.BeginCreateOrUpdate(ctx, c.azureConfig.ScannerResourceGroup, snapshotName, armcompute.Snapshot{
+	opCtx, finish := startOperation(ctx, models.SnapshotCreate, *vm.Location)
+	_, err = c.snapshotsClient.BeginCreateOrUpdate(opCtx, c.azureConfig.ScannerResourceGroup, snapshotName, armcompute.Snapshot{
 		Location: vm.Location,
 		Properties: &armcompute.SnapshotProperties{
 			CreationData: &armcompute.CreationData{
 				CreateOption:     to.Ptr(armcompute.DiskCreateOptionCopy),
 				SourceResourceID: vm.Properties.StorageProfile.OSDisk.ManagedDisk.ID,
 			},
+			// Only the changes of incremental snapshots can be compared
+			// for delta scanning.
+			Incremental: to.Ptr(config.DeltaScan),
 		},
 	}, nil)
+	finish(snapshotName, err)
 	if err != nil {
 		_, err := handleAzureRequestError(err, "creating snapshot %s", snapshotName)
 		return armcompute.Snapshot{}, err
 	}
 
-	return armcompute.Snapshot{}, provider.RetryableErrorf(SnapshotCreateEstimateProvisionTime, "snapshot creating")
+	return armcompute.Snapshot{}, provider.InProgressErrorf(SnapshotCreateEstimateProvisionTime, "snapshot creating")
 }
 
 func (c *Client) ensureSnapshotDeleted(ctx context.Context, config *provider.ScanJobConfig) error {
@@ -80,7 +86,9 @@ func (c *Client) ensureSnapshotDeleted(ctx context.Context, config *provider.Sca
 			return err // nolint: wrapcheck
 		},
 		func() error {
+			ctx, finish := startOperation(ctx, models.SnapshotDelete, c.azureConfig.ScannerLocation)
 			_, err := c.snapshotsClient.BeginDelete(ctx, c.azureConfig.ScannerResourceGroup, snapshotName, nil)
+			finish(snapshotName, err)
 			return err // nolint: wrapcheck
 		},
 		SnapshotDeleteEstimateTime,
@@ -23,6 +23,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v4"
 
+	"github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
 )
 
@@ -41,7 +42,7 @@ func (c *Client) ensureManagedDiskFromSnapshot(ctx context.Context, config *prov
 	volumeRes, err := c.disksClient.Get(ctx, c.azureConfig.ScannerResourceGroup, volumeName, nil)
 	if err == nil {
 		if *volumeRes.Disk.Properties.ProvisioningState != ProvisioningStateSucceeded {
-			return volumeRes.Disk, provider.RetryableErrorf(DiskEstimateProvisionTime, "volume is not ready yet, provisioning state: %s", *volumeRes.Disk.Properties.ProvisioningState)
+			return volumeRes.Disk, provider.InProgressErrorf(DiskEstimateProvisionTime, "volume is not ready yet, provisioning state: %s", *volumeRes.Disk.Properties.ProvisioningState)
 		}
 
 		return volumeRes.Disk, nil
@@ -52,8 +53,10 @@ func (c *Client) ensureManagedDiskFromSnapshot(ctx context.Context, config *prov
 		return armcompute.Disk{}, err
 	}
 
-	_, err = c.disksClient.BeginCreateOrUpdate(ctx, c.azureConfig.ScannerResourceGroup, volumeName, armcompute.Disk{
+	opCtx, finish := startOperation(ctx, models.VolumeCreate, c.azureConfig.ScannerLocation)
+	_, err = c.disksClient.BeginCreateOrUpdate(opCtx, c.azureConfig.ScannerResourceGroup, volumeName, armcompute.Disk{
 		Location: to.Ptr(c.azureConfig.ScannerLocation),
+		Zones:    c.scannerPlacement(config).Zones(),
 		SKU: &armcompute.DiskSKU{
 			Name: to.Ptr(armcompute.DiskStorageAccountTypesStandardSSDLRS),
 		},
@@ -64,26 +67,25 @@ func (c *Client) ensureManagedDiskFromSnapshot(ctx context.Context, config *prov
 			},
 		},
 	}, nil)
+	finish(volumeName, err)
 	if err != nil {
 		_, err := handleAzureRequestError(err, "creating disk %s", volumeName)
 		return armcompute.Disk{}, err
 	}
 
-	return armcompute.Disk{}, provider.RetryableErrorf(DiskEstimateProvisionTime, "disk creating")
+	return armcompute.Disk{}, provider.InProgressErrorf(DiskEstimateProvisionTime, "disk creating")
 }
 
+// ensureManagedDiskFromSnapshotInDifferentRegion imports the disk from a blob
+// copy of the snapshot in the scanner region. The blob is only checked until
+// the disk is created from it.
 func (c *Client) ensureManagedDiskFromSnapshotInDifferentRegion(ctx context.Context, config *provider.ScanJobConfig, snapshot armcompute.Snapshot) (armcompute.Disk, error) {
-	blobURL, err := c.ensureBlobFromSnapshot(ctx, config, snapshot)
-	if err != nil {
-		return armcompute.Disk{}, fmt.Errorf("failed to ensure blob from snapshot: %w", err)
-	}
-
 	volumeName := volumeNameFromJobConfig(config)
 
 	volumeRes, err := c.disksClient.Get(ctx, c.azureConfig.ScannerResourceGroup, volumeName, nil)
 	if err == nil {
 		if *volumeRes.Disk.Properties.ProvisioningState != ProvisioningStateSucceeded {
-			return volumeRes.Disk, provider.RetryableErrorf(DiskEstimateProvisionTime, "volume is not ready yet, provisioning state: %s", *volumeRes.Disk.Properties.ProvisioningState)
+			return volumeRes.Disk, provider.InProgressErrorf(DiskEstimateProvisionTime, "volume is not ready yet, provisioning state: %s", *volumeRes.Disk.Properties.ProvisioningState)
 		}
 
 		return volumeRes.Disk, nil
@@ -94,8 +96,15 @@ func (c *Client) ensureManagedDiskFromSnapshotInDifferentRegion(ctx context.Cont
 		return armcompute.Disk{}, err
 	}
 
-	_, err = c.disksClient.BeginCreateOrUpdate(ctx, c.azureConfig.ScannerResourceGroup, volumeName, armcompute.Disk{
+	blobURL, err := c.ensureBlobFromSnapshot(ctx, config, snapshot)
+	if err != nil {
+		return armcompute.Disk{}, fmt.Errorf("failed to ensure blob from snapshot: %w", err)
+	}
+
+	opCtx, finish := startOperation(ctx, models.VolumeCreate, c.azureConfig.ScannerLocation)
+	_, err = c.disksClient.BeginCreateOrUpdate(opCtx, c.azureConfig.ScannerResourceGroup, volumeName, armcompute.Disk{
 		Location: to.Ptr(c.azureConfig.ScannerLocation),
+		Zones:    c.scannerPlacement(config).Zones(),
 		SKU: &armcompute.DiskSKU{
 			Name: to.Ptr(armcompute.DiskStorageAccountTypesStandardSSDLRS),
 		},
@@ -107,11 +116,12 @@ func (c *Client) ensureManagedDiskFromSnapshotInDifferentRegion(ctx context.Cont
 			},
 		},
 	}, nil)
+	finish(volumeName, err)
 	if err != nil {
 		_, err := handleAzureRequestError(err, "creating disk %s", volumeName)
 		return armcompute.Disk{}, err
 	}
-	return armcompute.Disk{}, provider.RetryableErrorf(DiskEstimateProvisionTime, "disk creating")
+	return armcompute.Disk{}, provider.InProgressErrorf(DiskEstimateProvisionTime, "disk creating")
 }
 
 func (c *Client) ensureTargetDiskDeleted(ctx context.Context, config *provider.ScanJobConfig) error {
@@ -124,7 +134,9 @@ func (c *Client) ensureTargetDiskDeleted(ctx context.Context, config *provider.S
 			return err // nolint: wrapcheck
 		},
 		func() error {
+			ctx, finish := startOperation(ctx, models.VolumeDelete, c.azureConfig.ScannerLocation)
 			_, err := c.disksClient.BeginDelete(ctx, c.azureConfig.ScannerResourceGroup, volumeName, nil)
+			finish(volumeName, err)
 			return err // nolint: wrapcheck
 		},
 		DiskDeleteEstimateTime,
@@ -21,6 +21,15 @@ const (
 	ProvisioningStateSucceeded = "Succeeded"
 )
 
+// Power states of the VM instances, reported in the instance view with the
+// PowerState/ code prefix.
+const (
+	PowerStateCodePrefix  = "PowerState/"
+	PowerStateRunning     = "running"
+	PowerStateStopped     = "stopped"
+	PowerStateDeallocated = "deallocated"
+)
+
 type ScanScope struct {
 	AllResourceGroups bool
 	ResourceGroups    []ResourceGroup
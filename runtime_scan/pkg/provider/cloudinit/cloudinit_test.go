@@ -27,6 +27,12 @@ import (
 //go:embed testdata/cloud-init.yaml
 var ExpectedCloudInit string
 
+//go:embed testdata/cloud-init-config-url.yaml
+var ExpectedCloudInitConfigURL string
+
+//go:embed testdata/cloud-init-input-image.yaml
+var ExpectedCloudInitInputImage string
+
 //go:embed testdata/scanner-cli-config.yaml
 var ScannerCLIConfig string
 
@@ -61,6 +67,35 @@ func TestNewCloudInit(t *testing.T) {
 			},
 			ExpectedCloudInit: ExpectedCloudInit,
 		},
+		{
+			Name: "Cloud-init from ScanJobConfig with scanner config URL",
+			CloudInitData: provider.ScanJobConfig{
+				ScannerImage:       "ghcr.io/openclarity/vmclarity-cli:latest",
+				ScannerCLIConfig:   ScannerCLIConfig,
+				VMClarityAddress:   "10.1.1.1:8888",
+				ScannerConfigURL:   "http://10.1.1.1:8888/api/scanResults/d6ff6f55-5d53-4934-bef5-c3abb70a7f76/scannerConfig",
+				ScannerConfigToken: "Yx3sQpV1b9kR2mN8tW4zL6cF0hJ5dG7aE1uI3oP9rT2",
+				ScanMetadata: provider.ScanMetadata{
+					ScanResultID: "d6ff6f55-5d53-4934-bef5-c3abb70a7f76",
+				},
+			},
+			ExpectedCloudInit: ExpectedCloudInitConfigURL,
+		},
+		{
+			Name: "Cloud-init from ScanJobConfig with input image",
+			CloudInitData: provider.ScanJobConfig{
+				ScannerImage:       "ghcr.io/openclarity/vmclarity-cli:latest",
+				ScannerCLIConfig:   ScannerCLIConfig,
+				VMClarityAddress:   "10.1.1.1:8888",
+				ScannerConfigURL:   "http://10.1.1.1:8888/api/scanResults/d6ff6f55-5d53-4934-bef5-c3abb70a7f76/scannerConfig",
+				ScannerConfigToken: "Yx3sQpV1b9kR2mN8tW4zL6cF0hJ5dG7aE1uI3oP9rT2",
+				InputImage:         "docker.io/library/alpine@sha256:1234",
+				ScanMetadata: provider.ScanMetadata{
+					ScanResultID: "d6ff6f55-5d53-4934-bef5-c3abb70a7f76",
+				},
+			},
+			ExpectedCloudInit: ExpectedCloudInitInputImage,
+		},
 	}
 
 	for _, test := range tests {
@@ -58,9 +58,23 @@ func RetryableErrorf(d time.Duration, tmpl string, parts ...interface{}) Retryab
 	}
 }
 
+// InProgressErrorf returns a RetryableError of an operation which is waiting
+// for a resource to be created or deleted, rather than one which failed.
+func InProgressErrorf(d time.Duration, tmpl string, parts ...interface{}) RetryableError {
+	return RetryableError{
+		Err:        fmt.Errorf(tmpl, parts...),
+		After:      d,
+		InProgress: true,
+	}
+}
+
 type RetryableError struct {
 	Err   error
 	After time.Duration
+	// InProgress is set if the operation is progressing, e.g. a snapshot is
+	// still being copied, in which case it is retried after the estimate of
+	// the provider without using up its retry attempts.
+	InProgress bool
 }
 
 func (e RetryableError) Error() string {
@@ -0,0 +1,351 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+)
+
+// Clock is the source of time of the Client.
+type Clock interface {
+	Now() time.Time
+	// Sleep blocks until d has elapsed or ctx is done, and returns the
+	// error of ctx in the latter case.
+	Sleep(ctx context.Context, d time.Duration) error
+}
+
+// Script describes the behaviour of the Client for the scans of an instance.
+type Script struct {
+	// ProvisioningDelay is the time it takes to create the scanner
+	// resources, measured from the first RunTargetScan call which didn't
+	// return one of RunErrors.
+	ProvisioningDelay time.Duration
+	// DeprovisioningDelay is the time it takes to delete the scanner
+	// resources, measured from the first RemoveTargetScan call which didn't
+	// return one of RemoveErrors.
+	DeprovisioningDelay time.Duration
+	// RunLatency and RemoveLatency are the times each RunTargetScan and
+	// RemoveTargetScan call blocks for, e.g. to exceed the reconcile timeout.
+	RunLatency    time.Duration
+	RemoveLatency time.Duration
+	// RunErrors are returned in order by the first RunTargetScan calls.
+	RunErrors []error
+	// RemoveErrors are returned in order by the first RemoveTargetScan calls.
+	RemoveErrors []error
+	// ScanDuration is the time the scanner runs for once the scanner
+	// resources are created.
+	ScanDuration time.Duration
+	// ScanErrors are reported by the scanner when it finishes.
+	ScanErrors []string
+}
+
+// Scan is the record of the scanner resources of a ScanResult.
+type Scan struct {
+	ScanResultID string
+	InstanceID   string
+
+	RunCalls     int
+	RemoveCalls  int
+	PrewarmCalls int
+
+	// PrewarmedAt is the time the target volume snapshot was created ahead
+	// of the scanner resources, nil if it wasn't.
+	PrewarmedAt *time.Time
+
+	// ProvisionedAt is the time the scanner resources were created, and
+	// RemovedAt the time they were deleted. They are nil until then.
+	ProvisionedAt *time.Time
+	RemovedAt     *time.Time
+
+	provisioningStartedAt   *time.Time
+	deprovisioningStartedAt *time.Time
+}
+
+// Client implements provider.Provider without any cloud, for testing the
+// orchestrator. The instances it discovers and how the scanner resources of
+// their scans are created and deleted are scripted, and the progress of the
+// scripts is measured on a Clock which can be virtual, which makes the
+// behaviour of the Client deterministic.
+type Client struct {
+	kind  models.CloudProvider
+	clock Clock
+
+	instances []string
+	scripts   map[string]Script
+	scans     map[string]*Scan
+
+	running    int
+	maxRunning int
+
+	mu sync.Mutex
+}
+
+// New returns a Client of kind, which uses the wall time if clock is nil.
+func New(kind models.CloudProvider, clock Clock) *Client {
+	if clock == nil {
+		clock = realClock{}
+	}
+
+	return &Client{
+		kind:    kind,
+		clock:   clock,
+		scripts: make(map[string]Script),
+		scans:   make(map[string]*Scan),
+	}
+}
+
+// AddInstance adds a VM instance to the discovered targets, whose scans
+// behave as described by script.
+func (c *Client) AddInstance(instanceID string, script Script) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.scripts[instanceID]; !ok {
+		c.instances = append(c.instances, instanceID)
+	}
+	c.scripts[instanceID] = script
+}
+
+// Script returns the script of the instance.
+func (c *Client) Script(instanceID string) (Script, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	script, ok := c.scripts[instanceID]
+	return script, ok
+}
+
+// Scan returns the record of the scanner resources of the ScanResult.
+func (c *Client) Scan(scanResultID string) (Scan, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	scan, ok := c.scans[scanResultID]
+	if !ok {
+		return Scan{}, false
+	}
+	return *scan, true
+}
+
+// Scans returns the records of the scanner resources of all the ScanResults
+// ordered by instance ID.
+func (c *Client) Scans() []Scan {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	scans := make([]Scan, 0, len(c.scans))
+	for _, scan := range c.scans {
+		scans = append(scans, *scan)
+	}
+	sort.Slice(scans, func(i, j int) bool {
+		if scans[i].InstanceID != scans[j].InstanceID {
+			return scans[i].InstanceID < scans[j].InstanceID
+		}
+		return scans[i].ScanResultID < scans[j].ScanResultID
+	})
+	return scans
+}
+
+// MaxRunning returns the maximum number of scanner resources which existed
+// at the same time.
+func (c *Client) MaxRunning() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.maxRunning
+}
+
+func (c *Client) Kind() models.CloudProvider {
+	return c.kind
+}
+
+func (c *Client) DiscoverScopes(_ context.Context) (*models.Scopes, error) {
+	return &models.Scopes{}, nil
+}
+
+func (c *Client) DiscoverTargets(_ context.Context, _ *models.ScanScopeType) ([]models.AssetType, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	targets := make([]models.AssetType, 0, len(c.instances))
+	for _, instanceID := range c.instances {
+		info := models.AssetType{}
+		err := info.FromVMInfo(models.VMInfo{
+			InstanceID:       instanceID,
+			InstanceProvider: &c.kind,
+			Location:         "fake",
+			ObjectType:       "VMInfo",
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert from vm info: %w", err)
+		}
+		targets = append(targets, info)
+	}
+
+	return targets, nil
+}
+
+func (c *Client) RunTargetScan(ctx context.Context, config *provider.ScanJobConfig) error {
+	scan, script, err := c.startCall(ctx, config, func(s Script) time.Duration { return s.RunLatency })
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	scan.RunCalls++
+	if scan.RunCalls <= len(script.RunErrors) {
+		return script.RunErrors[scan.RunCalls-1]
+	}
+	if scan.ProvisionedAt != nil {
+		return nil
+	}
+
+	now := c.clock.Now()
+	if scan.provisioningStartedAt == nil {
+		scan.provisioningStartedAt = &now
+	}
+	if remaining := scan.provisioningStartedAt.Add(script.ProvisioningDelay).Sub(now); remaining > 0 {
+		return provider.InProgressErrorf(remaining, "scanner instance is being created")
+	}
+
+	scan.ProvisionedAt = &now
+	c.running++
+	if c.running > c.maxRunning {
+		c.maxRunning = c.running
+	}
+
+	return nil
+}
+
+func (c *Client) RemoveTargetScan(ctx context.Context, config *provider.ScanJobConfig) error {
+	scan, script, err := c.startCall(ctx, config, func(s Script) time.Duration { return s.RemoveLatency })
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	scan.RemoveCalls++
+	if scan.RemoveCalls <= len(script.RemoveErrors) {
+		return script.RemoveErrors[scan.RemoveCalls-1]
+	}
+	if scan.RemovedAt != nil {
+		return nil
+	}
+
+	now := c.clock.Now()
+	if scan.deprovisioningStartedAt == nil {
+		scan.deprovisioningStartedAt = &now
+	}
+	if remaining := scan.deprovisioningStartedAt.Add(script.DeprovisioningDelay).Sub(now); remaining > 0 {
+		return provider.InProgressErrorf(remaining, "scanner instance is being deleted")
+	}
+
+	scan.RemovedAt = &now
+	if scan.ProvisionedAt != nil {
+		c.running--
+	}
+
+	return nil
+}
+
+// PrewarmingClient is a Client which is a provider.SnapshotPrewarmer. The
+// target volume snapshots it creates ahead of the scanner resources are ready
+// right away.
+type PrewarmingClient struct {
+	*Client
+}
+
+func (c *PrewarmingClient) PrewarmTargetScan(ctx context.Context, config *provider.ScanJobConfig) error {
+	scan, _, err := c.startCall(ctx, config, func(Script) time.Duration { return 0 })
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	scan.PrewarmCalls++
+	if scan.PrewarmedAt == nil {
+		now := c.clock.Now()
+		scan.PrewarmedAt = &now
+	}
+
+	return nil
+}
+
+// startCall returns the record and script of the scan of config, after
+// blocking for the latency of the call in the script.
+func (c *Client) startCall(ctx context.Context, config *provider.ScanJobConfig, latency func(Script) time.Duration) (*Scan, Script, error) {
+	if config.AssetInfo == nil {
+		return nil, Script{}, provider.FatalErrorf("asset info is nil")
+	}
+	vminfo, err := config.AssetInfo.AsVMInfo()
+	if err != nil {
+		return nil, Script{}, provider.FatalErrorf("failed to convert asset to vm info: %w", err)
+	}
+
+	c.mu.Lock()
+	script, ok := c.scripts[vminfo.InstanceID]
+	if !ok {
+		c.mu.Unlock()
+		return nil, Script{}, provider.FatalErrorf("unknown instance: %s", vminfo.InstanceID)
+	}
+	scan, ok := c.scans[config.ScanResultID]
+	if !ok {
+		scan = &Scan{
+			ScanResultID: config.ScanResultID,
+			InstanceID:   vminfo.InstanceID,
+		}
+		c.scans[config.ScanResultID] = scan
+	}
+	c.mu.Unlock()
+
+	if err := c.clock.Sleep(ctx, latency(script)); err != nil {
+		return nil, Script{}, fmt.Errorf("call to provider was interrupted: %w", err)
+	}
+
+	return scan, script, nil
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// nolint:wrapcheck
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+
+	"github.com/openclarity/vmclarity/api/models"
+)
+
+// DefaultScannerImageChannel is used for resolving the scanner instance image
+// if no channel is configured.
+const DefaultScannerImageChannel = models.Stable
+
+// NewScannerImageChannel returns the models.ScannerImageChannel for s or
+// DefaultScannerImageChannel if s is empty.
+func NewScannerImageChannel(s string) (models.ScannerImageChannel, error) {
+	switch channel := models.ScannerImageChannel(s); channel {
+	case "":
+		return DefaultScannerImageChannel, nil
+	case models.Stable, models.Candidate:
+		return channel, nil
+	default:
+		return "", fmt.Errorf("invalid scanner image channel: %s", s)
+	}
+}
+
+// LatestVersion returns the highest semantic version of versions which is
+// published on the channel. Pre-release versions are published only on the
+// candidate channel. Versions which are not semantic versions are ignored.
+func LatestVersion(versions []string, channel models.ScannerImageChannel) (string, bool) {
+	var latest *semver.Version
+	var latestVersion string
+	for _, v := range versions {
+		version, err := semver.NewVersion(v)
+		if err != nil {
+			continue
+		}
+		if version.Prerelease() != "" && channel != models.Candidate {
+			continue
+		}
+		if latest == nil || version.GreaterThan(latest) {
+			latest = version
+			latestVersion = v
+		}
+	}
+
+	return latestVersion, latest != nil
+}
@@ -0,0 +1,79 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/openclarity/vmclarity/api/models"
+)
+
+func TestLatestVersion(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Versions []string
+		Channel  models.ScannerImageChannel
+
+		ExpectedVersion string
+		ExpectedOK      bool
+	}{
+		{
+			Name:            "Stable channel",
+			Versions:        []string{"0.5.0", "0.10.0", "0.6.1", "0.11.0-rc.1"},
+			Channel:         models.Stable,
+			ExpectedVersion: "0.10.0",
+			ExpectedOK:      true,
+		},
+		{
+			Name:            "Candidate channel",
+			Versions:        []string{"0.5.0", "0.10.0", "0.6.1", "0.11.0-rc.1"},
+			Channel:         models.Candidate,
+			ExpectedVersion: "0.11.0-rc.1",
+			ExpectedOK:      true,
+		},
+		{
+			Name:            "Invalid versions are ignored",
+			Versions:        []string{"latest", "v1.2.3", "nightly"},
+			Channel:         models.Stable,
+			ExpectedVersion: "v1.2.3",
+			ExpectedOK:      true,
+		},
+		{
+			Name:       "Only pre-releases on stable channel",
+			Versions:   []string{"1.0.0-rc.1"},
+			Channel:    models.Stable,
+			ExpectedOK: false,
+		},
+		{
+			Name:       "No versions",
+			Channel:    models.Candidate,
+			ExpectedOK: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			version, ok := LatestVersion(test.Versions, test.Channel)
+
+			g.Expect(ok).Should(Equal(test.ExpectedOK))
+			g.Expect(version).Should(Equal(test.ExpectedVersion))
+		})
+	}
+}
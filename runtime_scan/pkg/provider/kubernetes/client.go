@@ -0,0 +1,300 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const (
+	// nodeInstanceTypeLabel is the well-known label of the instance type of the node set by the cloud providers.
+	nodeInstanceTypeLabel = "node.kubernetes.io/instance-type"
+)
+
+// Client implements provider.Provider for Kubernetes clusters. The root filesystems of the nodes and the images of
+// the running workloads are discovered as targets, and each of them is scanned by a Kubernetes Job running the
+// scanner image in the cluster instead of a scanner VM.
+type Client struct {
+	config    *Config
+	clientSet k8s.Interface
+}
+
+func New(_ context.Context) (*Client, error) {
+	config, err := NewConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	err = config.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate configuration: %w", err)
+	}
+
+	// The in-cluster configuration is used if Kubeconfig is empty
+	restConfig, err := clientcmd.BuildConfigFromFlags("", config.Kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load kubernetes client configuration: %w", err)
+	}
+
+	clientSet, err := k8s.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kubernetes client: %w", err)
+	}
+
+	return &Client{
+		config:    config,
+		clientSet: clientSet,
+	}, nil
+}
+
+func (c Client) Kind() models.CloudProvider {
+	return models.Kubernetes
+}
+
+func (c Client) Capabilities() models.ProviderCapabilities {
+	return models.ProviderCapabilities{
+		ScanStoppedInstances: false,
+		CrossRegion:          false,
+		// The filesystem of the running node is scanned, so volume encryption is transparent.
+		EncryptedVolumes: true,
+		SpotInstances:    false,
+		ScannerPlacement: false,
+	}
+}
+
+func (c *Client) DiscoverScopes(ctx context.Context) (*models.Scopes, error) {
+	nodeList, err := c.clientSet.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+	nodes := make([]string, 0, len(nodeList.Items))
+	for _, node := range nodeList.Items {
+		nodes = append(nodes, node.Name)
+	}
+
+	namespaceList, err := c.clientSet.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+	namespaces := make([]string, 0, len(namespaceList.Items))
+	for _, namespace := range namespaceList.Items {
+		namespaces = append(namespaces, namespace.Name)
+	}
+
+	var ret models.Scopes
+	ret.ScopeInfo = &models.ScopeType{}
+
+	err = ret.ScopeInfo.FromKubernetesClusterScope(models.KubernetesClusterScope{
+		ClusterName: utils.PointerTo(c.config.ClusterName),
+		Namespaces:  utils.PointerTo(namespaces),
+		Nodes:       utils.PointerTo(nodes),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert from kubernetes cluster scope: %w", err)
+	}
+
+	return &ret, nil
+}
+
+func (c *Client) DiscoverTargets(ctx context.Context, scanScope *models.ScanScopeType) ([]models.AssetType, error) {
+	kubernetesScanScope, err := scanScope.AsKubernetesScanScope()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert as kubernetes scan scope: %w", err)
+	}
+
+	var ret []models.AssetType
+
+	if !utils.ValueOrZero(kubernetesScanScope.SkipNodes) {
+		nodes, err := c.discoverNodes(ctx, utils.ValueOrZero(kubernetesScanScope.NodeSelector))
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, nodes...)
+	}
+
+	if !utils.ValueOrZero(kubernetesScanScope.SkipWorkloadImages) {
+		images, err := c.discoverWorkloadImages(ctx, utils.ValueOrZero(kubernetesScanScope.Namespaces))
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, images...)
+	}
+
+	return ret, nil
+}
+
+// discoverNodes returns a VMInfo TargetType for each node which has all the labels of nodeSelector.
+func (c *Client) discoverNodes(ctx context.Context, nodeSelector []models.Tag) ([]models.AssetType, error) {
+	selector := make(labels.Set, len(nodeSelector))
+	for _, tag := range nodeSelector {
+		selector[tag.Key] = tag.Value
+	}
+
+	nodeList, err := c.clientSet.CoreV1().Nodes().List(ctx, metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	ret := make([]models.AssetType, 0, len(nodeList.Items))
+	for _, node := range nodeList.Items {
+		info, err := c.getVMInfoFromNode(node)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, info)
+	}
+
+	return ret, nil
+}
+
+// discoverWorkloadImages returns a ContainerImageInfo TargetType for each image run by the pods in namespaces, or
+// in all the namespaces if namespaces is empty. The images are identified by the digest reported by the container
+// runtime, so the same image run by several pods is returned once.
+func (c *Client) discoverWorkloadImages(ctx context.Context, namespaces []string) ([]models.AssetType, error) {
+	if len(namespaces) == 0 {
+		namespaces = []string{metav1.NamespaceAll}
+	}
+
+	var images []models.ContainerImageInfo
+	imageByLocation := make(map[string]int)
+	for _, namespace := range namespaces {
+		podList, err := c.clientSet.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pods in namespace %q: %w", namespace, err)
+		}
+
+		for _, pod := range podList.Items {
+			for _, status := range podContainerStatuses(pod) {
+				info, ok := getContainerImageInfo(status.Image, status.ImageID)
+				if !ok {
+					continue
+				}
+
+				idx, ok := imageByLocation[info.Location]
+				if !ok {
+					imageByLocation[info.Location] = len(images)
+					images = append(images, info)
+					continue
+				}
+				images[idx].Tags = mergeTags(images[idx].Tags, info.Tags)
+			}
+		}
+	}
+
+	ret := make([]models.AssetType, 0, len(images))
+	for _, image := range images {
+		var targetType models.AssetType
+		if err := targetType.FromContainerImageInfo(image); err != nil {
+			return nil, fmt.Errorf("failed to create TargetType from ContainerImageInfo: %w", err)
+		}
+		ret = append(ret, targetType)
+	}
+
+	return ret, nil
+}
+
+func (c *Client) getVMInfoFromNode(node corev1.Node) (models.AssetType, error) {
+	tags := make([]models.Tag, 0, len(node.Labels))
+	for key, value := range node.Labels {
+		tags = append(tags, models.Tag{Key: key, Value: value})
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		return tags[i].Key < tags[j].Key
+	})
+
+	targetType := models.AssetType{}
+	err := targetType.FromVMInfo(models.VMInfo{
+		ObjectType:       "VMInfo",
+		InstanceProvider: utils.PointerTo(models.Kubernetes),
+		InstanceID:       node.Name,
+		InstanceType:     node.Labels[nodeInstanceTypeLabel],
+		Location:         c.config.ClusterName,
+		Image:            node.Status.NodeInfo.OSImage,
+		Platform:         node.Status.NodeInfo.OperatingSystem,
+		LaunchTime:       node.CreationTimestamp.Time,
+		SecurityGroups:   &[]models.SecurityGroup{},
+		Tags:             &tags,
+	})
+	if err != nil {
+		err = fmt.Errorf("failed to create TargetType from VMInfo: %w", err)
+	}
+
+	return targetType, err
+}
+
+func podContainerStatuses(pod corev1.Pod) []corev1.ContainerStatus {
+	statuses := make([]corev1.ContainerStatus, 0, len(pod.Status.InitContainerStatuses)+len(pod.Status.ContainerStatuses))
+	statuses = append(statuses, pod.Status.InitContainerStatuses...)
+	statuses = append(statuses, pod.Status.ContainerStatuses...)
+
+	return statuses
+}
+
+// getContainerImageInfo returns the ContainerImageInfo of the image of a container from the image reference in the
+// pod spec and the imageID reported by the container runtime. It returns false if the imageID doesn't contain the
+// repository digest of the image, for example for images built on the node, as those can't be pulled by the scanner.
+func getContainerImageInfo(image, imageID string) (models.ContainerImageInfo, bool) {
+	// Docker reports the imageID with a docker-pullable:// or docker:// scheme
+	if i := strings.Index(imageID, "://"); i >= 0 {
+		imageID = imageID[i+len("://"):]
+	}
+
+	digest, err := name.NewDigest(imageID)
+	if err != nil {
+		return models.ContainerImageInfo{}, false
+	}
+	repository := digest.Context()
+
+	var tags []string
+	if tag, err := name.NewTag(image); err == nil && tag.Context().Name() == repository.Name() {
+		tags = append(tags, tag.TagStr())
+	}
+
+	return models.ContainerImageInfo{
+		ImageID:    digest.DigestStr(),
+		Registry:   utils.PointerTo(repository.RegistryStr()),
+		Repository: repository.RepositoryStr(),
+		Tags:       utils.PointerTo(tags),
+		// Fully qualified, so the same image referenced differently is only returned once
+		Location: fmt.Sprintf("%s@%s", repository.Name(), digest.DigestStr()),
+	}, true
+}
+
+func mergeTags(tags, other *[]string) *[]string {
+	merged := utils.ValueOrZero(tags)
+	for _, tag := range utils.ValueOrZero(other) {
+		if !utils.Contains(merged, tag) {
+			merged = append(merged, tag)
+		}
+	}
+
+	return utils.PointerTo(merged)
+}
@@ -0,0 +1,169 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const testDigest = "sha256:0d17b565c37bcbd895e9d92315a05c1c3c9a29f762b011a10c54a66cd53c9b31"
+
+func TestGetContainerImageInfo(t *testing.T) {
+	tests := []struct {
+		Name    string
+		Image   string
+		ImageID string
+
+		ExpectedInfo models.ContainerImageInfo
+		ExpectedOK   bool
+	}{
+		{
+			Name:    "Containerd image ID",
+			Image:   "nginx:1.25",
+			ImageID: "docker.io/library/nginx@" + testDigest,
+			ExpectedInfo: models.ContainerImageInfo{
+				ImageID:    testDigest,
+				Registry:   utils.PointerTo("index.docker.io"),
+				Repository: "library/nginx",
+				Tags:       utils.PointerTo([]string{"1.25"}),
+				Location:   "index.docker.io/library/nginx@" + testDigest,
+			},
+			ExpectedOK: true,
+		},
+		{
+			Name:    "Docker image ID of image referenced by digest",
+			Image:   "ghcr.io/openclarity/vmclarity-cli@" + testDigest,
+			ImageID: "docker-pullable://ghcr.io/openclarity/vmclarity-cli@" + testDigest,
+			ExpectedInfo: models.ContainerImageInfo{
+				ImageID:    testDigest,
+				Registry:   utils.PointerTo("ghcr.io"),
+				Repository: "openclarity/vmclarity-cli",
+				Tags:       utils.PointerTo[[]string](nil),
+				Location:   "ghcr.io/openclarity/vmclarity-cli@" + testDigest,
+			},
+			ExpectedOK: true,
+		},
+		{
+			Name:       "Image ID without repository digest",
+			Image:      "local/image:dev",
+			ImageID:    "docker://" + testDigest,
+			ExpectedOK: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			info, ok := getContainerImageInfo(test.Image, test.ImageID)
+			g.Expect(ok).Should(Equal(test.ExpectedOK))
+			g.Expect(info).Should(Equal(test.ExpectedInfo))
+		})
+	}
+}
+
+func TestDiscoverTargets(t *testing.T) {
+	clientSet := fake.NewSimpleClientset(
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "worker-1",
+				Labels: map[string]string{"pool": "general", nodeInstanceTypeLabel: "m5.large"},
+			},
+			Status: corev1.NodeStatus{
+				NodeInfo: corev1.NodeSystemInfo{OSImage: "Ubuntu 22.04.3 LTS", OperatingSystem: "linux"},
+			},
+		},
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "gpu-1",
+				Labels: map[string]string{"pool": "gpu"},
+			},
+		},
+		newPod("default", "web-1", "nginx:1.25", "docker.io/library/nginx@"+testDigest),
+		newPod("default", "web-2", "nginx:stable", "docker.io/library/nginx@"+testDigest),
+		newPod("kube-system", "local", "local/image:dev", "docker://"+testDigest),
+	)
+	client := &Client{
+		config:    &Config{ClusterName: "test", ScannerNamespace: DefaultScannerNamespace},
+		clientSet: clientSet,
+	}
+
+	t.Run("Nodes matching the node selector and all workload images", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		scanScope := &models.ScanScopeType{}
+		err := scanScope.FromKubernetesScanScope(models.KubernetesScanScope{
+			NodeSelector: &[]models.Tag{{Key: "pool", Value: "general"}},
+		})
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		targets, err := client.DiscoverTargets(context.Background(), scanScope)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(targets).Should(HaveLen(2))
+
+		vmInfo, err := targets[0].AsVMInfo()
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(vmInfo.InstanceID).Should(Equal("worker-1"))
+		g.Expect(vmInfo.InstanceType).Should(Equal("m5.large"))
+		g.Expect(vmInfo.Location).Should(Equal("test"))
+		g.Expect(vmInfo.Image).Should(Equal("Ubuntu 22.04.3 LTS"))
+		g.Expect(vmInfo.InstanceProvider).Should(Equal(utils.PointerTo(models.Kubernetes)))
+
+		imageInfo, err := targets[1].AsContainerImageInfo()
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(imageInfo.Location).Should(Equal("index.docker.io/library/nginx@" + testDigest))
+		g.Expect(imageInfo.Tags).Should(Equal(utils.PointerTo([]string{"1.25", "stable"})))
+	})
+
+	t.Run("Workload images in namespace without nodes", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		scanScope := &models.ScanScopeType{}
+		err := scanScope.FromKubernetesScanScope(models.KubernetesScanScope{
+			Namespaces: &[]string{"kube-system"},
+			SkipNodes:  utils.PointerTo(true),
+		})
+		g.Expect(err).ShouldNot(HaveOccurred())
+
+		targets, err := client.DiscoverTargets(context.Background(), scanScope)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(targets).Should(BeEmpty())
+	})
+}
+
+func newPod(namespace, name, image, imageID string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "main", Image: image}},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{Name: "main", Image: image, ImageID: imageID}},
+		},
+	}
+}
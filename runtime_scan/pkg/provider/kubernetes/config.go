@@ -0,0 +1,78 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	DefaultEnvPrefix        = "VMCLARITY_KUBERNETES"
+	DefaultClusterName      = "kubernetes"
+	DefaultScannerNamespace = "vmclarity"
+)
+
+type Config struct {
+	// Kubeconfig is the path of the kubeconfig file used for connecting to the cluster,
+	// the in-cluster configuration is used if empty
+	Kubeconfig string `mapstructure:"kubeconfig"`
+	// ClusterName is the name of the cluster used as the location of the discovered targets
+	ClusterName string `mapstructure:"cluster_name"`
+	// ScannerNamespace is the namespace where the scanner Jobs are created
+	ScannerNamespace string `mapstructure:"scanner_namespace"`
+	// ScannerServiceAccount is the name of the service account the scanner Jobs are run with,
+	// the default service account of ScannerNamespace is used if empty
+	ScannerServiceAccount string `mapstructure:"scanner_service_account"`
+}
+
+func (c *Config) Validate() error {
+	if c.ClusterName == "" {
+		return fmt.Errorf("parameter ClusterName must be provided")
+	}
+
+	if c.ScannerNamespace == "" {
+		return fmt.Errorf("parameter ScannerNamespace must be provided")
+	}
+
+	return nil
+}
+
+func NewConfig() (*Config, error) {
+	// Avoid modifying the global instance
+	v := viper.New()
+
+	v.SetEnvPrefix(DefaultEnvPrefix)
+	v.AllowEmptyEnv(true)
+	v.AutomaticEnv()
+
+	_ = v.BindEnv("kubeconfig")
+	_ = v.BindEnv("scanner_service_account")
+
+	_ = v.BindEnv("cluster_name")
+	v.SetDefault("cluster_name", DefaultClusterName)
+
+	_ = v.BindEnv("scanner_namespace")
+	v.SetDefault("scanner_namespace", DefaultScannerNamespace)
+
+	config := &Config{}
+	if err := v.Unmarshal(config); err != nil {
+		return nil, fmt.Errorf("failed to parse provider configuration. Provider=Kubernetes: %w", err)
+	}
+
+	return config, nil
+}
@@ -0,0 +1,94 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"os"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+)
+
+func TestConfig(t *testing.T) {
+	tests := []struct {
+		Name    string
+		EnvVars map[string]string
+
+		ExpectedNewErrorMatcher      types.GomegaMatcher
+		ExpectedConfig               *Config
+		ExpectedValidateErrorMatcher types.GomegaMatcher
+	}{
+		{
+			Name:                    "Default config",
+			EnvVars:                 map[string]string{},
+			ExpectedNewErrorMatcher: Not(HaveOccurred()),
+			ExpectedConfig: &Config{
+				ClusterName:      DefaultClusterName,
+				ScannerNamespace: DefaultScannerNamespace,
+			},
+			ExpectedValidateErrorMatcher: Not(HaveOccurred()),
+		},
+		{
+			Name: "Custom config",
+			EnvVars: map[string]string{
+				"VMCLARITY_KUBERNETES_KUBECONFIG":              "/etc/vmclarity/kubeconfig",
+				"VMCLARITY_KUBERNETES_CLUSTER_NAME":            "production",
+				"VMCLARITY_KUBERNETES_SCANNER_NAMESPACE":       "vmclarity-scanners",
+				"VMCLARITY_KUBERNETES_SCANNER_SERVICE_ACCOUNT": "vmclarity-scanner",
+			},
+			ExpectedNewErrorMatcher: Not(HaveOccurred()),
+			ExpectedConfig: &Config{
+				Kubeconfig:            "/etc/vmclarity/kubeconfig",
+				ClusterName:           "production",
+				ScannerNamespace:      "vmclarity-scanners",
+				ScannerServiceAccount: "vmclarity-scanner",
+			},
+			ExpectedValidateErrorMatcher: Not(HaveOccurred()),
+		},
+		{
+			Name: "Empty scanner namespace",
+			EnvVars: map[string]string{
+				"VMCLARITY_KUBERNETES_SCANNER_NAMESPACE": "",
+			},
+			ExpectedNewErrorMatcher: Not(HaveOccurred()),
+			ExpectedConfig: &Config{
+				ClusterName: DefaultClusterName,
+			},
+			ExpectedValidateErrorMatcher: HaveOccurred(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			os.Clearenv()
+			for k, v := range test.EnvVars {
+				err := os.Setenv(k, v)
+				g.Expect(err).Should(Not(HaveOccurred()))
+			}
+
+			config, err := NewConfig()
+
+			g.Expect(err).Should(test.ExpectedNewErrorMatcher)
+			g.Expect(config).Should(BeEquivalentTo(test.ExpectedConfig))
+
+			err = config.Validate()
+			g.Expect(err).Should(test.ExpectedValidateErrorMatcher)
+		})
+	}
+}
@@ -0,0 +1,278 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const (
+	DefaultRetryAfter = 10 * time.Second
+
+	LabelKeyManagedBy    = "app.kubernetes.io/managed-by"
+	LabelValueManagedBy  = "vmclarity"
+	LabelKeyScanID       = "vmclarity.openclarity.io/scan-id"
+	LabelKeyScanResultID = "vmclarity.openclarity.io/scan-result-id"
+	LabelKeyTargetID     = "vmclarity.openclarity.io/target-id"
+
+	scannerJobNamePrefix   = "vmclarity-scanner-"
+	scannerContainerName   = "vmclarity-scanner"
+	scannerConfigName      = "scanconfig.yaml"
+	scannerConfigDir       = "/etc/vmclarity"
+	scannerOutputDir       = "/var/opt/vmclarity"
+	hostRootDir            = "/host"
+	scannerConfigVolume    = "scanner-config"
+	scannerOutputVolume    = "scanner-output"
+	hostRootVolume         = "host-root"
+	targetTypeVMInfo       = "VMInfo"
+	scannerJobBackoffLimit = 0
+)
+
+// nolint:cyclop
+func (c *Client) RunTargetScan(ctx context.Context, config *provider.ScanJobConfig) error {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	jobName := scannerJobName(config)
+	jobs := c.clientSet.BatchV1().Jobs(c.config.ScannerNamespace)
+
+	job, err := jobs.Get(ctx, jobName, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return provider.RetryableErrorf(DefaultRetryAfter, "failed to get scanner job %s: %w", jobName, err)
+	}
+
+	// The scanner job has not been created by a previous reconciliation
+	if apierrors.IsNotFound(err) {
+		job, err = c.newScannerJob(config)
+		if err != nil {
+			return provider.FatalErrorf("failed to create scanner job spec: %w", err)
+		}
+
+		// The scanner config is only passed in a ConfigMap if the scanner can't fetch it from the backend
+		if config.ScannerConfigURL == "" {
+			if err = c.createScannerConfigMap(ctx, config); err != nil {
+				return err
+			}
+		}
+
+		logger.Debugf("Creating scanner job %s", jobName)
+		op := provider.StartOperation(ctx, models.Kubernetes, models.JobCreate, c.config.ClusterName)
+		job, err = jobs.Create(ctx, job, metav1.CreateOptions{})
+		op.Finish(ctx, c.jobResourceID(jobName), "", err)
+		if err != nil && !apierrors.IsAlreadyExists(err) {
+			return provider.RetryableErrorf(DefaultRetryAfter, "failed to create scanner job %s: %w", jobName, err)
+		}
+		if err != nil {
+			return provider.InProgressErrorf(DefaultRetryAfter, "scanner job %s is being created", jobName)
+		}
+	}
+
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+			return provider.FatalErrorf("scanner job %s failed: %s", jobName, condition.Message)
+		}
+	}
+
+	if job.Status.Active == 0 && job.Status.Succeeded == 0 {
+		return provider.InProgressErrorf(DefaultRetryAfter, "scanner job %s is not started yet", jobName)
+	}
+
+	return nil
+}
+
+func (c *Client) RemoveTargetScan(ctx context.Context, config *provider.ScanJobConfig) error {
+	jobName := scannerJobName(config)
+
+	// The pods of the job are only deleted with the job if a propagation policy is set
+	op := provider.StartOperation(ctx, models.Kubernetes, models.JobDelete, c.config.ClusterName)
+	err := c.clientSet.BatchV1().Jobs(c.config.ScannerNamespace).Delete(ctx, jobName, metav1.DeleteOptions{
+		PropagationPolicy: utils.PointerTo(metav1.DeletePropagationBackground),
+	})
+	// The job is only deleted by the first call of an idempotent removal
+	if !apierrors.IsNotFound(err) {
+		op.Finish(ctx, c.jobResourceID(jobName), "", err)
+	}
+	if err != nil && !apierrors.IsNotFound(err) {
+		return provider.RetryableErrorf(DefaultRetryAfter, "failed to delete scanner job %s: %w", jobName, err)
+	}
+
+	err = c.clientSet.CoreV1().ConfigMaps(c.config.ScannerNamespace).Delete(ctx, jobName, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return provider.RetryableErrorf(DefaultRetryAfter, "failed to delete scanner config %s: %w", jobName, err)
+	}
+
+	return nil
+}
+
+// jobResourceID returns the namespaced name of the scanner job.
+func (c *Client) jobResourceID(jobName string) string {
+	return c.config.ScannerNamespace + "/" + jobName
+}
+
+func (c *Client) createScannerConfigMap(ctx context.Context, config *provider.ScanJobConfig) error {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: c.scannerObjectMeta(config),
+		Data: map[string]string{
+			scannerConfigName: config.ScannerCLIConfig,
+		},
+	}
+
+	_, err := c.clientSet.CoreV1().ConfigMaps(c.config.ScannerNamespace).Create(ctx, configMap, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return provider.RetryableErrorf(DefaultRetryAfter, "failed to create scanner config %s: %w", configMap.Name, err)
+	}
+
+	return nil
+}
+
+// newScannerJob returns the Job which runs the scanner image on the node of a VMInfo target with the root
+// filesystem of the node mounted, or anywhere in the cluster for a ContainerImageInfo target, as the scanner pulls
+// the image itself.
+func (c *Client) newScannerJob(config *provider.ScanJobConfig) (*batchv1.Job, error) {
+	meta := c.scannerObjectMeta(config)
+
+	args := []string{
+		"--server", config.VMClarityAddress,
+		"--scan-result-id", config.ScanResultID,
+		"--output", scannerOutputDir,
+	}
+
+	volumes := []corev1.Volume{
+		{
+			Name:         scannerOutputVolume,
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		},
+	}
+	volumeMounts := []corev1.VolumeMount{
+		{
+			Name:      scannerOutputVolume,
+			MountPath: scannerOutputDir,
+		},
+	}
+
+	if config.ScannerConfigURL != "" {
+		args = append(args, "--config-url", config.ScannerConfigURL, "--config-token", config.ScannerConfigToken)
+	} else {
+		args = append(args, "--config", path.Join(scannerConfigDir, scannerConfigName))
+		volumes = append(volumes, corev1.Volume{
+			Name: scannerConfigVolume,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: meta.Name},
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      scannerConfigVolume,
+			MountPath: scannerConfigDir,
+			ReadOnly:  true,
+		})
+	}
+
+	podSpec := corev1.PodSpec{
+		RestartPolicy:      corev1.RestartPolicyNever,
+		ServiceAccountName: c.config.ScannerServiceAccount,
+	}
+
+	targetType, err := config.AssetInfo.Discriminator()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get target type: %w", err)
+	}
+
+	switch {
+	case config.InputImage != "":
+		args = append(args, "--input-image", config.InputImage)
+	case targetType == targetTypeVMInfo:
+		vmInfo, err := config.AssetInfo.AsVMInfo()
+		if err != nil {
+			return nil, fmt.Errorf("unable to get vminfo from target: %w", err)
+		}
+
+		// Run on the scanned node regardless of its taints and scan its root filesystem in place
+		podSpec.NodeName = vmInfo.InstanceID
+		podSpec.Tolerations = []corev1.Toleration{
+			{Operator: corev1.TolerationOpExists},
+		}
+		args = append(args, "--input-rootfs", hostRootDir)
+		volumes = append(volumes, corev1.Volume{
+			Name: hostRootVolume,
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{Path: "/"},
+			},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      hostRootVolume,
+			MountPath: hostRootDir,
+			ReadOnly:  true,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported target type %s", targetType)
+	}
+
+	podSpec.Volumes = volumes
+	podSpec.Containers = []corev1.Container{
+		{
+			Name:         scannerContainerName,
+			Image:        config.ScannerImage,
+			Args:         args,
+			VolumeMounts: volumeMounts,
+			SecurityContext: &corev1.SecurityContext{
+				// The files of the node root filesystem are only readable by root
+				RunAsUser: utils.PointerTo[int64](0),
+			},
+		},
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: meta,
+		Spec: batchv1.JobSpec{
+			BackoffLimit: utils.PointerTo[int32](scannerJobBackoffLimit),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: meta.Labels},
+				Spec:       podSpec,
+			},
+		},
+	}, nil
+}
+
+func (c *Client) scannerObjectMeta(config *provider.ScanJobConfig) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      scannerJobName(config),
+		Namespace: c.config.ScannerNamespace,
+		Labels: map[string]string{
+			LabelKeyManagedBy:    LabelValueManagedBy,
+			LabelKeyScanID:       config.ScanID,
+			LabelKeyScanResultID: config.ScanResultID,
+			LabelKeyTargetID:     config.TargetID,
+		},
+	}
+}
+
+func scannerJobName(config *provider.ScanJobConfig) string {
+	return scannerJobNamePrefix + config.ScanResultID
+}
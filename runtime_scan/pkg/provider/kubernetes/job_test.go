@@ -0,0 +1,132 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+)
+
+func TestRunTargetScan(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	clientSet := fake.NewSimpleClientset()
+	client := &Client{
+		config:    &Config{ClusterName: "test", ScannerNamespace: DefaultScannerNamespace},
+		clientSet: clientSet,
+	}
+
+	targetInfo := &models.AssetType{}
+	err := targetInfo.FromVMInfo(models.VMInfo{InstanceID: "worker-1", Location: "test"})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	config := &provider.ScanJobConfig{
+		ScannerImage:     "ghcr.io/openclarity/vmclarity-cli:latest",
+		ScannerCLIConfig: "vulnerabilities:\n  enabled: true\n",
+		VMClarityAddress: "vmclarity-backend:8888",
+		ScanMetadata: provider.ScanMetadata{
+			ScanID:       "scan-1",
+			ScanResultID: "result-1",
+			TargetID:     "target-1",
+		},
+		Asset: models.Asset{AssetInfo: targetInfo},
+	}
+	jobs := clientSet.BatchV1().Jobs(DefaultScannerNamespace)
+
+	// The job is created and the scan waits for it to start
+	err = client.RunTargetScan(ctx, config)
+	g.Expect(err).Should(BeAssignableToTypeOf(provider.RetryableError{}))
+
+	job, err := jobs.Get(ctx, "vmclarity-scanner-result-1", metav1.GetOptions{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	podSpec := job.Spec.Template.Spec
+	g.Expect(podSpec.NodeName).Should(Equal("worker-1"))
+	g.Expect(podSpec.Containers[0].Args).Should(Equal([]string{
+		"--server", "vmclarity-backend:8888",
+		"--scan-result-id", "result-1",
+		"--output", scannerOutputDir,
+		"--config", "/etc/vmclarity/scanconfig.yaml",
+		"--input-rootfs", hostRootDir,
+	}))
+
+	configMap, err := clientSet.CoreV1().ConfigMaps(DefaultScannerNamespace).Get(ctx, "vmclarity-scanner-result-1", metav1.GetOptions{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(configMap.Data).Should(HaveKeyWithValue(scannerConfigName, config.ScannerCLIConfig))
+
+	// The scan is running once the job has an active pod
+	job.Status.Active = 1
+	_, err = jobs.UpdateStatus(ctx, job, metav1.UpdateOptions{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(client.RunTargetScan(ctx, config)).Should(Succeed())
+
+	// A failed job can't be recovered
+	job.Status.Active = 0
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}}
+	_, err = jobs.UpdateStatus(ctx, job, metav1.UpdateOptions{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(client.RunTargetScan(ctx, config)).Should(BeAssignableToTypeOf(provider.FatalError{}))
+
+	// The job and its config are removed and removing them again succeeds
+	g.Expect(client.RemoveTargetScan(ctx, config)).Should(Succeed())
+	_, err = jobs.Get(ctx, "vmclarity-scanner-result-1", metav1.GetOptions{})
+	g.Expect(apierrors.IsNotFound(err)).Should(BeTrue())
+	g.Expect(client.RemoveTargetScan(ctx, config)).Should(Succeed())
+}
+
+func TestNewScannerJobForImage(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client := &Client{
+		config: &Config{ClusterName: "test", ScannerNamespace: DefaultScannerNamespace},
+	}
+
+	targetInfo := &models.AssetType{}
+	err := targetInfo.FromContainerImageInfo(models.ContainerImageInfo{
+		ImageID:    testDigest,
+		Repository: "library/nginx",
+		Location:   "index.docker.io/library/nginx@" + testDigest,
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	job, err := client.newScannerJob(&provider.ScanJobConfig{
+		ScannerConfigURL:   "http://vmclarity-backend:8888/api/scanResults/result-1/scannerConfig",
+		ScannerConfigToken: "Yx3sQpV1b9kR2mN8tW4zL6cF0hJ5dG7aE1uI3oP9rT2",
+		InputImage:         "index.docker.io/library/nginx@" + testDigest,
+		ScanMetadata:       provider.ScanMetadata{ScanResultID: "result-1"},
+		Asset:              models.Asset{AssetInfo: targetInfo},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	podSpec := job.Spec.Template.Spec
+	g.Expect(podSpec.NodeName).Should(BeEmpty())
+	g.Expect(podSpec.Volumes).Should(HaveLen(1))
+	g.Expect(podSpec.Containers[0].Args).Should(ContainElements(
+		"--config-url", "http://vmclarity-backend:8888/api/scanResults/result-1/scannerConfig",
+		"--config-token", "Yx3sQpV1b9kR2mN8tW4zL6cF0hJ5dG7aE1uI3oP9rT2",
+		"--input-image", "index.docker.io/library/nginx@"+testDigest,
+	))
+}
@@ -0,0 +1,92 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// OperationRecorder records the mutations of the cloud resources performed by
+// the providers, so that the activity reported by the cloud provider can be
+// correlated with the scans.
+type OperationRecorder interface {
+	RecordOperation(ctx context.Context, operation models.ProviderOperation)
+}
+
+type operationRecorderKey struct{}
+
+// WithOperationRecorder returns a copy of ctx which carries recorder. The
+// operations started with the returned context are recorded by recorder.
+func WithOperationRecorder(ctx context.Context, recorder OperationRecorder) context.Context {
+	return context.WithValue(ctx, operationRecorderKey{}, recorder)
+}
+
+func operationRecorderFromContext(ctx context.Context) OperationRecorder {
+	recorder, _ := ctx.Value(operationRecorderKey{}).(OperationRecorder)
+	return recorder
+}
+
+// Operation is a mutation of a cloud resource started by a provider.
+type Operation struct {
+	recorder  OperationRecorder
+	operation models.ProviderOperation
+}
+
+// StartOperation starts the operation of the given type on a resource in
+// location. Nothing is recorded if ctx doesn't carry an OperationRecorder.
+func StartOperation(ctx context.Context, kind models.CloudProvider, operationType models.ProviderOperationType, location string) *Operation {
+	return &Operation{
+		recorder: operationRecorderFromContext(ctx),
+		operation: models.ProviderOperation{
+			Provider:  kind,
+			Operation: operationType,
+			Location:  nilIfEmpty(location),
+			StartTime: time.Now(),
+		},
+	}
+}
+
+// Finish records the outcome of the operation. resourceID and requestID may
+// be empty if they are not known, for example when the request failed before
+// reaching the cloud API.
+func (o *Operation) Finish(ctx context.Context, resourceID, requestID string, err error) {
+	if o.recorder == nil {
+		return
+	}
+
+	operation := o.operation
+	operation.ResourceID = nilIfEmpty(resourceID)
+	operation.RequestID = nilIfEmpty(requestID)
+	operation.EndTime = utils.PointerTo(time.Now())
+	operation.Status = models.Successful
+	if err != nil {
+		operation.Status = models.Unsuccessful
+		operation.ErrorMessage = utils.PointerTo(err.Error())
+	}
+
+	o.recorder.RecordOperation(ctx, operation)
+}
+
+func nilIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,100 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/openclarity/vmclarity/api/models"
+)
+
+type fakeOperationRecorder struct {
+	operations []models.ProviderOperation
+}
+
+func (r *fakeOperationRecorder) RecordOperation(_ context.Context, operation models.ProviderOperation) {
+	r.operations = append(r.operations, operation)
+}
+
+func TestOperation(t *testing.T) {
+	tests := []struct {
+		Name       string
+		ResourceID string
+		RequestID  string
+		Err        error
+
+		ExpectedOperation models.ProviderOperation
+	}{
+		{
+			Name:       "Successful operation",
+			ResourceID: "snap-1",
+			RequestID:  "req-1",
+			ExpectedOperation: models.ProviderOperation{
+				Provider:   models.AWS,
+				Operation:  models.SnapshotCreate,
+				Location:   nilIfEmpty("eu-west-1"),
+				ResourceID: nilIfEmpty("snap-1"),
+				RequestID:  nilIfEmpty("req-1"),
+				Status:     models.Successful,
+			},
+		},
+		{
+			Name: "Unsuccessful operation without response",
+			Err:  errors.New("connection refused"),
+			ExpectedOperation: models.ProviderOperation{
+				Provider:     models.AWS,
+				Operation:    models.SnapshotCreate,
+				Location:     nilIfEmpty("eu-west-1"),
+				Status:       models.Unsuccessful,
+				ErrorMessage: nilIfEmpty("connection refused"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			recorder := &fakeOperationRecorder{}
+			ctx := WithOperationRecorder(context.Background(), recorder)
+
+			op := StartOperation(ctx, models.AWS, models.SnapshotCreate, "eu-west-1")
+			op.Finish(ctx, test.ResourceID, test.RequestID, test.Err)
+
+			g.Expect(recorder.operations).To(HaveLen(1))
+			recorded := recorder.operations[0]
+			g.Expect(recorded.EndTime).ToNot(BeNil())
+			g.Expect(recorded.EndTime.Before(recorded.StartTime)).To(BeFalse())
+
+			recorded.StartTime = test.ExpectedOperation.StartTime
+			recorded.EndTime = nil
+			g.Expect(recorded).To(Equal(test.ExpectedOperation))
+		})
+	}
+}
+
+func TestOperationWithoutRecorder(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+	op := StartOperation(ctx, models.Azure, models.VolumeDelete, "westeurope")
+
+	g.Expect(func() { op.Finish(ctx, "disk-1", "", nil) }).ToNot(Panic())
+}
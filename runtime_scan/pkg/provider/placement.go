@@ -0,0 +1,35 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"hash/fnv"
+)
+
+// ZoneForScanJob returns the zone of zones the Scanner instance of the scan job
+// is created in if the scanners are spread across zones. The zone is derived
+// from the ScanResultID, so that it doesn't change when the job is retried and
+// the Scanner instances of a scan are distributed evenly across the zones.
+func ZoneForScanJob(zones []string, config *ScanJobConfig) string {
+	if len(zones) == 0 {
+		return ""
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(config.ScanResultID))
+
+	return zones[h.Sum32()%uint32(len(zones))]
+}
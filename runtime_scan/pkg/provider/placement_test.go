@@ -0,0 +1,49 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestZoneForScanJob(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(ZoneForScanJob(nil, &ScanJobConfig{})).Should(BeEmpty())
+
+	zones := []string{"1", "2", "3"}
+	counts := make(map[string]int)
+	for i := 0; i < 300; i++ {
+		config := &ScanJobConfig{
+			ScanMetadata: ScanMetadata{ScanResultID: fmt.Sprintf("scan-result-%d", i)},
+		}
+
+		zone := ZoneForScanJob(zones, config)
+		g.Expect(zones).Should(ContainElement(zone))
+		// The zone of a job doesn't change when it is retried
+		g.Expect(ZoneForScanJob(zones, config)).Should(Equal(zone))
+		counts[zone]++
+	}
+
+	// The scanners are spread across all the zones
+	g.Expect(counts).Should(HaveLen(len(zones)))
+	for _, count := range counts {
+		g.Expect(count).Should(BeNumerically(">", 50))
+	}
+}
@@ -0,0 +1,268 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/scanexport"
+)
+
+// OperationClass groups the provider operations sharing a RetryPolicy.
+type OperationClass string
+
+const (
+	OperationRunTargetScan            OperationClass = "RunTargetScan"
+	OperationRemoveTargetScan         OperationClass = "RemoveTargetScan"
+	OperationCollectTargetScanResults OperationClass = "CollectTargetScanResults"
+)
+
+// RetryPolicy defines the jittered exponential backoff between the retries
+// of an operation and the number of retries allowed.
+type RetryPolicy struct {
+	// InitialInterval is the delay before the first retry.
+	InitialInterval time.Duration
+	// MaxInterval caps the delay between retries.
+	MaxInterval time.Duration
+	// Multiplier is applied to the delay after each retry.
+	Multiplier float64
+	// Jitter is the fraction of the delay which is randomized, between 0 and 1.
+	Jitter float64
+	// MaxAttempts is the number of retries before the operation fails, zero means unlimited.
+	MaxAttempts int
+}
+
+// DefaultRetryPolicies are used for the operations of the providers created by the orchestrator.
+var DefaultRetryPolicies = map[OperationClass]RetryPolicy{
+	OperationRunTargetScan: {
+		InitialInterval: 15 * time.Second,
+		MaxInterval:     5 * time.Minute,
+		Multiplier:      2,
+		Jitter:          0.2,
+		MaxAttempts:     60,
+	},
+	OperationRemoveTargetScan: {
+		InitialInterval: 15 * time.Second,
+		MaxInterval:     5 * time.Minute,
+		Multiplier:      2,
+		Jitter:          0.2,
+		MaxAttempts:     60,
+	},
+	OperationCollectTargetScanResults: {
+		InitialInterval: 15 * time.Second,
+		MaxInterval:     5 * time.Minute,
+		Multiplier:      2,
+		Jitter:          0.2,
+		MaxAttempts:     60,
+	},
+}
+
+// Backoff returns the delay before the given retry attempt, starting from 1.
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	d := float64(p.InitialInterval) * math.Pow(p.Multiplier, float64(attempt-1))
+	if p.MaxInterval > 0 && d > float64(p.MaxInterval) {
+		d = float64(p.MaxInterval)
+	}
+	if p.Jitter > 0 {
+		// nolint:gosec
+		d += d * p.Jitter * (2*rand.Float64() - 1)
+	}
+
+	return time.Duration(d)
+}
+
+// RetryStats holds the retry counters of an operation class.
+type RetryStats struct {
+	// Retries is the number of times an operation was retried after an error.
+	Retries int
+	// Exhausted is the number of operations which failed after using up their attempts.
+	Exhausted int
+	// Waits is the number of times an operation was retried as it was in progress.
+	Waits int
+}
+
+// RetryingProvider decorates a Provider by replacing the retry estimates of the
+// RetryableError(s) returned by its operations with the backoff of the
+// operation class, and failing the operation with FatalError when it runs out
+// of attempts. The operations which are in progress are retried after the
+// estimate of the Provider, and don't use up attempts as they have not failed.
+// Attempts are tracked per ScanResult and reset once the operation progresses.
+type RetryingProvider struct {
+	Provider
+
+	policies map[OperationClass]RetryPolicy
+	attempts map[string]int
+	stats    map[OperationClass]RetryStats
+	mu       sync.Mutex
+}
+
+func WithRetry(p Provider, policies map[OperationClass]RetryPolicy) *RetryingProvider {
+	return &RetryingProvider{
+		Provider: p,
+		policies: policies,
+		attempts: make(map[string]int),
+		stats:    make(map[OperationClass]RetryStats),
+	}
+}
+
+func (r *RetryingProvider) RunTargetScan(ctx context.Context, config *ScanJobConfig) error {
+	err := r.Provider.RunTargetScan(ctx, config)
+	return r.handleError(ctx, OperationRunTargetScan, config.ScanResultID, err)
+}
+
+func (r *RetryingProvider) RemoveTargetScan(ctx context.Context, config *ScanJobConfig) error {
+	err := r.Provider.RemoveTargetScan(ctx, config)
+	return r.handleError(ctx, OperationRemoveTargetScan, config.ScanResultID, err)
+}
+
+// GetDeltaScanInfo delegates to the decorated Provider if it is a DeltaScanner,
+// otherwise there is no delta to report.
+func (r *RetryingProvider) GetDeltaScanInfo(ctx context.Context, config *ScanJobConfig) (*models.DeltaScanInfo, error) {
+	deltaScanner, ok := r.Provider.(DeltaScanner)
+	if !ok {
+		return nil, nil
+	}
+	// nolint:wrapcheck
+	return deltaScanner.GetDeltaScanInfo(ctx, config)
+}
+
+// ResolveScannerImage delegates to the decorated Provider if it is a
+// ScannerImageResolver, otherwise the configured image is used.
+func (r *RetryingProvider) ResolveScannerImage(ctx context.Context) (*models.ScannerInstanceImage, error) {
+	resolver, ok := r.Provider.(ScannerImageResolver)
+	if !ok {
+		return nil, nil
+	}
+	// nolint:wrapcheck
+	return resolver.ResolveScannerImage(ctx)
+}
+
+// PrewarmTargetScan delegates to the decorated Provider if it is a
+// SnapshotPrewarmer, otherwise there is no snapshot to create ahead.
+func (r *RetryingProvider) PrewarmTargetScan(ctx context.Context, config *ScanJobConfig) error {
+	prewarmer, ok := r.Provider.(SnapshotPrewarmer)
+	if !ok {
+		return nil
+	}
+	// nolint:wrapcheck
+	return prewarmer.PrewarmTargetScan(ctx, config)
+}
+
+// CollectTargetScanResults delegates to the decorated Provider if it is a
+// ResultCollector, otherwise the Scanners export the results themselves.
+func (r *RetryingProvider) CollectTargetScanResults(ctx context.Context, config *ScanJobConfig) (*scanexport.Results, error) {
+	collector, ok := r.Provider.(ResultCollector)
+	if !ok {
+		return nil, nil
+	}
+	results, err := collector.CollectTargetScanResults(ctx, config)
+	return results, r.handleError(ctx, OperationCollectTargetScanResults, config.ScanResultID, err)
+}
+
+// Unwrap returns the decorated Provider.
+func (r *RetryingProvider) Unwrap() Provider {
+	return r.Provider
+}
+
+// Capabilities returns the capabilities of the decorated Provider.
+func (r *RetryingProvider) Capabilities() models.ProviderCapabilities {
+	return CapabilitiesOf(r.Provider)
+}
+
+// OperationClasses returns the operation classes which have a RetryPolicy.
+func (r *RetryingProvider) OperationClasses() []OperationClass {
+	classes := make([]OperationClass, 0, len(r.policies))
+	for class := range r.policies {
+		classes = append(classes, class)
+	}
+	sort.Slice(classes, func(i, j int) bool {
+		return classes[i] < classes[j]
+	})
+
+	return classes
+}
+
+// Stats returns the retry counters of the operation class.
+func (r *RetryingProvider) Stats(class OperationClass) RetryStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.stats[class]
+}
+
+func (r *RetryingProvider) handleError(ctx context.Context, class OperationClass, id string, err error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := fmt.Sprintf("%s/%s", class, id)
+
+	var retryableError RetryableError
+	if err == nil || !errors.As(err, &retryableError) {
+		delete(r.attempts, key)
+		return err
+	}
+
+	policy, ok := r.policies[class]
+	if !ok {
+		return err
+	}
+
+	if retryableError.InProgress {
+		delete(r.attempts, key)
+		stats := r.stats[class]
+		stats.Waits++
+		r.stats[class] = stats
+		return err
+	}
+
+	r.attempts[key]++
+	attempt := r.attempts[key]
+	stats := r.stats[class]
+
+	if policy.MaxAttempts > 0 && attempt > policy.MaxAttempts {
+		delete(r.attempts, key)
+		stats.Exhausted++
+		r.stats[class] = stats
+		return FatalError{
+			Err: fmt.Errorf("operation %s failed after %d attempts: %w", class, policy.MaxAttempts, err),
+		}
+	}
+
+	stats.Retries++
+	r.stats[class] = stats
+
+	after := policy.Backoff(attempt)
+	log.GetLoggerFromContextOrDiscard(ctx).Debugf("Retrying operation %s in %s. Attempt=%d ScanResultID=%s",
+		class, after, attempt, id)
+
+	return RetryableError{
+		Err:   err,
+		After: after,
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/openclarity/vmclarity/api/models"
+)
+
+type fakeScanner struct {
+	Provider
+
+	err error
+}
+
+func (f *fakeScanner) RunTargetScan(context.Context, *ScanJobConfig) error {
+	return f.err
+}
+
+func (f *fakeScanner) RemoveTargetScan(context.Context, *ScanJobConfig) error {
+	return f.err
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{
+		InitialInterval: time.Second,
+		MaxInterval:     10 * time.Second,
+		Multiplier:      2,
+	}
+
+	tests := []struct {
+		Name    string
+		Attempt int
+
+		ExpectedBackoff time.Duration
+	}{
+		{
+			Name:            "First attempt",
+			Attempt:         1,
+			ExpectedBackoff: time.Second,
+		},
+		{
+			Name:            "Third attempt",
+			Attempt:         3,
+			ExpectedBackoff: 4 * time.Second,
+		},
+		{
+			Name:            "Capped by max interval",
+			Attempt:         10,
+			ExpectedBackoff: 10 * time.Second,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			g.Expect(policy.Backoff(test.Attempt)).Should(Equal(test.ExpectedBackoff))
+		})
+	}
+}
+
+func TestRetryPolicyBackoffJitter(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	policy := RetryPolicy{
+		InitialInterval: 10 * time.Second,
+		Multiplier:      2,
+		Jitter:          0.5,
+	}
+
+	for i := 0; i < 100; i++ {
+		g.Expect(policy.Backoff(1)).Should(BeNumerically("~", 10*time.Second, 5*time.Second))
+	}
+}
+
+func TestRetryingProvider(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scanner := &fakeScanner{}
+	p := WithRetry(scanner, map[OperationClass]RetryPolicy{
+		OperationRunTargetScan: {
+			InitialInterval: time.Second,
+			Multiplier:      2,
+			MaxAttempts:     2,
+		},
+	})
+	config := &ScanJobConfig{ScanMetadata: ScanMetadata{ScanResultID: "1234"}}
+	ctx := context.Background()
+
+	scanner.err = RetryableError{Err: errors.New("not ready"), After: time.Hour}
+	err := p.RunTargetScan(ctx, config)
+	g.Expect(err).Should(Equal(RetryableError{Err: scanner.err, After: time.Second}))
+
+	err = p.RunTargetScan(ctx, config)
+	g.Expect(err).Should(Equal(RetryableError{Err: scanner.err, After: 2 * time.Second}))
+
+	err = p.RunTargetScan(ctx, config)
+	var fatalError FatalError
+	g.Expect(errors.As(err, &fatalError)).Should(BeTrue())
+	g.Expect(p.Stats(OperationRunTargetScan)).Should(Equal(RetryStats{Retries: 2, Exhausted: 1}))
+
+	// The attempts start over after the budget was exhausted or the operation succeeded.
+	err = p.RunTargetScan(ctx, config)
+	g.Expect(err).Should(Equal(RetryableError{Err: scanner.err, After: time.Second}))
+
+	scanner.err = nil
+	g.Expect(p.RunTargetScan(ctx, config)).Should(Succeed())
+
+	scanner.err = RetryableError{Err: errors.New("not ready"), After: time.Hour}
+	err = p.RunTargetScan(ctx, config)
+	g.Expect(err).Should(Equal(RetryableError{Err: scanner.err, After: time.Second}))
+
+	// Operations in progress keep the estimate of the provider and don't use
+	// up attempts.
+	inProgress := InProgressErrorf(time.Hour, "snapshot is being copied")
+	scanner.err = inProgress
+	for i := 0; i < 5; i++ {
+		g.Expect(p.RunTargetScan(ctx, config)).Should(Equal(inProgress))
+	}
+	scanner.err = RetryableError{Err: errors.New("not ready"), After: time.Hour}
+	err = p.RunTargetScan(ctx, config)
+	g.Expect(err).Should(Equal(RetryableError{Err: scanner.err, After: time.Second}))
+	g.Expect(p.Stats(OperationRunTargetScan)).Should(Equal(RetryStats{Retries: 5, Exhausted: 1, Waits: 5}))
+
+	// Operations without policy keep the estimate of the provider.
+	err = p.RemoveTargetScan(ctx, config)
+	g.Expect(err).Should(Equal(scanner.err))
+
+	info, err := p.GetDeltaScanInfo(ctx, config)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(info).Should(Equal((*models.DeltaScanInfo)(nil)))
+}
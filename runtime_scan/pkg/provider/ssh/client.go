@@ -0,0 +1,394 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ssh
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+	"github.com/openclarity/vmclarity/shared/pkg/scanexport"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const (
+	DefaultPort        = "22"
+	DefaultDialTimeout = 30 * time.Second
+	DefaultRetryAfter  = 30 * time.Second
+
+	scannerBinaryName = "vmclarity-cli"
+	scannerConfigName = "scanconfig.yaml"
+	scannerPIDName    = "scanner.pid"
+	scannerLogName    = "scanner.log"
+	scannerOutputName = "output"
+	scannerResultsDir = "results"
+	scannerTempDir    = "tmp"
+	scannerCacheDir   = "cache"
+
+	// scannerLogTailLines is the number of the last lines of the scanner log reported when it exits without results.
+	scannerLogTailLines = 20
+)
+
+// Client implements provider.Provider for hosts which are reachable over SSH. Instead of attaching a snapshot of
+// the target volume to a scanner instance, the scanner binary is copied to the target host and scans the root
+// filesystem of the host in place. Only the read-only families can be run, and the results are written to the work
+// directory on the host and pulled over SSH, so the hosts don't need to reach the backend. Note that the families
+// relying on external tools require them to be installed on the target host.
+type Client struct {
+	config       *Config
+	clientConfig *ssh.ClientConfig
+}
+
+func New(_ context.Context) (*Client, error) {
+	config, err := NewConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	err = config.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate configuration: %w", err)
+	}
+
+	privateKey, err := os.ReadFile(config.PrivateKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read private key: %w", err)
+	}
+
+	signer, err := ssh.ParsePrivateKey(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	hostKeyCallback, err := knownhosts.New(config.KnownHostsFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load known hosts: %w", err)
+	}
+
+	return &Client{
+		config: config,
+		clientConfig: &ssh.ClientConfig{
+			User:            config.User,
+			Auth:            []ssh.AuthMethod{ssh.PublicKeys(signer)},
+			HostKeyCallback: hostKeyCallback,
+			Timeout:         DefaultDialTimeout,
+		},
+	}, nil
+}
+
+func (c Client) Kind() models.CloudProvider {
+	return models.SSH
+}
+
+func (c Client) Capabilities() models.ProviderCapabilities {
+	return models.ProviderCapabilities{
+		ScanStoppedInstances: false,
+		CrossRegion:          false,
+		// The filesystem of the running host is scanned, so volume encryption is transparent.
+		EncryptedVolumes: true,
+		SpotInstances:    false,
+		ScannerPlacement: false,
+	}
+}
+
+func (c *Client) DiscoverScopes(_ context.Context) (*models.Scopes, error) {
+	var ret models.Scopes
+	ret.ScopeInfo = &models.ScopeType{}
+
+	err := ret.ScopeInfo.FromSSHHostsScope(models.SSHHostsScope{
+		Hosts: utils.PointerTo(c.config.Hosts),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert from ssh hosts scope: %w", err)
+	}
+
+	return &ret, nil
+}
+
+func (c *Client) DiscoverTargets(_ context.Context, scanScope *models.ScanScopeType) ([]models.AssetType, error) {
+	sshScanScope, err := scanScope.AsSSHScanScope()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert as ssh scan scope: %w", err)
+	}
+
+	hosts := filterHosts(c.config.Hosts, sshScanScope.Hosts)
+
+	ret := make([]models.AssetType, 0, len(hosts))
+	for _, host := range hosts {
+		info, err := getVMInfoFromHost(host)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, info)
+	}
+
+	return ret, nil
+}
+
+// nolint:cyclop
+func (c *Client) RunTargetScan(ctx context.Context, config *provider.ScanJobConfig) error {
+	logger := log.GetLoggerFromContextOrDiscard(ctx)
+
+	if config.InputImage != "" {
+		return provider.FatalErrorf("container image targets are not supported")
+	}
+
+	if err := checkReadOnlyFamilies(config.ScannerCLIConfig); err != nil {
+		return provider.FatalErrorf("unable to run scan: %w", err)
+	}
+
+	host, err := c.targetHost(config)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.dial(ctx, host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	workDir := c.workDirFor(config)
+	pidFile := path.Join(workDir, scannerPIDName)
+
+	// The scanner has already been started by a previous reconciliation
+	started, err := fileExists(client, pidFile)
+	if err != nil {
+		return provider.RetryableErrorf(DefaultRetryAfter, "failed to check scanner state on host %s: %w", host, err)
+	}
+	if started {
+		return nil
+	}
+
+	cmd := fmt.Sprintf("mkdir -p %s %s %s %s",
+		quote(path.Join(workDir, scannerOutputName)),
+		quote(path.Join(workDir, scannerResultsDir)),
+		quote(path.Join(workDir, scannerTempDir)),
+		quote(path.Join(workDir, scannerCacheDir)),
+	)
+	if _, err = runCommand(client, cmd, nil); err != nil {
+		return provider.RetryableErrorf(DefaultRetryAfter, "failed to create work directory on host %s: %w", host, err)
+	}
+
+	logger.Debugf("Copying scanner binary to host %s", host)
+	binary, err := os.Open(c.config.ScannerBinaryPath)
+	if err != nil {
+		return provider.FatalErrorf("failed to open scanner binary: %w", err)
+	}
+	defer binary.Close()
+
+	binaryPath := path.Join(workDir, scannerBinaryName)
+	if err = copyFile(client, binary, binaryPath, "0700"); err != nil {
+		return provider.RetryableErrorf(DefaultRetryAfter, "failed to copy scanner binary to host %s: %w", host, err)
+	}
+
+	configPath := path.Join(workDir, scannerConfigName)
+	if err = copyFile(client, stringReader(config.ScannerCLIConfig), configPath, "0600"); err != nil {
+		return provider.RetryableErrorf(DefaultRetryAfter, "failed to copy scanner config to host %s: %w", host, err)
+	}
+
+	// Run the scanner in the background as RunTargetScan must not block. The root filesystem of the host is scanned
+	// in place and the results are written to the work directory, from where CollectTargetScanResults pulls them.
+	// The temporary files and caches of the families are kept in the work directory too.
+	cmd = fmt.Sprintf("nohup env HOME=%s TMPDIR=%s XDG_CACHE_HOME=%s %s --config %s --export-dir %s --input-rootfs / --output %s > %s 2>&1 < /dev/null & echo $! > %s",
+		quote(workDir),
+		quote(path.Join(workDir, scannerTempDir)),
+		quote(path.Join(workDir, scannerCacheDir)),
+		quote(binaryPath),
+		quote(configPath),
+		quote(path.Join(workDir, scannerResultsDir)),
+		quote(path.Join(workDir, scannerOutputName)),
+		quote(path.Join(workDir, scannerLogName)),
+		quote(pidFile),
+	)
+	if _, err = runCommand(client, cmd, nil); err != nil {
+		return provider.RetryableErrorf(DefaultRetryAfter, "failed to start scanner on host %s: %w", host, err)
+	}
+
+	return nil
+}
+
+func (c *Client) CollectTargetScanResults(ctx context.Context, config *provider.ScanJobConfig) (*scanexport.Results, error) {
+	host, err := c.targetHost(config)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := c.dial(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	workDir := c.workDirFor(config)
+	resultsFile := path.Join(workDir, scannerResultsDir, scanexport.FileName)
+
+	// The results file is written once all the families are done
+	done, err := fileExists(client, resultsFile)
+	if err != nil {
+		return nil, provider.RetryableErrorf(DefaultRetryAfter, "failed to check scanner state on host %s: %w", host, err)
+	}
+	if done {
+		out, err := runCommand(client, fmt.Sprintf("cat %s", quote(resultsFile)), nil)
+		if err != nil {
+			return nil, provider.RetryableErrorf(DefaultRetryAfter, "failed to read scan results from host %s: %w", host, err)
+		}
+
+		var results scanexport.Results
+		if err = json.Unmarshal(out, &results); err != nil {
+			return nil, provider.FatalErrorf("failed to parse scan results from host %s: %w", host, err)
+		}
+
+		return &results, nil
+	}
+
+	pidFile := quote(path.Join(workDir, scannerPIDName))
+	running, err := runCommand(client, fmt.Sprintf("if kill -0 $(cat %s) 2>/dev/null; then echo running; fi", pidFile), nil)
+	if err != nil {
+		return nil, provider.RetryableErrorf(DefaultRetryAfter, "failed to check scanner state on host %s: %w", host, err)
+	}
+	if len(running) > 0 {
+		return nil, provider.InProgressErrorf(DefaultRetryAfter, "scanner is running on host %s", host)
+	}
+
+	logFile := path.Join(workDir, scannerLogName)
+	logTail, _ := runCommand(client, fmt.Sprintf("tail -n %d %s", scannerLogTailLines, quote(logFile)), nil)
+	return nil, provider.FatalErrorf("scanner exited without results on host %s, see %s: %s", host, logFile, logTail)
+}
+
+func (c *Client) RemoveTargetScan(ctx context.Context, config *provider.ScanJobConfig) error {
+	// Nothing was started for container image targets.
+	if config.InputImage != "" {
+		return nil
+	}
+
+	host, err := c.targetHost(config)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.dial(ctx, host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	workDir := c.workDirFor(config)
+	pidFile := quote(path.Join(workDir, scannerPIDName))
+
+	// Stop the scanner if it is still running and remove everything which was copied to the host
+	cmd := fmt.Sprintf("if [ -f %s ]; then kill $(cat %s) 2>/dev/null; fi; rm -rf %s", pidFile, pidFile, quote(workDir))
+	if _, err = runCommand(client, cmd, nil); err != nil {
+		return provider.RetryableErrorf(DefaultRetryAfter, "failed to remove scanner from host %s: %w", host, err)
+	}
+
+	return nil
+}
+
+// targetHost returns the host of the target to connect to. Only the configured hosts can be connected to, as the
+// targets are read from the backend.
+func (c *Client) targetHost(config *provider.ScanJobConfig) (string, error) {
+	vmInfo, err := config.AssetInfo.AsVMInfo()
+	if err != nil {
+		return "", provider.FatalErrorf("unable to get vminfo from target: %w", err)
+	}
+
+	if !utils.Contains(c.config.Hosts, vmInfo.InstanceID) {
+		return "", provider.FatalErrorf("host %s is not configured", vmInfo.InstanceID)
+	}
+
+	return vmInfo.InstanceID, nil
+}
+
+func (c *Client) workDirFor(config *provider.ScanJobConfig) string {
+	return path.Join(c.config.RemoteWorkDir, config.ScanResultID)
+}
+
+func (c *Client) dial(ctx context.Context, host string) (*ssh.Client, error) {
+	addr := hostAddress(host)
+
+	dialer := net.Dialer{Timeout: c.clientConfig.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, provider.RetryableErrorf(DefaultRetryAfter, "failed to connect to host %s: %w", host, err)
+	}
+
+	sshConn, chans, reqs, err := ssh.NewClientConn(conn, addr, c.clientConfig)
+	if err != nil {
+		_ = conn.Close()
+		var keyErr *knownhosts.KeyError
+		if errors.As(err, &keyErr) {
+			return nil, provider.FatalErrorf("failed to verify host key of host %s: %w", host, err)
+		}
+		return nil, provider.RetryableErrorf(DefaultRetryAfter, "failed to establish ssh connection to host %s: %w", host, err)
+	}
+
+	return ssh.NewClient(sshConn, chans, reqs), nil
+}
+
+// filterHosts returns the configured hosts which are in the scope. All the configured hosts are returned if the
+// scope is empty, as only the configured hosts can be connected to.
+func filterHosts(configured []string, scope *[]string) []string {
+	if scope == nil || len(*scope) == 0 {
+		return configured
+	}
+
+	ret := make([]string, 0, len(*scope))
+	for _, host := range configured {
+		if utils.Contains(*scope, host) {
+			ret = append(ret, host)
+		}
+	}
+
+	return ret
+}
+
+func hostAddress(host string) string {
+	if _, _, err := net.SplitHostPort(host); err == nil {
+		return host
+	}
+
+	return net.JoinHostPort(host, DefaultPort)
+}
+
+func getVMInfoFromHost(host string) (models.AssetType, error) {
+	targetType := models.AssetType{}
+	err := targetType.FromVMInfo(models.VMInfo{
+		ObjectType:       "VMInfo",
+		InstanceProvider: utils.PointerTo(models.SSH),
+		InstanceID:       host,
+		Location:         host,
+		Platform:         "Linux",
+		SecurityGroups:   &[]models.SecurityGroup{},
+		Tags:             &[]models.Tag{},
+	})
+	if err != nil {
+		err = fmt.Errorf("failed to create TargetType from VMInfo: %w", err)
+	}
+
+	return targetType, err
+}
@@ -0,0 +1,171 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ssh
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/runtime_scan/pkg/provider"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestFilterHosts(t *testing.T) {
+	configured := []string{"10.0.0.1", "10.0.0.2:2222", "host.example.com"}
+
+	tests := []struct {
+		Name  string
+		Scope *[]string
+
+		ExpectedHosts []string
+	}{
+		{
+			Name:          "Nil scope",
+			Scope:         nil,
+			ExpectedHosts: configured,
+		},
+		{
+			Name:          "Empty scope",
+			Scope:         &[]string{},
+			ExpectedHosts: configured,
+		},
+		{
+			Name:          "Scope with unknown host",
+			Scope:         utils.PointerTo([]string{"10.0.0.2:2222", "10.0.0.3"}),
+			ExpectedHosts: []string{"10.0.0.2:2222"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			g.Expect(filterHosts(configured, test.Scope)).Should(Equal(test.ExpectedHosts))
+		})
+	}
+}
+
+func TestTargetHost(t *testing.T) {
+	client := &Client{
+		config: &Config{Hosts: []string{"10.0.0.1", "10.0.0.2:2222"}},
+	}
+
+	tests := []struct {
+		Name string
+		Host string
+
+		ExpectedFatal bool
+	}{
+		{
+			Name: "Configured host",
+			Host: "10.0.0.2:2222",
+		},
+		{
+			Name:          "Host which is not configured",
+			Host:          "10.0.0.3",
+			ExpectedFatal: true,
+		},
+		{
+			Name:          "Configured host with another port",
+			Host:          "10.0.0.1:2222",
+			ExpectedFatal: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			assetInfo, err := getVMInfoFromHost(test.Host)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			config := &provider.ScanJobConfig{
+				Asset: models.Asset{AssetInfo: &assetInfo},
+			}
+
+			host, err := client.targetHost(config)
+			if test.ExpectedFatal {
+				var fatalError provider.FatalError
+				g.Expect(errors.As(err, &fatalError)).Should(BeTrue())
+				return
+			}
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(host).Should(Equal(test.Host))
+		})
+	}
+}
+
+func TestCheckReadOnlyFamilies(t *testing.T) {
+	tests := []struct {
+		Name   string
+		Config string
+
+		ExpectedErr bool
+	}{
+		{
+			Name: "Read-only families",
+			Config: `
+sbom:
+  enabled: true
+vulnerabilities:
+  enabled: true
+secrets:
+  enabled: true
+`,
+		},
+		{
+			Name: "Disabled families which are not read-only",
+			Config: `
+sbom:
+  enabled: true
+malware:
+  enabled: false
+rootkits:
+  enabled: false
+`,
+		},
+		{
+			Name: "Enabled family which is not read-only",
+			Config: `
+sbom:
+  enabled: true
+malware:
+  enabled: true
+`,
+			ExpectedErr: true,
+		},
+		{
+			Name:        "Invalid config",
+			Config:      "sbom: [",
+			ExpectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			err := checkReadOnlyFamilies(test.Config)
+			if test.ExpectedErr {
+				g.Expect(err).Should(HaveOccurred())
+			} else {
+				g.Expect(err).ShouldNot(HaveOccurred())
+			}
+		})
+	}
+}
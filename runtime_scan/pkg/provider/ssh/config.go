@@ -0,0 +1,99 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ssh
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	DefaultEnvPrefix     = "VMCLARITY_SSH"
+	DefaultUser          = "root"
+	DefaultRemoteWorkDir = "/var/tmp/vmclarity"
+)
+
+type Config struct {
+	// Hosts is the list of hosts in host[:port] format which can be scanned over SSH
+	Hosts []string `mapstructure:"hosts"`
+	// User is the name of the user used for connecting to the hosts
+	User string `mapstructure:"user"`
+	// PrivateKeyFile is the path of the private key used for connecting to the hosts,
+	// usually mounted from a secret
+	PrivateKeyFile string `mapstructure:"private_key_file"`
+	// KnownHostsFile is the path of the known_hosts file used for verifying the host keys
+	KnownHostsFile string `mapstructure:"known_hosts_file"`
+	// ScannerBinaryPath is the path of the static scanner binary which is copied to the hosts
+	ScannerBinaryPath string `mapstructure:"scanner_binary_path"`
+	// RemoteWorkDir is the directory on the hosts where the scanner binary, its configuration and output are stored
+	RemoteWorkDir string `mapstructure:"remote_work_dir"`
+}
+
+func (c *Config) Validate() error {
+	if len(c.Hosts) == 0 {
+		return fmt.Errorf("parameter Hosts must be provided")
+	}
+
+	if c.User == "" {
+		return fmt.Errorf("parameter User must be provided")
+	}
+
+	if c.PrivateKeyFile == "" {
+		return fmt.Errorf("parameter PrivateKeyFile must be provided")
+	}
+
+	if c.KnownHostsFile == "" {
+		return fmt.Errorf("parameter KnownHostsFile must be provided")
+	}
+
+	if c.ScannerBinaryPath == "" {
+		return fmt.Errorf("parameter ScannerBinaryPath must be provided")
+	}
+
+	if c.RemoteWorkDir == "" {
+		return fmt.Errorf("parameter RemoteWorkDir must be provided")
+	}
+
+	return nil
+}
+
+func NewConfig() (*Config, error) {
+	// Avoid modifying the global instance
+	v := viper.New()
+
+	v.SetEnvPrefix(DefaultEnvPrefix)
+	v.AllowEmptyEnv(true)
+	v.AutomaticEnv()
+
+	_ = v.BindEnv("hosts")
+	_ = v.BindEnv("private_key_file")
+	_ = v.BindEnv("known_hosts_file")
+	_ = v.BindEnv("scanner_binary_path")
+
+	_ = v.BindEnv("user")
+	v.SetDefault("user", DefaultUser)
+
+	_ = v.BindEnv("remote_work_dir")
+	v.SetDefault("remote_work_dir", DefaultRemoteWorkDir)
+
+	config := &Config{}
+	if err := v.Unmarshal(config); err != nil {
+		return nil, fmt.Errorf("failed to parse provider configuration. Provider=SSH: %w", err)
+	}
+
+	return config, nil
+}
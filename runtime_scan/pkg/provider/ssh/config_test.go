@@ -0,0 +1,93 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ssh
+
+import (
+	"os"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+)
+
+func TestConfig(t *testing.T) {
+	tests := []struct {
+		Name    string
+		EnvVars map[string]string
+
+		ExpectedNewErrorMatcher      types.GomegaMatcher
+		ExpectedConfig               *Config
+		ExpectedValidateErrorMatcher types.GomegaMatcher
+	}{
+		{
+			Name: "Valid config",
+			EnvVars: map[string]string{
+				"VMCLARITY_SSH_HOSTS":               "10.0.0.1,10.0.0.2:2222",
+				"VMCLARITY_SSH_USER":                "scanner",
+				"VMCLARITY_SSH_PRIVATE_KEY_FILE":    "/etc/vmclarity/ssh/id_ed25519",
+				"VMCLARITY_SSH_KNOWN_HOSTS_FILE":    "/etc/vmclarity/ssh/known_hosts",
+				"VMCLARITY_SSH_SCANNER_BINARY_PATH": "/usr/local/bin/vmclarity-cli",
+			},
+			ExpectedNewErrorMatcher: Not(HaveOccurred()),
+			ExpectedConfig: &Config{
+				Hosts:             []string{"10.0.0.1", "10.0.0.2:2222"},
+				User:              "scanner",
+				PrivateKeyFile:    "/etc/vmclarity/ssh/id_ed25519",
+				KnownHostsFile:    "/etc/vmclarity/ssh/known_hosts",
+				ScannerBinaryPath: "/usr/local/bin/vmclarity-cli",
+				RemoteWorkDir:     DefaultRemoteWorkDir,
+			},
+			ExpectedValidateErrorMatcher: Not(HaveOccurred()),
+		},
+		{
+			Name: "Missing hosts",
+			EnvVars: map[string]string{
+				"VMCLARITY_SSH_PRIVATE_KEY_FILE":    "/etc/vmclarity/ssh/id_ed25519",
+				"VMCLARITY_SSH_KNOWN_HOSTS_FILE":    "/etc/vmclarity/ssh/known_hosts",
+				"VMCLARITY_SSH_SCANNER_BINARY_PATH": "/usr/local/bin/vmclarity-cli",
+			},
+			ExpectedNewErrorMatcher: Not(HaveOccurred()),
+			ExpectedConfig: &Config{
+				User:              DefaultUser,
+				PrivateKeyFile:    "/etc/vmclarity/ssh/id_ed25519",
+				KnownHostsFile:    "/etc/vmclarity/ssh/known_hosts",
+				ScannerBinaryPath: "/usr/local/bin/vmclarity-cli",
+				RemoteWorkDir:     DefaultRemoteWorkDir,
+			},
+			ExpectedValidateErrorMatcher: HaveOccurred(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			os.Clearenv()
+			for k, v := range test.EnvVars {
+				err := os.Setenv(k, v)
+				g.Expect(err).Should(Not(HaveOccurred()))
+			}
+
+			config, err := NewConfig()
+
+			g.Expect(err).Should(test.ExpectedNewErrorMatcher)
+			g.Expect(config).Should(BeEquivalentTo(test.ExpectedConfig))
+
+			err = config.Validate()
+			g.Expect(err).Should(test.ExpectedValidateErrorMatcher)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ssh
+
+import (
+	"fmt"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// readOnlyFamilies are the families which only read the filesystem of the host they scan. The others run tools
+// which modify the host, e.g. the malware family updates the ClamAV databases and the rootkits family executes
+// binaries of the host, so they can't be run over SSH. The families which do write, e.g. temporary files or
+// caches, are run with their temporary and cache directories inside the work directory of the scan.
+var readOnlyFamilies = []string{
+	"sbom",
+	"vulnerabilities",
+	"secrets",
+	"certificates",
+	"compliance",
+	"exploits",
+}
+
+// checkReadOnlyFamilies returns an error if a family which isn't read-only is enabled in the scanner config.
+func checkReadOnlyFamilies(scannerCLIConfig string) error {
+	var families map[string]struct {
+		Enabled bool `yaml:"enabled"`
+	}
+	if err := yaml.Unmarshal([]byte(scannerCLIConfig), &families); err != nil {
+		return fmt.Errorf("failed to parse scanner config: %w", err)
+	}
+
+	var notReadOnly []string
+	for family, config := range families {
+		if config.Enabled && !utils.Contains(readOnlyFamilies, family) {
+			notReadOnly = append(notReadOnly, family)
+		}
+	}
+	if len(notReadOnly) > 0 {
+		sort.Strings(notReadOnly)
+		return fmt.Errorf("families %v can't be run over SSH as they are not read-only", notReadOnly)
+	}
+
+	return nil
+}
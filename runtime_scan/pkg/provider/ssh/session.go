@@ -0,0 +1,81 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ssh
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// runCommand runs cmd on the remote host with stdin as its standard input and returns its standard output.
+func runCommand(client *ssh.Client, cmd string, stdin io.Reader) ([]byte, error) {
+	session, err := client.NewSession()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ssh session: %w", err)
+	}
+	defer session.Close()
+
+	var stdout, stderr bytes.Buffer
+	session.Stdin = stdin
+	session.Stdout = &stdout
+	session.Stderr = &stderr
+
+	if err = session.Run(cmd); err != nil {
+		return nil, fmt.Errorf("failed to run command: %w: %s", err, stderr.String())
+	}
+
+	return stdout.Bytes(), nil
+}
+
+// copyFile streams src to the dst file on the remote host with the given mode.
+func copyFile(client *ssh.Client, src io.Reader, dst, mode string) error {
+	tmp := dst + ".tmp"
+	cmd := fmt.Sprintf("cat > %s && chmod %s %s && mv -f %s %s", quote(tmp), mode, quote(tmp), quote(tmp), quote(dst))
+	if _, err := runCommand(client, cmd, src); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// fileExists returns whether the file exists on the remote host.
+func fileExists(client *ssh.Client, file string) (bool, error) {
+	_, err := runCommand(client, fmt.Sprintf("test -f %s", quote(file)), nil)
+	if err == nil {
+		return true, nil
+	}
+
+	var exitErr *ssh.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitStatus() == 1 {
+		return false, nil
+	}
+
+	return false, err
+}
+
+func stringReader(s string) io.Reader {
+	return strings.NewReader(s)
+}
+
+// quote returns s quoted for the POSIX shell.
+func quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
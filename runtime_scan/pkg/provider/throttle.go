@@ -0,0 +1,298 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/scanexport"
+)
+
+// ThrottledRetryAfter is the delay before an operation waiting for a token
+// of a TokenBucket is retried.
+const ThrottledRetryAfter = time.Minute
+
+type throttleScope struct {
+	account string
+	region  string
+}
+
+// TokenBucket limits the number of the long-running operations of a kind,
+// e.g. snapshot copies, in progress at the same time in an account and
+// region, or in total if the account and region are empty, as the providers
+// have quotas on them which otherwise fail the operations late in big scans.
+// The operations of the ScanResults are retried until they finish, so a
+// ScanResult takes a token when its operation starts and returns it once the
+// operation finished, the same ScanResult taking the token again is a no-op.
+type TokenBucket struct {
+	mu sync.Mutex
+	// operation names the operations in the errors, e.g. "snapshot copies".
+	operation string
+	// capacity is the number of tokens of each account and region,
+	// unlimited if it is 0.
+	capacity int
+	taken    map[throttleScope]map[string]struct{}
+	// returned holds the ScanResults whose operation finished, which don't
+	// need a token again until they are forgotten.
+	returned map[string]struct{}
+}
+
+func NewTokenBucket(operation string, capacity int) *TokenBucket {
+	return &TokenBucket{
+		operation: operation,
+		capacity:  capacity,
+		taken:     map[throttleScope]map[string]struct{}{},
+		returned:  map[string]struct{}{},
+	}
+}
+
+// Take takes a token of the account and region for the operation of the
+// ScanResult. It returns a RetryableError if all the tokens are taken.
+func (b *TokenBucket) Take(account, region, scanResultID string) error {
+	if b == nil || b.capacity <= 0 {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.returned[scanResultID]; ok {
+		return nil
+	}
+
+	scope := throttleScope{account: account, region: region}
+	tokens, ok := b.taken[scope]
+	if !ok {
+		tokens = map[string]struct{}{}
+		b.taken[scope] = tokens
+	}
+	if _, ok := tokens[scanResultID]; ok {
+		return nil
+	}
+	if len(tokens) >= b.capacity {
+		if scope == (throttleScope{}) {
+			return InProgressErrorf(ThrottledRetryAfter, "%d %s are already in progress, waiting for one of them to finish",
+				b.capacity, b.operation)
+		}
+		return InProgressErrorf(ThrottledRetryAfter, "%d %s are already in progress in region %s of account %q, waiting for one of them to finish",
+			b.capacity, b.operation, region, account)
+	}
+	tokens[scanResultID] = struct{}{}
+
+	return nil
+}
+
+// Return returns the token of the ScanResult once its operation finished.
+func (b *TokenBucket) Return(scanResultID string) {
+	if b == nil || b.capacity <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.release(scanResultID)
+	b.returned[scanResultID] = struct{}{}
+}
+
+// Forget drops the ScanResult once its resources are removed, returning its
+// token if it still has one.
+func (b *TokenBucket) Forget(scanResultID string) {
+	if b == nil || b.capacity <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.release(scanResultID)
+	delete(b.returned, scanResultID)
+}
+
+func (b *TokenBucket) release(scanResultID string) {
+	for scope, tokens := range b.taken {
+		delete(tokens, scanResultID)
+		if len(tokens) == 0 {
+			delete(b.taken, scope)
+		}
+	}
+}
+
+// ThrottlingProvider decorates a Provider by limiting the number of the
+// Targets whose Scanner is being provisioned at the same time, as creating
+// and copying their volume snapshots is limited by the quotas of the cloud.
+// A Target takes a token of the account and region of its VM, and one of the
+// total, before its Scanner is run or its snapshot is pre-warmed, and returns
+// them once the operation is done. The Targets waiting for a token are in
+// progress, so waiting doesn't use up the attempts of a RetryingProvider.
+type ThrottlingProvider struct {
+	Provider
+
+	regional *TokenBucket
+	total    *TokenBucket
+}
+
+// WithThrottle limits the snapshot operations of p to perRegion in each
+// account and region and to total across them, 0 is unlimited.
+func WithThrottle(p Provider, perRegion, total int) *ThrottlingProvider {
+	return &ThrottlingProvider{
+		Provider: p,
+		regional: NewTokenBucket("snapshot operations", perRegion),
+		total:    NewTokenBucket("snapshot operations", total),
+	}
+}
+
+func (t *ThrottlingProvider) RunTargetScan(ctx context.Context, config *ScanJobConfig) error {
+	if err := t.take(config); err != nil {
+		return err
+	}
+
+	err := t.Provider.RunTargetScan(ctx, config)
+	t.handleError(config, err)
+
+	// nolint:wrapcheck
+	return err
+}
+
+func (t *ThrottlingProvider) RemoveTargetScan(ctx context.Context, config *ScanJobConfig) error {
+	err := t.Provider.RemoveTargetScan(ctx, config)
+	if err == nil {
+		t.regional.Forget(config.ScanResultID)
+		t.total.Forget(config.ScanResultID)
+	}
+
+	// nolint:wrapcheck
+	return err
+}
+
+// PrewarmTargetScan delegates to the decorated Provider if it is a
+// SnapshotPrewarmer once the Target has the tokens of its snapshot.
+func (t *ThrottlingProvider) PrewarmTargetScan(ctx context.Context, config *ScanJobConfig) error {
+	prewarmer, ok := t.Provider.(SnapshotPrewarmer)
+	if !ok {
+		return nil
+	}
+
+	if err := t.take(config); err != nil {
+		return err
+	}
+
+	err := prewarmer.PrewarmTargetScan(ctx, config)
+	t.handleError(config, err)
+
+	// nolint:wrapcheck
+	return err
+}
+
+// GetDeltaScanInfo delegates to the decorated Provider if it is a DeltaScanner,
+// otherwise there is no delta to report.
+func (t *ThrottlingProvider) GetDeltaScanInfo(ctx context.Context, config *ScanJobConfig) (*models.DeltaScanInfo, error) {
+	deltaScanner, ok := t.Provider.(DeltaScanner)
+	if !ok {
+		return nil, nil
+	}
+	// nolint:wrapcheck
+	return deltaScanner.GetDeltaScanInfo(ctx, config)
+}
+
+// ResolveScannerImage delegates to the decorated Provider if it is a
+// ScannerImageResolver, otherwise the configured image is used.
+func (t *ThrottlingProvider) ResolveScannerImage(ctx context.Context) (*models.ScannerInstanceImage, error) {
+	resolver, ok := t.Provider.(ScannerImageResolver)
+	if !ok {
+		return nil, nil
+	}
+	// nolint:wrapcheck
+	return resolver.ResolveScannerImage(ctx)
+}
+
+// CollectTargetScanResults delegates to the decorated Provider if it is a
+// ResultCollector, otherwise the Scanners export the results themselves.
+func (t *ThrottlingProvider) CollectTargetScanResults(ctx context.Context, config *ScanJobConfig) (*scanexport.Results, error) {
+	collector, ok := t.Provider.(ResultCollector)
+	if !ok {
+		return nil, nil
+	}
+	// nolint:wrapcheck
+	return collector.CollectTargetScanResults(ctx, config)
+}
+
+// Unwrap returns the decorated Provider.
+func (t *ThrottlingProvider) Unwrap() Provider {
+	return t.Provider
+}
+
+// Capabilities returns the capabilities of the decorated Provider.
+func (t *ThrottlingProvider) Capabilities() models.ProviderCapabilities {
+	return CapabilitiesOf(t.Provider)
+}
+
+// take takes the tokens of the Target of the ScanResult, only the VM Targets
+// scanned from the snapshots of their volumes need them.
+func (t *ThrottlingProvider) take(config *ScanJobConfig) error {
+	if config.InputImage != "" || config.AssetInfo == nil {
+		return nil
+	}
+	if discriminator, err := config.AssetInfo.Discriminator(); err != nil || discriminator != "VMInfo" {
+		return nil
+	}
+	vmInfo, err := config.AssetInfo.AsVMInfo()
+	if err != nil {
+		return FatalError{Err: err}
+	}
+
+	var account string
+	if vmInfo.AccountID != nil {
+		account = *vmInfo.AccountID
+	}
+	// The location of the VMs discovered before their location metadata was
+	// recorded starts with the region, e.g. us-east-1/vpc-1.
+	region, _, _ := strings.Cut(vmInfo.Location, "/")
+	if vmInfo.LocationMetadata != nil && vmInfo.LocationMetadata.Region != nil {
+		region = *vmInfo.LocationMetadata.Region
+	}
+
+	if err := t.regional.Take(account, region, config.ScanResultID); err != nil {
+		return err
+	}
+	if err := t.total.Take("", "", config.ScanResultID); err != nil {
+		// The Target doesn't hold back the other Targets of its region
+		// while it waits for a token of the total.
+		t.regional.Forget(config.ScanResultID)
+		return err
+	}
+
+	return nil
+}
+
+// handleError returns the tokens of the ScanResult once the operation is
+// done, or failed for good, and keeps them while it is retried.
+func (t *ThrottlingProvider) handleError(config *ScanJobConfig, err error) {
+	var retryableError RetryableError
+	switch {
+	case err == nil:
+		t.regional.Return(config.ScanResultID)
+		t.total.Return(config.ScanResultID)
+	case !errors.As(err, &retryableError):
+		t.regional.Forget(config.ScanResultID)
+		t.total.Forget(config.ScanResultID)
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/openclarity/vmclarity/api/models"
+)
+
+func TestTokenBucket(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	bucket := NewTokenBucket("snapshot copies", 2)
+
+	g.Expect(bucket.Take("111", "us-east-1", "sr-1")).Should(Succeed())
+	g.Expect(bucket.Take("111", "us-east-1", "sr-2")).Should(Succeed())
+
+	// The account and region has no tokens left, the other ones do.
+	err := bucket.Take("111", "us-east-1", "sr-3")
+	var retryableError RetryableError
+	g.Expect(errors.As(err, &retryableError)).Should(BeTrue())
+	g.Expect(retryableError.RetryAfter()).Should(Equal(ThrottledRetryAfter))
+	g.Expect(bucket.Take("111", "eu-west-1", "sr-3")).Should(Succeed())
+	g.Expect(bucket.Take("222", "us-east-1", "sr-4")).Should(Succeed())
+
+	// Taking the token again is a no-op.
+	g.Expect(bucket.Take("111", "us-east-1", "sr-1")).Should(Succeed())
+
+	// The returned token is taken by another ScanResult, the ScanResult
+	// which returned it doesn't need one again.
+	bucket.Return("sr-1")
+	g.Expect(bucket.Take("111", "us-east-1", "sr-5")).Should(Succeed())
+	g.Expect(bucket.Take("111", "us-east-1", "sr-1")).Should(Succeed())
+	g.Expect(bucket.Take("111", "us-east-1", "sr-6")).ShouldNot(Succeed())
+
+	// A forgotten ScanResult returns its token and needs a new one.
+	bucket.Forget("sr-2")
+	bucket.Forget("sr-1")
+	g.Expect(bucket.Take("111", "us-east-1", "sr-6")).Should(Succeed())
+	g.Expect(bucket.Take("111", "us-east-1", "sr-1")).ShouldNot(Succeed())
+}
+
+func TestTokenBucketUnlimited(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	bucket := NewTokenBucket("snapshot creations", 0)
+	for _, id := range []string{"sr-1", "sr-2", "sr-3"} {
+		g.Expect(bucket.Take("111", "us-east-1", id)).Should(Succeed())
+	}
+
+	var nilBucket *TokenBucket
+	g.Expect(nilBucket.Take("111", "us-east-1", "sr-1")).Should(Succeed())
+}
+
+func newThrottledConfig(t *testing.T, scanResultID, location string) *ScanJobConfig {
+	t.Helper()
+
+	assetInfo := &models.AssetType{}
+	if err := assetInfo.FromVMInfo(models.VMInfo{ObjectType: "VMInfo", Location: location}); err != nil {
+		t.Fatalf("failed to create asset info: %v", err)
+	}
+
+	return &ScanJobConfig{
+		ScanMetadata: ScanMetadata{ScanResultID: scanResultID},
+		Asset:        models.Asset{AssetInfo: assetInfo},
+	}
+}
+
+func TestThrottlingProvider(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	snapshotNotReady := InProgressErrorf(time.Minute, "snapshot is not ready")
+	scanner := &fakeScanner{err: snapshotNotReady}
+	p := WithThrottle(scanner, 1, 2)
+
+	sr1 := newThrottledConfig(t, "sr-1", "us-east-1/vpc-1")
+	sr2 := newThrottledConfig(t, "sr-2", "us-east-1/vpc-2")
+	sr3 := newThrottledConfig(t, "sr-3", "eu-west-1/vpc-1")
+	sr4 := newThrottledConfig(t, "sr-4", "eu-central-1/vpc-1")
+
+	// The snapshots in progress hold the tokens of their region and of the
+	// total.
+	g.Expect(p.RunTargetScan(ctx, sr1)).Should(Equal(snapshotNotReady))
+	err := p.RunTargetScan(ctx, sr2)
+	g.Expect(err).ShouldNot(Equal(snapshotNotReady))
+	g.Expect(err).Should(MatchError(ContainSubstring("in region us-east-1")))
+	g.Expect(p.RunTargetScan(ctx, sr3)).Should(Equal(snapshotNotReady))
+	err = p.RunTargetScan(ctx, sr4)
+	g.Expect(err).Should(MatchError(ContainSubstring("2 snapshot operations are already in progress")))
+	var retryableError RetryableError
+	g.Expect(errors.As(err, &retryableError)).Should(BeTrue())
+	g.Expect(retryableError.InProgress).Should(BeTrue())
+
+	// The failed attempts keep the tokens while they are retried.
+	scanner.err = RetryableErrorf(time.Minute, "request was throttled")
+	g.Expect(p.RunTargetScan(ctx, sr1)).Should(Equal(scanner.err))
+	g.Expect(p.RunTargetScan(ctx, sr4)).ShouldNot(Equal(scanner.err))
+
+	// The tokens are returned once the operation is done, and the same
+	// ScanResult doesn't need them again.
+	scanner.err = nil
+	g.Expect(p.RunTargetScan(ctx, sr1)).Should(Succeed())
+	g.Expect(p.RunTargetScan(ctx, sr4)).Should(Succeed())
+	scanner.err = snapshotNotReady
+	g.Expect(p.RunTargetScan(ctx, sr1)).Should(Equal(snapshotNotReady))
+	g.Expect(p.RunTargetScan(ctx, sr2)).Should(Equal(snapshotNotReady))
+	sr6 := newThrottledConfig(t, "sr-6", "ap-south-1/vpc-1")
+	g.Expect(p.RunTargetScan(ctx, sr6)).ShouldNot(Equal(snapshotNotReady))
+
+	// The operations which failed for good return their tokens.
+	scanner.err = FatalError{Err: errors.New("target VM instance not found")}
+	g.Expect(p.RunTargetScan(ctx, sr3)).Should(Equal(scanner.err))
+	scanner.err = snapshotNotReady
+	g.Expect(p.RunTargetScan(ctx, sr6)).Should(Equal(snapshotNotReady))
+
+	// The Targets which are not scanned from snapshots are not throttled.
+	image := &ScanJobConfig{
+		ScanMetadata: ScanMetadata{ScanResultID: "sr-5"},
+		InputImage:   "docker.io/library/alpine:3.18",
+	}
+	g.Expect(p.RunTargetScan(ctx, image)).Should(Equal(snapshotNotReady))
+
+	// Removing the scan resources forgets the ScanResult.
+	scanner.err = nil
+	g.Expect(p.RemoveTargetScan(ctx, sr2)).Should(Succeed())
+	scanner.err = snapshotNotReady
+	g.Expect(p.RunTargetScan(ctx, sr3)).Should(Equal(snapshotNotReady))
+}
+
+func TestThrottlingProviderWaitingForTotal(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	snapshotNotReady := InProgressErrorf(time.Minute, "snapshot is not ready")
+	scanner := &fakeScanner{err: snapshotNotReady}
+	p := WithThrottle(scanner, 1, 1)
+
+	sr1 := newThrottledConfig(t, "sr-1", "us-east-1/vpc-1")
+	g.Expect(p.RunTargetScan(ctx, sr1)).Should(Equal(snapshotNotReady))
+
+	// The Target waiting for a token of the total doesn't keep the token of
+	// its region.
+	g.Expect(p.RunTargetScan(ctx, newThrottledConfig(t, "sr-2", "eu-west-1/vpc-1"))).ShouldNot(Equal(snapshotNotReady))
+
+	scanner.err = nil
+	g.Expect(p.RunTargetScan(ctx, sr1)).Should(Succeed())
+	scanner.err = snapshotNotReady
+	g.Expect(p.RunTargetScan(ctx, newThrottledConfig(t, "sr-3", "eu-west-1/vpc-1"))).Should(Equal(snapshotNotReady))
+}
+
+func TestThrottlingProviderRetries(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	scanner := &fakeScanner{err: InProgressErrorf(time.Minute, "snapshot is not ready")}
+	p := WithRetry(WithThrottle(scanner, 1, 0), map[OperationClass]RetryPolicy{
+		OperationRunTargetScan: {
+			InitialInterval: time.Second,
+			MaxInterval:     time.Second,
+			Multiplier:      1,
+			MaxAttempts:     1,
+		},
+	})
+
+	g.Expect(p.RunTargetScan(ctx, newThrottledConfig(t, "sr-1", "westeurope"))).ShouldNot(Succeed())
+
+	// Waiting for a token doesn't use up the attempts.
+	throttled := newThrottledConfig(t, "sr-2", "westeurope")
+	for i := 0; i < 3; i++ {
+		var retryableError RetryableError
+		g.Expect(errors.As(p.RunTargetScan(ctx, throttled), &retryableError)).Should(BeTrue())
+		g.Expect(retryableError.RetryAfter()).Should(Equal(ThrottledRetryAfter))
+	}
+	g.Expect(p.Stats(OperationRunTargetScan)).Should(Equal(RetryStats{Waits: 4}))
+
+	// The decorators are transparent to the optional interfaces.
+	_, ok := SnapshotPrewarmerOf(p)
+	g.Expect(ok).Should(BeFalse())
+}
+
+func TestThrottlingProviderPrewarm(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	snapshotNotReady := InProgressErrorf(time.Minute, "snapshot is not ready")
+	prewarmer := &fakeSnapshotPrewarmer{Provider: &fakeScanner{err: snapshotNotReady}}
+	p := WithThrottle(prewarmer, 1, 0)
+
+	// The pre-warmed snapshot returns its token, the Scanner of the Target
+	// doesn't need it again.
+	sr1 := newThrottledConfig(t, "sr-1", "us-east-1/vpc-1")
+	g.Expect(p.PrewarmTargetScan(ctx, sr1)).Should(Succeed())
+	g.Expect(p.PrewarmTargetScan(ctx, newThrottledConfig(t, "sr-2", "us-east-1/vpc-1"))).Should(Succeed())
+	g.Expect(p.RunTargetScan(ctx, sr1)).Should(Equal(snapshotNotReady))
+
+	g.Expect(p.RunTargetScan(ctx, newThrottledConfig(t, "sr-3", "us-east-1/vpc-1"))).Should(Equal(snapshotNotReady))
+	g.Expect(p.PrewarmTargetScan(ctx, newThrottledConfig(t, "sr-4", "us-east-1/vpc-1"))).ShouldNot(Succeed())
+	g.Expect(prewarmer.prewarmed).Should(Equal([]string{"sr-1", "sr-2"}))
+}
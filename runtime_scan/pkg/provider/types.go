@@ -19,6 +19,7 @@ import (
 	"context"
 
 	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/scanexport"
 )
 
 type Provider interface {
@@ -33,7 +34,7 @@ type Discoverer interface {
 	// DiscoverScopes returns a list of discovered models.Scopes
 	DiscoverScopes(ctx context.Context) (*models.Scopes, error)
 	// DiscoverTargets returns list of TargetType in ScanScopeType
-	DiscoverTargets(ctx context.Context, scanScope *models.ScanScopeType) ([]models.TargetType, error)
+	DiscoverTargets(ctx context.Context, scanScope *models.ScanScopeType) ([]models.AssetType, error)
 }
 
 type Scanner interface {
@@ -51,6 +52,106 @@ type Scanner interface {
 	RemoveTargetScan(context.Context, *ScanJobConfig) error
 }
 
+// DeltaScanner is implemented by the providers which can report the changes of
+// the scanned volume since the previous scan of the same target.
+type DeltaScanner interface {
+	// GetDeltaScanInfo returns the blocks of the scanned volume changed since
+	// the previous scan of the target. It returns nil if there is no previous
+	// scan to compare to, in which case the volume must be scanned in full.
+	// It must be called after RunTargetScan returned nil.
+	GetDeltaScanInfo(context.Context, *ScanJobConfig) (*models.DeltaScanInfo, error)
+}
+
+// SnapshotPrewarmer is implemented by the providers which can create the
+// target volume snapshot of a scan before the Scanner instance is created.
+type SnapshotPrewarmer interface {
+	// PrewarmTargetScan is a non-blocking call which takes a ScanJobConfig and creates the volume snapshot used by
+	// RunTargetScan for the same ScanJobConfig, without creating any other resources.
+	// It may return FatalError or RetryableError to indicate if the error is permanent or transient.
+	// It is expected to return RetryableError in case the snapshot is still being created.
+	// It must return nil if the snapshot is ready.
+	// It also must be idempotent. The snapshot is removed by RemoveTargetScan.
+	PrewarmTargetScan(context.Context, *ScanJobConfig) error
+}
+
+// SnapshotPrewarmerOf returns p as a SnapshotPrewarmer if it creates the target
+// volume snapshots ahead of the Scanner instances. A decorator of another
+// Provider, which reports it with an Unwrap method, only does if the Provider
+// it decorates does.
+func SnapshotPrewarmerOf(p Provider) (SnapshotPrewarmer, bool) {
+	return implementedBy[SnapshotPrewarmer](p)
+}
+
+// ResultCollector is implemented by the providers whose Scanners don't export
+// the results of the scans to the backend, e.g. as they can't reach it. The
+// orchestrator collects the results from the provider and uploads them.
+type ResultCollector interface {
+	// CollectTargetScanResults is a non-blocking call which takes a ScanJobConfig and returns the results of the
+	// Scan started by RunTargetScan for the same ScanJobConfig.
+	// It may return FatalError or RetryableError to indicate if the error is permanent or transient.
+	// It is expected to return RetryableError in case the Scanner is still running.
+	// It also must be idempotent.
+	CollectTargetScanResults(context.Context, *ScanJobConfig) (*scanexport.Results, error)
+}
+
+// ResultCollectorOf returns p as a ResultCollector if its Scanners don't
+// export the results of the scans themselves. A decorator of another Provider
+// only does if the Provider it decorates does.
+func ResultCollectorOf(p Provider) (ResultCollector, bool) {
+	return implementedBy[ResultCollector](p)
+}
+
+// implementedBy returns p as T if p implements T. A decorator of another
+// Provider, which reports it with an Unwrap method, implements T only if the
+// Provider it decorates does, as the decorators implement every optional
+// interface and delegate to the decorated Provider.
+func implementedBy[T any](p Provider) (T, bool) {
+	var zero T
+	t, ok := p.(T)
+	if !ok {
+		return zero, false
+	}
+	for {
+		decorator, ok := p.(interface{ Unwrap() Provider })
+		if !ok {
+			return t, true
+		}
+		p = decorator.Unwrap()
+		if _, ok := p.(T); !ok {
+			return zero, false
+		}
+	}
+}
+
+// CapabilitiesReporter is implemented by the providers which can report the
+// scan options they honor.
+type CapabilitiesReporter interface {
+	// Capabilities returns the models.ProviderCapabilities of the provider
+	// with its current configuration.
+	Capabilities() models.ProviderCapabilities
+}
+
+// CapabilitiesOf returns the models.ProviderCapabilities reported by p. A
+// provider which is not a CapabilitiesReporter is assumed to support none of
+// the optional scan options.
+func CapabilitiesOf(p Provider) models.ProviderCapabilities {
+	reporter, ok := p.(CapabilitiesReporter)
+	if !ok {
+		return models.ProviderCapabilities{}
+	}
+	return reporter.Capabilities()
+}
+
+// ScannerImageResolver is implemented by the providers which can resolve the
+// image of the scanner instances from a release channel instead of using a
+// pinned image.
+type ScannerImageResolver interface {
+	// ResolveScannerImage returns the latest image published on the configured
+	// release channel. It returns nil if the provider is configured with a
+	// pinned image.
+	ResolveScannerImage(context.Context) (*models.ScannerInstanceImage, error)
+}
+
 type ScanMetadata struct {
 	ScanID       string
 	ScanResultID string
@@ -61,8 +162,31 @@ type ScanJobConfig struct {
 	ScannerImage     string // Scanner Container Image to use containing the vmclarity-cli and tools
 	ScannerCLIConfig string // Scanner CLI config yaml (families config yaml)
 	VMClarityAddress string // The backend address for the scanner CLI to export too
+	// ScannerConfigURL is the backend URL the scanner CLI fetches
+	// ScannerCLIConfig from, so that it doesn't need to be embedded in the
+	// size limited user data of the Scanner instance.
+	ScannerConfigURL string
+	// ScannerConfigToken is the short-lived token of the ScanResult the
+	// scanner CLI fetches ScannerCLIConfig from ScannerConfigURL with.
+	ScannerConfigToken string
+	DeltaScan          bool // Keep the target volume snapshot as the baseline of the next delta scan
+	// InputImage is the reference of the container image the scanner CLI
+	// pulls and scans instead of a volume attached to the Scanner instance.
+	InputImage string
+
+	// ScannerInstanceImage is the provider specific reference of the image to
+	// create the Scanner instance from, the configured image is used if empty.
+	ScannerInstanceImage string
+
+	// MaxParallelScanners is the maximum number of Scanners the scan runs in
+	// parallel, the providers cap the resources created per scan with it.
+	MaxParallelScanners int
+
+	// ScannerPlacement constrains the network and the zone of the Scanner
+	// instance, the provider configuration is used as is if nil.
+	ScannerPlacement *models.ScannerPlacement
 
 	ScanMetadata
 	models.ScannerInstanceCreationConfig
-	models.Target
+	models.Asset
 }
@@ -0,0 +1,199 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/scanexport"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+type fakeCapabilitiesReporter struct {
+	Provider
+
+	capabilities models.ProviderCapabilities
+}
+
+func (f *fakeCapabilitiesReporter) Capabilities() models.ProviderCapabilities {
+	return f.capabilities
+}
+
+type fakeSnapshotPrewarmer struct {
+	Provider
+
+	prewarmed []string
+}
+
+func (f *fakeSnapshotPrewarmer) PrewarmTargetScan(_ context.Context, config *ScanJobConfig) error {
+	f.prewarmed = append(f.prewarmed, config.ScanResultID)
+	return nil
+}
+
+type fakeResultCollector struct {
+	Provider
+
+	results *scanexport.Results
+}
+
+func (f *fakeResultCollector) CollectTargetScanResults(context.Context, *ScanJobConfig) (*scanexport.Results, error) {
+	return f.results, nil
+}
+
+func TestCapabilitiesOf(t *testing.T) {
+	capabilities := models.ProviderCapabilities{
+		ScanStoppedInstances: true,
+		CrossRegion:          true,
+		EncryptedVolumes:     false,
+		SpotInstances:        true,
+		ScannerPlacement:     true,
+	}
+
+	tests := []struct {
+		Name     string
+		Provider Provider
+
+		ExpectedCapabilities models.ProviderCapabilities
+	}{
+		{
+			Name:                 "Provider reporting capabilities",
+			Provider:             &fakeCapabilitiesReporter{capabilities: capabilities},
+			ExpectedCapabilities: capabilities,
+		},
+		{
+			Name:                 "Provider not reporting capabilities",
+			Provider:             &fakeScanner{},
+			ExpectedCapabilities: models.ProviderCapabilities{},
+		},
+		{
+			Name:                 "Decorated provider reporting capabilities",
+			Provider:             WithRetry(&fakeCapabilitiesReporter{capabilities: capabilities}, DefaultRetryPolicies),
+			ExpectedCapabilities: capabilities,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			g.Expect(CapabilitiesOf(test.Provider)).Should(Equal(test.ExpectedCapabilities))
+		})
+	}
+}
+
+func TestSnapshotPrewarmerOf(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Provider Provider
+
+		ExpectedPrewarmer bool
+	}{
+		{
+			Name:              "Provider creating snapshots ahead",
+			Provider:          &fakeSnapshotPrewarmer{},
+			ExpectedPrewarmer: true,
+		},
+		{
+			Name:              "Provider not creating snapshots ahead",
+			Provider:          &fakeScanner{},
+			ExpectedPrewarmer: false,
+		},
+		{
+			Name:              "Decorated provider creating snapshots ahead",
+			Provider:          WithRetry(&fakeSnapshotPrewarmer{}, DefaultRetryPolicies),
+			ExpectedPrewarmer: true,
+		},
+		{
+			Name:              "Decorated provider not creating snapshots ahead",
+			Provider:          WithRetry(&fakeScanner{}, DefaultRetryPolicies),
+			ExpectedPrewarmer: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			_, ok := SnapshotPrewarmerOf(test.Provider)
+			g.Expect(ok).Should(Equal(test.ExpectedPrewarmer))
+		})
+	}
+}
+
+func TestSnapshotPrewarmerOfDelegates(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	inner := &fakeSnapshotPrewarmer{}
+	prewarmer, ok := SnapshotPrewarmerOf(WithRetry(inner, DefaultRetryPolicies))
+	g.Expect(ok).Should(BeTrue())
+
+	config := &ScanJobConfig{ScanMetadata: ScanMetadata{ScanResultID: "1234"}}
+	g.Expect(prewarmer.PrewarmTargetScan(context.Background(), config)).Should(Succeed())
+	g.Expect(inner.prewarmed).Should(Equal([]string{"1234"}))
+}
+
+func TestResultCollectorOf(t *testing.T) {
+	results := &scanexport.Results{
+		General: models.AssetScanState{State: utils.PointerTo(models.AssetScanStateStateDone)},
+	}
+
+	tests := []struct {
+		Name     string
+		Provider Provider
+
+		ExpectedCollector bool
+	}{
+		{
+			Name:              "Provider collecting results",
+			Provider:          &fakeResultCollector{results: results},
+			ExpectedCollector: true,
+		},
+		{
+			Name:              "Provider not collecting results",
+			Provider:          &fakeScanner{},
+			ExpectedCollector: false,
+		},
+		{
+			Name:              "Decorated provider collecting results",
+			Provider:          WithThrottle(WithRetry(&fakeResultCollector{results: results}, DefaultRetryPolicies), 1, 1),
+			ExpectedCollector: true,
+		},
+		{
+			Name:              "Decorated provider not collecting results",
+			Provider:          WithThrottle(WithRetry(&fakeScanner{}, DefaultRetryPolicies), 1, 1),
+			ExpectedCollector: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			collector, ok := ResultCollectorOf(test.Provider)
+			g.Expect(ok).Should(Equal(test.ExpectedCollector))
+			if !ok {
+				return
+			}
+
+			config := &ScanJobConfig{ScanMetadata: ScanMetadata{ScanResultID: "1234"}}
+			g.Expect(collector.CollectTargetScanResults(context.Background(), config)).Should(Equal(results))
+		})
+	}
+}
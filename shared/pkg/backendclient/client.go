@@ -17,7 +17,9 @@
 package backendclient
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -26,8 +28,15 @@ import (
 	"github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
+// ScannerTokenHeader is the header of the token the scanner of a ScanResult
+// fetches its configuration with, see PutScannerConfig.
+const ScannerTokenHeader = "X-VMClarity-Scanner-Token"
+
 type BackendClient struct {
 	apiClient client.ClientWithResponsesInterface
+	// etags caches the polled objects, GetScan and GetScanResult send the
+	// ETag of the cached object and reuse it if it didn't change.
+	etags *etagCache
 }
 
 func Create(serverAddress string) (*BackendClient, error) {
@@ -38,15 +47,20 @@ func Create(serverAddress string) (*BackendClient, error) {
 
 	return &BackendClient{
 		apiClient: apiClient,
+		etags:     newETagCache(),
 	}, nil
 }
 
-func (b *BackendClient) GetScanResult(ctx context.Context, scanResultID string, params models.GetScanResultsScanResultIDParams) (models.TargetScanResult, error) {
+func (b *BackendClient) GetScanResult(ctx context.Context, scanResultID string, params models.GetScanResultsScanResultIDParams) (models.AssetScanResult, error) {
 	newGetExistingError := func(err error) error {
 		return fmt.Errorf("failed to get existing scan result %v: %w", scanResultID, err)
 	}
 
-	var scanResults models.TargetScanResult
+	var scanResults models.AssetScanResult
+	cacheKey := etagCacheKey("scanResults/"+scanResultID, params.Select, params.Expand)
+	if params.IfNoneMatch == nil {
+		params.IfNoneMatch = b.etags.etag(cacheKey)
+	}
 	resp, err := b.apiClient.GetScanResultsScanResultIDWithResponse(ctx, scanResultID, &params)
 	if err != nil {
 		return scanResults, newGetExistingError(err)
@@ -57,7 +71,13 @@ func (b *BackendClient) GetScanResult(ctx context.Context, scanResultID string,
 		if resp.JSON200 == nil {
 			return scanResults, newGetExistingError(fmt.Errorf("empty body"))
 		}
+		b.etags.store(cacheKey, resp.HTTPResponse, resp.Body)
 		return *resp.JSON200, nil
+	case http.StatusNotModified:
+		if err := b.etags.load(cacheKey, &scanResults); err != nil {
+			return scanResults, newGetExistingError(err)
+		}
+		return scanResults, nil
 	case http.StatusNotFound:
 		if resp.JSON404 == nil {
 			return scanResults, newGetExistingError(fmt.Errorf("empty body on not found"))
@@ -74,12 +94,12 @@ func (b *BackendClient) GetScanResult(ctx context.Context, scanResultID string,
 	}
 }
 
-func (b *BackendClient) GetScanResults(ctx context.Context, params models.GetScanResultsParams) (models.TargetScanResults, error) {
+func (b *BackendClient) GetScanResults(ctx context.Context, params models.GetScanResultsParams) (models.AssetScanResults, error) {
 	newGetScanResultsError := func(err error) error {
 		return fmt.Errorf("failed to get scan results: %w", err)
 	}
 
-	var scanResults models.TargetScanResults
+	var scanResults models.AssetScanResults
 	resp, err := b.apiClient.GetScanResultsWithResponse(ctx, &params)
 	if err != nil {
 		return scanResults, newGetScanResultsError(err)
@@ -99,7 +119,7 @@ func (b *BackendClient) GetScanResults(ctx context.Context, params models.GetSca
 	}
 }
 
-func (b *BackendClient) PatchScanResult(ctx context.Context, scanResult models.TargetScanResult, scanResultID string) error {
+func (b *BackendClient) PatchScanResult(ctx context.Context, scanResult models.AssetScanResult, scanResultID string) error {
 	newUpdateScanResultError := func(err error) error {
 		return fmt.Errorf("failed to update scan result %v: %w", scanResultID, err)
 	}
@@ -137,6 +157,95 @@ func (b *BackendClient) PatchScanResult(ctx context.Context, scanResult models.T
 	}
 }
 
+// JSONPatchScanResult applies the JSON Patch operations to the scan result,
+// e.g. to remove a field, which a merge patch can't unset.
+func (b *BackendClient) JSONPatchScanResult(ctx context.Context, scanResultID string, operations models.JSONPatch) error {
+	newUpdateScanResultError := func(err error) error {
+		return fmt.Errorf("failed to patch scan result %v: %w", scanResultID, err)
+	}
+
+	body, err := json.Marshal(operations)
+	if err != nil {
+		return newUpdateScanResultError(err)
+	}
+
+	params := models.PatchScanResultsScanResultIDParams{}
+	resp, err := b.apiClient.PatchScanResultsScanResultIDWithBodyWithResponse(ctx, scanResultID, &params, "application/json-patch+json", bytes.NewReader(body))
+	if err != nil {
+		return newUpdateScanResultError(err)
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if resp.JSON200 == nil {
+			return newUpdateScanResultError(fmt.Errorf("empty body"))
+		}
+		return nil
+	case http.StatusBadRequest:
+		if resp.JSON400 != nil && resp.JSON400.Message != nil {
+			return newUpdateScanResultError(fmt.Errorf("status code=%v: %v", resp.StatusCode(), *resp.JSON400.Message))
+		}
+		return newUpdateScanResultError(fmt.Errorf("status code=%v", resp.StatusCode()))
+	case http.StatusNotFound:
+		if resp.JSON404 != nil && resp.JSON404.Message != nil {
+			return newUpdateScanResultError(fmt.Errorf("not found: %v", *resp.JSON404.Message))
+		}
+		return newUpdateScanResultError(fmt.Errorf("not found"))
+	case http.StatusUnprocessableEntity:
+		if resp.JSON422 != nil && resp.JSON422.Message != nil {
+			return newUpdateScanResultError(fmt.Errorf("status code=%v: %v", resp.StatusCode(), *resp.JSON422.Message))
+		}
+		return newUpdateScanResultError(fmt.Errorf("status code=%v", resp.StatusCode()))
+	default:
+		if resp.JSONDefault != nil && resp.JSONDefault.Message != nil {
+			return newUpdateScanResultError(fmt.Errorf("status code=%v: %v", resp.StatusCode(), *resp.JSONDefault.Message))
+		}
+		return newUpdateScanResultError(fmt.Errorf("status code=%v", resp.StatusCode()))
+	}
+}
+
+// PutScanResult replaces the scan result with scanResult. The update fails if
+// revision is set and doesn't match the current revision of the scan result.
+func (b *BackendClient) PutScanResult(ctx context.Context, scanResult models.AssetScanResult, scanResultID string, revision *int) error {
+	newUpdateScanResultError := func(err error) error {
+		return fmt.Errorf("failed to replace scan result %v: %w", scanResultID, err)
+	}
+
+	params := models.PutScanResultsScanResultIDParams{IfMatch: revision}
+	resp, err := b.apiClient.PutScanResultsScanResultIDWithResponse(ctx, scanResultID, &params, scanResult)
+	if err != nil {
+		return newUpdateScanResultError(err)
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if resp.JSON200 == nil {
+			return newUpdateScanResultError(fmt.Errorf("empty body"))
+		}
+		return nil
+	case http.StatusBadRequest:
+		if resp.JSON400 != nil && resp.JSON400.Message != nil {
+			return newUpdateScanResultError(fmt.Errorf("status code=%v: %v", resp.StatusCode(), *resp.JSON400.Message))
+		}
+		return newUpdateScanResultError(fmt.Errorf("status code=%v", resp.StatusCode()))
+	case http.StatusNotFound:
+		if resp.JSON404 != nil && resp.JSON404.Message != nil {
+			return newUpdateScanResultError(fmt.Errorf("not found: %v", *resp.JSON404.Message))
+		}
+		return newUpdateScanResultError(fmt.Errorf("not found"))
+	case http.StatusPreconditionFailed:
+		if resp.JSON412 != nil && resp.JSON412.Message != nil {
+			return newUpdateScanResultError(fmt.Errorf("revision mismatch: %v", *resp.JSON412.Message))
+		}
+		return newUpdateScanResultError(fmt.Errorf("revision mismatch"))
+	default:
+		if resp.JSONDefault != nil && resp.JSONDefault.Message != nil {
+			return newUpdateScanResultError(fmt.Errorf("status code=%v: %v", resp.StatusCode(), *resp.JSONDefault.Message))
+		}
+		return newUpdateScanResultError(fmt.Errorf("status code=%v", resp.StatusCode()))
+	}
+}
+
 func (b *BackendClient) PostScan(ctx context.Context, scan models.Scan) (*models.Scan, error) {
 	resp, err := b.apiClient.PostScansWithResponse(ctx, scan)
 	if err != nil {
@@ -172,7 +281,7 @@ func (b *BackendClient) PostScan(ctx context.Context, scan models.Scan) (*models
 	}
 }
 
-func (b *BackendClient) PostScanResult(ctx context.Context, scanResult models.TargetScanResult) (*models.TargetScanResult, error) {
+func (b *BackendClient) PostScanResult(ctx context.Context, scanResult models.AssetScanResult) (*models.AssetScanResult, error) {
 	resp, err := b.apiClient.PostScanResultsWithResponse(ctx, scanResult)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create a scan result: %v", err)
@@ -192,11 +301,11 @@ func (b *BackendClient) PostScanResult(ctx context.Context, scanResult models.Ta
 		if resp.JSON409 == nil {
 			return nil, fmt.Errorf("failed to create a scan result: empty body. status code=%v", http.StatusConflict)
 		}
-		if resp.JSON409.TargetScanResult == nil {
+		if resp.JSON409.AssetScanResult == nil {
 			return nil, fmt.Errorf("failed to create a scan result: no scan result data. status code=%v", http.StatusConflict)
 		}
 		return nil, ScanResultConflictError{
-			ConflictingScanResult: resp.JSON409.TargetScanResult,
+			ConflictingScanResult: resp.JSON409.AssetScanResult,
 			Message:               "conflict",
 		}
 	default:
@@ -251,7 +360,7 @@ func (b *BackendClient) GetScanResultSummary(ctx context.Context, scanResultID s
 	return scanResult.Summary, nil
 }
 
-func (b *BackendClient) GetScanResultStatus(ctx context.Context, scanResultID string) (*models.TargetScanStatus, error) {
+func (b *BackendClient) GetScanResultStatus(ctx context.Context, scanResultID string) (*models.AssetScanStatus, error) {
 	params := models.GetScanResultsScanResultIDParams{
 		Select: utils.PointerTo("status"),
 	}
@@ -262,8 +371,8 @@ func (b *BackendClient) GetScanResultStatus(ctx context.Context, scanResultID st
 	return scanResult.Status, nil
 }
 
-func (b *BackendClient) PatchTargetScanStatus(ctx context.Context, scanResultID string, status *models.TargetScanStatus) error {
-	scanResult := models.TargetScanResult{
+func (b *BackendClient) PatchAssetScanStatus(ctx context.Context, scanResultID string, status *models.AssetScanStatus) error {
+	scanResult := models.AssetScanResult{
 		Status: status,
 	}
 	params := models.PatchScanResultsScanResultIDParams{}
@@ -299,6 +408,10 @@ func (b *BackendClient) PatchTargetScanStatus(ctx context.Context, scanResultID
 }
 
 func (b *BackendClient) GetScan(ctx context.Context, scanID string, params models.GetScansScanIDParams) (*models.Scan, error) {
+	cacheKey := etagCacheKey("scans/"+scanID, params.Select, params.Expand)
+	if params.IfNoneMatch == nil {
+		params.IfNoneMatch = b.etags.etag(cacheKey)
+	}
 	resp, err := b.apiClient.GetScansScanIDWithResponse(ctx, scanID, &params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get a scan: %v", err)
@@ -308,7 +421,14 @@ func (b *BackendClient) GetScan(ctx context.Context, scanID string, params model
 		if resp.JSON200 == nil {
 			return nil, fmt.Errorf("failed to get a scan: empty body")
 		}
+		b.etags.store(cacheKey, resp.HTTPResponse, resp.Body)
 		return resp.JSON200, nil
+	case http.StatusNotModified:
+		var scan models.Scan
+		if err := b.etags.load(cacheKey, &scan); err != nil {
+			return nil, fmt.Errorf("failed to get a scan: %w", err)
+		}
+		return &scan, nil
 	case http.StatusNotFound:
 		if resp.JSON404 == nil {
 			return nil, fmt.Errorf("failed to get a scan: empty body on not found")
@@ -429,8 +549,8 @@ func (b *BackendClient) GetScans(ctx context.Context, params models.GetScansPara
 }
 
 //nolint:cyclop
-func (b *BackendClient) PostTarget(ctx context.Context, target models.Target) (*models.Target, error) {
-	resp, err := b.apiClient.PostTargetsWithResponse(ctx, target)
+func (b *BackendClient) PostAsset(ctx context.Context, target models.Asset) (*models.Asset, error) {
+	resp, err := b.apiClient.PostAssetsWithResponse(ctx, target)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create a target: %v", err)
 	}
@@ -449,11 +569,11 @@ func (b *BackendClient) PostTarget(ctx context.Context, target models.Target) (*
 		if resp.JSON409 == nil {
 			return nil, fmt.Errorf("failed to create a target: empty body. status code=%v", http.StatusConflict)
 		}
-		if resp.JSON409.Target == nil {
+		if resp.JSON409.Asset == nil {
 			return nil, fmt.Errorf("failed to create a target: no target data. status code=%v", http.StatusConflict)
 		}
-		return nil, TargetConflictError{
-			ConflictingTarget: resp.JSON409.Target,
+		return nil, AssetConflictError{
+			ConflictingTarget: resp.JSON409.Asset,
 			Message:           "conflict",
 		}
 	default:
@@ -465,13 +585,44 @@ func (b *BackendClient) PostTarget(ctx context.Context, target models.Target) (*
 }
 
 //nolint:cyclop
-func (b *BackendClient) PatchTarget(ctx context.Context, target models.Target, targetID string) error {
+func (b *BackendClient) PutAsset(ctx context.Context, target models.Asset, targetID string) error {
+	newSaveTargetError := func(err error) error {
+		return fmt.Errorf("failed to save target %v: %w", targetID, err)
+	}
+
+	params := models.PutAssetsAssetIDParams{}
+	resp, err := b.apiClient.PutAssetsAssetIDWithResponse(ctx, targetID, &params, target)
+	if err != nil {
+		return newSaveTargetError(err)
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if resp.JSON200 == nil {
+			return newSaveTargetError(fmt.Errorf("empty body"))
+		}
+		return nil
+	case http.StatusNotFound:
+		if resp.JSON404 != nil && resp.JSON404.Message != nil {
+			return newSaveTargetError(fmt.Errorf("not found: %v", *resp.JSON404.Message))
+		}
+		return newSaveTargetError(fmt.Errorf("not found"))
+	default:
+		if resp.JSONDefault != nil && resp.JSONDefault.Message != nil {
+			return newSaveTargetError(fmt.Errorf("status code=%v: %v", resp.StatusCode(), *resp.JSONDefault.Message))
+		}
+		return newSaveTargetError(fmt.Errorf("status code=%v", resp.StatusCode()))
+	}
+}
+
+//nolint:cyclop
+func (b *BackendClient) PatchAsset(ctx context.Context, target models.Asset, targetID string) error {
 	newUpdateTargetError := func(err error) error {
 		return fmt.Errorf("failed to update target %v: %w", targetID, err)
 	}
 
-	params := models.PatchTargetsTargetIDParams{}
-	resp, err := b.apiClient.PatchTargetsTargetIDWithResponse(ctx, targetID, &params, target)
+	params := models.PatchAssetsAssetIDParams{}
+	resp, err := b.apiClient.PatchAssetsAssetIDWithResponse(ctx, targetID, &params, target)
 	if err != nil {
 		return newUpdateTargetError(err)
 	}
@@ -499,13 +650,13 @@ func (b *BackendClient) PatchTarget(ctx context.Context, target models.Target, t
 }
 
 // nolint:cyclop
-func (b *BackendClient) GetTarget(ctx context.Context, targetID string, params models.GetTargetsTargetIDParams) (models.Target, error) {
+func (b *BackendClient) GetAsset(ctx context.Context, targetID string, params models.GetAssetsAssetIDParams) (models.Asset, error) {
 	newGetExistingError := func(err error) error {
 		return fmt.Errorf("failed to get existing target %v: %w", targetID, err)
 	}
 
-	var target models.Target
-	resp, err := b.apiClient.GetTargetsTargetIDWithResponse(ctx, targetID, &params)
+	var target models.Asset
+	resp, err := b.apiClient.GetAssetsAssetIDWithResponse(ctx, targetID, &params)
 	if err != nil {
 		return target, newGetExistingError(err)
 	}
@@ -551,8 +702,8 @@ func (b *BackendClient) PutDiscoveryScopes(ctx context.Context, scope *models.Sc
 	}
 }
 
-func (b *BackendClient) GetTargets(ctx context.Context, params models.GetTargetsParams) (*models.Targets, error) {
-	resp, err := b.apiClient.GetTargetsWithResponse(ctx, &params)
+func (b *BackendClient) GetAssets(ctx context.Context, params models.GetAssetsParams) (*models.Assets, error) {
+	resp, err := b.apiClient.GetAssetsWithResponse(ctx, &params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get targets: %v", err)
 	}
@@ -589,6 +740,44 @@ func (b *BackendClient) GetFindings(ctx context.Context, params models.GetFindin
 	}
 }
 
+func (b *BackendClient) GetPostureScores(ctx context.Context, params models.GetPostureScoresParams) (*models.PostureScores, error) {
+	resp, err := b.apiClient.GetPostureScoresWithResponse(ctx, &params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get posture scores: %v", err)
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("no posture scores: empty body")
+		}
+		return resp.JSON200, nil
+	default:
+		if resp.JSONDefault != nil && resp.JSONDefault.Message != nil {
+			return nil, fmt.Errorf("failed to get posture scores. status code=%v: %s", resp.StatusCode(), *resp.JSONDefault.Message)
+		}
+		return nil, fmt.Errorf("failed to get posture scores. status code=%v", resp.StatusCode())
+	}
+}
+
+func (b *BackendClient) GetCorrelatedFindings(ctx context.Context, params models.GetCorrelatedFindingsParams) (*models.CorrelatedFindings, error) {
+	resp, err := b.apiClient.GetCorrelatedFindingsWithResponse(ctx, &params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get correlated findings: %v", err)
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("no correlated findings: empty body")
+		}
+		return resp.JSON200, nil
+	default:
+		if resp.JSONDefault != nil && resp.JSONDefault.Message != nil {
+			return nil, fmt.Errorf("failed to get correlated findings. status code=%v: %s", resp.StatusCode(), *resp.JSONDefault.Message)
+		}
+		return nil, fmt.Errorf("failed to get correlated findings. status code=%v", resp.StatusCode())
+	}
+}
+
 func (b *BackendClient) PatchFinding(ctx context.Context, findingID models.FindingID, finding models.Finding) error {
 	resp, err := b.apiClient.PatchFindingsFindingIDWithResponse(ctx, findingID, finding)
 	if err != nil {
@@ -650,3 +839,372 @@ func (b *BackendClient) PostFinding(ctx context.Context, finding models.Finding)
 		return nil, fmt.Errorf("failed to create a finding. status code=%v", resp.StatusCode())
 	}
 }
+
+// PostFindingsBulk creates the findings in a single request and returns the
+// result of each finding in the same order.
+func (b *BackendClient) PostFindingsBulk(ctx context.Context, findings []models.Finding) ([]models.FindingBulkItemResult, error) {
+	resp, err := b.apiClient.PostFindingsBulkWithResponse(ctx, models.FindingsBulkRequest{Items: findings})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create findings: %w", err)
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("failed to create findings: empty body")
+		}
+		return resp.JSON200.Items, nil
+	case http.StatusBadRequest:
+		if resp.JSON400 != nil && resp.JSON400.Message != nil {
+			return nil, fmt.Errorf("failed to create findings: status code=%v: %v", resp.StatusCode(), *resp.JSON400.Message)
+		}
+		return nil, fmt.Errorf("failed to create findings: status code=%v", resp.StatusCode())
+	default:
+		if resp.JSONDefault != nil && resp.JSONDefault.Message != nil {
+			return nil, fmt.Errorf("failed to create findings: status code=%v: %v", resp.StatusCode(), *resp.JSONDefault.Message)
+		}
+		return nil, fmt.Errorf("failed to create findings: status code=%v", resp.StatusCode())
+	}
+}
+
+// PatchFindingsBulk patches the findings, identified by their id, in a single
+// request and returns the result of each finding in the same order.
+func (b *BackendClient) PatchFindingsBulk(ctx context.Context, findings []models.Finding) ([]models.FindingBulkItemResult, error) {
+	resp, err := b.apiClient.PatchFindingsBulkWithResponse(ctx, models.FindingsBulkRequest{Items: findings})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update findings: %w", err)
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("failed to update findings: empty body")
+		}
+		return resp.JSON200.Items, nil
+	case http.StatusBadRequest:
+		if resp.JSON400 != nil && resp.JSON400.Message != nil {
+			return nil, fmt.Errorf("failed to update findings: status code=%v: %v", resp.StatusCode(), *resp.JSON400.Message)
+		}
+		return nil, fmt.Errorf("failed to update findings: status code=%v", resp.StatusCode())
+	default:
+		if resp.JSONDefault != nil && resp.JSONDefault.Message != nil {
+			return nil, fmt.Errorf("failed to update findings: status code=%v: %v", resp.StatusCode(), *resp.JSONDefault.Message)
+		}
+		return nil, fmt.Errorf("failed to update findings: status code=%v", resp.StatusCode())
+	}
+}
+
+func (b *BackendClient) GetReportSchedules(ctx context.Context, params models.GetReportSchedulesParams) (*models.ReportSchedules, error) {
+	resp, err := b.apiClient.GetReportSchedulesWithResponse(ctx, &params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get report schedules: %w", err)
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("no report schedules: empty body")
+		}
+		return resp.JSON200, nil
+	default:
+		if resp.JSONDefault != nil && resp.JSONDefault.Message != nil {
+			return nil, fmt.Errorf("failed to get report schedules. status code=%v: %s", resp.StatusCode(), *resp.JSONDefault.Message)
+		}
+		return nil, fmt.Errorf("failed to get report schedules. status code=%v", resp.StatusCode())
+	}
+}
+
+func (b *BackendClient) GetFindingExceptions(ctx context.Context, params models.GetFindingExceptionsParams) (*models.FindingExceptions, error) {
+	resp, err := b.apiClient.GetFindingExceptionsWithResponse(ctx, &params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get finding exceptions: %w", err)
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("no finding exceptions: empty body")
+		}
+		return resp.JSON200, nil
+	default:
+		if resp.JSONDefault != nil && resp.JSONDefault.Message != nil {
+			return nil, fmt.Errorf("failed to get finding exceptions. status code=%v: %s", resp.StatusCode(), *resp.JSONDefault.Message)
+		}
+		return nil, fmt.Errorf("failed to get finding exceptions. status code=%v", resp.StatusCode())
+	}
+}
+
+func (b *BackendClient) GetScanConfigTemplate(ctx context.Context, scanConfigTemplateID string, params models.GetScanConfigTemplatesScanConfigTemplateIDParams) (*models.ScanConfigTemplate, error) {
+	resp, err := b.apiClient.GetScanConfigTemplatesScanConfigTemplateIDWithResponse(ctx, scanConfigTemplateID, &params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a scan config template: %w", err)
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("failed to get scan config template: empty body")
+		}
+		return resp.JSON200, nil
+	case http.StatusNotFound:
+		if resp.JSON404 != nil && resp.JSON404.Message != nil {
+			return nil, fmt.Errorf("failed to get a scan config template, not found: %v", *resp.JSON404.Message)
+		}
+		return nil, fmt.Errorf("failed to get a scan config template, not found")
+	default:
+		if resp.JSONDefault != nil && resp.JSONDefault.Message != nil {
+			return nil, fmt.Errorf("failed to get a scan config template. status code=%v: %v", resp.StatusCode(), *resp.JSONDefault.Message)
+		}
+		return nil, fmt.Errorf("failed to get a scan config template. status code=%v", resp.StatusCode())
+	}
+}
+
+func (b *BackendClient) GetAssetGroup(ctx context.Context, assetGroupID string, params models.GetAssetGroupsAssetGroupIDParams) (*models.AssetGroup, error) {
+	resp, err := b.apiClient.GetAssetGroupsAssetGroupIDWithResponse(ctx, assetGroupID, &params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get an asset group: %w", err)
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("failed to get an asset group: empty body")
+		}
+		return resp.JSON200, nil
+	case http.StatusNotFound:
+		if resp.JSON404 != nil && resp.JSON404.Message != nil {
+			return nil, fmt.Errorf("failed to get an asset group, not found: %v", *resp.JSON404.Message)
+		}
+		return nil, fmt.Errorf("failed to get an asset group, not found")
+	default:
+		if resp.JSONDefault != nil && resp.JSONDefault.Message != nil {
+			return nil, fmt.Errorf("failed to get an asset group. status code=%v: %v", resp.StatusCode(), *resp.JSONDefault.Message)
+		}
+		return nil, fmt.Errorf("failed to get an asset group. status code=%v", resp.StatusCode())
+	}
+}
+
+// GetAssetGroupAssets returns the assets which are currently members of the
+// asset group.
+func (b *BackendClient) GetAssetGroupAssets(ctx context.Context, assetGroupID string, params models.GetAssetGroupsAssetGroupIDAssetsParams) (*models.Assets, error) {
+	resp, err := b.apiClient.GetAssetGroupsAssetGroupIDAssetsWithResponse(ctx, assetGroupID, &params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the assets of an asset group: %w", err)
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("failed to get the assets of an asset group: empty body")
+		}
+		return resp.JSON200, nil
+	case http.StatusNotFound:
+		if resp.JSON404 != nil && resp.JSON404.Message != nil {
+			return nil, fmt.Errorf("failed to get the assets of an asset group, not found: %v", *resp.JSON404.Message)
+		}
+		return nil, fmt.Errorf("failed to get the assets of an asset group, not found")
+	default:
+		if resp.JSONDefault != nil && resp.JSONDefault.Message != nil {
+			return nil, fmt.Errorf("failed to get the assets of an asset group. status code=%v: %v", resp.StatusCode(), *resp.JSONDefault.Message)
+		}
+		return nil, fmt.Errorf("failed to get the assets of an asset group. status code=%v", resp.StatusCode())
+	}
+}
+
+func (b *BackendClient) GetReportSchedule(ctx context.Context, reportScheduleID string, params models.GetReportSchedulesReportScheduleIDParams) (*models.ReportSchedule, error) {
+	resp, err := b.apiClient.GetReportSchedulesReportScheduleIDWithResponse(ctx, reportScheduleID, &params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get a report schedule: %w", err)
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("failed to get report schedule: empty body")
+		}
+		return resp.JSON200, nil
+	case http.StatusNotFound:
+		if resp.JSON404 != nil && resp.JSON404.Message != nil {
+			return nil, fmt.Errorf("failed to get a report schedule, not found: %v", *resp.JSON404.Message)
+		}
+		return nil, fmt.Errorf("failed to get a report schedule, not found")
+	default:
+		if resp.JSONDefault != nil && resp.JSONDefault.Message != nil {
+			return nil, fmt.Errorf("failed to get a report schedule. status code=%v: %v", resp.StatusCode(), *resp.JSONDefault.Message)
+		}
+		return nil, fmt.Errorf("failed to get a report schedule. status code=%v", resp.StatusCode())
+	}
+}
+
+func (b *BackendClient) PatchReportSchedule(ctx context.Context, reportScheduleID string, reportSchedule *models.ReportSchedule) error {
+	newPatchReportScheduleError := func(err error) error {
+		return fmt.Errorf("failed to update report schedule %v: %w", reportScheduleID, err)
+	}
+
+	params := models.PatchReportSchedulesReportScheduleIDParams{}
+	resp, err := b.apiClient.PatchReportSchedulesReportScheduleIDWithResponse(ctx, reportScheduleID, &params, *reportSchedule)
+	if err != nil {
+		return newPatchReportScheduleError(err)
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if resp.JSON200 == nil {
+			return newPatchReportScheduleError(fmt.Errorf("empty body"))
+		}
+		return nil
+	case http.StatusBadRequest:
+		if resp.JSON400 != nil && resp.JSON400.Message != nil {
+			return newPatchReportScheduleError(fmt.Errorf("status code=%v: %v", resp.StatusCode(), *resp.JSON400.Message))
+		}
+		return newPatchReportScheduleError(fmt.Errorf("status code=%v", resp.StatusCode()))
+	case http.StatusNotFound:
+		if resp.JSON404 != nil && resp.JSON404.Message != nil {
+			return newPatchReportScheduleError(fmt.Errorf("not found: %v", *resp.JSON404.Message))
+		}
+		return newPatchReportScheduleError(fmt.Errorf("not found"))
+	default:
+		if resp.JSONDefault != nil && resp.JSONDefault.Message != nil {
+			return newPatchReportScheduleError(fmt.Errorf("status code=%v: %v", resp.StatusCode(), *resp.JSONDefault.Message))
+		}
+		return newPatchReportScheduleError(fmt.Errorf("status code=%v", resp.StatusCode()))
+	}
+}
+
+// PutScannerConfig sets the scanner config of the ScanResult, the returned
+// config has the token its scanner fetches it with.
+func (b *BackendClient) PutScannerConfig(ctx context.Context, scanResultID string, scannerConfig models.ScannerConfig) (*models.ScannerConfig, error) {
+	resp, err := b.apiClient.PutScanResultsScanResultIDScannerConfigWithResponse(ctx, scanResultID, scannerConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to put scanner config: %w", err)
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("failed to put scanner config: empty body")
+		}
+		return resp.JSON200, nil
+	case http.StatusBadRequest:
+		if resp.JSON400 != nil && resp.JSON400.Message != nil {
+			return nil, fmt.Errorf("failed to put scanner config. status code=%v: %v", resp.StatusCode(), *resp.JSON400.Message)
+		}
+		return nil, fmt.Errorf("failed to put scanner config. status code=%v", resp.StatusCode())
+	case http.StatusNotFound:
+		if resp.JSON404 != nil && resp.JSON404.Message != nil {
+			return nil, fmt.Errorf("failed to put scanner config, scan result not found: %v", *resp.JSON404.Message)
+		}
+		return nil, fmt.Errorf("failed to put scanner config, scan result not found")
+	default:
+		if resp.JSONDefault != nil && resp.JSONDefault.Message != nil {
+			return nil, fmt.Errorf("failed to put scanner config. status code=%v: %v", resp.StatusCode(), *resp.JSONDefault.Message)
+		}
+		return nil, fmt.Errorf("failed to put scanner config. status code=%v", resp.StatusCode())
+	}
+}
+
+func (b *BackendClient) PostScanResultItems(ctx context.Context, scanResultID string, family models.ScanFamily, page models.ScanResultItems) (*models.ScanResultItems, error) {
+	resp, err := b.apiClient.PostScanResultsScanResultIDFamiliesFamilyItemsWithResponse(ctx, scanResultID, family, page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to post scan result items: %w", err)
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("failed to post scan result items: empty body. status code=%v", http.StatusOK)
+		}
+		return resp.JSON200, nil
+	case http.StatusBadRequest:
+		if resp.JSON400 != nil && resp.JSON400.Message != nil {
+			return nil, fmt.Errorf("failed to post scan result items. status code=%v: %v", resp.StatusCode(), *resp.JSON400.Message)
+		}
+		return nil, fmt.Errorf("failed to post scan result items. status code=%v", resp.StatusCode())
+	case http.StatusNotFound:
+		if resp.JSON404 != nil && resp.JSON404.Message != nil {
+			return nil, fmt.Errorf("failed to post scan result items, scan result not found: %v", *resp.JSON404.Message)
+		}
+		return nil, fmt.Errorf("failed to post scan result items, scan result not found")
+	case http.StatusConflict:
+		if resp.JSON409 != nil && resp.JSON409.Message != nil {
+			return nil, fmt.Errorf("failed to post scan result items. status code=%v: %v", resp.StatusCode(), *resp.JSON409.Message)
+		}
+		return nil, fmt.Errorf("failed to post scan result items. status code=%v", resp.StatusCode())
+	default:
+		if resp.JSONDefault != nil && resp.JSONDefault.Message != nil {
+			return nil, fmt.Errorf("failed to post scan result items. status code=%v: %v", resp.StatusCode(), *resp.JSONDefault.Message)
+		}
+		return nil, fmt.Errorf("failed to post scan result items. status code=%v", resp.StatusCode())
+	}
+}
+
+// PutScanResultArtifact uploads the raw output of the scan family of the scan
+// result to the artifact store of the backend.
+func (b *BackendClient) PutScanResultArtifact(ctx context.Context, scanResultID string, family models.ScanFamily, contentType string, data []byte) (*models.ScanResultArtifact, error) {
+	resp, err := b.apiClient.PutScanResultsScanResultIDFamiliesFamilyArtifactWithBodyWithResponse(ctx, scanResultID, family, contentType, bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to put scan result artifact: %w", err)
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("failed to put scan result artifact: empty body. status code=%v", http.StatusOK)
+		}
+		return resp.JSON200, nil
+	case http.StatusBadRequest:
+		if resp.JSON400 != nil && resp.JSON400.Message != nil {
+			return nil, fmt.Errorf("failed to put scan result artifact. status code=%v: %v", resp.StatusCode(), *resp.JSON400.Message)
+		}
+		return nil, fmt.Errorf("failed to put scan result artifact. status code=%v", resp.StatusCode())
+	case http.StatusNotFound:
+		if resp.JSON404 != nil && resp.JSON404.Message != nil {
+			return nil, fmt.Errorf("failed to put scan result artifact, scan result not found: %v", *resp.JSON404.Message)
+		}
+		return nil, fmt.Errorf("failed to put scan result artifact, scan result not found")
+	case http.StatusRequestEntityTooLarge:
+		if resp.JSON413 != nil && resp.JSON413.Message != nil {
+			return nil, fmt.Errorf("failed to put scan result artifact. status code=%v: %v", resp.StatusCode(), *resp.JSON413.Message)
+		}
+		return nil, fmt.Errorf("failed to put scan result artifact. status code=%v", resp.StatusCode())
+	case http.StatusServiceUnavailable:
+		return nil, ErrArtifactStoreNotConfigured
+	default:
+		if resp.JSONDefault != nil && resp.JSONDefault.Message != nil {
+			return nil, fmt.Errorf("failed to put scan result artifact. status code=%v: %v", resp.StatusCode(), *resp.JSONDefault.Message)
+		}
+		return nil, fmt.Errorf("failed to put scan result artifact. status code=%v", resp.StatusCode())
+	}
+}
+
+func (b *BackendClient) PostProviderOperation(ctx context.Context, providerOperation models.ProviderOperation) (*models.ProviderOperation, error) {
+	resp, err := b.apiClient.PostProviderOperationsWithResponse(ctx, providerOperation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a provider operation: %v", err)
+	}
+	switch resp.StatusCode() {
+	case http.StatusCreated:
+		if resp.JSON201 == nil {
+			return nil, fmt.Errorf("failed to create a provider operation: empty body. status code=%v", http.StatusCreated)
+		}
+		return resp.JSON201, nil
+	case http.StatusBadRequest:
+		if resp.JSON400 != nil && resp.JSON400.Message != nil {
+			return nil, fmt.Errorf("failed to create a provider operation. status code=%v: %v", resp.StatusCode(), *resp.JSON400.Message)
+		}
+		return nil, fmt.Errorf("failed to create a provider operation. status code=%v", resp.StatusCode())
+	default:
+		if resp.JSONDefault != nil && resp.JSONDefault.Message != nil {
+			return nil, fmt.Errorf("failed to create a provider operation. status code=%v: %v", resp.StatusCode(), *resp.JSONDefault.Message)
+		}
+		return nil, fmt.Errorf("failed to create a provider operation. status code=%v", resp.StatusCode())
+	}
+}
+
+func (b *BackendClient) GetMaintenanceMode(ctx context.Context) (*models.MaintenanceMode, error) {
+	resp, err := b.apiClient.GetAdminMaintenanceWithResponse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get maintenance mode: %v", err)
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if resp.JSON200 == nil {
+			return nil, fmt.Errorf("no maintenance mode: empty body")
+		}
+		return resp.JSON200, nil
+	default:
+		if resp.JSONDefault != nil && resp.JSONDefault.Message != nil {
+			return nil, fmt.Errorf("failed to get maintenance mode. status code=%v: %s", resp.StatusCode(), *resp.JSONDefault.Message)
+		}
+		return nil, fmt.Errorf("failed to get maintenance mode. status code=%v", resp.StatusCode())
+	}
+}
@@ -16,17 +16,22 @@
 package backendclient
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/openclarity/vmclarity/api/models"
 )
 
-type TargetConflictError struct {
-	ConflictingTarget *models.Target
+// ErrArtifactStoreNotConfigured is returned when an artifact is uploaded to a
+// backend without an artifact store.
+var ErrArtifactStoreNotConfigured = errors.New("artifact store is not configured")
+
+type AssetConflictError struct {
+	ConflictingTarget *models.Asset
 	Message           string
 }
 
-func (t TargetConflictError) Error() string {
+func (t AssetConflictError) Error() string {
 	return fmt.Sprintf("Conflicting Target Found with ID %s: %s", *t.ConflictingTarget.Id, t.Message)
 }
 
@@ -40,7 +45,7 @@ func (t ScanConflictError) Error() string {
 }
 
 type ScanResultConflictError struct {
-	ConflictingScanResult *models.TargetScanResult
+	ConflictingScanResult *models.AssetScanResult
 	Message               string
 }
 
@@ -0,0 +1,116 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backendclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// maxETagCacheEntries bounds the number of objects the cache keeps, an
+// arbitrary entry is evicted to make room for a new one.
+const maxETagCacheEntries = 1000
+
+type etagCacheEntry struct {
+	etag string
+	body []byte
+}
+
+// etagCache keeps the ETag and the body of the last response for the objects
+// which are polled, so that they are requested with If-None-Match and are not
+// downloaded again while they don't change.
+type etagCache struct {
+	mu      sync.Mutex
+	entries map[string]etagCacheEntry
+}
+
+func newETagCache() *etagCache {
+	return &etagCache{
+		entries: make(map[string]etagCacheEntry),
+	}
+}
+
+// etagCacheKey identifies the response for the object at path with the query
+// options, the ETag of an object depends on its $select and $expand.
+func etagCacheKey(path string, options ...*string) string {
+	key := []string{path}
+	for _, option := range options {
+		if option == nil {
+			key = append(key, "")
+			continue
+		}
+		key = append(key, *option)
+	}
+	return strings.Join(key, "?")
+}
+
+// etag returns the ETag of the cached response for the key, or nil if there
+// is none.
+func (c *etagCache) etag(key string) *string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+	return &entry.etag
+}
+
+// store caches the body of the response for the key if it has an ETag.
+func (c *etagCache) store(key string, resp *http.Response, body []byte) {
+	if resp == nil {
+		return
+	}
+	etag := resp.Header.Get("ETag")
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if etag == "" {
+		delete(c.entries, key)
+		return
+	}
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= maxETagCacheEntries {
+		for k := range c.entries {
+			delete(c.entries, k)
+			break
+		}
+	}
+	c.entries[key] = etagCacheEntry{
+		etag: etag,
+		body: body,
+	}
+}
+
+// load unmarshals the cached body for the key into dest. A fresh object is
+// returned each time so callers are free to modify it.
+func (c *etagCache) load(key string, dest interface{}) error {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("not modified but there is no cached response")
+	}
+	if err := json.Unmarshal(entry.body, dest); err != nil {
+		return fmt.Errorf("failed to unmarshal cached response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backendclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestBackendClient_GetScan_ETag(t *testing.T) {
+	const etag = `W/"1"`
+	var requests, notModified int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == etag {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", etag)
+		_, _ = w.Write([]byte(`{"id":"scan"}`))
+	}))
+	defer server.Close()
+
+	client, err := Create(server.URL)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		scan, err := client.GetScan(context.Background(), "scan", models.GetScansScanIDParams{})
+		if err != nil {
+			t.Fatalf("GetScan() error = %v", err)
+		}
+		if got := utils.ValueOrZero(scan.Id); got != "scan" {
+			t.Errorf("GetScan() id = %q, want %q", got, "scan")
+		}
+		// Modifying the returned scan must not modify the cached one.
+		scan.Id = utils.PointerTo("modified")
+	}
+	if requests != 3 || notModified != 2 {
+		t.Errorf("requests = %d, not modified = %d, want 3 and 2", requests, notModified)
+	}
+
+	// The ETag depends on the $select, it isn't sent for a different one.
+	if _, err := client.GetScan(context.Background(), "scan", models.GetScansScanIDParams{
+		Select: utils.PointerTo("id"),
+	}); err != nil {
+		t.Fatalf("GetScan() error = %v", err)
+	}
+	if notModified != 2 {
+		t.Errorf("not modified = %d, want 2", notModified)
+	}
+}
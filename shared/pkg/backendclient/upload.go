@@ -0,0 +1,137 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backendclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// resultItemsPageSize is the number of items of the result list of a family
+// uploaded in a request.
+const resultItemsPageSize = 1000
+
+// UploadFamilyResult sets the result of the family, the totals of its summary
+// and its state in the scan result. The result is not set if it is nil, e.g.
+// if the family failed.
+func (b *BackendClient) UploadFamilyResult(ctx context.Context, scanResultID string, family models.ScanFamily, result interface{}, state models.AssetScanState, summary *models.ScanFindingsSummary) error {
+	// Only the fields which are patched are fetched, rerunFamilies is
+	// fetched too as it is not omitted from the patch when it is not set.
+	scanResult, err := b.GetScanResult(ctx, scanResultID, models.GetScanResultsScanResultIDParams{
+		Select: utils.PointerTo("status,summary,rerunFamilies"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get scan result: %w", err)
+	}
+
+	if scanResult.Status == nil {
+		scanResult.Status = &models.AssetScanStatus{}
+	}
+	if scanResult.Summary == nil {
+		scanResult.Summary = &models.ScanFindingsSummary{}
+	}
+
+	if result != nil {
+		if err := b.uploadResult(ctx, scanResult, scanResultID, family, result); err != nil {
+			return err
+		}
+	}
+	if summary != nil {
+		if err := mergeSummary(scanResult.Summary, summary); err != nil {
+			return err
+		}
+	}
+
+	scanResult.Status.SetFamilyState(family, &state)
+
+	if err := b.PatchScanResult(ctx, scanResult, scanResultID); err != nil {
+		return fmt.Errorf("failed to patch scan result: %w", err)
+	}
+
+	return nil
+}
+
+// uploadResult patches the scan result with the result of the family without
+// its list of items, which are then uploaded in pages so that a large result
+// is not sent in a single request.
+func (b *BackendClient) uploadResult(ctx context.Context, scanResult models.AssetScanResult, scanResultID string, family models.ScanFamily, result interface{}) error {
+	resultField, itemsField, ok := models.ScanResultItemsFields(family)
+	if !ok {
+		return fmt.Errorf("unknown scan family %s", family)
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s result: %w", family, err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return fmt.Errorf("failed to unmarshal %s result: %w", family, err)
+	}
+	var items []map[string]interface{}
+	if raw, ok := fields[itemsField]; ok {
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return fmt.Errorf("failed to unmarshal %s result items: %w", family, err)
+		}
+	}
+	fields[itemsField] = json.RawMessage("[]")
+
+	// Set only the field of the family in the scan result.
+	data, err = json.Marshal(map[string]interface{}{resultField: fields})
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s result: %w", family, err)
+	}
+	if err := json.Unmarshal(data, &scanResult); err != nil {
+		return fmt.Errorf("failed to unmarshal %s result: %w", family, err)
+	}
+	if err := b.PatchScanResult(ctx, scanResult, scanResultID); err != nil {
+		return fmt.Errorf("failed to patch scan result: %w", err)
+	}
+
+	for offset := 0; offset < len(items); offset += resultItemsPageSize {
+		end := offset + resultItemsPageSize
+		if end > len(items) {
+			end = len(items)
+		}
+		page := items[offset:end]
+		_, err := b.PostScanResultItems(ctx, scanResultID, family, models.ScanResultItems{
+			Offset: offset,
+			Items:  &page,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to upload %s result items: %w", family, err)
+		}
+	}
+
+	return nil
+}
+
+// mergeSummary sets the totals of the summary of the family in the summary
+// of the scan result.
+func mergeSummary(summary, familySummary *models.ScanFindingsSummary) error {
+	b, err := json.Marshal(familySummary)
+	if err != nil {
+		return fmt.Errorf("failed to marshal summary: %w", err)
+	}
+	if err := json.Unmarshal(b, summary); err != nil {
+		return fmt.Errorf("failed to unmarshal summary: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compliance
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// bundledMappings are always loaded, the mappings of the user are added to them.
+//
+//go:embed mappings/*.yaml
+var bundledMappings embed.FS
+
+// Test identifies a misconfiguration test of a scanner.
+type Test struct {
+	ScannerName string `yaml:"scannerName"`
+	TestID      string `yaml:"testID"`
+}
+
+// Control is a control of a compliance framework and the tests which verify it.
+type Control struct {
+	ID    string `yaml:"id"`
+	Title string `yaml:"title"`
+	Tests []Test `yaml:"tests"`
+}
+
+// Mapping is the content of a mapping file which maps the tests to the controls of a compliance framework.
+type Mapping struct {
+	Framework string    `yaml:"framework"`
+	Controls  []Control `yaml:"controls"`
+}
+
+type controlKey struct {
+	Framework string
+	ControlID string
+}
+
+// Mapper maps the misconfiguration tests to the compliance framework controls they verify.
+type Mapper struct {
+	controls []models.ComplianceControl
+	byTest   map[Test][]models.ComplianceControl
+}
+
+// NewMapper returns a Mapper using the bundled mappings and the mapping files (*.yaml or *.yml) found in mappingsDir.
+// The tests of a control defined in several files are merged. The mappingsDir is optional.
+func NewMapper(mappingsDir string) (*Mapper, error) {
+	mappings, err := loadMappings(bundledMappings, "mappings")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load bundled compliance mappings: %w", err)
+	}
+
+	if mappingsDir != "" {
+		userMappings, err := loadMappings(os.DirFS(mappingsDir), ".")
+		if err != nil {
+			return nil, fmt.Errorf("failed to load compliance mappings from %s: %w", mappingsDir, err)
+		}
+		mappings = append(mappings, userMappings...)
+	}
+
+	return newMapper(mappings)
+}
+
+func loadMappings(fsys fs.FS, dir string) ([]Mapping, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory: %w", err)
+	}
+
+	mappings := make([]Mapping, 0, len(entries))
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml") {
+			continue
+		}
+
+		data, err := fs.ReadFile(fsys, filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", entry.Name(), err)
+		}
+
+		var mapping Mapping
+		if err = yaml.Unmarshal(data, &mapping); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", entry.Name(), err)
+		}
+		mappings = append(mappings, mapping)
+	}
+
+	return mappings, nil
+}
+
+func newMapper(mappings []Mapping) (*Mapper, error) {
+	controls := map[controlKey]models.ComplianceControl{}
+	tests := map[controlKey]map[Test]struct{}{}
+
+	for _, mapping := range mappings {
+		if mapping.Framework == "" {
+			return nil, fmt.Errorf("framework must be provided")
+		}
+
+		for _, control := range mapping.Controls {
+			if control.ID == "" {
+				return nil, fmt.Errorf("id must be provided for the controls of framework %s", mapping.Framework)
+			}
+
+			key := controlKey{Framework: mapping.Framework, ControlID: control.ID}
+			if _, ok := controls[key]; !ok {
+				controls[key] = models.ComplianceControl{
+					Framework: mapping.Framework,
+					ControlID: control.ID,
+				}
+				tests[key] = map[Test]struct{}{}
+			}
+			if control.Title != "" {
+				c := controls[key]
+				c.Title = utils.PointerTo(control.Title)
+				controls[key] = c
+			}
+
+			for _, test := range control.Tests {
+				if test.ScannerName == "" || test.TestID == "" {
+					return nil, fmt.Errorf("scannerName and testID must be provided for the tests of control %s/%s", mapping.Framework, control.ID)
+				}
+				tests[key][test] = struct{}{}
+			}
+		}
+	}
+
+	m := &Mapper{
+		controls: make([]models.ComplianceControl, 0, len(controls)),
+		byTest:   map[Test][]models.ComplianceControl{},
+	}
+	for _, control := range controls {
+		m.controls = append(m.controls, control)
+	}
+	sortControls(m.controls)
+
+	for _, control := range m.controls {
+		key := controlKey{Framework: control.Framework, ControlID: control.ControlID}
+		for test := range tests[key] {
+			m.byTest[test] = append(m.byTest[test], control)
+		}
+	}
+
+	return m, nil
+}
+
+func sortControls(controls []models.ComplianceControl) {
+	sort.Slice(controls, func(i, j int) bool {
+		if controls[i].Framework != controls[j].Framework {
+			return controls[i].Framework < controls[j].Framework
+		}
+		return controls[i].ControlID < controls[j].ControlID
+	})
+}
+
+// Controls returns all the controls known by the Mapper ordered by framework and control ID.
+func (m *Mapper) Controls() []models.ComplianceControl {
+	controls := make([]models.ComplianceControl, len(m.controls))
+	copy(controls, m.controls)
+	return controls
+}
+
+// ControlsFor returns the controls verified by the test of the scanner ordered by framework and control ID.
+func (m *Mapper) ControlsFor(scannerName, testID string) []models.ComplianceControl {
+	controls := m.byTest[Test{ScannerName: scannerName, TestID: testID}]
+	if len(controls) == 0 {
+		return nil
+	}
+
+	ret := make([]models.ComplianceControl, len(controls))
+	copy(ret, controls)
+	return ret
+}
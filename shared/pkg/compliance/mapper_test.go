@@ -0,0 +1,131 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compliance
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestNewMapper(t *testing.T) {
+	dir := t.TempDir()
+	userMapping := `framework: CIS
+controls:
+  - id: "5.4.1.1"
+    tests:
+      - scannerName: custom
+        testID: PASS-1
+  - id: "99.1"
+    title: Custom control
+    tests:
+      - scannerName: lynis
+        testID: AUTH-9286
+`
+	if err := os.WriteFile(filepath.Join(dir, "custom.yml"), []byte(userMapping), 0o600); err != nil {
+		t.Fatalf("failed to write mapping file: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a mapping"), 0o600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	mapper, err := NewMapper(dir)
+	if err != nil {
+		t.Fatalf("NewMapper() unexpected error: %v", err)
+	}
+
+	cisPasswordExpiration := models.ComplianceControl{
+		Framework: "CIS",
+		ControlID: "5.4.1.1",
+		Title:     utils.PointerTo("Ensure password expiration is 365 days or less"),
+	}
+
+	tests := []struct {
+		name        string
+		scannerName string
+		testID      string
+		want        []models.ComplianceControl
+	}{
+		{
+			name:        "bundled and user mappings are merged",
+			scannerName: "lynis",
+			testID:      "AUTH-9286",
+			want: []models.ComplianceControl{
+				cisPasswordExpiration,
+				{Framework: "CIS", ControlID: "99.1", Title: utils.PointerTo("Custom control")},
+				{Framework: "NIST-800-53", ControlID: "IA-5", Title: utils.PointerTo("Authenticator Management")},
+				{Framework: "PCI-DSS", ControlID: "8.2.4", Title: utils.PointerTo("Change user passwords at least once every 90 days")},
+			},
+		},
+		{
+			name:        "user test added to a bundled control",
+			scannerName: "custom",
+			testID:      "PASS-1",
+			want:        []models.ComplianceControl{cisPasswordExpiration},
+		},
+		{
+			name:        "unmapped test",
+			scannerName: "lynis",
+			testID:      "NAME-4016",
+			want:        nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mapper.ControlsFor(tt.scannerName, tt.testID)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ControlsFor() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewMapperInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		mappings []Mapping
+	}{
+		{
+			name:     "missing framework",
+			mappings: []Mapping{{Controls: []Control{{ID: "1"}}}},
+		},
+		{
+			name:     "missing control id",
+			mappings: []Mapping{{Framework: "CIS", Controls: []Control{{Title: "title"}}}},
+		},
+		{
+			name: "missing test id",
+			mappings: []Mapping{{Framework: "CIS", Controls: []Control{{
+				ID:    "1",
+				Tests: []Test{{ScannerName: "lynis"}},
+			}}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := newMapper(tt.mappings); err == nil {
+				t.Errorf("newMapper() expected error")
+			}
+		})
+	}
+}
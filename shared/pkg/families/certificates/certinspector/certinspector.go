@@ -0,0 +1,112 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certinspector
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openclarity/kubeclarity/shared/pkg/job_manager"
+	"github.com/openclarity/kubeclarity/shared/pkg/utils"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/certificates/certinspector/config"
+	"github.com/openclarity/vmclarity/shared/pkg/families/certificates/common"
+	familiesutils "github.com/openclarity/vmclarity/shared/pkg/families/utils"
+)
+
+const ScannerName = "certinspector"
+
+type Scanner struct {
+	name       string
+	logger     *log.Entry
+	config     config.Config
+	resultChan chan job_manager.Result
+}
+
+func (s *Scanner) Run(sourceType utils.SourceType, userInput string) error {
+	go func() {
+		retResults := common.Results{
+			ScannedInput: userInput,
+			ScannerName:  ScannerName,
+		}
+
+		if !s.isValidInputType(sourceType) {
+			retResults.Error = fmt.Errorf("received invalid input type for certificate inspector: %v", sourceType)
+			s.sendResults(retResults, nil)
+			return
+		}
+
+		files, err := findCandidateFiles(userInput, familiesutils.ExcludedPaths(s.config.ExcludedPaths))
+		if err != nil {
+			s.sendResults(retResults, fmt.Errorf("failed to find certificate files: %v", err))
+			return
+		}
+		s.logger.Infof("Found %d certificate and key files to inspect in %s", len(files), userInput)
+
+		inspector := inspector{
+			now:           time.Now(),
+			expiryWarning: time.Duration(s.config.GetExpiryWarningDays()) * 24 * time.Hour,
+		}
+		for _, file := range files {
+			certificates, err := inspector.inspectFile(file)
+			if err != nil {
+				// A single unreadable file should not fail the whole scan.
+				s.logger.Warnf("Failed to inspect %s: %v", file, err)
+				continue
+			}
+			retResults.Certificates = append(retResults.Certificates, certificates...)
+		}
+
+		s.sendResults(retResults, nil)
+	}()
+
+	return nil
+}
+
+func New(c job_manager.IsConfig, logger *log.Entry, resultChan chan job_manager.Result) job_manager.Job {
+	conf := c.(*common.ScannersConfig) // nolint:forcetypeassert
+	return &Scanner{
+		name:       ScannerName,
+		logger:     logger.Dup().WithField("scanner", ScannerName),
+		config:     conf.CertInspector,
+		resultChan: resultChan,
+	}
+}
+
+func (s *Scanner) isValidInputType(sourceType utils.SourceType) bool {
+	switch sourceType {
+	case utils.DIR, utils.ROOTFS:
+		return true
+	case utils.FILE, utils.IMAGE, utils.SBOM:
+		fallthrough
+	default:
+		s.logger.Infof("source type %v is not supported for certificate inspector, skipping.", sourceType)
+	}
+	return false
+}
+
+func (s *Scanner) sendResults(results common.Results, err error) {
+	if err != nil {
+		s.logger.Error(err)
+		results.Error = err
+	}
+	select {
+	case s.resultChan <- &results:
+	default:
+		s.logger.Error("Failed to send results on channel")
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+// DefaultExpiryWarningDays is used if ExpiryWarningDays is not configured.
+const DefaultExpiryWarningDays = 30
+
+type Config struct {
+	// ExpiryWarningDays is the number of days before the expiry of a
+	// certificate from which it is reported as expiring.
+	ExpiryWarningDays int `yaml:"expiry_warning_days" mapstructure:"expiry_warning_days"`
+	// ExcludedPaths are excluded in addition to the default excluded paths.
+	ExcludedPaths []string `yaml:"excluded_paths" mapstructure:"excluded_paths"`
+}
+
+func (c Config) GetExpiryWarningDays() int {
+	if c.ExpiryWarningDays <= 0 {
+		return DefaultExpiryWarningDays
+	}
+	return c.ExpiryWarningDays
+}
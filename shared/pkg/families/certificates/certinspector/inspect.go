@@ -0,0 +1,309 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certinspector
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/dsa" // nolint:staticcheck
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/certificates/common"
+	"github.com/openclarity/vmclarity/shared/pkg/families/certificates/types"
+)
+
+const (
+	// maxFileSize is the size above which files are not inspected, as
+	// certificate and key files are small.
+	maxFileSize = 1024 * 1024
+
+	minRSAKeySize   = 2048
+	minECDSAKeySize = 256
+)
+
+// candidateExtensions are the extensions of the files which are inspected.
+var candidateExtensions = map[string]bool{
+	".pem":  true,
+	".crt":  true,
+	".cer":  true,
+	".cert": true,
+	".der":  true,
+	".key":  true,
+}
+
+// trustStoreDirs are the directories relative to the scanned root which hold
+// the CA bundles shipped by the OS. Their certificates are managed by the
+// distribution and are not inspected.
+var trustStoreDirs = []string{
+	"etc/ssl/certs",
+	"etc/ca-certificates",
+	"etc/pki/ca-trust",
+	"usr/share/ca-certificates",
+	"usr/share/pki",
+}
+
+// weakSignatureAlgorithms are the signature algorithms which are considered
+// broken for certificates.
+var weakSignatureAlgorithms = map[x509.SignatureAlgorithm]bool{
+	x509.MD2WithRSA:    true,
+	x509.MD5WithRSA:    true,
+	x509.SHA1WithRSA:   true,
+	x509.DSAWithSHA1:   true,
+	x509.ECDSAWithSHA1: true,
+}
+
+// findCandidateFiles returns the files under root which may contain
+// certificates or private keys, skipping the trust stores and the given
+// excluded paths relative to root.
+func findCandidateFiles(root string, excludedPaths []string) ([]string, error) {
+	excluded := make(map[string]bool, len(trustStoreDirs)+len(excludedPaths))
+	for _, dir := range trustStoreDirs {
+		excluded[filepath.Join(root, dir)] = true
+	}
+	for _, path := range excludedPaths {
+		excluded[filepath.Join(root, path)] = true
+	}
+
+	var files []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// Skip unreadable directories instead of failing the walk.
+			if d != nil && d.IsDir() && path != root {
+				return fs.SkipDir
+			}
+			return err
+		}
+		if d.IsDir() {
+			if excluded[path] {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() || !candidateExtensions[strings.ToLower(filepath.Ext(path))] {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil || info.Size() > maxFileSize {
+			return nil // nolint:nilerr
+		}
+		files = append(files, path)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk %s: %w", root, err)
+	}
+
+	return files, nil
+}
+
+type inspector struct {
+	now           time.Time
+	expiryWarning time.Duration
+}
+
+// inspectFile returns the findings of the certificates and private keys in
+// the PEM or DER encoded file.
+func (i inspector) inspectFile(path string) ([]common.Certificate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	var findings []common.Certificate
+	var foundPEM bool
+	for rest := data; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		foundPEM = true
+
+		switch {
+		case block.Type == "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				continue
+			}
+			findings = append(findings, i.inspectCertificate(path, cert)...)
+		case strings.HasSuffix(block.Type, "PRIVATE KEY"):
+			findings = append(findings, i.inspectPrivateKey(path, block)...)
+		}
+	}
+
+	if !foundPEM {
+		// Binary certificates are DER encoded.
+		if cert, err := x509.ParseCertificate(data); err == nil {
+			findings = append(findings, i.inspectCertificate(path, cert)...)
+		}
+	}
+
+	return findings, nil
+}
+
+func (i inspector) inspectCertificate(path string, cert *x509.Certificate) []common.Certificate {
+	keyAlgorithm, keySize := publicKeyInfo(cert.PublicKey)
+	fingerprint := sha256.Sum256(cert.Raw)
+	newFinding := func(findingType types.FindingType, message string) common.Certificate {
+		return common.Certificate{
+			FindingType:        findingType,
+			FilePath:           path,
+			Subject:            cert.Subject.String(),
+			Issuer:             cert.Issuer.String(),
+			SerialNumber:       cert.SerialNumber.Text(16), // nolint:gomnd
+			Fingerprint:        hex.EncodeToString(fingerprint[:]),
+			NotBefore:          &cert.NotBefore,
+			NotAfter:           &cert.NotAfter,
+			KeyAlgorithm:       keyAlgorithm,
+			KeySize:            keySize,
+			SignatureAlgorithm: cert.SignatureAlgorithm.String(),
+			Message:            message,
+		}
+	}
+
+	var findings []common.Certificate
+	switch {
+	case i.now.After(cert.NotAfter):
+		findings = append(findings, newFinding(types.ExpiredCertificate,
+			fmt.Sprintf("Certificate expired on %s", cert.NotAfter.Format(time.RFC3339))))
+	case i.now.Add(i.expiryWarning).After(cert.NotAfter):
+		findings = append(findings, newFinding(types.ExpiringCertificate,
+			fmt.Sprintf("Certificate expires on %s", cert.NotAfter.Format(time.RFC3339))))
+	}
+
+	if reason, weak := isWeakKey(keyAlgorithm, keySize); weak {
+		findings = append(findings, newFinding(types.WeakKey, reason))
+	}
+
+	// The signature of a self-signed certificate is not used to establish
+	// trust in it, so a weak one is not reported.
+	selfSigned := bytes.Equal(cert.RawIssuer, cert.RawSubject)
+	if weakSignatureAlgorithms[cert.SignatureAlgorithm] && !selfSigned {
+		findings = append(findings, newFinding(types.WeakSignatureAlgorithm,
+			fmt.Sprintf("Certificate is signed with the weak signature algorithm %s", cert.SignatureAlgorithm)))
+	}
+
+	return findings
+}
+
+func (i inspector) inspectPrivateKey(path string, block *pem.Block) []common.Certificate {
+	encrypted := block.Type == "ENCRYPTED PRIVATE KEY" || strings.Contains(block.Headers["Proc-Type"], "ENCRYPTED")
+
+	var keyAlgorithm string
+	var keySize int
+	// The fingerprint of the public key is used when the key can be parsed
+	// so that the key can be matched with its certificate.
+	fingerprint := sha256.Sum256(block.Bytes)
+	if !encrypted {
+		if public, ok := parsePublicKey(block); ok {
+			keyAlgorithm, keySize = publicKeyInfo(public)
+			if der, err := x509.MarshalPKIXPublicKey(public); err == nil {
+				fingerprint = sha256.Sum256(der)
+			}
+		} else if block.Type == "DSA PRIVATE KEY" {
+			keyAlgorithm = "DSA"
+		}
+	}
+	newFinding := func(findingType types.FindingType, message string) common.Certificate {
+		return common.Certificate{
+			FindingType:  findingType,
+			FilePath:     path,
+			Fingerprint:  hex.EncodeToString(fingerprint[:]),
+			KeyAlgorithm: keyAlgorithm,
+			KeySize:      keySize,
+			Message:      message,
+		}
+	}
+
+	message := "Unencrypted private key"
+	if encrypted {
+		message = "Encrypted private key"
+	}
+	findings := []common.Certificate{newFinding(types.PrivateKey, message)}
+
+	if reason, weak := isWeakKey(keyAlgorithm, keySize); weak {
+		findings = append(findings, newFinding(types.WeakKey, reason))
+	}
+
+	return findings
+}
+
+// parsePublicKey returns the public key of an unencrypted PEM private key.
+func parsePublicKey(block *pem.Block) (crypto.PublicKey, bool) {
+	var key any
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, false
+	}
+	if err != nil {
+		return nil, false
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, false
+	}
+	return signer.Public(), true
+}
+
+// publicKeyInfo returns the algorithm and the size in bits of the key.
+func publicKeyInfo(key crypto.PublicKey) (string, int) {
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		return "RSA", k.N.BitLen()
+	case *ecdsa.PublicKey:
+		return "ECDSA", k.Curve.Params().BitSize
+	case ed25519.PublicKey:
+		return "Ed25519", ed25519.PublicKeySize * 8 // nolint:gomnd
+	case *dsa.PublicKey:
+		return "DSA", k.P.BitLen()
+	default:
+		return "", 0
+	}
+}
+
+// isWeakKey returns the reason why the key is weak, if it is.
+func isWeakKey(keyAlgorithm string, keySize int) (string, bool) {
+	switch {
+	case keyAlgorithm == "DSA":
+		return "DSA keys are deprecated and considered weak", true
+	case keyAlgorithm == "RSA" && keySize < minRSAKeySize:
+		return fmt.Sprintf("RSA key size %d is smaller than %d bits", keySize, minRSAKeySize), true
+	case keyAlgorithm == "ECDSA" && keySize < minECDSAKeySize:
+		return fmt.Sprintf("ECDSA key size %d is smaller than %d bits", keySize, minECDSAKeySize), true
+	default:
+		return "", false
+	}
+}
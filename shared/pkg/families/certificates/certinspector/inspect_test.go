@@ -0,0 +1,143 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certinspector
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/certificates/common"
+	"github.com/openclarity/vmclarity/shared/pkg/families/certificates/types"
+)
+
+var now = time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+func createCertificatePEM(t *testing.T, notAfter time.Time, key *ecdsa.PrivateKey, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) ([]byte, *x509.Certificate) {
+	t.Helper()
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(notAfter.Unix()),
+		Subject:      pkix.Name{CommonName: notAfter.Format(time.DateOnly)},
+		NotBefore:    now.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	assert.NilError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NilError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), cert
+}
+
+func findingTypes(findings []common.Certificate) []types.FindingType {
+	ret := []types.FindingType{}
+	for _, finding := range findings {
+		ret = append(ret, finding.FindingType)
+	}
+	return ret
+}
+
+func TestInspectFile(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NilError(t, err)
+	weakKey, err := ecdsa.GenerateKey(elliptic.P224(), rand.Reader)
+	assert.NilError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	assert.NilError(t, err)
+
+	valid, _ := createCertificatePEM(t, now.Add(365*24*time.Hour), key, nil, nil)
+	expiring, _ := createCertificatePEM(t, now.Add(10*24*time.Hour), key, nil, nil)
+	expired, _ := createCertificatePEM(t, now.Add(-24*time.Hour), key, nil, nil)
+	_, ca := createCertificatePEM(t, now.Add(365*24*time.Hour), key, nil, nil)
+	weak, _ := createCertificatePEM(t, now.Add(365*24*time.Hour), weakKey, ca, key)
+	ecKeyDER, err := x509.MarshalECPrivateKey(key)
+	assert.NilError(t, err)
+
+	root := t.TempDir()
+	files := map[string][]byte{
+		"valid.crt":     valid,
+		"chain.pem":     append(append([]byte{}, expiring...), expired...),
+		"weak.pem":      weak,
+		"tls.key":       pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: ecKeyDER}),
+		"rsa.key":       pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}),
+		"encrypted.key": pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED PRIVATE KEY", Bytes: []byte("encrypted")}),
+		"invalid.pem":   []byte("not a certificate"),
+	}
+	for name, content := range files {
+		assert.NilError(t, os.WriteFile(filepath.Join(root, name), content, 0o600))
+	}
+
+	i := inspector{
+		now:           now,
+		expiryWarning: 30 * 24 * time.Hour,
+	}
+	want := map[string][]types.FindingType{
+		"valid.crt":     {},
+		"chain.pem":     {types.ExpiringCertificate, types.ExpiredCertificate},
+		"weak.pem":      {types.WeakKey},
+		"tls.key":       {types.PrivateKey},
+		"rsa.key":       {types.PrivateKey, types.WeakKey},
+		"encrypted.key": {types.PrivateKey},
+		"invalid.pem":   {},
+	}
+	for name, wantTypes := range want {
+		findings, err := i.inspectFile(filepath.Join(root, name))
+		assert.NilError(t, err)
+		assert.DeepEqual(t, findingTypes(findings), wantTypes)
+	}
+
+	findings, err := i.inspectFile(filepath.Join(root, "rsa.key"))
+	assert.NilError(t, err)
+	assert.Equal(t, findings[1].KeyAlgorithm, "RSA")
+	assert.Equal(t, findings[1].KeySize, 1024)
+}
+
+func TestFindCandidateFiles(t *testing.T) {
+	root := t.TempDir()
+	for _, name := range []string{
+		"etc/nginx/tls.crt",
+		"etc/nginx/tls.KEY",
+		"etc/nginx/nginx.conf",
+		"etc/ssl/certs/ca-certificates.crt",
+		"usr/share/ca-certificates/mozilla/root.crt",
+		"srv/cache/cached.pem",
+	} {
+		path := filepath.Join(root, name)
+		assert.NilError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+		assert.NilError(t, os.WriteFile(path, []byte("content"), 0o600))
+	}
+
+	files, err := findCandidateFiles(root, []string{"srv/cache"})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, files, []string{
+		filepath.Join(root, "etc/nginx/tls.KEY"),
+		filepath.Join(root, "etc/nginx/tls.crt"),
+	})
+}
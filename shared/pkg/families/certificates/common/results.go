@@ -0,0 +1,48 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"time"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/certificates/types"
+)
+
+type Results struct {
+	Certificates []Certificate
+	ScannedInput string
+	ScannerName  string
+	Error        error
+}
+
+type Certificate struct {
+	FindingType        types.FindingType `json:"findingType,omitempty"`
+	FilePath           string            `json:"filePath,omitempty"`
+	Subject            string            `json:"subject,omitempty"`
+	Issuer             string            `json:"issuer,omitempty"`
+	SerialNumber       string            `json:"serialNumber,omitempty"`
+	Fingerprint        string            `json:"fingerprint,omitempty"`
+	NotBefore          *time.Time        `json:"notBefore,omitempty"`
+	NotAfter           *time.Time        `json:"notAfter,omitempty"`
+	KeyAlgorithm       string            `json:"keyAlgorithm,omitempty"`
+	KeySize            int               `json:"keySize,omitempty"`
+	SignatureAlgorithm string            `json:"signatureAlgorithm,omitempty"`
+	Message            string            `json:"message,omitempty"`
+}
+
+func (r *Results) GetError() error {
+	return r.Error
+}
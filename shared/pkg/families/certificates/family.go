@@ -0,0 +1,89 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certificates
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openclarity/kubeclarity/shared/pkg/job_manager"
+	"github.com/openclarity/kubeclarity/shared/pkg/utils"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/certificates/common"
+	"github.com/openclarity/vmclarity/shared/pkg/families/certificates/job"
+	familiesinterface "github.com/openclarity/vmclarity/shared/pkg/families/interfaces"
+	familiesresults "github.com/openclarity/vmclarity/shared/pkg/families/results"
+	"github.com/openclarity/vmclarity/shared/pkg/families/types"
+	familiesutils "github.com/openclarity/vmclarity/shared/pkg/families/utils"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+)
+
+type Certificates struct {
+	conf Config
+}
+
+func (c Certificates) Run(ctx context.Context, _ *familiesresults.Results) (familiesinterface.IsResults, error) {
+	logger := log.GetLoggerFromContextOrDiscard(ctx).WithField("family", "certificates")
+	logger.Info("Certificates Run...")
+
+	manager := job_manager.New(c.conf.ScannersList, c.conf.ScannersConfig, logger, job.Factory)
+	mergedResults := NewMergedResults()
+
+	for i, input := range c.conf.Inputs {
+		results, err := manager.Run(utils.SourceType(input.InputType), input.Input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan input %q for certificates: %v", input.Input, err)
+		}
+
+		// Merge results.
+		for name, result := range results {
+			logger.Infof("Merging result from %q", name)
+			scannerResult := result.(*common.Results) // nolint:forcetypeassert
+			if familiesutils.ShouldStripInputPath(input.StripPathFromResult, c.conf.StripInputPaths) {
+				scannerResult = StripPathFromResult(scannerResult, input.Input)
+			}
+			mergedResults = mergedResults.Merge(scannerResult)
+		}
+
+		familiesutils.ReportProgress(ctx, i+1, len(c.conf.Inputs))
+	}
+
+	logger.Info("Certificates Done...")
+	return &Results{
+		MergedResults: mergedResults,
+	}, nil
+}
+
+// StripPathFromResult strip input path from results wherever it is found.
+func StripPathFromResult(result *common.Results, path string) *common.Results {
+	for i := range result.Certificates {
+		result.Certificates[i].FilePath = familiesutils.TrimMountPath(result.Certificates[i].FilePath, path)
+	}
+	return result
+}
+
+func (c Certificates) GetType() types.FamilyType {
+	return types.Certificates
+}
+
+// ensure types implement the requisite interfaces.
+var _ familiesinterface.Family = &Certificates{}
+
+func New(conf Config) *Certificates {
+	return &Certificates{
+		conf: conf,
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certificates
+
+import (
+	"github.com/openclarity/vmclarity/shared/pkg/families/certificates/common"
+)
+
+type MergedResults struct {
+	Certificates []common.Certificate
+}
+
+func NewMergedResults() *MergedResults {
+	return &MergedResults{
+		Certificates: []common.Certificate{},
+	}
+}
+
+func (m *MergedResults) Merge(other *common.Results) *MergedResults {
+	m.Certificates = append(m.Certificates, other.Certificates...)
+
+	return m
+}
@@ -0,0 +1,96 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cisbenchmark
+
+import (
+	"fmt"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/compliance/common"
+	"github.com/openclarity/vmclarity/shared/pkg/families/compliance/types"
+)
+
+// check is a check of a benchmark performed on the files of the target.
+type check struct {
+	id          string
+	title       string
+	remediation string
+	// run returns the failures of the check on the filesystem mounted at
+	// root, or none if the check passed or doesn't apply to the target.
+	run func(root string) ([]failure, error)
+}
+
+// failure describes why a check failed on the file at path, which is the
+// absolute path of the file in the target.
+type failure struct {
+	path    string
+	message string
+}
+
+type benchmark struct {
+	name types.Benchmark
+	// applies returns whether the benchmark applies to the target, all the
+	// checks of the benchmark are skipped otherwise.
+	applies func(root string) (bool, error)
+	checks  []check
+}
+
+var benchmarks = []benchmark{
+	{
+		name:    types.CISLinux,
+		applies: func(string) (bool, error) { return true, nil },
+		checks:  linuxChecks,
+	},
+	{
+		name:    types.CISDocker,
+		applies: isDockerInstalled,
+		checks:  dockerChecks,
+	},
+}
+
+// runBenchmark returns the failed checks of the benchmark on the filesystem
+// mounted at root. A check which can't be performed is skipped, the errors of
+// the skipped checks are returned with the failed checks.
+func runBenchmark(b benchmark, root string) ([]common.Check, []error) {
+	applies, err := b.applies(root)
+	if err != nil {
+		return nil, []error{fmt.Errorf("failed to check whether %s applies: %w", b.name, err)}
+	}
+	if !applies {
+		return nil, nil
+	}
+
+	var checks []common.Check
+	var errs []error
+	for _, c := range b.checks {
+		failures, err := c.run(root)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to run check %s %s: %w", b.name, c.id, err))
+			continue
+		}
+		for _, f := range failures {
+			checks = append(checks, common.Check{
+				Benchmark:   b.name,
+				CheckID:     c.id,
+				Title:       c.title,
+				FilePath:    rootPath(root, f.path),
+				Message:     f.message,
+				Remediation: c.remediation,
+			})
+		}
+	}
+
+	return checks, errs
+}
@@ -0,0 +1,154 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cisbenchmark
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/compliance/common"
+)
+
+type testFile struct {
+	content string
+	perm    os.FileMode
+}
+
+func createRootfs(t *testing.T, files map[string]testFile) string {
+	t.Helper()
+
+	root := t.TempDir()
+	for name, file := range files {
+		path := filepath.Join(root, name)
+		assert.NilError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		assert.NilError(t, os.WriteFile(path, []byte(file.content), file.perm))
+		// Set the permissions regardless of the umask.
+		assert.NilError(t, os.Chmod(path, file.perm))
+	}
+
+	return root
+}
+
+func failedCheckIDs(checks []common.Check) []string {
+	ids := []string{}
+	for _, c := range checks {
+		ids = append(ids, c.CheckID)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func compliantLinuxFiles() map[string]testFile {
+	return map[string]testFile{
+		"etc/security/limits.conf": {content: "* hard core 0\n", perm: 0o644},
+		"etc/sysctl.d/99-cis.conf": {
+			content: "fs.suid_dumpable = 0\nnet.ipv4.conf.all.accept_redirects = 0\nnet/ipv4/conf/default/accept_redirects = 0\n",
+			perm:    0o644,
+		},
+		"etc/ssh/sshd_config": {
+			content: "Include /etc/ssh/sshd_config.d/*.conf\nPermitRootLogin no\nMaxAuthTries 4\nMatch User backup\n  PermitRootLogin yes\n",
+			perm:    0o600,
+		},
+		"etc/ssh/sshd_config.d/10-cis.conf": {content: "PermitUserEnvironment=no\n", perm: 0o644},
+		"etc/login.defs":                    {content: "PASS_MAX_DAYS 90\nPASS_MIN_DAYS 7\nPASS_WARN_AGE 7\n", perm: 0o644},
+		"etc/passwd":                        {content: "root:x:0:0:root:/root:/bin/bash\nuser:x:1000:1000::/home/user:/bin/bash\n", perm: 0o644},
+		"etc/shadow":                        {content: "root:!:19000::::::\nuser:$6$hash:19000:7:90:7:::\n", perm: 0o640},
+		"etc/group":                         {content: "root:x:0:\n", perm: 0o644},
+	}
+}
+
+func TestLinuxBenchmark(t *testing.T) {
+	t.Run("compliant", func(t *testing.T) {
+		root := createRootfs(t, compliantLinuxFiles())
+
+		checks, errs := runBenchmark(benchmarks[0], root)
+		assert.Equal(t, len(errs), 0)
+		assert.DeepEqual(t, failedCheckIDs(checks), []string{})
+	})
+
+	t.Run("non compliant", func(t *testing.T) {
+		files := compliantLinuxFiles()
+		files["etc/security/limits.conf"] = testFile{content: "* soft core 0\n", perm: 0o644}
+		files["etc/sysctl.conf"] = testFile{content: "net.ipv4.ip_forward = 1\nfs.suid_dumpable = 0\n", perm: 0o644}
+		files["etc/ssh/sshd_config"] = testFile{content: "PermitRootLogin yes\nX11Forwarding yes\n", perm: 0o644}
+		files["etc/login.defs"] = testFile{content: "PASS_MAX_DAYS 99999\nPASS_MIN_DAYS 0\n", perm: 0o644}
+		files["etc/passwd"] = testFile{content: "root:x:0:0:root:/root:/bin/bash\ntoor:x:0:0::/root:/bin/bash\n+::::::\n", perm: 0o666}
+		files["etc/shadow"] = testFile{content: "root:!:19000::::::\ntoor::19000::::::\n", perm: 0o644}
+
+		root := createRootfs(t, files)
+
+		checks, errs := runBenchmark(benchmarks[0], root)
+		assert.Equal(t, len(errs), 0)
+		assert.DeepEqual(t, failedCheckIDs(checks), []string{
+			"1.5.1", "3.1.1", "5.2.1", "5.2.10", "5.2.6", "5.2.7",
+			"5.4.1.1", "5.4.1.2", "5.4.1.3", "6.1.2", "6.1.3", "6.2.1", "6.2.2", "6.2.5",
+		})
+
+		for _, c := range checks {
+			if c.CheckID == "3.1.1" {
+				assert.Equal(t, c.FilePath, filepath.Join(root, "etc/sysctl.conf"))
+				assert.Equal(t, c.Message, "net.ipv4.ip_forward is set to 1, expected 0")
+			}
+		}
+	})
+}
+
+func TestDockerBenchmark(t *testing.T) {
+	t.Run("docker not installed", func(t *testing.T) {
+		checks, errs := runBenchmark(benchmarks[1], createRootfs(t, nil))
+		assert.Equal(t, len(errs), 0)
+		assert.DeepEqual(t, failedCheckIDs(checks), []string{})
+	})
+
+	t.Run("compliant", func(t *testing.T) {
+		root := createRootfs(t, map[string]testFile{
+			"etc/docker/daemon.json": {
+				content: `{"icc": false, "live-restore": true, "userland-proxy": false, "no-new-privileges": true, "userns-remap": "default", "log-level": "info"}`,
+				perm:    0o644,
+			},
+			"usr/lib/systemd/system/docker.service": {content: "[Service]\n", perm: 0o644},
+		})
+
+		checks, errs := runBenchmark(benchmarks[1], root)
+		assert.Equal(t, len(errs), 0)
+		assert.DeepEqual(t, failedCheckIDs(checks), []string{})
+	})
+
+	t.Run("default configuration", func(t *testing.T) {
+		root := createRootfs(t, map[string]testFile{
+			"usr/bin/dockerd":                   {content: "", perm: 0o755},
+			"lib/systemd/system/docker.service": {content: "[Service]\n", perm: 0o666},
+		})
+
+		checks, errs := runBenchmark(benchmarks[1], root)
+		assert.Equal(t, len(errs), 0)
+		assert.DeepEqual(t, failedCheckIDs(checks), []string{"2.15", "2.16", "2.18", "2.2", "2.9", "3.2"})
+	})
+
+	t.Run("invalid daemon configuration", func(t *testing.T) {
+		root := createRootfs(t, map[string]testFile{
+			"etc/docker/daemon.json": {content: `{"icc": false`, perm: 0o644},
+		})
+
+		checks, errs := runBenchmark(benchmarks[1], root)
+		assert.Equal(t, len(errs), 7)
+		assert.DeepEqual(t, failedCheckIDs(checks), []string{})
+	})
+}
@@ -0,0 +1,115 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cisbenchmark
+
+import (
+	"fmt"
+
+	"github.com/openclarity/kubeclarity/shared/pkg/job_manager"
+	"github.com/openclarity/kubeclarity/shared/pkg/utils"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/compliance/cisbenchmark/config"
+	"github.com/openclarity/vmclarity/shared/pkg/families/compliance/common"
+)
+
+const ScannerName = "cisbenchmark"
+
+type Scanner struct {
+	name       string
+	logger     *log.Entry
+	config     config.Config
+	resultChan chan job_manager.Result
+}
+
+func (s *Scanner) Run(sourceType utils.SourceType, userInput string) error {
+	go func() {
+		retResults := common.Results{
+			ScannedInput: userInput,
+			ScannerName:  ScannerName,
+		}
+
+		if !s.isValidInputType(sourceType) {
+			retResults.Error = fmt.Errorf("received invalid input type for CIS benchmark scanner: %v", sourceType)
+			s.sendResults(retResults, nil)
+			return
+		}
+
+		for _, b := range benchmarks {
+			if !s.isEnabled(string(b.name)) {
+				continue
+			}
+
+			checks, errs := runBenchmark(b, userInput)
+			for _, err := range errs {
+				// A check which can't be performed should not fail the whole scan.
+				s.logger.Warn(err)
+			}
+			s.logger.Infof("%d checks of %s failed in %s", len(checks), b.name, userInput)
+			retResults.Checks = append(retResults.Checks, checks...)
+		}
+
+		s.sendResults(retResults, nil)
+	}()
+
+	return nil
+}
+
+func New(c job_manager.IsConfig, logger *log.Entry, resultChan chan job_manager.Result) job_manager.Job {
+	conf := c.(*common.ScannersConfig) // nolint:forcetypeassert
+	return &Scanner{
+		name:       ScannerName,
+		logger:     logger.Dup().WithField("scanner", ScannerName),
+		config:     conf.CISBenchmark,
+		resultChan: resultChan,
+	}
+}
+
+func (s *Scanner) isEnabled(benchmark string) bool {
+	if len(s.config.Benchmarks) == 0 {
+		return true
+	}
+	for _, b := range s.config.Benchmarks {
+		if b == benchmark {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Scanner) isValidInputType(sourceType utils.SourceType) bool {
+	switch sourceType {
+	case utils.DIR, utils.ROOTFS:
+		return true
+	case utils.FILE, utils.IMAGE, utils.SBOM:
+		fallthrough
+	default:
+		s.logger.Infof("source type %v is not supported for CIS benchmark scanner, skipping.", sourceType)
+	}
+	return false
+}
+
+func (s *Scanner) sendResults(results common.Results, err error) {
+	if err != nil {
+		s.logger.Error(err)
+		results.Error = err
+	}
+	select {
+	case s.resultChan <- &results:
+	default:
+		s.logger.Error("Failed to send results on channel")
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cisbenchmark
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+)
+
+const (
+	dockerConfigDir      = "/etc/docker"
+	dockerDaemonJSONPath = "/etc/docker/daemon.json"
+)
+
+// dockerBinaryPaths are the locations of the Docker daemon binary, Docker is
+// considered installed if any of them exists.
+var dockerBinaryPaths = []string{
+	"/usr/bin/dockerd",
+	"/usr/sbin/dockerd",
+	"/usr/local/bin/dockerd",
+}
+
+// dockerChecks are the checks of the CIS Docker benchmark which can be
+// performed on the files of the target.
+var dockerChecks = []check{
+	{
+		id:          "2.2",
+		title:       "Ensure network traffic is restricted between containers on the default bridge",
+		remediation: "Set \"icc\": false in /etc/docker/daemon.json.",
+		run:         checkDockerDaemonOption("icc", false),
+	},
+	{
+		id:          "2.3",
+		title:       "Ensure the logging level is set to 'info'",
+		remediation: "Remove \"log-level\" from /etc/docker/daemon.json or set it to \"info\".",
+		run:         checkDockerLogLevel,
+	},
+	{
+		id:          "2.5",
+		title:       "Ensure insecure registries are not used",
+		remediation: "Remove \"insecure-registries\" from /etc/docker/daemon.json.",
+		run:         checkDockerInsecureRegistries,
+	},
+	{
+		id:          "2.9",
+		title:       "Enable user namespace support",
+		remediation: "Set \"userns-remap\": \"default\" in /etc/docker/daemon.json.",
+		run:         checkDockerUsernsRemap,
+	},
+	{
+		id:          "2.15",
+		title:       "Ensure live restore is enabled",
+		remediation: "Set \"live-restore\": true in /etc/docker/daemon.json.",
+		run:         checkDockerDaemonOption("live-restore", true),
+	},
+	{
+		id:          "2.16",
+		title:       "Ensure Userland Proxy is Disabled",
+		remediation: "Set \"userland-proxy\": false in /etc/docker/daemon.json.",
+		run:         checkDockerDaemonOption("userland-proxy", false),
+	},
+	{
+		id:          "2.18",
+		title:       "Ensure that containers are restricted from acquiring new privileges",
+		remediation: "Set \"no-new-privileges\": true in /etc/docker/daemon.json.",
+		run:         checkDockerDaemonOption("no-new-privileges", true),
+	},
+	{
+		id:          "3.2",
+		title:       "Ensure that docker.service file permissions are appropriately set",
+		remediation: "Run 'chmod 644' on the docker.service file.",
+		run: joinChecks(
+			checkPermissions("/lib/systemd/system/docker.service", 0o644),
+			checkPermissions("/usr/lib/systemd/system/docker.service", 0o644),
+			checkPermissions("/etc/systemd/system/docker.service", 0o644),
+		),
+	},
+	{
+		id:          "3.6",
+		title:       "Ensure that /etc/docker directory permissions are set to 755 or more restrictively",
+		remediation: "Run 'chmod 755 /etc/docker'.",
+		run:         checkPermissions(dockerConfigDir, 0o755),
+	},
+	{
+		id:          "3.18",
+		title:       "Ensure that daemon.json file permissions are set to 644 or more restrictive",
+		remediation: "Run 'chmod 644 /etc/docker/daemon.json'.",
+		run:         checkPermissions(dockerDaemonJSONPath, 0o644),
+	},
+	{
+		id:          "3.22",
+		title:       "Ensure that the /etc/default/docker file permissions are set to 644 or more restrictively",
+		remediation: "Run 'chmod 644 /etc/default/docker'.",
+		run:         checkPermissions("/etc/default/docker", 0o644),
+	},
+}
+
+func isDockerInstalled(root string) (bool, error) {
+	for _, p := range append([]string{dockerConfigDir}, dockerBinaryPaths...) {
+		_, err := os.Lstat(rootPath(root, p))
+		if err == nil {
+			return true, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return false, fmt.Errorf("failed to stat %s: %w", p, err)
+		}
+	}
+
+	return false, nil
+}
+
+// readDockerDaemonConfig returns the options of the Docker daemon
+// configuration file, which are empty if it doesn't exist.
+func readDockerDaemonConfig(root string) (map[string]interface{}, error) {
+	content, err := os.ReadFile(rootPath(root, dockerDaemonJSONPath))
+	if errors.Is(err, fs.ErrNotExist) {
+		return map[string]interface{}{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", dockerDaemonJSONPath, err)
+	}
+
+	options := map[string]interface{}{}
+	if err := json.Unmarshal(content, &options); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", dockerDaemonJSONPath, err)
+	}
+
+	return options, nil
+}
+
+// checkDockerDaemonOption returns a check function which fails if the boolean
+// option of the Docker daemon is not set to expected. The defaults of these
+// options are insecure, so the check fails if the option is not set as well.
+func checkDockerDaemonOption(option string, expected bool) func(root string) ([]failure, error) {
+	return func(root string) ([]failure, error) {
+		options, err := readDockerDaemonConfig(root)
+		if err != nil {
+			return nil, err
+		}
+
+		value, ok := options[option]
+		if ok && value == expected {
+			return nil, nil
+		}
+
+		message := fmt.Sprintf("%s is not set, expected %t", option, expected)
+		if ok {
+			message = fmt.Sprintf("%s is %v, expected %t", option, value, expected)
+		}
+
+		return []failure{{
+			path:    dockerDaemonJSONPath,
+			message: message,
+		}}, nil
+	}
+}
+
+func checkDockerLogLevel(root string) ([]failure, error) {
+	options, err := readDockerDaemonConfig(root)
+	if err != nil {
+		return nil, err
+	}
+
+	value, ok := options["log-level"]
+	if !ok || value == "info" {
+		return nil, nil
+	}
+
+	return []failure{{
+		path:    dockerDaemonJSONPath,
+		message: fmt.Sprintf("log-level is %v, expected info", value),
+	}}, nil
+}
+
+func checkDockerInsecureRegistries(root string) ([]failure, error) {
+	options, err := readDockerDaemonConfig(root)
+	if err != nil {
+		return nil, err
+	}
+
+	registries, _ := options["insecure-registries"].([]interface{})
+	if len(registries) == 0 {
+		return nil, nil
+	}
+
+	return []failure{{
+		path:    dockerDaemonJSONPath,
+		message: fmt.Sprintf("Insecure registries are used: %v", registries),
+	}}, nil
+}
+
+func checkDockerUsernsRemap(root string) ([]failure, error) {
+	options, err := readDockerDaemonConfig(root)
+	if err != nil {
+		return nil, err
+	}
+
+	if remap, _ := options["userns-remap"].(string); remap != "" {
+		return nil, nil
+	}
+
+	return []failure{{
+		path:    dockerDaemonJSONPath,
+		message: "userns-remap is not set, user namespace support is disabled",
+	}}, nil
+}
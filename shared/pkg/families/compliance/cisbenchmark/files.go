@@ -0,0 +1,115 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cisbenchmark
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// rootPath returns the path of the absolute path p of the target in the
+// filesystem mounted at root.
+func rootPath(root, p string) string {
+	return filepath.Join(root, filepath.FromSlash(path.Clean("/"+p)))
+}
+
+// readLines returns the lines of the file at p in the filesystem mounted at
+// root without the comments and blank lines. It returns false if the file
+// doesn't exist.
+func readLines(root, p string) ([]string, bool, error) {
+	file, err := os.Open(rootPath(root, p))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to open %s: %w", p, err)
+	}
+	defer file.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, true, fmt.Errorf("failed to read %s: %w", p, err)
+	}
+
+	return lines, true, nil
+}
+
+// globFiles returns the paths of the target matching pattern, sorted by name.
+func globFiles(root, pattern string) ([]string, error) {
+	matches, err := filepath.Glob(rootPath(root, pattern))
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern %s: %w", pattern, err)
+	}
+
+	files := make([]string, 0, len(matches))
+	for _, match := range matches {
+		rel, err := filepath.Rel(root, match)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get path of %s: %w", match, err)
+		}
+		files = append(files, "/"+filepath.ToSlash(rel))
+	}
+	sort.Strings(files)
+
+	return files, nil
+}
+
+// checkPermissions returns a check function which fails if the file at p has
+// any permission which isn't in maxPerm. Missing files and symlinks, which may
+// point outside of the mounted filesystem, are not checked.
+func checkPermissions(p string, maxPerm fs.FileMode) func(root string) ([]failure, error) {
+	return func(root string) ([]failure, error) {
+		info, err := os.Lstat(rootPath(root, p))
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to stat %s: %w", p, err)
+		}
+		if info.Mode()&fs.ModeSymlink != 0 {
+			return nil, nil
+		}
+
+		perm := info.Mode().Perm()
+		if perm&^maxPerm == 0 {
+			return nil, nil
+		}
+
+		return []failure{{
+			path:    p,
+			message: fmt.Sprintf("Permissions of %s are %04o, expected %04o or more restrictive", p, perm, maxPerm),
+		}}, nil
+	}
+}
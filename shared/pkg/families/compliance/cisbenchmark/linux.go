@@ -0,0 +1,526 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cisbenchmark
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+)
+
+const (
+	sshdConfigPath = "/etc/ssh/sshd_config"
+	loginDefsPath  = "/etc/login.defs"
+	passwdPath     = "/etc/passwd"
+	shadowPath     = "/etc/shadow"
+	sysctlConfPath = "/etc/sysctl.conf"
+
+	// maxSSHDIncludeDepth limits the nesting of Include directives in the
+	// sshd configuration.
+	maxSSHDIncludeDepth = 8
+)
+
+// linuxChecks are the checks of the CIS Distribution Independent Linux
+// benchmark which can be performed on the files of the target.
+var linuxChecks = []check{
+	{
+		id:          "1.5.1",
+		title:       "Ensure core dumps are restricted",
+		remediation: "Add '* hard core 0' to /etc/security/limits.conf and set fs.suid_dumpable = 0 in /etc/sysctl.conf.",
+		run:         checkCoreDumps,
+	},
+	{
+		id:          "1.5.3",
+		title:       "Ensure address space layout randomization (ASLR) is enabled",
+		remediation: "Set kernel.randomize_va_space = 2 in /etc/sysctl.conf.",
+		run:         checkSysctl("kernel.randomize_va_space", "2", false),
+	},
+	{
+		id:          "3.1.1",
+		title:       "Ensure IP forwarding is disabled",
+		remediation: "Set net.ipv4.ip_forward = 0 in /etc/sysctl.conf.",
+		run:         checkSysctl("net.ipv4.ip_forward", "0", false),
+	},
+	{
+		id:          "3.2.2",
+		title:       "Ensure ICMP redirects are not accepted",
+		remediation: "Set net.ipv4.conf.all.accept_redirects = 0 and net.ipv4.conf.default.accept_redirects = 0 in /etc/sysctl.conf.",
+		run: joinChecks(
+			checkSysctl("net.ipv4.conf.all.accept_redirects", "0", true),
+			checkSysctl("net.ipv4.conf.default.accept_redirects", "0", true),
+		),
+	},
+	{
+		id:          "5.2.1",
+		title:       "Ensure permissions on /etc/ssh/sshd_config are configured",
+		remediation: "Run 'chmod og-rwx /etc/ssh/sshd_config'.",
+		run:         checkPermissions(sshdConfigPath, 0o600),
+	},
+	{
+		id:          "5.2.6",
+		title:       "Ensure SSH X11 forwarding is disabled",
+		remediation: "Set 'X11Forwarding no' in /etc/ssh/sshd_config.",
+		run:         checkSSHDOption("X11Forwarding", "no", "no"),
+	},
+	{
+		id:          "5.2.7",
+		title:       "Ensure SSH MaxAuthTries is set to 4 or less",
+		remediation: "Set 'MaxAuthTries 4' in /etc/ssh/sshd_config.",
+		run:         checkSSHDMaxAuthTries,
+	},
+	{
+		id:          "5.2.8",
+		title:       "Ensure SSH IgnoreRhosts is enabled",
+		remediation: "Set 'IgnoreRhosts yes' in /etc/ssh/sshd_config.",
+		run:         checkSSHDOption("IgnoreRhosts", "yes", "yes"),
+	},
+	{
+		id:          "5.2.9",
+		title:       "Ensure SSH HostbasedAuthentication is disabled",
+		remediation: "Set 'HostbasedAuthentication no' in /etc/ssh/sshd_config.",
+		run:         checkSSHDOption("HostbasedAuthentication", "no", "no"),
+	},
+	{
+		id:          "5.2.10",
+		title:       "Ensure SSH root login is disabled",
+		remediation: "Set 'PermitRootLogin no' in /etc/ssh/sshd_config.",
+		run:         checkSSHDOption("PermitRootLogin", "no", "prohibit-password"),
+	},
+	{
+		id:          "5.2.11",
+		title:       "Ensure SSH PermitEmptyPasswords is disabled",
+		remediation: "Set 'PermitEmptyPasswords no' in /etc/ssh/sshd_config.",
+		run:         checkSSHDOption("PermitEmptyPasswords", "no", "no"),
+	},
+	{
+		id:          "5.2.12",
+		title:       "Ensure SSH PermitUserEnvironment is disabled",
+		remediation: "Set 'PermitUserEnvironment no' in /etc/ssh/sshd_config.",
+		run:         checkSSHDOption("PermitUserEnvironment", "no", "no"),
+	},
+	{
+		id:          "5.4.1.1",
+		title:       "Ensure password expiration is 365 days or less",
+		remediation: "Set 'PASS_MAX_DAYS 365' in /etc/login.defs.",
+		run:         checkLoginDefs("PASS_MAX_DAYS", func(days int) bool { return days <= 365 }, "365 or less"),
+	},
+	{
+		id:          "5.4.1.2",
+		title:       "Ensure minimum days between password changes is 7 or more",
+		remediation: "Set 'PASS_MIN_DAYS 7' in /etc/login.defs.",
+		run:         checkLoginDefs("PASS_MIN_DAYS", func(days int) bool { return days >= 7 }, "7 or more"),
+	},
+	{
+		id:          "5.4.1.3",
+		title:       "Ensure password expiration warning days is 7 or more",
+		remediation: "Set 'PASS_WARN_AGE 7' in /etc/login.defs.",
+		run:         checkLoginDefs("PASS_WARN_AGE", func(days int) bool { return days >= 7 }, "7 or more"),
+	},
+	{
+		id:          "6.1.2",
+		title:       "Ensure permissions on /etc/passwd are configured",
+		remediation: "Run 'chmod 644 /etc/passwd'.",
+		run:         checkPermissions(passwdPath, 0o644),
+	},
+	{
+		id:          "6.1.3",
+		title:       "Ensure permissions on /etc/shadow are configured",
+		remediation: "Run 'chmod o-rwx,g-wx /etc/shadow'.",
+		run:         checkPermissions(shadowPath, 0o640),
+	},
+	{
+		id:          "6.1.4",
+		title:       "Ensure permissions on /etc/group are configured",
+		remediation: "Run 'chmod 644 /etc/group'.",
+		run:         checkPermissions("/etc/group", 0o644),
+	},
+	{
+		id:          "6.1.5",
+		title:       "Ensure permissions on /etc/gshadow are configured",
+		remediation: "Run 'chmod o-rwx,g-wx /etc/gshadow'.",
+		run:         checkPermissions("/etc/gshadow", 0o640),
+	},
+	{
+		id:          "6.2.1",
+		title:       "Ensure password fields are not empty",
+		remediation: "Lock the accounts without a password with 'passwd -l <username>'.",
+		run:         checkEmptyPasswords,
+	},
+	{
+		id:          "6.2.2",
+		title:       "Ensure no legacy \"+\" entries exist in /etc/passwd",
+		remediation: "Remove the lines starting with '+' from /etc/passwd.",
+		run:         checkLegacyPasswdEntries,
+	},
+	{
+		id:          "6.2.5",
+		title:       "Ensure root is the only UID 0 account",
+		remediation: "Remove the accounts other than root with UID 0 or assign them a new UID.",
+		run:         checkUID0Accounts,
+	},
+}
+
+// joinChecks returns a check function which returns the failures of all the
+// given check functions.
+func joinChecks(runs ...func(root string) ([]failure, error)) func(root string) ([]failure, error) {
+	return func(root string) ([]failure, error) {
+		var failures []failure
+		for _, run := range runs {
+			f, err := run(root)
+			if err != nil {
+				return nil, err
+			}
+			failures = append(failures, f...)
+		}
+		return failures, nil
+	}
+}
+
+// sysctlParam is a kernel parameter set in the sysctl configuration file at
+// path.
+type sysctlParam struct {
+	value string
+	path  string
+}
+
+// readSysctlConfig returns the kernel parameters set in the sysctl
+// configuration files, the value of the file applied last at boot is used.
+func readSysctlConfig(root string) (map[string]sysctlParam, error) {
+	var files []string
+	for _, pattern := range []string{"/usr/lib/sysctl.d/*.conf", "/etc/sysctl.d/*.conf"} {
+		matches, err := globFiles(root, pattern)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, matches...)
+	}
+	files = append(files, sysctlConfPath)
+
+	params := map[string]sysctlParam{}
+	for _, file := range files {
+		lines, _, err := readLines(root, file)
+		if err != nil {
+			return nil, err
+		}
+		for _, line := range lines {
+			key, value, ok := strings.Cut(line, "=")
+			if !ok {
+				continue
+			}
+			key = strings.ReplaceAll(strings.TrimPrefix(strings.TrimSpace(key), "-"), "/", ".")
+			params[key] = sysctlParam{value: strings.TrimSpace(value), path: file}
+		}
+	}
+
+	return params, nil
+}
+
+// checkSysctl returns a check function which fails if the kernel parameter is
+// configured to a value other than expected. If required is set the check
+// fails if the parameter isn't configured as well, as its default is insecure.
+func checkSysctl(key, expected string, required bool) func(root string) ([]failure, error) {
+	return func(root string) ([]failure, error) {
+		params, err := readSysctlConfig(root)
+		if err != nil {
+			return nil, err
+		}
+
+		param, ok := params[key]
+		switch {
+		case !ok && required:
+			return []failure{{
+				path:    sysctlConfPath,
+				message: fmt.Sprintf("%s is not configured, expected %s", key, expected),
+			}}, nil
+		case ok && param.value != expected:
+			return []failure{{
+				path:    param.path,
+				message: fmt.Sprintf("%s is set to %s, expected %s", key, param.value, expected),
+			}}, nil
+		default:
+			return nil, nil
+		}
+	}
+}
+
+func checkCoreDumps(root string) ([]failure, error) {
+	files, err := globFiles(root, "/etc/security/limits.d/*.conf")
+	if err != nil {
+		return nil, err
+	}
+	files = append([]string{"/etc/security/limits.conf"}, files...)
+
+	var restricted bool
+	for _, file := range files {
+		lines, _, err := readLines(root, file)
+		if err != nil {
+			return nil, err
+		}
+		for _, line := range lines {
+			fields := strings.Fields(line)
+			if len(fields) == 4 && fields[0] == "*" && fields[1] == "hard" && fields[2] == "core" && fields[3] == "0" {
+				restricted = true
+			}
+		}
+	}
+
+	var failures []failure
+	if !restricted {
+		failures = append(failures, failure{
+			path:    "/etc/security/limits.conf",
+			message: "Core dumps are not limited to 0 for all users",
+		})
+	}
+
+	suidDumpable, err := checkSysctl("fs.suid_dumpable", "0", true)(root)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(failures, suidDumpable...), nil
+}
+
+// readSSHDConfig returns the global options of the sshd configuration keyed by
+// their lowercase name. The first value of an option is used like sshd does.
+// It returns false if sshd is not configured.
+func readSSHDConfig(root string) (map[string]string, bool, error) {
+	options := map[string]string{}
+	parser := sshdConfigParser{root: root, options: options}
+	exists, err := parser.parse(sshdConfigPath, 0)
+	if err != nil {
+		return nil, exists, err
+	}
+
+	return options, exists, nil
+}
+
+type sshdConfigParser struct {
+	root    string
+	options map[string]string
+	// match is set once a Match block is reached, the options following it
+	// only apply conditionally.
+	match bool
+}
+
+func (p *sshdConfigParser) parse(file string, depth int) (bool, error) {
+	if depth > maxSSHDIncludeDepth {
+		return true, fmt.Errorf("too many nested includes in %s", file)
+	}
+
+	lines, exists, err := readLines(p.root, file)
+	if err != nil || !exists {
+		return exists, err
+	}
+
+	for _, line := range lines {
+		if p.match {
+			break
+		}
+
+		key, value := splitSSHDOption(line)
+		switch key {
+		case "match":
+			p.match = true
+		case "include":
+			for _, pattern := range strings.Fields(value) {
+				if !path.IsAbs(pattern) {
+					pattern = path.Join("/etc/ssh", pattern)
+				}
+				files, err := globFiles(p.root, pattern)
+				if err != nil {
+					return true, err
+				}
+				for _, included := range files {
+					if _, err := p.parse(included, depth+1); err != nil {
+						return true, err
+					}
+				}
+			}
+		default:
+			if _, ok := p.options[key]; !ok {
+				p.options[key] = value
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// splitSSHDOption returns the lowercase keyword and the value of an option,
+// which are separated by whitespace or an equal sign.
+func splitSSHDOption(line string) (string, string) {
+	i := strings.IndexAny(line, " \t=")
+	if i < 0 {
+		return strings.ToLower(line), ""
+	}
+	value := strings.TrimSpace(line[i:])
+	value = strings.TrimSpace(strings.TrimPrefix(value, "="))
+	return strings.ToLower(line[:i]), value
+}
+
+// checkSSHDOption returns a check function which fails if the sshd option,
+// or its default value if it isn't set, differs from expected.
+func checkSSHDOption(option, expected, defaultValue string) func(root string) ([]failure, error) {
+	return func(root string) ([]failure, error) {
+		options, exists, err := readSSHDConfig(root)
+		if err != nil || !exists {
+			return nil, err
+		}
+
+		value, ok := options[strings.ToLower(option)]
+		if !ok {
+			value = defaultValue
+		}
+		if strings.EqualFold(value, expected) {
+			return nil, nil
+		}
+
+		return []failure{{
+			path:    sshdConfigPath,
+			message: fmt.Sprintf("%s is %s, expected %s", option, value, expected),
+		}}, nil
+	}
+}
+
+func checkSSHDMaxAuthTries(root string) ([]failure, error) {
+	options, exists, err := readSSHDConfig(root)
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	value, ok := options["maxauthtries"]
+	if !ok {
+		value = "6"
+	}
+	tries, err := strconv.Atoi(value)
+	if err == nil && tries <= 4 {
+		return nil, nil
+	}
+
+	return []failure{{
+		path:    sshdConfigPath,
+		message: fmt.Sprintf("MaxAuthTries is %s, expected 4 or less", value),
+	}}, nil
+}
+
+// checkLoginDefs returns a check function which fails if the numeric option
+// of /etc/login.defs is not set or its value is not valid.
+func checkLoginDefs(option string, valid func(int) bool, expected string) func(root string) ([]failure, error) {
+	return func(root string) ([]failure, error) {
+		lines, exists, err := readLines(root, loginDefsPath)
+		if err != nil || !exists {
+			return nil, err
+		}
+
+		var value string
+		for _, line := range lines {
+			fields := strings.Fields(line)
+			if len(fields) >= 2 && fields[0] == option {
+				value = fields[1]
+			}
+		}
+		if value == "" {
+			return []failure{{
+				path:    loginDefsPath,
+				message: fmt.Sprintf("%s is not set, expected %s", option, expected),
+			}}, nil
+		}
+
+		days, err := strconv.Atoi(value)
+		if err == nil && valid(days) {
+			return nil, nil
+		}
+
+		return []failure{{
+			path:    loginDefsPath,
+			message: fmt.Sprintf("%s is %s, expected %s", option, value, expected),
+		}}, nil
+	}
+}
+
+// readAccounts returns the colon separated fields of the lines of the
+// account database file at p.
+func readAccounts(root, p string) ([][]string, error) {
+	lines, _, err := readLines(root, p)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([][]string, 0, len(lines))
+	for _, line := range lines {
+		accounts = append(accounts, strings.Split(line, ":"))
+	}
+
+	return accounts, nil
+}
+
+func checkEmptyPasswords(root string) ([]failure, error) {
+	accounts, err := readAccounts(root, shadowPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var failures []failure
+	for _, fields := range accounts {
+		if len(fields) >= 2 && fields[1] == "" {
+			failures = append(failures, failure{
+				path:    shadowPath,
+				message: fmt.Sprintf("Account %s has no password", fields[0]),
+			})
+		}
+	}
+
+	return failures, nil
+}
+
+func checkLegacyPasswdEntries(root string) ([]failure, error) {
+	accounts, err := readAccounts(root, passwdPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var failures []failure
+	for _, fields := range accounts {
+		if strings.HasPrefix(fields[0], "+") {
+			failures = append(failures, failure{
+				path:    passwdPath,
+				message: fmt.Sprintf("Legacy entry %s exists", fields[0]),
+			})
+		}
+	}
+
+	return failures, nil
+}
+
+func checkUID0Accounts(root string) ([]failure, error) {
+	accounts, err := readAccounts(root, passwdPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var failures []failure
+	for _, fields := range accounts {
+		if len(fields) >= 3 && fields[2] == "0" && fields[0] != "root" {
+			failures = append(failures, failure{
+				path:    passwdPath,
+				message: fmt.Sprintf("Account %s has UID 0", fields[0]),
+			})
+		}
+	}
+
+	return failures, nil
+}
@@ -0,0 +1,77 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compliance
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/compliance/common"
+)
+
+func TestStripPathFromResult(t *testing.T) {
+	type args struct {
+		result *common.Results
+		path   string
+	}
+	tests := []struct {
+		name string
+		args args
+		want *common.Results
+	}{
+		{
+			name: "sanity",
+			args: args{
+				result: &common.Results{
+					Checks: []common.Check{
+						{
+							CheckID:  "5.2.1",
+							FilePath: "/mnt/etc/ssh/sshd_config",
+						},
+						{
+							CheckID:  "6.1.3",
+							FilePath: "/mnt/etc/shadow",
+						},
+					},
+					ScannedInput: "/mnt",
+					ScannerName:  "scanner1",
+				},
+				path: "/mnt",
+			},
+			want: &common.Results{
+				Checks: []common.Check{
+					{
+						CheckID:  "5.2.1",
+						FilePath: "/etc/ssh/sshd_config",
+					},
+					{
+						CheckID:  "6.1.3",
+						FilePath: "/etc/shadow",
+					},
+				},
+				ScannedInput: "/mnt",
+				ScannerName:  "scanner1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StripPathFromResult(tt.args.result, tt.args.path); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("StripPathFromResult() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -16,9 +16,12 @@
 package families
 
 import (
+	"github.com/openclarity/vmclarity/shared/pkg/families/certificates"
+	"github.com/openclarity/vmclarity/shared/pkg/families/compliance"
 	"github.com/openclarity/vmclarity/shared/pkg/families/exploits"
 	"github.com/openclarity/vmclarity/shared/pkg/families/malware"
 	misconfigurationTypes "github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration/types"
+	"github.com/openclarity/vmclarity/shared/pkg/families/plugins"
 	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits"
 	"github.com/openclarity/vmclarity/shared/pkg/families/sbom"
 	"github.com/openclarity/vmclarity/shared/pkg/families/secrets"
@@ -35,6 +38,9 @@ type Config struct {
 	Rootkits         rootkits.Config              `json:"rootkits" yaml:"rootkits" mapstructure:"rootkits"`
 	Malware          malware.Config               `json:"malware" yaml:"malware" mapstructure:"malware"`
 	Misconfiguration misconfigurationTypes.Config `json:"misconfiguration" yaml:"misconfiguration" mapstructure:"misconfiguration"`
+	Certificates     certificates.Config          `json:"certificates" yaml:"certificates" mapstructure:"certificates"`
+	Compliance       compliance.Config            `json:"compliance" yaml:"compliance" mapstructure:"compliance"`
+	Plugins          plugins.Config               `json:"plugins" yaml:"plugins" mapstructure:"plugins"`
 
 	// Enrichers
 	Exploits exploits.Config `json:"exploits" yaml:"exploits" mapstructure:"exploits"`
@@ -48,6 +54,9 @@ func NewConfig() *Config {
 		Rootkits:         rootkits.Config{},
 		Malware:          malware.Config{},
 		Misconfiguration: misconfigurationTypes.Config{},
+		Certificates:     certificates.Config{},
+		Compliance:       compliance.Config{},
+		Plugins:          plugins.Config{},
 		Exploits:         exploits.Config{},
 	}
 }
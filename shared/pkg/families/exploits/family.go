@@ -29,6 +29,7 @@ import (
 	"github.com/openclarity/vmclarity/shared/pkg/families/interfaces"
 	"github.com/openclarity/vmclarity/shared/pkg/families/results"
 	"github.com/openclarity/vmclarity/shared/pkg/families/types"
+	familiesutils "github.com/openclarity/vmclarity/shared/pkg/families/utils"
 	"github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities"
 	"github.com/openclarity/vmclarity/shared/pkg/log"
 )
@@ -60,7 +61,7 @@ func (e Exploits) Run(ctx context.Context, res *results.Results) (interfaces.IsR
 		})
 	}
 
-	for _, input := range e.conf.Inputs {
+	for i, input := range e.conf.Inputs {
 		managerResults, err := manager.Run(utils.SourceType(input.InputType), input.Input)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan input %q for exploits: %v", input.Input, err)
@@ -71,6 +72,8 @@ func (e Exploits) Run(ctx context.Context, res *results.Results) (interfaces.IsR
 			logger.Infof("Merging result from %q", name)
 			mergedResults = mergedResults.Merge(result.(*common.Results)) // nolint:forcetypeassert
 		}
+
+		familiesutils.ReportProgress(ctx, i+1, len(e.conf.Inputs))
 	}
 	logger.Info("Exploits Done...")
 
@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"regexp"
 	"strings"
 
 	"github.com/openclarity/vmclarity/shared/pkg/families/malware/clam/constants"
@@ -31,6 +33,7 @@ import (
 	"github.com/openclarity/vmclarity/shared/pkg/families/malware/clam/config"
 	"github.com/openclarity/vmclarity/shared/pkg/families/malware/clam/util"
 	"github.com/openclarity/vmclarity/shared/pkg/families/malware/common"
+	familiesutils "github.com/openclarity/vmclarity/shared/pkg/families/utils"
 	sharedutils "github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
@@ -82,7 +85,11 @@ func (s *Scanner) Run(sourceType utils.SourceType, userInput string) error {
 		s.logger.Infof("freshclam has finished running: %s", string(freshclamOut))
 
 		// Define the clamscan args to run
-		args := []string{"--infected", "-r", userInput}
+		args := []string{"--infected", "-r"}
+		for _, path := range familiesutils.ExcludedPaths(s.config.ExcludedPaths) {
+			args = append(args, "--exclude-dir="+excludeDirRegex(userInput, path))
+		}
+		args = append(args, userInput)
 
 		s.logger.Infof("Running clamscan...")
 		// Execute the clamscan command
@@ -189,3 +196,9 @@ func (s *Scanner) sendResults(results common.Results, err error) {
 		s.logger.Error("Failed to send results on channel")
 	}
 }
+
+// excludeDirRegex returns the clamscan regex matching the excluded path of the
+// scanned directory and everything inside it.
+func excludeDirRegex(root, path string) string {
+	return "^" + regexp.QuoteMeta(filepath.Join(root, path)) + "(/|$)"
+}
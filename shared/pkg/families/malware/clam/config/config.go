@@ -19,4 +19,6 @@ type Config struct {
 	ClamScanBinaryPath            string `yaml:"clamscan_binary_path" mapstructure:"clamscan_binary_path"`
 	FreshclamBinaryPath           string `yaml:"freshclam_binary_path" mapstructure:"freshclam_binary_path"`
 	AlternativeFreshclamMirrorURL string `yaml:"alternative_freshclam_mirror_url" mapstructure:"alternative_freshclam_mirror_url"`
+	// ExcludedPaths are excluded in addition to the default excluded paths.
+	ExcludedPaths []string `yaml:"excluded_paths" mapstructure:"excluded_paths"`
 }
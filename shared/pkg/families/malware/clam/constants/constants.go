@@ -22,6 +22,8 @@ const (
 	DetectedMalwareDescriptionPosition = 1
 	LongMalwareTypePosition            = 1
 	MalwareDetectedIndication          = "FOUND"        // When a line in a clam scan includes the word "FOUND", it describes a malware detection
+	HeuristicsSignaturePrefix          = "Heuristics."  // Detections of the heuristic engine, e.g. Heuristics.Phishing.Email.SpoofedDomain
+	PUASignaturePrefix                 = "PUA."         // Detections of potentially unwanted applications, e.g. PUA.Win.Tool.Packed
 	ScanSummaryText                    = "SCAN SUMMARY" // The clam output text that indicates the scan summary
 	KnownVirusesField                  = "Known viruses"
 	EngineVersionField                 = "Engine version"
@@ -24,6 +24,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/openclarity/vmclarity/shared/pkg/families/malware/common"
+	"github.com/openclarity/vmclarity/shared/pkg/families/types"
 )
 
 func ParseMalwareScanOutput(clamOutput string) ([]common.DetectedMalware, *common.ScanSummary) {
@@ -130,5 +131,21 @@ func extractDetectedMalware(words []string) *common.DetectedMalware {
 		MalwareName: malwareName,
 		MalwareType: malwareTypeStr,
 		Path:        filePath,
+		Confidence:  detectionConfidence(malwareDesc),
+	}
+}
+
+// detectionConfidence returns the confidence of a detection from the name of
+// the signature. Heuristics only suspect that a file is malicious and PUA
+// signatures detect potentially unwanted applications which may be
+// legitimate, the other signatures match known malware.
+func detectionConfidence(malwareDesc string) types.Confidence {
+	switch {
+	case strings.HasPrefix(malwareDesc, constants.HeuristicsSignaturePrefix):
+		return types.ConfidenceLow
+	case strings.HasPrefix(malwareDesc, constants.PUASignaturePrefix):
+		return types.ConfidenceMedium
+	default:
+		return types.ConfidenceHigh
 	}
 }
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/openclarity/vmclarity/shared/pkg/families/malware/common"
+	"github.com/openclarity/vmclarity/shared/pkg/families/types"
 )
 
 func Test_ParseMalwareScanOutput(t *testing.T) {
@@ -51,6 +52,7 @@ func Test_ParseMalwareScanOutput(t *testing.T) {
 					MalwareName: "Generic-1",
 					MalwareType: "TROJAN",
 					Path:        "/path/to/malware.exe",
+					Confidence:  types.ConfidenceHigh,
 				},
 			},
 			expectedScanned: 10,
@@ -111,6 +113,27 @@ func Test_ExtractMalwareInfo(t *testing.T) {
 				MalwareName: "Generic-123",
 				MalwareType: "TROJAN",
 				Path:        "/path/to/malware.exe",
+				Confidence:  types.ConfidenceHigh,
+			},
+		},
+		{
+			name:  "Heuristic detection",
+			words: []string{"/path/to/mail.eml:", "Heuristics.Phishing.Email.SpoofedDomain", "FOUND"},
+			expected: &common.DetectedMalware{
+				MalwareName: "Email.SpoofedDomain",
+				MalwareType: "PHISHING",
+				Path:        "/path/to/mail.eml",
+				Confidence:  types.ConfidenceLow,
+			},
+		},
+		{
+			name:  "Potentially unwanted application",
+			words: []string{"/path/to/tool.exe:", "PUA.Win.Packer.Upx-1", "FOUND"},
+			expected: &common.DetectedMalware{
+				MalwareName: "Packer.Upx-1",
+				MalwareType: "WIN",
+				Path:        "/path/to/tool.exe",
+				Confidence:  types.ConfidenceMedium,
 			},
 		},
 	}
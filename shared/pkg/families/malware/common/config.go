@@ -15,10 +15,14 @@
 
 package common
 
-import "github.com/openclarity/vmclarity/shared/pkg/families/malware/clam/config"
+import (
+	clamconfig "github.com/openclarity/vmclarity/shared/pkg/families/malware/clam/config"
+	yaraconfig "github.com/openclarity/vmclarity/shared/pkg/families/malware/yara/config"
+)
 
 type ScannersConfig struct {
-	Clam config.Config `yaml:"clam" mapstructure:"clam"`
+	Clam clamconfig.Config `yaml:"clam" mapstructure:"clam"`
+	Yara yaraconfig.Config `yaml:"yara" mapstructure:"yara"`
 }
 
 func (ScannersConfig) IsConfig() {}
@@ -15,6 +15,10 @@
 
 package common
 
+import (
+	"github.com/openclarity/vmclarity/shared/pkg/families/types"
+)
+
 type Results struct {
 	Source      string
 	Error       error
@@ -39,6 +43,21 @@ type DetectedMalware struct {
 	MalwareName string `json:"malwareName,omitempty"`
 	MalwareType string `json:"malwareType,omitempty"`
 	Path        string `json:"path,omitempty"`
+	ScannerName string `json:"scannerName,omitempty"`
+	// Confidence is whether the malware was detected by a signature or a
+	// heuristic, if known.
+	Confidence types.Confidence `json:"confidence,omitempty"`
+	// RuleName is the name of the YARA rule which matched, for the malware
+	// detected by the YARA scanner.
+	RuleName string `json:"ruleName,omitempty"`
+	// MatchedStrings are the strings of the YARA rule found in the file.
+	MatchedStrings []MatchedString `json:"matchedStrings,omitempty"`
+}
+
+type MatchedString struct {
+	Identifier string `json:"identifier,omitempty"`
+	Offset     int64  `json:"offset"`
+	Data       string `json:"data,omitempty"`
 }
 
 func (r *Results) GetError() error {
@@ -42,7 +42,7 @@ func (m Malware) Run(ctx context.Context, _ *results.Results) (interfaces.IsResu
 	manager := job_manager.New(m.conf.ScannersList, m.conf.ScannersConfig, logger, job.Factory)
 	mergedResults := NewMergedResults()
 
-	for _, input := range m.conf.Inputs {
+	for i, input := range m.conf.Inputs {
 		resultArr, err := manager.Run(utils.SourceType(input.InputType), input.Input)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan input %q for malware: %v", input.Input, err)
@@ -59,6 +59,8 @@ func (m Malware) Run(ctx context.Context, _ *results.Results) (interfaces.IsResu
 			}
 			mergedResults = mergedResults.Merge(res)
 		}
+
+		familiesutils.ReportProgress(ctx, i+1, len(m.conf.Inputs))
 	}
 
 	logger.Info("Malware Done...")
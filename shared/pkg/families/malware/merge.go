@@ -32,7 +32,13 @@ func NewMergedResults() *MergedResults {
 }
 
 func (m *MergedResults) Merge(other *common.Results) *MergedResults {
-	m.DetectedMalware = append(m.DetectedMalware, other.Malware...)
+	for _, detected := range other.Malware {
+		// Record which scanner detected the malware so that it can be
+		// attributed when the same malware is reported by multiple
+		// scanners.
+		detected.ScannerName = other.ScannerName
+		m.DetectedMalware = append(m.DetectedMalware, detected)
+	}
 	m.Metadata[other.ScannerName] = other.Summary
 
 	return m
@@ -0,0 +1,32 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+type Config struct {
+	YaraBinaryPath  string `yaml:"yara_binary_path" mapstructure:"yara_binary_path"`
+	YaracBinaryPath string `yaml:"yarac_binary_path" mapstructure:"yarac_binary_path"`
+	// RuleSources are the sources of the YARA rules to scan with. A source is
+	// either a local file or directory, an HTTP(S) URL of a rule file, or a
+	// git repository prefixed with "git+" and optionally suffixed with
+	// "#<ref>" (for example git+https://github.com/org/rules.git#main).
+	RuleSources []string `yaml:"rule_sources" mapstructure:"rule_sources"`
+	// CacheDir is where the fetched rules and the compiled rules are kept
+	// between scans, the compiled rules are reused as long as the rules
+	// don't change.
+	CacheDir string `yaml:"cache_dir" mapstructure:"cache_dir"`
+	// ExcludedPaths are excluded in addition to the default excluded paths.
+	ExcludedPaths []string `yaml:"excluded_paths" mapstructure:"excluded_paths"`
+}
@@ -0,0 +1,147 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yara
+
+import (
+	"bufio"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/malware/common"
+	"github.com/openclarity/vmclarity/shared/pkg/families/types"
+	familiesutils "github.com/openclarity/vmclarity/shared/pkg/families/utils"
+)
+
+const (
+	// maxMatchedStrings is the maximum number of matched strings reported
+	// per rule match, rules matching short strings can match thousands of
+	// times in a single file.
+	maxMatchedStrings = 10
+	// maxMatchedStringLength is the maximum length of the reported data of
+	// a matched string.
+	maxMatchedStringLength = 256
+
+	unknownMalwareType = "UNKNOWN"
+)
+
+// parseScanOutput parses the output of yara run with the -g and -s flags:
+//
+//	RuleName [tag1,tag2] /path/to/file
+//	0x1a2b:$identifier: data
+//
+// Matches of the excluded paths, relative to root, are dropped.
+func parseScanOutput(root, output string, excludedPaths []string) []common.DetectedMalware {
+	var detected []common.DetectedMalware
+	var current *common.DetectedMalware
+
+	flush := func() {
+		if current != nil {
+			detected = append(detected, *current)
+			current = nil
+		}
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		if matched, ok := parseMatchedString(line); ok {
+			if current != nil && len(current.MatchedStrings) < maxMatchedStrings {
+				current.MatchedStrings = append(current.MatchedStrings, matched)
+			}
+			continue
+		}
+
+		flush()
+		malware, ok := parseMatchLine(line)
+		if !ok || isExcluded(root, malware.Path, excludedPaths) {
+			continue
+		}
+		current = &malware
+	}
+	flush()
+
+	return detected
+}
+
+// parseMatchLine parses a "RuleName [tags] path" line.
+func parseMatchLine(line string) (common.DetectedMalware, bool) {
+	rule, rest, ok := strings.Cut(line, " [")
+	if !ok {
+		return common.DetectedMalware{}, false
+	}
+	tags, path, ok := strings.Cut(rest, "] ")
+	if !ok || rule == "" || path == "" {
+		return common.DetectedMalware{}, false
+	}
+
+	malwareType := unknownMalwareType
+	if tag, _, _ := strings.Cut(tags, ","); tag != "" {
+		malwareType = strings.ToUpper(tag)
+	}
+
+	return common.DetectedMalware{
+		MalwareName: rule,
+		MalwareType: malwareType,
+		Path:        path,
+		RuleName:    rule,
+		// A rule match is a signature match, however the rules are user
+		// provided and are commonly hunting rules rather than detections.
+		Confidence: types.ConfidenceMedium,
+	}, true
+}
+
+// parseMatchedString parses a "0xoffset:$identifier: data" line.
+func parseMatchedString(line string) (common.MatchedString, bool) {
+	if !strings.HasPrefix(line, "0x") {
+		return common.MatchedString{}, false
+	}
+	offset, rest, ok := strings.Cut(line, ":")
+	if !ok {
+		return common.MatchedString{}, false
+	}
+	identifier, data, ok := strings.Cut(rest, ": ")
+	if !ok || !strings.HasPrefix(identifier, "$") {
+		return common.MatchedString{}, false
+	}
+	value, err := strconv.ParseInt(strings.TrimPrefix(offset, "0x"), 16, 64)
+	if err != nil {
+		return common.MatchedString{}, false
+	}
+	if len(data) > maxMatchedStringLength {
+		data = data[:maxMatchedStringLength]
+	}
+
+	return common.MatchedString{
+		Identifier: identifier,
+		Offset:     value,
+		Data:       data,
+	}, true
+}
+
+func isExcluded(root, path string, excludedPaths []string) bool {
+	rel, err := filepath.Rel(root, path)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return false
+	}
+
+	return familiesutils.IsExcludedPath(rel, excludedPaths)
+}
@@ -0,0 +1,111 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yara
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/malware/common"
+	"github.com/openclarity/vmclarity/shared/pkg/families/types"
+)
+
+func TestParseScanOutput(t *testing.T) {
+	tests := []struct {
+		Name          string
+		Output        string
+		ExcludedPaths []string
+
+		ExpectedMalware []common.DetectedMalware
+	}{
+		{
+			Name:            "No matches",
+			Output:          "",
+			ExpectedMalware: nil,
+		},
+		{
+			Name: "Matches with strings",
+			Output: `Eicar_Test_File [malware,test] /rootfs/tmp/eicar.com
+0x0:$eicar: X5O!P%@AP[4\PZX54(P^)7CC)7}$EICAR
+Webshell_Generic [] /rootfs/var/www/shell file.php
+0x1a:$eval: eval($_POST
+0x40:$eval: eval($_POST
+`,
+			ExpectedMalware: []common.DetectedMalware{
+				{
+					MalwareName: "Eicar_Test_File",
+					MalwareType: "MALWARE",
+					Path:        "/rootfs/tmp/eicar.com",
+					RuleName:    "Eicar_Test_File",
+					Confidence:  types.ConfidenceMedium,
+					MatchedStrings: []common.MatchedString{
+						{Identifier: "$eicar", Offset: 0, Data: `X5O!P%@AP[4\PZX54(P^)7CC)7}$EICAR`},
+					},
+				},
+				{
+					MalwareName: "Webshell_Generic",
+					MalwareType: "UNKNOWN",
+					Path:        "/rootfs/var/www/shell file.php",
+					RuleName:    "Webshell_Generic",
+					Confidence:  types.ConfidenceMedium,
+					MatchedStrings: []common.MatchedString{
+						{Identifier: "$eval", Offset: 0x1a, Data: "eval($_POST"},
+						{Identifier: "$eval", Offset: 0x40, Data: "eval($_POST"},
+					},
+				},
+			},
+		},
+		{
+			Name: "Excluded path",
+			Output: `Miner [coinminer] /rootfs/mnt/snapshots/xmrig
+0x10:$a: stratum+tcp
+Miner [coinminer] /rootfs/opt/xmrig
+`,
+			ExcludedPaths: []string{"mnt/snapshots"},
+			ExpectedMalware: []common.DetectedMalware{
+				{
+					MalwareName: "Miner",
+					MalwareType: "COINMINER",
+					Path:        "/rootfs/opt/xmrig",
+					RuleName:    "Miner",
+					Confidence:  types.ConfidenceMedium,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			g.Expect(parseScanOutput("/rootfs", test.Output, test.ExcludedPaths)).Should(Equal(test.ExpectedMalware))
+		})
+	}
+}
+
+func TestParseScanOutputMaxMatchedStrings(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	output := "Rule [] /file\n"
+	for i := 0; i < 2*maxMatchedStrings; i++ {
+		output += "0x0:$a: data\n"
+	}
+
+	malware := parseScanOutput("/", output, nil)
+	g.Expect(malware).Should(HaveLen(1))
+	g.Expect(malware[0].MatchedStrings).Should(HaveLen(maxMatchedStrings))
+}
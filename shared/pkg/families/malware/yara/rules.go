@@ -0,0 +1,275 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yara
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+
+	sharedutils "github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const (
+	gitSourcePrefix = "git+"
+	gitRefSeparator = "#"
+
+	compiledRulesExtension = ".yarc"
+	fetchedSourcesDir      = "sources"
+	httpRulesTimeout       = 2 * time.Minute
+)
+
+type ruleSourceKind string
+
+const (
+	ruleSourceLocal ruleSourceKind = "local"
+	ruleSourceHTTP  ruleSourceKind = "http"
+	ruleSourceGit   ruleSourceKind = "git"
+)
+
+type ruleSource struct {
+	Kind     ruleSourceKind
+	Location string
+	// Ref is the branch, tag or commit checked out for git sources, the
+	// default branch if empty.
+	Ref string
+}
+
+func parseRuleSource(source string) ruleSource {
+	switch {
+	case strings.HasPrefix(source, gitSourcePrefix):
+		location, ref, _ := strings.Cut(strings.TrimPrefix(source, gitSourcePrefix), gitRefSeparator)
+		return ruleSource{Kind: ruleSourceGit, Location: location, Ref: ref}
+	case strings.HasPrefix(source, "http://"), strings.HasPrefix(source, "https://"):
+		return ruleSource{Kind: ruleSourceHTTP, Location: source}
+	default:
+		return ruleSource{Kind: ruleSourceLocal, Location: source}
+	}
+}
+
+// fetchRules returns the rule files of the sources, the remote sources are
+// fetched into the cache directory first.
+func fetchRules(sources []string, cacheDir string) ([]string, error) {
+	var files []string
+	for _, s := range sources {
+		source := parseRuleSource(s)
+
+		path := source.Location
+		switch source.Kind {
+		case ruleSourceHTTP:
+			path = filepath.Join(cacheDir, fetchedSourcesDir, cacheKey(source.Location)+".yar")
+			if err := downloadRules(source.Location, path); err != nil {
+				return nil, fmt.Errorf("failed to download rules from %s: %w", source.Location, err)
+			}
+		case ruleSourceGit:
+			path = filepath.Join(cacheDir, fetchedSourcesDir, cacheKey(s))
+			if err := cloneRules(source.Location, source.Ref, path); err != nil {
+				return nil, fmt.Errorf("failed to clone rules from %s: %w", source.Location, err)
+			}
+		case ruleSourceLocal:
+		}
+
+		sourceFiles, err := findRuleFiles(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find rules of source %s: %w", s, err)
+		}
+		files = append(files, sourceFiles...)
+	}
+
+	if len(files) == 0 {
+		return nil, errors.New("no rule files were found in the rule sources")
+	}
+
+	return files, nil
+}
+
+func downloadRules(url, path string) error {
+	client := &http.Client{Timeout: httpRulesTimeout}
+	// nolint:noctx
+	resp, err := client.Get(url)
+	if err != nil {
+		return err // nolint:wrapcheck
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(file, resp.Body); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	return nil
+}
+
+// cloneRules clones the repository into path, replacing any earlier clone so
+// that the latest rules of the ref are used.
+func cloneRules(url, ref, path string) error {
+	if err := os.RemoveAll(path); err != nil {
+		return fmt.Errorf("failed to remove previous clone: %w", err)
+	}
+
+	options := &git.CloneOptions{URL: url}
+	if ref == "" {
+		options.Depth = 1
+	}
+	repo, err := git.PlainClone(path, false, options)
+	if err != nil {
+		return err // nolint:wrapcheck
+	}
+
+	if ref == "" {
+		return nil
+	}
+
+	hash, err := repo.ResolveRevision(plumbing.Revision(ref))
+	if err != nil {
+		// Branches other than the default one only exist as remote branches.
+		hash, err = repo.ResolveRevision(plumbing.Revision("refs/remotes/origin/" + ref))
+		if err != nil {
+			return fmt.Errorf("failed to resolve ref %s: %w", ref, err)
+		}
+	}
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return fmt.Errorf("failed to get worktree: %w", err)
+	}
+	if err := worktree.Checkout(&git.CheckoutOptions{Hash: *hash}); err != nil {
+		return fmt.Errorf("failed to checkout ref %s: %w", ref, err)
+	}
+
+	return nil
+}
+
+// findRuleFiles returns the path itself if it is a file, or the .yar and
+// .yara files found under it if it is a directory.
+func findRuleFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err // nolint:wrapcheck
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	var files []string
+	err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		switch strings.ToLower(filepath.Ext(p)) {
+		case ".yar", ".yara":
+			files = append(files, p)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err // nolint:wrapcheck
+	}
+	sort.Strings(files)
+
+	return files, nil
+}
+
+// compiledRulesPath returns the path of the compiled rules in the cache
+// directory, keyed by the contents of the rule files.
+func compiledRulesPath(files []string, cacheDir string) (string, error) {
+	h := sha256.New()
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("failed to read rule file %s: %w", file, err)
+		}
+		// The path is part of the key as includes are relative to it.
+		fmt.Fprintf(h, "%s\x00%d\x00", file, len(content))
+		h.Write(content)
+	}
+
+	return filepath.Join(cacheDir, hex.EncodeToString(h.Sum(nil))+compiledRulesExtension), nil
+}
+
+// compileRules compiles the rule files with yarac unless compiled rules of
+// the same rule files are already cached, and returns the compiled rules path.
+func compileRules(yaracBinaryPath string, files []string, cacheDir string) (string, bool, error) {
+	compiled, err := compiledRulesPath(files, cacheDir)
+	if err != nil {
+		return "", false, err
+	}
+
+	if _, err := os.Stat(compiled); err == nil {
+		return compiled, true, nil
+	}
+
+	if err := os.MkdirAll(cacheDir, 0o700); err != nil {
+		return "", false, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	// Each file gets its own namespace so that rules with the same name in
+	// different sources don't collide.
+	args := make([]string, 0, len(files)+1)
+	for i, file := range files {
+		args = append(args, fmt.Sprintf("source%d:%s", i, file))
+	}
+	// Compile into a temporary file first so that a failed compilation
+	// doesn't leave partial compiled rules in the cache.
+	tmp := compiled + ".tmp"
+	args = append(args, tmp)
+
+	// nolint:gosec
+	if _, err := sharedutils.RunCommand(exec.Command(yaracBinaryPath, args...)); err != nil {
+		_ = os.Remove(tmp)
+		return "", false, fmt.Errorf("failed to compile rules: %w", err)
+	}
+	if err := os.Rename(tmp, compiled); err != nil {
+		return "", false, fmt.Errorf("failed to store compiled rules: %w", err)
+	}
+
+	return compiled, false, nil
+}
+
+func cacheKey(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
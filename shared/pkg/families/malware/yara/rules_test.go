@@ -0,0 +1,92 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yara
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParseRuleSource(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(parseRuleSource("/etc/yara/rules")).Should(Equal(ruleSource{
+		Kind:     ruleSourceLocal,
+		Location: "/etc/yara/rules",
+	}))
+	g.Expect(parseRuleSource("https://example.com/rules.yar")).Should(Equal(ruleSource{
+		Kind:     ruleSourceHTTP,
+		Location: "https://example.com/rules.yar",
+	}))
+	g.Expect(parseRuleSource("git+https://github.com/org/rules.git#v1.0")).Should(Equal(ruleSource{
+		Kind:     ruleSourceGit,
+		Location: "https://github.com/org/rules.git",
+		Ref:      "v1.0",
+	}))
+	g.Expect(parseRuleSource("git+https://github.com/org/rules.git")).Should(Equal(ruleSource{
+		Kind:     ruleSourceGit,
+		Location: "https://github.com/org/rules.git",
+	}))
+}
+
+func TestFetchLocalRules(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir := t.TempDir()
+	for _, name := range []string{"b.yara", "a.yar", "README.md", ".git/config.yar", "nested/c.YAR"} {
+		path := filepath.Join(dir, name)
+		g.Expect(os.MkdirAll(filepath.Dir(path), 0o700)).Should(Succeed())
+		g.Expect(os.WriteFile(path, []byte("rule r { condition: true }"), 0o600)).Should(Succeed())
+	}
+
+	files, err := fetchRules([]string{dir, filepath.Join(dir, "README.md")}, t.TempDir())
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(files).Should(Equal([]string{
+		filepath.Join(dir, "a.yar"),
+		filepath.Join(dir, "b.yara"),
+		filepath.Join(dir, "nested/c.YAR"),
+		filepath.Join(dir, "README.md"),
+	}))
+
+	_, err = fetchRules([]string{filepath.Join(dir, "nested", "missing")}, t.TempDir())
+	g.Expect(err).Should(HaveOccurred())
+}
+
+func TestCompiledRulesPath(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir := t.TempDir()
+	rules := filepath.Join(dir, "rules.yar")
+	g.Expect(os.WriteFile(rules, []byte("rule a { condition: true }"), 0o600)).Should(Succeed())
+
+	first, err := compiledRulesPath([]string{rules}, "/cache")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(filepath.Dir(first)).Should(Equal("/cache"))
+
+	// Unchanged rules reuse the compiled rules.
+	second, err := compiledRulesPath([]string{rules}, "/cache")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(second).Should(Equal(first))
+
+	// Changed rules are compiled again.
+	g.Expect(os.WriteFile(rules, []byte("rule b { condition: true }"), 0o600)).Should(Succeed())
+	third, err := compiledRulesPath([]string{rules}, "/cache")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(third).ShouldNot(Equal(first))
+}
@@ -0,0 +1,178 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yara
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/openclarity/kubeclarity/shared/pkg/job_manager"
+	"github.com/openclarity/kubeclarity/shared/pkg/utils"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/malware/common"
+	"github.com/openclarity/vmclarity/shared/pkg/families/malware/yara/config"
+	familiesutils "github.com/openclarity/vmclarity/shared/pkg/families/utils"
+	sharedutils "github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const (
+	ScannerName = "yara"
+
+	// fileErrorIndication prefixes the errors yara reports for files it
+	// fails to scan while scanning a directory, these don't fail the scan.
+	fileErrorIndication = "error scanning "
+)
+
+type Scanner struct {
+	name       string
+	logger     *log.Entry
+	config     config.Config
+	resultChan chan job_manager.Result
+}
+
+func (s *Scanner) Run(sourceType utils.SourceType, userInput string) error {
+	go func() {
+		retResults := common.Results{
+			Source:      userInput,
+			ScannerName: ScannerName,
+		}
+
+		if !s.isValidInputType(sourceType) {
+			retResults.Error = fmt.Errorf("received invalid input type for YARA scanner: %v", sourceType)
+			s.sendResults(retResults, nil)
+			return
+		}
+
+		s.logger.Debugf("yara binary path: %s", s.config.YaraBinaryPath)
+		s.logger.Debugf("yarac binary path: %s", s.config.YaracBinaryPath)
+
+		cacheDir := s.cacheDir()
+		ruleFiles, err := fetchRules(s.config.RuleSources, cacheDir)
+		if err != nil {
+			s.sendResults(retResults, fmt.Errorf("failed to fetch rules: %w", err))
+			return
+		}
+
+		compiledRules, cached, err := compileRules(s.config.YaracBinaryPath, ruleFiles, cacheDir)
+		if err != nil {
+			s.sendResults(retResults, err)
+			return
+		}
+		if cached {
+			s.logger.Infof("Using cached compiled rules %s", compiledRules)
+		} else {
+			s.logger.Infof("Compiled %d rule files into %s", len(ruleFiles), compiledRules)
+		}
+
+		// nolint:gosec
+		versionOut, err := sharedutils.RunCommand(exec.Command(s.config.YaraBinaryPath, "--version"))
+		if err != nil {
+			s.sendResults(retResults, fmt.Errorf("failed to get yara version: %w", err))
+			return
+		}
+
+		s.logger.Infof("Running yara...")
+		// Compiled rules, recursive, print matched strings and tags, no
+		// warnings, don't follow symlinks.
+		// nolint:gosec
+		yaraCommand := exec.Command(s.config.YaraBinaryPath, "-C", "-r", "-s", "-g", "-w", "-N", compiledRules, userInput)
+		out, err := sharedutils.RunCommand(yaraCommand)
+		if err != nil {
+			var runError sharedutils.CmdRunError
+			if !errors.As(err, &runError) || !onlyFileErrors(runError.Stderr) {
+				s.sendResults(retResults, fmt.Errorf("failed to run yara command: %w", err))
+				return
+			}
+
+			s.logger.Warnf("yara failed to scan some files: %s", runError.Stderr)
+			out = runError.Stdout
+		}
+
+		detectedMalware := parseScanOutput(userInput, string(out), familiesutils.ExcludedPaths(s.config.ExcludedPaths))
+
+		infected := make(map[string]struct{})
+		for _, malware := range detectedMalware {
+			infected[malware.Path] = struct{}{}
+		}
+
+		retResults.Malware = detectedMalware
+		retResults.Summary = &common.ScanSummary{
+			EngineVersion: strings.TrimSpace(string(versionOut)),
+			InfectedFiles: len(infected),
+		}
+
+		s.sendResults(retResults, nil)
+	}()
+
+	return nil
+}
+
+func New(c job_manager.IsConfig, logger *log.Entry, resultChan chan job_manager.Result) job_manager.Job {
+	conf := c.(*common.ScannersConfig) // nolint:forcetypeassert
+	return &Scanner{
+		name:       ScannerName,
+		logger:     logger.Dup().WithField("scanner", ScannerName),
+		config:     conf.Yara,
+		resultChan: resultChan,
+	}
+}
+
+func (s *Scanner) cacheDir() string {
+	if s.config.CacheDir != "" {
+		return s.config.CacheDir
+	}
+	return filepath.Join(os.TempDir(), "vmclarity-yara")
+}
+
+func (s *Scanner) isValidInputType(sourceType utils.SourceType) bool {
+	switch sourceType {
+	case utils.ROOTFS, utils.DIR, utils.FILE:
+		return true
+	case utils.IMAGE, utils.SBOM:
+		fallthrough
+	default:
+		s.logger.Infof("source type %v is not supported for yara, skipping.", sourceType)
+	}
+	return false
+}
+
+func (s *Scanner) sendResults(results common.Results, err error) {
+	if err != nil {
+		s.logger.Error(err)
+		results.Error = err
+	}
+	select {
+	case s.resultChan <- &results:
+	default:
+		s.logger.Error("Failed to send results on channel")
+	}
+}
+
+// onlyFileErrors returns true if yara only failed to scan some of the files.
+func onlyFileErrors(stderr string) bool {
+	lines := strings.Split(strings.TrimSpace(stderr), "\n")
+	for _, line := range lines {
+		if !strings.HasPrefix(line, fileErrorIndication) {
+			return false
+		}
+	}
+	return true
+}
@@ -19,15 +19,19 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/openclarity/vmclarity/shared/pkg/families/certificates"
+	"github.com/openclarity/vmclarity/shared/pkg/families/compliance"
 	"github.com/openclarity/vmclarity/shared/pkg/families/exploits"
 	"github.com/openclarity/vmclarity/shared/pkg/families/interfaces"
 	"github.com/openclarity/vmclarity/shared/pkg/families/malware"
 	"github.com/openclarity/vmclarity/shared/pkg/families/misconfiguration"
+	"github.com/openclarity/vmclarity/shared/pkg/families/plugins"
 	"github.com/openclarity/vmclarity/shared/pkg/families/results"
 	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits"
 	"github.com/openclarity/vmclarity/shared/pkg/families/sbom"
 	"github.com/openclarity/vmclarity/shared/pkg/families/secrets"
 	"github.com/openclarity/vmclarity/shared/pkg/families/types"
+	familiesutils "github.com/openclarity/vmclarity/shared/pkg/families/utils"
 	"github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities"
 	"github.com/openclarity/vmclarity/shared/pkg/log"
 )
@@ -65,6 +69,15 @@ func New(config *Config) *Manager {
 	if config.Misconfiguration.Enabled {
 		manager.families = append(manager.families, misconfiguration.New(config.Misconfiguration))
 	}
+	if config.Certificates.Enabled {
+		manager.families = append(manager.families, certificates.New(config.Certificates))
+	}
+	if config.Compliance.Enabled {
+		manager.families = append(manager.families, compliance.New(config.Compliance))
+	}
+	if config.Plugins.Enabled {
+		manager.families = append(manager.families, plugins.New(config.Plugins))
+	}
 
 	// Enrichers.
 	// Exploits MUST be after Vulnerabilities to support the case it is configured to use the output from Vulnerabilities.
@@ -88,6 +101,13 @@ type FamilyNotifier interface {
 	FamilyFinished(ctx context.Context, res FamilyResult) error
 }
 
+// FamilyProgressNotifier is implemented by the notifiers which publish the
+// progress of the families while they are running. A failed progress
+// notification doesn't fail the family.
+type FamilyProgressNotifier interface {
+	FamilyProgress(ctx context.Context, familyType types.FamilyType, percent int) error
+}
+
 func (m *Manager) Run(ctx context.Context, notifier FamilyNotifier) []error {
 	var oneOrMoreFamilyFailed bool
 	var errors []error
@@ -101,9 +121,19 @@ func (m *Manager) Run(ctx context.Context, notifier FamilyNotifier) []error {
 			continue
 		}
 
+		familyCtx := ctx
+		if progressNotifier, ok := notifier.(FamilyProgressNotifier); ok {
+			familyType := family.GetType()
+			familyCtx = familiesutils.WithProgressFunc(ctx, func(percent int) {
+				if err := progressNotifier.FamilyProgress(ctx, familyType, percent); err != nil {
+					logger.Warnf("family %q progress notification failed: %v", familyType, err)
+				}
+			})
+		}
+
 		result := make(chan FamilyResult)
 		go func() {
-			ret, err := family.Run(ctx, familyResults)
+			ret, err := family.Run(familyCtx, familyResults)
 			result <- FamilyResult{
 				Result:     ret,
 				Err:        err,
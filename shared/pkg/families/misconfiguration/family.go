@@ -42,7 +42,7 @@ func (m Misconfiguration) Run(ctx context.Context, _ *results.Results) (interfac
 	misConfigResults := NewResults()
 
 	manager := job_manager.New(m.conf.ScannersList, m.conf.ScannersConfig, logger, job.Factory)
-	for _, input := range m.conf.Inputs {
+	for i, input := range m.conf.Inputs {
 		managerResults, err := manager.Run(utils.SourceType(input.InputType), input.Input)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan input %q for misconfigurations: %v", input.Input, err)
@@ -60,6 +60,8 @@ func (m Misconfiguration) Run(ctx context.Context, _ *results.Results) (interfac
 				return nil, fmt.Errorf("received bad scanner result type %T, expected misconfigurationTypes.ScannerResult", result)
 			}
 		}
+
+		familiesutils.ReportProgress(ctx, i+1, len(m.conf.Inputs))
 	}
 
 	logger.Info("Misconfiguration Done...")
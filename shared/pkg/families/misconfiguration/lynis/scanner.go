@@ -98,7 +98,7 @@ func (a *Scanner) Run(sourceType utils.SourceType, userInput string) error {
 			"/dev/null",
 			"--forensics",
 			"--tests",
-			strings.Join(testsToRun, ","),
+			strings.Join(testsExcept(a.config.SkipTests), ","),
 			"--rootdir",
 			userInput,
 		}
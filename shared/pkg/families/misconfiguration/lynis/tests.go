@@ -142,3 +142,23 @@ var testsToRun = []string{
 	"USB-2000",
 	"USB-3000",
 }
+
+// testsExcept returns the tests to run without the skipped ones.
+func testsExcept(skip []string) []string {
+	if len(skip) == 0 {
+		return testsToRun
+	}
+
+	skipped := make(map[string]struct{}, len(skip))
+	for _, id := range skip {
+		skipped[id] = struct{}{}
+	}
+
+	tests := make([]string, 0, len(testsToRun))
+	for _, id := range testsToRun {
+		if _, ok := skipped[id]; !ok {
+			tests = append(tests, id)
+		}
+	}
+	return tests
+}
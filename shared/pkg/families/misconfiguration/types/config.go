@@ -44,4 +44,6 @@ func (ScannersConfig) IsConfig() {}
 
 type LynisConfig struct {
 	InstallPath string `yaml:"install_path" mapstructure:"install_path"`
+	// SkipTests are the IDs of the tests which are not run.
+	SkipTests []string `yaml:"skip_tests" mapstructure:"skip_tests"`
 }
@@ -0,0 +1,48 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugins
+
+import (
+	"time"
+)
+
+type Config struct {
+	Enabled         bool           `yaml:"enabled" mapstructure:"enabled"`
+	Plugins         []PluginConfig `yaml:"plugins" mapstructure:"plugins"`
+	StripInputPaths bool           `yaml:"strip_input_paths" mapstructure:"strip_input_paths"`
+	Inputs          []Input        `yaml:"inputs" mapstructure:"inputs"`
+	// ExcludedPaths are passed to the plugins in addition to the default
+	// excluded paths.
+	ExcludedPaths []string `yaml:"excluded_paths" mapstructure:"excluded_paths"`
+}
+
+type PluginConfig struct {
+	Name string `yaml:"name" mapstructure:"name"`
+	// Command is the path of the executable of the plugin.
+	Command string   `yaml:"command" mapstructure:"command"`
+	Args    []string `yaml:"args" mapstructure:"args"`
+	// Config is passed to the plugin in the request.
+	Config map[string]interface{} `yaml:"config" mapstructure:"config"`
+	// Timeout of the plugin for each input, DefaultTimeout if not set.
+	Timeout time.Duration `yaml:"timeout" mapstructure:"timeout"`
+}
+
+type Input struct {
+	// StripPathFromResult overrides global StripInputPaths value
+	StripPathFromResult *bool  `yaml:"strip_path_from_result" mapstructure:"strip_path_from_result"`
+	Input               string `yaml:"input" mapstructure:"input"`
+	InputType           string `yaml:"input_type" mapstructure:"input_type"`
+}
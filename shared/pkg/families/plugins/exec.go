@@ -0,0 +1,121 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugins
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/plugins/common"
+	"github.com/openclarity/vmclarity/shared/pkg/families/plugins/types"
+)
+
+const (
+	DefaultTimeout = 10 * time.Minute
+
+	// maxStderrLength is the length of the end of the standard error of a
+	// failed plugin which is reported in the error.
+	maxStderrLength = 1024
+
+	waitDelay = 5 * time.Second
+)
+
+// runPlugin executes the plugin for the input, writing the request to its
+// standard input and reading the response from its standard output.
+func runPlugin(ctx context.Context, plugin PluginConfig, input Input, excludedPaths []string) *common.Results {
+	results := &common.Results{
+		ScannedInput: input.Input,
+		PluginName:   plugin.Name,
+	}
+
+	timeout := plugin.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	request, err := json.Marshal(types.Request{
+		APIVersion:    types.APIVersion,
+		Input:         input.Input,
+		InputType:     input.InputType,
+		ExcludedPaths: excludedPaths,
+		Config:        plugin.Config,
+	})
+	if err != nil {
+		results.Error = fmt.Errorf("failed to marshal request: %w", err)
+		return results
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, plugin.Command, plugin.Args...) // nolint:gosec
+	cmd.Stdin = bytes.NewReader(request)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	// Don't wait for the processes started by the plugin which still hold
+	// its output once it was killed.
+	cmd.WaitDelay = waitDelay
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			err = fmt.Errorf("timed out after %v", timeout)
+		}
+		results.Error = fmt.Errorf("failed to run plugin: %w: %s", err, lastBytes(stderr.String(), maxStderrLength))
+		return results
+	}
+
+	var response types.Response
+	if err := json.Unmarshal(stdout.Bytes(), &response); err != nil {
+		results.Error = fmt.Errorf("failed to unmarshal response: %w", err)
+		return results
+	}
+	if response.Error != "" {
+		results.Error = fmt.Errorf("plugin failed: %s", response.Error)
+		return results
+	}
+
+	type findingKey struct {
+		id   string
+		path string
+	}
+	seen := map[findingKey]struct{}{}
+	for _, finding := range response.Findings {
+		key := findingKey{id: finding.ID, path: finding.Path}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		results.Findings = append(results.Findings, common.Finding{
+			PluginName: plugin.Name,
+			Finding:    finding,
+		})
+	}
+
+	return results
+}
+
+func lastBytes(s string, n int) string {
+	s = strings.TrimSpace(s)
+	if len(s) > n {
+		return s[len(s)-n:]
+	}
+	return s
+}
@@ -0,0 +1,115 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugins
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/plugins/common"
+	"github.com/openclarity/vmclarity/shared/pkg/families/plugins/types"
+)
+
+// shellPlugin returns a plugin running the shell script, the request is
+// available in the REQUEST variable of the script.
+func shellPlugin(name, script string) PluginConfig {
+	return PluginConfig{
+		Name:    name,
+		Command: "/bin/sh",
+		Args:    []string{"-c", "REQUEST=$(cat); " + script},
+		Config:  map[string]interface{}{"level": "strict"},
+	}
+}
+
+func Test_runPlugin(t *testing.T) {
+	input := Input{
+		Input:     "/mnt",
+		InputType: "rootfs",
+	}
+	tests := []struct {
+		name    string
+		plugin  PluginConfig
+		want    []common.Finding
+		wantErr string
+	}{
+		{
+			name:   "findings",
+			plugin: shellPlugin("plugin1", `echo '{"findings": [{"id": "R1", "severity": "HIGH", "path": "/mnt/etc/app.conf", "properties": {"key": "value"}}, {"id": "R1", "path": "/mnt/etc/app.conf"}]}'`),
+			want: []common.Finding{
+				{
+					PluginName: "plugin1",
+					Finding: types.Finding{
+						ID:         "R1",
+						Severity:   "HIGH",
+						Path:       "/mnt/etc/app.conf",
+						Properties: map[string]string{"key": "value"},
+					},
+				},
+			},
+		},
+		{
+			name: "request",
+			plugin: shellPlugin("plugin1", `
+				[ "$REQUEST" = '{"apiVersion":"v1","input":"/mnt","inputType":"rootfs","excludedPaths":["mnt/snapshots"],"config":{"level":"strict"}}' ] || exit 1
+				echo '{"findings": []}'`),
+			want: nil,
+		},
+		{
+			name:    "non-zero exit status",
+			plugin:  shellPlugin("plugin1", `echo "database not found" >&2; exit 2`),
+			wantErr: "failed to run plugin: exit status 2: database not found",
+		},
+		{
+			name:    "error response",
+			plugin:  shellPlugin("plugin1", `echo '{"error": "unsupported input"}'`),
+			wantErr: "plugin failed: unsupported input",
+		},
+		{
+			name:    "invalid response",
+			plugin:  shellPlugin("plugin1", `echo 'done'`),
+			wantErr: "failed to unmarshal response",
+		},
+		{
+			name: "timeout",
+			plugin: func() PluginConfig {
+				p := shellPlugin("plugin1", `exec sleep 5`)
+				p.Timeout = 100 * time.Millisecond
+				return p
+			}(),
+			wantErr: "timed out after 100ms",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := runPlugin(context.Background(), tt.plugin, input, []string{"mnt/snapshots"})
+			if tt.wantErr != "" {
+				if got.Error == nil || !strings.Contains(got.Error.Error(), tt.wantErr) {
+					t.Fatalf("runPlugin() error = %v, want %q", got.Error, tt.wantErr)
+				}
+				return
+			}
+			if got.Error != nil {
+				t.Fatalf("runPlugin() error = %v", got.Error)
+			}
+			if !reflect.DeepEqual(got.Findings, tt.want) {
+				t.Errorf("runPlugin() findings = %v, want %v", got.Findings, tt.want)
+			}
+		})
+	}
+}
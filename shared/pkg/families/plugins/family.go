@@ -0,0 +1,96 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/interfaces"
+	"github.com/openclarity/vmclarity/shared/pkg/families/plugins/common"
+	familiesresults "github.com/openclarity/vmclarity/shared/pkg/families/results"
+	"github.com/openclarity/vmclarity/shared/pkg/families/types"
+	familiesutils "github.com/openclarity/vmclarity/shared/pkg/families/utils"
+	"github.com/openclarity/vmclarity/shared/pkg/log"
+)
+
+type Plugins struct {
+	conf Config
+}
+
+func (p Plugins) Run(ctx context.Context, _ *familiesresults.Results) (interfaces.IsResults, error) {
+	logger := log.GetLoggerFromContextOrDiscard(ctx).WithField("family", "plugins")
+	logger.Info("Plugins Run...")
+
+	mergedResults := NewMergedResults()
+	excludedPaths := familiesutils.ExcludedPaths(p.conf.ExcludedPaths)
+
+	for i, input := range p.conf.Inputs {
+		// Like the scanners of the other families, the scan of the input
+		// fails only if all the plugins failed.
+		var errs error
+		succeeded := 0
+		for _, plugin := range p.conf.Plugins {
+			result := runPlugin(ctx, plugin, input, excludedPaths)
+			if err := result.GetError(); err != nil {
+				err = fmt.Errorf("%q plugin failed: %w", plugin.Name, err)
+				logger.Warning(err)
+				errs = errors.Join(errs, err)
+				continue
+			}
+			succeeded++
+
+			logger.Infof("Merging result from %q", plugin.Name)
+			if familiesutils.ShouldStripInputPath(input.StripPathFromResult, p.conf.StripInputPaths) {
+				result = StripPathFromResult(result, input.Input)
+			}
+			mergedResults = mergedResults.Merge(result)
+		}
+		if succeeded == 0 && errs != nil {
+			return nil, fmt.Errorf("failed to scan input %q with plugins: %w", input.Input, errs)
+		}
+
+		familiesutils.ReportProgress(ctx, i+1, len(p.conf.Inputs))
+	}
+
+	logger.Info("Plugins Done...")
+	return &Results{
+		MergedResults: mergedResults,
+	}, nil
+}
+
+func StripPathFromResult(result *common.Results, path string) *common.Results {
+	for i := range result.Findings {
+		if result.Findings[i].Path == "" {
+			continue
+		}
+		result.Findings[i].Path = familiesutils.TrimMountPath(result.Findings[i].Path, path)
+	}
+	return result
+}
+
+func (p Plugins) GetType() types.FamilyType {
+	return types.Plugins
+}
+
+var _ interfaces.Family = &Plugins{}
+
+func New(conf Config) *Plugins {
+	return &Plugins{
+		conf: conf,
+	}
+}
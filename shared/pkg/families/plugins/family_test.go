@@ -0,0 +1,87 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugins
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/plugins/common"
+	"github.com/openclarity/vmclarity/shared/pkg/families/plugins/types"
+)
+
+func TestStripPathFromResult(t *testing.T) {
+	result := &common.Results{
+		Findings: []common.Finding{
+			{PluginName: "plugin1", Finding: types.Finding{ID: "R1", Path: "/mnt/etc/app.conf"}},
+			{PluginName: "plugin1", Finding: types.Finding{ID: "R2"}},
+		},
+		ScannedInput: "/mnt",
+		PluginName:   "plugin1",
+	}
+	want := &common.Results{
+		Findings: []common.Finding{
+			{PluginName: "plugin1", Finding: types.Finding{ID: "R1", Path: "/etc/app.conf"}},
+			{PluginName: "plugin1", Finding: types.Finding{ID: "R2"}},
+		},
+		ScannedInput: "/mnt",
+		PluginName:   "plugin1",
+	}
+	if got := StripPathFromResult(result, "/mnt"); !reflect.DeepEqual(got, want) {
+		t.Errorf("StripPathFromResult() = %v, want %v", got, want)
+	}
+}
+
+func TestPlugins_Run(t *testing.T) {
+	succeeding := shellPlugin("succeeding", `echo '{"findings": [{"id": "R1", "path": "/mnt/etc/app.conf"}]}'`)
+	failing := shellPlugin("failing", `exit 1`)
+	input := Input{Input: "/mnt", InputType: "rootfs"}
+
+	t.Run("a plugin failed", func(t *testing.T) {
+		p := New(Config{
+			Enabled:         true,
+			Plugins:         []PluginConfig{failing, succeeding},
+			StripInputPaths: true,
+			Inputs:          []Input{input},
+		})
+		got, err := p.Run(context.Background(), nil)
+		if err != nil {
+			t.Fatalf("Run() error = %v", err)
+		}
+		want := &Results{
+			MergedResults: &MergedResults{
+				Findings: []common.Finding{
+					{PluginName: "succeeding", Finding: types.Finding{ID: "R1", Path: "/etc/app.conf"}},
+				},
+			},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Run() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("all plugins failed", func(t *testing.T) {
+		p := New(Config{
+			Enabled: true,
+			Plugins: []PluginConfig{failing},
+			Inputs:  []Input{input},
+		})
+		if _, err := p.Run(context.Background(), nil); err == nil {
+			t.Errorf("Run() expected error")
+		}
+	})
+}
@@ -0,0 +1,59 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// APIVersion is the version of the contract between VMClarity and the
+// scanner plugins.
+const APIVersion = "v1"
+
+// Request is written as JSON to the standard input of a plugin, it is
+// executed once for each scanned input.
+type Request struct {
+	APIVersion string `json:"apiVersion"`
+	// Input is the path of the scanned input, e.g. the directory the
+	// filesystem of the target is mounted at.
+	Input string `json:"input"`
+	// InputType is the type of the input, e.g. rootfs, dir or image.
+	InputType string `json:"inputType"`
+	// ExcludedPaths are the paths, relative to the input, which should not
+	// be scanned.
+	ExcludedPaths []string `json:"excludedPaths,omitempty"`
+	// Config is the plugin specific configuration of the scan.
+	Config map[string]interface{} `json:"config,omitempty"`
+}
+
+// Response must be written as JSON to the standard output of a plugin before
+// it exits. The plugin fails the scan of the input by exiting with a non-zero
+// status or by setting Error.
+type Response struct {
+	Findings []Finding `json:"findings"`
+	Error    string    `json:"error,omitempty"`
+}
+
+// Finding is a generic finding reported by a plugin. The findings of a plugin
+// are identified by their ID and Path, only the first of the findings with the
+// same ID and Path is kept.
+type Finding struct {
+	// ID of the rule or check of the plugin which reported the finding.
+	ID          string `json:"id"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Severity    string `json:"severity,omitempty"`
+	// Path of the file the finding was found in, under Request.Input.
+	Path        string            `json:"path,omitempty"`
+	Remediation string            `json:"remediation,omitempty"`
+	Properties  map[string]string `json:"properties,omitempty"`
+}
@@ -0,0 +1,134 @@
+// Copyright © 2022 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bootintegrity
+
+import (
+	"fmt"
+
+	"github.com/openclarity/kubeclarity/shared/pkg/job_manager"
+	"github.com/openclarity/kubeclarity/shared/pkg/utils"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits/bootintegrity/config"
+	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits/common"
+	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits/types"
+)
+
+const (
+	ScannerName = "bootintegrity"
+
+	rootkitName = "boot integrity"
+)
+
+type Scanner struct {
+	name       string
+	logger     *log.Entry
+	config     config.Config
+	resultChan chan job_manager.Result
+}
+
+func (s *Scanner) Run(sourceType utils.SourceType, userInput string) error {
+	go func() {
+		retResults := common.Results{
+			ScannedInput: userInput,
+			ScannerName:  ScannerName,
+		}
+
+		if !s.isValidInputType(sourceType) {
+			retResults.Error = fmt.Errorf("received invalid input type for boot integrity scanner: %v", sourceType)
+			s.sendResults(retResults, nil)
+			return
+		}
+
+		if s.config.KnownGoodHashesFile == "" {
+			s.logger.Infof("Known good hashes file is not configured, skipping boot integrity check")
+			s.sendResults(retResults, nil)
+			return
+		}
+
+		knownGood, err := loadKnownGoodHashes(s.config.KnownGoodHashesFile)
+		if err != nil {
+			s.sendResults(retResults, fmt.Errorf("failed to load known good hashes: %v", err))
+			return
+		}
+
+		bootFiles, err := findBootFiles(userInput)
+		if err != nil {
+			s.sendResults(retResults, fmt.Errorf("failed to find boot files: %v", err))
+			return
+		}
+		s.logger.Infof("Found %d boot files to check in %s", len(bootFiles), userInput)
+
+		mismatches, err := checkBootFiles(bootFiles, knownGood)
+		if err != nil {
+			s.sendResults(retResults, fmt.Errorf("failed to check boot files: %v", err))
+			return
+		}
+
+		retResults.Rootkits = toResultsRootkits(mismatches)
+
+		s.sendResults(retResults, nil)
+	}()
+
+	return nil
+}
+
+func toResultsRootkits(mismatches []hashMismatch) []common.Rootkit {
+	ret := make([]common.Rootkit, 0, len(mismatches))
+	for _, mismatch := range mismatches {
+		ret = append(ret, common.Rootkit{
+			Message:     fmt.Sprintf("%s has unknown sha256 %s which does not match any known good hash", mismatch.path, mismatch.hash),
+			RootkitName: rootkitName,
+			RootkitType: types.BOOT,
+		})
+	}
+
+	return ret
+}
+
+func New(c job_manager.IsConfig, logger *log.Entry, resultChan chan job_manager.Result) job_manager.Job {
+	conf := c.(*common.ScannersConfig) // nolint:forcetypeassert
+	return &Scanner{
+		name:       ScannerName,
+		logger:     logger.Dup().WithField("scanner", ScannerName),
+		config:     conf.BootIntegrity,
+		resultChan: resultChan,
+	}
+}
+
+func (s *Scanner) isValidInputType(sourceType utils.SourceType) bool {
+	switch sourceType {
+	case utils.DIR, utils.ROOTFS:
+		return true
+	case utils.FILE, utils.IMAGE, utils.SBOM:
+		fallthrough
+	default:
+		s.logger.Infof("source type %v is not supported for boot integrity scanner, skipping.", sourceType)
+	}
+	return false
+}
+
+func (s *Scanner) sendResults(results common.Results, err error) {
+	if err != nil {
+		s.logger.Error(err)
+		results.Error = err
+	}
+	select {
+	case s.resultChan <- &results:
+	default:
+		s.logger.Error("Failed to send results on channel")
+	}
+}
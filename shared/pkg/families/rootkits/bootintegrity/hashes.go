@@ -0,0 +1,157 @@
+// Copyright © 2022 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bootintegrity
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// bootDirs are the directories relative to the scanned root which contain
+// bootloaders and kernels. EFI is the root of a mounted EFI system partition.
+var bootDirs = []string{"boot", "EFI"}
+
+type hashMismatch struct {
+	path string
+	hash string
+}
+
+// knownGoodHashes maps a boot file name to its known good sha256 hashes.
+type knownGoodHashes map[string]map[string]struct{}
+
+// loadKnownGoodHashes parses a file in sha256sum format ("<hash>  <path>"),
+// lines starting with # are ignored.
+func loadKnownGoodHashes(path string) (knownGoodHashes, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	return parseKnownGoodHashes(f)
+}
+
+func parseKnownGoodHashes(r io.Reader) (knownGoodHashes, error) {
+	hashes := knownGoodHashes{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid known good hash line: %q", line)
+		}
+		hash := strings.ToLower(fields[0])
+		// sha256sum marks binary mode with a * prefix
+		name := filepath.Base(strings.TrimPrefix(fields[1], "*"))
+
+		if _, ok := hashes[name]; !ok {
+			hashes[name] = map[string]struct{}{}
+		}
+		hashes[name][hash] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read known good hashes: %w", err)
+	}
+
+	return hashes, nil
+}
+
+// findBootFiles returns the kernels and bootloaders found under root.
+func findBootFiles(root string) ([]string, error) {
+	var files []string
+	for _, dir := range bootDirs {
+		err := filepath.WalkDir(filepath.Join(root, dir), func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.Type().IsRegular() && isBootFile(d.Name()) {
+				files = append(files, path)
+			}
+			return nil
+		})
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("failed to walk %s: %w", dir, err)
+		}
+	}
+
+	return files, nil
+}
+
+func isBootFile(name string) bool {
+	lower := strings.ToLower(name)
+	switch {
+	case strings.HasPrefix(lower, "vmlinuz"), strings.HasPrefix(lower, "vmlinux"):
+		return true
+	case strings.HasSuffix(lower, ".efi"):
+		return true
+	case lower == "core.img", lower == "boot.img":
+		return true
+	default:
+		return false
+	}
+}
+
+// checkBootFiles returns the boot files for which known good hashes exist
+// but none of them match. Files without known good hashes are not reported.
+func checkBootFiles(files []string, knownGood knownGoodHashes) ([]hashMismatch, error) {
+	var mismatches []hashMismatch
+	for _, file := range files {
+		hashes, ok := knownGood[filepath.Base(file)]
+		if !ok {
+			continue
+		}
+
+		hash, err := sha256File(file)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := hashes[hash]; !ok {
+			mismatches = append(mismatches, hashMismatch{
+				path: file,
+				hash: hash,
+			})
+		}
+	}
+
+	return mismatches, nil
+}
+
+func sha256File(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("failed to hash %s: %w", path, err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,89 @@
+// Copyright © 2022 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bootintegrity
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"gotest.tools/v3/assert"
+)
+
+// sha256 of "good".
+const goodHash = "770e607624d689265ca6c44884d0807d9b054d23c473c106c72be9de08b7376c"
+
+func TestParseKnownGoodHashes(t *testing.T) {
+	input := strings.Join([]string{
+		"# known good hashes",
+		"",
+		goodHash + "  /boot/vmlinuz-5.15.0",
+		strings.ToUpper(goodHash) + " *EFI/ubuntu/shimx64.efi",
+	}, "\n")
+
+	got, err := parseKnownGoodHashes(strings.NewReader(input))
+	assert.NilError(t, err)
+
+	want := knownGoodHashes{
+		"vmlinuz-5.15.0": {goodHash: {}},
+		"shimx64.efi":    {goodHash: {}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("parseKnownGoodHashes() mismatch (-want +got):\n%s", diff)
+	}
+
+	_, err = parseKnownGoodHashes(strings.NewReader("invalid"))
+	assert.ErrorContains(t, err, "invalid known good hash line")
+}
+
+func TestCheckBootFiles(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		"boot/vmlinuz-5.15.0":           "good",
+		"boot/efi/EFI/BOOT/grubx64.efi": "tampered",
+		"boot/grub/i386-pc/core.img":    "unknown",
+		"boot/config-5.15.0":            "ignored",
+		"etc/passwd":                    "ignored",
+	}
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		assert.NilError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+		assert.NilError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+
+	bootFiles, err := findBootFiles(root)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, bootFiles, []string{
+		filepath.Join(root, "boot/efi/EFI/BOOT/grubx64.efi"),
+		filepath.Join(root, "boot/grub/i386-pc/core.img"),
+		filepath.Join(root, "boot/vmlinuz-5.15.0"),
+	})
+
+	knownGood := knownGoodHashes{
+		"vmlinuz-5.15.0": {goodHash: {}},
+		"grubx64.efi":    {goodHash: {}},
+	}
+	mismatches, err := checkBootFiles(bootFiles, knownGood)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, mismatches, []hashMismatch{
+		{
+			path: filepath.Join(root, "boot/efi/EFI/BOOT/grubx64.efi"),
+			hash: "d121be3103007b41edf96f8262925f8c7d61894afe9a041843b631f69445bc57",
+		},
+	}, cmp.AllowUnexported(hashMismatch{}))
+}
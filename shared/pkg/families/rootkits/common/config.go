@@ -15,10 +15,14 @@
 
 package common
 
-import "github.com/openclarity/vmclarity/shared/pkg/families/rootkits/chkrootkit/config"
+import (
+	bootintegrityconfig "github.com/openclarity/vmclarity/shared/pkg/families/rootkits/bootintegrity/config"
+	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits/chkrootkit/config"
+)
 
 type ScannersConfig struct {
-	Chkrootkit config.Config `yaml:"chkrootkit" mapstructure:"chkrootkit"`
+	Chkrootkit    config.Config              `yaml:"chkrootkit" mapstructure:"chkrootkit"`
+	BootIntegrity bootintegrityconfig.Config `yaml:"bootintegrity" mapstructure:"bootintegrity"`
 }
 
 func (ScannersConfig) IsConfig() {}
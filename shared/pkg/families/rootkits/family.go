@@ -42,7 +42,7 @@ func (r Rootkits) Run(ctx context.Context, _ *familiesresults.Results) (families
 	manager := job_manager.New(r.conf.ScannersList, r.conf.ScannersConfig, logger, job.Factory)
 	mergedResults := NewMergedResults()
 
-	for _, input := range r.conf.Inputs {
+	for i, input := range r.conf.Inputs {
 		results, err := manager.Run(utils.SourceType(input.InputType), input.Input)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan input %q for rootkits: %v", input.Input, err)
@@ -57,6 +57,8 @@ func (r Rootkits) Run(ctx context.Context, _ *familiesresults.Results) (families
 			}
 			mergedResults = mergedResults.Merge(scannerResult)
 		}
+
+		familiesutils.ReportProgress(ctx, i+1, len(r.conf.Inputs))
 	}
 
 	logger.Info("Rootkits Done...")
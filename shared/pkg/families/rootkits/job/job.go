@@ -18,6 +18,7 @@ package job
 import (
 	"github.com/openclarity/kubeclarity/shared/pkg/job_manager"
 
+	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits/bootintegrity"
 	"github.com/openclarity/vmclarity/shared/pkg/families/rootkits/chkrootkit"
 )
 
@@ -25,4 +26,5 @@ var Factory = job_manager.NewJobFactory()
 
 func init() {
 	Factory.Register(chkrootkit.ScannerName, chkrootkit.New)
+	Factory.Register(bootintegrity.ScannerName, bootintegrity.New)
 }
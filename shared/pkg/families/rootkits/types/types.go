@@ -19,6 +19,7 @@ type RootkitType string
 
 const (
 	APPLICATION RootkitType = "APPLICATION"
+	BOOT        RootkitType = "BOOT"
 	FIRMWARE    RootkitType = "FIRMWARE"
 	KERNEL      RootkitType = "KERNEL"
 	MEMORY      RootkitType = "MEMORY"
@@ -23,6 +23,8 @@ type Config struct {
 	Inputs          []Input        `yaml:"inputs" mapstructure:"inputs"`
 	MergeWith       []MergeWith    `yaml:"merge_with" mapstructure:"merge_with"`
 	AnalyzersConfig *config.Config `yaml:"analyzers_config" mapstructure:"analyzers_config"`
+	// ExcludedPaths are excluded in addition to the default excluded paths.
+	ExcludedPaths []string `yaml:"excluded_paths" mapstructure:"excluded_paths"`
 }
 
 type Input struct {
@@ -0,0 +1,95 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbom
+
+import (
+	"regexp"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+
+	familiesutils "github.com/openclarity/vmclarity/shared/pkg/families/utils"
+)
+
+// locationPropertyRegex matches the names of the component properties the
+// analyzers record the locations a component was found at in.
+var locationPropertyRegex = regexp.MustCompile(`^(syft:location:\d+:path|aquasecurity:trivy:FilePath)$`)
+
+// excludeComponents removes the components of the bom which were only found
+// under the excluded paths of the analyzed roots, together with their
+// dependencies. Components without a recorded location are kept.
+func excludeComponents(bom *cdx.BOM, roots []string, excluded []string) {
+	if bom.Components == nil || len(excluded) == 0 {
+		return
+	}
+
+	removed := map[string]bool{}
+	components := make([]cdx.Component, 0, len(*bom.Components))
+	for _, component := range *bom.Components {
+		if isExcludedComponent(component, roots, excluded) {
+			removed[component.BOMRef] = true
+			continue
+		}
+		components = append(components, component)
+	}
+	if len(removed) == 0 {
+		return
+	}
+	bom.Components = &components
+
+	if bom.Dependencies == nil {
+		return
+	}
+	dependencies := make([]cdx.Dependency, 0, len(*bom.Dependencies))
+	for _, dependency := range *bom.Dependencies {
+		if removed[dependency.Ref] {
+			continue
+		}
+		if dependency.Dependencies != nil {
+			refs := make([]string, 0, len(*dependency.Dependencies))
+			for _, ref := range *dependency.Dependencies {
+				if !removed[ref] {
+					refs = append(refs, ref)
+				}
+			}
+			dependency.Dependencies = &refs
+		}
+		dependencies = append(dependencies, dependency)
+	}
+	bom.Dependencies = &dependencies
+}
+
+func isExcludedComponent(component cdx.Component, roots []string, excluded []string) bool {
+	if component.Properties == nil {
+		return false
+	}
+
+	var found bool
+	for _, property := range *component.Properties {
+		if !locationPropertyRegex.MatchString(property.Name) {
+			continue
+		}
+		found = true
+		path := property.Value
+		for _, root := range roots {
+			path = familiesutils.TrimMountPath(path, root)
+		}
+		if !familiesutils.IsExcludedPath(path, excluded) {
+			return false
+		}
+	}
+
+	return found
+}
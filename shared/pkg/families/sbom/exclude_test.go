@@ -0,0 +1,62 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sbom
+
+import (
+	"testing"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExcludeComponents(t *testing.T) {
+	component := func(ref string, paths ...string) cdx.Component {
+		properties := make([]cdx.Property, 0, len(paths))
+		for _, path := range paths {
+			properties = append(properties, cdx.Property{Name: "syft:location:0:path", Value: path})
+		}
+		return cdx.Component{BOMRef: ref, Name: ref, Properties: &properties}
+	}
+
+	bom := &cdx.BOM{
+		Components: &[]cdx.Component{
+			component("app", "/mnt/root/usr/lib/app/package.json"),
+			component("cached", "/mnt/root/var/lib/agent/cache/package.json"),
+			component("both", "/mnt/root/var/lib/agent/package.json", "/mnt/root/opt/both/package.json"),
+			{BOMRef: "nolocation", Name: "nolocation"},
+		},
+		Dependencies: &[]cdx.Dependency{
+			{Ref: "app", Dependencies: &[]string{"cached", "both"}},
+			{Ref: "cached", Dependencies: &[]string{}},
+		},
+	}
+
+	excludeComponents(bom, []string{"/mnt/root"}, []string{"var/lib/agent"})
+
+	want := &cdx.BOM{
+		Components: &[]cdx.Component{
+			component("app", "/mnt/root/usr/lib/app/package.json"),
+			component("both", "/mnt/root/var/lib/agent/package.json", "/mnt/root/opt/both/package.json"),
+			{BOMRef: "nolocation", Name: "nolocation"},
+		},
+		Dependencies: &[]cdx.Dependency{
+			{Ref: "app", Dependencies: &[]string{"both"}},
+		},
+	}
+	if diff := cmp.Diff(want, bom); diff != "" {
+		t.Errorf("excludeComponents() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/openclarity/vmclarity/shared/pkg/families/interfaces"
 	familiesresults "github.com/openclarity/vmclarity/shared/pkg/families/results"
 	"github.com/openclarity/vmclarity/shared/pkg/families/types"
+	familiesutils "github.com/openclarity/vmclarity/shared/pkg/families/utils"
 	"github.com/openclarity/vmclarity/shared/pkg/log"
 )
 
@@ -56,7 +57,7 @@ func (s SBOM) Run(ctx context.Context, _ *familiesresults.Results) (interfaces.I
 	manager := job_manager.New(s.conf.AnalyzersList, s.conf.AnalyzersConfig, logger, job.Factory)
 	mergedResults := sharedanalyzer.NewMergedResults(utils.SourceType(s.conf.Inputs[0].InputType), hash)
 
-	for _, input := range s.conf.Inputs {
+	for i, input := range s.conf.Inputs {
 		results, err := manager.Run(utils.SourceType(input.InputType), input.Input)
 		if err != nil {
 			return nil, fmt.Errorf("failed to analyzer input %q: %v", s.conf.Inputs[0].Input, err)
@@ -67,6 +68,8 @@ func (s SBOM) Run(ctx context.Context, _ *familiesresults.Results) (interfaces.I
 			logger.Infof("Merging result from %q", name)
 			mergedResults = mergedResults.Merge(result.(*sharedanalyzer.Results)) // nolint:forcetypeassert
 		}
+
+		familiesutils.ReportProgress(ctx, i+1, len(s.conf.Inputs))
 	}
 
 	for i, with := range s.conf.MergeWith {
@@ -92,6 +95,12 @@ func (s SBOM) Run(ctx context.Context, _ *familiesresults.Results) (interfaces.I
 		return nil, fmt.Errorf("failed to load merged output to CDX bom: %w", err)
 	}
 
+	roots := make([]string, 0, len(s.conf.Inputs))
+	for _, input := range s.conf.Inputs {
+		roots = append(roots, input.Input)
+	}
+	excludeComponents(cdxBom, roots, familiesutils.ExcludedPaths(s.conf.ExcludedPaths))
+
 	logger.Info("SBOM Done...")
 
 	return &Results{
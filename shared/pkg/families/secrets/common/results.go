@@ -15,6 +15,18 @@
 
 package common
 
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/types"
+)
+
+const (
+	highConfidenceEntropy   = 4.0
+	mediumConfidenceEntropy = 3.0
+)
+
 // Results for now will be as the gitleaks results struct since it is our only secret scanner.
 // once another secret scanner is integrated, we will need to think of a common scheme.
 type Results struct {
@@ -58,6 +70,49 @@ type Findings struct {
 
 	// unique identifier
 	Fingerprint string `json:"Fingerprint"`
+
+	// RedactedCredentialFingerprint is the CredentialFingerprint of the
+	// finding, kept after the secret was removed by Redact.
+	RedactedCredentialFingerprint string `json:"RedactedCredentialFingerprint,omitempty"`
+}
+
+// CredentialFingerprint returns a hash identifying the matched secret so that
+// occurrences of the same credential can be grouped without storing the
+// secret itself, or an empty string if the secret is not known.
+func (f Findings) CredentialFingerprint() string {
+	if f.Secret == "" {
+		return f.RedactedCredentialFingerprint
+	}
+	sum := sha256.Sum256([]byte(f.RuleID + ":" + f.Secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// Redact removes everything which may contain the secret material from the
+// finding, keeping only its credential fingerprint and location. The
+// description is replaced by the ID of the rule which matched the secret.
+func (f *Findings) Redact() {
+	f.RedactedCredentialFingerprint = f.CredentialFingerprint()
+	f.Description = f.RuleID
+	f.Secret = ""
+	f.Match = ""
+	f.Line = ""
+	f.Message = ""
+}
+
+// Confidence returns the confidence of the finding from the entropy of the
+// secret, low entropy secrets are more likely to be placeholders or words
+// matched by a generic rule.
+func (f Findings) Confidence() types.Confidence {
+	switch {
+	case f.Entropy >= highConfidenceEntropy:
+		return types.ConfidenceHigh
+	case f.Entropy >= mediumConfidenceEntropy:
+		return types.ConfidenceMedium
+	case f.Entropy > 0:
+		return types.ConfidenceLow
+	default:
+		return ""
+	}
 }
 
 func (r *Results) GetError() error {
@@ -20,11 +20,14 @@ import (
 )
 
 type Config struct {
-	Enabled         bool                   `yaml:"enabled" mapstructure:"enabled"`
-	ScannersList    []string               `yaml:"scanners_list" mapstructure:"scanners_list"`
-	StripInputPaths bool                   `yaml:"strip_input_paths" mapstructure:"strip_input_paths"`
-	Inputs          []Input                `yaml:"inputs" mapstructure:"inputs"`
-	ScannersConfig  *common.ScannersConfig `yaml:"scanners_config" mapstructure:"scanners_config"`
+	Enabled         bool     `yaml:"enabled" mapstructure:"enabled"`
+	ScannersList    []string `yaml:"scanners_list" mapstructure:"scanners_list"`
+	StripInputPaths bool     `yaml:"strip_input_paths" mapstructure:"strip_input_paths"`
+	// Redact removes the secret material from the findings before they are
+	// reported, see common.Findings.Redact.
+	Redact         bool                   `yaml:"redact" mapstructure:"redact"`
+	Inputs         []Input                `yaml:"inputs" mapstructure:"inputs"`
+	ScannersConfig *common.ScannersConfig `yaml:"scanners_config" mapstructure:"scanners_config"`
 }
 
 type Input struct {
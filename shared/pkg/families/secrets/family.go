@@ -42,7 +42,7 @@ func (s Secrets) Run(ctx context.Context, _ *familiesresults.Results) (interface
 	manager := job_manager.New(s.conf.ScannersList, s.conf.ScannersConfig, logger, job.Factory)
 	mergedResults := NewMergedResults()
 
-	for _, input := range s.conf.Inputs {
+	for i, input := range s.conf.Inputs {
 		results, err := manager.Run(utils.SourceType(input.InputType), input.Input)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan input %q for secrets: %v", input.Input, err)
@@ -54,9 +54,14 @@ func (s Secrets) Run(ctx context.Context, _ *familiesresults.Results) (interface
 			if familiesutils.ShouldStripInputPath(input.StripPathFromResult, s.conf.StripInputPaths) {
 				secretResult = StripPathFromResult(secretResult, input.Input)
 			}
+			if s.conf.Redact {
+				RedactResult(secretResult)
+			}
 			logger.Infof("Merging result from %q", name)
 			mergedResults = mergedResults.Merge(secretResult)
 		}
+
+		familiesutils.ReportProgress(ctx, i+1, len(s.conf.Inputs))
 	}
 
 	logger.Info("Secrets Done...")
@@ -74,6 +79,13 @@ func StripPathFromResult(result *common.Results, path string) *common.Results {
 	return result
 }
 
+// RedactResult removes the secret material from all the findings of the result.
+func RedactResult(result *common.Results) {
+	for i := range result.Findings {
+		result.Findings[i].Redact()
+	}
+}
+
 func (s Secrets) GetType() types.FamilyType {
 	return types.Secrets
 }
@@ -95,3 +95,47 @@ func TestStripPathFromResult(t *testing.T) {
 		})
 	}
 }
+
+func TestRedactResult(t *testing.T) {
+	finding := common.Findings{
+		Description: "Detected a Generic API Key",
+		StartLine:   3,
+		EndLine:     3,
+		StartColumn: 10,
+		EndColumn:   42,
+		Line:        "api_key = \"0123456789abcdef0123456789abcdef\"",
+		Match:       "api_key = \"0123456789abcdef0123456789abcdef\"",
+		Secret:      "0123456789abcdef0123456789abcdef",
+		File:        "/etc/app.conf",
+		RuleID:      "generic-api-key",
+		Fingerprint: "/etc/app.conf:generic-api-key:3",
+	}
+	credentialFingerprint := finding.CredentialFingerprint()
+
+	result := &common.Results{
+		Findings: []common.Findings{finding},
+	}
+	RedactResult(result)
+
+	want := &common.Results{
+		Findings: []common.Findings{
+			{
+				Description:                   "generic-api-key",
+				StartLine:                     3,
+				EndLine:                       3,
+				StartColumn:                   10,
+				EndColumn:                     42,
+				File:                          "/etc/app.conf",
+				RuleID:                        "generic-api-key",
+				Fingerprint:                   "/etc/app.conf:generic-api-key:3",
+				RedactedCredentialFingerprint: credentialFingerprint,
+			},
+		},
+	}
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("RedactResult() = %v, want %v", result, want)
+	}
+	if got := result.Findings[0].CredentialFingerprint(); got != credentialFingerprint {
+		t.Errorf("CredentialFingerprint() after redaction = %v, want %v", got, credentialFingerprint)
+	}
+}
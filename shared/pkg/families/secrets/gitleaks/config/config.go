@@ -17,4 +17,16 @@ package config
 
 type Config struct {
 	BinaryPath string `yaml:"binary_path" mapstructure:"binary_path"`
+	// ExcludedPaths are excluded in addition to the default excluded paths.
+	ExcludedPaths []string `yaml:"excluded_paths" mapstructure:"excluded_paths"`
+	// Allowlist of the secrets which are not reported.
+	Allowlist []AllowlistEntry `yaml:"allowlist" mapstructure:"allowlist"`
+}
+
+// AllowlistEntry allows the secret with the credential fingerprint, in the
+// file relative to the scanned directory if it is set or in any file
+// otherwise.
+type AllowlistEntry struct {
+	CredentialFingerprint string `yaml:"credential_fingerprint" mapstructure:"credential_fingerprint"`
+	FilePath              string `yaml:"file_path" mapstructure:"file_path"`
 }
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path"
 
 	log "github.com/sirupsen/logrus"
 
@@ -28,6 +29,7 @@ import (
 
 	"github.com/openclarity/vmclarity/shared/pkg/families/secrets/common"
 	gitleaksconfig "github.com/openclarity/vmclarity/shared/pkg/families/secrets/gitleaks/config"
+	familiesutils "github.com/openclarity/vmclarity/shared/pkg/families/utils"
 	sharedutils "github.com/openclarity/vmclarity/shared/pkg/utils"
 )
 
@@ -45,7 +47,7 @@ func New(c job_manager.IsConfig, logger *log.Entry, resultChan chan job_manager.
 	return &Scanner{
 		name:       ScannerName,
 		logger:     logger.Dup().WithField("scanner", ScannerName),
-		config:     gitleaksconfig.Config{BinaryPath: conf.Gitleaks.BinaryPath},
+		config:     conf.Gitleaks,
 		resultChan: resultChan,
 	}
 }
@@ -92,10 +94,13 @@ func (a *Scanner) Run(sourceType utils.SourceType, userInput string) error {
 			return
 		}
 
-		if err := json.Unmarshal(out, &retResults.Findings); err != nil {
+		var findings []common.Findings
+		if err := json.Unmarshal(out, &findings); err != nil {
 			a.sendResults(retResults, fmt.Errorf("failed to unmarshal results. out: %s. err: %v", out, err))
 			return
 		}
+		findings = excludeFindings(findings, userInput, familiesutils.ExcludedPaths(a.config.ExcludedPaths))
+		retResults.Findings = allowFindings(findings, userInput, a.config.Allowlist)
 		a.sendResults(retResults, nil)
 	}()
 
@@ -125,3 +130,50 @@ func (a *Scanner) sendResults(results common.Results, err error) {
 		a.logger.Error("Failed to send results on channel")
 	}
 }
+
+// excludeFindings removes the findings in the files under the excluded paths
+// of the scanned directory.
+func excludeFindings(findings []common.Findings, root string, excluded []string) []common.Findings {
+	ret := make([]common.Findings, 0, len(findings))
+	for _, finding := range findings {
+		if familiesutils.IsExcludedPath(familiesutils.TrimMountPath(finding.File, root), excluded) {
+			continue
+		}
+		ret = append(ret, finding)
+	}
+
+	return ret
+}
+
+// allowFindings removes the findings of the secrets in the allowlist.
+func allowFindings(findings []common.Findings, root string, allowlist []gitleaksconfig.AllowlistEntry) []common.Findings {
+	if len(allowlist) == 0 {
+		return findings
+	}
+
+	ret := make([]common.Findings, 0, len(findings))
+	for _, finding := range findings {
+		if isAllowed(finding, root, allowlist) {
+			continue
+		}
+		ret = append(ret, finding)
+	}
+
+	return ret
+}
+
+func isAllowed(finding common.Findings, root string, allowlist []gitleaksconfig.AllowlistEntry) bool {
+	fingerprint := finding.CredentialFingerprint()
+	if fingerprint == "" {
+		return false
+	}
+	for _, entry := range allowlist {
+		if entry.CredentialFingerprint != fingerprint {
+			continue
+		}
+		if entry.FilePath == "" || path.Clean("/"+entry.FilePath) == path.Clean("/"+familiesutils.TrimMountPath(finding.File, root)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitleaks
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/shared/pkg/families/secrets/common"
+	gitleaksconfig "github.com/openclarity/vmclarity/shared/pkg/families/secrets/gitleaks/config"
+)
+
+func Test_allowFindings(t *testing.T) {
+	fixture := common.Findings{RuleID: "private-key", Secret: "fixture", File: "/mnt/app/test/key.pem"}
+	fixtureCopy := common.Findings{RuleID: "private-key", Secret: "fixture", File: "/mnt/app/docs/key.pem"}
+	leaked := common.Findings{RuleID: "private-key", Secret: "leaked", File: "/mnt/app/key.pem"}
+	findings := []common.Findings{fixture, fixtureCopy, leaked}
+
+	tests := []struct {
+		name      string
+		allowlist []gitleaksconfig.AllowlistEntry
+		want      []common.Findings
+	}{
+		{
+			name: "empty allowlist",
+			want: findings,
+		},
+		{
+			name: "credential in any file",
+			allowlist: []gitleaksconfig.AllowlistEntry{
+				{CredentialFingerprint: fixture.CredentialFingerprint()},
+			},
+			want: []common.Findings{leaked},
+		},
+		{
+			name: "credential in a file",
+			allowlist: []gitleaksconfig.AllowlistEntry{
+				{CredentialFingerprint: fixture.CredentialFingerprint(), FilePath: "app/test/key.pem"},
+			},
+			want: []common.Findings{fixtureCopy, leaked},
+		},
+		{
+			name: "unknown credential",
+			allowlist: []gitleaksconfig.AllowlistEntry{
+				{CredentialFingerprint: "unknown"},
+			},
+			want: findings,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := allowFindings(findings, "/mnt", tt.allowlist)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("allowFindings() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -25,6 +25,20 @@ const (
 	Rootkits         FamilyType = "rootkits"
 	Malware          FamilyType = "malware"
 	Misconfiguration FamilyType = "misconfiguration"
+	Certificates     FamilyType = "certificates"
+	Compliance       FamilyType = "compliance"
+	Plugins          FamilyType = "plugins"
 
 	Exploits FamilyType = "exploits"
 )
+
+// Confidence is how likely a finding is a true positive, as derived from the
+// output of the scanner which reported it. It is empty if the scanner doesn't
+// report one.
+type Confidence string
+
+const (
+	ConfidenceHigh   Confidence = "high"
+	ConfidenceMedium Confidence = "medium"
+	ConfidenceLow    Confidence = "low"
+)
@@ -0,0 +1,68 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// DefaultExcludedPaths are the paths, relative to the root of the scanned
+// filesystem, which the families walking the filesystem always exclude. They
+// hold the scratch space of the scanners and the caches of the cloud agents,
+// which are frequently reported without being a finding of the target.
+var DefaultExcludedPaths = []string{
+	"mnt/snapshots",
+	"var/lib/clamav",
+	"var/lib/amazon/ssm",
+	"var/lib/waagent",
+	"var/lib/google",
+	"var/lib/cloud/instances",
+	"opt/aws/amazon-cloudwatch-agent/logs",
+}
+
+// ExcludedPaths returns the default excluded paths followed by the configured
+// ones, cleaned and relative to the root of the scanned filesystem.
+func ExcludedPaths(configured []string) []string {
+	paths := make([]string, 0, len(DefaultExcludedPaths)+len(configured))
+	seen := make(map[string]bool, cap(paths))
+	for _, list := range [][]string{DefaultExcludedPaths, configured} {
+		for _, path := range list {
+			path = strings.TrimPrefix(filepath.Clean("/"+path), "/")
+			// Excluding the root would exclude the whole filesystem.
+			if path == "" || seen[path] {
+				continue
+			}
+			seen[path] = true
+			paths = append(paths, path)
+		}
+	}
+
+	return paths
+}
+
+// IsExcludedPath reports whether the path, relative to the root of the
+// scanned filesystem, is one of the excluded paths or inside one of them.
+func IsExcludedPath(path string, excluded []string) bool {
+	path = strings.TrimPrefix(filepath.Clean("/"+path), "/")
+	for _, e := range excluded {
+		if path == e || strings.HasPrefix(path, e+"/") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,72 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExcludedPaths(t *testing.T) {
+	got := ExcludedPaths([]string{"/data/cache/", "var/lib/clamav", "/", "opt//agent"})
+	want := append(append([]string{}, DefaultExcludedPaths...), "data/cache", "opt/agent")
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ExcludedPaths() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestIsExcludedPath(t *testing.T) {
+	excluded := []string{"var/lib/clamav", "data/cache"}
+	tests := []struct {
+		name string
+		path string
+		want bool
+	}{
+		{
+			name: "excluded directory",
+			path: "/var/lib/clamav",
+			want: true,
+		},
+		{
+			name: "inside excluded directory",
+			path: "var/lib/clamav/main.cvd",
+			want: true,
+		},
+		{
+			name: "sibling with common prefix",
+			path: "/var/lib/clamav-old/main.cvd",
+			want: false,
+		},
+		{
+			name: "parent of excluded directory",
+			path: "/data",
+			want: false,
+		},
+		{
+			name: "unrelated path",
+			path: "/etc/passwd",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsExcludedPath(tt.path, excluded); got != tt.want {
+				t.Errorf("IsExcludedPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
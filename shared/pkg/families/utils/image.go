@@ -0,0 +1,142 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/crane"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+)
+
+// PullImageFilesystem pulls the image referenced by imageRef and unpacks its
+// flattened filesystem into dir, so that the families can scan it as a rootfs.
+// Registry credentials are looked up in the default docker keychain.
+func PullImageFilesystem(ctx context.Context, imageRef string, dir string) error {
+	img, err := crane.Pull(imageRef, crane.WithContext(ctx), crane.WithAuthFromKeychain(authn.DefaultKeychain))
+	if err != nil {
+		return fmt.Errorf("failed to pull image %s: %w", imageRef, err)
+	}
+
+	if err := extractImage(img, dir); err != nil {
+		return fmt.Errorf("failed to extract filesystem of image %s: %w", imageRef, err)
+	}
+
+	return nil
+}
+
+// ExtractImageTarball unpacks the flattened filesystem of the image in the
+// tarball at path, as written by docker save, into dir.
+func ExtractImageTarball(path string, dir string) error {
+	img, err := crane.Load(path)
+	if err != nil {
+		return fmt.Errorf("failed to load image tarball %s: %w", path, err)
+	}
+
+	if err := extractImage(img, dir); err != nil {
+		return fmt.Errorf("failed to extract filesystem of image tarball %s: %w", path, err)
+	}
+
+	return nil
+}
+
+func extractImage(img v1.Image, dir string) error {
+	fs := mutate.Extract(img)
+	defer fs.Close()
+
+	return extractTar(fs, dir)
+}
+
+// extractTar unpacks the tar stream r into dir. Entries are resolved within
+// dir, following the symlinks already extracted as if dir was the root, so
+// that a malicious image can't write outside of it. Device files are skipped
+// as they are irrelevant for scanning.
+func extractTar(r io.Reader, dir string) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read tar header: %w", err)
+		}
+
+		target, err := securejoin.SecureJoin(dir, hdr.Name)
+		if err != nil {
+			return fmt.Errorf("failed to resolve path %s: %w", hdr.Name, err)
+		}
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, hdr.FileInfo().Mode().Perm()|0o700); err != nil {
+				return fmt.Errorf("failed to create directory %s: %w", hdr.Name, err)
+			}
+		case tar.TypeReg:
+			if err := writeFile(tr, target, hdr.FileInfo().Mode().Perm()); err != nil {
+				return fmt.Errorf("failed to create file %s: %w", hdr.Name, err)
+			}
+		case tar.TypeSymlink:
+			if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+				return fmt.Errorf("failed to create directory for %s: %w", hdr.Name, err)
+			}
+			// The link is kept as is, it's meant to be resolved relative to the image root.
+			if err := os.Symlink(hdr.Linkname, target); err != nil {
+				return fmt.Errorf("failed to create symlink %s: %w", hdr.Name, err)
+			}
+		case tar.TypeLink:
+			source, err := securejoin.SecureJoin(dir, hdr.Linkname)
+			if err != nil {
+				return fmt.Errorf("failed to resolve path %s: %w", hdr.Linkname, err)
+			}
+			if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+				return fmt.Errorf("failed to create directory for %s: %w", hdr.Name, err)
+			}
+			if err := os.Link(source, target); err != nil {
+				return fmt.Errorf("failed to create hard link %s: %w", hdr.Name, err)
+			}
+		default:
+			continue
+		}
+	}
+}
+
+func writeFile(r io.Reader, path string, perm os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create parent directory: %w", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, perm)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, r); err != nil { // nolint:gosec
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"archive/tar"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/crane"
+)
+
+type tarEntry struct {
+	hdr     tar.Header
+	content string
+}
+
+func newTar(t *testing.T, entries []tarEntry) *bytes.Buffer {
+	t.Helper()
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, e := range entries {
+		hdr := e.hdr
+		hdr.Size = int64(len(e.content))
+		if err := tw.WriteHeader(&hdr); err != nil {
+			t.Fatalf("failed to write tar header: %v", err)
+		}
+		if _, err := tw.Write([]byte(e.content)); err != nil {
+			t.Fatalf("failed to write tar content: %v", err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("failed to close tar writer: %v", err)
+	}
+	return &buf
+}
+
+func TestExtractTar(t *testing.T) {
+	outside := t.TempDir()
+	dir := t.TempDir()
+
+	r := newTar(t, []tarEntry{
+		{hdr: tar.Header{Name: "etc/", Typeflag: tar.TypeDir, Mode: 0o755}},
+		{hdr: tar.Header{Name: "etc/os-release", Typeflag: tar.TypeReg, Mode: 0o644}, content: "ID=alpine\n"},
+		{hdr: tar.Header{Name: "etc/os-release.link", Typeflag: tar.TypeLink, Linkname: "etc/os-release"}},
+		{hdr: tar.Header{Name: "escape", Typeflag: tar.TypeSymlink, Linkname: outside}},
+		{hdr: tar.Header{Name: "escape/passwd", Typeflag: tar.TypeReg, Mode: 0o644}, content: "root:x:0:0\n"},
+		{hdr: tar.Header{Name: "../../parent", Typeflag: tar.TypeReg, Mode: 0o644}, content: "parent\n"},
+		{hdr: tar.Header{Name: "dev/null", Typeflag: tar.TypeChar, Mode: 0o666}},
+	})
+
+	if err := extractTar(r, dir); err != nil {
+		t.Fatalf("extractTar() error = %v", err)
+	}
+
+	for path, want := range map[string]string{
+		"etc/os-release":      "ID=alpine\n",
+		"etc/os-release.link": "ID=alpine\n",
+		outside + "/passwd":   "root:x:0:0\n",
+		"parent":              "parent\n",
+	} {
+		got, err := os.ReadFile(filepath.Join(dir, path))
+		if err != nil {
+			t.Errorf("failed to read %s: %v", path, err)
+			continue
+		}
+		if string(got) != want {
+			t.Errorf("content of %s = %q, want %q", path, got, want)
+		}
+	}
+
+	if entries, _ := os.ReadDir(outside); len(entries) != 0 {
+		t.Errorf("extractTar() wrote outside of the target directory: %v", entries)
+	}
+	if _, err := os.Lstat(filepath.Join(dir, "dev/null")); !os.IsNotExist(err) {
+		t.Errorf("extractTar() extracted device file, err = %v", err)
+	}
+}
+
+func TestExtractImageTarball(t *testing.T) {
+	img, err := crane.Image(map[string][]byte{
+		"etc/os-release": []byte("ID=alpine\n"),
+	})
+	if err != nil {
+		t.Fatalf("failed to create image: %v", err)
+	}
+	tarball := filepath.Join(t.TempDir(), "image.tar")
+	if err := crane.Save(img, "alpine:test", tarball); err != nil {
+		t.Fatalf("failed to save image: %v", err)
+	}
+
+	dir := t.TempDir()
+	if err := ExtractImageTarball(tarball, dir); err != nil {
+		t.Fatalf("ExtractImageTarball() error = %v", err)
+	}
+
+	got, err := os.ReadFile(filepath.Join(dir, "etc/os-release"))
+	if err != nil {
+		t.Fatalf("failed to read etc/os-release: %v", err)
+	}
+	if string(got) != "ID=alpine\n" {
+		t.Errorf("content of etc/os-release = %q, want %q", got, "ID=alpine\n")
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import "context"
+
+// ProgressFunc receives the percentage of the scan of a family which is done.
+type ProgressFunc func(percent int)
+
+type progressFuncKey struct{}
+
+// WithProgressFunc returns a copy of ctx which carries fn, the family run
+// with the returned context reports its progress to fn.
+func WithProgressFunc(ctx context.Context, fn ProgressFunc) context.Context {
+	return context.WithValue(ctx, progressFuncKey{}, fn)
+}
+
+// ReportProgress reports that done out of total units of work of the family,
+// e.g. its inputs, are done. It does nothing if ctx carries no ProgressFunc.
+func ReportProgress(ctx context.Context, done, total int) {
+	fn, ok := ctx.Value(progressFuncKey{}).(ProgressFunc)
+	if !ok || fn == nil || total <= 0 {
+		return
+	}
+
+	switch {
+	case done < 0:
+		done = 0
+	case done > total:
+		done = total
+	}
+
+	fn(done * 100 / total) // nolint:gomnd
+}
@@ -0,0 +1,79 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"testing"
+)
+
+func TestReportProgress(t *testing.T) {
+	tests := []struct {
+		name  string
+		done  int
+		total int
+		want  []int
+	}{
+		{
+			name:  "part of the work is done",
+			done:  1,
+			total: 3,
+			want:  []int{33},
+		},
+		{
+			name:  "all the work is done",
+			done:  3,
+			total: 3,
+			want:  []int{100},
+		},
+		{
+			name:  "more than the total is capped",
+			done:  4,
+			total: 3,
+			want:  []int{100},
+		},
+		{
+			name:  "no work is not reported",
+			done:  0,
+			total: 0,
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			ctx := WithProgressFunc(context.Background(), func(percent int) {
+				got = append(got, percent)
+			})
+
+			ReportProgress(ctx, tt.done, tt.total)
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("ReportProgress() reported %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("ReportProgress() reported %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestReportProgressWithoutProgressFunc(_ *testing.T) {
+	// Must not panic.
+	ReportProgress(context.Background(), 1, 2)
+}
@@ -17,8 +17,10 @@ package vulnerabilities
 
 import (
 	"github.com/openclarity/kubeclarity/shared/pkg/config"
+	sharedscanner "github.com/openclarity/kubeclarity/shared/pkg/scanner"
 
 	"github.com/openclarity/vmclarity/shared/pkg/families/types"
+	"github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities/trivy"
 )
 
 type Config struct {
@@ -27,6 +29,30 @@ type Config struct {
 	Inputs         []Input        `yaml:"inputs" mapstructure:"inputs"`
 	InputFromSbom  bool           `yaml:"input_from_sbom" mapstructure:"input_from_sbom"`
 	ScannersConfig *config.Config `yaml:"scanners_config" mapstructure:"scanners_config"`
+	IgnoreRules    []IgnoreRule   `yaml:"ignore_rules" mapstructure:"ignore_rules"`
+	TrivyConfig    trivy.Config   `yaml:"trivy_config" mapstructure:"trivy_config"`
+}
+
+// IgnoreRule ignores the vulnerability like a grype ignore rule, in the
+// package with the name and version if they are set or in any package
+// otherwise.
+type IgnoreRule struct {
+	Vulnerability  string `yaml:"vulnerability" mapstructure:"vulnerability"`
+	PackageName    string `yaml:"package_name" mapstructure:"package_name"`
+	PackageVersion string `yaml:"package_version" mapstructure:"package_version"`
+}
+
+func (r IgnoreRule) matches(vulnerability sharedscanner.Vulnerability) bool {
+	if r.Vulnerability != vulnerability.ID {
+		return false
+	}
+	if r.PackageName != "" && r.PackageName != vulnerability.Package.Name {
+		return false
+	}
+	if r.PackageVersion != "" && r.PackageVersion != vulnerability.Package.Version {
+		return false
+	}
+	return true
 }
 
 type Input struct {
@@ -19,22 +19,26 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/openclarity/kubeclarity/shared/pkg/config"
 	"github.com/openclarity/kubeclarity/shared/pkg/job_manager"
 	sharedscanner "github.com/openclarity/kubeclarity/shared/pkg/scanner"
-	"github.com/openclarity/kubeclarity/shared/pkg/scanner/job"
+	"github.com/openclarity/kubeclarity/shared/pkg/scanner/dependency_track"
+	"github.com/openclarity/kubeclarity/shared/pkg/scanner/grype"
 	"github.com/openclarity/kubeclarity/shared/pkg/utils"
 
 	"github.com/openclarity/vmclarity/shared/pkg/families/interfaces"
 	"github.com/openclarity/vmclarity/shared/pkg/families/results"
 	"github.com/openclarity/vmclarity/shared/pkg/families/sbom"
 	"github.com/openclarity/vmclarity/shared/pkg/families/types"
+	familiesutils "github.com/openclarity/vmclarity/shared/pkg/families/utils"
+	"github.com/openclarity/vmclarity/shared/pkg/families/vulnerabilities/trivy"
 	"github.com/openclarity/vmclarity/shared/pkg/log"
 )
 
 const (
-	sbomTempFilePath = "/tmp/sbom"
+	sbomTempFileName = "sbom"
 )
 
 type Vulnerabilities struct {
@@ -46,7 +50,7 @@ func (v Vulnerabilities) Run(ctx context.Context, res *results.Results) (interfa
 	logger := log.GetLoggerFromContextOrDiscard(ctx).WithField("family", "vulnerabilities")
 	logger.Info("Vulnerabilities Run...")
 
-	manager := job_manager.New(v.conf.ScannersList, v.conf.ScannersConfig, logger, job.Factory)
+	manager := job_manager.New(v.conf.ScannersList, v.conf.ScannersConfig, logger, v.jobFactory())
 	mergedResults := sharedscanner.NewMergedResults()
 
 	if v.conf.InputFromSbom {
@@ -63,6 +67,8 @@ func (v Vulnerabilities) Run(ctx context.Context, res *results.Results) (interfa
 		}
 
 		// TODO: need to avoid writing sbom to file
+		// The temp directory is honored so that the scans of the hosts over SSH don't write outside their work directory.
+		sbomTempFilePath := filepath.Join(os.TempDir(), sbomTempFileName)
 		if err := os.WriteFile(sbomTempFilePath, sbomBytes, 0o600 /* read & write */); err != nil { // nolint:gomnd,gofumpt
 			return nil, fmt.Errorf("failed to write sbom to file: %v", err)
 		}
@@ -77,7 +83,7 @@ func (v Vulnerabilities) Run(ctx context.Context, res *results.Results) (interfa
 		return nil, fmt.Errorf("inputs list is empty")
 	}
 
-	for _, input := range v.conf.Inputs {
+	for i, input := range v.conf.Inputs {
 		runResults, err := manager.Run(utils.SourceType(input.InputType), input.Input)
 		if err != nil {
 			return nil, fmt.Errorf("failed to run for input %v of type %v: %w", input.Input, input.InputType, err)
@@ -96,8 +102,13 @@ func (v Vulnerabilities) Run(ctx context.Context, res *results.Results) (interfa
 		//	Name: config.ImageIDToScan,
 		//	Hash: config.ImageHashToScan,
 		// })
+
+		familiesutils.ReportProgress(ctx, i+1, len(v.conf.Inputs))
 	}
 
+	dedupVulnerabilities(mergedResults, v.conf.ScannersList)
+	ignoreVulnerabilities(mergedResults, v.conf.IgnoreRules)
+
 	logger.Info("Vulnerabilities Done...")
 
 	return &Results{
@@ -105,6 +116,47 @@ func (v Vulnerabilities) Run(ctx context.Context, res *results.Results) (interfa
 	}, nil
 }
 
+// jobFactory returns the factory of the scanners, the kubeclarity ones with
+// the trivy scanner replaced by ours which supports the trivy config.
+func (v Vulnerabilities) jobFactory() *job_manager.Factory {
+	factory := job_manager.NewJobFactory()
+	factory.Register(grype.ScannerName, grype.New)
+	factory.Register(dependency_track.ScannerName, dependency_track.New)
+	factory.Register(trivy.ScannerName, trivy.New(v.conf.TrivyConfig))
+	return factory
+}
+
+// ignoreVulnerabilities removes the vulnerabilities matching an ignore rule
+// from the merged results.
+func ignoreVulnerabilities(mergedResults *sharedscanner.MergedResults, rules []IgnoreRule) {
+	if len(rules) == 0 {
+		return
+	}
+
+	for key, vulnerabilities := range mergedResults.MergedVulnerabilitiesByKey {
+		kept := make([]sharedscanner.MergedVulnerability, 0, len(vulnerabilities))
+		for _, v := range vulnerabilities {
+			if !isIgnored(v.Vulnerability, rules) {
+				kept = append(kept, v)
+			}
+		}
+		if len(kept) == 0 {
+			delete(mergedResults.MergedVulnerabilitiesByKey, key)
+			continue
+		}
+		mergedResults.MergedVulnerabilitiesByKey[key] = kept
+	}
+}
+
+func isIgnored(vulnerability sharedscanner.Vulnerability, rules []IgnoreRule) bool {
+	for _, rule := range rules {
+		if rule.matches(vulnerability) {
+			return true
+		}
+	}
+	return false
+}
+
 func (v Vulnerabilities) GetType() types.FamilyType {
 	return types.Vulnerabilities
 }
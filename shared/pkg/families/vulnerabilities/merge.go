@@ -0,0 +1,68 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vulnerabilities
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	sharedscanner "github.com/openclarity/kubeclarity/shared/pkg/scanner"
+)
+
+// dedupVulnerabilities reports a vulnerability of a package once when it is
+// reported by more than one scanner. The results are merged by the exact
+// vulnerability ID and package, so the same vulnerability reported by grype
+// and trivy with a differently cased ID or package name is regrouped here.
+// The versions reported by each scanner are kept ordered by the scanners
+// list, so the first one, which is the one reported, comes from the first
+// scanner of the list which found it and the others are attributed.
+func dedupVulnerabilities(mergedResults *sharedscanner.MergedResults, scannersList []string) {
+	byKey := make(map[sharedscanner.VulnerabilityKey][]sharedscanner.MergedVulnerability, len(mergedResults.MergedVulnerabilitiesByKey))
+	for _, vulnerabilities := range mergedResults.MergedVulnerabilitiesByKey {
+		for _, v := range vulnerabilities {
+			key := dedupKey(v.Vulnerability)
+			byKey[key] = append(byKey[key], v)
+		}
+	}
+
+	for _, vulnerabilities := range byKey {
+		sort.SliceStable(vulnerabilities, func(i, j int) bool {
+			return scannerRank(vulnerabilities[i], scannersList) < scannerRank(vulnerabilities[j], scannersList)
+		})
+	}
+
+	mergedResults.MergedVulnerabilitiesByKey = byKey
+}
+
+func dedupKey(vulnerability sharedscanner.Vulnerability) sharedscanner.VulnerabilityKey {
+	return sharedscanner.VulnerabilityKey(fmt.Sprintf("%s.%s.%s",
+		strings.ToUpper(vulnerability.ID), strings.ToLower(vulnerability.Package.Name), vulnerability.Package.Version))
+}
+
+// scannerRank returns the position in the scanners list of the first scanner
+// which reported the vulnerability.
+func scannerRank(vulnerability sharedscanner.MergedVulnerability, scannersList []string) int {
+	rank := len(scannersList)
+	for _, info := range vulnerability.ScannersInfo {
+		for i, name := range scannersList {
+			if name == info.Name && i < rank {
+				rank = i
+			}
+		}
+	}
+	return rank
+}
@@ -0,0 +1,96 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vulnerabilities
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	sharedscanner "github.com/openclarity/kubeclarity/shared/pkg/scanner"
+)
+
+func TestDedupVulnerabilities(t *testing.T) {
+	match := func(id, pkg, version, severity string) sharedscanner.Match {
+		return sharedscanner.Match{
+			Vulnerability: sharedscanner.Vulnerability{
+				ID:       id,
+				Severity: severity,
+				Package:  sharedscanner.Package{Name: pkg, Version: version},
+			},
+		}
+	}
+
+	mergedResults := sharedscanner.NewMergedResults()
+	for _, results := range []*sharedscanner.Results{
+		{
+			ScannerInfo: sharedscanner.Info{Name: "trivy"},
+			Matches: sharedscanner.Matches{
+				match("cve-2023-1", "Flask", "1.0", "HIGH"),
+				match("CVE-2023-2", "openssl", "3.0", "LOW"),
+				match("CVE-2023-3", "zlib", "1.2", "MEDIUM"),
+			},
+		},
+		{
+			ScannerInfo: sharedscanner.Info{Name: "grype"},
+			Matches: sharedscanner.Matches{
+				match("CVE-2023-1", "flask", "1.0", "MEDIUM"),
+				match("CVE-2023-2", "openssl", "3.0", "CRITICAL"),
+				match("CVE-2023-3", "zlib", "1.2", "MEDIUM"),
+				match("CVE-2023-2", "openssl", "3.1", "LOW"),
+			},
+		},
+	} {
+		mergedResults.Merge(results)
+	}
+
+	dedupVulnerabilities(mergedResults, []string{"grype", "trivy"})
+
+	type candidate struct {
+		ID       string
+		Severity string
+		Scanners []string
+	}
+	got := map[sharedscanner.VulnerabilityKey][]candidate{}
+	for key, vulnerabilities := range mergedResults.MergedVulnerabilitiesByKey {
+		for _, v := range vulnerabilities {
+			var scanners []string
+			for _, info := range v.ScannersInfo {
+				scanners = append(scanners, info.Name)
+			}
+			got[key] = append(got[key], candidate{ID: v.Vulnerability.ID, Severity: v.Vulnerability.Severity, Scanners: scanners})
+		}
+	}
+
+	want := map[sharedscanner.VulnerabilityKey][]candidate{
+		"CVE-2023-1.flask.1.0": {
+			{ID: "CVE-2023-1", Severity: "MEDIUM", Scanners: []string{"grype"}},
+			{ID: "cve-2023-1", Severity: "HIGH", Scanners: []string{"trivy"}},
+		},
+		"CVE-2023-2.openssl.3.0": {
+			{ID: "CVE-2023-2", Severity: "CRITICAL", Scanners: []string{"grype"}},
+			{ID: "CVE-2023-2", Severity: "LOW", Scanners: []string{"trivy"}},
+		},
+		"CVE-2023-2.openssl.3.1": {
+			{ID: "CVE-2023-2", Severity: "LOW", Scanners: []string{"grype"}},
+		},
+		"CVE-2023-3.zlib.1.2": {
+			{ID: "CVE-2023-3", Severity: "MEDIUM", Scanners: []string{"trivy", "grype"}},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("dedupVulnerabilities() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trivy
+
+type Config struct {
+	// CacheDir is where the vulnerability DB is downloaded to and kept
+	// between scans, the default cache directory of trivy if not set.
+	CacheDir string `yaml:"cache_dir" mapstructure:"cache_dir"`
+	// Offline scans with the vulnerability DB in CacheDir without updating
+	// it or fetching anything else from the network, for scanners which
+	// can't reach the internet. The DB must already be in CacheDir.
+	Offline bool `yaml:"offline" mapstructure:"offline"`
+}
@@ -0,0 +1,184 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trivy
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	trivyDBTypes "github.com/aquasecurity/trivy-db/pkg/types"
+	"github.com/aquasecurity/trivy/pkg/commands/artifact"
+	trivyFlag "github.com/aquasecurity/trivy/pkg/flag"
+	trivyTypes "github.com/aquasecurity/trivy/pkg/types"
+	trivyFsutils "github.com/aquasecurity/trivy/pkg/utils/fsutils"
+	"github.com/openclarity/kubeclarity/shared/pkg/config"
+	"github.com/openclarity/kubeclarity/shared/pkg/job_manager"
+	kubeclaritytrivy "github.com/openclarity/kubeclarity/shared/pkg/scanner/trivy"
+	"github.com/openclarity/kubeclarity/shared/pkg/utils"
+	utilsSBOM "github.com/openclarity/kubeclarity/shared/pkg/utils/sbom"
+	utilsTrivy "github.com/openclarity/kubeclarity/shared/pkg/utils/trivy"
+	log "github.com/sirupsen/logrus"
+)
+
+const ScannerName = kubeclaritytrivy.ScannerName
+
+// Scanner runs trivy like the kubeclarity trivy scanner, which it uses to
+// convert the report, with the DB cache directory and offline mode of the
+// Config.
+type Scanner struct {
+	logger     *log.Entry
+	conf       Config
+	scanConf   config.ScannerTrivyConfig
+	registry   *config.Registry
+	converter  *kubeclaritytrivy.Scanner
+	resultChan chan job_manager.Result
+}
+
+// New returns the function creating the trivy scanner jobs with the config
+// to register in a job factory.
+func New(conf Config) job_manager.CreateJobFunc {
+	return func(c job_manager.IsConfig, logger *log.Entry, resultChan chan job_manager.Result) job_manager.Job {
+		kubeclarityConf := c.(*config.Config) // nolint:forcetypeassert
+
+		return &Scanner{
+			logger:     logger.Dup().WithField("scanner", ScannerName),
+			conf:       conf,
+			scanConf:   kubeclarityConf.Scanner.TrivyConfig,
+			registry:   kubeclarityConf.Registry,
+			converter:  kubeclaritytrivy.New(c, logger, resultChan).(*kubeclaritytrivy.Scanner), // nolint:forcetypeassert
+			resultChan: resultChan,
+		}
+	}
+}
+
+func (s *Scanner) Run(sourceType utils.SourceType, userInput string) error {
+	s.logger.Infof("Called %s scanner on source %v %v", ScannerName, sourceType, userInput)
+	go func() {
+		var hash string
+		switch sourceType {
+		case utils.IMAGE, utils.ROOTFS, utils.DIR, utils.FILE:
+		case utils.SBOM:
+			var err error
+			_, hash, err = utilsSBOM.GetTargetNameAndHashFromSBOM(userInput)
+			if err != nil {
+				s.setError(fmt.Errorf("failed to get original source and hash from SBOM: %w", err))
+				return
+			}
+		default:
+			s.logger.Infof("Skipping scan for unsupported source type: %s", sourceType)
+			s.resultChan <- s.converter.CreateResult(nil, hash)
+			return
+		}
+
+		var output bytes.Buffer
+		trivyOptions, err := s.createTrivyOptions(&output, userInput)
+		if err != nil {
+			s.setError(fmt.Errorf("unable to create trivy options: %w", err))
+			return
+		}
+
+		trivySourceType, err := utilsTrivy.KubeclaritySourceToTrivySource(sourceType)
+		if err != nil {
+			s.setError(fmt.Errorf("failed to configure trivy: %w", err))
+			return
+		}
+		trivyOptions = utilsTrivy.SetTrivyRegistryConfigs(s.registry, trivyOptions)
+
+		if err := artifact.Run(context.TODO(), trivyOptions, trivySourceType); err != nil {
+			s.setError(fmt.Errorf("failed to scan for vulnerabilities: %w", err))
+			return
+		}
+
+		s.logger.Infof("Sending successful results")
+		s.resultChan <- s.converter.CreateResult(output.Bytes(), hash)
+	}()
+
+	return nil
+}
+
+func (s *Scanner) createTrivyOptions(output *bytes.Buffer, userInput string) (trivyFlag.Options, error) {
+	dbRepository, ok := trivyFlag.DBRepositoryFlag.Value.(string)
+	if !ok {
+		return trivyFlag.Options{}, fmt.Errorf("unable to get trivy DB repo config")
+	}
+
+	severities := make([]trivyDBTypes.Severity, 0, len(trivyDBTypes.SeverityNames))
+	for _, name := range trivyDBTypes.SeverityNames {
+		severity, err := trivyDBTypes.NewSeverity(strings.ToUpper(name))
+		if err != nil {
+			return trivyFlag.Options{}, fmt.Errorf("unable to get trivy severity %s: %w", name, err)
+		}
+		severities = append(severities, severity)
+	}
+
+	cacheDir := trivyFsutils.CacheDir()
+	if s.conf.CacheDir != "" {
+		cacheDir = s.conf.CacheDir
+	}
+
+	options := trivyFlag.Options{
+		GlobalOptions: trivyFlag.GlobalOptions{
+			Timeout:  time.Duration(s.scanConf.Timeout) * time.Second,
+			CacheDir: cacheDir,
+		},
+		ScanOptions: trivyFlag.ScanOptions{
+			Target:      userInput,
+			Scanners:    []trivyTypes.Scanner{trivyTypes.VulnerabilityScanner},
+			OfflineScan: s.conf.Offline,
+		},
+		ReportOptions: trivyFlag.ReportOptions{
+			Format:       "json",
+			ReportFormat: "all",
+			Output:       output,
+			Severities:   severities,
+		},
+		DBOptions: trivyFlag.DBOptions{
+			DBRepository:     dbRepository,
+			NoProgress:       true,
+			SkipDBUpdate:     s.conf.Offline,
+			SkipJavaDBUpdate: s.conf.Offline,
+		},
+		VulnerabilityOptions: trivyFlag.VulnerabilityOptions{
+			VulnType: trivyTypes.VulnTypes,
+		},
+	}
+
+	if s.scanConf.ServerAddr != "" {
+		customHeaders := http.Header{}
+		if s.scanConf.ServerToken != "" {
+			customHeaders.Set(trivyFlag.DefaultTokenHeader, s.scanConf.ServerToken)
+		}
+		options.RemoteOptions = trivyFlag.RemoteOptions{
+			ServerAddr:    s.scanConf.ServerAddr,
+			Token:         s.scanConf.ServerToken,
+			TokenHeader:   trivyFlag.DefaultTokenHeader,
+			CustomHeaders: customHeaders,
+		}
+	}
+
+	return options, nil
+}
+
+func (s *Scanner) setError(err error) {
+	s.logger.Error(err)
+	result := s.converter.CreateResult(nil, "")
+	result.Error = err
+	s.resultChan <- result
+}
@@ -42,6 +42,12 @@ func GenerateFindingKey(findingInfo *models.Finding_FindingInfo) (string, error)
 		return GenerateSecretKey(info).String(), nil
 	case models.PackageFindingInfo:
 		return GeneratePackageKey(info).String(), nil
+	case models.CertificateFindingInfo:
+		return GenerateCertificateKey(info).String(), nil
+	case models.ComplianceCheckFindingInfo:
+		return GenerateComplianceCheckKey(info).String(), nil
+	case models.PluginFindingInfo:
+		return GeneratePluginFindingKey(info).String(), nil
 	default:
 		return "", fmt.Errorf("unsupported finding info type %T", value)
 	}
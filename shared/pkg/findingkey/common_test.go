@@ -65,6 +65,22 @@ func TestGenerateFindingKey(t *testing.T) {
 		Name:    utils.PointerTo("Name"),
 		Version: utils.PointerTo("Version"),
 	}
+	certFindingInfo := models.CertificateFindingInfo{
+		FilePath:    utils.PointerTo("FilePath"),
+		FindingType: utils.PointerTo(models.EXPIREDCERTIFICATE),
+		Fingerprint: utils.PointerTo("Fingerprint"),
+	}
+	complianceFindingInfo := models.ComplianceCheckFindingInfo{
+		Benchmark: utils.PointerTo(models.CISLINUX),
+		CheckID:   utils.PointerTo("CheckID"),
+		FilePath:  utils.PointerTo("FilePath"),
+		Message:   utils.PointerTo("Message"),
+	}
+	pluginFindingInfo := models.PluginFindingInfo{
+		PluginName: utils.PointerTo("PluginName"),
+		FindingID:  utils.PointerTo("FindingID"),
+		Path:       utils.PointerTo("Path"),
+	}
 
 	type args struct {
 		findingInfo *models.Finding_FindingInfo
@@ -131,6 +147,30 @@ func TestGenerateFindingKey(t *testing.T) {
 			want:    GeneratePackageKey(pkgFindingInfo).String(),
 			wantErr: false,
 		},
+		{
+			name: "certificate",
+			args: args{
+				findingInfo: createFindingInfo(t, certFindingInfo),
+			},
+			want:    GenerateCertificateKey(certFindingInfo).String(),
+			wantErr: false,
+		},
+		{
+			name: "compliance check",
+			args: args{
+				findingInfo: createFindingInfo(t, complianceFindingInfo),
+			},
+			want:    GenerateComplianceCheckKey(complianceFindingInfo).String(),
+			wantErr: false,
+		},
+		{
+			name: "plugin finding",
+			args: args{
+				findingInfo: createFindingInfo(t, pluginFindingInfo),
+			},
+			want:    GeneratePluginFindingKey(pluginFindingInfo).String(),
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -165,6 +205,12 @@ func createFindingInfo(t *testing.T, info interface{}) *models.Finding_FindingIn
 		err = findingInfoB.FromVulnerabilityFindingInfo(fInfo)
 	case models.PackageFindingInfo:
 		err = findingInfoB.FromPackageFindingInfo(fInfo)
+	case models.CertificateFindingInfo:
+		err = findingInfoB.FromCertificateFindingInfo(fInfo)
+	case models.ComplianceCheckFindingInfo:
+		err = findingInfoB.FromComplianceCheckFindingInfo(fInfo)
+	case models.PluginFindingInfo:
+		err = findingInfoB.FromPluginFindingInfo(fInfo)
 	}
 	assert.NilError(t, err)
 	return &findingInfoB
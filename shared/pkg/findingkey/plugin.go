@@ -0,0 +1,42 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package findingkey
+
+import (
+	"fmt"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+type PluginFindingKey struct {
+	PluginName string
+	FindingID  string
+	Path       string
+}
+
+func (k PluginFindingKey) String() string {
+	return fmt.Sprintf("%s.%s.%s", k.PluginName, k.FindingID, k.Path)
+}
+
+func GeneratePluginFindingKey(info models.PluginFindingInfo) PluginFindingKey {
+	// The plugins may report findings which are not found in a file.
+	return PluginFindingKey{
+		PluginName: *info.PluginName,
+		FindingID:  *info.FindingID,
+		Path:       utils.ValueOrZero(info.Path),
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package findingtemplate
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"text/template"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/findingkey"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+const (
+	DefaultTitle       = `{{.Type}} {{.Key}}{{with .AssetID}} on asset {{.}}{{end}}`
+	DefaultDescription = `{{.Type}} {{.Key}} was found{{with .AssetID}} on asset {{.}}{{end}}` +
+		`{{with .Finding.FoundOn}} on {{.Format "2006-01-02 15:04 MST"}}{{end}}.`
+)
+
+// Families are the finding families which can have their own templates,
+// the objectTypes of the findingInfo of the findings.
+var Families = []string{
+	"Package",
+	"Vulnerability",
+	"Malware",
+	"Secret",
+	"Misconfiguration",
+	"Rootkit",
+	"Exploit",
+	"Certificate",
+	"ComplianceCheck",
+	"PluginFinding",
+}
+
+// Data is the object the templates are executed on.
+type Data struct {
+	// Type is the family of the finding.
+	Type string
+	// Key is the unique key of the finding in its family.
+	Key string
+	// Info is the findingInfo of the finding, e.g. a
+	// models.VulnerabilityFindingInfo.
+	Info interface{}
+	// AssetID is the ID of the asset the finding was found on.
+	AssetID string
+	Finding models.Finding
+}
+
+type templatePair struct {
+	title       *template.Template
+	description *template.Template
+}
+
+// Templates are the parsed finding templates.
+type Templates struct {
+	defaults templatePair
+	families map[string]templatePair
+}
+
+var builtin = mustParse(models.FindingTemplateSettings{})
+
+// Default returns the built-in Templates.
+func Default() *Templates {
+	return builtin
+}
+
+// Parse parses the templates of the settings, the built-in ones are used for
+// the unset templates.
+func Parse(settings models.FindingTemplateSettings) (*Templates, error) {
+	defaults, err := parsePair("", utils.ValueOrZero(settings.Title), utils.ValueOrZero(settings.Description), templatePair{})
+	if err != nil {
+		return nil, err
+	}
+
+	families := make(map[string]templatePair)
+	for family, familyTemplate := range utils.ValueOrZero(settings.Families) {
+		if !isFamily(family) {
+			return nil, fmt.Errorf("unknown finding family %q", family)
+		}
+		pair, err := parsePair("families."+family+".", utils.ValueOrZero(familyTemplate.Title),
+			utils.ValueOrZero(familyTemplate.Description), defaults)
+		if err != nil {
+			return nil, err
+		}
+		families[family] = pair
+	}
+
+	return &Templates{
+		defaults: defaults,
+		families: families,
+	}, nil
+}
+
+func mustParse(settings models.FindingTemplateSettings) *Templates {
+	t, err := Parse(settings)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// parsePair parses the title and description templates, using fallback for
+// the empty ones, or the built-in templates if fallback has none.
+func parsePair(prefix, title, description string, fallback templatePair) (templatePair, error) {
+	var pair templatePair
+	var err error
+
+	pair.title, err = parseTemplate(prefix+"title", title, DefaultTitle, fallback.title)
+	if err != nil {
+		return templatePair{}, err
+	}
+	pair.description, err = parseTemplate(prefix+"description", description, DefaultDescription, fallback.description)
+	if err != nil {
+		return templatePair{}, err
+	}
+
+	return pair, nil
+}
+
+func parseTemplate(name, text, defaultText string, fallback *template.Template) (*template.Template, error) {
+	if text == "" {
+		if fallback != nil {
+			return fallback, nil
+		}
+		text = defaultText
+	}
+
+	t, err := template.New(name).Funcs(funcs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s template: %w", name, err)
+	}
+	return t, nil
+}
+
+// Render renders the title and the description of the finding with the
+// templates of its family.
+func (t *Templates) Render(finding models.Finding) (models.RenderedFinding, error) {
+	data := newData(finding)
+
+	pair, ok := t.families[data.Type]
+	if !ok {
+		pair = t.defaults
+	}
+
+	title, err := execute(pair.title, data)
+	if err != nil {
+		return models.RenderedFinding{}, err
+	}
+	description, err := execute(pair.description, data)
+	if err != nil {
+		return models.RenderedFinding{}, err
+	}
+
+	return models.RenderedFinding{
+		Title:       title,
+		Description: description,
+	}, nil
+}
+
+func newData(finding models.Finding) Data {
+	data := Data{
+		Finding: finding,
+	}
+	if finding.Asset != nil {
+		data.AssetID = finding.Asset.Id
+	}
+	if finding.FindingInfo != nil {
+		data.Type, _ = finding.FindingInfo.Discriminator()
+		data.Info, _ = finding.FindingInfo.ValueByDiscriminator()
+		data.Key = findingKey(finding.FindingInfo)
+	}
+	return data
+}
+
+// findingKey returns the key of the finding info, or an empty string if the
+// info lacks any of the fields of the key, which GenerateFindingKey doesn't
+// check for.
+func findingKey(info *models.Finding_FindingInfo) (key string) {
+	defer func() {
+		if recover() != nil {
+			key = ""
+		}
+	}()
+
+	key, _ = findingkey.GenerateFindingKey(info)
+	return key
+}
+
+func execute(t *template.Template, data Data) (string, error) {
+	var b strings.Builder
+	if err := t.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("failed to render %s: %w", t.Name(), err)
+	}
+	return strings.TrimSpace(b.String()), nil
+}
+
+func isFamily(family string) bool {
+	for _, f := range Families {
+		if f == family {
+			return true
+		}
+	}
+	return false
+}
+
+var funcs = template.FuncMap{
+	"default": func(defaultValue string, v interface{}) string {
+		if s := toString(v); s != "" {
+			return s
+		}
+		return defaultValue
+	},
+	"upper": func(v interface{}) string {
+		return strings.ToUpper(toString(v))
+	},
+	"lower": func(v interface{}) string {
+		return strings.ToLower(toString(v))
+	},
+}
+
+// toString returns the value v points to as a string, or an empty string if
+// v is nil.
+func toString(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return ""
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return ""
+	}
+	return fmt.Sprint(rv.Interface())
+}
@@ -0,0 +1,199 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package findingtemplate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func newVulnerabilityFinding(t *testing.T, info models.VulnerabilityFindingInfo) models.Finding {
+	t.Helper()
+
+	findingInfo := &models.Finding_FindingInfo{}
+	if err := findingInfo.FromVulnerabilityFindingInfo(info); err != nil {
+		t.Fatalf("FromVulnerabilityFindingInfo() error = %v", err)
+	}
+	return models.Finding{
+		Asset:       &models.AssetRelationship{Id: "asset-1"},
+		FindingInfo: findingInfo,
+		FoundOn:     utils.PointerTo(time.Date(2023, time.May, 1, 10, 30, 0, 0, time.UTC)),
+	}
+}
+
+func TestTemplates_Render(t *testing.T) {
+	vulnerability := models.VulnerabilityFindingInfo{
+		VulnerabilityName: utils.PointerTo("CVE-2023-1234"),
+		Severity:          utils.PointerTo(models.CRITICAL),
+		Package: &models.Package{
+			Name:    utils.PointerTo("openssl"),
+			Version: utils.PointerTo("1.1.1"),
+		},
+	}
+
+	malwareInfo := &models.Finding_FindingInfo{}
+	if err := malwareInfo.FromMalwareFindingInfo(models.MalwareFindingInfo{
+		MalwareName: utils.PointerTo("eicar"),
+		MalwareType: utils.PointerTo("virus"),
+		Path:        utils.PointerTo("/tmp/eicar"),
+	}); err != nil {
+		t.Fatalf("FromMalwareFindingInfo() error = %v", err)
+	}
+	malware := models.Finding{FindingInfo: malwareInfo}
+
+	tests := []struct {
+		name     string
+		settings models.FindingTemplateSettings
+		finding  models.Finding
+		want     models.RenderedFinding
+	}{
+		{
+			name:     "built-in templates",
+			settings: models.FindingTemplateSettings{},
+			finding:  newVulnerabilityFinding(t, vulnerability),
+			want: models.RenderedFinding{
+				Title:       "Vulnerability CVE-2023-1234.openssl.1.1.1 on asset asset-1",
+				Description: "Vulnerability CVE-2023-1234.openssl.1.1.1 was found on asset asset-1 on 2023-05-01 10:30 UTC.",
+			},
+		},
+		{
+			name: "default templates",
+			settings: models.FindingTemplateSettings{
+				Title:       utils.PointerTo("[{{.Type}}] {{.Key}}"),
+				Description: utils.PointerTo("Found on {{.AssetID}}"),
+			},
+			finding: newVulnerabilityFinding(t, vulnerability),
+			want: models.RenderedFinding{
+				Title:       "[Vulnerability] CVE-2023-1234.openssl.1.1.1",
+				Description: "Found on asset-1",
+			},
+		},
+		{
+			name: "family template overrides the default title only",
+			settings: models.FindingTemplateSettings{
+				Description: utils.PointerTo("Found on {{.AssetID}}"),
+				Families: &map[string]models.FindingTemplate{
+					"Vulnerability": {
+						Title: utils.PointerTo("{{upper .Info.Severity}} {{.Info.VulnerabilityName}} in {{.Info.Package.Name}}"),
+					},
+				},
+			},
+			finding: newVulnerabilityFinding(t, vulnerability),
+			want: models.RenderedFinding{
+				Title:       "CRITICAL CVE-2023-1234 in openssl",
+				Description: "Found on asset-1",
+			},
+		},
+		{
+			name: "family template of another family",
+			settings: models.FindingTemplateSettings{
+				Families: &map[string]models.FindingTemplate{
+					"Vulnerability": {
+						Title: utils.PointerTo("{{.Info.VulnerabilityName}}"),
+					},
+				},
+			},
+			finding: malware,
+			want: models.RenderedFinding{
+				Title:       "Malware eicar.virus./tmp/eicar",
+				Description: "Malware eicar.virus./tmp/eicar was found.",
+			},
+		},
+		{
+			name: "default function",
+			settings: models.FindingTemplateSettings{
+				Title: utils.PointerTo(`{{default "unknown" .Info.Severity}} {{.Info.VulnerabilityName}}`),
+			},
+			finding: newVulnerabilityFinding(t, models.VulnerabilityFindingInfo{
+				VulnerabilityName: utils.PointerTo("CVE-2023-1234"),
+			}),
+			want: models.RenderedFinding{
+				Title:       "unknown CVE-2023-1234",
+				Description: "Vulnerability  was found on asset asset-1 on 2023-05-01 10:30 UTC.",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			templates, err := Parse(tt.settings)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			got, err := templates.Render(tt.finding)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Render() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings models.FindingTemplateSettings
+	}{
+		{
+			name: "invalid default template",
+			settings: models.FindingTemplateSettings{
+				Title: utils.PointerTo("{{.Type"),
+			},
+		},
+		{
+			name: "invalid family template",
+			settings: models.FindingTemplateSettings{
+				Families: &map[string]models.FindingTemplate{
+					"Malware": {Description: utils.PointerTo("{{end}}")},
+				},
+			},
+		},
+		{
+			name: "unknown family",
+			settings: models.FindingTemplateSettings{
+				Families: &map[string]models.FindingTemplate{
+					"Unknown": {Title: utils.PointerTo("{{.Key}}")},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(tt.settings); err == nil {
+				t.Errorf("Parse() error = nil, want an error")
+			}
+		})
+	}
+}
+
+func TestTemplates_RenderExecutionError(t *testing.T) {
+	templates, err := Parse(models.FindingTemplateSettings{
+		Title: utils.PointerTo("{{.Info.NoSuchField}}"),
+	})
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if _, err := templates.Render(newVulnerabilityFinding(t, models.VulnerabilityFindingInfo{})); err == nil {
+		t.Errorf("Render() error = nil, want an error")
+	}
+}
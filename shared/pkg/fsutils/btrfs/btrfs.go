@@ -0,0 +1,50 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package btrfs
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// rootSubvolumes are the names distributions use for the subvolume of the
+// root filesystem when it is not the default subvolume (e.g. "@" on Ubuntu
+// and Debian, "root" on Fedora).
+var rootSubvolumes = []string{"@", "root", "@root", "@rootfs", "rootfs"}
+
+// RootPath returns the path of the root filesystem in a btrfs filesystem
+// mounted with its default subvolume. If the default subvolume is the top
+// level one, the root filesystem is in one of its child subvolumes. The
+// mountPoint is returned if no root filesystem is found.
+func RootPath(mountPoint string) string {
+	if isRootFS(mountPoint) {
+		return mountPoint
+	}
+
+	for _, name := range rootSubvolumes {
+		path := filepath.Join(mountPoint, name)
+		if isRootFS(path) {
+			return path
+		}
+	}
+
+	return mountPoint
+}
+
+func isRootFS(path string) bool {
+	info, err := os.Stat(filepath.Join(path, "etc"))
+	return err == nil && info.IsDir()
+}
@@ -0,0 +1,67 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package btrfs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestRootPath(t *testing.T) {
+	tests := []struct {
+		Name string
+		Dirs []string
+
+		ExpectedRootPath string
+	}{
+		{
+			Name:             "Root filesystem in default subvolume",
+			Dirs:             []string{"etc", "usr", "@home"},
+			ExpectedRootPath: "",
+		},
+		{
+			Name:             "Ubuntu layout",
+			Dirs:             []string{"@/etc", "@/usr", "@home/user"},
+			ExpectedRootPath: "@",
+		},
+		{
+			Name:             "Fedora layout",
+			Dirs:             []string{"root/etc", "home/user"},
+			ExpectedRootPath: "root",
+		},
+		{
+			Name:             "No root filesystem",
+			Dirs:             []string{"data", "backups"},
+			ExpectedRootPath: "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			mountPoint := t.TempDir()
+			for _, dir := range test.Dirs {
+				g.Expect(os.MkdirAll(filepath.Join(mountPoint, dir), 0o755)).Should(Succeed())
+			}
+
+			g.Expect(RootPath(mountPoint)).Should(Equal(filepath.Join(mountPoint, test.ExpectedRootPath)))
+		})
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+)
+
+// ErrUnsupported is returned if the location of the file data on the block
+// device cannot be determined for the filesystem or the platform.
+var ErrUnsupported = errors.New("getting file extents is unsupported")
+
+// Extent is a range of bytes on a block device.
+type Extent struct {
+	Offset int64
+	Length int64
+}
+
+type Changes struct {
+	// Paths are the files and directories which have data in the changed
+	// extents, relative to the root of the filesystem.
+	Paths []string
+	// Files are the regular files which need to be scanned again, as they
+	// are changed or their directory is changed, relative to the root of
+	// the filesystem.
+	Files []string
+}
+
+// extentsFunc returns the extents of the file data on the block device
+// relative to the start of the filesystem. It returns nil extents if the
+// location of the data is not known, in which case the file is considered
+// changed.
+type extentsFunc func(path string) ([]Extent, error)
+
+// Find returns the files and directories under root which have data in the
+// changed extents of the block device. The offset is the start of the
+// filesystem mounted at root on the block device.
+func Find(ctx context.Context, root string, offset int64, changed []Extent) (*Changes, error) {
+	return find(ctx, root, offset, changed, fileExtents)
+}
+
+func find(ctx context.Context, root string, offset int64, changed []Extent, getExtents extentsFunc) (*Changes, error) {
+	changed = sortExtents(changed)
+	changedDirs := map[string]bool{}
+	ret := &Changes{}
+
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err() // nolint:wrapcheck
+		}
+		if !d.IsDir() && !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return fmt.Errorf("failed to get relative path of %s: %w", path, err)
+		}
+		rel = "/" + filepath.ToSlash(rel)
+		if rel == "/." {
+			rel = "/"
+		}
+
+		extents, err := getExtents(path)
+		if err != nil {
+			return err
+		}
+		isChanged := extents == nil
+		for _, e := range extents {
+			if overlaps(changed, Extent{Offset: offset + e.Offset, Length: e.Length}) {
+				isChanged = true
+				break
+			}
+		}
+
+		if isChanged {
+			ret.Paths = append(ret.Paths, rel)
+		}
+		if d.IsDir() {
+			changedDirs[rel] = isChanged
+		} else if isChanged || changedDirs[filepath.ToSlash(filepath.Dir(rel))] {
+			ret.Files = append(ret.Files, rel)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find changed files in %s: %w", root, err)
+	}
+
+	return ret, nil
+}
+
+func sortExtents(extents []Extent) []Extent {
+	sorted := make([]Extent, len(extents))
+	copy(sorted, extents)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Offset < sorted[j].Offset
+	})
+	return sorted
+}
+
+// overlaps returns true if the extent overlaps any of the sorted and non
+// overlapping extents.
+func overlaps(sorted []Extent, e Extent) bool {
+	i := sort.Search(len(sorted), func(i int) bool {
+		return sorted[i].Offset+sorted[i].Length > e.Offset
+	})
+	return i < len(sorted) && sorted[i].Offset < e.Offset+e.Length
+}
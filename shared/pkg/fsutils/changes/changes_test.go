@@ -0,0 +1,115 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changes
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestFind(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	root := t.TempDir()
+	for _, dir := range []string{"etc", "home/user"} {
+		g.Expect(os.MkdirAll(filepath.Join(root, dir), 0o755)).Should(Succeed())
+	}
+	for _, file := range []string{"etc/passwd", "etc/hosts", "home/user/key", "home/user/notes"} {
+		g.Expect(os.WriteFile(filepath.Join(root, file), []byte("data"), 0o600)).Should(Succeed())
+	}
+
+	// Extents of the files relative to the start of the filesystem, which
+	// starts at offset 1000 on the device.
+	extents := map[string][]Extent{
+		"/":                {{Offset: 0, Length: 10}},
+		"/etc":             {{Offset: 10, Length: 10}},
+		"/etc/hosts":       {{Offset: 20, Length: 10}},
+		"/etc/passwd":      {{Offset: 30, Length: 10}, {Offset: 100, Length: 10}},
+		"/home":            {{Offset: 40, Length: 10}},
+		"/home/user":       {{Offset: 50, Length: 10}},
+		"/home/user/key":   nil,
+		"/home/user/notes": {{Offset: 60, Length: 10}},
+	}
+	getExtents := func(path string) ([]Extent, error) {
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return nil, err
+		}
+		return extents[filepath.Clean("/"+rel)], nil
+	}
+
+	changed := []Extent{
+		{Offset: 1105, Length: 1},
+		{Offset: 1015, Length: 3},
+	}
+
+	changes, err := find(context.Background(), root, 1000, changed, getExtents)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(changes.Paths).Should(ConsistOf("/etc", "/etc/passwd", "/home/user/key"))
+	// The unchanged files of changed directories are scanned again as well.
+	g.Expect(changes.Files).Should(ConsistOf("/etc/hosts", "/etc/passwd", "/home/user/key"))
+}
+
+func TestOverlaps(t *testing.T) {
+	sorted := []Extent{
+		{Offset: 10, Length: 10},
+		{Offset: 40, Length: 5},
+	}
+
+	tests := []struct {
+		Name           string
+		Extent         Extent
+		ExpectedResult bool
+	}{
+		{
+			Name:           "Before all extents",
+			Extent:         Extent{Offset: 0, Length: 10},
+			ExpectedResult: false,
+		},
+		{
+			Name:           "Overlaps the start of an extent",
+			Extent:         Extent{Offset: 5, Length: 6},
+			ExpectedResult: true,
+		},
+		{
+			Name:           "Inside an extent",
+			Extent:         Extent{Offset: 41, Length: 1},
+			ExpectedResult: true,
+		},
+		{
+			Name:           "Between extents",
+			Extent:         Extent{Offset: 20, Length: 20},
+			ExpectedResult: false,
+		},
+		{
+			Name:           "After all extents",
+			Extent:         Extent{Offset: 45, Length: 100},
+			ExpectedResult: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			g.Expect(overlaps(sorted, test.Extent)).Should(Equal(test.ExpectedResult))
+		})
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+// +build linux
+
+package changes
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"unsafe"
+)
+
+const (
+	// fsIocFiemap is the FS_IOC_FIEMAP ioctl request, _IOWR('f', 11, struct fiemap).
+	fsIocFiemap = 0xC020660B
+
+	fiemapExtentCount = 64
+
+	fiemapExtentLast       = 0x00000001
+	fiemapExtentUnknown    = 0x00000002
+	fiemapExtentDelalloc   = 0x00000004
+	fiemapExtentNotAligned = 0x00000100
+	fiemapExtentDataInline = 0x00000200
+
+	// Extents with these flags do not have a known location on the block device.
+	fiemapExtentUnknownLocation = fiemapExtentUnknown | fiemapExtentDelalloc | fiemapExtentNotAligned | fiemapExtentDataInline
+
+	sectorSize = 512
+)
+
+// fiemapExtent is struct fiemap_extent from linux/fiemap.h.
+type fiemapExtent struct {
+	Logical    uint64
+	Physical   uint64
+	Length     uint64
+	Reserved64 [2]uint64
+	Flags      uint32
+	Reserved   [3]uint32
+}
+
+// fiemap is struct fiemap from linux/fiemap.h with room for a fixed number of
+// extents.
+type fiemap struct {
+	Start         uint64
+	Length        uint64
+	Flags         uint32
+	MappedExtents uint32
+	ExtentCount   uint32
+	Reserved      uint32
+	Extents       [fiemapExtentCount]fiemapExtent
+}
+
+// fileExtents returns the extents of the file data on the block device using
+// the FIEMAP ioctl.
+func fileExtents(path string) ([]Extent, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	extents := []Extent{}
+	var start uint64
+	for {
+		fm := fiemap{
+			Start:       start,
+			Length:      ^uint64(0) - start,
+			ExtentCount: fiemapExtentCount,
+		}
+		// nolint:gosec
+		_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, f.Fd(), fsIocFiemap, uintptr(unsafe.Pointer(&fm)))
+		if errno != 0 {
+			if errors.Is(errno, syscall.EOPNOTSUPP) || errors.Is(errno, syscall.ENOTTY) {
+				return nil, ErrUnsupported
+			}
+			return nil, fmt.Errorf("failed to get extents of %s: %w", path, errno)
+		}
+
+		for i := uint32(0); i < fm.MappedExtents; i++ {
+			e := fm.Extents[i]
+			if e.Flags&fiemapExtentUnknownLocation != 0 {
+				return nil, nil
+			}
+			extents = append(extents, Extent{
+				Offset: int64(e.Physical),
+				Length: int64(e.Length),
+			})
+			if e.Flags&fiemapExtentLast != 0 {
+				return extents, nil
+			}
+		}
+
+		if fm.MappedExtents < fiemapExtentCount {
+			return extents, nil
+		}
+		last := fm.Extents[fm.MappedExtents-1]
+		start = last.Logical + last.Length
+	}
+}
+
+// PartitionOffset returns the offset in bytes of the partition on its disk,
+// or 0 if the device is not a partition.
+func PartitionOffset(devicePath string) (int64, error) {
+	path, err := filepath.EvalSymlinks(devicePath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to resolve device path %s: %w", devicePath, err)
+	}
+
+	start, err := os.ReadFile(filepath.Join("/sys/class/block", filepath.Base(path), "start"))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read partition start of %s: %w", devicePath, err)
+	}
+
+	sectors, err := strconv.ParseInt(strings.TrimSpace(string(start)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse partition start of %s: %w", devicePath, err)
+	}
+
+	return sectors * sectorSize, nil
+}
@@ -25,4 +25,7 @@ const (
 	Ntfs     FilesystemType = "ntfs"
 	ReiserFs FilesystemType = "reiserfs"
 	Btrfs    FilesystemType = "btrfs"
+	Vfat     FilesystemType = "vfat"
+	// ZfsMember is reported for the devices which are part of a ZFS pool.
+	ZfsMember FilesystemType = "zfs_member"
 )
@@ -0,0 +1,214 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zfs
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/openclarity/vmclarity/shared/pkg/command"
+)
+
+// Pool is a ZFS pool which can be imported.
+type Pool struct {
+	Name string
+	ID   string
+}
+
+// Dataset is a ZFS filesystem dataset.
+type Dataset struct {
+	Name       string
+	MountPoint string
+	CanMount   string
+}
+
+type ZFS struct {
+	ZpoolBinaryPath string
+	ZfsBinaryPath   string
+	Environment     []string
+}
+
+func New() *ZFS {
+	return &ZFS{
+		ZpoolBinaryPath: "zpool",
+		ZfsBinaryPath:   "zfs",
+		Environment:     os.Environ(),
+	}
+}
+
+// ImportablePools returns the pools which have a member on the device.
+func (z *ZFS) ImportablePools(ctx context.Context, device string) ([]Pool, error) {
+	result, err := z.run(ctx, z.ZpoolBinaryPath, "import", "-d", device)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list importable pools. Device=%s: %w", device, err)
+	}
+
+	return parseImportablePools(result), nil
+}
+
+// ImportReadOnly imports the pool read-only under altRoot with a temporary
+// name, to avoid clashing with the pools of the host, and mounts its
+// datasets. The boot filesystem of the pool is mounted as altRoot.
+func (z *ZFS) ImportReadOnly(ctx context.Context, device string, pool Pool, altRoot string) error {
+	name := "vmclarity-" + pool.ID
+
+	_, err := z.run(ctx, z.ZpoolBinaryPath, "import", "-d", device,
+		"-o", "readonly=on", "-R", altRoot, "-N", "-f", "-t", pool.ID, name)
+	if err != nil {
+		return fmt.Errorf("failed to import pool. Pool=%s Device=%s: %w", pool.Name, device, err)
+	}
+
+	result, err := z.run(ctx, z.ZpoolBinaryPath, "get", "-H", "-o", "value", "bootfs", name)
+	if err != nil {
+		return fmt.Errorf("failed to get boot filesystem of pool. Pool=%s: %w", pool.Name, err)
+	}
+	// The bootfs property still refers to the original name of the pool.
+	bootfs := strings.TrimSpace(result.String())
+	if rest, ok := strings.CutPrefix(bootfs, pool.Name); ok {
+		bootfs = name + rest
+	}
+
+	result, err = z.run(ctx, z.ZfsBinaryPath, "list", "-H", "-t", "filesystem", "-o", "name,mountpoint,canmount", "-r", name)
+	if err != nil {
+		return fmt.Errorf("failed to list datasets of pool. Pool=%s: %w", pool.Name, err)
+	}
+
+	for _, dataset := range datasetsToMount(parseDatasets(result), bootfs, altRoot) {
+		_, err = z.run(ctx, z.ZfsBinaryPath, "mount", "-o", "ro,noexec,nosuid,noatime", dataset.Name)
+		if err != nil {
+			return fmt.Errorf("failed to mount dataset. Dataset=%s MountPoint=%s: %w", dataset.Name, dataset.MountPoint, err)
+		}
+	}
+
+	return nil
+}
+
+func (z *ZFS) run(ctx context.Context, binary string, args ...string) (*bytes.Buffer, error) {
+	cmd := &command.Command{
+		Cmd:  binary,
+		Args: args,
+		Env:  z.Environment,
+	}
+
+	result, err := cmd.Run(ctx)
+	if err != nil {
+		if result != nil && result.StdErr.Len() > 0 {
+			return nil, fmt.Errorf("%w: %s", err, strings.TrimSpace(result.StdErr.String()))
+		}
+		return nil, fmt.Errorf("failed to run %s command: %w", binary, err)
+	}
+
+	return result.StdOut, nil
+}
+
+// parseImportablePools parses the output of "zpool import" listing the
+// importable pools, each of them starting with a "pool:" and "id:" line.
+func parseImportablePools(b *bytes.Buffer) []Pool {
+	if b == nil {
+		return nil
+	}
+
+	var pools []Pool
+	scanner := bufio.NewScanner(b)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "pool":
+			pools = append(pools, Pool{Name: value})
+		case "id":
+			if len(pools) > 0 && pools[len(pools)-1].ID == "" {
+				pools[len(pools)-1].ID = value
+			}
+		}
+	}
+
+	return pools
+}
+
+// parseDatasets parses the tab separated output of "zfs list -H -o name,mountpoint,canmount".
+func parseDatasets(b *bytes.Buffer) []Dataset {
+	if b == nil {
+		return nil
+	}
+
+	var datasets []Dataset
+	scanner := bufio.NewScanner(b)
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), "\t")
+		if len(fields) != 3 {
+			continue
+		}
+		datasets = append(datasets, Dataset{
+			Name:       fields[0],
+			MountPoint: fields[1],
+			CanMount:   fields[2],
+		})
+	}
+
+	return datasets
+}
+
+// datasetsToMount returns the datasets to mount in mount order. Boot
+// environments share the root mountpoint, so only the boot filesystem is
+// mounted as root if it is set, and the other datasets are only mounted if
+// they would be mounted on boot.
+func datasetsToMount(datasets []Dataset, bootfs, altRoot string) []Dataset {
+	isRoot := func(d Dataset) bool {
+		return filepath.Clean(d.MountPoint) == filepath.Clean(altRoot)
+	}
+	hasMountPoint := func(d Dataset) bool {
+		return d.MountPoint != "none" && d.MountPoint != "legacy" && d.MountPoint != "-"
+	}
+
+	var ret []Dataset
+	var rootMounted bool
+	for _, d := range datasets {
+		if d.Name == bootfs && d.CanMount != "off" && hasMountPoint(d) {
+			ret = append(ret, d)
+			rootMounted = isRoot(d)
+		}
+	}
+	for _, d := range datasets {
+		if d.Name == bootfs || d.CanMount != "on" || !hasMountPoint(d) {
+			continue
+		}
+		if isRoot(d) {
+			if rootMounted {
+				continue
+			}
+			rootMounted = true
+		}
+		ret = append(ret, d)
+	}
+
+	// Mount parents before their children.
+	sort.SliceStable(ret, func(i, j int) bool {
+		return strings.Count(filepath.Clean(ret[i].MountPoint), "/") < strings.Count(filepath.Clean(ret[j].MountPoint), "/")
+	})
+
+	return ret
+}
@@ -0,0 +1,101 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zfs
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+const zpoolImportOutput = `   pool: rpool
+     id: 5395414066339327497
+  state: ONLINE
+ action: The pool can be imported using its name or numeric identifier.
+ config:
+
+	rpool       ONLINE
+	  nvme1n1p4  ONLINE
+
+   pool: bpool
+     id: 1190493123457843901
+  state: ONLINE
+ action: The pool can be imported using its name or numeric identifier.
+ config:
+
+	bpool       ONLINE
+	  nvme1n1p3  ONLINE
+`
+
+func TestParseImportablePools(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(parseImportablePools(nil)).Should(BeNil())
+	g.Expect(parseImportablePools(bytes.NewBufferString(zpoolImportOutput))).Should(Equal([]Pool{
+		{Name: "rpool", ID: "5395414066339327497"},
+		{Name: "bpool", ID: "1190493123457843901"},
+	}))
+}
+
+func TestDatasetsToMount(t *testing.T) {
+	const altRoot = "/mnt/snapshots/1234"
+
+	output := "vmclarity-1\t/mnt/snapshots/1234\toff\n" +
+		"vmclarity-1/ROOT\tnone\toff\n" +
+		"vmclarity-1/ROOT/ubuntu_old\t/mnt/snapshots/1234\tnoauto\n" +
+		"vmclarity-1/ROOT/ubuntu_new\t/mnt/snapshots/1234\tnoauto\n" +
+		"vmclarity-1/ROOT/ubuntu_new/var\t/mnt/snapshots/1234/var\ton\n" +
+		"vmclarity-1/USERDATA/home\t/mnt/snapshots/1234/home\ton\n" +
+		"vmclarity-1/docker\tlegacy\ton\n"
+
+	tests := []struct {
+		Name   string
+		Bootfs string
+
+		ExpectedDatasets []string
+	}{
+		{
+			Name:   "Boot filesystem is mounted as root",
+			Bootfs: "vmclarity-1/ROOT/ubuntu_new",
+			ExpectedDatasets: []string{
+				"vmclarity-1/ROOT/ubuntu_new",
+				"vmclarity-1/ROOT/ubuntu_new/var",
+				"vmclarity-1/USERDATA/home",
+			},
+		},
+		{
+			Name:   "No boot filesystem",
+			Bootfs: "-",
+			ExpectedDatasets: []string{
+				"vmclarity-1/ROOT/ubuntu_new/var",
+				"vmclarity-1/USERDATA/home",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			var names []string
+			for _, d := range datasetsToMount(parseDatasets(bytes.NewBufferString(output)), test.Bootfs, altRoot) {
+				names = append(names, d.Name)
+			}
+			g.Expect(names).Should(Equal(test.ExpectedDatasets))
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+)
+
+// AzureBlobStore stores the objects as block blobs in an Azure storage
+// container, named by the SHA-256 digest of their content, e.g. the object
+// with key "sha256:ab12..." is stored as sha256/ab12.... The requests are
+// authenticated with the default Azure credential chain.
+type AzureBlobStore struct {
+	client    *azblob.Client
+	container string
+}
+
+// NewAzureBlobStore returns the store of the container of the storage account
+// with serviceURL, e.g. https://<account>.blob.core.windows.net/.
+func NewAzureBlobStore(serviceURL, container string) (*AzureBlobStore, error) {
+	if serviceURL == "" || container == "" {
+		return nil, fmt.Errorf("object store service URL and container must be set")
+	}
+
+	cred, err := azidentity.NewDefaultAzureCredential(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Azure credential: %w", err)
+	}
+	client, err := azblob.NewClient(serviceURL, cred, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create blob client: %w", err)
+	}
+
+	return &AzureBlobStore{client: client, container: container}, nil
+}
+
+func (s *AzureBlobStore) Put(ctx context.Context, data []byte) (string, error) {
+	sum := sha256.Sum256(data)
+	digest := hex.EncodeToString(sum[:])
+
+	// Storing the same content again overwrites the blob with itself.
+	if _, err := s.client.UploadBuffer(ctx, s.container, blobName(digest), data, nil); err != nil {
+		return "", fmt.Errorf("failed to upload object: %w", err)
+	}
+
+	return keyPrefix + digest, nil
+}
+
+func (s *AzureBlobStore) Get(ctx context.Context, key string) ([]byte, error) {
+	digest, err := parseKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.DownloadStream(ctx, s.container, blobName(digest), nil)
+	if err != nil {
+		if bloberror.HasCode(err, bloberror.BlobNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to download object: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+
+	return data, nil
+}
+
+func (s *AzureBlobStore) Delete(ctx context.Context, key string) error {
+	digest, err := parseKey(key)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.client.DeleteBlob(ctx, s.container, blobName(digest), nil); err != nil && !bloberror.HasCode(err, bloberror.BlobNotFound) {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+
+	return nil
+}
+
+func blobName(digest string) string {
+	return path.Join("sha256", digest)
+}
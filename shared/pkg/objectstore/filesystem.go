@@ -0,0 +1,131 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const keyPrefix = "sha256:"
+
+var keyDigestPattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// FilesystemStore stores the objects as files in a directory, named by the
+// SHA-256 digest of their content, e.g. the object with key "sha256:ab12..."
+// is stored in <dir>/sha256/ab/ab12....
+type FilesystemStore struct {
+	dir string
+}
+
+func NewFilesystemStore(dir string) (*FilesystemStore, error) {
+	if dir == "" {
+		return nil, errors.New("object store directory is not set")
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil { // nolint:gomnd
+		return nil, fmt.Errorf("failed to create object store directory: %w", err)
+	}
+	return &FilesystemStore{dir: dir}, nil
+}
+
+func (s *FilesystemStore) Put(_ context.Context, data []byte) (string, error) {
+	sum := sha256.Sum256(data)
+	digest := hex.EncodeToString(sum[:])
+	path := s.path(digest)
+
+	// The content of an object never changes, so an existing file is
+	// already the object.
+	if _, err := os.Stat(path); err == nil {
+		return keyPrefix + digest, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil { // nolint:gomnd
+		return "", fmt.Errorf("failed to create object directory: %w", err)
+	}
+
+	// The data is written to a temporary file first so that a partially
+	// written object is never visible under its key, even if the same
+	// object is stored concurrently.
+	tmp, err := os.CreateTemp(filepath.Dir(path), digest+".*.tmp")
+	if err != nil {
+		return "", fmt.Errorf("failed to create object file: %w", err)
+	}
+	defer os.Remove(tmp.Name()) // nolint:errcheck
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return "", fmt.Errorf("failed to write object: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("failed to write object: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", fmt.Errorf("failed to write object: %w", err)
+	}
+
+	return keyPrefix + digest, nil
+}
+
+func (s *FilesystemStore) Get(_ context.Context, key string) ([]byte, error) {
+	digest, err := parseKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(s.path(digest))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+
+	return data, nil
+}
+
+func (s *FilesystemStore) Delete(_ context.Context, key string) error {
+	digest, err := parseKey(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(s.path(digest)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+
+	return nil
+}
+
+func (s *FilesystemStore) path(digest string) string {
+	return filepath.Join(s.dir, "sha256", digest[:2], digest)
+}
+
+// parseKey returns the digest of the key, the key is validated so that it
+// can't refer to a file outside the store.
+func parseKey(key string) (string, error) {
+	digest, ok := strings.CutPrefix(key, keyPrefix)
+	if !ok || !keyDigestPattern.MatchString(digest) {
+		return "", fmt.Errorf("invalid object key %q", key)
+	}
+	return digest, nil
+}
@@ -0,0 +1,91 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrNotFound is returned when no object is stored under the key.
+var ErrNotFound = errors.New("object not found")
+
+// Store stores immutable objects, e.g. generated reports or the raw outputs
+// of the scanners, under the key derived from their content.
+type Store interface {
+	// Put stores data and returns its key. Storing the same data again
+	// returns the same key.
+	Put(ctx context.Context, data []byte) (string, error)
+	// Get returns the data stored under key, or ErrNotFound.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Delete removes the data stored under key, deleting a missing object
+	// is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+type DriverType string
+
+const (
+	// DriverTypeFilesystem stores the objects in a local directory, it is
+	// the store of the single node installs using the local database.
+	DriverTypeFilesystem DriverType = "FILESYSTEM"
+	// DriverTypeS3 stores the objects in an S3 bucket.
+	DriverTypeS3 DriverType = "S3"
+	// DriverTypeAzureBlob stores the objects in an Azure storage container.
+	DriverTypeAzureBlob DriverType = "AZURE_BLOB"
+)
+
+type Config struct {
+	Driver DriverType
+	// Path is the directory of the filesystem store.
+	Path string
+	// S3Bucket and S3Region are the bucket of the S3 store and its region,
+	// S3Endpoint is only set for S3 compatible stores other than AWS.
+	S3Bucket   string
+	S3Region   string
+	S3Endpoint string
+	// AzureBlobServiceURL is the blob service URL of the storage account of
+	// the Azure blob store, and AzureBlobContainer its container.
+	AzureBlobServiceURL string
+	AzureBlobContainer  string
+}
+
+// New returns the store of the configured driver.
+func New(ctx context.Context, config Config) (Store, error) {
+	switch config.Driver {
+	case DriverTypeFilesystem:
+		store, err := NewFilesystemStore(config.Path)
+		if err != nil {
+			return nil, err
+		}
+		return store, nil
+	case DriverTypeS3:
+		store, err := NewS3Store(ctx, config.S3Bucket, config.S3Region, config.S3Endpoint)
+		if err != nil {
+			return nil, err
+		}
+		return store, nil
+	case DriverTypeAzureBlob:
+		store, err := NewAzureBlobStore(config.AzureBlobServiceURL, config.AzureBlobContainer)
+		if err != nil {
+			return nil, err
+		}
+		return store, nil
+	default:
+		return nil, fmt.Errorf("object store driver type %q is not supported", config.Driver)
+	}
+}
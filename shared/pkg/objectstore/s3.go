@@ -0,0 +1,200 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+)
+
+const (
+	s3Service = "s3"
+	// emptyPayloadHash is the SHA-256 digest of the empty request body.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	// maxErrorBodySize is the size of the error response body read into the
+	// error message.
+	maxErrorBodySize = 1024
+)
+
+// S3Store stores the objects in an S3 bucket, named by the SHA-256 digest of
+// their content, e.g. the object with key "sha256:ab12..." is stored as
+// sha256/ab12.... The requests are signed with the credentials of the default
+// AWS credential chain, so the store also works with the S3 compatible stores
+// supporting signature version 4.
+type S3Store struct {
+	bucketURL   *url.URL
+	region      string
+	credentials aws.CredentialsProvider
+	signer      *v4.Signer
+	client      *http.Client
+}
+
+// NewS3Store returns the store of the bucket in region. The bucket is
+// addressed as a virtual host of the AWS endpoint of the region, or as a path
+// of endpoint if set.
+func NewS3Store(ctx context.Context, bucket, region, endpoint string) (*S3Store, error) {
+	if bucket == "" || region == "" {
+		return nil, fmt.Errorf("object store bucket and region must be set")
+	}
+
+	bucketURL, err := s3BucketURL(bucket, region, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(region))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+
+	return newS3Store(bucketURL, region, cfg.Credentials, http.DefaultClient), nil
+}
+
+func newS3Store(bucketURL *url.URL, region string, credentials aws.CredentialsProvider, client *http.Client) *S3Store {
+	return &S3Store{
+		bucketURL:   bucketURL,
+		region:      region,
+		credentials: credentials,
+		signer:      v4.NewSigner(),
+		client:      client,
+	}
+}
+
+func s3BucketURL(bucket, region, endpoint string) (*url.URL, error) {
+	if endpoint == "" {
+		return &url.URL{Scheme: "https", Host: fmt.Sprintf("%s.s3.%s.amazonaws.com", bucket, region)}, nil
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid object store endpoint: %w", err)
+	}
+	return u.JoinPath(bucket), nil
+}
+
+func (s *S3Store) Put(ctx context.Context, data []byte) (string, error) {
+	sum := sha256.Sum256(data)
+	digest := hex.EncodeToString(sum[:])
+
+	// Storing the same content again overwrites the object with itself.
+	resp, err := s.do(ctx, http.MethodPut, digest, data)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", s3Error(resp)
+	}
+
+	return keyPrefix + digest, nil
+}
+
+func (s *S3Store) Get(ctx context.Context, key string) ([]byte, error) {
+	digest, err := parseKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.do(ctx, http.MethodGet, digest, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, s3Error(resp)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+
+	return data, nil
+}
+
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	digest, err := parseKey(key)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.do(ctx, http.MethodDelete, digest, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Deleting a missing object succeeds in S3.
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return s3Error(resp)
+	}
+
+	return nil
+}
+
+// do sends the signed request of the object with digest.
+func (s *S3Store) do(ctx context.Context, method, digest string, data []byte) (*http.Response, error) {
+	payloadHash := emptyPayloadHash
+	var body io.Reader
+	if data != nil {
+		// The digest of the object is the digest of its content.
+		payloadHash = digest
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, s.bucketURL.JoinPath("sha256", digest).String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	credentials, err := s.credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve AWS credentials: %w", err)
+	}
+	if err := s.signer.SignHTTP(ctx, credentials, req, payloadHash, s3Service, s.region, time.Now()); err != nil {
+		return nil, fmt.Errorf("failed to sign request: %w", err)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send %s request: %w", method, err)
+	}
+
+	return resp, nil
+}
+
+func s3Error(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, body)
+}
@@ -0,0 +1,163 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package report
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// base64LineLength is the maximum line length of base64 encoded MIME parts.
+const base64LineLength = 76
+
+var ErrSMTPNotConfigured = errors.New("SMTP server is not configured")
+
+// SMTPConfig is the mail server the reports are delivered through.
+type SMTPConfig struct {
+	// Address is the host:port of the server, reports are not delivered if
+	// it is not set.
+	Address string
+	// Username and Password are used for PLAIN authentication if Username
+	// is set.
+	Username string
+	Password string
+	// From is the sender address of the reports.
+	From string
+}
+
+// Attachment is a file attached to the message.
+type Attachment struct {
+	Name        string
+	ContentType string
+	Data        []byte
+}
+
+// Message is an email with a plain text body and an optional attachment.
+type Message struct {
+	From       string
+	To         []string
+	Subject    string
+	Date       time.Time
+	Body       string
+	Attachment *Attachment
+}
+
+// Bytes returns the message encoded as a MIME multipart message.
+func (m Message) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+
+	fmt.Fprintf(&buf, "From: %s\r\n", m.From)
+	fmt.Fprintf(&buf, "To: %s\r\n", strings.Join(m.To, ", "))
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", m.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", m.Date.Format(time.RFC1123Z))
+	fmt.Fprintf(&buf, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%q\r\n\r\n", w.Boundary())
+
+	body, err := w.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {"text/plain; charset=utf-8"},
+		"Content-Transfer-Encoding": {"8bit"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create body part: %w", err)
+	}
+	if _, err = body.Write([]byte(m.Body)); err != nil {
+		return nil, fmt.Errorf("failed to write body part: %w", err)
+	}
+
+	if m.Attachment == nil {
+		if err = w.Close(); err != nil {
+			return nil, fmt.Errorf("failed to close message: %w", err)
+		}
+		return buf.Bytes(), nil
+	}
+
+	file, err := w.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {mime.FormatMediaType(m.Attachment.ContentType, map[string]string{"name": m.Attachment.Name})},
+		"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": m.Attachment.Name})},
+		"Content-Transfer-Encoding": {"base64"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create attachment part: %w", err)
+	}
+	encoded := base64.StdEncoding.EncodeToString(m.Attachment.Data)
+	for len(encoded) > 0 {
+		n := base64LineLength
+		if len(encoded) < n {
+			n = len(encoded)
+		}
+		if _, err = fmt.Fprintf(file, "%s\r\n", encoded[:n]); err != nil {
+			return nil, fmt.Errorf("failed to write attachment part: %w", err)
+		}
+		encoded = encoded[n:]
+	}
+
+	if err = w.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close message: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Send delivers the m message through the SMTP server.
+func (c SMTPConfig) Send(m Message) error {
+	if c.Address == "" {
+		return ErrSMTPNotConfigured
+	}
+
+	from, err := mail.ParseAddress(m.From)
+	if err != nil {
+		return fmt.Errorf("invalid sender address %q: %w", m.From, err)
+	}
+	to := make([]string, 0, len(m.To))
+	for _, recipient := range m.To {
+		addr, err := mail.ParseAddress(recipient)
+		if err != nil {
+			return fmt.Errorf("invalid recipient address %q: %w", recipient, err)
+		}
+		to = append(to, addr.Address)
+	}
+
+	msg, err := m.Bytes()
+	if err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if c.Username != "" {
+		host, _, err := net.SplitHostPort(c.Address)
+		if err != nil {
+			return fmt.Errorf("invalid SMTP server address %q: %w", c.Address, err)
+		}
+		auth = smtp.PlainAuth("", c.Username, c.Password, host)
+	}
+
+	if err := smtp.SendMail(c.Address, auth, from.Address, to, msg); err != nil {
+		return fmt.Errorf("failed to send mail: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package report
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMessage_Bytes(t *testing.T) {
+	// Large enough for the encoded attachment to span multiple lines.
+	data := bytes.Repeat([]byte("%PDF-1.4"), 100)
+	m := Message{
+		From:    "VMClarity <vmclarity@example.com>",
+		To:      []string{"ciso@example.com", "security@example.com"},
+		Subject: "Weekly – executive summary",
+		Date:    time.Date(2023, 3, 27, 8, 0, 0, 0, time.UTC),
+		Body:    "The summary is attached.",
+		Attachment: &Attachment{
+			Name:        "executive-summary-2023-03-27.pdf",
+			ContentType: "application/pdf",
+			Data:        data,
+		},
+	}
+
+	encoded, err := m.Bytes()
+	if err != nil {
+		t.Fatalf("Bytes() unexpected error: %v", err)
+	}
+
+	msg, err := mail.ReadMessage(bytes.NewReader(encoded))
+	if err != nil {
+		t.Fatalf("failed to parse message: %v", err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		t.Fatalf("failed to decode subject: %v", err)
+	}
+	if subject != m.Subject {
+		t.Errorf("Subject = %q, want %q", subject, m.Subject)
+	}
+	to, err := msg.Header.AddressList("To")
+	if err != nil {
+		t.Fatalf("failed to parse recipients: %v", err)
+	}
+	if len(to) != 2 {
+		t.Errorf("got %d recipients, want 2", len(to))
+	}
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/mixed" {
+		t.Fatalf("unexpected content type %q: %v", msg.Header.Get("Content-Type"), err)
+	}
+
+	r := multipart.NewReader(msg.Body, params["boundary"])
+	body, err := r.NextPart()
+	if err != nil {
+		t.Fatalf("failed to read body part: %v", err)
+	}
+	bodyText, _ := io.ReadAll(body)
+	if diff := cmp.Diff(m.Body, string(bodyText)); diff != "" {
+		t.Errorf("body mismatch (-want +got):\n%s", diff)
+	}
+
+	file, err := r.NextPart()
+	if err != nil {
+		t.Fatalf("failed to read attachment part: %v", err)
+	}
+	if file.FileName() != m.Attachment.Name {
+		t.Errorf("attachment name = %q, want %q", file.FileName(), m.Attachment.Name)
+	}
+	attached, err := io.ReadAll(base64.NewDecoder(base64.StdEncoding, file))
+	if err != nil {
+		t.Fatalf("failed to decode attachment: %v", err)
+	}
+	if !bytes.Equal(attached, data) {
+		t.Errorf("attachment data mismatch")
+	}
+
+	if _, err := r.NextPart(); !errors.Is(err, io.EOF) {
+		t.Errorf("expected two parts, got error %v", err)
+	}
+}
+
+func TestMessage_Bytes_withoutAttachment(t *testing.T) {
+	m := Message{
+		From:    "vmclarity@example.com",
+		To:      []string{"security@example.com"},
+		Subject: "Daily finding digest",
+		Date:    time.Date(2023, 3, 27, 8, 0, 0, 0, time.UTC),
+		Body:    "No attachment.",
+	}
+
+	encoded, err := m.Bytes()
+	if err != nil {
+		t.Fatalf("Bytes() unexpected error: %v", err)
+	}
+
+	msg, err := mail.ReadMessage(bytes.NewReader(encoded))
+	if err != nil {
+		t.Fatalf("failed to parse message: %v", err)
+	}
+	_, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		t.Fatalf("unexpected content type %q: %v", msg.Header.Get("Content-Type"), err)
+	}
+
+	r := multipart.NewReader(msg.Body, params["boundary"])
+	body, err := r.NextPart()
+	if err != nil {
+		t.Fatalf("failed to read body part: %v", err)
+	}
+	bodyText, _ := io.ReadAll(body)
+	if diff := cmp.Diff(m.Body, string(bodyText)); diff != "" {
+		t.Errorf("body mismatch (-want +got):\n%s", diff)
+	}
+	if _, err := r.NextPart(); !errors.Is(err, io.EOF) {
+		t.Errorf("expected one part, got error %v", err)
+	}
+}
+
+func TestSMTPConfig_Send_notConfigured(t *testing.T) {
+	err := SMTPConfig{}.Send(Message{})
+	if !errors.Is(err, ErrSMTPNotConfigured) {
+		t.Errorf("Send() error = %v, want %v", err, ErrSMTPNotConfigured)
+	}
+}
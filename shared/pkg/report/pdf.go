@@ -0,0 +1,177 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// Page layout of the Document in points, an A4 page with one inch margins.
+const (
+	pageWidth   = 595
+	pageHeight  = 842
+	pageMargin  = 72
+	valueColumn = 360
+	lineSpacing = 1.5
+	headingSize = 18
+	sectionSize = 13
+	textSize    = 11
+	regularFont = "F1"
+	boldFont    = "F2"
+)
+
+type textLine struct {
+	x    float64
+	y    float64
+	font string
+	size float64
+	text string
+}
+
+// Document is a minimal PDF document of text lines laid out from top to
+// bottom using the standard Helvetica fonts, which is all the reports need.
+// Pages are added as the lines fill them.
+type Document struct {
+	pages [][]textLine
+	y     float64
+}
+
+func NewDocument() *Document {
+	d := &Document{}
+	d.addPage()
+	return d
+}
+
+// Heading adds the title of the document.
+func (d *Document) Heading(text string) {
+	d.add(pageMargin, boldFont, headingSize, text)
+}
+
+// Section adds the title of a section.
+func (d *Document) Section(text string) {
+	d.Space()
+	d.add(pageMargin, boldFont, sectionSize, text)
+}
+
+// Text adds a line of text.
+func (d *Document) Text(text string) {
+	d.add(pageMargin, regularFont, textSize, text)
+}
+
+// Row adds a line with a label and its value aligned in a second column.
+func (d *Document) Row(label, value string) {
+	d.newLine(textSize)
+	page := len(d.pages) - 1
+	d.pages[page] = append(d.pages[page],
+		textLine{x: pageMargin, y: d.y, font: regularFont, size: textSize, text: label},
+		textLine{x: valueColumn, y: d.y, font: boldFont, size: textSize, text: value},
+	)
+}
+
+// Space adds an empty line.
+func (d *Document) Space() {
+	d.newLine(textSize)
+}
+
+func (d *Document) add(x float64, font string, size float64, text string) {
+	d.newLine(size)
+	page := len(d.pages) - 1
+	d.pages[page] = append(d.pages[page], textLine{x: x, y: d.y, font: font, size: size, text: text})
+}
+
+func (d *Document) newLine(size float64) {
+	d.y -= size * lineSpacing
+	if d.y < pageMargin {
+		d.addPage()
+		d.y -= size * lineSpacing
+	}
+}
+
+func (d *Document) addPage() {
+	d.pages = append(d.pages, nil)
+	d.y = pageHeight - pageMargin
+}
+
+// Bytes returns the document encoded as PDF.
+func (d *Document) Bytes() []byte {
+	var buf bytes.Buffer
+	var offsets []int
+
+	// Objects are numbered from 1 in the order they are written: the
+	// catalog, the page tree, the two fonts and then a page and its content
+	// stream for each page.
+	writeObject := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+	const firstPageObject = 5
+
+	buf.WriteString("%PDF-1.4\n")
+	writeObject("<< /Type /Catalog /Pages 2 0 R >>")
+
+	kids := make([]string, 0, len(d.pages))
+	for i := range d.pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", firstPageObject+2*i))
+	}
+	writeObject(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	writeObject("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	writeObject("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+
+	for i, lines := range d.pages {
+		writeObject(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /%s 3 0 R /%s 4 0 R >> >> /Contents %d 0 R >>",
+			pageWidth, pageHeight, regularFont, boldFont, firstPageObject+2*i+1))
+
+		var content bytes.Buffer
+		for _, l := range lines {
+			fmt.Fprintf(&content, "BT /%s %g Tf %g %g Td (%s) Tj ET\n", l.font, l.size, l.x, l.y, escapeText(l.text))
+		}
+		writeObject(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return buf.Bytes()
+}
+
+// escapeText escapes the text for a PDF string literal. Characters outside
+// of Latin-1 can't be shown by the standard fonts and are replaced.
+func escapeText(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < ' ':
+			b.WriteByte(' ')
+		case r > 0xff:
+			b.WriteByte('?')
+		case r > 0x7e:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
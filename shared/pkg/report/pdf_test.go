@@ -0,0 +1,86 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package report
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestDocument_Bytes(t *testing.T) {
+	d := NewDocument()
+	d.Heading("Weekly (prod)")
+	// Fill more than a page so that a second page is added.
+	for i := 0; i < 60; i++ {
+		d.Row("Targets", strconv.Itoa(i))
+	}
+	pdf := d.Bytes()
+
+	if !bytes.HasPrefix(pdf, []byte("%PDF-1.4\n")) {
+		t.Errorf("missing PDF header")
+	}
+	if !bytes.Contains(pdf, []byte(`(Weekly \(prod\)) Tj`)) {
+		t.Errorf("heading is not escaped")
+	}
+	if !bytes.Contains(pdf, []byte("/Count 2")) {
+		t.Errorf("expected two pages")
+	}
+
+	// Every xref entry must point to the object it numbers.
+	m := regexp.MustCompile(`(?s)xref\n0 (\d+)\n0000000000 65535 f \n(.*)trailer`).FindSubmatch(pdf)
+	if m == nil {
+		t.Fatalf("missing xref table")
+	}
+	entries := regexp.MustCompile(`(\d{10}) 00000 n \n`).FindAllSubmatch(m[2], -1)
+	size, _ := strconv.Atoi(string(m[1]))
+	if len(entries) != size-1 {
+		t.Fatalf("xref has %d entries, want %d", len(entries), size-1)
+	}
+	for i, e := range entries {
+		offset, _ := strconv.Atoi(string(e[1]))
+		want := strconv.Itoa(i+1) + " 0 obj\n"
+		if !bytes.HasPrefix(pdf[offset:], []byte(want)) {
+			t.Errorf("xref entry %d doesn't point to %q", i+1, want)
+		}
+	}
+
+	m = regexp.MustCompile(`startxref\n(\d+)\n%%EOF\n$`).FindSubmatch(pdf)
+	if m == nil {
+		t.Fatalf("missing startxref")
+	}
+	offset, _ := strconv.Atoi(string(m[1]))
+	if !bytes.HasPrefix(pdf[offset:], []byte("xref\n")) {
+		t.Errorf("startxref doesn't point to the xref table")
+	}
+}
+
+func Test_escapeText(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "plain", want: "plain"},
+		{text: `a (b) \c`, want: `a \(b\) \\c`},
+		{text: "Ünnep\t€", want: `\334nnep ?`},
+	}
+	for _, tt := range tests {
+		if got := escapeText(tt.text); got != tt.want {
+			t.Errorf("escapeText(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
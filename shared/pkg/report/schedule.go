@@ -0,0 +1,65 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package report
+
+import (
+	"fmt"
+	"time"
+	// Embed the time zone database so that the time zones of the report and
+	// scan schedules can be loaded regardless of the host it runs on.
+	_ "time/tzdata"
+
+	"github.com/aptible/supercronic/cronexpr"
+)
+
+// DefaultTimezone is used for the report and scan schedules which don't set
+// one.
+const DefaultTimezone = "UTC"
+
+// LoadTimezone returns the location of the IANA time zone name, or of the
+// DefaultTimezone if name is empty.
+func LoadTimezone(name string) (*time.Location, error) {
+	if name == "" {
+		name = DefaultTimezone
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone %q: %w", name, err)
+	}
+	return loc, nil
+}
+
+// NextDeliveryTime returns the first time after the after time which matches
+// the cronLine evaluated in the timezone, so that a report scheduled for
+// 08:00 is delivered at 08:00 local time also across daylight saving changes.
+func NextDeliveryTime(cronLine, timezone string, after time.Time) (time.Time, error) {
+	expr, err := cronexpr.Parse(cronLine)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("malformed cron expression: %w", err)
+	}
+
+	loc, err := LoadTimezone(timezone)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	next := expr.Next(after.In(loc))
+	if next.IsZero() {
+		return time.Time{}, fmt.Errorf("cron expression %q has no occurrence after %s", cronLine, after.Format(time.RFC3339))
+	}
+	return next, nil
+}
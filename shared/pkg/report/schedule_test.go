@@ -0,0 +1,77 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package report
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextDeliveryTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		cronLine string
+		timezone string
+		after    time.Time
+		want     time.Time
+		wantErr  bool
+	}{
+		{
+			name:     "weekly in UTC by default",
+			cronLine: "0 8 * * 1",
+			after:    time.Date(2023, 3, 22, 12, 0, 0, 0, time.UTC),
+			want:     time.Date(2023, 3, 27, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "weekly in local time before daylight saving starts",
+			cronLine: "0 8 * * 1",
+			timezone: "Europe/Budapest",
+			after:    time.Date(2023, 3, 14, 12, 0, 0, 0, time.UTC),
+			want:     time.Date(2023, 3, 20, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "weekly in local time after daylight saving starts",
+			cronLine: "0 8 * * 1",
+			timezone: "Europe/Budapest",
+			after:    time.Date(2023, 3, 22, 12, 0, 0, 0, time.UTC),
+			want:     time.Date(2023, 3, 27, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "malformed cron expression",
+			cronLine: "every monday",
+			after:    time.Date(2023, 3, 22, 12, 0, 0, 0, time.UTC),
+			wantErr:  true,
+		},
+		{
+			name:     "unknown time zone",
+			cronLine: "0 8 * * 1",
+			timezone: "Mars/Olympus_Mons",
+			after:    time.Date(2023, 3, 22, 12, 0, 0, 0, time.UTC),
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NextDeliveryTime(tt.cronLine, tt.timezone, tt.after)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NextDeliveryTime() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("NextDeliveryTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
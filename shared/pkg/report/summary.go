@@ -0,0 +1,83 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package report
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const summaryTimeLayout = "2006-01-02 15:04 MST"
+
+// Count is the number of objects of a kind, e.g. the active findings of a
+// finding type.
+type Count struct {
+	Name  string
+	Count int
+}
+
+// ExecutiveSummary is a high level overview of the security posture of the
+// deployment and of its change during the reported period.
+type ExecutiveSummary struct {
+	Title       string
+	PeriodStart time.Time
+	GeneratedAt time.Time
+
+	Targets          int
+	CompletedScans   int
+	NewFindings      int
+	ResolvedFindings int
+
+	ActiveFindings        []Count
+	ActiveVulnerabilities []Count
+}
+
+// FileName returns the name of the file the summary is attached as.
+func (s ExecutiveSummary) FileName() string {
+	return SummaryFileName(s.GeneratedAt)
+}
+
+// SummaryFileName returns the file name of the summary generated at t.
+func SummaryFileName(t time.Time) string {
+	return fmt.Sprintf("executive-summary-%s.pdf", t.Format("2006-01-02"))
+}
+
+// PDF renders the summary as a PDF document.
+func (s ExecutiveSummary) PDF() []byte {
+	d := NewDocument()
+	d.Heading(s.Title)
+	d.Text(fmt.Sprintf("Executive summary for %s - %s",
+		s.PeriodStart.Format(summaryTimeLayout), s.GeneratedAt.Format(summaryTimeLayout)))
+
+	d.Section("Overview")
+	d.Row("Targets", strconv.Itoa(s.Targets))
+	d.Row("Completed scans", strconv.Itoa(s.CompletedScans))
+	d.Row("New findings", strconv.Itoa(s.NewFindings))
+	d.Row("Resolved findings", strconv.Itoa(s.ResolvedFindings))
+
+	d.Section("Active findings")
+	for _, c := range s.ActiveFindings {
+		d.Row(c.Name, strconv.Itoa(c.Count))
+	}
+
+	d.Section("Active vulnerabilities by severity")
+	for _, c := range s.ActiveVulnerabilities {
+		d.Row(c.Name, strconv.Itoa(c.Count))
+	}
+
+	return d.Bytes()
+}
@@ -0,0 +1,116 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package scanexport is the format of the results of a scan which the
+// scanner writes to a file instead of exporting them to the backend, so that
+// the orchestrator collects them from scanners which can't reach the backend.
+package scanexport
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"sync"
+
+	"github.com/openclarity/vmclarity/api/models"
+)
+
+// FileName is the name of the file the results are written to in the export
+// directory. It is written once the scan is done.
+const FileName = "results.json"
+
+// FamilyResult is the result of a family converted to its API model, along
+// with the state of the family and the summary of its findings.
+type FamilyResult struct {
+	Family  models.ScanFamily           `json:"family"`
+	Result  json.RawMessage             `json:"result,omitempty"`
+	Summary *models.ScanFindingsSummary `json:"summary,omitempty"`
+	State   models.AssetScanState       `json:"state"`
+}
+
+// Results are the results of a scan.
+type Results struct {
+	// General is the state of the scan, it is done once the results are
+	// written.
+	General  models.AssetScanState `json:"general"`
+	Families []FamilyResult        `json:"families"`
+}
+
+// Writer collects the results of the families of a scan and writes them to
+// the export directory once the scan is done.
+type Writer struct {
+	dir string
+
+	mu      sync.Mutex
+	results Results
+}
+
+func NewWriter(dir string) *Writer {
+	return &Writer{
+		dir: dir,
+	}
+}
+
+// AddFamilyResult adds the result of a family, result is marshaled to JSON.
+func (w *Writer) AddFamilyResult(family models.ScanFamily, result interface{}, state models.AssetScanState, summary *models.ScanFindingsSummary) error {
+	var raw json.RawMessage
+	if result != nil {
+		b, err := json.Marshal(result)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s result: %w", family, err)
+		}
+		raw = b
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.results.Families = append(w.results.Families, FamilyResult{
+		Family:  family,
+		Result:  raw,
+		Summary: summary,
+		State:   state,
+	})
+
+	return nil
+}
+
+// WriteDone writes the results with the general state of the scan. The file
+// is renamed into place, so that it is never read partially written.
+func (w *Writer) WriteDone(general models.AssetScanState) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.results.General = general
+	b, err := json.Marshal(w.results)
+	if err != nil {
+		return fmt.Errorf("failed to marshal results: %w", err)
+	}
+
+	if err := os.MkdirAll(w.dir, 0o700); err != nil { // nolint:gomnd
+		return fmt.Errorf("failed to create export directory: %w", err)
+	}
+	filePath := path.Join(w.dir, FileName)
+	tmp := filePath + ".tmp"
+	if err := os.WriteFile(tmp, b, 0o600); err != nil { // nolint:gomnd
+		return fmt.Errorf("failed to write results: %w", err)
+	}
+	if err := os.Rename(tmp, filePath); err != nil {
+		return fmt.Errorf("failed to rename results: %w", err)
+	}
+
+	return nil
+}
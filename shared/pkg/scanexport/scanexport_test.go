@@ -0,0 +1,79 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanexport
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestWriter(t *testing.T) {
+	dir := path.Join(t.TempDir(), "results")
+	writer := NewWriter(dir)
+
+	state := models.AssetScanState{State: utils.PointerTo(models.AssetScanStateStateDone)}
+	sbom := models.SbomScan{Packages: &[]models.Package{{Name: utils.PointerTo("openssl")}}}
+	summary := &models.ScanFindingsSummary{TotalPackages: utils.PointerTo(1)}
+	if err := writer.AddFamilyResult(models.ScanFamilySbom, sbom, state, summary); err != nil {
+		t.Fatalf("AddFamilyResult() error = %v", err)
+	}
+	if err := writer.AddFamilyResult(models.ScanFamilySecrets, nil, state, nil); err != nil {
+		t.Fatalf("AddFamilyResult() error = %v", err)
+	}
+
+	if _, err := os.Stat(path.Join(dir, FileName)); !os.IsNotExist(err) {
+		t.Fatalf("results are written before the scan is done, Stat() error = %v", err)
+	}
+
+	if err := writer.WriteDone(state); err != nil {
+		t.Fatalf("WriteDone() error = %v", err)
+	}
+
+	b, err := os.ReadFile(path.Join(dir, FileName))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	var results Results
+	if err := json.Unmarshal(b, &results); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if got := utils.ValueOrZero(results.General.State); got != models.AssetScanStateStateDone {
+		t.Errorf("general state = %s, want %s", got, models.AssetScanStateStateDone)
+	}
+	if len(results.Families) != 2 {
+		t.Fatalf("families = %d, want 2", len(results.Families))
+	}
+
+	var gotSbom models.SbomScan
+	if err := json.Unmarshal(results.Families[0].Result, &gotSbom); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if got := utils.ValueOrZero((*gotSbom.Packages)[0].Name); got != "openssl" {
+		t.Errorf("sbom package = %q, want %q", got, "openssl")
+	}
+	if got := utils.ValueOrZero(results.Families[0].Summary.TotalPackages); got != 1 {
+		t.Errorf("sbom summary total packages = %d, want 1", got)
+	}
+	if results.Families[1].Result != nil {
+		t.Errorf("secrets result = %s, want none", results.Families[1].Result)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scannerclient
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/scannerpb"
+)
+
+// ScannerClient reports the progress and the results of a scan to the
+// scanner gRPC API of the backend.
+type ScannerClient struct {
+	conn   *grpc.ClientConn
+	client scannerpb.ScannerServiceClient
+
+	chunkSize int
+}
+
+// Create connects to the scanner API at serverAddress over TLS. The server
+// certificate is verified with the CA certificates of caFile, or with the
+// system CA certificates if it is not set.
+func Create(serverAddress, caFile string) (*ScannerClient, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if caFile != "" {
+		ca, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA certificates: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("no CA certificates found in %s", caFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	conn, err := grpc.Dial(serverAddress, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to create VMClarity scanner API client. serverAddress=%v: %w", serverAddress, err)
+	}
+
+	return &ScannerClient{
+		conn:      conn,
+		client:    scannerpb.NewScannerServiceClient(conn),
+		chunkSize: scannerpb.DefaultChunkSize,
+	}, nil
+}
+
+func (c *ScannerClient) Close() error {
+	// nolint:wrapcheck
+	return c.conn.Close()
+}
+
+// UpdateScanStatus sets the state of the family of the scan result. The
+// errors of the state are appended to the errors already reported for the
+// family if appendErrors is set.
+func (c *ScannerClient) UpdateScanStatus(ctx context.Context, scanResultID models.ScanResultID, family scannerpb.ScanFamily, state models.AssetScanState, appendErrors bool) error {
+	pbState, err := scannerpb.NewTargetScanState(state)
+	if err != nil {
+		return fmt.Errorf("failed to convert state: %w", err)
+	}
+
+	_, err = c.client.UpdateScanStatus(ctx, &scannerpb.UpdateScanStatusRequest{
+		ScanResultId: scanResultID,
+		States: []*scannerpb.FamilyState{
+			{
+				Family:       family,
+				State:        pbState,
+				AppendErrors: appendErrors,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update scan result status: %w", err)
+	}
+
+	return nil
+}
+
+// UploadScanResult streams the result of the family, its API model e.g.
+// models.SbomScan, to the scan result along with the state of the family and
+// the summary of the result. The result is not changed if it is nil.
+func (c *ScannerClient) UploadScanResult(ctx context.Context, scanResultID models.ScanResultID, family scannerpb.ScanFamily, result interface{}, state models.AssetScanState, summary *models.ScanFindingsSummary) error {
+	pbState, err := scannerpb.NewTargetScanState(state)
+	if err != nil {
+		return fmt.Errorf("failed to convert state: %w", err)
+	}
+
+	var chunks [][]byte
+	if result != nil {
+		chunks, err = scannerpb.SplitResult(result, c.chunkSize)
+		if err != nil {
+			return fmt.Errorf("failed to split result: %w", err)
+		}
+	}
+
+	stream, err := c.client.UploadScanResult(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start scan result upload: %w", err)
+	}
+
+	reqs := []*scannerpb.UploadScanResultRequest{
+		{
+			Payload: &scannerpb.UploadScanResultRequest_Header{
+				Header: &scannerpb.UploadHeader{
+					ScanResultId: scanResultID,
+					Family:       family,
+				},
+			},
+		},
+	}
+	for _, chunk := range chunks {
+		reqs = append(reqs, &scannerpb.UploadScanResultRequest{
+			Payload: &scannerpb.UploadScanResultRequest_Chunk{
+				Chunk: &scannerpb.ResultChunk{Result: chunk},
+			},
+		})
+	}
+	if summary != nil {
+		reqs = append(reqs, &scannerpb.UploadScanResultRequest{
+			Payload: &scannerpb.UploadScanResultRequest_Summary{
+				Summary: scannerpb.NewScanFindingsSummary(*summary),
+			},
+		})
+	}
+	reqs = append(reqs, &scannerpb.UploadScanResultRequest{
+		Payload: &scannerpb.UploadScanResultRequest_State{
+			State: pbState,
+		},
+	})
+
+	for _, req := range reqs {
+		if err := stream.Send(req); err != nil {
+			// The server closed the stream, the reason is returned by
+			// CloseAndRecv.
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return fmt.Errorf("failed to send scan result: %w", err)
+		}
+	}
+
+	if _, err := stream.CloseAndRecv(); err != nil {
+		return fmt.Errorf("failed to upload scan result: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scannerpb
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// DefaultChunkSize is the number of list items sent in a chunk.
+const DefaultChunkSize = 1000
+
+// SplitResult encodes the API model of the result of a family into chunks
+// which have at most chunkSize items of each list of the result. The other
+// fields are sent in the first chunk.
+func SplitResult(result interface{}, chunkSize int) ([][]byte, error) {
+	if chunkSize <= 0 {
+		return nil, errors.New("chunk size must be positive")
+	}
+
+	b, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal result: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal result: %w", err)
+	}
+
+	// Sort the fields so that the chunks are deterministic.
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	chunks := []map[string]interface{}{{}}
+	for _, key := range keys {
+		var items []json.RawMessage
+		if err := json.Unmarshal(fields[key], &items); err != nil || items == nil {
+			chunks[0][key] = fields[key]
+			continue
+		}
+		for i := 0; i == 0 || i*chunkSize < len(items); i++ {
+			if i == len(chunks) {
+				chunks = append(chunks, map[string]interface{}{})
+			}
+			end := (i + 1) * chunkSize
+			if end > len(items) {
+				end = len(items)
+			}
+			chunks[i][key] = items[i*chunkSize : end]
+		}
+	}
+
+	ret := make([][]byte, 0, len(chunks))
+	for _, chunk := range chunks {
+		b, err := json.Marshal(chunk)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal chunk: %w", err)
+		}
+		ret = append(ret, b)
+	}
+	return ret, nil
+}
+
+// ResultMerger assembles the result of a family from its chunks.
+type ResultMerger struct {
+	fields map[string]json.RawMessage
+	lists  map[string][]json.RawMessage
+	chunks int
+}
+
+func NewResultMerger() *ResultMerger {
+	return &ResultMerger{
+		fields: map[string]json.RawMessage{},
+		lists:  map[string][]json.RawMessage{},
+	}
+}
+
+// Add merges the chunk into the result, the lists are concatenated and the
+// other fields replace the earlier ones.
+func (m *ResultMerger) Add(chunk []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(chunk, &fields); err != nil {
+		return fmt.Errorf("failed to unmarshal chunk: %w", err)
+	}
+
+	for key, value := range fields {
+		var items []json.RawMessage
+		if err := json.Unmarshal(value, &items); err != nil || items == nil {
+			delete(m.lists, key)
+			m.fields[key] = value
+			continue
+		}
+		delete(m.fields, key)
+		if _, ok := m.lists[key]; !ok {
+			m.lists[key] = []json.RawMessage{}
+		}
+		m.lists[key] = append(m.lists[key], items...)
+	}
+	m.chunks++
+
+	return nil
+}
+
+// Chunks returns the number of chunks merged.
+func (m *ResultMerger) Chunks() int {
+	return m.chunks
+}
+
+// Result returns the JSON encoded result, nil if no chunk was merged.
+func (m *ResultMerger) Result() (json.RawMessage, error) {
+	if m.chunks == 0 {
+		return nil, nil
+	}
+
+	result := make(map[string]interface{}, len(m.fields)+len(m.lists))
+	for key, value := range m.fields {
+		result[key] = value
+	}
+	for key, items := range m.lists {
+		result[key] = items
+	}
+	b, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal result: %w", err)
+	}
+	return b, nil
+}
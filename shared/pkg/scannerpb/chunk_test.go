@@ -0,0 +1,121 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scannerpb
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+func TestSplitResult(t *testing.T) {
+	packages := make([]models.Package, 0, 5)
+	for i := 0; i < 5; i++ {
+		packages = append(packages, models.Package{Name: utils.PointerTo(fmt.Sprintf("package-%d", i))})
+	}
+	misconfigurations := &models.MisconfigurationScan{
+		Misconfigurations: &[]models.Misconfiguration{
+			{Message: utils.PointerTo("a")},
+			{Message: utils.PointerTo("b")},
+			{Message: utils.PointerTo("c")},
+		},
+		Scanners: &[]string{"lynis"},
+	}
+
+	tests := []struct {
+		name       string
+		result     interface{}
+		chunkSize  int
+		wantChunks int
+	}{
+		{
+			name:       "lists are split",
+			result:     &models.SbomScan{Packages: &packages},
+			chunkSize:  2,
+			wantChunks: 3,
+		},
+		{
+			name:       "fits in a chunk",
+			result:     &models.SbomScan{Packages: &packages},
+			chunkSize:  10,
+			wantChunks: 1,
+		},
+		{
+			name:       "empty list",
+			result:     &models.SbomScan{Packages: &[]models.Package{}},
+			chunkSize:  2,
+			wantChunks: 1,
+		},
+		{
+			name:       "lists of different sizes",
+			result:     misconfigurations,
+			chunkSize:  1,
+			wantChunks: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chunks, err := SplitResult(tt.result, tt.chunkSize)
+			if err != nil {
+				t.Fatalf("SplitResult() error = %v", err)
+			}
+			if len(chunks) != tt.wantChunks {
+				t.Errorf("SplitResult() returned %d chunks, want %d", len(chunks), tt.wantChunks)
+			}
+
+			merger := NewResultMerger()
+			for _, chunk := range chunks {
+				if err := merger.Add(chunk); err != nil {
+					t.Fatalf("Add() error = %v", err)
+				}
+			}
+			merged, err := merger.Result()
+			if err != nil {
+				t.Fatalf("Result() error = %v", err)
+			}
+
+			want, err := json.Marshal(tt.result)
+			if err != nil {
+				t.Fatalf("failed to marshal result: %v", err)
+			}
+			var gotFields, wantFields map[string]interface{}
+			if err := json.Unmarshal(merged, &gotFields); err != nil {
+				t.Fatalf("failed to unmarshal merged result: %v", err)
+			}
+			if err := json.Unmarshal(want, &wantFields); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if diff := cmp.Diff(wantFields, gotFields); diff != "" {
+				t.Errorf("merged result mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResultMerger_NoChunks(t *testing.T) {
+	result, err := NewResultMerger().Result()
+	if err != nil {
+		t.Fatalf("Result() error = %v", err)
+	}
+	if result != nil {
+		t.Errorf("Result() = %s, want nil", result)
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scannerpb
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+)
+
+// familyFields are the JSON fields of AssetScanResult the result and the
+// status of each family are stored in.
+var familyFields = map[ScanFamily]struct {
+	result string
+	status string
+}{
+	ScanFamily_SCAN_FAMILY_GENERAL:           {status: "general"},
+	ScanFamily_SCAN_FAMILY_SBOM:              {result: "sboms", status: "sbom"},
+	ScanFamily_SCAN_FAMILY_VULNERABILITIES:   {result: "vulnerabilities", status: "vulnerabilities"},
+	ScanFamily_SCAN_FAMILY_EXPLOITS:          {result: "exploits", status: "exploits"},
+	ScanFamily_SCAN_FAMILY_MISCONFIGURATIONS: {result: "misconfigurations", status: "misconfigurations"},
+	ScanFamily_SCAN_FAMILY_SECRETS:           {result: "secrets", status: "secrets"},
+	ScanFamily_SCAN_FAMILY_MALWARE:           {result: "malware", status: "malware"},
+	ScanFamily_SCAN_FAMILY_ROOTKITS:          {result: "rootkits", status: "rootkits"},
+	ScanFamily_SCAN_FAMILY_COMPLIANCE:        {result: "compliance", status: "compliance"},
+	ScanFamily_SCAN_FAMILY_CERTIFICATES:      {result: "certificates", status: "certificates"},
+	ScanFamily_SCAN_FAMILY_PLUGINS:           {result: "plugins", status: "plugins"},
+}
+
+// ResultField returns the JSON field of AssetScanResult the result of the
+// family is stored in.
+func ResultField(family ScanFamily) (string, error) {
+	fields, ok := familyFields[family]
+	if !ok || fields.result == "" {
+		return "", fmt.Errorf("family %v has no result", family)
+	}
+	return fields.result, nil
+}
+
+// StatusField returns the JSON field of AssetScanStatus the state of the
+// family is stored in.
+func StatusField(family ScanFamily) (string, error) {
+	fields, ok := familyFields[family]
+	if !ok {
+		return "", fmt.Errorf("unknown family %v", family)
+	}
+	return fields.status, nil
+}
+
+var stateToModel = map[TargetScanState_State]models.AssetScanStateState{
+	TargetScanState_STATE_PENDING:       models.AssetScanStateStatePending,
+	TargetScanState_STATE_SCHEDULED:     models.AssetScanStateStateScheduled,
+	TargetScanState_STATE_READY_TO_SCAN: models.AssetScanStateStateReadyToScan,
+	TargetScanState_STATE_IN_PROGRESS:   models.AssetScanStateStateInProgress,
+	TargetScanState_STATE_ABORTED:       models.AssetScanStateStateAborted,
+	TargetScanState_STATE_NOT_SCANNED:   models.AssetScanStateStateNotScanned,
+	TargetScanState_STATE_DONE:          models.AssetScanStateStateDone,
+}
+
+// NewTargetScanState converts the API model of the state of a family.
+func NewTargetScanState(state models.AssetScanState) (*TargetScanState, error) {
+	ret := &TargetScanState{}
+	if state.State != nil {
+		found := false
+		for s, m := range stateToModel {
+			if m == *state.State {
+				ret.State, found = s, true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown state %v", *state.State)
+		}
+	}
+	if state.Errors != nil {
+		ret.Errors = *state.Errors
+	}
+	if state.LastTransitionTime != nil {
+		ret.LastTransitionTime = timestamppb.New(*state.LastTransitionTime)
+	}
+	return ret, nil
+}
+
+// ToModel converts the state of a family to its API model.
+func (x *TargetScanState) ToModel() (models.AssetScanState, error) {
+	ret := models.AssetScanState{}
+	if x.GetState() != TargetScanState_STATE_UNSPECIFIED {
+		state, ok := stateToModel[x.GetState()]
+		if !ok {
+			return ret, fmt.Errorf("unknown state %v", x.GetState())
+		}
+		ret.State = &state
+	}
+	if x.GetErrors() != nil {
+		ret.Errors = utils.PointerTo(x.GetErrors())
+	}
+	if x.GetLastTransitionTime() != nil {
+		ret.LastTransitionTime = utils.PointerTo(x.GetLastTransitionTime().AsTime())
+	}
+	return ret, nil
+}
+
+// NewScanFindingsSummary converts the API model of the summary.
+func NewScanFindingsSummary(summary models.ScanFindingsSummary) *ScanFindingsSummary {
+	ret := &ScanFindingsSummary{
+		TotalPackages:          toInt64(summary.TotalPackages),
+		TotalExploits:          toInt64(summary.TotalExploits),
+		TotalMisconfigurations: toInt64(summary.TotalMisconfigurations),
+		TotalSecrets:           toInt64(summary.TotalSecrets),
+		TotalMalware:           toInt64(summary.TotalMalware),
+		TotalRootkits:          toInt64(summary.TotalRootkits),
+		TotalComplianceChecks:  toInt64(summary.TotalComplianceChecks),
+		TotalCertificates:      toInt64(summary.TotalCertificates),
+		TotalPluginFindings:    toInt64(summary.TotalPluginFindings),
+	}
+	if v := summary.TotalVulnerabilities; v != nil {
+		ret.TotalVulnerabilities = &VulnerabilityScanSummary{
+			TotalCriticalVulnerabilities:   toInt64(v.TotalCriticalVulnerabilities),
+			TotalHighVulnerabilities:       toInt64(v.TotalHighVulnerabilities),
+			TotalMediumVulnerabilities:     toInt64(v.TotalMediumVulnerabilities),
+			TotalLowVulnerabilities:        toInt64(v.TotalLowVulnerabilities),
+			TotalNegligibleVulnerabilities: toInt64(v.TotalNegligibleVulnerabilities),
+		}
+	}
+	return ret
+}
+
+// ToModel converts the summary to its API model.
+func (x *ScanFindingsSummary) ToModel() models.ScanFindingsSummary {
+	ret := models.ScanFindingsSummary{
+		TotalPackages:          toInt(x.TotalPackages),
+		TotalExploits:          toInt(x.TotalExploits),
+		TotalMisconfigurations: toInt(x.TotalMisconfigurations),
+		TotalSecrets:           toInt(x.TotalSecrets),
+		TotalMalware:           toInt(x.TotalMalware),
+		TotalRootkits:          toInt(x.TotalRootkits),
+		TotalComplianceChecks:  toInt(x.TotalComplianceChecks),
+		TotalCertificates:      toInt(x.TotalCertificates),
+		TotalPluginFindings:    toInt(x.TotalPluginFindings),
+	}
+	if v := x.GetTotalVulnerabilities(); v != nil {
+		ret.TotalVulnerabilities = &models.VulnerabilityScanSummary{
+			TotalCriticalVulnerabilities:   toInt(v.TotalCriticalVulnerabilities),
+			TotalHighVulnerabilities:       toInt(v.TotalHighVulnerabilities),
+			TotalMediumVulnerabilities:     toInt(v.TotalMediumVulnerabilities),
+			TotalLowVulnerabilities:        toInt(v.TotalLowVulnerabilities),
+			TotalNegligibleVulnerabilities: toInt(v.TotalNegligibleVulnerabilities),
+		}
+	}
+	return ret
+}
+
+func toInt64(i *int) *int64 {
+	if i == nil {
+		return nil
+	}
+	return utils.PointerTo(int64(*i))
+}
+
+func toInt(i *int64) *int {
+	if i == nil {
+		return nil
+	}
+	return utils.PointerTo(int(*i))
+}
@@ -0,0 +1,1281 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.12
+// source: shared/pkg/scannerpb/scanner.proto
+
+package scannerpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ScanFamily is a family of the scan result, see TargetScanStatus.
+type ScanFamily int32
+
+const (
+	ScanFamily_SCAN_FAMILY_UNSPECIFIED       ScanFamily = 0
+	ScanFamily_SCAN_FAMILY_GENERAL           ScanFamily = 1
+	ScanFamily_SCAN_FAMILY_SBOM              ScanFamily = 2
+	ScanFamily_SCAN_FAMILY_VULNERABILITIES   ScanFamily = 3
+	ScanFamily_SCAN_FAMILY_EXPLOITS          ScanFamily = 4
+	ScanFamily_SCAN_FAMILY_MISCONFIGURATIONS ScanFamily = 5
+	ScanFamily_SCAN_FAMILY_SECRETS           ScanFamily = 6
+	ScanFamily_SCAN_FAMILY_MALWARE           ScanFamily = 7
+	ScanFamily_SCAN_FAMILY_ROOTKITS          ScanFamily = 8
+	ScanFamily_SCAN_FAMILY_COMPLIANCE        ScanFamily = 9
+	ScanFamily_SCAN_FAMILY_CERTIFICATES      ScanFamily = 10
+	ScanFamily_SCAN_FAMILY_PLUGINS           ScanFamily = 11
+)
+
+// Enum value maps for ScanFamily.
+var (
+	ScanFamily_name = map[int32]string{
+		0:  "SCAN_FAMILY_UNSPECIFIED",
+		1:  "SCAN_FAMILY_GENERAL",
+		2:  "SCAN_FAMILY_SBOM",
+		3:  "SCAN_FAMILY_VULNERABILITIES",
+		4:  "SCAN_FAMILY_EXPLOITS",
+		5:  "SCAN_FAMILY_MISCONFIGURATIONS",
+		6:  "SCAN_FAMILY_SECRETS",
+		7:  "SCAN_FAMILY_MALWARE",
+		8:  "SCAN_FAMILY_ROOTKITS",
+		9:  "SCAN_FAMILY_COMPLIANCE",
+		10: "SCAN_FAMILY_CERTIFICATES",
+		11: "SCAN_FAMILY_PLUGINS",
+	}
+	ScanFamily_value = map[string]int32{
+		"SCAN_FAMILY_UNSPECIFIED":       0,
+		"SCAN_FAMILY_GENERAL":           1,
+		"SCAN_FAMILY_SBOM":              2,
+		"SCAN_FAMILY_VULNERABILITIES":   3,
+		"SCAN_FAMILY_EXPLOITS":          4,
+		"SCAN_FAMILY_MISCONFIGURATIONS": 5,
+		"SCAN_FAMILY_SECRETS":           6,
+		"SCAN_FAMILY_MALWARE":           7,
+		"SCAN_FAMILY_ROOTKITS":          8,
+		"SCAN_FAMILY_COMPLIANCE":        9,
+		"SCAN_FAMILY_CERTIFICATES":      10,
+		"SCAN_FAMILY_PLUGINS":           11,
+	}
+)
+
+func (x ScanFamily) Enum() *ScanFamily {
+	p := new(ScanFamily)
+	*p = x
+	return p
+}
+
+func (x ScanFamily) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScanFamily) Descriptor() protoreflect.EnumDescriptor {
+	return file_shared_pkg_scannerpb_scanner_proto_enumTypes[0].Descriptor()
+}
+
+func (ScanFamily) Type() protoreflect.EnumType {
+	return &file_shared_pkg_scannerpb_scanner_proto_enumTypes[0]
+}
+
+func (x ScanFamily) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScanFamily.Descriptor instead.
+func (ScanFamily) EnumDescriptor() ([]byte, []int) {
+	return file_shared_pkg_scannerpb_scanner_proto_rawDescGZIP(), []int{0}
+}
+
+type TargetScanState_State int32
+
+const (
+	TargetScanState_STATE_UNSPECIFIED   TargetScanState_State = 0
+	TargetScanState_STATE_PENDING       TargetScanState_State = 1
+	TargetScanState_STATE_SCHEDULED     TargetScanState_State = 2
+	TargetScanState_STATE_READY_TO_SCAN TargetScanState_State = 3
+	TargetScanState_STATE_IN_PROGRESS   TargetScanState_State = 4
+	TargetScanState_STATE_ABORTED       TargetScanState_State = 5
+	TargetScanState_STATE_NOT_SCANNED   TargetScanState_State = 6
+	TargetScanState_STATE_DONE          TargetScanState_State = 7
+)
+
+// Enum value maps for TargetScanState_State.
+var (
+	TargetScanState_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "STATE_PENDING",
+		2: "STATE_SCHEDULED",
+		3: "STATE_READY_TO_SCAN",
+		4: "STATE_IN_PROGRESS",
+		5: "STATE_ABORTED",
+		6: "STATE_NOT_SCANNED",
+		7: "STATE_DONE",
+	}
+	TargetScanState_State_value = map[string]int32{
+		"STATE_UNSPECIFIED":   0,
+		"STATE_PENDING":       1,
+		"STATE_SCHEDULED":     2,
+		"STATE_READY_TO_SCAN": 3,
+		"STATE_IN_PROGRESS":   4,
+		"STATE_ABORTED":       5,
+		"STATE_NOT_SCANNED":   6,
+		"STATE_DONE":          7,
+	}
+)
+
+func (x TargetScanState_State) Enum() *TargetScanState_State {
+	p := new(TargetScanState_State)
+	*p = x
+	return p
+}
+
+func (x TargetScanState_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TargetScanState_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_shared_pkg_scannerpb_scanner_proto_enumTypes[1].Descriptor()
+}
+
+func (TargetScanState_State) Type() protoreflect.EnumType {
+	return &file_shared_pkg_scannerpb_scanner_proto_enumTypes[1]
+}
+
+func (x TargetScanState_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TargetScanState_State.Descriptor instead.
+func (TargetScanState_State) EnumDescriptor() ([]byte, []int) {
+	return file_shared_pkg_scannerpb_scanner_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// TargetScanState is the state of a family, see TargetScanState.
+type TargetScanState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State              TargetScanState_State  `protobuf:"varint,1,opt,name=state,proto3,enum=vmclarity.scanner.v1.TargetScanState_State" json:"state,omitempty"`
+	Errors             []string               `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	LastTransitionTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_transition_time,json=lastTransitionTime,proto3" json:"last_transition_time,omitempty"`
+}
+
+func (x *TargetScanState) Reset() {
+	*x = TargetScanState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_pkg_scannerpb_scanner_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TargetScanState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetScanState) ProtoMessage() {}
+
+func (x *TargetScanState) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_pkg_scannerpb_scanner_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetScanState.ProtoReflect.Descriptor instead.
+func (*TargetScanState) Descriptor() ([]byte, []int) {
+	return file_shared_pkg_scannerpb_scanner_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TargetScanState) GetState() TargetScanState_State {
+	if x != nil {
+		return x.State
+	}
+	return TargetScanState_STATE_UNSPECIFIED
+}
+
+func (x *TargetScanState) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *TargetScanState) GetLastTransitionTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastTransitionTime
+	}
+	return nil
+}
+
+type FamilyState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Family ScanFamily       `protobuf:"varint,1,opt,name=family,proto3,enum=vmclarity.scanner.v1.ScanFamily" json:"family,omitempty"`
+	State  *TargetScanState `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// Append the errors to the errors already reported for the family
+	// instead of replacing them.
+	AppendErrors bool `protobuf:"varint,3,opt,name=append_errors,json=appendErrors,proto3" json:"append_errors,omitempty"`
+}
+
+func (x *FamilyState) Reset() {
+	*x = FamilyState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_pkg_scannerpb_scanner_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FamilyState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FamilyState) ProtoMessage() {}
+
+func (x *FamilyState) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_pkg_scannerpb_scanner_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FamilyState.ProtoReflect.Descriptor instead.
+func (*FamilyState) Descriptor() ([]byte, []int) {
+	return file_shared_pkg_scannerpb_scanner_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FamilyState) GetFamily() ScanFamily {
+	if x != nil {
+		return x.Family
+	}
+	return ScanFamily_SCAN_FAMILY_UNSPECIFIED
+}
+
+func (x *FamilyState) GetState() *TargetScanState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *FamilyState) GetAppendErrors() bool {
+	if x != nil {
+		return x.AppendErrors
+	}
+	return false
+}
+
+type UpdateScanStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScanResultId string         `protobuf:"bytes,1,opt,name=scan_result_id,json=scanResultId,proto3" json:"scan_result_id,omitempty"`
+	States       []*FamilyState `protobuf:"bytes,2,rep,name=states,proto3" json:"states,omitempty"`
+}
+
+func (x *UpdateScanStatusRequest) Reset() {
+	*x = UpdateScanStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_pkg_scannerpb_scanner_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateScanStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScanStatusRequest) ProtoMessage() {}
+
+func (x *UpdateScanStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_pkg_scannerpb_scanner_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScanStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateScanStatusRequest) Descriptor() ([]byte, []int) {
+	return file_shared_pkg_scannerpb_scanner_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateScanStatusRequest) GetScanResultId() string {
+	if x != nil {
+		return x.ScanResultId
+	}
+	return ""
+}
+
+func (x *UpdateScanStatusRequest) GetStates() []*FamilyState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+type UpdateScanStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateScanStatusResponse) Reset() {
+	*x = UpdateScanStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_pkg_scannerpb_scanner_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateScanStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScanStatusResponse) ProtoMessage() {}
+
+func (x *UpdateScanStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_pkg_scannerpb_scanner_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScanStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateScanStatusResponse) Descriptor() ([]byte, []int) {
+	return file_shared_pkg_scannerpb_scanner_proto_rawDescGZIP(), []int{3}
+}
+
+// VulnerabilityScanSummary is the number of vulnerabilities per severity,
+// see VulnerabilityScanSummary.
+type VulnerabilityScanSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalCriticalVulnerabilities   *int64 `protobuf:"varint,1,opt,name=total_critical_vulnerabilities,json=totalCriticalVulnerabilities,proto3,oneof" json:"total_critical_vulnerabilities,omitempty"`
+	TotalHighVulnerabilities       *int64 `protobuf:"varint,2,opt,name=total_high_vulnerabilities,json=totalHighVulnerabilities,proto3,oneof" json:"total_high_vulnerabilities,omitempty"`
+	TotalMediumVulnerabilities     *int64 `protobuf:"varint,3,opt,name=total_medium_vulnerabilities,json=totalMediumVulnerabilities,proto3,oneof" json:"total_medium_vulnerabilities,omitempty"`
+	TotalLowVulnerabilities        *int64 `protobuf:"varint,4,opt,name=total_low_vulnerabilities,json=totalLowVulnerabilities,proto3,oneof" json:"total_low_vulnerabilities,omitempty"`
+	TotalNegligibleVulnerabilities *int64 `protobuf:"varint,5,opt,name=total_negligible_vulnerabilities,json=totalNegligibleVulnerabilities,proto3,oneof" json:"total_negligible_vulnerabilities,omitempty"`
+}
+
+func (x *VulnerabilityScanSummary) Reset() {
+	*x = VulnerabilityScanSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_pkg_scannerpb_scanner_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VulnerabilityScanSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VulnerabilityScanSummary) ProtoMessage() {}
+
+func (x *VulnerabilityScanSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_pkg_scannerpb_scanner_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VulnerabilityScanSummary.ProtoReflect.Descriptor instead.
+func (*VulnerabilityScanSummary) Descriptor() ([]byte, []int) {
+	return file_shared_pkg_scannerpb_scanner_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *VulnerabilityScanSummary) GetTotalCriticalVulnerabilities() int64 {
+	if x != nil && x.TotalCriticalVulnerabilities != nil {
+		return *x.TotalCriticalVulnerabilities
+	}
+	return 0
+}
+
+func (x *VulnerabilityScanSummary) GetTotalHighVulnerabilities() int64 {
+	if x != nil && x.TotalHighVulnerabilities != nil {
+		return *x.TotalHighVulnerabilities
+	}
+	return 0
+}
+
+func (x *VulnerabilityScanSummary) GetTotalMediumVulnerabilities() int64 {
+	if x != nil && x.TotalMediumVulnerabilities != nil {
+		return *x.TotalMediumVulnerabilities
+	}
+	return 0
+}
+
+func (x *VulnerabilityScanSummary) GetTotalLowVulnerabilities() int64 {
+	if x != nil && x.TotalLowVulnerabilities != nil {
+		return *x.TotalLowVulnerabilities
+	}
+	return 0
+}
+
+func (x *VulnerabilityScanSummary) GetTotalNegligibleVulnerabilities() int64 {
+	if x != nil && x.TotalNegligibleVulnerabilities != nil {
+		return *x.TotalNegligibleVulnerabilities
+	}
+	return 0
+}
+
+// ScanFindingsSummary is the number of findings per family, see
+// ScanFindingsSummary. Only the set totals are updated.
+type ScanFindingsSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalPackages          *int64                    `protobuf:"varint,1,opt,name=total_packages,json=totalPackages,proto3,oneof" json:"total_packages,omitempty"`
+	TotalVulnerabilities   *VulnerabilityScanSummary `protobuf:"bytes,2,opt,name=total_vulnerabilities,json=totalVulnerabilities,proto3" json:"total_vulnerabilities,omitempty"`
+	TotalExploits          *int64                    `protobuf:"varint,3,opt,name=total_exploits,json=totalExploits,proto3,oneof" json:"total_exploits,omitempty"`
+	TotalMisconfigurations *int64                    `protobuf:"varint,4,opt,name=total_misconfigurations,json=totalMisconfigurations,proto3,oneof" json:"total_misconfigurations,omitempty"`
+	TotalSecrets           *int64                    `protobuf:"varint,5,opt,name=total_secrets,json=totalSecrets,proto3,oneof" json:"total_secrets,omitempty"`
+	TotalMalware           *int64                    `protobuf:"varint,6,opt,name=total_malware,json=totalMalware,proto3,oneof" json:"total_malware,omitempty"`
+	TotalRootkits          *int64                    `protobuf:"varint,7,opt,name=total_rootkits,json=totalRootkits,proto3,oneof" json:"total_rootkits,omitempty"`
+	TotalComplianceChecks  *int64                    `protobuf:"varint,8,opt,name=total_compliance_checks,json=totalComplianceChecks,proto3,oneof" json:"total_compliance_checks,omitempty"`
+	TotalCertificates      *int64                    `protobuf:"varint,9,opt,name=total_certificates,json=totalCertificates,proto3,oneof" json:"total_certificates,omitempty"`
+	TotalPluginFindings    *int64                    `protobuf:"varint,10,opt,name=total_plugin_findings,json=totalPluginFindings,proto3,oneof" json:"total_plugin_findings,omitempty"`
+}
+
+func (x *ScanFindingsSummary) Reset() {
+	*x = ScanFindingsSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_pkg_scannerpb_scanner_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanFindingsSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanFindingsSummary) ProtoMessage() {}
+
+func (x *ScanFindingsSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_pkg_scannerpb_scanner_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanFindingsSummary.ProtoReflect.Descriptor instead.
+func (*ScanFindingsSummary) Descriptor() ([]byte, []int) {
+	return file_shared_pkg_scannerpb_scanner_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ScanFindingsSummary) GetTotalPackages() int64 {
+	if x != nil && x.TotalPackages != nil {
+		return *x.TotalPackages
+	}
+	return 0
+}
+
+func (x *ScanFindingsSummary) GetTotalVulnerabilities() *VulnerabilityScanSummary {
+	if x != nil {
+		return x.TotalVulnerabilities
+	}
+	return nil
+}
+
+func (x *ScanFindingsSummary) GetTotalExploits() int64 {
+	if x != nil && x.TotalExploits != nil {
+		return *x.TotalExploits
+	}
+	return 0
+}
+
+func (x *ScanFindingsSummary) GetTotalMisconfigurations() int64 {
+	if x != nil && x.TotalMisconfigurations != nil {
+		return *x.TotalMisconfigurations
+	}
+	return 0
+}
+
+func (x *ScanFindingsSummary) GetTotalSecrets() int64 {
+	if x != nil && x.TotalSecrets != nil {
+		return *x.TotalSecrets
+	}
+	return 0
+}
+
+func (x *ScanFindingsSummary) GetTotalMalware() int64 {
+	if x != nil && x.TotalMalware != nil {
+		return *x.TotalMalware
+	}
+	return 0
+}
+
+func (x *ScanFindingsSummary) GetTotalRootkits() int64 {
+	if x != nil && x.TotalRootkits != nil {
+		return *x.TotalRootkits
+	}
+	return 0
+}
+
+func (x *ScanFindingsSummary) GetTotalComplianceChecks() int64 {
+	if x != nil && x.TotalComplianceChecks != nil {
+		return *x.TotalComplianceChecks
+	}
+	return 0
+}
+
+func (x *ScanFindingsSummary) GetTotalCertificates() int64 {
+	if x != nil && x.TotalCertificates != nil {
+		return *x.TotalCertificates
+	}
+	return 0
+}
+
+func (x *ScanFindingsSummary) GetTotalPluginFindings() int64 {
+	if x != nil && x.TotalPluginFindings != nil {
+		return *x.TotalPluginFindings
+	}
+	return 0
+}
+
+type UploadHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScanResultId string     `protobuf:"bytes,1,opt,name=scan_result_id,json=scanResultId,proto3" json:"scan_result_id,omitempty"`
+	Family       ScanFamily `protobuf:"varint,2,opt,name=family,proto3,enum=vmclarity.scanner.v1.ScanFamily" json:"family,omitempty"`
+}
+
+func (x *UploadHeader) Reset() {
+	*x = UploadHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_pkg_scannerpb_scanner_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadHeader) ProtoMessage() {}
+
+func (x *UploadHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_pkg_scannerpb_scanner_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadHeader.ProtoReflect.Descriptor instead.
+func (*UploadHeader) Descriptor() ([]byte, []int) {
+	return file_shared_pkg_scannerpb_scanner_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UploadHeader) GetScanResultId() string {
+	if x != nil {
+		return x.ScanResultId
+	}
+	return ""
+}
+
+func (x *UploadHeader) GetFamily() ScanFamily {
+	if x != nil {
+		return x.Family
+	}
+	return ScanFamily_SCAN_FAMILY_UNSPECIFIED
+}
+
+// ResultChunk is a part of the result of the family. The result is the JSON
+// encoded API model of the family, e.g. SbomScan, the lists of the chunks are
+// concatenated and the other fields of a later chunk replace the earlier ones.
+type ResultChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result []byte `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *ResultChunk) Reset() {
+	*x = ResultChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_pkg_scannerpb_scanner_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResultChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultChunk) ProtoMessage() {}
+
+func (x *ResultChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_pkg_scannerpb_scanner_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultChunk.ProtoReflect.Descriptor instead.
+func (*ResultChunk) Descriptor() ([]byte, []int) {
+	return file_shared_pkg_scannerpb_scanner_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ResultChunk) GetResult() []byte {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type UploadScanResultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Payload:
+	//	*UploadScanResultRequest_Header
+	//	*UploadScanResultRequest_Chunk
+	//	*UploadScanResultRequest_State
+	//	*UploadScanResultRequest_Summary
+	Payload isUploadScanResultRequest_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *UploadScanResultRequest) Reset() {
+	*x = UploadScanResultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_pkg_scannerpb_scanner_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadScanResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadScanResultRequest) ProtoMessage() {}
+
+func (x *UploadScanResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_pkg_scannerpb_scanner_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadScanResultRequest.ProtoReflect.Descriptor instead.
+func (*UploadScanResultRequest) Descriptor() ([]byte, []int) {
+	return file_shared_pkg_scannerpb_scanner_proto_rawDescGZIP(), []int{8}
+}
+
+func (m *UploadScanResultRequest) GetPayload() isUploadScanResultRequest_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *UploadScanResultRequest) GetHeader() *UploadHeader {
+	if x, ok := x.GetPayload().(*UploadScanResultRequest_Header); ok {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *UploadScanResultRequest) GetChunk() *ResultChunk {
+	if x, ok := x.GetPayload().(*UploadScanResultRequest_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *UploadScanResultRequest) GetState() *TargetScanState {
+	if x, ok := x.GetPayload().(*UploadScanResultRequest_State); ok {
+		return x.State
+	}
+	return nil
+}
+
+func (x *UploadScanResultRequest) GetSummary() *ScanFindingsSummary {
+	if x, ok := x.GetPayload().(*UploadScanResultRequest_Summary); ok {
+		return x.Summary
+	}
+	return nil
+}
+
+type isUploadScanResultRequest_Payload interface {
+	isUploadScanResultRequest_Payload()
+}
+
+type UploadScanResultRequest_Header struct {
+	Header *UploadHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
+}
+
+type UploadScanResultRequest_Chunk struct {
+	Chunk *ResultChunk `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+type UploadScanResultRequest_State struct {
+	// State of the family applied along with the result.
+	State *TargetScanState `protobuf:"bytes,3,opt,name=state,proto3,oneof"`
+}
+
+type UploadScanResultRequest_Summary struct {
+	Summary *ScanFindingsSummary `protobuf:"bytes,4,opt,name=summary,proto3,oneof"`
+}
+
+func (*UploadScanResultRequest_Header) isUploadScanResultRequest_Payload() {}
+
+func (*UploadScanResultRequest_Chunk) isUploadScanResultRequest_Payload() {}
+
+func (*UploadScanResultRequest_State) isUploadScanResultRequest_Payload() {}
+
+func (*UploadScanResultRequest_Summary) isUploadScanResultRequest_Payload() {}
+
+type UploadScanResultResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of chunks the result was assembled from.
+	Chunks int64 `protobuf:"varint,1,opt,name=chunks,proto3" json:"chunks,omitempty"`
+}
+
+func (x *UploadScanResultResponse) Reset() {
+	*x = UploadScanResultResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_pkg_scannerpb_scanner_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadScanResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadScanResultResponse) ProtoMessage() {}
+
+func (x *UploadScanResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_pkg_scannerpb_scanner_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadScanResultResponse.ProtoReflect.Descriptor instead.
+func (*UploadScanResultResponse) Descriptor() ([]byte, []int) {
+	return file_shared_pkg_scannerpb_scanner_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UploadScanResultResponse) GetChunks() int64 {
+	if x != nil {
+		return x.Chunks
+	}
+	return 0
+}
+
+var File_shared_pkg_scannerpb_scanner_proto protoreflect.FileDescriptor
+
+var file_shared_pkg_scannerpb_scanner_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x73, 0x63, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2f, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x76, 0x6d, 0x63, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e,
+	0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xed, 0x02, 0x0a, 0x0f,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x41, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b,
+	0x2e, 0x76, 0x6d, 0x63, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x63, 0x61, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x4c, 0x0a, 0x14, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x05, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59,
+	0x5f, 0x54, 0x4f, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10,
+	0x04, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x42, 0x4f, 0x52, 0x54,
+	0x45, 0x44, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x4e, 0x45, 0x44, 0x10, 0x06, 0x12, 0x0e, 0x0a, 0x0a, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x07, 0x22, 0xa9, 0x01, 0x0a, 0x0b,
+	0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x66,
+	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x76, 0x6d,
+	0x63, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x06, 0x66,
+	0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x76, 0x6d, 0x63, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79,
+	0x2e, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x65, 0x6e,
+	0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x7a, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x63, 0x61, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x6d, 0x63, 0x6c, 0x61,
+	0x72, 0x69, 0x74, 0x79, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x61,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xa5, 0x04, 0x0a, 0x18, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x49, 0x0a, 0x1e,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x76,
+	0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x1c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x72, 0x69,
+	0x74, 0x69, 0x63, 0x61, 0x6c, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x1a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x68, 0x69, 0x67, 0x68, 0x5f, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x18, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x48, 0x69, 0x67, 0x68, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x1c, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x5f, 0x76, 0x75, 0x6c, 0x6e, 0x65,
+	0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x02, 0x52, 0x1a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x56,
+	0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x3f, 0x0a, 0x19, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c, 0x6f, 0x77, 0x5f, 0x76,
+	0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x6f, 0x77,
+	0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x4d, 0x0a, 0x20, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6e, 0x65, 0x67, 0x6c,
+	0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x1e,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4e, 0x65, 0x67, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x56,
+	0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x88, 0x01,
+	0x01, 0x42, 0x21, 0x0a, 0x1f, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x72, 0x69, 0x74,
+	0x69, 0x63, 0x61, 0x6c, 0x5f, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x68,
+	0x69, 0x67, 0x68, 0x5f, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x42, 0x1f, 0x0a, 0x1d, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x65,
+	0x64, 0x69, 0x75, 0x6d, 0x5f, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x42, 0x1c, 0x0a, 0x1a, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c,
+	0x6f, 0x77, 0x5f, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x42, 0x23, 0x0a, 0x21, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6e, 0x65, 0x67,
+	0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x80, 0x06, 0x0a, 0x13, 0x53, 0x63, 0x61, 0x6e,
+	0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12,
+	0x2a, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x63, 0x0a, 0x15, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x76, 0x6d, 0x63,
+	0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x53,
+	0x63, 0x61, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x14, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x12, 0x2a, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x65, 0x78, 0x70, 0x6c, 0x6f, 0x69,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x45, 0x78, 0x70, 0x6c, 0x6f, 0x69, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x17,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52,
+	0x16, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x61,
+	0x6c, 0x77, 0x61, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x0c, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x61, 0x6c, 0x77, 0x61, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2a,
+	0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x6b, 0x69, 0x74, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x48, 0x05, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52,
+	0x6f, 0x6f, 0x74, 0x6b, 0x69, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x17, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x48, 0x06, 0x52, 0x15, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x73, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x07, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x15, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x66, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x48, 0x08, 0x52, 0x13, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x65, 0x78, 0x70, 0x6c, 0x6f, 0x69, 0x74, 0x73, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x6c, 0x77, 0x61, 0x72, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x6b, 0x69, 0x74, 0x73, 0x42, 0x1a, 0x0a,
+	0x18, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73,
+	0x42, 0x18, 0x0a, 0x16, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x5f, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x6e, 0x0a, 0x0c, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x63,
+	0x61, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x73, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x49, 0x64,
+	0x12, 0x38, 0x0a, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x20, 0x2e, 0x76, 0x6d, 0x63, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x73, 0x63, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x46, 0x61, 0x6d, 0x69,
+	0x6c, 0x79, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x22, 0x25, 0x0a, 0x0b, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0xa3, 0x02, 0x0a, 0x17, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x63, 0x61, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x76, 0x6d, 0x63, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x05, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x6d, 0x63,
+	0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x00, 0x52,
+	0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x3d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x76, 0x6d, 0x63, 0x6c, 0x61, 0x72, 0x69, 0x74,
+	0x79, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x76, 0x6d, 0x63, 0x6c, 0x61, 0x72, 0x69,
+	0x74, 0x79, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63,
+	0x61, 0x6e, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x42, 0x09, 0x0a, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x32, 0x0a, 0x18, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x2a, 0xd5, 0x02, 0x0a, 0x0a,
+	0x53, 0x63, 0x61, 0x6e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x43,
+	0x41, 0x4e, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x43, 0x41, 0x4e, 0x5f,
+	0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x4c, 0x10, 0x01,
+	0x12, 0x14, 0x0a, 0x10, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f,
+	0x53, 0x42, 0x4f, 0x4d, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x46,
+	0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x56, 0x55, 0x4c, 0x4e, 0x45, 0x52, 0x41, 0x42, 0x49, 0x4c,
+	0x49, 0x54, 0x49, 0x45, 0x53, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x43, 0x41, 0x4e, 0x5f,
+	0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x45, 0x58, 0x50, 0x4c, 0x4f, 0x49, 0x54, 0x53, 0x10,
+	0x04, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59,
+	0x5f, 0x4d, 0x49, 0x53, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x53, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x46, 0x41, 0x4d,
+	0x49, 0x4c, 0x59, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x53, 0x10, 0x06, 0x12, 0x17, 0x0a,
+	0x13, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x4d, 0x41, 0x4c,
+	0x57, 0x41, 0x52, 0x45, 0x10, 0x07, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x46,
+	0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x4b, 0x49, 0x54, 0x53, 0x10, 0x08,
+	0x12, 0x1a, 0x0a, 0x16, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f,
+	0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x49, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x09, 0x12, 0x1c, 0x0a, 0x18,
+	0x53, 0x43, 0x41, 0x4e, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x43, 0x45, 0x52, 0x54,
+	0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x45, 0x53, 0x10, 0x0a, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x43,
+	0x41, 0x4e, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x5f, 0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e,
+	0x53, 0x10, 0x0b, 0x32, 0xf8, 0x01, 0x0a, 0x0e, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x71, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x63, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x2e, 0x76, 0x6d, 0x63,
+	0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x76, 0x6d, 0x63, 0x6c,
+	0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x10, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2d, 0x2e,
+	0x76, 0x6d, 0x63, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x63, 0x61, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x76,
+	0x6d, 0x63, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2e, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x42, 0x37,
+	0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65,
+	0x6e, 0x63, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x76, 0x6d, 0x63, 0x6c, 0x61, 0x72, 0x69,
+	0x74, 0x79, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x73, 0x63,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_shared_pkg_scannerpb_scanner_proto_rawDescOnce sync.Once
+	file_shared_pkg_scannerpb_scanner_proto_rawDescData = file_shared_pkg_scannerpb_scanner_proto_rawDesc
+)
+
+func file_shared_pkg_scannerpb_scanner_proto_rawDescGZIP() []byte {
+	file_shared_pkg_scannerpb_scanner_proto_rawDescOnce.Do(func() {
+		file_shared_pkg_scannerpb_scanner_proto_rawDescData = protoimpl.X.CompressGZIP(file_shared_pkg_scannerpb_scanner_proto_rawDescData)
+	})
+	return file_shared_pkg_scannerpb_scanner_proto_rawDescData
+}
+
+var file_shared_pkg_scannerpb_scanner_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_shared_pkg_scannerpb_scanner_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_shared_pkg_scannerpb_scanner_proto_goTypes = []interface{}{
+	(ScanFamily)(0),                  // 0: vmclarity.scanner.v1.ScanFamily
+	(TargetScanState_State)(0),       // 1: vmclarity.scanner.v1.TargetScanState.State
+	(*TargetScanState)(nil),          // 2: vmclarity.scanner.v1.TargetScanState
+	(*FamilyState)(nil),              // 3: vmclarity.scanner.v1.FamilyState
+	(*UpdateScanStatusRequest)(nil),  // 4: vmclarity.scanner.v1.UpdateScanStatusRequest
+	(*UpdateScanStatusResponse)(nil), // 5: vmclarity.scanner.v1.UpdateScanStatusResponse
+	(*VulnerabilityScanSummary)(nil), // 6: vmclarity.scanner.v1.VulnerabilityScanSummary
+	(*ScanFindingsSummary)(nil),      // 7: vmclarity.scanner.v1.ScanFindingsSummary
+	(*UploadHeader)(nil),             // 8: vmclarity.scanner.v1.UploadHeader
+	(*ResultChunk)(nil),              // 9: vmclarity.scanner.v1.ResultChunk
+	(*UploadScanResultRequest)(nil),  // 10: vmclarity.scanner.v1.UploadScanResultRequest
+	(*UploadScanResultResponse)(nil), // 11: vmclarity.scanner.v1.UploadScanResultResponse
+	(*timestamppb.Timestamp)(nil),    // 12: google.protobuf.Timestamp
+}
+var file_shared_pkg_scannerpb_scanner_proto_depIdxs = []int32{
+	1,  // 0: vmclarity.scanner.v1.TargetScanState.state:type_name -> vmclarity.scanner.v1.TargetScanState.State
+	12, // 1: vmclarity.scanner.v1.TargetScanState.last_transition_time:type_name -> google.protobuf.Timestamp
+	0,  // 2: vmclarity.scanner.v1.FamilyState.family:type_name -> vmclarity.scanner.v1.ScanFamily
+	2,  // 3: vmclarity.scanner.v1.FamilyState.state:type_name -> vmclarity.scanner.v1.TargetScanState
+	3,  // 4: vmclarity.scanner.v1.UpdateScanStatusRequest.states:type_name -> vmclarity.scanner.v1.FamilyState
+	6,  // 5: vmclarity.scanner.v1.ScanFindingsSummary.total_vulnerabilities:type_name -> vmclarity.scanner.v1.VulnerabilityScanSummary
+	0,  // 6: vmclarity.scanner.v1.UploadHeader.family:type_name -> vmclarity.scanner.v1.ScanFamily
+	8,  // 7: vmclarity.scanner.v1.UploadScanResultRequest.header:type_name -> vmclarity.scanner.v1.UploadHeader
+	9,  // 8: vmclarity.scanner.v1.UploadScanResultRequest.chunk:type_name -> vmclarity.scanner.v1.ResultChunk
+	2,  // 9: vmclarity.scanner.v1.UploadScanResultRequest.state:type_name -> vmclarity.scanner.v1.TargetScanState
+	7,  // 10: vmclarity.scanner.v1.UploadScanResultRequest.summary:type_name -> vmclarity.scanner.v1.ScanFindingsSummary
+	4,  // 11: vmclarity.scanner.v1.ScannerService.UpdateScanStatus:input_type -> vmclarity.scanner.v1.UpdateScanStatusRequest
+	10, // 12: vmclarity.scanner.v1.ScannerService.UploadScanResult:input_type -> vmclarity.scanner.v1.UploadScanResultRequest
+	5,  // 13: vmclarity.scanner.v1.ScannerService.UpdateScanStatus:output_type -> vmclarity.scanner.v1.UpdateScanStatusResponse
+	11, // 14: vmclarity.scanner.v1.ScannerService.UploadScanResult:output_type -> vmclarity.scanner.v1.UploadScanResultResponse
+	13, // [13:15] is the sub-list for method output_type
+	11, // [11:13] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_shared_pkg_scannerpb_scanner_proto_init() }
+func file_shared_pkg_scannerpb_scanner_proto_init() {
+	if File_shared_pkg_scannerpb_scanner_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_shared_pkg_scannerpb_scanner_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TargetScanState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_pkg_scannerpb_scanner_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FamilyState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_pkg_scannerpb_scanner_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateScanStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_pkg_scannerpb_scanner_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateScanStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_pkg_scannerpb_scanner_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VulnerabilityScanSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_pkg_scannerpb_scanner_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanFindingsSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_pkg_scannerpb_scanner_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_pkg_scannerpb_scanner_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResultChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_pkg_scannerpb_scanner_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadScanResultRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_pkg_scannerpb_scanner_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadScanResultResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_shared_pkg_scannerpb_scanner_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_shared_pkg_scannerpb_scanner_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	file_shared_pkg_scannerpb_scanner_proto_msgTypes[8].OneofWrappers = []interface{}{
+		(*UploadScanResultRequest_Header)(nil),
+		(*UploadScanResultRequest_Chunk)(nil),
+		(*UploadScanResultRequest_State)(nil),
+		(*UploadScanResultRequest_Summary)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_shared_pkg_scannerpb_scanner_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_shared_pkg_scannerpb_scanner_proto_goTypes,
+		DependencyIndexes: file_shared_pkg_scannerpb_scanner_proto_depIdxs,
+		EnumInfos:         file_shared_pkg_scannerpb_scanner_proto_enumTypes,
+		MessageInfos:      file_shared_pkg_scannerpb_scanner_proto_msgTypes,
+	}.Build()
+	File_shared_pkg_scannerpb_scanner_proto = out.File
+	file_shared_pkg_scannerpb_scanner_proto_rawDesc = nil
+	file_shared_pkg_scannerpb_scanner_proto_goTypes = nil
+	file_shared_pkg_scannerpb_scanner_proto_depIdxs = nil
+}
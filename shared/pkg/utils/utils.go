@@ -57,6 +57,16 @@ func PointerTo[T any](value T) *T {
 	return &value
 }
 
+// ValueOrZero returns the value pointed to by ptr, or the zero value of T if
+// ptr is nil.
+func ValueOrZero[T any](ptr *T) T {
+	var zero T
+	if ptr == nil {
+		return zero
+	}
+	return *ptr
+}
+
 func StringKeyMapToArray[T any](m map[string]T) []T {
 	ret := make([]T, 0, len(m))
 	for _, t := range m {
@@ -0,0 +1,106 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+// CompareVersions is a best-effort comparison of package version strings
+// which works across the common versioning schemes (semver, deb, rpm, ...).
+// Semver versions are compared by their precedence, e.g. 1.0.0-rc1 is lower
+// than 1.0.0. Other versions, and semver versions of the same precedence
+// like deb revisions in the build metadata, are split into numeric and
+// alphabetic segments which are compared in order, numeric segments
+// numerically. It returns -1, 0 or 1 if a is lower than, equal to or greater
+// than b.
+func CompareVersions(a, b string) int {
+	if av, err := semver.NewVersion(a); err == nil {
+		if bv, err := semver.NewVersion(b); err == nil {
+			if c := av.Compare(bv); c != 0 {
+				return c
+			}
+		}
+	}
+
+	as, bs := splitVersion(a), splitVersion(b)
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		if c := compareVersionSegments(as[i], bs[i]); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case len(as) < len(bs):
+		return -1
+	case len(as) > len(bs):
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareVersionSegments(a, b string) int {
+	an, aErr := strconv.ParseUint(a, 10, 64)
+	bn, bErr := strconv.ParseUint(b, 10, 64)
+	switch {
+	case aErr == nil && bErr == nil:
+		switch {
+		case an < bn:
+			return -1
+		case an > bn:
+			return 1
+		default:
+			return 0
+		}
+	case aErr == nil:
+		// numeric segments are considered newer than alphabetic ones, e.g. 1.0.1 > 1.0.rc1
+		return 1
+	case bErr == nil:
+		return -1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+func splitVersion(version string) []string {
+	var segments []string
+	var current strings.Builder
+	var currentIsDigit bool
+	for _, r := range strings.TrimPrefix(version, "v") {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if current.Len() > 0 {
+				segments = append(segments, current.String())
+				current.Reset()
+			}
+			continue
+		}
+		if current.Len() > 0 && unicode.IsDigit(r) != currentIsDigit {
+			segments = append(segments, current.String())
+			current.Reset()
+		}
+		currentIsDigit = unicode.IsDigit(r)
+		current.WriteRune(r)
+	}
+	if current.Len() > 0 {
+		segments = append(segments, current.String())
+	}
+	return segments
+}
@@ -0,0 +1,95 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import "testing"
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want int
+	}{
+		{
+			name: "equal",
+			a:    "1.2.3",
+			b:    "1.2.3",
+			want: 0,
+		},
+		{
+			name: "numeric segments are compared numerically",
+			a:    "1.10.0",
+			b:    "1.9.0",
+			want: 1,
+		},
+		{
+			name: "lower patch",
+			a:    "1.2.3",
+			b:    "1.2.4",
+			want: -1,
+		},
+		{
+			name: "more segments is newer",
+			a:    "1.2",
+			b:    "1.2.1",
+			want: -1,
+		},
+		{
+			name: "v prefix is ignored",
+			a:    "v1.2.3",
+			b:    "1.2.3",
+			want: 0,
+		},
+		{
+			name: "deb revision",
+			a:    "2.36.1-8+deb11u1",
+			b:    "2.36.1-8",
+			want: 1,
+		},
+		{
+			name: "release is newer than pre-release",
+			a:    "1.0.1",
+			b:    "1.0.rc1",
+			want: 1,
+		},
+		{
+			name: "semver release is newer than its pre-release",
+			a:    "1.0.0-rc1",
+			b:    "1.0.0",
+			want: -1,
+		},
+		{
+			name: "semver pre-releases",
+			a:    "1.0.0-rc.10",
+			b:    "1.0.0-rc.2",
+			want: 1,
+		},
+		{
+			name: "semver pre-release of a newer version",
+			a:    "v2.0.0-beta",
+			b:    "1.9.9",
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CompareVersions(tt.a, tt.b); got != tt.want {
+				t.Errorf("CompareVersions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
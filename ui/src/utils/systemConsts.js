@@ -13,7 +13,7 @@ export const ROUTES = {
 export const APIS = {
     SCANS: "scans",
     SCAN_CONFIGS: "scanConfigs",
-    ASSETS: "targets",
+    ASSETS: "assets",
     ASSET_SCANS: "scanResults",
     SCOPES_DISCOVERY: "discovery/scopes",
     FINDINGS: "findings",
@@ -89,17 +89,92 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetDashboardComplianceCoverage request
+	GetDashboardComplianceCoverage(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDashboardCorrelatedFindings request
+	GetDashboardCorrelatedFindings(ctx context.Context, params *GetDashboardCorrelatedFindingsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDashboardCoverage request
+	GetDashboardCoverage(ctx context.Context, params *GetDashboardCoverageParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDashboardFindingsByLocation request
+	GetDashboardFindingsByLocation(ctx context.Context, params *GetDashboardFindingsByLocationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetDashboardFindingsImpact request
 	GetDashboardFindingsImpact(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetDashboardFindingsPerScanConfig request
+	GetDashboardFindingsPerScanConfig(ctx context.Context, params *GetDashboardFindingsPerScanConfigParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetDashboardFindingsTrends request
 	GetDashboardFindingsTrends(ctx context.Context, params *GetDashboardFindingsTrendsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetDashboardMalwarePrevalence request
+	GetDashboardMalwarePrevalence(ctx context.Context, params *GetDashboardMalwarePrevalenceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDashboardPostureScores request
+	GetDashboardPostureScores(ctx context.Context, params *GetDashboardPostureScoresParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetDashboardRiskiestAssets request
-	GetDashboardRiskiestAssets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetDashboardRiskiestAssets(ctx context.Context, params *GetDashboardRiskiestAssetsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetDashboardRiskiestRegions request
 	GetDashboardRiskiestRegions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDashboardRootkitDetections request
+	GetDashboardRootkitDetections(ctx context.Context, params *GetDashboardRootkitDetectionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetQueryResource request
+	GetQueryResource(ctx context.Context, resource Resource, params *GetQueryResourceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetDashboardComplianceCoverage(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDashboardComplianceCoverageRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDashboardCorrelatedFindings(ctx context.Context, params *GetDashboardCorrelatedFindingsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDashboardCorrelatedFindingsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDashboardCoverage(ctx context.Context, params *GetDashboardCoverageParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDashboardCoverageRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDashboardFindingsByLocation(ctx context.Context, params *GetDashboardFindingsByLocationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDashboardFindingsByLocationRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
 func (c *Client) GetDashboardFindingsImpact(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -114,6 +189,18 @@ func (c *Client) GetDashboardFindingsImpact(ctx context.Context, reqEditors ...R
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetDashboardFindingsPerScanConfig(ctx context.Context, params *GetDashboardFindingsPerScanConfigParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDashboardFindingsPerScanConfigRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetDashboardFindingsTrends(ctx context.Context, params *GetDashboardFindingsTrendsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetDashboardFindingsTrendsRequest(c.Server, params)
 	if err != nil {
@@ -126,8 +213,32 @@ func (c *Client) GetDashboardFindingsTrends(ctx context.Context, params *GetDash
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetDashboardRiskiestAssets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDashboardRiskiestAssetsRequest(c.Server)
+func (c *Client) GetDashboardMalwarePrevalence(ctx context.Context, params *GetDashboardMalwarePrevalenceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDashboardMalwarePrevalenceRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDashboardPostureScores(ctx context.Context, params *GetDashboardPostureScoresParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDashboardPostureScoresRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetDashboardRiskiestAssets(ctx context.Context, params *GetDashboardRiskiestAssetsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDashboardRiskiestAssetsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -150,8 +261,32 @@ func (c *Client) GetDashboardRiskiestRegions(ctx context.Context, reqEditors ...
 	return c.Client.Do(req)
 }
 
-// NewGetDashboardFindingsImpactRequest generates requests for GetDashboardFindingsImpact
-func NewGetDashboardFindingsImpactRequest(server string) (*http.Request, error) {
+func (c *Client) GetDashboardRootkitDetections(ctx context.Context, params *GetDashboardRootkitDetectionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDashboardRootkitDetectionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetQueryResource(ctx context.Context, resource Resource, params *GetQueryResourceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetQueryResourceRequest(c.Server, resource, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetDashboardComplianceCoverageRequest generates requests for GetDashboardComplianceCoverage
+func NewGetDashboardComplianceCoverageRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -159,7 +294,7 @@ func NewGetDashboardFindingsImpactRequest(server string) (*http.Request, error)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/dashboard/findingsImpact")
+	operationPath := fmt.Sprintf("/dashboard/complianceCoverage")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -177,8 +312,8 @@ func NewGetDashboardFindingsImpactRequest(server string) (*http.Request, error)
 	return req, nil
 }
 
-// NewGetDashboardFindingsTrendsRequest generates requests for GetDashboardFindingsTrends
-func NewGetDashboardFindingsTrendsRequest(server string, params *GetDashboardFindingsTrendsParams) (*http.Request, error) {
+// NewGetDashboardCorrelatedFindingsRequest generates requests for GetDashboardCorrelatedFindings
+func NewGetDashboardCorrelatedFindingsRequest(server string, params *GetDashboardCorrelatedFindingsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -186,7 +321,7 @@ func NewGetDashboardFindingsTrendsRequest(server string, params *GetDashboardFin
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/dashboard/findingsTrends")
+	operationPath := fmt.Sprintf("/dashboard/correlatedFindings")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -199,28 +334,20 @@ func NewGetDashboardFindingsTrendsRequest(server string, params *GetDashboardFin
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "startTime", runtime.ParamLocationQuery, params.StartTime); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
+		if params.AssetGroupID != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "assetGroupID", runtime.ParamLocationQuery, *params.AssetGroupID); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
 				}
 			}
-		}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "endTime", runtime.ParamLocationQuery, params.EndTime); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
 		}
 
 		queryURL.RawQuery = queryValues.Encode()
@@ -234,8 +361,8 @@ func NewGetDashboardFindingsTrendsRequest(server string, params *GetDashboardFin
 	return req, nil
 }
 
-// NewGetDashboardRiskiestAssetsRequest generates requests for GetDashboardRiskiestAssets
-func NewGetDashboardRiskiestAssetsRequest(server string) (*http.Request, error) {
+// NewGetDashboardCoverageRequest generates requests for GetDashboardCoverage
+func NewGetDashboardCoverageRequest(server string, params *GetDashboardCoverageParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -243,7 +370,7 @@ func NewGetDashboardRiskiestAssetsRequest(server string) (*http.Request, error)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/dashboard/riskiestAssets")
+	operationPath := fmt.Sprintf("/dashboard/coverage")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -253,6 +380,76 @@ func NewGetDashboardRiskiestAssetsRequest(server string) (*http.Request, error)
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.GroupBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "groupBy", runtime.ParamLocationQuery, *params.GroupBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SlaDays != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "slaDays", runtime.ParamLocationQuery, *params.SlaDays); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TeamTag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "teamTag", runtime.ParamLocationQuery, *params.TeamTag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AssetGroupID != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "assetGroupID", runtime.ParamLocationQuery, *params.AssetGroupID); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -261,8 +458,8 @@ func NewGetDashboardRiskiestAssetsRequest(server string) (*http.Request, error)
 	return req, nil
 }
 
-// NewGetDashboardRiskiestRegionsRequest generates requests for GetDashboardRiskiestRegions
-func NewGetDashboardRiskiestRegionsRequest(server string) (*http.Request, error) {
+// NewGetDashboardFindingsByLocationRequest generates requests for GetDashboardFindingsByLocation
+func NewGetDashboardFindingsByLocationRequest(server string, params *GetDashboardFindingsByLocationParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -270,7 +467,7 @@ func NewGetDashboardRiskiestRegionsRequest(server string) (*http.Request, error)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/dashboard/riskiestRegions")
+	operationPath := fmt.Sprintf("/dashboard/findingsByLocation")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -280,6 +477,28 @@ func NewGetDashboardRiskiestRegionsRequest(server string) (*http.Request, error)
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.GroupBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "groupBy", runtime.ParamLocationQuery, *params.GroupBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -288,206 +507,1317 @@ func NewGetDashboardRiskiestRegionsRequest(server string) (*http.Request, error)
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewGetDashboardFindingsImpactRequest generates requests for GetDashboardFindingsImpact
+func NewGetDashboardFindingsImpactRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+
+	operationPath := fmt.Sprintf("/dashboard/findingsImpact")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+
+	return req, nil
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+// NewGetDashboardFindingsPerScanConfigRequest generates requests for GetDashboardFindingsPerScanConfig
+func NewGetDashboardFindingsPerScanConfigRequest(server string, params *GetDashboardFindingsPerScanConfigParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetDashboardFindingsImpact request
-	GetDashboardFindingsImpactWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDashboardFindingsImpactResponse, error)
+	operationPath := fmt.Sprintf("/dashboard/findingsPerScanConfig")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetDashboardFindingsTrends request
-	GetDashboardFindingsTrendsWithResponse(ctx context.Context, params *GetDashboardFindingsTrendsParams, reqEditors ...RequestEditorFn) (*GetDashboardFindingsTrendsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetDashboardRiskiestAssets request
-	GetDashboardRiskiestAssetsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDashboardRiskiestAssetsResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// GetDashboardRiskiestRegions request
-	GetDashboardRiskiestRegionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDashboardRiskiestRegionsResponse, error)
-}
+		if params.AssetGroupID != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "assetGroupID", runtime.ParamLocationQuery, *params.AssetGroupID); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type GetDashboardFindingsImpactResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *FindingsImpact
-	JSONDefault  *ApiResponse
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r GetDashboardFindingsImpactResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDashboardFindingsImpactResponse) StatusCode() int {
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDashboardFindingsTrendsRequest generates requests for GetDashboardFindingsTrends
+func NewGetDashboardFindingsTrendsRequest(server string, params *GetDashboardFindingsTrendsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/dashboard/findingsTrends")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "startTime", runtime.ParamLocationQuery, params.StartTime); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "endTime", runtime.ParamLocationQuery, params.EndTime); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.AssetGroupID != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "assetGroupID", runtime.ParamLocationQuery, *params.AssetGroupID); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDashboardMalwarePrevalenceRequest generates requests for GetDashboardMalwarePrevalence
+func NewGetDashboardMalwarePrevalenceRequest(server string, params *GetDashboardMalwarePrevalenceParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/dashboard/malwarePrevalence")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "startTime", runtime.ParamLocationQuery, params.StartTime); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "endTime", runtime.ParamLocationQuery, params.EndTime); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.AssetGroupID != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "assetGroupID", runtime.ParamLocationQuery, *params.AssetGroupID); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDashboardPostureScoresRequest generates requests for GetDashboardPostureScores
+func NewGetDashboardPostureScoresRequest(server string, params *GetDashboardPostureScoresParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/dashboard/postureScores")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "startTime", runtime.ParamLocationQuery, params.StartTime); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "endTime", runtime.ParamLocationQuery, params.EndTime); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDashboardRiskiestAssetsRequest generates requests for GetDashboardRiskiestAssets
+func NewGetDashboardRiskiestAssetsRequest(server string, params *GetDashboardRiskiestAssetsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/dashboard/riskiestAssets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.AssetGroupID != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "assetGroupID", runtime.ParamLocationQuery, *params.AssetGroupID); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDashboardRiskiestRegionsRequest generates requests for GetDashboardRiskiestRegions
+func NewGetDashboardRiskiestRegionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/dashboard/riskiestRegions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDashboardRootkitDetectionsRequest generates requests for GetDashboardRootkitDetections
+func NewGetDashboardRootkitDetectionsRequest(server string, params *GetDashboardRootkitDetectionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/dashboard/rootkitDetections")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "startTime", runtime.ParamLocationQuery, params.StartTime); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "endTime", runtime.ParamLocationQuery, params.EndTime); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.AssetGroupID != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "assetGroupID", runtime.ParamLocationQuery, *params.AssetGroupID); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetQueryResourceRequest generates requests for GetQueryResource
+func NewGetQueryResourceRequest(server string, resource Resource, params *GetQueryResourceParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "resource", runtime.ParamLocationPath, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/query/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Select != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$select", runtime.ParamLocationQuery, *params.Select); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$orderby", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Count != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$count", runtime.ParamLocationQuery, *params.Count); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Top != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$top", runtime.ParamLocationQuery, *params.Top); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Skip != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "$skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetDashboardComplianceCoverage request
+	GetDashboardComplianceCoverageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDashboardComplianceCoverageResponse, error)
+
+	// GetDashboardCorrelatedFindings request
+	GetDashboardCorrelatedFindingsWithResponse(ctx context.Context, params *GetDashboardCorrelatedFindingsParams, reqEditors ...RequestEditorFn) (*GetDashboardCorrelatedFindingsResponse, error)
+
+	// GetDashboardCoverage request
+	GetDashboardCoverageWithResponse(ctx context.Context, params *GetDashboardCoverageParams, reqEditors ...RequestEditorFn) (*GetDashboardCoverageResponse, error)
+
+	// GetDashboardFindingsByLocation request
+	GetDashboardFindingsByLocationWithResponse(ctx context.Context, params *GetDashboardFindingsByLocationParams, reqEditors ...RequestEditorFn) (*GetDashboardFindingsByLocationResponse, error)
+
+	// GetDashboardFindingsImpact request
+	GetDashboardFindingsImpactWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDashboardFindingsImpactResponse, error)
+
+	// GetDashboardFindingsPerScanConfig request
+	GetDashboardFindingsPerScanConfigWithResponse(ctx context.Context, params *GetDashboardFindingsPerScanConfigParams, reqEditors ...RequestEditorFn) (*GetDashboardFindingsPerScanConfigResponse, error)
+
+	// GetDashboardFindingsTrends request
+	GetDashboardFindingsTrendsWithResponse(ctx context.Context, params *GetDashboardFindingsTrendsParams, reqEditors ...RequestEditorFn) (*GetDashboardFindingsTrendsResponse, error)
+
+	// GetDashboardMalwarePrevalence request
+	GetDashboardMalwarePrevalenceWithResponse(ctx context.Context, params *GetDashboardMalwarePrevalenceParams, reqEditors ...RequestEditorFn) (*GetDashboardMalwarePrevalenceResponse, error)
+
+	// GetDashboardPostureScores request
+	GetDashboardPostureScoresWithResponse(ctx context.Context, params *GetDashboardPostureScoresParams, reqEditors ...RequestEditorFn) (*GetDashboardPostureScoresResponse, error)
+
+	// GetDashboardRiskiestAssets request
+	GetDashboardRiskiestAssetsWithResponse(ctx context.Context, params *GetDashboardRiskiestAssetsParams, reqEditors ...RequestEditorFn) (*GetDashboardRiskiestAssetsResponse, error)
+
+	// GetDashboardRiskiestRegions request
+	GetDashboardRiskiestRegionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDashboardRiskiestRegionsResponse, error)
+
+	// GetDashboardRootkitDetections request
+	GetDashboardRootkitDetectionsWithResponse(ctx context.Context, params *GetDashboardRootkitDetectionsParams, reqEditors ...RequestEditorFn) (*GetDashboardRootkitDetectionsResponse, error)
+
+	// GetQueryResource request
+	GetQueryResourceWithResponse(ctx context.Context, resource Resource, params *GetQueryResourceParams, reqEditors ...RequestEditorFn) (*GetQueryResourceResponse, error)
+}
+
+type GetDashboardComplianceCoverageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ComplianceCoverage
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDashboardComplianceCoverageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDashboardComplianceCoverageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDashboardCorrelatedFindingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CorrelatedFindings
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDashboardCorrelatedFindingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDashboardCorrelatedFindingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDashboardCoverageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AssetCoverage
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDashboardCoverageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDashboardCoverageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDashboardFindingsByLocationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LocationFindings
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDashboardFindingsByLocationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDashboardFindingsByLocationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDashboardFindingsImpactResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FindingsImpact
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDashboardFindingsImpactResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDashboardFindingsImpactResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDashboardFindingsPerScanConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FindingsPerScanConfig
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDashboardFindingsPerScanConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDashboardFindingsPerScanConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDashboardFindingsTrendsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FindingsTrends
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDashboardFindingsTrendsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDashboardFindingsTrendsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetDashboardFindingsTrendsResponse struct {
+type GetDashboardMalwarePrevalenceResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *FindingsTrends
+	JSON200      *MalwarePrevalence
 	JSONDefault  *ApiResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r GetDashboardFindingsTrendsResponse) Status() string {
+func (r GetDashboardMalwarePrevalenceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDashboardMalwarePrevalenceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDashboardPostureScoresResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PostureScores
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDashboardPostureScoresResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDashboardPostureScoresResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDashboardRiskiestAssetsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RiskiestAssets
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDashboardRiskiestAssetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDashboardRiskiestAssetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDashboardRiskiestRegionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RiskiestRegions
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDashboardRiskiestRegionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDashboardRiskiestRegionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDashboardRootkitDetectionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RootkitDetections
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDashboardRootkitDetectionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDashboardRootkitDetectionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetQueryResourceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *QueryResult
+	JSON400      *ApiResponse
+	JSONDefault  *ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetQueryResourceResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
 	return http.StatusText(0)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDashboardFindingsTrendsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetQueryResourceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetDashboardComplianceCoverageWithResponse request returning *GetDashboardComplianceCoverageResponse
+func (c *ClientWithResponses) GetDashboardComplianceCoverageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDashboardComplianceCoverageResponse, error) {
+	rsp, err := c.GetDashboardComplianceCoverage(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDashboardComplianceCoverageResponse(rsp)
+}
+
+// GetDashboardCorrelatedFindingsWithResponse request returning *GetDashboardCorrelatedFindingsResponse
+func (c *ClientWithResponses) GetDashboardCorrelatedFindingsWithResponse(ctx context.Context, params *GetDashboardCorrelatedFindingsParams, reqEditors ...RequestEditorFn) (*GetDashboardCorrelatedFindingsResponse, error) {
+	rsp, err := c.GetDashboardCorrelatedFindings(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDashboardCorrelatedFindingsResponse(rsp)
+}
+
+// GetDashboardCoverageWithResponse request returning *GetDashboardCoverageResponse
+func (c *ClientWithResponses) GetDashboardCoverageWithResponse(ctx context.Context, params *GetDashboardCoverageParams, reqEditors ...RequestEditorFn) (*GetDashboardCoverageResponse, error) {
+	rsp, err := c.GetDashboardCoverage(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDashboardCoverageResponse(rsp)
+}
+
+// GetDashboardFindingsByLocationWithResponse request returning *GetDashboardFindingsByLocationResponse
+func (c *ClientWithResponses) GetDashboardFindingsByLocationWithResponse(ctx context.Context, params *GetDashboardFindingsByLocationParams, reqEditors ...RequestEditorFn) (*GetDashboardFindingsByLocationResponse, error) {
+	rsp, err := c.GetDashboardFindingsByLocation(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDashboardFindingsByLocationResponse(rsp)
+}
+
+// GetDashboardFindingsImpactWithResponse request returning *GetDashboardFindingsImpactResponse
+func (c *ClientWithResponses) GetDashboardFindingsImpactWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDashboardFindingsImpactResponse, error) {
+	rsp, err := c.GetDashboardFindingsImpact(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDashboardFindingsImpactResponse(rsp)
+}
+
+// GetDashboardFindingsPerScanConfigWithResponse request returning *GetDashboardFindingsPerScanConfigResponse
+func (c *ClientWithResponses) GetDashboardFindingsPerScanConfigWithResponse(ctx context.Context, params *GetDashboardFindingsPerScanConfigParams, reqEditors ...RequestEditorFn) (*GetDashboardFindingsPerScanConfigResponse, error) {
+	rsp, err := c.GetDashboardFindingsPerScanConfig(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDashboardFindingsPerScanConfigResponse(rsp)
+}
+
+// GetDashboardFindingsTrendsWithResponse request returning *GetDashboardFindingsTrendsResponse
+func (c *ClientWithResponses) GetDashboardFindingsTrendsWithResponse(ctx context.Context, params *GetDashboardFindingsTrendsParams, reqEditors ...RequestEditorFn) (*GetDashboardFindingsTrendsResponse, error) {
+	rsp, err := c.GetDashboardFindingsTrends(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDashboardFindingsTrendsResponse(rsp)
+}
+
+// GetDashboardMalwarePrevalenceWithResponse request returning *GetDashboardMalwarePrevalenceResponse
+func (c *ClientWithResponses) GetDashboardMalwarePrevalenceWithResponse(ctx context.Context, params *GetDashboardMalwarePrevalenceParams, reqEditors ...RequestEditorFn) (*GetDashboardMalwarePrevalenceResponse, error) {
+	rsp, err := c.GetDashboardMalwarePrevalence(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDashboardMalwarePrevalenceResponse(rsp)
+}
+
+// GetDashboardPostureScoresWithResponse request returning *GetDashboardPostureScoresResponse
+func (c *ClientWithResponses) GetDashboardPostureScoresWithResponse(ctx context.Context, params *GetDashboardPostureScoresParams, reqEditors ...RequestEditorFn) (*GetDashboardPostureScoresResponse, error) {
+	rsp, err := c.GetDashboardPostureScores(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDashboardPostureScoresResponse(rsp)
+}
+
+// GetDashboardRiskiestAssetsWithResponse request returning *GetDashboardRiskiestAssetsResponse
+func (c *ClientWithResponses) GetDashboardRiskiestAssetsWithResponse(ctx context.Context, params *GetDashboardRiskiestAssetsParams, reqEditors ...RequestEditorFn) (*GetDashboardRiskiestAssetsResponse, error) {
+	rsp, err := c.GetDashboardRiskiestAssets(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDashboardRiskiestAssetsResponse(rsp)
+}
+
+// GetDashboardRiskiestRegionsWithResponse request returning *GetDashboardRiskiestRegionsResponse
+func (c *ClientWithResponses) GetDashboardRiskiestRegionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDashboardRiskiestRegionsResponse, error) {
+	rsp, err := c.GetDashboardRiskiestRegions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDashboardRiskiestRegionsResponse(rsp)
+}
+
+// GetDashboardRootkitDetectionsWithResponse request returning *GetDashboardRootkitDetectionsResponse
+func (c *ClientWithResponses) GetDashboardRootkitDetectionsWithResponse(ctx context.Context, params *GetDashboardRootkitDetectionsParams, reqEditors ...RequestEditorFn) (*GetDashboardRootkitDetectionsResponse, error) {
+	rsp, err := c.GetDashboardRootkitDetections(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDashboardRootkitDetectionsResponse(rsp)
+}
+
+// GetQueryResourceWithResponse request returning *GetQueryResourceResponse
+func (c *ClientWithResponses) GetQueryResourceWithResponse(ctx context.Context, resource Resource, params *GetQueryResourceParams, reqEditors ...RequestEditorFn) (*GetQueryResourceResponse, error) {
+	rsp, err := c.GetQueryResource(ctx, resource, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetQueryResourceResponse(rsp)
+}
+
+// ParseGetDashboardComplianceCoverageResponse parses an HTTP response from a GetDashboardComplianceCoverageWithResponse call
+func ParseGetDashboardComplianceCoverageResponse(rsp *http.Response) (*GetDashboardComplianceCoverageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDashboardComplianceCoverageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ComplianceCoverage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDashboardCorrelatedFindingsResponse parses an HTTP response from a GetDashboardCorrelatedFindingsWithResponse call
+func ParseGetDashboardCorrelatedFindingsResponse(rsp *http.Response) (*GetDashboardCorrelatedFindingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDashboardCorrelatedFindingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CorrelatedFindings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
-	return 0
-}
 
-type GetDashboardRiskiestAssetsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *RiskiestAssets
-	JSONDefault  *ApiResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetDashboardRiskiestAssetsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetDashboardCoverageResponse parses an HTTP response from a GetDashboardCoverageWithResponse call
+func ParseGetDashboardCoverageResponse(rsp *http.Response) (*GetDashboardCoverageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDashboardRiskiestAssetsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetDashboardCoverageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetDashboardRiskiestRegionsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *RiskiestRegions
-	JSONDefault  *ApiResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AssetCoverage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetDashboardRiskiestRegionsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDashboardRiskiestRegionsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
+
+	return response, nil
 }
 
-// GetDashboardFindingsImpactWithResponse request returning *GetDashboardFindingsImpactResponse
-func (c *ClientWithResponses) GetDashboardFindingsImpactWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDashboardFindingsImpactResponse, error) {
-	rsp, err := c.GetDashboardFindingsImpact(ctx, reqEditors...)
+// ParseGetDashboardFindingsByLocationResponse parses an HTTP response from a GetDashboardFindingsByLocationWithResponse call
+func ParseGetDashboardFindingsByLocationResponse(rsp *http.Response) (*GetDashboardFindingsByLocationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDashboardFindingsImpactResponse(rsp)
+
+	response := &GetDashboardFindingsByLocationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LocationFindings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetDashboardFindingsTrendsWithResponse request returning *GetDashboardFindingsTrendsResponse
-func (c *ClientWithResponses) GetDashboardFindingsTrendsWithResponse(ctx context.Context, params *GetDashboardFindingsTrendsParams, reqEditors ...RequestEditorFn) (*GetDashboardFindingsTrendsResponse, error) {
-	rsp, err := c.GetDashboardFindingsTrends(ctx, params, reqEditors...)
+// ParseGetDashboardFindingsImpactResponse parses an HTTP response from a GetDashboardFindingsImpactWithResponse call
+func ParseGetDashboardFindingsImpactResponse(rsp *http.Response) (*GetDashboardFindingsImpactResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDashboardFindingsTrendsResponse(rsp)
+
+	response := &GetDashboardFindingsImpactResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FindingsImpact
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetDashboardRiskiestAssetsWithResponse request returning *GetDashboardRiskiestAssetsResponse
-func (c *ClientWithResponses) GetDashboardRiskiestAssetsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDashboardRiskiestAssetsResponse, error) {
-	rsp, err := c.GetDashboardRiskiestAssets(ctx, reqEditors...)
+// ParseGetDashboardFindingsPerScanConfigResponse parses an HTTP response from a GetDashboardFindingsPerScanConfigWithResponse call
+func ParseGetDashboardFindingsPerScanConfigResponse(rsp *http.Response) (*GetDashboardFindingsPerScanConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDashboardRiskiestAssetsResponse(rsp)
+
+	response := &GetDashboardFindingsPerScanConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FindingsPerScanConfig
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetDashboardRiskiestRegionsWithResponse request returning *GetDashboardRiskiestRegionsResponse
-func (c *ClientWithResponses) GetDashboardRiskiestRegionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetDashboardRiskiestRegionsResponse, error) {
-	rsp, err := c.GetDashboardRiskiestRegions(ctx, reqEditors...)
+// ParseGetDashboardFindingsTrendsResponse parses an HTTP response from a GetDashboardFindingsTrendsWithResponse call
+func ParseGetDashboardFindingsTrendsResponse(rsp *http.Response) (*GetDashboardFindingsTrendsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDashboardRiskiestRegionsResponse(rsp)
+
+	response := &GetDashboardFindingsTrendsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FindingsTrends
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-// ParseGetDashboardFindingsImpactResponse parses an HTTP response from a GetDashboardFindingsImpactWithResponse call
-func ParseGetDashboardFindingsImpactResponse(rsp *http.Response) (*GetDashboardFindingsImpactResponse, error) {
+// ParseGetDashboardMalwarePrevalenceResponse parses an HTTP response from a GetDashboardMalwarePrevalenceWithResponse call
+func ParseGetDashboardMalwarePrevalenceResponse(rsp *http.Response) (*GetDashboardMalwarePrevalenceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDashboardFindingsImpactResponse{
+	response := &GetDashboardMalwarePrevalenceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FindingsImpact
+		var dest MalwarePrevalence
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -505,22 +1835,22 @@ func ParseGetDashboardFindingsImpactResponse(rsp *http.Response) (*GetDashboardF
 	return response, nil
 }
 
-// ParseGetDashboardFindingsTrendsResponse parses an HTTP response from a GetDashboardFindingsTrendsWithResponse call
-func ParseGetDashboardFindingsTrendsResponse(rsp *http.Response) (*GetDashboardFindingsTrendsResponse, error) {
+// ParseGetDashboardPostureScoresResponse parses an HTTP response from a GetDashboardPostureScoresWithResponse call
+func ParseGetDashboardPostureScoresResponse(rsp *http.Response) (*GetDashboardPostureScoresResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDashboardFindingsTrendsResponse{
+	response := &GetDashboardPostureScoresResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FindingsTrends
+		var dest PostureScores
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -603,3 +1933,76 @@ func ParseGetDashboardRiskiestRegionsResponse(rsp *http.Response) (*GetDashboard
 
 	return response, nil
 }
+
+// ParseGetDashboardRootkitDetectionsResponse parses an HTTP response from a GetDashboardRootkitDetectionsWithResponse call
+func ParseGetDashboardRootkitDetectionsResponse(rsp *http.Response) (*GetDashboardRootkitDetectionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDashboardRootkitDetectionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RootkitDetections
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetQueryResourceResponse parses an HTTP response from a GetQueryResourceWithResponse call
+func ParseGetQueryResourceResponse(rsp *http.Response) (*GetQueryResourceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetQueryResourceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest QueryResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
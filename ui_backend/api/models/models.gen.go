@@ -11,6 +11,16 @@ import (
 const (
 	AWSEC2Instance AssetType = "AWS EC2 Instance"
 	AzureInstance  AssetType = "Azure Instance"
+	ContainerImage AssetType = "Container Image"
+	SBOM           AssetType = "SBOM"
+	VMImage        AssetType = "VM Image"
+)
+
+// Defines values for CoverageGroupBy.
+const (
+	Provider CoverageGroupBy = "provider"
+	Region   CoverageGroupBy = "region"
+	Team     CoverageGroupBy = "team"
 )
 
 // Defines values for FindingType.
@@ -24,6 +34,14 @@ const (
 	VULNERABILITY    FindingType = "VULNERABILITY"
 )
 
+// Defines values for LocationGroupBy.
+const (
+	LocationCloud   LocationGroupBy = "cloud"
+	LocationCountry LocationGroupBy = "country"
+	LocationRegion  LocationGroupBy = "region"
+	LocationZone    LocationGroupBy = "zone"
+)
+
 // Defines values for MisconfigurationSeverity.
 const (
 	MisconfigurationHighSeverity   MisconfigurationSeverity = "MisconfigurationHighSeverity"
@@ -31,6 +49,14 @@ const (
 	MisconfigurationMediumSeverity MisconfigurationSeverity = "MisconfigurationMediumSeverity"
 )
 
+// Defines values for QueryResource.
+const (
+	Assets      QueryResource = "assets"
+	Findings    QueryResource = "findings"
+	ScanResults QueryResource = "scanResults"
+	Scans       QueryResource = "scans"
+)
+
 // Defines values for RootkitType.
 const (
 	APPLICATION RootkitType = "APPLICATION"
@@ -53,6 +79,18 @@ type ApiResponse struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// AssetCoverage defines model for AssetCoverage.
+type AssetCoverage struct {
+	GroupBy *CoverageGroupBy `json:"groupBy,omitempty"`
+
+	// Groups The coverage of each group, ordered by the number of overdue assets.
+	Groups *[]GroupCoverage `json:"groups,omitempty"`
+
+	// SlaDays The number of days an asset is scanned within the SLA after its last scan.
+	SlaDays *int            `json:"slaDays,omitempty"`
+	Total   *CoverageCounts `json:"total,omitempty"`
+}
+
 // AssetInfo defines model for AssetInfo.
 type AssetInfo struct {
 	Location *string    `json:"location,omitempty"`
@@ -63,6 +101,68 @@ type AssetInfo struct {
 // AssetType defines model for AssetType.
 type AssetType string
 
+// ComplianceCoverage defines model for ComplianceCoverage.
+type ComplianceCoverage struct {
+	Frameworks *[]FrameworkComplianceCoverage `json:"frameworks,omitempty"`
+
+	// ScannedAssetsCount The number of assets with a completed misconfiguration scan.
+	ScannedAssetsCount *int `json:"scannedAssetsCount,omitempty"`
+}
+
+// ControlComplianceCoverage defines model for ControlComplianceCoverage.
+type ControlComplianceCoverage struct {
+	ControlID          *string `json:"controlID,omitempty"`
+	FailingAssetsCount *int    `json:"failingAssetsCount,omitempty"`
+	PassingAssetsCount *int    `json:"passingAssetsCount,omitempty"`
+	Title              *string `json:"title,omitempty"`
+}
+
+// CorrelatedFindingSummary defines model for CorrelatedFindingSummary.
+type CorrelatedFindingSummary struct {
+	AssetInfo     *AssetInfo `json:"assetInfo,omitempty"`
+	FindingsCount *int       `json:"findingsCount,omitempty"`
+	FirstSeen     *time.Time `json:"firstSeen,omitempty"`
+	Id            *string    `json:"id,omitempty"`
+
+	// Path The path the findings were reported on, only set for the SamePath rule.
+	Path *string `json:"path,omitempty"`
+
+	// Rule The correlation rule, e.g. SamePath or MalwareAndRootkit.
+	Rule     *string                `json:"rule,omitempty"`
+	Severity *VulnerabilitySeverity `json:"severity,omitempty"`
+}
+
+// CorrelatedFindings defines model for CorrelatedFindings.
+type CorrelatedFindings struct {
+	// AffectedAssetsCount The number of assets with an unresolved correlated finding.
+	AffectedAssetsCount *int `json:"affectedAssetsCount,omitempty"`
+
+	// CorrelatedFindings The top 10 unresolved correlated findings to investigate first.
+	CorrelatedFindings *[]CorrelatedFindingSummary `json:"correlatedFindings,omitempty"`
+
+	// Rules The number of unresolved correlated findings of each rule, sorted by rule.
+	Rules *[]CorrelationRuleCount `json:"rules,omitempty"`
+}
+
+// CorrelationRuleCount defines model for CorrelationRuleCount.
+type CorrelationRuleCount struct {
+	CriticalCount *int `json:"criticalCount,omitempty"`
+	HighCount     *int `json:"highCount,omitempty"`
+
+	// Rule The correlation rule, e.g. SamePath or MalwareAndRootkit.
+	Rule *string `json:"rule,omitempty"`
+}
+
+// CoverageCounts defines model for CoverageCounts.
+type CoverageCounts struct {
+	NeverScanned *int `json:"neverScanned,omitempty"`
+	Overdue      *int `json:"overdue,omitempty"`
+	WithinSla    *int `json:"withinSla,omitempty"`
+}
+
+// CoverageGroupBy defines model for CoverageGroupBy.
+type CoverageGroupBy string
+
 // Exploit defines model for Exploit.
 type Exploit struct {
 	CveID       *string   `json:"cveID,omitempty"`
@@ -128,9 +228,54 @@ type FindingsImpact struct {
 	Vulnerabilities *[]VulnerabilityFindingImpact `json:"vulnerabilities,omitempty"`
 }
 
+// FindingsPerScanConfig defines model for FindingsPerScanConfig.
+type FindingsPerScanConfig struct {
+	ScanConfigs *[]ScanConfigFindings `json:"scanConfigs,omitempty"`
+}
+
 // FindingsTrends List of finding trends for all finding types.
 type FindingsTrends = []FindingTrends
 
+// FrameworkComplianceCoverage defines model for FrameworkComplianceCoverage.
+type FrameworkComplianceCoverage struct {
+	Controls  *[]ControlComplianceCoverage `json:"controls,omitempty"`
+	Framework *string                      `json:"framework,omitempty"`
+}
+
+// GroupCoverage defines model for GroupCoverage.
+type GroupCoverage struct {
+	Counts *CoverageCounts `json:"counts,omitempty"`
+
+	// Name The provider, region or team of the group, empty if the assets do not have one.
+	Name *string `json:"name,omitempty"`
+}
+
+// LocationFindings defines model for LocationFindings.
+type LocationFindings struct {
+	GroupBy *LocationGroupBy         `json:"groupBy,omitempty"`
+	Groups  *[]LocationFindingsGroup `json:"groups,omitempty"`
+}
+
+// LocationFindingsGroup The findings of the assets in a location.
+type LocationFindingsGroup struct {
+	Assets *int `json:"assets,omitempty"`
+
+	// Cloud The cloud of the region or zone of the group.
+	Cloud *string `json:"cloud,omitempty"`
+
+	// Country The ISO 3166-1 alpha-2 code of the country of the region or zone of the group.
+	Country *string `json:"country,omitempty"`
+
+	// FindingsCount total count of each finding type
+	FindingsCount *FindingsCount `json:"findingsCount,omitempty"`
+
+	// Name The cloud, region, zone or country of the group.
+	Name *string `json:"name,omitempty"`
+}
+
+// LocationGroupBy defines model for LocationGroupBy.
+type LocationGroupBy string
+
 // Malware defines model for Malware.
 type Malware struct {
 	MalwareName *string      `json:"malwareName,omitempty"`
@@ -140,12 +285,30 @@ type Malware struct {
 	Path *string `json:"path,omitempty"`
 }
 
+// MalwareFamilyPrevalence defines model for MalwareFamilyPrevalence.
+type MalwareFamilyPrevalence struct {
+	AffectedAssetsCount *int         `json:"affectedAssetsCount,omitempty"`
+	Family              *string      `json:"family,omitempty"`
+	FindingsCount       *int         `json:"findingsCount,omitempty"`
+	MalwareType         *MalwareType `json:"malwareType,omitempty"`
+}
+
 // MalwareFindingImpact defines model for MalwareFindingImpact.
 type MalwareFindingImpact struct {
 	AffectedAssetsCount *int     `json:"affectedAssetsCount,omitempty"`
 	Malware             *Malware `json:"malware,omitempty"`
 }
 
+// MalwarePrevalence defines model for MalwarePrevalence.
+type MalwarePrevalence struct {
+	// AffectedAssetsCount The number of assets with an active malware finding.
+	AffectedAssetsCount *int `json:"affectedAssetsCount,omitempty"`
+
+	// Families Top 10 malware families sorted by affected assets count
+	Families *[]MalwareFamilyPrevalence `json:"families,omitempty"`
+	Trend    *[]FindingTrend            `json:"trend,omitempty"`
+}
+
 // MalwareType defines model for MalwareType.
 type MalwareType = string
 
@@ -183,6 +346,31 @@ type PackageFindingImpact struct {
 	Package             *Package `json:"package,omitempty"`
 }
 
+// PostureScoreTrend Represents the posture score of a team at a specific time
+type PostureScoreTrend struct {
+	Score *int       `json:"score,omitempty"`
+	Time  *time.Time `json:"time,omitempty"`
+}
+
+// PostureScores defines model for PostureScores.
+type PostureScores struct {
+	// Teams The most recent score of each team, ordered by score from the worst.
+	Teams *[]TeamPostureScore `json:"teams,omitempty"`
+
+	// Time The time the most recent scores were computed at.
+	Time *time.Time `json:"time,omitempty"`
+}
+
+// QueryResource defines model for QueryResource.
+type QueryResource string
+
+// QueryResult defines model for QueryResult.
+type QueryResult struct {
+	// Count Total count of the items matching the filter, only set if $count is true.
+	Count *int                      `json:"count,omitempty"`
+	Items *[]map[string]interface{} `json:"items,omitempty"`
+}
+
 // RegionFindings Total findings for a region
 type RegionFindings struct {
 	// FindingsCount total count of each finding type
@@ -229,6 +417,26 @@ type Rootkit struct {
 	RootkitType *RootkitType `json:"rootkitType,omitempty"`
 }
 
+// RootkitDetection defines model for RootkitDetection.
+type RootkitDetection struct {
+	AffectedAssetsCount *int     `json:"affectedAssetsCount,omitempty"`
+	FindingsCount       *int     `json:"findingsCount,omitempty"`
+	Rootkit             *Rootkit `json:"rootkit,omitempty"`
+}
+
+// RootkitDetections defines model for RootkitDetections.
+type RootkitDetections struct {
+	// AffectedAssetsCount The number of assets with an active rootkit finding.
+	AffectedAssetsCount *int `json:"affectedAssetsCount,omitempty"`
+
+	// Assets Assets with rootkits sorted by rootkit findings count
+	Assets *[]RiskyAsset `json:"assets,omitempty"`
+
+	// Rootkits Rootkits sorted by affected assets count
+	Rootkits *[]RootkitDetection `json:"rootkits,omitempty"`
+	Trend    *[]FindingTrend     `json:"trend,omitempty"`
+}
+
 // RootkitFindingImpact defines model for RootkitFindingImpact.
 type RootkitFindingImpact struct {
 	AffectedAssetsCount *int     `json:"affectedAssetsCount,omitempty"`
@@ -238,6 +446,14 @@ type RootkitFindingImpact struct {
 // RootkitType defines model for RootkitType.
 type RootkitType string
 
+// ScanConfigFindings defines model for ScanConfigFindings.
+type ScanConfigFindings struct {
+	// FindingsCount total count of each finding type
+	FindingsCount  *FindingsCount `json:"findingsCount,omitempty"`
+	ScanConfigID   *string        `json:"scanConfigID,omitempty"`
+	ScanConfigName *string        `json:"scanConfigName,omitempty"`
+}
+
 // Secret defines model for Secret.
 type Secret struct {
 	EndColumn *int `json:"endColumn,omitempty"`
@@ -258,6 +474,27 @@ type SecretFindingImpact struct {
 	Secret              *Secret `json:"secret,omitempty"`
 }
 
+// TeamPostureScore defines model for TeamPostureScore.
+type TeamPostureScore struct {
+	Assets *int `json:"assets,omitempty"`
+
+	// Change The change of the score since the first score of the trend.
+	Change *int `json:"change,omitempty"`
+
+	// ExposedAssets The number of assets with an active critical or high finding.
+	ExposedAssets *int `json:"exposedAssets,omitempty"`
+
+	// Score The posture score from 0, the worst, to 100.
+	Score *int `json:"score,omitempty"`
+
+	// SlaBreaches The number of assets which were never scanned or whose last scan is older than the SLA.
+	SlaBreaches *int `json:"slaBreaches,omitempty"`
+
+	// Team The team of the assets, empty for the assets without a team.
+	Team  *string              `json:"team,omitempty"`
+	Trend *[]PostureScoreTrend `json:"trend,omitempty"`
+}
+
 // VulnerabilitiesFindingImpact defines model for VulnerabilitiesFindingImpact.
 type VulnerabilitiesFindingImpact = []VulnerabilityFindingImpact
 
@@ -301,20 +538,131 @@ type VulnerabilityRiskyAsset struct {
 // VulnerabilitySeverity defines model for VulnerabilitySeverity.
 type VulnerabilitySeverity string
 
+// AssetGroupID defines model for assetGroupID.
+type AssetGroupID = string
+
 // EndTime defines model for endTime.
 type EndTime = time.Time
 
 // ExampleFilter defines model for exampleFilter.
 type ExampleFilter = string
 
+// FindingsLocationGroupBy defines model for findingsLocationGroupBy.
+type FindingsLocationGroupBy = LocationGroupBy
+
+// GroupBy defines model for groupBy.
+type GroupBy = CoverageGroupBy
+
+// OdataCount defines model for odataCount.
+type OdataCount = bool
+
+// OdataFilter defines model for odataFilter.
+type OdataFilter = string
+
+// OrderBy defines model for odataOrderBy.
+type OrderBy = string
+
+// OdataSelect defines model for odataSelect.
+type OdataSelect = string
+
+// OdataSkip defines model for odataSkip.
+type OdataSkip = int
+
+// OdataTop defines model for odataTop.
+type OdataTop = int
+
+// Resource defines model for resource.
+type Resource = QueryResource
+
+// SlaDays defines model for slaDays.
+type SlaDays = int
+
 // StartTime defines model for startTime.
 type StartTime = time.Time
 
+// TeamTag defines model for teamTag.
+type TeamTag = string
+
 // UnknownError An object that is returned in all cases of failures.
 type UnknownError = ApiResponse
 
+// GetDashboardCorrelatedFindingsParams defines parameters for GetDashboardCorrelatedFindings.
+type GetDashboardCorrelatedFindingsParams struct {
+	// AssetGroupID If set, only the assets which are members of the asset group are included.
+	AssetGroupID *AssetGroupID `form:"assetGroupID,omitempty" json:"assetGroupID,omitempty"`
+}
+
+// GetDashboardCoverageParams defines parameters for GetDashboardCoverage.
+type GetDashboardCoverageParams struct {
+	GroupBy *GroupBy `form:"groupBy,omitempty" json:"groupBy,omitempty"`
+
+	// SlaDays The number of days after its last scan an asset is overdue, 7 if not set.
+	SlaDays *SlaDays `form:"slaDays,omitempty" json:"slaDays,omitempty"`
+
+	// TeamTag The key of the tag holding the team of the assets, team if not set.
+	TeamTag *TeamTag `form:"teamTag,omitempty" json:"teamTag,omitempty"`
+
+	// AssetGroupID If set, only the assets which are members of the asset group are included.
+	AssetGroupID *AssetGroupID `form:"assetGroupID,omitempty" json:"assetGroupID,omitempty"`
+}
+
+// GetDashboardFindingsByLocationParams defines parameters for GetDashboardFindingsByLocation.
+type GetDashboardFindingsByLocationParams struct {
+	// GroupBy The dimension of the location to group by, region if not set.
+	GroupBy *FindingsLocationGroupBy `form:"groupBy,omitempty" json:"groupBy,omitempty"`
+}
+
+// GetDashboardFindingsPerScanConfigParams defines parameters for GetDashboardFindingsPerScanConfig.
+type GetDashboardFindingsPerScanConfigParams struct {
+	// AssetGroupID If set, only the assets which are members of the asset group are included.
+	AssetGroupID *AssetGroupID `form:"assetGroupID,omitempty" json:"assetGroupID,omitempty"`
+}
+
 // GetDashboardFindingsTrendsParams defines parameters for GetDashboardFindingsTrends.
 type GetDashboardFindingsTrendsParams struct {
 	StartTime StartTime `form:"startTime" json:"startTime"`
 	EndTime   EndTime   `form:"endTime" json:"endTime"`
+
+	// AssetGroupID If set, only the assets which are members of the asset group are included.
+	AssetGroupID *AssetGroupID `form:"assetGroupID,omitempty" json:"assetGroupID,omitempty"`
+}
+
+// GetDashboardMalwarePrevalenceParams defines parameters for GetDashboardMalwarePrevalence.
+type GetDashboardMalwarePrevalenceParams struct {
+	StartTime StartTime `form:"startTime" json:"startTime"`
+	EndTime   EndTime   `form:"endTime" json:"endTime"`
+
+	// AssetGroupID If set, only the assets which are members of the asset group are included.
+	AssetGroupID *AssetGroupID `form:"assetGroupID,omitempty" json:"assetGroupID,omitempty"`
+}
+
+// GetDashboardPostureScoresParams defines parameters for GetDashboardPostureScores.
+type GetDashboardPostureScoresParams struct {
+	StartTime StartTime `form:"startTime" json:"startTime"`
+	EndTime   EndTime   `form:"endTime" json:"endTime"`
+}
+
+// GetDashboardRiskiestAssetsParams defines parameters for GetDashboardRiskiestAssets.
+type GetDashboardRiskiestAssetsParams struct {
+	// AssetGroupID If set, only the assets which are members of the asset group are included.
+	AssetGroupID *AssetGroupID `form:"assetGroupID,omitempty" json:"assetGroupID,omitempty"`
+}
+
+// GetDashboardRootkitDetectionsParams defines parameters for GetDashboardRootkitDetections.
+type GetDashboardRootkitDetectionsParams struct {
+	StartTime StartTime `form:"startTime" json:"startTime"`
+	EndTime   EndTime   `form:"endTime" json:"endTime"`
+
+	// AssetGroupID If set, only the assets which are members of the asset group are included.
+	AssetGroupID *AssetGroupID `form:"assetGroupID,omitempty" json:"assetGroupID,omitempty"`
+}
+
+// GetQueryResourceParams defines parameters for GetQueryResource.
+type GetQueryResourceParams struct {
+	Filter  *OdataFilter `form:"$filter,omitempty" json:"$filter,omitempty"`
+	Select  *OdataSelect `form:"$select,omitempty" json:"$select,omitempty"`
+	OrderBy *OrderBy     `form:"$orderby,omitempty" json:"$orderby,omitempty"`
+	Count   *OdataCount  `form:"$count,omitempty" json:"$count,omitempty"`
+	Top     *OdataTop    `form:"$top,omitempty" json:"$top,omitempty"`
+	Skip    *OdataSkip   `form:"$skip,omitempty" json:"$skip,omitempty"`
 }
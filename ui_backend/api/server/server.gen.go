@@ -21,18 +21,45 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Get the pass/fail coverage of the compliance framework controls.
+	// (GET /dashboard/complianceCoverage)
+	GetDashboardComplianceCoverage(ctx echo.Context) error
+	// Get the unresolved correlated findings of the assets.
+	// (GET /dashboard/correlatedFindings)
+	GetDashboardCorrelatedFindings(ctx echo.Context, params GetDashboardCorrelatedFindingsParams) error
+	// Get the scan coverage of the assets.
+	// (GET /dashboard/coverage)
+	GetDashboardCoverage(ctx echo.Context, params GetDashboardCoverageParams) error
+	// Get the active findings of the assets grouped by their location.
+	// (GET /dashboard/findingsByLocation)
+	GetDashboardFindingsByLocation(ctx echo.Context, params GetDashboardFindingsByLocationParams) error
 	// Get a list of findings impact for the dashboard.
 	// (GET /dashboard/findingsImpact)
 	GetDashboardFindingsImpact(ctx echo.Context) error
+	// Get the active findings surfaced by each scan config.
+	// (GET /dashboard/findingsPerScanConfig)
+	GetDashboardFindingsPerScanConfig(ctx echo.Context, params GetDashboardFindingsPerScanConfigParams) error
 	// Get a list of finding trends for all finding types.
 	// (GET /dashboard/findingsTrends)
 	GetDashboardFindingsTrends(ctx echo.Context, params GetDashboardFindingsTrendsParams) error
+	// Get the prevalence of the malware families across the assets.
+	// (GET /dashboard/malwarePrevalence)
+	GetDashboardMalwarePrevalence(ctx echo.Context, params GetDashboardMalwarePrevalenceParams) error
+	// Get the security posture scores of the teams.
+	// (GET /dashboard/postureScores)
+	GetDashboardPostureScores(ctx echo.Context, params GetDashboardPostureScoresParams) error
 	// Get a list of riskiest assets for the dashboard.
 	// (GET /dashboard/riskiestAssets)
-	GetDashboardRiskiestAssets(ctx echo.Context) error
+	GetDashboardRiskiestAssets(ctx echo.Context, params GetDashboardRiskiestAssetsParams) error
 	// Get a list of riskiest regions for the dashboard.
 	// (GET /dashboard/riskiestRegions)
 	GetDashboardRiskiestRegions(ctx echo.Context) error
+	// Get the rootkits detected on the assets.
+	// (GET /dashboard/rootkitDetections)
+	GetDashboardRootkitDetections(ctx echo.Context, params GetDashboardRootkitDetectionsParams) error
+	// Query a backend resource for ad hoc UI widgets.
+	// (GET /query/{resource})
+	GetQueryResource(ctx echo.Context, resource Resource, params GetQueryResourceParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -40,6 +67,90 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetDashboardComplianceCoverage converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDashboardComplianceCoverage(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetDashboardComplianceCoverage(ctx)
+	return err
+}
+
+// GetDashboardCorrelatedFindings converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDashboardCorrelatedFindings(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDashboardCorrelatedFindingsParams
+	// ------------- Optional query parameter "assetGroupID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "assetGroupID", ctx.QueryParams(), &params.AssetGroupID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assetGroupID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetDashboardCorrelatedFindings(ctx, params)
+	return err
+}
+
+// GetDashboardCoverage converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDashboardCoverage(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDashboardCoverageParams
+	// ------------- Optional query parameter "groupBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "groupBy", ctx.QueryParams(), &params.GroupBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupBy: %s", err))
+	}
+
+	// ------------- Optional query parameter "slaDays" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "slaDays", ctx.QueryParams(), &params.SlaDays)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter slaDays: %s", err))
+	}
+
+	// ------------- Optional query parameter "teamTag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "teamTag", ctx.QueryParams(), &params.TeamTag)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter teamTag: %s", err))
+	}
+
+	// ------------- Optional query parameter "assetGroupID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "assetGroupID", ctx.QueryParams(), &params.AssetGroupID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assetGroupID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetDashboardCoverage(ctx, params)
+	return err
+}
+
+// GetDashboardFindingsByLocation converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDashboardFindingsByLocation(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDashboardFindingsByLocationParams
+	// ------------- Optional query parameter "groupBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "groupBy", ctx.QueryParams(), &params.GroupBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupBy: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetDashboardFindingsByLocation(ctx, params)
+	return err
+}
+
 // GetDashboardFindingsImpact converts echo context to params.
 func (w *ServerInterfaceWrapper) GetDashboardFindingsImpact(ctx echo.Context) error {
 	var err error
@@ -49,6 +160,24 @@ func (w *ServerInterfaceWrapper) GetDashboardFindingsImpact(ctx echo.Context) er
 	return err
 }
 
+// GetDashboardFindingsPerScanConfig converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDashboardFindingsPerScanConfig(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDashboardFindingsPerScanConfigParams
+	// ------------- Optional query parameter "assetGroupID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "assetGroupID", ctx.QueryParams(), &params.AssetGroupID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assetGroupID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetDashboardFindingsPerScanConfig(ctx, params)
+	return err
+}
+
 // GetDashboardFindingsTrends converts echo context to params.
 func (w *ServerInterfaceWrapper) GetDashboardFindingsTrends(ctx echo.Context) error {
 	var err error
@@ -69,17 +198,90 @@ func (w *ServerInterfaceWrapper) GetDashboardFindingsTrends(ctx echo.Context) er
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter endTime: %s", err))
 	}
 
+	// ------------- Optional query parameter "assetGroupID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "assetGroupID", ctx.QueryParams(), &params.AssetGroupID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assetGroupID: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.GetDashboardFindingsTrends(ctx, params)
 	return err
 }
 
+// GetDashboardMalwarePrevalence converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDashboardMalwarePrevalence(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDashboardMalwarePrevalenceParams
+	// ------------- Required query parameter "startTime" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "startTime", ctx.QueryParams(), &params.StartTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter startTime: %s", err))
+	}
+
+	// ------------- Required query parameter "endTime" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "endTime", ctx.QueryParams(), &params.EndTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter endTime: %s", err))
+	}
+
+	// ------------- Optional query parameter "assetGroupID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "assetGroupID", ctx.QueryParams(), &params.AssetGroupID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assetGroupID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetDashboardMalwarePrevalence(ctx, params)
+	return err
+}
+
+// GetDashboardPostureScores converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDashboardPostureScores(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDashboardPostureScoresParams
+	// ------------- Required query parameter "startTime" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "startTime", ctx.QueryParams(), &params.StartTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter startTime: %s", err))
+	}
+
+	// ------------- Required query parameter "endTime" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "endTime", ctx.QueryParams(), &params.EndTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter endTime: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetDashboardPostureScores(ctx, params)
+	return err
+}
+
 // GetDashboardRiskiestAssets converts echo context to params.
 func (w *ServerInterfaceWrapper) GetDashboardRiskiestAssets(ctx echo.Context) error {
 	var err error
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDashboardRiskiestAssetsParams
+	// ------------- Optional query parameter "assetGroupID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "assetGroupID", ctx.QueryParams(), &params.AssetGroupID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assetGroupID: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetDashboardRiskiestAssets(ctx)
+	err = w.Handler.GetDashboardRiskiestAssets(ctx, params)
 	return err
 }
 
@@ -92,6 +294,98 @@ func (w *ServerInterfaceWrapper) GetDashboardRiskiestRegions(ctx echo.Context) e
 	return err
 }
 
+// GetDashboardRootkitDetections converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDashboardRootkitDetections(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDashboardRootkitDetectionsParams
+	// ------------- Required query parameter "startTime" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "startTime", ctx.QueryParams(), &params.StartTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter startTime: %s", err))
+	}
+
+	// ------------- Required query parameter "endTime" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "endTime", ctx.QueryParams(), &params.EndTime)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter endTime: %s", err))
+	}
+
+	// ------------- Optional query parameter "assetGroupID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "assetGroupID", ctx.QueryParams(), &params.AssetGroupID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assetGroupID: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetDashboardRootkitDetections(ctx, params)
+	return err
+}
+
+// GetQueryResource converts echo context to params.
+func (w *ServerInterfaceWrapper) GetQueryResource(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "resource" -------------
+	var resource Resource
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "resource", runtime.ParamLocationPath, ctx.Param("resource"), &resource)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resource: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetQueryResourceParams
+	// ------------- Optional query parameter "$filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$filter", ctx.QueryParams(), &params.Filter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "$select" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$select", ctx.QueryParams(), &params.Select)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $select: %s", err))
+	}
+
+	// ------------- Optional query parameter "$orderby" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$orderby", ctx.QueryParams(), &params.OrderBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $orderby: %s", err))
+	}
+
+	// ------------- Optional query parameter "$count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$count", ctx.QueryParams(), &params.Count)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $count: %s", err))
+	}
+
+	// ------------- Optional query parameter "$top" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$top", ctx.QueryParams(), &params.Top)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $top: %s", err))
+	}
+
+	// ------------- Optional query parameter "$skip" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "$skip", ctx.QueryParams(), &params.Skip)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter $skip: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetQueryResource(ctx, resource, params)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -120,48 +414,99 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/dashboard/complianceCoverage", wrapper.GetDashboardComplianceCoverage)
+	router.GET(baseURL+"/dashboard/correlatedFindings", wrapper.GetDashboardCorrelatedFindings)
+	router.GET(baseURL+"/dashboard/coverage", wrapper.GetDashboardCoverage)
+	router.GET(baseURL+"/dashboard/findingsByLocation", wrapper.GetDashboardFindingsByLocation)
 	router.GET(baseURL+"/dashboard/findingsImpact", wrapper.GetDashboardFindingsImpact)
+	router.GET(baseURL+"/dashboard/findingsPerScanConfig", wrapper.GetDashboardFindingsPerScanConfig)
 	router.GET(baseURL+"/dashboard/findingsTrends", wrapper.GetDashboardFindingsTrends)
+	router.GET(baseURL+"/dashboard/malwarePrevalence", wrapper.GetDashboardMalwarePrevalence)
+	router.GET(baseURL+"/dashboard/postureScores", wrapper.GetDashboardPostureScores)
 	router.GET(baseURL+"/dashboard/riskiestAssets", wrapper.GetDashboardRiskiestAssets)
 	router.GET(baseURL+"/dashboard/riskiestRegions", wrapper.GetDashboardRiskiestRegions)
+	router.GET(baseURL+"/dashboard/rootkitDetections", wrapper.GetDashboardRootkitDetections)
+	router.GET(baseURL+"/query/:resource", wrapper.GetQueryResource)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8RaX3PiOBL/KirdPfpCdq/uhTeGkIxrIKEImbmtrXkQdgPa2JJHkpPlpvjuV5JsbGwJ",
-	"m1mYfUtQd+vX3VL/k7/jiKcZZ8CUxMPvOCOCpKBAmP+AxUuagv6TMjzE33IQOxxgRvSPh+UAC/iWUwEx",
-	"HiqRQ4BltIWUaL41FylReIhjouBfypKrXab5pRKUbfB+H2D4k6RZAvc0USC8+1kiXJffFiUVEeoU7Irg",
-	"LwPfawky40yCMdgLe2X8nU2E4EaLiDMFTOk/SZYlNCKKcjb4Q3Kmf6t2+6eANR7ifwwqdwzsqhyMMroo",
-	"NrFbxiAjQTMtCg/LPRGYTY0FLKOWW+cdfm9wjhjiqz8gUkhtiUJUIgEqFwxiRBkiSYIiIkEivkZrQpNc",
-	"gLzBAc4Ez0AoalVOQUqyMdIFkPiJJbvSmG3fFL/YXfE+wCMpQYVszc3hOxKccGsth5dLVzoW7A8dBtWb",
-	"LjWhH9OykAMsT/Hwdzz68owm419RyKQiLNKHYfS/XED1w9egjWbyZ5ZwqtrKRW8Q3jkVOHLROZpLnosI",
-	"7j64zUJV4mbLRWIQUQWpdO+YJwlZafYjtxIhyM5twULte8piyjZhmpHIYQOyXkOkIDb2lmOe24viOUWU",
-	"KdiAwCZYHKx6ys2l8Z0QC2xLASxu34wFZAKklobUFpDiiiSI5ekKhLkNllkiohBBMoOIrmmEiiDR8HSp",
-	"V1sPVQSpnjHypA6yrcSUSqXRntQgA2FwI5lwhdZcGPKDSgWdvg3tq19b7PLFfY1Uq3KAfDh2fbiNs0zM",
-	"dR6REyfy/hhqeavno/Gn0cMEB/jzy/Rxshh9CKfh8jcc4Nlo+mW00CvPk/FistQ/hc/jp8f78OFlMVqG",
-	"T484wIunp+WnUC9O/jufPoVLZxQoNq+O+LGfrG/MOdGuARJtS7sjI6tp9+L8S/epSknyTgR4FqmMOFvT",
-	"TS5McPXIEJyrV+8OEiIBvsW3PGEgyIomtMTbJDrhIOkLFnWdj8235Bn6DyrWq4MtuVAQo9UOUSMSYkRM",
-	"oLGWxkG/o+cMZZ1H8MgLLrjF8sXhzqzc8+G6zoUTeIPw8ho0NjhblYxEr2QDXg2K9YsDn1u5Z+Ot3zUX",
-	"3mL94ngXVu7ZeGu33wXXLl8c7bMRezZYRzRyga6T7S6O/XNd+pkqnIqVXYn/kEQMnUnuuqiv5xZT0J+d",
-	"g2Uf08+qCNjoGOzCo6+QLdb7lBWzGqm5+mrbNsecqG1ZB61pArbb0a0ZoUyWobhfyeWMr5erbGtZo4fa",
-	"JyGW5muZtxlgHQ6qWroWt4AUYupvzGREGIN4XnjCsy68zpfwBoKq3blp4rnk0yYBqcZEwYaLnbsbAqnu",
-	"OvosTeNs0Zw2P5m0Lng+HL47x0r90D/XfFBWyk2aj3SzPdC1Rcwgpnl6gmDK3w+rrpq5yKZt23n73ywX",
-	"iXPhDYR0e9llDGcav5wHs0qvHsWEG+ICNtUZc+Y03VAcspiJ+0gYJl8PV6nQIwcUxCYaaKGey+yETuUr",
-	"Bams3c4v80XBX+bhKkOXnGcWQVS+7gyYCxT1fnBluX9NbH0r+BMomyKuibez7PXCLDmvia6jyPWDKxiv",
-	"ia1nTevH2BTwI2XsOZBPBQIbyxyRQFQL7uq2IEDvVG2L2q4IeKa+eyc68uUsRpzp5fQGLeocjFcM7zRJ",
-	"EOMKrQAJyIyhehfGjWj8w9YorOmJ5o7RnYnrzLq3FddJfbDeOQw3hPvAP6x0grb30OE6u+Ct8Yr1PgX+",
-	"okZ6CsS10rWodOwB8yTE5uxxNpk9LX7DAf40WTxOpjjAo/l8Go7L2eJ9uJiZEaSrPLLtsCN/snjMkzxl",
-	"7uEcsHhKmWc2qHujubOD0p486qCK5sl0kVsogh524FxTtgGRCeoafD5yBUOktlQiKs39yxn9loNLkHm0",
-	"O6WaIfAp53KLa6JwuYMjDw7qnmq48X0+jtItoFcfPRxD2LkesaT8MSRjzdn5tNS/GzwSXm8FjwY7/jrV",
-	"Ywi3Myx6R9esBI3Ot8Os4DOdSqTsw/FfbGK8m7RQr4iE54gfvRfYXFN7aSsN66Wz4zHfeifCa13Ct+b5",
-	"7e2YHqDPydme+eI1MrigikYkaUSPsf8Vcks32/7UCX/vT5yaKUB/egabhG7oKoG+PJ1ecs0yxotwGY5H",
-	"OuV+DB8+4gDPJnfhywwHePr0BQf4cfIwDR/CD1NX8tV70sItxbM6/jwbJ0Rvg15CNJqHuqY+3Fj8y83t",
-	"za1GxjNgJKN4iP99c3vzC7YTS+PtQUzkdsWJiAfr1lPYxp4xfTpMYxbGeIgfQN2VPI3Xs8ZXKb/e3l7s",
-	"Y5TGTo7vUZ7zKAIb3mNYkzzxZsEDyMHRdzPmE5Y8TYnYWTURQcnxSFsWA/nDg/XBejeG3WHNalje25oF",
-	"S3D0VdTvbl0qkkH1fdE+6CQuv6Haf/0JTiuH93+P0zreIRp+E61JUaffGsOlKxq0sdPPNmizte++BaLd",
-	"bvc2Z8nzE+xZbvW3GbQcKjgtagpS8VaGATNvxoOcDnRM33/d/z8AAP//LhtbqU0pAAA=",
+	"H4sIAAAAAAACA+Uc2XIbN/JXprh+pHUku9kqv9GU5LAiWQopO7sb5QGaAUlEczDAjBTG5X/fbhxzAjOg",
+	"TMquSpUrEQdAo9HdaPQFfBqFWbLJUprmYvTm02hDOEloTrn8RYSg+TueFZvZGf6OqAg52+QsS0dvRrNl",
+	"AM3jIEvjbZCvaSC7i+BpzcJ1QDgNEprcA6ggW1btwQrhyWaWhnER0ehoNB4xhPhHQfkWfqSAA/xsTD8e",
+	"iXBNE4J45NsNtoucs3Q1+vx5PKJpdMtw0CcrJNM8HnH6R8E4jUZvcl7QOtBlxhOSQ+eI5PR1rrpbZvqT",
+	"JJuYXrAYqOScT3UaQHrJ0gj+FpdZSJCmcqlvt11K3wLxIkAoFfDbUDPWo4I80zS9344DTlf4jS2DNMuR",
+	"Py7irvRkdRRfcbqEpn8cV0JxrFrFcRtJXMCqQngfU0yzR8rJitanyIAbZJoVae6a5VUoWy2kvs+ymJK0",
+	"gtPPtFdL1dzPNAnomkeUuxf+KsP2+20fqPHoz9er7LUeYQCaCRY0pqF7yUI1e2C6eGAbNxhstABhaU5X",
+	"QIkSym3mBpJngzA4FVnBw2p7bki+rkCUzX3bs09wfkaU5gYKzihicka2wr6V0gLVEu6jCPoEZAlcDxho",
+	"rpgI2DMhSQP8J7UVA+0FUhkBMsG/PbaVmbiOesJSlhTJ6M3p2EYdkROe92mvqsMe9FdOSXJLVnbKPNCt",
+	"US85WQXrLEYFpX7DuIYiF2P1bZgmZso+cVVSAowVVHLtQ/qQZk/pOeeZ3LBhBhRTSoBsNjFTyuj4d4G4",
+	"f/KUk8mGzfUkasomBfScAZWTStaogQi3PrZDuwlo5fvfYUsCeYgUGk7zgqc0gkMuIHEchAQWhvRbEhYX",
+	"sFQk1oZnG8pzppacUCFA++GfnJLoGo5Vw2ULG9UXNSuqjQnyxGhQeZQ3gNdU9U4qWCt5x04KdXdcGCVw",
+	"6svOYBKgOoPF3yvDoNpwei9pCZICk9NEDKEl0SkXJ2XFSiDCOZFI77T/a3sdNz9y7Ynla+AcIr+4nNhU",
+	"xNGou5nhS5aT2JfI8lgTPeycpcusy0pz8FsPFbXlLA3qw8D+wElvsaMbp1sNh6ao0X4dTX5ZBOfT74JZ",
+	"CmoqlUp88hcIeP3DFDYvYSmQcJYgA8ejxdvrK/jfxyv95bdxF+MpIBgzBOEW6yWaq08Zf5C/vGTpwgyx",
+	"wPeRLCUhkhaiNEz6hMyYxSBSAQkQoxgs7ChImACttmSrgitDziVWNl4gSXkW+9AoVF2VCd+hMuoj+LO1",
+	"nq5kb2AZPv1ylsfUbpBYFsE5jeG4ii6UKbwokoTwbXcNpL4dBkVYdqwZ2D3oLhkX+YLS1PcIBY0VWQkp",
+	"bRqrKGCLVCUGn+AJtCMcEZuMoyBkqXaiUAkBDkrtgJTe4EBexPTIhgc2uNSyoisKFfYaB/RodVSBhCmu",
+	"SPwEPtgkjeZZlj+w3DqFoCBULB88Nj4WMexucg+ilG8XZpAfz4WF28sl9H7+JkuDIkWjMn4E6oblhIb+",
+	"dtUdWhHrzgn2bnB60j+DQKeMpY9U5GwFDYEUM+/jzrkvPPQT8nvw3BtA3pzmSnaEklI4y40o7rQImH8O",
+	"4xQXBxfQJzINSF01BzIHZmHcs9nXbLXuaT74hrIvrmEMdJaV4m5aqEPHjra2qeyNypBZxC7XzIlPLRhh",
+	"jnpA7JFF0kNWYYaRciesp/f5n5s4YzY+PVLHUdSg+y62jXL8zt7aDR/HgTQeFTxumg3dGYs4Jvc43FdW",
+	"9bL1vp0lGxLm3grOsTVq7KQVVfv2niG+FUWN2y2nadQV9zndgG5AaMrtQ4O2pjlKHQFuDgnEhoZsycJA",
+	"n5Vtw6PHSlCs9HVa+9Zg0XaXDKx048i6VgCYSrwDEWfVuVsuSfdDe7frq9UaB+3NWldcSomyn7VaZ9az",
+	"tOdFE1WzmW8m058m787RCP9w+f58Pnk7u5zd/hd+X00uf5nMsWVxPp2f3+Kn2WJ6/f5i9u7DfHI7u34P",
+	"n+bX17c/zbDx/D83l9fwl00LXLQtsCafFG+knJSHjiZtIGG16a7lX9ilKlE62NHYMrcdMLjS3o5WQUPw",
+	"7B2NjzUrSOPro3ENjVzKor7m1tEEpsi/At1eCXZ1YDMJEv7W5pEJlXqJnlWVeVggNS7Y0NXNe0dXH8C7",
+	"o2uTCyvibX9t7ytoTbDzUqDfAxzdzhXo9r0jfqPg7oxvfa/Z8NXte8dXW2g741vb/TZ0VfPesV1IsDsj",
+	"a9FGNqTr3bZ7x73hGO64hD5deaNs4qncLl2VKco2/5O2glf6f1+E4pBtUp5zsp+0PzBQXD/+/AOkTXvI",
+	"Qzr6omCuCJI/Ld3Rqc9dVMoYnmfUqBkMtiBrHKldYrCVd2GJ32i/p8yuoqlYS4fooDdNNrCDWD1FEkSZ",
+	"TI6sySMNAANPn9DkWt0REs9wfidpWw/ne3GyjYqE9LyNYQdlpXg9ElGjJuZSyrx31yhXvey2WRhnReRw",
+	"7rHJzFRx+C+gRIPD1giZlDbuyNnPFtfB96c//PD6FLb2Zk1efwfaMyqh6rHPnboT3fRQEKIMw7jFXdLD",
+	"yPpYI8Pb2Lrw6uO8Jaag+FILKOB0o4quHacC8+Y4+PUj4bgGgVDMBFMNzfyeG6jmw/8U9LK/mQWQvKrM",
+	"1lZeTjW8d0UfdLuPL3hV6+oMGauI0lJHjGOqcoqhyqEIYz/7Ud4YxSRh8faG00cS0zSk3hEJS8BcgrKn",
+	"EobD7c+lVd/aDhRqqbkxHmj2ovgMwu8Q64ZlMzheWn6VPcgtuee0Bk9PKii6Y80QNJh+kXfWFkQPUyU3",
+	"gaoXCphcNWW0I+ZtN82iMapMfjdrQxMaMXcCV+cXb7RqcLRzpzbyTdm0V1FlbTCoK/IpAaHJuH2nY4ez",
+	"gWgt9rEGeq0073V997ipLbzbhUp+2C9qPDAHXbvPj2y1Lvt1QVyBkBRJT4fL7KlstUXetE9uSSi4JGdT",
+	"8NjaALMIO5dtxLAGA/bHwU21Lo+QhAPFTOQFp4swg23uFQbfqBGBwCFSBysPwCMKLoccJgpeX4fFSUAM",
+	"HanABEaChRfCAqs1yfArDmoU8KjmJc8SSQlw1HbIZN4CtDqWXvqeuSxTGa3PbejrlDpiUMgjSqL4XLo2",
+	"6wlrm1h7GJWpM1IK2fwfxhQxdLBtSAMUOjicVtuh3IiP49Il2eGYzsO1Kc1Tdau1GgLwQVVVLJY0IZHt",
+	"xkDJwXYCrKLE8GmpTOyelLlcQunQyTBHUFr71qzKMz0aBdRxMFpRZ+IBZs4npd+4W+Cd6/HGIKpMJTNy",
+	"x7AkwNtKZPYQZncjZ0y8Q+LmG1PvwbIN4pD4DgainWiakYfEbiDs7EZODzwkbp5RZjeObQDPCSzvgnKf",
+	"IlC6zKIJeNVgD+bqDsona5R6Sef9iaDmK1Ks9cLm5CiY10ekWa02jMWxDBreVyVi3udtSxs/mxqamg5t",
+	"bkmmS72uC2ntgbmdq/ec5QNWpNU+tLBONTj9Jd3uE5GY17r2IXFGc/hg9Qz9wyzDsRReLdkDaz+MxQED",
+	"FK1snt0mqWK4rQr7GshS53bUcCWPX+WwmHcR+6KgSUeevsFoiTWXuj93b49C3q6AuTq/up5jwctP5/P3",
+	"55dYuH5zczmbmgqXi9n8ShbC2Kx5S7awW5z+ReZslcN0lMtVHXaweVUy2WLrptE0i4sktesaaL5kKXXp",
+	"qpjeWEPZiFgjlK2j2MZzUQaKI7kB0Dfw06Jt3mc5fQMAwLuBf3hWFin7o7BWa8vLU31Lkx1ci3OT8FAC",
+	"L0oGDdcE2PHr+Nz2anpXomxN0pUrMyTbDENVYECwNKSawVzUogmy9g71il3Rg4uUCUOf5x0opuIXs1NY",
+	"2tt/spRxGEuCtxHdkaGOk3EV7RhjNffpyYkDbkzecgydUO9lyAvSMmAhq3vLW0ewjqc1UKV2ERHvH8YR",
+	"VioC7c19JMfdIyzFtcdNLHf3VLbaVD3WCJwVuY5uWbOOu50v3Ujbsw6Zj00nobP3Dl6L0kRha6tqFuJ5",
+	"mExx5GCt8RffxWi6alv3keEghJ0ZCntLAgTghbvT4UqPk0HnMFdXP78wHu2cpIP1PRGVxtRw1OatlV4b",
+	"wjr7qXopV/sghoc6Vx7b8uvNGA+kd3EZHQVnh3Ag9fnQ0h4DF0T8e8fZk3/nRCZ0/PundBWzFQOF4Dtm",
+	"kEu2tNR0PrsFkxet3x9n737ERNP52ewDXs68vP4F/vv+/N3l7N3s7aXNDsY5mWaLvmcx+ng1jQlOE3yY",
+	"BZObGYZ0yh07Oj06OTqRt1Y2NCUbBp++h0+nI1UNIbl9HBGxvs8IjySLu9VpK5ucXYAAyQyGrlarqmwM",
+	"iCAhmw0K27i686vO4jURd2l1M5PY72bKu9tCA1VzsGXAsLwLbxHfpSYT7ygUlvMDeLwbhu+mACD+xATG",
+	"9AO8XknF0R0GxVHy5cAZHLSjdzQ/M/SwFNS17s1/d3Kyt+vytvK97q35RRGGVB1hEV0Snd6wwS0RPW7c",
+	"7pcX7c3FT1yuyrgBQY6R4I1L5i2GlqWDhiHiSIJrSJDtWp9VglQtoJyj/4LcuEo0AatYFqGWibfg79+l",
+	"OPqehA9g64wxANC+OgaCEgXmLB/LXzETelogFF4dvEs7dweVOVpZvNjxni7RXsUGaf7KjwgQvqQlMpZg",
+	"HXyuEKPDQteh4LjxUNCvdnZXXY4bL/l8/u2gMttB9uVkdvhaZWVrWwR1QMHVxPPjVfexJRCXOA4i1D2P",
+	"Jnf7tKaoZBTD0eG4Sxsex1h5IaW70X36AFPBEry+ZXgUTFDRdd5LQIkjSktmUicyLH5NPYSr1GO7idSq",
+	"LGkd7Goeg/Doap4q8ej6cjLdfNrj5cRZcrOtfR3Sa4T87fay9jyFVY4XRSKaORLzohXBmiE5bfnNKel3",
+	"aVPU827lKnmE48MYmt0yVqkeGQ9SrBKI2V8Ap6wtDm5NiatQ0t940AQHGa16l1anQsuTVhfR1Zsy5Wtd",
+	"CE0CBmhFqjx72JbKHcfanKEdc9Gl9K57x/Xw2EGluFPU/nKCrO0yR1W54UbJ26rC3CHklXOmBXyYW3rI",
+	"AenbmullqEuk8dIwLpT/W0aWSuo5qdm5yzN0+lnYqUqXwDkxqkDrLgRZxSvRRldqRCVjMRNrzKsqZdN3",
+	"aVgqhmrLaziySoqk9fhZfX7c8hiklqqHRr4bvEmXb9fksuP79Xa3KPiShLoQB+WixgunEFa3tby3tB6y",
+	"K2Oq19w8jAzzcOU3ZY+0CPCVNM3AxbkWnxNbJb5V0UxWKzAg0BSpS1fn1nKtkEpdiRijEazcMVAQ8pMs",
+	"v1tXg/F4L3WEyo+o15cCwVYpkTkIHSoBJXFrEii6DES03lJzYkbzJ0pB1ZWSJp1CLUpD2qd7ZeHvJ+Bd",
+	"Grxg3KOc1JxbnSsZJOSZEH3W+KZdGGyV9HlNrOoVtZ1i51phsAl83KWtyEfQCHyQOAOpLquhdI2uyQoC",
+	"pLtUi2nwLCltVj6/jIQeUuKa63lBF4+GhYzVNnje4FRXuninbnbwzGyV2n67xkwL0Zc+29p1ksNWNO/W",
+	"Lnpzw4x5AXqaqb4aQU2FpgdFbTVxO5gKnVq0Wn0aUd6C5K72JTyO+C7Etu68S3dQnt2iv7/fEd+lwcsp",
+	"3bJ+MZKzU1MV3DzN5SPKx5/M49if+xJfYB9ESnauz0hOAjkW80y1Q/kouDYP9S8ZjaE/nNzZEwaoM65S",
+	"BWauAB2mexoUQj1g/MrcMNEPj0sxNo+cqxwGPgMO5u5dGqocl3HDKQeD1iGQzWs2u8pg+Wq4h1zVX3/3",
+	"7a6fYPftXr3g7tff1Pn59cYH2L0Rx1fdD7p56leZ+rfNP/cZBu9/vVsiZeoBtWjva+cq2MRspWqjSOcz",
+	"CtZZiNnuJxat9AaWBTso+0qW5dXK0XHBjjHn/fm3z/8HXHC9hXljAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
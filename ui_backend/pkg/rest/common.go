@@ -16,9 +16,13 @@
 package rest
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/labstack/echo/v4"
 	log "github.com/sirupsen/logrus"
 
+	backendmodels "github.com/openclarity/vmclarity/api/models"
 	"github.com/openclarity/vmclarity/ui_backend/api/models"
 )
 
@@ -33,3 +37,26 @@ func sendError(ctx echo.Context, code int, message string) error {
 func sendResponse(ctx echo.Context, code int, object interface{}) error {
 	return ctx.JSON(code, object)
 }
+
+// getAssetGroup returns the asset group the dashboard is filtered by, or nil
+// if assetGroupID is not set.
+func (s *ServerImpl) getAssetGroup(ctx context.Context, assetGroupID *string) (*backendmodels.AssetGroup, error) {
+	if assetGroupID == nil || *assetGroupID == "" {
+		return nil, nil // nolint:nilnil
+	}
+	group, err := s.BackendClient.GetAssetGroup(ctx, *assetGroupID, backendmodels.GetAssetGroupsAssetGroupIDParams{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get asset group: %w", err)
+	}
+	return group, nil
+}
+
+// withAssetGroup restricts the filter to the members of the group, if any. The
+// properties of the assets are prefixed with assetPath, e.g. "asset/" to
+// filter the findings.
+func withAssetGroup(filter string, group *backendmodels.AssetGroup, assetPath string) string {
+	if group == nil {
+		return filter
+	}
+	return fmt.Sprintf("(%s) and (%s)", filter, group.MembersFilter(assetPath))
+}
@@ -29,8 +29,8 @@ import (
 )
 
 func (s *ServerImpl) GetDashboardRiskiestRegions(ctx echo.Context) error {
-	targets, err := s.BackendClient.GetTargets(ctx.Request().Context(), backendmodels.GetTargetsParams{
-		Filter: utils.PointerTo("targetInfo/objectType eq 'VMInfo'"),
+	targets, err := s.BackendClient.GetAssets(ctx.Request().Context(), backendmodels.GetAssetsParams{
+		Filter: utils.PointerTo("assetInfo/objectType eq 'VMInfo'"),
 	})
 	if err != nil {
 		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get targets: %v", err))
@@ -42,7 +42,7 @@ func (s *ServerImpl) GetDashboardRiskiestRegions(ctx echo.Context) error {
 	})
 }
 
-func createRegionFindingsFromTargets(targets *backendmodels.Targets) []models.RegionFindings {
+func createRegionFindingsFromTargets(targets *backendmodels.Assets) []models.RegionFindings {
 	// Map regions to findings count per finding type
 	findingsPerRegion := make(map[string]*models.FindingsCount)
 
@@ -80,20 +80,29 @@ func createRegionFindingsFromTargets(targets *backendmodels.Targets) []models.Re
 	return items
 }
 
-func getTargetRegion(target backendmodels.Target) (string, error) {
-	discriminator, err := target.TargetInfo.ValueByDiscriminator()
+func getTargetRegion(target backendmodels.Asset) (string, error) {
+	discriminator, err := target.AssetInfo.ValueByDiscriminator()
 	if err != nil {
 		return "", fmt.Errorf("failed to get value by discriminator: %w", err)
 	}
 
 	switch info := discriminator.(type) {
 	case backendmodels.VMInfo:
-		return getRegionByProvider(info), nil
+		return getRegion(info), nil
 	default:
 		return "", fmt.Errorf("target type is not supported (%T)", discriminator)
 	}
 }
 
+// getRegion returns the region of the normalized location of the VM, or the
+// one of its provider location if it was discovered without one.
+func getRegion(info backendmodels.VMInfo) string {
+	if info.LocationMetadata != nil && info.LocationMetadata.Region != nil {
+		return *info.LocationMetadata.Region
+	}
+	return getRegionByProvider(info)
+}
+
 func getRegionByProvider(info backendmodels.VMInfo) string {
 	if info.InstanceProvider == nil {
 		log.Warnf("Instace provider is nil. instance id: %v", info.InstanceID)
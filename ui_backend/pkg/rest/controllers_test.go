@@ -32,18 +32,22 @@ import (
 )
 
 func Test_getTargetLocation(t *testing.T) {
-	targetInfo := backendmodels.TargetType{}
+	targetInfo := backendmodels.AssetType{}
 	err := targetInfo.FromVMInfo(backendmodels.VMInfo{
 		InstanceProvider: utils.PointerTo(backendmodels.AWS),
 		Location:         "us-east-1/vpcid-1/sg-1",
+		LocationMetadata: &backendmodels.LocationMetadata{
+			Cloud:  utils.PointerTo(backendmodels.AWS),
+			Region: utils.PointerTo("us-east-1"),
+		},
 	})
 	assert.NilError(t, err)
-	nonSupportedTargetInfo := backendmodels.TargetType{}
+	nonSupportedTargetInfo := backendmodels.AssetType{}
 	err = nonSupportedTargetInfo.FromDirInfo(backendmodels.DirInfo{})
 	assert.NilError(t, err)
 
 	type args struct {
-		target backendmodels.Target
+		target backendmodels.Asset
 	}
 	tests := []struct {
 		name    string
@@ -54,8 +58,8 @@ func Test_getTargetLocation(t *testing.T) {
 		{
 			name: "sanity",
 			args: args{
-				target: backendmodels.Target{
-					TargetInfo: &targetInfo,
+				target: backendmodels.Asset{
+					AssetInfo: &targetInfo,
 				},
 			},
 			want:    "us-east-1",
@@ -64,8 +68,8 @@ func Test_getTargetLocation(t *testing.T) {
 		{
 			name: "non supported target",
 			args: args{
-				target: backendmodels.Target{
-					TargetInfo: &nonSupportedTargetInfo,
+				target: backendmodels.Asset{
+					AssetInfo: &nonSupportedTargetInfo,
 				},
 			},
 			want:    "",
@@ -207,22 +211,22 @@ func Test_getTotalVulnerabilities(t *testing.T) {
 
 // nolint:errcheck
 func Test_createRegionFindingsFromTargets(t *testing.T) {
-	dirTarget := backendmodels.TargetType{}
+	dirTarget := backendmodels.AssetType{}
 	dirTarget.FromDirInfo(backendmodels.DirInfo{
 		DirName:  utils.PointerTo("test-name"),
 		Location: utils.PointerTo("location-test"),
 	})
 
-	vmFromRegion1 := backendmodels.TargetType{}
+	vmFromRegion1 := backendmodels.AssetType{}
 	vmFromRegion1.FromVMInfo(backendmodels.VMInfo{
 		Location: "region1",
 	})
-	vm1FromRegion2 := backendmodels.TargetType{}
+	vm1FromRegion2 := backendmodels.AssetType{}
 	vm1FromRegion2.FromVMInfo(backendmodels.VMInfo{
 		Location: "region2",
 	})
 	type args struct {
-		targets *backendmodels.Targets
+		targets *backendmodels.Assets
 	}
 	tests := []struct {
 		name string
@@ -232,9 +236,9 @@ func Test_createRegionFindingsFromTargets(t *testing.T) {
 		{
 			name: "Unsupported target is skipped",
 			args: args{
-				targets: &backendmodels.Targets{
+				targets: &backendmodels.Assets{
 					Count: utils.PointerTo(1),
-					Items: utils.PointerTo([]backendmodels.Target{
+					Items: utils.PointerTo([]backendmodels.Asset{
 						{
 							Summary: &backendmodels.ScanFindingsSummary{
 								TotalExploits:          utils.PointerTo(1),
@@ -251,7 +255,7 @@ func Test_createRegionFindingsFromTargets(t *testing.T) {
 									TotalNegligibleVulnerabilities: utils.PointerTo(1),
 								},
 							},
-							TargetInfo: &dirTarget,
+							AssetInfo: &dirTarget,
 						},
 					}),
 				},
@@ -261,9 +265,9 @@ func Test_createRegionFindingsFromTargets(t *testing.T) {
 		{
 			name: "sanity",
 			args: args{
-				targets: &backendmodels.Targets{
+				targets: &backendmodels.Assets{
 					Count: utils.PointerTo(3),
-					Items: &[]backendmodels.Target{
+					Items: &[]backendmodels.Asset{
 						{
 							Summary: &backendmodels.ScanFindingsSummary{
 								TotalExploits:          utils.PointerTo(1),
@@ -280,7 +284,7 @@ func Test_createRegionFindingsFromTargets(t *testing.T) {
 									TotalNegligibleVulnerabilities: utils.PointerTo(11),
 								},
 							},
-							TargetInfo: &vmFromRegion1,
+							AssetInfo: &vmFromRegion1,
 						},
 						{
 							Summary: &backendmodels.ScanFindingsSummary{
@@ -298,7 +302,7 @@ func Test_createRegionFindingsFromTargets(t *testing.T) {
 									TotalNegligibleVulnerabilities: utils.PointerTo(12),
 								},
 							},
-							TargetInfo: &vmFromRegion1,
+							AssetInfo: &vmFromRegion1,
 						},
 						{
 							Summary: &backendmodels.ScanFindingsSummary{
@@ -316,7 +320,7 @@ func Test_createRegionFindingsFromTargets(t *testing.T) {
 									TotalNegligibleVulnerabilities: utils.PointerTo(13),
 								},
 							},
-							TargetInfo: &vm1FromRegion2,
+							AssetInfo: &vm1FromRegion2,
 						},
 					},
 				},
@@ -397,7 +401,7 @@ func Test_getPointerValOrZero(t *testing.T) {
 	}
 }
 
-func Test_getRegionByProvider(t *testing.T) {
+func Test_getRegion(t *testing.T) {
 	type args struct {
 		info backendmodels.VMInfo
 	}
@@ -436,11 +440,37 @@ func Test_getRegionByProvider(t *testing.T) {
 			},
 			want: "eu-central-1/vpc-1",
 		},
+		{
+			name: "AWS location metadata",
+			args: args{
+				info: backendmodels.VMInfo{
+					InstanceProvider: utils.PointerTo(backendmodels.AWS),
+					Location:         "eu-central-1/vpc-1",
+					LocationMetadata: &backendmodels.LocationMetadata{
+						Region: utils.PointerTo("eu-central-1"),
+					},
+				},
+			},
+			want: "eu-central-1",
+		},
+		{
+			name: "Azure location metadata",
+			args: args{
+				info: backendmodels.VMInfo{
+					InstanceProvider: utils.PointerTo(backendmodels.Azure),
+					Location:         "West Europe",
+					LocationMetadata: &backendmodels.LocationMetadata{
+						Region: utils.PointerTo("westeurope"),
+					},
+				},
+			},
+			want: "westeurope",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := getRegionByProvider(tt.args.info); got != tt.want {
-				t.Errorf("getRegionByProvider() = %v, want %v", got, tt.want)
+			if got := getRegion(tt.args.info); got != tt.want {
+				t.Errorf("getRegion() = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -0,0 +1,185 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	backendmodels "github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+	"github.com/openclarity/vmclarity/ui_backend/api/models"
+)
+
+const complianceCoveragePageSize = 100
+
+type complianceControlKey struct {
+	Framework string
+	ControlID string
+}
+
+func (s *ServerImpl) GetDashboardComplianceCoverage(ctx echo.Context) error {
+	coverage, err := s.getComplianceCoverage(ctx.Request().Context())
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get compliance coverage: %v", err))
+	}
+
+	return sendResponse(ctx, http.StatusOK, coverage)
+}
+
+func (s *ServerImpl) getComplianceCoverage(ctx context.Context) (models.ComplianceCoverage, error) {
+	var controls []backendmodels.ComplianceControl
+	if s.ComplianceMapper != nil {
+		controls = s.ComplianceMapper.Controls()
+	}
+
+	scannedAssets, err := s.getMisconfigurationScannedAssets(ctx)
+	if err != nil {
+		return models.ComplianceCoverage{}, fmt.Errorf("failed to get assets scanned for misconfigurations: %w", err)
+	}
+
+	failingAssets, err := s.getComplianceFailingAssets(ctx, scannedAssets)
+	if err != nil {
+		return models.ComplianceCoverage{}, fmt.Errorf("failed to get assets failing compliance controls: %w", err)
+	}
+
+	return createComplianceCoverage(controls, len(scannedAssets), failingAssets), nil
+}
+
+// getMisconfigurationScannedAssets returns the IDs of the assets which have completed a misconfiguration scan.
+func (s *ServerImpl) getMisconfigurationScannedAssets(ctx context.Context) (map[string]struct{}, error) {
+	filter := fmt.Sprintf("status/misconfigurations/state eq '%s'", backendmodels.AssetScanStateStateDone)
+	assets := map[string]struct{}{}
+	top := complianceCoveragePageSize
+	skip := 0
+	for {
+		scanResults, err := s.BackendClient.GetScanResults(ctx, backendmodels.GetScanResultsParams{
+			Filter: &filter,
+			Select: utils.PointerTo("asset/id"),
+			Top:    &top,
+			Skip:   &skip,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get scan results: %w", err)
+		}
+		if scanResults.Items == nil {
+			break
+		}
+
+		for _, scanResult := range *scanResults.Items {
+			if scanResult.Asset != nil {
+				assets[scanResult.Asset.Id] = struct{}{}
+			}
+		}
+
+		if len(*scanResults.Items) < top {
+			break
+		}
+		skip += top
+	}
+
+	return assets, nil
+}
+
+// getComplianceFailingAssets returns the IDs of the scanned assets with an active misconfiguration finding mapped to
+// each compliance control. The findings are mapped using the current mappings, so that changes of the mappings also
+// apply to the existing findings.
+func (s *ServerImpl) getComplianceFailingAssets(ctx context.Context, scannedAssets map[string]struct{}) (map[complianceControlKey]map[string]struct{}, error) {
+	failingAssets := map[complianceControlKey]map[string]struct{}{}
+	if s.ComplianceMapper == nil {
+		return failingAssets, nil
+	}
+
+	filter := "findingInfo/objectType eq 'Misconfiguration' and invalidatedOn eq null and suppression eq null"
+	top := complianceCoveragePageSize
+	skip := 0
+	for {
+		findings, err := s.BackendClient.GetFindings(ctx, backendmodels.GetFindingsParams{
+			Filter: &filter,
+			Select: utils.PointerTo("asset/id,findingInfo/scannerName,findingInfo/testID"),
+			Top:    &top,
+			Skip:   &skip,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get findings: %w", err)
+		}
+		if findings.Items == nil {
+			break
+		}
+
+		for _, finding := range *findings.Items {
+			if finding.Asset == nil || finding.FindingInfo == nil {
+				continue
+			}
+			if _, ok := scannedAssets[finding.Asset.Id]; !ok {
+				continue
+			}
+
+			info, err := finding.FindingInfo.AsMisconfigurationFindingInfo()
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert finding info to misconfiguration info: %w", err)
+			}
+			if info.ScannerName == nil || info.TestID == nil {
+				continue
+			}
+
+			for _, control := range s.ComplianceMapper.ControlsFor(*info.ScannerName, *info.TestID) {
+				key := complianceControlKey{Framework: control.Framework, ControlID: control.ControlID}
+				if failingAssets[key] == nil {
+					failingAssets[key] = map[string]struct{}{}
+				}
+				failingAssets[key][finding.Asset.Id] = struct{}{}
+			}
+		}
+
+		if len(*findings.Items) < top {
+			break
+		}
+		skip += top
+	}
+
+	return failingAssets, nil
+}
+
+// createComplianceCoverage groups the controls, which are ordered by framework, into the coverage of each framework.
+func createComplianceCoverage(controls []backendmodels.ComplianceControl, scannedAssetsCount int, failingAssets map[complianceControlKey]map[string]struct{}) models.ComplianceCoverage {
+	frameworks := []models.FrameworkComplianceCoverage{}
+	for _, control := range controls {
+		if len(frameworks) == 0 || *frameworks[len(frameworks)-1].Framework != control.Framework {
+			frameworks = append(frameworks, models.FrameworkComplianceCoverage{
+				Framework: utils.PointerTo(control.Framework),
+				Controls:  &[]models.ControlComplianceCoverage{},
+			})
+		}
+
+		failingAssetsCount := len(failingAssets[complianceControlKey{Framework: control.Framework, ControlID: control.ControlID}])
+		framework := &frameworks[len(frameworks)-1]
+		*framework.Controls = append(*framework.Controls, models.ControlComplianceCoverage{
+			ControlID:          utils.PointerTo(control.ControlID),
+			Title:              control.Title,
+			FailingAssetsCount: utils.PointerTo(failingAssetsCount),
+			PassingAssetsCount: utils.PointerTo(scannedAssetsCount - failingAssetsCount),
+		})
+	}
+
+	return models.ComplianceCoverage{
+		ScannedAssetsCount: utils.PointerTo(scannedAssetsCount),
+		Frameworks:         &frameworks,
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	backendmodels "github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+	"github.com/openclarity/vmclarity/ui_backend/api/models"
+)
+
+func Test_createComplianceCoverage(t *testing.T) {
+	controls := []backendmodels.ComplianceControl{
+		{Framework: "CIS", ControlID: "1.1", Title: utils.PointerTo("title")},
+		{Framework: "CIS", ControlID: "1.2"},
+		{Framework: "PCI-DSS", ControlID: "8.2.4"},
+	}
+
+	tests := []struct {
+		name               string
+		controls           []backendmodels.ComplianceControl
+		scannedAssetsCount int
+		failingAssets      map[complianceControlKey]map[string]struct{}
+		want               models.ComplianceCoverage
+	}{
+		{
+			name:               "no controls",
+			controls:           nil,
+			scannedAssetsCount: 2,
+			failingAssets:      map[complianceControlKey]map[string]struct{}{},
+			want: models.ComplianceCoverage{
+				ScannedAssetsCount: utils.PointerTo(2),
+				Frameworks:         &[]models.FrameworkComplianceCoverage{},
+			},
+		},
+		{
+			name:               "controls grouped by framework",
+			controls:           controls,
+			scannedAssetsCount: 3,
+			failingAssets: map[complianceControlKey]map[string]struct{}{
+				{Framework: "CIS", ControlID: "1.1"}: {
+					"asset-1": {},
+					"asset-2": {},
+				},
+				{Framework: "PCI-DSS", ControlID: "8.2.4"}: {
+					"asset-3": {},
+				},
+			},
+			want: models.ComplianceCoverage{
+				ScannedAssetsCount: utils.PointerTo(3),
+				Frameworks: &[]models.FrameworkComplianceCoverage{
+					{
+						Framework: utils.PointerTo("CIS"),
+						Controls: &[]models.ControlComplianceCoverage{
+							{
+								ControlID:          utils.PointerTo("1.1"),
+								Title:              utils.PointerTo("title"),
+								FailingAssetsCount: utils.PointerTo(2),
+								PassingAssetsCount: utils.PointerTo(1),
+							},
+							{
+								ControlID:          utils.PointerTo("1.2"),
+								FailingAssetsCount: utils.PointerTo(0),
+								PassingAssetsCount: utils.PointerTo(3),
+							},
+						},
+					},
+					{
+						Framework: utils.PointerTo("PCI-DSS"),
+						Controls: &[]models.ControlComplianceCoverage{
+							{
+								ControlID:          utils.PointerTo("8.2.4"),
+								FailingAssetsCount: utils.PointerTo(1),
+								PassingAssetsCount: utils.PointerTo(2),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := createComplianceCoverage(tt.controls, tt.scannedAssetsCount, tt.failingAssets)
+			assert.DeepEqual(t, got, tt.want)
+		})
+	}
+}
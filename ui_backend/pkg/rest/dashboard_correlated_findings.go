@@ -0,0 +1,164 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/labstack/echo/v4"
+
+	backendmodels "github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+	"github.com/openclarity/vmclarity/ui_backend/api/models"
+)
+
+const (
+	correlatedFindingsPageSize = 100
+	topCorrelatedFindings      = 10
+)
+
+func (s *ServerImpl) GetDashboardCorrelatedFindings(ctx echo.Context, params models.GetDashboardCorrelatedFindingsParams) error {
+	reqCtx := ctx.Request().Context()
+
+	group, err := s.getAssetGroup(reqCtx, params.AssetGroupID)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, err.Error())
+	}
+
+	correlatedFindings, err := s.getUnresolvedCorrelatedFindings(reqCtx, group)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get correlated findings: %v", err))
+	}
+
+	summary, err := createCorrelatedFindings(correlatedFindings)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to create correlated findings: %v", err))
+	}
+
+	return sendResponse(ctx, http.StatusOK, summary)
+}
+
+// getUnresolvedCorrelatedFindings returns the unresolved correlated findings
+// of the members of the group, with their asset expanded.
+func (s *ServerImpl) getUnresolvedCorrelatedFindings(ctx context.Context, group *backendmodels.AssetGroup) ([]backendmodels.CorrelatedFinding, error) {
+	var correlatedFindings []backendmodels.CorrelatedFinding
+	filter := withAssetGroup("resolvedOn eq null", group, "asset/")
+	top := correlatedFindingsPageSize
+	skip := 0
+	for {
+		page, err := s.BackendClient.GetCorrelatedFindings(ctx, backendmodels.GetCorrelatedFindingsParams{
+			Filter:  &filter,
+			Expand:  utils.PointerTo("asset"),
+			OrderBy: utils.PointerTo("firstSeen desc"),
+			Top:     &top,
+			Skip:    &skip,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if page.Items == nil {
+			break
+		}
+
+		correlatedFindings = append(correlatedFindings, *page.Items...)
+
+		if len(*page.Items) < top {
+			break
+		}
+		skip += top
+	}
+
+	return correlatedFindings, nil
+}
+
+// createCorrelatedFindings counts the correlated findings by rule and
+// severity, and returns the top ones, the critical ones first, then the ones
+// correlating the most findings and then the most recent ones.
+func createCorrelatedFindings(correlatedFindings []backendmodels.CorrelatedFinding) (models.CorrelatedFindings, error) {
+	assets := map[string]struct{}{}
+	rules := map[backendmodels.CorrelationRule]*models.CorrelationRuleCount{}
+	for _, correlatedFinding := range correlatedFindings {
+		assets[correlatedFinding.Asset.Id] = struct{}{}
+
+		count, ok := rules[correlatedFinding.Rule]
+		if !ok {
+			count = &models.CorrelationRuleCount{
+				Rule:          utils.PointerTo(string(correlatedFinding.Rule)),
+				CriticalCount: utils.PointerTo(0),
+				HighCount:     utils.PointerTo(0),
+			}
+			rules[correlatedFinding.Rule] = count
+		}
+		if correlatedFinding.Severity == backendmodels.CRITICAL {
+			*count.CriticalCount++
+		} else {
+			*count.HighCount++
+		}
+	}
+
+	ruleCounts := make([]models.CorrelationRuleCount, 0, len(rules))
+	for _, count := range rules {
+		ruleCounts = append(ruleCounts, *count)
+	}
+	sort.Slice(ruleCounts, func(i, j int) bool {
+		return *ruleCounts[i].Rule < *ruleCounts[j].Rule
+	})
+
+	top := make([]backendmodels.CorrelatedFinding, len(correlatedFindings))
+	copy(top, correlatedFindings)
+	sort.SliceStable(top, func(i, j int) bool {
+		a, b := top[i], top[j]
+		if (a.Severity == backendmodels.CRITICAL) != (b.Severity == backendmodels.CRITICAL) {
+			return a.Severity == backendmodels.CRITICAL
+		}
+		if len(a.FindingIDs) != len(b.FindingIDs) {
+			return len(a.FindingIDs) > len(b.FindingIDs)
+		}
+		return a.FirstSeen.After(b.FirstSeen)
+	})
+	if len(top) > topCorrelatedFindings {
+		top = top[:topCorrelatedFindings]
+	}
+
+	summaries := make([]models.CorrelatedFindingSummary, 0, len(top))
+	for _, correlatedFinding := range top {
+		summary := models.CorrelatedFindingSummary{
+			Id:            correlatedFinding.Id,
+			Rule:          utils.PointerTo(string(correlatedFinding.Rule)),
+			Severity:      utils.PointerTo(models.VulnerabilitySeverity(correlatedFinding.Severity)),
+			Path:          correlatedFinding.Path,
+			FindingsCount: utils.PointerTo(len(correlatedFinding.FindingIDs)),
+			FirstSeen:     utils.PointerTo(correlatedFinding.FirstSeen),
+		}
+		if correlatedFinding.Asset.AssetInfo != nil {
+			assetInfo, err := getAssetInfo(correlatedFinding.Asset.AssetInfo)
+			if err != nil {
+				return models.CorrelatedFindings{}, fmt.Errorf("failed to get asset info: %w", err)
+			}
+			summary.AssetInfo = assetInfo
+		}
+		summaries = append(summaries, summary)
+	}
+
+	return models.CorrelatedFindings{
+		AffectedAssetsCount: utils.PointerTo(len(assets)),
+		Rules:               &ruleCounts,
+		CorrelatedFindings:  &summaries,
+	}, nil
+}
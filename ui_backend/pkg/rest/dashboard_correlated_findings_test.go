@@ -0,0 +1,121 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	backendmodels "github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+	"github.com/openclarity/vmclarity/ui_backend/api/models"
+)
+
+func Test_createCorrelatedFindings(t *testing.T) {
+	firstSeen := time.Date(2023, 6, 30, 12, 0, 0, 0, time.UTC)
+	assetInfo := backendmodels.AssetType{}
+	err := assetInfo.FromVMInfo(backendmodels.VMInfo{
+		InstanceID:       "i-1",
+		InstanceProvider: utils.PointerTo(backendmodels.AWS),
+		Location:         "us-east-1",
+	})
+	assert.NilError(t, err)
+	asset := backendmodels.AssetRelationship{Id: "asset-1", AssetInfo: &assetInfo}
+
+	correlatedFindings := []backendmodels.CorrelatedFinding{
+		{
+			Id:         utils.PointerTo("cf-1"),
+			Asset:      asset,
+			Rule:       backendmodels.SamePath,
+			Severity:   backendmodels.HIGH,
+			Path:       utils.PointerTo("/etc/ssl/key.pem"),
+			FindingIDs: []string{"f-1", "f-2", "f-3"},
+			FirstSeen:  firstSeen,
+		},
+		{
+			Id:         utils.PointerTo("cf-2"),
+			Asset:      asset,
+			Rule:       backendmodels.MalwareAndRootkit,
+			Severity:   backendmodels.CRITICAL,
+			FindingIDs: []string{"f-4", "f-5"},
+			FirstSeen:  firstSeen,
+		},
+		{
+			Id:         utils.PointerTo("cf-3"),
+			Asset:      backendmodels.AssetRelationship{Id: "asset-2"},
+			Rule:       backendmodels.SamePath,
+			Severity:   backendmodels.CRITICAL,
+			Path:       utils.PointerTo("/usr/bin/sshd"),
+			FindingIDs: []string{"f-6", "f-7"},
+			FirstSeen:  firstSeen.Add(time.Hour),
+		},
+	}
+
+	got, err := createCorrelatedFindings(correlatedFindings)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, models.CorrelatedFindings{
+		AffectedAssetsCount: utils.PointerTo(2),
+		Rules: &[]models.CorrelationRuleCount{
+			{
+				Rule:          utils.PointerTo("MalwareAndRootkit"),
+				CriticalCount: utils.PointerTo(1),
+				HighCount:     utils.PointerTo(0),
+			},
+			{
+				Rule:          utils.PointerTo("SamePath"),
+				CriticalCount: utils.PointerTo(1),
+				HighCount:     utils.PointerTo(1),
+			},
+		},
+		CorrelatedFindings: &[]models.CorrelatedFindingSummary{
+			{
+				Id:            utils.PointerTo("cf-3"),
+				Rule:          utils.PointerTo("SamePath"),
+				Severity:      utils.PointerTo(models.CRITICAL),
+				Path:          utils.PointerTo("/usr/bin/sshd"),
+				FindingsCount: utils.PointerTo(2),
+				FirstSeen:     utils.PointerTo(firstSeen.Add(time.Hour)),
+			},
+			{
+				Id:            utils.PointerTo("cf-2"),
+				Rule:          utils.PointerTo("MalwareAndRootkit"),
+				Severity:      utils.PointerTo(models.CRITICAL),
+				FindingsCount: utils.PointerTo(2),
+				FirstSeen:     utils.PointerTo(firstSeen),
+				AssetInfo: &models.AssetInfo{
+					Name:     utils.PointerTo("i-1"),
+					Location: utils.PointerTo("us-east-1"),
+					Type:     utils.PointerTo(models.AWSEC2Instance),
+				},
+			},
+			{
+				Id:            utils.PointerTo("cf-1"),
+				Rule:          utils.PointerTo("SamePath"),
+				Severity:      utils.PointerTo(models.HIGH),
+				Path:          utils.PointerTo("/etc/ssl/key.pem"),
+				FindingsCount: utils.PointerTo(3),
+				FirstSeen:     utils.PointerTo(firstSeen),
+				AssetInfo: &models.AssetInfo{
+					Name:     utils.PointerTo("i-1"),
+					Location: utils.PointerTo("us-east-1"),
+					Type:     utils.PointerTo(models.AWSEC2Instance),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,228 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	log "github.com/sirupsen/logrus"
+
+	backendmodels "github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+	"github.com/openclarity/vmclarity/ui_backend/api/models"
+)
+
+const (
+	coveragePageSize       = 100
+	defaultCoverageSLADays = 7
+	defaultTeamTag         = "team"
+)
+
+func (s *ServerImpl) GetDashboardCoverage(ctx echo.Context, params models.GetDashboardCoverageParams) error {
+	groupBy := models.Provider
+	if params.GroupBy != nil {
+		groupBy = *params.GroupBy
+	}
+	slaDays := defaultCoverageSLADays
+	if params.SlaDays != nil {
+		slaDays = *params.SlaDays
+	}
+	teamTag := defaultTeamTag
+	if params.TeamTag != nil && *params.TeamTag != "" {
+		teamTag = *params.TeamTag
+	}
+
+	reqCtx := ctx.Request().Context()
+	group, err := s.getAssetGroup(reqCtx, params.AssetGroupID)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, err.Error())
+	}
+
+	targets, err := s.getCoverageTargets(reqCtx, "id,assetInfo", group)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get targets: %v", err))
+	}
+
+	lastScanned, err := s.getLastScannedTimes(reqCtx)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get last scanned times: %v", err))
+	}
+
+	return sendResponse(ctx, http.StatusOK, createAssetCoverage(targets, lastScanned, groupBy, slaDays, teamTag, time.Now()))
+}
+
+// getCoverageTargets returns the VM targets which are still discovered with
+// the fields in selectFields, restricted to the members of the group if any.
+func (s *ServerImpl) getCoverageTargets(ctx context.Context, selectFields string, group *backendmodels.AssetGroup) ([]backendmodels.Asset, error) {
+	filter := withAssetGroup("assetInfo/objectType eq 'VMInfo' and terminatedOn eq null", group, "")
+	var targets []backendmodels.Asset
+	top := coveragePageSize
+	skip := 0
+	for {
+		page, err := s.BackendClient.GetAssets(ctx, backendmodels.GetAssetsParams{
+			Filter: &filter,
+			Select: &selectFields,
+			Top:    &top,
+			Skip:   &skip,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get targets: %w", err)
+		}
+		if page.Items == nil {
+			break
+		}
+
+		targets = append(targets, *page.Items...)
+
+		if len(*page.Items) < top {
+			break
+		}
+		skip += top
+	}
+
+	return targets, nil
+}
+
+// getLastScannedTimes returns the time of the last done scan of each target.
+func (s *ServerImpl) getLastScannedTimes(ctx context.Context) (map[string]time.Time, error) {
+	filter := fmt.Sprintf("status/general/state eq '%s'", backendmodels.AssetScanStateStateDone)
+	lastScanned := map[string]time.Time{}
+	top := coveragePageSize
+	skip := 0
+	for {
+		scanResults, err := s.BackendClient.GetScanResults(ctx, backendmodels.GetScanResultsParams{
+			Filter: &filter,
+			Select: utils.PointerTo("asset/id,status/general/lastTransitionTime"),
+			Top:    &top,
+			Skip:   &skip,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get scan results: %w", err)
+		}
+		if scanResults.Items == nil {
+			break
+		}
+
+		for _, scanResult := range *scanResults.Items {
+			if scanResult.Asset == nil || scanResult.Status == nil || scanResult.Status.General == nil ||
+				scanResult.Status.General.LastTransitionTime == nil {
+				continue
+			}
+			scannedAt := *scanResult.Status.General.LastTransitionTime
+			if scannedAt.After(lastScanned[scanResult.Asset.Id]) {
+				lastScanned[scanResult.Asset.Id] = scannedAt
+			}
+		}
+
+		if len(*scanResults.Items) < top {
+			break
+		}
+		skip += top
+	}
+
+	return lastScanned, nil
+}
+
+// createAssetCoverage counts the targets by whether they were never scanned,
+// were last scanned within the SLA or are overdue, in total and per group.
+func createAssetCoverage(targets []backendmodels.Asset, lastScanned map[string]time.Time, groupBy models.CoverageGroupBy, slaDays int, teamTag string, now time.Time) models.AssetCoverage {
+	sla := time.Duration(slaDays) * 24 * time.Hour
+	total := models.CoverageCounts{NeverScanned: utils.PointerTo(0), WithinSla: utils.PointerTo(0), Overdue: utils.PointerTo(0)}
+	countsPerGroup := map[string]*models.CoverageCounts{}
+
+	for _, target := range targets {
+		if target.Id == nil || target.AssetInfo == nil {
+			continue
+		}
+		info, err := target.AssetInfo.AsVMInfo()
+		if err != nil {
+			log.Warnf("Couldn't get VM info, skipping target: %v", err)
+			continue
+		}
+
+		group := getCoverageGroup(info, groupBy, teamTag)
+		if _, ok := countsPerGroup[group]; !ok {
+			countsPerGroup[group] = &models.CoverageCounts{NeverScanned: utils.PointerTo(0), WithinSla: utils.PointerTo(0), Overdue: utils.PointerTo(0)}
+		}
+		counts := countsPerGroup[group]
+
+		scannedAt, ok := lastScanned[*target.Id]
+		switch {
+		case !ok:
+			*total.NeverScanned++
+			*counts.NeverScanned++
+		case now.Sub(scannedAt) <= sla:
+			*total.WithinSla++
+			*counts.WithinSla++
+		default:
+			*total.Overdue++
+			*counts.Overdue++
+		}
+	}
+
+	groups := make([]models.GroupCoverage, 0, len(countsPerGroup))
+	for name, counts := range countsPerGroup {
+		groups = append(groups, models.GroupCoverage{
+			Name:   utils.PointerTo(name),
+			Counts: counts,
+		})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		a, b := groups[i], groups[j]
+		if *a.Counts.Overdue != *b.Counts.Overdue {
+			return *a.Counts.Overdue > *b.Counts.Overdue
+		}
+		if *a.Counts.NeverScanned != *b.Counts.NeverScanned {
+			return *a.Counts.NeverScanned > *b.Counts.NeverScanned
+		}
+		return *a.Name < *b.Name
+	})
+
+	return models.AssetCoverage{
+		GroupBy: utils.PointerTo(groupBy),
+		SlaDays: utils.PointerTo(slaDays),
+		Total:   &total,
+		Groups:  &groups,
+	}
+}
+
+func getCoverageGroup(info backendmodels.VMInfo, groupBy models.CoverageGroupBy, teamTag string) string {
+	switch groupBy {
+	case models.Region:
+		return getRegion(info)
+	case models.Team:
+		if info.Tags != nil {
+			for _, tag := range *info.Tags {
+				if tag.Key == teamTag {
+					return tag.Value
+				}
+			}
+		}
+		return ""
+	case models.Provider:
+		fallthrough
+	default:
+		if info.InstanceProvider == nil {
+			return ""
+		}
+		return string(*info.InstanceProvider)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	backendmodels "github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+	"github.com/openclarity/vmclarity/ui_backend/api/models"
+)
+
+func createCoverageTarget(t *testing.T, id string, provider backendmodels.CloudProvider, team string) backendmodels.Asset {
+	t.Helper()
+	info := backendmodels.AssetType{}
+	err := info.FromVMInfo(backendmodels.VMInfo{
+		InstanceID:       id,
+		InstanceProvider: utils.PointerTo(provider),
+		Location:         "us-east-1/vpc-1/sg-1",
+		Tags: &[]backendmodels.Tag{
+			{Key: "team", Value: team},
+		},
+	})
+	assert.NilError(t, err)
+	return backendmodels.Asset{
+		Id:        utils.PointerTo(id),
+		AssetInfo: &info,
+	}
+}
+
+func coverageCounts(neverScanned, withinSla, overdue int) *models.CoverageCounts {
+	return &models.CoverageCounts{
+		NeverScanned: utils.PointerTo(neverScanned),
+		WithinSla:    utils.PointerTo(withinSla),
+		Overdue:      utils.PointerTo(overdue),
+	}
+}
+
+func Test_createAssetCoverage(t *testing.T) {
+	now := time.Date(2023, 6, 10, 0, 0, 0, 0, time.UTC)
+	targets := []backendmodels.Asset{
+		createCoverageTarget(t, "t1", backendmodels.AWS, "red"),
+		createCoverageTarget(t, "t2", backendmodels.AWS, "blue"),
+		createCoverageTarget(t, "t3", backendmodels.Azure, "red"),
+		createCoverageTarget(t, "t4", backendmodels.Azure, "blue"),
+		{Id: utils.PointerTo("no-info")},
+	}
+	lastScanned := map[string]time.Time{
+		"t1": now.Add(-24 * time.Hour),
+		"t2": now.Add(-10 * 24 * time.Hour),
+		"t3": now.Add(-7 * 24 * time.Hour),
+	}
+
+	tests := []struct {
+		name    string
+		groupBy models.CoverageGroupBy
+		want    models.AssetCoverage
+	}{
+		{
+			name:    "group by provider",
+			groupBy: models.Provider,
+			want: models.AssetCoverage{
+				GroupBy: utils.PointerTo(models.Provider),
+				SlaDays: utils.PointerTo(7),
+				Total:   coverageCounts(1, 2, 1),
+				Groups: &[]models.GroupCoverage{
+					{Name: utils.PointerTo(string(backendmodels.AWS)), Counts: coverageCounts(0, 1, 1)},
+					{Name: utils.PointerTo(string(backendmodels.Azure)), Counts: coverageCounts(1, 1, 0)},
+				},
+			},
+		},
+		{
+			name:    "group by team",
+			groupBy: models.Team,
+			want: models.AssetCoverage{
+				GroupBy: utils.PointerTo(models.Team),
+				SlaDays: utils.PointerTo(7),
+				Total:   coverageCounts(1, 2, 1),
+				Groups: &[]models.GroupCoverage{
+					{Name: utils.PointerTo("blue"), Counts: coverageCounts(1, 0, 1)},
+					{Name: utils.PointerTo("red"), Counts: coverageCounts(0, 2, 0)},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := createAssetCoverage(targets, lastScanned, tt.groupBy, 7, "team", now)
+			assert.DeepEqual(t, got, tt.want)
+		})
+	}
+}
+
+func Test_getCoverageGroup(t *testing.T) {
+	info := backendmodels.VMInfo{
+		InstanceProvider: utils.PointerTo(backendmodels.AWS),
+		Location:         "us-east-1/vpc-1/sg-1",
+		LocationMetadata: &backendmodels.LocationMetadata{
+			Cloud:  utils.PointerTo(backendmodels.AWS),
+			Region: utils.PointerTo("us-east-1"),
+		},
+		Tags: &[]backendmodels.Tag{
+			{Key: "owner", Value: "platform"},
+		},
+	}
+
+	assert.Equal(t, getCoverageGroup(info, models.Provider, "team"), "AWS")
+	assert.Equal(t, getCoverageGroup(info, models.Region, "team"), "us-east-1")
+	assert.Equal(t, getCoverageGroup(info, models.Team, "owner"), "platform")
+	assert.Equal(t, getCoverageGroup(info, models.Team, "team"), "")
+}
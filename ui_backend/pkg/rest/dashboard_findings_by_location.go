@@ -0,0 +1,140 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/labstack/echo/v4"
+	log "github.com/sirupsen/logrus"
+
+	backendmodels "github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+	"github.com/openclarity/vmclarity/ui_backend/api/models"
+)
+
+func (s *ServerImpl) GetDashboardFindingsByLocation(ctx echo.Context, params models.GetDashboardFindingsByLocationParams) error {
+	groupBy := models.LocationRegion
+	if params.GroupBy != nil {
+		groupBy = *params.GroupBy
+	}
+
+	targets, err := s.getCoverageTargets(ctx.Request().Context(), "id,assetInfo,summary", nil)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get targets: %v", err))
+	}
+
+	return sendResponse(ctx, http.StatusOK, createLocationFindings(targets, groupBy))
+}
+
+// createLocationFindings sums the findings of the latest scans of the targets
+// by the dimension of their location.
+func createLocationFindings(targets []backendmodels.Asset, groupBy models.LocationGroupBy) models.LocationFindings {
+	groups := map[string]*models.LocationFindingsGroup{}
+	for _, target := range targets {
+		if target.AssetInfo == nil {
+			continue
+		}
+		info, err := target.AssetInfo.AsVMInfo()
+		if err != nil {
+			log.Warnf("Failed to get VM info of target, skipping target: %v", err)
+			continue
+		}
+
+		group := newLocationFindingsGroup(info, groupBy)
+		if existing, ok := groups[*group.Name]; ok {
+			group = existing
+		} else {
+			groups[*group.Name] = group
+		}
+		group.Assets = utils.PointerTo(*group.Assets + 1)
+		group.FindingsCount = addTargetSummaryToFindingsCount(group.FindingsCount, target.Summary)
+	}
+
+	items := make([]models.LocationFindingsGroup, 0, len(groups))
+	for _, group := range groups {
+		items = append(items, *group)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		a, b := getTotalFindings(items[i].FindingsCount), getTotalFindings(items[j].FindingsCount)
+		if a != b {
+			return a > b
+		}
+		return *items[i].Name < *items[j].Name
+	})
+
+	return models.LocationFindings{
+		GroupBy: &groupBy,
+		Groups:  &items,
+	}
+}
+
+// newLocationFindingsGroup returns the empty group of the VM by the dimension
+// of its normalized location. The cloud and the country are also set for the
+// regions and the zones.
+func newLocationFindingsGroup(info backendmodels.VMInfo, groupBy models.LocationGroupBy) *models.LocationFindingsGroup {
+	location := utils.ValueOrZero(info.LocationMetadata)
+
+	group := &models.LocationFindingsGroup{
+		Assets: utils.PointerTo(0),
+		FindingsCount: &models.FindingsCount{
+			Exploits:          utils.PointerTo(0),
+			Malware:           utils.PointerTo(0),
+			Misconfigurations: utils.PointerTo(0),
+			Rootkits:          utils.PointerTo(0),
+			Secrets:           utils.PointerTo(0),
+			Vulnerabilities:   utils.PointerTo(0),
+		},
+	}
+
+	var cloud string
+	if location.Cloud != nil {
+		cloud = string(*location.Cloud)
+	} else if info.InstanceProvider != nil {
+		cloud = string(*info.InstanceProvider)
+	}
+
+	switch groupBy {
+	case models.LocationCloud:
+		group.Name = utils.PointerTo(cloud)
+	case models.LocationCountry:
+		group.Name = utils.PointerTo(utils.ValueOrZero(location.Country))
+	case models.LocationZone:
+		group.Name = utils.PointerTo(utils.ValueOrZero(location.Zone))
+	case models.LocationRegion:
+		fallthrough
+	default:
+		group.Name = utils.PointerTo(getRegion(info))
+	}
+
+	if groupBy == models.LocationRegion || groupBy == models.LocationZone {
+		group.Cloud = utils.PointerTo(cloud)
+		group.Country = utils.PointerTo(utils.ValueOrZero(location.Country))
+	}
+
+	return group
+}
+
+func getTotalFindings(count *models.FindingsCount) int {
+	if count == nil {
+		return 0
+	}
+	return getPointerValOrZero(count.Exploits) + getPointerValOrZero(count.Malware) +
+		getPointerValOrZero(count.Misconfigurations) + getPointerValOrZero(count.Rootkits) +
+		getPointerValOrZero(count.Secrets) + getPointerValOrZero(count.Vulnerabilities)
+}
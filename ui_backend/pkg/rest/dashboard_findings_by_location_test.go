@@ -0,0 +1,134 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	backendmodels "github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+	"github.com/openclarity/vmclarity/ui_backend/api/models"
+)
+
+func createLocationTarget(t *testing.T, location *backendmodels.LocationMetadata, secrets int) backendmodels.Asset {
+	t.Helper()
+	info := backendmodels.AssetType{}
+	err := info.FromVMInfo(backendmodels.VMInfo{
+		InstanceProvider: location.Cloud,
+		Location:         "West Europe",
+		LocationMetadata: location,
+	})
+	assert.NilError(t, err)
+	return backendmodels.Asset{
+		AssetInfo: &info,
+		Summary: &backendmodels.ScanFindingsSummary{
+			TotalSecrets: utils.PointerTo(secrets),
+		},
+	}
+}
+
+func locationFindingsGroup(name, cloud, country string, assets, secrets int) models.LocationFindingsGroup {
+	group := models.LocationFindingsGroup{
+		Name:   utils.PointerTo(name),
+		Assets: utils.PointerTo(assets),
+		FindingsCount: &models.FindingsCount{
+			Exploits:          utils.PointerTo(0),
+			Malware:           utils.PointerTo(0),
+			Misconfigurations: utils.PointerTo(0),
+			Rootkits:          utils.PointerTo(0),
+			Secrets:           utils.PointerTo(secrets),
+			Vulnerabilities:   utils.PointerTo(0),
+		},
+	}
+	if cloud != "" || country != "" {
+		group.Cloud = utils.PointerTo(cloud)
+		group.Country = utils.PointerTo(country)
+	}
+	return group
+}
+
+func Test_createLocationFindings(t *testing.T) {
+	westEurope := &backendmodels.LocationMetadata{
+		Cloud:   utils.PointerTo(backendmodels.Azure),
+		Region:  utils.PointerTo("westeurope"),
+		Zone:    utils.PointerTo("westeurope-1"),
+		Country: utils.PointerTo("NL"),
+	}
+	frankfurt := &backendmodels.LocationMetadata{
+		Cloud:   utils.PointerTo(backendmodels.AWS),
+		Region:  utils.PointerTo("eu-central-1"),
+		Zone:    utils.PointerTo("eu-central-1a"),
+		Country: utils.PointerTo("DE"),
+	}
+	amsterdam := &backendmodels.LocationMetadata{
+		Cloud:   utils.PointerTo(backendmodels.AWS),
+		Region:  utils.PointerTo("eu-west-nl"),
+		Country: utils.PointerTo("NL"),
+	}
+	targets := []backendmodels.Asset{
+		createLocationTarget(t, westEurope, 1),
+		createLocationTarget(t, westEurope, 2),
+		createLocationTarget(t, frankfurt, 5),
+		createLocationTarget(t, amsterdam, 1),
+	}
+
+	tests := []struct {
+		groupBy models.LocationGroupBy
+		want    []models.LocationFindingsGroup
+	}{
+		{
+			groupBy: models.LocationRegion,
+			want: []models.LocationFindingsGroup{
+				locationFindingsGroup("eu-central-1", "AWS", "DE", 1, 5),
+				locationFindingsGroup("westeurope", "Azure", "NL", 2, 3),
+				locationFindingsGroup("eu-west-nl", "AWS", "NL", 1, 1),
+			},
+		},
+		{
+			groupBy: models.LocationZone,
+			want: []models.LocationFindingsGroup{
+				locationFindingsGroup("eu-central-1a", "AWS", "DE", 1, 5),
+				locationFindingsGroup("westeurope-1", "Azure", "NL", 2, 3),
+				locationFindingsGroup("", "AWS", "NL", 1, 1),
+			},
+		},
+		{
+			groupBy: models.LocationCountry,
+			want: []models.LocationFindingsGroup{
+				locationFindingsGroup("DE", "", "", 1, 5),
+				locationFindingsGroup("NL", "", "", 3, 4),
+			},
+		},
+		{
+			groupBy: models.LocationCloud,
+			want: []models.LocationFindingsGroup{
+				locationFindingsGroup("AWS", "", "", 2, 6),
+				locationFindingsGroup("Azure", "", "", 2, 3),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.groupBy), func(t *testing.T) {
+			got := createLocationFindings(targets, tt.groupBy)
+			assert.DeepEqual(t, got, models.LocationFindings{
+				GroupBy: utils.PointerTo(tt.groupBy),
+				Groups:  &tt.want,
+			})
+		})
+	}
+}
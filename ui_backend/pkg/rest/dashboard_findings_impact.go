@@ -74,20 +74,22 @@ func (s *ServerImpl) GetDashboardFindingsImpact(ctx echo.Context) error {
 	return sendResponse(ctx, http.StatusOK, findingsImpact)
 }
 
-func (s *ServerImpl) recalculateFindingsImpact(ctx context.Context) {
+func (s *ServerImpl) recalculateFindingsImpact(ctx context.Context) error {
 	log.Debugf("Recalculating findings impact...")
 	findingsImpact, err := s.getFindingsImpact(ctx)
 	if err != nil {
-		log.Errorf("failed to get findings impact: %v", err)
-	} else {
-		s.findingsImpactMutex.Lock()
-		s.findingsImpact = findingsImpact
-		s.once.Do(func() {
-			close(s.findingsImpactFetchedChannel)
-		})
-		s.findingsImpactMutex.Unlock()
+		return fmt.Errorf("failed to get findings impact: %w", err)
 	}
+
+	s.findingsImpactMutex.Lock()
+	s.findingsImpact = findingsImpact
+	s.once.Do(func() {
+		close(s.findingsImpactFetchedChannel)
+	})
+	s.findingsImpactMutex.Unlock()
+
 	log.Debugf("Done recalculating findings impact...")
+	return nil
 }
 
 func (s *ServerImpl) getFindingsImpact(ctx context.Context) (models.FindingsImpact, error) {
@@ -446,12 +448,12 @@ func createPackageFindingImpact(findingInfo *backendmodels.Finding_FindingInfo,
 }
 
 func (s *ServerImpl) getVulnerabilityFindingToAssetCountMap(ctx context.Context, severity string) (map[string]findingInfoCount, error) {
-	filter := fmt.Sprintf("findingInfo/objectType eq 'Vulnerability' and findingInfo/severity eq '%s' and invalidatedOn eq null", severity)
+	filter := fmt.Sprintf("findingInfo/objectType eq 'Vulnerability' and findingInfo/severity eq '%s' and invalidatedOn eq null and suppression eq null", severity)
 	return s.getFindingToAssetCountMapWithFilter(ctx, filter)
 }
 
 func (s *ServerImpl) getFindingToAssetCountMap(ctx context.Context, findingType string) (map[string]findingInfoCount, error) {
-	filter := fmt.Sprintf("findingInfo/objectType eq '%s' and invalidatedOn eq null", findingType)
+	filter := fmt.Sprintf("findingInfo/objectType eq '%s' and invalidatedOn eq null and suppression eq null", findingType)
 	return s.getFindingToAssetCountMapWithFilter(ctx, filter)
 }
 
@@ -56,7 +56,7 @@ func Test_processFindings(t *testing.T) {
 			args: args{
 				findings: []backendmodels.Finding{
 					{
-						Asset: &backendmodels.TargetRelationship{
+						Asset: &backendmodels.AssetRelationship{
 							Id: "target-1",
 						},
 						FindingInfo: rootkitFindingInfo1,
@@ -81,7 +81,7 @@ func Test_processFindings(t *testing.T) {
 			args: args{
 				findings: []backendmodels.Finding{
 					{
-						Asset: &backendmodels.TargetRelationship{
+						Asset: &backendmodels.AssetRelationship{
 							Id: "target-1",
 						},
 						FindingInfo: rootkitFindingInfo1,
@@ -113,7 +113,7 @@ func Test_processFindings(t *testing.T) {
 			args: args{
 				findings: []backendmodels.Finding{
 					{
-						Asset: &backendmodels.TargetRelationship{
+						Asset: &backendmodels.AssetRelationship{
 							Id: "target-1",
 						},
 						FindingInfo: rootkitFindingInfo1,
@@ -150,21 +150,21 @@ func Test_processFindings(t *testing.T) {
 				findings: []backendmodels.Finding{
 					{
 						// findingAssetKey already in findingAssetMap
-						Asset: &backendmodels.TargetRelationship{
+						Asset: &backendmodels.AssetRelationship{
 							Id: "target-1",
 						},
 						FindingInfo: rootkitFindingInfo1,
 					},
 					{
 						// findingAssetKey not in findingAssetMap - already saw the finding
-						Asset: &backendmodels.TargetRelationship{
+						Asset: &backendmodels.AssetRelationship{
 							Id: "target-1",
 						},
 						FindingInfo: rootkitFindingInfo2,
 					},
 					{
 						// findingAssetKey not in findingAssetMap - first time we see the finding
-						Asset: &backendmodels.TargetRelationship{
+						Asset: &backendmodels.AssetRelationship{
 							Id: "target-1",
 						},
 						FindingInfo: rootkitFindingInfo3,
@@ -0,0 +1,201 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/labstack/echo/v4"
+
+	backendmodels "github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+	"github.com/openclarity/vmclarity/ui_backend/api/models"
+)
+
+const scanConfigFindingsPageSize = 500
+
+func (s *ServerImpl) GetDashboardFindingsPerScanConfig(ctx echo.Context, params models.GetDashboardFindingsPerScanConfigParams) error {
+	reqCtx := ctx.Request().Context()
+
+	group, err := s.getAssetGroup(reqCtx, params.AssetGroupID)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, err.Error())
+	}
+
+	scanConfigs, err := s.BackendClient.GetScanConfigs(reqCtx, backendmodels.GetScanConfigsParams{
+		Select: utils.PointerTo("id,name"),
+	})
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scan configs: %v", err))
+	}
+
+	scanConfigIDs, err := s.getScanConfigIDsByScan(reqCtx)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get scans: %v", err))
+	}
+
+	findings, err := s.getActiveFindingsWithScan(reqCtx, group)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get findings: %v", err))
+	}
+
+	findingsPerScanConfig, err := createFindingsPerScanConfig(utils.ValueOrZero(scanConfigs.Items), scanConfigIDs, findings)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to create findings per scan config: %v", err))
+	}
+
+	return sendResponse(ctx, http.StatusOK, findingsPerScanConfig)
+}
+
+// getScanConfigIDsByScan returns the ID of the scan config of each scan
+// started by a scan config.
+func (s *ServerImpl) getScanConfigIDsByScan(ctx context.Context) (map[string]string, error) {
+	scanConfigIDs := map[string]string{}
+	top := scanConfigFindingsPageSize
+	skip := 0
+	for {
+		scans, err := s.BackendClient.GetScans(ctx, backendmodels.GetScansParams{
+			Select: utils.PointerTo("id,scanConfig"),
+			Top:    &top,
+			Skip:   &skip,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if scans.Items == nil {
+			break
+		}
+
+		for _, scan := range *scans.Items {
+			if scan.Id == nil || scan.ScanConfig == nil {
+				continue
+			}
+			scanConfigIDs[*scan.Id] = scan.ScanConfig.Id
+		}
+
+		if len(*scans.Items) < top {
+			break
+		}
+		skip += top
+	}
+
+	return scanConfigIDs, nil
+}
+
+// getActiveFindingsWithScan returns the type and the scan of the active
+// findings of the members of the group.
+func (s *ServerImpl) getActiveFindingsWithScan(ctx context.Context, group *backendmodels.AssetGroup) ([]backendmodels.Finding, error) {
+	filter := withAssetGroup("invalidatedOn eq null and suppression eq null", group, "asset/")
+	var ret []backendmodels.Finding
+	top := scanConfigFindingsPageSize
+	skip := 0
+	for {
+		findings, err := s.BackendClient.GetFindings(ctx, backendmodels.GetFindingsParams{
+			Filter: &filter,
+			Select: utils.PointerTo("scan,findingInfo/objectType"),
+			Top:    &top,
+			Skip:   &skip,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if findings.Items == nil {
+			break
+		}
+
+		ret = append(ret, *findings.Items...)
+
+		if len(*findings.Items) < top {
+			break
+		}
+		skip += top
+	}
+
+	return ret, nil
+}
+
+// createFindingsPerScanConfig counts the findings of each type by the scan
+// config of the scan which found them. The scan configs without findings are
+// listed with zero counts, the findings of the scans without a scan config,
+// or of a deleted one, are not counted.
+func createFindingsPerScanConfig(scanConfigs []backendmodels.ScanConfig, scanConfigIDs map[string]string, findings []backendmodels.Finding) (models.FindingsPerScanConfig, error) {
+	counts := make(map[string]*models.ScanConfigFindings, len(scanConfigs))
+	for _, scanConfig := range scanConfigs {
+		if scanConfig.Id == nil {
+			continue
+		}
+		counts[*scanConfig.Id] = &models.ScanConfigFindings{
+			ScanConfigID:   scanConfig.Id,
+			ScanConfigName: scanConfig.Name,
+			FindingsCount: &models.FindingsCount{
+				Exploits:          utils.PointerTo(0),
+				Malware:           utils.PointerTo(0),
+				Misconfigurations: utils.PointerTo(0),
+				Rootkits:          utils.PointerTo(0),
+				Secrets:           utils.PointerTo(0),
+				Vulnerabilities:   utils.PointerTo(0),
+			},
+		}
+	}
+
+	for _, finding := range findings {
+		if finding.Scan == nil || finding.FindingInfo == nil {
+			continue
+		}
+		count, ok := counts[scanConfigIDs[finding.Scan.Id]]
+		if !ok {
+			continue
+		}
+
+		objectType, err := finding.FindingInfo.Discriminator()
+		if err != nil {
+			return models.FindingsPerScanConfig{}, fmt.Errorf("failed to get finding type: %w", err)
+		}
+		switch objectType {
+		case getObjectType(models.EXPLOIT):
+			*count.FindingsCount.Exploits++
+		case getObjectType(models.MALWARE):
+			*count.FindingsCount.Malware++
+		case getObjectType(models.MISCONFIGURATION):
+			*count.FindingsCount.Misconfigurations++
+		case getObjectType(models.ROOTKIT):
+			*count.FindingsCount.Rootkits++
+		case getObjectType(models.SECRET):
+			*count.FindingsCount.Secrets++
+		case getObjectType(models.VULNERABILITY):
+			*count.FindingsCount.Vulnerabilities++
+		}
+	}
+
+	items := make([]models.ScanConfigFindings, 0, len(counts))
+	for _, count := range counts {
+		items = append(items, *count)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		a, b := getTotalFindings(items[i].FindingsCount), getTotalFindings(items[j].FindingsCount)
+		if a != b {
+			return a > b
+		}
+		return utils.ValueOrZero(items[i].ScanConfigName) < utils.ValueOrZero(items[j].ScanConfigName)
+	})
+
+	return models.FindingsPerScanConfig{
+		ScanConfigs: &items,
+	}, nil
+}
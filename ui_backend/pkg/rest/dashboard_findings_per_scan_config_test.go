@@ -0,0 +1,95 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	backendmodels "github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+	"github.com/openclarity/vmclarity/ui_backend/api/models"
+)
+
+func createScanFinding(t *testing.T, scanID string, secret bool) backendmodels.Finding {
+	t.Helper()
+	info := backendmodels.Finding_FindingInfo{}
+	var err error
+	if secret {
+		err = info.FromSecretFindingInfo(backendmodels.SecretFindingInfo{})
+	} else {
+		err = info.FromVulnerabilityFindingInfo(backendmodels.VulnerabilityFindingInfo{})
+	}
+	assert.NilError(t, err)
+	return backendmodels.Finding{
+		FindingInfo: &info,
+		Scan:        &backendmodels.ScanRelationship{Id: scanID},
+	}
+}
+
+func scanConfigFindings(id, name string, secrets, vulnerabilities int) models.ScanConfigFindings {
+	return models.ScanConfigFindings{
+		ScanConfigID:   utils.PointerTo(id),
+		ScanConfigName: utils.PointerTo(name),
+		FindingsCount: &models.FindingsCount{
+			Exploits:          utils.PointerTo(0),
+			Malware:           utils.PointerTo(0),
+			Misconfigurations: utils.PointerTo(0),
+			Rootkits:          utils.PointerTo(0),
+			Secrets:           utils.PointerTo(secrets),
+			Vulnerabilities:   utils.PointerTo(vulnerabilities),
+		},
+	}
+}
+
+func Test_createFindingsPerScanConfig(t *testing.T) {
+	scanConfigs := []backendmodels.ScanConfig{
+		{Id: utils.PointerTo("daily"), Name: utils.PointerTo("daily")},
+		{Id: utils.PointerTo("weekly"), Name: utils.PointerTo("weekly")},
+		{Id: utils.PointerTo("adhoc"), Name: utils.PointerTo("adhoc")},
+		{Id: utils.PointerTo("idle"), Name: utils.PointerTo("idle")},
+	}
+	scanConfigIDs := map[string]string{
+		"daily-1":  "daily",
+		"daily-2":  "daily",
+		"weekly-1": "weekly",
+		"adhoc-1":  "adhoc",
+		"deleted":  "deleted",
+	}
+	findings := []backendmodels.Finding{
+		createScanFinding(t, "daily-1", true),
+		createScanFinding(t, "daily-2", false),
+		createScanFinding(t, "daily-2", false),
+		createScanFinding(t, "weekly-1", true),
+		createScanFinding(t, "adhoc-1", false),
+		// The findings of a deleted scan config and of a scan
+		// without a scan config are not counted.
+		createScanFinding(t, "deleted", true),
+		createScanFinding(t, "manual", true),
+	}
+
+	got, err := createFindingsPerScanConfig(scanConfigs, scanConfigIDs, findings)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, got, models.FindingsPerScanConfig{
+		ScanConfigs: &[]models.ScanConfigFindings{
+			scanConfigFindings("daily", "daily", 1, 2),
+			scanConfigFindings("adhoc", "adhoc", 0, 1),
+			scanConfigFindings("weekly", "weekly", 1, 0),
+			scanConfigFindings("idle", "idle", 0, 0),
+		},
+	})
+}
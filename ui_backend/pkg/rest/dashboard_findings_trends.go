@@ -40,6 +40,11 @@ func (s *ServerImpl) GetDashboardFindingsTrends(ctx echo.Context, params models.
 		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("Request params are not valid: %v", err))
 	}
 
+	group, err := s.getAssetGroup(reqCtx, params.AssetGroupID)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, err.Error())
+	}
+
 	times := createTimes(params)
 
 	findingTypes := models.GetFindingTypes()
@@ -52,7 +57,7 @@ func (s *ServerImpl) GetDashboardFindingsTrends(ctx echo.Context, params models.
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			trends, err := s.getFindingTrendsForFindingType(reqCtx, ft, times)
+			trends, err := s.getFindingTrendsForFindingType(reqCtx, ft, times, group)
 			if err != nil {
 				errs <- fmt.Errorf("failed to get %s trends: %v", ft, err)
 				return
@@ -64,7 +69,6 @@ func (s *ServerImpl) GetDashboardFindingsTrends(ctx echo.Context, params models.
 	close(errs)
 	close(findingsTrendsChan)
 
-	var err error
 	for e := range errs {
 		if e != nil {
 			err = errors.Join(err, e)
@@ -103,10 +107,10 @@ func createTimes(params models.GetDashboardFindingsTrendsParams) []time.Time {
 	return times
 }
 
-func (s *ServerImpl) getFindingTrendsForFindingType(ctx context.Context, findingType models.FindingType, times []time.Time) (models.FindingTrends, error) {
+func (s *ServerImpl) getFindingTrendsForFindingType(ctx context.Context, findingType models.FindingType, times []time.Time, group *backendmodels.AssetGroup) (models.FindingTrends, error) {
 	trends := make([]models.FindingTrend, len(times))
 	for i, point := range times {
-		trend, err := s.getFindingTrendPerPoint(ctx, findingType, point)
+		trend, err := s.getFindingTrendPerPoint(ctx, findingType, point, group)
 		if err != nil {
 			return models.FindingTrends{}, fmt.Errorf("failed to get finding trend: %v", err)
 		}
@@ -119,13 +123,13 @@ func (s *ServerImpl) getFindingTrendsForFindingType(ctx context.Context, finding
 	}, nil
 }
 
-func (s *ServerImpl) getFindingTrendPerPoint(ctx context.Context, findingType models.FindingType, point time.Time) (models.FindingTrend, error) {
+func (s *ServerImpl) getFindingTrendPerPoint(ctx context.Context, findingType models.FindingType, point time.Time, group *backendmodels.AssetGroup) (models.FindingTrend, error) {
 	// Count total findings for the given finding type that was active during the given time point.
 	findings, err := s.BackendClient.GetFindings(ctx, backendmodels.GetFindingsParams{
 		Count: utils.PointerTo(true),
-		Filter: utils.PointerTo(fmt.Sprintf(
-			"findingInfo/objectType eq '%s' and foundOn le %v and (invalidatedOn eq null or invalidatedOn gt %v)",
-			getObjectType(findingType), point.Format(time.RFC3339), point.Format(time.RFC3339))),
+		Filter: utils.PointerTo(withAssetGroup(fmt.Sprintf(
+			"findingInfo/objectType eq '%s' and foundOn le %v and (invalidatedOn eq null or invalidatedOn gt %v) and suppression eq null",
+			getObjectType(findingType), point.Format(time.RFC3339), point.Format(time.RFC3339)), group, "asset/")),
 		// Select the smallest amount of data to return in items, we only care about the count.
 		Select: utils.PointerTo("id"),
 		Top:    utils.PointerTo(0),
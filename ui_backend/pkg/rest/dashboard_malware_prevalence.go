@@ -0,0 +1,171 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+
+	"github.com/labstack/echo/v4"
+
+	backendmodels "github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+	"github.com/openclarity/vmclarity/ui_backend/api/models"
+)
+
+const (
+	activeFindingsPageSize = 100
+	topMalwareFamilies     = 10
+)
+
+// malwareSignatureVersion matches the signature version suffix of malware names, e.g. Win.Trojan.Agent-1234567.
+var malwareSignatureVersion = regexp.MustCompile(`-\d+$`)
+
+type malwareFamilyCount struct {
+	malwareType   *string
+	assets        map[string]struct{}
+	findingsCount int
+}
+
+func (s *ServerImpl) GetDashboardMalwarePrevalence(ctx echo.Context, params models.GetDashboardMalwarePrevalenceParams) error {
+	reqCtx := ctx.Request().Context()
+	trendsParams := models.GetDashboardFindingsTrendsParams{
+		StartTime: params.StartTime,
+		EndTime:   params.EndTime,
+	}
+	if err := validateParams(trendsParams); err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("Request params are not valid: %v", err))
+	}
+
+	group, err := s.getAssetGroup(reqCtx, params.AssetGroupID)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, err.Error())
+	}
+
+	findings, err := s.getActiveFindings(reqCtx, models.MALWARE, group)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get malware findings: %v", err))
+	}
+
+	prevalence, err := createMalwarePrevalence(findings)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to create malware prevalence: %v", err))
+	}
+
+	trends, err := s.getFindingTrendsForFindingType(reqCtx, models.MALWARE, createTimes(trendsParams), group)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get malware trends: %v", err))
+	}
+	prevalence.Trend = trends.Trends
+
+	return sendResponse(ctx, http.StatusOK, prevalence)
+}
+
+// getActiveFindings returns the active findings of the given type with their
+// assets expanded, restricted to the members of the group if any.
+func (s *ServerImpl) getActiveFindings(ctx context.Context, findingType models.FindingType, group *backendmodels.AssetGroup) ([]backendmodels.Finding, error) {
+	filter := withAssetGroup(fmt.Sprintf("findingInfo/objectType eq '%s' and invalidatedOn eq null and suppression eq null", getObjectType(findingType)), group, "asset/")
+	var ret []backendmodels.Finding
+	top := activeFindingsPageSize
+	skip := 0
+	for {
+		findings, err := s.BackendClient.GetFindings(ctx, backendmodels.GetFindingsParams{
+			Filter: &filter,
+			Select: utils.PointerTo("asset,findingInfo"),
+			Expand: utils.PointerTo("asset($select=id,assetInfo)"),
+			Top:    &top,
+			Skip:   &skip,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get findings: %w", err)
+		}
+		if findings.Items == nil {
+			break
+		}
+
+		ret = append(ret, *findings.Items...)
+
+		if len(*findings.Items) < top {
+			break
+		}
+		skip += top
+	}
+
+	return ret, nil
+}
+
+func createMalwarePrevalence(findings []backendmodels.Finding) (models.MalwarePrevalence, error) {
+	assets := map[string]struct{}{}
+	families := map[string]*malwareFamilyCount{}
+	for _, finding := range findings {
+		if finding.Asset == nil || finding.FindingInfo == nil {
+			continue
+		}
+
+		info, err := finding.FindingInfo.AsMalwareFindingInfo()
+		if err != nil {
+			return models.MalwarePrevalence{}, fmt.Errorf("failed to convert finding info to malware info: %w", err)
+		}
+		if info.MalwareName == nil {
+			continue
+		}
+
+		family := malwareFamily(*info.MalwareName)
+		count, ok := families[family]
+		if !ok {
+			count = &malwareFamilyCount{
+				malwareType: info.MalwareType,
+				assets:      map[string]struct{}{},
+			}
+			families[family] = count
+		}
+		count.assets[finding.Asset.Id] = struct{}{}
+		count.findingsCount++
+		assets[finding.Asset.Id] = struct{}{}
+	}
+
+	prevalences := make([]models.MalwareFamilyPrevalence, 0, len(families))
+	for family, count := range families {
+		prevalences = append(prevalences, models.MalwareFamilyPrevalence{
+			Family:              utils.PointerTo(family),
+			MalwareType:         count.malwareType,
+			AffectedAssetsCount: utils.PointerTo(len(count.assets)),
+			FindingsCount:       utils.PointerTo(count.findingsCount),
+		})
+	}
+	sort.Slice(prevalences, func(i, j int) bool {
+		if *prevalences[i].AffectedAssetsCount != *prevalences[j].AffectedAssetsCount {
+			return *prevalences[i].AffectedAssetsCount > *prevalences[j].AffectedAssetsCount
+		}
+		return *prevalences[i].Family < *prevalences[j].Family
+	})
+	if len(prevalences) > topMalwareFamilies {
+		prevalences = prevalences[:topMalwareFamilies]
+	}
+
+	return models.MalwarePrevalence{
+		AffectedAssetsCount: utils.PointerTo(len(assets)),
+		Families:            &prevalences,
+	}, nil
+}
+
+// malwareFamily returns the family of the malware, which is its name without the signature version.
+func malwareFamily(malwareName string) string {
+	return malwareSignatureVersion.ReplaceAllString(malwareName, "")
+}
@@ -0,0 +1,95 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	backendmodels "github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+	"github.com/openclarity/vmclarity/ui_backend/api/models"
+)
+
+func createMalwareFinding(t *testing.T, assetID, name string) backendmodels.Finding {
+	t.Helper()
+	info := backendmodels.Finding_FindingInfo{}
+	err := info.FromMalwareFindingInfo(backendmodels.MalwareFindingInfo{
+		MalwareName: utils.PointerTo(name),
+		MalwareType: utils.PointerTo("WORM"),
+		Path:        utils.PointerTo("/bin/" + name),
+	})
+	assert.NilError(t, err)
+	return backendmodels.Finding{
+		Asset:       &backendmodels.AssetRelationship{Id: assetID},
+		FindingInfo: &info,
+	}
+}
+
+func Test_malwareFamily(t *testing.T) {
+	tests := []struct {
+		name        string
+		malwareName string
+		want        string
+	}{
+		{
+			name:        "signature version is removed",
+			malwareName: "Win.Trojan.Agent-1234567",
+			want:        "Win.Trojan.Agent",
+		},
+		{
+			name:        "name without signature version",
+			malwareName: "Eicar-Signature",
+			want:        "Eicar-Signature",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, malwareFamily(tt.malwareName), tt.want)
+		})
+	}
+}
+
+func Test_createMalwarePrevalence(t *testing.T) {
+	findings := []backendmodels.Finding{
+		createMalwareFinding(t, "asset-1", "Win.Trojan.Agent-1"),
+		createMalwareFinding(t, "asset-1", "Win.Trojan.Agent-2"),
+		createMalwareFinding(t, "asset-2", "Win.Trojan.Agent-1"),
+		createMalwareFinding(t, "asset-3", "Unix.Worm.Mirai-5"),
+		{FindingInfo: nil},
+	}
+
+	prevalence, err := createMalwarePrevalence(findings)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, prevalence, models.MalwarePrevalence{
+		AffectedAssetsCount: utils.PointerTo(3),
+		Families: &[]models.MalwareFamilyPrevalence{
+			{
+				Family:              utils.PointerTo("Win.Trojan.Agent"),
+				MalwareType:         utils.PointerTo("WORM"),
+				AffectedAssetsCount: utils.PointerTo(2),
+				FindingsCount:       utils.PointerTo(3),
+			},
+			{
+				Family:              utils.PointerTo("Unix.Worm.Mirai"),
+				MalwareType:         utils.PointerTo("WORM"),
+				AffectedAssetsCount: utils.PointerTo(1),
+				FindingsCount:       utils.PointerTo(1),
+			},
+		},
+	})
+}
@@ -0,0 +1,149 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	backendmodels "github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+	"github.com/openclarity/vmclarity/ui_backend/api/models"
+)
+
+const postureScoresPageSize = 100
+
+func (s *ServerImpl) GetDashboardPostureScores(ctx echo.Context, params models.GetDashboardPostureScoresParams) error {
+	reqCtx := ctx.Request().Context()
+	trendsParams := models.GetDashboardFindingsTrendsParams{
+		StartTime: params.StartTime,
+		EndTime:   params.EndTime,
+	}
+	if err := validateParams(trendsParams); err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("Request params are not valid: %v", err))
+	}
+
+	latest, err := s.BackendClient.GetPostureScores(reqCtx, backendmodels.GetPostureScoresParams{
+		Select:  utils.PointerTo("time"),
+		OrderBy: utils.PointerTo("time desc"),
+		Top:     utils.PointerTo(1),
+	})
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get latest posture score: %v", err))
+	}
+	if latest.Items == nil || len(*latest.Items) == 0 || (*latest.Items)[0].Time == nil {
+		return sendResponse(ctx, http.StatusOK, models.PostureScores{Teams: &[]models.TeamPostureScore{}})
+	}
+	latestTime := *(*latest.Items)[0].Time
+
+	current, err := s.getPostureScores(reqCtx, fmt.Sprintf("time eq %s", latestTime.Format(time.RFC3339)))
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get current posture scores: %v", err))
+	}
+
+	history, err := s.getPostureScores(reqCtx, fmt.Sprintf("time ge %s and time le %s",
+		params.StartTime.Format(time.RFC3339), params.EndTime.Format(time.RFC3339)))
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get posture score trends: %v", err))
+	}
+
+	return sendResponse(ctx, http.StatusOK, createPostureScores(latestTime, current, history))
+}
+
+// getPostureScores returns the posture scores matching the filter ordered by
+// time.
+func (s *ServerImpl) getPostureScores(ctx context.Context, filter string) ([]backendmodels.PostureScore, error) {
+	var scores []backendmodels.PostureScore
+	top := postureScoresPageSize
+	skip := 0
+	for {
+		page, err := s.BackendClient.GetPostureScores(ctx, backendmodels.GetPostureScoresParams{
+			Filter:  &filter,
+			OrderBy: utils.PointerTo("time asc"),
+			Top:     &top,
+			Skip:    &skip,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if page.Items == nil {
+			break
+		}
+
+		scores = append(scores, *page.Items...)
+
+		if len(*page.Items) < top {
+			break
+		}
+		skip += top
+	}
+
+	return scores, nil
+}
+
+// createPostureScores returns the current score of each team along with its
+// trend from the history of scores ordered by time.
+func createPostureScores(latestTime time.Time, current, history []backendmodels.PostureScore) models.PostureScores {
+	trends := map[string][]models.PostureScoreTrend{}
+	for _, score := range history {
+		team := utils.ValueOrZero(score.Team)
+		trends[team] = append(trends[team], models.PostureScoreTrend{
+			Time:  score.Time,
+			Score: score.Score,
+		})
+	}
+
+	teams := make([]models.TeamPostureScore, 0, len(current))
+	for _, score := range current {
+		team := utils.ValueOrZero(score.Team)
+		trend := trends[team]
+		if trend == nil {
+			trend = []models.PostureScoreTrend{}
+		}
+
+		change := 0
+		if len(trend) > 0 {
+			change = utils.ValueOrZero(score.Score) - utils.ValueOrZero(trend[0].Score)
+		}
+
+		teams = append(teams, models.TeamPostureScore{
+			Team:          utils.PointerTo(team),
+			Score:         score.Score,
+			Change:        utils.PointerTo(change),
+			Assets:        score.Assets,
+			ExposedAssets: score.ExposedAssets,
+			SlaBreaches:   score.SlaBreaches,
+			Trend:         &trend,
+		})
+	}
+	sort.Slice(teams, func(i, j int) bool {
+		a, b := utils.ValueOrZero(teams[i].Score), utils.ValueOrZero(teams[j].Score)
+		if a != b {
+			return a < b
+		}
+		return *teams[i].Team < *teams[j].Team
+	})
+
+	return models.PostureScores{
+		Time:  &latestTime,
+		Teams: &teams,
+	}
+}
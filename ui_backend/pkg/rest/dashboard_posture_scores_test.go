@@ -0,0 +1,84 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	backendmodels "github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+	"github.com/openclarity/vmclarity/ui_backend/api/models"
+)
+
+func Test_createPostureScores(t *testing.T) {
+	day1 := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	day2 := day1.Add(24 * time.Hour)
+	day3 := day2.Add(24 * time.Hour)
+
+	postureScore := func(team string, at time.Time, score int) backendmodels.PostureScore {
+		return backendmodels.PostureScore{
+			Team:          utils.PointerTo(team),
+			Time:          utils.PointerTo(at),
+			Score:         utils.PointerTo(score),
+			Assets:        utils.PointerTo(10),
+			ExposedAssets: utils.PointerTo(2),
+			SlaBreaches:   utils.PointerTo(1),
+		}
+	}
+	trend := func(at time.Time, score int) models.PostureScoreTrend {
+		return models.PostureScoreTrend{Time: utils.PointerTo(at), Score: utils.PointerTo(score)}
+	}
+
+	current := []backendmodels.PostureScore{
+		postureScore("web", day3, 80),
+		postureScore("db", day3, 60),
+		postureScore("new", day3, 80),
+	}
+	history := []backendmodels.PostureScore{
+		postureScore("web", day1, 70),
+		postureScore("db", day1, 65),
+		postureScore("web", day2, 75),
+		postureScore("db", day2, 62),
+		postureScore("web", day3, 80),
+		postureScore("db", day3, 60),
+	}
+
+	teamPostureScore := func(team string, score, change int, trend []models.PostureScoreTrend) models.TeamPostureScore {
+		return models.TeamPostureScore{
+			Team:          utils.PointerTo(team),
+			Score:         utils.PointerTo(score),
+			Change:        utils.PointerTo(change),
+			Assets:        utils.PointerTo(10),
+			ExposedAssets: utils.PointerTo(2),
+			SlaBreaches:   utils.PointerTo(1),
+			Trend:         &trend,
+		}
+	}
+	want := models.PostureScores{
+		Time: utils.PointerTo(day3),
+		Teams: &[]models.TeamPostureScore{
+			teamPostureScore("db", 60, -5, []models.PostureScoreTrend{trend(day1, 65), trend(day2, 62), trend(day3, 60)}),
+			teamPostureScore("new", 80, 0, []models.PostureScoreTrend{}),
+			teamPostureScore("web", 80, 10, []models.PostureScoreTrend{trend(day1, 70), trend(day2, 75), trend(day3, 80)}),
+		},
+	}
+
+	got := createPostureScores(day3, current, history)
+	assert.DeepEqual(t, got, want)
+}
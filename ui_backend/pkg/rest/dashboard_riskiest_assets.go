@@ -52,39 +52,44 @@ var orderedSeveritiesFields = []string{
 	totalNegligibleVulnerabilitiesFieldName,
 }
 
-func (s *ServerImpl) GetDashboardRiskiestAssets(ctx echo.Context) error {
+func (s *ServerImpl) GetDashboardRiskiestAssets(ctx echo.Context, params models.GetDashboardRiskiestAssetsParams) error {
 	reqCtx := ctx.Request().Context()
-	exploits, err := s.getRiskiestAssetsForFindingType(reqCtx, backendmodels.EXPLOIT)
+	group, err := s.getAssetGroup(reqCtx, params.AssetGroupID)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, err.Error())
+	}
+
+	exploits, err := s.getRiskiestAssetsForFindingType(reqCtx, backendmodels.EXPLOIT, group)
 	if err != nil {
 		return sendError(ctx, http.StatusInternalServerError,
 			fmt.Sprintf("failed to get riskiest assets for exploits: %v", err))
 	}
 
-	malware, err := s.getRiskiestAssetsForFindingType(reqCtx, backendmodels.MALWARE)
+	malware, err := s.getRiskiestAssetsForFindingType(reqCtx, backendmodels.MALWARE, group)
 	if err != nil {
 		return sendError(ctx, http.StatusInternalServerError,
 			fmt.Sprintf("failed to get riskiest assets for malware: %v", err))
 	}
 
-	misconfigurations, err := s.getRiskiestAssetsForFindingType(reqCtx, backendmodels.MISCONFIGURATION)
+	misconfigurations, err := s.getRiskiestAssetsForFindingType(reqCtx, backendmodels.MISCONFIGURATION, group)
 	if err != nil {
 		return sendError(ctx, http.StatusInternalServerError,
 			fmt.Sprintf("failed to get riskiest assets for misconfigurations: %v", err))
 	}
 
-	rootkits, err := s.getRiskiestAssetsForFindingType(reqCtx, backendmodels.ROOTKIT)
+	rootkits, err := s.getRiskiestAssetsForFindingType(reqCtx, backendmodels.ROOTKIT, group)
 	if err != nil {
 		return sendError(ctx, http.StatusInternalServerError,
 			fmt.Sprintf("failed to get riskiest assets for rootkits: %v", err))
 	}
 
-	secrets, err := s.getRiskiestAssetsForFindingType(reqCtx, backendmodels.SECRET)
+	secrets, err := s.getRiskiestAssetsForFindingType(reqCtx, backendmodels.SECRET, group)
 	if err != nil {
 		return sendError(ctx, http.StatusInternalServerError,
 			fmt.Sprintf("failed to get riskiest assets for secrets: %v", err))
 	}
 
-	vulnerabilities, err := s.getRiskiestAssetsForVulnerabilityType(reqCtx)
+	vulnerabilities, err := s.getRiskiestAssetsForVulnerabilityType(reqCtx, group)
 	if err != nil {
 		return sendError(ctx, http.StatusInternalServerError,
 			fmt.Sprintf("failed to get riskiest assets for vulnerabilities: %v", err))
@@ -100,8 +105,8 @@ func (s *ServerImpl) GetDashboardRiskiestAssets(ctx echo.Context) error {
 	})
 }
 
-func (s *ServerImpl) getRiskiestAssetsForFindingType(ctx context.Context, findingType backendmodels.ScanType) ([]models.RiskyAsset, error) {
-	riskiestAssets, err := s.getRiskiestAssetsPerFinding(ctx, findingType)
+func (s *ServerImpl) getRiskiestAssetsForFindingType(ctx context.Context, findingType backendmodels.ScanType, group *backendmodels.AssetGroup) ([]models.RiskyAsset, error) {
+	riskiestAssets, err := s.getRiskiestAssetsPerFinding(ctx, findingType, group)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get riskiest assets: %v", err)
 	}
@@ -109,8 +114,8 @@ func (s *ServerImpl) getRiskiestAssetsForFindingType(ctx context.Context, findin
 	return toAPIRiskyAssets(*riskiestAssets.Items, findingType), nil
 }
 
-func (s *ServerImpl) getRiskiestAssetsForVulnerabilityType(ctx context.Context) ([]models.VulnerabilityRiskyAsset, error) {
-	targets, err := s.getRiskiestAssetsPerFinding(ctx, backendmodels.VULNERABILITY)
+func (s *ServerImpl) getRiskiestAssetsForVulnerabilityType(ctx context.Context, group *backendmodels.AssetGroup) ([]models.VulnerabilityRiskyAsset, error) {
+	targets, err := s.getRiskiestAssetsPerFinding(ctx, backendmodels.VULNERABILITY, group)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get riskiest assets: %v", err)
 	}
@@ -118,17 +123,17 @@ func (s *ServerImpl) getRiskiestAssetsForVulnerabilityType(ctx context.Context)
 	return toAPIVulnerabilityRiskyAssets(*targets.Items), nil
 }
 
-func (s *ServerImpl) getRiskiestAssetsPerFinding(ctx context.Context, findingType backendmodels.ScanType) (*backendmodels.Targets, error) {
+func (s *ServerImpl) getRiskiestAssetsPerFinding(ctx context.Context, findingType backendmodels.ScanType, group *backendmodels.AssetGroup) (*backendmodels.Assets, error) {
 	totalFindingField, err := getTotalFindingFieldName(findingType)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get total findings field name: %v", err)
 	}
 
-	riskiestAssets, err := s.BackendClient.GetTargets(ctx, backendmodels.GetTargetsParams{
-		Select:  utils.PointerTo(fmt.Sprintf("summary/%s,targetInfo", totalFindingField)),
+	riskiestAssets, err := s.BackendClient.GetAssets(ctx, backendmodels.GetAssetsParams{
+		Select:  utils.PointerTo(fmt.Sprintf("summary/%s,assetInfo", totalFindingField)),
 		Top:     utils.PointerTo(topRiskiestAssetsCount),
 		OrderBy: utils.PointerTo(getOrderByOData(totalFindingField)),
-		Filter:  utils.PointerTo(fmt.Sprintf("summary/%s ne null", totalFindingField)),
+		Filter:  utils.PointerTo(withAssetGroup(fmt.Sprintf("summary/%s ne null", totalFindingField), group, "")),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get targets: %v", err)
@@ -154,11 +159,11 @@ func getOrderByOdataForVulnerabilities() string {
 	return strings.Join(ret, ",")
 }
 
-func toAPIVulnerabilityRiskyAssets(targets []backendmodels.Target) []models.VulnerabilityRiskyAsset {
+func toAPIVulnerabilityRiskyAssets(targets []backendmodels.Asset) []models.VulnerabilityRiskyAsset {
 	ret := make([]models.VulnerabilityRiskyAsset, 0, len(targets))
 
 	for _, target := range targets {
-		assetInfo, err := getAssetInfo(target.TargetInfo)
+		assetInfo, err := getAssetInfo(target.AssetInfo)
 		if err != nil {
 			log.Warningf("Failed to get asset info, skipping target: %v", err)
 			continue
@@ -178,11 +183,11 @@ func toAPIVulnerabilityRiskyAssets(targets []backendmodels.Target) []models.Vuln
 	return ret
 }
 
-func toAPIRiskyAssets(targets []backendmodels.Target, findingType backendmodels.ScanType) []models.RiskyAsset {
+func toAPIRiskyAssets(targets []backendmodels.Asset, findingType backendmodels.ScanType) []models.RiskyAsset {
 	ret := make([]models.RiskyAsset, 0, len(targets))
 
 	for _, target := range targets {
-		assetInfo, err := getAssetInfo(target.TargetInfo)
+		assetInfo, err := getAssetInfo(target.AssetInfo)
 		if err != nil {
 			log.Warningf("Failed to get asset info, skipping target: %v", err)
 			continue
@@ -203,7 +208,7 @@ func toAPIRiskyAssets(targets []backendmodels.Target, findingType backendmodels.
 	return ret
 }
 
-func getAssetInfo(target *backendmodels.TargetType) (*models.AssetInfo, error) {
+func getAssetInfo(target *backendmodels.AssetType) (*models.AssetInfo, error) {
 	discriminator, err := target.ValueByDiscriminator()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get value by discriminator: %w", err)
@@ -212,6 +217,24 @@ func getAssetInfo(target *backendmodels.TargetType) (*models.AssetInfo, error) {
 	switch info := discriminator.(type) {
 	case backendmodels.VMInfo:
 		return vmInfoToAssetInfo(info)
+	case backendmodels.ContainerImageInfo:
+		return &models.AssetInfo{
+			Location: &info.Location,
+			Name:     &info.Repository,
+			Type:     utils.PointerTo(models.ContainerImage),
+		}, nil
+	case backendmodels.SBOMInfo:
+		return &models.AssetInfo{
+			Location: info.Location,
+			Name:     &info.Name,
+			Type:     utils.PointerTo(models.SBOM),
+		}, nil
+	case backendmodels.VMImageInfo:
+		return &models.AssetInfo{
+			Location: (*string)(info.InstanceProvider),
+			Name:     &info.Image,
+			Type:     utils.PointerTo(models.VMImage),
+		}, nil
 	default:
 		return nil, fmt.Errorf("target type is not supported (%T)", discriminator)
 	}
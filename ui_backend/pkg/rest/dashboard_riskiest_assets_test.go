@@ -215,7 +215,7 @@ func Test_getCount(t *testing.T) {
 
 func Test_getAssetInfo(t *testing.T) {
 	type args struct {
-		target *backendmodels.TargetType
+		target *backendmodels.AssetType
 	}
 	tests := []struct {
 		name    string
@@ -258,9 +258,9 @@ func Test_getAssetInfo(t *testing.T) {
 	}
 }
 
-func createVMInfo(t *testing.T, instanceID, location string) *backendmodels.TargetType {
+func createVMInfo(t *testing.T, instanceID, location string) *backendmodels.AssetType {
 	t.Helper()
-	info := backendmodels.TargetType{}
+	info := backendmodels.AssetType{}
 	err := info.FromVMInfo(backendmodels.VMInfo{
 		InstanceID:       instanceID,
 		InstanceProvider: utils.PointerTo(backendmodels.AWS),
@@ -270,9 +270,9 @@ func createVMInfo(t *testing.T, instanceID, location string) *backendmodels.Targ
 	return &info
 }
 
-func createPodInfo(t *testing.T, podName, location string) *backendmodels.TargetType {
+func createPodInfo(t *testing.T, podName, location string) *backendmodels.AssetType {
 	t.Helper()
-	info := backendmodels.TargetType{}
+	info := backendmodels.AssetType{}
 	err := info.FromPodInfo(backendmodels.PodInfo{
 		Location: &location,
 		PodName:  &podName,
@@ -283,7 +283,7 @@ func createPodInfo(t *testing.T, podName, location string) *backendmodels.Target
 
 func Test_toAPIVulnerabilityRiskyAsset(t *testing.T) {
 	type args struct {
-		targets []backendmodels.Target
+		targets []backendmodels.Asset
 	}
 	tests := []struct {
 		name string
@@ -300,7 +300,7 @@ func Test_toAPIVulnerabilityRiskyAsset(t *testing.T) {
 		{
 			name: "supported and unsupported target",
 			args: args{
-				targets: []backendmodels.Target{
+				targets: []backendmodels.Asset{
 					{
 						Summary: &backendmodels.ScanFindingsSummary{
 							TotalVulnerabilities: &backendmodels.VulnerabilityScanSummary{
@@ -311,7 +311,7 @@ func Test_toAPIVulnerabilityRiskyAsset(t *testing.T) {
 								TotalNegligibleVulnerabilities: utils.PointerTo(5),
 							},
 						},
-						TargetInfo: createVMInfo(t, "vm name", "vm location"),
+						AssetInfo: createVMInfo(t, "vm name", "vm location"),
 					},
 					{
 						Summary: &backendmodels.ScanFindingsSummary{
@@ -319,7 +319,7 @@ func Test_toAPIVulnerabilityRiskyAsset(t *testing.T) {
 								TotalHighVulnerabilities: utils.PointerTo(1),
 							},
 						},
-						TargetInfo: createPodInfo(t, "pod name", "pod location"),
+						AssetInfo: createPodInfo(t, "pod name", "pod location"),
 					},
 				},
 			},
@@ -350,7 +350,7 @@ func Test_toAPIVulnerabilityRiskyAsset(t *testing.T) {
 
 func Test_toAPIRiskyAssets(t *testing.T) {
 	type args struct {
-		targets     []backendmodels.Target
+		targets     []backendmodels.Asset
 		findingType backendmodels.ScanType
 	}
 	tests := []struct {
@@ -369,18 +369,18 @@ func Test_toAPIRiskyAssets(t *testing.T) {
 		{
 			name: "supported and unsupported target",
 			args: args{
-				targets: []backendmodels.Target{
+				targets: []backendmodels.Asset{
 					{
 						Summary: &backendmodels.ScanFindingsSummary{
 							TotalMalware: utils.PointerTo(1),
 						},
-						TargetInfo: createVMInfo(t, "vm name", "vm location"),
+						AssetInfo: createVMInfo(t, "vm name", "vm location"),
 					},
 					{
 						Summary: &backendmodels.ScanFindingsSummary{
 							TotalMalware: utils.PointerTo(2),
 						},
-						TargetInfo: createPodInfo(t, "pod name", "pod location"),
+						AssetInfo: createPodInfo(t, "pod name", "pod location"),
 					},
 				},
 				findingType: backendmodels.MALWARE,
@@ -399,14 +399,14 @@ func Test_toAPIRiskyAssets(t *testing.T) {
 		{
 			name: "unsupported finding type target",
 			args: args{
-				targets: []backendmodels.Target{
+				targets: []backendmodels.Asset{
 					{
 						Summary: &backendmodels.ScanFindingsSummary{
 							TotalVulnerabilities: &backendmodels.VulnerabilityScanSummary{
 								TotalHighVulnerabilities: utils.PointerTo(1),
 							},
 						},
-						TargetInfo: createVMInfo(t, "name", "location"),
+						AssetInfo: createVMInfo(t, "name", "location"),
 					},
 					{
 						Summary: &backendmodels.ScanFindingsSummary{
@@ -414,7 +414,7 @@ func Test_toAPIRiskyAssets(t *testing.T) {
 								TotalHighVulnerabilities: utils.PointerTo(1),
 							},
 						},
-						TargetInfo: createVMInfo(t, "name1", "location1"),
+						AssetInfo: createVMInfo(t, "name1", "location1"),
 					},
 				},
 				findingType: "unsupported",
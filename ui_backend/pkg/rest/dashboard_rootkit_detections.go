@@ -0,0 +1,159 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/labstack/echo/v4"
+
+	backendmodels "github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+	"github.com/openclarity/vmclarity/ui_backend/api/models"
+)
+
+type rootkitKey struct {
+	Name string
+	Type backendmodels.RootkitType
+}
+
+type rootkitCount struct {
+	assets        map[string]struct{}
+	findingsCount int
+}
+
+type rootkitAssetCount struct {
+	target        *backendmodels.AssetType
+	findingsCount int
+}
+
+func (s *ServerImpl) GetDashboardRootkitDetections(ctx echo.Context, params models.GetDashboardRootkitDetectionsParams) error {
+	reqCtx := ctx.Request().Context()
+	trendsParams := models.GetDashboardFindingsTrendsParams{
+		StartTime: params.StartTime,
+		EndTime:   params.EndTime,
+	}
+	if err := validateParams(trendsParams); err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("Request params are not valid: %v", err))
+	}
+
+	group, err := s.getAssetGroup(reqCtx, params.AssetGroupID)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, err.Error())
+	}
+
+	findings, err := s.getActiveFindings(reqCtx, models.ROOTKIT, group)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get rootkit findings: %v", err))
+	}
+
+	detections, err := createRootkitDetections(findings)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to create rootkit detections: %v", err))
+	}
+
+	trends, err := s.getFindingTrendsForFindingType(reqCtx, models.ROOTKIT, createTimes(trendsParams), group)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to get rootkit trends: %v", err))
+	}
+	detections.Trend = trends.Trends
+
+	return sendResponse(ctx, http.StatusOK, detections)
+}
+
+func createRootkitDetections(findings []backendmodels.Finding) (models.RootkitDetections, error) {
+	rootkits := map[rootkitKey]*rootkitCount{}
+	assets := map[string]*rootkitAssetCount{}
+	for _, finding := range findings {
+		if finding.Asset == nil || finding.FindingInfo == nil {
+			continue
+		}
+
+		info, err := finding.FindingInfo.AsRootkitFindingInfo()
+		if err != nil {
+			return models.RootkitDetections{}, fmt.Errorf("failed to convert finding info to rootkit info: %w", err)
+		}
+
+		key := rootkitKey{
+			Name: utils.ValueOrZero(info.RootkitName),
+			Type: utils.ValueOrZero(info.RootkitType),
+		}
+		count, ok := rootkits[key]
+		if !ok {
+			count = &rootkitCount{assets: map[string]struct{}{}}
+			rootkits[key] = count
+		}
+		count.assets[finding.Asset.Id] = struct{}{}
+		count.findingsCount++
+
+		assetCount, ok := assets[finding.Asset.Id]
+		if !ok {
+			assetCount = &rootkitAssetCount{target: finding.Asset.AssetInfo}
+			assets[finding.Asset.Id] = assetCount
+		}
+		assetCount.findingsCount++
+	}
+
+	detections := make([]models.RootkitDetection, 0, len(rootkits))
+	for key, count := range rootkits {
+		rootkit := &models.Rootkit{
+			RootkitName: utils.PointerTo(key.Name),
+		}
+		if key.Type != "" {
+			rootkit.RootkitType = toModelsRootkitType(&key.Type)
+		}
+		detections = append(detections, models.RootkitDetection{
+			Rootkit:             rootkit,
+			AffectedAssetsCount: utils.PointerTo(len(count.assets)),
+			FindingsCount:       utils.PointerTo(count.findingsCount),
+		})
+	}
+	sort.Slice(detections, func(i, j int) bool {
+		if *detections[i].AffectedAssetsCount != *detections[j].AffectedAssetsCount {
+			return *detections[i].AffectedAssetsCount > *detections[j].AffectedAssetsCount
+		}
+		return *detections[i].Rootkit.RootkitName < *detections[j].Rootkit.RootkitName
+	})
+
+	riskyAssets := make([]models.RiskyAsset, 0, len(assets))
+	for _, assetCount := range assets {
+		if assetCount.target == nil {
+			continue
+		}
+		assetInfo, err := getAssetInfo(assetCount.target)
+		if err != nil {
+			return models.RootkitDetections{}, fmt.Errorf("failed to get asset info: %w", err)
+		}
+		riskyAssets = append(riskyAssets, models.RiskyAsset{
+			AssetInfo: assetInfo,
+			Count:     utils.PointerTo(assetCount.findingsCount),
+		})
+	}
+	sort.Slice(riskyAssets, func(i, j int) bool {
+		if *riskyAssets[i].Count != *riskyAssets[j].Count {
+			return *riskyAssets[i].Count > *riskyAssets[j].Count
+		}
+		return utils.ValueOrZero(riskyAssets[i].AssetInfo.Name) < utils.ValueOrZero(riskyAssets[j].AssetInfo.Name)
+	})
+
+	return models.RootkitDetections{
+		AffectedAssetsCount: utils.PointerTo(len(assets)),
+		Rootkits:            &detections,
+		Assets:              &riskyAssets,
+	}, nil
+}
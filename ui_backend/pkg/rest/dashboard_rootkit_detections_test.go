@@ -0,0 +1,94 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	backendmodels "github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+	"github.com/openclarity/vmclarity/ui_backend/api/models"
+)
+
+func createRootkitFinding(t *testing.T, assetID, name string) backendmodels.Finding {
+	t.Helper()
+	targetInfo := backendmodels.AssetType{}
+	err := targetInfo.FromVMInfo(backendmodels.VMInfo{
+		InstanceID:       assetID,
+		InstanceProvider: utils.PointerTo(backendmodels.AWS),
+		Location:         "us-east-1",
+	})
+	assert.NilError(t, err)
+	return backendmodels.Finding{
+		Asset: &backendmodels.AssetRelationship{
+			Id:        assetID,
+			AssetInfo: &targetInfo,
+		},
+		FindingInfo: createRootkitFindingInfo(t, "message", name, "KERNEL"),
+	}
+}
+
+func Test_createRootkitDetections(t *testing.T) {
+	findings := []backendmodels.Finding{
+		createRootkitFinding(t, "i-1", "rootkit-a"),
+		createRootkitFinding(t, "i-1", "rootkit-b"),
+		createRootkitFinding(t, "i-2", "rootkit-a"),
+	}
+
+	detections, err := createRootkitDetections(findings)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, detections, models.RootkitDetections{
+		AffectedAssetsCount: utils.PointerTo(2),
+		Rootkits: &[]models.RootkitDetection{
+			{
+				Rootkit: &models.Rootkit{
+					RootkitName: utils.PointerTo("rootkit-a"),
+					RootkitType: utils.PointerTo(models.KERNEL),
+				},
+				AffectedAssetsCount: utils.PointerTo(2),
+				FindingsCount:       utils.PointerTo(2),
+			},
+			{
+				Rootkit: &models.Rootkit{
+					RootkitName: utils.PointerTo("rootkit-b"),
+					RootkitType: utils.PointerTo(models.KERNEL),
+				},
+				AffectedAssetsCount: utils.PointerTo(1),
+				FindingsCount:       utils.PointerTo(1),
+			},
+		},
+		Assets: &[]models.RiskyAsset{
+			{
+				AssetInfo: &models.AssetInfo{
+					Name:     utils.PointerTo("i-1"),
+					Location: utils.PointerTo("us-east-1"),
+					Type:     utils.PointerTo(models.AWSEC2Instance),
+				},
+				Count: utils.PointerTo(2),
+			},
+			{
+				AssetInfo: &models.AssetInfo{
+					Name:     utils.PointerTo("i-2"),
+					Location: utils.PointerTo("us-east-1"),
+					Type:     utils.PointerTo(models.AWSEC2Instance),
+				},
+				Count: utils.PointerTo(1),
+			},
+		},
+	})
+}
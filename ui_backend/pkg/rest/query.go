@@ -0,0 +1,250 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/CiscoM31/godata"
+	"github.com/labstack/echo/v4"
+
+	backendmodels "github.com/openclarity/vmclarity/api/models"
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+	"github.com/openclarity/vmclarity/ui_backend/api/models"
+)
+
+// maxQueryTop caps the number of items a passthrough query can return.
+const maxQueryTop = 100
+
+// queryAllowedFields lists, per resource, the fields which can be used in
+// $filter, $select and $orderby. A field allows all of its sub fields.
+var queryAllowedFields = map[models.QueryResource][]string{
+	models.Assets: {
+		"id",
+		"assetInfo",
+		"summary",
+		"scansCount",
+	},
+	models.Findings: {
+		"id",
+		"findingInfo",
+		"foundOn",
+		"invalidatedOn",
+		"asset/id",
+		"scan/id",
+	},
+	models.Scans: {
+		"id",
+		"startTime",
+		"endTime",
+		"state",
+		"stateReason",
+		"summary",
+		"assetIDs",
+		"scanConfig/id",
+	},
+	models.ScanResults: {
+		"id",
+		"status",
+		"summary",
+		"scannerStartTime",
+		"scannerEndTime",
+		"scan/id",
+		"asset/id",
+	},
+}
+
+func (s *ServerImpl) GetQueryResource(ctx echo.Context, resource models.Resource, params models.GetQueryResourceParams) error {
+	allowedFields, ok := queryAllowedFields[resource]
+	if !ok {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("resource %q can not be queried", resource))
+	}
+
+	query, err := buildQueryParams(allowedFields, params)
+	if err != nil {
+		return sendError(ctx, http.StatusBadRequest, fmt.Sprintf("invalid query for %s: %v", resource, err))
+	}
+
+	result, err := s.runQuery(ctx.Request().Context(), resource, query)
+	if err != nil {
+		return sendError(ctx, http.StatusInternalServerError, fmt.Sprintf("failed to query %s: %v", resource, err))
+	}
+
+	return sendResponse(ctx, http.StatusOK, result)
+}
+
+// buildQueryParams validates the query against the allowed fields and
+// enforces the $top cap.
+func buildQueryParams(allowedFields []string, params models.GetQueryResourceParams) (models.GetQueryResourceParams, error) {
+	if params.Filter != nil {
+		filter, err := godata.ParseFilterString(context.TODO(), *params.Filter)
+		if err != nil {
+			return models.GetQueryResourceParams{}, fmt.Errorf("failed to parse $filter: %w", err)
+		}
+		if err := checkFilterFields(allowedFields, filter.Tree); err != nil {
+			return models.GetQueryResourceParams{}, fmt.Errorf("invalid $filter: %w", err)
+		}
+	}
+
+	if params.Select != nil {
+		for _, field := range strings.Split(*params.Select, ",") {
+			if err := checkQueryField(allowedFields, strings.TrimSpace(field)); err != nil {
+				return models.GetQueryResourceParams{}, fmt.Errorf("invalid $select: %w", err)
+			}
+		}
+	}
+
+	if params.OrderBy != nil {
+		orderBy, err := godata.ParseOrderByString(context.TODO(), *params.OrderBy)
+		if err != nil {
+			return models.GetQueryResourceParams{}, fmt.Errorf("failed to parse $orderby: %w", err)
+		}
+		for _, item := range orderBy.OrderByItems {
+			path, err := queryPathFromParseNode(item.Tree.Tree)
+			if err != nil {
+				return models.GetQueryResourceParams{}, fmt.Errorf("invalid $orderby: %w", err)
+			}
+			if err := checkQueryField(allowedFields, path); err != nil {
+				return models.GetQueryResourceParams{}, fmt.Errorf("invalid $orderby: %w", err)
+			}
+		}
+	}
+
+	top := maxQueryTop
+	if params.Top != nil && *params.Top >= 0 && *params.Top < maxQueryTop {
+		top = *params.Top
+	}
+
+	params.Top = utils.PointerTo(top)
+
+	return params, nil
+}
+
+// checkFilterFields checks that all the properties referenced by the filter
+// are allowed.
+func checkFilterFields(allowedFields []string, node *godata.ParseNode) error {
+	if node == nil {
+		return nil
+	}
+
+	switch node.Token.Type {
+	case godata.ExpressionTokenNav, godata.ExpressionTokenLiteral:
+		path, err := queryPathFromParseNode(node)
+		if err != nil {
+			return err
+		}
+		return checkQueryField(allowedFields, path)
+	default:
+		for _, child := range node.Children {
+			if err := checkFilterFields(allowedFields, child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func queryPathFromParseNode(node *godata.ParseNode) (string, error) {
+	switch node.Token.Type {
+	case godata.ExpressionTokenNav:
+		left, err := queryPathFromParseNode(node.Children[0])
+		if err != nil {
+			return "", err
+		}
+		right, err := queryPathFromParseNode(node.Children[1])
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s/%s", left, right), nil
+	case godata.ExpressionTokenLiteral:
+		return node.Token.Value, nil
+	default:
+		return "", fmt.Errorf("unsupported property path %q", node.Token.Value)
+	}
+}
+
+func checkQueryField(allowedFields []string, field string) error {
+	for _, allowed := range allowedFields {
+		if field == allowed || strings.HasPrefix(field, allowed+"/") {
+			return nil
+		}
+	}
+	return fmt.Errorf("field %q is not allowed", field)
+}
+
+// nolint:cyclop
+func (s *ServerImpl) runQuery(ctx context.Context, resource models.QueryResource, query models.GetQueryResourceParams) (*models.QueryResult, error) {
+	var count *int
+	var items interface{}
+
+	switch resource {
+	case models.Assets:
+		targets, err := s.BackendClient.GetAssets(ctx, backendmodels.GetAssetsParams{
+			Filter: query.Filter, Select: query.Select, OrderBy: query.OrderBy, Count: query.Count, Top: query.Top, Skip: query.Skip,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get targets: %w", err)
+		}
+		count, items = targets.Count, targets.Items
+	case models.Findings:
+		findings, err := s.BackendClient.GetFindings(ctx, backendmodels.GetFindingsParams{
+			Filter: query.Filter, Select: query.Select, OrderBy: query.OrderBy, Count: query.Count, Top: query.Top, Skip: query.Skip,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get findings: %w", err)
+		}
+		count, items = findings.Count, findings.Items
+	case models.Scans:
+		scans, err := s.BackendClient.GetScans(ctx, backendmodels.GetScansParams{
+			Filter: query.Filter, Select: query.Select, OrderBy: query.OrderBy, Count: query.Count, Top: query.Top, Skip: query.Skip,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get scans: %w", err)
+		}
+		count, items = scans.Count, scans.Items
+	case models.ScanResults:
+		scanResults, err := s.BackendClient.GetScanResults(ctx, backendmodels.GetScanResultsParams{
+			Filter: query.Filter, Select: query.Select, OrderBy: query.OrderBy, Count: query.Count, Top: query.Top, Skip: query.Skip,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get scan results: %w", err)
+		}
+		count, items = scanResults.Count, scanResults.Items
+	default:
+		return nil, fmt.Errorf("unsupported resource %q", resource)
+	}
+
+	// The items are returned as generic objects, convert them through JSON.
+	result := []map[string]interface{}{}
+	data, err := json.Marshal(items)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal items: %w", err)
+	}
+	if string(data) != "null" {
+		if err := json.Unmarshal(data, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal items: %w", err)
+		}
+	}
+
+	return &models.QueryResult{
+		Count: count,
+		Items: &result,
+	}, nil
+}
@@ -0,0 +1,114 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/openclarity/vmclarity/shared/pkg/utils"
+	"github.com/openclarity/vmclarity/ui_backend/api/models"
+)
+
+func Test_buildQueryParams(t *testing.T) {
+	allowedFields := queryAllowedFields[models.Findings]
+
+	tests := []struct {
+		name    string
+		params  models.GetQueryResourceParams
+		wantTop int
+		wantErr bool
+	}{
+		{
+			name:    "no query uses the top cap",
+			params:  models.GetQueryResourceParams{},
+			wantTop: maxQueryTop,
+		},
+		{
+			name: "allowed fields",
+			params: models.GetQueryResourceParams{
+				Filter:  utils.PointerTo("findingInfo/objectType eq 'Vulnerability' and asset/id eq '1234' and invalidatedOn eq null"),
+				Select:  utils.PointerTo("id, findingInfo/severity"),
+				OrderBy: utils.PointerTo("foundOn desc"),
+				Top:     utils.PointerTo(10),
+			},
+			wantTop: 10,
+		},
+		{
+			name: "allowed fields in function",
+			params: models.GetQueryResourceParams{
+				Filter: utils.PointerTo("contains(findingInfo/vulnerabilityName, 'CVE-2023')"),
+			},
+			wantTop: maxQueryTop,
+		},
+		{
+			name: "top above the cap",
+			params: models.GetQueryResourceParams{
+				Top: utils.PointerTo(maxQueryTop + 1),
+			},
+			wantTop: maxQueryTop,
+		},
+		{
+			name: "filter on field which is not allowed",
+			params: models.GetQueryResourceParams{
+				Filter: utils.PointerTo("asset/assetInfo/instanceID eq 'i-1234'"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "select field which is not allowed",
+			params: models.GetQueryResourceParams{
+				Select: utils.PointerTo("id,scan"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "order by field which is not allowed",
+			params: models.GetQueryResourceParams{
+				OrderBy: utils.PointerTo("revision"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "prefix of allowed field",
+			params: models.GetQueryResourceParams{
+				Select: utils.PointerTo("findingInfoExtra"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid filter",
+			params: models.GetQueryResourceParams{
+				Filter: utils.PointerTo("id eq"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildQueryParams(allowedFields, tt.params)
+			if tt.wantErr {
+				assert.Assert(t, err != nil)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, *got.Top, tt.wantTop)
+			assert.DeepEqual(t, got.Filter, tt.params.Filter)
+			assert.DeepEqual(t, got.Select, tt.params.Select)
+		})
+	}
+}
@@ -20,48 +20,41 @@ import (
 	"time"
 
 	"github.com/openclarity/vmclarity/shared/pkg/backendclient"
+	"github.com/openclarity/vmclarity/shared/pkg/compliance"
 	"github.com/openclarity/vmclarity/shared/pkg/log"
 )
 
 const (
-	backgroundRecalculationInterval = 15 * time.Minute
+	BackgroundRecalculationInterval = 15 * time.Minute
 )
 
 type ServerImpl struct {
-	BackendClient *backendclient.BackendClient
+	BackendClient    *backendclient.BackendClient
+	ComplianceMapper *compliance.Mapper
 	findingsImpactData
 }
 
-func CreateUIBackedServer(client *backendclient.BackendClient) *ServerImpl {
+func CreateUIBackedServer(client *backendclient.BackendClient, complianceMapper *compliance.Mapper) *ServerImpl {
 	return &ServerImpl{
-		BackendClient: client,
+		BackendClient:    client,
+		ComplianceMapper: complianceMapper,
 		findingsImpactData: findingsImpactData{
 			findingsImpactFetchedChannel: make(chan struct{}),
 		},
 	}
 }
 
-func (s *ServerImpl) StartBackgroundProcessing(ctx context.Context) {
-	go func() {
-		logger := log.GetLoggerFromContextOrDiscard(ctx)
-
-		s.runBackgroundRecalculation(ctx)
-		for {
-			select {
-			case <-time.After(backgroundRecalculationInterval):
-				s.runBackgroundRecalculation(ctx)
-			case <-ctx.Done():
-				logger.Infof("Stop background recalculation")
-				return
-			}
-		}
-	}()
-}
-
-func (s *ServerImpl) runBackgroundRecalculation(ctx context.Context) {
+// RunBackgroundRecalculation recalculates the dashboard data which the UI
+// backend caches. The backend runs it every BackgroundRecalculationInterval
+// once its REST server was started.
+func (s *ServerImpl) RunBackgroundRecalculation(ctx context.Context, _ time.Time) error {
 	logger := log.GetLoggerFromContextOrDiscard(ctx)
 
 	logger.Infof("Background recalculation started...")
-	s.recalculateFindingsImpact(ctx)
+	if err := s.recalculateFindingsImpact(ctx); err != nil {
+		return err
+	}
 	logger.Infof("Background recalculation ended...")
+
+	return nil
 }